@@ -0,0 +1,13 @@
+package auctioneerclient_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestAuctioneerclient(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Auctioneerclient Suite")
+}
@@ -0,0 +1,287 @@
+package auctioneerclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+)
+
+const (
+	auctionRequestsQueued = metric.Metric("AuctionRequestsQueued")
+
+	// Requests are counted each time their submission fails, so a request
+	// retried several times is counted several times.
+	auctionRequestsFailed = metric.Counter("AuctionRequestsFailed")
+
+	// Dropped requests, turned away by a full buffer, rejected by the
+	// auctioneer, or still queued at shutdown, are auctioned again by
+	// convergence.
+	auctionRequestsDropped = metric.Counter("AuctionRequestsDropped")
+)
+
+// ErrBufferFull is returned for requests that would take a BufferedClient
+// past its capacity. They are dropped, as they are when the auctioneer cannot
+// be reached without a buffer, and convergence requests them again.
+var ErrBufferFull = errors.New("auction request buffer is full")
+
+// BufferedClient queues the requests it is given and submits them to another
+// Client in the background, so that the BBS does not wait on the auctioneer,
+// nor depend on it being available. Requests that fail to be submitted are
+// kept, ahead of newer ones and as far as the capacity allows, and submitted
+// again after the retry interval. The interval doubles after each consecutive
+// failure, up to the max retry interval, and is reset once a submission
+// succeeds. Requests the auctioneer rejects as invalid are dropped rather than
+// submitted again.
+//
+// A BufferedClient must be run as an ifrit.Runner to submit anything. The
+// requests still queued when it is signalled are dropped.
+type BufferedClient struct {
+	logger           lager.Logger
+	client           Client
+	clock            clock.Clock
+	capacity         int
+	retryInterval    time.Duration
+	maxRetryInterval time.Duration
+
+	lock       sync.Mutex
+	lrpStarts  []*auctioneer.LRPStartRequest
+	taskStarts []*auctioneer.TaskStartRequest
+	queued     chan struct{}
+}
+
+// NewBufferedClient returns a BufferedClient holding at most capacity
+// requests, each LRPStartRequest and TaskStartRequest counting as one. A
+// maxRetryInterval shorter than retryInterval disables the backoff.
+func NewBufferedClient(logger lager.Logger, client Client, clock clock.Clock, capacity int, retryInterval, maxRetryInterval time.Duration) *BufferedClient {
+	if maxRetryInterval < retryInterval {
+		maxRetryInterval = retryInterval
+	}
+
+	return &BufferedClient{
+		logger:           logger.Session("buffered-auctioneer-client"),
+		client:           client,
+		clock:            clock,
+		capacity:         capacity,
+		retryInterval:    retryInterval,
+		maxRetryInterval: maxRetryInterval,
+		queued:           make(chan struct{}, 1),
+	}
+}
+
+func (c *BufferedClient) RequestLRPAuctions(lrpStarts []*auctioneer.LRPStartRequest) error {
+	c.lock.Lock()
+	if c.len()+len(lrpStarts) > c.capacity {
+		c.lock.Unlock()
+		c.logger.Info("dropping-lrp-auctions-buffer-full", lager.Data{"count": len(lrpStarts), "capacity": c.capacity})
+		c.countDropped(len(lrpStarts))
+		return ErrBufferFull
+	}
+
+	c.lrpStarts = append(c.lrpStarts, lrpStarts...)
+	queued := c.len()
+	c.lock.Unlock()
+
+	c.sendQueued(queued)
+	c.notify()
+	return nil
+}
+
+func (c *BufferedClient) RequestTaskAuctions(tasks []*auctioneer.TaskStartRequest) error {
+	c.lock.Lock()
+	if c.len()+len(tasks) > c.capacity {
+		c.lock.Unlock()
+		c.logger.Info("dropping-task-auctions-buffer-full", lager.Data{"count": len(tasks), "capacity": c.capacity})
+		c.countDropped(len(tasks))
+		return ErrBufferFull
+	}
+
+	c.taskStarts = append(c.taskStarts, tasks...)
+	queued := c.len()
+	c.lock.Unlock()
+
+	c.sendQueued(queued)
+	c.notify()
+	return nil
+}
+
+// Len returns the number of requests waiting to be submitted.
+func (c *BufferedClient) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.len()
+}
+
+func (c *BufferedClient) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := c.logger
+	logger.Info("starting")
+	close(ready)
+	logger.Info("started")
+	defer logger.Info("finished")
+
+	var retryTimer clock.Timer
+	var retry <-chan time.Time
+	queued := c.queued
+	retryInterval := c.retryInterval
+
+	for {
+		select {
+		case <-signals:
+			if retryTimer != nil {
+				retryTimer.Stop()
+			}
+			if dropped := c.Len(); dropped > 0 {
+				logger.Info("dropping-queued-auctions", lager.Data{"count": dropped})
+				c.countDropped(dropped)
+			}
+			return nil
+		case <-queued:
+		case <-retry:
+		}
+
+		// while waiting to retry, new requests are left queued, so that an
+		// unavailable auctioneer is not sent every request as it arrives
+		if c.submit(logger) {
+			retryTimer, retry = nil, nil
+			queued = c.queued
+			retryInterval = c.retryInterval
+		} else {
+			logger.Info("retrying-after", lager.Data{"interval": retryInterval.String()})
+			retryTimer = c.clock.NewTimer(retryInterval)
+			retry = retryTimer.C()
+			queued = nil
+			retryInterval *= 2
+			if retryInterval > c.maxRetryInterval {
+				retryInterval = c.maxRetryInterval
+			}
+		}
+	}
+}
+
+// submit sends every queued request, returning false if any of them failed
+// and should be submitted again, even if the buffer had no room left for them.
+func (c *BufferedClient) submit(logger lager.Logger) bool {
+	c.lock.Lock()
+	lrpStarts, taskStarts := c.lrpStarts, c.taskStarts
+	c.lrpStarts, c.taskStarts = nil, nil
+	c.lock.Unlock()
+
+	var failedLRPStarts []*auctioneer.LRPStartRequest
+	if len(lrpStarts) > 0 {
+		err := c.client.RequestLRPAuctions(lrpStarts)
+		if err != nil {
+			logger.Error("failed-requesting-lrp-auctions", err, lager.Data{"count": len(lrpStarts)})
+			c.countFailed(len(lrpStarts))
+			if isRejected(err) {
+				logger.Info("dropping-rejected-lrp-auctions", lager.Data{"count": len(lrpStarts)})
+				c.countDropped(len(lrpStarts))
+			} else {
+				failedLRPStarts = lrpStarts
+			}
+		}
+	}
+
+	var failedTaskStarts []*auctioneer.TaskStartRequest
+	if len(taskStarts) > 0 {
+		err := c.client.RequestTaskAuctions(taskStarts)
+		if err != nil {
+			logger.Error("failed-requesting-task-auctions", err, lager.Data{"count": len(taskStarts)})
+			c.countFailed(len(taskStarts))
+			if isRejected(err) {
+				logger.Info("dropping-rejected-task-auctions", lager.Data{"count": len(taskStarts)})
+				c.countDropped(len(taskStarts))
+			} else {
+				failedTaskStarts = taskStarts
+			}
+		}
+	}
+
+	failed := failedLRPStarts != nil || failedTaskStarts != nil
+
+	c.lock.Lock()
+	// the requests queued meanwhile were accepted within the capacity, so the
+	// failed ones only get the room left
+	room := c.capacity - c.len()
+	if room < 0 {
+		room = 0
+	}
+	dropped := 0
+	if len(failedLRPStarts) > room {
+		dropped += len(failedLRPStarts) - room
+		failedLRPStarts = failedLRPStarts[:room]
+	}
+	room -= len(failedLRPStarts)
+	if len(failedTaskStarts) > room {
+		dropped += len(failedTaskStarts) - room
+		failedTaskStarts = failedTaskStarts[:room]
+	}
+	c.lrpStarts = append(failedLRPStarts, c.lrpStarts...)
+	c.taskStarts = append(failedTaskStarts, c.taskStarts...)
+	queued := c.len()
+	c.lock.Unlock()
+
+	if dropped > 0 {
+		logger.Info("dropping-failed-auctions-buffer-full", lager.Data{"count": dropped, "capacity": c.capacity})
+		c.countDropped(dropped)
+	}
+
+	c.sendQueued(queued)
+	return !failed
+}
+
+// isRejected reports whether the auctioneer turned the requests away with a
+// client error, which submitting them again cannot fix. The auctioneer client
+// only reports the status in the message of its error. Timeouts and rate
+// limiting are not rejections.
+func isRejected(err error) bool {
+	var status int
+	_, scanErr := fmt.Sscanf(err.Error(), "http error: status code %d", &status)
+	if scanErr != nil {
+		return false
+	}
+	if status == http.StatusRequestTimeout || status == http.StatusTooManyRequests {
+		return false
+	}
+	return status >= 400 && status < 500
+}
+
+func (c *BufferedClient) len() int {
+	return len(c.lrpStarts) + len(c.taskStarts)
+}
+
+func (c *BufferedClient) sendQueued(queued int) {
+	err := auctionRequestsQueued.Send(queued)
+	if err != nil {
+		c.logger.Error("failed-to-send-auction-requests-queued-metric", err)
+	}
+}
+
+func (c *BufferedClient) countFailed(count int) {
+	err := auctionRequestsFailed.Add(uint64(count))
+	if err != nil {
+		c.logger.Error("failed-to-send-auction-requests-failed-metric", err)
+	}
+}
+
+func (c *BufferedClient) countDropped(count int) {
+	err := auctionRequestsDropped.Add(uint64(count))
+	if err != nil {
+		c.logger.Error("failed-to-send-auction-requests-dropped-metric", err)
+	}
+}
+
+// notify wakes Run without blocking; a wake up already pending covers the
+// new requests too.
+func (c *BufferedClient) notify() {
+	select {
+	case c.queued <- struct{}{}:
+	default:
+	}
+}
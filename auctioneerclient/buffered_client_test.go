@@ -0,0 +1,242 @@
+package auctioneerclient_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/auctioneerclient"
+	"code.cloudfoundry.org/bbs/auctioneerclient/auctioneerclientfakes"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BufferedClient", func() {
+	const (
+		retryInterval    = 5 * time.Second
+		maxRetryInterval = 15 * time.Second
+	)
+
+	var (
+		logger     *lagertest.TestLogger
+		fakeClient *auctioneerclientfakes.FakeClient
+		fakeClock  *fakeclock.FakeClock
+		client     *auctioneerclient.BufferedClient
+		process    ifrit.Process
+		sender     *fake.FakeMetricSender
+
+		lrpStart  *auctioneer.LRPStartRequest
+		taskStart *auctioneer.TaskStartRequest
+	)
+
+	BeforeEach(func() {
+		sender = fake.NewFakeMetricSender()
+		dropsonde_metrics.Initialize(sender, nil)
+
+		logger = lagertest.NewTestLogger("test")
+		fakeClient = new(auctioneerclientfakes.FakeClient)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		client = auctioneerclient.NewBufferedClient(logger, fakeClient, fakeClock, 2, retryInterval, maxRetryInterval)
+
+		lrpStart = &auctioneer.LRPStartRequest{ProcessGuid: "process-guid", Indices: []int{0}}
+		taskStart = &auctioneer.TaskStartRequest{}
+	})
+
+	AfterEach(func() {
+		if process != nil {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive())
+			process = nil
+		}
+	})
+
+	It("queues requests until it is run", func() {
+		Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart})).To(Succeed())
+		Expect(client.RequestTaskAuctions([]*auctioneer.TaskStartRequest{taskStart})).To(Succeed())
+		Expect(client.Len()).To(Equal(2))
+		Expect(sender.GetValue("AuctionRequestsQueued").Value).To(BeEquivalentTo(2))
+		Consistently(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(0))
+	})
+
+	It("rejects requests past its capacity", func() {
+		Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart, lrpStart})).To(Succeed())
+		Expect(client.RequestTaskAuctions([]*auctioneer.TaskStartRequest{taskStart})).To(MatchError(auctioneerclient.ErrBufferFull))
+		Expect(client.Len()).To(Equal(2))
+		Expect(sender.GetCounter("AuctionRequestsDropped")).To(BeEquivalentTo(1))
+	})
+
+	Context("when running", func() {
+		BeforeEach(func() {
+			process = ifrit.Invoke(client)
+		})
+
+		It("submits queued requests", func() {
+			Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart})).To(Succeed())
+			Expect(client.RequestTaskAuctions([]*auctioneer.TaskStartRequest{taskStart})).To(Succeed())
+
+			Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+			Expect(fakeClient.RequestLRPAuctionsArgsForCall(0)).To(ConsistOf(lrpStart))
+			Eventually(fakeClient.RequestTaskAuctionsCallCount).Should(Equal(1))
+			Expect(fakeClient.RequestTaskAuctionsArgsForCall(0)).To(ConsistOf(taskStart))
+			Eventually(client.Len).Should(Equal(0))
+			Eventually(func() float64 { return sender.GetValue("AuctionRequestsQueued").Value }).Should(BeZero())
+		})
+
+		Context("when the auctioneer fails", func() {
+			BeforeEach(func() {
+				fakeClient.RequestLRPAuctionsReturns(errors.New("unavailable"))
+			})
+
+			It("keeps the requests and retries them after the retry interval", func() {
+				Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart})).To(Succeed())
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+				Eventually(client.Len).Should(Equal(1))
+
+				fakeClient.RequestLRPAuctionsReturns(nil)
+				newStart := &auctioneer.LRPStartRequest{ProcessGuid: "other-guid", Indices: []int{1}}
+				Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{newStart})).To(Succeed())
+				Consistently(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+
+				fakeClock.WaitForWatcherAndIncrement(retryInterval)
+
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(2))
+				Expect(fakeClient.RequestLRPAuctionsArgsForCall(1)).To(Equal([]*auctioneer.LRPStartRequest{lrpStart, newStart}))
+				Eventually(client.Len).Should(Equal(0))
+			})
+
+			It("keeps only as many failed requests as the capacity leaves room for", func() {
+				newStart := &auctioneer.LRPStartRequest{ProcessGuid: "other-guid", Indices: []int{1}}
+				fakeClient.RequestLRPAuctionsStub = func([]*auctioneer.LRPStartRequest) error {
+					if fakeClient.RequestLRPAuctionsCallCount() == 1 {
+						client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{newStart})
+					}
+					return errors.New("unavailable")
+				}
+
+				Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart, lrpStart})).To(Succeed())
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+
+				Eventually(client.Len).Should(Equal(2))
+				Eventually(func() uint64 { return sender.GetCounter("AuctionRequestsDropped") }).Should(BeEquivalentTo(1))
+
+				fakeClient.RequestLRPAuctionsStub = nil
+				fakeClient.RequestLRPAuctionsReturns(nil)
+				fakeClock.WaitForWatcherAndIncrement(retryInterval)
+
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(2))
+				Expect(fakeClient.RequestLRPAuctionsArgsForCall(1)).To(Equal([]*auctioneer.LRPStartRequest{lrpStart, newStart}))
+			})
+		})
+
+		Context("when the auctioneer rejects the requests", func() {
+			BeforeEach(func() {
+				fakeClient.RequestLRPAuctionsReturns(errors.New("http error: status code 400 (Bad Request)"))
+			})
+
+			It("drops them without retrying", func() {
+				Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart})).To(Succeed())
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+
+				Eventually(func() uint64 { return sender.GetCounter("AuctionRequestsDropped") }).Should(BeEquivalentTo(1))
+				Expect(client.Len()).To(Equal(0))
+
+				fakeClock.Increment(retryInterval)
+				Consistently(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+			})
+
+			Context("because it is rate limiting them", func() {
+				BeforeEach(func() {
+					fakeClient.RequestLRPAuctionsReturns(errors.New("http error: status code 429 (Too Many Requests)"))
+				})
+
+				It("keeps them to retry", func() {
+					Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart})).To(Succeed())
+					Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+					Eventually(client.Len).Should(Equal(1))
+
+					fakeClock.WaitForWatcherAndIncrement(retryInterval)
+					Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(2))
+				})
+			})
+		})
+
+		Context("when the auctioneer is flaky", func() {
+			var failures int
+
+			BeforeEach(func() {
+				failures = 3
+				fakeClient.RequestLRPAuctionsStub = func([]*auctioneer.LRPStartRequest) error {
+					if fakeClient.RequestLRPAuctionsCallCount() <= failures {
+						return errors.New("unavailable")
+					}
+					return nil
+				}
+			})
+
+			It("backs off between attempts until the requests are accepted", func() {
+				Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart})).To(Succeed())
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+
+				fakeClock.WaitForWatcherAndIncrement(retryInterval)
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(2))
+
+				fakeClock.WaitForWatcherAndIncrement(2*retryInterval - time.Second)
+				Consistently(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(2))
+				fakeClock.Increment(time.Second)
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(3))
+
+				fakeClock.WaitForWatcherAndIncrement(maxRetryInterval - time.Second)
+				Consistently(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(3))
+				fakeClock.Increment(time.Second)
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(4))
+
+				Eventually(client.Len).Should(Equal(0))
+				for i := 0; i < 4; i++ {
+					Expect(fakeClient.RequestLRPAuctionsArgsForCall(i)).To(ConsistOf(lrpStart))
+				}
+				Expect(sender.GetCounter("AuctionRequestsFailed")).To(BeEquivalentTo(3))
+			})
+
+			It("resets the backoff once a submission succeeds", func() {
+				failures = 1
+				Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart})).To(Succeed())
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+				fakeClock.WaitForWatcherAndIncrement(retryInterval)
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(2))
+				Eventually(client.Len).Should(Equal(0))
+
+				failures = 3
+				Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart})).To(Succeed())
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(3))
+				fakeClock.WaitForWatcherAndIncrement(retryInterval)
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(4))
+			})
+
+			It("drops the requests still queued when signalled", func() {
+				Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{lrpStart})).To(Succeed())
+				Eventually(fakeClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+
+				process.Signal(os.Interrupt)
+				Eventually(process.Wait()).Should(Receive())
+				process = nil
+
+				Expect(sender.GetCounter("AuctionRequestsDropped")).To(BeEquivalentTo(1))
+			})
+		})
+	})
+})
+
+var _ = Describe("DiscardingClient", func() {
+	It("drops every request", func() {
+		client := auctioneerclient.NewDiscardingClient(lagertest.NewTestLogger("test"))
+		Expect(client.RequestLRPAuctions([]*auctioneer.LRPStartRequest{{ProcessGuid: "process-guid"}})).To(Succeed())
+		Expect(client.RequestTaskAuctions([]*auctioneer.TaskStartRequest{{}})).To(Succeed())
+	})
+})
@@ -0,0 +1,191 @@
+package auctioneerclient
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+)
+
+const (
+	// 0 while closed, 1 while open and 2 while half-open.
+	auctioneerCircuitBreakerState = metric.Metric("AuctioneerCircuitBreakerState")
+	// Counts the times the breaker opened, from closed or half-open.
+	auctioneerCircuitBreakerOpened = metric.Counter("AuctioneerCircuitBreakerOpened")
+	// Requests are counted once per call turned away while the breaker is
+	// open, however many starts the call carries.
+	auctionRequestsShortCircuited = metric.Counter("AuctionRequestsShortCircuited")
+)
+
+// ErrCircuitOpen is returned, without contacting the auctioneer, for the
+// requests made while a CircuitBreakerClient is open. Like any other failed
+// request, they are retried by a BufferedClient, or else by convergence.
+var ErrCircuitOpen = errors.New("auctioneer circuit breaker is open")
+
+type CircuitState int
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitOpen
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreakerClient stops sending requests to another Client once that
+// many consecutive requests have failed, so that an overwhelmed auctioneer
+// is given time to recover. While open, it fails every request with
+// ErrCircuitOpen. After the open timeout, it half-opens and lets a single
+// request through to probe the auctioneer: the breaker closes if the request
+// succeeds, and opens again for another open timeout if it fails.
+type CircuitBreakerClient struct {
+	logger           lager.Logger
+	client           Client
+	clock            clock.Clock
+	failureThreshold int
+	openTimeout      time.Duration
+
+	lock     sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreakerClient returns a closed CircuitBreakerClient that opens
+// after failureThreshold consecutive failures, and stays open for
+// openTimeout before probing the auctioneer.
+func NewCircuitBreakerClient(logger lager.Logger, client Client, clock clock.Clock, failureThreshold int, openTimeout time.Duration) *CircuitBreakerClient {
+	return &CircuitBreakerClient{
+		logger:           logger.Session("auctioneer-circuit-breaker"),
+		client:           client,
+		clock:            clock,
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+	}
+}
+
+func (c *CircuitBreakerClient) RequestLRPAuctions(lrpStarts []*auctioneer.LRPStartRequest) error {
+	allowed, probe := c.allow()
+	if !allowed {
+		return ErrCircuitOpen
+	}
+	err := c.client.RequestLRPAuctions(lrpStarts)
+	c.record(err, probe)
+	return err
+}
+
+func (c *CircuitBreakerClient) RequestTaskAuctions(tasks []*auctioneer.TaskStartRequest) error {
+	allowed, probe := c.allow()
+	if !allowed {
+		return ErrCircuitOpen
+	}
+	err := c.client.RequestTaskAuctions(tasks)
+	c.record(err, probe)
+	return err
+}
+
+// State returns the current state of the breaker. An open breaker whose open
+// timeout has elapsed is reported open until a request probes the auctioneer.
+func (c *CircuitBreakerClient) State() CircuitState {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.state
+}
+
+// allow reports whether a request may be sent to the auctioneer, and whether
+// it probes a half-open breaker, half-opening the breaker once its open
+// timeout has elapsed. Only one request probes a half-open breaker at a time;
+// the others are turned away.
+func (c *CircuitBreakerClient) allow() (bool, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.state == CircuitOpen && c.clock.Since(c.openedAt) >= c.openTimeout {
+		c.transition(CircuitHalfOpen)
+	}
+
+	switch c.state {
+	case CircuitClosed:
+		return true, false
+	case CircuitHalfOpen:
+		if !c.probing {
+			c.probing = true
+			return true, true
+		}
+	}
+
+	c.countShortCircuited()
+	return false, false
+}
+
+// record updates the breaker with the outcome of a request. Only the outcome
+// of the probe moves a half-open breaker; requests sent before the breaker
+// opened only update the count of consecutive failures.
+func (c *CircuitBreakerClient) record(err error, probe bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err == nil {
+		c.failures = 0
+	} else {
+		c.failures++
+	}
+
+	switch {
+	case probe:
+		c.probing = false
+		if err == nil {
+			c.transition(CircuitClosed)
+		} else {
+			c.open()
+		}
+	case c.state == CircuitClosed && c.failures >= c.failureThreshold:
+		c.open()
+	}
+}
+
+// open must be called with the lock held.
+func (c *CircuitBreakerClient) open() {
+	c.openedAt = c.clock.Now()
+	c.transition(CircuitOpen)
+}
+
+// transition must be called with the lock held.
+func (c *CircuitBreakerClient) transition(state CircuitState) {
+	c.logger.Info("state-changed", lager.Data{"from": c.state.String(), "to": state.String(), "consecutive_failures": c.failures})
+	c.state = state
+
+	err := auctioneerCircuitBreakerState.Send(int(state))
+	if err != nil {
+		c.logger.Error("failed-to-send-circuit-breaker-state-metric", err)
+	}
+	if state == CircuitOpen {
+		err = auctioneerCircuitBreakerOpened.Increment()
+		if err != nil {
+			c.logger.Error("failed-to-send-circuit-breaker-opened-metric", err)
+		}
+	}
+}
+
+func (c *CircuitBreakerClient) countShortCircuited() {
+	err := auctionRequestsShortCircuited.Increment()
+	if err != nil {
+		c.logger.Error("failed-to-send-auction-requests-short-circuited-metric", err)
+	}
+}
@@ -0,0 +1,147 @@
+package auctioneerclient_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/auctioneerclient"
+	"code.cloudfoundry.org/bbs/auctioneerclient/auctioneerclientfakes"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CircuitBreakerClient", func() {
+	const (
+		failureThreshold = 3
+		openTimeout      = 10 * time.Second
+	)
+
+	var (
+		fakeClient  *auctioneerclientfakes.FakeClient
+		fakeClock   *fakeclock.FakeClock
+		client      *auctioneerclient.CircuitBreakerClient
+		sender      *fake.FakeMetricSender
+		unavailable error
+		lrpStarts   []*auctioneer.LRPStartRequest
+		taskStarts  []*auctioneer.TaskStartRequest
+	)
+
+	BeforeEach(func() {
+		sender = fake.NewFakeMetricSender()
+		dropsonde_metrics.Initialize(sender, nil)
+
+		unavailable = errors.New("unavailable")
+		fakeClient = new(auctioneerclientfakes.FakeClient)
+		fakeClient.RequestLRPAuctionsReturns(unavailable)
+		fakeClient.RequestTaskAuctionsReturns(unavailable)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		client = auctioneerclient.NewCircuitBreakerClient(lagertest.NewTestLogger("test"), fakeClient, fakeClock, failureThreshold, openTimeout)
+
+		lrpStarts = []*auctioneer.LRPStartRequest{{ProcessGuid: "process-guid", Indices: []int{0}}}
+		taskStarts = []*auctioneer.TaskStartRequest{{}}
+	})
+
+	trip := func() {
+		for i := 0; i < failureThreshold; i++ {
+			Expect(client.RequestLRPAuctions(lrpStarts)).To(MatchError(unavailable))
+		}
+		Expect(client.State()).To(Equal(auctioneerclient.CircuitOpen))
+	}
+
+	It("passes requests through while closed", func() {
+		fakeClient.RequestLRPAuctionsReturns(nil)
+		fakeClient.RequestTaskAuctionsReturns(nil)
+
+		Expect(client.RequestLRPAuctions(lrpStarts)).To(Succeed())
+		Expect(client.RequestTaskAuctions(taskStarts)).To(Succeed())
+		Expect(fakeClient.RequestLRPAuctionsArgsForCall(0)).To(Equal(lrpStarts))
+		Expect(fakeClient.RequestTaskAuctionsArgsForCall(0)).To(Equal(taskStarts))
+		Expect(client.State()).To(Equal(auctioneerclient.CircuitClosed))
+	})
+
+	It("stays closed while the failures are not consecutive", func() {
+		for i := 0; i < failureThreshold-1; i++ {
+			Expect(client.RequestLRPAuctions(lrpStarts)).To(MatchError(unavailable))
+		}
+
+		fakeClient.RequestTaskAuctionsReturns(nil)
+		Expect(client.RequestTaskAuctions(taskStarts)).To(Succeed())
+
+		for i := 0; i < failureThreshold-1; i++ {
+			Expect(client.RequestLRPAuctions(lrpStarts)).To(MatchError(unavailable))
+		}
+		Expect(client.State()).To(Equal(auctioneerclient.CircuitClosed))
+		Expect(fakeClient.RequestLRPAuctionsCallCount()).To(Equal(2 * (failureThreshold - 1)))
+	})
+
+	It("goes from closed to open to half-open to closed as the auctioneer fails and recovers", func() {
+		trip()
+		Expect(sender.GetValue("AuctioneerCircuitBreakerState").Value).To(BeEquivalentTo(1))
+		Expect(sender.GetCounter("AuctioneerCircuitBreakerOpened")).To(BeEquivalentTo(1))
+
+		By("short-circuiting the requests while open")
+		Expect(client.RequestLRPAuctions(lrpStarts)).To(MatchError(auctioneerclient.ErrCircuitOpen))
+		Expect(client.RequestTaskAuctions(taskStarts)).To(MatchError(auctioneerclient.ErrCircuitOpen))
+		fakeClock.Increment(openTimeout - time.Second)
+		Expect(client.RequestLRPAuctions(lrpStarts)).To(MatchError(auctioneerclient.ErrCircuitOpen))
+		Expect(fakeClient.RequestLRPAuctionsCallCount()).To(Equal(failureThreshold))
+		Expect(fakeClient.RequestTaskAuctionsCallCount()).To(Equal(0))
+		Expect(sender.GetCounter("AuctionRequestsShortCircuited")).To(BeEquivalentTo(3))
+
+		By("half-opening to probe the auctioneer after the open timeout")
+		fakeClock.Increment(time.Second)
+		probed := make(chan struct{})
+		release := make(chan struct{})
+		fakeClient.RequestLRPAuctionsStub = func([]*auctioneer.LRPStartRequest) error {
+			close(probed)
+			<-release
+			return nil
+		}
+
+		errs := make(chan error)
+		go func() {
+			errs <- client.RequestLRPAuctions(lrpStarts)
+		}()
+		Eventually(probed).Should(BeClosed())
+		Expect(client.State()).To(Equal(auctioneerclient.CircuitHalfOpen))
+		Expect(sender.GetValue("AuctioneerCircuitBreakerState").Value).To(BeEquivalentTo(2))
+
+		By("short-circuiting the other requests while probing")
+		Expect(client.RequestTaskAuctions(taskStarts)).To(MatchError(auctioneerclient.ErrCircuitOpen))
+		Expect(fakeClient.RequestTaskAuctionsCallCount()).To(Equal(0))
+
+		By("closing once the probe succeeds")
+		close(release)
+		Eventually(errs).Should(Receive(BeNil()))
+		Expect(client.State()).To(Equal(auctioneerclient.CircuitClosed))
+		Expect(sender.GetValue("AuctioneerCircuitBreakerState").Value).To(BeEquivalentTo(0))
+
+		fakeClient.RequestTaskAuctionsReturns(nil)
+		Expect(client.RequestTaskAuctions(taskStarts)).To(Succeed())
+		Expect(fakeClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+	})
+
+	It("opens again for another open timeout when the probe fails", func() {
+		trip()
+
+		fakeClock.Increment(openTimeout)
+		Expect(client.RequestLRPAuctions(lrpStarts)).To(MatchError(unavailable))
+		Expect(client.State()).To(Equal(auctioneerclient.CircuitOpen))
+		Expect(fakeClient.RequestLRPAuctionsCallCount()).To(Equal(failureThreshold + 1))
+		Expect(sender.GetCounter("AuctioneerCircuitBreakerOpened")).To(BeEquivalentTo(2))
+
+		fakeClock.Increment(openTimeout - time.Second)
+		Expect(client.RequestLRPAuctions(lrpStarts)).To(MatchError(auctioneerclient.ErrCircuitOpen))
+
+		fakeClock.Increment(time.Second)
+		fakeClient.RequestLRPAuctionsReturns(nil)
+		Expect(client.RequestLRPAuctions(lrpStarts)).To(Succeed())
+		Expect(client.State()).To(Equal(auctioneerclient.CircuitClosed))
+	})
+})
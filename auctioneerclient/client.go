@@ -0,0 +1,39 @@
+package auctioneerclient
+
+import (
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . Client
+
+// Client submits the LRP and Task start requests that the BBS wants
+// auctioned. auctioneer.Client, which sends them straight to the auctioneer,
+// is a Client; the handlers and convergence depend only on this interface, so
+// that main can choose the implementation.
+type Client interface {
+	RequestLRPAuctions(lrpStarts []*auctioneer.LRPStartRequest) error
+	RequestTaskAuctions(tasks []*auctioneer.TaskStartRequest) error
+}
+
+type discardingClient struct {
+	logger lager.Logger
+}
+
+// NewDiscardingClient returns a Client for a BBS run without an auctioneer. It
+// logs and drops every request. The LRPs stay unclaimed and the Tasks
+// pending, and convergence requests their auctions again, so they are placed
+// once an auctioneer is configured.
+func NewDiscardingClient(logger lager.Logger) Client {
+	return &discardingClient{logger: logger.Session("discarding-auctioneer-client")}
+}
+
+func (c *discardingClient) RequestLRPAuctions(lrpStarts []*auctioneer.LRPStartRequest) error {
+	c.logger.Info("discarding-lrp-auctions", lager.Data{"count": len(lrpStarts)})
+	return nil
+}
+
+func (c *discardingClient) RequestTaskAuctions(tasks []*auctioneer.TaskStartRequest) error {
+	c.logger.Info("discarding-task-auctions", lager.Data{"count": len(tasks)})
+	return nil
+}
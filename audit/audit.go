@@ -0,0 +1,108 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+)
+
+// Record describes a single mutation. Its JSON encoding is part of the BBS's
+// operator-facing interface: fields may be added, but existing fields must
+// not be renamed or change meaning.
+type Record struct {
+	Timestamp             time.Time        `json:"timestamp"`
+	Actor                 string           `json:"actor"`
+	Operation             string           `json:"operation"`
+	Target                string           `json:"target,omitempty"`
+	Index                 *int32           `json:"index,omitempty"`
+	BeforeModificationTag *ModificationTag `json:"before_modification_tag,omitempty"`
+	AfterModificationTag  *ModificationTag `json:"after_modification_tag,omitempty"`
+	Error                 string           `json:"error,omitempty"`
+}
+
+type ModificationTag struct {
+	Epoch string `json:"epoch"`
+	Index uint32 `json:"index"`
+}
+
+func newModificationTag(tag *models.ModificationTag) *ModificationTag {
+	if tag == nil {
+		return nil
+	}
+	return &ModificationTag{Epoch: tag.Epoch, Index: tag.Index}
+}
+
+type contextKey struct{}
+
+type entry struct {
+	lock   sync.Mutex
+	record Record
+}
+
+// Wrap emits a record to the sink for every request served by handler, once
+// the handler has returned. The handler adds what it knows about the mutation
+// with SetTarget, SetModificationTags and SetError.
+func Wrap(sink Sink, operation string, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		e := &entry{record: Record{
+			Actor:     middleware.Identity(req),
+			Operation: operation,
+		}}
+
+		handler.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), contextKey{}, e)))
+
+		e.lock.Lock()
+		record := e.record
+		e.lock.Unlock()
+
+		record.Timestamp = time.Now().UTC()
+		sink.Emit(record)
+	})
+}
+
+// SetTarget records the guid of the resource the request mutates. It does
+// nothing for requests that are not audited.
+func SetTarget(req *http.Request, target string) {
+	update(req, func(r *Record) { r.Target = target })
+}
+
+// SetTargetInstance records the process guid and index of the actual LRP the
+// request mutates.
+func SetTargetInstance(req *http.Request, processGuid string, index int32) {
+	update(req, func(r *Record) {
+		r.Target = processGuid
+		r.Index = &index
+	})
+}
+
+// SetModificationTags records the modification tag of the resource before and
+// after the mutation. Either may be nil.
+func SetModificationTags(req *http.Request, before, after *models.ModificationTag) {
+	update(req, func(r *Record) {
+		r.BeforeModificationTag = newModificationTag(before)
+		r.AfterModificationTag = newModificationTag(after)
+	})
+}
+
+// SetError records that the mutation failed.
+func SetError(req *http.Request, err *models.Error) {
+	if err == nil {
+		return
+	}
+	update(req, func(r *Record) { r.Error = err.Error() })
+}
+
+func update(req *http.Request, f func(*Record)) {
+	e, ok := req.Context().Value(contextKey{}).(*entry)
+	if !ok {
+		return
+	}
+
+	e.lock.Lock()
+	f(&e.record)
+	e.lock.Unlock()
+}
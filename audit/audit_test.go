@@ -0,0 +1,116 @@
+package audit_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/audit/auditfakes"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Wrap", func() {
+	var (
+		sink     *auditfakes.FakeSink
+		handler  http.HandlerFunc
+		request  *http.Request
+		recorder *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		sink = new(auditfakes.FakeSink)
+		handler = func(w http.ResponseWriter, req *http.Request) {}
+		recorder = httptest.NewRecorder()
+
+		var err error
+		request, err = http.NewRequest("POST", "/v1/some/mutation", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		audit.Wrap(sink, "SomeOperation", handler).ServeHTTP(recorder, request)
+	})
+
+	It("emits one record for the request", func() {
+		Expect(sink.EmitCallCount()).To(Equal(1))
+		record := sink.EmitArgsForCall(0)
+		Expect(record.Operation).To(Equal("SomeOperation"))
+		Expect(record.Timestamp).To(BeTemporally("~", time.Now(), time.Second))
+	})
+
+	Context("when the client did not present a certificate", func() {
+		It("records the actor as anonymous", func() {
+			Expect(sink.EmitArgsForCall(0).Actor).To(Equal(middleware.AnonymousIdentity))
+		})
+	})
+
+	Context("when the client presented a certificate", func() {
+		BeforeEach(func() {
+			request.TLS = &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{
+					{Subject: pkix.Name{CommonName: "some-client"}},
+				},
+			}
+		})
+
+		It("records the common name as the actor", func() {
+			Expect(sink.EmitArgsForCall(0).Actor).To(Equal("some-client"))
+		})
+	})
+
+	Context("when the handler annotates the request", func() {
+		BeforeEach(func() {
+			handler = func(w http.ResponseWriter, req *http.Request) {
+				audit.SetTargetInstance(req, "some-guid", 2)
+				audit.SetModificationTags(req,
+					&models.ModificationTag{Epoch: "some-epoch", Index: 1},
+					&models.ModificationTag{Epoch: "some-epoch", Index: 2},
+				)
+				audit.SetError(req, models.ErrResourceConflict)
+			}
+		})
+
+		It("records the annotations", func() {
+			index := int32(2)
+			record := sink.EmitArgsForCall(0)
+			Expect(record.Target).To(Equal("some-guid"))
+			Expect(record.Index).To(Equal(&index))
+			Expect(record.BeforeModificationTag).To(Equal(&audit.ModificationTag{Epoch: "some-epoch", Index: 1}))
+			Expect(record.AfterModificationTag).To(Equal(&audit.ModificationTag{Epoch: "some-epoch", Index: 2}))
+			Expect(record.Error).To(Equal(models.ErrResourceConflict.Error()))
+		})
+	})
+
+	Context("when the handler records a nil error", func() {
+		BeforeEach(func() {
+			handler = func(w http.ResponseWriter, req *http.Request) {
+				audit.SetError(req, nil)
+			}
+		})
+
+		It("records no error", func() {
+			Expect(sink.EmitArgsForCall(0).Error).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("annotating a request that is not audited", func() {
+	It("does nothing", func() {
+		request, err := http.NewRequest("POST", "/v1/some/mutation", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(func() {
+			audit.SetTarget(request, "some-guid")
+			audit.SetModificationTags(request, nil, nil)
+			audit.SetError(request, models.ErrUnknownError)
+		}).NotTo(Panic())
+	})
+})
@@ -0,0 +1,64 @@
+// This file was generated by counterfeiter
+package auditfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/audit"
+)
+
+type FakeSink struct {
+	EmitStub        func(audit.Record)
+	emitMutex       sync.RWMutex
+	emitArgsForCall []struct {
+		arg1 audit.Record
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSink) Emit(arg1 audit.Record) {
+	fake.emitMutex.Lock()
+	fake.emitArgsForCall = append(fake.emitArgsForCall, struct {
+		arg1 audit.Record
+	}{arg1})
+	fake.recordInvocation("Emit", []interface{}{arg1})
+	fake.emitMutex.Unlock()
+	if fake.EmitStub != nil {
+		fake.EmitStub(arg1)
+	}
+}
+
+func (fake *FakeSink) EmitCallCount() int {
+	fake.emitMutex.RLock()
+	defer fake.emitMutex.RUnlock()
+	return len(fake.emitArgsForCall)
+}
+
+func (fake *FakeSink) EmitArgsForCall(i int) audit.Record {
+	fake.emitMutex.RLock()
+	defer fake.emitMutex.RUnlock()
+	return fake.emitArgsForCall[i].arg1
+}
+
+func (fake *FakeSink) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.emitMutex.RLock()
+	defer fake.emitMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSink) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ audit.Sink = new(FakeSink)
@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o auditfakes/fake_sink.go . Sink
+
+// Sink receives a record of every mutation the BBS serves.
+type Sink interface {
+	Emit(Record)
+}
+
+type lagerSink struct {
+	logger lager.Logger
+}
+
+// NewLagerSink logs each record in an "audit" session of the given logger.
+func NewLagerSink(logger lager.Logger) Sink {
+	return &lagerSink{logger: logger.Session("audit")}
+}
+
+func (s *lagerSink) Emit(record Record) {
+	s.logger.Info("mutation", lager.Data{"record": record})
+}
+
+type writerSink struct {
+	lock    sync.Mutex
+	encoder *json.Encoder
+	logger  lager.Logger
+}
+
+// NewWriterSink writes each record to w as a single line of JSON.
+func NewWriterSink(logger lager.Logger, w io.Writer) Sink {
+	return &writerSink{
+		encoder: json.NewEncoder(w),
+		logger:  logger.Session("audit"),
+	}
+}
+
+func (s *writerSink) Emit(record Record) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.encoder.Encode(record)
+	if err != nil {
+		s.logger.Error("failed-to-write-record", err, lager.Data{"record": record})
+	}
+}
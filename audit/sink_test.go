@@ -0,0 +1,63 @@
+package audit_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Sinks", func() {
+	var (
+		logger *lagertest.TestLogger
+		record audit.Record
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		index := int32(0)
+		record = audit.Record{
+			Timestamp:            time.Date(2016, time.November, 1, 12, 30, 0, 0, time.UTC),
+			Actor:                "some-client",
+			Operation:            "StartActualLRP",
+			Target:               "some-guid",
+			Index:                &index,
+			AfterModificationTag: &audit.ModificationTag{Epoch: "some-epoch", Index: 3},
+		}
+	})
+
+	Describe("WriterSink", func() {
+		It("writes each record as a line of JSON", func() {
+			buffer := gbytes.NewBuffer()
+			sink := audit.NewWriterSink(logger, buffer)
+
+			sink.Emit(record)
+			sink.Emit(audit.Record{
+				Timestamp: record.Timestamp,
+				Actor:     "anonymous",
+				Operation: "ConvergeLRPs",
+				Error:     "some error",
+			})
+
+			Expect(string(buffer.Contents())).To(Equal(
+				`{"timestamp":"2016-11-01T12:30:00Z","actor":"some-client","operation":"StartActualLRP","target":"some-guid","index":0,"after_modification_tag":{"epoch":"some-epoch","index":3}}` + "\n" +
+					`{"timestamp":"2016-11-01T12:30:00Z","actor":"anonymous","operation":"ConvergeLRPs","error":"some error"}` + "\n",
+			))
+		})
+	})
+
+	Describe("LagerSink", func() {
+		It("logs the record in an audit session", func() {
+			sink := audit.NewLagerSink(logger)
+			sink.Emit(record)
+
+			Expect(logger).To(gbytes.Say("test.audit.mutation"))
+			Expect(logger).To(gbytes.Say(`"operation":"StartActualLRP"`))
+		})
+	})
+})
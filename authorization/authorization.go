@@ -0,0 +1,81 @@
+package authorization
+
+import (
+	"crypto/tls"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Wrap serves requests with handler only when the policy allows the client
+// to perform operations of the class. Other requests are answered with
+// 403 Forbidden and a models.Error of type Error_Forbidden, which every BBS
+// response message decodes into its error field.
+func Wrap(logger lager.Logger, policy *Policy, class OperationClass, handler http.Handler) http.Handler {
+	if policy == nil {
+		return handler
+	}
+
+	logger = logger.Session("authorization")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		names := ClientNames(r)
+		if policy.Allows(names, class) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		identity := middleware.Identity(r)
+		logger.Info("forbidden", lager.Data{
+			"identity":        identity,
+			"operation-class": class,
+			"method":          r.Method,
+			"request":         r.URL.String(),
+		})
+
+		writeForbidden(w, models.NewError(
+			models.Error_Forbidden,
+			identity+" is not allowed to perform "+string(class)+" operations",
+		))
+	})
+}
+
+// ClientNames lists the names the client making the request is known by:
+// its identity followed by every subject alternative name of its
+// certificate.
+func ClientNames(r *http.Request) []string {
+	return append([]string{middleware.Identity(r)}, CertificateNames(r.TLS)...)
+}
+
+// CertificateNames lists every subject alternative name of the certificate
+// the client of a TLS connection presented.
+func CertificateNames(state *tls.ConnectionState) []string {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+
+	cert := state.PeerCertificates[0]
+	names := append([]string{}, cert.DNSNames...)
+	names = append(names, cert.EmailAddresses...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	return names
+}
+
+func writeForbidden(w http.ResponseWriter, err *models.Error) {
+	responseBytes, marshalErr := proto.Marshal(&models.ErrorResponse{Error: err})
+	if marshalErr != nil {
+		panic("Unable to encode Proto: " + marshalErr.Error())
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(responseBytes)))
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(http.StatusForbidden)
+
+	w.Write(responseBytes)
+}
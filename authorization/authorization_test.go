@@ -0,0 +1,115 @@
+package authorization_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Wrap", func() {
+	var (
+		logger   *lagertest.TestLogger
+		policy   *authorization.Policy
+		served   bool
+		request  *http.Request
+		recorder *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		policy = &authorization.Policy{
+			Rules: []authorization.Rule{
+				{Identities: []string{"operator"}, Classes: []authorization.OperationClass{authorization.Read, authorization.Write, authorization.Admin}},
+				{Identities: []string{"*.cell.internal"}, Classes: []authorization.OperationClass{authorization.Read, authorization.Write}},
+			},
+		}
+		served = false
+		recorder = httptest.NewRecorder()
+
+		var err error
+		request, err = http.NewRequest("POST", "/v1/some/route", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	withCertificate := func(cert *x509.Certificate) {
+		request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	}
+
+	serve := func(class authorization.OperationClass) {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { served = true })
+		authorization.Wrap(logger, policy, class, handler).ServeHTTP(recorder, request)
+	}
+
+	expectForbidden := func() {
+		Expect(served).To(BeFalse())
+		Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/x-protobuf"))
+
+		response := &models.DesiredLRPLifecycleResponse{}
+		Expect(response.Unmarshal(recorder.Body.Bytes())).To(Succeed())
+		Expect(response.Error.Type).To(Equal(models.Error_Forbidden))
+	}
+
+	Context("when the client is an admin", func() {
+		BeforeEach(func() {
+			withCertificate(&x509.Certificate{Subject: pkix.Name{CommonName: "operator"}})
+		})
+
+		It("serves every class of operation", func() {
+			for _, class := range []authorization.OperationClass{authorization.Read, authorization.Write, authorization.Admin} {
+				served = false
+				serve(class)
+				Expect(served).To(BeTrue())
+			}
+		})
+	})
+
+	Context("when the client is allowed to write by one of its subject alternative names", func() {
+		BeforeEach(func() {
+			withCertificate(&x509.Certificate{
+				Subject:  pkix.Name{CommonName: "rep"},
+				DNSNames: []string{"cell-1.cell.internal"},
+			})
+		})
+
+		It("serves writes", func() {
+			serve(authorization.Write)
+			Expect(served).To(BeTrue())
+		})
+
+		It("forbids admin operations", func() {
+			serve(authorization.Admin)
+			expectForbidden()
+			Expect(logger).To(gbytes.Say("authorization.forbidden"))
+			Expect(logger).To(gbytes.Say(`"identity":"rep"`))
+		})
+	})
+
+	Context("when the client did not present a certificate", func() {
+		It("forbids reads", func() {
+			serve(authorization.Read)
+			expectForbidden()
+		})
+	})
+
+	Context("when there is no policy", func() {
+		BeforeEach(func() {
+			policy = nil
+		})
+
+		It("serves everyone", func() {
+			serve(authorization.Admin)
+			Expect(served).To(BeTrue())
+		})
+	})
+})
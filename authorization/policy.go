@@ -0,0 +1,117 @@
+package authorization
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+)
+
+// OperationClass groups the BBS routes by what they allow a client to do.
+type OperationClass string
+
+const (
+	Read  OperationClass = "read"
+	Write OperationClass = "write"
+	Admin OperationClass = "admin"
+)
+
+func (c OperationClass) Validate() error {
+	switch c {
+	case Read, Write, Admin:
+		return nil
+	}
+	return fmt.Errorf("unknown operation class %q", c)
+}
+
+// Rule grants the operation classes to every client that has a name matching
+// one of the identity patterns. Patterns use the syntax of path.Match and are
+// matched against the subject common name and the subject alternative names
+// of the client's certificate, or against "anonymous" for a client that did
+// not present one.
+type Rule struct {
+	Identities []string         `json:"identities"`
+	Classes    []OperationClass `json:"classes"`
+}
+
+// Policy is the set of rules that decides which clients may use which routes.
+// A client that matches no rule is allowed nothing. A nil Policy allows
+// everything.
+type Policy struct {
+	Rules []Rule `json:"rules"`
+}
+
+// LoadPolicy reads a JSON encoded policy from the file at policyPath.
+func LoadPolicy(policyPath string) (*Policy, error) {
+	data, err := ioutil.ReadFile(policyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{}
+	err = json.Unmarshal(data, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (p *Policy) Validate() error {
+	for i, rule := range p.Rules {
+		if len(rule.Identities) == 0 {
+			return fmt.Errorf("rule %d has no identities", i)
+		}
+		for _, pattern := range rule.Identities {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("rule %d has invalid identity pattern %q: %s", i, pattern, err)
+			}
+		}
+		for _, class := range rule.Classes {
+			if err := class.Validate(); err != nil {
+				return fmt.Errorf("rule %d: %s", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Allows reports whether a client known by any of the names may perform
+// operations of the class.
+func (p *Policy) Allows(names []string, class OperationClass) bool {
+	if p == nil {
+		return true
+	}
+
+	for _, rule := range p.Rules {
+		if rule.grants(class) && rule.matches(names) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Rule) grants(class OperationClass) bool {
+	for _, c := range r.Classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Rule) matches(names []string) bool {
+	for _, pattern := range r.Identities {
+		for _, name := range names {
+			if matched, _ := path.Match(pattern, name); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
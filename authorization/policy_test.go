@@ -0,0 +1,108 @@
+package authorization_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/bbs/authorization"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	Describe("LoadPolicy", func() {
+		var policyFile *os.File
+
+		BeforeEach(func() {
+			var err error
+			policyFile, err = ioutil.TempFile("", "policy")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.Remove(policyFile.Name())
+		})
+
+		writePolicy := func(contents string) {
+			_, err := policyFile.WriteString(contents)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policyFile.Close()).To(Succeed())
+		}
+
+		It("loads the rules", func() {
+			writePolicy(`{"rules": [{"identities": ["cc-*"], "classes": ["read", "write"]}]}`)
+
+			policy, err := authorization.LoadPolicy(policyFile.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.Rules).To(Equal([]authorization.Rule{
+				{Identities: []string{"cc-*"}, Classes: []authorization.OperationClass{authorization.Read, authorization.Write}},
+			}))
+		})
+
+		It("fails when the file does not exist", func() {
+			_, err := authorization.LoadPolicy("/does/not/exist")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the file is not valid JSON", func() {
+			writePolicy(`{"rules": [`)
+			_, err := authorization.LoadPolicy(policyFile.Name())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when a rule names an unknown class", func() {
+			writePolicy(`{"rules": [{"identities": ["cc"], "classes": ["superuser"]}]}`)
+			_, err := authorization.LoadPolicy(policyFile.Name())
+			Expect(err).To(MatchError(ContainSubstring(`unknown operation class "superuser"`)))
+		})
+
+		It("fails when a rule has an invalid pattern", func() {
+			writePolicy(`{"rules": [{"identities": ["cc-["], "classes": ["read"]}]}`)
+			_, err := authorization.LoadPolicy(policyFile.Name())
+			Expect(err).To(MatchError(ContainSubstring("invalid identity pattern")))
+		})
+
+		It("fails when a rule has no identities", func() {
+			writePolicy(`{"rules": [{"classes": ["read"]}]}`)
+			_, err := authorization.LoadPolicy(policyFile.Name())
+			Expect(err).To(MatchError(ContainSubstring("no identities")))
+		})
+	})
+
+	Describe("Allows", func() {
+		var policy *authorization.Policy
+
+		BeforeEach(func() {
+			policy = &authorization.Policy{
+				Rules: []authorization.Rule{
+					{Identities: []string{"operator"}, Classes: []authorization.OperationClass{authorization.Read, authorization.Write, authorization.Admin}},
+					{Identities: []string{"*.cell.internal"}, Classes: []authorization.OperationClass{authorization.Read, authorization.Write}},
+					{Identities: []string{"anonymous"}, Classes: []authorization.OperationClass{authorization.Read}},
+				},
+			}
+		})
+
+		DescribeTable("deciding by name and operation class",
+			func(names []string, read, write, admin bool) {
+				Expect(policy.Allows(names, authorization.Read)).To(Equal(read))
+				Expect(policy.Allows(names, authorization.Write)).To(Equal(write))
+				Expect(policy.Allows(names, authorization.Admin)).To(Equal(admin))
+			},
+			Entry("an admin", []string{"operator"}, true, true, true),
+			Entry("a writer matched by a pattern", []string{"rep", "cell-1.cell.internal"}, true, true, false),
+			Entry("an anonymous reader", []string{"anonymous"}, true, false, false),
+			Entry("a client matching no rule", []string{"someone-else"}, false, false, false),
+		)
+
+		It("allows everything when there is no policy", func() {
+			var nilPolicy *authorization.Policy
+			Expect(nilPolicy.Allows([]string{"anyone"}, authorization.Admin)).To(BeTrue())
+		})
+
+		It("allows nothing when the policy has no rules", func() {
+			Expect((&authorization.Policy{}).Allows([]string{"operator"}, authorization.Read)).To(BeFalse())
+		})
+	})
+})
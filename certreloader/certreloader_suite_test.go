@@ -0,0 +1,13 @@
+package certreloader_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestCertreloader(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Certreloader Suite")
+}
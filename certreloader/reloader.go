@@ -0,0 +1,114 @@
+package certreloader
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// Reloader serves the certificate and key in a pair of files, reading them
+// again on a new handshake whenever either file has changed on disk, so that
+// a rotated certificate is picked up without restarting the process.
+//
+// A pair that fails to load, e.g. because only one of the files has been
+// replaced so far, is logged and the previous certificate is served until
+// the files load again.
+type Reloader struct {
+	logger   lager.Logger
+	certFile string
+	keyFile  string
+
+	lock        sync.Mutex
+	certificate *tls.Certificate
+	certStamp   fileStamp
+	keyStamp    fileStamp
+}
+
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// New loads the certificate and key, and fails if they cannot be loaded.
+func New(logger lager.Logger, certFile, keyFile string) (*Reloader, error) {
+	r := &Reloader{
+		logger:   logger.Session("cert-reloader", lager.Data{"cert_file": certFile, "key_file": keyFile}),
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+
+	certStamp, keyStamp, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.load(certStamp, keyStamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// GetCertificate is meant to be set as the GetCertificate of a tls.Config.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	certStamp, keyStamp, err := r.stat()
+	if err != nil {
+		r.logger.Error("failed-to-stat-certificate", err)
+		return r.certificate, nil
+	}
+
+	if certStamp == r.certStamp && keyStamp == r.keyStamp {
+		return r.certificate, nil
+	}
+
+	err = r.load(certStamp, keyStamp)
+	if err != nil {
+		r.logger.Error("failed-to-reload-certificate", err)
+		return r.certificate, nil
+	}
+
+	r.logger.Info("reloaded-certificate")
+	return r.certificate, nil
+}
+
+func (r *Reloader) stat() (fileStamp, fileStamp, error) {
+	certStamp, err := stampOf(r.certFile)
+	if err != nil {
+		return fileStamp{}, fileStamp{}, err
+	}
+
+	keyStamp, err := stampOf(r.keyFile)
+	if err != nil {
+		return fileStamp{}, fileStamp{}, err
+	}
+
+	return certStamp, keyStamp, nil
+}
+
+func (r *Reloader) load(certStamp, keyStamp fileStamp) error {
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	r.certificate = &certificate
+	r.certStamp = certStamp
+	r.keyStamp = keyStamp
+	return nil
+}
+
+func stampOf(path string) (fileStamp, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, err
+	}
+
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}, nil
+}
@@ -0,0 +1,140 @@
+package certreloader_test
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/bbs/certreloader"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reloader", func() {
+	var (
+		logger            *lagertest.TestLogger
+		certDir           string
+		certFile, keyFile string
+		modTime           time.Time
+
+		reloader *certreloader.Reloader
+		listener net.Listener
+	)
+
+	fixture := func(certs, name string) string {
+		return filepath.Join("..", "cmd", "bbs", "fixtures", certs, name)
+	}
+
+	// install copies a fixture over one of the served files, moving its
+	// modification time forward so that the change is seen however coarse the
+	// file system timestamps are.
+	install := func(certs, name, path string) {
+		contents, err := ioutil.ReadFile(fixture(certs, name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(path, contents, 0600)).To(Succeed())
+
+		modTime = modTime.Add(time.Minute)
+		Expect(os.Chtimes(path, modTime, modTime)).To(Succeed())
+	}
+
+	loadFixture := func(certs string) []byte {
+		certificate, err := tls.LoadX509KeyPair(fixture(certs, "server.crt"), fixture(certs, "server.key"))
+		Expect(err).NotTo(HaveOccurred())
+		return certificate.Certificate[0]
+	}
+
+	servedCertificate := func() []byte {
+		conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+
+		Expect(conn.Handshake()).To(Succeed())
+		return conn.ConnectionState().PeerCertificates[0].Raw
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		modTime = time.Now()
+
+		var err error
+		certDir, err = ioutil.TempDir("", "certreloader")
+		Expect(err).NotTo(HaveOccurred())
+
+		certFile = filepath.Join(certDir, "server.crt")
+		keyFile = filepath.Join(certDir, "server.key")
+		install("blue-certs", "server.crt", certFile)
+		install("blue-certs", "server.key", keyFile)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		reloader, err = certreloader.New(logger, certFile, keyFile)
+		Expect(err).NotTo(HaveOccurred())
+
+		listener, err = tls.Listen("tcp", "127.0.0.1:0", &tls.Config{GetCertificate: reloader.GetCertificate})
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				go func() {
+					conn.(*tls.Conn).Handshake()
+					conn.Close()
+				}()
+			}
+		}()
+	})
+
+	AfterEach(func() {
+		listener.Close()
+		os.RemoveAll(certDir)
+	})
+
+	It("serves the certificate in the files", func() {
+		Expect(servedCertificate()).To(Equal(loadFixture("blue-certs")))
+	})
+
+	Context("when the files are replaced", func() {
+		It("serves the new certificate on new handshakes", func() {
+			Expect(servedCertificate()).To(Equal(loadFixture("blue-certs")))
+
+			install("green-certs", "server.crt", certFile)
+			install("green-certs", "server.key", keyFile)
+
+			Expect(servedCertificate()).To(Equal(loadFixture("green-certs")))
+			Expect(logger).To(gbytes.Say("reloaded-certificate"))
+		})
+	})
+
+	Context("when only one of the files has been replaced so far", func() {
+		It("keeps serving the previous certificate until the pair loads again", func() {
+			install("green-certs", "server.crt", certFile)
+
+			Expect(servedCertificate()).To(Equal(loadFixture("blue-certs")))
+			Expect(logger).To(gbytes.Say("failed-to-reload-certificate"))
+
+			install("green-certs", "server.key", keyFile)
+
+			Expect(servedCertificate()).To(Equal(loadFixture("green-certs")))
+		})
+	})
+
+	Context("when the files cannot be loaded", func() {
+		It("fails to create the reloader", func() {
+			_, err := certreloader.New(logger, filepath.Join(certDir, "missing.crt"), keyFile)
+			Expect(err).To(HaveOccurred())
+
+			_, err = certreloader.New(logger, keyFile, keyFile)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
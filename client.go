@@ -23,7 +23,10 @@ import (
 const (
 	ContentTypeHeader    = "Content-Type"
 	XCfRouterErrorHeader = "X-Cf-Routererror"
+	ETagHeader           = "ETag"
+	IfNoneMatchHeader    = "If-None-Match"
 	ProtoContentType     = "application/x-protobuf"
+	JSONContentType      = "application/json"
 	KeepContainer        = true
 	DeleteContainer      = false
 )
@@ -42,10 +45,21 @@ type InternalClient interface {
 
 	ClaimActualLRP(logger lager.Logger, processGuid string, index int, instanceKey *models.ActualLRPInstanceKey) error
 	StartActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo) error
+
+	// Starts every ActualLRP the cell reports as running in one request and
+	// returns the keys of those that could not be started on the cell. An
+	// authoritative report also unclaims the running ActualLRPs on the cell
+	// that it leaves out and requests auctions to place them again.
+	StartActualLRPs(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) ([]*models.ActualLRPKey, error)
+
 	CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) error
 	FailActualLRP(logger lager.Logger, key *models.ActualLRPKey, errorMessage string) error
 	RemoveActualLRP(logger lager.Logger, processGuid string, index int, instanceKey *models.ActualLRPInstanceKey) error
 
+	// Unclaims every ActualLRP placed on the cell, stops its instances and
+	// requests auctions to place them elsewhere. Returns the number retired.
+	RetireActualLRPsOnCell(logger lager.Logger, cellID string) (int, error)
+
 	EvacuateClaimedActualLRP(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)
 	EvacuateRunningActualLRP(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, uint64) (bool, error)
 	EvacuateStoppedActualLRP(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)
@@ -54,7 +68,69 @@ type InternalClient interface {
 
 	StartTask(logger lager.Logger, taskGuid string, cellID string) (bool, error)
 	FailTask(logger lager.Logger, taskGuid, failureReason string) error
+	// Records that the pending Task could not be placed, for the given
+	// reason. The Task stays pending and is placed again later.
+	RejectTask(logger lager.Logger, taskGuid, rejectionReason string) error
 	CompleteTask(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) error
+
+	// Runs an LRP convergence pass over the given domains, or over every
+	// domain when none are given
+	ConvergeLRPs(logger lager.Logger, domains []string) error
+
+	// Asks the BBS that receives the request to give up the lock and stop
+	// writing so that another instance can take over. Requires a client
+	// certificate.
+	ReleaseLock(logger lager.Logger) error
+
+	// Lists the DesiredLRPs that match the given DesiredLRPFilter together
+	// with the tombstones of those that were removed, which have DeletedAt
+	// set. Requires admin access.
+	DesiredLRPsIncludingDeleted(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+
+	// Deletes the Completed and Resolving Tasks that completed more than
+	// minAge ago, leaving those with a completion callback still to be
+	// delivered, and returns how many were deleted. Requires admin access.
+	PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error)
+
+	// Returns when the last LRP and Task convergence passes of the BBS
+	// holding the lock completed, how long they took and how many operations
+	// they performed. Requires admin access.
+	ConvergenceStatuses(logger lager.Logger) ([]*models.ConvergenceStatus, error)
+
+	// Pauses the scheduled LRP and Task convergence passes of the BBS holding
+	// the lock for the given duration, capped at the longest pause it allows,
+	// and returns when the pause ends. A duration of 0 asks for the longest
+	// pause. Requires admin access.
+	PauseConvergence(logger lager.Logger, duration time.Duration) (time.Time, error)
+
+	// Ends a pause started with PauseConvergence. Requires admin access.
+	ResumeConvergence(logger lager.Logger) error
+
+	// Writes the given Domains, DesiredLRPs, ActualLRPs and Tasks directly
+	// into the store, to restore a backup. Unless force is set, the import is
+	// refused with ErrImportStoreNotEmpty when the store already holds
+	// records.
+	// Returns a result for each record, with the error of those that were
+	// not imported. Requires admin access.
+	Import(logger lager.Logger, force bool, records []*models.ImportRecord) ([]*models.ImportResult, error)
+
+	// Returns every Domain, DesiredLRP, ActualLRP and Task in the store, all
+	// read as of a single point in time, in the form Import takes. Evacuating
+	// ActualLRPs are left out. Requires admin access.
+	Export(logger lager.Logger) ([]*models.ImportRecord, error)
+
+	// Lists the registered Cells, ordered by cell id, with the TTL of their
+	// presences and when they expire. Presences with less than half their
+	// TTL left are flagged as stale.
+	CellPresenceStatuses(logger lager.Logger) ([]*models.CellPresenceStatus, error)
+
+	// Reports whether the BBS that receives the request holds the lock, the
+	// BBS holding the lock according to the store, and every BBS serving
+	// reads, with the sessions and TTLs of their presences. Every BBS
+	// answers, whether or not it holds the lock. When part of the state could
+	// not be read, the rest is returned along with the error. Requires admin
+	// access.
+	CoordinationState(logger lager.Logger) (*models.CoordinationStateResponse, error)
 }
 
 /*
@@ -74,6 +150,12 @@ type Client interface {
 
 	// Lists all Cells
 	Cells(logger lager.Logger) ([]*models.CellPresence, error)
+
+	// Counts the DesiredLRPs, the ActualLRP instances and the Tasks without
+	// listing them, also grouping the counts by each of the given dimensions,
+	// models.CountGroupByDomain and models.CountGroupByState. DesiredLRPs are
+	// never grouped by state.
+	ObjectCounts(logger lager.Logger, groupBy ...string) (*models.ObjectCountsResponse, error)
 }
 
 /*
@@ -86,6 +168,10 @@ type ExternalTaskClient interface {
 	// Creates a Task from the given TaskDefinition
 	DesireTask(logger lager.Logger, guid, domain string, def *models.TaskDefinition) error
 
+	// Creates a Task, or succeeds without creating one if the idempotency key
+	// was already used for the same request
+	DesireTaskWithIdempotencyKey(logger lager.Logger, idempotencyKey, guid, domain string, def *models.TaskDefinition) error
+
 	// Lists all Tasks
 	Tasks(logger lager.Logger) ([]*models.Task, error)
 
@@ -95,12 +181,28 @@ type ExternalTaskClient interface {
 	// Lists all Tasks on the given cell
 	TasksByCellID(logger lager.Logger, cellId string) ([]*models.Task, error)
 
+	// Lists all Tasks that match the given TaskFilter
+	TasksWithFilter(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
+
+	// Lists the Tasks that match the given TaskFilter, leaving out the ones
+	// that cannot be read and returning them as RecordErrors instead
+	TasksWithRecordErrors(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, []*models.RecordError, error)
+
 	// Returns the Task with the given guid
 	TaskByGuid(logger lager.Logger, guid string) (*models.Task, error)
 
+	// Returns the groups of Tasks in the given domain whose definitions have
+	// the same content hash, to help find Tasks that were submitted twice
+	DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error)
+
 	// Cancels the Task with the given task guid
 	CancelTask(logger lager.Logger, taskGuid string) error
 
+	// Cancels every pending or running Task in the given domain and/or with
+	// the given task guids, reporting which were cancelled, which had already
+	// completed and which could not be found
+	CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResponse, error)
+
 	// Resolves a Task with the given guid
 	ResolvingTask(logger lager.Logger, taskGuid string) error
 
@@ -117,6 +219,13 @@ type ExternalDomainClient interface {
 
 	// Creates a domain or bumps the ttl on an existing domain
 	UpsertDomain(logger lager.Logger, domain string, ttl time.Duration) error
+
+	// Creates or bumps the ttl on each of the given domains, returning a
+	// result per domain in the order given
+	UpsertDomains(logger lager.Logger, domains []*models.UpsertDomainRequest) ([]*models.UpsertDomainResult, error)
+
+	// Lists the active domains along with when each one expires
+	DomainFreshness(logger lager.Logger) ([]*models.DomainFreshness, error)
 }
 
 /*
@@ -126,12 +235,25 @@ type ExternalActualLRPClient interface {
 	// Returns all ActualLRPGroups matching the given ActualLRPFilter
 	ActualLRPGroups(lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
 
+	// Like ActualLRPGroups, but leaves out the ActualLRPs that cannot be read
+	// and returns them as RecordErrors, by process guid, instead
+	ActualLRPGroupsWithRecordErrors(lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error)
+
 	// Returns all ActualLRPGroups that have the given process guid
 	ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 
 	// Returns the ActualLRPGroup with the given process guid and instance index
 	ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, processGuid string, index int) (*models.ActualLRPGroup, error)
 
+	// Returns the non-evacuating ActualLRPs that have crashed the most, highest
+	// crash count first. A limit of 0 returns the server's default number of
+	// ActualLRPs; the server also caps how many it returns.
+	CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error)
+
+	// Returns, for each cell, the claimed and running ActualLRPs placed on it
+	// and the memory and disk they take up. An empty cellID returns every cell.
+	CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error)
+
 	// Shuts down the ActualLRP matching the given ActualLRPKey, but does not modify the desired state
 	RetireActualLRP(logger lager.Logger, key *models.ActualLRPKey) error
 }
@@ -143,18 +265,61 @@ type ExternalDesiredLRPClient interface {
 	// Lists all DesiredLRPs that match the given DesiredLRPFilter
 	DesiredLRPs(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 
+	// Like DesiredLRPs, but also returns the revision of the listing. Pass it
+	// as the ModifiedSinceRevision of the filter to list only the DesiredLRPs
+	// desired or updated since.
+	DesiredLRPsWithRevision(lager.Logger, models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, revision uint64, err error)
+
+	// Like DesiredLRPs, but leaves out the DesiredLRPs that cannot be read and
+	// returns them as RecordErrors instead
+	DesiredLRPsWithRecordErrors(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error)
+
 	// Returns the DesiredLRP with the given process guid
 	DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 
 	// Returns all DesiredLRPSchedulingInfos that match the given DesiredLRPFilter
 	DesiredLRPSchedulingInfos(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)
 
+	// Like DesiredLRPSchedulingInfos, but leaves out the DesiredLRPs that
+	// cannot be read and returns them as RecordErrors instead
+	DesiredLRPSchedulingInfosWithRecordErrors(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, []*models.RecordError, error)
+
+	// Like DesiredLRPSchedulingInfos, but also returns the ETag of the
+	// listing. Pass the ETag of the previous listing to get modified == false
+	// and no scheduling infos when nothing has changed since.
+	DesiredLRPSchedulingInfosIfModified(logger lager.Logger, filter models.DesiredLRPFilter, etag string) (schedulingInfos []*models.DesiredLRPSchedulingInfo, newETag string, modified bool, err error)
+
+	// Compares the content hashes of the DesiredLRPs the client holds, as
+	// returned by DesiredLRP.ContentHash, with those of the stored DesiredLRPs
+	// in the domain, or in every domain when the domain is empty. Returns the
+	// process guids that are not stored, those stored with another hash, and
+	// those stored but not held by the client.
+	DesiredLRPDiff(logger lager.Logger, domain string, contentHashes []*models.DesiredLRPContentHash) (missing, changed, extra []string, err error)
+
+	// Validates the given DesiredLRP as DesireLRP would, including against the
+	// resource limits of the BBS, without creating it. Returns every
+	// validation error, and none when the DesiredLRP is valid.
+	ValidateDesiredLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) (validationErrors []string, err error)
+
 	// Creates the given DesiredLRP and its corresponding ActualLRPs
 	DesireLRP(lager.Logger, *models.DesiredLRP) error
 
+	// Creates the given DesiredLRP as DesireLRP does, and returns the lint
+	// warnings the BBS reported for it
+	DesireLRPWithWarnings(logger lager.Logger, desiredLRP *models.DesiredLRP) ([]*models.LintWarning, error)
+
+	// Creates the given DesiredLRP, or succeeds without creating it if the
+	// idempotency key was already used for the same request
+	DesireLRPWithIdempotencyKey(logger lager.Logger, idempotencyKey string, desiredLRP *models.DesiredLRP) error
+
 	// Updates the DesiredLRP matching the given process guid
 	UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error
 
+	// Updates the DesiredLRP matching the given process guid as
+	// UpdateDesiredLRP does, and returns the lint warnings the BBS reported
+	// for the updated DesiredLRP
+	UpdateDesiredLRPWithWarnings(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) ([]*models.LintWarning, error)
+
 	// Removes the DesiredLRP matching the given process guid
 	RemoveDesiredLRP(logger lager.Logger, processGuid string) error
 }
@@ -164,6 +329,9 @@ The ExternalEventClient is used to subscribe to groups of Events.
 */
 type ExternalEventClient interface {
 	SubscribeToEvents(logger lager.Logger) (events.EventSource, error)
+
+	// Returns an EventSource of the tasks created, changed and removed
+	SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error)
 }
 
 func newClient(url string) *client {
@@ -238,6 +406,15 @@ func (c *client) Domains(logger lager.Logger) ([]string, error) {
 	return response.Domains, response.Error.ToError()
 }
 
+func (c *client) DomainFreshness(logger lager.Logger) ([]*models.DomainFreshness, error) {
+	response := models.DomainFreshnessResponse{}
+	err := c.doRequest(logger, DomainFreshnessRoute, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Domains, response.Error.ToError()
+}
+
 func (c *client) UpsertDomain(logger lager.Logger, domain string, ttl time.Duration) error {
 	request := models.UpsertDomainRequest{
 		Domain: domain,
@@ -251,10 +428,127 @@ func (c *client) UpsertDomain(logger lager.Logger, domain string, ttl time.Durat
 	return response.Error.ToError()
 }
 
+func (c *client) UpsertDomains(logger lager.Logger, domains []*models.UpsertDomainRequest) ([]*models.UpsertDomainResult, error) {
+	request := models.UpsertDomainsRequest{
+		Domains: domains,
+	}
+	response := models.UpsertDomainsResponse{}
+	err := c.doRequest(logger, UpsertDomainsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Results, response.Error.ToError()
+}
+
+func (c *client) ConvergeLRPs(logger lager.Logger, domains []string) error {
+	request := models.ConvergeLRPsRequest{
+		Domains: domains,
+	}
+	response := models.ConvergeLRPsResponse{}
+	err := c.doRequest(logger, ConvergeLRPsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) ReleaseLock(logger lager.Logger) error {
+	response := models.ReleaseLockResponse{}
+	err := c.doRequest(logger, ReleaseLockRoute, nil, nil, nil, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) ConvergenceStatuses(logger lager.Logger) ([]*models.ConvergenceStatus, error) {
+	response := models.ConvergenceStatusResponse{}
+	err := c.doRequest(logger, ConvergenceStatusRoute, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Statuses, response.Error.ToError()
+}
+
+func (c *client) PauseConvergence(logger lager.Logger, duration time.Duration) (time.Time, error) {
+	request := models.PauseConvergenceRequest{
+		Duration: int64(duration),
+	}
+	response := models.PauseConvergenceResponse{}
+	err := c.doRequest(logger, PauseConvergenceRoute, nil, nil, &request, &response)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, response.PausedUntil), response.Error.ToError()
+}
+
+func (c *client) ResumeConvergence(logger lager.Logger) error {
+	response := models.ResumeConvergenceResponse{}
+	err := c.doRequest(logger, ResumeConvergenceRoute, nil, nil, nil, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) Import(logger lager.Logger, force bool, records []*models.ImportRecord) ([]*models.ImportResult, error) {
+	request := models.ImportRequest{
+		Force:   force,
+		Records: records,
+	}
+	response := models.ImportResponse{}
+	err := c.doRequest(logger, ImportRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Results, response.Error.ToError()
+}
+
+func (c *client) Export(logger lager.Logger) ([]*models.ImportRecord, error) {
+	request := models.ExportRequest{}
+	response := models.ExportResponse{}
+	err := c.doRequest(logger, ExportRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Records, response.Error.ToError()
+}
+
+func (c *client) CoordinationState(logger lager.Logger) (*models.CoordinationStateResponse, error) {
+	response := models.CoordinationStateResponse{}
+	err := c.doRequest(logger, CoordinationStateRoute, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, response.Error.ToError()
+}
+
 func (c *client) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
+	filter.PartialResults = false
+	response, err := c.actualLRPGroups(logger, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ActualLrpGroups, response.Error.ToError()
+}
+
+func (c *client) ActualLRPGroupsWithRecordErrors(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
+	filter.PartialResults = true
+	response, err := c.actualLRPGroups(logger, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.ActualLrpGroups, response.RecordErrors, response.Error.ToError()
+}
+
+func (c *client) actualLRPGroups(logger lager.Logger, filter models.ActualLRPFilter) (*models.ActualLRPGroupsResponse, error) {
 	request := models.ActualLRPGroupsRequest{
-		Domain: filter.Domain,
-		CellId: filter.CellID,
+		Domain:         filter.Domain,
+		CellId:         filter.CellID,
+		States:         filter.States,
+		PartialResults: filter.PartialResults,
 	}
 	response := models.ActualLRPGroupsResponse{}
 	err := c.doRequest(logger, ActualLRPGroupsRoute, nil, nil, &request, &response)
@@ -262,7 +556,7 @@ func (c *client) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFil
 		return nil, err
 	}
 
-	return response.ActualLrpGroups, response.Error.ToError()
+	return &response, nil
 }
 
 func (c *client) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
@@ -292,6 +586,32 @@ func (c *client) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, proces
 	return response.ActualLrpGroup, response.Error.ToError()
 }
 
+func (c *client) CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error) {
+	request := models.CrashingActualLRPsRequest{
+		Limit: int32(limit),
+	}
+	response := models.ActualLRPsResponse{}
+	err := c.doRequest(logger, CrashingActualLRPsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ActualLrps, response.Error.ToError()
+}
+
+func (c *client) CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error) {
+	request := models.CellPlacementsRequest{
+		CellId: cellID,
+	}
+	response := models.CellPlacementsResponse{}
+	err := c.doRequest(logger, CellPlacementsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.CellPlacements, response.Error.ToError()
+}
+
 func (c *client) ClaimActualLRP(logger lager.Logger, processGuid string, index int, instanceKey *models.ActualLRPInstanceKey) error {
 	request := models.ClaimActualLRPRequest{
 		ProcessGuid:          processGuid,
@@ -321,6 +641,20 @@ func (c *client) StartActualLRP(logger lager.Logger, key *models.ActualLRPKey, i
 	return response.Error.ToError()
 }
 
+func (c *client) StartActualLRPs(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) ([]*models.ActualLRPKey, error) {
+	request := models.StartActualLRPsRequest{
+		CellId:        cellID,
+		ActualLrps:    starts,
+		Authoritative: authoritative,
+	}
+	response := models.StartActualLRPsResponse{}
+	err := c.doRequest(logger, StartActualLRPsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.RejectedActualLrpKeys, response.Error.ToError()
+}
+
 func (c *client) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) error {
 	request := models.CrashActualLRPRequest{
 		ActualLrpKey:         key,
@@ -363,6 +697,18 @@ func (c *client) RetireActualLRP(logger lager.Logger, key *models.ActualLRPKey)
 	return response.Error.ToError()
 }
 
+func (c *client) RetireActualLRPsOnCell(logger lager.Logger, cellID string) (int, error) {
+	request := models.RetireActualLRPsOnCellRequest{
+		CellId: cellID,
+	}
+	response := models.RetireActualLRPsOnCellResponse{}
+	err := c.doRequest(logger, RetireActualLRPsOnCellRoute, nil, nil, &request, &response)
+	if err != nil {
+		return 0, err
+	}
+	return int(response.RetiredCount), response.Error.ToError()
+}
+
 func (c *client) RemoveActualLRP(logger lager.Logger, processGuid string, index int, instanceKey *models.ActualLRPInstanceKey) error {
 	request := models.RemoveActualLRPRequest{
 		ProcessGuid:          processGuid,
@@ -425,8 +771,37 @@ func (c *client) RemoveEvacuatingActualLRP(logger lager.Logger, key *models.Actu
 }
 
 func (c *client) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	desiredLRPs, _, err := c.DesiredLRPsWithRevision(logger, filter)
+	return desiredLRPs, err
+}
+
+func (c *client) DesiredLRPsWithRevision(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, uint64, error) {
+	filter.PartialResults = false
+	response, err := c.desiredLRPs(logger, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return response.DesiredLrps, response.Revision, response.Error.ToError()
+}
+
+func (c *client) DesiredLRPsWithRecordErrors(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
+	filter.PartialResults = true
+	response, err := c.desiredLRPs(logger, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.DesiredLrps, response.RecordErrors, response.Error.ToError()
+}
+
+func (c *client) desiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) (*models.DesiredLRPsResponse, error) {
 	request := models.DesiredLRPsRequest{
-		Domain: filter.Domain,
+		Domain:                filter.Domain,
+		ProcessGuids:          filter.ProcessGuids,
+		Selector:              filter.LabelSelector.String(),
+		ModifiedSinceRevision: filter.ModifiedSinceRevision,
+		PartialResults:        filter.PartialResults,
 	}
 	response := models.DesiredLRPsResponse{}
 	err := c.doRequest(logger, DesiredLRPsRoute, nil, nil, &request, &response)
@@ -434,6 +809,22 @@ func (c *client) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter
 		return nil, err
 	}
 
+	return &response, nil
+}
+
+func (c *client) DesiredLRPsIncludingDeleted(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	request := models.DesiredLRPsRequest{
+		Domain:                filter.Domain,
+		ProcessGuids:          filter.ProcessGuids,
+		Selector:              filter.LabelSelector.String(),
+		ModifiedSinceRevision: filter.ModifiedSinceRevision,
+	}
+	response := models.DesiredLRPsResponse{}
+	err := c.doRequest(logger, DesiredLRPsIncludingDeletedRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
 	return response.DesiredLrps, response.Error.ToError()
 }
 
@@ -450,9 +841,60 @@ func (c *client) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string
 	return response.DesiredLrp, response.Error.ToError()
 }
 
+func (c *client) DesiredLRPDiff(logger lager.Logger, domain string, contentHashes []*models.DesiredLRPContentHash) ([]string, []string, []string, error) {
+	request := models.DesiredLRPDiffRequest{
+		Domain:      domain,
+		DesiredLrps: contentHashes,
+	}
+	response := models.DesiredLRPDiffResponse{}
+	err := c.doRequest(logger, DesiredLRPDiffRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return response.MissingProcessGuids, response.ChangedProcessGuids, response.ExtraProcessGuids, response.Error.ToError()
+}
+
+func (c *client) ValidateDesiredLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) ([]string, error) {
+	request := models.ValidateDesiredLRPRequest{
+		DesiredLrp: desiredLRP,
+	}
+	response := models.ValidateDesiredLRPResponse{}
+	err := c.doRequest(logger, ValidateDesiredLRPRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ValidationErrors, response.Error.ToError()
+}
+
 func (c *client) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+	filter.PartialResults = false
+	response, err := c.desiredLRPSchedulingInfos(logger, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.DesiredLrpSchedulingInfos, response.Error.ToError()
+}
+
+func (c *client) DesiredLRPSchedulingInfosWithRecordErrors(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, []*models.RecordError, error) {
+	filter.PartialResults = true
+	response, err := c.desiredLRPSchedulingInfos(logger, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.DesiredLrpSchedulingInfos, response.RecordErrors, response.Error.ToError()
+}
+
+func (c *client) desiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter) (*models.DesiredLRPSchedulingInfosResponse, error) {
 	request := models.DesiredLRPsRequest{
-		Domain: filter.Domain,
+		Domain:                filter.Domain,
+		ProcessGuids:          filter.ProcessGuids,
+		Selector:              filter.LabelSelector.String(),
+		ModifiedSinceRevision: filter.ModifiedSinceRevision,
+		PartialResults:        filter.PartialResults,
 	}
 	response := models.DesiredLRPSchedulingInfosResponse{}
 	err := c.doRequest(logger, DesiredLRPSchedulingInfosRoute, nil, nil, &request, &response)
@@ -460,26 +902,85 @@ func (c *client) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.De
 		return nil, err
 	}
 
-	return response.DesiredLrpSchedulingInfos, response.Error.ToError()
+	return &response, nil
+}
+
+func (c *client) DesiredLRPSchedulingInfosIfModified(logger lager.Logger, filter models.DesiredLRPFilter, etag string) ([]*models.DesiredLRPSchedulingInfo, string, bool, error) {
+	logger = logger.Session("desired-lrp-scheduling-infos-if-modified")
+
+	request, err := c.createRequest(DesiredLRPSchedulingInfosRoute, nil, nil, &models.DesiredLRPsRequest{
+		Domain:                filter.Domain,
+		ProcessGuids:          filter.ProcessGuids,
+		Selector:              filter.LabelSelector.String(),
+		ModifiedSinceRevision: filter.ModifiedSinceRevision,
+	})
+	if err != nil {
+		logger.Error("failed-creating-request", err)
+		return nil, "", false, err
+	}
+	if etag != "" {
+		request.Header.Set(IfNoneMatchHeader, etag)
+	}
+
+	httpResponse, err := c.httpClient.Do(request)
+	if err != nil {
+		logger.Error("failed-doing-request", err)
+		return nil, "", false, err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode == http.StatusNotModified {
+		return nil, etag, false, nil
+	}
+
+	response := models.DesiredLRPSchedulingInfosResponse{}
+	err = c.handleResponse(httpResponse, &response)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	return response.DesiredLrpSchedulingInfos, httpResponse.Header.Get(ETagHeader), true, response.Error.ToError()
 }
 
-func (c *client) doDesiredLRPLifecycleRequest(logger lager.Logger, route string, request proto.Message) error {
+func (c *client) doDesiredLRPLifecycleRequest(logger lager.Logger, route string, request proto.Message) ([]*models.LintWarning, error) {
 	response := models.DesiredLRPLifecycleResponse{}
 	err := c.doRequest(logger, route, nil, nil, request, &response)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return response.Error.ToError()
+	for _, warning := range response.Warnings {
+		logger.Info("desired-lrp-lint-warning", lager.Data{"check": warning.Check, "field": warning.Field, "message": warning.Message})
+	}
+	return response.Warnings, response.Error.ToError()
 }
 
 func (c *client) DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) error {
+	_, err := c.DesireLRPWithWarnings(logger, desiredLRP)
+	return err
+}
+
+func (c *client) DesireLRPWithWarnings(logger lager.Logger, desiredLRP *models.DesiredLRP) ([]*models.LintWarning, error) {
 	request := models.DesireLRPRequest{
 		DesiredLrp: desiredLRP,
 	}
 	return c.doDesiredLRPLifecycleRequest(logger, DesireDesiredLRPRoute, &request)
 }
 
+func (c *client) DesireLRPWithIdempotencyKey(logger lager.Logger, idempotencyKey string, desiredLRP *models.DesiredLRP) error {
+	request := models.DesireLRPRequest{
+		DesiredLrp:     desiredLRP,
+		IdempotencyKey: idempotencyKey,
+	}
+	_, err := c.doDesiredLRPLifecycleRequest(logger, DesireDesiredLRPRoute, &request)
+	return err
+}
+
 func (c *client) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error {
+	_, err := c.UpdateDesiredLRPWithWarnings(logger, processGuid, update)
+	return err
+}
+
+func (c *client) UpdateDesiredLRPWithWarnings(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) ([]*models.LintWarning, error) {
 	request := models.UpdateDesiredLRPRequest{
 		ProcessGuid: processGuid,
 		Update:      update,
@@ -491,7 +992,8 @@ func (c *client) RemoveDesiredLRP(logger lager.Logger, processGuid string) error
 	request := models.RemoveDesiredLRPRequest{
 		ProcessGuid: processGuid,
 	}
-	return c.doDesiredLRPLifecycleRequest(logger, RemoveDesiredLRPRoute, &request)
+	_, err := c.doDesiredLRPLifecycleRequest(logger, RemoveDesiredLRPRoute, &request)
+	return err
 }
 
 func (c *client) Tasks(logger lager.Logger) ([]*models.Task, error) {
@@ -531,6 +1033,44 @@ func (c *client) TasksByCellID(logger lager.Logger, cellId string) ([]*models.Ta
 	return response.Tasks, response.Error.ToError()
 }
 
+func (c *client) TasksWithFilter(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
+	filter.PartialResults = false
+	response, err := c.tasks(logger, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Tasks, response.Error.ToError()
+}
+
+func (c *client) TasksWithRecordErrors(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, []*models.RecordError, error) {
+	filter.PartialResults = true
+	response, err := c.tasks(logger, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Tasks, response.RecordErrors, response.Error.ToError()
+}
+
+func (c *client) tasks(logger lager.Logger, filter models.TaskFilter) (*models.TasksResponse, error) {
+	request := models.TasksRequest{
+		Domain:         filter.Domain,
+		CellId:         filter.CellID,
+		PartialResults: filter.PartialResults,
+	}
+	for _, state := range filter.States {
+		request.States = append(request.States, state.String())
+	}
+	response := models.TasksResponse{}
+	err := c.doRequest(logger, TasksRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 func (c *client) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	request := models.TaskByGuidRequest{
 		TaskGuid: taskGuid,
@@ -544,6 +1084,19 @@ func (c *client) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task,
 	return response.Task, response.Error.ToError()
 }
 
+func (c *client) DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error) {
+	request := models.DuplicateTasksRequest{
+		Domain: domain,
+	}
+	response := models.DuplicateTasksResponse{}
+	err := c.doRequest(logger, DuplicateTasksRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Duplicates, response.Error.ToError()
+}
+
 func (c *client) doTaskLifecycleRequest(logger lager.Logger, route string, request proto.Message) error {
 	response := models.TaskLifecycleResponse{}
 	err := c.doRequest(logger, route, nil, nil, request, &response)
@@ -563,6 +1116,16 @@ func (c *client) DesireTask(logger lager.Logger, taskGuid, domain string, taskDe
 	return c.doTaskLifecycleRequest(logger, route, &request)
 }
 
+func (c *client) DesireTaskWithIdempotencyKey(logger lager.Logger, idempotencyKey, taskGuid, domain string, taskDef *models.TaskDefinition) error {
+	request := models.DesireTaskRequest{
+		TaskGuid:       taskGuid,
+		Domain:         domain,
+		TaskDefinition: taskDef,
+		IdempotencyKey: idempotencyKey,
+	}
+	return c.doTaskLifecycleRequest(logger, DesireTaskRoute, &request)
+}
+
 func (c *client) StartTask(logger lager.Logger, taskGuid string, cellId string) (bool, error) {
 	request := &models.StartTaskRequest{
 		TaskGuid: taskGuid,
@@ -584,6 +1147,20 @@ func (c *client) CancelTask(logger lager.Logger, taskGuid string) error {
 	return c.doTaskLifecycleRequest(logger, route, &request)
 }
 
+func (c *client) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResponse, error) {
+	request := models.CancelTasksRequest{
+		Domain:    domain,
+		TaskGuids: taskGuids,
+	}
+	response := models.CancelTasksResponse{}
+	err := c.doRequest(logger, CancelTasksRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, response.Error.ToError()
+}
+
 func (c *client) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	request := models.TaskGuidRequest{
 		TaskGuid: taskGuid,
@@ -600,6 +1177,19 @@ func (c *client) DeleteTask(logger lager.Logger, taskGuid string) error {
 	return c.doTaskLifecycleRequest(logger, route, &request)
 }
 
+func (c *client) PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error) {
+	request := models.PurgeCompletedTasksRequest{
+		MinAgeInSeconds: int64(minAge / time.Second),
+	}
+	response := models.PurgeCompletedTasksResponse{}
+	err := c.doRequest(logger, PurgeCompletedTasksRoute, nil, nil, &request, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(response.PurgedCount), response.Error.ToError()
+}
+
 func (c *client) FailTask(logger lager.Logger, taskGuid, failureReason string) error {
 	request := models.FailTaskRequest{
 		TaskGuid:      taskGuid,
@@ -609,6 +1199,15 @@ func (c *client) FailTask(logger lager.Logger, taskGuid, failureReason string) e
 	return c.doTaskLifecycleRequest(logger, route, &request)
 }
 
+func (c *client) RejectTask(logger lager.Logger, taskGuid, rejectionReason string) error {
+	request := models.RejectTaskRequest{
+		TaskGuid:        taskGuid,
+		RejectionReason: rejectionReason,
+	}
+	route := RejectTaskRoute
+	return c.doTaskLifecycleRequest(logger, route, &request)
+}
+
 func (c *client) CompleteTask(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) error {
 	request := models.CompleteTaskRequest{
 		TaskGuid:      taskGuid,
@@ -622,7 +1221,11 @@ func (c *client) CompleteTask(logger lager.Logger, taskGuid, cellId string, fail
 }
 
 func (c *client) subscribeToEvents(route string) (events.EventSource, error) {
-	eventSource, err := sse.Connect(c.streamingHTTPClient, time.Second, func() *http.Request {
+	// The event stream is gzipped on the wire and decompressed as it is read.
+	streamingHTTPClient := *c.streamingHTTPClient
+	streamingHTTPClient.Transport = events.NewGzipTransport(c.streamingHTTPClient.Transport)
+
+	eventSource, err := sse.Connect(&streamingHTTPClient, time.Second, func() *http.Request {
 		request, err := c.reqGen.CreateRequest(route, nil, nil)
 		if err != nil {
 			panic(err) // totally shouldn't happen
@@ -642,6 +1245,10 @@ func (c *client) SubscribeToEvents(logger lager.Logger) (events.EventSource, err
 	return c.subscribeToEvents(EventStreamRoute_r0)
 }
 
+func (c *client) SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error) {
+	return c.subscribeToEvents(TaskEventStreamRoute)
+}
+
 func (c *client) Cells(logger lager.Logger) ([]*models.CellPresence, error) {
 	response := models.CellsResponse{}
 	err := c.doRequest(logger, CellsRoute, nil, nil, nil, &response)
@@ -651,6 +1258,27 @@ func (c *client) Cells(logger lager.Logger) ([]*models.CellPresence, error) {
 	return response.Cells, response.Error.ToError()
 }
 
+func (c *client) ObjectCounts(logger lager.Logger, groupBy ...string) (*models.ObjectCountsResponse, error) {
+	request := models.ObjectCountsRequest{
+		GroupBy: groupBy,
+	}
+	response := models.ObjectCountsResponse{}
+	err := c.doRequest(logger, ObjectCountsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, response.Error.ToError()
+}
+
+func (c *client) CellPresenceStatuses(logger lager.Logger) ([]*models.CellPresenceStatus, error) {
+	response := models.CellPresenceStatusesResponse{}
+	err := c.doRequest(logger, CellPresenceStatusesRoute, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Statuses, response.Error.ToError()
+}
+
 func (c *client) createRequest(requestName string, params rata.Params, queryParams url.Values, message proto.Message) (*http.Request, error) {
 	var messageBody []byte
 	var err error
@@ -718,6 +1346,10 @@ func (c *client) do(request *http.Request, responseObject proto.Message) error {
 		_ = response.Body.Close()
 	}()
 
+	return c.handleResponse(response, responseObject)
+}
+
+func (c *client) handleResponse(response *http.Response, responseObject proto.Message) error {
 	var parsedContentType string
 	if contentType, ok := response.Header[ContentTypeHeader]; ok {
 		parsedContentType, _, _ = mime.ParseMediaType(contentType[0])
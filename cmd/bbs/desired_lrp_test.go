@@ -83,6 +83,18 @@ var _ = Describe("DesiredLRP API", func() {
 				Expect(actualDesiredLRPs).To(ConsistOf(expectedDesiredLRPs))
 			})
 		})
+
+		Context("when filtering by process guids", func() {
+			var expectedDesiredLRP *models.DesiredLRP
+			BeforeEach(func() {
+				expectedDesiredLRP = desiredLRPs["domain-2"][1]
+				filter = models.DesiredLRPFilter{ProcessGuids: []string{expectedDesiredLRP.ProcessGuid}}
+			})
+
+			It("returns only the desired lrps with the requested process guids", func() {
+				Expect(actualDesiredLRPs).To(ConsistOf(expectedDesiredLRP))
+			})
+		})
 	})
 
 	Describe("DesiredLRPByProcessGuid", func() {
@@ -155,6 +167,18 @@ var _ = Describe("DesiredLRP API", func() {
 				Expect(schedulingInfos).To(ConsistOf(expectedSchedulingInfos))
 			})
 		})
+
+		Context("when filtering by process guids", func() {
+			var expectedSchedulingInfo models.DesiredLRPSchedulingInfo
+			BeforeEach(func() {
+				expectedSchedulingInfo = desiredLRPs["domain-2"][1].DesiredLRPSchedulingInfo()
+				filter = models.DesiredLRPFilter{ProcessGuids: []string{expectedSchedulingInfo.ProcessGuid}}
+			})
+
+			It("returns only the scheduling infos with the requested process guids", func() {
+				Expect(schedulingInfos).To(ConsistOf(&expectedSchedulingInfo))
+			})
+		})
 	})
 
 	Describe("DesireLRP", func() {
@@ -208,6 +232,18 @@ var _ = Describe("DesiredLRP API", func() {
 				Expect(persistedDesiredLRP.Action.RunAction.SuppressLogOutput).To(BeFalse())
 			})
 		})
+
+		Context("when the DesiredLRP raises lint warnings", func() {
+			It("returns them from DesireLRPWithWarnings", func() {
+				lowMemoryLRP := model_helpers.NewValidDesiredLRP("low-memory-lrp")
+				lowMemoryLRP.MemoryMb = 32
+
+				warnings, err := client.DesireLRPWithWarnings(logger, lowMemoryLRP)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).NotTo(BeEmpty())
+				Expect(warnings).To(Equal(lowMemoryLRP.Lint()))
+			})
+		})
 	})
 
 	Describe("RemoveDesiredLRP", func() {
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/bbs/db/etcd"
+	etcdclient "github.com/coreos/go-etcd/etcd"
 )
 
 type ETCDFlags struct {
@@ -17,6 +18,7 @@ type ETCDFlags struct {
 	clusterUrls            string
 	clientSessionCacheSize int
 	maxIdleConnsPerHost    int
+	bulkReadConsistency    string
 }
 
 func AddETCDFlags(flagSet *flag.FlagSet) *ETCDFlags {
@@ -59,6 +61,12 @@ func AddETCDFlags(flagSet *flag.FlagSet) *ETCDFlags {
 		0,
 		"Controls the maximum number of idle (keep-alive) connctions per host. If zero, golang's default will be used",
 	)
+	flagSet.StringVar(
+		&flags.bulkReadConsistency,
+		"etcdBulkReadConsistency",
+		"strong",
+		"Consistency of etcd reads for the bulk list endpoints, 'strong' or 'weak'. Weak reads can be served by any etcd member instead of the leader, but may return slightly stale data. The desired LRP listings, which are versioned by a revision, are always strong",
+	)
 	return flags
 }
 
@@ -87,6 +95,16 @@ func (flags *ETCDFlags) Validate() (*etcd.ETCDOptions, error) {
 		}
 	}
 
+	var bulkReadConsistency string
+	switch flags.bulkReadConsistency {
+	case "", "strong":
+		bulkReadConsistency = etcdclient.STRONG_CONSISTENCY
+	case "weak":
+		bulkReadConsistency = etcdclient.WEAK_CONSISTENCY
+	default:
+		return nil, fmt.Errorf("Invalid bulk read consistency: '%s', must be 'strong' or 'weak'", flags.bulkReadConsistency)
+	}
+
 	isSSL := false
 	if scheme == "https" {
 		isSSL = true
@@ -99,13 +117,14 @@ func (flags *ETCDFlags) Validate() (*etcd.ETCDOptions, error) {
 	}
 
 	return &etcd.ETCDOptions{
-		CertFile:    flags.etcdCertFile,
-		KeyFile:     flags.etcdKeyFile,
-		CAFile:      flags.etcdCaFile,
-		ClusterUrls: clusterUrls,
-		IsSSL:       isSSL,
+		CertFile:               flags.etcdCertFile,
+		KeyFile:                flags.etcdKeyFile,
+		CAFile:                 flags.etcdCaFile,
+		ClusterUrls:            clusterUrls,
+		IsSSL:                  isSSL,
 		ClientSessionCacheSize: flags.clientSessionCacheSize,
 		MaxIdleConnsPerHost:    flags.maxIdleConnsPerHost,
+		BulkReadConsistency:    bulkReadConsistency,
 		IsConfigured:           true,
 	}, nil
 }
@@ -12,25 +12,35 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/auctioneerclient"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/certreloader"
+	"code.cloudfoundry.org/bbs/connlimit"
 	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/converger"
 	"code.cloudfoundry.org/bbs/db"
 	etcddb "code.cloudfoundry.org/bbs/db/etcd"
 	"code.cloudfoundry.org/bbs/db/migrations"
 	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/db/sqlmigrations"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/encryptor"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/guidprovider"
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/lockmaintainer"
 	"code.cloudfoundry.org/bbs/metrics"
 	"code.cloudfoundry.org/bbs/migration"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/bbs/readiness"
 	"code.cloudfoundry.org/bbs/taskworkpool"
 	"code.cloudfoundry.org/cfhttp"
 	"code.cloudfoundry.org/cflager"
@@ -41,6 +51,7 @@ import (
 	"code.cloudfoundry.org/locket"
 	"code.cloudfoundry.org/rep"
 	"github.com/cloudfoundry/dropsonde"
+	"github.com/cloudfoundry/dropsonde/emitter"
 	etcdclient "github.com/coreos/go-etcd/etcd"
 	"github.com/go-sql-driver/mysql"
 	"github.com/hashicorp/consul/api"
@@ -49,6 +60,8 @@ import (
 	"github.com/tedsuo/ifrit/grouper"
 	"github.com/tedsuo/ifrit/http_server"
 	"github.com/tedsuo/ifrit/sigmon"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 var accessLogPath = flag.String(
@@ -57,12 +70,60 @@ var accessLogPath = flag.String(
 	"Location of the access log",
 )
 
+var auditLogPath = flag.String(
+	"auditLogPath",
+	"",
+	"Location of the audit log of mutations, written as one JSON record per line. When unset, records are written to the main log.",
+)
+
+var authorizationPolicyFile = flag.String(
+	"authorizationPolicyFile",
+	"",
+	"Path to a JSON file mapping client certificate names to the operation classes (read, write, admin) they may perform. When unset, every client may perform every operation.",
+)
+
+var rateLimitConfigFile = flag.String(
+	"rateLimitConfigFile",
+	"",
+	"Path to a JSON file limiting the requests per second each client may make of each operation class (read, write, admin). Clients are told apart by certificate identity, or by remote IP when they present no certificate. When unset, requests are not rate limited.",
+)
+
 var listenAddress = flag.String(
 	"listenAddress",
 	"",
 	"The host:port that the server is bound to.",
 )
 
+var grpcListenAddress = flag.String(
+	"grpcListenAddress",
+	"",
+	"The host:port that the gRPC server for LRP and task reads and event streaming is bound to. When unset, the gRPC server is not started.",
+)
+
+var maxServerConnections = flag.Int(
+	"maxServerConnections",
+	0,
+	"Maximum number of connections open at a time on the server bound to listenAddress; 0 leaves them unlimited",
+)
+
+var serverConnectionLimitPolicy = flag.String(
+	"serverConnectionLimitPolicy",
+	string(connlimit.PolicyWait),
+	"What happens to new connections while the server is at maxServerConnections: 'wait' stops accepting until a connection closes, 'reject' closes them right away",
+)
+
+var serverShutdownTimeout = flag.Duration(
+	"serverShutdownTimeout",
+	connlimit.DefaultShutdownTimeout,
+	"How long the server bound to listenAddress waits for the requests in flight to finish when stopping, before closing their connections",
+)
+
+var serverListenBacklog = flag.Int(
+	"serverListenBacklog",
+	0,
+	"Size of the accept backlog of the server bound to listenAddress, capped by net.core.somaxconn; 0 keeps the system default. Only supported on Linux",
+)
+
 var requireSSL = flag.Bool(
 	"requireSSL",
 	false,
@@ -78,7 +139,7 @@ var caFile = flag.String(
 var certFile = flag.String(
 	"certFile",
 	"",
-	"the public key file to use with ssl authentication",
+	"the public key file to use with ssl authentication; reloaded when it changes on disk",
 )
 
 var keyFile = flag.String(
@@ -87,6 +148,12 @@ var keyFile = flag.String(
 	"the private key file to use with ssl authentication",
 )
 
+var dbReadinessCheckInterval = flag.Duration(
+	"dbReadinessCheckInterval",
+	10*time.Second,
+	"How often the SQL database is pinged to report the BBS as degraded on the readiness endpoint while it is unreachable",
+)
+
 var healthAddress = flag.String(
 	"healthAddress",
 	"",
@@ -108,7 +175,43 @@ var communicationTimeout = flag.Duration(
 var auctioneerAddress = flag.String(
 	"auctioneerAddress",
 	"",
-	"The address to the auctioneer api server",
+	"The address to the auctioneer api server; required unless discardAuctionRequests is set",
+)
+
+var discardAuctionRequests = flag.Bool(
+	"discardAuctionRequests",
+	false,
+	"Run without an auctioneer, logging and dropping auction requests; only allowed without auctioneerAddress",
+)
+
+var auctioneerRequestBufferSize = flag.Int(
+	"auctioneerRequestBufferSize",
+	0,
+	"Max auction requests queued for submission to the auctioneer in the background; 0 sends them directly",
+)
+
+var auctioneerRetryInterval = flag.Duration(
+	"auctioneerRetryInterval",
+	time.Second,
+	"Delay before resubmitting queued auction requests the auctioneer failed to accept; doubled after each consecutive failure",
+)
+
+var auctioneerMaxRetryInterval = flag.Duration(
+	"auctioneerMaxRetryInterval",
+	30*time.Second,
+	"Longest delay between attempts to submit queued auction requests while the auctioneer is unavailable",
+)
+
+var auctioneerCircuitBreakerFailureThreshold = flag.Int(
+	"auctioneerCircuitBreakerFailureThreshold",
+	0,
+	"Consecutive failed auction requests after which requests stop being sent to the auctioneer for a while; 0 disables the circuit breaker",
+)
+
+var auctioneerCircuitBreakerOpenTimeout = flag.Duration(
+	"auctioneerCircuitBreakerOpenTimeout",
+	30*time.Second,
+	"How long auction requests stop being sent to the auctioneer once the circuit breaker opens, before one request probes it",
 )
 
 var sessionName = flag.String(
@@ -123,16 +226,34 @@ var consulCluster = flag.String(
 	"comma-separated list of consul server URLs (scheme://ip:port)",
 )
 
+var lockBackend = flag.String(
+	"lockBackend",
+	consulLockBackend,
+	"where the BBS lock and the cell and BBS presences are kept, either 'consul' or 'sql'; 'sql' requires a SQL database and every BBS and cell to use it",
+)
+
 var lockTTL = flag.Duration(
 	"lockTTL",
 	locket.LockTTL,
-	"TTL for service lock",
+	"TTL for service lock; must be at least twice, and is recommended to be three times, the lockRetryInterval",
 )
 
 var lockRetryInterval = flag.Duration(
 	"lockRetryInterval",
 	locket.RetryInterval,
-	"interval to wait before retrying a failed lock acquisition",
+	"interval at which the lock and presences are refreshed, and to wait before retrying a failed lock acquisition",
+)
+
+var clockSkewTolerance = flag.Duration(
+	"clockSkewTolerance",
+	0,
+	fmt.Sprintf("safety margin for small clock skew between the nodes sharing the SQL database, added to the expiry of domains, presences and the sql lock; at most %s and shorter than the lockRetryInterval", lockmaintainer.MaxClockSkewTolerance),
+)
+
+var serveReadsWhileStandby = flag.Bool(
+	"serveReadsWhileStandby",
+	false,
+	"serve read requests while waiting for the lock; reads from a standby may lag slightly behind the lock holder",
 )
 
 var reportInterval = flag.Duration(
@@ -141,22 +262,52 @@ var reportInterval = flag.Duration(
 	"interval on which to report metrics",
 )
 
+var maxReportedDomains = flag.Int(
+	"maxReportedDomains",
+	0,
+	"maximum number of domains to report desired and running LRP counts for, largest first; 0 disables the per-domain metrics. With etcd, the counts are those of the last LRP convergence",
+)
+
+var minReportedDomainInstances = flag.Int(
+	"minReportedDomainInstances",
+	0,
+	"only report per-domain LRP counts for domains with at least this many desired instances",
+)
+
 var dropsondePort = flag.Int(
 	"dropsondePort",
 	3457,
 	"port the local metron agent is listening on",
 )
 
+var metricTags = flag.String(
+	"metricTags",
+	"",
+	"comma separated key:value tags, such as deployment:cf,region:us-east-1,index:0, added to every emitted metric and log line",
+)
+
 var convergenceWorkers = flag.Int(
 	"convergenceWorkers",
 	20,
-	"Max concurrency for convergence",
+	"Max concurrency for convergence, shared by LRP and Task convergence",
+)
+
+var convergencePriorityDomains = flag.String(
+	"convergencePriorityDomains",
+	"",
+	"comma separated domains whose LRPs are converged, and whose auctions are requested, before the work of every other domain, in the order given",
+)
+
+var convergenceStepOrder = flag.String(
+	"convergenceStepOrder",
+	"",
+	"comma separated order in which LRP convergence retires extra instances ('retire'), unclaims instances on missing cells ('unclaim') and requests the start auctions ('start') within a domain. Defaults to retire,unclaim,start",
 )
 
 var updateWorkers = flag.Int(
 	"updateWorkers",
 	1000,
-	"Max concurrency for etcd updates in a single request",
+	"Max concurrency for etcd updates, and for decoding records in etcd bulk reads, in a single request",
 )
 
 var taskCallBackWorkers = flag.Int(
@@ -165,12 +316,126 @@ var taskCallBackWorkers = flag.Int(
 	"Max concurrency for task callback requests",
 )
 
+var taskCallbackQueueSize = flag.Int(
+	"taskCallbackQueueSize",
+	0,
+	"Max task callbacks waiting for a worker; further callbacks are dropped and resubmitted by task convergence. 0 queues all callbacks",
+)
+
+var taskCallbackRetryAttempts = flag.Int(
+	"taskCallbackRetryAttempts",
+	taskworkpool.DefaultRetryPolicy.MaxAttempts,
+	"Number of times a task completion callback is attempted before giving up",
+)
+
+var taskCallbackRetryBaseDelay = flag.Duration(
+	"taskCallbackRetryBaseDelay",
+	taskworkpool.DefaultRetryPolicy.BaseDelay,
+	"Delay before the first retry of a task completion callback",
+)
+
+var taskCallbackRetryBackoffMultiplier = flag.Float64(
+	"taskCallbackRetryBackoffMultiplier",
+	taskworkpool.DefaultRetryPolicy.BackoffMultiplier,
+	"Factor applied to the delay after each failed task completion callback; delays are capped at communicationTimeout",
+)
+
+var taskCallbackDrainTimeout = flag.Duration(
+	"taskCallbackDrainTimeout",
+	10*time.Second,
+	"How long to wait on shutdown for queued and running task completion callbacks to finish; convergence resubmits the callbacks left over",
+)
+
+var maxMemoryMb = flag.Int(
+	"maxMemoryMb",
+	0,
+	"Largest memory_mb a task or desired LRP may request; 0 means no limit",
+)
+
+var maxDiskMb = flag.Int(
+	"maxDiskMb",
+	0,
+	"Largest disk_mb a task or desired LRP may request; 0 means no limit",
+)
+
+var maxDesiredInstances = flag.Int(
+	"maxDesiredInstances",
+	0,
+	"Largest number of instances a desired LRP may request; updates that do not raise the instance count are always allowed; 0 means no limit",
+)
+
+var maxRequestBodySize = flag.Int64(
+	"maxRequestBodySize",
+	10*1024*1024,
+	"Largest request body, in bytes, the BBS will accept; 0 means no limit",
+)
+
 var desiredLRPCreationTimeout = flag.Duration(
 	"desiredLRPCreationTimeout",
 	1*time.Minute,
 	"Expected maximum time to create all components of a desired LRP",
 )
 
+var sqlReadTimeout = flag.Duration(
+	"sqlReadTimeout",
+	time.Minute,
+	"Longest a single read from the SQL database may run before it is cancelled; streamed tasks are only limited until the query returns. 0 means no limit",
+)
+
+var sqlWriteTimeout = flag.Duration(
+	"sqlWriteTimeout",
+	5*time.Minute,
+	"Longest a single write or convergence pass against the SQL database may run before it is cancelled and rolled back; 0 means no limit",
+)
+
+var stuckEvacuationThreshold = flag.Duration(
+	"stuckEvacuationThreshold",
+	10*time.Minute,
+	"How long an ActualLRP may stay evacuating before convergence reports it as stuck; 0 disables the report",
+)
+
+var softDeleteDesiredLRPs = flag.Bool(
+	"softDeleteDesiredLRPs",
+	false,
+	"Keep a tombstone of every removed DesiredLRP until convergence purges it after the desiredLRPTombstoneRetention",
+)
+
+var desiredLRPTombstoneRetention = flag.Duration(
+	"desiredLRPTombstoneRetention",
+	24*time.Hour,
+	"How long the tombstone of a removed DesiredLRP is kept when softDeleteDesiredLRPs is set",
+)
+
+var actualLRPPlacementHistoryLength = flag.Int(
+	"actualLRPPlacementHistoryLength",
+	0,
+	fmt.Sprintf("How many recent placements (claims, starts and crashes) to keep on each ActualLRP, at most %d; 0 disables the history", models.MaximumPlacementHistoryLength),
+)
+
+var orphanedActualLRPGracePeriod = flag.Duration(
+	"orphanedActualLRPGracePeriod",
+	0,
+	"How long convergence keeps an ActualLRP whose DesiredLRP no longer exists before retiring it; when set, a removal that sets KeepInstances leaves its instances for convergence. 0 retires orphans on the first convergence pass",
+)
+
+var etcdSchedulingInfoCacheTTL = flag.Duration(
+	"etcdSchedulingInfoCacheTTL",
+	0,
+	"How long to keep a decrypted DesiredLRP scheduling info in memory when reading from etcd; 0 disables the cache",
+)
+
+var etcdSchedulingInfoCacheSize = flag.Int(
+	"etcdSchedulingInfoCacheSize",
+	0,
+	"How many decrypted DesiredLRP scheduling infos to keep in memory when reading from etcd; 0 disables the cache",
+)
+
+var missingEncryptionKeyPolicy = flag.String(
+	"missingEncryptionKeyPolicy",
+	string(format.MissingKeyFail),
+	"What reads do with a record encrypted with a key that is no longer configured: fail (fail the read and keep the record), skip (leave it out of the read and keep it) or quarantine (move it aside for inspection and leave it out of the read)",
+)
+
 var databaseConnectionString = flag.String(
 	"databaseConnectionString",
 	"",
@@ -189,6 +454,18 @@ var databaseDriver = flag.String(
 	"SQL database driver name",
 )
 
+var sqlTablePrefix = flag.String(
+	"sqlTablePrefix",
+	"",
+	"Prefix of the name of every table the BBS creates and uses, so that several BBS deployments can share a SQL database; lower case letters, digits and underscores, starting with a letter",
+)
+
+var sqlConvergenceBatchSize = flag.Int(
+	"sqlConvergenceBatchSize",
+	0,
+	"Most rows convergence writes to the SQL database with a single statement; 0 or 1 writes each row with its own statement. On MySQL, keep a batch within max_allowed_packet",
+)
+
 var sqlCACertFile = flag.String(
 	"sqlCACertFile",
 	"",
@@ -198,7 +475,37 @@ var sqlCACertFile = flag.String(
 var convergeRepeatInterval = flag.Duration(
 	"convergeRepeatInterval",
 	30*time.Second,
-	"the interval between runs of the converger",
+	"the interval between runs of the converger, used for LRPs and Tasks unless overridden",
+)
+
+var convergeLRPsInterval = flag.Duration(
+	"convergeLRPsInterval",
+	0,
+	"the interval between LRP convergence runs (defaults to convergeRepeatInterval)",
+)
+
+var convergeTasksInterval = flag.Duration(
+	"convergeTasksInterval",
+	0,
+	"the interval between Task convergence runs (defaults to convergeRepeatInterval)",
+)
+
+var convergeJitter = flag.Float64(
+	"convergeJitter",
+	0,
+	"the fraction, up to 0.5, by which each convergence interval is randomly lengthened or shortened",
+)
+
+var domainConvergeInterval = flag.Duration(
+	"domainConvergeInterval",
+	0,
+	"the interval between LRP convergence runs scoped to a single domain, cycling through the fresh domains (0 disables them)",
+)
+
+var maxConvergencePause = flag.Duration(
+	"maxConvergencePause",
+	time.Hour,
+	"the longest an operator may pause convergence for; longer pauses are shortened to this",
 )
 
 var kickTaskDuration = flag.Duration(
@@ -216,11 +523,43 @@ var expireCompletedTaskDuration = flag.Duration(
 var expirePendingTaskDuration = flag.Duration(
 	"expirePendingTaskDuration",
 	30*time.Minute,
-	"unclaimed tasks are marked as failed, after this duration",
+	"unclaimed tasks are marked as failed, after this duration; 0 never fails them",
+)
+
+var maxTaskRejections = flag.Int(
+	"maxTaskRejections",
+	0,
+	"pending tasks are failed once they could not be placed this many times; until then, failing a pending task, as the auctioneer does when it cannot place it, records a rejection and leaves it to be auctioned again by convergence; 0 fails them when asked",
+)
+
+var eventReplayBufferSize = flag.Int(
+	"eventReplayBufferSize",
+	0,
+	"Number of recent events each event hub keeps to replay to event stream clients that reconnect; 0 disables replay",
+)
+
+var eventCoalescingWindow = flag.Duration(
+	"eventCoalescingWindow",
+	0,
+	"How long LRP changed events are held back so that repeated changes to the same LRP are streamed as a single event; 0 disables coalescing",
+)
+
+var eventStreamRequireResync = flag.Bool(
+	"eventStreamRequireResync",
+	false,
+	"Refuse event stream clients reconnecting from an event that can no longer be replayed, so they resync, instead of streaming only new events to them",
+)
+
+var maxEventSubscribers = flag.Int(
+	"maxEventSubscribers",
+	0,
+	"Maximum number of concurrent subscribers to each of the LRP and task event streams; further subscribers are refused with a TooManySubscribers error until one disconnects. 0 disables the limit",
 )
 
 const (
 	dropsondeOrigin           = "bbs"
+	consulLockBackend         = "consul"
+	sqlLockBackend            = "sql"
 	bbsWatchRetryWaitDuration = 3 * time.Second
 )
 
@@ -235,20 +574,68 @@ func main() {
 	cfhttp.Initialize(*communicationTimeout)
 
 	logger, reconfigurableSink := cflager.New("bbs")
+
+	tags, err := metrics.ParseTags(*metricTags)
+	if err != nil {
+		logger.Fatal("invalid-metric-tags", err)
+	}
+	logger = metrics.TagLogger(logger, tags)
 	logger.Info("starting")
 
-	initializeDropsonde(logger)
+	initializeDropsonde(logger, tags)
 
 	clock := clock.NewClock()
 
-	consulClient, err := consuladapter.NewClientFromUrl(*consulCluster)
-	if err != nil {
-		logger.Fatal("new-consul-client-failed", err)
+	if err := sqldb.ValidateTablePrefix(*sqlTablePrefix); err != nil {
+		logger.Fatal("invalid-sql-table-prefix", err)
 	}
 
-	serviceClient := bbs.NewServiceClient(consulClient, clock)
+	// If SQL database info is passed in, use SQL instead of ETCD
+	var sqlConn *sql.DB
+	if *databaseDriver != "" && *databaseConnectionString != "" {
+		sqlConn = initializeSQLConn(logger)
+		defer sqlConn.Close()
+	}
 
-	maintainer := initializeLockMaintainer(logger, serviceClient)
+	var consulClient consuladapter.Client
+	var serviceClient bbs.ServiceClient
+	switch *lockBackend {
+	case consulLockBackend:
+		consulClient, err = consuladapter.NewClientFromUrl(*consulCluster)
+		if err != nil {
+			logger.Fatal("new-consul-client-failed", err)
+		}
+		serviceClient = bbs.NewServiceClient(consulClient, clock)
+	case sqlLockBackend:
+		if sqlConn == nil {
+			logger.Fatal("invalid-lock-backend", errors.New("the sql lock backend requires a SQL database"))
+		}
+		serviceClient, err = bbs.NewSQLServiceClient(logger, sqlConn, *databaseDriver, *sqlTablePrefix, clock, *clockSkewTolerance)
+		if err != nil {
+			logger.Fatal("new-sql-service-client-failed", err)
+		}
+	default:
+		logger.Fatal("invalid-lock-backend", fmt.Errorf("unknown lock backend '%s'", *lockBackend))
+	}
+
+	err = lockmaintainer.ValidateTimings(*lockTTL, *lockRetryInterval)
+	if err != nil {
+		logger.Fatal("invalid-lock-timings", err)
+	}
+	err = lockmaintainer.ValidateClockSkewTolerance(*clockSkewTolerance, *lockRetryInterval)
+	if err != nil {
+		logger.Fatal("invalid-clock-skew-tolerance", err)
+	}
+	lockTimings := lager.Data{"lock-ttl": lockTTL.String(), "lock-retry-interval": lockRetryInterval.String(), "clock-skew-tolerance": clockSkewTolerance.String()}
+	if lockmaintainer.TimingsBelowRecommendation(*lockTTL, *lockRetryInterval) {
+		logger.Info("lock-timings-below-recommended-ratio", lockTimings)
+	}
+	logger.Info("lock-timings", lockTimings)
+
+	bbsPresence := initializeBBSPresence(logger)
+	// After an operator releases the lock, wait out two of the other instances'
+	// retry intervals before contending again so that one of them takes over.
+	maintainer := lockmaintainer.New(logger, initializeLockMaintainer(logger, serviceClient, bbsPresence), clock, 2*(*lockRetryInterval))
 
 	_, portString, err := net.SplitHostPort(*listenAddress)
 	if err != nil {
@@ -268,13 +655,104 @@ func main() {
 		logger.Fatal("failed-invalid-health-port", err)
 	}
 
-	registrationRunner := initializeRegistrationRunner(logger, consulClient, portNum, clock)
+	// service registration is a Consul feature, so there is nothing to
+	// register with when the lock is kept in SQL
+	var registrationRunner ifrit.Runner
+	if consulClient != nil {
+		registrationRunner = initializeRegistrationRunner(logger, consulClient, portNum, clock)
+	}
+
+	if *eventReplayBufferSize < 0 {
+		logger.Fatal("invalid-event-replay-buffer-size", errors.New("eventReplayBufferSize must not be negative"))
+	}
+	if *eventCoalescingWindow < 0 {
+		logger.Fatal("invalid-event-coalescing-window", errors.New("eventCoalescingWindow must not be negative"))
+	}
+	if *maxEventSubscribers < 0 {
+		logger.Fatal("invalid-max-event-subscribers", errors.New("maxEventSubscribers must not be negative"))
+	}
+	if *maxTaskRejections < 0 {
+		logger.Fatal("invalid-max-task-rejections", errors.New("maxTaskRejections must not be negative"))
+	}
+	if *taskCallbackRetryAttempts < 1 {
+		logger.Fatal("invalid-task-callback-retry-attempts", errors.New("taskCallbackRetryAttempts must be at least 1"))
+	}
+	if *taskCallbackRetryBackoffMultiplier < 1 {
+		logger.Fatal("invalid-task-callback-retry-backoff-multiplier", errors.New("taskCallbackRetryBackoffMultiplier must be at least 1"))
+	}
+	if *softDeleteDesiredLRPs && *desiredLRPTombstoneRetention <= 0 {
+		logger.Fatal("invalid-desired-lrp-tombstone-retention", errors.New("desiredLRPTombstoneRetention must be positive when softDeleteDesiredLRPs is set"))
+	}
+	if *auctioneerAddress == "" && !*discardAuctionRequests {
+		logger.Fatal("missing-auctioneer-address", errors.New("auctioneerAddress is required unless discardAuctionRequests is set"))
+	}
+	if *auctioneerAddress != "" && *discardAuctionRequests {
+		logger.Fatal("invalid-discard-auction-requests", errors.New("discardAuctionRequests must not be set with auctioneerAddress"))
+	}
+	if *auctioneerRequestBufferSize < 0 {
+		logger.Fatal("invalid-auctioneer-request-buffer-size", errors.New("auctioneerRequestBufferSize must not be negative"))
+	}
+	if *auctioneerRequestBufferSize > 0 && *auctioneerRetryInterval <= 0 {
+		logger.Fatal("invalid-auctioneer-retry-interval", errors.New("auctioneerRetryInterval must be positive"))
+	}
+	if *auctioneerRequestBufferSize > 0 && *auctioneerMaxRetryInterval < *auctioneerRetryInterval {
+		logger.Fatal("invalid-auctioneer-max-retry-interval", errors.New("auctioneerMaxRetryInterval must not be less than auctioneerRetryInterval"))
+	}
+	if *auctioneerCircuitBreakerFailureThreshold < 0 {
+		logger.Fatal("invalid-auctioneer-circuit-breaker-failure-threshold", errors.New("auctioneerCircuitBreakerFailureThreshold must not be negative"))
+	}
+	if *auctioneerCircuitBreakerFailureThreshold > 0 && *auctioneerCircuitBreakerOpenTimeout <= 0 {
+		logger.Fatal("invalid-auctioneer-circuit-breaker-open-timeout", errors.New("auctioneerCircuitBreakerOpenTimeout must be positive"))
+	}
+	if *maxServerConnections < 0 {
+		logger.Fatal("invalid-max-server-connections", errors.New("maxServerConnections must not be negative"))
+	}
+	if *serverShutdownTimeout <= 0 {
+		logger.Fatal("invalid-server-shutdown-timeout", errors.New("serverShutdownTimeout must be positive"))
+	}
+	if *serverListenBacklog < 0 {
+		logger.Fatal("invalid-server-listen-backlog", errors.New("serverListenBacklog must not be negative"))
+	}
+	connectionLimitPolicy, err := connlimit.ParsePolicy(*serverConnectionLimitPolicy)
+	if err != nil {
+		logger.Fatal("invalid-server-connection-limit-policy", err)
+	}
+	if *expirePendingTaskDuration < 0 {
+		logger.Fatal("invalid-expire-pending-task-duration", errors.New("expirePendingTaskDuration must not be negative"))
+	}
+	if *maxConvergencePause <= 0 {
+		logger.Fatal("invalid-max-convergence-pause", errors.New("maxConvergencePause must be positive"))
+	}
+	if *dbReadinessCheckInterval <= 0 {
+		logger.Fatal("invalid-db-readiness-check-interval", errors.New("dbReadinessCheckInterval must be positive"))
+	}
+	if *actualLRPPlacementHistoryLength < 0 || *actualLRPPlacementHistoryLength > models.MaximumPlacementHistoryLength {
+		logger.Fatal("invalid-actual-lrp-placement-history-length", fmt.Errorf("actualLRPPlacementHistoryLength must be between 0 and %d", models.MaximumPlacementHistoryLength))
+	}
+	if *orphanedActualLRPGracePeriod < 0 {
+		logger.Fatal("invalid-orphaned-actual-lrp-grace-period", errors.New("orphanedActualLRPGracePeriod must not be negative"))
+	}
+	if *etcdSchedulingInfoCacheTTL < 0 || *etcdSchedulingInfoCacheSize < 0 {
+		logger.Fatal("invalid-etcd-scheduling-info-cache", errors.New("etcdSchedulingInfoCacheTTL and etcdSchedulingInfoCacheSize must not be negative"))
+	}
+	if *taskCallbackDrainTimeout < 0 {
+		logger.Fatal("invalid-task-callback-drain-timeout", errors.New("taskCallbackDrainTimeout must not be negative"))
+	}
+	if err := format.MissingKeyPolicy(*missingEncryptionKeyPolicy).Validate(); err != nil {
+		logger.Fatal("invalid-missing-encryption-key-policy", err)
+	}
+	if *sqlConvergenceBatchSize < 0 {
+		logger.Fatal("invalid-sql-convergence-batch-size", errors.New("sqlConvergenceBatchSize must not be negative"))
+	}
 
-	cbWorkPool := taskworkpool.New(logger, *taskCallBackWorkers, taskworkpool.HandleCompletedTask)
+	cbWorkPool := taskworkpool.New(logger, *taskCallBackWorkers, *taskCallbackQueueSize, taskworkpool.HandleCompletedTask, taskworkpool.RetryPolicy{
+		MaxAttempts:       *taskCallbackRetryAttempts,
+		BaseDelay:         *taskCallbackRetryBaseDelay,
+		BackoffMultiplier: *taskCallbackRetryBackoffMultiplier,
+	}, *taskCallbackDrainTimeout)
 
-	var activeDB db.DB
+	var activeDB, bulkReadDB db.DB
 	var sqlDB *sqldb.SQLDB
-	var sqlConn *sql.DB
 	var storeClient etcddb.StoreClient
 	var etcdDB *etcddb.ETCDDB
 
@@ -286,7 +764,11 @@ func main() {
 	if err != nil {
 		logger.Fatal("cannot-setup-encryption", err)
 	}
-	cryptor := encryption.NewCryptor(keyManager, rand.Reader)
+	encryptionAlgorithm, err := encryptionFlags.Algorithm()
+	if err != nil {
+		logger.Fatal("cannot-setup-encryption", err)
+	}
+	cryptor := encryption.NewCryptorWithAlgorithm(keyManager, encryptionAlgorithm, rand.Reader)
 
 	etcdOptions, err := etcdFlags.Validate()
 	if err != nil {
@@ -294,35 +776,30 @@ func main() {
 	}
 
 	if etcdOptions.IsConfigured {
-		storeClient = initializeEtcdStoreClient(logger, etcdOptions)
-		etcdDB = initializeEtcdDB(logger, cryptor, storeClient, cbWorkPool, serviceClient, *desiredLRPCreationTimeout)
+		storeClient = initializeEtcdStoreClient(logger, etcdOptions, etcdclient.STRONG_CONSISTENCY)
+		bulkReadStoreClient := storeClient
+		if etcdOptions.BulkReadConsistency != etcdclient.STRONG_CONSISTENCY {
+			bulkReadStoreClient = initializeEtcdStoreClient(logger, etcdOptions, etcdOptions.BulkReadConsistency)
+		}
+		etcdDB = initializeEtcdDB(logger, cryptor, storeClient, bulkReadStoreClient, cbWorkPool, serviceClient, *desiredLRPCreationTimeout)
 		activeDB = etcdDB
+		bulkReadDB = etcdDB.BulkReadDB()
 	}
 
-	// If SQL database info is passed in, use SQL instead of ETCD
-	if *databaseDriver != "" && *databaseConnectionString != "" {
-		var err error
-		connectionString := appendSSLConnectionStringParam(logger, *databaseDriver, *databaseConnectionString, *sqlCACertFile)
-
-		sqlConn, err = sql.Open(*databaseDriver, connectionString)
-		if err != nil {
-			logger.Fatal("failed-to-open-sql", err)
-		}
-		defer sqlConn.Close()
-		sqlConn.SetMaxOpenConns(*maxDatabaseConnections)
-		sqlConn.SetMaxIdleConns(*maxDatabaseConnections)
-
-		err = sqlConn.Ping()
-		if err != nil {
-			logger.Fatal("sql-failed-to-connect", err)
-		}
-
-		sqlDB = sqldb.NewSQLDB(sqlConn, *convergenceWorkers, *updateWorkers, format.ENCRYPTED_PROTO, cryptor, guidprovider.DefaultGuidProvider, clock, *databaseDriver)
+	if sqlConn != nil {
+		sqlDB = sqldb.NewSQLDBWithOptions(sqlConn, *convergenceWorkers, *updateWorkers, *stuckEvacuationThreshold, tombstoneRetention(), format.ENCRYPTED_PROTO, cryptor, guidprovider.DefaultGuidProvider, clock, *databaseDriver, *sqlReadTimeout, *sqlWriteTimeout, *sqlTablePrefix, sqldb.SQLDBOptions{
+			PlacementHistoryLength: *actualLRPPlacementHistoryLength,
+			ClockSkewTolerance:     *clockSkewTolerance,
+			OrphanGracePeriod:      *orphanedActualLRPGracePeriod,
+			ConvergenceBatchSize:   *sqlConvergenceBatchSize,
+			MissingKeyPolicy:       format.MissingKeyPolicy(*missingEncryptionKeyPolicy),
+		})
 		err = sqlDB.CreateConfigurationsTable(logger)
 		if err != nil {
 			logger.Fatal("sql-failed-create-configurations-table", err)
 		}
 		activeDB = sqlDB
+		bulkReadDB = sqlDB
 	}
 
 	if activeDB == nil {
@@ -331,6 +808,7 @@ func main() {
 
 	encryptor := encryptor.New(logger, activeDB, keyManager, cryptor, clock)
 
+	managerDone := make(chan struct{})
 	migrationsDone := make(chan struct{})
 
 	migrationManager := migration.NewManager(
@@ -341,16 +819,51 @@ func main() {
 		sqlConn,
 		cryptor,
 		migrations.Migrations,
-		migrationsDone,
+		managerDone,
 		clock,
 		*databaseDriver,
+		*sqlTablePrefix,
 	)
 
-	desiredHub := events.NewHub()
-	actualHub := events.NewHub()
+	sqlMigrator := migration.NewSQLMigrator(
+		logger,
+		sqlConn,
+		*databaseDriver,
+		*sqlTablePrefix,
+		sqlmigrations.Migrations,
+		migrationsDone,
+		clock,
+	)
+
+	// A standby serving reads waits for the lock holder to finish any
+	// migrations; otherwise reads are only served once this BBS has run them.
+	var readsReady <-chan struct{} = migrationsDone
+	var versionWatcher ifrit.Runner
+	if *serveReadsWhileStandby {
+		versionReady := make(chan struct{})
+		readsReady = versionReady
+		versionWatcher = migration.NewVersionWatcher(migrationManager, versionReady, *lockRetryInterval)
+	}
+
+	resyncPolicy := events.ContinueWithoutReplay
+	if *eventStreamRequireResync {
+		resyncPolicy = events.RequireResync
+	}
+	desiredHub := events.NewReplayingHub(*eventReplayBufferSize, resyncPolicy)
+	actualHub := events.NewReplayingHub(*eventReplayBufferSize, resyncPolicy)
+	taskHub := events.NewReplayingHub(*eventReplayBufferSize, resyncPolicy)
+	if *eventCoalescingWindow > 0 {
+		desiredHub = events.NewCoalescingHub(desiredHub, clock, *eventCoalescingWindow)
+		actualHub = events.NewCoalescingHub(actualHub, clock, *eventCoalescingWindow)
+	}
 
 	repClientFactory := rep.NewClientFactory(cfhttp.NewClient(), cfhttp.NewClient())
-	auctioneerClient := initializeAuctioneerClient(logger)
+	auctioneerClient := initializeAuctioneerClient(logger, clock)
+	var auctionBuffer *auctioneerclient.BufferedClient
+	if *auctioneerRequestBufferSize > 0 {
+		auctionBuffer = auctioneerclient.NewBufferedClient(logger, auctioneerClient, clock, *auctioneerRequestBufferSize, *auctioneerRetryInterval, *auctioneerMaxRetryInterval)
+		auctioneerClient = auctionBuffer
+	}
 
 	exitChan := make(chan struct{})
 
@@ -365,32 +878,121 @@ func main() {
 		accessLogger.RegisterSink(lager.NewWriterSink(file, lager.INFO))
 	}
 
+	auditSink := audit.NewLagerSink(logger)
+	if *auditLogPath != "" {
+		file, err := os.OpenFile(*auditLogPath, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+		if err != nil {
+			logger.Error("invalid-audit-log-path", err, lager.Data{"audit-log-path": *auditLogPath})
+			os.Exit(1)
+		}
+		auditSink = audit.NewWriterSink(logger, file)
+	}
+
+	var policy *authorization.Policy
+	if *authorizationPolicyFile != "" {
+		policy, err = authorization.LoadPolicy(*authorizationPolicyFile)
+		if err != nil {
+			logger.Fatal("invalid-authorization-policy", err, lager.Data{"authorization-policy-file": *authorizationPolicyFile})
+		}
+		logger.Info("loaded-authorization-policy", lager.Data{"rules": len(policy.Rules)})
+	}
+
+	var limiter *ratelimit.Limiter
+	if *rateLimitConfigFile != "" {
+		rateLimitConfig, err := ratelimit.LoadConfig(*rateLimitConfigFile)
+		if err != nil {
+			logger.Fatal("invalid-rate-limit-config", err, lager.Data{"rate-limit-config-file": *rateLimitConfigFile})
+		}
+		limiter = ratelimit.NewLimiter(clock, rateLimitConfig)
+		logger.Info("loaded-rate-limit-config", lager.Data{"classes": rateLimitConfig.Classes})
+	}
+
+	order := convergenceOrder()
+	if err := order.Validate(); err != nil {
+		logger.Fatal("invalid-convergence-order", err)
+	}
+
+	retirer := controllers.NewActualLRPRetirer(activeDB, actualHub, repClientFactory, serviceClient)
+	convergenceStatus := controllers.NewConvergenceStatusTracker(clock)
+	convergencePause := controllers.NewConvergencePause(clock, *maxConvergencePause)
+	lrpConvergenceController := controllers.NewLRPConvergenceController(logger, activeDB, actualHub, auctioneerClient, serviceClient, retirer, *convergenceWorkers, convergenceStatus, order)
+
 	handler := handlers.New(
 		logger,
 		accessLogger,
 		*updateWorkers,
 		*convergenceWorkers,
 		activeDB,
+		bulkReadDB,
 		desiredHub,
 		actualHub,
+		taskHub,
 		cbWorkPool,
 		serviceClient,
 		auctioneerClient,
 		repClientFactory,
+		lrpConvergenceController,
+		convergenceStatus,
+		convergencePause,
 		migrationsDone,
+		readsReady,
+		models.ResourceRequestLimits{MaxMemoryMb: int32(*maxMemoryMb), MaxDiskMb: int32(*maxDiskMb), MaxInstances: int32(*maxDesiredInstances)},
+		*maxRequestBodySize,
+		*maxEventSubscribers,
+		*maxTaskRejections,
+		*orphanedActualLRPGracePeriod,
+		maintainer,
+		bbsPresence.ID,
+		auditSink,
+		policy,
+		limiter,
 		exitChan,
 	)
 
+	// A nil *sql.DB must not become a non-nil DBStatsSource.
+	var dbStats metrics.DBStatsSource
+	if sqlConn != nil {
+		dbStats = sqlConn
+	}
+
 	metricsNotifier := metrics.NewPeriodicMetronNotifier(
 		logger,
 		*reportInterval,
 		etcdOptions,
 		clock,
+		activeDB,
+		metrics.DomainMetricsConfig{
+			MaxDomains:   *maxReportedDomains,
+			MinInstances: *minReportedDomainInstances,
+		},
+		dbStats,
+		cbWorkPool,
+		serviceClient,
+		map[string]metrics.EventHubStatsSource{
+			"DesiredLRP": desiredHub,
+			"ActualLRP":  actualHub,
+			"Task":       taskHub,
+		},
+		storeClient,
 	)
 
-	retirer := controllers.NewActualLRPRetirer(activeDB, actualHub, repClientFactory, serviceClient)
-	lrpConvergenceController := controllers.NewLRPConvergenceController(logger, activeDB, actualHub, auctioneerClient, serviceClient, retirer, *convergenceWorkers)
-	taskController := controllers.NewTaskController(activeDB, cbWorkPool, auctioneerClient, serviceClient, repClientFactory)
+	taskController := controllers.NewTaskController(activeDB, activeDB, cbWorkPool, auctioneerClient, serviceClient, repClientFactory, taskHub, convergenceStatus, *maxTaskRejections)
+
+	if *convergeJitter < 0 || *convergeJitter > converger.MaximumConvergeJitter {
+		logger.Fatal("invalid-converge-jitter", fmt.Errorf("convergeJitter must be between 0 and %g", converger.MaximumConvergeJitter))
+	}
+
+	lrpConvergeInterval := *convergeRepeatInterval
+	if *convergeLRPsInterval > 0 {
+		lrpConvergeInterval = *convergeLRPsInterval
+	}
+	taskConvergeInterval := *convergeRepeatInterval
+	if *convergeTasksInterval > 0 {
+		taskConvergeInterval = *convergeTasksInterval
+	}
+	if lrpConvergeInterval < converger.MinimumConvergeInterval || taskConvergeInterval < converger.MinimumConvergeInterval {
+		logger.Info("converge-interval-raised-to-minimum", lager.Data{"minimum": converger.MinimumConvergeInterval.String()})
+	}
 
 	convergerProcess := converger.New(
 		logger,
@@ -398,35 +1000,126 @@ func main() {
 		lrpConvergenceController,
 		taskController,
 		serviceClient,
-		*convergeRepeatInterval,
+		maintainer,
+		convergencePause,
+		lrpConvergeInterval,
+		taskConvergeInterval,
+		*convergeJitter,
 		*kickTaskDuration,
 		*expirePendingTaskDuration,
-		*expireCompletedTaskDuration)
+		*expireCompletedTaskDuration,
+		*maxTaskRejections)
+
+	serverConnectionLimits := connlimit.Config{
+		MaxConnections:  *maxServerConnections,
+		Policy:          connectionLimitPolicy,
+		Backlog:         *serverListenBacklog,
+		ShutdownTimeout: *serverShutdownTimeout,
+	}
 
 	var server ifrit.Runner
+	var grpcServerOptions []grpc.ServerOption
 	if *requireSSL {
 		tlsConfig, err := cfhttp.NewTLSConfig(*certFile, *keyFile, *caFile)
 		if err != nil {
 			logger.Fatal("tls-configuration-failed", err)
 		}
-		server = http_server.NewTLSServer(*listenAddress, handler, tlsConfig)
+		// Serve the certificate through a reloader, so that rotating the
+		// files on disk does not require a restart.
+		certReloader, err := certreloader.New(logger, *certFile, *keyFile)
+		if err != nil {
+			logger.Fatal("tls-configuration-failed", err)
+		}
+		tlsConfig.Certificates = nil
+		tlsConfig.GetCertificate = certReloader.GetCertificate
+		server = connlimit.NewServer(logger, *listenAddress, handler, tlsConfig, serverConnectionLimits)
+		grpcServerOptions = append(grpcServerOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	} else {
-		server = http_server.New(*listenAddress, handler)
+		server = connlimit.NewServer(logger, *listenAddress, handler, nil, serverConnectionLimits)
+	}
+
+	var grpcServer ifrit.Runner
+	if *grpcListenAddress != "" {
+		grpcHandler := handlers.NewGRPCHandler(logger, activeDB, desiredHub, actualHub, taskController, *maxEventSubscribers, exitChan)
+		grpcServer = grpcServerRunner(logger, *grpcListenAddress, handlers.NewGRPCServer(logger, grpcHandler, readsReady, maintainer, policy, grpcServerOptions...))
 	}
 
-	healthcheckServer := http_server.New(*healthAddress, http.HandlerFunc(healthCheckHandler))
+	readinessTracker := readiness.NewTracker(logger)
+
+	healthcheckMux := http.NewServeMux()
+	healthcheckMux.HandleFunc("/", healthCheckHandler)
+	healthcheckMux.Handle("/ready", readinessTracker)
+	healthcheckServer := http_server.New(*healthAddress, healthcheckMux)
 
 	members := grouper.Members{
 		{"healthcheck", healthcheckServer},
-		{"lock-maintainer", maintainer},
-		{"workpool", cbWorkPool},
-		{"server", server},
+	}
+
+	if sqlConn != nil {
+		members = append(members, grouper.Member{"db-checker", readiness.NewDBChecker(logger, readinessTracker, sqlConn, clock, *dbReadinessCheckInterval)})
+	}
+
+	// A standby serving reads is ready once it can serve them: the lock, the
+	// migrations it only runs once it holds the lock, and the gRPC server it
+	// only starts then are left out of its readiness.
+	var lockMember, migratorMember, grpcMember ifrit.Runner = maintainer, sqlMigrator, grpcServer
+	serverMember := readinessTracker.Runner("server", "starting", server)
+
+	if *serveReadsWhileStandby {
+		readinessTracker.Register("reads", "waiting for the database version")
+		go func() {
+			<-readsReady
+			readinessTracker.SetReady("reads")
+		}()
+
+		// The version watcher is ready as soon as it starts, so the lock
+		// maintainer is not held back by migrations that only run once some
+		// BBS holds the lock. Only the read routes wait for the version.
+		members = append(members, grouper.Members{
+			{"workpool", cbWorkPool},
+			{"server", serverMember},
+			{"version-watcher", versionWatcher},
+			{"read-presence", initializeReadPresence(logger, serviceClient, bbsPresence)},
+			{"lock-maintainer", lockMember},
+		}...)
+	} else {
+		lockMember = readinessTracker.Runner("lock", "waiting for lock", maintainer)
+		migratorMember = readinessTracker.Runner("migrations", "migrating", sqlMigrator)
+		if grpcServer != nil {
+			grpcMember = readinessTracker.Runner("grpc-server", "starting", grpcServer)
+		}
+
+		members = append(members, grouper.Members{
+			{"lock-maintainer", lockMember},
+			{"workpool", cbWorkPool},
+			{"server", serverMember},
+		}...)
+	}
+
+	if grpcServer != nil {
+		members = append(members, grouper.Member{"grpc-server", grpcMember})
+	}
+
+	members = append(members, grouper.Members{
 		{"migration-manager", migrationManager},
+		{"sql-migrator", migratorMember},
 		{"encryptor", encryptor},
-		{"hub-maintainer", hubMaintainer(logger, desiredHub, actualHub)},
+		{"hub-maintainer", hubMaintainer(logger, desiredHub, actualHub, taskHub)},
 		{"metrics", *metricsNotifier},
 		{"converger", convergerProcess},
-		{"registration-runner", registrationRunner},
+	}...)
+
+	if auctionBuffer != nil {
+		members = append(members, grouper.Member{"auction-buffer", auctionBuffer})
+	}
+
+	if registrationRunner != nil {
+		members = append(members, grouper.Member{"registration-runner", registrationRunner})
+	}
+
+	if *domainConvergeInterval > 0 {
+		domainConvergerProcess := converger.NewDomainConverger(logger, clock, lrpConvergenceController, activeDB, maintainer, convergencePause, *domainConvergeInterval)
+		members = append(members, grouper.Member{"domain-converger", domainConvergerProcess})
 	}
 
 	if dbgAddr := debugserver.DebugAddress(flag.CommandLine); dbgAddr != "" {
@@ -496,7 +1189,7 @@ func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
-func hubMaintainer(logger lager.Logger, desiredHub, actualHub events.Hub) ifrit.RunFunc {
+func hubMaintainer(logger lager.Logger, desiredHub, actualHub, taskHub events.Hub) ifrit.RunFunc {
 	return func(signals <-chan os.Signal, ready chan<- struct{}) error {
 		logger := logger.Session("hub-maintainer")
 		close(ready)
@@ -512,10 +1205,46 @@ func hubMaintainer(logger lager.Logger, desiredHub, actualHub events.Hub) ifrit.
 		if err != nil {
 			logger.Error("error-closing-actual-hub", err)
 		}
+		err = taskHub.Close()
+		if err != nil {
+			logger.Error("error-closing-task-hub", err)
+		}
 		return nil
 	}
 }
 
+// grpcServerRunner serves the gRPC server until signalled. It stops the
+// server without waiting for RPCs to finish, as event subscriptions never do.
+func grpcServerRunner(logger lager.Logger, listenAddress string, server *grpc.Server) ifrit.RunFunc {
+	return func(signals <-chan os.Signal, ready chan<- struct{}) error {
+		logger := logger.Session("grpc-server")
+
+		listener, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			logger.Error("failed-to-listen", err, lager.Data{"address": listenAddress})
+			return err
+		}
+
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- server.Serve(listener)
+		}()
+
+		close(ready)
+		logger.Info("started", lager.Data{"address": listenAddress})
+		defer logger.Info("finished")
+
+		select {
+		case <-signals:
+			server.Stop()
+			return nil
+		case err := <-errChan:
+			logger.Error("failed-serving", err)
+			return err
+		}
+	}
+}
+
 func initializeRegistrationRunner(
 	logger lager.Logger,
 	consulClient consuladapter.Client,
@@ -531,7 +1260,28 @@ func initializeRegistrationRunner(
 	return locket.NewRegistrationRunner(logger, registration, consulClient, locket.RetryInterval, clock)
 }
 
-func initializeLockMaintainer(logger lager.Logger, serviceClient bbs.ServiceClient) ifrit.Runner {
+func initializeSQLConn(logger lager.Logger) *sql.DB {
+	connectionString := appendSSLConnectionStringParam(logger, *databaseDriver, *databaseConnectionString, *sqlCACertFile)
+
+	// the connection string holds the database credentials
+	logData := lager.Data{"connection_string": sqldb.RedactConnectionString(*databaseDriver, connectionString)}
+
+	sqlConn, err := sql.Open(*databaseDriver, connectionString)
+	if err != nil {
+		logger.Fatal("failed-to-open-sql", sqldb.RedactConnectionStringError(*databaseDriver, connectionString, err), logData)
+	}
+	sqlConn.SetMaxOpenConns(*maxDatabaseConnections)
+	sqlConn.SetMaxIdleConns(*maxDatabaseConnections)
+
+	err = sqlConn.Ping()
+	if err != nil {
+		logger.Fatal("sql-failed-to-connect", sqldb.RedactConnectionStringError(*databaseDriver, connectionString, err), logData)
+	}
+
+	return sqlConn
+}
+
+func initializeBBSPresence(logger lager.Logger) models.BBSPresence {
 	uuid, err := uuid.NewV4()
 	if err != nil {
 		logger.Fatal("Couldn't generate uuid", err)
@@ -541,50 +1291,116 @@ func initializeLockMaintainer(logger lager.Logger, serviceClient bbs.ServiceClie
 		logger.Fatal("Advertise URL must be specified", nil)
 	}
 
-	bbsPresence := models.NewBBSPresence(uuid.String(), *advertiseURL)
+	return models.NewBBSPresence(uuid.String(), *advertiseURL)
+}
+
+func initializeLockMaintainer(logger lager.Logger, serviceClient bbs.ServiceClient, bbsPresence models.BBSPresence) ifrit.Runner {
 	lockMaintainer, err := serviceClient.NewBBSLockRunner(logger, &bbsPresence, *lockRetryInterval, *lockTTL)
 	if err != nil {
 		logger.Fatal("Couldn't create lock maintainer", err)
 	}
 
-	return lockMaintainer
+	return metrics.NewLockHeldMetronNotifier(logger, lockMaintainer, bbsPresence.ID, *lockTTL, *lockRetryInterval)
 }
 
-func initializeAuctioneerClient(logger lager.Logger) auctioneer.Client {
-	if *auctioneerAddress == "" {
-		logger.Fatal("auctioneer-address-validation-failed", errors.New("auctioneerAddress is required"))
+func initializeReadPresence(logger lager.Logger, serviceClient bbs.ServiceClient, bbsPresence models.BBSPresence) ifrit.Runner {
+	readPresence, err := serviceClient.NewBBSReadPresenceRunner(logger, &bbsPresence, *lockRetryInterval, *lockTTL)
+	if err != nil {
+		logger.Fatal("Couldn't create read presence", err)
 	}
-	return auctioneer.NewClient(*auctioneerAddress)
+
+	return readPresence
+}
+
+func initializeAuctioneerClient(logger lager.Logger, clock clock.Clock) auctioneerclient.Client {
+	if *discardAuctionRequests {
+		logger.Info("discarding-auction-requests")
+		return auctioneerclient.NewDiscardingClient(logger)
+	}
+
+	var client auctioneerclient.Client = auctioneer.NewClient(*auctioneerAddress)
+	if *auctioneerCircuitBreakerFailureThreshold > 0 {
+		client = auctioneerclient.NewCircuitBreakerClient(logger, client, clock, *auctioneerCircuitBreakerFailureThreshold, *auctioneerCircuitBreakerOpenTimeout)
+	}
+	return client
 }
 
-func initializeDropsonde(logger lager.Logger) {
+// initializeDropsonde also lets the format package tag the DecryptionFailures
+// counter with the key label, through the same emitter.
+func initializeDropsonde(logger lager.Logger, tags map[string]string) {
 	dropsondeDestination := fmt.Sprint("localhost:", *dropsondePort)
-	err := dropsonde.Initialize(dropsondeDestination, dropsondeOrigin)
+	udpEmitter, err := emitter.NewUdpEmitter(dropsondeDestination)
 	if err != nil {
 		logger.Error("failed-to-initialize-dropsonde", err)
+		return
+	}
+
+	taggedEmitter := metrics.NewTaggedEventEmitter(udpEmitter, dropsondeOrigin, tags)
+	dropsonde.InitializeWithEmitter(taggedEmitter)
+	format.SetTaggedEmitter(taggedEmitter)
+}
+
+// tombstoneRetention is how long the databases keep the tombstones of
+// removed DesiredLRPs, where 0 removes them without one.
+func tombstoneRetention() time.Duration {
+	if !*softDeleteDesiredLRPs {
+		return 0
 	}
+	return *desiredLRPTombstoneRetention
+}
+
+func convergenceOrder() controllers.ConvergenceOrder {
+	order := controllers.ConvergenceOrder{
+		PriorityDomains: splitList(*convergencePriorityDomains),
+		Steps:           splitList(*convergenceStepOrder),
+	}
+	if len(order.Steps) == 0 {
+		order.Steps = controllers.DefaultConvergenceOrder.Steps
+	}
+	return order
+}
+
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
 
 func initializeEtcdDB(
 	logger lager.Logger,
 	cryptor encryption.Cryptor,
 	storeClient etcddb.StoreClient,
+	bulkReadStoreClient etcddb.StoreClient,
 	cbClient taskworkpool.TaskCompletionClient,
 	serviceClient bbs.ServiceClient,
 	desiredLRPCreationMaxTime time.Duration,
 ) *etcddb.ETCDDB {
-	return etcddb.NewETCD(
+	return etcddb.NewETCDWithOptions(
 		format.ENCRYPTED_PROTO,
 		*convergenceWorkers,
 		*updateWorkers,
 		desiredLRPCreationMaxTime,
+		*stuckEvacuationThreshold,
+		tombstoneRetention(),
 		cryptor,
 		storeClient,
+		bulkReadStoreClient,
 		clock.NewClock(),
+		etcddb.ETCDDBOptions{
+			PlacementHistoryLength:  *actualLRPPlacementHistoryLength,
+			SchedulingInfoCacheTTL:  *etcdSchedulingInfoCacheTTL,
+			SchedulingInfoCacheSize: *etcdSchedulingInfoCacheSize,
+			OrphanGracePeriod:       *orphanedActualLRPGracePeriod,
+			MissingKeyPolicy:        format.MissingKeyPolicy(*missingEncryptionKeyPolicy),
+		},
 	)
 }
 
-func initializeEtcdStoreClient(logger lager.Logger, etcdOptions *etcddb.ETCDOptions) etcddb.StoreClient {
+func initializeEtcdStoreClient(logger lager.Logger, etcdOptions *etcddb.ETCDOptions, consistency string) etcddb.StoreClient {
 	var etcdClient *etcdclient.Client
 	var tr *http.Transport
 
@@ -619,7 +1435,7 @@ func initializeEtcdStoreClient(logger lager.Logger, etcdOptions *etcddb.ETCDOpti
 	} else {
 		etcdClient = etcdclient.NewClient(etcdOptions.ClusterUrls)
 	}
-	etcdClient.SetConsistency(etcdclient.STRONG_CONSISTENCY)
+	etcdClient.SetConsistency(consistency)
 
 	return etcddb.NewStoreClient(etcdClient)
 }
@@ -0,0 +1,52 @@
+//go:build linux
+// +build linux
+
+package connlimit
+
+import (
+	"net"
+	"os"
+	"syscall"
+)
+
+// Listen listens on the TCP address with an accept backlog of backlog
+// connections, or the system default when backlog is 0. The backlog is still
+// capped by net.core.somaxconn.
+//
+// The socket is created by net.Listen, so an address without a host, e.g.
+// ":8889", listens on every IPv4 and IPv6 address as it does without a
+// backlog. Linux resizes the backlog of a socket that is listened on again.
+func Listen(address string, backlog int) (net.Listener, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil || backlog == 0 {
+		return listener, err
+	}
+
+	err = setBacklog(listener.(*net.TCPListener), backlog)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+func setBacklog(listener *net.TCPListener, backlog int) error {
+	rawConn, err := listener.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	var listenErr error
+	err = rawConn.Control(func(fd uintptr) {
+		listenErr = syscall.Listen(int(fd), backlog)
+	})
+	if err != nil {
+		return err
+	}
+	if listenErr != nil {
+		return os.NewSyscallError("listen", listenErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+//go:build !linux
+// +build !linux
+
+package connlimit
+
+import (
+	"errors"
+	"net"
+)
+
+// Listen listens on the TCP address. Setting the accept backlog is only
+// supported on Linux; elsewhere a backlog other than 0 fails.
+func Listen(address string, backlog int) (net.Listener, error) {
+	if backlog != 0 {
+		return nil, errors.New("connlimit: setting the accept backlog is only supported on linux")
+	}
+	return net.Listen("tcp", address)
+}
@@ -0,0 +1,155 @@
+package connlimit
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+)
+
+const (
+	// The connections currently open on the server, sent each time one is
+	// accepted or closed.
+	currentServerConnections = metric.Metric("CurrentServerConnections")
+	// Counts the connections closed on accept because the server was at its
+	// connection limit, with the reject policy.
+	rejectedServerConnections = metric.Counter("RejectedServerConnections")
+)
+
+// Policy decides what happens to a new connection while the server is at its
+// connection limit.
+type Policy string
+
+const (
+	// PolicyWait stops accepting until a connection closes, leaving the new
+	// connections queued in the accept backlog of the socket.
+	PolicyWait Policy = "wait"
+	// PolicyReject accepts the new connections and closes them right away.
+	PolicyReject Policy = "reject"
+)
+
+func ParsePolicy(policy string) (Policy, error) {
+	switch Policy(policy) {
+	case PolicyWait, PolicyReject:
+		return Policy(policy), nil
+	default:
+		return "", fmt.Errorf("unknown connection limit policy %q, must be %q or %q", policy, PolicyWait, PolicyReject)
+	}
+}
+
+// Listener wraps another net.Listener, keeping at most maxConnections of the
+// connections it accepted open at a time. A slot is released when the
+// connection is closed, by either side. The connections are counted, and the
+// CurrentServerConnections metric sent, even when they are unlimited.
+type Listener struct {
+	net.Listener
+	logger         lager.Logger
+	maxConnections int
+	policy         Policy
+
+	slots     chan struct{}
+	current   int64
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewListener limits the listener to maxConnections open connections, or
+// only counts them when maxConnections is 0.
+func NewListener(logger lager.Logger, listener net.Listener, maxConnections int, policy Policy) *Listener {
+	return &Listener{
+		Listener:       listener,
+		logger:         logger.Session("connection-limit", lager.Data{"max_connections": maxConnections, "policy": policy}),
+		maxConnections: maxConnections,
+		policy:         policy,
+		slots:          make(chan struct{}, maxConnections),
+		closed:         make(chan struct{}),
+	}
+}
+
+func (l *Listener) limited() bool {
+	return l.maxConnections > 0
+}
+
+func (l *Listener) Accept() (net.Conn, error) {
+	if l.limited() && l.policy == PolicyWait {
+		select {
+		case l.slots <- struct{}{}:
+		case <-l.closed:
+			return nil, errors.New("connlimit: use of closed listener")
+		}
+	}
+
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			if l.limited() && l.policy == PolicyWait {
+				<-l.slots
+			}
+			return nil, err
+		}
+
+		if l.limited() && l.policy == PolicyReject {
+			select {
+			case l.slots <- struct{}{}:
+			default:
+				l.reject(conn)
+				continue
+			}
+		}
+
+		l.changeCurrent(1)
+		return &limitedConn{Conn: conn, listener: l}, nil
+	}
+}
+
+func (l *Listener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return l.Listener.Close()
+}
+
+// CurrentConnections returns the number of accepted connections still open.
+func (l *Listener) CurrentConnections() int {
+	return int(atomic.LoadInt64(&l.current))
+}
+
+func (l *Listener) reject(conn net.Conn) {
+	l.logger.Info("rejected-connection", lager.Data{"remote_addr": conn.RemoteAddr().String()})
+	conn.Close()
+
+	err := rejectedServerConnections.Increment()
+	if err != nil {
+		l.logger.Error("failed-to-send-rejected-server-connections-metric", err)
+	}
+}
+
+func (l *Listener) release() {
+	l.changeCurrent(-1)
+	if l.limited() {
+		<-l.slots
+	}
+}
+
+func (l *Listener) changeCurrent(delta int64) {
+	current := atomic.AddInt64(&l.current, delta)
+
+	err := currentServerConnections.Send(int(current))
+	if err != nil {
+		l.logger.Error("failed-to-send-current-server-connections-metric", err)
+	}
+}
+
+type limitedConn struct {
+	net.Conn
+	listener    *Listener
+	releaseOnce sync.Once
+}
+
+func (c *limitedConn) Close() error {
+	err := c.Conn.Close()
+	c.releaseOnce.Do(c.listener.release)
+	return err
+}
@@ -0,0 +1,202 @@
+package connlimit_test
+
+import (
+	"io"
+	"net"
+	"time"
+
+	"code.cloudfoundry.org/bbs/connlimit"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Listener", func() {
+	var (
+		inner    net.Listener
+		listener *connlimit.Listener
+		sender   *fake.FakeMetricSender
+		policy   connlimit.Policy
+		accepted chan net.Conn
+	)
+
+	BeforeEach(func() {
+		sender = fake.NewFakeMetricSender()
+		dropsonde_metrics.Initialize(sender, nil)
+
+		var err error
+		inner, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		accepted = make(chan net.Conn, 1)
+	})
+
+	JustBeforeEach(func() {
+		listener = connlimit.NewListener(lagertest.NewTestLogger("test"), inner, 1, policy)
+	})
+
+	AfterEach(func() {
+		listener.Close()
+	})
+
+	dial := func() net.Conn {
+		conn, err := net.Dial("tcp", inner.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		return conn
+	}
+
+	acceptInBackground := func() {
+		go func() {
+			defer GinkgoRecover()
+			conn, err := listener.Accept()
+			if err == nil {
+				accepted <- conn
+			}
+		}()
+	}
+
+	Context("with the wait policy", func() {
+		BeforeEach(func() {
+			policy = connlimit.PolicyWait
+		})
+
+		It("does not accept another connection until one closes", func() {
+			dial()
+			acceptInBackground()
+			var first net.Conn
+			Eventually(accepted).Should(Receive(&first))
+			Expect(listener.CurrentConnections()).To(Equal(1))
+			Expect(sender.GetValue("CurrentServerConnections").Value).To(BeEquivalentTo(1))
+
+			dial()
+			acceptInBackground()
+			Consistently(accepted, 200*time.Millisecond).ShouldNot(Receive())
+
+			Expect(first.Close()).To(Succeed())
+			Eventually(accepted).Should(Receive())
+			Expect(listener.CurrentConnections()).To(Equal(1))
+		})
+
+		It("releases a connection's slot only once", func() {
+			dial()
+			conn, err := listener.Accept()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(conn.Close()).To(Succeed())
+			conn.Close()
+			Expect(listener.CurrentConnections()).To(Equal(0))
+			Expect(sender.GetValue("CurrentServerConnections").Value).To(BeEquivalentTo(0))
+		})
+
+		It("stops waiting when the listener is closed", func() {
+			dial()
+			_, err := listener.Accept()
+			Expect(err).NotTo(HaveOccurred())
+
+			errs := make(chan error, 1)
+			go func() {
+				_, err := listener.Accept()
+				errs <- err
+			}()
+			Consistently(errs, 100*time.Millisecond).ShouldNot(Receive())
+
+			Expect(listener.Close()).To(Succeed())
+			Eventually(errs).Should(Receive(HaveOccurred()))
+		})
+	})
+
+	Context("without a limit", func() {
+		JustBeforeEach(func() {
+			listener = connlimit.NewListener(lagertest.NewTestLogger("test"), inner, 0, connlimit.PolicyWait)
+		})
+
+		It("accepts every connection and still counts them", func() {
+			dial()
+			first, err := listener.Accept()
+			Expect(err).NotTo(HaveOccurred())
+			dial()
+			_, err = listener.Accept()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listener.CurrentConnections()).To(Equal(2))
+			Expect(sender.GetValue("CurrentServerConnections").Value).To(BeEquivalentTo(2))
+
+			Expect(first.Close()).To(Succeed())
+			Expect(listener.CurrentConnections()).To(Equal(1))
+			Expect(sender.GetValue("CurrentServerConnections").Value).To(BeEquivalentTo(1))
+		})
+	})
+
+	Context("with the reject policy", func() {
+		BeforeEach(func() {
+			policy = connlimit.PolicyReject
+		})
+
+		It("closes the connections over the limit", func() {
+			dial()
+			acceptInBackground()
+			var first net.Conn
+			Eventually(accepted).Should(Receive(&first))
+
+			rejected := dial()
+			acceptInBackground()
+			rejected.SetReadDeadline(time.Now().Add(5 * time.Second))
+			_, err := rejected.Read(make([]byte, 1))
+			Expect(err).To(Equal(io.EOF))
+			Expect(sender.GetCounter("RejectedServerConnections")).To(BeEquivalentTo(1))
+			Consistently(accepted, 100*time.Millisecond).ShouldNot(Receive())
+
+			Expect(first.Close()).To(Succeed())
+			dial()
+			Eventually(accepted).Should(Receive())
+			Expect(listener.CurrentConnections()).To(Equal(1))
+		})
+	})
+})
+
+var _ = Describe("ParsePolicy", func() {
+	It("parses the known policies", func() {
+		Expect(connlimit.ParsePolicy("wait")).To(Equal(connlimit.PolicyWait))
+		Expect(connlimit.ParsePolicy("reject")).To(Equal(connlimit.PolicyReject))
+	})
+
+	It("fails on an unknown policy", func() {
+		_, err := connlimit.ParsePolicy("drop")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Listen", func() {
+	It("listens with the given accept backlog", func() {
+		listener, err := connlimit.Listen("127.0.0.1:0", 16)
+		Expect(err).NotTo(HaveOccurred())
+		defer listener.Close()
+
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+
+		accepted, err := listener.Accept()
+		Expect(err).NotTo(HaveOccurred())
+		accepted.Close()
+	})
+
+	It("listens on IPv6 too when the address has no host", func() {
+		probe, err := net.Listen("tcp", "[::1]:0")
+		if err != nil {
+			Skip("IPv6 is not available")
+		}
+		probe.Close()
+
+		listener, err := connlimit.Listen(":0", 16)
+		Expect(err).NotTo(HaveOccurred())
+		defer listener.Close()
+
+		_, port, err := net.SplitHostPort(listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		conn, err := net.Dial("tcp", net.JoinHostPort("::1", port))
+		Expect(err).NotTo(HaveOccurred())
+		conn.Close()
+	})
+})
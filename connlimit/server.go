@@ -0,0 +1,110 @@
+package connlimit
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// DefaultShutdownTimeout is how long a Server waits for its active
+// connections to finish when its Config does not set a ShutdownTimeout.
+const DefaultShutdownTimeout = 10 * time.Second
+
+// Config limits the connections of a server. A MaxConnections of 0 leaves
+// them unlimited, a Backlog of 0 keeps the system default, and a
+// ShutdownTimeout of 0 waits for DefaultShutdownTimeout.
+type Config struct {
+	MaxConnections  int
+	Policy          Policy
+	Backlog         int
+	ShutdownTimeout time.Duration
+}
+
+// Server is an ifrit runner serving HTTP, or HTTPS with a TLS config, on a
+// listener limited by its Config. The connections are counted before the TLS
+// handshake, so that clients that never finish it still hold a slot.
+//
+// When signalled, it stops accepting, closes the idle connections and waits
+// up to its ShutdownTimeout for the active ones to finish before closing them
+// too.
+type Server struct {
+	logger    lager.Logger
+	address   string
+	handler   http.Handler
+	tlsConfig *tls.Config
+	config    Config
+}
+
+func NewServer(logger lager.Logger, address string, handler http.Handler, tlsConfig *tls.Config, config Config) *Server {
+	return &Server{
+		logger:    logger.Session("http-server", lager.Data{"address": address}),
+		address:   address,
+		handler:   handler,
+		tlsConfig: tlsConfig,
+		config:    config,
+	}
+}
+
+func (s *Server) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	listener, err := s.listen()
+	if err != nil {
+		s.logger.Error("failed-to-listen", err)
+		return err
+	}
+
+	server := &http.Server{Handler: s.handler, TLSConfig: s.tlsConfig}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.Serve(listener)
+	}()
+
+	s.logger.Info("started", lager.Data{"max_connections": s.config.MaxConnections, "policy": s.config.Policy, "backlog": s.config.Backlog})
+	close(ready)
+
+	select {
+	case err := <-errChan:
+		s.logger.Error("failed-serving", err)
+		return err
+	case <-signals:
+		s.logger.Info("stopping")
+		return s.shutdown(server)
+	}
+}
+
+func (s *Server) shutdown(server *http.Server) error {
+	timeout := s.config.ShutdownTimeout
+	if timeout == 0 {
+		timeout = DefaultShutdownTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := server.Shutdown(ctx)
+	if err == context.DeadlineExceeded {
+		s.logger.Info("closing-active-connections", lager.Data{"shutdown_timeout": timeout.String()})
+		return server.Close()
+	}
+	return err
+}
+
+func (s *Server) listen() (net.Listener, error) {
+	listener, err := Listen(s.address, s.config.Backlog)
+	if err != nil {
+		return nil, err
+	}
+
+	listener = NewListener(s.logger, listener, s.config.MaxConnections, s.config.Policy)
+
+	if s.tlsConfig != nil {
+		listener = tls.NewListener(listener, s.tlsConfig)
+	}
+
+	return listener, nil
+}
@@ -0,0 +1,100 @@
+package connlimit_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/connlimit"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/ginkgomon"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		address string
+		process ifrit.Process
+		client  *http.Client
+		block   chan struct{}
+	)
+
+	BeforeEach(func() {
+		address = fmt.Sprintf("127.0.0.1:%d", 7100+GinkgoParallelNode())
+		block = make(chan struct{})
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/block" {
+				<-block
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+		server := connlimit.NewServer(lagertest.NewTestLogger("test"), address, handler, nil, connlimit.Config{
+			MaxConnections:  1,
+			Policy:          connlimit.PolicyReject,
+			Backlog:         8,
+			ShutdownTimeout: 100 * time.Millisecond,
+		})
+		process = ginkgomon.Invoke(server)
+
+		client = &http.Client{
+			Timeout:   5 * time.Second,
+			Transport: &http.Transport{DisableKeepAlives: true},
+		}
+	})
+
+	AfterEach(func() {
+		close(block)
+		ginkgomon.Interrupt(process)
+	})
+
+	It("serves the handler", func() {
+		resp, err := client.Get("http://" + address)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+	})
+
+	It("enforces the connection limit", func() {
+		held, err := net.Dial("tcp", address)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func() error {
+			resp, err := client.Get("http://" + address)
+			if err == nil {
+				resp.Body.Close()
+			}
+			return err
+		}).Should(HaveOccurred())
+
+		Expect(held.Close()).To(Succeed())
+
+		Eventually(func() error {
+			resp, err := client.Get("http://" + address)
+			if err == nil {
+				resp.Body.Close()
+			}
+			return err
+		}).Should(Succeed())
+	})
+
+	It("closes the active connections once the shutdown timeout passes", func() {
+		errs := make(chan error, 1)
+		go func() {
+			resp, err := client.Get("http://" + address + "/block")
+			if err == nil {
+				resp.Body.Close()
+			}
+			errs <- err
+		}()
+		Consistently(errs, 100*time.Millisecond).ShouldNot(Receive())
+
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait(), time.Second).Should(Receive())
+		Eventually(errs).Should(Receive(HaveOccurred()))
+	})
+})
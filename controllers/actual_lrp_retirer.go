@@ -11,6 +11,7 @@ import (
 
 type ActualLRPRetirer interface {
 	RetireActualLRP(logger lager.Logger, processGuid string, index int32) error
+	RetireActualLRPsOnCell(logger lager.Logger, cellID string) ([]*models.ActualLRP, error)
 }
 
 type actualLRPRetirer struct {
@@ -83,3 +84,43 @@ func (r *actualLRPRetirer) RetireActualLRP(logger lager.Logger, processGuid stri
 
 	return err
 }
+
+// RetireActualLRPsOnCell unclaims the actual LRPs on the cell and asks the
+// cell's rep to stop their instances, returning the LRPs it unclaimed. Failing
+// to stop an instance is not an error: the rep will find that it no longer
+// owns the LRP and shut it down.
+func (r *actualLRPRetirer) RetireActualLRPsOnCell(logger lager.Logger, cellID string) ([]*models.ActualLRP, error) {
+	logger = logger.Session("retire-actual-lrps-on-cell", lager.Data{"cell_id": cellID})
+
+	befores, afters, err := r.db.RetireActualLRPsOnCell(logger, cellID)
+	if err != nil {
+		return nil, err
+	}
+
+	retired := make([]*models.ActualLRP, 0, len(afters))
+	for i := range afters {
+		go r.actualHub.Emit(models.NewActualLRPChangedEvent(befores[i], afters[i]))
+		retired = append(retired, afters[i].Instance)
+	}
+
+	if len(befores) == 0 {
+		return retired, nil
+	}
+
+	cell, err := r.serviceClient.CellById(logger, cellID)
+	if err != nil {
+		logger.Error("failed-fetching-cell-presence", err)
+		return retired, nil
+	}
+
+	client := r.repClientFactory.CreateClient(cell.RepAddress)
+	for _, before := range befores {
+		lrp := before.Instance
+		err = client.StopLRPInstance(lrp.ActualLRPKey, lrp.ActualLRPInstanceKey)
+		if err != nil {
+			logger.Error("failed-stopping-lrp-instance", err, lager.Data{"process_guid": lrp.ProcessGuid, "index": lrp.Index})
+		}
+	}
+
+	return retired, nil
+}
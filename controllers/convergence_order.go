@@ -0,0 +1,100 @@
+package controllers
+
+import "fmt"
+
+// The sub-operations of LRP convergence that a ConvergenceOrder can order.
+const (
+	// ConvergenceStepRetire retires the extra actual LRPs of desired LRPs
+	// that were scaled down or removed.
+	ConvergenceStepRetire = "retire"
+
+	// ConvergenceStepUnclaim unclaims the actual LRPs of missing cells so
+	// that they are auctioned again.
+	ConvergenceStepUnclaim = "unclaim"
+
+	// ConvergenceStepStart requests the auctions of the actual LRPs that are
+	// missing, crashed or unclaimed so far.
+	ConvergenceStepStart = "start"
+)
+
+// ConvergenceOrder decides the order of the work of LRP convergence. A pass
+// converges its priority domains one at a time, in the order given, and then
+// every other domain together. Within each, it runs the steps one after the
+// other, so a priority domain's auctions are requested before any work of the
+// domains after it.
+//
+// The auctions of actual LRPs unclaimed after the start step are requested
+// once the other steps are done.
+type ConvergenceOrder struct {
+	// PriorityDomains are converged before every other domain, in the order
+	// given. Their missing actual LRPs are also created first.
+	PriorityDomains []string
+
+	// Steps orders the sub-operations within a domain. Steps left out follow
+	// the ones given, in the order of DefaultConvergenceOrder.
+	Steps []string
+}
+
+// DefaultConvergenceOrder retires extra actual LRPs, then unclaims those on
+// missing cells, then requests the start auctions, and prioritizes no
+// domain.
+var DefaultConvergenceOrder = ConvergenceOrder{
+	Steps: []string{ConvergenceStepRetire, ConvergenceStepUnclaim, ConvergenceStepStart},
+}
+
+func (o ConvergenceOrder) Validate() error {
+	seenSteps := map[string]bool{}
+	for _, step := range o.Steps {
+		switch step {
+		case ConvergenceStepRetire, ConvergenceStepUnclaim, ConvergenceStepStart:
+		default:
+			return fmt.Errorf("unknown convergence step %q", step)
+		}
+		if seenSteps[step] {
+			return fmt.Errorf("convergence step %q is listed more than once", step)
+		}
+		seenSteps[step] = true
+	}
+
+	seenDomains := map[string]bool{}
+	for _, domain := range o.PriorityDomains {
+		if domain == "" {
+			return fmt.Errorf("priority domains must not be empty")
+		}
+		if seenDomains[domain] {
+			return fmt.Errorf("priority domain %q is listed more than once", domain)
+		}
+		seenDomains[domain] = true
+	}
+	return nil
+}
+
+// domainRank is the position of the domain in PriorityDomains. Every other
+// domain ranks after all of them.
+func (o ConvergenceOrder) domainRank(domain string) int {
+	for i, d := range o.PriorityDomains {
+		if d == domain {
+			return i
+		}
+	}
+	return len(o.PriorityDomains)
+}
+
+// steps is every step in the order it runs: the ones given, then the ones
+// left out in the order of DefaultConvergenceOrder.
+func (o ConvergenceOrder) steps() []string {
+	steps := append([]string{}, o.Steps...)
+	for _, step := range DefaultConvergenceOrder.Steps {
+		listed := false
+		for _, s := range o.Steps {
+			if s == step {
+				listed = true
+				break
+			}
+		}
+		if !listed {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
@@ -0,0 +1,49 @@
+package controllers_test
+
+import (
+	"code.cloudfoundry.org/bbs/controllers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConvergenceOrder", func() {
+	Describe("Validate", func() {
+		It("accepts the default order", func() {
+			Expect(controllers.DefaultConvergenceOrder.Validate()).To(Succeed())
+		})
+
+		It("accepts priority domains and a subset of the steps", func() {
+			order := controllers.ConvergenceOrder{
+				PriorityDomains: []string{"critical", "important"},
+				Steps:           []string{controllers.ConvergenceStepUnclaim},
+			}
+			Expect(order.Validate()).To(Succeed())
+		})
+
+		It("accepts the start step anywhere", func() {
+			order := controllers.ConvergenceOrder{
+				Steps: []string{controllers.ConvergenceStepStart, controllers.ConvergenceStepRetire},
+			}
+			Expect(order.Validate()).To(Succeed())
+		})
+
+		It("rejects unknown steps", func() {
+			order := controllers.ConvergenceOrder{Steps: []string{"defragment"}}
+			Expect(order.Validate()).To(MatchError(`unknown convergence step "defragment"`))
+		})
+
+		It("rejects repeated steps", func() {
+			order := controllers.ConvergenceOrder{Steps: []string{controllers.ConvergenceStepRetire, controllers.ConvergenceStepRetire}}
+			Expect(order.Validate()).To(MatchError(`convergence step "retire" is listed more than once`))
+		})
+
+		It("rejects empty and repeated domains", func() {
+			order := controllers.ConvergenceOrder{PriorityDomains: []string{""}}
+			Expect(order.Validate()).To(HaveOccurred())
+
+			order = controllers.ConvergenceOrder{PriorityDomains: []string{"critical", "critical"}}
+			Expect(order.Validate()).To(MatchError(`priority domain "critical" is listed more than once`))
+		})
+	})
+})
@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+)
+
+// convergencePaused is 1 while convergence is paused and 0 otherwise.
+const convergencePaused = metric.Metric("ConvergencePaused")
+
+// ConvergencePause lets operators stop the scheduled convergence passes of
+// this BBS for a while, e.g. during manual fixes to the database, without
+// stopping the API. Every pause ends on its own after at most maxDuration,
+// so that convergence cannot be left paused by mistake. The pause is not
+// shared with the other BBS instances, so it ends when the lock moves.
+type ConvergencePause struct {
+	clock       clock.Clock
+	maxDuration time.Duration
+
+	lock        sync.Mutex
+	pausedUntil time.Time
+}
+
+func NewConvergencePause(clock clock.Clock, maxDuration time.Duration) *ConvergencePause {
+	return &ConvergencePause{
+		clock:       clock,
+		maxDuration: maxDuration,
+	}
+}
+
+// Pause pauses convergence for the given duration, or for the maximum
+// duration if it is 0 or longer, replacing any pause in progress, and returns
+// when the pause ends.
+func (p *ConvergencePause) Pause(logger lager.Logger, duration time.Duration) time.Time {
+	if duration <= 0 || duration > p.maxDuration {
+		duration = p.maxDuration
+	}
+
+	p.lock.Lock()
+	p.pausedUntil = p.clock.Now().Add(duration)
+	pausedUntil := p.pausedUntil
+	p.lock.Unlock()
+
+	logger.Info("convergence-paused", lager.Data{"duration": duration.String(), "paused-until": pausedUntil})
+	p.sendMetric(logger, 1)
+	return pausedUntil
+}
+
+// Resume ends the pause in progress, if any.
+func (p *ConvergencePause) Resume(logger lager.Logger) {
+	p.lock.Lock()
+	wasPaused := !p.pausedUntil.IsZero()
+	p.pausedUntil = time.Time{}
+	p.lock.Unlock()
+
+	if wasPaused {
+		logger.Info("convergence-resumed")
+	}
+	p.sendMetric(logger, 0)
+}
+
+// Paused reports whether convergence is paused. A pause whose time is up is
+// ended here, so the resumption is logged and reported by the first pass
+// that would have been skipped.
+func (p *ConvergencePause) Paused(logger lager.Logger) bool {
+	p.lock.Lock()
+	if p.pausedUntil.IsZero() {
+		p.lock.Unlock()
+		return false
+	}
+
+	if p.clock.Now().Before(p.pausedUntil) {
+		pausedUntil := p.pausedUntil
+		p.lock.Unlock()
+		logger.Info("convergence-is-paused", lager.Data{"paused-until": pausedUntil})
+		return true
+	}
+
+	p.pausedUntil = time.Time{}
+	p.lock.Unlock()
+
+	logger.Info("convergence-pause-expired")
+	p.sendMetric(logger, 0)
+	return false
+}
+
+func (p *ConvergencePause) sendMetric(logger lager.Logger, paused int) {
+	err := convergencePaused.Send(paused)
+	if err != nil {
+		logger.Error("failed-to-send-convergence-paused-metric", err)
+	}
+}
@@ -0,0 +1,70 @@
+package controllers_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConvergencePause", func() {
+	const maxDuration = time.Hour
+
+	var (
+		fakeClock *fakeclock.FakeClock
+		sender    *fake.FakeMetricSender
+		pause     *controllers.ConvergencePause
+	)
+
+	BeforeEach(func() {
+		sender = fake.NewFakeMetricSender()
+		dropsonde_metrics.Initialize(sender, nil)
+
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		pause = controllers.NewConvergencePause(fakeClock, maxDuration)
+	})
+
+	It("is not paused to begin with", func() {
+		Expect(pause.Paused(logger)).To(BeFalse())
+	})
+
+	It("pauses until the duration has passed", func() {
+		pausedUntil := pause.Pause(logger, 10*time.Minute)
+		Expect(pausedUntil).To(Equal(fakeClock.Now().Add(10 * time.Minute)))
+		Expect(pause.Paused(logger)).To(BeTrue())
+		Expect(sender.GetValue("ConvergencePaused").Value).To(BeEquivalentTo(1))
+
+		fakeClock.Increment(10*time.Minute - time.Second)
+		Expect(pause.Paused(logger)).To(BeTrue())
+
+		fakeClock.Increment(time.Second)
+		Expect(pause.Paused(logger)).To(BeFalse())
+		Expect(sender.GetValue("ConvergencePaused").Value).To(BeEquivalentTo(0))
+	})
+
+	It("pauses for at most the maximum duration", func() {
+		Expect(pause.Pause(logger, 2*maxDuration)).To(Equal(fakeClock.Now().Add(maxDuration)))
+		Expect(pause.Pause(logger, 0)).To(Equal(fakeClock.Now().Add(maxDuration)))
+	})
+
+	It("replaces the pause in progress", func() {
+		pause.Pause(logger, 10*time.Minute)
+		pause.Pause(logger, time.Minute)
+
+		fakeClock.Increment(time.Minute)
+		Expect(pause.Paused(logger)).To(BeFalse())
+	})
+
+	It("resumes when asked to", func() {
+		pause.Pause(logger, 10*time.Minute)
+		pause.Resume(logger)
+
+		Expect(pause.Paused(logger)).To(BeFalse())
+		Expect(sender.GetValue("ConvergencePaused").Value).To(BeEquivalentTo(0))
+	})
+})
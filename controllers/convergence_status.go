@@ -0,0 +1,131 @@
+package controllers
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+)
+
+// ConvergenceStatusTracker records when the last convergence pass of each type
+// completed, how long it took and how many operations it performed. Each
+// completed pass is also emitted as the ConvergenceLRPLast* or
+// ConvergenceTaskLast* metrics.
+type ConvergenceStatusTracker struct {
+	clock clock.Clock
+
+	lock     sync.Mutex
+	statuses map[string]*models.ConvergenceStatus
+}
+
+func NewConvergenceStatusTracker(clock clock.Clock) *ConvergenceStatusTracker {
+	return &ConvergenceStatusTracker{
+		clock:    clock,
+		statuses: map[string]*models.ConvergenceStatus{},
+	}
+}
+
+// ConvergenceRun is a convergence pass in progress, started with
+// ConvergenceStatusTracker.Start.
+type ConvergenceRun struct {
+	tracker         *ConvergenceStatusTracker
+	convergenceType string
+	startedAt       time.Time
+}
+
+// Start begins timing a convergence pass of the given type. Passes that do
+// not complete, e.g. because the cells could not be listed, are not recorded.
+func (t *ConvergenceStatusTracker) Start(convergenceType string) ConvergenceRun {
+	return ConvergenceRun{tracker: t, convergenceType: convergenceType, startedAt: t.clock.Now()}
+}
+
+// Complete records the pass as the last one of its type, completed now.
+func (r ConvergenceRun) Complete(logger lager.Logger, operations map[string]int32) {
+	r.tracker.complete(logger, r.convergenceType, r.startedAt, operations)
+}
+
+// ConvergenceStatuses returns the status of every type of convergence that has
+// completed a pass, ordered by type.
+func (t *ConvergenceStatusTracker) ConvergenceStatuses() []*models.ConvergenceStatus {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	types := make([]string, 0, len(t.statuses))
+	for convergenceType := range t.statuses {
+		types = append(types, convergenceType)
+	}
+	sort.Strings(types)
+
+	statuses := make([]*models.ConvergenceStatus, 0, len(types))
+	for _, convergenceType := range types {
+		status := *t.statuses[convergenceType]
+		status.Operations = map[string]int32{}
+		for operation, count := range t.statuses[convergenceType].Operations {
+			status.Operations[operation] = count
+		}
+		statuses = append(statuses, &status)
+	}
+	return statuses
+}
+
+func (t *ConvergenceStatusTracker) complete(logger lager.Logger, convergenceType string, startedAt time.Time, operations map[string]int32) {
+	completedAt := t.clock.Now()
+	duration := completedAt.Sub(startedAt)
+
+	status := &models.ConvergenceStatus{
+		Type:        convergenceType,
+		CompletedAt: completedAt.UnixNano(),
+		Duration:    int64(duration),
+		Operations:  map[string]int32{},
+	}
+	total := 0
+	for operation, count := range operations {
+		status.Operations[operation] = count
+		total += int(count)
+	}
+
+	t.lock.Lock()
+	t.statuses[convergenceType] = status
+	t.lock.Unlock()
+
+	logger.Info("convergence-completed", lager.Data{
+		"type":       convergenceType,
+		"duration":   duration.String(),
+		"operations": operations,
+	})
+	t.sendMetrics(logger, convergenceType, completedAt, duration, total)
+}
+
+// sendMetrics emits the completion time in seconds since the epoch, the
+// duration and the total number of operations of the last pass. The metric
+// names are capitalised after the type, e.g. ConvergenceLRPLastDuration.
+func (t *ConvergenceStatusTracker) sendMetrics(logger lager.Logger, convergenceType string, completedAt time.Time, duration time.Duration, operations int) {
+	var prefix string
+	switch convergenceType {
+	case models.ConvergenceTypeLRP:
+		prefix = "ConvergenceLRPLast"
+	case models.ConvergenceTypeTask:
+		prefix = "ConvergenceTaskLast"
+	default:
+		return
+	}
+
+	err := metric.Metric(prefix + "CompletedAt").Send(int(completedAt.Unix()))
+	if err != nil {
+		logger.Error("failed-to-send-convergence-completed-at-metric", err, lager.Data{"type": convergenceType})
+	}
+
+	err = metric.Duration(prefix + "Duration").Send(duration)
+	if err != nil {
+		logger.Error("failed-to-send-convergence-duration-metric", err, lager.Data{"type": convergenceType})
+	}
+
+	err = metric.Metric(prefix + "Operations").Send(operations)
+	if err != nil {
+		logger.Error("failed-to-send-convergence-operations-metric", err, lager.Data{"type": convergenceType})
+	}
+}
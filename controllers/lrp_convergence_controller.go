@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/auctioneerclient"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
@@ -16,20 +17,29 @@ type LRPConvergenceController struct {
 	logger                 lager.Logger
 	db                     db.LRPDB
 	actualHub              events.Hub
-	auctioneerClient       auctioneer.Client
+	auctioneerClient       auctioneerclient.Client
 	serviceClient          bbs.ServiceClient
 	retirer                ActualLRPRetirer
 	convergenceWorkersSize int
+	convergenceStatus      *ConvergenceStatusTracker
+	order                  ConvergenceOrder
+	startOrderGate         *StartOrderGate
+
+	// convergeLock keeps global and domain scoped passes from interleaving,
+	// so neither acts on LRPs the other is still resolving.
+	convergeLock sync.Mutex
 }
 
 func NewLRPConvergenceController(
 	logger lager.Logger,
 	db db.LRPDB,
 	actualHub events.Hub,
-	auctioneerClient auctioneer.Client,
+	auctioneerClient auctioneerclient.Client,
 	serviceClient bbs.ServiceClient,
 	retirer ActualLRPRetirer,
 	convergenceWorkersSize int,
+	convergenceStatus *ConvergenceStatusTracker,
+	order ConvergenceOrder,
 ) *LRPConvergenceController {
 	return &LRPConvergenceController{
 		logger:                 logger,
@@ -39,13 +49,21 @@ func NewLRPConvergenceController(
 		serviceClient:          serviceClient,
 		retirer:                retirer,
 		convergenceWorkersSize: convergenceWorkersSize,
+		convergenceStatus:      convergenceStatus,
+		order:                  order,
+		startOrderGate:         NewStartOrderGate(db, db),
 	}
 }
 
-func (h *LRPConvergenceController) ConvergeLRPs(logger lager.Logger) error {
-	logger = h.logger.Session("converge-lrps")
+func (h *LRPConvergenceController) ConvergeLRPs(logger lager.Logger, filter models.ConvergenceFilter) error {
+	logger = h.logger.Session("converge-lrps", lager.Data{"domains": filter.Domains})
 	var err error
 
+	h.convergeLock.Lock()
+	defer h.convergeLock.Unlock()
+
+	run := h.convergenceStatus.Start(models.ConvergenceTypeLRP)
+
 	logger.Debug("listing-cells")
 	var cellSet models.CellSet
 	cellSet, err = h.serviceClient.Cells(logger)
@@ -59,28 +77,114 @@ func (h *LRPConvergenceController) ConvergeLRPs(logger lager.Logger) error {
 	}
 	logger.Debug("succeeded-listing-cells")
 
-	startRequests, keysWithMissingCells, keysToRetire := h.db.ConvergeLRPs(logger, cellSet)
+	filter.PriorityDomains = h.order.PriorityDomains
+	startRequests, keysWithMissingCells, keysToRetire := h.db.ConvergeLRPs(logger, cellSet, filter)
 
-	retireLogger := logger.WithData(lager.Data{"retiring_lrp_count": len(keysToRetire)})
-	works := []func(){}
+	stages := make([]*convergenceStage, len(h.order.PriorityDomains)+1)
+	for i := range stages {
+		stages[i] = &convergenceStage{}
+	}
+	for _, startRequest := range startRequests {
+		stage := stages[h.order.domainRank(startRequest.Domain)]
+		stage.startRequests = append(stage.startRequests, startRequest)
+	}
 	for _, key := range keysToRetire {
-		key := key
-		works = append(works, func() { h.retirer.RetireActualLRP(retireLogger, key.ProcessGuid, key.Index) })
+		stage := stages[h.order.domainRank(key.Domain)]
+		stage.keysToRetire = append(stage.keysToRetire, key)
+	}
+	for _, key := range keysWithMissingCells {
+		stage := stages[h.order.domainRank(key.Key.Domain)]
+		stage.keysWithMissingCells = append(stage.keysWithMissingCells, key)
 	}
 
+	var auctioned, unclaimed int32
 	errChan := make(chan *models.Error, 1)
+	for _, stage := range stages {
+		for _, step := range h.order.steps() {
+			var works []func()
+			switch step {
+			case ConvergenceStepRetire:
+				works = h.retireWorks(logger, stage)
+			case ConvergenceStepUnclaim:
+				works = h.unclaimWorks(logger, stage, errChan)
+			case ConvergenceStepStart:
+				auctioned += h.requestAuctions(logger, stage)
+				continue
+			}
+			if len(works) == 0 {
+				continue
+			}
 
-	startRequestLock := &sync.Mutex{}
-	for _, key := range keysWithMissingCells {
+			var throttler *workpool.Throttler
+			throttler, err = workpool.NewThrottler(h.convergenceWorkersSize, works)
+			if err != nil {
+				logger.Error("failed-constructing-throttler", err, lager.Data{"max_workers": h.convergenceWorkersSize, "num_works": len(works)})
+				return nil
+			}
+
+			logger.Debug("running-convergence-step", lager.Data{"step": step, "num_works": len(works)})
+			throttler.Work()
+			logger.Debug("done-running-convergence-step", lager.Data{"step": step})
+
+			select {
+			case err := <-errChan:
+				return err
+			default:
+			}
+		}
+
+		// the LRPs unclaimed after the start step
+		auctioned += h.requestAuctions(logger, stage)
+		unclaimed += stage.unclaimed
+	}
+
+	// A pass scoped to some domains does not tell when every LRP was last
+	// converged, so only full passes are recorded.
+	if !filter.IsScoped() {
+		run.Complete(logger, map[string]int32{
+			models.ConvergenceOperationLRPsAuctioned: auctioned,
+			models.ConvergenceOperationLRPsUnclaimed: unclaimed,
+			models.ConvergenceOperationLRPsRetired:   int32(len(keysToRetire)),
+		})
+	}
+
+	return nil
+}
+
+// convergenceStage is the work of a pass in one of its priority domains, or
+// in every other domain.
+type convergenceStage struct {
+	keysToRetire         []*models.ActualLRPKey
+	keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo
+
+	startRequestLock sync.Mutex
+	startRequests    []*auctioneer.LRPStartRequest
+	unclaimed        int32
+}
+
+func (h *LRPConvergenceController) retireWorks(logger lager.Logger, stage *convergenceStage) []func() {
+	retireLogger := logger.WithData(lager.Data{"retiring_lrp_count": len(stage.keysToRetire)})
+	works := []func(){}
+	for _, key := range stage.keysToRetire {
+		key := key
+		works = append(works, func() { h.retirer.RetireActualLRP(retireLogger, key.ProcessGuid, key.Index) })
+	}
+	return works
+}
+
+func (h *LRPConvergenceController) unclaimWorks(logger lager.Logger, stage *convergenceStage, errChan chan<- *models.Error) []func() {
+	works := []func(){}
+	for _, key := range stage.keysWithMissingCells {
 		key := key
 		works = append(works, func() {
 			before, after, err := h.db.UnclaimActualLRP(logger, key.Key)
 			if err == nil {
 				h.actualHub.Emit(models.NewActualLRPChangedEvent(before, after))
 				startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(key.SchedulingInfo, int(key.Key.Index))
-				startRequestLock.Lock()
-				startRequests = append(startRequests, &startRequest)
-				startRequestLock.Unlock()
+				stage.startRequestLock.Lock()
+				stage.startRequests = append(stage.startRequests, &startRequest)
+				stage.unclaimed++
+				stage.startRequestLock.Unlock()
 			} else {
 				bbsErr := models.ConvertError(err)
 				if bbsErr.GetType() != models.Error_Unrecoverable {
@@ -95,33 +199,23 @@ func (h *LRPConvergenceController) ConvergeLRPs(logger lager.Logger) error {
 			}
 		})
 	}
+	return works
+}
 
-	var throttler *workpool.Throttler
-	throttler, err = workpool.NewThrottler(h.convergenceWorkersSize, works)
-	if err != nil {
-		logger.Error("failed-constructing-throttler", err, lager.Data{"max_workers": h.convergenceWorkersSize, "num_works": len(works)})
-		return nil
-	}
-
-	retireLogger.Debug("retiring-actual-lrps")
-	throttler.Work()
-	retireLogger.Debug("done-retiring-actual-lrps")
-
-	select {
-	case err := <-errChan:
-		return err
-	default:
-	}
+// requestAuctions requests the auctions of the stage's start requests so far
+// and returns how many were requested.
+func (h *LRPConvergenceController) requestAuctions(logger lager.Logger, stage *convergenceStage) int32 {
+	startRequests := h.startOrderGate.StartRequests(logger, stage.startRequests)
+	stage.startRequests = nil
 
 	startLogger := logger.WithData(lager.Data{"start_requests_count": len(startRequests)})
 	if len(startRequests) > 0 {
 		startLogger.Debug("requesting-start-auctions")
-		err = h.auctioneerClient.RequestLRPAuctions(startRequests)
+		err := h.auctioneerClient.RequestLRPAuctions(startRequests)
 		if err != nil {
 			startLogger.Error("failed-to-request-starts", err, lager.Data{"lrp_start_auctions": startRequests})
 		}
 		startLogger.Debug("done-requesting-start-auctions")
 	}
-
-	return nil
+	return int32(len(startRequests))
 }
@@ -3,6 +3,8 @@ package controllers_test
 import (
 	"errors"
 	"net/http/httptest"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
@@ -12,6 +14,7 @@ import (
 	"code.cloudfoundry.org/bbs/fake_bbs"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"code.cloudfoundry.org/rep/repfakes"
@@ -43,6 +46,9 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		cellID  string
 		cellSet models.CellSet
 
+		fakeClock         *fakeclock.FakeClock
+		convergenceStatus *controllers.ConvergenceStatusTracker
+
 		controller *controllers.LRPConvergenceController
 	)
 
@@ -122,17 +128,19 @@ var _ = Describe("LRP Convergence Controllers", func() {
 
 		actualHub = &eventfakes.FakeHub{}
 		retirer := controllers.NewActualLRPRetirer(fakeLRPDB, actualHub, fakeRepClientFactory, fakeServiceClient)
-		controller = controllers.NewLRPConvergenceController(logger, fakeLRPDB, actualHub, fakeAuctioneerClient, fakeServiceClient, retirer, 2)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		convergenceStatus = controllers.NewConvergenceStatusTracker(fakeClock)
+		controller = controllers.NewLRPConvergenceController(logger, fakeLRPDB, actualHub, fakeAuctioneerClient, fakeServiceClient, retirer, 2, convergenceStatus, controllers.DefaultConvergenceOrder)
 	})
 
 	JustBeforeEach(func() {
-		err = controller.ConvergeLRPs(logger)
+		err = controller.ConvergeLRPs(logger, models.ConvergenceFilter{})
 	})
 
 	It("calls ConvergeLRPs", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(fakeLRPDB.ConvergeLRPsCallCount()).To(Equal(1))
-		_, actualCellSet := fakeLRPDB.ConvergeLRPsArgsForCall(0)
+		_, actualCellSet, _ := fakeLRPDB.ConvergeLRPsArgsForCall(0)
 		Expect(actualCellSet).To(BeEquivalentTo(cellSet))
 	})
 
@@ -152,6 +160,10 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		It("logs the error", func() {
 			Eventually(logger).Should(gbytes.Say("failed-listing-cells"))
 		})
+
+		It("does not record the pass", func() {
+			Expect(convergenceStatus.ConvergenceStatuses()).To(BeEmpty())
+		})
 	})
 
 	Context("when fetching the cells returns ErrResourceNotFound", func() {
@@ -162,7 +174,7 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		It("calls ConvergeLRPs with an empty CellSet", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeLRPDB.ConvergeLRPsCallCount()).To(Equal(1))
-			_, actualCellSet := fakeLRPDB.ConvergeLRPsArgsForCall(0)
+			_, actualCellSet, _ := fakeLRPDB.ConvergeLRPsArgsForCall(0)
 			Expect(actualCellSet).To(BeEquivalentTo(models.CellSet{}))
 		})
 	})
@@ -181,6 +193,87 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		Expect(startAuctions).To(ConsistOf(expectedStartRequests))
 	})
 
+	It("records when the pass completed and what it did", func() {
+		Expect(convergenceStatus.ConvergenceStatuses()).To(ConsistOf(&models.ConvergenceStatus{
+			Type:        models.ConvergenceTypeLRP,
+			CompletedAt: fakeClock.Now().UnixNano(),
+			Operations: map[string]int32{
+				models.ConvergenceOperationLRPsAuctioned: 4,
+				models.ConvergenceOperationLRPsUnclaimed: 2,
+				models.ConvergenceOperationLRPsRetired:   2,
+			},
+		}))
+	})
+
+	Context("when the pass is scoped to some domains", func() {
+		JustBeforeEach(func() {
+			fakeClock.Increment(time.Minute)
+			err = controller.ConvergeLRPs(logger, models.ConvergenceFilter{Domains: []string{"domain"}})
+		})
+
+		It("does not record it", func() {
+			statuses := convergenceStatus.ConvergenceStatuses()
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].CompletedAt).To(Equal(time.Unix(1000, 0).UnixNano()))
+		})
+	})
+
+	Context("when an LRP starts its leader first", func() {
+		var leaderGroup *models.ActualLRPGroup
+
+		BeforeEach(func() {
+			schedulingInfo := model_helpers.NewValidDesiredLRP("to-auction-1").DesiredLRPSchedulingInfo()
+			schedulingInfo.StartOrder = models.StartOrder_LeaderFirst
+			fakeLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil)
+
+			leaderGroup = nil
+			lookupGroup := fakeLRPDB.ActualLRPGroupByProcessGuidAndIndexStub
+			fakeLRPDB.ActualLRPGroupByProcessGuidAndIndexStub = func(logger lager.Logger, processGuid string, index int32) (*models.ActualLRPGroup, error) {
+				if processGuid == "to-auction-1" {
+					if leaderGroup == nil {
+						return nil, models.ErrResourceNotFound
+					}
+					return leaderGroup, nil
+				}
+				return lookupGroup(logger, processGuid, index)
+			}
+		})
+
+		It("withholds the starts of its other instances until instance 0 is running", func() {
+			Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			startAuctions := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+			Expect(startAuctions).To(HaveLen(3))
+			for _, startAuction := range startAuctions {
+				Expect(startAuction.ProcessGuid).NotTo(Equal("to-auction-1"))
+			}
+		})
+
+		Context("when instance 0 is not running yet", func() {
+			BeforeEach(func() {
+				leader := model_helpers.NewValidActualLRP("to-auction-1", 0)
+				leader.State = models.ActualLRPStateClaimed
+				leaderGroup = &models.ActualLRPGroup{Instance: leader}
+			})
+
+			It("withholds the starts of its other instances", func() {
+				startAuctions := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+				Expect(startAuctions).To(HaveLen(3))
+			})
+		})
+
+		Context("when instance 0 is running", func() {
+			BeforeEach(func() {
+				leaderGroup = &models.ActualLRPGroup{Instance: model_helpers.NewValidActualLRP("to-auction-1", 0)}
+			})
+
+			It("auctions its other instances", func() {
+				startAuctions := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+				Expect(startAuctions).To(HaveLen(4))
+				Expect(startAuctions).To(ContainElement(keysToAuction[0]))
+			})
+		})
+	})
+
 	Context("when no lrps to auction", func() {
 		BeforeEach(func() {
 			fakeLRPDB.ConvergeLRPsReturns(nil, nil, nil)
@@ -216,6 +309,116 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		Expect(changeEvents).To(ContainElement(models.NewActualLRPChangedEvent(group2, group2)))
 	})
 
+	Context("when some domains are prioritized", func() {
+		var (
+			dispatchLock sync.Mutex
+			dispatched   []string
+		)
+
+		BeforeEach(func() {
+			retiringActualLRP2.Domain = "critical"
+			unclaimingActualLRP2.Domain = "critical"
+			desiredLRP2.Domain = "critical"
+			keysToAuction[1].Domain = "critical"
+
+			dispatched = []string{}
+			record := func(processGuid string) {
+				dispatchLock.Lock()
+				defer dispatchLock.Unlock()
+				for _, guid := range dispatched {
+					if guid == processGuid {
+						return
+					}
+				}
+				dispatched = append(dispatched, processGuid)
+			}
+
+			actualLRPGroup := fakeLRPDB.ActualLRPGroupByProcessGuidAndIndexStub
+			fakeLRPDB.ActualLRPGroupByProcessGuidAndIndexStub = func(logger lager.Logger, processGuid string, index int32) (*models.ActualLRPGroup, error) {
+				record(processGuid)
+				return actualLRPGroup(logger, processGuid, index)
+			}
+			unclaimActualLRP := fakeLRPDB.UnclaimActualLRPStub
+			fakeLRPDB.UnclaimActualLRPStub = func(logger lager.Logger, key *models.ActualLRPKey) (*models.ActualLRPGroup, *models.ActualLRPGroup, error) {
+				record(key.ProcessGuid)
+				return unclaimActualLRP(logger, key)
+			}
+
+			retirer := controllers.NewActualLRPRetirer(fakeLRPDB, actualHub, fakeRepClientFactory, fakeServiceClient)
+			order := controllers.ConvergenceOrder{
+				PriorityDomains: []string{"critical"},
+				Steps:           []string{controllers.ConvergenceStepUnclaim},
+			}
+			controller = controllers.NewLRPConvergenceController(logger, fakeLRPDB, actualHub, fakeAuctioneerClient, fakeServiceClient, retirer, 1, convergenceStatus, order)
+		})
+
+		It("dispatches the operations of prioritized domains first, in the order of the steps", func() {
+			Expect(dispatched).To(Equal([]string{
+				unclaimingActualLRP2.ProcessGuid,
+				retiringActualLRP2.ProcessGuid,
+				unclaimingActualLRP1.ProcessGuid,
+				retiringActualLRP1.ProcessGuid,
+			}))
+		})
+
+		It("requests the auctions of prioritized domains before the work of the other domains", func() {
+			Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(2))
+
+			domains := func(call int) []string {
+				domains := []string{}
+				for _, startRequest := range fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(call) {
+					domains = append(domains, startRequest.Domain)
+				}
+				return domains
+			}
+			Expect(domains(0)).To(Equal([]string{"critical", "critical"}))
+			Expect(domains(1)).To(Equal([]string{"some-domain", "some-domain"}))
+		})
+
+		It("passes the priority domains to the DB, which creates their missing instances first", func() {
+			_, _, filter := fakeLRPDB.ConvergeLRPsArgsForCall(0)
+			Expect(filter.PriorityDomains).To(Equal([]string{"critical"}))
+			Expect(filter.IsScoped()).To(BeFalse())
+		})
+
+		Context("when the start step comes first", func() {
+			BeforeEach(func() {
+				auctionRequested := fakeAuctioneerClient.RequestLRPAuctionsStub
+				fakeAuctioneerClient.RequestLRPAuctionsStub = func(startRequests []*auctioneer.LRPStartRequest) error {
+					dispatchLock.Lock()
+					for _, startRequest := range startRequests {
+						dispatched = append(dispatched, "auction-"+startRequest.ProcessGuid)
+					}
+					dispatchLock.Unlock()
+					if auctionRequested != nil {
+						return auctionRequested(startRequests)
+					}
+					return nil
+				}
+
+				retirer := controllers.NewActualLRPRetirer(fakeLRPDB, actualHub, fakeRepClientFactory, fakeServiceClient)
+				order := controllers.ConvergenceOrder{
+					PriorityDomains: []string{"critical"},
+					Steps:           []string{controllers.ConvergenceStepStart, controllers.ConvergenceStepRetire},
+				}
+				controller = controllers.NewLRPConvergenceController(logger, fakeLRPDB, actualHub, fakeAuctioneerClient, fakeServiceClient, retirer, 1, convergenceStatus, order)
+			})
+
+			It("requests the auctions before retiring, and those of the unclaimed LRPs last", func() {
+				Expect(dispatched).To(Equal([]string{
+					"auction-" + keysToAuction[1].ProcessGuid,
+					retiringActualLRP2.ProcessGuid,
+					unclaimingActualLRP2.ProcessGuid,
+					"auction-" + unclaimingActualLRP2.ProcessGuid,
+					"auction-" + keysToAuction[0].ProcessGuid,
+					retiringActualLRP1.ProcessGuid,
+					unclaimingActualLRP1.ProcessGuid,
+					"auction-" + unclaimingActualLRP1.ProcessGuid,
+				}))
+			})
+		})
+	})
+
 	Context("when the DB returns an unrecoverable error", func() {
 		BeforeEach(func() {
 			fakeLRPDB.UnclaimActualLRPReturns(nil, nil, models.NewUnrecoverableError(nil))
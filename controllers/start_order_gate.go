@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// StartOrderGate withholds the start auctions of the instances that have to
+// wait for instance 0 of their LRP to be running, as the start order of the
+// LRP requires. A withheld instance is left UNCLAIMED: its auction is
+// requested once instance 0 starts, or by the convergence of stale unclaimed
+// LRPs, which goes through the gate again.
+type StartOrderGate struct {
+	actualLRPDB  db.ActualLRPDB
+	desiredLRPDB db.DesiredLRPDB
+}
+
+func NewStartOrderGate(actualLRPDB db.ActualLRPDB, desiredLRPDB db.DesiredLRPDB) *StartOrderGate {
+	return &StartOrderGate{
+		actualLRPDB:  actualLRPDB,
+		desiredLRPDB: desiredLRPDB,
+	}
+}
+
+// Indices returns the indices of the LRP whose auctions can be requested now.
+func (g *StartOrderGate) Indices(logger lager.Logger, schedulingInfo *models.DesiredLRPSchedulingInfo, indices []int) []int {
+	waiting := false
+	for _, index := range indices {
+		if schedulingInfo.WaitsForLeader(int32(index)) {
+			waiting = true
+			break
+		}
+	}
+	if !waiting || g.leaderRunning(logger, schedulingInfo.ProcessGuid) {
+		return indices
+	}
+
+	allowed := make([]int, 0, len(indices))
+	withheld := make([]int, 0, len(indices))
+	for _, index := range indices {
+		if schedulingInfo.WaitsForLeader(int32(index)) {
+			withheld = append(withheld, index)
+		} else {
+			allowed = append(allowed, index)
+		}
+	}
+
+	logger.Info("withholding-starts-until-leader-is-running", lager.Data{"process_guid": schedulingInfo.ProcessGuid, "indices": withheld})
+	return allowed
+}
+
+// StartRequests returns the start requests without the instances that are
+// withheld. When the scheduling infos of the LRPs cannot be fetched, nothing
+// is withheld, so that the LRPs without a start order still start.
+func (g *StartOrderGate) StartRequests(logger lager.Logger, startRequests []*auctioneer.LRPStartRequest) []*auctioneer.LRPStartRequest {
+	processGuids := []string{}
+	for _, startRequest := range startRequests {
+		for _, index := range startRequest.Indices {
+			if index > 0 {
+				processGuids = append(processGuids, startRequest.ProcessGuid)
+				break
+			}
+		}
+	}
+	if len(processGuids) == 0 {
+		return startRequests
+	}
+
+	schedulingInfos, err := g.desiredLRPDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: processGuids})
+	if err != nil {
+		logger.Error("failed-fetching-scheduling-infos", err)
+		return startRequests
+	}
+
+	ordered := map[string]*models.DesiredLRPSchedulingInfo{}
+	for _, schedulingInfo := range schedulingInfos {
+		if schedulingInfo.StartOrder == models.StartOrder_LeaderFirst {
+			ordered[schedulingInfo.ProcessGuid] = schedulingInfo
+		}
+	}
+	if len(ordered) == 0 {
+		return startRequests
+	}
+
+	allowed := make([]*auctioneer.LRPStartRequest, 0, len(startRequests))
+	for _, startRequest := range startRequests {
+		schedulingInfo, ok := ordered[startRequest.ProcessGuid]
+		if !ok {
+			allowed = append(allowed, startRequest)
+			continue
+		}
+
+		gated := *startRequest
+		gated.Indices = g.Indices(logger, schedulingInfo, startRequest.Indices)
+		if len(gated.Indices) > 0 {
+			allowed = append(allowed, &gated)
+		}
+	}
+
+	return allowed
+}
+
+// Released returns the start request of the instances that were withheld
+// until instance 0 of the LRP was running, or nil when there are none.
+func (g *StartOrderGate) Released(logger lager.Logger, processGuid string) (*auctioneer.LRPStartRequest, error) {
+	schedulingInfos, err := g.desiredLRPDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: []string{processGuid}})
+	if err != nil {
+		return nil, err
+	}
+	if len(schedulingInfos) == 0 || schedulingInfos[0].StartOrder != models.StartOrder_LeaderFirst {
+		return nil, nil
+	}
+
+	groups, err := g.actualLRPDB.ActualLRPGroupsByProcessGuid(logger, processGuid)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := []int{}
+	for _, group := range groups {
+		lrp := group.Instance
+		if lrp != nil && lrp.State == models.ActualLRPStateUnclaimed && schedulingInfos[0].WaitsForLeader(lrp.Index) {
+			indices = append(indices, int(lrp.Index))
+		}
+	}
+	if len(indices) == 0 {
+		return nil, nil
+	}
+
+	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedulingInfos[0], indices...)
+	return &startRequest, nil
+}
+
+func (g *StartOrderGate) leaderRunning(logger lager.Logger, processGuid string) bool {
+	group, err := g.actualLRPDB.ActualLRPGroupByProcessGuidAndIndex(logger, processGuid, 0)
+	if err != nil {
+		if err != models.ErrResourceNotFound {
+			logger.Error("failed-fetching-leader", err, lager.Data{"process_guid": processGuid})
+		}
+		return false
+	}
+
+	// an evacuating instance 0 is still running, on the cell it leaves
+	return group != nil &&
+		(isRunning(group.Instance) || isRunning(group.Evacuating))
+}
+
+func isRunning(lrp *models.ActualLRP) bool {
+	return lrp != nil && lrp.State == models.ActualLRPStateRunning
+}
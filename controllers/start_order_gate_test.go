@@ -0,0 +1,144 @@
+package controllers_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StartOrderGate", func() {
+	var (
+		fakeLRPDB      *dbfakes.FakeLRPDB
+		schedulingInfo models.DesiredLRPSchedulingInfo
+		leader         *models.ActualLRP
+		gate           *controllers.StartOrderGate
+	)
+
+	BeforeEach(func() {
+		fakeLRPDB = new(dbfakes.FakeLRPDB)
+
+		schedulingInfo = model_helpers.NewValidDesiredLRP("some-guid").DesiredLRPSchedulingInfo()
+		schedulingInfo.StartOrder = models.StartOrder_LeaderFirst
+		fakeLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil)
+
+		leader = model_helpers.NewValidActualLRP("some-guid", 0)
+		leader.State = models.ActualLRPStateUnclaimed
+		fakeLRPDB.ActualLRPGroupByProcessGuidAndIndexReturns(&models.ActualLRPGroup{Instance: leader}, nil)
+
+		gate = controllers.NewStartOrderGate(fakeLRPDB, fakeLRPDB)
+	})
+
+	Describe("Indices", func() {
+		It("withholds every instance but instance 0 until instance 0 is running", func() {
+			Expect(gate.Indices(logger, &schedulingInfo, []int{0, 1, 2})).To(Equal([]int{0}))
+			Expect(gate.Indices(logger, &schedulingInfo, []int{1, 2})).To(BeEmpty())
+
+			_, processGuid, index := fakeLRPDB.ActualLRPGroupByProcessGuidAndIndexArgsForCall(0)
+			Expect(processGuid).To(Equal("some-guid"))
+			Expect(index).To(BeEquivalentTo(0))
+		})
+
+		It("withholds them when instance 0 cannot be found", func() {
+			fakeLRPDB.ActualLRPGroupByProcessGuidAndIndexReturns(nil, models.ErrResourceNotFound)
+			Expect(gate.Indices(logger, &schedulingInfo, []int{1, 2})).To(BeEmpty())
+		})
+
+		It("releases them once instance 0 is running", func() {
+			leader.State = models.ActualLRPStateRunning
+			Expect(gate.Indices(logger, &schedulingInfo, []int{1, 2})).To(Equal([]int{1, 2}))
+		})
+
+		It("releases them while instance 0 is evacuating", func() {
+			evacuating := model_helpers.NewValidActualLRP("some-guid", 0)
+			fakeLRPDB.ActualLRPGroupByProcessGuidAndIndexReturns(&models.ActualLRPGroup{Instance: leader, Evacuating: evacuating}, nil)
+			Expect(gate.Indices(logger, &schedulingInfo, []int{1, 2})).To(Equal([]int{1, 2}))
+		})
+
+		It("withholds nothing when the instances start in parallel", func() {
+			schedulingInfo.StartOrder = models.StartOrder_Parallel
+			Expect(gate.Indices(logger, &schedulingInfo, []int{1, 2})).To(Equal([]int{1, 2}))
+			Expect(fakeLRPDB.ActualLRPGroupByProcessGuidAndIndexCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("StartRequests", func() {
+		var orderedStart, parallelStart auctioneer.LRPStartRequest
+
+		BeforeEach(func() {
+			orderedStart = auctioneer.NewLRPStartRequestFromSchedulingInfo(&schedulingInfo, 0, 3)
+			parallelStart = auctioneer.NewLRPStartRequestFromModel(model_helpers.NewValidDesiredLRP("other-guid"), 1, 2)
+		})
+
+		It("withholds the instances of the LRPs that start their leader first", func() {
+			startRequests := gate.StartRequests(logger, []*auctioneer.LRPStartRequest{&orderedStart, &parallelStart})
+			Expect(startRequests).To(HaveLen(2))
+			Expect(startRequests[0].ProcessGuid).To(Equal("some-guid"))
+			Expect(startRequests[0].Indices).To(Equal([]int{0}))
+			Expect(startRequests[1]).To(Equal(&parallelStart))
+
+			Expect(orderedStart.Indices).To(Equal([]int{0, 3}))
+		})
+
+		It("drops the requests whose instances are all withheld", func() {
+			orderedStart.Indices = []int{3}
+			startRequests := gate.StartRequests(logger, []*auctioneer.LRPStartRequest{&orderedStart, &parallelStart})
+			Expect(startRequests).To(Equal([]*auctioneer.LRPStartRequest{&parallelStart}))
+		})
+
+		It("does not fetch the scheduling infos when only instances 0 are started", func() {
+			orderedStart.Indices = []int{0}
+			startRequests := gate.StartRequests(logger, []*auctioneer.LRPStartRequest{&orderedStart})
+			Expect(startRequests).To(Equal([]*auctioneer.LRPStartRequest{&orderedStart}))
+			Expect(fakeLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(0))
+		})
+
+		It("withholds nothing when the scheduling infos cannot be fetched", func() {
+			fakeLRPDB.DesiredLRPSchedulingInfosReturns(nil, errors.New("boom"))
+			startRequests := gate.StartRequests(logger, []*auctioneer.LRPStartRequest{&orderedStart, &parallelStart})
+			Expect(startRequests).To(Equal([]*auctioneer.LRPStartRequest{&orderedStart, &parallelStart}))
+		})
+	})
+
+	Describe("Released", func() {
+		BeforeEach(func() {
+			leader.State = models.ActualLRPStateRunning
+			withheld := model_helpers.NewValidActualLRP("some-guid", 1)
+			withheld.State = models.ActualLRPStateUnclaimed
+			claimed := model_helpers.NewValidActualLRP("some-guid", 2)
+			claimed.State = models.ActualLRPStateClaimed
+			fakeLRPDB.ActualLRPGroupsByProcessGuidReturns([]*models.ActualLRPGroup{
+				{Instance: leader},
+				{Instance: withheld},
+				{Instance: claimed},
+			}, nil)
+		})
+
+		It("returns the start request of the unclaimed instances that waited for instance 0", func() {
+			startRequest, err := gate.Released(logger, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(startRequest.ProcessGuid).To(Equal("some-guid"))
+			Expect(startRequest.Indices).To(Equal([]int{1}))
+		})
+
+		It("returns nothing when the instances start in parallel", func() {
+			schedulingInfo.StartOrder = models.StartOrder_Parallel
+			startRequest, err := gate.Released(logger, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(startRequest).To(BeNil())
+			Expect(fakeLRPDB.ActualLRPGroupsByProcessGuidCallCount()).To(Equal(0))
+		})
+
+		It("fails when the actual LRPs cannot be fetched", func() {
+			fakeLRPDB.ActualLRPGroupsByProcessGuidReturns(nil, errors.New("boom"))
+			_, err := gate.Released(logger, "some-guid")
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+})
@@ -5,7 +5,9 @@ import (
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/auctioneerclient"
 	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/taskworkpool"
 	"code.cloudfoundry.org/lager"
@@ -14,33 +16,53 @@ import (
 
 type TaskController struct {
 	db                   db.TaskDB
+	bulkReadDB           db.TaskDB
 	taskCompletionClient taskworkpool.TaskCompletionClient
-	auctioneerClient     auctioneer.Client
+	auctioneerClient     auctioneerclient.Client
 	serviceClient        bbs.ServiceClient
 	repClientFactory     rep.ClientFactory
+	convergenceStatus    *ConvergenceStatusTracker
+	maxTaskRejections    int
 }
 
+// NewTaskController lists tasks with bulkReadDB, which may be weakly
+// consistent, and makes every other read and write with db. With a positive
+// maxTaskRejections, failing a pending task rejects it instead until it has
+// been rejected that many times.
 func NewTaskController(
-	db db.TaskDB,
+	db, bulkReadDB db.TaskDB,
 	taskCompletionClient taskworkpool.TaskCompletionClient,
-	auctioneerClient auctioneer.Client,
+	auctioneerClient auctioneerclient.Client,
 	serviceClient bbs.ServiceClient,
 	repClientFactory rep.ClientFactory,
+	taskHub events.Hub,
+	convergenceStatus *ConvergenceStatusTracker,
+	maxTaskRejections int,
 ) *TaskController {
+	if taskHub != nil {
+		db = newTaskEventsDB(db, taskHub)
+	}
+
 	return &TaskController{
 		db:                   db,
+		bulkReadDB:           bulkReadDB,
 		taskCompletionClient: taskCompletionClient,
 		auctioneerClient:     auctioneerClient,
 		serviceClient:        serviceClient,
 		repClientFactory:     repClientFactory,
+		convergenceStatus:    convergenceStatus,
+		maxTaskRejections:    maxTaskRejections,
 	}
 }
 
-func (h *TaskController) Tasks(logger lager.Logger, domain, cellId string) ([]*models.Task, error) {
+func (h *TaskController) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
 	logger = logger.Session("tasks")
+	return h.bulkReadDB.Tasks(logger, filter)
+}
 
-	filter := models.TaskFilter{Domain: domain, CellID: cellId}
-	return h.db.Tasks(logger, filter)
+func (h *TaskController) StreamTasks(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+	logger = logger.Session("stream-tasks")
+	return h.bulkReadDB.StreamTasks(logger, filter, yield)
 }
 
 func (h *TaskController) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
@@ -49,39 +71,68 @@ func (h *TaskController) TaskByGuid(logger lager.Logger, taskGuid string) (*mode
 	return h.db.TaskByGuid(logger, taskGuid)
 }
 
+func (h *TaskController) DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error) {
+	logger = logger.Session("duplicate-tasks")
+
+	return h.db.DuplicateTasks(logger, domain)
+}
+
 func (h *TaskController) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error {
 	var err error
 	logger = logger.Session("desire-task")
 
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
 
-	err = h.db.DesireTask(logger, taskDefinition, taskGuid, domain)
+	taskDefinition.NormalizeEgressRules()
+	_, err = h.db.DesireTask(logger, taskDefinition, taskGuid, domain)
 	if err != nil {
 		return err
 	}
 
+	h.requestTaskAuction(logger, taskDefinition, taskGuid, domain)
+	return nil
+}
+
+// DesireTaskWithIdempotencyKey desires the task unless the key was already
+// used for the same request, in which case it succeeds without requesting
+// another auction.
+func (h *TaskController) DesireTaskWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid, domain string) error {
+	logger = logger.Session("desire-task")
+
+	logger = logger.WithData(lager.Data{"task_guid": taskGuid, "idempotency_key": key.Key})
+
+	taskDefinition.NormalizeEgressRules()
+	_, replayed, err := h.db.DesireTaskWithIdempotencyKey(logger, key, taskDefinition, taskGuid, domain)
+	if err != nil || replayed {
+		return err
+	}
+
+	h.requestTaskAuction(logger, taskDefinition, taskGuid, domain)
+	return nil
+}
+
+func (h *TaskController) requestTaskAuction(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) {
 	logger.Debug("start-task-auction-request")
 	taskStartRequest := auctioneer.NewTaskStartRequestFromModel(taskGuid, domain, taskDefinition)
-	err = h.auctioneerClient.RequestTaskAuctions([]*auctioneer.TaskStartRequest{&taskStartRequest})
+	err := h.auctioneerClient.RequestTaskAuctions([]*auctioneer.TaskStartRequest{&taskStartRequest})
 	if err != nil {
 		logger.Error("failed-requesting-task-auction", err)
 		// The creation succeeded, the auction request error can be dropped
 	} else {
 		logger.Debug("succeeded-requesting-task-auction")
 	}
-
-	return nil
 }
 
 func (h *TaskController) StartTask(logger lager.Logger, taskGuid, cellId string) (shouldStart bool, err error) {
 	logger = logger.Session("start-task", lager.Data{"task_guid": taskGuid, "cell_id": cellId})
-	return h.db.StartTask(logger, taskGuid, cellId)
+	_, _, shouldStart, err = h.db.StartTask(logger, taskGuid, cellId)
+	return shouldStart, err
 }
 
 func (h *TaskController) CancelTask(logger lager.Logger, taskGuid string) error {
 	logger = logger.Session("cancel-task")
 
-	task, cellID, err := h.db.CancelTask(logger, taskGuid)
+	_, task, cellID, err := h.db.CancelTask(logger, taskGuid)
 	if err != nil {
 		return err
 	}
@@ -95,32 +146,72 @@ func (h *TaskController) CancelTask(logger lager.Logger, taskGuid string) error
 		return nil
 	}
 
+	h.cancelTaskOnCell(logger, taskGuid, cellID)
+	return nil
+}
+
+func (h *TaskController) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error) {
+	logger = logger.Session("cancel-tasks")
+
+	result, err := h.db.CancelTasks(logger, domain, taskGuids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cancelled := range result.Cancelled {
+		if cancelled.Task.CompletionCallbackUrl != "" {
+			logger.Info("task-client-completing-task", lager.Data{"task_guid": cancelled.Task.TaskGuid})
+			go h.taskCompletionClient.Submit(h.db, cancelled.Task)
+		}
+
+		if cancelled.CellID != "" {
+			h.cancelTaskOnCell(logger, cancelled.Task.TaskGuid, cancelled.CellID)
+		}
+	}
+
+	return result, nil
+}
+
+// cancelTaskOnCell asks the rep to stop a cancelled task. Failures are only
+// logged, since the rep will converge on the task's state later.
+func (h *TaskController) cancelTaskOnCell(logger lager.Logger, taskGuid, cellID string) {
 	logger.Info("start-check-cell-presence", lager.Data{"cell_id": cellID})
 	cellPresence, err := h.serviceClient.CellById(logger, cellID)
 	if err != nil {
 		logger.Error("failed-fetching-cell-presence", err)
-		// don't return an error, the rep will converge later
-		return nil
+		return
 	}
 	logger.Info("finished-check-cell-presence", lager.Data{"cell_id": cellID})
 
 	repClient := h.repClientFactory.CreateClient(cellPresence.RepAddress)
 	logger.Info("start-rep-cancel-task", lager.Data{"task_guid": taskGuid})
-	repClient.CancelTask(taskGuid)
+	err = repClient.CancelTask(taskGuid)
 	if err != nil {
 		logger.Error("failed-rep-cancel-task", err)
-		// don't return an error, the rep will converge later
-		return nil
+		return
 	}
 	logger.Info("finished-rep-cancel-task", lager.Data{"task_guid": taskGuid})
-	return nil
 }
 
+// FailTask fails the task. A pending task is failed by the auctioneer when it
+// cannot be placed, so with a retry budget it is rejected instead, to be
+// auctioned again by convergence, and only failed once its last rejection
+// would use up the budget.
 func (h *TaskController) FailTask(logger lager.Logger, taskGuid, failureReason string) error {
 	var err error
 	logger = logger.Session("fail-task")
 
-	task, err := h.db.FailTask(logger, taskGuid, failureReason)
+	if h.maxTaskRejections > 0 {
+		rejected, err := h.rejectWithinBudget(logger, taskGuid, failureReason)
+		if err != nil {
+			return err
+		}
+		if rejected {
+			return nil
+		}
+	}
+
+	_, task, err := h.db.FailTask(logger, taskGuid, failureReason)
 	if err != nil {
 		return err
 	}
@@ -133,6 +224,45 @@ func (h *TaskController) FailTask(logger lager.Logger, taskGuid, failureReason s
 	return nil
 }
 
+// RejectTask records that the pending task could not be placed. The task is
+// auctioned again by convergence, which fails it once it has been rejected as
+// many times as its retry budget allows.
+func (h *TaskController) RejectTask(logger lager.Logger, taskGuid, rejectionReason string) error {
+	logger = logger.Session("reject-task")
+
+	_, task, err := h.db.RejectTask(logger, taskGuid, rejectionReason)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("rejected-task", lager.Data{"task_guid": taskGuid, "rejection_count": task.RejectionCount})
+	return nil
+}
+
+// rejectWithinBudget rejects the task if it is pending and has rejections
+// left in its budget, and reports whether it did.
+func (h *TaskController) rejectWithinBudget(logger lager.Logger, taskGuid, rejectionReason string) (bool, error) {
+	task, err := h.db.TaskByGuid(logger, taskGuid)
+	if err != nil {
+		return false, err
+	}
+	if task.State != models.Task_Pending || int(task.RejectionCount)+1 >= h.maxTaskRejections {
+		return false, nil
+	}
+
+	_, task, err = h.db.RejectTask(logger, taskGuid, rejectionReason)
+	if bbsErr := models.ConvertError(err); bbsErr != nil && bbsErr.Type == models.Error_InvalidStateTransition {
+		// the task left the pending state since it was read, so it is failed as asked
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	logger.Info("rejected-task-instead-of-failing", lager.Data{"task_guid": taskGuid, "rejection_count": task.RejectionCount, "max_task_rejections": h.maxTaskRejections})
+	return true, nil
+}
+
 func (h *TaskController) CompleteTask(
 	logger lager.Logger,
 	taskGuid,
@@ -144,7 +274,7 @@ func (h *TaskController) CompleteTask(
 	var err error
 	logger = logger.Session("complete-task")
 
-	task, err := h.db.CompleteTask(logger, taskGuid, cellId, failed, failureReason, result)
+	_, task, err := h.db.CompleteTask(logger, taskGuid, cellId, failed, failureReason, result)
 	if err != nil {
 		return err
 	}
@@ -160,13 +290,21 @@ func (h *TaskController) CompleteTask(
 func (h *TaskController) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	logger = logger.Session("resolving-task")
 
-	return h.db.ResolvingTask(logger, taskGuid)
+	_, _, err := h.db.ResolvingTask(logger, taskGuid)
+	return err
 }
 
 func (h *TaskController) DeleteTask(logger lager.Logger, taskGuid string) error {
 	logger = logger.Session("delete-task")
 
-	return h.db.DeleteTask(logger, taskGuid)
+	_, err := h.db.DeleteTask(logger, taskGuid)
+	return err
+}
+
+func (h *TaskController) PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error) {
+	logger = logger.Session("purge-completed-tasks")
+
+	return h.db.PurgeCompletedTasks(logger, minAge)
 }
 
 func (h *TaskController) ConvergeTasks(
@@ -174,10 +312,13 @@ func (h *TaskController) ConvergeTasks(
 	kickTaskDuration,
 	expirePendingTaskDuration,
 	expireCompletedTaskDuration time.Duration,
+	maxTaskRejections int,
 ) error {
 	var err error
 	logger = logger.Session("converge-tasks")
 
+	run := h.convergenceStatus.Start(models.ConvergenceTypeTask)
+
 	logger.Debug("listing-cells")
 	cellSet, err := h.serviceClient.Cells(logger)
 	if err == models.ErrResourceNotFound {
@@ -195,6 +336,7 @@ func (h *TaskController) ConvergeTasks(
 		kickTaskDuration,
 		expirePendingTaskDuration,
 		expireCompletedTaskDuration,
+		maxTaskRejections,
 	)
 
 	if len(tasksToAuction) > 0 {
@@ -216,5 +358,10 @@ func (h *TaskController) ConvergeTasks(
 		h.taskCompletionClient.Submit(h.db, task)
 	}
 	logger.Debug("done-submitting-tasks-to-be-completed", lager.Data{"num_tasks_to_complete": len(tasksToComplete)})
+
+	run.Complete(logger, map[string]int32{
+		models.ConvergenceOperationTasksAuctioned:         int32(len(tasksToAuction)),
+		models.ConvergenceOperationTaskCallbacksSubmitted: int32(len(tasksToComplete)),
+	})
 	return nil
 }
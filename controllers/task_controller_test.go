@@ -11,6 +11,8 @@ import (
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/taskworkpool/taskworkpoolfakes"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"code.cloudfoundry.org/rep"
 	. "github.com/onsi/ginkgo"
@@ -23,6 +25,8 @@ var _ = Describe("Task Controller", func() {
 		fakeTaskDB               *dbfakes.FakeTaskDB
 		fakeAuctioneerClient     *auctioneerfakes.FakeClient
 		fakeTaskCompletionClient *taskworkpoolfakes.FakeTaskCompletionClient
+		fakeClock                *fakeclock.FakeClock
+		convergenceStatus        *controllers.ConvergenceStatusTracker
 
 		controller *controllers.TaskController
 	)
@@ -32,8 +36,11 @@ var _ = Describe("Task Controller", func() {
 		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
 		fakeTaskCompletionClient = new(taskworkpoolfakes.FakeTaskCompletionClient)
 
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		convergenceStatus = controllers.NewConvergenceStatusTracker(fakeClock)
+
 		logger = lagertest.NewTestLogger("test")
-		controller = controllers.NewTaskController(fakeTaskDB, fakeTaskCompletionClient, fakeAuctioneerClient, fakeServiceClient, fakeRepClientFactory)
+		controller = controllers.NewTaskController(fakeTaskDB, fakeTaskDB, fakeTaskCompletionClient, fakeAuctioneerClient, fakeServiceClient, fakeRepClientFactory, nil, convergenceStatus, 0)
 	})
 
 	Describe("Tasks", func() {
@@ -53,7 +60,7 @@ var _ = Describe("Task Controller", func() {
 		})
 
 		JustBeforeEach(func() {
-			actualTasks, err = controller.Tasks(logger, domain, cellId)
+			actualTasks, err = controller.Tasks(logger, models.TaskFilter{Domain: domain, CellID: cellId})
 		})
 
 		Context("when reading tasks from DB succeeds", func() {
@@ -111,6 +118,61 @@ var _ = Describe("Task Controller", func() {
 		})
 	})
 
+	Describe("StreamTasks", func() {
+		var (
+			yielded              []*models.Task
+			returnedRecordErrors []*models.RecordError
+			err                  error
+		)
+
+		BeforeEach(func() {
+			yielded = nil
+			fakeTaskDB.StreamTasksStub = func(_ lager.Logger, _ models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+				return nil, yield(&models.Task{TaskGuid: "task-guid"})
+			}
+		})
+
+		JustBeforeEach(func() {
+			returnedRecordErrors, err = controller.StreamTasks(logger, models.TaskFilter{Domain: "domain-1", CellID: "cell-id"}, func(task *models.Task) error {
+				yielded = append(yielded, task)
+				return nil
+			})
+		})
+
+		It("streams the tasks from the DB with the filter", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(yielded).To(Equal([]*models.Task{{TaskGuid: "task-guid"}}))
+
+			Expect(fakeTaskDB.StreamTasksCallCount()).To(Equal(1))
+			_, filter, _ := fakeTaskDB.StreamTasksArgsForCall(0)
+			Expect(filter).To(Equal(models.TaskFilter{Domain: "domain-1", CellID: "cell-id"}))
+		})
+
+		Context("when the DB leaves out unreadable tasks", func() {
+			var recordErrors []*models.RecordError
+
+			BeforeEach(func() {
+				recordErrors = []*models.RecordError{{Guid: "unreadable-guid", Reason: "kaboom"}}
+				fakeTaskDB.StreamTasksReturns(recordErrors, nil)
+			})
+
+			It("returns the record errors", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(returnedRecordErrors).To(Equal(recordErrors))
+			})
+		})
+
+		Context("when the DB returns an error", func() {
+			BeforeEach(func() {
+				fakeTaskDB.StreamTasksReturns(nil, errors.New("kaboom"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("kaboom"))
+			})
+		})
+	})
+
 	Describe("TaskByGuid", func() {
 		var (
 			taskGuid   = "task-guid"
@@ -169,6 +231,20 @@ var _ = Describe("Task Controller", func() {
 			err = controller.DesireTask(logger, taskDef, taskGuid, domain)
 		})
 
+		Context("when the egress rules are not in canonical form", func() {
+			BeforeEach(func() {
+				taskDef.EgressRules = []*models.SecurityGroupRule{
+					{Protocol: models.AllProtocol, Destinations: []string{"10.1.2.3/8", "10.0.0.5-10.0.0.5"}},
+				}
+			})
+
+			It("desires the task with them normalized", func() {
+				Expect(fakeTaskDB.DesireTaskCallCount()).To(Equal(1))
+				_, actualTaskDef, _, _ := fakeTaskDB.DesireTaskArgsForCall(0)
+				Expect(actualTaskDef.EgressRules[0].Destinations).To(Equal([]string{"10.0.0.0/8", "10.0.0.5"}))
+			})
+		})
+
 		Context("when the desire is successful", func() {
 			It("desires the task with the requested definitions", func() {
 				Expect(err).NotTo(HaveOccurred())
@@ -236,7 +312,7 @@ var _ = Describe("Task Controller", func() {
 
 		Context("when desiring the task fails", func() {
 			BeforeEach(func() {
-				fakeTaskDB.DesireTaskReturns(errors.New("kaboom"))
+				fakeTaskDB.DesireTaskReturns(nil, errors.New("kaboom"))
 			})
 
 			It("responds with an error", func() {
@@ -245,6 +321,67 @@ var _ = Describe("Task Controller", func() {
 		})
 	})
 
+	Describe("DesireTaskWithIdempotencyKey", func() {
+		var (
+			taskGuid = "task-guid"
+			domain   = "domain"
+			key      = models.IdempotencyKey{Key: "retry-key", Fingerprint: "some-fingerprint"}
+			taskDef  *models.TaskDefinition
+			err      error
+		)
+
+		BeforeEach(func() {
+			taskDef = model_helpers.NewValidTaskDefinition()
+		})
+
+		JustBeforeEach(func() {
+			err = controller.DesireTaskWithIdempotencyKey(logger, key, taskDef, taskGuid, domain)
+		})
+
+		Context("when the task is created", func() {
+			BeforeEach(func() {
+				fakeTaskDB.DesireTaskWithIdempotencyKeyReturns(nil, false, nil)
+			})
+
+			It("desires the task under the key", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeTaskDB.DesireTaskWithIdempotencyKeyCallCount()).To(Equal(1))
+				_, actualKey, actualTaskDef, actualTaskGuid, actualDomain := fakeTaskDB.DesireTaskWithIdempotencyKeyArgsForCall(0)
+				Expect(actualKey).To(Equal(key))
+				Expect(actualTaskDef).To(Equal(taskDef))
+				Expect(actualTaskGuid).To(Equal(taskGuid))
+				Expect(actualDomain).To(Equal(domain))
+			})
+
+			It("requests an auction", func() {
+				Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the request is a replay", func() {
+			BeforeEach(func() {
+				fakeTaskDB.DesireTaskWithIdempotencyKeyReturns(nil, true, nil)
+			})
+
+			It("succeeds without requesting another auction", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the key was used for a different request", func() {
+			BeforeEach(func() {
+				fakeTaskDB.DesireTaskWithIdempotencyKeyReturns(nil, false, models.ErrIdempotencyKeyConflict)
+			})
+
+			It("returns the conflict without requesting an auction", func() {
+				Expect(err).To(Equal(models.ErrIdempotencyKeyConflict))
+				Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("StartTask", func() {
 		Context("when the start is successful", func() {
 			var (
@@ -272,7 +409,7 @@ var _ = Describe("Task Controller", func() {
 
 			Context("when the task should start", func() {
 				BeforeEach(func() {
-					fakeTaskDB.StartTaskReturns(true, nil)
+					fakeTaskDB.StartTaskReturns(nil, nil, true, nil)
 				})
 
 				It("responds with true", func() {
@@ -283,7 +420,7 @@ var _ = Describe("Task Controller", func() {
 
 			Context("when the task should not start", func() {
 				BeforeEach(func() {
-					fakeTaskDB.StartTaskReturns(false, nil)
+					fakeTaskDB.StartTaskReturns(nil, nil, false, nil)
 				})
 
 				It("responds with false", func() {
@@ -294,7 +431,7 @@ var _ = Describe("Task Controller", func() {
 
 			Context("when the DB fails", func() {
 				BeforeEach(func() {
-					fakeTaskDB.StartTaskReturns(false, errors.New("kaboom"))
+					fakeTaskDB.StartTaskReturns(nil, nil, false, errors.New("kaboom"))
 				})
 
 				It("bubbles up the underlying model error", func() {
@@ -314,7 +451,7 @@ var _ = Describe("Task Controller", func() {
 			taskGuid = "task-guid"
 			cellID = "the-cell"
 			task := model_helpers.NewValidTask("hi-bob")
-			fakeTaskDB.CancelTaskReturns(task, cellID, nil)
+			fakeTaskDB.CancelTaskReturns(nil, task, cellID, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -340,7 +477,7 @@ var _ = Describe("Task Controller", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
 						task.CompletionCallbackUrl = "bogus"
-						fakeTaskDB.CancelTaskReturns(task, cellID, nil)
+						fakeTaskDB.CancelTaskReturns(nil, task, cellID, nil)
 					})
 
 					It("causes the workpool to complete its callback work", func() {
@@ -351,7 +488,7 @@ var _ = Describe("Task Controller", func() {
 				Context("but the task has no complete URL", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
-						fakeTaskDB.CancelTaskReturns(task, cellID, nil)
+						fakeTaskDB.CancelTaskReturns(nil, task, cellID, nil)
 					})
 
 					It("does not complete the task callback", func() {
@@ -372,7 +509,7 @@ var _ = Describe("Task Controller", func() {
 				Context("when the task has no cell id", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
-						fakeTaskDB.CancelTaskReturns(task, "", nil)
+						fakeTaskDB.CancelTaskReturns(nil, task, "", nil)
 					})
 
 					It("does not return an error", func() {
@@ -415,7 +552,7 @@ var _ = Describe("Task Controller", func() {
 
 			Context("when cancelling the task fails", func() {
 				BeforeEach(func() {
-					fakeTaskDB.CancelTaskReturns(nil, "", errors.New("kaboom"))
+					fakeTaskDB.CancelTaskReturns(nil, nil, "", errors.New("kaboom"))
 				})
 
 				It("responds with an error", func() {
@@ -425,6 +562,109 @@ var _ = Describe("Task Controller", func() {
 		})
 	})
 
+	Describe("CancelTasks", func() {
+		var (
+			runningTask, pendingTask *models.Task
+			result                   *models.CancelTasksResult
+			err                      error
+		)
+
+		BeforeEach(func() {
+			runningTask = model_helpers.NewValidTask("running-task")
+			runningTask.CompletionCallbackUrl = "bogus"
+			pendingTask = model_helpers.NewValidTask("pending-task")
+
+			fakeTaskDB.CancelTasksReturns(&models.CancelTasksResult{
+				Cancelled: []models.CancelledTask{
+					{Task: runningTask, CellID: "the-cell"},
+					{Task: pendingTask},
+				},
+				NotCancellable: []string{"completed-task"},
+				NotFound:       []string{"missing-task"},
+			}, nil)
+
+			cellPresence := models.CellPresence{CellId: "the-cell"}
+			fakeServiceClient.CellByIdReturns(&cellPresence, nil)
+		})
+
+		JustBeforeEach(func() {
+			result, err = controller.CancelTasks(logger, "the-domain", []string{"running-task", "pending-task", "completed-task", "missing-task"})
+		})
+
+		It("cancels the tasks in the db", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeTaskDB.CancelTasksCallCount()).To(Equal(1))
+			_, domain, guids := fakeTaskDB.CancelTasksArgsForCall(0)
+			Expect(domain).To(Equal("the-domain"))
+			Expect(guids).To(Equal([]string{"running-task", "pending-task", "completed-task", "missing-task"}))
+		})
+
+		It("returns the db result", func() {
+			Expect(result.Cancelled).To(HaveLen(2))
+			Expect(result.NotCancellable).To(Equal([]string{"completed-task"}))
+			Expect(result.NotFound).To(Equal([]string{"missing-task"}))
+		})
+
+		It("submits callbacks only for cancelled tasks with a completion callback url", func() {
+			Eventually(fakeTaskCompletionClient.SubmitCallCount).Should(Equal(1))
+			_, task := fakeTaskCompletionClient.SubmitArgsForCall(0)
+			Expect(task).To(Equal(runningTask))
+		})
+
+		It("stops only the tasks that were running on a cell", func() {
+			Expect(fakeServiceClient.CellByIdCallCount()).To(Equal(1))
+			_, cellID := fakeServiceClient.CellByIdArgsForCall(0)
+			Expect(cellID).To(Equal("the-cell"))
+
+			Expect(fakeRepClient.CancelTaskCallCount()).To(Equal(1))
+			Expect(fakeRepClient.CancelTaskArgsForCall(0)).To(Equal("running-task"))
+		})
+
+		Context("when cancelling the tasks fails", func() {
+			BeforeEach(func() {
+				fakeTaskDB.CancelTasksReturns(nil, errors.New("kaboom"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("kaboom"))
+			})
+		})
+	})
+
+	Describe("RejectTask", func() {
+		var err error
+
+		BeforeEach(func() {
+			task := model_helpers.NewValidTask("task-guid")
+			task.RejectionCount = 1
+			fakeTaskDB.RejectTaskReturns(nil, task, nil)
+		})
+
+		JustBeforeEach(func() {
+			err = controller.RejectTask(logger, "task-guid", "insufficient resources")
+		})
+
+		Context("when rejecting the task succeeds", func() {
+			It("records the rejection", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeTaskDB.RejectTaskCallCount()).To(Equal(1))
+				_, actualTaskGuid, actualRejectionReason := fakeTaskDB.RejectTaskArgsForCall(0)
+				Expect(actualTaskGuid).To(Equal("task-guid"))
+				Expect(actualRejectionReason).To(Equal("insufficient resources"))
+			})
+		})
+
+		Context("when rejecting the task fails", func() {
+			BeforeEach(func() {
+				fakeTaskDB.RejectTaskReturns(nil, nil, errors.New("kaboom"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("kaboom"))
+			})
+		})
+	})
+
 	Describe("FailTask", func() {
 		var (
 			taskGuid      string
@@ -436,7 +676,7 @@ var _ = Describe("Task Controller", func() {
 			taskGuid = "task-guid"
 			failureReason = "just cuz ;)"
 			task := model_helpers.NewValidTask("hi-bob")
-			fakeTaskDB.FailTaskReturns(task, nil)
+			fakeTaskDB.FailTaskReturns(nil, task, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -455,7 +695,7 @@ var _ = Describe("Task Controller", func() {
 				BeforeEach(func() {
 					task := model_helpers.NewValidTask("hi-bob")
 					task.CompletionCallbackUrl = "bogus"
-					fakeTaskDB.FailTaskReturns(task, nil)
+					fakeTaskDB.FailTaskReturns(nil, task, nil)
 				})
 
 				It("causes the workpool to complete its callback work", func() {
@@ -466,7 +706,7 @@ var _ = Describe("Task Controller", func() {
 			Context("but the task has no complete URL", func() {
 				BeforeEach(func() {
 					task := model_helpers.NewValidTask("hi-bob")
-					fakeTaskDB.FailTaskReturns(task, nil)
+					fakeTaskDB.FailTaskReturns(nil, task, nil)
 				})
 
 				It("does not complete the task callback", func() {
@@ -477,13 +717,81 @@ var _ = Describe("Task Controller", func() {
 
 		Context("when failing the task fails", func() {
 			BeforeEach(func() {
-				fakeTaskDB.FailTaskReturns(nil, errors.New("kaboom"))
+				fakeTaskDB.FailTaskReturns(nil, nil, errors.New("kaboom"))
 			})
 
 			It("responds with an error", func() {
 				Expect(err).To(MatchError("kaboom"))
 			})
 		})
+
+		Context("with a retry budget", func() {
+			var task *models.Task
+
+			BeforeEach(func() {
+				controller = controllers.NewTaskController(fakeTaskDB, fakeTaskDB, fakeTaskCompletionClient, fakeAuctioneerClient, fakeServiceClient, fakeRepClientFactory, nil, convergenceStatus, 3)
+
+				task = model_helpers.NewValidTask(taskGuid)
+				task.State = models.Task_Pending
+				task.RejectionCount = 1
+				fakeTaskDB.TaskByGuidReturns(task, nil)
+				fakeTaskDB.RejectTaskReturns(nil, task, nil)
+			})
+
+			It("rejects the pending task instead of failing it", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeTaskDB.RejectTaskCallCount()).To(Equal(1))
+				_, actualTaskGuid, rejectionReason := fakeTaskDB.RejectTaskArgsForCall(0)
+				Expect(actualTaskGuid).To(Equal(taskGuid))
+				Expect(rejectionReason).To(Equal(failureReason))
+				Expect(fakeTaskDB.FailTaskCallCount()).To(Equal(0))
+			})
+
+			Context("when the rejection would use up the budget", func() {
+				BeforeEach(func() {
+					task.RejectionCount = 2
+				})
+
+				It("fails the task", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeTaskDB.RejectTaskCallCount()).To(Equal(0))
+					Expect(fakeTaskDB.FailTaskCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the task is not pending", func() {
+				BeforeEach(func() {
+					task.State = models.Task_Running
+				})
+
+				It("fails the task", func() {
+					Expect(fakeTaskDB.RejectTaskCallCount()).To(Equal(0))
+					Expect(fakeTaskDB.FailTaskCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the task leaves the pending state before it is rejected", func() {
+				BeforeEach(func() {
+					fakeTaskDB.RejectTaskReturns(nil, nil, models.NewTaskTransitionError(models.Task_Running, models.Task_Pending))
+				})
+
+				It("fails the task", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeTaskDB.FailTaskCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the task cannot be read", func() {
+				BeforeEach(func() {
+					fakeTaskDB.TaskByGuidReturns(nil, models.ErrResourceNotFound)
+				})
+
+				It("responds with the error", func() {
+					Expect(err).To(Equal(models.ErrResourceNotFound))
+					Expect(fakeTaskDB.FailTaskCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 
 	Describe("CompleteTask", func() {
@@ -504,7 +812,7 @@ var _ = Describe("Task Controller", func() {
 			result = "yeah"
 
 			task := model_helpers.NewValidTask("hi-bob")
-			fakeTaskDB.CompleteTaskReturns(task, nil)
+			fakeTaskDB.CompleteTaskReturns(nil, task, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -528,7 +836,7 @@ var _ = Describe("Task Controller", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
 						task.CompletionCallbackUrl = "bogus"
-						fakeTaskDB.CompleteTaskReturns(task, nil)
+						fakeTaskDB.CompleteTaskReturns(nil, task, nil)
 					})
 
 					It("causes the workpool to complete its callback work", func() {
@@ -539,7 +847,7 @@ var _ = Describe("Task Controller", func() {
 				Context("but the task has no complete URL", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
-						fakeTaskDB.CompleteTaskReturns(task, nil)
+						fakeTaskDB.CompleteTaskReturns(nil, task, nil)
 					})
 
 					It("does not complete the task callback", func() {
@@ -551,7 +859,7 @@ var _ = Describe("Task Controller", func() {
 
 		Context("when completing the task fails", func() {
 			BeforeEach(func() {
-				fakeTaskDB.CompleteTaskReturns(nil, errors.New("kaboom"))
+				fakeTaskDB.CompleteTaskReturns(nil, nil, errors.New("kaboom"))
 			})
 
 			It("responds with an error", func() {
@@ -586,7 +894,7 @@ var _ = Describe("Task Controller", func() {
 
 			Context("when desiring the task fails", func() {
 				BeforeEach(func() {
-					fakeTaskDB.ResolvingTaskReturns(errors.New("kaboom"))
+					fakeTaskDB.ResolvingTaskReturns(nil, nil, errors.New("kaboom"))
 				})
 
 				It("responds with an error", func() {
@@ -622,7 +930,7 @@ var _ = Describe("Task Controller", func() {
 
 			Context("when desiring the task fails", func() {
 				BeforeEach(func() {
-					fakeTaskDB.DeleteTaskReturns(errors.New("kaboom"))
+					fakeTaskDB.DeleteTaskReturns(nil, errors.New("kaboom"))
 				})
 
 				It("responds with an error", func() {
@@ -632,12 +940,49 @@ var _ = Describe("Task Controller", func() {
 		})
 	})
 
+	Describe("PurgeCompletedTasks", func() {
+		var (
+			purged int
+			err    error
+		)
+
+		JustBeforeEach(func() {
+			purged, err = controller.PurgeCompletedTasks(logger, time.Hour)
+		})
+
+		Context("when purging the tasks succeeds", func() {
+			BeforeEach(func() {
+				fakeTaskDB.PurgeCompletedTasksReturns(2, nil)
+			})
+
+			It("purges the tasks older than the given age and returns the count", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(purged).To(Equal(2))
+
+				Expect(fakeTaskDB.PurgeCompletedTasksCallCount()).To(Equal(1))
+				_, minAge := fakeTaskDB.PurgeCompletedTasksArgsForCall(0)
+				Expect(minAge).To(Equal(time.Hour))
+			})
+		})
+
+		Context("when purging the tasks fails", func() {
+			BeforeEach(func() {
+				fakeTaskDB.PurgeCompletedTasksReturns(0, errors.New("kaboom"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("kaboom"))
+			})
+		})
+	})
+
 	Describe("ConvergeTasks", func() {
 		Context("when the request is normal", func() {
 			var (
 				kickTaskDuration            = 10 * time.Second
 				expirePendingTaskDuration   = 10 * time.Second
 				expireCompletedTaskDuration = 10 * time.Second
+				maxTaskRejections           = 3
 				cellSet                     models.CellSet
 				err                         error
 			)
@@ -649,18 +994,41 @@ var _ = Describe("Task Controller", func() {
 			})
 
 			JustBeforeEach(func() {
-				err = controller.ConvergeTasks(logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+				err = controller.ConvergeTasks(logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections)
 			})
 
 			It("calls ConvergeTasks", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fakeTaskDB.ConvergeTasksCallCount()).To(Equal(1))
-				taskLogger, actualCellSet, actualKickDuration, actualPendingDuration, actualCompletedDuration := fakeTaskDB.ConvergeTasksArgsForCall(0)
+				taskLogger, actualCellSet, actualKickDuration, actualPendingDuration, actualCompletedDuration, actualMaxTaskRejections := fakeTaskDB.ConvergeTasksArgsForCall(0)
 				Expect(taskLogger.SessionName()).To(ContainSubstring("converge-tasks"))
 				Expect(actualCellSet).To(BeEquivalentTo(cellSet))
 				Expect(actualKickDuration).To(BeEquivalentTo(kickTaskDuration))
 				Expect(actualPendingDuration).To(BeEquivalentTo(expirePendingTaskDuration))
 				Expect(actualCompletedDuration).To(BeEquivalentTo(expireCompletedTaskDuration))
+				Expect(actualMaxTaskRejections).To(Equal(maxTaskRejections))
+			})
+
+			Context("when the pass completes", func() {
+				BeforeEach(func() {
+					fakeTaskDB.ConvergeTasksStub = func(lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration, int) ([]*auctioneer.TaskStartRequest, []*models.Task) {
+						fakeClock.Increment(3 * time.Second)
+						return []*auctioneer.TaskStartRequest{{}}, []*models.Task{{TaskGuid: "a"}, {TaskGuid: "b"}}
+					}
+				})
+
+				It("records when it completed, how long it took and what it did", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(convergenceStatus.ConvergenceStatuses()).To(ConsistOf(&models.ConvergenceStatus{
+						Type:        models.ConvergenceTypeTask,
+						CompletedAt: fakeClock.Now().UnixNano(),
+						Duration:    int64(3 * time.Second),
+						Operations: map[string]int32{
+							models.ConvergenceOperationTasksAuctioned:         1,
+							models.ConvergenceOperationTaskCallbacksSubmitted: 2,
+						},
+					}))
+				})
 			})
 
 			Context("when fetching cells fails", func() {
@@ -672,6 +1040,10 @@ var _ = Describe("Task Controller", func() {
 					Expect(err).To(MatchError("kaboom"))
 					Expect(fakeTaskDB.ConvergeTasksCallCount()).To(Equal(0))
 				})
+
+				It("does not record the pass", func() {
+					Expect(convergenceStatus.ConvergenceStatuses()).To(BeEmpty())
+				})
 			})
 
 			Context("when fetching cells returns ErrResourceNotFound", func() {
@@ -682,7 +1054,7 @@ var _ = Describe("Task Controller", func() {
 				It("calls ConvergeTasks with an empty CellSet", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(fakeTaskDB.ConvergeTasksCallCount()).To(Equal(1))
-					_, actualCellSet, _, _, _ := fakeTaskDB.ConvergeTasksArgsForCall(0)
+					_, actualCellSet, _, _, _, _ := fakeTaskDB.ConvergeTasksArgsForCall(0)
 					Expect(actualCellSet).To(BeEquivalentTo(models.CellSet{}))
 				})
 			})
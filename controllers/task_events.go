@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// taskEventsDB emits an event to the task hub for each change made to a task
+// through it, from the tasks the DB returns as they were before and after the
+// change. Changes made by convergence and by purging completed tasks are not
+// emitted.
+type taskEventsDB struct {
+	db.TaskDB
+	taskHub events.Hub
+}
+
+func newTaskEventsDB(taskDB db.TaskDB, taskHub events.Hub) db.TaskDB {
+	return &taskEventsDB{TaskDB: taskDB, taskHub: taskHub}
+}
+
+func (t *taskEventsDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) (*models.Task, error) {
+	task, err := t.TaskDB.DesireTask(logger, taskDefinition, taskGuid, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	t.taskHub.Emit(models.NewTaskCreatedEvent(task))
+	return task, nil
+}
+
+func (t *taskEventsDB) DesireTaskWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid, domain string) (*models.Task, bool, error) {
+	task, replayed, err := t.TaskDB.DesireTaskWithIdempotencyKey(logger, key, taskDefinition, taskGuid, domain)
+	if err != nil || replayed {
+		return task, replayed, err
+	}
+
+	t.taskHub.Emit(models.NewTaskCreatedEvent(task))
+	return task, false, nil
+}
+
+func (t *taskEventsDB) StartTask(logger lager.Logger, taskGuid, cellId string) (*models.Task, *models.Task, bool, error) {
+	before, after, shouldStart, err := t.TaskDB.StartTask(logger, taskGuid, cellId)
+	if err != nil || !shouldStart {
+		return before, after, shouldStart, err
+	}
+
+	t.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	return before, after, true, nil
+}
+
+func (t *taskEventsDB) CancelTask(logger lager.Logger, taskGuid string) (*models.Task, *models.Task, string, error) {
+	before, after, cellID, err := t.TaskDB.CancelTask(logger, taskGuid)
+	if err != nil {
+		return before, after, cellID, err
+	}
+
+	t.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	return before, after, cellID, nil
+}
+
+func (t *taskEventsDB) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error) {
+	result, err := t.TaskDB.CancelTasks(logger, domain, taskGuids)
+	if err != nil {
+		return result, err
+	}
+
+	for _, cancelled := range result.Cancelled {
+		t.taskHub.Emit(models.NewTaskChangedEvent(cancelled.Before, cancelled.Task))
+	}
+	return result, nil
+}
+
+func (t *taskEventsDB) FailTask(logger lager.Logger, taskGuid, failureReason string) (*models.Task, *models.Task, error) {
+	before, after, err := t.TaskDB.FailTask(logger, taskGuid, failureReason)
+	if err != nil {
+		return before, after, err
+	}
+
+	t.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	return before, after, nil
+}
+
+func (t *taskEventsDB) RejectTask(logger lager.Logger, taskGuid, rejectionReason string) (*models.Task, *models.Task, error) {
+	before, after, err := t.TaskDB.RejectTask(logger, taskGuid, rejectionReason)
+	if err != nil {
+		return before, after, err
+	}
+
+	t.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	return before, after, nil
+}
+
+func (t *taskEventsDB) CompleteTask(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (*models.Task, *models.Task, error) {
+	before, after, err := t.TaskDB.CompleteTask(logger, taskGuid, cellId, failed, failureReason, result)
+	if err != nil {
+		return before, after, err
+	}
+
+	t.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	return before, after, nil
+}
+
+func (t *taskEventsDB) ResolvingTask(logger lager.Logger, taskGuid string) (*models.Task, *models.Task, error) {
+	before, after, err := t.TaskDB.ResolvingTask(logger, taskGuid)
+	if err != nil {
+		return before, after, err
+	}
+
+	t.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	return before, after, nil
+}
+
+func (t *taskEventsDB) DeleteTask(logger lager.Logger, taskGuid string) (*models.Task, error) {
+	task, err := t.TaskDB.DeleteTask(logger, taskGuid)
+	if err != nil {
+		return task, err
+	}
+
+	t.taskHub.Emit(models.NewTaskRemovedEvent(task))
+	return task, nil
+}
@@ -0,0 +1,174 @@
+package controllers_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/bbs/taskworkpool/taskworkpoolfakes"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task Controller events", func() {
+	var (
+		logger                   *lagertest.TestLogger
+		fakeTaskDB               *dbfakes.FakeTaskDB
+		fakeTaskCompletionClient *taskworkpoolfakes.FakeTaskCompletionClient
+		taskHub                  *eventfakes.FakeHub
+
+		before, after *models.Task
+
+		controller *controllers.TaskController
+	)
+
+	BeforeEach(func() {
+		fakeTaskDB = new(dbfakes.FakeTaskDB)
+		fakeTaskCompletionClient = new(taskworkpoolfakes.FakeTaskCompletionClient)
+		taskHub = new(eventfakes.FakeHub)
+
+		before = model_helpers.NewValidTask("task-guid")
+		before.State = models.Task_Pending
+		after = model_helpers.NewValidTask("task-guid")
+		after.State = models.Task_Running
+
+		logger = lagertest.NewTestLogger("test")
+		controller = controllers.NewTaskController(fakeTaskDB, fakeTaskDB, fakeTaskCompletionClient, new(auctioneerfakes.FakeClient), fakeServiceClient, fakeRepClientFactory, taskHub, nil, 0)
+	})
+
+	Describe("DesireTask", func() {
+		BeforeEach(func() {
+			fakeTaskDB.DesireTaskReturns(before, nil)
+		})
+
+		It("emits a task created event with the desired task", func() {
+			err := controller.DesireTask(logger, before.TaskDefinition, "task-guid", "domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(taskHub.EmitCallCount()).To(Equal(1))
+			Expect(taskHub.EmitArgsForCall(0)).To(Equal(models.NewTaskCreatedEvent(before)))
+			Expect(fakeTaskDB.TaskByGuidCallCount()).To(Equal(0))
+		})
+
+		Context("when desiring the task fails", func() {
+			BeforeEach(func() {
+				fakeTaskDB.DesireTaskReturns(nil, errors.New("boom"))
+			})
+
+			It("does not emit an event", func() {
+				err := controller.DesireTask(logger, before.TaskDefinition, "task-guid", "domain")
+				Expect(err).To(HaveOccurred())
+				Expect(taskHub.EmitCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DesireTaskWithIdempotencyKey", func() {
+		Context("when the request is replayed", func() {
+			BeforeEach(func() {
+				fakeTaskDB.DesireTaskWithIdempotencyKeyReturns(nil, true, nil)
+			})
+
+			It("does not emit an event", func() {
+				err := controller.DesireTaskWithIdempotencyKey(logger, models.IdempotencyKey{Key: "key"}, before.TaskDefinition, "task-guid", "domain")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(taskHub.EmitCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("StartTask", func() {
+		It("emits a task changed event with the tasks the DB returns", func() {
+			fakeTaskDB.StartTaskReturns(before, after, true, nil)
+
+			_, err := controller.StartTask(logger, "task-guid", "cell-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(taskHub.EmitCallCount()).To(Equal(1))
+			Expect(taskHub.EmitArgsForCall(0)).To(Equal(models.NewTaskChangedEvent(before, after)))
+			Expect(fakeTaskDB.TaskByGuidCallCount()).To(Equal(0))
+		})
+
+		Context("when the task should not be started", func() {
+			It("does not emit an event", func() {
+				fakeTaskDB.StartTaskReturns(after, after, false, nil)
+
+				_, err := controller.StartTask(logger, "task-guid", "cell-id")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(taskHub.EmitCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CompleteTask", func() {
+		It("emits a task changed event", func() {
+			fakeTaskDB.CompleteTaskReturns(before, after, nil)
+
+			err := controller.CompleteTask(logger, "task-guid", "cell-id", false, "", "result")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(taskHub.EmitCallCount()).To(Equal(1))
+			Expect(taskHub.EmitArgsForCall(0)).To(Equal(models.NewTaskChangedEvent(before, after)))
+		})
+	})
+
+	Describe("CancelTasks", func() {
+		It("emits a task changed event for each cancelled task without listing the tasks", func() {
+			fakeTaskDB.CancelTasksReturns(&models.CancelTasksResult{
+				Cancelled: []models.CancelledTask{{Before: before, Task: after}},
+			}, nil)
+
+			_, err := controller.CancelTasks(logger, "domain", []string{"task-guid"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(taskHub.EmitCallCount()).To(Equal(1))
+			Expect(taskHub.EmitArgsForCall(0)).To(Equal(models.NewTaskChangedEvent(before, after)))
+			Expect(fakeTaskDB.TasksCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("ResolvingTask", func() {
+		It("emits a task changed event", func() {
+			fakeTaskDB.ResolvingTaskReturns(before, after, nil)
+
+			err := controller.ResolvingTask(logger, "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(taskHub.EmitCallCount()).To(Equal(1))
+			Expect(taskHub.EmitArgsForCall(0)).To(Equal(models.NewTaskChangedEvent(before, after)))
+			Expect(fakeTaskDB.TaskByGuidCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("DeleteTask", func() {
+		BeforeEach(func() {
+			fakeTaskDB.DeleteTaskReturns(before, nil)
+		})
+
+		It("emits a task removed event with the deleted task", func() {
+			err := controller.DeleteTask(logger, "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(taskHub.EmitCallCount()).To(Equal(1))
+			Expect(taskHub.EmitArgsForCall(0)).To(Equal(models.NewTaskRemovedEvent(before)))
+			Expect(fakeTaskDB.TaskByGuidCallCount()).To(Equal(0))
+		})
+
+		Context("when deleting the task fails", func() {
+			BeforeEach(func() {
+				fakeTaskDB.DeleteTaskReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("does not emit an event", func() {
+				err := controller.DeleteTask(logger, "task-guid")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+				Expect(taskHub.EmitCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
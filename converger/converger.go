@@ -1,6 +1,7 @@
 package converger
 
 import (
+	"math/rand"
 	"os"
 	"sync"
 	"time"
@@ -13,30 +14,57 @@ import (
 	"code.cloudfoundry.org/clock"
 )
 
+// MinimumConvergeInterval is the shortest interval the converger will wait
+// between passes, so that a misconfigured interval cannot hammer the database.
+const MinimumConvergeInterval = time.Second
+
+// MaximumConvergeJitter bounds the fraction by which each interval may be
+// randomly lengthened or shortened.
+const MaximumConvergeJitter = 0.5
+
 type Converger struct {
 	id                          string
 	serviceClient               bbs.ServiceClient
+	lockHolder                  LockHolder
+	pauseChecker                PauseChecker
 	lrpConvergenceController    LrpConvergenceController
 	taskController              TaskController
 	logger                      lager.Logger
 	clock                       clock.Clock
-	convergeRepeatInterval      time.Duration
+	lrpConvergeInterval         time.Duration
+	taskConvergeInterval        time.Duration
+	convergeJitter              float64
 	kickTaskDuration            time.Duration
 	expirePendingTaskDuration   time.Duration
 	expireCompletedTaskDuration time.Duration
+	maxTaskRejections           int
 	closeOnce                   *sync.Once
+	random                      *rand.Rand
 }
 
+// New creates a converger that runs LRP and Task convergence on their own
+// intervals. Every cycle waits for its interval randomly adjusted by up to
+// convergeJitter of it in either direction, so that the BBS does not wake up
+// at the same moment as the other subsystems converging on a schedule.
+// Intervals are raised to MinimumConvergeInterval and the jitter is clamped
+// to [0, MaximumConvergeJitter]. Task convergence fails pending Tasks rejected
+// maxTaskRejections times, unless it is 0. Passes are skipped while the lock is
+// not held or convergence is paused.
 func New(
 	logger lager.Logger,
 	clock clock.Clock,
 	lrpConvergenceController LrpConvergenceController,
 	taskController TaskController,
 	serviceClient bbs.ServiceClient,
-	convergeRepeatInterval,
+	lockHolder LockHolder,
+	pauseChecker PauseChecker,
+	lrpConvergeInterval,
+	taskConvergeInterval time.Duration,
+	convergeJitter float64,
 	kickTaskDuration,
 	expirePendingTaskDuration,
 	expireCompletedTaskDuration time.Duration,
+	maxTaskRejections int,
 ) *Converger {
 
 	uuid, err := uuid.NewV4()
@@ -44,29 +72,59 @@ func New(
 		panic("Failed to generate a random guid....:" + err.Error())
 	}
 
+	if lrpConvergeInterval < MinimumConvergeInterval {
+		lrpConvergeInterval = MinimumConvergeInterval
+	}
+	if taskConvergeInterval < MinimumConvergeInterval {
+		taskConvergeInterval = MinimumConvergeInterval
+	}
+	if convergeJitter < 0 {
+		convergeJitter = 0
+	}
+	if convergeJitter > MaximumConvergeJitter {
+		convergeJitter = MaximumConvergeJitter
+	}
+
 	return &Converger{
 		id:                          uuid.String(),
 		logger:                      logger,
 		clock:                       clock,
 		serviceClient:               serviceClient,
+		lockHolder:                  lockHolder,
+		pauseChecker:                pauseChecker,
 		lrpConvergenceController:    lrpConvergenceController,
 		taskController:              taskController,
-		convergeRepeatInterval:      convergeRepeatInterval,
+		lrpConvergeInterval:         lrpConvergeInterval,
+		taskConvergeInterval:        taskConvergeInterval,
+		convergeJitter:              convergeJitter,
 		kickTaskDuration:            kickTaskDuration,
 		expirePendingTaskDuration:   expirePendingTaskDuration,
 		expireCompletedTaskDuration: expireCompletedTaskDuration,
+		maxTaskRejections:           maxTaskRejections,
 		closeOnce:                   &sync.Once{},
+		random:                      rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
 func (c *Converger) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	logger := c.logger.Session("converger-process")
-	logger.Info("started")
+	logger.Info("started", lager.Data{
+		"lrp-converge-interval":  c.lrpConvergeInterval.String(),
+		"task-converge-interval": c.taskConvergeInterval.String(),
+		"converge-jitter":        c.convergeJitter,
+	})
+
+	lrpTimer := c.clock.NewTimer(c.jittered(c.lrpConvergeInterval))
+	taskTimer := c.clock.NewTimer(c.jittered(c.taskConvergeInterval))
 
-	convergeTimer := c.clock.NewTimer(c.convergeRepeatInterval)
+	passes := &sync.WaitGroup{}
+	lrpPass := newConvergencePass(c.convergeLRPs, lrpTimer)
+	taskPass := newConvergencePass(c.convergeTasks, taskTimer)
 	defer func() {
+		lrpTimer.Stop()
+		taskTimer.Stop()
+		passes.Wait()
 		logger.Info("done")
-		convergeTimer.Stop()
 	}()
 
 	cellEvents := c.serviceClient.CellEvents(logger)
@@ -82,55 +140,147 @@ func (c *Converger) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 			switch event.EventType() {
 			case models.EventTypeCellDisappeared:
 				logger.Info("received-cell-disappeared-event", lager.Data{"cell-id": event.CellIDs()})
-				c.converge()
+				convergenceLogger := c.logger.Session("executing-convergence")
+				lrpPass.start(convergenceLogger, passes)
+				taskPass.start(convergenceLogger, passes)
+			default:
+				lrpPass.postpone(c.jittered(c.lrpConvergeInterval))
+				taskPass.postpone(c.jittered(c.taskConvergeInterval))
 			}
 
-		case <-convergeTimer.C():
-			c.converge()
+		case <-lrpTimer.C():
+			lrpPass.start(c.logger.Session("executing-convergence"), passes)
+
+		case <-taskTimer.C():
+			taskPass.start(c.logger.Session("executing-convergence"), passes)
+
+		case <-lrpPass.done:
+			lrpPass.finish(c.logger.Session("executing-convergence"), passes, c.jittered(c.lrpConvergeInterval))
+
+		case <-taskPass.done:
+			taskPass.finish(c.logger.Session("executing-convergence"), passes, c.jittered(c.taskConvergeInterval))
 		}
+	}
+}
+
+// convergencePass runs LRP or Task convergence in the background, so that the
+// two can overlap, but never more than one of the same kind at a time. Its
+// timer is stopped while the pass runs and reset once it is done, so that the
+// interval is measured from the end of the previous pass. It is only used
+// from the loop of Run.
+type convergencePass struct {
+	converge func(lager.Logger)
+	timer    clock.Timer
+	done     chan struct{}
+
+	running bool
+	rerun   bool
+}
 
-		convergeTimer.Reset(c.convergeRepeatInterval)
+func newConvergencePass(converge func(lager.Logger), timer clock.Timer) *convergencePass {
+	return &convergencePass{
+		converge: converge,
+		timer:    timer,
+		done:     make(chan struct{}, 1),
 	}
 }
 
-func (c *Converger) converge() {
-	logger := c.logger.Session("executing-convergence")
-	wg := sync.WaitGroup{}
+// start runs the pass, or runs it again as soon as it is done if it is
+// already running, as the running pass may have missed the change that
+// prompted this one.
+func (p *convergencePass) start(logger lager.Logger, passes *sync.WaitGroup) {
+	if p.running {
+		p.rerun = true
+		return
+	}
+
+	p.running = true
+	p.timer.Stop()
 
-	wg.Add(1)
+	passes.Add(1)
 	go func() {
-		logger.Info("converge-tasks-started")
-
-		defer func() {
-			logger.Info("converge-tasks-done")
-			wg.Done()
-		}()
-
-		err := c.taskController.ConvergeTasks(
-			c.logger,
-			c.kickTaskDuration,
-			c.expirePendingTaskDuration,
-			c.expireCompletedTaskDuration,
-		)
-		if err != nil {
-			logger.Error("failed-to-converge-tasks", err)
-		}
+		defer passes.Done()
+		p.converge(logger)
+		p.done <- struct{}{}
 	}()
+}
 
-	wg.Add(1)
-	go func() {
-		logger.Info("converge-lrps-started")
+func (p *convergencePass) finish(logger lager.Logger, passes *sync.WaitGroup, interval time.Duration) {
+	p.running = false
+	if p.rerun {
+		p.rerun = false
+		p.start(logger, passes)
+		return
+	}
 
-		defer func() {
-			logger.Info("converge-lrps-done")
-			wg.Done()
-		}()
+	p.timer.Reset(interval)
+}
 
-		err := c.lrpConvergenceController.ConvergeLRPs(c.logger)
-		if err != nil {
-			logger.Error("failed-to-converge-lrps", err)
-		}
-	}()
+// postpone restarts the interval before the next pass, unless one is running.
+func (p *convergencePass) postpone(interval time.Duration) {
+	if !p.running {
+		p.timer.Reset(interval)
+	}
+}
+
+// jittered returns the interval moved by a random amount of up to
+// convergeJitter of it in either direction, never below
+// MinimumConvergeInterval.
+func (c *Converger) jittered(interval time.Duration) time.Duration {
+	if c.convergeJitter == 0 {
+		return interval
+	}
+
+	offset := (2*c.random.Float64() - 1) * c.convergeJitter * float64(interval)
+	jittered := interval + time.Duration(offset)
+	if jittered < MinimumConvergeInterval {
+		return MinimumConvergeInterval
+	}
+	return jittered
+}
+
+func (c *Converger) convergeTasks(logger lager.Logger) {
+	if !c.lockHolder.HoldsLock() {
+		logger.Info("skipping-task-convergence-lock-not-held")
+		return
+	}
+
+	if c.pauseChecker.Paused(logger) {
+		logger.Info("skipping-task-convergence-paused")
+		return
+	}
 
-	wg.Wait()
+	logger.Info("converge-tasks-started")
+	defer logger.Info("converge-tasks-done")
+
+	err := c.taskController.ConvergeTasks(
+		c.logger,
+		c.kickTaskDuration,
+		c.expirePendingTaskDuration,
+		c.expireCompletedTaskDuration,
+		c.maxTaskRejections,
+	)
+	if err != nil {
+		logger.Error("failed-to-converge-tasks", err)
+	}
+}
+
+func (c *Converger) convergeLRPs(logger lager.Logger) {
+	if !c.lockHolder.HoldsLock() {
+		logger.Info("skipping-lrp-convergence-lock-not-held")
+		return
+	}
+
+	if c.pauseChecker.Paused(logger) {
+		logger.Info("skipping-lrp-convergence-paused")
+		return
+	}
+
+	logger.Info("converge-lrps-started")
+	defer logger.Info("converge-lrps-done")
+
+	err := c.lrpConvergenceController.ConvergeLRPs(c.logger, models.ConvergenceFilter{})
+	if err != nil {
+		logger.Error("failed-to-converge-lrps", err)
+	}
 }
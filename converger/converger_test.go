@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/tedsuo/ifrit"
 	"github.com/tedsuo/ifrit/ginkgomon"
@@ -25,12 +26,17 @@ var _ = Describe("ConvergerProcess", func() {
 		fakeLrpConvergenceController *fake_controllers.FakeLrpConvergenceController
 		fakeTaskController           *fake_controllers.FakeTaskController
 		fakeBBSServiceClient         *fake_bbs.FakeServiceClient
+		fakeLockHolder               *fake_controllers.FakeLockHolder
+		fakePauseChecker             *fake_controllers.FakePauseChecker
 		logger                       *lagertest.TestLogger
 		fakeClock                    *fakeclock.FakeClock
-		convergeRepeatInterval       time.Duration
+		lrpConvergeInterval          time.Duration
+		taskConvergeInterval         time.Duration
+		convergeJitter               float64
 		kickTaskDuration             time.Duration
 		expirePendingTaskDuration    time.Duration
 		expireCompletedTaskDuration  time.Duration
+		maxTaskRejections            int
 
 		process ifrit.Process
 
@@ -42,14 +48,20 @@ var _ = Describe("ConvergerProcess", func() {
 		fakeLrpConvergenceController = new(fake_controllers.FakeLrpConvergenceController)
 		fakeTaskController = new(fake_controllers.FakeTaskController)
 		fakeBBSServiceClient = new(fake_bbs.FakeServiceClient)
+		fakeLockHolder = new(fake_controllers.FakeLockHolder)
+		fakeLockHolder.HoldsLockReturns(true)
+		fakePauseChecker = new(fake_controllers.FakePauseChecker)
 		logger = lagertest.NewTestLogger("test")
 		fakeClock = fakeclock.NewFakeClock(time.Now())
 
-		convergeRepeatInterval = 1 * time.Second
+		lrpConvergeInterval = 1 * time.Second
+		taskConvergeInterval = 1 * time.Second
+		convergeJitter = 0
 
 		kickTaskDuration = 10 * time.Millisecond
 		expirePendingTaskDuration = 30 * time.Second
 		expireCompletedTaskDuration = 60 * time.Minute
+		maxTaskRejections = 3
 
 		cellEvents := make(chan models.CellEvent, 100)
 		errs := make(chan error, 100)
@@ -68,14 +80,24 @@ var _ = Describe("ConvergerProcess", func() {
 				fakeLrpConvergenceController,
 				fakeTaskController,
 				fakeBBSServiceClient,
-				convergeRepeatInterval,
+				fakeLockHolder,
+				fakePauseChecker,
+				lrpConvergeInterval,
+				taskConvergeInterval,
+				convergeJitter,
 				kickTaskDuration,
 				expirePendingTaskDuration,
 				expireCompletedTaskDuration,
+				maxTaskRejections,
 			),
 		)
 	})
 
+	increment := func(duration time.Duration) {
+		Eventually(fakeClock.WatcherCount).Should(Equal(2))
+		fakeClock.Increment(duration)
+	}
+
 	AfterEach(func() {
 		ginkgomon.Interrupt(process)
 		Eventually(process.Wait()).Should(Receive())
@@ -83,25 +105,123 @@ var _ = Describe("ConvergerProcess", func() {
 
 	Describe("converging over time", func() {
 		It("converges tasks, LRPs, and auctions when the lock is periodically reestablished", func() {
-			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			increment(lrpConvergeInterval + aBit)
 
 			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(1))
 			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
+			_, filter := fakeLrpConvergenceController.ConvergeLRPsArgsForCall(0)
+			Expect(filter.IsScoped()).To(BeFalse())
 
-			_, actualKickTaskDuration, actualExpirePendingTaskDuration, actualExpireCompletedTaskDuration := fakeTaskController.ConvergeTasksArgsForCall(0)
+			_, actualKickTaskDuration, actualExpirePendingTaskDuration, actualExpireCompletedTaskDuration, actualMaxTaskRejections := fakeTaskController.ConvergeTasksArgsForCall(0)
 			Expect(actualKickTaskDuration).To(Equal(kickTaskDuration))
 			Expect(actualExpirePendingTaskDuration).To(Equal(expirePendingTaskDuration))
 			Expect(actualExpireCompletedTaskDuration).To(Equal(expireCompletedTaskDuration))
+			Expect(actualMaxTaskRejections).To(Equal(maxTaskRejections))
 
-			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			increment(lrpConvergeInterval + aBit)
 
 			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(2))
 			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(2))
 
-			_, actualKickTaskDuration, actualExpirePendingTaskDuration, actualExpireCompletedTaskDuration = fakeTaskController.ConvergeTasksArgsForCall(1)
+			_, actualKickTaskDuration, actualExpirePendingTaskDuration, actualExpireCompletedTaskDuration, actualMaxTaskRejections = fakeTaskController.ConvergeTasksArgsForCall(1)
 			Expect(actualKickTaskDuration).To(Equal(kickTaskDuration))
 			Expect(actualExpirePendingTaskDuration).To(Equal(expirePendingTaskDuration))
 			Expect(actualExpireCompletedTaskDuration).To(Equal(expireCompletedTaskDuration))
+			Expect(actualMaxTaskRejections).To(Equal(maxTaskRejections))
+		})
+	})
+
+	Describe("converging LRPs and tasks on separate intervals", func() {
+		BeforeEach(func() {
+			lrpConvergeInterval = 2 * time.Second
+			taskConvergeInterval = 5 * time.Second
+		})
+
+		It("converges each on its own schedule", func() {
+			increment(lrpConvergeInterval + aBit)
+			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
+			Consistently(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(0))
+
+			increment(lrpConvergeInterval + aBit)
+			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(2))
+			Consistently(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(0))
+
+			increment(taskConvergeInterval - 2*lrpConvergeInterval)
+			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(1))
+		})
+	})
+
+	Describe("converging with jitter", func() {
+		BeforeEach(func() {
+			lrpConvergeInterval = 10 * time.Second
+			taskConvergeInterval = time.Hour
+			convergeJitter = 0.2
+		})
+
+		It("converges within the jittered window of every interval", func() {
+			earliest := 8 * time.Second
+			latest := 12 * time.Second
+
+			for i := 1; i <= 10; i++ {
+				increment(earliest - aBit)
+				Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(i - 1))
+
+				increment(latest - earliest + 2*aBit)
+				Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(i))
+			}
+		})
+	})
+
+	Describe("converging with an interval below the minimum", func() {
+		BeforeEach(func() {
+			lrpConvergeInterval = time.Millisecond
+			taskConvergeInterval = time.Millisecond
+		})
+
+		It("waits for the minimum interval instead", func() {
+			increment(converger.MinimumConvergeInterval - aBit)
+			Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(0))
+
+			increment(2 * aBit)
+			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
+			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(1))
+		})
+	})
+
+	Describe("converging while the lock is not held", func() {
+		BeforeEach(func() {
+			fakeLockHolder.HoldsLockReturns(false)
+		})
+
+		It("skips convergence until the lock is held again", func() {
+			increment(lrpConvergeInterval + aBit)
+			Consistently(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(0))
+			Expect(fakeLrpConvergenceController.ConvergeLRPsCallCount()).To(Equal(0))
+
+			fakeLockHolder.HoldsLockReturns(true)
+
+			increment(lrpConvergeInterval + aBit)
+			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(1))
+			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
+		})
+	})
+
+	Describe("converging while convergence is paused", func() {
+		BeforeEach(func() {
+			fakePauseChecker.PausedReturns(true)
+		})
+
+		It("skips convergence until it is resumed", func() {
+			increment(lrpConvergeInterval + aBit)
+			Consistently(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(0))
+			Expect(fakeLrpConvergenceController.ConvergeLRPsCallCount()).To(Equal(0))
+			Expect(fakePauseChecker.PausedCallCount()).To(Equal(2))
+
+			fakePauseChecker.PausedReturns(false)
+
+			increment(lrpConvergeInterval + aBit)
+			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(1))
+			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
 		})
 	})
 
@@ -117,10 +237,11 @@ var _ = Describe("ConvergerProcess", func() {
 			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(1))
 			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
 
-			_, actualKickTaskDuration, actualExpirePendingTaskDuration, actualExpireCompletedTaskDuration := fakeTaskController.ConvergeTasksArgsForCall(0)
+			_, actualKickTaskDuration, actualExpirePendingTaskDuration, actualExpireCompletedTaskDuration, actualMaxTaskRejections := fakeTaskController.ConvergeTasksArgsForCall(0)
 			Expect(actualKickTaskDuration).To(Equal(kickTaskDuration))
 			Expect(actualExpirePendingTaskDuration).To(Equal(expirePendingTaskDuration))
 			Expect(actualExpireCompletedTaskDuration).To(Equal(expireCompletedTaskDuration))
+			Expect(actualMaxTaskRejections).To(Equal(maxTaskRejections))
 
 			waitErrs <- errors.New("whoopsie")
 
@@ -133,7 +254,7 @@ var _ = Describe("ConvergerProcess", func() {
 		})
 
 		It("defers convergence to one full interval later", func() {
-			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval - aBit)
+			increment(lrpConvergeInterval - aBit)
 
 			waitEvents <- models.CellDisappearedEvent{
 				IDs: []string{"some-cell-id"},
@@ -142,14 +263,62 @@ var _ = Describe("ConvergerProcess", func() {
 			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(1))
 			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
 
-			fakeClock.WaitForWatcherAndIncrement(2 * aBit)
+			increment(2 * aBit)
 
 			Consistently(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(1))
 			Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
 
-			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			increment(lrpConvergeInterval + aBit)
 			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(2))
 			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(2))
 		})
 	})
+
+	Describe("overlapping LRP and Task convergence", func() {
+		var release chan struct{}
+
+		BeforeEach(func() {
+			release = make(chan struct{})
+			fakeLrpConvergenceController.ConvergeLRPsStub = func(lager.Logger, models.ConvergenceFilter) error {
+				<-release
+				return nil
+			}
+		})
+
+		AfterEach(func() {
+			select {
+			case <-release:
+			default:
+				close(release)
+			}
+		})
+
+		It("converges tasks while an LRP pass is still running", func() {
+			increment(lrpConvergeInterval + aBit)
+			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
+			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(1))
+
+			Eventually(fakeClock.WatcherCount).Should(Equal(1))
+			fakeClock.Increment(taskConvergeInterval + aBit)
+			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(2))
+			Expect(fakeLrpConvergenceController.ConvergeLRPsCallCount()).To(Equal(1))
+
+			close(release)
+			Eventually(fakeClock.WatcherCount).Should(Equal(2))
+		})
+
+		It("runs a pass prompted while the same pass is running once it is done", func() {
+			increment(lrpConvergeInterval + aBit)
+			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
+
+			waitEvents <- models.CellDisappearedEvent{
+				IDs: []string{"some-cell-id"},
+			}
+			Eventually(fakeTaskController.ConvergeTasksCallCount, aBit).Should(Equal(2))
+			Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(1))
+
+			close(release)
+			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount, aBit).Should(Equal(2))
+		})
+	})
 })
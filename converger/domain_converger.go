@@ -0,0 +1,118 @@
+package converger
+
+import (
+	"os"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_domain_lister.go . DomainLister
+
+type DomainLister interface {
+	Domains(logger lager.Logger) ([]string, error)
+}
+
+// DomainConverger converges the LRPs of one fresh domain at a time, moving on
+// to the next domain in name order on every tick. This keeps each domain
+// reconciling on its own schedule between the global passes of the Converger.
+type DomainConverger struct {
+	logger                   lager.Logger
+	clock                    clock.Clock
+	lrpConvergenceController LrpConvergenceController
+	domainLister             DomainLister
+	lockHolder               LockHolder
+	pauseChecker             PauseChecker
+	interval                 time.Duration
+	lastDomain               string
+}
+
+func NewDomainConverger(
+	logger lager.Logger,
+	clock clock.Clock,
+	lrpConvergenceController LrpConvergenceController,
+	domainLister DomainLister,
+	lockHolder LockHolder,
+	pauseChecker PauseChecker,
+	interval time.Duration,
+) *DomainConverger {
+	return &DomainConverger{
+		logger:                   logger,
+		clock:                    clock,
+		lrpConvergenceController: lrpConvergenceController,
+		domainLister:             domainLister,
+		lockHolder:               lockHolder,
+		pauseChecker:             pauseChecker,
+		interval:                 interval,
+	}
+}
+
+func (c *DomainConverger) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := c.logger.Session("domain-converger-process")
+	logger.Info("started")
+
+	timer := c.clock.NewTimer(c.interval)
+	defer func() {
+		logger.Info("done")
+		timer.Stop()
+	}()
+
+	close(ready)
+
+	for {
+		select {
+		case <-signals:
+			return nil
+
+		case <-timer.C():
+			c.convergeNextDomain(logger)
+		}
+
+		timer.Reset(c.interval)
+	}
+}
+
+func (c *DomainConverger) convergeNextDomain(logger lager.Logger) {
+	if !c.lockHolder.HoldsLock() {
+		logger.Info("skipping-convergence-lock-not-held")
+		return
+	}
+
+	if c.pauseChecker.Paused(logger) {
+		logger.Info("skipping-convergence-paused")
+		return
+	}
+
+	domains, err := c.domainLister.Domains(logger)
+	if err != nil {
+		logger.Error("failed-listing-domains", err)
+		return
+	}
+
+	if len(domains) == 0 {
+		return
+	}
+
+	// pick the first domain after the last one converged, so that domains
+	// coming and going between ticks do not restart the cycle
+	sort.Strings(domains)
+	next := domains[0]
+	for _, domain := range domains {
+		if domain > c.lastDomain {
+			next = domain
+			break
+		}
+	}
+	c.lastDomain = next
+
+	logger.Info("converge-domain-started", lager.Data{"domain": next})
+	err = c.lrpConvergenceController.ConvergeLRPs(logger, models.ConvergenceFilter{Domains: []string{next}})
+	if err != nil {
+		logger.Error("failed-to-converge-domain", err, lager.Data{"domain": next})
+		return
+	}
+	logger.Info("converge-domain-done", lager.Data{"domain": next})
+}
@@ -0,0 +1,160 @@
+package converger_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs/converger"
+	"code.cloudfoundry.org/bbs/converger/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/ginkgomon"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DomainConverger", func() {
+	var (
+		fakeLrpConvergenceController *fake_controllers.FakeLrpConvergenceController
+		fakeDomainLister             *fake_controllers.FakeDomainLister
+		fakeLockHolder               *fake_controllers.FakeLockHolder
+		fakePauseChecker             *fake_controllers.FakePauseChecker
+		logger                       *lagertest.TestLogger
+		fakeClock                    *fakeclock.FakeClock
+		interval                     time.Duration
+
+		process ifrit.Process
+	)
+
+	convergedDomains := func() []string {
+		domains := []string{}
+		for i := 0; i < fakeLrpConvergenceController.ConvergeLRPsCallCount(); i++ {
+			_, filter := fakeLrpConvergenceController.ConvergeLRPsArgsForCall(i)
+			domains = append(domains, filter.Domains...)
+		}
+		return domains
+	}
+
+	tick := func() {
+		fakeClock.WaitForWatcherAndIncrement(interval + aBit)
+	}
+
+	BeforeEach(func() {
+		fakeLrpConvergenceController = new(fake_controllers.FakeLrpConvergenceController)
+		fakeDomainLister = new(fake_controllers.FakeDomainLister)
+		fakeLockHolder = new(fake_controllers.FakeLockHolder)
+		fakeLockHolder.HoldsLockReturns(true)
+		fakePauseChecker = new(fake_controllers.FakePauseChecker)
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		interval = 5 * time.Second
+
+		fakeDomainLister.DomainsReturns([]string{"domain-b", "domain-a"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		process = ifrit.Invoke(converger.NewDomainConverger(
+			logger,
+			fakeClock,
+			fakeLrpConvergenceController,
+			fakeDomainLister,
+			fakeLockHolder,
+			fakePauseChecker,
+			interval,
+		))
+	})
+
+	AfterEach(func() {
+		ginkgomon.Interrupt(process)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	It("converges one domain per tick, cycling through the domains in order", func() {
+		tick()
+		Eventually(convergedDomains).Should(Equal([]string{"domain-a"}))
+
+		tick()
+		Eventually(convergedDomains).Should(Equal([]string{"domain-a", "domain-b"}))
+
+		tick()
+		Eventually(convergedDomains).Should(Equal([]string{"domain-a", "domain-b", "domain-a"}))
+	})
+
+	It("scopes each pass to a single domain", func() {
+		tick()
+		Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(1))
+		_, filter := fakeLrpConvergenceController.ConvergeLRPsArgsForCall(0)
+		Expect(filter).To(Equal(models.ConvergenceFilter{Domains: []string{"domain-a"}}))
+	})
+
+	It("converges with the logger of its session", func() {
+		tick()
+		Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(1))
+		convergeLogger, _ := fakeLrpConvergenceController.ConvergeLRPsArgsForCall(0)
+		Expect(convergeLogger.SessionName()).To(Equal("test.domain-converger-process"))
+	})
+
+	Context("when the lock is not held", func() {
+		BeforeEach(func() {
+			fakeLockHolder.HoldsLockReturns(false)
+		})
+
+		It("does not converge any domain", func() {
+			tick()
+			Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(0))
+			Expect(fakeDomainLister.DomainsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when convergence is paused", func() {
+		BeforeEach(func() {
+			fakePauseChecker.PausedReturns(true)
+		})
+
+		It("does not converge any domain", func() {
+			tick()
+			Eventually(fakePauseChecker.PausedCallCount).Should(Equal(1))
+			Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(0))
+			Expect(fakeDomainLister.DomainsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a domain appears between ticks", func() {
+		It("picks it up in name order without restarting the cycle", func() {
+			tick()
+			Eventually(convergedDomains).Should(Equal([]string{"domain-a"}))
+
+			fakeDomainLister.DomainsReturns([]string{"domain-a", "domain-b", "domain-aa"}, nil)
+
+			tick()
+			Eventually(convergedDomains).Should(Equal([]string{"domain-a", "domain-aa"}))
+		})
+	})
+
+	Context("when listing domains fails", func() {
+		BeforeEach(func() {
+			fakeDomainLister.DomainsReturns(nil, errors.New("boom"))
+		})
+
+		It("does not converge", func() {
+			tick()
+			Eventually(fakeDomainLister.DomainsCallCount).Should(Equal(1))
+			Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(0))
+		})
+	})
+
+	Context("when there are no domains", func() {
+		BeforeEach(func() {
+			fakeDomainLister.DomainsReturns([]string{}, nil)
+		})
+
+		It("does not converge", func() {
+			tick()
+			Eventually(fakeDomainLister.DomainsCallCount).Should(Equal(1))
+			Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/converger"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeDomainLister struct {
+	DomainsStub        func(logger lager.Logger) ([]string, error)
+	domainsMutex       sync.RWMutex
+	domainsArgsForCall []struct {
+		logger lager.Logger
+	}
+	domainsReturns struct {
+		result1 []string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDomainLister) Domains(logger lager.Logger) ([]string, error) {
+	fake.domainsMutex.Lock()
+	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Domains", []interface{}{logger})
+	fake.domainsMutex.Unlock()
+	if fake.DomainsStub != nil {
+		return fake.DomainsStub(logger)
+	} else {
+		return fake.domainsReturns.result1, fake.domainsReturns.result2
+	}
+}
+
+func (fake *FakeDomainLister) DomainsCallCount() int {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	return len(fake.domainsArgsForCall)
+}
+
+func (fake *FakeDomainLister) DomainsArgsForCall(i int) lager.Logger {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	return fake.domainsArgsForCall[i].logger
+}
+
+func (fake *FakeDomainLister) DomainsReturns(result1 []string, result2 error) {
+	fake.DomainsStub = nil
+	fake.domainsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainLister) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDomainLister) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.DomainLister = new(FakeDomainLister)
@@ -0,0 +1,66 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/converger"
+)
+
+type FakeLockHolder struct {
+	HoldsLockStub        func() bool
+	holdsLockMutex       sync.RWMutex
+	holdsLockArgsForCall []struct{}
+	holdsLockReturns     struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLockHolder) HoldsLock() bool {
+	fake.holdsLockMutex.Lock()
+	fake.holdsLockArgsForCall = append(fake.holdsLockArgsForCall, struct{}{})
+	fake.recordInvocation("HoldsLock", []interface{}{})
+	fake.holdsLockMutex.Unlock()
+	if fake.HoldsLockStub != nil {
+		return fake.HoldsLockStub()
+	} else {
+		return fake.holdsLockReturns.result1
+	}
+}
+
+func (fake *FakeLockHolder) HoldsLockCallCount() int {
+	fake.holdsLockMutex.RLock()
+	defer fake.holdsLockMutex.RUnlock()
+	return len(fake.holdsLockArgsForCall)
+}
+
+func (fake *FakeLockHolder) HoldsLockReturns(result1 bool) {
+	fake.HoldsLockStub = nil
+	fake.holdsLockReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeLockHolder) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.holdsLockMutex.RLock()
+	defer fake.holdsLockMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeLockHolder) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.LockHolder = new(FakeLockHolder)
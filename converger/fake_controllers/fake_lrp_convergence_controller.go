@@ -5,14 +5,16 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/bbs/converger"
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
 
 type FakeLrpConvergenceController struct {
-	ConvergeLRPsStub        func(logger lager.Logger) error
+	ConvergeLRPsStub        func(logger lager.Logger, filter models.ConvergenceFilter) error
 	convergeLRPsMutex       sync.RWMutex
 	convergeLRPsArgsForCall []struct {
 		logger lager.Logger
+		filter models.ConvergenceFilter
 	}
 	convergeLRPsReturns struct {
 		result1 error
@@ -21,15 +23,16 @@ type FakeLrpConvergenceController struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeLrpConvergenceController) ConvergeLRPs(logger lager.Logger) error {
+func (fake *FakeLrpConvergenceController) ConvergeLRPs(logger lager.Logger, filter models.ConvergenceFilter) error {
 	fake.convergeLRPsMutex.Lock()
 	fake.convergeLRPsArgsForCall = append(fake.convergeLRPsArgsForCall, struct {
 		logger lager.Logger
-	}{logger})
-	fake.recordInvocation("ConvergeLRPs", []interface{}{logger})
+		filter models.ConvergenceFilter
+	}{logger, filter})
+	fake.recordInvocation("ConvergeLRPs", []interface{}{logger, filter})
 	fake.convergeLRPsMutex.Unlock()
 	if fake.ConvergeLRPsStub != nil {
-		return fake.ConvergeLRPsStub(logger)
+		return fake.ConvergeLRPsStub(logger, filter)
 	} else {
 		return fake.convergeLRPsReturns.result1
 	}
@@ -41,10 +44,10 @@ func (fake *FakeLrpConvergenceController) ConvergeLRPsCallCount() int {
 	return len(fake.convergeLRPsArgsForCall)
 }
 
-func (fake *FakeLrpConvergenceController) ConvergeLRPsArgsForCall(i int) lager.Logger {
+func (fake *FakeLrpConvergenceController) ConvergeLRPsArgsForCall(i int) (lager.Logger, models.ConvergenceFilter) {
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
-	return fake.convergeLRPsArgsForCall[i].logger
+	return fake.convergeLRPsArgsForCall[i].logger, fake.convergeLRPsArgsForCall[i].filter
 }
 
 func (fake *FakeLrpConvergenceController) ConvergeLRPsReturns(result1 error) {
@@ -0,0 +1,77 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/converger"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakePauseChecker struct {
+	PausedStub        func(logger lager.Logger) bool
+	pausedMutex       sync.RWMutex
+	pausedArgsForCall []struct {
+		logger lager.Logger
+	}
+	pausedReturns struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePauseChecker) Paused(logger lager.Logger) bool {
+	fake.pausedMutex.Lock()
+	fake.pausedArgsForCall = append(fake.pausedArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Paused", []interface{}{logger})
+	fake.pausedMutex.Unlock()
+	if fake.PausedStub != nil {
+		return fake.PausedStub(logger)
+	} else {
+		return fake.pausedReturns.result1
+	}
+}
+
+func (fake *FakePauseChecker) PausedCallCount() int {
+	fake.pausedMutex.RLock()
+	defer fake.pausedMutex.RUnlock()
+	return len(fake.pausedArgsForCall)
+}
+
+func (fake *FakePauseChecker) PausedArgsForCall(i int) lager.Logger {
+	fake.pausedMutex.RLock()
+	defer fake.pausedMutex.RUnlock()
+	return fake.pausedArgsForCall[i].logger
+}
+
+func (fake *FakePauseChecker) PausedReturns(result1 bool) {
+	fake.PausedStub = nil
+	fake.pausedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePauseChecker) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pausedMutex.RLock()
+	defer fake.pausedMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePauseChecker) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.PauseChecker = new(FakePauseChecker)
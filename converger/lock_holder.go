@@ -0,0 +1,10 @@
+package converger
+
+//go:generate counterfeiter -o fake_controllers/fake_lock_holder.go . LockHolder
+
+// LockHolder reports whether this BBS currently holds the lock. Convergence
+// writes to the database, so it is skipped while the lock is not held, such
+// as during a handoff to another instance.
+type LockHolder interface {
+	HoldsLock() bool
+}
@@ -1,9 +1,12 @@
 package converger
 
-import "code.cloudfoundry.org/lager"
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
 
 //go:generate counterfeiter -o fake_controllers/fake_lrp_convergence_controller.go . LrpConvergenceController
 
 type LrpConvergenceController interface {
-	ConvergeLRPs(logger lager.Logger) error
+	ConvergeLRPs(logger lager.Logger, filter models.ConvergenceFilter) error
 }
@@ -0,0 +1,12 @@
+package converger
+
+import "code.cloudfoundry.org/lager"
+
+//go:generate counterfeiter -o fake_controllers/fake_pause_checker.go . PauseChecker
+
+// PauseChecker reports whether an operator has paused convergence. Scheduled
+// passes are skipped while it is paused, as they are while the lock is not
+// held. *controllers.ConvergencePause is a PauseChecker.
+type PauseChecker interface {
+	Paused(logger lager.Logger) bool
+}
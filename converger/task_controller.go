@@ -9,5 +9,5 @@ import (
 //go:generate counterfeiter -o fake_controllers/fake_task_controller.go . TaskController
 
 type TaskController interface {
-	ConvergeTasks(logger lager.Logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) error
+	ConvergeTasks(logger lager.Logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration, maxTaskRejections int) error
 }
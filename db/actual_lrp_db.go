@@ -9,14 +9,41 @@ import (
 
 type ActualLRPDB interface {
 	ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
+	// ActualLRPGroupsWithRecordErrors is ActualLRPGroups that, with
+	// filter.PartialResults, leaves out the actual LRPs that cannot be read
+	// and returns them as record errors instead of failing the whole read.
+	ActualLRPGroupsWithRecordErrors(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error)
 	ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, processGuid string, index int32) (*models.ActualLRPGroup, error)
 
+	// CrashingActualLRPs returns up to limit non-evacuating actual LRPs that
+	// have crashed at least once, ordered by crash count with the highest
+	// first. LRPs with the same crash count are ordered by process guid and
+	// index.
+	CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error)
+
+	// CellPlacements returns, for each cell, the claimed and running actual
+	// LRPs placed on it, evacuating ones included, with the memory and disk
+	// their desired LRPs ask for. An empty cellID returns every cell. Cells
+	// are ordered by id, and their actual LRPs by process guid and index.
+	CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error)
+
 	CreateUnclaimedActualLRP(logger lager.Logger, key *models.ActualLRPKey) (after *models.ActualLRPGroup, err error)
 	UnclaimActualLRP(logger lager.Logger, key *models.ActualLRPKey) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, err error)
 	ClaimActualLRP(logger lager.Logger, processGuid string, index int32, instanceKey *models.ActualLRPInstanceKey) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, err error)
 	StartActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, err error)
+
+	// StartActualLRPs records the actual LRPs a cell reports as running, as
+	// StartActualLRP does for each of them. When authoritative, it also
+	// unclaims the running, non-evacuating actual LRPs on the cell that the
+	// report leaves out.
+	StartActualLRPs(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) (*models.StartActualLRPsResult, error)
+
 	CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, crashReason string) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, shouldRestart bool, err error)
 	FailActualLRP(logger lager.Logger, key *models.ActualLRPKey, placementError string) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, err error)
 	RemoveActualLRP(logger lager.Logger, processGuid string, index int32, instanceKey *models.ActualLRPInstanceKey) error
+
+	// RetireActualLRPsOnCell unclaims every claimed or running, non-evacuating
+	// actual LRP on the cell, returning each before and after the change.
+	RetireActualLRPsOnCell(logger lager.Logger, cellID string) (before []*models.ActualLRPGroup, after []*models.ActualLRPGroup, err error)
 }
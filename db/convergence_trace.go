@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"runtime/trace"
+)
+
+// ConvergenceTrace marks a convergence pass as a task of the runtime
+// execution trace, and its phases as regions of that task, so that a trace
+// captured through the debug server shows which phase a slow pass spends its
+// time in. While no trace is being captured, it costs a single check per
+// call.
+type ConvergenceTrace struct {
+	ctx  context.Context
+	task *trace.Task
+}
+
+// ConvergencePhase is a phase of a convergence pass, ended by End.
+type ConvergencePhase struct {
+	region *trace.Region
+}
+
+func StartConvergenceTrace(name string) ConvergenceTrace {
+	if !trace.IsEnabled() {
+		return ConvergenceTrace{}
+	}
+
+	ctx, task := trace.NewTask(context.Background(), name)
+	return ConvergenceTrace{ctx: ctx, task: task}
+}
+
+// Phase starts the phase with the given name. Phases must be ended on the
+// goroutine they were started on.
+func (t ConvergenceTrace) Phase(name string) ConvergencePhase {
+	if t.task == nil {
+		return ConvergencePhase{}
+	}
+
+	return ConvergencePhase{region: trace.StartRegion(t.ctx, name)}
+}
+
+func (t ConvergenceTrace) End() {
+	if t.task != nil {
+		t.task.End()
+	}
+}
+
+func (p ConvergencePhase) End() {
+	if p.region != nil {
+		p.region.End()
+	}
+}
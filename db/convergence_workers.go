@@ -0,0 +1,94 @@
+package db
+
+import "sync"
+
+// ConvergenceWorkers runs the work of convergence passes on at most size
+// goroutines shared by every pass using it, so that LRP and Task convergence
+// running at the same time stay within the configured number of convergence
+// workers together. Works are run in the order they were submitted, and
+// goroutines are only kept while there is work queued.
+//
+// A work must not wait for other works of the same ConvergenceWorkers, as
+// they may never get a worker to run on.
+type ConvergenceWorkers struct {
+	size int
+
+	lock   sync.Mutex
+	queue  []func()
+	active int
+}
+
+func NewConvergenceWorkers(size int) *ConvergenceWorkers {
+	if size < 1 {
+		size = 1
+	}
+
+	return &ConvergenceWorkers{size: size}
+}
+
+// Run runs works and returns once all of them have returned.
+func (w *ConvergenceWorkers) Run(works []func()) {
+	batch := w.NewBatch()
+	for _, work := range works {
+		batch.Submit(work)
+	}
+	batch.Wait()
+}
+
+// NewBatch returns a batch to submit works to as a pass finds them, without
+// waiting for them to run.
+func (w *ConvergenceWorkers) NewBatch() *ConvergenceBatch {
+	return &ConvergenceBatch{workers: w}
+}
+
+func (w *ConvergenceWorkers) submit(work func()) {
+	w.lock.Lock()
+	w.queue = append(w.queue, work)
+	startWorker := w.active < w.size
+	if startWorker {
+		w.active++
+	}
+	w.lock.Unlock()
+
+	if startWorker {
+		go w.work()
+	}
+}
+
+func (w *ConvergenceWorkers) work() {
+	for {
+		w.lock.Lock()
+		if len(w.queue) == 0 {
+			w.active--
+			w.lock.Unlock()
+			return
+		}
+		work := w.queue[0]
+		w.queue[0] = nil
+		w.queue = w.queue[1:]
+		w.lock.Unlock()
+
+		work()
+	}
+}
+
+// ConvergenceBatch is the set of works a single pass submitted to the
+// ConvergenceWorkers.
+type ConvergenceBatch struct {
+	workers *ConvergenceWorkers
+	wg      sync.WaitGroup
+}
+
+// Submit queues work to run on the shared workers and returns immediately.
+func (b *ConvergenceBatch) Submit(work func()) {
+	b.wg.Add(1)
+	b.workers.submit(func() {
+		defer b.wg.Done()
+		work()
+	})
+}
+
+// Wait returns once every work submitted to the batch has returned.
+func (b *ConvergenceBatch) Wait() {
+	b.wg.Wait()
+}
@@ -0,0 +1,111 @@
+package db_test
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"code.cloudfoundry.org/bbs/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConvergenceWorkers", func() {
+	var (
+		workers *db.ConvergenceWorkers
+
+		running    int32
+		maxRunning int32
+		release    chan struct{}
+	)
+
+	BeforeEach(func() {
+		workers = db.NewConvergenceWorkers(3)
+		running = 0
+		maxRunning = 0
+		release = make(chan struct{})
+	})
+
+	blockingWorks := func(count int, done *int32) []func() {
+		works := []func(){}
+		for i := 0; i < count; i++ {
+			works = append(works, func() {
+				now := atomic.AddInt32(&running, 1)
+				for {
+					max := atomic.LoadInt32(&maxRunning)
+					if now <= max || atomic.CompareAndSwapInt32(&maxRunning, max, now) {
+						break
+					}
+				}
+
+				<-release
+				atomic.AddInt32(&running, -1)
+				atomic.AddInt32(done, 1)
+			})
+		}
+		return works
+	}
+
+	Describe("Run", func() {
+		It("runs every work and returns once they are done", func() {
+			var done int32
+			close(release)
+			workers.Run(blockingWorks(10, &done))
+			Expect(atomic.LoadInt32(&done)).To(BeEquivalentTo(10))
+		})
+
+		It("shares the limit between concurrent passes", func() {
+			var lrpsDone, tasksDone int32
+			wg := sync.WaitGroup{}
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				workers.Run(blockingWorks(10, &lrpsDone))
+			}()
+			go func() {
+				defer wg.Done()
+				workers.Run(blockingWorks(10, &tasksDone))
+			}()
+
+			Eventually(func() int32 { return atomic.LoadInt32(&running) }).Should(BeEquivalentTo(3))
+			Consistently(func() int32 { return atomic.LoadInt32(&running) }).Should(BeEquivalentTo(3))
+
+			close(release)
+			wg.Wait()
+			Expect(atomic.LoadInt32(&lrpsDone)).To(BeEquivalentTo(10))
+			Expect(atomic.LoadInt32(&tasksDone)).To(BeEquivalentTo(10))
+			Expect(atomic.LoadInt32(&maxRunning)).To(BeEquivalentTo(3))
+		})
+	})
+
+	Describe("NewBatch", func() {
+		It("waits only for the works submitted to the batch", func() {
+			var blockedDone, batchDone int32
+			other := workers.NewBatch()
+			other.Submit(blockingWorks(1, &blockedDone)[0])
+
+			batch := workers.NewBatch()
+			batch.Submit(func() { atomic.AddInt32(&batchDone, 1) })
+			batch.Wait()
+			Expect(atomic.LoadInt32(&batchDone)).To(BeEquivalentTo(1))
+			Expect(atomic.LoadInt32(&blockedDone)).To(BeEquivalentTo(0))
+
+			close(release)
+			other.Wait()
+			Expect(atomic.LoadInt32(&blockedDone)).To(BeEquivalentTo(1))
+		})
+	})
+
+	Context("when the size is not positive", func() {
+		BeforeEach(func() {
+			workers = db.NewConvergenceWorkers(0)
+		})
+
+		It("still runs the works, one at a time", func() {
+			var done int32
+			close(release)
+			workers.Run(blockingWorks(3, &done))
+			Expect(atomic.LoadInt32(&done)).To(BeEquivalentTo(3))
+			Expect(atomic.LoadInt32(&maxRunning)).To(BeEquivalentTo(1))
+		})
+	})
+})
@@ -0,0 +1,22 @@
+package db
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// ObjectCounts are the number of DesiredLRPs, of ActualLRP instances, not
+// counting those evacuating, and of Tasks.
+type ObjectCounts struct {
+	DesiredLRPs *models.ObjectCount
+	ActualLRPs  *models.ObjectCount
+	Tasks       *models.ObjectCount
+}
+
+//go:generate counterfeiter . CountsDB
+type CountsDB interface {
+	// ObjectCounts counts the objects without reading them into models,
+	// grouping the counts by domain and by state when asked to. DesiredLRPs
+	// have no state and are never grouped by it.
+	ObjectCounts(logger lager.Logger, byDomain, byState bool) (*ObjectCounts, error)
+}
@@ -3,9 +3,11 @@ package db
 //go:generate counterfeiter . DB
 
 type DB interface {
+	CountsDB
 	DomainDB
 	EncryptionDB
 	EvacuationDB
+	ImportDB
 	LRPDB
 	TaskDB
 	VersionDB
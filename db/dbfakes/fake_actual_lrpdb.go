@@ -20,6 +20,17 @@ type FakeActualLRPDB struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPGroupsWithRecordErrorsStub        func(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error)
+	actualLRPGroupsWithRecordErrorsMutex       sync.RWMutex
+	actualLRPGroupsWithRecordErrorsArgsForCall []struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}
+	actualLRPGroupsWithRecordErrorsReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}
 	ActualLRPGroupsByProcessGuidStub        func(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
 	actualLRPGroupsByProcessGuidArgsForCall []struct {
@@ -41,6 +52,26 @@ type FakeActualLRPDB struct {
 		result1 *models.ActualLRPGroup
 		result2 error
 	}
+	CrashingActualLRPsStub        func(logger lager.Logger, limit int) ([]*models.ActualLRP, error)
+	crashingActualLRPsMutex       sync.RWMutex
+	crashingActualLRPsArgsForCall []struct {
+		logger lager.Logger
+		limit  int
+	}
+	crashingActualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	CellPlacementsStub        func(logger lager.Logger, cellID string) ([]*models.CellPlacement, error)
+	cellPlacementsMutex       sync.RWMutex
+	cellPlacementsArgsForCall []struct {
+		logger lager.Logger
+		cellID string
+	}
+	cellPlacementsReturns struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}
 	CreateUnclaimedActualLRPStub        func(logger lager.Logger, key *models.ActualLRPKey) (after *models.ActualLRPGroup, err error)
 	createUnclaimedActualLRPMutex       sync.RWMutex
 	createUnclaimedActualLRPArgsForCall []struct {
@@ -88,6 +119,18 @@ type FakeActualLRPDB struct {
 		result2 *models.ActualLRPGroup
 		result3 error
 	}
+	StartActualLRPsStub        func(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) (*models.StartActualLRPsResult, error)
+	startActualLRPsMutex       sync.RWMutex
+	startActualLRPsArgsForCall []struct {
+		logger        lager.Logger
+		cellID        string
+		starts        []*models.StartActualLRPRequest
+		authoritative bool
+	}
+	startActualLRPsReturns struct {
+		result1 *models.StartActualLRPsResult
+		result2 error
+	}
 	CrashActualLRPStub        func(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, crashReason string) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, shouldRestart bool, err error)
 	crashActualLRPMutex       sync.RWMutex
 	crashActualLRPArgsForCall []struct {
@@ -125,6 +168,17 @@ type FakeActualLRPDB struct {
 	removeActualLRPReturns struct {
 		result1 error
 	}
+	RetireActualLRPsOnCellStub        func(logger lager.Logger, cellID string) (before []*models.ActualLRPGroup, after []*models.ActualLRPGroup, err error)
+	retireActualLRPsOnCellMutex       sync.RWMutex
+	retireActualLRPsOnCellArgsForCall []struct {
+		logger lager.Logger
+		cellID string
+	}
+	retireActualLRPsOnCellReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.ActualLRPGroup
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -164,6 +218,42 @@ func (fake *FakeActualLRPDB) ActualLRPGroupsReturns(result1 []*models.ActualLRPG
 	}{result1, result2}
 }
 
+func (fake *FakeActualLRPDB) ActualLRPGroupsWithRecordErrors(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.Lock()
+	fake.actualLRPGroupsWithRecordErrorsArgsForCall = append(fake.actualLRPGroupsWithRecordErrorsArgsForCall, struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("ActualLRPGroupsWithRecordErrors", []interface{}{logger, filter})
+	fake.actualLRPGroupsWithRecordErrorsMutex.Unlock()
+	if fake.ActualLRPGroupsWithRecordErrorsStub != nil {
+		return fake.ActualLRPGroupsWithRecordErrorsStub(logger, filter)
+	} else {
+		return fake.actualLRPGroupsWithRecordErrorsReturns.result1, fake.actualLRPGroupsWithRecordErrorsReturns.result2, fake.actualLRPGroupsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeActualLRPDB) ActualLRPGroupsWithRecordErrorsCallCount() int {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return len(fake.actualLRPGroupsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeActualLRPDB) ActualLRPGroupsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].logger, fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].filter
+}
+
+func (fake *FakeActualLRPDB) ActualLRPGroupsWithRecordErrorsReturns(result1 []*models.ActualLRPGroup, result2 []*models.RecordError, result3 error) {
+	fake.ActualLRPGroupsWithRecordErrorsStub = nil
+	fake.actualLRPGroupsWithRecordErrorsReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActualLRPDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsByProcessGuidMutex.Lock()
 	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
@@ -235,6 +325,76 @@ func (fake *FakeActualLRPDB) ActualLRPGroupByProcessGuidAndIndexReturns(result1
 	}{result1, result2}
 }
 
+func (fake *FakeActualLRPDB) CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error) {
+	fake.crashingActualLRPsMutex.Lock()
+	fake.crashingActualLRPsArgsForCall = append(fake.crashingActualLRPsArgsForCall, struct {
+		logger lager.Logger
+		limit  int
+	}{logger, limit})
+	fake.recordInvocation("CrashingActualLRPs", []interface{}{logger, limit})
+	fake.crashingActualLRPsMutex.Unlock()
+	if fake.CrashingActualLRPsStub != nil {
+		return fake.CrashingActualLRPsStub(logger, limit)
+	} else {
+		return fake.crashingActualLRPsReturns.result1, fake.crashingActualLRPsReturns.result2
+	}
+}
+
+func (fake *FakeActualLRPDB) CrashingActualLRPsCallCount() int {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return len(fake.crashingActualLRPsArgsForCall)
+}
+
+func (fake *FakeActualLRPDB) CrashingActualLRPsArgsForCall(i int) (lager.Logger, int) {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return fake.crashingActualLRPsArgsForCall[i].logger, fake.crashingActualLRPsArgsForCall[i].limit
+}
+
+func (fake *FakeActualLRPDB) CrashingActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.CrashingActualLRPsStub = nil
+	fake.crashingActualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPDB) CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error) {
+	fake.cellPlacementsMutex.Lock()
+	fake.cellPlacementsArgsForCall = append(fake.cellPlacementsArgsForCall, struct {
+		logger lager.Logger
+		cellID string
+	}{logger, cellID})
+	fake.recordInvocation("CellPlacements", []interface{}{logger, cellID})
+	fake.cellPlacementsMutex.Unlock()
+	if fake.CellPlacementsStub != nil {
+		return fake.CellPlacementsStub(logger, cellID)
+	} else {
+		return fake.cellPlacementsReturns.result1, fake.cellPlacementsReturns.result2
+	}
+}
+
+func (fake *FakeActualLRPDB) CellPlacementsCallCount() int {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return len(fake.cellPlacementsArgsForCall)
+}
+
+func (fake *FakeActualLRPDB) CellPlacementsArgsForCall(i int) (lager.Logger, string) {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return fake.cellPlacementsArgsForCall[i].logger, fake.cellPlacementsArgsForCall[i].cellID
+}
+
+func (fake *FakeActualLRPDB) CellPlacementsReturns(result1 []*models.CellPlacement, result2 error) {
+	fake.CellPlacementsStub = nil
+	fake.cellPlacementsReturns = struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActualLRPDB) CreateUnclaimedActualLRP(logger lager.Logger, key *models.ActualLRPKey) (after *models.ActualLRPGroup, err error) {
 	fake.createUnclaimedActualLRPMutex.Lock()
 	fake.createUnclaimedActualLRPArgsForCall = append(fake.createUnclaimedActualLRPArgsForCall, struct {
@@ -382,6 +542,43 @@ func (fake *FakeActualLRPDB) StartActualLRPReturns(result1 *models.ActualLRPGrou
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActualLRPDB) StartActualLRPs(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) (*models.StartActualLRPsResult, error) {
+	fake.startActualLRPsMutex.Lock()
+	fake.startActualLRPsArgsForCall = append(fake.startActualLRPsArgsForCall, struct {
+		logger        lager.Logger
+		cellID        string
+		starts        []*models.StartActualLRPRequest
+		authoritative bool
+	}{logger, cellID, starts, authoritative})
+	fake.recordInvocation("StartActualLRPs", []interface{}{logger, cellID, starts, authoritative})
+	fake.startActualLRPsMutex.Unlock()
+	if fake.StartActualLRPsStub != nil {
+		return fake.StartActualLRPsStub(logger, cellID, starts, authoritative)
+	} else {
+		return fake.startActualLRPsReturns.result1, fake.startActualLRPsReturns.result2
+	}
+}
+
+func (fake *FakeActualLRPDB) StartActualLRPsCallCount() int {
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
+	return len(fake.startActualLRPsArgsForCall)
+}
+
+func (fake *FakeActualLRPDB) StartActualLRPsArgsForCall(i int) (lager.Logger, string, []*models.StartActualLRPRequest, bool) {
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
+	return fake.startActualLRPsArgsForCall[i].logger, fake.startActualLRPsArgsForCall[i].cellID, fake.startActualLRPsArgsForCall[i].starts, fake.startActualLRPsArgsForCall[i].authoritative
+}
+
+func (fake *FakeActualLRPDB) StartActualLRPsReturns(result1 *models.StartActualLRPsResult, result2 error) {
+	fake.StartActualLRPsStub = nil
+	fake.startActualLRPsReturns = struct {
+		result1 *models.StartActualLRPsResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActualLRPDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, crashReason string) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, shouldRestart bool, err error) {
 	fake.crashActualLRPMutex.Lock()
 	fake.crashActualLRPArgsForCall = append(fake.crashActualLRPArgsForCall, struct {
@@ -494,15 +691,57 @@ func (fake *FakeActualLRPDB) RemoveActualLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeActualLRPDB) RetireActualLRPsOnCell(logger lager.Logger, cellID string) (before []*models.ActualLRPGroup, after []*models.ActualLRPGroup, err error) {
+	fake.retireActualLRPsOnCellMutex.Lock()
+	fake.retireActualLRPsOnCellArgsForCall = append(fake.retireActualLRPsOnCellArgsForCall, struct {
+		logger lager.Logger
+		cellID string
+	}{logger, cellID})
+	fake.recordInvocation("RetireActualLRPsOnCell", []interface{}{logger, cellID})
+	fake.retireActualLRPsOnCellMutex.Unlock()
+	if fake.RetireActualLRPsOnCellStub != nil {
+		return fake.RetireActualLRPsOnCellStub(logger, cellID)
+	} else {
+		return fake.retireActualLRPsOnCellReturns.result1, fake.retireActualLRPsOnCellReturns.result2, fake.retireActualLRPsOnCellReturns.result3
+	}
+}
+
+func (fake *FakeActualLRPDB) RetireActualLRPsOnCellCallCount() int {
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
+	return len(fake.retireActualLRPsOnCellArgsForCall)
+}
+
+func (fake *FakeActualLRPDB) RetireActualLRPsOnCellArgsForCall(i int) (lager.Logger, string) {
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
+	return fake.retireActualLRPsOnCellArgsForCall[i].logger, fake.retireActualLRPsOnCellArgsForCall[i].cellID
+}
+
+func (fake *FakeActualLRPDB) RetireActualLRPsOnCellReturns(result1 []*models.ActualLRPGroup, result2 []*models.ActualLRPGroup, result3 error) {
+	fake.RetireActualLRPsOnCellStub = nil
+	fake.retireActualLRPsOnCellReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.ActualLRPGroup
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActualLRPDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
 	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
 	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
 	fake.createUnclaimedActualLRPMutex.RLock()
 	defer fake.createUnclaimedActualLRPMutex.RUnlock()
 	fake.unclaimActualLRPMutex.RLock()
@@ -511,12 +750,16 @@ func (fake *FakeActualLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.claimActualLRPMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
 	fake.crashActualLRPMutex.RLock()
 	defer fake.crashActualLRPMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
 	return fake.invocations
 }
 
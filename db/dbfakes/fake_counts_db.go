@@ -0,0 +1,83 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeCountsDB struct {
+	ObjectCountsStub        func(logger lager.Logger, byDomain, byState bool) (*db.ObjectCounts, error)
+	objectCountsMutex       sync.RWMutex
+	objectCountsArgsForCall []struct {
+		logger   lager.Logger
+		byDomain bool
+		byState  bool
+	}
+	objectCountsReturns struct {
+		result1 *db.ObjectCounts
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCountsDB) ObjectCounts(logger lager.Logger, byDomain bool, byState bool) (*db.ObjectCounts, error) {
+	fake.objectCountsMutex.Lock()
+	fake.objectCountsArgsForCall = append(fake.objectCountsArgsForCall, struct {
+		logger   lager.Logger
+		byDomain bool
+		byState  bool
+	}{logger, byDomain, byState})
+	fake.recordInvocation("ObjectCounts", []interface{}{logger, byDomain, byState})
+	fake.objectCountsMutex.Unlock()
+	if fake.ObjectCountsStub != nil {
+		return fake.ObjectCountsStub(logger, byDomain, byState)
+	} else {
+		return fake.objectCountsReturns.result1, fake.objectCountsReturns.result2
+	}
+}
+
+func (fake *FakeCountsDB) ObjectCountsCallCount() int {
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
+	return len(fake.objectCountsArgsForCall)
+}
+
+func (fake *FakeCountsDB) ObjectCountsArgsForCall(i int) (lager.Logger, bool, bool) {
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
+	return fake.objectCountsArgsForCall[i].logger, fake.objectCountsArgsForCall[i].byDomain, fake.objectCountsArgsForCall[i].byState
+}
+
+func (fake *FakeCountsDB) ObjectCountsReturns(result1 *db.ObjectCounts, result2 error) {
+	fake.ObjectCountsStub = nil
+	fake.objectCountsReturns = struct {
+		result1 *db.ObjectCounts
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCountsDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCountsDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.CountsDB = new(FakeCountsDB)
@@ -12,6 +12,17 @@ import (
 )
 
 type FakeDB struct {
+	ObjectCountsStub        func(logger lager.Logger, byDomain, byState bool) (*db.ObjectCounts, error)
+	objectCountsMutex       sync.RWMutex
+	objectCountsArgsForCall []struct {
+		logger   lager.Logger
+		byDomain bool
+		byState  bool
+	}
+	objectCountsReturns struct {
+		result1 *db.ObjectCounts
+		result2 error
+	}
 	DomainsStub        func(logger lager.Logger) ([]string, error)
 	domainsMutex       sync.RWMutex
 	domainsArgsForCall []struct {
@@ -21,6 +32,15 @@ type FakeDB struct {
 		result1 []string
 		result2 error
 	}
+	DomainFreshnessStub        func(logger lager.Logger) ([]*models.DomainFreshness, error)
+	domainFreshnessMutex       sync.RWMutex
+	domainFreshnessArgsForCall []struct {
+		logger lager.Logger
+	}
+	domainFreshnessReturns struct {
+		result1 []*models.DomainFreshness
+		result2 error
+	}
 	UpsertDomainStub        func(lgger lager.Logger, domain string, ttl uint32) error
 	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
@@ -31,6 +51,15 @@ type FakeDB struct {
 	upsertDomainReturns struct {
 		result1 error
 	}
+	UpsertDomainsStub        func(logger lager.Logger, domains []*models.UpsertDomainRequest) []error
+	upsertDomainsMutex       sync.RWMutex
+	upsertDomainsArgsForCall []struct {
+		logger  lager.Logger
+		domains []*models.UpsertDomainRequest
+	}
+	upsertDomainsReturns struct {
+		result1 []error
+	}
 	EncryptionKeyLabelStub        func(logger lager.Logger) (string, error)
 	encryptionKeyLabelMutex       sync.RWMutex
 	encryptionKeyLabelArgsForCall []struct {
@@ -49,6 +78,24 @@ type FakeDB struct {
 	setEncryptionKeyLabelReturns struct {
 		result1 error
 	}
+	EncryptionAlgorithmStub        func(logger lager.Logger) (string, error)
+	encryptionAlgorithmMutex       sync.RWMutex
+	encryptionAlgorithmArgsForCall []struct {
+		logger lager.Logger
+	}
+	encryptionAlgorithmReturns struct {
+		result1 string
+		result2 error
+	}
+	SetEncryptionAlgorithmStub        func(logger lager.Logger, algorithm string) error
+	setEncryptionAlgorithmMutex       sync.RWMutex
+	setEncryptionAlgorithmArgsForCall []struct {
+		logger    lager.Logger
+		algorithm string
+	}
+	setEncryptionAlgorithmReturns struct {
+		result1 error
+	}
 	PerformEncryptionStub        func(logger lager.Logger) error
 	performEncryptionMutex       sync.RWMutex
 	performEncryptionArgsForCall []struct {
@@ -80,6 +127,33 @@ type FakeDB struct {
 		result1 *models.ActualLRPGroup
 		result2 error
 	}
+	IsEmptyStub        func(logger lager.Logger) (bool, error)
+	isEmptyMutex       sync.RWMutex
+	isEmptyArgsForCall []struct {
+		logger lager.Logger
+	}
+	isEmptyReturns struct {
+		result1 bool
+		result2 error
+	}
+	ImportRecordsStub        func(logger lager.Logger, records []*models.ImportRecord) []error
+	importRecordsMutex       sync.RWMutex
+	importRecordsArgsForCall []struct {
+		logger  lager.Logger
+		records []*models.ImportRecord
+	}
+	importRecordsReturns struct {
+		result1 []error
+	}
+	ExportRecordsStub        func(logger lager.Logger, yield func(*models.ImportRecord) error) error
+	exportRecordsMutex       sync.RWMutex
+	exportRecordsArgsForCall []struct {
+		logger lager.Logger
+		yield  func(*models.ImportRecord) error
+	}
+	exportRecordsReturns struct {
+		result1 error
+	}
 	ActualLRPGroupsStub        func(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsMutex       sync.RWMutex
 	actualLRPGroupsArgsForCall []struct {
@@ -90,6 +164,17 @@ type FakeDB struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPGroupsWithRecordErrorsStub        func(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error)
+	actualLRPGroupsWithRecordErrorsMutex       sync.RWMutex
+	actualLRPGroupsWithRecordErrorsArgsForCall []struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}
+	actualLRPGroupsWithRecordErrorsReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}
 	ActualLRPGroupsByProcessGuidStub        func(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
 	actualLRPGroupsByProcessGuidArgsForCall []struct {
@@ -111,6 +196,26 @@ type FakeDB struct {
 		result1 *models.ActualLRPGroup
 		result2 error
 	}
+	CrashingActualLRPsStub        func(logger lager.Logger, limit int) ([]*models.ActualLRP, error)
+	crashingActualLRPsMutex       sync.RWMutex
+	crashingActualLRPsArgsForCall []struct {
+		logger lager.Logger
+		limit  int
+	}
+	crashingActualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	CellPlacementsStub        func(logger lager.Logger, cellID string) ([]*models.CellPlacement, error)
+	cellPlacementsMutex       sync.RWMutex
+	cellPlacementsArgsForCall []struct {
+		logger lager.Logger
+		cellID string
+	}
+	cellPlacementsReturns struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}
 	CreateUnclaimedActualLRPStub        func(logger lager.Logger, key *models.ActualLRPKey) (after *models.ActualLRPGroup, err error)
 	createUnclaimedActualLRPMutex       sync.RWMutex
 	createUnclaimedActualLRPArgsForCall []struct {
@@ -158,6 +263,18 @@ type FakeDB struct {
 		result2 *models.ActualLRPGroup
 		result3 error
 	}
+	StartActualLRPsStub        func(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) (*models.StartActualLRPsResult, error)
+	startActualLRPsMutex       sync.RWMutex
+	startActualLRPsArgsForCall []struct {
+		logger        lager.Logger
+		cellID        string
+		starts        []*models.StartActualLRPRequest
+		authoritative bool
+	}
+	startActualLRPsReturns struct {
+		result1 *models.StartActualLRPsResult
+		result2 error
+	}
 	CrashActualLRPStub        func(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, crashReason string) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, shouldRestart bool, err error)
 	crashActualLRPMutex       sync.RWMutex
 	crashActualLRPArgsForCall []struct {
@@ -195,6 +312,17 @@ type FakeDB struct {
 	removeActualLRPReturns struct {
 		result1 error
 	}
+	RetireActualLRPsOnCellStub        func(logger lager.Logger, cellID string) (before []*models.ActualLRPGroup, after []*models.ActualLRPGroup, err error)
+	retireActualLRPsOnCellMutex       sync.RWMutex
+	retireActualLRPsOnCellArgsForCall []struct {
+		logger lager.Logger
+		cellID string
+	}
+	retireActualLRPsOnCellReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.ActualLRPGroup
+		result3 error
+	}
 	DesiredLRPsStub        func(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPsMutex       sync.RWMutex
 	desiredLRPsArgsForCall []struct {
@@ -205,6 +333,17 @@ type FakeDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsWithRecordErrorsStub        func(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error)
+	desiredLRPsWithRecordErrorsMutex       sync.RWMutex
+	desiredLRPsWithRecordErrorsArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}
+	desiredLRPsWithRecordErrorsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}
 	DesiredLRPByProcessGuidStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -225,6 +364,26 @@ type FakeDB struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	StreamDesiredLRPSchedulingInfosStub        func(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error)
+	streamDesiredLRPSchedulingInfosMutex       sync.RWMutex
+	streamDesiredLRPSchedulingInfosArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		yield  func(*models.DesiredLRPSchedulingInfo) error
+	}
+	streamDesiredLRPSchedulingInfosReturns struct {
+		result1 []*models.RecordError
+		result2 error
+	}
+	DesiredLRPsRevisionStub        func(logger lager.Logger) (uint64, error)
+	desiredLRPsRevisionMutex       sync.RWMutex
+	desiredLRPsRevisionArgsForCall []struct {
+		logger lager.Logger
+	}
+	desiredLRPsRevisionReturns struct {
+		result1 uint64
+		result2 error
+	}
 	DesireLRPStub        func(logger lager.Logger, desiredLRP *models.DesiredLRP) error
 	desireLRPMutex       sync.RWMutex
 	desireLRPArgsForCall []struct {
@@ -234,6 +393,17 @@ type FakeDB struct {
 	desireLRPReturns struct {
 		result1 error
 	}
+	DesireLRPWithIdempotencyKeyStub        func(logger lager.Logger, key models.IdempotencyKey, desiredLRP *models.DesiredLRP) (replayed bool, err error)
+	desireLRPWithIdempotencyKeyMutex       sync.RWMutex
+	desireLRPWithIdempotencyKeyArgsForCall []struct {
+		logger     lager.Logger
+		key        models.IdempotencyKey
+		desiredLRP *models.DesiredLRP
+	}
+	desireLRPWithIdempotencyKeyReturns struct {
+		result1 bool
+		result2 error
+	}
 	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error)
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -254,11 +424,21 @@ type FakeDB struct {
 	removeDesiredLRPReturns struct {
 		result1 error
 	}
-	ConvergeLRPsStub        func(logger lager.Logger, cellSet models.CellSet) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey)
+	LRPCountsByDomainStub        func(logger lager.Logger) (map[string]db.DomainLRPCounts, error)
+	lRPCountsByDomainMutex       sync.RWMutex
+	lRPCountsByDomainArgsForCall []struct {
+		logger lager.Logger
+	}
+	lRPCountsByDomainReturns struct {
+		result1 map[string]db.DomainLRPCounts
+		result2 error
+	}
+	ConvergeLRPsStub        func(logger lager.Logger, cellSet models.CellSet, filter models.ConvergenceFilter) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey)
 	convergeLRPsMutex       sync.RWMutex
 	convergeLRPsArgsForCall []struct {
 		logger  lager.Logger
 		cellSet models.CellSet
+		filter  models.ConvergenceFilter
 	}
 	convergeLRPsReturns struct {
 		result1 []*auctioneer.LRPStartRequest
@@ -285,6 +465,17 @@ type FakeDB struct {
 		result1 []*models.Task
 		result2 error
 	}
+	StreamTasksStub        func(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error)
+	streamTasksMutex       sync.RWMutex
+	streamTasksArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+		yield  func(*models.Task) error
+	}
+	streamTasksReturns struct {
+		result1 []*models.RecordError
+		result2 error
+	}
 	TaskByGuidStub        func(logger lager.Logger, taskGuid string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -295,7 +486,7 @@ type FakeDB struct {
 		result1 *models.Task
 		result2 error
 	}
-	DesireTaskStub        func(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
+	DesireTaskStub        func(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) (*models.Task, error)
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
 		logger         lager.Logger
@@ -304,9 +495,34 @@ type FakeDB struct {
 		domain         string
 	}
 	desireTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 error
+	}
+	DesireTaskWithIdempotencyKeyStub        func(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid, domain string) (task *models.Task, replayed bool, err error)
+	desireTaskWithIdempotencyKeyMutex       sync.RWMutex
+	desireTaskWithIdempotencyKeyArgsForCall []struct {
+		logger         lager.Logger
+		key            models.IdempotencyKey
+		taskDefinition *models.TaskDefinition
+		taskGuid       string
+		domain         string
+	}
+	desireTaskWithIdempotencyKeyReturns struct {
+		result1 *models.Task
+		result2 bool
+		result3 error
+	}
+	DuplicateTasksStub        func(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error)
+	duplicateTasksMutex       sync.RWMutex
+	duplicateTasksArgsForCall []struct {
+		logger lager.Logger
+		domain string
+	}
+	duplicateTasksReturns struct {
+		result1 []*models.DuplicateTasks
+		result2 error
 	}
-	StartTaskStub        func(logger lager.Logger, taskGuid, cellId string) (bool, error)
+	StartTaskStub        func(logger lager.Logger, taskGuid, cellId string) (before *models.Task, after *models.Task, started bool, err error)
 	startTaskMutex       sync.RWMutex
 	startTaskArgsForCall []struct {
 		logger   lager.Logger
@@ -314,10 +530,12 @@ type FakeDB struct {
 		cellId   string
 	}
 	startTaskReturns struct {
-		result1 bool
-		result2 error
+		result1 *models.Task
+		result2 *models.Task
+		result3 bool
+		result4 error
 	}
-	CancelTaskStub        func(logger lager.Logger, taskGuid string) (task *models.Task, cellID string, err error)
+	CancelTaskStub        func(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error)
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
 		logger   lager.Logger
@@ -325,10 +543,22 @@ type FakeDB struct {
 	}
 	cancelTaskReturns struct {
 		result1 *models.Task
-		result2 string
-		result3 error
+		result2 *models.Task
+		result3 string
+		result4 error
 	}
-	FailTaskStub        func(logger lager.Logger, taskGuid, failureReason string) (task *models.Task, err error)
+	CancelTasksStub        func(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error)
+	cancelTasksMutex       sync.RWMutex
+	cancelTasksArgsForCall []struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}
+	cancelTasksReturns struct {
+		result1 *models.CancelTasksResult
+		result2 error
+	}
+	FailTaskStub        func(logger lager.Logger, taskGuid, failureReason string) (before *models.Task, after *models.Task, err error)
 	failTaskMutex       sync.RWMutex
 	failTaskArgsForCall []struct {
 		logger        lager.Logger
@@ -337,9 +567,22 @@ type FakeDB struct {
 	}
 	failTaskReturns struct {
 		result1 *models.Task
-		result2 error
+		result2 *models.Task
+		result3 error
+	}
+	RejectTaskStub        func(logger lager.Logger, taskGuid, rejectionReason string) (before *models.Task, after *models.Task, err error)
+	rejectTaskMutex       sync.RWMutex
+	rejectTaskArgsForCall []struct {
+		logger          lager.Logger
+		taskGuid        string
+		rejectionReason string
 	}
-	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (task *models.Task, err error)
+	rejectTaskReturns struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}
+	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (before *models.Task, after *models.Task, err error)
 	completeTaskMutex       sync.RWMutex
 	completeTaskArgsForCall []struct {
 		logger        lager.Logger
@@ -351,27 +594,41 @@ type FakeDB struct {
 	}
 	completeTaskReturns struct {
 		result1 *models.Task
-		result2 error
+		result2 *models.Task
+		result3 error
 	}
-	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) error
+	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error)
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
 		logger   lager.Logger
 		taskGuid string
 	}
 	resolvingTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
 	}
-	DeleteTaskStub        func(logger lager.Logger, taskGuid string) error
+	DeleteTaskStub        func(logger lager.Logger, taskGuid string) (*models.Task, error)
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
 		logger   lager.Logger
 		taskGuid string
 	}
 	deleteTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 error
 	}
-	ConvergeTasksStub        func(logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task)
+	PurgeCompletedTasksStub        func(logger lager.Logger, minAge time.Duration) (int, error)
+	purgeCompletedTasksMutex       sync.RWMutex
+	purgeCompletedTasksArgsForCall []struct {
+		logger lager.Logger
+		minAge time.Duration
+	}
+	purgeCompletedTasksReturns struct {
+		result1 int
+		result2 error
+	}
+	ConvergeTasksStub        func(logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration, maxTaskRejections int) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task)
 	convergeTasksMutex       sync.RWMutex
 	convergeTasksArgsForCall []struct {
 		logger                      lager.Logger
@@ -379,6 +636,7 @@ type FakeDB struct {
 		kickTaskDuration            time.Duration
 		expirePendingTaskDuration   time.Duration
 		expireCompletedTaskDuration time.Duration
+		maxTaskRejections           int
 	}
 	convergeTasksReturns struct {
 		result1 []*auctioneer.TaskStartRequest
@@ -406,6 +664,42 @@ type FakeDB struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeDB) ObjectCounts(logger lager.Logger, byDomain bool, byState bool) (*db.ObjectCounts, error) {
+	fake.objectCountsMutex.Lock()
+	fake.objectCountsArgsForCall = append(fake.objectCountsArgsForCall, struct {
+		logger   lager.Logger
+		byDomain bool
+		byState  bool
+	}{logger, byDomain, byState})
+	fake.recordInvocation("ObjectCounts", []interface{}{logger, byDomain, byState})
+	fake.objectCountsMutex.Unlock()
+	if fake.ObjectCountsStub != nil {
+		return fake.ObjectCountsStub(logger, byDomain, byState)
+	} else {
+		return fake.objectCountsReturns.result1, fake.objectCountsReturns.result2
+	}
+}
+
+func (fake *FakeDB) ObjectCountsCallCount() int {
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
+	return len(fake.objectCountsArgsForCall)
+}
+
+func (fake *FakeDB) ObjectCountsArgsForCall(i int) (lager.Logger, bool, bool) {
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
+	return fake.objectCountsArgsForCall[i].logger, fake.objectCountsArgsForCall[i].byDomain, fake.objectCountsArgsForCall[i].byState
+}
+
+func (fake *FakeDB) ObjectCountsReturns(result1 *db.ObjectCounts, result2 error) {
+	fake.ObjectCountsStub = nil
+	fake.objectCountsReturns = struct {
+		result1 *db.ObjectCounts
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) Domains(logger lager.Logger) ([]string, error) {
 	fake.domainsMutex.Lock()
 	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
@@ -440,6 +734,40 @@ func (fake *FakeDB) DomainsReturns(result1 []string, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeDB) DomainFreshness(logger lager.Logger) ([]*models.DomainFreshness, error) {
+	fake.domainFreshnessMutex.Lock()
+	fake.domainFreshnessArgsForCall = append(fake.domainFreshnessArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DomainFreshness", []interface{}{logger})
+	fake.domainFreshnessMutex.Unlock()
+	if fake.DomainFreshnessStub != nil {
+		return fake.DomainFreshnessStub(logger)
+	} else {
+		return fake.domainFreshnessReturns.result1, fake.domainFreshnessReturns.result2
+	}
+}
+
+func (fake *FakeDB) DomainFreshnessCallCount() int {
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
+	return len(fake.domainFreshnessArgsForCall)
+}
+
+func (fake *FakeDB) DomainFreshnessArgsForCall(i int) lager.Logger {
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
+	return fake.domainFreshnessArgsForCall[i].logger
+}
+
+func (fake *FakeDB) DomainFreshnessReturns(result1 []*models.DomainFreshness, result2 error) {
+	fake.DomainFreshnessStub = nil
+	fake.domainFreshnessReturns = struct {
+		result1 []*models.DomainFreshness
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) UpsertDomain(lgger lager.Logger, domain string, ttl uint32) error {
 	fake.upsertDomainMutex.Lock()
 	fake.upsertDomainArgsForCall = append(fake.upsertDomainArgsForCall, struct {
@@ -475,6 +803,40 @@ func (fake *FakeDB) UpsertDomainReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) UpsertDomains(logger lager.Logger, domains []*models.UpsertDomainRequest) []error {
+	fake.upsertDomainsMutex.Lock()
+	fake.upsertDomainsArgsForCall = append(fake.upsertDomainsArgsForCall, struct {
+		logger  lager.Logger
+		domains []*models.UpsertDomainRequest
+	}{logger, domains})
+	fake.recordInvocation("UpsertDomains", []interface{}{logger, domains})
+	fake.upsertDomainsMutex.Unlock()
+	if fake.UpsertDomainsStub != nil {
+		return fake.UpsertDomainsStub(logger, domains)
+	} else {
+		return fake.upsertDomainsReturns.result1
+	}
+}
+
+func (fake *FakeDB) UpsertDomainsCallCount() int {
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	return len(fake.upsertDomainsArgsForCall)
+}
+
+func (fake *FakeDB) UpsertDomainsArgsForCall(i int) (lager.Logger, []*models.UpsertDomainRequest) {
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	return fake.upsertDomainsArgsForCall[i].logger, fake.upsertDomainsArgsForCall[i].domains
+}
+
+func (fake *FakeDB) UpsertDomainsReturns(result1 []error) {
+	fake.UpsertDomainsStub = nil
+	fake.upsertDomainsReturns = struct {
+		result1 []error
+	}{result1}
+}
+
 func (fake *FakeDB) EncryptionKeyLabel(logger lager.Logger) (string, error) {
 	fake.encryptionKeyLabelMutex.Lock()
 	fake.encryptionKeyLabelArgsForCall = append(fake.encryptionKeyLabelArgsForCall, struct {
@@ -543,6 +905,74 @@ func (fake *FakeDB) SetEncryptionKeyLabelReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) EncryptionAlgorithm(logger lager.Logger) (string, error) {
+	fake.encryptionAlgorithmMutex.Lock()
+	fake.encryptionAlgorithmArgsForCall = append(fake.encryptionAlgorithmArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("EncryptionAlgorithm", []interface{}{logger})
+	fake.encryptionAlgorithmMutex.Unlock()
+	if fake.EncryptionAlgorithmStub != nil {
+		return fake.EncryptionAlgorithmStub(logger)
+	} else {
+		return fake.encryptionAlgorithmReturns.result1, fake.encryptionAlgorithmReturns.result2
+	}
+}
+
+func (fake *FakeDB) EncryptionAlgorithmCallCount() int {
+	fake.encryptionAlgorithmMutex.RLock()
+	defer fake.encryptionAlgorithmMutex.RUnlock()
+	return len(fake.encryptionAlgorithmArgsForCall)
+}
+
+func (fake *FakeDB) EncryptionAlgorithmArgsForCall(i int) lager.Logger {
+	fake.encryptionAlgorithmMutex.RLock()
+	defer fake.encryptionAlgorithmMutex.RUnlock()
+	return fake.encryptionAlgorithmArgsForCall[i].logger
+}
+
+func (fake *FakeDB) EncryptionAlgorithmReturns(result1 string, result2 error) {
+	fake.EncryptionAlgorithmStub = nil
+	fake.encryptionAlgorithmReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SetEncryptionAlgorithm(logger lager.Logger, algorithm string) error {
+	fake.setEncryptionAlgorithmMutex.Lock()
+	fake.setEncryptionAlgorithmArgsForCall = append(fake.setEncryptionAlgorithmArgsForCall, struct {
+		logger    lager.Logger
+		algorithm string
+	}{logger, algorithm})
+	fake.recordInvocation("SetEncryptionAlgorithm", []interface{}{logger, algorithm})
+	fake.setEncryptionAlgorithmMutex.Unlock()
+	if fake.SetEncryptionAlgorithmStub != nil {
+		return fake.SetEncryptionAlgorithmStub(logger, algorithm)
+	} else {
+		return fake.setEncryptionAlgorithmReturns.result1
+	}
+}
+
+func (fake *FakeDB) SetEncryptionAlgorithmCallCount() int {
+	fake.setEncryptionAlgorithmMutex.RLock()
+	defer fake.setEncryptionAlgorithmMutex.RUnlock()
+	return len(fake.setEncryptionAlgorithmArgsForCall)
+}
+
+func (fake *FakeDB) SetEncryptionAlgorithmArgsForCall(i int) (lager.Logger, string) {
+	fake.setEncryptionAlgorithmMutex.RLock()
+	defer fake.setEncryptionAlgorithmMutex.RUnlock()
+	return fake.setEncryptionAlgorithmArgsForCall[i].logger, fake.setEncryptionAlgorithmArgsForCall[i].algorithm
+}
+
+func (fake *FakeDB) SetEncryptionAlgorithmReturns(result1 error) {
+	fake.SetEncryptionAlgorithmStub = nil
+	fake.setEncryptionAlgorithmReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) PerformEncryption(logger lager.Logger) error {
 	fake.performEncryptionMutex.Lock()
 	fake.performEncryptionArgsForCall = append(fake.performEncryptionArgsForCall, struct {
@@ -649,6 +1079,108 @@ func (fake *FakeDB) EvacuateActualLRPReturns(result1 *models.ActualLRPGroup, res
 	}{result1, result2}
 }
 
+func (fake *FakeDB) IsEmpty(logger lager.Logger) (bool, error) {
+	fake.isEmptyMutex.Lock()
+	fake.isEmptyArgsForCall = append(fake.isEmptyArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("IsEmpty", []interface{}{logger})
+	fake.isEmptyMutex.Unlock()
+	if fake.IsEmptyStub != nil {
+		return fake.IsEmptyStub(logger)
+	} else {
+		return fake.isEmptyReturns.result1, fake.isEmptyReturns.result2
+	}
+}
+
+func (fake *FakeDB) IsEmptyCallCount() int {
+	fake.isEmptyMutex.RLock()
+	defer fake.isEmptyMutex.RUnlock()
+	return len(fake.isEmptyArgsForCall)
+}
+
+func (fake *FakeDB) IsEmptyArgsForCall(i int) lager.Logger {
+	fake.isEmptyMutex.RLock()
+	defer fake.isEmptyMutex.RUnlock()
+	return fake.isEmptyArgsForCall[i].logger
+}
+
+func (fake *FakeDB) IsEmptyReturns(result1 bool, result2 error) {
+	fake.IsEmptyStub = nil
+	fake.isEmptyReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ImportRecords(logger lager.Logger, records []*models.ImportRecord) []error {
+	fake.importRecordsMutex.Lock()
+	fake.importRecordsArgsForCall = append(fake.importRecordsArgsForCall, struct {
+		logger  lager.Logger
+		records []*models.ImportRecord
+	}{logger, records})
+	fake.recordInvocation("ImportRecords", []interface{}{logger, records})
+	fake.importRecordsMutex.Unlock()
+	if fake.ImportRecordsStub != nil {
+		return fake.ImportRecordsStub(logger, records)
+	} else {
+		return fake.importRecordsReturns.result1
+	}
+}
+
+func (fake *FakeDB) ImportRecordsCallCount() int {
+	fake.importRecordsMutex.RLock()
+	defer fake.importRecordsMutex.RUnlock()
+	return len(fake.importRecordsArgsForCall)
+}
+
+func (fake *FakeDB) ImportRecordsArgsForCall(i int) (lager.Logger, []*models.ImportRecord) {
+	fake.importRecordsMutex.RLock()
+	defer fake.importRecordsMutex.RUnlock()
+	return fake.importRecordsArgsForCall[i].logger, fake.importRecordsArgsForCall[i].records
+}
+
+func (fake *FakeDB) ImportRecordsReturns(result1 []error) {
+	fake.ImportRecordsStub = nil
+	fake.importRecordsReturns = struct {
+		result1 []error
+	}{result1}
+}
+
+func (fake *FakeDB) ExportRecords(logger lager.Logger, yield func(*models.ImportRecord) error) error {
+	fake.exportRecordsMutex.Lock()
+	fake.exportRecordsArgsForCall = append(fake.exportRecordsArgsForCall, struct {
+		logger lager.Logger
+		yield  func(*models.ImportRecord) error
+	}{logger, yield})
+	fake.recordInvocation("ExportRecords", []interface{}{logger, yield})
+	fake.exportRecordsMutex.Unlock()
+	if fake.ExportRecordsStub != nil {
+		return fake.ExportRecordsStub(logger, yield)
+	} else {
+		return fake.exportRecordsReturns.result1
+	}
+}
+
+func (fake *FakeDB) ExportRecordsCallCount() int {
+	fake.exportRecordsMutex.RLock()
+	defer fake.exportRecordsMutex.RUnlock()
+	return len(fake.exportRecordsArgsForCall)
+}
+
+func (fake *FakeDB) ExportRecordsArgsForCall(i int) (lager.Logger, func(*models.ImportRecord) error) {
+	fake.exportRecordsMutex.RLock()
+	defer fake.exportRecordsMutex.RUnlock()
+	return fake.exportRecordsArgsForCall[i].logger, fake.exportRecordsArgsForCall[i].yield
+}
+
+func (fake *FakeDB) ExportRecordsReturns(result1 error) {
+	fake.ExportRecordsStub = nil
+	fake.exportRecordsReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsMutex.Lock()
 	fake.actualLRPGroupsArgsForCall = append(fake.actualLRPGroupsArgsForCall, struct {
@@ -684,6 +1216,42 @@ func (fake *FakeDB) ActualLRPGroupsReturns(result1 []*models.ActualLRPGroup, res
 	}{result1, result2}
 }
 
+func (fake *FakeDB) ActualLRPGroupsWithRecordErrors(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.Lock()
+	fake.actualLRPGroupsWithRecordErrorsArgsForCall = append(fake.actualLRPGroupsWithRecordErrorsArgsForCall, struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("ActualLRPGroupsWithRecordErrors", []interface{}{logger, filter})
+	fake.actualLRPGroupsWithRecordErrorsMutex.Unlock()
+	if fake.ActualLRPGroupsWithRecordErrorsStub != nil {
+		return fake.ActualLRPGroupsWithRecordErrorsStub(logger, filter)
+	} else {
+		return fake.actualLRPGroupsWithRecordErrorsReturns.result1, fake.actualLRPGroupsWithRecordErrorsReturns.result2, fake.actualLRPGroupsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeDB) ActualLRPGroupsWithRecordErrorsCallCount() int {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return len(fake.actualLRPGroupsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeDB) ActualLRPGroupsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].logger, fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].filter
+}
+
+func (fake *FakeDB) ActualLRPGroupsWithRecordErrorsReturns(result1 []*models.ActualLRPGroup, result2 []*models.RecordError, result3 error) {
+	fake.ActualLRPGroupsWithRecordErrorsStub = nil
+	fake.actualLRPGroupsWithRecordErrorsReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsByProcessGuidMutex.Lock()
 	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
@@ -755,6 +1323,76 @@ func (fake *FakeDB) ActualLRPGroupByProcessGuidAndIndexReturns(result1 *models.A
 	}{result1, result2}
 }
 
+func (fake *FakeDB) CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error) {
+	fake.crashingActualLRPsMutex.Lock()
+	fake.crashingActualLRPsArgsForCall = append(fake.crashingActualLRPsArgsForCall, struct {
+		logger lager.Logger
+		limit  int
+	}{logger, limit})
+	fake.recordInvocation("CrashingActualLRPs", []interface{}{logger, limit})
+	fake.crashingActualLRPsMutex.Unlock()
+	if fake.CrashingActualLRPsStub != nil {
+		return fake.CrashingActualLRPsStub(logger, limit)
+	} else {
+		return fake.crashingActualLRPsReturns.result1, fake.crashingActualLRPsReturns.result2
+	}
+}
+
+func (fake *FakeDB) CrashingActualLRPsCallCount() int {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return len(fake.crashingActualLRPsArgsForCall)
+}
+
+func (fake *FakeDB) CrashingActualLRPsArgsForCall(i int) (lager.Logger, int) {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return fake.crashingActualLRPsArgsForCall[i].logger, fake.crashingActualLRPsArgsForCall[i].limit
+}
+
+func (fake *FakeDB) CrashingActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.CrashingActualLRPsStub = nil
+	fake.crashingActualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error) {
+	fake.cellPlacementsMutex.Lock()
+	fake.cellPlacementsArgsForCall = append(fake.cellPlacementsArgsForCall, struct {
+		logger lager.Logger
+		cellID string
+	}{logger, cellID})
+	fake.recordInvocation("CellPlacements", []interface{}{logger, cellID})
+	fake.cellPlacementsMutex.Unlock()
+	if fake.CellPlacementsStub != nil {
+		return fake.CellPlacementsStub(logger, cellID)
+	} else {
+		return fake.cellPlacementsReturns.result1, fake.cellPlacementsReturns.result2
+	}
+}
+
+func (fake *FakeDB) CellPlacementsCallCount() int {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return len(fake.cellPlacementsArgsForCall)
+}
+
+func (fake *FakeDB) CellPlacementsArgsForCall(i int) (lager.Logger, string) {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return fake.cellPlacementsArgsForCall[i].logger, fake.cellPlacementsArgsForCall[i].cellID
+}
+
+func (fake *FakeDB) CellPlacementsReturns(result1 []*models.CellPlacement, result2 error) {
+	fake.CellPlacementsStub = nil
+	fake.cellPlacementsReturns = struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) CreateUnclaimedActualLRP(logger lager.Logger, key *models.ActualLRPKey) (after *models.ActualLRPGroup, err error) {
 	fake.createUnclaimedActualLRPMutex.Lock()
 	fake.createUnclaimedActualLRPArgsForCall = append(fake.createUnclaimedActualLRPArgsForCall, struct {
@@ -902,6 +1540,43 @@ func (fake *FakeDB) StartActualLRPReturns(result1 *models.ActualLRPGroup, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeDB) StartActualLRPs(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) (*models.StartActualLRPsResult, error) {
+	fake.startActualLRPsMutex.Lock()
+	fake.startActualLRPsArgsForCall = append(fake.startActualLRPsArgsForCall, struct {
+		logger        lager.Logger
+		cellID        string
+		starts        []*models.StartActualLRPRequest
+		authoritative bool
+	}{logger, cellID, starts, authoritative})
+	fake.recordInvocation("StartActualLRPs", []interface{}{logger, cellID, starts, authoritative})
+	fake.startActualLRPsMutex.Unlock()
+	if fake.StartActualLRPsStub != nil {
+		return fake.StartActualLRPsStub(logger, cellID, starts, authoritative)
+	} else {
+		return fake.startActualLRPsReturns.result1, fake.startActualLRPsReturns.result2
+	}
+}
+
+func (fake *FakeDB) StartActualLRPsCallCount() int {
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
+	return len(fake.startActualLRPsArgsForCall)
+}
+
+func (fake *FakeDB) StartActualLRPsArgsForCall(i int) (lager.Logger, string, []*models.StartActualLRPRequest, bool) {
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
+	return fake.startActualLRPsArgsForCall[i].logger, fake.startActualLRPsArgsForCall[i].cellID, fake.startActualLRPsArgsForCall[i].starts, fake.startActualLRPsArgsForCall[i].authoritative
+}
+
+func (fake *FakeDB) StartActualLRPsReturns(result1 *models.StartActualLRPsResult, result2 error) {
+	fake.StartActualLRPsStub = nil
+	fake.startActualLRPsReturns = struct {
+		result1 *models.StartActualLRPsResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, crashReason string) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, shouldRestart bool, err error) {
 	fake.crashActualLRPMutex.Lock()
 	fake.crashActualLRPArgsForCall = append(fake.crashActualLRPArgsForCall, struct {
@@ -1014,6 +1689,42 @@ func (fake *FakeDB) RemoveActualLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) RetireActualLRPsOnCell(logger lager.Logger, cellID string) (before []*models.ActualLRPGroup, after []*models.ActualLRPGroup, err error) {
+	fake.retireActualLRPsOnCellMutex.Lock()
+	fake.retireActualLRPsOnCellArgsForCall = append(fake.retireActualLRPsOnCellArgsForCall, struct {
+		logger lager.Logger
+		cellID string
+	}{logger, cellID})
+	fake.recordInvocation("RetireActualLRPsOnCell", []interface{}{logger, cellID})
+	fake.retireActualLRPsOnCellMutex.Unlock()
+	if fake.RetireActualLRPsOnCellStub != nil {
+		return fake.RetireActualLRPsOnCellStub(logger, cellID)
+	} else {
+		return fake.retireActualLRPsOnCellReturns.result1, fake.retireActualLRPsOnCellReturns.result2, fake.retireActualLRPsOnCellReturns.result3
+	}
+}
+
+func (fake *FakeDB) RetireActualLRPsOnCellCallCount() int {
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
+	return len(fake.retireActualLRPsOnCellArgsForCall)
+}
+
+func (fake *FakeDB) RetireActualLRPsOnCellArgsForCall(i int) (lager.Logger, string) {
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
+	return fake.retireActualLRPsOnCellArgsForCall[i].logger, fake.retireActualLRPsOnCellArgsForCall[i].cellID
+}
+
+func (fake *FakeDB) RetireActualLRPsOnCellReturns(result1 []*models.ActualLRPGroup, result2 []*models.ActualLRPGroup, result3 error) {
+	fake.RetireActualLRPsOnCellStub = nil
+	fake.retireActualLRPsOnCellReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.ActualLRPGroup
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPsMutex.Lock()
 	fake.desiredLRPsArgsForCall = append(fake.desiredLRPsArgsForCall, struct {
@@ -1049,6 +1760,42 @@ func (fake *FakeDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeDB) DesiredLRPsWithRecordErrors(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
+	fake.desiredLRPsWithRecordErrorsMutex.Lock()
+	fake.desiredLRPsWithRecordErrorsArgsForCall = append(fake.desiredLRPsWithRecordErrorsArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("DesiredLRPsWithRecordErrors", []interface{}{logger, filter})
+	fake.desiredLRPsWithRecordErrorsMutex.Unlock()
+	if fake.DesiredLRPsWithRecordErrorsStub != nil {
+		return fake.DesiredLRPsWithRecordErrorsStub(logger, filter)
+	} else {
+		return fake.desiredLRPsWithRecordErrorsReturns.result1, fake.desiredLRPsWithRecordErrorsReturns.result2, fake.desiredLRPsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeDB) DesiredLRPsWithRecordErrorsCallCount() int {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return len(fake.desiredLRPsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return fake.desiredLRPsWithRecordErrorsArgsForCall[i].logger, fake.desiredLRPsWithRecordErrorsArgsForCall[i].filter
+}
+
+func (fake *FakeDB) DesiredLRPsWithRecordErrorsReturns(result1 []*models.DesiredLRP, result2 []*models.RecordError, result3 error) {
+	fake.DesiredLRPsWithRecordErrorsStub = nil
+	fake.desiredLRPsWithRecordErrorsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
@@ -1119,6 +1866,76 @@ func (fake *FakeDB) DesiredLRPSchedulingInfosReturns(result1 []*models.DesiredLR
 	}{result1, result2}
 }
 
+func (fake *FakeDB) StreamDesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error) {
+	fake.streamDesiredLRPSchedulingInfosMutex.Lock()
+	fake.streamDesiredLRPSchedulingInfosArgsForCall = append(fake.streamDesiredLRPSchedulingInfosArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		yield  func(*models.DesiredLRPSchedulingInfo) error
+	}{logger, filter, yield})
+	fake.recordInvocation("StreamDesiredLRPSchedulingInfos", []interface{}{logger, filter, yield})
+	fake.streamDesiredLRPSchedulingInfosMutex.Unlock()
+	if fake.StreamDesiredLRPSchedulingInfosStub != nil {
+		return fake.StreamDesiredLRPSchedulingInfosStub(logger, filter, yield)
+	} else {
+		return fake.streamDesiredLRPSchedulingInfosReturns.result1, fake.streamDesiredLRPSchedulingInfosReturns.result2
+	}
+}
+
+func (fake *FakeDB) StreamDesiredLRPSchedulingInfosCallCount() int {
+	fake.streamDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.streamDesiredLRPSchedulingInfosMutex.RUnlock()
+	return len(fake.streamDesiredLRPSchedulingInfosArgsForCall)
+}
+
+func (fake *FakeDB) StreamDesiredLRPSchedulingInfosArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter, func(*models.DesiredLRPSchedulingInfo) error) {
+	fake.streamDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.streamDesiredLRPSchedulingInfosMutex.RUnlock()
+	return fake.streamDesiredLRPSchedulingInfosArgsForCall[i].logger, fake.streamDesiredLRPSchedulingInfosArgsForCall[i].filter, fake.streamDesiredLRPSchedulingInfosArgsForCall[i].yield
+}
+
+func (fake *FakeDB) StreamDesiredLRPSchedulingInfosReturns(result1 []*models.RecordError, result2 error) {
+	fake.StreamDesiredLRPSchedulingInfosStub = nil
+	fake.streamDesiredLRPSchedulingInfosReturns = struct {
+		result1 []*models.RecordError
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPsRevision(logger lager.Logger) (uint64, error) {
+	fake.desiredLRPsRevisionMutex.Lock()
+	fake.desiredLRPsRevisionArgsForCall = append(fake.desiredLRPsRevisionArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DesiredLRPsRevision", []interface{}{logger})
+	fake.desiredLRPsRevisionMutex.Unlock()
+	if fake.DesiredLRPsRevisionStub != nil {
+		return fake.DesiredLRPsRevisionStub(logger)
+	} else {
+		return fake.desiredLRPsRevisionReturns.result1, fake.desiredLRPsRevisionReturns.result2
+	}
+}
+
+func (fake *FakeDB) DesiredLRPsRevisionCallCount() int {
+	fake.desiredLRPsRevisionMutex.RLock()
+	defer fake.desiredLRPsRevisionMutex.RUnlock()
+	return len(fake.desiredLRPsRevisionArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPsRevisionArgsForCall(i int) lager.Logger {
+	fake.desiredLRPsRevisionMutex.RLock()
+	defer fake.desiredLRPsRevisionMutex.RUnlock()
+	return fake.desiredLRPsRevisionArgsForCall[i].logger
+}
+
+func (fake *FakeDB) DesiredLRPsRevisionReturns(result1 uint64, result2 error) {
+	fake.DesiredLRPsRevisionStub = nil
+	fake.desiredLRPsRevisionReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) error {
 	fake.desireLRPMutex.Lock()
 	fake.desireLRPArgsForCall = append(fake.desireLRPArgsForCall, struct {
@@ -1153,6 +1970,42 @@ func (fake *FakeDB) DesireLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) DesireLRPWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, desiredLRP *models.DesiredLRP) (replayed bool, err error) {
+	fake.desireLRPWithIdempotencyKeyMutex.Lock()
+	fake.desireLRPWithIdempotencyKeyArgsForCall = append(fake.desireLRPWithIdempotencyKeyArgsForCall, struct {
+		logger     lager.Logger
+		key        models.IdempotencyKey
+		desiredLRP *models.DesiredLRP
+	}{logger, key, desiredLRP})
+	fake.recordInvocation("DesireLRPWithIdempotencyKey", []interface{}{logger, key, desiredLRP})
+	fake.desireLRPWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireLRPWithIdempotencyKeyStub != nil {
+		return fake.DesireLRPWithIdempotencyKeyStub(logger, key, desiredLRP)
+	} else {
+		return fake.desireLRPWithIdempotencyKeyReturns.result1, fake.desireLRPWithIdempotencyKeyReturns.result2
+	}
+}
+
+func (fake *FakeDB) DesireLRPWithIdempotencyKeyCallCount() int {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireLRPWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeDB) DesireLRPWithIdempotencyKeyArgsForCall(i int) (lager.Logger, models.IdempotencyKey, *models.DesiredLRP) {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireLRPWithIdempotencyKeyArgsForCall[i].logger, fake.desireLRPWithIdempotencyKeyArgsForCall[i].key, fake.desireLRPWithIdempotencyKeyArgsForCall[i].desiredLRP
+}
+
+func (fake *FakeDB) DesireLRPWithIdempotencyKeyReturns(result1 bool, result2 error) {
+	fake.DesireLRPWithIdempotencyKeyStub = nil
+	fake.desireLRPWithIdempotencyKeyReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error) {
 	fake.updateDesiredLRPMutex.Lock()
 	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
@@ -1223,16 +2076,51 @@ func (fake *FakeDB) RemoveDesiredLRPReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey) {
+func (fake *FakeDB) LRPCountsByDomain(logger lager.Logger) (map[string]db.DomainLRPCounts, error) {
+	fake.lRPCountsByDomainMutex.Lock()
+	fake.lRPCountsByDomainArgsForCall = append(fake.lRPCountsByDomainArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("LRPCountsByDomain", []interface{}{logger})
+	fake.lRPCountsByDomainMutex.Unlock()
+	if fake.LRPCountsByDomainStub != nil {
+		return fake.LRPCountsByDomainStub(logger)
+	} else {
+		return fake.lRPCountsByDomainReturns.result1, fake.lRPCountsByDomainReturns.result2
+	}
+}
+
+func (fake *FakeDB) LRPCountsByDomainCallCount() int {
+	fake.lRPCountsByDomainMutex.RLock()
+	defer fake.lRPCountsByDomainMutex.RUnlock()
+	return len(fake.lRPCountsByDomainArgsForCall)
+}
+
+func (fake *FakeDB) LRPCountsByDomainArgsForCall(i int) lager.Logger {
+	fake.lRPCountsByDomainMutex.RLock()
+	defer fake.lRPCountsByDomainMutex.RUnlock()
+	return fake.lRPCountsByDomainArgsForCall[i].logger
+}
+
+func (fake *FakeDB) LRPCountsByDomainReturns(result1 map[string]db.DomainLRPCounts, result2 error) {
+	fake.LRPCountsByDomainStub = nil
+	fake.lRPCountsByDomainReturns = struct {
+		result1 map[string]db.DomainLRPCounts
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet, filter models.ConvergenceFilter) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey) {
 	fake.convergeLRPsMutex.Lock()
 	fake.convergeLRPsArgsForCall = append(fake.convergeLRPsArgsForCall, struct {
 		logger  lager.Logger
 		cellSet models.CellSet
-	}{logger, cellSet})
-	fake.recordInvocation("ConvergeLRPs", []interface{}{logger, cellSet})
+		filter  models.ConvergenceFilter
+	}{logger, cellSet, filter})
+	fake.recordInvocation("ConvergeLRPs", []interface{}{logger, cellSet, filter})
 	fake.convergeLRPsMutex.Unlock()
 	if fake.ConvergeLRPsStub != nil {
-		return fake.ConvergeLRPsStub(logger, cellSet)
+		return fake.ConvergeLRPsStub(logger, cellSet, filter)
 	} else {
 		return fake.convergeLRPsReturns.result1, fake.convergeLRPsReturns.result2, fake.convergeLRPsReturns.result3
 	}
@@ -1244,10 +2132,10 @@ func (fake *FakeDB) ConvergeLRPsCallCount() int {
 	return len(fake.convergeLRPsArgsForCall)
 }
 
-func (fake *FakeDB) ConvergeLRPsArgsForCall(i int) (lager.Logger, models.CellSet) {
+func (fake *FakeDB) ConvergeLRPsArgsForCall(i int) (lager.Logger, models.CellSet, models.ConvergenceFilter) {
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
-	return fake.convergeLRPsArgsForCall[i].logger, fake.convergeLRPsArgsForCall[i].cellSet
+	return fake.convergeLRPsArgsForCall[i].logger, fake.convergeLRPsArgsForCall[i].cellSet, fake.convergeLRPsArgsForCall[i].filter
 }
 
 func (fake *FakeDB) ConvergeLRPsReturns(result1 []*auctioneer.LRPStartRequest, result2 []*models.ActualLRPKeyWithSchedulingInfo, result3 []*models.ActualLRPKey) {
@@ -1329,6 +2217,42 @@ func (fake *FakeDB) TasksReturns(result1 []*models.Task, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeDB) StreamTasks(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+	fake.streamTasksMutex.Lock()
+	fake.streamTasksArgsForCall = append(fake.streamTasksArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+		yield  func(*models.Task) error
+	}{logger, filter, yield})
+	fake.recordInvocation("StreamTasks", []interface{}{logger, filter, yield})
+	fake.streamTasksMutex.Unlock()
+	if fake.StreamTasksStub != nil {
+		return fake.StreamTasksStub(logger, filter, yield)
+	} else {
+		return fake.streamTasksReturns.result1, fake.streamTasksReturns.result2
+	}
+}
+
+func (fake *FakeDB) StreamTasksCallCount() int {
+	fake.streamTasksMutex.RLock()
+	defer fake.streamTasksMutex.RUnlock()
+	return len(fake.streamTasksArgsForCall)
+}
+
+func (fake *FakeDB) StreamTasksArgsForCall(i int) (lager.Logger, models.TaskFilter, func(*models.Task) error) {
+	fake.streamTasksMutex.RLock()
+	defer fake.streamTasksMutex.RUnlock()
+	return fake.streamTasksArgsForCall[i].logger, fake.streamTasksArgsForCall[i].filter, fake.streamTasksArgsForCall[i].yield
+}
+
+func (fake *FakeDB) StreamTasksReturns(result1 []*models.RecordError, result2 error) {
+	fake.StreamTasksStub = nil
+	fake.streamTasksReturns = struct {
+		result1 []*models.RecordError
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
@@ -1364,7 +2288,7 @@ func (fake *FakeDB) TaskByGuidReturns(result1 *models.Task, result2 error) {
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid string, domain string) error {
+func (fake *FakeDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid string, domain string) (*models.Task, error) {
 	fake.desireTaskMutex.Lock()
 	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
 		logger         lager.Logger
@@ -1377,7 +2301,7 @@ func (fake *FakeDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskD
 	if fake.DesireTaskStub != nil {
 		return fake.DesireTaskStub(logger, taskDefinition, taskGuid, domain)
 	} else {
-		return fake.desireTaskReturns.result1
+		return fake.desireTaskReturns.result1, fake.desireTaskReturns.result2
 	}
 }
 
@@ -1393,14 +2317,89 @@ func (fake *FakeDB) DesireTaskArgsForCall(i int) (lager.Logger, *models.TaskDefi
 	return fake.desireTaskArgsForCall[i].logger, fake.desireTaskArgsForCall[i].taskDefinition, fake.desireTaskArgsForCall[i].taskGuid, fake.desireTaskArgsForCall[i].domain
 }
 
-func (fake *FakeDB) DesireTaskReturns(result1 error) {
+func (fake *FakeDB) DesireTaskReturns(result1 *models.Task, result2 error) {
 	fake.DesireTaskStub = nil
 	fake.desireTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesireTaskWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid string, domain string) (task *models.Task, replayed bool, err error) {
+	fake.desireTaskWithIdempotencyKeyMutex.Lock()
+	fake.desireTaskWithIdempotencyKeyArgsForCall = append(fake.desireTaskWithIdempotencyKeyArgsForCall, struct {
+		logger         lager.Logger
+		key            models.IdempotencyKey
+		taskDefinition *models.TaskDefinition
+		taskGuid       string
+		domain         string
+	}{logger, key, taskDefinition, taskGuid, domain})
+	fake.recordInvocation("DesireTaskWithIdempotencyKey", []interface{}{logger, key, taskDefinition, taskGuid, domain})
+	fake.desireTaskWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireTaskWithIdempotencyKeyStub != nil {
+		return fake.DesireTaskWithIdempotencyKeyStub(logger, key, taskDefinition, taskGuid, domain)
+	} else {
+		return fake.desireTaskWithIdempotencyKeyReturns.result1, fake.desireTaskWithIdempotencyKeyReturns.result2, fake.desireTaskWithIdempotencyKeyReturns.result3
+	}
+}
+
+func (fake *FakeDB) DesireTaskWithIdempotencyKeyCallCount() int {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireTaskWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeDB) DesireTaskWithIdempotencyKeyArgsForCall(i int) (lager.Logger, models.IdempotencyKey, *models.TaskDefinition, string, string) {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireTaskWithIdempotencyKeyArgsForCall[i].logger, fake.desireTaskWithIdempotencyKeyArgsForCall[i].key, fake.desireTaskWithIdempotencyKeyArgsForCall[i].taskDefinition, fake.desireTaskWithIdempotencyKeyArgsForCall[i].taskGuid, fake.desireTaskWithIdempotencyKeyArgsForCall[i].domain
+}
+
+func (fake *FakeDB) DesireTaskWithIdempotencyKeyReturns(result1 *models.Task, result2 bool, result3 error) {
+	fake.DesireTaskWithIdempotencyKeyStub = nil
+	fake.desireTaskWithIdempotencyKeyReturns = struct {
+		result1 *models.Task
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) StartTask(logger lager.Logger, taskGuid string, cellId string) (bool, error) {
+func (fake *FakeDB) DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error) {
+	fake.duplicateTasksMutex.Lock()
+	fake.duplicateTasksArgsForCall = append(fake.duplicateTasksArgsForCall, struct {
+		logger lager.Logger
+		domain string
+	}{logger, domain})
+	fake.recordInvocation("DuplicateTasks", []interface{}{logger, domain})
+	fake.duplicateTasksMutex.Unlock()
+	if fake.DuplicateTasksStub != nil {
+		return fake.DuplicateTasksStub(logger, domain)
+	} else {
+		return fake.duplicateTasksReturns.result1, fake.duplicateTasksReturns.result2
+	}
+}
+
+func (fake *FakeDB) DuplicateTasksCallCount() int {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return len(fake.duplicateTasksArgsForCall)
+}
+
+func (fake *FakeDB) DuplicateTasksArgsForCall(i int) (lager.Logger, string) {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return fake.duplicateTasksArgsForCall[i].logger, fake.duplicateTasksArgsForCall[i].domain
+}
+
+func (fake *FakeDB) DuplicateTasksReturns(result1 []*models.DuplicateTasks, result2 error) {
+	fake.DuplicateTasksStub = nil
+	fake.duplicateTasksReturns = struct {
+		result1 []*models.DuplicateTasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) StartTask(logger lager.Logger, taskGuid string, cellId string) (before *models.Task, after *models.Task, started bool, err error) {
 	fake.startTaskMutex.Lock()
 	fake.startTaskArgsForCall = append(fake.startTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -1412,7 +2411,7 @@ func (fake *FakeDB) StartTask(logger lager.Logger, taskGuid string, cellId strin
 	if fake.StartTaskStub != nil {
 		return fake.StartTaskStub(logger, taskGuid, cellId)
 	} else {
-		return fake.startTaskReturns.result1, fake.startTaskReturns.result2
+		return fake.startTaskReturns.result1, fake.startTaskReturns.result2, fake.startTaskReturns.result3, fake.startTaskReturns.result4
 	}
 }
 
@@ -1428,15 +2427,17 @@ func (fake *FakeDB) StartTaskArgsForCall(i int) (lager.Logger, string, string) {
 	return fake.startTaskArgsForCall[i].logger, fake.startTaskArgsForCall[i].taskGuid, fake.startTaskArgsForCall[i].cellId
 }
 
-func (fake *FakeDB) StartTaskReturns(result1 bool, result2 error) {
+func (fake *FakeDB) StartTaskReturns(result1 *models.Task, result2 *models.Task, result3 bool, result4 error) {
 	fake.StartTaskStub = nil
 	fake.startTaskReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 *models.Task
+		result2 *models.Task
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeDB) CancelTask(logger lager.Logger, taskGuid string) (task *models.Task, cellID string, err error) {
+func (fake *FakeDB) CancelTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error) {
 	fake.cancelTaskMutex.Lock()
 	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -1447,7 +2448,7 @@ func (fake *FakeDB) CancelTask(logger lager.Logger, taskGuid string) (task *mode
 	if fake.CancelTaskStub != nil {
 		return fake.CancelTaskStub(logger, taskGuid)
 	} else {
-		return fake.cancelTaskReturns.result1, fake.cancelTaskReturns.result2, fake.cancelTaskReturns.result3
+		return fake.cancelTaskReturns.result1, fake.cancelTaskReturns.result2, fake.cancelTaskReturns.result3, fake.cancelTaskReturns.result4
 	}
 }
 
@@ -1463,16 +2464,53 @@ func (fake *FakeDB) CancelTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.cancelTaskArgsForCall[i].logger, fake.cancelTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeDB) CancelTaskReturns(result1 *models.Task, result2 string, result3 error) {
+func (fake *FakeDB) CancelTaskReturns(result1 *models.Task, result2 *models.Task, result3 string, result4 error) {
 	fake.CancelTaskStub = nil
 	fake.cancelTaskReturns = struct {
 		result1 *models.Task
-		result2 string
-		result3 error
-	}{result1, result2, result3}
+		result2 *models.Task
+		result3 string
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeDB) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error) {
+	fake.cancelTasksMutex.Lock()
+	fake.cancelTasksArgsForCall = append(fake.cancelTasksArgsForCall, struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}{logger, domain, taskGuids})
+	fake.recordInvocation("CancelTasks", []interface{}{logger, domain, taskGuids})
+	fake.cancelTasksMutex.Unlock()
+	if fake.CancelTasksStub != nil {
+		return fake.CancelTasksStub(logger, domain, taskGuids)
+	} else {
+		return fake.cancelTasksReturns.result1, fake.cancelTasksReturns.result2
+	}
+}
+
+func (fake *FakeDB) CancelTasksCallCount() int {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return len(fake.cancelTasksArgsForCall)
+}
+
+func (fake *FakeDB) CancelTasksArgsForCall(i int) (lager.Logger, string, []string) {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return fake.cancelTasksArgsForCall[i].logger, fake.cancelTasksArgsForCall[i].domain, fake.cancelTasksArgsForCall[i].taskGuids
 }
 
-func (fake *FakeDB) FailTask(logger lager.Logger, taskGuid string, failureReason string) (task *models.Task, err error) {
+func (fake *FakeDB) CancelTasksReturns(result1 *models.CancelTasksResult, result2 error) {
+	fake.CancelTasksStub = nil
+	fake.cancelTasksReturns = struct {
+		result1 *models.CancelTasksResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) FailTask(logger lager.Logger, taskGuid string, failureReason string) (before *models.Task, after *models.Task, err error) {
 	fake.failTaskMutex.Lock()
 	fake.failTaskArgsForCall = append(fake.failTaskArgsForCall, struct {
 		logger        lager.Logger
@@ -1484,7 +2522,7 @@ func (fake *FakeDB) FailTask(logger lager.Logger, taskGuid string, failureReason
 	if fake.FailTaskStub != nil {
 		return fake.FailTaskStub(logger, taskGuid, failureReason)
 	} else {
-		return fake.failTaskReturns.result1, fake.failTaskReturns.result2
+		return fake.failTaskReturns.result1, fake.failTaskReturns.result2, fake.failTaskReturns.result3
 	}
 }
 
@@ -1500,15 +2538,53 @@ func (fake *FakeDB) FailTaskArgsForCall(i int) (lager.Logger, string, string) {
 	return fake.failTaskArgsForCall[i].logger, fake.failTaskArgsForCall[i].taskGuid, fake.failTaskArgsForCall[i].failureReason
 }
 
-func (fake *FakeDB) FailTaskReturns(result1 *models.Task, result2 error) {
+func (fake *FakeDB) FailTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.FailTaskStub = nil
 	fake.failTaskReturns = struct {
 		result1 *models.Task
-		result2 error
-	}{result1, result2}
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) (task *models.Task, err error) {
+func (fake *FakeDB) RejectTask(logger lager.Logger, taskGuid string, rejectionReason string) (before *models.Task, after *models.Task, err error) {
+	fake.rejectTaskMutex.Lock()
+	fake.rejectTaskArgsForCall = append(fake.rejectTaskArgsForCall, struct {
+		logger          lager.Logger
+		taskGuid        string
+		rejectionReason string
+	}{logger, taskGuid, rejectionReason})
+	fake.recordInvocation("RejectTask", []interface{}{logger, taskGuid, rejectionReason})
+	fake.rejectTaskMutex.Unlock()
+	if fake.RejectTaskStub != nil {
+		return fake.RejectTaskStub(logger, taskGuid, rejectionReason)
+	} else {
+		return fake.rejectTaskReturns.result1, fake.rejectTaskReturns.result2, fake.rejectTaskReturns.result3
+	}
+}
+
+func (fake *FakeDB) RejectTaskCallCount() int {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return len(fake.rejectTaskArgsForCall)
+}
+
+func (fake *FakeDB) RejectTaskArgsForCall(i int) (lager.Logger, string, string) {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return fake.rejectTaskArgsForCall[i].logger, fake.rejectTaskArgsForCall[i].taskGuid, fake.rejectTaskArgsForCall[i].rejectionReason
+}
+
+func (fake *FakeDB) RejectTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.RejectTaskStub = nil
+	fake.rejectTaskReturns = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) (before *models.Task, after *models.Task, err error) {
 	fake.completeTaskMutex.Lock()
 	fake.completeTaskArgsForCall = append(fake.completeTaskArgsForCall, struct {
 		logger        lager.Logger
@@ -1523,7 +2599,7 @@ func (fake *FakeDB) CompleteTask(logger lager.Logger, taskGuid string, cellId st
 	if fake.CompleteTaskStub != nil {
 		return fake.CompleteTaskStub(logger, taskGuid, cellId, failed, failureReason, result)
 	} else {
-		return fake.completeTaskReturns.result1, fake.completeTaskReturns.result2
+		return fake.completeTaskReturns.result1, fake.completeTaskReturns.result2, fake.completeTaskReturns.result3
 	}
 }
 
@@ -1539,15 +2615,16 @@ func (fake *FakeDB) CompleteTaskArgsForCall(i int) (lager.Logger, string, string
 	return fake.completeTaskArgsForCall[i].logger, fake.completeTaskArgsForCall[i].taskGuid, fake.completeTaskArgsForCall[i].cellId, fake.completeTaskArgsForCall[i].failed, fake.completeTaskArgsForCall[i].failureReason, fake.completeTaskArgsForCall[i].result
 }
 
-func (fake *FakeDB) CompleteTaskReturns(result1 *models.Task, result2 error) {
+func (fake *FakeDB) CompleteTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.CompleteTaskStub = nil
 	fake.completeTaskReturns = struct {
 		result1 *models.Task
-		result2 error
-	}{result1, result2}
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
+func (fake *FakeDB) ResolvingTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error) {
 	fake.resolvingTaskMutex.Lock()
 	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -1558,7 +2635,7 @@ func (fake *FakeDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	if fake.ResolvingTaskStub != nil {
 		return fake.ResolvingTaskStub(logger, taskGuid)
 	} else {
-		return fake.resolvingTaskReturns.result1
+		return fake.resolvingTaskReturns.result1, fake.resolvingTaskReturns.result2, fake.resolvingTaskReturns.result3
 	}
 }
 
@@ -1574,14 +2651,16 @@ func (fake *FakeDB) ResolvingTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.resolvingTaskArgsForCall[i].logger, fake.resolvingTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeDB) ResolvingTaskReturns(result1 error) {
+func (fake *FakeDB) ResolvingTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.ResolvingTaskStub = nil
 	fake.resolvingTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) DeleteTask(logger lager.Logger, taskGuid string) error {
+func (fake *FakeDB) DeleteTask(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	fake.deleteTaskMutex.Lock()
 	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -1592,7 +2671,7 @@ func (fake *FakeDB) DeleteTask(logger lager.Logger, taskGuid string) error {
 	if fake.DeleteTaskStub != nil {
 		return fake.DeleteTaskStub(logger, taskGuid)
 	} else {
-		return fake.deleteTaskReturns.result1
+		return fake.deleteTaskReturns.result1, fake.deleteTaskReturns.result2
 	}
 }
 
@@ -1608,14 +2687,50 @@ func (fake *FakeDB) DeleteTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.deleteTaskArgsForCall[i].logger, fake.deleteTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeDB) DeleteTaskReturns(result1 error) {
+func (fake *FakeDB) DeleteTaskReturns(result1 *models.Task, result2 error) {
 	fake.DeleteTaskStub = nil
 	fake.deleteTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error) {
+	fake.purgeCompletedTasksMutex.Lock()
+	fake.purgeCompletedTasksArgsForCall = append(fake.purgeCompletedTasksArgsForCall, struct {
+		logger lager.Logger
+		minAge time.Duration
+	}{logger, minAge})
+	fake.recordInvocation("PurgeCompletedTasks", []interface{}{logger, minAge})
+	fake.purgeCompletedTasksMutex.Unlock()
+	if fake.PurgeCompletedTasksStub != nil {
+		return fake.PurgeCompletedTasksStub(logger, minAge)
+	} else {
+		return fake.purgeCompletedTasksReturns.result1, fake.purgeCompletedTasksReturns.result2
+	}
+}
+
+func (fake *FakeDB) PurgeCompletedTasksCallCount() int {
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
+	return len(fake.purgeCompletedTasksArgsForCall)
+}
+
+func (fake *FakeDB) PurgeCompletedTasksArgsForCall(i int) (lager.Logger, time.Duration) {
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
+	return fake.purgeCompletedTasksArgsForCall[i].logger, fake.purgeCompletedTasksArgsForCall[i].minAge
+}
+
+func (fake *FakeDB) PurgeCompletedTasksReturns(result1 int, result2 error) {
+	fake.PurgeCompletedTasksStub = nil
+	fake.purgeCompletedTasksReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task) {
+func (fake *FakeDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration, maxTaskRejections int) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task) {
 	fake.convergeTasksMutex.Lock()
 	fake.convergeTasksArgsForCall = append(fake.convergeTasksArgsForCall, struct {
 		logger                      lager.Logger
@@ -1623,11 +2738,12 @@ func (fake *FakeDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, k
 		kickTaskDuration            time.Duration
 		expirePendingTaskDuration   time.Duration
 		expireCompletedTaskDuration time.Duration
-	}{logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration})
-	fake.recordInvocation("ConvergeTasks", []interface{}{logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration})
+		maxTaskRejections           int
+	}{logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections})
+	fake.recordInvocation("ConvergeTasks", []interface{}{logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections})
 	fake.convergeTasksMutex.Unlock()
 	if fake.ConvergeTasksStub != nil {
-		return fake.ConvergeTasksStub(logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+		return fake.ConvergeTasksStub(logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections)
 	} else {
 		return fake.convergeTasksReturns.result1, fake.convergeTasksReturns.result2
 	}
@@ -1639,10 +2755,10 @@ func (fake *FakeDB) ConvergeTasksCallCount() int {
 	return len(fake.convergeTasksArgsForCall)
 }
 
-func (fake *FakeDB) ConvergeTasksArgsForCall(i int) (lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) {
+func (fake *FakeDB) ConvergeTasksArgsForCall(i int) (lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration, int) {
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
-	return fake.convergeTasksArgsForCall[i].logger, fake.convergeTasksArgsForCall[i].cellSet, fake.convergeTasksArgsForCall[i].kickTaskDuration, fake.convergeTasksArgsForCall[i].expirePendingTaskDuration, fake.convergeTasksArgsForCall[i].expireCompletedTaskDuration
+	return fake.convergeTasksArgsForCall[i].logger, fake.convergeTasksArgsForCall[i].cellSet, fake.convergeTasksArgsForCall[i].kickTaskDuration, fake.convergeTasksArgsForCall[i].expirePendingTaskDuration, fake.convergeTasksArgsForCall[i].expireCompletedTaskDuration, fake.convergeTasksArgsForCall[i].maxTaskRejections
 }
 
 func (fake *FakeDB) ConvergeTasksReturns(result1 []*auctioneer.TaskStartRequest, result2 []*models.Task) {
@@ -1724,26 +2840,48 @@ func (fake *FakeDB) SetVersionReturns(result1 error) {
 func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
 	fake.encryptionKeyLabelMutex.RLock()
 	defer fake.encryptionKeyLabelMutex.RUnlock()
 	fake.setEncryptionKeyLabelMutex.RLock()
 	defer fake.setEncryptionKeyLabelMutex.RUnlock()
+	fake.encryptionAlgorithmMutex.RLock()
+	defer fake.encryptionAlgorithmMutex.RUnlock()
+	fake.setEncryptionAlgorithmMutex.RLock()
+	defer fake.setEncryptionAlgorithmMutex.RUnlock()
 	fake.performEncryptionMutex.RLock()
 	defer fake.performEncryptionMutex.RUnlock()
 	fake.removeEvacuatingActualLRPMutex.RLock()
 	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
 	fake.evacuateActualLRPMutex.RLock()
 	defer fake.evacuateActualLRPMutex.RUnlock()
+	fake.isEmptyMutex.RLock()
+	defer fake.isEmptyMutex.RUnlock()
+	fake.importRecordsMutex.RLock()
+	defer fake.importRecordsMutex.RUnlock()
+	fake.exportRecordsMutex.RLock()
+	defer fake.exportRecordsMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
 	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
 	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
 	fake.createUnclaimedActualLRPMutex.RLock()
 	defer fake.createUnclaimedActualLRPMutex.RUnlock()
 	fake.unclaimActualLRPMutex.RLock()
@@ -1752,46 +2890,72 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.claimActualLRPMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
 	fake.crashActualLRPMutex.RLock()
 	defer fake.crashActualLRPMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.streamDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.streamDesiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPsRevisionMutex.RLock()
+	defer fake.desiredLRPsRevisionMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.lRPCountsByDomainMutex.RLock()
+	defer fake.lRPCountsByDomainMutex.RUnlock()
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
 	fake.gatherAndPruneLRPsMutex.RLock()
 	defer fake.gatherAndPruneLRPsMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
+	fake.streamTasksMutex.RLock()
+	defer fake.streamTasksMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
 	fake.startTaskMutex.RLock()
 	defer fake.startTaskMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
 	fake.completeTaskMutex.RLock()
 	defer fake.completeTaskMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
 	fake.versionMutex.RLock()
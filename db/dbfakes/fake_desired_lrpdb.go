@@ -20,6 +20,17 @@ type FakeDesiredLRPDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsWithRecordErrorsStub        func(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error)
+	desiredLRPsWithRecordErrorsMutex       sync.RWMutex
+	desiredLRPsWithRecordErrorsArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}
+	desiredLRPsWithRecordErrorsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}
 	DesiredLRPByProcessGuidStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -40,6 +51,26 @@ type FakeDesiredLRPDB struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	StreamDesiredLRPSchedulingInfosStub        func(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error)
+	streamDesiredLRPSchedulingInfosMutex       sync.RWMutex
+	streamDesiredLRPSchedulingInfosArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		yield  func(*models.DesiredLRPSchedulingInfo) error
+	}
+	streamDesiredLRPSchedulingInfosReturns struct {
+		result1 []*models.RecordError
+		result2 error
+	}
+	DesiredLRPsRevisionStub        func(logger lager.Logger) (uint64, error)
+	desiredLRPsRevisionMutex       sync.RWMutex
+	desiredLRPsRevisionArgsForCall []struct {
+		logger lager.Logger
+	}
+	desiredLRPsRevisionReturns struct {
+		result1 uint64
+		result2 error
+	}
 	DesireLRPStub        func(logger lager.Logger, desiredLRP *models.DesiredLRP) error
 	desireLRPMutex       sync.RWMutex
 	desireLRPArgsForCall []struct {
@@ -49,6 +80,17 @@ type FakeDesiredLRPDB struct {
 	desireLRPReturns struct {
 		result1 error
 	}
+	DesireLRPWithIdempotencyKeyStub        func(logger lager.Logger, key models.IdempotencyKey, desiredLRP *models.DesiredLRP) (replayed bool, err error)
+	desireLRPWithIdempotencyKeyMutex       sync.RWMutex
+	desireLRPWithIdempotencyKeyArgsForCall []struct {
+		logger     lager.Logger
+		key        models.IdempotencyKey
+		desiredLRP *models.DesiredLRP
+	}
+	desireLRPWithIdempotencyKeyReturns struct {
+		result1 bool
+		result2 error
+	}
 	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error)
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -108,6 +150,42 @@ func (fake *FakeDesiredLRPDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, r
 	}{result1, result2}
 }
 
+func (fake *FakeDesiredLRPDB) DesiredLRPsWithRecordErrors(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
+	fake.desiredLRPsWithRecordErrorsMutex.Lock()
+	fake.desiredLRPsWithRecordErrorsArgsForCall = append(fake.desiredLRPsWithRecordErrorsArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("DesiredLRPsWithRecordErrors", []interface{}{logger, filter})
+	fake.desiredLRPsWithRecordErrorsMutex.Unlock()
+	if fake.DesiredLRPsWithRecordErrorsStub != nil {
+		return fake.DesiredLRPsWithRecordErrorsStub(logger, filter)
+	} else {
+		return fake.desiredLRPsWithRecordErrorsReturns.result1, fake.desiredLRPsWithRecordErrorsReturns.result2, fake.desiredLRPsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsWithRecordErrorsCallCount() int {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return len(fake.desiredLRPsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return fake.desiredLRPsWithRecordErrorsArgsForCall[i].logger, fake.desiredLRPsWithRecordErrorsArgsForCall[i].filter
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsWithRecordErrorsReturns(result1 []*models.DesiredLRP, result2 []*models.RecordError, result3 error) {
+	fake.DesiredLRPsWithRecordErrorsStub = nil
+	fake.desiredLRPsWithRecordErrorsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDesiredLRPDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
@@ -178,6 +256,76 @@ func (fake *FakeDesiredLRPDB) DesiredLRPSchedulingInfosReturns(result1 []*models
 	}{result1, result2}
 }
 
+func (fake *FakeDesiredLRPDB) StreamDesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error) {
+	fake.streamDesiredLRPSchedulingInfosMutex.Lock()
+	fake.streamDesiredLRPSchedulingInfosArgsForCall = append(fake.streamDesiredLRPSchedulingInfosArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		yield  func(*models.DesiredLRPSchedulingInfo) error
+	}{logger, filter, yield})
+	fake.recordInvocation("StreamDesiredLRPSchedulingInfos", []interface{}{logger, filter, yield})
+	fake.streamDesiredLRPSchedulingInfosMutex.Unlock()
+	if fake.StreamDesiredLRPSchedulingInfosStub != nil {
+		return fake.StreamDesiredLRPSchedulingInfosStub(logger, filter, yield)
+	} else {
+		return fake.streamDesiredLRPSchedulingInfosReturns.result1, fake.streamDesiredLRPSchedulingInfosReturns.result2
+	}
+}
+
+func (fake *FakeDesiredLRPDB) StreamDesiredLRPSchedulingInfosCallCount() int {
+	fake.streamDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.streamDesiredLRPSchedulingInfosMutex.RUnlock()
+	return len(fake.streamDesiredLRPSchedulingInfosArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) StreamDesiredLRPSchedulingInfosArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter, func(*models.DesiredLRPSchedulingInfo) error) {
+	fake.streamDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.streamDesiredLRPSchedulingInfosMutex.RUnlock()
+	return fake.streamDesiredLRPSchedulingInfosArgsForCall[i].logger, fake.streamDesiredLRPSchedulingInfosArgsForCall[i].filter, fake.streamDesiredLRPSchedulingInfosArgsForCall[i].yield
+}
+
+func (fake *FakeDesiredLRPDB) StreamDesiredLRPSchedulingInfosReturns(result1 []*models.RecordError, result2 error) {
+	fake.StreamDesiredLRPSchedulingInfosStub = nil
+	fake.streamDesiredLRPSchedulingInfosReturns = struct {
+		result1 []*models.RecordError
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsRevision(logger lager.Logger) (uint64, error) {
+	fake.desiredLRPsRevisionMutex.Lock()
+	fake.desiredLRPsRevisionArgsForCall = append(fake.desiredLRPsRevisionArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DesiredLRPsRevision", []interface{}{logger})
+	fake.desiredLRPsRevisionMutex.Unlock()
+	if fake.DesiredLRPsRevisionStub != nil {
+		return fake.DesiredLRPsRevisionStub(logger)
+	} else {
+		return fake.desiredLRPsRevisionReturns.result1, fake.desiredLRPsRevisionReturns.result2
+	}
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsRevisionCallCount() int {
+	fake.desiredLRPsRevisionMutex.RLock()
+	defer fake.desiredLRPsRevisionMutex.RUnlock()
+	return len(fake.desiredLRPsRevisionArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsRevisionArgsForCall(i int) lager.Logger {
+	fake.desiredLRPsRevisionMutex.RLock()
+	defer fake.desiredLRPsRevisionMutex.RUnlock()
+	return fake.desiredLRPsRevisionArgsForCall[i].logger
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsRevisionReturns(result1 uint64, result2 error) {
+	fake.DesiredLRPsRevisionStub = nil
+	fake.desiredLRPsRevisionReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDesiredLRPDB) DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) error {
 	fake.desireLRPMutex.Lock()
 	fake.desireLRPArgsForCall = append(fake.desireLRPArgsForCall, struct {
@@ -212,6 +360,42 @@ func (fake *FakeDesiredLRPDB) DesireLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDesiredLRPDB) DesireLRPWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, desiredLRP *models.DesiredLRP) (replayed bool, err error) {
+	fake.desireLRPWithIdempotencyKeyMutex.Lock()
+	fake.desireLRPWithIdempotencyKeyArgsForCall = append(fake.desireLRPWithIdempotencyKeyArgsForCall, struct {
+		logger     lager.Logger
+		key        models.IdempotencyKey
+		desiredLRP *models.DesiredLRP
+	}{logger, key, desiredLRP})
+	fake.recordInvocation("DesireLRPWithIdempotencyKey", []interface{}{logger, key, desiredLRP})
+	fake.desireLRPWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireLRPWithIdempotencyKeyStub != nil {
+		return fake.DesireLRPWithIdempotencyKeyStub(logger, key, desiredLRP)
+	} else {
+		return fake.desireLRPWithIdempotencyKeyReturns.result1, fake.desireLRPWithIdempotencyKeyReturns.result2
+	}
+}
+
+func (fake *FakeDesiredLRPDB) DesireLRPWithIdempotencyKeyCallCount() int {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireLRPWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) DesireLRPWithIdempotencyKeyArgsForCall(i int) (lager.Logger, models.IdempotencyKey, *models.DesiredLRP) {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireLRPWithIdempotencyKeyArgsForCall[i].logger, fake.desireLRPWithIdempotencyKeyArgsForCall[i].key, fake.desireLRPWithIdempotencyKeyArgsForCall[i].desiredLRP
+}
+
+func (fake *FakeDesiredLRPDB) DesireLRPWithIdempotencyKeyReturns(result1 bool, result2 error) {
+	fake.DesireLRPWithIdempotencyKeyStub = nil
+	fake.desireLRPWithIdempotencyKeyReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDesiredLRPDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error) {
 	fake.updateDesiredLRPMutex.Lock()
 	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
@@ -287,12 +471,20 @@ func (fake *FakeDesiredLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.streamDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.streamDesiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPsRevisionMutex.RLock()
+	defer fake.desiredLRPsRevisionMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
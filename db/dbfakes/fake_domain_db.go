@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
 
@@ -18,6 +19,15 @@ type FakeDomainDB struct {
 		result1 []string
 		result2 error
 	}
+	DomainFreshnessStub        func(logger lager.Logger) ([]*models.DomainFreshness, error)
+	domainFreshnessMutex       sync.RWMutex
+	domainFreshnessArgsForCall []struct {
+		logger lager.Logger
+	}
+	domainFreshnessReturns struct {
+		result1 []*models.DomainFreshness
+		result2 error
+	}
 	UpsertDomainStub        func(lgger lager.Logger, domain string, ttl uint32) error
 	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
@@ -28,6 +38,15 @@ type FakeDomainDB struct {
 	upsertDomainReturns struct {
 		result1 error
 	}
+	UpsertDomainsStub        func(logger lager.Logger, domains []*models.UpsertDomainRequest) []error
+	upsertDomainsMutex       sync.RWMutex
+	upsertDomainsArgsForCall []struct {
+		logger  lager.Logger
+		domains []*models.UpsertDomainRequest
+	}
+	upsertDomainsReturns struct {
+		result1 []error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -66,6 +85,40 @@ func (fake *FakeDomainDB) DomainsReturns(result1 []string, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeDomainDB) DomainFreshness(logger lager.Logger) ([]*models.DomainFreshness, error) {
+	fake.domainFreshnessMutex.Lock()
+	fake.domainFreshnessArgsForCall = append(fake.domainFreshnessArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DomainFreshness", []interface{}{logger})
+	fake.domainFreshnessMutex.Unlock()
+	if fake.DomainFreshnessStub != nil {
+		return fake.DomainFreshnessStub(logger)
+	} else {
+		return fake.domainFreshnessReturns.result1, fake.domainFreshnessReturns.result2
+	}
+}
+
+func (fake *FakeDomainDB) DomainFreshnessCallCount() int {
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
+	return len(fake.domainFreshnessArgsForCall)
+}
+
+func (fake *FakeDomainDB) DomainFreshnessArgsForCall(i int) lager.Logger {
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
+	return fake.domainFreshnessArgsForCall[i].logger
+}
+
+func (fake *FakeDomainDB) DomainFreshnessReturns(result1 []*models.DomainFreshness, result2 error) {
+	fake.DomainFreshnessStub = nil
+	fake.domainFreshnessReturns = struct {
+		result1 []*models.DomainFreshness
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDomainDB) UpsertDomain(lgger lager.Logger, domain string, ttl uint32) error {
 	fake.upsertDomainMutex.Lock()
 	fake.upsertDomainArgsForCall = append(fake.upsertDomainArgsForCall, struct {
@@ -101,13 +154,51 @@ func (fake *FakeDomainDB) UpsertDomainReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDomainDB) UpsertDomains(logger lager.Logger, domains []*models.UpsertDomainRequest) []error {
+	fake.upsertDomainsMutex.Lock()
+	fake.upsertDomainsArgsForCall = append(fake.upsertDomainsArgsForCall, struct {
+		logger  lager.Logger
+		domains []*models.UpsertDomainRequest
+	}{logger, domains})
+	fake.recordInvocation("UpsertDomains", []interface{}{logger, domains})
+	fake.upsertDomainsMutex.Unlock()
+	if fake.UpsertDomainsStub != nil {
+		return fake.UpsertDomainsStub(logger, domains)
+	} else {
+		return fake.upsertDomainsReturns.result1
+	}
+}
+
+func (fake *FakeDomainDB) UpsertDomainsCallCount() int {
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	return len(fake.upsertDomainsArgsForCall)
+}
+
+func (fake *FakeDomainDB) UpsertDomainsArgsForCall(i int) (lager.Logger, []*models.UpsertDomainRequest) {
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	return fake.upsertDomainsArgsForCall[i].logger, fake.upsertDomainsArgsForCall[i].domains
+}
+
+func (fake *FakeDomainDB) UpsertDomainsReturns(result1 []error) {
+	fake.UpsertDomainsStub = nil
+	fake.upsertDomainsReturns = struct {
+		result1 []error
+	}{result1}
+}
+
 func (fake *FakeDomainDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -27,6 +27,24 @@ type FakeEncryptionDB struct {
 	setEncryptionKeyLabelReturns struct {
 		result1 error
 	}
+	EncryptionAlgorithmStub        func(logger lager.Logger) (string, error)
+	encryptionAlgorithmMutex       sync.RWMutex
+	encryptionAlgorithmArgsForCall []struct {
+		logger lager.Logger
+	}
+	encryptionAlgorithmReturns struct {
+		result1 string
+		result2 error
+	}
+	SetEncryptionAlgorithmStub        func(logger lager.Logger, algorithm string) error
+	setEncryptionAlgorithmMutex       sync.RWMutex
+	setEncryptionAlgorithmArgsForCall []struct {
+		logger    lager.Logger
+		algorithm string
+	}
+	setEncryptionAlgorithmReturns struct {
+		result1 error
+	}
 	PerformEncryptionStub        func(logger lager.Logger) error
 	performEncryptionMutex       sync.RWMutex
 	performEncryptionArgsForCall []struct {
@@ -107,6 +125,74 @@ func (fake *FakeEncryptionDB) SetEncryptionKeyLabelReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeEncryptionDB) EncryptionAlgorithm(logger lager.Logger) (string, error) {
+	fake.encryptionAlgorithmMutex.Lock()
+	fake.encryptionAlgorithmArgsForCall = append(fake.encryptionAlgorithmArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("EncryptionAlgorithm", []interface{}{logger})
+	fake.encryptionAlgorithmMutex.Unlock()
+	if fake.EncryptionAlgorithmStub != nil {
+		return fake.EncryptionAlgorithmStub(logger)
+	} else {
+		return fake.encryptionAlgorithmReturns.result1, fake.encryptionAlgorithmReturns.result2
+	}
+}
+
+func (fake *FakeEncryptionDB) EncryptionAlgorithmCallCount() int {
+	fake.encryptionAlgorithmMutex.RLock()
+	defer fake.encryptionAlgorithmMutex.RUnlock()
+	return len(fake.encryptionAlgorithmArgsForCall)
+}
+
+func (fake *FakeEncryptionDB) EncryptionAlgorithmArgsForCall(i int) lager.Logger {
+	fake.encryptionAlgorithmMutex.RLock()
+	defer fake.encryptionAlgorithmMutex.RUnlock()
+	return fake.encryptionAlgorithmArgsForCall[i].logger
+}
+
+func (fake *FakeEncryptionDB) EncryptionAlgorithmReturns(result1 string, result2 error) {
+	fake.EncryptionAlgorithmStub = nil
+	fake.encryptionAlgorithmReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionDB) SetEncryptionAlgorithm(logger lager.Logger, algorithm string) error {
+	fake.setEncryptionAlgorithmMutex.Lock()
+	fake.setEncryptionAlgorithmArgsForCall = append(fake.setEncryptionAlgorithmArgsForCall, struct {
+		logger    lager.Logger
+		algorithm string
+	}{logger, algorithm})
+	fake.recordInvocation("SetEncryptionAlgorithm", []interface{}{logger, algorithm})
+	fake.setEncryptionAlgorithmMutex.Unlock()
+	if fake.SetEncryptionAlgorithmStub != nil {
+		return fake.SetEncryptionAlgorithmStub(logger, algorithm)
+	} else {
+		return fake.setEncryptionAlgorithmReturns.result1
+	}
+}
+
+func (fake *FakeEncryptionDB) SetEncryptionAlgorithmCallCount() int {
+	fake.setEncryptionAlgorithmMutex.RLock()
+	defer fake.setEncryptionAlgorithmMutex.RUnlock()
+	return len(fake.setEncryptionAlgorithmArgsForCall)
+}
+
+func (fake *FakeEncryptionDB) SetEncryptionAlgorithmArgsForCall(i int) (lager.Logger, string) {
+	fake.setEncryptionAlgorithmMutex.RLock()
+	defer fake.setEncryptionAlgorithmMutex.RUnlock()
+	return fake.setEncryptionAlgorithmArgsForCall[i].logger, fake.setEncryptionAlgorithmArgsForCall[i].algorithm
+}
+
+func (fake *FakeEncryptionDB) SetEncryptionAlgorithmReturns(result1 error) {
+	fake.SetEncryptionAlgorithmStub = nil
+	fake.setEncryptionAlgorithmReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeEncryptionDB) PerformEncryption(logger lager.Logger) error {
 	fake.performEncryptionMutex.Lock()
 	fake.performEncryptionArgsForCall = append(fake.performEncryptionArgsForCall, struct {
@@ -147,6 +233,10 @@ func (fake *FakeEncryptionDB) Invocations() map[string][][]interface{} {
 	defer fake.encryptionKeyLabelMutex.RUnlock()
 	fake.setEncryptionKeyLabelMutex.RLock()
 	defer fake.setEncryptionKeyLabelMutex.RUnlock()
+	fake.encryptionAlgorithmMutex.RLock()
+	defer fake.encryptionAlgorithmMutex.RUnlock()
+	fake.setEncryptionAlgorithmMutex.RLock()
+	defer fake.setEncryptionAlgorithmMutex.RUnlock()
 	fake.performEncryptionMutex.RLock()
 	defer fake.performEncryptionMutex.RUnlock()
 	return fake.invocations
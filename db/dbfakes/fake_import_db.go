@@ -0,0 +1,170 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeImportDB struct {
+	IsEmptyStub        func(logger lager.Logger) (bool, error)
+	isEmptyMutex       sync.RWMutex
+	isEmptyArgsForCall []struct {
+		logger lager.Logger
+	}
+	isEmptyReturns struct {
+		result1 bool
+		result2 error
+	}
+	ImportRecordsStub        func(logger lager.Logger, records []*models.ImportRecord) []error
+	importRecordsMutex       sync.RWMutex
+	importRecordsArgsForCall []struct {
+		logger  lager.Logger
+		records []*models.ImportRecord
+	}
+	importRecordsReturns struct {
+		result1 []error
+	}
+	ExportRecordsStub        func(logger lager.Logger, yield func(*models.ImportRecord) error) error
+	exportRecordsMutex       sync.RWMutex
+	exportRecordsArgsForCall []struct {
+		logger lager.Logger
+		yield  func(*models.ImportRecord) error
+	}
+	exportRecordsReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImportDB) IsEmpty(logger lager.Logger) (bool, error) {
+	fake.isEmptyMutex.Lock()
+	fake.isEmptyArgsForCall = append(fake.isEmptyArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("IsEmpty", []interface{}{logger})
+	fake.isEmptyMutex.Unlock()
+	if fake.IsEmptyStub != nil {
+		return fake.IsEmptyStub(logger)
+	} else {
+		return fake.isEmptyReturns.result1, fake.isEmptyReturns.result2
+	}
+}
+
+func (fake *FakeImportDB) IsEmptyCallCount() int {
+	fake.isEmptyMutex.RLock()
+	defer fake.isEmptyMutex.RUnlock()
+	return len(fake.isEmptyArgsForCall)
+}
+
+func (fake *FakeImportDB) IsEmptyArgsForCall(i int) lager.Logger {
+	fake.isEmptyMutex.RLock()
+	defer fake.isEmptyMutex.RUnlock()
+	return fake.isEmptyArgsForCall[i].logger
+}
+
+func (fake *FakeImportDB) IsEmptyReturns(result1 bool, result2 error) {
+	fake.IsEmptyStub = nil
+	fake.isEmptyReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportDB) ImportRecords(logger lager.Logger, records []*models.ImportRecord) []error {
+	fake.importRecordsMutex.Lock()
+	fake.importRecordsArgsForCall = append(fake.importRecordsArgsForCall, struct {
+		logger  lager.Logger
+		records []*models.ImportRecord
+	}{logger, records})
+	fake.recordInvocation("ImportRecords", []interface{}{logger, records})
+	fake.importRecordsMutex.Unlock()
+	if fake.ImportRecordsStub != nil {
+		return fake.ImportRecordsStub(logger, records)
+	} else {
+		return fake.importRecordsReturns.result1
+	}
+}
+
+func (fake *FakeImportDB) ImportRecordsCallCount() int {
+	fake.importRecordsMutex.RLock()
+	defer fake.importRecordsMutex.RUnlock()
+	return len(fake.importRecordsArgsForCall)
+}
+
+func (fake *FakeImportDB) ImportRecordsArgsForCall(i int) (lager.Logger, []*models.ImportRecord) {
+	fake.importRecordsMutex.RLock()
+	defer fake.importRecordsMutex.RUnlock()
+	return fake.importRecordsArgsForCall[i].logger, fake.importRecordsArgsForCall[i].records
+}
+
+func (fake *FakeImportDB) ImportRecordsReturns(result1 []error) {
+	fake.ImportRecordsStub = nil
+	fake.importRecordsReturns = struct {
+		result1 []error
+	}{result1}
+}
+
+func (fake *FakeImportDB) ExportRecords(logger lager.Logger, yield func(*models.ImportRecord) error) error {
+	fake.exportRecordsMutex.Lock()
+	fake.exportRecordsArgsForCall = append(fake.exportRecordsArgsForCall, struct {
+		logger lager.Logger
+		yield  func(*models.ImportRecord) error
+	}{logger, yield})
+	fake.recordInvocation("ExportRecords", []interface{}{logger, yield})
+	fake.exportRecordsMutex.Unlock()
+	if fake.ExportRecordsStub != nil {
+		return fake.ExportRecordsStub(logger, yield)
+	} else {
+		return fake.exportRecordsReturns.result1
+	}
+}
+
+func (fake *FakeImportDB) ExportRecordsCallCount() int {
+	fake.exportRecordsMutex.RLock()
+	defer fake.exportRecordsMutex.RUnlock()
+	return len(fake.exportRecordsArgsForCall)
+}
+
+func (fake *FakeImportDB) ExportRecordsArgsForCall(i int) (lager.Logger, func(*models.ImportRecord) error) {
+	fake.exportRecordsMutex.RLock()
+	defer fake.exportRecordsMutex.RUnlock()
+	return fake.exportRecordsArgsForCall[i].logger, fake.exportRecordsArgsForCall[i].yield
+}
+
+func (fake *FakeImportDB) ExportRecordsReturns(result1 error) {
+	fake.ExportRecordsStub = nil
+	fake.exportRecordsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImportDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.isEmptyMutex.RLock()
+	defer fake.isEmptyMutex.RUnlock()
+	fake.importRecordsMutex.RLock()
+	defer fake.importRecordsMutex.RUnlock()
+	fake.exportRecordsMutex.RLock()
+	defer fake.exportRecordsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeImportDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ImportDB = new(FakeImportDB)
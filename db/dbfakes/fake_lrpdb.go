@@ -21,6 +21,17 @@ type FakeLRPDB struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPGroupsWithRecordErrorsStub        func(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error)
+	actualLRPGroupsWithRecordErrorsMutex       sync.RWMutex
+	actualLRPGroupsWithRecordErrorsArgsForCall []struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}
+	actualLRPGroupsWithRecordErrorsReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}
 	ActualLRPGroupsByProcessGuidStub        func(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
 	actualLRPGroupsByProcessGuidArgsForCall []struct {
@@ -42,6 +53,26 @@ type FakeLRPDB struct {
 		result1 *models.ActualLRPGroup
 		result2 error
 	}
+	CrashingActualLRPsStub        func(logger lager.Logger, limit int) ([]*models.ActualLRP, error)
+	crashingActualLRPsMutex       sync.RWMutex
+	crashingActualLRPsArgsForCall []struct {
+		logger lager.Logger
+		limit  int
+	}
+	crashingActualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	CellPlacementsStub        func(logger lager.Logger, cellID string) ([]*models.CellPlacement, error)
+	cellPlacementsMutex       sync.RWMutex
+	cellPlacementsArgsForCall []struct {
+		logger lager.Logger
+		cellID string
+	}
+	cellPlacementsReturns struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}
 	CreateUnclaimedActualLRPStub        func(logger lager.Logger, key *models.ActualLRPKey) (after *models.ActualLRPGroup, err error)
 	createUnclaimedActualLRPMutex       sync.RWMutex
 	createUnclaimedActualLRPArgsForCall []struct {
@@ -89,6 +120,18 @@ type FakeLRPDB struct {
 		result2 *models.ActualLRPGroup
 		result3 error
 	}
+	StartActualLRPsStub        func(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) (*models.StartActualLRPsResult, error)
+	startActualLRPsMutex       sync.RWMutex
+	startActualLRPsArgsForCall []struct {
+		logger        lager.Logger
+		cellID        string
+		starts        []*models.StartActualLRPRequest
+		authoritative bool
+	}
+	startActualLRPsReturns struct {
+		result1 *models.StartActualLRPsResult
+		result2 error
+	}
 	CrashActualLRPStub        func(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, crashReason string) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, shouldRestart bool, err error)
 	crashActualLRPMutex       sync.RWMutex
 	crashActualLRPArgsForCall []struct {
@@ -126,6 +169,17 @@ type FakeLRPDB struct {
 	removeActualLRPReturns struct {
 		result1 error
 	}
+	RetireActualLRPsOnCellStub        func(logger lager.Logger, cellID string) (before []*models.ActualLRPGroup, after []*models.ActualLRPGroup, err error)
+	retireActualLRPsOnCellMutex       sync.RWMutex
+	retireActualLRPsOnCellArgsForCall []struct {
+		logger lager.Logger
+		cellID string
+	}
+	retireActualLRPsOnCellReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.ActualLRPGroup
+		result3 error
+	}
 	DesiredLRPsStub        func(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 	desiredLRPsMutex       sync.RWMutex
 	desiredLRPsArgsForCall []struct {
@@ -136,6 +190,17 @@ type FakeLRPDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsWithRecordErrorsStub        func(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error)
+	desiredLRPsWithRecordErrorsMutex       sync.RWMutex
+	desiredLRPsWithRecordErrorsArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}
+	desiredLRPsWithRecordErrorsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}
 	DesiredLRPByProcessGuidStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -156,6 +221,26 @@ type FakeLRPDB struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	StreamDesiredLRPSchedulingInfosStub        func(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error)
+	streamDesiredLRPSchedulingInfosMutex       sync.RWMutex
+	streamDesiredLRPSchedulingInfosArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		yield  func(*models.DesiredLRPSchedulingInfo) error
+	}
+	streamDesiredLRPSchedulingInfosReturns struct {
+		result1 []*models.RecordError
+		result2 error
+	}
+	DesiredLRPsRevisionStub        func(logger lager.Logger) (uint64, error)
+	desiredLRPsRevisionMutex       sync.RWMutex
+	desiredLRPsRevisionArgsForCall []struct {
+		logger lager.Logger
+	}
+	desiredLRPsRevisionReturns struct {
+		result1 uint64
+		result2 error
+	}
 	DesireLRPStub        func(logger lager.Logger, desiredLRP *models.DesiredLRP) error
 	desireLRPMutex       sync.RWMutex
 	desireLRPArgsForCall []struct {
@@ -165,6 +250,17 @@ type FakeLRPDB struct {
 	desireLRPReturns struct {
 		result1 error
 	}
+	DesireLRPWithIdempotencyKeyStub        func(logger lager.Logger, key models.IdempotencyKey, desiredLRP *models.DesiredLRP) (replayed bool, err error)
+	desireLRPWithIdempotencyKeyMutex       sync.RWMutex
+	desireLRPWithIdempotencyKeyArgsForCall []struct {
+		logger     lager.Logger
+		key        models.IdempotencyKey
+		desiredLRP *models.DesiredLRP
+	}
+	desireLRPWithIdempotencyKeyReturns struct {
+		result1 bool
+		result2 error
+	}
 	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error)
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -185,11 +281,21 @@ type FakeLRPDB struct {
 	removeDesiredLRPReturns struct {
 		result1 error
 	}
-	ConvergeLRPsStub        func(logger lager.Logger, cellSet models.CellSet) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey)
+	LRPCountsByDomainStub        func(logger lager.Logger) (map[string]db.DomainLRPCounts, error)
+	lRPCountsByDomainMutex       sync.RWMutex
+	lRPCountsByDomainArgsForCall []struct {
+		logger lager.Logger
+	}
+	lRPCountsByDomainReturns struct {
+		result1 map[string]db.DomainLRPCounts
+		result2 error
+	}
+	ConvergeLRPsStub        func(logger lager.Logger, cellSet models.CellSet, filter models.ConvergenceFilter) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey)
 	convergeLRPsMutex       sync.RWMutex
 	convergeLRPsArgsForCall []struct {
 		logger  lager.Logger
 		cellSet models.CellSet
+		filter  models.ConvergenceFilter
 	}
 	convergeLRPsReturns struct {
 		result1 []*auctioneer.LRPStartRequest
@@ -245,6 +351,42 @@ func (fake *FakeLRPDB) ActualLRPGroupsReturns(result1 []*models.ActualLRPGroup,
 	}{result1, result2}
 }
 
+func (fake *FakeLRPDB) ActualLRPGroupsWithRecordErrors(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.Lock()
+	fake.actualLRPGroupsWithRecordErrorsArgsForCall = append(fake.actualLRPGroupsWithRecordErrorsArgsForCall, struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("ActualLRPGroupsWithRecordErrors", []interface{}{logger, filter})
+	fake.actualLRPGroupsWithRecordErrorsMutex.Unlock()
+	if fake.ActualLRPGroupsWithRecordErrorsStub != nil {
+		return fake.ActualLRPGroupsWithRecordErrorsStub(logger, filter)
+	} else {
+		return fake.actualLRPGroupsWithRecordErrorsReturns.result1, fake.actualLRPGroupsWithRecordErrorsReturns.result2, fake.actualLRPGroupsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeLRPDB) ActualLRPGroupsWithRecordErrorsCallCount() int {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return len(fake.actualLRPGroupsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeLRPDB) ActualLRPGroupsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].logger, fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].filter
+}
+
+func (fake *FakeLRPDB) ActualLRPGroupsWithRecordErrorsReturns(result1 []*models.ActualLRPGroup, result2 []*models.RecordError, result3 error) {
+	fake.ActualLRPGroupsWithRecordErrorsStub = nil
+	fake.actualLRPGroupsWithRecordErrorsReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeLRPDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsByProcessGuidMutex.Lock()
 	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
@@ -316,6 +458,76 @@ func (fake *FakeLRPDB) ActualLRPGroupByProcessGuidAndIndexReturns(result1 *model
 	}{result1, result2}
 }
 
+func (fake *FakeLRPDB) CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error) {
+	fake.crashingActualLRPsMutex.Lock()
+	fake.crashingActualLRPsArgsForCall = append(fake.crashingActualLRPsArgsForCall, struct {
+		logger lager.Logger
+		limit  int
+	}{logger, limit})
+	fake.recordInvocation("CrashingActualLRPs", []interface{}{logger, limit})
+	fake.crashingActualLRPsMutex.Unlock()
+	if fake.CrashingActualLRPsStub != nil {
+		return fake.CrashingActualLRPsStub(logger, limit)
+	} else {
+		return fake.crashingActualLRPsReturns.result1, fake.crashingActualLRPsReturns.result2
+	}
+}
+
+func (fake *FakeLRPDB) CrashingActualLRPsCallCount() int {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return len(fake.crashingActualLRPsArgsForCall)
+}
+
+func (fake *FakeLRPDB) CrashingActualLRPsArgsForCall(i int) (lager.Logger, int) {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return fake.crashingActualLRPsArgsForCall[i].logger, fake.crashingActualLRPsArgsForCall[i].limit
+}
+
+func (fake *FakeLRPDB) CrashingActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.CrashingActualLRPsStub = nil
+	fake.crashingActualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error) {
+	fake.cellPlacementsMutex.Lock()
+	fake.cellPlacementsArgsForCall = append(fake.cellPlacementsArgsForCall, struct {
+		logger lager.Logger
+		cellID string
+	}{logger, cellID})
+	fake.recordInvocation("CellPlacements", []interface{}{logger, cellID})
+	fake.cellPlacementsMutex.Unlock()
+	if fake.CellPlacementsStub != nil {
+		return fake.CellPlacementsStub(logger, cellID)
+	} else {
+		return fake.cellPlacementsReturns.result1, fake.cellPlacementsReturns.result2
+	}
+}
+
+func (fake *FakeLRPDB) CellPlacementsCallCount() int {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return len(fake.cellPlacementsArgsForCall)
+}
+
+func (fake *FakeLRPDB) CellPlacementsArgsForCall(i int) (lager.Logger, string) {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return fake.cellPlacementsArgsForCall[i].logger, fake.cellPlacementsArgsForCall[i].cellID
+}
+
+func (fake *FakeLRPDB) CellPlacementsReturns(result1 []*models.CellPlacement, result2 error) {
+	fake.CellPlacementsStub = nil
+	fake.cellPlacementsReturns = struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) CreateUnclaimedActualLRP(logger lager.Logger, key *models.ActualLRPKey) (after *models.ActualLRPGroup, err error) {
 	fake.createUnclaimedActualLRPMutex.Lock()
 	fake.createUnclaimedActualLRPArgsForCall = append(fake.createUnclaimedActualLRPArgsForCall, struct {
@@ -463,6 +675,43 @@ func (fake *FakeLRPDB) StartActualLRPReturns(result1 *models.ActualLRPGroup, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeLRPDB) StartActualLRPs(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) (*models.StartActualLRPsResult, error) {
+	fake.startActualLRPsMutex.Lock()
+	fake.startActualLRPsArgsForCall = append(fake.startActualLRPsArgsForCall, struct {
+		logger        lager.Logger
+		cellID        string
+		starts        []*models.StartActualLRPRequest
+		authoritative bool
+	}{logger, cellID, starts, authoritative})
+	fake.recordInvocation("StartActualLRPs", []interface{}{logger, cellID, starts, authoritative})
+	fake.startActualLRPsMutex.Unlock()
+	if fake.StartActualLRPsStub != nil {
+		return fake.StartActualLRPsStub(logger, cellID, starts, authoritative)
+	} else {
+		return fake.startActualLRPsReturns.result1, fake.startActualLRPsReturns.result2
+	}
+}
+
+func (fake *FakeLRPDB) StartActualLRPsCallCount() int {
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
+	return len(fake.startActualLRPsArgsForCall)
+}
+
+func (fake *FakeLRPDB) StartActualLRPsArgsForCall(i int) (lager.Logger, string, []*models.StartActualLRPRequest, bool) {
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
+	return fake.startActualLRPsArgsForCall[i].logger, fake.startActualLRPsArgsForCall[i].cellID, fake.startActualLRPsArgsForCall[i].starts, fake.startActualLRPsArgsForCall[i].authoritative
+}
+
+func (fake *FakeLRPDB) StartActualLRPsReturns(result1 *models.StartActualLRPsResult, result2 error) {
+	fake.StartActualLRPsStub = nil
+	fake.startActualLRPsReturns = struct {
+		result1 *models.StartActualLRPsResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, crashReason string) (before *models.ActualLRPGroup, after *models.ActualLRPGroup, shouldRestart bool, err error) {
 	fake.crashActualLRPMutex.Lock()
 	fake.crashActualLRPArgsForCall = append(fake.crashActualLRPArgsForCall, struct {
@@ -575,6 +824,42 @@ func (fake *FakeLRPDB) RemoveActualLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeLRPDB) RetireActualLRPsOnCell(logger lager.Logger, cellID string) (before []*models.ActualLRPGroup, after []*models.ActualLRPGroup, err error) {
+	fake.retireActualLRPsOnCellMutex.Lock()
+	fake.retireActualLRPsOnCellArgsForCall = append(fake.retireActualLRPsOnCellArgsForCall, struct {
+		logger lager.Logger
+		cellID string
+	}{logger, cellID})
+	fake.recordInvocation("RetireActualLRPsOnCell", []interface{}{logger, cellID})
+	fake.retireActualLRPsOnCellMutex.Unlock()
+	if fake.RetireActualLRPsOnCellStub != nil {
+		return fake.RetireActualLRPsOnCellStub(logger, cellID)
+	} else {
+		return fake.retireActualLRPsOnCellReturns.result1, fake.retireActualLRPsOnCellReturns.result2, fake.retireActualLRPsOnCellReturns.result3
+	}
+}
+
+func (fake *FakeLRPDB) RetireActualLRPsOnCellCallCount() int {
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
+	return len(fake.retireActualLRPsOnCellArgsForCall)
+}
+
+func (fake *FakeLRPDB) RetireActualLRPsOnCellArgsForCall(i int) (lager.Logger, string) {
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
+	return fake.retireActualLRPsOnCellArgsForCall[i].logger, fake.retireActualLRPsOnCellArgsForCall[i].cellID
+}
+
+func (fake *FakeLRPDB) RetireActualLRPsOnCellReturns(result1 []*models.ActualLRPGroup, result2 []*models.ActualLRPGroup, result3 error) {
+	fake.RetireActualLRPsOnCellStub = nil
+	fake.retireActualLRPsOnCellReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.ActualLRPGroup
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeLRPDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	fake.desiredLRPsMutex.Lock()
 	fake.desiredLRPsArgsForCall = append(fake.desiredLRPsArgsForCall, struct {
@@ -610,6 +895,42 @@ func (fake *FakeLRPDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2
 	}{result1, result2}
 }
 
+func (fake *FakeLRPDB) DesiredLRPsWithRecordErrors(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
+	fake.desiredLRPsWithRecordErrorsMutex.Lock()
+	fake.desiredLRPsWithRecordErrorsArgsForCall = append(fake.desiredLRPsWithRecordErrorsArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("DesiredLRPsWithRecordErrors", []interface{}{logger, filter})
+	fake.desiredLRPsWithRecordErrorsMutex.Unlock()
+	if fake.DesiredLRPsWithRecordErrorsStub != nil {
+		return fake.DesiredLRPsWithRecordErrorsStub(logger, filter)
+	} else {
+		return fake.desiredLRPsWithRecordErrorsReturns.result1, fake.desiredLRPsWithRecordErrorsReturns.result2, fake.desiredLRPsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeLRPDB) DesiredLRPsWithRecordErrorsCallCount() int {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return len(fake.desiredLRPsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeLRPDB) DesiredLRPsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return fake.desiredLRPsWithRecordErrorsArgsForCall[i].logger, fake.desiredLRPsWithRecordErrorsArgsForCall[i].filter
+}
+
+func (fake *FakeLRPDB) DesiredLRPsWithRecordErrorsReturns(result1 []*models.DesiredLRP, result2 []*models.RecordError, result3 error) {
+	fake.DesiredLRPsWithRecordErrorsStub = nil
+	fake.desiredLRPsWithRecordErrorsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeLRPDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
@@ -680,6 +1001,76 @@ func (fake *FakeLRPDB) DesiredLRPSchedulingInfosReturns(result1 []*models.Desire
 	}{result1, result2}
 }
 
+func (fake *FakeLRPDB) StreamDesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error) {
+	fake.streamDesiredLRPSchedulingInfosMutex.Lock()
+	fake.streamDesiredLRPSchedulingInfosArgsForCall = append(fake.streamDesiredLRPSchedulingInfosArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		yield  func(*models.DesiredLRPSchedulingInfo) error
+	}{logger, filter, yield})
+	fake.recordInvocation("StreamDesiredLRPSchedulingInfos", []interface{}{logger, filter, yield})
+	fake.streamDesiredLRPSchedulingInfosMutex.Unlock()
+	if fake.StreamDesiredLRPSchedulingInfosStub != nil {
+		return fake.StreamDesiredLRPSchedulingInfosStub(logger, filter, yield)
+	} else {
+		return fake.streamDesiredLRPSchedulingInfosReturns.result1, fake.streamDesiredLRPSchedulingInfosReturns.result2
+	}
+}
+
+func (fake *FakeLRPDB) StreamDesiredLRPSchedulingInfosCallCount() int {
+	fake.streamDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.streamDesiredLRPSchedulingInfosMutex.RUnlock()
+	return len(fake.streamDesiredLRPSchedulingInfosArgsForCall)
+}
+
+func (fake *FakeLRPDB) StreamDesiredLRPSchedulingInfosArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter, func(*models.DesiredLRPSchedulingInfo) error) {
+	fake.streamDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.streamDesiredLRPSchedulingInfosMutex.RUnlock()
+	return fake.streamDesiredLRPSchedulingInfosArgsForCall[i].logger, fake.streamDesiredLRPSchedulingInfosArgsForCall[i].filter, fake.streamDesiredLRPSchedulingInfosArgsForCall[i].yield
+}
+
+func (fake *FakeLRPDB) StreamDesiredLRPSchedulingInfosReturns(result1 []*models.RecordError, result2 error) {
+	fake.StreamDesiredLRPSchedulingInfosStub = nil
+	fake.streamDesiredLRPSchedulingInfosReturns = struct {
+		result1 []*models.RecordError
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) DesiredLRPsRevision(logger lager.Logger) (uint64, error) {
+	fake.desiredLRPsRevisionMutex.Lock()
+	fake.desiredLRPsRevisionArgsForCall = append(fake.desiredLRPsRevisionArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DesiredLRPsRevision", []interface{}{logger})
+	fake.desiredLRPsRevisionMutex.Unlock()
+	if fake.DesiredLRPsRevisionStub != nil {
+		return fake.DesiredLRPsRevisionStub(logger)
+	} else {
+		return fake.desiredLRPsRevisionReturns.result1, fake.desiredLRPsRevisionReturns.result2
+	}
+}
+
+func (fake *FakeLRPDB) DesiredLRPsRevisionCallCount() int {
+	fake.desiredLRPsRevisionMutex.RLock()
+	defer fake.desiredLRPsRevisionMutex.RUnlock()
+	return len(fake.desiredLRPsRevisionArgsForCall)
+}
+
+func (fake *FakeLRPDB) DesiredLRPsRevisionArgsForCall(i int) lager.Logger {
+	fake.desiredLRPsRevisionMutex.RLock()
+	defer fake.desiredLRPsRevisionMutex.RUnlock()
+	return fake.desiredLRPsRevisionArgsForCall[i].logger
+}
+
+func (fake *FakeLRPDB) DesiredLRPsRevisionReturns(result1 uint64, result2 error) {
+	fake.DesiredLRPsRevisionStub = nil
+	fake.desiredLRPsRevisionReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) error {
 	fake.desireLRPMutex.Lock()
 	fake.desireLRPArgsForCall = append(fake.desireLRPArgsForCall, struct {
@@ -714,6 +1105,42 @@ func (fake *FakeLRPDB) DesireLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeLRPDB) DesireLRPWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, desiredLRP *models.DesiredLRP) (replayed bool, err error) {
+	fake.desireLRPWithIdempotencyKeyMutex.Lock()
+	fake.desireLRPWithIdempotencyKeyArgsForCall = append(fake.desireLRPWithIdempotencyKeyArgsForCall, struct {
+		logger     lager.Logger
+		key        models.IdempotencyKey
+		desiredLRP *models.DesiredLRP
+	}{logger, key, desiredLRP})
+	fake.recordInvocation("DesireLRPWithIdempotencyKey", []interface{}{logger, key, desiredLRP})
+	fake.desireLRPWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireLRPWithIdempotencyKeyStub != nil {
+		return fake.DesireLRPWithIdempotencyKeyStub(logger, key, desiredLRP)
+	} else {
+		return fake.desireLRPWithIdempotencyKeyReturns.result1, fake.desireLRPWithIdempotencyKeyReturns.result2
+	}
+}
+
+func (fake *FakeLRPDB) DesireLRPWithIdempotencyKeyCallCount() int {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireLRPWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeLRPDB) DesireLRPWithIdempotencyKeyArgsForCall(i int) (lager.Logger, models.IdempotencyKey, *models.DesiredLRP) {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireLRPWithIdempotencyKeyArgsForCall[i].logger, fake.desireLRPWithIdempotencyKeyArgsForCall[i].key, fake.desireLRPWithIdempotencyKeyArgsForCall[i].desiredLRP
+}
+
+func (fake *FakeLRPDB) DesireLRPWithIdempotencyKeyReturns(result1 bool, result2 error) {
+	fake.DesireLRPWithIdempotencyKeyStub = nil
+	fake.desireLRPWithIdempotencyKeyReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error) {
 	fake.updateDesiredLRPMutex.Lock()
 	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
@@ -784,16 +1211,51 @@ func (fake *FakeLRPDB) RemoveDesiredLRPReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeLRPDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey) {
+func (fake *FakeLRPDB) LRPCountsByDomain(logger lager.Logger) (map[string]db.DomainLRPCounts, error) {
+	fake.lRPCountsByDomainMutex.Lock()
+	fake.lRPCountsByDomainArgsForCall = append(fake.lRPCountsByDomainArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("LRPCountsByDomain", []interface{}{logger})
+	fake.lRPCountsByDomainMutex.Unlock()
+	if fake.LRPCountsByDomainStub != nil {
+		return fake.LRPCountsByDomainStub(logger)
+	} else {
+		return fake.lRPCountsByDomainReturns.result1, fake.lRPCountsByDomainReturns.result2
+	}
+}
+
+func (fake *FakeLRPDB) LRPCountsByDomainCallCount() int {
+	fake.lRPCountsByDomainMutex.RLock()
+	defer fake.lRPCountsByDomainMutex.RUnlock()
+	return len(fake.lRPCountsByDomainArgsForCall)
+}
+
+func (fake *FakeLRPDB) LRPCountsByDomainArgsForCall(i int) lager.Logger {
+	fake.lRPCountsByDomainMutex.RLock()
+	defer fake.lRPCountsByDomainMutex.RUnlock()
+	return fake.lRPCountsByDomainArgsForCall[i].logger
+}
+
+func (fake *FakeLRPDB) LRPCountsByDomainReturns(result1 map[string]db.DomainLRPCounts, result2 error) {
+	fake.LRPCountsByDomainStub = nil
+	fake.lRPCountsByDomainReturns = struct {
+		result1 map[string]db.DomainLRPCounts
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet, filter models.ConvergenceFilter) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey) {
 	fake.convergeLRPsMutex.Lock()
 	fake.convergeLRPsArgsForCall = append(fake.convergeLRPsArgsForCall, struct {
 		logger  lager.Logger
 		cellSet models.CellSet
-	}{logger, cellSet})
-	fake.recordInvocation("ConvergeLRPs", []interface{}{logger, cellSet})
+		filter  models.ConvergenceFilter
+	}{logger, cellSet, filter})
+	fake.recordInvocation("ConvergeLRPs", []interface{}{logger, cellSet, filter})
 	fake.convergeLRPsMutex.Unlock()
 	if fake.ConvergeLRPsStub != nil {
-		return fake.ConvergeLRPsStub(logger, cellSet)
+		return fake.ConvergeLRPsStub(logger, cellSet, filter)
 	} else {
 		return fake.convergeLRPsReturns.result1, fake.convergeLRPsReturns.result2, fake.convergeLRPsReturns.result3
 	}
@@ -805,10 +1267,10 @@ func (fake *FakeLRPDB) ConvergeLRPsCallCount() int {
 	return len(fake.convergeLRPsArgsForCall)
 }
 
-func (fake *FakeLRPDB) ConvergeLRPsArgsForCall(i int) (lager.Logger, models.CellSet) {
+func (fake *FakeLRPDB) ConvergeLRPsArgsForCall(i int) (lager.Logger, models.CellSet, models.ConvergenceFilter) {
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
-	return fake.convergeLRPsArgsForCall[i].logger, fake.convergeLRPsArgsForCall[i].cellSet
+	return fake.convergeLRPsArgsForCall[i].logger, fake.convergeLRPsArgsForCall[i].cellSet, fake.convergeLRPsArgsForCall[i].filter
 }
 
 func (fake *FakeLRPDB) ConvergeLRPsReturns(result1 []*auctioneer.LRPStartRequest, result2 []*models.ActualLRPKeyWithSchedulingInfo, result3 []*models.ActualLRPKey) {
@@ -860,10 +1322,16 @@ func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
 	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
 	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
 	fake.createUnclaimedActualLRPMutex.RLock()
 	defer fake.createUnclaimedActualLRPMutex.RUnlock()
 	fake.unclaimActualLRPMutex.RLock()
@@ -872,24 +1340,38 @@ func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.claimActualLRPMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
 	fake.crashActualLRPMutex.RLock()
 	defer fake.crashActualLRPMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.streamDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.streamDesiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPsRevisionMutex.RLock()
+	defer fake.desiredLRPsRevisionMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.lRPCountsByDomainMutex.RLock()
+	defer fake.lRPCountsByDomainMutex.RUnlock()
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
 	fake.gatherAndPruneLRPsMutex.RLock()
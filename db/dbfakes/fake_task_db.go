@@ -22,6 +22,17 @@ type FakeTaskDB struct {
 		result1 []*models.Task
 		result2 error
 	}
+	StreamTasksStub        func(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error)
+	streamTasksMutex       sync.RWMutex
+	streamTasksArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+		yield  func(*models.Task) error
+	}
+	streamTasksReturns struct {
+		result1 []*models.RecordError
+		result2 error
+	}
 	TaskByGuidStub        func(logger lager.Logger, taskGuid string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -32,7 +43,7 @@ type FakeTaskDB struct {
 		result1 *models.Task
 		result2 error
 	}
-	DesireTaskStub        func(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
+	DesireTaskStub        func(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) (*models.Task, error)
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
 		logger         lager.Logger
@@ -41,9 +52,34 @@ type FakeTaskDB struct {
 		domain         string
 	}
 	desireTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 error
+	}
+	DesireTaskWithIdempotencyKeyStub        func(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid, domain string) (task *models.Task, replayed bool, err error)
+	desireTaskWithIdempotencyKeyMutex       sync.RWMutex
+	desireTaskWithIdempotencyKeyArgsForCall []struct {
+		logger         lager.Logger
+		key            models.IdempotencyKey
+		taskDefinition *models.TaskDefinition
+		taskGuid       string
+		domain         string
+	}
+	desireTaskWithIdempotencyKeyReturns struct {
+		result1 *models.Task
+		result2 bool
+		result3 error
+	}
+	DuplicateTasksStub        func(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error)
+	duplicateTasksMutex       sync.RWMutex
+	duplicateTasksArgsForCall []struct {
+		logger lager.Logger
+		domain string
+	}
+	duplicateTasksReturns struct {
+		result1 []*models.DuplicateTasks
+		result2 error
 	}
-	StartTaskStub        func(logger lager.Logger, taskGuid, cellId string) (bool, error)
+	StartTaskStub        func(logger lager.Logger, taskGuid, cellId string) (before *models.Task, after *models.Task, started bool, err error)
 	startTaskMutex       sync.RWMutex
 	startTaskArgsForCall []struct {
 		logger   lager.Logger
@@ -51,10 +87,12 @@ type FakeTaskDB struct {
 		cellId   string
 	}
 	startTaskReturns struct {
-		result1 bool
-		result2 error
+		result1 *models.Task
+		result2 *models.Task
+		result3 bool
+		result4 error
 	}
-	CancelTaskStub        func(logger lager.Logger, taskGuid string) (task *models.Task, cellID string, err error)
+	CancelTaskStub        func(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error)
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
 		logger   lager.Logger
@@ -62,10 +100,22 @@ type FakeTaskDB struct {
 	}
 	cancelTaskReturns struct {
 		result1 *models.Task
-		result2 string
-		result3 error
+		result2 *models.Task
+		result3 string
+		result4 error
+	}
+	CancelTasksStub        func(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error)
+	cancelTasksMutex       sync.RWMutex
+	cancelTasksArgsForCall []struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}
+	cancelTasksReturns struct {
+		result1 *models.CancelTasksResult
+		result2 error
 	}
-	FailTaskStub        func(logger lager.Logger, taskGuid, failureReason string) (task *models.Task, err error)
+	FailTaskStub        func(logger lager.Logger, taskGuid, failureReason string) (before *models.Task, after *models.Task, err error)
 	failTaskMutex       sync.RWMutex
 	failTaskArgsForCall []struct {
 		logger        lager.Logger
@@ -74,9 +124,22 @@ type FakeTaskDB struct {
 	}
 	failTaskReturns struct {
 		result1 *models.Task
-		result2 error
+		result2 *models.Task
+		result3 error
+	}
+	RejectTaskStub        func(logger lager.Logger, taskGuid, rejectionReason string) (before *models.Task, after *models.Task, err error)
+	rejectTaskMutex       sync.RWMutex
+	rejectTaskArgsForCall []struct {
+		logger          lager.Logger
+		taskGuid        string
+		rejectionReason string
+	}
+	rejectTaskReturns struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
 	}
-	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (task *models.Task, err error)
+	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (before *models.Task, after *models.Task, err error)
 	completeTaskMutex       sync.RWMutex
 	completeTaskArgsForCall []struct {
 		logger        lager.Logger
@@ -88,27 +151,41 @@ type FakeTaskDB struct {
 	}
 	completeTaskReturns struct {
 		result1 *models.Task
-		result2 error
+		result2 *models.Task
+		result3 error
 	}
-	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) error
+	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error)
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
 		logger   lager.Logger
 		taskGuid string
 	}
 	resolvingTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
 	}
-	DeleteTaskStub        func(logger lager.Logger, taskGuid string) error
+	DeleteTaskStub        func(logger lager.Logger, taskGuid string) (*models.Task, error)
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
 		logger   lager.Logger
 		taskGuid string
 	}
 	deleteTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 error
 	}
-	ConvergeTasksStub        func(logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task)
+	PurgeCompletedTasksStub        func(logger lager.Logger, minAge time.Duration) (int, error)
+	purgeCompletedTasksMutex       sync.RWMutex
+	purgeCompletedTasksArgsForCall []struct {
+		logger lager.Logger
+		minAge time.Duration
+	}
+	purgeCompletedTasksReturns struct {
+		result1 int
+		result2 error
+	}
+	ConvergeTasksStub        func(logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration, maxTaskRejections int) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task)
 	convergeTasksMutex       sync.RWMutex
 	convergeTasksArgsForCall []struct {
 		logger                      lager.Logger
@@ -116,6 +193,7 @@ type FakeTaskDB struct {
 		kickTaskDuration            time.Duration
 		expirePendingTaskDuration   time.Duration
 		expireCompletedTaskDuration time.Duration
+		maxTaskRejections           int
 	}
 	convergeTasksReturns struct {
 		result1 []*auctioneer.TaskStartRequest
@@ -160,6 +238,42 @@ func (fake *FakeTaskDB) TasksReturns(result1 []*models.Task, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeTaskDB) StreamTasks(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+	fake.streamTasksMutex.Lock()
+	fake.streamTasksArgsForCall = append(fake.streamTasksArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+		yield  func(*models.Task) error
+	}{logger, filter, yield})
+	fake.recordInvocation("StreamTasks", []interface{}{logger, filter, yield})
+	fake.streamTasksMutex.Unlock()
+	if fake.StreamTasksStub != nil {
+		return fake.StreamTasksStub(logger, filter, yield)
+	} else {
+		return fake.streamTasksReturns.result1, fake.streamTasksReturns.result2
+	}
+}
+
+func (fake *FakeTaskDB) StreamTasksCallCount() int {
+	fake.streamTasksMutex.RLock()
+	defer fake.streamTasksMutex.RUnlock()
+	return len(fake.streamTasksArgsForCall)
+}
+
+func (fake *FakeTaskDB) StreamTasksArgsForCall(i int) (lager.Logger, models.TaskFilter, func(*models.Task) error) {
+	fake.streamTasksMutex.RLock()
+	defer fake.streamTasksMutex.RUnlock()
+	return fake.streamTasksArgsForCall[i].logger, fake.streamTasksArgsForCall[i].filter, fake.streamTasksArgsForCall[i].yield
+}
+
+func (fake *FakeTaskDB) StreamTasksReturns(result1 []*models.RecordError, result2 error) {
+	fake.StreamTasksStub = nil
+	fake.streamTasksReturns = struct {
+		result1 []*models.RecordError
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
@@ -195,7 +309,7 @@ func (fake *FakeTaskDB) TaskByGuidReturns(result1 *models.Task, result2 error) {
 	}{result1, result2}
 }
 
-func (fake *FakeTaskDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid string, domain string) error {
+func (fake *FakeTaskDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid string, domain string) (*models.Task, error) {
 	fake.desireTaskMutex.Lock()
 	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
 		logger         lager.Logger
@@ -208,7 +322,7 @@ func (fake *FakeTaskDB) DesireTask(logger lager.Logger, taskDefinition *models.T
 	if fake.DesireTaskStub != nil {
 		return fake.DesireTaskStub(logger, taskDefinition, taskGuid, domain)
 	} else {
-		return fake.desireTaskReturns.result1
+		return fake.desireTaskReturns.result1, fake.desireTaskReturns.result2
 	}
 }
 
@@ -224,14 +338,89 @@ func (fake *FakeTaskDB) DesireTaskArgsForCall(i int) (lager.Logger, *models.Task
 	return fake.desireTaskArgsForCall[i].logger, fake.desireTaskArgsForCall[i].taskDefinition, fake.desireTaskArgsForCall[i].taskGuid, fake.desireTaskArgsForCall[i].domain
 }
 
-func (fake *FakeTaskDB) DesireTaskReturns(result1 error) {
+func (fake *FakeTaskDB) DesireTaskReturns(result1 *models.Task, result2 error) {
 	fake.DesireTaskStub = nil
 	fake.desireTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeTaskDB) StartTask(logger lager.Logger, taskGuid string, cellId string) (bool, error) {
+func (fake *FakeTaskDB) DesireTaskWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid string, domain string) (task *models.Task, replayed bool, err error) {
+	fake.desireTaskWithIdempotencyKeyMutex.Lock()
+	fake.desireTaskWithIdempotencyKeyArgsForCall = append(fake.desireTaskWithIdempotencyKeyArgsForCall, struct {
+		logger         lager.Logger
+		key            models.IdempotencyKey
+		taskDefinition *models.TaskDefinition
+		taskGuid       string
+		domain         string
+	}{logger, key, taskDefinition, taskGuid, domain})
+	fake.recordInvocation("DesireTaskWithIdempotencyKey", []interface{}{logger, key, taskDefinition, taskGuid, domain})
+	fake.desireTaskWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireTaskWithIdempotencyKeyStub != nil {
+		return fake.DesireTaskWithIdempotencyKeyStub(logger, key, taskDefinition, taskGuid, domain)
+	} else {
+		return fake.desireTaskWithIdempotencyKeyReturns.result1, fake.desireTaskWithIdempotencyKeyReturns.result2, fake.desireTaskWithIdempotencyKeyReturns.result3
+	}
+}
+
+func (fake *FakeTaskDB) DesireTaskWithIdempotencyKeyCallCount() int {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireTaskWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeTaskDB) DesireTaskWithIdempotencyKeyArgsForCall(i int) (lager.Logger, models.IdempotencyKey, *models.TaskDefinition, string, string) {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireTaskWithIdempotencyKeyArgsForCall[i].logger, fake.desireTaskWithIdempotencyKeyArgsForCall[i].key, fake.desireTaskWithIdempotencyKeyArgsForCall[i].taskDefinition, fake.desireTaskWithIdempotencyKeyArgsForCall[i].taskGuid, fake.desireTaskWithIdempotencyKeyArgsForCall[i].domain
+}
+
+func (fake *FakeTaskDB) DesireTaskWithIdempotencyKeyReturns(result1 *models.Task, result2 bool, result3 error) {
+	fake.DesireTaskWithIdempotencyKeyStub = nil
+	fake.desireTaskWithIdempotencyKeyReturns = struct {
+		result1 *models.Task
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskDB) DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error) {
+	fake.duplicateTasksMutex.Lock()
+	fake.duplicateTasksArgsForCall = append(fake.duplicateTasksArgsForCall, struct {
+		logger lager.Logger
+		domain string
+	}{logger, domain})
+	fake.recordInvocation("DuplicateTasks", []interface{}{logger, domain})
+	fake.duplicateTasksMutex.Unlock()
+	if fake.DuplicateTasksStub != nil {
+		return fake.DuplicateTasksStub(logger, domain)
+	} else {
+		return fake.duplicateTasksReturns.result1, fake.duplicateTasksReturns.result2
+	}
+}
+
+func (fake *FakeTaskDB) DuplicateTasksCallCount() int {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return len(fake.duplicateTasksArgsForCall)
+}
+
+func (fake *FakeTaskDB) DuplicateTasksArgsForCall(i int) (lager.Logger, string) {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return fake.duplicateTasksArgsForCall[i].logger, fake.duplicateTasksArgsForCall[i].domain
+}
+
+func (fake *FakeTaskDB) DuplicateTasksReturns(result1 []*models.DuplicateTasks, result2 error) {
+	fake.DuplicateTasksStub = nil
+	fake.duplicateTasksReturns = struct {
+		result1 []*models.DuplicateTasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDB) StartTask(logger lager.Logger, taskGuid string, cellId string) (before *models.Task, after *models.Task, started bool, err error) {
 	fake.startTaskMutex.Lock()
 	fake.startTaskArgsForCall = append(fake.startTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -243,7 +432,7 @@ func (fake *FakeTaskDB) StartTask(logger lager.Logger, taskGuid string, cellId s
 	if fake.StartTaskStub != nil {
 		return fake.StartTaskStub(logger, taskGuid, cellId)
 	} else {
-		return fake.startTaskReturns.result1, fake.startTaskReturns.result2
+		return fake.startTaskReturns.result1, fake.startTaskReturns.result2, fake.startTaskReturns.result3, fake.startTaskReturns.result4
 	}
 }
 
@@ -259,15 +448,17 @@ func (fake *FakeTaskDB) StartTaskArgsForCall(i int) (lager.Logger, string, strin
 	return fake.startTaskArgsForCall[i].logger, fake.startTaskArgsForCall[i].taskGuid, fake.startTaskArgsForCall[i].cellId
 }
 
-func (fake *FakeTaskDB) StartTaskReturns(result1 bool, result2 error) {
+func (fake *FakeTaskDB) StartTaskReturns(result1 *models.Task, result2 *models.Task, result3 bool, result4 error) {
 	fake.StartTaskStub = nil
 	fake.startTaskReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 *models.Task
+		result2 *models.Task
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTaskDB) CancelTask(logger lager.Logger, taskGuid string) (task *models.Task, cellID string, err error) {
+func (fake *FakeTaskDB) CancelTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error) {
 	fake.cancelTaskMutex.Lock()
 	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -278,7 +469,7 @@ func (fake *FakeTaskDB) CancelTask(logger lager.Logger, taskGuid string) (task *
 	if fake.CancelTaskStub != nil {
 		return fake.CancelTaskStub(logger, taskGuid)
 	} else {
-		return fake.cancelTaskReturns.result1, fake.cancelTaskReturns.result2, fake.cancelTaskReturns.result3
+		return fake.cancelTaskReturns.result1, fake.cancelTaskReturns.result2, fake.cancelTaskReturns.result3, fake.cancelTaskReturns.result4
 	}
 }
 
@@ -294,16 +485,53 @@ func (fake *FakeTaskDB) CancelTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.cancelTaskArgsForCall[i].logger, fake.cancelTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeTaskDB) CancelTaskReturns(result1 *models.Task, result2 string, result3 error) {
+func (fake *FakeTaskDB) CancelTaskReturns(result1 *models.Task, result2 *models.Task, result3 string, result4 error) {
 	fake.CancelTaskStub = nil
 	fake.cancelTaskReturns = struct {
 		result1 *models.Task
-		result2 string
-		result3 error
-	}{result1, result2, result3}
+		result2 *models.Task
+		result3 string
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeTaskDB) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error) {
+	fake.cancelTasksMutex.Lock()
+	fake.cancelTasksArgsForCall = append(fake.cancelTasksArgsForCall, struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}{logger, domain, taskGuids})
+	fake.recordInvocation("CancelTasks", []interface{}{logger, domain, taskGuids})
+	fake.cancelTasksMutex.Unlock()
+	if fake.CancelTasksStub != nil {
+		return fake.CancelTasksStub(logger, domain, taskGuids)
+	} else {
+		return fake.cancelTasksReturns.result1, fake.cancelTasksReturns.result2
+	}
 }
 
-func (fake *FakeTaskDB) FailTask(logger lager.Logger, taskGuid string, failureReason string) (task *models.Task, err error) {
+func (fake *FakeTaskDB) CancelTasksCallCount() int {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return len(fake.cancelTasksArgsForCall)
+}
+
+func (fake *FakeTaskDB) CancelTasksArgsForCall(i int) (lager.Logger, string, []string) {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return fake.cancelTasksArgsForCall[i].logger, fake.cancelTasksArgsForCall[i].domain, fake.cancelTasksArgsForCall[i].taskGuids
+}
+
+func (fake *FakeTaskDB) CancelTasksReturns(result1 *models.CancelTasksResult, result2 error) {
+	fake.CancelTasksStub = nil
+	fake.cancelTasksReturns = struct {
+		result1 *models.CancelTasksResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDB) FailTask(logger lager.Logger, taskGuid string, failureReason string) (before *models.Task, after *models.Task, err error) {
 	fake.failTaskMutex.Lock()
 	fake.failTaskArgsForCall = append(fake.failTaskArgsForCall, struct {
 		logger        lager.Logger
@@ -315,7 +543,7 @@ func (fake *FakeTaskDB) FailTask(logger lager.Logger, taskGuid string, failureRe
 	if fake.FailTaskStub != nil {
 		return fake.FailTaskStub(logger, taskGuid, failureReason)
 	} else {
-		return fake.failTaskReturns.result1, fake.failTaskReturns.result2
+		return fake.failTaskReturns.result1, fake.failTaskReturns.result2, fake.failTaskReturns.result3
 	}
 }
 
@@ -331,15 +559,53 @@ func (fake *FakeTaskDB) FailTaskArgsForCall(i int) (lager.Logger, string, string
 	return fake.failTaskArgsForCall[i].logger, fake.failTaskArgsForCall[i].taskGuid, fake.failTaskArgsForCall[i].failureReason
 }
 
-func (fake *FakeTaskDB) FailTaskReturns(result1 *models.Task, result2 error) {
+func (fake *FakeTaskDB) FailTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.FailTaskStub = nil
 	fake.failTaskReturns = struct {
 		result1 *models.Task
-		result2 error
-	}{result1, result2}
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskDB) RejectTask(logger lager.Logger, taskGuid string, rejectionReason string) (before *models.Task, after *models.Task, err error) {
+	fake.rejectTaskMutex.Lock()
+	fake.rejectTaskArgsForCall = append(fake.rejectTaskArgsForCall, struct {
+		logger          lager.Logger
+		taskGuid        string
+		rejectionReason string
+	}{logger, taskGuid, rejectionReason})
+	fake.recordInvocation("RejectTask", []interface{}{logger, taskGuid, rejectionReason})
+	fake.rejectTaskMutex.Unlock()
+	if fake.RejectTaskStub != nil {
+		return fake.RejectTaskStub(logger, taskGuid, rejectionReason)
+	} else {
+		return fake.rejectTaskReturns.result1, fake.rejectTaskReturns.result2, fake.rejectTaskReturns.result3
+	}
+}
+
+func (fake *FakeTaskDB) RejectTaskCallCount() int {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return len(fake.rejectTaskArgsForCall)
+}
+
+func (fake *FakeTaskDB) RejectTaskArgsForCall(i int) (lager.Logger, string, string) {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return fake.rejectTaskArgsForCall[i].logger, fake.rejectTaskArgsForCall[i].taskGuid, fake.rejectTaskArgsForCall[i].rejectionReason
+}
+
+func (fake *FakeTaskDB) RejectTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.RejectTaskStub = nil
+	fake.rejectTaskReturns = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTaskDB) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) (task *models.Task, err error) {
+func (fake *FakeTaskDB) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) (before *models.Task, after *models.Task, err error) {
 	fake.completeTaskMutex.Lock()
 	fake.completeTaskArgsForCall = append(fake.completeTaskArgsForCall, struct {
 		logger        lager.Logger
@@ -354,7 +620,7 @@ func (fake *FakeTaskDB) CompleteTask(logger lager.Logger, taskGuid string, cellI
 	if fake.CompleteTaskStub != nil {
 		return fake.CompleteTaskStub(logger, taskGuid, cellId, failed, failureReason, result)
 	} else {
-		return fake.completeTaskReturns.result1, fake.completeTaskReturns.result2
+		return fake.completeTaskReturns.result1, fake.completeTaskReturns.result2, fake.completeTaskReturns.result3
 	}
 }
 
@@ -370,15 +636,16 @@ func (fake *FakeTaskDB) CompleteTaskArgsForCall(i int) (lager.Logger, string, st
 	return fake.completeTaskArgsForCall[i].logger, fake.completeTaskArgsForCall[i].taskGuid, fake.completeTaskArgsForCall[i].cellId, fake.completeTaskArgsForCall[i].failed, fake.completeTaskArgsForCall[i].failureReason, fake.completeTaskArgsForCall[i].result
 }
 
-func (fake *FakeTaskDB) CompleteTaskReturns(result1 *models.Task, result2 error) {
+func (fake *FakeTaskDB) CompleteTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.CompleteTaskStub = nil
 	fake.completeTaskReturns = struct {
 		result1 *models.Task
-		result2 error
-	}{result1, result2}
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTaskDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
+func (fake *FakeTaskDB) ResolvingTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error) {
 	fake.resolvingTaskMutex.Lock()
 	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -389,7 +656,7 @@ func (fake *FakeTaskDB) ResolvingTask(logger lager.Logger, taskGuid string) erro
 	if fake.ResolvingTaskStub != nil {
 		return fake.ResolvingTaskStub(logger, taskGuid)
 	} else {
-		return fake.resolvingTaskReturns.result1
+		return fake.resolvingTaskReturns.result1, fake.resolvingTaskReturns.result2, fake.resolvingTaskReturns.result3
 	}
 }
 
@@ -405,14 +672,16 @@ func (fake *FakeTaskDB) ResolvingTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.resolvingTaskArgsForCall[i].logger, fake.resolvingTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeTaskDB) ResolvingTaskReturns(result1 error) {
+func (fake *FakeTaskDB) ResolvingTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.ResolvingTaskStub = nil
 	fake.resolvingTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTaskDB) DeleteTask(logger lager.Logger, taskGuid string) error {
+func (fake *FakeTaskDB) DeleteTask(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	fake.deleteTaskMutex.Lock()
 	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -423,7 +692,7 @@ func (fake *FakeTaskDB) DeleteTask(logger lager.Logger, taskGuid string) error {
 	if fake.DeleteTaskStub != nil {
 		return fake.DeleteTaskStub(logger, taskGuid)
 	} else {
-		return fake.deleteTaskReturns.result1
+		return fake.deleteTaskReturns.result1, fake.deleteTaskReturns.result2
 	}
 }
 
@@ -439,14 +708,50 @@ func (fake *FakeTaskDB) DeleteTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.deleteTaskArgsForCall[i].logger, fake.deleteTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeTaskDB) DeleteTaskReturns(result1 error) {
+func (fake *FakeTaskDB) DeleteTaskReturns(result1 *models.Task, result2 error) {
 	fake.DeleteTaskStub = nil
 	fake.deleteTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDB) PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error) {
+	fake.purgeCompletedTasksMutex.Lock()
+	fake.purgeCompletedTasksArgsForCall = append(fake.purgeCompletedTasksArgsForCall, struct {
+		logger lager.Logger
+		minAge time.Duration
+	}{logger, minAge})
+	fake.recordInvocation("PurgeCompletedTasks", []interface{}{logger, minAge})
+	fake.purgeCompletedTasksMutex.Unlock()
+	if fake.PurgeCompletedTasksStub != nil {
+		return fake.PurgeCompletedTasksStub(logger, minAge)
+	} else {
+		return fake.purgeCompletedTasksReturns.result1, fake.purgeCompletedTasksReturns.result2
+	}
+}
+
+func (fake *FakeTaskDB) PurgeCompletedTasksCallCount() int {
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
+	return len(fake.purgeCompletedTasksArgsForCall)
+}
+
+func (fake *FakeTaskDB) PurgeCompletedTasksArgsForCall(i int) (lager.Logger, time.Duration) {
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
+	return fake.purgeCompletedTasksArgsForCall[i].logger, fake.purgeCompletedTasksArgsForCall[i].minAge
+}
+
+func (fake *FakeTaskDB) PurgeCompletedTasksReturns(result1 int, result2 error) {
+	fake.PurgeCompletedTasksStub = nil
+	fake.purgeCompletedTasksReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeTaskDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task) {
+func (fake *FakeTaskDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration, maxTaskRejections int) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task) {
 	fake.convergeTasksMutex.Lock()
 	fake.convergeTasksArgsForCall = append(fake.convergeTasksArgsForCall, struct {
 		logger                      lager.Logger
@@ -454,11 +759,12 @@ func (fake *FakeTaskDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSe
 		kickTaskDuration            time.Duration
 		expirePendingTaskDuration   time.Duration
 		expireCompletedTaskDuration time.Duration
-	}{logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration})
-	fake.recordInvocation("ConvergeTasks", []interface{}{logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration})
+		maxTaskRejections           int
+	}{logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections})
+	fake.recordInvocation("ConvergeTasks", []interface{}{logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections})
 	fake.convergeTasksMutex.Unlock()
 	if fake.ConvergeTasksStub != nil {
-		return fake.ConvergeTasksStub(logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+		return fake.ConvergeTasksStub(logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections)
 	} else {
 		return fake.convergeTasksReturns.result1, fake.convergeTasksReturns.result2
 	}
@@ -470,10 +776,10 @@ func (fake *FakeTaskDB) ConvergeTasksCallCount() int {
 	return len(fake.convergeTasksArgsForCall)
 }
 
-func (fake *FakeTaskDB) ConvergeTasksArgsForCall(i int) (lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) {
+func (fake *FakeTaskDB) ConvergeTasksArgsForCall(i int) (lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration, int) {
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
-	return fake.convergeTasksArgsForCall[i].logger, fake.convergeTasksArgsForCall[i].cellSet, fake.convergeTasksArgsForCall[i].kickTaskDuration, fake.convergeTasksArgsForCall[i].expirePendingTaskDuration, fake.convergeTasksArgsForCall[i].expireCompletedTaskDuration
+	return fake.convergeTasksArgsForCall[i].logger, fake.convergeTasksArgsForCall[i].cellSet, fake.convergeTasksArgsForCall[i].kickTaskDuration, fake.convergeTasksArgsForCall[i].expirePendingTaskDuration, fake.convergeTasksArgsForCall[i].expireCompletedTaskDuration, fake.convergeTasksArgsForCall[i].maxTaskRejections
 }
 
 func (fake *FakeTaskDB) ConvergeTasksReturns(result1 []*auctioneer.TaskStartRequest, result2 []*models.Task) {
@@ -489,22 +795,34 @@ func (fake *FakeTaskDB) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
+	fake.streamTasksMutex.RLock()
+	defer fake.streamTasksMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
 	fake.startTaskMutex.RLock()
 	defer fake.startTaskMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
 	fake.completeTaskMutex.RLock()
 	defer fake.completeTaskMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
 	return fake.invocations
@@ -9,11 +9,28 @@ import (
 
 type DesiredLRPDB interface {
 	DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+	// DesiredLRPsWithRecordErrors is DesiredLRPs that, with
+	// filter.PartialResults, leaves out the DesiredLRPs that cannot be read
+	// and returns them as record errors instead of failing the whole read.
+	DesiredLRPsWithRecordErrors(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error)
 	DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 
 	DesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)
+	// StreamDesiredLRPSchedulingInfos calls yield with each scheduling info
+	// matching the filter as it is read, without collecting them. It stops at
+	// the first error yield returns. With filter.PartialResults, the
+	// scheduling infos that cannot be read are left out and returned as
+	// record errors instead of failing the whole read.
+	StreamDesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error)
+	// DesiredLRPsRevision returns a number that increases whenever a desired
+	// LRP is desired, updated, or removed. It may also increase when nothing
+	// changed, but equal revisions always mean equal desired LRPs.
+	DesiredLRPsRevision(logger lager.Logger) (uint64, error)
 
 	DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) error
+	// DesireLRPWithIdempotencyKey is DesireLRP guarded by an idempotency key,
+	// with the same semantics as TaskDB.DesireTaskWithIdempotencyKey.
+	DesireLRPWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, desiredLRP *models.DesiredLRP) (replayed bool, err error)
 	UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error)
 	RemoveDesiredLRP(logger lager.Logger, processGuid string) error
 }
@@ -1,9 +1,18 @@
 package db
 
-import "code.cloudfoundry.org/lager"
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
 
 //go:generate counterfeiter . DomainDB
 type DomainDB interface {
 	Domains(logger lager.Logger) ([]string, error)
+	// DomainFreshness lists the fresh domains with when their freshness
+	// expires, sorted by domain.
+	DomainFreshness(logger lager.Logger) ([]*models.DomainFreshness, error)
 	UpsertDomain(lgger lager.Logger, domain string, ttl uint32) error
+	// UpsertDomains upserts every domain of the batch and returns the error
+	// of each, in order, nil for those that were upserted.
+	UpsertDomains(logger lager.Logger, domains []*models.UpsertDomainRequest) []error
 }
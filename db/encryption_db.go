@@ -7,5 +7,12 @@ import "code.cloudfoundry.org/lager"
 type EncryptionDB interface {
 	EncryptionKeyLabel(logger lager.Logger) (string, error)
 	SetEncryptionKeyLabel(logger lager.Logger, encryptionKeyLabel string) error
+
+	// EncryptionAlgorithm returns the name of the algorithm the records were
+	// last encrypted with. Records encrypted before it was first set use
+	// encryption.AES256GCM.
+	EncryptionAlgorithm(logger lager.Logger) (string, error)
+	SetEncryptionAlgorithm(logger lager.Logger, algorithm string) error
+
 	PerformEncryption(logger lager.Logger) error
 }
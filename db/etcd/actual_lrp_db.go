@@ -1,9 +1,9 @@
 package etcd
 
 import (
+	"container/heap"
+	"fmt"
 	"path"
-	"sync"
-	"sync/atomic"
 	"time"
 
 	"code.cloudfoundry.org/bbs/models"
@@ -20,56 +20,53 @@ const (
 )
 
 func (db *ETCDDB) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
-	node, err := db.fetchRecursiveRaw(logger, ActualLRPSchemaRoot)
+	filter.PartialResults = false
+	groups, _, err := db.ActualLRPGroupsWithRecordErrors(logger, filter)
+	return groups, err
+}
+
+func (db *ETCDDB) ActualLRPGroupsWithRecordErrors(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
+	node, err := db.fetchBulkRecursiveRaw(logger, ActualLRPSchemaRoot)
 	bbsErr := models.ConvertError(err)
 	if bbsErr != nil {
 		if bbsErr.Type == models.Error_ResourceNotFound {
-			return []*models.ActualLRPGroup{}, nil
+			return []*models.ActualLRPGroup{}, nil, nil
 		}
-		return nil, err
+		return nil, nil, err
 	}
 	if len(node.Nodes) == 0 {
-		return []*models.ActualLRPGroup{}, nil
+		return []*models.ActualLRPGroup{}, nil, nil
 	}
 
-	groups := []*models.ActualLRPGroup{}
-
-	var workErr atomic.Value
-	groupChan := make(chan []*models.ActualLRPGroup, len(node.Nodes))
-	wg := sync.WaitGroup{}
+	groupsByNode := make([][]*models.ActualLRPGroup, len(node.Nodes))
+	unreadableByNode := make([][]*models.RecordError, len(node.Nodes))
 
 	logger.Debug("performing-deserialization-work")
-	for _, node := range node.Nodes {
-		node := node
-
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			g, err := db.parseActualLRPGroups(logger, node, filter)
-			if err != nil {
-				workErr.Store(err)
-				return
-			}
-			groupChan <- g
-		}()
+	err = db.inParallel(len(node.Nodes), func(i int) error {
+		g, unreadable, err := db.parseActualLRPGroupsWithRecordErrors(logger, node.Nodes[i], filter)
+		if err != nil {
+			return err
+		}
+		groupsByNode[i] = g
+		unreadableByNode[i] = unreadable
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed-performing-deserialization-work", err)
+		return []*models.ActualLRPGroup{}, nil, models.ErrUnknownError
 	}
 
-	go func() {
-		wg.Wait()
-		close(groupChan)
-	}()
-
-	for g := range groupChan {
+	groups := []*models.ActualLRPGroup{}
+	for _, g := range groupsByNode {
 		groups = append(groups, g...)
 	}
-
-	if err, ok := workErr.Load().(error); ok {
-		logger.Error("failed-performing-deserialization-work", err)
-		return []*models.ActualLRPGroup{}, models.ErrUnknownError
+	var recordErrors []*models.RecordError
+	for _, unreadable := range unreadableByNode {
+		recordErrors = append(recordErrors, unreadable...)
 	}
 	logger.Debug("succeeded-performing-deserialization-work", lager.Data{"num_actual_lrp_groups": len(groups)})
 
-	return groups, nil
+	return groups, recordErrors, nil
 }
 
 func (db *ETCDDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
@@ -93,6 +90,76 @@ func (db *ETCDDB) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, proce
 	return group, err
 }
 
+// CrashingActualLRPs scans every actual LRP and keeps the worst crashers in a
+// heap of at most limit entries.
+func (db *ETCDDB) CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error) {
+	logger = logger.Session("crashing-actual-lrps", lager.Data{"limit": limit})
+
+	groups, err := db.quorumDB().ActualLRPGroups(logger, models.ActualLRPFilter{})
+	if err != nil {
+		logger.Error("failed-to-fetch-actual-lrps", err)
+		return nil, err
+	}
+
+	if limit <= 0 {
+		return []*models.ActualLRP{}, nil
+	}
+
+	worst := &crashingActualLRPHeap{}
+	for _, group := range groups {
+		actualLRP := group.Instance
+		if actualLRP == nil || actualLRP.CrashCount == 0 {
+			continue
+		}
+
+		if worst.Len() < limit {
+			heap.Push(worst, actualLRP)
+		} else if crashesBefore(actualLRP, (*worst)[0]) {
+			(*worst)[0] = actualLRP
+			heap.Fix(worst, 0)
+		}
+	}
+
+	actualLRPs := make([]*models.ActualLRP, worst.Len())
+	for i := len(actualLRPs) - 1; i >= 0; i-- {
+		actualLRPs[i] = heap.Pop(worst).(*models.ActualLRP)
+	}
+
+	return actualLRPs, nil
+}
+
+// crashesBefore orders actual LRPs by crash count, highest first, then by
+// process guid and index.
+func crashesBefore(a, b *models.ActualLRP) bool {
+	if a.CrashCount != b.CrashCount {
+		return a.CrashCount > b.CrashCount
+	}
+	if a.ProcessGuid != b.ProcessGuid {
+		return a.ProcessGuid < b.ProcessGuid
+	}
+	return a.Index < b.Index
+}
+
+// crashingActualLRPHeap keeps the actual LRP that sorts last at its root, so
+// it is the one replaced when a worse crasher is found.
+type crashingActualLRPHeap []*models.ActualLRP
+
+func (h crashingActualLRPHeap) Len() int           { return len(h) }
+func (h crashingActualLRPHeap) Less(i, j int) bool { return crashesBefore(h[j], h[i]) }
+func (h crashingActualLRPHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *crashingActualLRPHeap) Push(x interface{}) {
+	*h = append(*h, x.(*models.ActualLRP))
+}
+
+func (h *crashingActualLRPHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
 func (db *ETCDDB) CreateUnclaimedActualLRP(logger lager.Logger, key *models.ActualLRPKey) (*models.ActualLRPGroup, error) {
 	lrp, err := db.newUnclaimedActualLRP(key)
 	if err != nil {
@@ -136,6 +203,53 @@ func (db *ETCDDB) UnclaimActualLRP(logger lager.Logger, key *models.ActualLRPKey
 	return &models.ActualLRPGroup{Instance: &beforeActualLRP}, &models.ActualLRPGroup{Instance: actualLRP}, nil
 }
 
+// RetireActualLRPsOnCell scans for the actual LRPs on the cell and unclaims
+// them one at a time. An LRP that changes between the scan and its unclaim is
+// left alone.
+func (db *ETCDDB) RetireActualLRPsOnCell(logger lager.Logger, cellID string) ([]*models.ActualLRPGroup, []*models.ActualLRPGroup, error) {
+	logger = logger.Session("retire-actual-lrps-on-cell", lager.Data{"cell_id": cellID})
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	groups, err := db.quorumDB().ActualLRPGroups(logger, models.ActualLRPFilter{CellID: cellID})
+	if err != nil {
+		logger.Error("failed-to-fetch-actual-lrps", err)
+		return nil, nil, err
+	}
+
+	befores := []*models.ActualLRPGroup{}
+	afters := []*models.ActualLRPGroup{}
+	for _, group := range groups {
+		if group.Instance == nil {
+			continue
+		}
+		key := group.Instance.ActualLRPKey
+
+		actualLRP, modifiedIndex, err := db.rawActualLRPByProcessGuidAndIndex(logger, key.ProcessGuid, key.Index)
+		if err != nil {
+			logger.Error("failed-to-fetch-actual-lrp", err, lager.Data{"key": key})
+			continue
+		}
+
+		if actualLRP.CellId != cellID ||
+			(actualLRP.State != models.ActualLRPStateClaimed && actualLRP.State != models.ActualLRPStateRunning) {
+			continue
+		}
+		beforeActualLRP := *actualLRP
+
+		_, err = db.unclaimActualLRPWithIndex(logger, actualLRP, modifiedIndex, &beforeActualLRP.ActualLRPKey, &beforeActualLRP.ActualLRPInstanceKey)
+		if err != nil {
+			logger.Error("failed-to-unclaim-actual-lrp", err, lager.Data{"key": key})
+			continue
+		}
+
+		befores = append(befores, &models.ActualLRPGroup{Instance: &beforeActualLRP})
+		afters = append(afters, &models.ActualLRPGroup{Instance: actualLRP})
+	}
+
+	return befores, afters, nil
+}
+
 func (db *ETCDDB) ClaimActualLRP(logger lager.Logger, processGuid string, index int32, instanceKey *models.ActualLRPInstanceKey) (*models.ActualLRPGroup, *models.ActualLRPGroup, error) {
 	logger = logger.WithData(lager.Data{"process_guid": processGuid, "index": index, "actual_lrp_instance_key": instanceKey})
 	logger.Info("starting")
@@ -161,6 +275,7 @@ func (db *ETCDDB) ClaimActualLRP(logger lager.Logger, processGuid string, index
 	lrp.ActualLRPNetInfo = models.ActualLRPNetInfo{}
 	lrp.ModificationTag.Increment()
 	lrp.Since = db.clock.Now().UnixNano()
+	lrp.RecordPlacement(lrp.CellId, lrp.Since, models.PlacementReasonClaimed, db.placementHistoryLength)
 
 	err = lrp.Validate()
 	if err != nil {
@@ -224,6 +339,7 @@ func (db *ETCDDB) StartActualLRP(logger lager.Logger, key *models.ActualLRPKey,
 	lrp.ActualLRPInstanceKey = *instanceKey
 	lrp.ActualLRPNetInfo = *netInfo
 	lrp.PlacementError = ""
+	lrp.RecordPlacement(lrp.CellId, lrp.Since, models.PlacementReasonStarted, db.placementHistoryLength)
 
 	lrpData, serializeErr := db.serializeModel(logger, lrp)
 	if serializeErr != nil {
@@ -239,6 +355,78 @@ func (db *ETCDDB) StartActualLRP(logger lager.Logger, key *models.ActualLRPKey,
 	return &models.ActualLRPGroup{Instance: &beforeActualLRP}, &models.ActualLRPGroup{Instance: lrp}, nil
 }
 
+// StartActualLRPs starts the reported actual LRPs one at a time and, when
+// authoritative, then unclaims the running LRPs on the cell that the report
+// leaves out. The LRPs are not changed atomically: an error stops the batch,
+// leaving the LRPs before it started.
+func (db *ETCDDB) StartActualLRPs(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) (*models.StartActualLRPsResult, error) {
+	logger = logger.Session("start-actual-lrps", lager.Data{"cell_id": cellID, "count": len(starts), "authoritative": authoritative})
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	result := &models.StartActualLRPsResult{}
+	reported := make(map[models.ActualLRPKey]bool, len(starts))
+	for _, start := range starts {
+		key := start.ActualLrpKey
+		reported[models.ActualLRPKey{ProcessGuid: key.ProcessGuid, Index: key.Index}] = true
+
+		before, after, err := db.StartActualLRP(logger, key, start.ActualLrpInstanceKey, start.ActualLrpNetInfo)
+		if err == models.ErrActualLRPCannotBeStarted {
+			result.Rejected = append(result.Rejected, key)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.Started = append(result.Started, models.ActualLRPChange{Before: before, After: after})
+	}
+
+	if !authoritative {
+		return result, nil
+	}
+
+	groups, err := db.quorumDB().ActualLRPGroups(logger, models.ActualLRPFilter{CellID: cellID})
+	if err != nil {
+		logger.Error("failed-to-fetch-actual-lrps", err)
+		return nil, err
+	}
+
+	for _, group := range groups {
+		if group.Instance == nil {
+			continue
+		}
+		key := group.Instance.ActualLRPKey
+		if reported[models.ActualLRPKey{ProcessGuid: key.ProcessGuid, Index: key.Index}] {
+			continue
+		}
+
+		actualLRP, modifiedIndex, err := db.rawActualLRPByProcessGuidAndIndex(logger, key.ProcessGuid, key.Index)
+		if err != nil {
+			logger.Error("failed-to-fetch-actual-lrp", err, lager.Data{"key": key})
+			continue
+		}
+
+		if actualLRP.CellId != cellID || actualLRP.State != models.ActualLRPStateRunning {
+			continue
+		}
+		beforeActualLRP := *actualLRP
+
+		_, err = db.unclaimActualLRPWithIndex(logger, actualLRP, modifiedIndex, &beforeActualLRP.ActualLRPKey, &beforeActualLRP.ActualLRPInstanceKey)
+		if err != nil {
+			logger.Error("failed-to-unclaim-actual-lrp", err, lager.Data{"key": key})
+			continue
+		}
+
+		result.Unclaimed = append(result.Unclaimed, models.ActualLRPChange{
+			Before: &models.ActualLRPGroup{Instance: &beforeActualLRP},
+			After:  &models.ActualLRPGroup{Instance: actualLRP},
+		})
+	}
+
+	return result, nil
+}
+
 func (db *ETCDDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) (*models.ActualLRPGroup, *models.ActualLRPGroup, bool, error) {
 	logger = logger.WithData(lager.Data{"actual_lrp_key": key, "actual_lrp_instance_key": instanceKey})
 	logger.Info("starting")
@@ -265,8 +453,10 @@ func (db *ETCDDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey,
 		return nil, nil, false, models.ErrActualLRPCannotBeCrashed
 	}
 
+	now := db.clock.Now().UnixNano()
+	lrp.RecordPlacement(lrp.CellId, now, models.PlacementReasonCrashed, db.placementHistoryLength)
 	lrp.State = models.ActualLRPStateCrashed
-	lrp.Since = db.clock.Now().UnixNano()
+	lrp.Since = now
 	lrp.CrashCount = newCrashCount
 	lrp.ActualLRPInstanceKey = models.ActualLRPInstanceKey{}
 	lrp.ActualLRPNetInfo = models.EmptyActualLRPNetInfo()
@@ -355,7 +545,17 @@ func (db *ETCDDB) removeActualLRP(logger lager.Logger, lrp *models.ActualLRP, pr
 }
 
 func (db *ETCDDB) parseActualLRPGroups(logger lager.Logger, node *etcd.Node, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
+	filter.PartialResults = false
+	groups, _, err := db.parseActualLRPGroupsWithRecordErrors(logger, node, filter)
+	return groups, err
+}
+
+// parseActualLRPGroupsWithRecordErrors parses the groups of the process node.
+// With filter.PartialResults, an actual LRP that cannot be read is left out
+// of its group and returned as a record error under its process guid.
+func (db *ETCDDB) parseActualLRPGroupsWithRecordErrors(logger lager.Logger, node *etcd.Node, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
 	var groups = []*models.ActualLRPGroup{}
+	var recordErrors []*models.RecordError
 
 	logger.Debug("performing-parsing-actual-lrp-groups")
 	for _, indexNode := range node.Nodes {
@@ -363,9 +563,21 @@ func (db *ETCDDB) parseActualLRPGroups(logger lager.Logger, node *etcd.Node, fil
 		for _, instanceNode := range indexNode.Nodes {
 			var lrp models.ActualLRP
 			deserializeErr := db.deserializeModel(logger, instanceNode, &lrp)
+			if deserializeErr == errRecordSkipped {
+				continue
+			}
+			if deserializeErr != nil && filter.PartialResults && isUnreadableRecordError(deserializeErr) {
+				guid := path.Base(node.Key)
+				logger.Info("leaving-out-unreadable-actual-lrp", lager.Data{"key": instanceNode.Key, "reason": deserializeErr.Error()})
+				recordErrors = append(recordErrors, &models.RecordError{
+					Guid:   guid,
+					Reason: fmt.Sprintf("index %s: %s", path.Base(indexNode.Key), deserializeErr.Error()),
+				})
+				continue
+			}
 			if deserializeErr != nil {
 				logger.Error("failed-parsing-actual-lrp-groups", deserializeErr, lager.Data{"key": instanceNode.Key})
-				return []*models.ActualLRPGroup{}, deserializeErr
+				return []*models.ActualLRPGroup{}, nil, deserializeErr
 			}
 			if filter.Domain != "" && lrp.Domain != filter.Domain {
 				continue
@@ -373,6 +585,9 @@ func (db *ETCDDB) parseActualLRPGroups(logger lager.Logger, node *etcd.Node, fil
 			if filter.CellID != "" && lrp.CellId != filter.CellID {
 				continue
 			}
+			if !filter.MatchesState(lrp.State) {
+				continue
+			}
 
 			if isInstanceActualLRPNode(instanceNode) {
 				group.Instance = &lrp
@@ -389,7 +604,7 @@ func (db *ETCDDB) parseActualLRPGroups(logger lager.Logger, node *etcd.Node, fil
 	}
 	logger.Debug("succeeded-performing-parsing-actual-lrp-groups", lager.Data{"num_actual_lrp_groups": len(groups)})
 
-	return groups, nil
+	return groups, recordErrors, nil
 }
 
 func (db *ETCDDB) unclaimActualLRP(
@@ -477,6 +692,9 @@ func (db *ETCDDB) rawActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, pr
 	for _, instanceNode := range node.Nodes {
 		var lrp models.ActualLRP
 		deserializeErr := db.deserializeModel(logger, instanceNode, &lrp)
+		if deserializeErr == errRecordSkipped {
+			continue
+		}
 		if deserializeErr != nil {
 			logger.Error("failed-parsing-actual-lrp", deserializeErr, lager.Data{"key": instanceNode.Key})
 			return nil, deserializeErr
@@ -619,6 +837,7 @@ func (db *ETCDDB) createRunningActualLRP(logger lager.Logger, key *models.Actual
 	if err != nil {
 		return nil, models.ErrActualLRPCannotBeStarted
 	}
+	lrp.RecordPlacement(lrp.CellId, lrp.Since, models.PlacementReasonStarted, db.placementHistoryLength)
 
 	return lrp, db.createRawActualLRP(logger, lrp)
 }
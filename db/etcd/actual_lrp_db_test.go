@@ -3,8 +3,10 @@ package etcd_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	. "code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	etcderrors "github.com/coreos/go-etcd/etcd"
@@ -137,6 +139,32 @@ var _ = Describe("ActualLRPDB", func() {
 					&models.ActualLRPGroup{Instance: otherCellIdLRP, Evacuating: nil},
 				))
 			})
+
+			It("can filter by state", func() {
+				filter.States = []string{models.ActualLRPStateClaimed}
+				actualLRPGroups, err := etcdDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualLRPGroups).To(ConsistOf(
+					&models.ActualLRPGroup{Instance: nil, Evacuating: otherIndexLRP},
+				))
+			})
+
+			It("can filter by several states", func() {
+				filter.States = []string{models.ActualLRPStateClaimed, models.ActualLRPStateCrashed}
+				filter.Domain = baseDomain
+				actualLRPGroups, err := etcdDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualLRPGroups).To(ConsistOf(
+					&models.ActualLRPGroup{Instance: nil, Evacuating: otherIndexLRP},
+				))
+			})
+
+			It("returns no groups when no LRP is in the states", func() {
+				filter.States = []string{models.ActualLRPStateUnclaimed, models.ActualLRPStateCrashed}
+				actualLRPGroups, err := etcdDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualLRPGroups).To(BeEmpty())
+			})
 		})
 
 		Context("when there are no LRPs", func() {
@@ -176,6 +204,18 @@ var _ = Describe("ActualLRPDB", func() {
 				_, err := etcdDB.ActualLRPGroups(logger, filter)
 				Expect(err).To(HaveOccurred())
 			})
+
+			Context("and partial results are requested", func() {
+				It("returns the valid groups and a record error for the invalid one", func() {
+					filter.PartialResults = true
+					groups, recordErrors, err := etcdDB.ActualLRPGroupsWithRecordErrors(logger, filter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(groups).To(HaveLen(2))
+					Expect(recordErrors).To(HaveLen(1))
+					Expect(recordErrors[0].Guid).To(Equal("some-other-guid"))
+					Expect(recordErrors[0].Reason).To(HavePrefix("index 0: "))
+				})
+			})
 		})
 
 		Context("when etcd is not there", func() {
@@ -194,6 +234,42 @@ var _ = Describe("ActualLRPDB", func() {
 		})
 	})
 
+	Describe("CrashingActualLRPs", func() {
+		crashingLRP := func(processGuid string, index int32, crashCount int32) *models.ActualLRP {
+			lrp := model_helpers.NewValidActualLRP(processGuid, index)
+			lrp.CrashCount = crashCount
+			return lrp
+		}
+
+		BeforeEach(func() {
+			etcdHelper.SetRawActualLRP(crashingLRP("guid-a", 0, 3))
+			etcdHelper.SetRawActualLRP(crashingLRP("guid-a", 1, 7))
+			etcdHelper.SetRawActualLRP(crashingLRP("guid-b", 0, 3))
+			etcdHelper.SetRawActualLRP(crashingLRP("guid-d", 0, 0))
+			etcdHelper.SetRawEvacuatingActualLRP(crashingLRP("guid-c", 0, 9), noExpirationTTL)
+		})
+
+		It("returns the crashing instance lrps ordered by crash count, process guid and index", func() {
+			actualLRPs, err := etcdDB.CrashingActualLRPs(logger, 10)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actualLRPs).To(HaveLen(3))
+			Expect(actualLRPs[0]).To(Equal(crashingLRP("guid-a", 1, 7)))
+			Expect(actualLRPs[1]).To(Equal(crashingLRP("guid-a", 0, 3)))
+			Expect(actualLRPs[2]).To(Equal(crashingLRP("guid-b", 0, 3)))
+		})
+
+		It("returns no more than the limit", func() {
+			actualLRPs, err := etcdDB.CrashingActualLRPs(logger, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actualLRPs).To(Equal([]*models.ActualLRP{
+				crashingLRP("guid-a", 1, 7),
+				crashingLRP("guid-a", 0, 3),
+			}))
+		})
+	})
+
 	Describe("ActualLRPGroupsByProcessGuid", func() {
 		Context("when there are both /instance and /evacuating LRPs", func() {
 			BeforeEach(func() {
@@ -512,6 +588,142 @@ var _ = Describe("ActualLRPDB", func() {
 		})
 	})
 
+	Describe("RetireActualLRPsOnCell", func() {
+		var runningLRP, claimedLRP, otherCellLRP, crashedLRP *models.ActualLRP
+
+		BeforeEach(func() {
+			runningLRP = model_helpers.NewValidActualLRP("running-guid", 0)
+
+			claimedLRP = model_helpers.NewValidActualLRP("claimed-guid", 0)
+			claimedLRP.State = models.ActualLRPStateClaimed
+			claimedLRP.ActualLRPNetInfo = models.EmptyActualLRPNetInfo()
+
+			otherCellLRP = model_helpers.NewValidActualLRP("other-cell-guid", 0)
+			otherCellLRP.CellId = "other-cell"
+
+			crashedLRP = model_helpers.NewValidActualLRP("crashed-guid", 0)
+			crashedLRP.State = models.ActualLRPStateCrashed
+			crashedLRP.ActualLRPInstanceKey = models.ActualLRPInstanceKey{}
+			crashedLRP.ActualLRPNetInfo = models.EmptyActualLRPNetInfo()
+
+			for _, lrp := range []*models.ActualLRP{runningLRP, claimedLRP, otherCellLRP, crashedLRP} {
+				etcdHelper.SetRawActualLRP(lrp)
+			}
+		})
+
+		It("unclaims the claimed and running actual LRPs on the cell", func() {
+			befores, afters, err := etcdDB.RetireActualLRPsOnCell(logger, "some-cell")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(befores).To(ConsistOf(
+				&models.ActualLRPGroup{Instance: runningLRP},
+				&models.ActualLRPGroup{Instance: claimedLRP},
+			))
+			Expect(afters).To(HaveLen(2))
+
+			for _, after := range afters {
+				group, err := etcdDB.ActualLRPGroupByProcessGuidAndIndex(logger, after.Instance.ProcessGuid, after.Instance.Index)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(group).To(Equal(after))
+				Expect(group.Instance.State).To(Equal(models.ActualLRPStateUnclaimed))
+				Expect(group.Instance.ActualLRPInstanceKey).To(Equal(models.ActualLRPInstanceKey{}))
+				Expect(group.Instance.Since).To(Equal(clock.Now().UnixNano()))
+				Expect(group.Instance.ModificationTag.Index).To(BeEquivalentTo(1000))
+			}
+		})
+
+		It("leaves other actual LRPs alone", func() {
+			_, _, err := etcdDB.RetireActualLRPsOnCell(logger, "some-cell")
+			Expect(err).NotTo(HaveOccurred())
+
+			group, err := etcdDB.ActualLRPGroupByProcessGuidAndIndex(logger, otherCellLRP.ProcessGuid, otherCellLRP.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance).To(Equal(otherCellLRP))
+
+			group, err = etcdDB.ActualLRPGroupByProcessGuidAndIndex(logger, crashedLRP.ProcessGuid, crashedLRP.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance).To(Equal(crashedLRP))
+		})
+
+		Context("when the cell has no actual LRPs", func() {
+			It("retires nothing", func() {
+				befores, afters, err := etcdDB.RetireActualLRPsOnCell(logger, "empty-cell")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(befores).To(BeEmpty())
+				Expect(afters).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("StartActualLRPs", func() {
+		var reportedLRP, claimedLRP, staleLRP, otherCellLRP *models.ActualLRP
+
+		startFor := func(lrp *models.ActualLRP) *models.StartActualLRPRequest {
+			key := lrp.ActualLRPKey
+			instanceKey := models.NewActualLRPInstanceKey(lrp.InstanceGuid, "some-cell")
+			netInfo := models.NewActualLRPNetInfo("some-address", models.NewPortMapping(2222, 4444))
+			return &models.StartActualLRPRequest{
+				ActualLrpKey:         &key,
+				ActualLrpInstanceKey: &instanceKey,
+				ActualLrpNetInfo:     &netInfo,
+			}
+		}
+
+		BeforeEach(func() {
+			reportedLRP = model_helpers.NewValidActualLRP("reported-guid", 0)
+
+			claimedLRP = model_helpers.NewValidActualLRP("claimed-guid", 0)
+			claimedLRP.State = models.ActualLRPStateClaimed
+			claimedLRP.ActualLRPNetInfo = models.EmptyActualLRPNetInfo()
+
+			staleLRP = model_helpers.NewValidActualLRP("stale-guid", 0)
+
+			otherCellLRP = model_helpers.NewValidActualLRP("other-cell-guid", 0)
+			otherCellLRP.CellId = "other-cell"
+
+			for _, lrp := range []*models.ActualLRP{reportedLRP, claimedLRP, staleLRP, otherCellLRP} {
+				etcdHelper.SetRawActualLRP(lrp)
+			}
+		})
+
+		It("starts the reported LRPs, rejects the ones running elsewhere and unclaims the stale ones", func() {
+			starts := []*models.StartActualLRPRequest{startFor(reportedLRP), startFor(claimedLRP), startFor(otherCellLRP)}
+			result, err := etcdDB.StartActualLRPs(logger, "some-cell", starts, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Started).To(HaveLen(2))
+			Expect(result.Started[0].Before).To(Equal(result.Started[0].After))
+			Expect(result.Started[1].Before.Instance).To(Equal(claimedLRP))
+			Expect(result.Started[1].After.Instance.State).To(Equal(models.ActualLRPStateRunning))
+
+			Expect(result.Rejected).To(Equal([]*models.ActualLRPKey{&otherCellLRP.ActualLRPKey}))
+
+			Expect(result.Unclaimed).To(HaveLen(1))
+			Expect(result.Unclaimed[0].Before.Instance).To(Equal(staleLRP))
+
+			group, err := etcdDB.ActualLRPGroupByProcessGuidAndIndex(logger, staleLRP.ProcessGuid, staleLRP.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group).To(Equal(result.Unclaimed[0].After))
+			Expect(group.Instance.State).To(Equal(models.ActualLRPStateUnclaimed))
+
+			group, err = etcdDB.ActualLRPGroupByProcessGuidAndIndex(logger, otherCellLRP.ProcessGuid, otherCellLRP.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance).To(Equal(otherCellLRP))
+		})
+
+		Context("when the report is not authoritative", func() {
+			It("leaves the LRPs that were not reported alone", func() {
+				result, err := etcdDB.StartActualLRPs(logger, "some-cell", []*models.StartActualLRPRequest{startFor(reportedLRP)}, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Started).To(HaveLen(1))
+				Expect(result.Unclaimed).To(BeEmpty())
+
+				group, err := etcdDB.ActualLRPGroupByProcessGuidAndIndex(logger, staleLRP.ProcessGuid, staleLRP.Index)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(group.Instance).To(Equal(staleLRP))
+			})
+		})
+	})
+
 	Describe("ClaimActualLRP", func() {
 		var (
 			actualLRP                           *models.ActualLRP
@@ -1335,4 +1547,59 @@ var _ = Describe("ActualLRPDB", func() {
 			})
 		})
 	})
+
+	Describe("placement history", func() {
+		var (
+			historyDB   *ETCDDB
+			key         models.ActualLRPKey
+			instanceKey models.ActualLRPInstanceKey
+			netInfo     models.ActualLRPNetInfo
+		)
+
+		BeforeEach(func() {
+			historyDB = NewETCDWithOptions(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, cryptor, storeClient, storeClient, clock, ETCDDBOptions{PlacementHistoryLength: 2})
+
+			key = models.NewActualLRPKey(baseProcessGuid, 0, baseDomain)
+			instanceKey = models.NewActualLRPInstanceKey(baseInstanceGuid, cellID)
+			netInfo = models.NewActualLRPNetInfo("1.2.3.4")
+
+			etcdHelper.SetRawActualLRP(&models.ActualLRP{
+				ActualLRPKey:    key,
+				State:           models.ActualLRPStateUnclaimed,
+				Since:           clock.Now().UnixNano(),
+				ModificationTag: models.ModificationTag{Epoch: "epoch"},
+			})
+		})
+
+		It("records the claims, starts and crashes, keeping the most recent", func() {
+			_, _, err := historyDB.ClaimActualLRP(logger, key.ProcessGuid, key.Index, &instanceKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			clock.Increment(time.Minute)
+			_, _, err = historyDB.StartActualLRP(logger, &key, &instanceKey, &netInfo)
+			Expect(err).NotTo(HaveOccurred())
+			startedAt := clock.Now().UnixNano()
+
+			clock.Increment(time.Minute)
+			_, _, _, err = historyDB.CrashActualLRP(logger, &key, &instanceKey, "boom")
+			Expect(err).NotTo(HaveOccurred())
+			crashedAt := clock.Now().UnixNano()
+
+			group, err := historyDB.ActualLRPGroupByProcessGuidAndIndex(logger, key.ProcessGuid, key.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.PlacementHistory).To(Equal([]*models.ActualLRPPlacement{
+				{CellId: cellID, Timestamp: startedAt, Reason: models.PlacementReasonStarted},
+				{CellId: cellID, Timestamp: crashedAt, Reason: models.PlacementReasonCrashed},
+			}))
+		})
+
+		It("records nothing when the history is disabled", func() {
+			_, _, err := etcdDB.ClaimActualLRP(logger, key.ProcessGuid, key.Index, &instanceKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			group, err := etcdDB.ActualLRPGroupByProcessGuidAndIndex(logger, key.ProcessGuid, key.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.PlacementHistory).To(BeEmpty())
+		})
+	})
 })
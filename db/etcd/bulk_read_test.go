@@ -0,0 +1,111 @@
+package etcd_test
+
+import (
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/etcd/fakes"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	etcdclient "github.com/coreos/go-etcd/etcd"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bulk reads", func() {
+	var (
+		writeStoreClient    *fakes.FakeStoreClient
+		bulkReadStoreClient *fakes.FakeStoreClient
+		etcdDB              *etcd.ETCDDB
+		etcdDBWithBulkStore db.DB
+	)
+
+	BeforeEach(func() {
+		writeStoreClient = &fakes.FakeStoreClient{}
+		bulkReadStoreClient = &fakes.FakeStoreClient{}
+		bulkReadStoreClient.GetReturns(&etcdclient.Response{Node: &etcdclient.Node{}}, nil)
+
+		etcdDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, cryptor, writeStoreClient, bulkReadStoreClient, clock)
+		etcdDBWithBulkStore = etcdDB.BulkReadDB()
+	})
+
+	It("lists through the regular client on the DB itself", func() {
+		writeStoreClient.GetReturns(&etcdclient.Response{Node: &etcdclient.Node{}}, nil)
+		_, err := etcdDB.ActualLRPGroups(logger, models.ActualLRPFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writeStoreClient.GetCallCount()).To(Equal(1))
+		Expect(bulkReadStoreClient.GetCallCount()).To(Equal(0))
+	})
+
+	It("lists through the regular client for the methods that write", func() {
+		writeStoreClient.GetReturns(&etcdclient.Response{Node: &etcdclient.Node{}}, nil)
+		_, err := etcdDBWithBulkStore.CellPlacements(logger, "some-cell")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writeStoreClient.GetCallCount()).To(Equal(2))
+		Expect(bulkReadStoreClient.GetCallCount()).To(Equal(0))
+	})
+
+	It("lists domains using the bulk read client", func() {
+		_, err := etcdDBWithBulkStore.Domains(logger)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bulkReadStoreClient.GetCallCount()).To(Equal(1))
+		Expect(writeStoreClient.GetCallCount()).To(Equal(0))
+	})
+
+	It("lists actual lrp groups using the bulk read client", func() {
+		_, err := etcdDBWithBulkStore.ActualLRPGroups(logger, models.ActualLRPFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bulkReadStoreClient.GetCallCount()).To(Equal(1))
+		Expect(writeStoreClient.GetCallCount()).To(Equal(0))
+	})
+
+	Context("for the desired lrp listings, which are versioned by the revision", func() {
+		BeforeEach(func() {
+			writeStoreClient.GetReturns(&etcdclient.Response{Node: &etcdclient.Node{}, EtcdIndex: 7}, nil)
+		})
+
+		It("reads the revision through the regular client", func() {
+			revision, err := etcdDBWithBulkStore.DesiredLRPsRevision(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision).To(BeEquivalentTo(7))
+			Expect(writeStoreClient.GetCallCount()).To(Equal(1))
+			Expect(bulkReadStoreClient.GetCallCount()).To(Equal(0))
+		})
+
+		It("lists desired lrps through the regular client", func() {
+			_, err := etcdDBWithBulkStore.DesiredLRPs(logger, models.DesiredLRPFilter{ModifiedSinceRevision: 7})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(writeStoreClient.GetCallCount()).To(Equal(1))
+			Expect(bulkReadStoreClient.GetCallCount()).To(Equal(0))
+		})
+
+		It("lists desired lrp scheduling infos through the regular client", func() {
+			_, err := etcdDBWithBulkStore.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(writeStoreClient.GetCallCount()).To(Equal(1))
+			Expect(bulkReadStoreClient.GetCallCount()).To(Equal(0))
+		})
+
+		It("streams desired lrp scheduling infos through the regular client", func() {
+			_, err := etcdDBWithBulkStore.StreamDesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{}, func(*models.DesiredLRPSchedulingInfo) error { return nil })
+			Expect(err).NotTo(HaveOccurred())
+			Expect(writeStoreClient.GetCallCount()).To(Equal(1))
+			Expect(bulkReadStoreClient.GetCallCount()).To(Equal(0))
+		})
+	})
+
+	It("lists tasks using the bulk read client", func() {
+		_, err := etcdDBWithBulkStore.Tasks(logger, models.TaskFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bulkReadStoreClient.GetCallCount()).To(Equal(1))
+		Expect(writeStoreClient.GetCallCount()).To(Equal(0))
+	})
+
+	It("fetches a single task using the regular client", func() {
+		writeStoreClient.GetReturns(nil, etcdclient.EtcdError{ErrorCode: etcd.ETCDErrKeyNotFound})
+		_, err := etcdDBWithBulkStore.TaskByGuid(logger, "some-guid")
+		Expect(err).To(Equal(models.ErrResourceNotFound))
+		Expect(writeStoreClient.GetCallCount()).To(Equal(1))
+		Expect(bulkReadStoreClient.GetCallCount()).To(Equal(0))
+	})
+})
@@ -0,0 +1,80 @@
+package etcd
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// CellPlacements assembles the placements in memory from every actual LRP
+// group and desired LRP scheduling info.
+func (db *ETCDDB) CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error) {
+	logger = logger.Session("cell-placements", lager.Data{"cell_id": cellID})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	groups, err := db.quorumDB().ActualLRPGroups(logger, models.ActualLRPFilter{CellID: cellID})
+	if err != nil {
+		logger.Error("failed-fetching-actual-lrps", err)
+		return nil, err
+	}
+
+	schedulingInfos, err := db.quorumDB().DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{})
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrps", err)
+		return nil, err
+	}
+
+	resources := make(map[string]models.DesiredLRPResource, len(schedulingInfos))
+	for _, schedulingInfo := range schedulingInfos {
+		resources[schedulingInfo.ProcessGuid] = schedulingInfo.DesiredLRPResource
+	}
+
+	placementsByCell := map[string]*models.CellPlacement{}
+	for _, group := range groups {
+		for _, actualLRP := range []*models.ActualLRP{group.Instance, group.Evacuating} {
+			if actualLRP == nil || actualLRP.CellId == "" {
+				continue
+			}
+			if actualLRP.State != models.ActualLRPStateClaimed && actualLRP.State != models.ActualLRPStateRunning {
+				continue
+			}
+
+			placement, ok := placementsByCell[actualLRP.CellId]
+			if !ok {
+				placement = &models.CellPlacement{CellId: actualLRP.CellId}
+				placementsByCell[actualLRP.CellId] = placement
+			}
+			placement.ActualLrps = append(placement.ActualLrps, actualLRP)
+			placement.MemoryMb += resources[actualLRP.ProcessGuid].MemoryMb
+			placement.DiskMb += resources[actualLRP.ProcessGuid].DiskMb
+		}
+	}
+
+	placements := make([]*models.CellPlacement, 0, len(placementsByCell))
+	for _, placement := range placementsByCell {
+		sort.Sort(actualLRPsByProcessGuidAndIndex(placement.ActualLrps))
+		placements = append(placements, placement)
+	}
+	sort.Sort(cellPlacementsByCellID(placements))
+
+	return placements, nil
+}
+
+type actualLRPsByProcessGuidAndIndex []*models.ActualLRP
+
+func (a actualLRPsByProcessGuidAndIndex) Len() int      { return len(a) }
+func (a actualLRPsByProcessGuidAndIndex) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a actualLRPsByProcessGuidAndIndex) Less(i, j int) bool {
+	if a[i].ProcessGuid != a[j].ProcessGuid {
+		return a[i].ProcessGuid < a[j].ProcessGuid
+	}
+	return a[i].Index < a[j].Index
+}
+
+type cellPlacementsByCellID []*models.CellPlacement
+
+func (p cellPlacementsByCellID) Len() int           { return len(p) }
+func (p cellPlacementsByCellID) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p cellPlacementsByCellID) Less(i, j int) bool { return p[i].CellId < p[j].CellId }
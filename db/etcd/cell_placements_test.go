@@ -0,0 +1,90 @@
+package etcd_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CellPlacements", func() {
+	var running, claimed, evacuating, orphaned, otherCell *models.ActualLRP
+
+	BeforeEach(func() {
+		desiredLRP := model_helpers.NewValidDesiredLRP("guid-1")
+		desiredLRP.MemoryMb = 256
+		desiredLRP.DiskMb = 1024
+		etcdHelper.SetRawDesiredLRP(desiredLRP)
+
+		desiredLRP = model_helpers.NewValidDesiredLRP("guid-2")
+		desiredLRP.MemoryMb = 128
+		desiredLRP.DiskMb = 512
+		etcdHelper.SetRawDesiredLRP(desiredLRP)
+
+		claimed = model_helpers.NewValidActualLRP("guid-1", 1)
+		claimed.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-guid-1", "cell-a")
+		claimed.State = models.ActualLRPStateClaimed
+		claimed.ActualLRPNetInfo = models.ActualLRPNetInfo{}
+		etcdHelper.SetRawActualLRP(claimed)
+
+		running = model_helpers.NewValidActualLRP("guid-1", 0)
+		running.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-guid-0", "cell-a")
+		etcdHelper.SetRawActualLRP(running)
+
+		evacuating = model_helpers.NewValidActualLRP("guid-2", 0)
+		evacuating.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-guid-2", "cell-a")
+		etcdHelper.SetRawEvacuatingActualLRP(evacuating, 100)
+
+		orphaned = model_helpers.NewValidActualLRP("guid-3", 0)
+		orphaned.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-guid-3", "cell-a")
+		etcdHelper.SetRawActualLRP(orphaned)
+
+		otherCell = model_helpers.NewValidActualLRP("guid-2", 1)
+		otherCell.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-guid-4", "cell-b")
+		etcdHelper.SetRawActualLRP(otherCell)
+
+		unclaimed := model_helpers.NewValidActualLRP("guid-2", 2)
+		unclaimed.ActualLRPInstanceKey = models.ActualLRPInstanceKey{}
+		unclaimed.ActualLRPNetInfo = models.ActualLRPNetInfo{}
+		unclaimed.State = models.ActualLRPStateUnclaimed
+		etcdHelper.SetRawActualLRP(unclaimed)
+	})
+
+	It("returns the claimed and running actual lrps on each cell with their resources", func() {
+		placements, err := etcdDB.CellPlacements(logger, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(placements).To(Equal([]*models.CellPlacement{
+			{
+				CellId:     "cell-a",
+				ActualLrps: []*models.ActualLRP{running, claimed, evacuating, orphaned},
+				MemoryMb:   256 + 256 + 128,
+				DiskMb:     1024 + 1024 + 512,
+			},
+			{
+				CellId:     "cell-b",
+				ActualLrps: []*models.ActualLRP{otherCell},
+				MemoryMb:   128,
+				DiskMb:     512,
+			},
+		}))
+	})
+
+	Context("when filtering by cell", func() {
+		It("only returns that cell", func() {
+			placements, err := etcdDB.CellPlacements(logger, "cell-b")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(placements).To(HaveLen(1))
+			Expect(placements[0].CellId).To(Equal("cell-b"))
+			Expect(placements[0].ActualLrps).To(ConsistOf(otherCell))
+		})
+	})
+
+	Context("when there are no actual lrps", func() {
+		It("returns no placements", func() {
+			placements, err := etcdDB.CellPlacements(logger, "cell-c")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(placements).To(BeEmpty())
+		})
+	})
+})
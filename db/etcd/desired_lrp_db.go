@@ -1,6 +1,7 @@
 package etcd
 
 import (
+	"path"
 	"sync"
 
 	"code.cloudfoundry.org/bbs/models"
@@ -9,43 +10,84 @@ import (
 	"github.com/nu7hatch/gouuid"
 )
 
-type guidSet struct {
-	set map[string]struct{}
+func (db *ETCDDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	filter.PartialResults = false
+	desireds, _, err := db.DesiredLRPsWithRecordErrors(logger, filter)
+	return desireds, err
 }
 
-func newGuidSet() guidSet {
-	return guidSet{
-		set: map[string]struct{}{},
+func (db *ETCDDB) DesiredLRPsWithRecordErrors(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
+	logger = logger.WithData(lager.Data{"filter": filter})
+	logger.Info("start")
+	defer logger.Info("complete")
+
+	desireds, recordErrors, err := db.desiredLRPs(logger, filter)
+	if err != nil {
+		logger.Error("failed", err)
+		return desireds, nil, err
 	}
-}
 
-func (g guidSet) Add(guid string) {
-	g.set[guid] = struct{}{}
-}
+	if filter.IncludeDeleted {
+		tombstones, tombstoneRecordErrors, err := db.desiredLRPTombstones(logger, filter)
+		if err != nil {
+			logger.Error("failed-fetching-tombstones", err)
+			return nil, nil, err
+		}
+		desireds = append(desireds, tombstones...)
+		recordErrors = append(recordErrors, tombstoneRecordErrors...)
+	}
 
-func (g guidSet) Merge(other guidSet) {
-	for guid := range other.set {
-		g.set[guid] = struct{}{}
+	if !filter.PartialResults {
+		return desireds, nil, nil
 	}
+	return desireds, recordErrors, nil
 }
 
-func (g guidSet) ToMap() map[string]struct{} {
-	return g.set
-}
+func (db *ETCDDB) desiredLRPTombstones(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
+	root, err := db.fetchRecursiveRaw(logger, DesiredLRPTombstoneSchemaRoot)
+	bbsErr := models.ConvertError(err)
+	if bbsErr != nil {
+		if bbsErr.Type == models.Error_ResourceNotFound {
+			return []*models.DesiredLRP{}, nil, nil
+		}
+		return nil, nil, err
+	}
 
-func (db *ETCDDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
-	logger = logger.WithData(lager.Data{"filter": filter})
-	logger.Info("start")
-	defer logger.Info("complete")
+	tombstones := []*models.DesiredLRP{}
+	var recordErrors []*models.RecordError
+	for _, node := range root.Nodes {
+		if !filterIncludesNode(filter, node) {
+			continue
+		}
 
-	desireds, _, err := db.desiredLRPs(logger, filter)
-	if err != nil {
-		logger.Error("failed", err)
+		tombstone := new(models.DesiredLRP)
+		err := db.deserializeModel(logger, node, tombstone)
+		if err == errMissingEncryptionKey && !filter.PartialResults {
+			return nil, nil, err
+		}
+		if err == errRecordSkipped {
+			continue
+		}
+		if err != nil {
+			logger.Error("failed-parsing-tombstone", err, lager.Data{"key": node.Key})
+			recordErrors = append(recordErrors, &models.RecordError{Guid: path.Base(node.Key), Reason: err.Error()})
+			continue
+		}
+
+		if filter.Domain != "" && tombstone.Domain != filter.Domain {
+			continue
+		}
+
+		if filter.LabelSelector.Matches(tombstone.MetadataLabels) {
+			tombstones = append(tombstones, tombstone)
+		}
 	}
-	return desireds, err
+
+	return tombstones, recordErrors, nil
 }
 
 func (db *ETCDDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+	filter.PartialResults = false
 	logger = logger.WithData(lager.Data{"filter": filter})
 	logger.Info("start")
 	defer logger.Info("complete")
@@ -59,7 +101,10 @@ func (db *ETCDDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.D
 		return nil, err
 	}
 
-	schedulingInfoMap, _ := db.deserializeScheduleInfos(logger, root.Nodes, filter)
+	schedulingInfoMap, _, err := db.deserializeScheduleInfos(logger, root.Nodes, filter)
+	if err != nil {
+		return nil, err
+	}
 
 	schedulingInfos := make([]*models.DesiredLRPSchedulingInfo, 0, len(schedulingInfoMap))
 	for _, schedulingInfo := range schedulingInfoMap {
@@ -68,92 +113,250 @@ func (db *ETCDDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.D
 	return schedulingInfos, nil
 }
 
-func (db *ETCDDB) desiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, guidSet, error) {
+// DesiredLRPsRevision returns the current etcd index. It increases with every
+// write to etcd, not only with writes to desired LRPs, so it may report a
+// change when there was none but never misses one.
+//
+// The revision and the desired LRP listings it versions are read through the
+// quorum client, even on the DB returned by BulkReadDB. A weakly consistent
+// listing could be older than the revision, and a client listing the desired
+// LRPs modified since that revision would then never see what it missed.
+func (db *ETCDDB) DesiredLRPsRevision(logger lager.Logger) (uint64, error) {
+	response, err := db.client.Get(DesiredLRPSchedulingInfoSchemaRoot, false, false)
+	if etcdErrCode(err) == ETCDErrKeyNotFound {
+		// no desired LRP has ever existed, so every empty listing is the same
+		return 0, nil
+	} else if err != nil {
+		return 0, ErrorFromEtcdError(logger, err)
+	}
+
+	return response.EtcdIndex, nil
+}
+
+func (db *ETCDDB) StreamDesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error) {
+	logger = logger.Session("stream-desired-lrp-scheduling-infos", lager.Data{"filter": filter})
+	logger.Info("start")
+	defer logger.Info("complete")
+
+	root, err := db.fetchRecursiveRaw(logger, DesiredLRPSchedulingInfoSchemaRoot)
+	bbsErr := models.ConvertError(err)
+	if bbsErr != nil {
+		if bbsErr.Type == models.Error_ResourceNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	schedulingInfos := make([]*models.DesiredLRPSchedulingInfo, len(root.Nodes))
+	unreadable := make([]*models.RecordError, len(root.Nodes))
+	err = db.inParallel(len(root.Nodes), func(i int) error {
+		node := root.Nodes[i]
+		if !filterIncludesProcessGuid(filter, path.Base(node.Key)) {
+			return nil
+		}
+
+		schedulingInfo, err := db.deserializeSchedulingInfo(logger, node)
+		if err == errMissingEncryptionKey && !filter.PartialResults {
+			return err
+		}
+		if err != nil && filter.PartialResults && isUnreadableRecordError(err) {
+			guid := path.Base(node.Key)
+			logger.Info("leaving-out-unreadable-desired-lrp-scheduling-info", lager.Data{"guid": guid, "reason": err.Error()})
+			unreadable[i] = &models.RecordError{Guid: guid, Reason: err.Error()}
+			return nil
+		}
+		if err != nil {
+			logger.Error("failed-parsing-desired-lrp-scheduling-info", err)
+			return nil
+		}
+		schedulingInfos[i] = schedulingInfo
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, schedulingInfo := range schedulingInfos {
+		if schedulingInfo == nil {
+			continue
+		}
+
+		if filter.Domain != "" && schedulingInfo.Domain != filter.Domain {
+			continue
+		}
+
+		if !filter.LabelSelector.Matches(schedulingInfo.MetadataLabels) {
+			continue
+		}
+
+		err = yield(schedulingInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return compactRecordErrors(unreadable), nil
+}
+
+// desiredLRPs returns the DesiredLRPs whose scheduling and run infos could
+// both be read, along with record errors for those that could not.
+func (db *ETCDDB) desiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
 	root, err := db.fetchRecursiveRaw(logger, DesiredLRPComponentsSchemaRoot)
 	bbsErr := models.ConvertError(err)
 	if bbsErr != nil {
 		if bbsErr.Type == models.Error_ResourceNotFound {
-			return []*models.DesiredLRP{}, newGuidSet(), nil
+			return []*models.DesiredLRP{}, nil, nil
 		}
-		return nil, newGuidSet(), err
+		return nil, nil, err
 	}
 	if root.Nodes.Len() == 0 {
-		return []*models.DesiredLRP{}, newGuidSet(), nil
+		return []*models.DesiredLRP{}, nil, nil
 	}
 
-	var schedules map[string]*models.DesiredLRPSchedulingInfo
-	var runs map[string]*models.DesiredLRPRunInfo
-	var malformedInfos guidSet
-	var malformedRunInfos guidSet
-	var wg sync.WaitGroup
+	// each component is deserialized across the whole worker pool, so they
+	// are handled one after the other to keep within the worker count
+	schedules := map[string]*models.DesiredLRPSchedulingInfo{}
+	runs := map[string]*models.DesiredLRPRunInfo{}
+	var unreadableInfos, unreadableRunInfos []*models.RecordError
 	for i := range root.Nodes {
 		node := root.Nodes[i]
 		switch node.Key {
 		case DesiredLRPSchedulingInfoSchemaRoot:
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				schedules, malformedInfos = db.deserializeScheduleInfos(logger, node.Nodes, filter)
-			}()
+			schedules, unreadableInfos, err = db.deserializeScheduleInfos(logger, node.Nodes, filter)
 		case DesiredLRPRunInfoSchemaRoot:
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				runs, malformedRunInfos = db.deserializeRunInfos(logger, node.Nodes, filter)
-			}()
+			runs, unreadableRunInfos, err = db.deserializeRunInfos(logger, node.Nodes, filter)
 		default:
 			logger.Error("unexpected-etcd-key", nil, lager.Data{"key": node.Key})
 		}
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	wg.Wait()
-
 	desiredLRPs := []*models.DesiredLRP{}
 	for processGuid, schedule := range schedules {
-		desired := models.NewDesiredLRP(*schedule, *runs[processGuid])
+		run, ok := runs[processGuid]
+		if !ok {
+			continue
+		}
+		desired := models.NewDesiredLRP(*schedule, *run)
 		desiredLRPs = append(desiredLRPs, &desired)
 	}
 
-	malformedInfos.Merge(malformedRunInfos)
-	return desiredLRPs, malformedInfos, nil
+	// a DesiredLRP is reported once even when neither of its infos could
+	// be read
+	recordErrors := unreadableInfos
+	reported := make(map[string]bool, len(unreadableInfos))
+	for _, recordError := range unreadableInfos {
+		reported[recordError.Guid] = true
+	}
+	for _, recordError := range unreadableRunInfos {
+		if !reported[recordError.Guid] {
+			recordErrors = append(recordErrors, recordError)
+		}
+	}
+
+	return desiredLRPs, recordErrors, nil
 }
 
-func (db *ETCDDB) deserializeScheduleInfos(logger lager.Logger, nodes etcd.Nodes, filter models.DesiredLRPFilter) (map[string]*models.DesiredLRPSchedulingInfo, guidSet) {
+// deserializeScheduleInfos fails only on a node whose encryption key is missing
+// under the fail policy, unless the filter asks for partial results; the other
+// nodes that cannot be deserialized are returned as record errors.
+func (db *ETCDDB) deserializeScheduleInfos(logger lager.Logger, nodes etcd.Nodes, filter models.DesiredLRPFilter) (map[string]*models.DesiredLRPSchedulingInfo, []*models.RecordError, error) {
 	logger.Debug("deserializing-scheduling-infos", lager.Data{"count": len(nodes)})
 
 	components := make(map[string]*models.DesiredLRPSchedulingInfo)
-	malformedModels := newGuidSet()
 
-	for i := range nodes {
+	decoded := make([]*models.DesiredLRPSchedulingInfo, len(nodes))
+	unreadable := make([]*models.RecordError, len(nodes))
+	err := db.inParallel(len(nodes), func(i int) error {
 		node := nodes[i]
-		model := new(models.DesiredLRPSchedulingInfo)
-		err := db.deserializeModel(logger, node, model)
+		if !filterIncludesNode(filter, node) {
+			return nil
+		}
+
+		model, err := db.deserializeSchedulingInfo(logger, node)
+		if err == errRecordSkipped {
+			return nil
+		}
+		if err == errMissingEncryptionKey && !filter.PartialResults {
+			return err
+		}
 		if err != nil {
 			logger.Error("failed-parsing-desired-lrp-scheduling-info", err)
-			malformedModels.Add(model.ProcessGuid)
+			unreadable[i] = &models.RecordError{Guid: path.Base(node.Key), Reason: err.Error()}
+			return nil
+		}
+		decoded[i] = model
+		return nil
+	})
+
+	for _, model := range decoded {
+		if model == nil {
 			continue
 		}
-		if filter.Domain == "" || model.Domain == filter.Domain {
+		if filter.Domain != "" && model.Domain != filter.Domain {
+			continue
+		}
+		if filter.LabelSelector.Matches(model.MetadataLabels) {
 			components[model.ProcessGuid] = model
 		}
 	}
 
-	return components, malformedModels
+	return components, compactRecordErrors(unreadable), err
+}
+
+// deserializeSchedulingInfo decrypts the scheduling info in the node, unless
+// the cache holds the one decrypted from the node at its current modified
+// index.
+func (db *ETCDDB) deserializeSchedulingInfo(logger lager.Logger, node *etcd.Node) (*models.DesiredLRPSchedulingInfo, error) {
+	processGuid := path.Base(node.Key)
+	if schedulingInfo, ok := db.schedulingInfoCache.get(processGuid, node.ModifiedIndex); ok {
+		return schedulingInfo, nil
+	}
+
+	schedulingInfo := new(models.DesiredLRPSchedulingInfo)
+	err := db.deserializeModel(logger, node, schedulingInfo)
+	if err != nil {
+		return schedulingInfo, err
+	}
+
+	db.schedulingInfoCache.put(processGuid, node.ModifiedIndex, schedulingInfo)
+	return schedulingInfo, nil
 }
 
-func (db *ETCDDB) deserializeRunInfos(logger lager.Logger, nodes etcd.Nodes, filter models.DesiredLRPFilter) (map[string]*models.DesiredLRPRunInfo, guidSet) {
+func (db *ETCDDB) deserializeRunInfos(logger lager.Logger, nodes etcd.Nodes, filter models.DesiredLRPFilter) (map[string]*models.DesiredLRPRunInfo, []*models.RecordError, error) {
 	logger.Info("deserializing-run-infos", lager.Data{"count": len(nodes)})
 
 	components := make(map[string]*models.DesiredLRPRunInfo, len(nodes))
-	malformedModels := newGuidSet()
 
-	for i := range nodes {
+	decoded := make([]*models.DesiredLRPRunInfo, len(nodes))
+	unreadable := make([]*models.RecordError, len(nodes))
+	err := db.inParallel(len(nodes), func(i int) error {
 		node := nodes[i]
+		if !filterIncludesProcessGuid(filter, path.Base(node.Key)) {
+			return nil
+		}
+
 		model := new(models.DesiredLRPRunInfo)
 		err := db.deserializeModel(logger, node, model)
+		if err == errRecordSkipped {
+			return nil
+		}
+		if err == errMissingEncryptionKey && !filter.PartialResults {
+			return err
+		}
 		if err != nil {
 			logger.Error("failed-parsing-desired-lrp-run-info", err)
-			malformedModels.Add(model.ProcessGuid)
+			unreadable[i] = &models.RecordError{Guid: path.Base(node.Key), Reason: err.Error()}
+			return nil
+		}
+		decoded[i] = model
+		return nil
+	})
+
+	for _, model := range decoded {
+		if model == nil {
 			continue
 		}
 		if filter.Domain == "" || model.Domain == filter.Domain {
@@ -161,7 +364,34 @@ func (db *ETCDDB) deserializeRunInfos(logger lager.Logger, nodes etcd.Nodes, fil
 		}
 	}
 
-	return components, malformedModels
+	return components, compactRecordErrors(unreadable), err
+}
+
+// filterIncludesNode checks the process guid and the modified index of the
+// node against the filter. The scheduling info node is written whenever a
+// desired LRP is desired or updated, and its run info only when it is
+// desired, so the scheduling info's modified index is that of the desired
+// LRP. Modified indexes and DesiredLRPsRevision are both etcd indexes.
+func filterIncludesNode(filter models.DesiredLRPFilter, node *etcd.Node) bool {
+	if node.ModifiedIndex <= filter.ModifiedSinceRevision {
+		return false
+	}
+	return filterIncludesProcessGuid(filter, path.Base(node.Key))
+}
+
+// filterIncludesProcessGuid checks the process guid against the filter before
+// the node is deserialized, so that unwanted models are never decrypted.
+func filterIncludesProcessGuid(filter models.DesiredLRPFilter, processGuid string) bool {
+	if len(filter.ProcessGuids) == 0 {
+		return true
+	}
+
+	for _, guid := range filter.ProcessGuids {
+		if guid == processGuid {
+			return true
+		}
+	}
+	return false
 }
 
 func (db *ETCDDB) rawDesiredLRPSchedulingInfo(logger lager.Logger, processGuid string) (*models.DesiredLRPSchedulingInfo, uint64, error) {
@@ -296,6 +526,7 @@ func (db *ETCDDB) updateDesiredLRPSchedulingInfo(logger lager.Logger, scheduling
 		return err
 	}
 
+	db.schedulingInfoCache.invalidate(schedulingInfo.ProcessGuid)
 	_, err = db.client.CompareAndSwap(DesiredLRPSchedulingInfoSchemaPath(schedulingInfo.ProcessGuid), value, NO_TTL, index)
 	if err != nil {
 		logger.Error("failed-to-CAS-scheduling-info", err)
@@ -373,6 +604,14 @@ func (db *ETCDDB) RemoveDesiredLRP(logger lager.Logger, processGuid string) erro
 	logger.Info("starting")
 	defer logger.Info("complete")
 
+	if db.tombstoneRetention > 0 {
+		err := db.tombstoneDesiredLRP(logger, processGuid)
+		if err != nil && err != models.ErrResourceNotFound {
+			return err
+		}
+	}
+
+	db.schedulingInfoCache.invalidate(processGuid)
 	_, schedulingInfoErr := db.client.Delete(DesiredLRPSchedulingInfoSchemaPath(processGuid), true)
 	schedulingInfoErr = ErrorFromEtcdError(logger, schedulingInfoErr)
 	if schedulingInfoErr != nil && schedulingInfoErr != models.ErrResourceNotFound {
@@ -394,3 +633,60 @@ func (db *ETCDDB) RemoveDesiredLRP(logger lager.Logger, processGuid string) erro
 
 	return nil
 }
+
+// tombstoneDesiredLRP stores a copy of the desired LRP, stamped with the time
+// it was removed, replacing an earlier tombstone of the same process guid.
+// Desired LRPs that can no longer be read are removed without one.
+func (db *ETCDDB) tombstoneDesiredLRP(logger lager.Logger, processGuid string) error {
+	desiredLRP, _, err := db.rawDesiredLRPByProcessGuid(logger, processGuid)
+	if bbsErr := models.ConvertError(err); bbsErr != nil && bbsErr.Type == models.Error_InvalidRecord {
+		logger.Error("failed-reading-desired-lrp-for-tombstone", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	desiredLRP.DeletedAt = db.clock.Now().UnixNano()
+	value, err := db.serializeModel(logger, desiredLRP)
+	if err != nil {
+		logger.Error("failed-to-serialize-tombstone", err)
+		return err
+	}
+
+	_, err = db.client.Set(DesiredLRPTombstoneSchemaPath(processGuid), value, NO_TTL)
+	if err != nil {
+		logger.Error("failed-persisting-tombstone", err)
+		return ErrorFromEtcdError(logger, err)
+	}
+
+	return nil
+}
+
+// purgeDesiredLRPTombstones deletes the tombstones of desired LRPs that were
+// removed longer than the retention window ago.
+func (db *ETCDDB) purgeDesiredLRPTombstones(logger lager.Logger) {
+	logger = logger.Session("purge-desired-lrp-tombstones")
+
+	root, err := db.fetchRecursiveRaw(logger, DesiredLRPTombstoneSchemaRoot)
+	if err != nil {
+		if err != models.ErrResourceNotFound {
+			logger.Error("failed-fetching-tombstones", err)
+		}
+		return
+	}
+
+	cutoff := db.clock.Now().Add(-db.tombstoneRetention).UnixNano()
+	for _, node := range root.Nodes {
+		tombstone := new(models.DesiredLRP)
+		err := db.deserializeModel(logger, node, tombstone)
+		if isMissingKeyError(err) || (err == nil && tombstone.DeletedAt > cutoff) {
+			continue
+		}
+
+		_, err = db.client.CompareAndDelete(node.Key, node.ModifiedIndex)
+		if err != nil {
+			logger.Error("failed-deleting-tombstone", err, lager.Data{"key": node.Key})
+		}
+	}
+}
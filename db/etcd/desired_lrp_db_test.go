@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	etcdclient "github.com/coreos/go-etcd/etcd"
@@ -55,6 +58,28 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(desiredLRPs).To(ConsistOf(expectedDesiredLRPs))
 			})
+
+			It("can filter by process guids", func() {
+				lrp := desiredLRPsInDomains["domain-1"][0]
+				expectedDesiredLRPs = append(expectedDesiredLRPs, lrp)
+				filter.ProcessGuids = []string{lrp.ProcessGuid}
+				desiredLRPs, err := etcdDB.DesiredLRPs(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(ConsistOf(expectedDesiredLRPs))
+			})
+
+			It("can filter by label selector", func() {
+				lrp := model_helpers.NewValidDesiredLRP("labelled-guid")
+				lrp.MetadataLabels = map[string]string{"team": "routing", "cost-center": "1234"}
+				Expect(etcdDB.DesireLRP(logger, lrp)).To(Succeed())
+
+				filter.LabelSelector = models.LabelSelector{{Key: "team", Operator: models.LabelOperatorIn, Values: []string{"routing", "payments"}}}
+				desiredLRPs, err := etcdDB.DesiredLRPs(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(HaveLen(1))
+				Expect(desiredLRPs[0].ProcessGuid).To(Equal("labelled-guid"))
+				Expect(desiredLRPs[0].MetadataLabels).To(Equal(lrp.MetadataLabels))
+			})
 		})
 
 		Context("when there are no LRPs", func() {
@@ -79,6 +104,18 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(desireds).To(HaveLen(2))
 				Expect([]string{desireds[0].ProcessGuid, desireds[1].ProcessGuid}).To(ConsistOf("guid-1", "guid-2"))
 			})
+
+			Context("and partial results are requested", func() {
+				It("returns the valid records and a single record error for the invalid one", func() {
+					filter.PartialResults = true
+					desireds, recordErrors, err := etcdDB.DesiredLRPsWithRecordErrors(logger, filter)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(desireds).To(HaveLen(2))
+					Expect(recordErrors).To(HaveLen(1))
+					Expect(recordErrors[0].Guid).To(Equal("bad-guid"))
+					Expect(recordErrors[0].Reason).NotTo(BeEmpty())
+				})
+			})
 		})
 
 		Context("when etcd is not there", func() {
@@ -191,6 +228,16 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(schedulingInfos).To(ConsistOf(expectedSchedulingInfos))
 			})
+
+			It("can filter by process guids", func() {
+				lrp := desiredLRPsInDomains["domain-1"][0]
+				schedulingInfo := lrp.DesiredLRPSchedulingInfo()
+				expectedSchedulingInfos = append(expectedSchedulingInfos, &schedulingInfo)
+				filter.ProcessGuids = []string{lrp.ProcessGuid}
+				schedulingInfos, err := etcdDB.DesiredLRPSchedulingInfos(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(schedulingInfos).To(ConsistOf(expectedSchedulingInfos))
+			})
 		})
 
 		Context("when there are no LRPs", func() {
@@ -215,6 +262,21 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(schedulingInfo).To(HaveLen(2))
 				Expect([]string{schedulingInfo[0].ProcessGuid, schedulingInfo[1].ProcessGuid}).To(ConsistOf("guid-1", "guid-2"))
 			})
+
+			Context("and partial results are requested from the stream", func() {
+				It("yields the valid records and returns a record error for the invalid one", func() {
+					filter.PartialResults = true
+					schedulingInfos := []*models.DesiredLRPSchedulingInfo{}
+					recordErrors, err := etcdDB.StreamDesiredLRPSchedulingInfos(logger, filter, func(schedulingInfo *models.DesiredLRPSchedulingInfo) error {
+						schedulingInfos = append(schedulingInfos, schedulingInfo)
+						return nil
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(schedulingInfos).To(HaveLen(2))
+					Expect(recordErrors).To(HaveLen(1))
+					Expect(recordErrors[0].Guid).To(Equal("bad-guid"))
+				})
+			})
 		})
 
 		Context("when etcd is not there", func() {
@@ -233,6 +295,177 @@ var _ = Describe("DesiredLRPDB", func() {
 		})
 	})
 
+	Describe("the scheduling info cache", func() {
+		var (
+			countingCryptor *encryptionfakes.FakeCryptor
+			cacheTTL        time.Duration
+			cacheSize       int
+			cachingDB       *etcd.ETCDDB
+			lrp1, lrp2      *models.DesiredLRP
+		)
+
+		schedulingInfos := func() []*models.DesiredLRPSchedulingInfo {
+			schedulingInfos, err := cachingDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			return schedulingInfos
+		}
+
+		BeforeEach(func() {
+			countingCryptor = new(encryptionfakes.FakeCryptor)
+			countingCryptor.EncryptStub = cryptor.Encrypt
+			countingCryptor.DecryptStub = cryptor.Decrypt
+			cacheTTL = time.Minute
+			cacheSize = 10
+
+			lrp1 = model_helpers.NewValidDesiredLRP("guid-1")
+			lrp2 = model_helpers.NewValidDesiredLRP("guid-2")
+			Expect(etcdDB.DesireLRP(logger, lrp1)).To(Succeed())
+			Expect(etcdDB.DesireLRP(logger, lrp2)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			cachingDB = etcd.NewETCDWithOptions(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, countingCryptor, storeClient, storeClient, clock, etcd.ETCDDBOptions{SchedulingInfoCacheTTL: cacheTTL, SchedulingInfoCacheSize: cacheSize})
+			Expect(schedulingInfos()).To(HaveLen(2))
+			Expect(countingCryptor.DecryptCallCount()).To(Equal(2))
+		})
+
+		It("does not decrypt unchanged scheduling infos again", func() {
+			Expect(schedulingInfos()).To(HaveLen(2))
+			streamed := 0
+			_, err := cachingDB.StreamDesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{}, func(*models.DesiredLRPSchedulingInfo) error {
+				streamed++
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(streamed).To(Equal(2))
+			Expect(countingCryptor.DecryptCallCount()).To(Equal(2))
+		})
+
+		It("returns copies that do not change the cache", func() {
+			schedulingInfos()[0].Instances = 1000
+			for _, schedulingInfo := range schedulingInfos() {
+				Expect(schedulingInfo.Instances).NotTo(Equal(int32(1000)))
+			}
+		})
+
+		It("decrypts a scheduling info again once it is updated", func() {
+			instances := int32(42)
+			_, err := cachingDB.UpdateDesiredLRP(logger, lrp1.ProcessGuid, &models.DesiredLRPUpdate{Instances: &instances})
+			Expect(err).NotTo(HaveOccurred())
+			decrypted := countingCryptor.DecryptCallCount()
+
+			schedulingInfos, err := cachingDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: []string{lrp1.ProcessGuid}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedulingInfos).To(HaveLen(1))
+			Expect(schedulingInfos[0].Instances).To(Equal(instances))
+			Expect(countingCryptor.DecryptCallCount()).To(Equal(decrypted + 1))
+		})
+
+		It("decrypts a scheduling info again once another BBS writes it", func() {
+			instances := int32(42)
+			_, err := etcdDB.UpdateDesiredLRP(logger, lrp1.ProcessGuid, &models.DesiredLRPUpdate{Instances: &instances})
+			Expect(err).NotTo(HaveOccurred())
+
+			schedulingInfos, err := cachingDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: []string{lrp1.ProcessGuid}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedulingInfos).To(HaveLen(1))
+			Expect(schedulingInfos[0].Instances).To(Equal(instances))
+			Expect(countingCryptor.DecryptCallCount()).To(Equal(3))
+		})
+
+		It("does not return a removed scheduling info", func() {
+			Expect(cachingDB.RemoveDesiredLRP(logger, lrp1.ProcessGuid)).To(Succeed())
+
+			schedulingInfos := schedulingInfos()
+			Expect(schedulingInfos).To(HaveLen(1))
+			Expect(schedulingInfos[0].ProcessGuid).To(Equal(lrp2.ProcessGuid))
+		})
+
+		It("decrypts the scheduling infos again once they expire", func() {
+			clock.Increment(cacheTTL)
+			Expect(schedulingInfos()).To(HaveLen(2))
+			Expect(countingCryptor.DecryptCallCount()).To(Equal(4))
+		})
+
+		Context("when the cache is smaller than the number of scheduling infos", func() {
+			BeforeEach(func() {
+				cacheSize = 1
+			})
+
+			It("decrypts the scheduling infos that do not fit", func() {
+				Expect(schedulingInfos()).To(HaveLen(2))
+				Expect(countingCryptor.DecryptCallCount()).To(BeNumerically(">", 2))
+			})
+		})
+
+		Context("when the cache is disabled", func() {
+			BeforeEach(func() {
+				cacheTTL = 0
+			})
+
+			It("decrypts the scheduling infos on every read", func() {
+				Expect(schedulingInfos()).To(HaveLen(2))
+				Expect(countingCryptor.DecryptCallCount()).To(Equal(4))
+			})
+		})
+	})
+
+	Describe("DesiredLRPsRevision", func() {
+		revision := func() uint64 {
+			revision, err := etcdDB.DesiredLRPsRevision(logger)
+			Expect(err).NotTo(HaveOccurred())
+			return revision
+		}
+
+		It("increases when a desired LRP is desired or removed", func() {
+			initial := revision()
+
+			desiredLRP := model_helpers.NewValidDesiredLRP("the-guid")
+			Expect(etcdDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+			afterDesire := revision()
+			Expect(afterDesire).To(BeNumerically(">", initial))
+
+			Expect(etcdDB.RemoveDesiredLRP(logger, desiredLRP.ProcessGuid)).To(Succeed())
+			Expect(revision()).To(BeNumerically(">", afterDesire))
+		})
+
+		Describe("listing the desired LRPs modified since a revision", func() {
+			processGuids := func(filter models.DesiredLRPFilter) []string {
+				desiredLRPs, err := etcdDB.DesiredLRPs(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				guids := []string{}
+				for _, desiredLRP := range desiredLRPs {
+					guids = append(guids, desiredLRP.ProcessGuid)
+				}
+				return guids
+			}
+
+			BeforeEach(func() {
+				Expect(etcdDB.DesireLRP(logger, model_helpers.NewValidDesiredLRP("the-guid"))).To(Succeed())
+				Expect(etcdDB.DesireLRP(logger, model_helpers.NewValidDesiredLRP("other-guid"))).To(Succeed())
+			})
+
+			It("lists every desired LRP when the revision is 0", func() {
+				Expect(processGuids(models.DesiredLRPFilter{})).To(ConsistOf("the-guid", "other-guid"))
+			})
+
+			It("lists no desired LRP at the current revision", func() {
+				Expect(processGuids(models.DesiredLRPFilter{ModifiedSinceRevision: revision()})).To(BeEmpty())
+			})
+
+			It("lists the desired LRPs desired or updated after the revision", func() {
+				since := revision()
+				Expect(etcdDB.DesireLRP(logger, model_helpers.NewValidDesiredLRP("new-guid"))).To(Succeed())
+				instances := int32(3)
+				_, err := etcdDB.UpdateDesiredLRP(logger, "other-guid", &models.DesiredLRPUpdate{Instances: &instances})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(processGuids(models.DesiredLRPFilter{ModifiedSinceRevision: since})).To(ConsistOf("new-guid", "other-guid"))
+			})
+		})
+	})
+
 	Describe("DesireLRP", func() {
 		var lrp *models.DesiredLRP
 
@@ -256,6 +489,18 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(persisted.DesiredLRPRunInfo(clock.Now())).To(Equal(lrp.DesiredLRPRunInfo(clock.Now())))
 			})
 
+			It("stores the extension fields unchanged", func() {
+				lrp.ExtensionFields = map[string][]byte{
+					"example.com/json":   []byte(`{"replicas":3}`),
+					"example.com/binary": {0x00, 0xff, 0x80},
+				}
+				Expect(etcdDB.DesireLRP(logger, lrp)).To(Succeed())
+
+				persisted, err := etcdDB.DesiredLRPByProcessGuid(logger, "some-process-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(persisted.ExtensionFields).To(Equal(lrp.ExtensionFields))
+			})
+
 			It("sets the ModificationTag on the DesiredLRP", func() {
 				err := etcdDB.DesireLRP(logger, lrp)
 				Expect(err).NotTo(HaveOccurred())
@@ -375,6 +620,67 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
+
+		Context("when soft delete is enabled", func() {
+			const retention = time.Hour
+
+			var tombstoningDB *etcd.ETCDDB
+
+			BeforeEach(func() {
+				tombstoningDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, retention, cryptor, storeClient, storeClient, clock)
+
+				Expect(tombstoningDB.DesireLRP(logger, lrp)).To(Succeed())
+				Expect(tombstoningDB.RemoveDesiredLRP(logger, lrp.ProcessGuid)).To(Succeed())
+			})
+
+			It("removes the lrp", func() {
+				_, err := tombstoningDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+
+				desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(BeEmpty())
+			})
+
+			It("keeps a tombstone stamped with the time it was removed", func() {
+				desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{IncludeDeleted: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(HaveLen(1))
+				Expect(desiredLRPs[0].ProcessGuid).To(Equal(lrp.ProcessGuid))
+				Expect(desiredLRPs[0].Instances).To(BeEquivalentTo(5))
+				Expect(desiredLRPs[0].DeletedAt).To(Equal(clock.Now().UnixNano()))
+			})
+
+			It("filters the tombstones like the desired lrps", func() {
+				desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{Domain: "other-domain", IncludeDeleted: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(BeEmpty())
+
+				desiredLRPs, err = tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{ProcessGuids: []string{"other-guid"}, IncludeDeleted: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(BeEmpty())
+			})
+
+			Context("when convergence runs", func() {
+				It("keeps the tombstone within the retention window", func() {
+					clock.Increment(retention - time.Second)
+					tombstoningDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
+
+					desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{IncludeDeleted: true})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(desiredLRPs).To(HaveLen(1))
+				})
+
+				It("purges the tombstone after the retention window", func() {
+					clock.Increment(retention)
+					tombstoningDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
+
+					desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{IncludeDeleted: true})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(desiredLRPs).To(BeEmpty())
+				})
+			})
+		})
 	})
 
 	Describe("UpdateDesiredLRP", func() {
@@ -396,6 +702,47 @@ var _ = Describe("DesiredLRPDB", func() {
 			update = &models.DesiredLRPUpdate{}
 		})
 
+		Context("when only the routes are present", func() {
+			BeforeEach(func() {
+				rawMessage := json.RawMessage([]byte(`{"port":8080,"hosts":["new-route"]}`))
+				update.Routes = &models.Routes{
+					"router": &rawMessage,
+				}
+			})
+
+			It("updates the routes and leaves the other fields alone", func() {
+				_, err := etcdDB.UpdateDesiredLRP(logger, lrp.ProcessGuid, update)
+				Expect(err).NotTo(HaveOccurred())
+
+				updated, err := etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(*updated.Routes).To(HaveKey("router"))
+				Expect(updated.Instances).To(Equal(desiredLRP.Instances))
+				Expect(updated.Annotation).To(Equal(desiredLRP.Annotation))
+				Expect(updated.ModificationTag.Index).To(Equal(desiredLRP.ModificationTag.Index + 1))
+			})
+		})
+
+		Context("when only the annotation is present", func() {
+			BeforeEach(func() {
+				annotation := "new-annotation"
+				update.Annotation = &annotation
+			})
+
+			It("updates the annotation and leaves the other fields alone", func() {
+				_, err := etcdDB.UpdateDesiredLRP(logger, lrp.ProcessGuid, update)
+				Expect(err).NotTo(HaveOccurred())
+
+				updated, err := etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(updated.Annotation).To(Equal("new-annotation"))
+				Expect(updated.Instances).To(Equal(desiredLRP.Instances))
+				Expect(updated.Routes).To(Equal(desiredLRP.Routes))
+			})
+		})
+
 		Context("When the updates are valid", func() {
 			BeforeEach(func() {
 				annotation := "new-annotation"
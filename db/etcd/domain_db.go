@@ -8,7 +8,7 @@ import (
 )
 
 func (db *ETCDDB) Domains(logger lager.Logger) ([]string, error) {
-	response, err := db.client.Get(DomainSchemaRoot, false, true)
+	response, err := db.listingClient.Get(DomainSchemaRoot, false, true)
 	if err != nil {
 		if etcdErrCode(err) == ETCDErrKeyNotFound {
 			return []string{}, nil
@@ -25,6 +25,30 @@ func (db *ETCDDB) Domains(logger lager.Logger) ([]string, error) {
 	return domains, nil
 }
 
+func (db *ETCDDB) DomainFreshness(logger lager.Logger) ([]*models.DomainFreshness, error) {
+	response, err := db.listingClient.Get(DomainSchemaRoot, false, true)
+	if err != nil {
+		if etcdErrCode(err) == ETCDErrKeyNotFound {
+			return []*models.DomainFreshness{}, nil
+		}
+		logger.Error("failed-to-fetch-domains", err)
+		return nil, models.ErrUnknownError
+	}
+
+	results := []*models.DomainFreshness{}
+	for _, child := range response.Node.Nodes {
+		freshness := &models.DomainFreshness{Domain: path.Base(child.Key)}
+		if child.Expiration != nil {
+			freshness.ExpireTime = child.Expiration.UnixNano()
+			freshness.TtlRemaining = uint32(child.TTL)
+		}
+		results = append(results, freshness)
+	}
+
+	models.SortDomainFreshness(results)
+	return results, nil
+}
+
 func (db *ETCDDB) UpsertDomain(logger lager.Logger, domain string, ttl uint32) error {
 	_, err := db.client.Set(DomainSchemaPath(domain), []byte{}, uint64(ttl))
 	if err != nil {
@@ -34,6 +58,21 @@ func (db *ETCDDB) UpsertDomain(logger lager.Logger, domain string, ttl uint32) e
 	return nil
 }
 
+// UpsertDomains sets the domains in parallel, on at most updateWorkersSize
+// goroutines, since etcd cannot write them in a single transaction. Each
+// domain succeeds or fails on its own.
+func (db *ETCDDB) UpsertDomains(logger lager.Logger, domains []*models.UpsertDomainRequest) []error {
+	logger = logger.Session("upsert-domains", lager.Data{"count": len(domains)})
+
+	errs := make([]error, len(domains))
+	db.inParallel(len(domains), func(i int) error {
+		domain := domains[i]
+		errs[i] = db.UpsertDomain(logger.WithData(lager.Data{"domain": domain.Domain}), domain.Domain, domain.Ttl)
+		return nil
+	})
+	return errs
+}
+
 func DomainSchemaPath(domain string) string {
 	return path.Join(DomainSchemaRoot, domain)
 }
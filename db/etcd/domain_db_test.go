@@ -2,6 +2,7 @@ package etcd_test
 
 import (
 	. "code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/models"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -42,6 +43,24 @@ var _ = Describe("DomainDB", func() {
 		})
 	})
 
+	Describe("UpsertDomains", func() {
+		It("upserts every domain with its TTL", func() {
+			errs := etcdDB.UpsertDomains(logger, []*models.UpsertDomainRequest{
+				{Domain: "domain-1", Ttl: 5432},
+				{Domain: "domain-2", Ttl: 1337},
+			})
+			Expect(errs).To(Equal([]error{nil, nil}))
+
+			etcdEntry, err := storeClient.Get(DomainSchemaPath("domain-1"), false, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(etcdEntry.Node.TTL).To(BeNumerically("<=", 5432))
+
+			etcdEntry, err = storeClient.Get(DomainSchemaPath("domain-2"), false, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(etcdEntry.Node.TTL).To(BeNumerically("<=", 1337))
+		})
+	})
+
 	Describe("Domains", func() {
 		Context("when there are domains in the DB", func() {
 			BeforeEach(func() {
@@ -69,4 +88,36 @@ var _ = Describe("DomainDB", func() {
 			})
 		})
 	})
+
+	Describe("DomainFreshness", func() {
+		Context("when there are domains in the DB", func() {
+			BeforeEach(func() {
+				var err error
+				_, err = storeClient.Set(DomainSchemaPath("domain-2"), []byte(""), 100)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = storeClient.Set(DomainSchemaPath("domain-1"), []byte(""), 0)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the expiry of each domain, sorted by domain", func() {
+				freshness, err := etcdDB.DomainFreshness(logger)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(freshness).To(HaveLen(2))
+				Expect(freshness[0]).To(Equal(&models.DomainFreshness{Domain: "domain-1"}))
+				Expect(freshness[1].Domain).To(Equal("domain-2"))
+				Expect(freshness[1].ExpireTime).NotTo(BeZero())
+				Expect(freshness[1].TtlRemaining).To(BeNumerically("<=", 100))
+				Expect(freshness[1].TtlRemaining).To(BeNumerically(">", 0))
+			})
+		})
+
+		Context("when there are no domains in the DB", func() {
+			It("returns no domains", func() {
+				freshness, err := etcdDB.DomainFreshness(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(freshness).To(HaveLen(0))
+			})
+		})
+	})
 })
@@ -27,6 +27,26 @@ func (db *ETCDDB) EncryptionKeyLabel(logger lager.Logger) (string, error) {
 	return node.Value, nil
 }
 
+func (db *ETCDDB) SetEncryptionAlgorithm(logger lager.Logger, algorithm string) error {
+	logger.Debug("set-encryption-algorithm", lager.Data{"encryption_algorithm": algorithm})
+	defer logger.Debug("set-encryption-algorithm-finished")
+
+	_, err := db.client.Set(EncryptionAlgorithmKey, []byte(algorithm), NO_TTL)
+	return err
+}
+
+func (db *ETCDDB) EncryptionAlgorithm(logger lager.Logger) (string, error) {
+	logger.Debug("get-encryption-algorithm")
+	defer logger.Debug("get-encryption-algorithm-finished")
+
+	node, err := db.fetchRaw(logger, EncryptionAlgorithmKey)
+	if err != nil {
+		return "", err
+	}
+
+	return node.Value, nil
+}
+
 func (db *ETCDDB) PerformEncryption(logger lager.Logger) error {
 	response, err := db.client.Get(V1SchemaRoot, false, true)
 	if err != nil {
@@ -51,6 +71,7 @@ func (db *ETCDDB) rewriteNode(logger lager.Logger, node *etcd.Node) error {
 		encoder := format.NewEncoder(db.cryptor)
 		payload, err := encoder.Decode([]byte(node.Value))
 		if err != nil {
+			format.LogDecryptionFailure(logger, node.Key, err)
 			logger.Error("failed-to-read-node", err, lager.Data{"etcd_key": node.Key})
 			return nil
 		}
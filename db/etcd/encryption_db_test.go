@@ -2,6 +2,7 @@ package etcd_test
 
 import (
 	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 
 	"code.cloudfoundry.org/bbs/db/etcd"
@@ -47,7 +48,41 @@ var _ = Describe("Encryption", func() {
 		})
 	})
 
-	makeCryptor := func(activeLabel string, decryptionLabels ...string) encryption.Cryptor {
+	Describe("SetEncryptionAlgorithm", func() {
+		It("sets the encryption algorithm into the database", func() {
+			err := etcdDB.SetEncryptionAlgorithm(logger, "xchacha20-poly1305")
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err := storeClient.Get(etcd.EncryptionAlgorithmKey, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Node.Value).To(Equal("xchacha20-poly1305"))
+		})
+	})
+
+	Describe("EncryptionAlgorithm", func() {
+		Context("when the encryption algorithm key exists", func() {
+			It("retrieves the encryption algorithm from the database", func() {
+				_, err := storeClient.Set(etcd.EncryptionAlgorithmKey, []byte("aes-256-gcm"), etcd.NO_TTL)
+				Expect(err).NotTo(HaveOccurred())
+
+				algorithm, err := etcdDB.EncryptionAlgorithm(logger)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(algorithm).To(Equal("aes-256-gcm"))
+			})
+		})
+
+		Context("when the encryption algorithm key does not exist", func() {
+			It("returns a ErrResourceNotFound", func() {
+				algorithm, err := etcdDB.EncryptionAlgorithm(logger)
+				Expect(err).To(MatchError(models.ErrResourceNotFound))
+				Expect(algorithm).To(Equal(""))
+			})
+		})
+	})
+
+	makeCryptorWithAlgorithm := func(algorithm encryption.Algorithm, activeLabel string, decryptionLabels ...string) encryption.Cryptor {
 		activeKey, err := encryption.NewKey(activeLabel, fmt.Sprintf("%s-passphrase", activeLabel))
 		Expect(err).NotTo(HaveOccurred())
 
@@ -63,7 +98,11 @@ var _ = Describe("Encryption", func() {
 
 		keyManager, err := encryption.NewKeyManager(activeKey, decryptionKeys)
 		Expect(err).NotTo(HaveOccurred())
-		return encryption.NewCryptor(keyManager, rand.Reader)
+		return encryption.NewCryptorWithAlgorithm(keyManager, algorithm, rand.Reader)
+	}
+
+	makeCryptor := func(activeLabel string, decryptionLabels ...string) encryption.Cryptor {
+		return makeCryptorWithAlgorithm(encryption.AES256GCM, activeLabel, decryptionLabels...)
 	}
 
 	Describe("PerformEncryption", func() {
@@ -90,7 +129,7 @@ var _ = Describe("Encryption", func() {
 
 			cryptor = makeCryptor("new", "old")
 
-			etcdDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, cryptor, storeClient, clock)
+			etcdDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, cryptor, storeClient, storeClient, clock)
 			err = etcdDB.PerformEncryption(logger)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -110,6 +149,60 @@ var _ = Describe("Encryption", func() {
 			Expect(decrypted2).To(Equal(value2))
 		})
 
+		It("re-encrypts records written with another algorithm with the cryptor's algorithm", func() {
+			value := []byte("some text")
+
+			encoded, err := format.NewEncoder(makeCryptorWithAlgorithm(encryption.XChaCha20Poly1305, "label")).Encode(format.BASE64_ENCRYPTED, value)
+			Expect(err).NotTo(HaveOccurred())
+
+			key := fmt.Sprintf("%s/my/key-1", etcd.V1SchemaRoot)
+			_, err = storeClient.Set(key, encoded, etcd.NO_TTL)
+			Expect(err).NotTo(HaveOccurred())
+
+			etcdDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, makeCryptor("label"), storeClient, storeClient, clock)
+			err = etcdDB.PerformEncryption(logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := storeClient.Get(key, false, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Node.Value).NotTo(Equal(string(encoded)))
+
+			decoded, err := base64.StdEncoding.DecodeString(res.Node.Value[format.EncodingOffset:])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded[0]).To(BeEquivalentTo(len("label")))
+
+			decrypted, err := format.NewEncoder(makeCryptor("label")).Decode([]byte(res.Node.Value))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(value))
+		})
+
+		It("re-encrypts records written with AES-256-GCM with XChaCha20-Poly1305 when it is the cryptor's algorithm", func() {
+			value := []byte("some text")
+
+			encoded, err := format.NewEncoder(makeCryptor("label")).Encode(format.BASE64_ENCRYPTED, value)
+			Expect(err).NotTo(HaveOccurred())
+
+			key := fmt.Sprintf("%s/my/key-1", etcd.V1SchemaRoot)
+			_, err = storeClient.Set(key, encoded, etcd.NO_TTL)
+			Expect(err).NotTo(HaveOccurred())
+
+			xchachaCryptor := makeCryptorWithAlgorithm(encryption.XChaCha20Poly1305, "label")
+			etcdDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, xchachaCryptor, storeClient, storeClient, clock)
+			err = etcdDB.PerformEncryption(logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := storeClient.Get(key, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			decoded, err := base64.StdEncoding.DecodeString(res.Node.Value[format.EncodingOffset:])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded[0]).To(Equal(byte(0x80 | encryption.XChaCha20Poly1305)))
+
+			decrypted, err := format.NewEncoder(xchachaCryptor).Decode([]byte(res.Node.Value))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(value))
+		})
+
 		It("does not fail encryption if it can't read a record", func() {
 			var cryptor encryption.Cryptor
 			var encoder format.Encoder
@@ -127,7 +220,7 @@ var _ = Describe("Encryption", func() {
 
 			cryptor = makeCryptor("new", "old")
 
-			etcdDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, cryptor, storeClient, clock)
+			etcdDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, cryptor, storeClient, storeClient, clock)
 			err = etcdDB.PerformEncryption(logger)
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -4,8 +4,10 @@ import (
 	"path"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
@@ -16,9 +18,10 @@ import (
 )
 
 const (
-	V1SchemaRoot          = "/v1/"
-	VersionKey            = "/version"
-	EncryptionKeyLabelKey = "/encryption-key"
+	V1SchemaRoot           = "/v1/"
+	VersionKey             = "/version"
+	EncryptionKeyLabelKey  = "/encryption-key"
+	EncryptionAlgorithmKey = "/encryption-algorithm"
 
 	DomainSchemaRoot = V1SchemaRoot + "domain"
 
@@ -33,6 +36,10 @@ const (
 	DesiredLRPRunInfoSchemaRoot        = DesiredLRPComponentsSchemaRoot + "/" + DesiredLRPRunInfoKey
 
 	TaskSchemaRoot = V1SchemaRoot + "task"
+
+	IdempotencyKeySchemaRoot = V1SchemaRoot + "idempotency_key"
+
+	DesiredLRPTombstoneSchemaRoot = V1SchemaRoot + "desired_lrp_tombstone"
 )
 
 func ActualLRPProcessDir(processGuid string) string {
@@ -67,6 +74,14 @@ func TaskSchemaPathByGuid(taskGuid string) string {
 	return path.Join(TaskSchemaRoot, taskGuid)
 }
 
+func IdempotencyKeySchemaPath(key string) string {
+	return path.Join(IdempotencyKeySchemaRoot, key)
+}
+
+func DesiredLRPTombstoneSchemaPath(processGuid string) string {
+	return path.Join(DesiredLRPTombstoneSchemaRoot, processGuid)
+}
+
 type ETCDOptions struct {
 	CertFile               string
 	KeyFile                string
@@ -75,20 +90,48 @@ type ETCDOptions struct {
 	IsSSL                  bool
 	ClientSessionCacheSize int
 	MaxIdleConnsPerHost    int
+	BulkReadConsistency    string
 	IsConfigured           bool
 }
 
 type ETCDDB struct {
 	format                    *format.Format
-	convergenceWorkersSize    int
+	convergenceWorkers        *bbsdb.ConvergenceWorkers
 	updateWorkersSize         int
 	desiredLRPCreationTimeout time.Duration
+	stuckEvacuationThreshold  time.Duration
+	tombstoneRetention        time.Duration
+	placementHistoryLength    int
+	schedulingInfoCache       *schedulingInfoCache
+	orphanGracePeriod         time.Duration
+	missingKeyPolicy          format.MissingKeyPolicy
 	serializer                format.Serializer
 	cryptor                   encryption.Cryptor
 	client                    StoreClient
+	bulkReadClient            StoreClient
+	listingClient             StoreClient
 	clock                     clock.Clock
 	inflightWatches           map[chan bool]bool
 	inflightWatchLock         *sync.Mutex
+	lrpCounts                 *lrpCounts
+}
+
+// ETCDDBOptions holds the settings of an ETCDDB that have a default. The zero
+// value of each field is its default.
+type ETCDDBOptions struct {
+	// PlacementHistoryLength is how many placements an ActualLRP records. 0
+	// records none.
+	PlacementHistoryLength int
+	// SchedulingInfoCacheTTL and SchedulingInfoCacheSize bound the cache of
+	// decrypted scheduling infos. 0 for either disables the cache.
+	SchedulingInfoCacheTTL  time.Duration
+	SchedulingInfoCacheSize int
+	// OrphanGracePeriod is how long convergence keeps an ActualLRP whose
+	// DesiredLRP no longer exists. 0 retires it on the first pass.
+	OrphanGracePeriod time.Duration
+	// MissingKeyPolicy is how records encrypted with a key that is no longer
+	// known are read. It defaults to format.MissingKeyFail.
+	MissingKeyPolicy format.MissingKeyPolicy
 }
 
 func NewETCD(
@@ -96,21 +139,54 @@ func NewETCD(
 	convergenceWorkersSize int,
 	updateWorkersSize int,
 	desiredLRPCreationTimeout time.Duration,
+	stuckEvacuationThreshold time.Duration,
+	tombstoneRetention time.Duration,
+	cryptor encryption.Cryptor,
+	storeClient StoreClient,
+	bulkReadClient StoreClient,
+	clock clock.Clock,
+) *ETCDDB {
+	return NewETCDWithOptions(serializationFormat, convergenceWorkersSize, updateWorkersSize, desiredLRPCreationTimeout, stuckEvacuationThreshold, tombstoneRetention, cryptor, storeClient, bulkReadClient, clock, ETCDDBOptions{})
+}
+
+func NewETCDWithOptions(
+	serializationFormat *format.Format,
+	convergenceWorkersSize int,
+	updateWorkersSize int,
+	desiredLRPCreationTimeout time.Duration,
+	stuckEvacuationThreshold time.Duration,
+	tombstoneRetention time.Duration,
 	cryptor encryption.Cryptor,
 	storeClient StoreClient,
+	bulkReadClient StoreClient,
 	clock clock.Clock,
+	options ETCDDBOptions,
 ) *ETCDDB {
+	missingKeyPolicy := options.MissingKeyPolicy
+	if missingKeyPolicy == "" {
+		missingKeyPolicy = format.MissingKeyFail
+	}
+
 	return &ETCDDB{
 		format:                    serializationFormat,
-		convergenceWorkersSize:    convergenceWorkersSize,
+		convergenceWorkers:        bbsdb.NewConvergenceWorkers(convergenceWorkersSize),
 		updateWorkersSize:         updateWorkersSize,
 		desiredLRPCreationTimeout: desiredLRPCreationTimeout,
+		stuckEvacuationThreshold:  stuckEvacuationThreshold,
+		tombstoneRetention:        tombstoneRetention,
+		placementHistoryLength:    options.PlacementHistoryLength,
+		schedulingInfoCache:       newSchedulingInfoCache(options.SchedulingInfoCacheTTL, options.SchedulingInfoCacheSize, clock),
+		orphanGracePeriod:         options.OrphanGracePeriod,
+		missingKeyPolicy:          missingKeyPolicy,
 		serializer:                format.NewSerializer(cryptor),
 		cryptor:                   cryptor,
 		client:                    storeClient,
+		bulkReadClient:            bulkReadClient,
+		listingClient:             storeClient,
 		clock:                     clock,
 		inflightWatches:           map[chan bool]bool{},
 		inflightWatchLock:         &sync.Mutex{},
+		lrpCounts:                 &lrpCounts{},
 	}
 }
 
@@ -123,18 +199,102 @@ func (db *ETCDDB) serializeModel(logger lager.Logger, model format.Versioner) ([
 	return encodedPayload, nil
 }
 
+// deserializeModel logs the key of the node when it cannot be decrypted, and
+// applies the missing key policy when its key is missing.
 func (db *ETCDDB) deserializeModel(logger lager.Logger, node *etcdclient.Node, model format.Versioner) error { // this is the number of desired instances
 	err := db.serializer.Unmarshal(logger, []byte(node.Value), model)
 	if err != nil {
+		format.LogDecryptionFailure(logger, node.Key, err)
 		logger.Error("failed-to-deserialize-model", err)
+		if format.IsMissingKey(err) {
+			return db.handleMissingKey(logger, node)
+		}
 		return models.NewError(models.Error_InvalidRecord, err.Error())
 	}
 	return nil
 }
 
+// inParallel calls work once for every index below count, spread across at
+// most updateWorkersSize goroutines, e.g. to deserialize the nodes of a listing
+// or to write several records. Work should write its result into a slot for
+// its index so that callers can keep the order of their inputs. The first
+// error stops any work that has not started yet and is returned.
+func (db *ETCDDB) inParallel(count int, work func(i int) error) error {
+	workers := db.updateWorkersSize
+	if workers > count {
+		workers = count
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var (
+		next     int64 = -1
+		failed   int32
+		firstErr error
+		errOnce  sync.Once
+		wg       sync.WaitGroup
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&failed) == 0 {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= count {
+					return
+				}
+
+				err := work(i)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						atomic.StoreInt32(&failed, 1)
+					})
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
 func (db *ETCDDB) fetchRecursiveRaw(logger lager.Logger, key string) (*etcd.Node, error) {
+	return db.fetchRecursiveRawWithClient(logger, db.client, key)
+}
+
+// BulkReadDB returns a copy of the DB for the read endpoints, whose bulk
+// listings are read with the bulk read client. That client may be weakly
+// consistent, so the copy must not be used to decide on writes. The methods
+// that write list what they need through the quorum client on either copy, and
+// so do the desired LRP listings; see DesiredLRPsRevision.
+func (db *ETCDDB) BulkReadDB() *ETCDDB {
+	bulk := *db
+	bulk.listingClient = db.bulkReadClient
+	return &bulk
+}
+
+// quorumDB returns a copy of the DB whose bulk listings are read through the
+// quorum client, for the methods that write based on what they list.
+func (db *ETCDDB) quorumDB() *ETCDDB {
+	quorum := *db
+	quorum.listingClient = db.client
+	return &quorum
+}
+
+// fetchBulkRecursiveRaw is used by the bulk listings. It reads with the bulk
+// read client on the DB returned by BulkReadDB, and with the quorum client
+// otherwise.
+func (db *ETCDDB) fetchBulkRecursiveRaw(logger lager.Logger, key string) (*etcd.Node, error) {
+	return db.fetchRecursiveRawWithClient(logger, db.listingClient, key)
+}
+
+func (db *ETCDDB) fetchRecursiveRawWithClient(logger lager.Logger, client StoreClient, key string) (*etcd.Node, error) {
 	logger.Debug("fetching-recursive-from-etcd")
-	response, err := db.client.Get(key, false, true)
+	response, err := client.Get(key, false, true)
 	if err != nil {
 		return nil, ErrorFromEtcdError(logger, err)
 	}
@@ -181,6 +341,12 @@ func ErrorFromEtcdError(logger lager.Logger, err error) error {
 	}
 }
 
+// IsKeyNotFound reports whether err is etcd's answer for a key that does not
+// exist.
+func IsKeyNotFound(err error) bool {
+	return etcdErrCode(err) == ETCDErrKeyNotFound
+}
+
 func etcdErrCode(err error) int {
 	if err != nil {
 		switch err.(type) {
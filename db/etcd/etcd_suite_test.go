@@ -22,6 +22,7 @@ import (
 )
 
 const DesiredLRPCreationTimeout = time.Minute
+const StuckEvacuationThreshold = 10 * time.Minute
 
 var etcdPort int
 var etcdUrl string
@@ -76,6 +77,6 @@ var _ = BeforeEach(func() {
 	storeClient = etcd.NewStoreClient(etcdClient)
 	fakeStoreClient = &fakes.FakeStoreClient{}
 	etcdHelper = etcd_helpers.NewETCDHelper(format.ENCRYPTED_PROTO, cryptor, storeClient, clock)
-	etcdDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, cryptor, storeClient, clock)
-	etcdDBWithFakeStore = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, cryptor, fakeStoreClient, clock)
+	etcdDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, cryptor, storeClient, storeClient, clock)
+	etcdDBWithFakeStore = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, cryptor, fakeStoreClient, fakeStoreClient, clock)
 })
@@ -0,0 +1,73 @@
+package etcd
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+func (db *ETCDDB) DesireTaskWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, taskDef *models.TaskDefinition, taskGuid, domain string) (*models.Task, bool, error) {
+	logger = logger.Session("desire-task-with-idempotency-key", lager.Data{"idempotency_key": key.Key})
+
+	replayed, err := db.checkIdempotencyKey(logger, key)
+	if err != nil || replayed {
+		return nil, replayed, err
+	}
+
+	task, err := db.DesireTask(logger, taskDef, taskGuid, domain)
+	if err != nil {
+		return nil, false, err
+	}
+
+	db.recordIdempotencyKey(logger, key)
+	return task, false, nil
+}
+
+func (db *ETCDDB) DesireLRPWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, desiredLRP *models.DesiredLRP) (bool, error) {
+	logger = logger.Session("desire-lrp-with-idempotency-key", lager.Data{"idempotency_key": key.Key})
+
+	replayed, err := db.checkIdempotencyKey(logger, key)
+	if err != nil || replayed {
+		return replayed, err
+	}
+
+	err = db.DesireLRP(logger, desiredLRP)
+	if err != nil {
+		return false, err
+	}
+
+	db.recordIdempotencyKey(logger, key)
+	return false, nil
+}
+
+// checkIdempotencyKey returns true if the key was already recorded for the
+// same request. etcd has no transactions, so unlike the SQL backend the key is
+// only recorded once the creation has succeeded; concurrent requests with the
+// same key fall back to the uniqueness of the created resource.
+func (db *ETCDDB) checkIdempotencyKey(logger lager.Logger, key models.IdempotencyKey) (bool, error) {
+	response, err := db.client.Get(IdempotencyKeySchemaPath(key.Key), false, false)
+	if err != nil {
+		if etcdErrCode(err) == ETCDErrKeyNotFound {
+			return false, nil
+		}
+		return false, ErrorFromEtcdError(logger, err)
+	}
+
+	if response.Node.Value != key.Fingerprint {
+		logger.Info("idempotency-key-conflict")
+		return false, models.ErrIdempotencyKeyConflict
+	}
+
+	logger.Info("replaying-request")
+	return true, nil
+}
+
+// recordIdempotencyKey is best effort: the resource has already been created,
+// so a failure here only means a retry gets ErrResourceExists instead of a
+// replayed success.
+func (db *ETCDDB) recordIdempotencyKey(logger lager.Logger, key models.IdempotencyKey) {
+	ttl := uint64(models.IdempotencyKeyTTL.Seconds())
+	_, err := db.client.Create(IdempotencyKeySchemaPath(key.Key), []byte(key.Fingerprint), ttl)
+	if err != nil {
+		logger.Error("failed-recording-idempotency-key", err)
+	}
+}
@@ -0,0 +1,106 @@
+package etcd_test
+
+import (
+	. "code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Idempotency keys", func() {
+	var key models.IdempotencyKey
+
+	BeforeEach(func() {
+		key = models.IdempotencyKey{Key: "retry-key", Fingerprint: "some-fingerprint"}
+	})
+
+	Describe("DesireTaskWithIdempotencyKey", func() {
+		var task *models.Task
+
+		BeforeEach(func() {
+			task = model_helpers.NewValidTask("task-guid")
+		})
+
+		It("creates the task and records the key with a TTL", func() {
+			_, replayed, err := etcdDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replayed).To(BeFalse())
+
+			_, err = etcdDB.TaskByGuid(logger, task.TaskGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err := storeClient.Get(IdempotencyKeySchemaPath(key.Key), false, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Node.Value).To(Equal(key.Fingerprint))
+			Expect(response.Node.TTL).To(BeNumerically(">", 0))
+			Expect(response.Node.TTL).To(BeNumerically("<=", models.IdempotencyKeyTTL.Seconds()))
+		})
+
+		Context("when the key was already used for the same request", func() {
+			BeforeEach(func() {
+				_, _, err := etcdDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("replays the request", func() {
+				_, replayed, err := etcdDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(replayed).To(BeTrue())
+			})
+		})
+
+		Context("when the key was already used for a different request", func() {
+			BeforeEach(func() {
+				otherKey := models.IdempotencyKey{Key: key.Key, Fingerprint: "other-fingerprint"}
+				_, _, err := etcdDB.DesireTaskWithIdempotencyKey(logger, otherKey, task.TaskDefinition, "other-task-guid", task.Domain)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a conflict without creating the task", func() {
+				_, _, err := etcdDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).To(Equal(models.ErrIdempotencyKeyConflict))
+
+				_, err = etcdDB.TaskByGuid(logger, task.TaskGuid)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when creating the task fails", func() {
+			BeforeEach(func() {
+				_, err := etcdDB.DesireTask(logger, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not record the key", func() {
+				_, _, err := etcdDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).To(Equal(models.ErrResourceExists))
+
+				_, err = storeClient.Get(IdempotencyKeySchemaPath(key.Key), false, false)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("DesireLRPWithIdempotencyKey", func() {
+		var desiredLRP *models.DesiredLRP
+
+		BeforeEach(func() {
+			desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+		})
+
+		It("creates the desired lrp and replays a retry", func() {
+			replayed, err := etcdDB.DesireLRPWithIdempotencyKey(logger, key, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replayed).To(BeFalse())
+
+			_, err = etcdDB.DesiredLRPByProcessGuid(logger, desiredLRP.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			replayed, err = etcdDB.DesireLRPWithIdempotencyKey(logger, key, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replayed).To(BeTrue())
+		})
+	})
+})
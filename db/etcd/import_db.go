@@ -0,0 +1,227 @@
+package etcd
+
+import (
+	"path"
+	"sort"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func (db *ETCDDB) IsEmpty(logger lager.Logger) (bool, error) {
+	logger = logger.Session("is-empty")
+
+	for _, root := range []string{DomainSchemaRoot, DesiredLRPSchedulingInfoSchemaRoot, ActualLRPSchemaRoot, TaskSchemaRoot} {
+		node, err := db.fetchRaw(logger, root)
+		if err == models.ErrResourceNotFound {
+			continue
+		}
+		if err != nil {
+			logger.Error("failed-fetching-root", err, lager.Data{"root": root})
+			return false, err
+		}
+		if len(node.Nodes) > 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ImportRecords writes the records one at a time, as etcd cannot write several
+// keys in a single transaction.
+func (db *ETCDDB) ImportRecords(logger lager.Logger, records []*models.ImportRecord) []error {
+	logger = logger.Session("import-records", lager.Data{"count": len(records)})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	errs := make([]error, len(records))
+	for i, record := range records {
+		switch {
+		case record.Domain != nil:
+			errs[i] = db.UpsertDomain(logger, record.Domain.Domain, record.Domain.Ttl)
+		case record.DesiredLrp != nil:
+			errs[i] = db.importDesiredLRP(logger, record.DesiredLrp)
+		case record.Task != nil:
+			errs[i] = db.importTask(logger, record.Task)
+		case record.ActualLrp != nil:
+			errs[i] = db.importActualLRP(logger, record.ActualLrp)
+		default:
+			errs[i] = models.ErrBadRequest
+		}
+	}
+	return errs
+}
+
+func (db *ETCDDB) importDesiredLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) error {
+	for _, key := range []string{DesiredLRPSchedulingInfoSchemaPath(desiredLRP.ProcessGuid), DesiredLRPRunInfoSchemaPath(desiredLRP.ProcessGuid)} {
+		_, err := db.client.Delete(key, true)
+		err = ErrorFromEtcdError(logger, err)
+		if err != nil && err != models.ErrResourceNotFound {
+			logger.Error("failed-deleting-existing-desired-lrp", err, lager.Data{"process_guid": desiredLRP.ProcessGuid})
+			return err
+		}
+	}
+
+	return db.DesireLRP(logger, desiredLRP)
+}
+
+func (db *ETCDDB) importTask(logger lager.Logger, task *models.Task) error {
+	now := db.clock.Now().UnixNano()
+	if task.CreatedAt == 0 {
+		task.CreatedAt = now
+	}
+	if task.UpdatedAt == 0 {
+		task.UpdatedAt = now
+	}
+
+	value, err := db.serializeModel(logger, task)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.client.Set(TaskSchemaPathByGuid(task.TaskGuid), value, NO_TTL)
+	if err != nil {
+		logger.Error("failed-persisting-task", err, lager.Data{"task_guid": task.TaskGuid})
+		return ErrorFromEtcdError(logger, err)
+	}
+
+	return nil
+}
+
+func (db *ETCDDB) importActualLRP(logger lager.Logger, actualLRP *models.ActualLRP) error {
+	value, err := db.serializeModel(logger, actualLRP)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.client.Set(ActualLRPSchemaPath(actualLRP.ProcessGuid, actualLRP.Index), value, NO_TTL)
+	if err != nil {
+		logger.Error("failed-persisting-actual-lrp", err, lager.Data{"process_guid": actualLRP.ProcessGuid, "index": actualLRP.Index})
+		return ErrorFromEtcdError(logger, err)
+	}
+
+	return nil
+}
+
+// ExportRecords reads the whole v1 tree with a single recursive get, so that
+// every record is read at the same etcd index. Nodes that cannot be
+// deserialized are skipped.
+func (db *ETCDDB) ExportRecords(logger lager.Logger, yield func(*models.ImportRecord) error) error {
+	logger = logger.Session("export-records")
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	root, err := db.fetchRecursiveRaw(logger, V1SchemaRoot)
+	if err == models.ErrResourceNotFound {
+		return nil
+	}
+	if err != nil {
+		logger.Error("failed-fetching-root", err)
+		return err
+	}
+
+	var domains, desiredLRPComponents, actualLRPs, tasks etcd.Nodes
+	for _, node := range root.Nodes {
+		switch node.Key {
+		case DomainSchemaRoot:
+			domains = node.Nodes
+		case DesiredLRPComponentsSchemaRoot:
+			desiredLRPComponents = node.Nodes
+		case ActualLRPSchemaRoot:
+			actualLRPs = node.Nodes
+		case TaskSchemaRoot:
+			tasks = node.Nodes
+		}
+	}
+
+	records := db.exportDomains(domains)
+	records = append(records, db.exportDesiredLRPs(logger, desiredLRPComponents)...)
+	records = append(records, db.exportActualLRPs(logger, actualLRPs)...)
+	records = append(records, db.exportTasks(logger, tasks)...)
+
+	for _, record := range records {
+		err = yield(record)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *ETCDDB) exportDomains(nodes etcd.Nodes) []*models.ImportRecord {
+	records := make([]*models.ImportRecord, 0, len(nodes))
+	for _, node := range nodes {
+		records = append(records, &models.ImportRecord{
+			Domain: &models.ImportDomain{Domain: path.Base(node.Key), Ttl: uint32(node.TTL)},
+		})
+	}
+	return records
+}
+
+func (db *ETCDDB) exportDesiredLRPs(logger lager.Logger, nodes etcd.Nodes) []*models.ImportRecord {
+	schedules := map[string]*models.DesiredLRPSchedulingInfo{}
+	runs := map[string]*models.DesiredLRPRunInfo{}
+	for _, node := range nodes {
+		switch node.Key {
+		case DesiredLRPSchedulingInfoSchemaRoot:
+			schedules, _, _ = db.deserializeScheduleInfos(logger, node.Nodes, models.DesiredLRPFilter{})
+		case DesiredLRPRunInfoSchemaRoot:
+			runs, _, _ = db.deserializeRunInfos(logger, node.Nodes, models.DesiredLRPFilter{})
+		}
+	}
+
+	processGuids := make([]string, 0, len(schedules))
+	for processGuid := range schedules {
+		if _, ok := runs[processGuid]; ok {
+			processGuids = append(processGuids, processGuid)
+		}
+	}
+	sort.Strings(processGuids)
+
+	records := make([]*models.ImportRecord, 0, len(processGuids))
+	for _, processGuid := range processGuids {
+		desiredLRP := models.NewDesiredLRP(*schedules[processGuid], *runs[processGuid])
+		records = append(records, &models.ImportRecord{DesiredLrp: &desiredLRP})
+	}
+	return records
+}
+
+// exportActualLRPs reads the instance of every index of every process guid,
+// leaving out the evacuating ones.
+func (db *ETCDDB) exportActualLRPs(logger lager.Logger, processNodes etcd.Nodes) []*models.ImportRecord {
+	records := []*models.ImportRecord{}
+	for _, processNode := range processNodes {
+		for _, indexNode := range processNode.Nodes {
+			for _, node := range indexNode.Nodes {
+				if path.Base(node.Key) != ActualLRPInstanceKey {
+					continue
+				}
+
+				actualLRP := new(models.ActualLRP)
+				err := db.deserializeModel(logger, node, actualLRP)
+				if err != nil {
+					logger.Error("failed-parsing-actual-lrp", err, lager.Data{"key": node.Key})
+					continue
+				}
+				records = append(records, &models.ImportRecord{ActualLrp: actualLRP})
+			}
+		}
+	}
+	return records
+}
+
+func (db *ETCDDB) exportTasks(logger lager.Logger, nodes etcd.Nodes) []*models.ImportRecord {
+	records := make([]*models.ImportRecord, 0, len(nodes))
+	for _, node := range nodes {
+		task := new(models.Task)
+		err := db.deserializeModel(logger, node, task)
+		if err != nil {
+			logger.Error("failed-parsing-task", err, lager.Data{"key": node.Key})
+			continue
+		}
+		records = append(records, &models.ImportRecord{Task: task})
+	}
+	return records
+}
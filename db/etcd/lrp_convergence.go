@@ -8,8 +8,8 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
-	"code.cloudfoundry.org/workpool"
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
@@ -35,32 +35,58 @@ const (
 
 	crashedActualLRPs   = metric.Metric("CrashedActualLRPs")
 	crashingDesiredLRPs = metric.Metric("CrashingDesiredLRPs")
+
+	evacuatingLRPs      = metric.Metric("LRPsEvacuating")
+	stuckEvacuatingLRPs = metric.Metric("LRPsStuckEvacuating")
 )
 
-func (db *ETCDDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet) ([]*auctioneer.LRPStartRequest, []*models.ActualLRPKeyWithSchedulingInfo, []*models.ActualLRPKey) {
+func (db *ETCDDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet, filter models.ConvergenceFilter) ([]*auctioneer.LRPStartRequest, []*models.ActualLRPKeyWithSchedulingInfo, []*models.ActualLRPKey) {
 	convergeStart := db.clock.Now()
-	convergeLRPRunsCounter.Increment()
-	logger = logger.Session("etcd")
+	logger = logger.Session("etcd", lager.Data{"domains": filter.Domains})
 	logger.Info("starting-convergence")
 	defer logger.Info("finished-convergence")
 
-	defer func() {
-		err := convergeLRPDuration.Send(time.Since(convergeStart))
-		if err != nil {
-			logger.Error("failed-sending-converge-lrp-duration-metric", err)
-		}
-	}()
+	if !filter.IsScoped() {
+		convergeLRPRunsCounter.Increment()
+		defer func() {
+			err := convergeLRPDuration.Send(time.Since(convergeStart))
+			if err != nil {
+				logger.Error("failed-sending-converge-lrp-duration-metric", err)
+			}
+		}()
+	}
+
+	convergenceTrace := bbsdb.StartConvergenceTrace("converge-lrps")
+	defer convergenceTrace.End()
+
+	phase := convergenceTrace.Phase("purge-desired-lrp-tombstones")
+	db.purgeDesiredLRPTombstones(logger)
+	phase.End()
 
 	logger.Debug("gathering-convergence-input")
+	phase = convergenceTrace.Phase("gather-and-prune-lrps")
 	input, err := db.GatherAndPruneLRPs(logger, cellSet)
+	phase.End()
 	if err != nil {
 		logger.Error("failed-gathering-convergence-input", err)
 		return nil, nil, nil
 	}
 	logger.Debug("succeeded-gathering-convergence-input")
 
+	if filter.IsScoped() {
+		input = scopeConvergenceInput(input, filter)
+	}
+	input.OrphanGracePeriod = db.orphanGracePeriod
+
+	phase = convergenceTrace.Phase("calculate-convergence")
 	changes := CalculateConvergence(logger, db.clock, models.NewDefaultRestartCalculator(), input)
+	phase.End()
+
+	// the priority domains' missing actual LRPs are created first, so that
+	// they are the first to be auctioned when there are more than workers
+	filter.SortKeysByPriority(changes.ActualLRPKeysForMissingIndices)
 
+	defer convergenceTrace.Phase("resolve-convergence").End()
 	return db.ResolveConvergence(logger, input.DesiredLRPs, changes)
 }
 
@@ -71,6 +97,45 @@ type LRPMetricCounter struct {
 	crashedActualLRPs   int32
 	crashingDesiredLRPs int32
 	desiredLRPs         int32
+	evacuatingLRPs      int32
+	stuckEvacuatingLRPs int32
+
+	// nil unless the per-domain counts are wanted
+	domains *domainLRPCounter
+}
+
+// domainLRPCounter tallies the desired and running instances of each domain
+// while convergence walks the LRPs, so that LRPCountsByDomain does not have
+// to read them again.
+type domainLRPCounter struct {
+	lock   sync.Mutex
+	counts map[string]bbsdb.DomainLRPCounts
+}
+
+func newDomainLRPCounter() *domainLRPCounter {
+	return &domainLRPCounter{counts: map[string]bbsdb.DomainLRPCounts{}}
+}
+
+func (c *domainLRPCounter) addDesired(domain string, instances int32) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	counts := c.counts[domain]
+	counts.DesiredInstances += int(instances)
+	c.counts[domain] = counts
+	c.lock.Unlock()
+}
+
+func (c *domainLRPCounter) addRunning(domain string) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	counts := c.counts[domain]
+	counts.RunningInstances++
+	c.counts[domain] = counts
+	c.lock.Unlock()
 }
 
 func (lmc LRPMetricCounter) Send(logger lager.Logger) {
@@ -103,13 +168,23 @@ func (lmc LRPMetricCounter) Send(logger lager.Logger) {
 	if err != nil {
 		logger.Error("failed-sending-desired-lrps-metric", err)
 	}
+
+	err = evacuatingLRPs.Send(int(lmc.evacuatingLRPs))
+	if err != nil {
+		logger.Error("failed-sending-evacuating-lrps-metric", err)
+	}
+
+	err = stuckEvacuatingLRPs.Send(int(lmc.stuckEvacuatingLRPs))
+	if err != nil {
+		logger.Error("failed-sending-stuck-evacuating-lrps-metric", err)
+	}
 }
 
 func (db *ETCDDB) GatherAndPruneLRPs(logger lager.Logger, cellSet models.CellSet) (*models.ConvergenceInput, error) {
 	guids := map[string]struct{}{}
 	// always fetch actualLRPs before desiredLRPs to ensure correctness
 	logger.Debug("gathering-and-pruning-actual-lrps")
-	lrpMetricCounter := &LRPMetricCounter{}
+	lrpMetricCounter := &LRPMetricCounter{domains: newDomainLRPCounter()}
 
 	actuals, err := db.gatherAndPruneActualLRPs(logger, guids, lrpMetricCounter) // modifies guids
 	if err != nil {
@@ -121,6 +196,8 @@ func (db *ETCDDB) GatherAndPruneLRPs(logger lager.Logger, cellSet models.CellSet
 		lrpMetricCounter.crashedActualLRPs = -1
 		lrpMetricCounter.crashingDesiredLRPs = -1
 		lrpMetricCounter.desiredLRPs = -1
+		lrpMetricCounter.evacuatingLRPs = -1
+		lrpMetricCounter.stuckEvacuatingLRPs = -1
 
 		lrpMetricCounter.Send(logger)
 
@@ -142,9 +219,10 @@ func (db *ETCDDB) GatherAndPruneLRPs(logger lager.Logger, cellSet models.CellSet
 	logger.Debug("succeeded-gathering-and-pruning-desired-lrps")
 
 	lrpMetricCounter.Send(logger)
+	db.setLRPCountsByDomain(lrpMetricCounter.domains.counts)
 
 	logger.Debug("listing-domains")
-	domains, err := db.Domains(logger)
+	domains, err := db.quorumDB().Domains(logger)
 	if err != nil {
 		return &models.ConvergenceInput{}, err
 	}
@@ -191,6 +269,7 @@ func (db *ETCDDB) gatherAndOptionallyPruneActualLRPs(logger lager.Logger, guids
 	logger.Debug("walking-actual-lrp-tree")
 	works := []func(){}
 	crashingDesireds := map[string]struct{}{}
+	stuckBefore := db.clock.Now().Add(-db.stuckEvacuationThreshold).UnixNano()
 
 	for _, guidGroup := range response.Nodes {
 		guidGroup := guidGroup
@@ -203,6 +282,12 @@ func (db *ETCDDB) gatherAndOptionallyPruneActualLRPs(logger lager.Logger, guids
 				for _, actualNode := range indexGroup.Nodes {
 					actual := new(models.ActualLRP)
 					err := db.deserializeModel(logger, actualNode, actual)
+					if isMissingKeyError(err) {
+						// kept, but not converged
+						indexGroupWillBeEmpty = false
+						guidGroupWillBeEmpty = false
+						continue
+					}
 					if err != nil {
 						actualsToDeleteLock.Lock()
 						actualsToDelete = append(actualsToDelete, actualNode.Key)
@@ -230,6 +315,9 @@ func (db *ETCDDB) gatherAndOptionallyPruneActualLRPs(logger lager.Logger, guids
 						atomic.AddInt32(&lmc.claimedLRPs, 1)
 					case models.ActualLRPStateRunning:
 						atomic.AddInt32(&lmc.runningLRPs, 1)
+						if path.Base(actualNode.Key) == ActualLRPInstanceKey {
+							lmc.domains.addRunning(actual.Domain)
+						}
 					case models.ActualLRPStateCrashed:
 						crashingDesiredsLock.Lock()
 						crashingDesireds[actual.ProcessGuid] = struct{}{}
@@ -237,6 +325,19 @@ func (db *ETCDDB) gatherAndOptionallyPruneActualLRPs(logger lager.Logger, guids
 						atomic.AddInt32(&lmc.crashedActualLRPs, 1)
 					}
 
+					if path.Base(actualNode.Key) == ActualLRPEvacuatingKey {
+						atomic.AddInt32(&lmc.evacuatingLRPs, 1)
+						if db.stuckEvacuationThreshold > 0 && actual.Since <= stuckBefore {
+							atomic.AddInt32(&lmc.stuckEvacuatingLRPs, 1)
+							logger.Info("stuck-evacuating-actual-lrp", lager.Data{
+								"process_guid":     actual.ProcessGuid,
+								"index":            actual.Index,
+								"cell_id":          actual.CellId,
+								"evacuating_since": actual.Since,
+							})
+						}
+					}
+
 					guidsLock.Lock()
 					guids[actual.ProcessGuid] = struct{}{}
 					guidsLock.Unlock()
@@ -267,12 +368,7 @@ func (db *ETCDDB) gatherAndOptionallyPruneActualLRPs(logger lager.Logger, guids
 	}
 	logger.Debug("done-walking-actual-lrp-tree")
 
-	throttler, err := workpool.NewThrottler(db.convergenceWorkersSize, works)
-	if err != nil {
-		logger.Error("failed-to-create-throttler", err)
-	}
-
-	throttler.Work()
+	db.convergenceWorkers.Run(works)
 
 	if doPrune {
 		logger.Info("deleting-invalid-actual-lrps", lager.Data{"num_lrps": len(actualsToDelete)})
@@ -280,7 +376,7 @@ func (db *ETCDDB) gatherAndOptionallyPruneActualLRPs(logger lager.Logger, guids
 		actualLRPsDeleted.Add(uint64(len(actualsToDelete)))
 
 		logger.Info("deleting-empty-actual-indices", lager.Data{"num_indices": len(indexKeysToDelete)})
-		err = db.deleteLeaves(logger, indexKeysToDelete)
+		err := db.deleteLeaves(logger, indexKeysToDelete)
 		if err != nil {
 			logger.Error("failed-deleting-empty-actual-indices", err, lager.Data{"num_indices": len(indexKeysToDelete)})
 		} else {
@@ -314,12 +410,7 @@ func (db *ETCDDB) deleteLeaves(logger lager.Logger, keys []string) error {
 		})
 	}
 
-	throttler, err := workpool.NewThrottler(db.convergenceWorkersSize, works)
-	if err != nil {
-		return err
-	}
-
-	throttler.Work()
+	db.convergenceWorkers.Run(works)
 
 	return nil
 }
@@ -341,7 +432,11 @@ func (db *ETCDDB) GatherAndPruneDesiredLRPs(logger lager.Logger, guids map[strin
 
 	var malformedSchedulingInfos, malformedRunInfos []string
 
-	var guidsLock, schedulingInfosLock, runInfosLock sync.Mutex
+	// the process guids of the components whose encryption key is missing,
+	// whose other component is not deleted for missing its counterpart
+	unreadableGuids := map[string]struct{}{}
+
+	var guidsLock, schedulingInfosLock, runInfosLock, unreadableGuidsLock sync.Mutex
 
 	works := []func(){}
 	logger.Debug("walking-desired-lrp-components-tree")
@@ -354,7 +449,11 @@ func (db *ETCDDB) GatherAndPruneDesiredLRPs(logger lager.Logger, guids map[strin
 				works = append(works, func() {
 					var schedulingInfo models.DesiredLRPSchedulingInfo
 					err := db.deserializeModel(logger, node, &schedulingInfo)
-					if err != nil || schedulingInfo.Validate() != nil {
+					if isMissingKeyError(err) {
+						unreadableGuidsLock.Lock()
+						unreadableGuids[path.Base(node.Key)] = struct{}{}
+						unreadableGuidsLock.Unlock()
+					} else if err != nil || schedulingInfo.Validate() != nil {
 						logger.Error("failed-to-deserialize-scheduling-info", err)
 						schedulingInfosLock.Lock()
 						malformedSchedulingInfos = append(malformedSchedulingInfos, node.Key)
@@ -364,6 +463,7 @@ func (db *ETCDDB) GatherAndPruneDesiredLRPs(logger lager.Logger, guids map[strin
 						schedulingInfos[schedulingInfo.ProcessGuid] = &schedulingInfo
 						schedulingInfosLock.Unlock()
 						atomic.AddInt32(&lmc.desiredLRPs, schedulingInfo.Instances)
+						lmc.domains.addDesired(schedulingInfo.Domain, schedulingInfo.Instances)
 
 						guidsLock.Lock()
 						guids[schedulingInfo.ProcessGuid] = struct{}{}
@@ -377,7 +477,11 @@ func (db *ETCDDB) GatherAndPruneDesiredLRPs(logger lager.Logger, guids map[strin
 				works = append(works, func() {
 					var runInfo models.DesiredLRPRunInfo
 					err := db.deserializeModel(logger, node, &runInfo)
-					if err != nil || runInfo.Validate() != nil {
+					if isMissingKeyError(err) {
+						unreadableGuidsLock.Lock()
+						unreadableGuids[path.Base(node.Key)] = struct{}{}
+						unreadableGuidsLock.Unlock()
+					} else if err != nil || runInfo.Validate() != nil {
 						runInfosLock.Lock()
 						malformedRunInfos = append(malformedRunInfos, node.Key)
 						runInfosLock.Unlock()
@@ -395,12 +499,7 @@ func (db *ETCDDB) GatherAndPruneDesiredLRPs(logger lager.Logger, guids map[strin
 		}
 	}
 
-	throttler, err := workpool.NewThrottler(db.convergenceWorkersSize, works)
-	if err != nil {
-		logger.Error("failed-to-create-throttler", err)
-	}
-
-	throttler.Work()
+	db.convergenceWorkers.Run(works)
 
 	db.batchDeleteNodes(malformedSchedulingInfos, logger)
 	db.batchDeleteNodes(malformedRunInfos, logger)
@@ -414,6 +513,9 @@ func (db *ETCDDB) GatherAndPruneDesiredLRPs(logger lager.Logger, guids map[strin
 	var schedInfosToDelete []string
 	for guid, schedulingInfo := range schedulingInfos {
 		runInfo, ok := runInfos[guid]
+		if _, unreadable := unreadableGuids[guid]; !ok && unreadable {
+			continue
+		}
 		if !ok {
 			err := fmt.Errorf("Missing runInfo for GUID %s", guid)
 			logger.Error("runInfo-not-found-error", err)
@@ -433,7 +535,8 @@ func (db *ETCDDB) GatherAndPruneDesiredLRPs(logger lager.Logger, guids map[strin
 			// existed for longer than desiredLRPCreationTimeout, consider it orphaned
 			// and delete it.
 			_, ok := schedulingInfos[guid]
-			if !ok && db.clock.Since(time.Unix(0, runInfo.CreatedAt)) > db.desiredLRPCreationTimeout {
+			_, unreadable := unreadableGuids[guid]
+			if !ok && !unreadable && db.clock.Since(time.Unix(0, runInfo.CreatedAt)) > db.desiredLRPCreationTimeout {
 				orphanedRunInfosMetric.Add(1)
 				runInfosToDelete = append(runInfosToDelete, DesiredLRPRunInfoSchemaPath(guid))
 			}
@@ -465,12 +568,7 @@ func (db *ETCDDB) batchDeleteNodes(keys []string, logger lager.Logger) {
 		})
 	}
 
-	throttler, err := workpool.NewThrottler(db.convergenceWorkersSize, works)
-	if err != nil {
-		logger.Error("failed-to-create-throttler", err)
-	}
-
-	throttler.Work()
+	db.convergenceWorkers.Run(works)
 	return
 }
 
@@ -514,6 +612,11 @@ func CalculateConvergence(
 			}
 
 			for i, actual := range actualsByIndex {
+				if actual.OrphanedSince != 0 {
+					pLog.Info("no-longer-orphaned", lager.Data{"index": i})
+					changes.ActualLRPsNoLongerOrphaned = append(changes.ActualLRPsNoLongerOrphaned, actual)
+				}
+
 				if actual.CellIsMissing(input.Cells) {
 					pLog.Info("missing-cell", lager.Data{"index": i, "cell_id": actual.CellId})
 					changes.ActualLRPsWithMissingCells = append(changes.ActualLRPsWithMissingCells, actual)
@@ -546,6 +649,17 @@ func CalculateConvergence(
 					continue
 				}
 
+				if input.OrphanGracePeriod > 0 && actual.OrphanedSince == 0 {
+					pLog.Info("orphaned", lager.Data{"index": i})
+					changes.NewlyOrphanedActualLRPs = append(changes.NewlyOrphanedActualLRPs, actual)
+					continue
+				}
+
+				if !actual.ShouldRetireOrphan(now, input.OrphanGracePeriod) {
+					pLog.Debug("orphaned-within-grace-period", lager.Data{"index": i, "orphaned_since": actual.OrphanedSince})
+					continue
+				}
+
 				pLog.Info("no-longer-desired", lager.Data{"index": i})
 				extraLRPCount++
 				changes.ActualLRPsForExtraIndices = append(changes.ActualLRPsForExtraIndices, actual)
@@ -553,12 +667,59 @@ func CalculateConvergence(
 		}
 	}
 
-	missingLRPs.Send(missingLRPCount)
-	extraLRPs.Send(extraLRPCount)
+	if !input.Filter.IsScoped() {
+		missingLRPs.Send(missingLRPCount)
+		extraLRPs.Send(extraLRPCount)
+	}
 
 	return changes
 }
 
+// scopeConvergenceInput restricts a gathered input to the LRPs in the
+// filter's domains. The fresh domains are taken from the full input, so a
+// scoped pass sees the same freshness for its domains as a global pass.
+func scopeConvergenceInput(input *models.ConvergenceInput, filter models.ConvergenceFilter) *models.ConvergenceInput {
+	scoped := &models.ConvergenceInput{
+		AllProcessGuids: map[string]struct{}{},
+		DesiredLRPs:     map[string]*models.DesiredLRP{},
+		ActualLRPs:      map[string]map[int32]*models.ActualLRP{},
+		Domains:         models.DomainSet{},
+		Cells:           input.Cells,
+		Filter:          filter,
+
+		OrphanGracePeriod: input.OrphanGracePeriod,
+	}
+
+	for guid, desired := range input.DesiredLRPs {
+		if filter.IncludesDomain(desired.Domain) {
+			scoped.DesiredLRPs[guid] = desired
+			scoped.AllProcessGuids[guid] = struct{}{}
+		}
+	}
+
+	for guid, actualsByIndex := range input.ActualLRPs {
+		for index, actual := range actualsByIndex {
+			if !filter.IncludesDomain(actual.Domain) {
+				continue
+			}
+
+			if _, ok := scoped.ActualLRPs[guid]; !ok {
+				scoped.ActualLRPs[guid] = map[int32]*models.ActualLRP{}
+			}
+			scoped.ActualLRPs[guid][index] = actual
+			scoped.AllProcessGuids[guid] = struct{}{}
+		}
+	}
+
+	input.Domains.Each(func(domain string) {
+		if filter.IncludesDomain(domain) {
+			scoped.Domains.Add(domain)
+		}
+	})
+
+	return scoped
+}
+
 func (db *ETCDDB) ResolveConvergence(logger lager.Logger, desiredLRPs map[string]*models.DesiredLRP, changes *models.ConvergenceChanges) ([]*auctioneer.LRPStartRequest, []*models.ActualLRPKeyWithSchedulingInfo, []*models.ActualLRPKey) {
 	startRequests := newStartRequests(desiredLRPs)
 	for _, actual := range changes.StaleUnclaimedActualLRPs {
@@ -598,14 +759,17 @@ func (db *ETCDDB) ResolveConvergence(logger lager.Logger, desiredLRPs map[string
 		works = append(works, db.resolveRestartableCrashedActualLRPS(logger, actual, startRequests))
 	}
 
-	throttler, err := workpool.NewThrottler(db.convergenceWorkersSize, works)
-	if err != nil {
-		logger.Error("failed-constructing-throttler", err, lager.Data{"max_workers": db.convergenceWorkersSize, "num_works": len(works)})
-		return nil, nil, nil
+	orphanedSince := db.clock.Now().UnixNano()
+	for _, actual := range changes.NewlyOrphanedActualLRPs {
+		works = append(works, db.setActualLRPOrphanedSince(logger, &actual.ActualLRPKey, orphanedSince))
+	}
+
+	for _, actual := range changes.ActualLRPsNoLongerOrphaned {
+		works = append(works, db.setActualLRPOrphanedSince(logger, &actual.ActualLRPKey, 0))
 	}
 
 	logger.Debug("waiting-for-lrp-convergence-work")
-	throttler.Work()
+	db.convergenceWorkers.Run(works)
 	logger.Debug("done-waiting-for-lrp-convergence-work")
 
 	return startRequests.Slice(), keysWithMissingCells, keysToRetire
@@ -656,6 +820,38 @@ func (db *ETCDDB) resolveRestartableCrashedActualLRPS(logger lager.Logger, actua
 	}
 }
 
+// setActualLRPOrphanedSince records when the actual LRP was first found
+// without a DesiredLRP, or clears it with 0. It does not change the
+// modification tag, since the actual LRP itself has not changed.
+func (db *ETCDDB) setActualLRPOrphanedSince(logger lager.Logger, actualKey *models.ActualLRPKey, orphanedSince int64) func() {
+	return func() {
+		logger := logger.Session("set-orphaned-since", lager.Data{
+			"process_guid":   actualKey.ProcessGuid,
+			"index":          actualKey.Index,
+			"orphaned_since": orphanedSince,
+		})
+
+		lrp, storeIndex, err := db.rawActualLRPByProcessGuidAndIndex(logger, actualKey.ProcessGuid, actualKey.Index)
+		if err != nil {
+			logger.Error("failed-fetching-actual-lrp", err)
+			return
+		}
+
+		lrp.OrphanedSince = orphanedSince
+		data, err := db.serializeModel(logger, lrp)
+		if err != nil {
+			logger.Error("failed-serializing-actual-lrp", err)
+			return
+		}
+
+		_, err = db.client.CompareAndSwap(ActualLRPSchemaPath(actualKey.ProcessGuid, actualKey.Index), data, 0, storeIndex)
+		if err != nil {
+			logger.Error("failed-compare-and-swap", err)
+			return
+		}
+	}
+}
+
 type startRequests struct {
 	desiredMap    map[string]*models.DesiredLRP
 	startMap      map[string]*auctioneer.LRPStartRequest
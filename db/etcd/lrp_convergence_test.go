@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/clock/fakeclock"
@@ -660,6 +661,91 @@ var _ = Describe("LRPConvergence", func() {
 					changesEqual(changes, &models.ConvergenceChanges{})
 				})
 			})
+
+			Context("with an orphan grace period", func() {
+				var orphanedLRP, otherOrphanedLRP *models.ActualLRP
+
+				BeforeEach(func() {
+					input.OrphanGracePeriod = time.Minute
+
+					orphanedLRP = newRunningActualLRP(lrpA, cellA.CellId, 0)
+					otherOrphanedLRP = newRunningActualLRP(lrpA, cellA.CellId, 1)
+					input.ActualLRPs = actualLRPs(orphanedLRP, otherOrphanedLRP)
+				})
+
+				Context("when they have not been marked orphaned", func() {
+					It("marks them orphaned instead of retiring them", func() {
+						output := &models.ConvergenceChanges{
+							NewlyOrphanedActualLRPs: []*models.ActualLRP{orphanedLRP, otherOrphanedLRP},
+						}
+
+						changesEqual(changes, output)
+					})
+
+					It("does not count them as extra", func() {
+						Expect(sender.GetValue("LRPsExtra").Value).To(Equal(float64(0)))
+					})
+				})
+
+				Context("when they have been orphaned for less than the grace period", func() {
+					BeforeEach(func() {
+						orphanedLRP.OrphanedSince = fakeClock.Now().Add(-30 * time.Second).UnixNano()
+						otherOrphanedLRP.OrphanedSince = fakeClock.Now().UnixNano()
+					})
+
+					It("does nothing", func() {
+						changesEqual(changes, &models.ConvergenceChanges{})
+					})
+				})
+
+				Context("when one has been orphaned for longer than the grace period", func() {
+					BeforeEach(func() {
+						orphanedLRP.OrphanedSince = fakeClock.Now().Add(-time.Minute).UnixNano()
+						otherOrphanedLRP.OrphanedSince = fakeClock.Now().UnixNano()
+					})
+
+					It("retires only that one", func() {
+						output := &models.ConvergenceChanges{
+							ActualLRPsForExtraIndices: []*models.ActualLRP{orphanedLRP},
+						}
+
+						changesEqual(changes, output)
+					})
+
+					It("counts only that one as extra", func() {
+						Expect(sender.GetValue("LRPsExtra").Value).To(Equal(float64(1)))
+					})
+				})
+			})
+		})
+
+		Context("orphaned actual LRPs that are desired again", func() {
+			var orphanedLRP *models.ActualLRP
+
+			BeforeEach(func() {
+				orphanedLRP = newStableRunningActualLRP(lrpA, cellA.CellId, 0)
+				orphanedLRP.OrphanedSince = 1000
+
+				input = &models.ConvergenceInput{
+					AllProcessGuids: map[string]struct{}{lrpA.ProcessGuid: struct{}{}},
+					DesiredLRPs:     desiredLRPs(lrpA),
+					ActualLRPs: actualLRPs(
+						orphanedLRP,
+						newStableRunningActualLRP(lrpA, cellA.CellId, 1),
+					),
+					Domains:           models.NewDomainSet([]string{domainA}),
+					Cells:             cellSet(cellA),
+					OrphanGracePeriod: time.Minute,
+				}
+			})
+
+			It("reports them as no longer orphaned", func() {
+				output := &models.ConvergenceChanges{
+					ActualLRPsNoLongerOrphaned: []*models.ActualLRP{orphanedLRP},
+				}
+
+				changesEqual(changes, output)
+			})
 		})
 
 		Context("stable state", func() {
@@ -687,17 +773,50 @@ var _ = Describe("LRPConvergence", func() {
 		})
 	})
 
+	Describe("evacuation metrics", func() {
+		var convergenceLogger *lagertest.TestLogger
+
+		BeforeEach(func() {
+			convergenceLogger = lagertest.NewTestLogger("convergence")
+			netInfo := models.NewActualLRPNetInfo("1.2.3.4", models.NewPortMapping(1234, 5678))
+
+			stuckKey := models.NewActualLRPKey("stuck-guid", 0, "some-domain")
+			_, err := etcdDB.EvacuateActualLRP(logger, &stuckKey, &models.ActualLRPInstanceKey{InstanceGuid: "stuck-instance", CellId: "draining-cell"}, &netInfo, 3600)
+			Expect(err).NotTo(HaveOccurred())
+
+			clock.Increment(StuckEvacuationThreshold + time.Second)
+
+			freshKey := models.NewActualLRPKey("fresh-guid", 0, "some-domain")
+			_, err = etcdDB.EvacuateActualLRP(logger, &freshKey, &models.ActualLRPInstanceKey{InstanceGuid: "fresh-instance", CellId: "draining-cell"}, &netInfo, 3600)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("emits the number of evacuating LRPs and of those stuck evacuating", func() {
+			etcdDB.ConvergeLRPs(convergenceLogger, models.CellSet{}, models.ConvergenceFilter{})
+
+			Expect(sender.GetValue("LRPsEvacuating").Value).To(Equal(float64(2)))
+			Expect(sender.GetValue("LRPsStuckEvacuating").Value).To(Equal(float64(1)))
+		})
+
+		It("logs the LRPs stuck evacuating", func() {
+			etcdDB.ConvergeLRPs(convergenceLogger, models.CellSet{}, models.ConvergenceFilter{})
+
+			Expect(convergenceLogger).To(gbytes.Say(`stuck-evacuating-actual-lrp.*"process_guid":"stuck-guid"`))
+			Expect(convergenceLogger).NotTo(gbytes.Say("fresh-guid"))
+		})
+	})
+
 	Describe("convergence counters", func() {
 		It("bumps the convergence counter", func() {
 			Expect(sender.GetCounter("ConvergenceLRPRuns")).To(Equal(uint64(0)))
-			etcdDB.ConvergeLRPs(logger, models.CellSet{})
+			etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 			Expect(sender.GetCounter("ConvergenceLRPRuns")).To(Equal(uint64(1)))
-			etcdDB.ConvergeLRPs(logger, models.CellSet{})
+			etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 			Expect(sender.GetCounter("ConvergenceLRPRuns")).To(Equal(uint64(2)))
 		})
 
 		It("reports the duration that it took to converge", func() {
-			etcdDB.ConvergeLRPs(logger, models.CellSet{})
+			etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 
 			reportedDuration := sender.GetValue("ConvergenceLRPDuration")
 			Expect(reportedDuration.Unit).To(Equal("nanos"))
@@ -705,6 +824,127 @@ var _ = Describe("LRPConvergence", func() {
 		})
 	})
 
+	Describe("converging scoped to domains", func() {
+		var otherDesiredLRP *models.DesiredLRP
+
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("scoped-process-guid")
+			desiredLRP.Domain = "scoped-domain"
+			etcdHelper.SetRawDesiredLRP(desiredLRP)
+
+			otherDesiredLRP = model_helpers.NewValidDesiredLRP("other-process-guid")
+			otherDesiredLRP.Domain = "other-domain"
+			etcdHelper.SetRawDesiredLRP(otherDesiredLRP)
+		})
+
+		It("only converges the LRPs in those domains", func() {
+			filter := models.ConvergenceFilter{Domains: []string{"other-domain"}}
+			startRequests, _, _ := etcdDB.ConvergeLRPs(logger, models.CellSet{}, filter)
+
+			Expect(startRequests).To(HaveLen(1))
+			Expect(startRequests[0].ProcessGuid).To(Equal(otherDesiredLRP.ProcessGuid))
+
+			_, err := etcdDB.ActualLRPGroupByProcessGuidAndIndex(logger, "scoped-process-guid", 0)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("does not emit the foundation-wide convergence metrics", func() {
+			etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{Domains: []string{"other-domain"}})
+			Expect(sender.GetCounter("ConvergenceLRPRuns")).To(Equal(uint64(0)))
+			Expect(sender.GetValue("LRPsMissing").Unit).To(BeEmpty())
+		})
+	})
+
+	Describe("converging orphaned actual LRPs with a grace period", func() {
+		const gracePeriod = time.Minute
+
+		var (
+			gracefulDB  *etcd.ETCDDB
+			desiredLRP  *models.DesiredLRP
+			orphanedLRP *models.ActualLRP
+			cells       models.CellSet
+
+			keysToRetire []*models.ActualLRPKey
+		)
+
+		BeforeEach(func() {
+			gracefulDB = etcd.NewETCDWithOptions(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, cryptor, storeClient, storeClient, clock, etcd.ETCDDBOptions{OrphanGracePeriod: gracePeriod})
+
+			desiredLRP = model_helpers.NewValidDesiredLRP("orphaned-process-guid")
+			desiredLRP.Instances = 1
+			etcdHelper.SetRawDomain(desiredLRP.Domain)
+
+			orphanedLRP = &models.ActualLRP{
+				ActualLRPKey:         models.NewActualLRPKey(desiredLRP.ProcessGuid, 0, desiredLRP.Domain),
+				ActualLRPInstanceKey: models.NewActualLRPInstanceKey("some-instance-guid", "cell-id"),
+				ActualLRPNetInfo:     models.NewActualLRPNetInfo("1.2.3.4", &models.PortMapping{ContainerPort: 1234, HostPort: 5678}),
+				State:                models.ActualLRPStateRunning,
+				Since:                clock.Now().UnixNano(),
+			}
+			etcdHelper.SetRawActualLRP(orphanedLRP)
+
+			cellPresence := models.NewCellPresence("cell-id", "cell.example.com", "the-zone", models.CellCapacity{MemoryMb: 128, DiskMb: 1024, Containers: 3}, nil, nil, nil, nil)
+			cells = models.CellSet{"cell-id": &cellPresence}
+
+			_, _, keysToRetire = gracefulDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
+		})
+
+		It("marks the orphan instead of retiring it", func() {
+			Expect(keysToRetire).To(BeEmpty())
+
+			actualLRPGroup, err := gracefulDB.ActualLRPGroupByProcessGuidAndIndex(logger, desiredLRP.ProcessGuid, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPGroup.Instance.OrphanedSince).To(Equal(clock.Now().UnixNano()))
+			Expect(actualLRPGroup.Instance.ModificationTag).To(Equal(orphanedLRP.ModificationTag))
+		})
+
+		Context("when the grace period has passed", func() {
+			BeforeEach(func() {
+				clock.Increment(gracePeriod)
+				_, _, keysToRetire = gracefulDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
+			})
+
+			It("retires the orphan", func() {
+				Expect(keysToRetire).To(ConsistOf(&orphanedLRP.ActualLRPKey))
+			})
+		})
+
+		Context("when the desired LRP reappears within the grace period", func() {
+			BeforeEach(func() {
+				etcdHelper.SetRawDesiredLRP(desiredLRP)
+				clock.Increment(gracePeriod)
+				_, _, keysToRetire = gracefulDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
+			})
+
+			It("keeps the actual LRP and clears its orphaned mark", func() {
+				Expect(keysToRetire).To(BeEmpty())
+
+				actualLRPGroup, err := gracefulDB.ActualLRPGroupByProcessGuidAndIndex(logger, desiredLRP.ProcessGuid, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualLRPGroup.Instance.OrphanedSince).To(BeZero())
+			})
+
+			Context("and is removed again", func() {
+				BeforeEach(func() {
+					_, err := storeClient.Delete(etcd.DesiredLRPSchedulingInfoSchemaPath(desiredLRP.ProcessGuid), true)
+					Expect(err).NotTo(HaveOccurred())
+					_, err = storeClient.Delete(etcd.DesiredLRPRunInfoSchemaPath(desiredLRP.ProcessGuid), true)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, keysToRetire = gracefulDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
+				})
+
+				It("gives the orphan a new grace period", func() {
+					Expect(keysToRetire).To(BeEmpty())
+
+					actualLRPGroup, err := gracefulDB.ActualLRPGroupByProcessGuidAndIndex(logger, desiredLRP.ProcessGuid, 0)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(actualLRPGroup.Instance.OrphanedSince).To(Equal(clock.Now().UnixNano()))
+				})
+			})
+		})
+	})
+
 	Describe("converging missing actual LRPs", func() {
 		var (
 			desiredLRP          *models.DesiredLRP
@@ -736,7 +976,7 @@ var _ = Describe("LRPConvergence", func() {
 		})
 
 		JustBeforeEach(func() {
-			lrpStartRequests, _, _ = etcdDB.ConvergeLRPs(logger, cells)
+			lrpStartRequests, _, _ = etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 		})
 
 		Context("when there are no actuals for desired LRP", func() {
@@ -816,7 +1056,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		BeforeEach(func() {
 			etcdHelper.CreateMalformedDesiredLRP(processGuid)
-			etcdDB.ConvergeLRPs(logger, models.CellSet{})
+			etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 		})
 
 		It("logs", func() {
@@ -832,7 +1072,7 @@ var _ = Describe("LRPConvergence", func() {
 
 			etcdHelper.SetRawDesiredLRP(desiredLRP)
 			clock.Increment(10000 * time.Second)
-			etcdDB.ConvergeLRPs(logger, models.CellSet{})
+			etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 		})
 
 		It("deletes the invalid scheduling info and run info", func() {
@@ -853,7 +1093,7 @@ var _ = Describe("LRPConvergence", func() {
 			actualLRP.Since = 0
 			etcdHelper.SetRawActualLRP(actualLRP)
 
-			etcdDB.ConvergeLRPs(logger, models.CellSet{})
+			etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 		})
 
 		It("deletes the invalid scheduling info and run info", func() {
@@ -907,7 +1147,7 @@ var _ = Describe("LRPConvergence", func() {
 		})
 
 		JustBeforeEach(func() {
-			_, keysWithMissingCells, _ = etcdDB.ConvergeLRPs(logger, cells)
+			_, keysWithMissingCells, _ = etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 		})
 
 		Context("when the cell is present", func() {
@@ -972,7 +1212,7 @@ var _ = Describe("LRPConvergence", func() {
 
 			Context("when the actual LRP is UNCLAIMED", func() {
 				It("returns the lrp to be retired", func() {
-					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, models.CellSet{})
+					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 					Expect(keysToRetire).To(ConsistOf(&models.ActualLRPKey{
 						ProcessGuid: processGuid,
 						Index:       index,
@@ -981,7 +1221,7 @@ var _ = Describe("LRPConvergence", func() {
 				})
 
 				It("logs", func() {
-					etcdDB.ConvergeLRPs(logger, models.CellSet{})
+					etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 					Expect(logger.TestSink).To(gbytes.Say("no-longer-desired"))
 				})
 
@@ -991,7 +1231,7 @@ var _ = Describe("LRPConvergence", func() {
 					})
 
 					It("returns no lrp to be retired", func() {
-						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, models.CellSet{})
+						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 						Expect(keysToRetire).To(BeEmpty())
 					})
 				})
@@ -1037,7 +1277,7 @@ var _ = Describe("LRPConvergence", func() {
 					})
 
 					It("returns the lrp to be retired", func() {
-						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 						Expect(keysToRetire).To(ConsistOf(&models.ActualLRPKey{
 							ProcessGuid: processGuid,
 							Index:       index,
@@ -1046,7 +1286,7 @@ var _ = Describe("LRPConvergence", func() {
 					})
 
 					It("logs", func() {
-						etcdDB.ConvergeLRPs(logger, cells)
+						etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 						Expect(logger.TestSink).To(gbytes.Say("no-longer-desired"))
 					})
 
@@ -1056,7 +1296,7 @@ var _ = Describe("LRPConvergence", func() {
 						})
 
 						It("returns no lrps to be retired", func() {
-							_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+							_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 							Expect(keysToRetire).To(BeEmpty())
 						})
 					})
@@ -1064,7 +1304,7 @@ var _ = Describe("LRPConvergence", func() {
 
 				Context("when the cell is missing", func() {
 					It("returns the lrp to be retired", func() {
-						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 						Expect(keysToRetire).To(ConsistOf(&models.ActualLRPKey{
 							ProcessGuid: processGuid,
 							Index:       index,
@@ -1078,7 +1318,7 @@ var _ = Describe("LRPConvergence", func() {
 						})
 
 						It("returns no lrp to be retired", func() {
-							_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+							_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 							Expect(keysToRetire).To(BeEmpty())
 						})
 					})
@@ -1128,7 +1368,7 @@ var _ = Describe("LRPConvergence", func() {
 				})
 
 				It("returns the correct lrps to retire", func() {
-					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 					Expect(keysToRetire).To(ConsistOf(&models.ActualLRPKey{
 						ProcessGuid: processGuid,
 						Index:       index,
@@ -1142,7 +1382,7 @@ var _ = Describe("LRPConvergence", func() {
 					})
 
 					It("returns no lrps to retire", func() {
-						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 						Expect(keysToRetire).To(BeEmpty())
 					})
 				})
@@ -1185,7 +1425,7 @@ var _ = Describe("LRPConvergence", func() {
 				})
 
 				It("returns the lrp to be retired", func() {
-					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, models.CellSet{})
+					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 					Expect(keysToRetire).To(ConsistOf(&models.ActualLRPKey{
 						ProcessGuid: processGuid,
 						Index:       index,
@@ -1199,7 +1439,7 @@ var _ = Describe("LRPConvergence", func() {
 					})
 
 					It("returns no lrp to be retired", func() {
-						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, models.CellSet{})
+						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 						Expect(keysToRetire).To(BeEmpty())
 					})
 				})
@@ -1250,7 +1490,7 @@ var _ = Describe("LRPConvergence", func() {
 				})
 
 				It("returns the lrp to be retired", func() {
-					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 					Expect(keysToRetire).To(ConsistOf(&models.ActualLRPKey{
 						ProcessGuid: processGuid,
 						Index:       index,
@@ -1264,7 +1504,7 @@ var _ = Describe("LRPConvergence", func() {
 					})
 
 					It("returns no lrp to be retired", func() {
-						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 						Expect(keysToRetire).To(BeEmpty())
 					})
 				})
@@ -1325,7 +1565,7 @@ var _ = Describe("LRPConvergence", func() {
 				})
 
 				It("sends a stop request to the corresponding cell", func() {
-					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+					_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 					Expect(keysToRetire).To(ConsistOf(&models.ActualLRPKey{
 						ProcessGuid: processGuid,
 						Index:       index,
@@ -1339,7 +1579,7 @@ var _ = Describe("LRPConvergence", func() {
 					})
 
 					It("does not stop the actual LRP", func() {
-						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells)
+						_, _, keysToRetire := etcdDB.ConvergeLRPs(logger, cells, models.ConvergenceFilter{})
 						Expect(keysToRetire).To(HaveLen(0))
 					})
 				})
@@ -1367,12 +1607,12 @@ var _ = Describe("LRPConvergence", func() {
 		})
 
 		It("logs", func() {
-			etcdDB.ConvergeLRPs(logger, models.CellSet{})
+			etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 			Expect(logger.TestSink).To(gbytes.Say("adding-start-auction"))
 		})
 
 		It("re-returns start auction requests", func() {
-			startRequests, _, _ := etcdDB.ConvergeLRPs(logger, models.CellSet{})
+			startRequests, _, _ := etcdDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 			Expect(startRequests).To(HaveLen(1))
 
 			startAuction := startRequests[0]
@@ -1807,6 +2047,8 @@ func changesEqual(actual *models.ConvergenceChanges, expected *models.Convergenc
 	Expect(actual.ActualLRPKeysForMissingIndices).To(ConsistOf(expected.ActualLRPKeysForMissingIndices))
 	Expect(actual.RestartableCrashedActualLRPs).To(ConsistOf(expected.RestartableCrashedActualLRPs))
 	Expect(actual.StaleUnclaimedActualLRPs).To(ConsistOf(expected.StaleUnclaimedActualLRPs))
+	Expect(actual.NewlyOrphanedActualLRPs).To(ConsistOf(expected.NewlyOrphanedActualLRPs))
+	Expect(actual.ActualLRPsNoLongerOrphaned).To(ConsistOf(expected.ActualLRPsNoLongerOrphaned))
 }
 
 func cellSet(cells ...*models.CellPresence) models.CellSet {
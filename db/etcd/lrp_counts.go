@@ -0,0 +1,35 @@
+package etcd
+
+import (
+	"sync"
+
+	bbsdb "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/lager"
+)
+
+// lrpCounts holds the per-domain counts of the last LRP convergence, shared
+// by the copies of an ETCDDB that read from other clients.
+type lrpCounts struct {
+	lock     sync.Mutex
+	byDomain map[string]bbsdb.DomainLRPCounts
+}
+
+// LRPCountsByDomain returns the counts tallied by the last LRP convergence
+// that gathered every LRP, rather than reading the LRPs again. It returns no
+// counts until such a convergence has run.
+func (db *ETCDDB) LRPCountsByDomain(logger lager.Logger) (map[string]bbsdb.DomainLRPCounts, error) {
+	db.lrpCounts.lock.Lock()
+	defer db.lrpCounts.lock.Unlock()
+
+	counts := make(map[string]bbsdb.DomainLRPCounts, len(db.lrpCounts.byDomain))
+	for domain, domainCounts := range db.lrpCounts.byDomain {
+		counts[domain] = domainCounts
+	}
+	return counts, nil
+}
+
+func (db *ETCDDB) setLRPCountsByDomain(counts map[string]bbsdb.DomainLRPCounts) {
+	db.lrpCounts.lock.Lock()
+	db.lrpCounts.byDomain = counts
+	db.lrpCounts.lock.Unlock()
+}
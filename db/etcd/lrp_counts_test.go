@@ -0,0 +1,79 @@
+package etcd_test
+
+import (
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LRPCountsByDomain", func() {
+	Context("when there are LRPs in several domains", func() {
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("guid-1")
+			desiredLRP.Domain = "domain-1"
+			desiredLRP.Instances = 3
+			etcdHelper.SetRawDesiredLRP(desiredLRP)
+
+			desiredLRP = model_helpers.NewValidDesiredLRP("guid-2")
+			desiredLRP.Domain = "domain-1"
+			desiredLRP.Instances = 2
+			etcdHelper.SetRawDesiredLRP(desiredLRP)
+
+			desiredLRP = model_helpers.NewValidDesiredLRP("guid-3")
+			desiredLRP.Domain = "domain-2"
+			desiredLRP.Instances = 1
+			etcdHelper.SetRawDesiredLRP(desiredLRP)
+
+			running := model_helpers.NewValidActualLRP("guid-1", 0)
+			running.Domain = "domain-1"
+			etcdHelper.SetRawActualLRP(running)
+
+			claimed := model_helpers.NewValidActualLRP("guid-1", 1)
+			claimed.Domain = "domain-1"
+			claimed.State = models.ActualLRPStateClaimed
+			claimed.ActualLRPNetInfo = models.ActualLRPNetInfo{}
+			etcdHelper.SetRawActualLRP(claimed)
+
+			evacuating := model_helpers.NewValidActualLRP("guid-3", 0)
+			evacuating.Domain = "domain-2"
+			etcdHelper.SetRawEvacuatingActualLRP(evacuating, 100)
+
+			orphaned := model_helpers.NewValidActualLRP("guid-4", 0)
+			orphaned.Domain = "domain-3"
+			etcdHelper.SetRawActualLRP(orphaned)
+		})
+
+		It("reports no counts until convergence has gathered the LRPs", func() {
+			counts, err := etcdDB.LRPCountsByDomain(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(BeEmpty())
+		})
+
+		It("reports the desired and running instances of each domain counted by convergence", func() {
+			_, err := etcdDB.GatherAndPruneLRPs(logger, models.NewCellSet())
+			Expect(err).NotTo(HaveOccurred())
+
+			counts, err := etcdDB.LRPCountsByDomain(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(Equal(map[string]db.DomainLRPCounts{
+				"domain-1": {DesiredInstances: 5, RunningInstances: 1},
+				"domain-2": {DesiredInstances: 1, RunningInstances: 0},
+				"domain-3": {DesiredInstances: 0, RunningInstances: 1},
+			}))
+		})
+	})
+
+	Context("when there are no LRPs", func() {
+		It("returns no counts", func() {
+			_, err := etcdDB.GatherAndPruneLRPs(logger, models.NewCellSet())
+			Expect(err).NotTo(HaveOccurred())
+
+			counts, err := etcdDB.LRPCountsByDomain(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,97 @@
+package etcd
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	etcdclient "github.com/coreos/go-etcd/etcd"
+)
+
+// QuarantineSchemaRoot holds the nodes that were moved aside because their
+// encryption key was missing, under the same path they had under
+// V1SchemaRoot. It is outside of V1SchemaRoot so that neither the reads nor
+// the key rotation come across them.
+const QuarantineSchemaRoot = "/quarantine"
+
+func QuarantineSchemaPath(key string) string {
+	return QuarantineSchemaRoot + "/" + strings.TrimPrefix(key, V1SchemaRoot)
+}
+
+// errMissingEncryptionKey fails the reads of a node whose encryption key is
+// missing. Unlike the other deserialization errors, it never gets the node
+// deleted.
+var errMissingEncryptionKey = models.NewError(models.Error_InvalidRecord, "the record was encrypted with a key that is missing")
+
+// errRecordSkipped leaves a node whose encryption key is missing out of the
+// reads: the bulk reads pass over it, and the reads of the node alone do not
+// find it.
+var errRecordSkipped = models.NewError(models.Error_ResourceNotFound, "the record was encrypted with a key that is missing")
+
+func isMissingKeyError(err error) bool {
+	return err == errMissingEncryptionKey || err == errRecordSkipped
+}
+
+// isUnreadableRecordError reports whether err fails the read of a single node,
+// malformed or encrypted with a missing key, which the bulk reads with partial
+// results leave out instead of failing.
+func isUnreadableRecordError(err error) bool {
+	bbsErr := models.ConvertError(err)
+	return bbsErr != nil && bbsErr.Type == models.Error_InvalidRecord
+}
+
+// compactRecordErrors drops the empty slots of the record errors collected by
+// node index across a parallel deserialization.
+func compactRecordErrors(unreadable []*models.RecordError) []*models.RecordError {
+	var recordErrors []*models.RecordError
+	for _, recordError := range unreadable {
+		if recordError != nil {
+			recordErrors = append(recordErrors, recordError)
+		}
+	}
+	return recordErrors
+}
+
+// handleMissingKey applies the missing key policy to a node that could not be
+// decrypted. A quarantined node is no longer there, so it is then skipped.
+// When it cannot be moved, it fails the read instead.
+func (db *ETCDDB) handleMissingKey(logger lager.Logger, node *etcdclient.Node) error {
+	logger = logger.WithData(lager.Data{"key": node.Key, "policy": db.missingKeyPolicy})
+
+	switch db.missingKeyPolicy {
+	case format.MissingKeySkip:
+		logger.Info("skipping-record-with-missing-key")
+		db.missingKeyPolicy.CountRecord()
+		return errRecordSkipped
+
+	case format.MissingKeyQuarantine:
+		err := db.quarantineNode(logger, node)
+		if err != nil {
+			logger.Error("failed-quarantining-record", err)
+			return errMissingEncryptionKey
+		}
+		logger.Info("quarantined-record-with-missing-key", lager.Data{"quarantine_key": QuarantineSchemaPath(node.Key)})
+		db.missingKeyPolicy.CountRecord()
+		return errRecordSkipped
+
+	default:
+		return errMissingEncryptionKey
+	}
+}
+
+// quarantineNode copies the node before deleting it, and only deletes it if it
+// was not written since it was read, so that a node is never lost.
+func (db *ETCDDB) quarantineNode(logger lager.Logger, node *etcdclient.Node) error {
+	_, err := db.client.Set(QuarantineSchemaPath(node.Key), []byte(node.Value), NO_TTL)
+	if err != nil {
+		return ErrorFromEtcdError(logger, err)
+	}
+
+	_, err = db.client.CompareAndDelete(node.Key, node.ModifiedIndex)
+	if err != nil {
+		return ErrorFromEtcdError(logger, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package etcd_test
+
+import (
+	"crypto/rand"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/etcd/test/etcd_helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Records whose encryption key is missing", func() {
+	var (
+		policyDB      *etcd.ETCDDB
+		policy        format.MissingKeyPolicy
+		metricSender  *fake.FakeMetricSender
+		unreadableKey string
+	)
+
+	BeforeEach(func() {
+		metricSender = fake.NewFakeMetricSender()
+		dropsonde_metrics.Initialize(metricSender, nil)
+
+		removedKey, err := encryption.NewKey("removed-label", "removed passphrase")
+		Expect(err).NotTo(HaveOccurred())
+		keyManager, err := encryption.NewKeyManager(removedKey, nil)
+		Expect(err).NotTo(HaveOccurred())
+		removedKeyHelper := etcd_helpers.NewETCDHelper(format.ENCRYPTED_PROTO, encryption.NewCryptor(keyManager, rand.Reader), storeClient, clock)
+
+		etcdHelper.SetRawTask(model_helpers.NewValidTask("readable-task"))
+		removedKeyHelper.SetRawTask(model_helpers.NewValidTask("unreadable-task"))
+		unreadableKey = etcd.TaskSchemaPathByGuid("unreadable-task")
+	})
+
+	JustBeforeEach(func() {
+		policyDB = etcd.NewETCDWithOptions(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, cryptor, storeClient, storeClient, clock, etcd.ETCDDBOptions{MissingKeyPolicy: policy})
+	})
+
+	Context("with the fail policy", func() {
+		BeforeEach(func() {
+			policy = format.MissingKeyFail
+		})
+
+		It("fails the bulk read", func() {
+			_, err := policyDB.Tasks(logger, models.TaskFilter{})
+			Expect(err).To(HaveOccurred())
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidRecord))
+		})
+
+		It("fails the read of the record", func() {
+			_, err := policyDB.TaskByGuid(logger, "unreadable-task")
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidRecord))
+		})
+
+		It("keeps the record, even through convergence", func() {
+			policyDB.Tasks(logger, models.TaskFilter{})
+			policyDB.ConvergeTasks(logger, models.CellSet{}, time.Minute, time.Minute, time.Minute, 3)
+
+			_, err := storeClient.Get(unreadableKey, false, false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("with the skip policy", func() {
+		BeforeEach(func() {
+			policy = format.MissingKeySkip
+		})
+
+		It("leaves the record out of the bulk read", func() {
+			tasks, err := policyDB.Tasks(logger, models.TaskFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(HaveLen(1))
+			Expect(tasks[0].TaskGuid).To(Equal("readable-task"))
+		})
+
+		It("does not find the record", func() {
+			_, err := policyDB.TaskByGuid(logger, "unreadable-task")
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_ResourceNotFound))
+		})
+
+		It("keeps the record and counts it", func() {
+			policyDB.Tasks(logger, models.TaskFilter{})
+
+			_, err := storeClient.Get(unreadableKey, false, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metricSender.GetCounter("MissingKeyRecordsSkipped")).To(BeEquivalentTo(1))
+		})
+	})
+
+	Context("with the quarantine policy", func() {
+		BeforeEach(func() {
+			policy = format.MissingKeyQuarantine
+		})
+
+		It("leaves the record out of the bulk read", func() {
+			tasks, err := policyDB.Tasks(logger, models.TaskFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(HaveLen(1))
+			Expect(tasks[0].TaskGuid).To(Equal("readable-task"))
+		})
+
+		It("moves the node under the quarantine root, as it was", func() {
+			stored, err := storeClient.Get(unreadableKey, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			policyDB.Tasks(logger, models.TaskFilter{})
+
+			_, err = storeClient.Get(unreadableKey, false, false)
+			Expect(err).To(HaveOccurred())
+
+			quarantined, err := storeClient.Get(etcd.QuarantineSchemaPath(unreadableKey), false, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quarantined.Node.Value).To(Equal(stored.Node.Value))
+			Expect(metricSender.GetCounter("MissingKeyRecordsQuarantined")).To(BeEquivalentTo(1))
+		})
+
+		It("counts the record in the total without moving it", func() {
+			counts, err := policyDB.ObjectCounts(logger, true, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts.Tasks.Total).To(BeEquivalentTo(2))
+			Expect(counts.Tasks.ByState).To(Equal(map[string]int32{models.Task_Pending.String(): 1}))
+
+			_, err = storeClient.Get(unreadableKey, false, false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,132 @@
+package etcd
+
+import (
+	"path"
+
+	bbsdb "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+// ObjectCounts counts each kind of object from a single read of its
+// directory, so that the counts of one kind are consistent with each other,
+// though the kinds may be read at slightly different times. The totals are the
+// number of child nodes; records are only decoded when the counts are
+// grouped, and a record that cannot be decoded is counted in the total but in
+// no group.
+func (db *ETCDDB) ObjectCounts(logger lager.Logger, byDomain, byState bool) (*bbsdb.ObjectCounts, error) {
+	logger = logger.Session("object-counts", lager.Data{"by_domain": byDomain, "by_state": byState})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	counts := &bbsdb.ObjectCounts{
+		DesiredLRPs: models.NewObjectCount(byDomain, false),
+		ActualLRPs:  models.NewObjectCount(byDomain, byState),
+		Tasks:       models.NewObjectCount(byDomain, byState),
+	}
+
+	root, err := db.fetchCountedRoot(logger, DesiredLRPSchedulingInfoSchemaRoot, false)
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrps", err)
+		return nil, err
+	}
+	counts.DesiredLRPs.Total = int32(len(root.Nodes))
+	if byDomain {
+		for _, node := range root.Nodes {
+			schedulingInfo, ok := db.decodeCountedSchedulingInfo(logger, node)
+			if ok {
+				counts.DesiredLRPs.ByDomain[schedulingInfo.Domain]++
+			}
+		}
+	}
+
+	// the instances are nested under their process guid and index, so only
+	// their directory can be read recursively
+	root, err = db.fetchCountedRoot(logger, ActualLRPSchemaRoot, true)
+	if err != nil {
+		logger.Error("failed-fetching-actual-lrps", err)
+		return nil, err
+	}
+	for _, processNode := range root.Nodes {
+		for _, indexNode := range processNode.Nodes {
+			for _, node := range indexNode.Nodes {
+				if !isInstanceActualLRPNode(node) {
+					continue
+				}
+				counts.ActualLRPs.Total++
+				if !byDomain && !byState {
+					continue
+				}
+				var lrp models.ActualLRP
+				if db.decodeCounted(logger, node, &lrp) {
+					countGroups(counts.ActualLRPs, lrp.Domain, lrp.State)
+				}
+			}
+		}
+	}
+
+	root, err = db.fetchCountedRoot(logger, TaskSchemaRoot, false)
+	if err != nil {
+		logger.Error("failed-fetching-tasks", err)
+		return nil, err
+	}
+	counts.Tasks.Total = int32(len(root.Nodes))
+	if byDomain || byState {
+		for _, node := range root.Nodes {
+			var task models.Task
+			if db.decodeCounted(logger, node, &task) {
+				countGroups(counts.Tasks, task.Domain, task.State.String())
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// fetchCountedRoot reads the directory at key, treating a directory that was
+// never created as an empty one.
+func (db *ETCDDB) fetchCountedRoot(logger lager.Logger, key string, recursive bool) (*etcd.Node, error) {
+	response, err := db.listingClient.Get(key, false, recursive)
+	if err != nil {
+		err = ErrorFromEtcdError(logger, err)
+		if models.ConvertError(err).Type == models.Error_ResourceNotFound {
+			return &etcd.Node{}, nil
+		}
+		return nil, err
+	}
+	return response.Node, nil
+}
+
+// decodeCountedSchedulingInfo decodes the scheduling info of a DesiredLRP,
+// unless it is already cached at the node's index.
+func (db *ETCDDB) decodeCountedSchedulingInfo(logger lager.Logger, node *etcd.Node) (*models.DesiredLRPSchedulingInfo, bool) {
+	if schedulingInfo, ok := db.schedulingInfoCache.get(path.Base(node.Key), node.ModifiedIndex); ok {
+		return schedulingInfo, true
+	}
+
+	schedulingInfo := new(models.DesiredLRPSchedulingInfo)
+	return schedulingInfo, db.decodeCounted(logger, node, schedulingInfo)
+}
+
+// decodeCounted decodes a record to group its count. Unlike deserializeModel
+// it leaves a record whose encryption key is missing where it is, whatever the
+// missing key policy, since counting must not write.
+func (db *ETCDDB) decodeCounted(logger lager.Logger, node *etcd.Node, model format.Versioner) bool {
+	err := db.serializer.Unmarshal(logger, []byte(node.Value), model)
+	if err != nil {
+		logger.Debug("skipped-counting-undecodable-record", lager.Data{"key": node.Key, "error": err.Error()})
+		return false
+	}
+	return true
+}
+
+func countGroups(count *models.ObjectCount, domain, state string) {
+	if count.ByDomain != nil {
+		count.ByDomain[domain]++
+	}
+	if count.ByState != nil {
+		count.ByState[state]++
+	}
+}
@@ -0,0 +1,145 @@
+package etcd_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObjectCounts", func() {
+	Context("when there are objects in several domains and states", func() {
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("guid-1")
+			desiredLRP.Domain = "domain-1"
+			etcdHelper.SetRawDesiredLRP(desiredLRP)
+
+			desiredLRP = model_helpers.NewValidDesiredLRP("guid-2")
+			desiredLRP.Domain = "domain-2"
+			etcdHelper.SetRawDesiredLRP(desiredLRP)
+
+			running := model_helpers.NewValidActualLRP("guid-1", 0)
+			running.Domain = "domain-1"
+			etcdHelper.SetRawActualLRP(running)
+
+			claimed := model_helpers.NewValidActualLRP("guid-1", 1)
+			claimed.Domain = "domain-1"
+			claimed.State = models.ActualLRPStateClaimed
+			claimed.ActualLRPNetInfo = models.ActualLRPNetInfo{}
+			etcdHelper.SetRawActualLRP(claimed)
+
+			evacuating := model_helpers.NewValidActualLRP("guid-2", 0)
+			evacuating.Domain = "domain-2"
+			etcdHelper.SetRawEvacuatingActualLRP(evacuating, 100)
+
+			task := model_helpers.NewValidTask("task-1")
+			task.Domain = "domain-1"
+			etcdHelper.SetRawTask(task)
+
+			task = model_helpers.NewValidTask("task-2")
+			task.Domain = "domain-1"
+			task.State = models.Task_Running
+			etcdHelper.SetRawTask(task)
+
+			task = model_helpers.NewValidTask("task-3")
+			task.Domain = "domain-2"
+			etcdHelper.SetRawTask(task)
+		})
+
+		It("counts every object, leaving out evacuating instances", func() {
+			counts, err := etcdDB.ObjectCounts(logger, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{Total: 2}))
+			Expect(counts.ActualLRPs).To(Equal(&models.ObjectCount{Total: 2}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{Total: 3}))
+		})
+
+		It("groups the counts by domain", func() {
+			counts, err := etcdDB.ObjectCounts(logger, true, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{
+				Total:    2,
+				ByDomain: map[string]int32{"domain-1": 1, "domain-2": 1},
+			}))
+			Expect(counts.ActualLRPs).To(Equal(&models.ObjectCount{
+				Total:    2,
+				ByDomain: map[string]int32{"domain-1": 2},
+			}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{
+				Total:    3,
+				ByDomain: map[string]int32{"domain-1": 2, "domain-2": 1},
+			}))
+		})
+
+		It("groups the counts by state", func() {
+			counts, err := etcdDB.ObjectCounts(logger, false, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{Total: 2}))
+			Expect(counts.ActualLRPs).To(Equal(&models.ObjectCount{
+				Total: 2,
+				ByState: map[string]int32{
+					models.ActualLRPStateRunning: 1,
+					models.ActualLRPStateClaimed: 1,
+				},
+			}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{
+				Total: 3,
+				ByState: map[string]int32{
+					models.Task_Pending.String(): 2,
+					models.Task_Running.String(): 1,
+				},
+			}))
+		})
+	})
+
+	Context("when records cannot be decoded", func() {
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("guid-1")
+			desiredLRP.Domain = "domain-1"
+			etcdHelper.SetRawDesiredLRP(desiredLRP)
+			etcdHelper.CreateMalformedDesiredLRP("malformed-guid")
+
+			task := model_helpers.NewValidTask("task-1")
+			task.Domain = "domain-1"
+			etcdHelper.SetRawTask(task)
+			etcdHelper.CreateMalformedTask("malformed-task")
+		})
+
+		It("counts them in the totals without decoding them", func() {
+			counts, err := etcdDB.ObjectCounts(logger, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{Total: 2}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{Total: 2}))
+		})
+
+		It("leaves them out of the groups", func() {
+			counts, err := etcdDB.ObjectCounts(logger, true, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{
+				Total:    2,
+				ByDomain: map[string]int32{"domain-1": 1},
+			}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{
+				Total:    2,
+				ByDomain: map[string]int32{"domain-1": 1},
+			}))
+		})
+	})
+
+	Context("when there are no objects", func() {
+		It("returns zero counts with empty groups", func() {
+			counts, err := etcdDB.ObjectCounts(logger, true, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{ByDomain: map[string]int32{}}))
+			Expect(counts.ActualLRPs).To(Equal(&models.ObjectCount{ByDomain: map[string]int32{}, ByState: map[string]int32{}}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{ByDomain: map[string]int32{}, ByState: map[string]int32{}}))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+package etcd
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+)
+
+// schedulingInfoCache holds decrypted scheduling infos so that bulk reads do
+// not decrypt records that have not changed since the last read. An entry is
+// keyed by the process guid and the etcd modified index of the node it was
+// decrypted from; any write to the node changes the index, so a cached entry
+// is never served for a newer record. Entries also expire after a jittered
+// TTL, so that records are decrypted again now and then and entries for
+// removed process guids do not linger, and are dropped on the writes this BBS
+// makes. Like DesiredLRP.Copy, the copies it returns are shallow, so callers
+// must not modify the maps and slices they refer to.
+//
+// A nil *schedulingInfoCache is a disabled cache.
+type schedulingInfoCache struct {
+	ttl     time.Duration
+	size    int
+	clock   clock.Clock
+	lock    sync.Mutex
+	random  *rand.Rand
+	entries map[string]schedulingInfoCacheEntry
+}
+
+type schedulingInfoCacheEntry struct {
+	modifiedIndex  uint64
+	expiresAt      time.Time
+	schedulingInfo models.DesiredLRPSchedulingInfo
+}
+
+// newSchedulingInfoCache returns nil, a disabled cache, unless both the TTL and
+// the size are positive.
+func newSchedulingInfoCache(ttl time.Duration, size int, clock clock.Clock) *schedulingInfoCache {
+	if ttl <= 0 || size <= 0 {
+		return nil
+	}
+
+	return &schedulingInfoCache{
+		ttl:     ttl,
+		size:    size,
+		clock:   clock,
+		random:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		entries: make(map[string]schedulingInfoCacheEntry, size),
+	}
+}
+
+// get returns a copy of the cached scheduling info of the process guid if it
+// was decrypted from the node at the modified index and has not expired.
+func (c *schedulingInfoCache) get(processGuid string, modifiedIndex uint64) (*models.DesiredLRPSchedulingInfo, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[processGuid]
+	if !ok {
+		return nil, false
+	}
+	if entry.modifiedIndex != modifiedIndex || !c.clock.Now().Before(entry.expiresAt) {
+		delete(c.entries, processGuid)
+		return nil, false
+	}
+
+	schedulingInfo := entry.schedulingInfo
+	return &schedulingInfo, true
+}
+
+// put caches a copy of the scheduling info of the process guid decrypted from
+// the node at the modified index. It expires between three quarters of the
+// TTL and the TTL, so that entries cached by the same bulk read do not all
+// expire together. When the cache is full, an arbitrary entry makes room for
+// it.
+func (c *schedulingInfoCache) put(processGuid string, modifiedIndex uint64, schedulingInfo *models.DesiredLRPSchedulingInfo) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.entries[processGuid]; !ok && len(c.entries) >= c.size {
+		c.evict()
+	}
+
+	jitter := time.Duration(c.random.Int63n(int64(c.ttl)/4 + 1))
+	c.entries[processGuid] = schedulingInfoCacheEntry{
+		modifiedIndex:  modifiedIndex,
+		expiresAt:      c.clock.Now().Add(c.ttl - jitter),
+		schedulingInfo: *schedulingInfo,
+	}
+}
+
+// invalidate drops the cached scheduling info of the process guid.
+func (c *schedulingInfoCache) invalidate(processGuid string) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, processGuid)
+}
+
+// evict drops an arbitrary entry. Expired entries are dropped when they are
+// next looked up.
+func (c *schedulingInfoCache) evict() {
+	for processGuid := range c.entries {
+		delete(c.entries, processGuid)
+		return
+	}
+}
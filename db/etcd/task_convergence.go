@@ -4,10 +4,10 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/runtimeschema/metric"
-	"code.cloudfoundry.org/workpool"
 )
 
 const (
@@ -32,6 +32,7 @@ func (db *ETCDDB) ConvergeTasks(
 	logger lager.Logger,
 	cellSet models.CellSet,
 	kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration,
+	maxTaskRejections int,
 ) ([]*auctioneer.TaskStartRequest, []*models.Task) {
 	logger.Info("starting-convergence")
 	defer logger.Info("finished-convergence")
@@ -47,8 +48,13 @@ func (db *ETCDDB) ConvergeTasks(
 		}
 	}()
 
+	convergenceTrace := bbsdb.StartConvergenceTrace("converge-tasks")
+	defer convergenceTrace.End()
+
 	logger.Debug("listing-tasks")
+	phase := convergenceTrace.Phase("list-tasks")
 	taskState, modelErr := db.fetchRecursiveRaw(logger, TaskSchemaRoot)
+	phase.End()
 	if modelErr != nil {
 		logger.Debug("failed-listing-task")
 		sendTaskMetrics(logger, -1, -1, -1, -1)
@@ -90,9 +96,13 @@ func (db *ETCDDB) ConvergeTasks(
 	resolvingCount := 0
 
 	logger.Debug("determining-convergence-work", lager.Data{"num_tasks": len(taskState.Nodes)})
+	phase = convergenceTrace.Phase("determine-convergence-work")
 	for _, node := range taskState.Nodes {
 		task := new(models.Task)
 		err := db.deserializeModel(logger, node, task)
+		if isMissingKeyError(err) {
+			continue
+		}
 		if err != nil || task.Validate() != nil {
 			logger.Error("found-invalid-task", err, lager.Data{
 				"key":   node.Key,
@@ -108,10 +118,16 @@ func (db *ETCDDB) ConvergeTasks(
 		switch task.State {
 		case models.Task_Pending:
 			pendingCount++
-			shouldMarkAsFailed := db.durationSinceTaskCreated(task) >= expirePendingTaskDuration
+			shouldMarkAsFailed := expirePendingTaskDuration > 0 && db.durationSinceTaskCreated(task) >= expirePendingTaskDuration
+			rejectedTooOften := maxTaskRejections > 0 && task.RejectionCount >= int32(maxTaskRejections)
 			if shouldMarkAsFailed {
 				logError(task, "failed-to-start-in-time")
-				db.markTaskFailed(task, "not started within time limit")
+				db.markTaskFailed(task, models.ExpiredPendingTaskFailureReason)
+				scheduleForCASByIndex(node.ModifiedIndex, task)
+				tasksKicked++
+			} else if rejectedTooOften {
+				logError(task, "rejected-too-many-times")
+				db.markTaskFailed(task, models.RejectedTaskFailureReason(task.RejectionCount, task.RejectionReason))
 				scheduleForCASByIndex(node.ModifiedIndex, task)
 				tasksKicked++
 			} else if shouldKickTask {
@@ -155,6 +171,7 @@ func (db *ETCDDB) ConvergeTasks(
 			}
 		}
 	}
+	phase.End()
 	logger.Debug("done-determining-convergence-work", lager.Data{
 		"num_tasks_to_auction":  len(tasksToAuction),
 		"num_tasks_to_cas":      len(tasksToCAS),
@@ -166,7 +183,9 @@ func (db *ETCDDB) ConvergeTasks(
 
 	tasksKickedCounter.Add(tasksKicked)
 	logger.Debug("compare-and-swapping-tasks", lager.Data{"num_tasks_to_cas": len(tasksToCAS)})
+	phase = convergenceTrace.Phase("compare-and-swap-tasks")
 	err := db.batchCompareAndSwapTasks(tasksToCAS, logger)
+	phase.End()
 	if err != nil {
 		return nil, nil
 	}
@@ -174,7 +193,9 @@ func (db *ETCDDB) ConvergeTasks(
 
 	tasksPrunedCounter.Add(uint64(len(keysToDelete)))
 	logger.Debug("deleting-keys", lager.Data{"num_keys_to_delete": len(keysToDelete)})
+	phase = convergenceTrace.Phase("delete-tasks")
 	db.batchDeleteTasks(keysToDelete, logger)
+	phase.End()
 	logger.Debug("done-deleting-keys", lager.Data{"num_keys_to_delete": len(keysToDelete)})
 
 	return tasksToAuction, tasksToComplete
@@ -233,12 +254,7 @@ func (db *ETCDDB) batchCompareAndSwapTasks(tasksToCAS []compareAndSwappableTask,
 		})
 	}
 
-	throttler, err := workpool.NewThrottler(db.convergenceWorkersSize, works)
-	if err != nil {
-		return err
-	}
-
-	throttler.Work()
+	db.convergenceWorkers.Run(works)
 	return nil
 }
 
@@ -261,12 +277,7 @@ func (db *ETCDDB) batchDeleteTasks(taskGuids []string, logger lager.Logger) {
 		})
 	}
 
-	throttler, err := workpool.NewThrottler(db.convergenceWorkersSize, works)
-	if err != nil {
-		logger.Error("failed-to-create-throttler", err)
-	}
-
-	throttler.Work()
+	db.convergenceWorkers.Run(works)
 	return
 }
 
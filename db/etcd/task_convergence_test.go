@@ -45,14 +45,17 @@ var _ = Describe("Convergence of Tasks", func() {
 			tasksToAuction  []*auctioneer.TaskStartRequest
 			tasksToComplete []*models.Task
 			cells           models.CellSet
+
+			maxTaskRejections int
 		)
 
 		BeforeEach(func() {
 			cells = models.CellSet{}
+			maxTaskRejections = 0
 		})
 
 		JustBeforeEach(func() {
-			tasksToAuction, tasksToComplete = etcdDB.ConvergeTasks(logger, cells, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+			tasksToAuction, tasksToComplete = etcdDB.ConvergeTasks(logger, cells, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections)
 		})
 
 		It("bumps the convergence counter", func() {
@@ -164,15 +167,66 @@ var _ = Describe("Convergence of Tasks", func() {
 				It("bumps the compare-and-swap counter", func() {
 					Expect(sender.GetCounter("ConvergenceTasksKicked")).To(Equal(uint64(2)))
 				})
+
+				Context("when pending tasks never expire", func() {
+					BeforeEach(func() {
+						expirePendingTaskDuration = 0
+					})
+
+					It("leaves the Tasks pending and returns them to be auctioned", func() {
+						returnedTask, err := etcdDB.TaskByGuid(logger, taskGuid)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(returnedTask.State).To(Equal(models.Task_Pending))
+						Expect(returnedTask.Failed).To(BeFalse())
+
+						Expect(tasksToAuction).To(HaveLen(2))
+					})
+				})
+			})
+
+			Context("when a Task was rejected", func() {
+				BeforeEach(func() {
+					_, _, err := etcdDB.RejectTask(logger, taskGuid, "insufficient resources")
+					Expect(err).NotTo(HaveOccurred())
+					_, _, err = etcdDB.RejectTask(logger, taskGuid, "found no compatible cell")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("leaves it pending when there is no retry budget", func() {
+					returnedTask, err := etcdDB.TaskByGuid(logger, taskGuid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(returnedTask.State).To(Equal(models.Task_Pending))
+				})
+
+				Context("when the retry budget is spent", func() {
+					BeforeEach(func() {
+						maxTaskRejections = 2
+					})
+
+					It("should mark the Task as completed & failed with the last rejection reason", func() {
+						returnedTask, err := etcdDB.TaskByGuid(logger, taskGuid)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(returnedTask.State).To(Equal(models.Task_Completed))
+
+						Expect(returnedTask.Failed).To(Equal(true))
+						Expect(returnedTask.FailureReason).To(Equal("task was rejected 2 times, last because: found no compatible cell"))
+					})
+
+					It("leaves the other Task pending", func() {
+						returnedTask, err := etcdDB.TaskByGuid(logger, taskGuid2)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(returnedTask.State).To(Equal(models.Task_Pending))
+					})
+				})
 			})
 		})
 
 		Context("when a Task is running", func() {
 			BeforeEach(func() {
-				err := etcdDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, "cell-id")
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, "cell-id")
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -224,22 +278,22 @@ var _ = Describe("Convergence of Tasks", func() {
 					taskDef := model_helpers.NewValidTaskDefinition()
 					taskDef.CompletionCallbackUrl = "blah"
 
-					err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+					_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 					Expect(err).NotTo(HaveOccurred())
 
-					_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+					_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 					Expect(err).NotTo(HaveOccurred())
 
-					task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "'cause I said so", "a magical result")
+					_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "'cause I said so", "a magical result")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(task.TaskGuid).To(Equal(taskGuid))
 
-					err = etcdDB.DesireTask(logger, taskDef, taskGuid2, domain)
+					_, err = etcdDB.DesireTask(logger, taskDef, taskGuid2, domain)
 
-					_, err = etcdDB.StartTask(logger, taskGuid2, cellId)
+					_, _, _, err = etcdDB.StartTask(logger, taskGuid2, cellId)
 					Expect(err).NotTo(HaveOccurred())
 
-					task, err = etcdDB.CompleteTask(logger, taskGuid2, cellId, true, "'cause I said so", "a magical result")
+					_, task, err = etcdDB.CompleteTask(logger, taskGuid2, cellId, true, "'cause I said so", "a magical result")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(task.TaskGuid).To(Equal(taskGuid2))
 				})
@@ -297,17 +351,17 @@ var _ = Describe("Convergence of Tasks", func() {
 				taskDef := model_helpers.NewValidTaskDefinition()
 				taskDef.CompletionCallbackUrl = "blah"
 
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "'cause I said so", "a magical result")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "'cause I said so", "a magical result")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(task.TaskGuid).To(Equal(taskGuid))
 
-				err = etcdDB.ResolvingTask(logger, taskGuid)
+				_, _, err = etcdDB.ResolvingTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -1,17 +1,31 @@
 package etcd
 
 import (
+	"path"
+	"sort"
+	"time"
+
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
 
 const NO_TTL = 0
 
-func (db *ETCDDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition, taskGuid, domain string) error {
+// TaskStreamChunkSize is the number of tasks StreamTasks decodes before
+// yielding them.
+const TaskStreamChunkSize = 500
+
+func (db *ETCDDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition, taskGuid, domain string) (*models.Task, error) {
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("finished")
 
+	contentHash, err := taskDef.ContentHash()
+	if err != nil {
+		logger.Error("failed-hashing-task-definition", err)
+		return nil, err
+	}
+
 	now := db.clock.Now().UnixNano()
 	task := &models.Task{
 		TaskDefinition: taskDef,
@@ -20,58 +34,148 @@ func (db *ETCDDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition
 		State:          models.Task_Pending,
 		CreatedAt:      now,
 		UpdatedAt:      now,
+		ContentHash:    contentHash,
 	}
 
 	value, err := db.serializeModel(logger, task)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logger.Debug("persisting-task")
 	_, err = db.client.Create(TaskSchemaPathByGuid(task.TaskGuid), value, NO_TTL)
 	if err != nil {
-		return ErrorFromEtcdError(logger, err)
+		return nil, ErrorFromEtcdError(logger, err)
 	}
 	logger.Debug("succeeded-persisting-task")
 
-	return nil
+	return task, nil
+}
+
+func (db *ETCDDB) DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error) {
+	logger = logger.Session("duplicate-tasks", lager.Data{"domain": domain})
+	logger.Debug("starting")
+	defer logger.Debug("finished")
+
+	guidsByHash := map[string][]string{}
+	_, err := db.StreamTasks(logger, models.TaskFilter{Domain: domain}, func(task *models.Task) error {
+		if task.ContentHash != "" {
+			guidsByHash[task.ContentHash] = append(guidsByHash[task.ContentHash], task.TaskGuid)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed-to-fetch-tasks", err)
+		return nil, err
+	}
+
+	contentHashes := []string{}
+	for contentHash, guids := range guidsByHash {
+		if len(guids) > 1 {
+			contentHashes = append(contentHashes, contentHash)
+		}
+	}
+	sort.Strings(contentHashes)
+
+	duplicates := []*models.DuplicateTasks{}
+	for _, contentHash := range contentHashes {
+		guids := guidsByHash[contentHash]
+		sort.Strings(guids)
+		duplicates = append(duplicates, &models.DuplicateTasks{ContentHash: contentHash, TaskGuids: guids})
+	}
+
+	return duplicates, nil
 }
 
 func (db *ETCDDB) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
-	root, err := db.fetchRecursiveRaw(logger, TaskSchemaRoot)
+	tasks := []*models.Task{}
+	filter.PartialResults = false
+	_, err := db.StreamTasks(logger, filter, func(task *models.Task) error {
+		tasks = append(tasks, task)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("succeeded-performing-deserialization", lager.Data{"num_tasks": len(tasks)})
+
+	return tasks, nil
+}
+
+func (db *ETCDDB) StreamTasks(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+	root, err := db.fetchBulkRecursiveRaw(logger, TaskSchemaRoot)
 	bbsErr := models.ConvertError(err)
 	if bbsErr != nil {
 		if bbsErr.Type == models.Error_ResourceNotFound {
-			return []*models.Task{}, nil
+			return nil, nil
 		}
 		return nil, err
 	}
-	if root.Nodes.Len() == 0 {
-		return []*models.Task{}, nil
-	}
 
-	tasks := []*models.Task{}
-
-	for _, node := range root.Nodes {
-		task := new(models.Task)
-		err := db.deserializeModel(logger, node, task)
+	// etcd returns the whole listing at once. The tasks are decoded in
+	// parallel a chunk at a time and yielded in the order of the listing
+	// before the next chunk is decoded, so that at most TaskStreamChunkSize
+	// decoded tasks are held together.
+	var recordErrors []*models.RecordError
+	for start := 0; start < len(root.Nodes); start += TaskStreamChunkSize {
+		end := start + TaskStreamChunkSize
+		if end > len(root.Nodes) {
+			end = len(root.Nodes)
+		}
+		nodes := root.Nodes[start:end]
+
+		tasks := make([]*models.Task, len(nodes))
+		unreadable := make([]error, len(nodes))
+		err := db.inParallel(len(nodes), func(i int) error {
+			task := new(models.Task)
+			err := db.deserializeModel(logger, nodes[i], task)
+			if err == errRecordSkipped {
+				return nil
+			}
+			if err != nil && filter.PartialResults && isUnreadableRecordError(err) {
+				unreadable[i] = err
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			tasks[i] = task
+			return nil
+		})
 		if err != nil {
 			return nil, err
 		}
 
-		if filter.Domain != "" && task.Domain != filter.Domain {
-			continue
-		}
-		if filter.CellID != "" && task.CellId != filter.CellID {
-			continue
+		for i, task := range tasks {
+			if unreadable[i] != nil {
+				guid := path.Base(nodes[i].Key)
+				logger.Info("leaving-out-unreadable-task", lager.Data{"guid": guid, "reason": unreadable[i].Error()})
+				recordErrors = append(recordErrors, &models.RecordError{Guid: guid, Reason: unreadable[i].Error()})
+				continue
+			}
+			if task == nil {
+				continue
+			}
+
+			if filter.Domain != "" && task.Domain != filter.Domain {
+				continue
+			}
+			if filter.CellID != "" && task.CellId != filter.CellID {
+				continue
+			}
+			if !filter.MatchesState(task.State) {
+				continue
+			}
+
+			err = yield(task)
+			if err != nil {
+				return nil, err
+			}
 		}
-
-		tasks = append(tasks, task)
 	}
 
-	logger.Debug("succeeded-performing-deserialization", lager.Data{"num_tasks": len(tasks)})
-
-	return tasks, nil
+	return recordErrors, nil
 }
 
 func (db *ETCDDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
@@ -87,6 +191,9 @@ func (db *ETCDDB) taskByGuidWithIndex(logger lager.Logger, taskGuid string) (*mo
 
 	task := new(models.Task)
 	deserializeErr := db.deserializeModel(logger, node, task)
+	if isMissingKeyError(deserializeErr) {
+		return nil, 0, deserializeErr
+	}
 	if deserializeErr != nil {
 		logger.Error("failed-parsing-desired-task", deserializeErr)
 		return nil, 0, models.ErrDeserialize
@@ -95,49 +202,50 @@ func (db *ETCDDB) taskByGuidWithIndex(logger lager.Logger, taskGuid string) (*mo
 	return task, node.ModifiedIndex, nil
 }
 
-func (db *ETCDDB) StartTask(logger lager.Logger, taskGuid, cellID string) (bool, error) {
+func (db *ETCDDB) StartTask(logger lager.Logger, taskGuid, cellID string) (*models.Task, *models.Task, bool, error) {
 	logger.Debug("starting")
 	defer logger.Debug("finished")
 
 	task, index, err := db.taskByGuidWithIndex(logger, taskGuid)
 	if err != nil {
 		logger.Error("failed-to-fetch-task", err)
-		return false, err
+		return nil, nil, false, err
 	}
 
 	logger = logger.WithData(lager.Data{"task": task.LagerData()})
 
 	if task.State == models.Task_Running && task.CellId == cellID {
 		logger.Info("task-already-running")
-		return false, nil
+		return task, task, false, nil
 	}
 
 	if err = task.ValidateTransitionTo(models.Task_Running); err != nil {
-		return false, err
+		return nil, nil, false, err
 	}
 
+	beforeTask := task.Copy()
 	task.UpdatedAt = db.clock.Now().UnixNano()
 	task.State = models.Task_Running
 	task.CellId = cellID
 
 	value, err := db.serializeModel(logger, task)
 	if err != nil {
-		return false, err
+		return nil, nil, false, err
 	}
 
 	_, err = db.client.CompareAndSwap(TaskSchemaPathByGuid(taskGuid), value, NO_TTL, index)
 	if err != nil {
 		logger.Error("failed-persisting-task", err)
-		return false, ErrorFromEtcdError(logger, err)
+		return nil, nil, false, ErrorFromEtcdError(logger, err)
 	}
 
-	return true, nil
+	return beforeTask, task, true, nil
 }
 
 // The cell calls this when the user requested to cancel the task
 // stagerTaskBBS will retry this repeatedly if it gets a StoreTimeout error (up to N seconds?)
 // Will fail if the task has already been cancelled or completed normally
-func (db *ETCDDB) CancelTask(logger lager.Logger, taskGuid string) (*models.Task, string, error) {
+func (db *ETCDDB) CancelTask(logger lager.Logger, taskGuid string) (*models.Task, *models.Task, string, error) {
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
 
 	logger.Info("starting")
@@ -146,33 +254,88 @@ func (db *ETCDDB) CancelTask(logger lager.Logger, taskGuid string) (*models.Task
 	task, index, err := db.taskByGuidWithIndex(logger, taskGuid)
 	if err != nil {
 		logger.Error("failed-to-fetch-task", err)
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
 	if err = task.ValidateTransitionTo(models.Task_Completed); err != nil {
 		if task.State != models.Task_Pending {
 			logger.Error("invalid-state-transition", err)
-			return nil, "", err
+			return nil, nil, "", err
 		}
 	}
 
 	logger.Info("completing-task")
-	cellID := task.CellId
+	beforeTask := task.Copy()
 	err = db.completeTask(logger, task, index, true, "task was cancelled", "")
 	if err != nil {
 		logger.Error("failed-completing-task", err)
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
 	logger.Info("succeeded-completing-task")
-	return task, cellID, nil
+	return beforeTask, task, beforeTask.CellId, nil
+}
+
+func (db *ETCDDB) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error) {
+	logger = logger.Session("cancel-tasks", lager.Data{"domain": domain, "task_guids": taskGuids})
+
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	guids := taskGuids
+	if len(guids) == 0 {
+		tasks, err := db.quorumDB().Tasks(logger, models.TaskFilter{Domain: domain})
+		if err != nil {
+			logger.Error("failed-to-fetch-tasks", err)
+			return nil, err
+		}
+		for _, task := range tasks {
+			guids = append(guids, task.TaskGuid)
+		}
+	}
+
+	result := &models.CancelTasksResult{}
+	for _, guid := range guids {
+		task, index, err := db.taskByGuidWithIndex(logger, guid)
+		if err == models.ErrResourceNotFound {
+			result.NotFound = append(result.NotFound, guid)
+			continue
+		}
+		if err != nil {
+			logger.Error("failed-to-fetch-task", err, lager.Data{"task_guid": guid})
+			return nil, err
+		}
+
+		if domain != "" && task.Domain != domain {
+			result.NotFound = append(result.NotFound, guid)
+			continue
+		}
+
+		if !task.Cancellable() {
+			result.NotCancellable = append(result.NotCancellable, guid)
+			continue
+		}
+
+		beforeTask := task.Copy()
+		err = db.completeTask(logger, task, index, true, "task was cancelled", "")
+		if err != nil {
+			// the task changed underneath us, most likely because it completed
+			logger.Error("failed-completing-task", err, lager.Data{"task_guid": guid})
+			result.NotCancellable = append(result.NotCancellable, guid)
+			continue
+		}
+
+		result.Cancelled = append(result.Cancelled, models.CancelledTask{Before: beforeTask, Task: task, CellID: beforeTask.CellId})
+	}
+
+	return result, nil
 }
 
 // The cell calls this when it has finished running the task (be it success or failure)
 // stagerTaskBBS will retry this repeatedly if it gets a StoreTimeout error (up to N seconds?)
 // This really really shouldn't fail.  If it does, blog about it and walk away. If it failed in a
 // consistent way (i.e. key already exists), there's probably a flaw in our design.
-func (db *ETCDDB) CompleteTask(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (*models.Task, error) {
+func (db *ETCDDB) CompleteTask(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (*models.Task, *models.Task, error) {
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid, "cell_id": cellId})
 
 	logger.Info("starting")
@@ -181,24 +344,30 @@ func (db *ETCDDB) CompleteTask(logger lager.Logger, taskGuid, cellId string, fai
 	task, index, err := db.taskByGuidWithIndex(logger, taskGuid)
 	if err != nil {
 		logger.Error("failed-getting-task", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	if task.State == models.Task_Running && task.CellId != cellId {
 		err = models.NewRunningOnDifferentCellError(cellId, task.CellId)
 		logger.Error("invalid-cell-id", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err = task.ValidateTransitionTo(models.Task_Completed); err != nil {
 		logger.Error("invalid-state-transition", err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	return task, db.completeTask(logger, task, index, failed, failureReason, result)
+	beforeTask := task.Copy()
+	err = db.completeTask(logger, task, index, failed, failureReason, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beforeTask, task, nil
 }
 
-func (db *ETCDDB) FailTask(logger lager.Logger, taskGuid, failureReason string) (*models.Task, error) {
+func (db *ETCDDB) FailTask(logger lager.Logger, taskGuid, failureReason string) (*models.Task, *models.Task, error) {
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
 
 	logger.Info("starting")
@@ -208,18 +377,62 @@ func (db *ETCDDB) FailTask(logger lager.Logger, taskGuid, failureReason string)
 	task, index, err := db.taskByGuidWithIndex(logger, taskGuid)
 	if err != nil {
 		logger.Error("failed-getting-task", err)
-		return nil, err
+		return nil, nil, err
 	}
 	logger.Info("succeeded-getting-task")
 
 	if err = task.ValidateTransitionTo(models.Task_Completed); err != nil {
 		if task.State != models.Task_Pending {
 			logger.Error("invalid-state-transition", err)
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	return task, db.completeTask(logger, task, index, true, failureReason, "")
+	beforeTask := task.Copy()
+	err = db.completeTask(logger, task, index, true, failureReason, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beforeTask, task, nil
+}
+
+func (db *ETCDDB) RejectTask(logger lager.Logger, taskGuid, rejectionReason string) (*models.Task, *models.Task, error) {
+	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
+
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	task, index, err := db.taskByGuidWithIndex(logger, taskGuid)
+	if err != nil {
+		logger.Error("failed-getting-task", err)
+		return nil, nil, err
+	}
+
+	if task.State != models.Task_Pending {
+		err = models.NewTaskTransitionError(task.State, models.Task_Pending)
+		logger.Error("invalid-state-transition", err)
+		return nil, nil, err
+	}
+
+	beforeTask := task.Copy()
+	task.RejectionCount++
+	task.RejectionReason = rejectionReason
+	task.UpdatedAt = db.clock.Now().UnixNano()
+
+	value, err := db.serializeModel(logger, task)
+	if err != nil {
+		logger.Error("failed-serializing-model", err)
+		return nil, nil, err
+	}
+
+	_, err = db.client.CompareAndSwap(TaskSchemaPathByGuid(task.TaskGuid), value, NO_TTL, index)
+	if err != nil {
+		logger.Error("failed-persisting-task", err)
+		return nil, nil, ErrorFromEtcdError(logger, err)
+	}
+
+	return beforeTask, task, nil
 }
 
 func (db *ETCDDB) completeTask(logger lager.Logger, task *models.Task, index uint64, failed bool, failureReason, result string) error {
@@ -253,7 +466,7 @@ func (db *ETCDDB) markTaskCompleted(task *models.Task, failed bool, failureReaso
 
 // The stager calls this when it wants to claim a completed task.  This ensures that only one
 // stager ever attempts to handle a completed task
-func (db *ETCDDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
+func (db *ETCDDB) ResolvingTask(logger lager.Logger, taskGuid string) (*models.Task, *models.Task, error) {
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
 
 	logger.Info("starting")
@@ -262,34 +475,35 @@ func (db *ETCDDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	task, index, err := db.taskByGuidWithIndex(logger, taskGuid)
 	if err != nil {
 		logger.Error("failed-getting-task", err)
-		return err
+		return nil, nil, err
 	}
 
 	err = task.ValidateTransitionTo(models.Task_Resolving)
 	if err != nil {
 		logger.Error("invalid-state-transition", err)
-		return err
+		return nil, nil, err
 	}
 
+	beforeTask := task.Copy()
 	task.UpdatedAt = db.clock.Now().UnixNano()
 	task.State = models.Task_Resolving
 
 	value, err := db.serializeModel(logger, task)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	_, err = db.client.CompareAndSwap(TaskSchemaPathByGuid(taskGuid), value, NO_TTL, index)
 	if err != nil {
-		return ErrorFromEtcdError(logger, err)
+		return nil, nil, ErrorFromEtcdError(logger, err)
 	}
-	return nil
+	return beforeTask, task, nil
 }
 
 // The stager calls this when it wants to signal that it has received a completion and is handling it
 // stagerTaskBBS will retry this repeatedly if it gets a StoreTimeout error (up to N seconds?)
 // If this fails, the stager should assume that someone else is handling the completion and should bail
-func (db *ETCDDB) DeleteTask(logger lager.Logger, taskGuid string) error {
+func (db *ETCDDB) DeleteTask(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
 
 	logger.Info("starting")
@@ -298,15 +512,61 @@ func (db *ETCDDB) DeleteTask(logger lager.Logger, taskGuid string) error {
 	task, _, err := db.taskByGuidWithIndex(logger, taskGuid)
 	if err != nil {
 		logger.Error("failed-getting-task", err)
-		return err
+		return nil, err
 	}
 
 	if task.State != models.Task_Resolving {
 		err = models.NewTaskTransitionError(task.State, models.Task_Resolving)
 		logger.Error("invalid-state-transition", err)
-		return err
+		return nil, err
 	}
 
 	_, err = db.client.Delete(TaskSchemaPathByGuid(taskGuid), false)
-	return ErrorFromEtcdError(logger, err)
+	if err != nil {
+		return nil, ErrorFromEtcdError(logger, err)
+	}
+	return task, nil
+}
+
+func (db *ETCDDB) PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error) {
+	logger = logger.WithData(lager.Data{"min_age": minAge.String()})
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	root, err := db.fetchRecursiveRaw(logger, TaskSchemaRoot)
+	bbsErr := models.ConvertError(err)
+	if bbsErr != nil {
+		if bbsErr.Type == models.Error_ResourceNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	purged := 0
+	for _, node := range root.Nodes {
+		task := new(models.Task)
+		err := db.deserializeModel(logger, node, task)
+		if err != nil {
+			logger.Error("failed-to-deserialize-task", err, lager.Data{"key": node.Key})
+			continue
+		}
+
+		if task.State != models.Task_Completed && task.State != models.Task_Resolving {
+			continue
+		}
+
+		if task.CompletionCallbackUrl != "" || db.durationSinceTaskFirstCompleted(task) <= minAge {
+			continue
+		}
+
+		_, err = db.client.CompareAndDelete(node.Key, node.ModifiedIndex)
+		if err != nil {
+			logger.Error("failed-to-compare-and-delete", err, lager.Data{"task_guid": task.TaskGuid})
+			continue
+		}
+		purged++
+	}
+
+	logger.Info("purged-tasks", lager.Data{"count": purged})
+	return purged, nil
 }
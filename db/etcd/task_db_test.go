@@ -1,8 +1,19 @@
 package etcd_test
 
 import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	etcdclient "github.com/coreos/go-etcd/etcd"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -41,7 +52,11 @@ var _ = Describe("TaskDB", func() {
 				task2 := model_helpers.NewValidTask("b-guid")
 				task2.Domain = "domain-2"
 				task2.CellId = "cell-2"
-				expectedTasks = []*models.Task{task1, task2}
+				task3 := model_helpers.NewValidTask("c-guid")
+				task3.Domain = "domain-2"
+				task3.CellId = "cell-1"
+				task3.State = models.Task_Running
+				expectedTasks = []*models.Task{task1, task2, task3}
 
 				for _, t := range expectedTasks {
 					etcdHelper.SetRawTask(t)
@@ -67,6 +82,56 @@ var _ = Describe("TaskDB", func() {
 				Expect(tasks).To(HaveLen(1))
 				Expect(tasks[0]).To(Equal(expectedTasks[1]))
 			})
+
+			It("can filter by cell id and states", func() {
+				tasks, err := etcdDB.Tasks(logger, models.TaskFilter{CellID: "cell-1", States: []models.Task_State{models.Task_Running}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal([]*models.Task{expectedTasks[2]}))
+
+				tasks, err = etcdDB.Tasks(logger, models.TaskFilter{CellID: "cell-1", States: []models.Task_State{models.Task_Pending, models.Task_Running}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(ConsistOf(expectedTasks[0], expectedTasks[2]))
+			})
+
+			It("returns an empty list when no task matches", func() {
+				tasks, err := etcdDB.Tasks(logger, models.TaskFilter{CellID: "cell-3"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(BeEmpty())
+
+				tasks, err = etcdDB.Tasks(logger, models.TaskFilter{CellID: "cell-2", States: []models.Task_State{models.Task_Running}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(BeEmpty())
+			})
+		})
+
+		Context("when there are more tasks than update workers", func() {
+			var expectedTasks []*models.Task
+
+			BeforeEach(func() {
+				expectedTasks = []*models.Task{}
+				for i := 0; i < 150; i++ {
+					task := model_helpers.NewValidTask(fmt.Sprintf("guid-%d", i))
+					etcdHelper.SetRawTask(task)
+					expectedTasks = append(expectedTasks, task)
+				}
+			})
+
+			It("returns all the tasks", func() {
+				tasks, err := etcdDB.Tasks(logger, models.TaskFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(ConsistOf(expectedTasks))
+			})
+
+			Context("and one of them is invalid", func() {
+				BeforeEach(func() {
+					etcdHelper.CreateMalformedTask("guid-75")
+				})
+
+				It("errors", func() {
+					_, err := etcdDB.Tasks(logger, models.TaskFilter{})
+					Expect(err).To(HaveOccurred())
+				})
+			})
 		})
 
 		Context("when there are no tasks", func() {
@@ -107,6 +172,143 @@ var _ = Describe("TaskDB", func() {
 		})
 	})
 
+	Describe("StreamTasks", func() {
+		var expectedTasks []*models.Task
+
+		BeforeEach(func() {
+			task1 := model_helpers.NewValidTask("a-guid")
+			task1.Domain = "domain-1"
+			task2 := model_helpers.NewValidTask("b-guid")
+			task2.Domain = "domain-2"
+			expectedTasks = []*models.Task{task1, task2}
+
+			for _, t := range expectedTasks {
+				etcdHelper.SetRawTask(t)
+			}
+		})
+
+		It("yields each task matching the filter", func() {
+			tasks := []*models.Task{}
+			_, err := etcdDB.StreamTasks(logger, models.TaskFilter{Domain: "domain-2"}, func(task *models.Task) error {
+				tasks = append(tasks, task)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(ConsistOf(expectedTasks[1]))
+		})
+
+		Context("when yield returns an error", func() {
+			It("stops and returns the error", func() {
+				calls := 0
+				_, err := etcdDB.StreamTasks(logger, models.TaskFilter{}, func(task *models.Task) error {
+					calls++
+					return errors.New("boom")
+				})
+				Expect(err).To(MatchError("boom"))
+				Expect(calls).To(Equal(1))
+			})
+		})
+
+		Context("when a task cannot be read", func() {
+			BeforeEach(func() {
+				etcdHelper.CreateMalformedTask("c-guid")
+			})
+
+			It("errors", func() {
+				_, err := etcdDB.StreamTasks(logger, models.TaskFilter{}, func(task *models.Task) error {
+					return nil
+				})
+				Expect(err).To(HaveOccurred())
+			})
+
+			Context("and partial results are requested", func() {
+				It("yields the other tasks and returns a record error for it", func() {
+					tasks := []*models.Task{}
+					recordErrors, err := etcdDB.StreamTasks(logger, models.TaskFilter{Domain: "domain-2", PartialResults: true}, func(task *models.Task) error {
+						tasks = append(tasks, task)
+						return nil
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(tasks).To(ConsistOf(expectedTasks[1]))
+					Expect(recordErrors).To(HaveLen(1))
+					Expect(recordErrors[0].Guid).To(Equal("c-guid"))
+					Expect(recordErrors[0].Reason).NotTo(BeEmpty())
+				})
+
+				It("still fails the whole list from Tasks", func() {
+					_, err := etcdDB.Tasks(logger, models.TaskFilter{PartialResults: true})
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+	})
+
+	Describe("StreamTasks over several chunks", func() {
+		var (
+			taskCount int
+			nodes     etcdclient.Nodes
+		)
+
+		BeforeEach(func() {
+			taskCount = 2*etcd.TaskStreamChunkSize + 10
+			serializer := format.NewSerializer(cryptor)
+
+			nodes = etcdclient.Nodes{}
+			for i := taskCount - 1; i >= 0; i-- {
+				task := model_helpers.NewValidTask(fmt.Sprintf("guid-%04d", i))
+				value, err := serializer.Marshal(logger, format.ENCRYPTED_PROTO, task)
+				Expect(err).NotTo(HaveOccurred())
+				nodes = append(nodes, &etcdclient.Node{Key: etcd.TaskSchemaPath(task), Value: string(value)})
+			}
+
+			fakeStoreClient.GetReturns(&etcdclient.Response{Node: &etcdclient.Node{Key: etcd.TaskSchemaRoot, Dir: true, Nodes: nodes}}, nil)
+		})
+
+		It("yields the tasks in the order of the listing", func() {
+			guids := []string{}
+			_, err := etcdDBWithFakeStore.StreamTasks(logger, models.TaskFilter{}, func(task *models.Task) error {
+				guids = append(guids, task.TaskGuid)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(guids).To(HaveLen(taskCount))
+			for i, guid := range guids {
+				Expect(guid).To(Equal(fmt.Sprintf("guid-%04d", taskCount-1-i)))
+			}
+		})
+
+		Context("when a task in the first chunk cannot be read", func() {
+			var (
+				countingDB db.DB
+				decrypts   int32
+			)
+
+			BeforeEach(func() {
+				nodes[1].Value = "{{{"
+
+				countingCryptor := &encryptionfakes.FakeCryptor{}
+				countingCryptor.DecryptStub = func(encrypted encryption.Encrypted) ([]byte, error) {
+					atomic.AddInt32(&decrypts, 1)
+					return cryptor.Decrypt(encrypted)
+				}
+				countingDB = etcd.NewETCD(format.ENCRYPTED_PROTO, 100, 100, DesiredLRPCreationTimeout, StuckEvacuationThreshold, 0, countingCryptor, fakeStoreClient, fakeStoreClient, clock)
+			})
+
+			It("stops decoding and yields nothing", func() {
+				yielded := 0
+				_, err := countingDB.StreamTasks(logger, models.TaskFilter{}, func(task *models.Task) error {
+					yielded++
+					return nil
+				})
+				Expect(err).To(HaveOccurred())
+
+				Expect(yielded).To(Equal(0))
+				Expect(atomic.LoadInt32(&decrypts)).To(BeNumerically("<", etcd.TaskStreamChunkSize))
+			})
+		})
+	})
+
 	Describe("TaskByGuid", func() {
 		Context("when there is a task", func() {
 			var expectedTask *models.Task
@@ -123,6 +325,24 @@ var _ = Describe("TaskDB", func() {
 			})
 		})
 
+		Context("when the task is resolving", func() {
+			var expectedTask *models.Task
+
+			BeforeEach(func() {
+				expectedTask = model_helpers.NewValidTask("resolving-task-guid")
+				expectedTask.State = models.Task_Resolving
+				expectedTask.Result = "some-result"
+				etcdHelper.SetRawTask(expectedTask)
+			})
+
+			It("returns the task in its current state", func() {
+				task, err := etcdDB.TaskByGuid(logger, "resolving-task-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task).To(Equal(expectedTask))
+				Expect(task.State).To(Equal(models.Task_Resolving))
+			})
+		})
+
 		Context("when there is no task", func() {
 			It("returns a ResourceNotFound", func() {
 				_, err := etcdDB.TaskByGuid(logger, "nota-guid")
@@ -162,7 +382,7 @@ var _ = Describe("TaskDB", func() {
 		var task *models.Task
 
 		JustBeforeEach(func() {
-			errDesire = etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+			_, errDesire = etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 		})
 
 		BeforeEach(func() {
@@ -189,6 +409,21 @@ var _ = Describe("TaskDB", func() {
 				Expect(persistedTask.CreatedAt).To(Equal(clock.Now().UnixNano()))
 			})
 
+			Context("when the task definition has extension fields", func() {
+				BeforeEach(func() {
+					taskDef.ExtensionFields = map[string][]byte{
+						"example.com/json":   []byte(`{"replicas":3}`),
+						"example.com/binary": {0x00, 0xff, 0x80},
+					}
+				})
+
+				It("stores them unchanged", func() {
+					persistedTask, err := etcdDB.TaskByGuid(logger, taskGuid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(persistedTask.ExtensionFields).To(Equal(taskDef.ExtensionFields))
+				})
+			})
+
 			It("sets the UpdatedAt time", func() {
 				persistedTask, err := etcdDB.TaskByGuid(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
@@ -200,7 +435,7 @@ var _ = Describe("TaskDB", func() {
 			const initialDomain = "other-domain"
 
 			BeforeEach(func() {
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, initialDomain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, initialDomain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -224,12 +459,12 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when starting a pending Task", func() {
 			BeforeEach(func() {
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("returns shouldStart as true", func() {
-				started, err := etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, started, err := etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(started).To(BeTrue())
 			})
@@ -237,11 +472,13 @@ var _ = Describe("TaskDB", func() {
 			It("correctly updates the task record", func() {
 				clock.IncrementBySeconds(1)
 
-				_, err := etcdDB.StartTask(logger, taskGuid, cellId)
+				before, after, _, err := etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 
 				task, err := etcdDB.TaskByGuid(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
+				Expect(before.State).To(Equal(models.Task_Pending))
+				Expect(after).To(Equal(task))
 
 				Expect(task.TaskGuid).To(Equal(taskGuid))
 				Expect(task.State).To(Equal(models.Task_Running))
@@ -252,16 +489,16 @@ var _ = Describe("TaskDB", func() {
 
 		Context("When starting a Task that is already started", func() {
 			BeforeEach(func() {
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, "domain")
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, "domain")
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			Context("on the same cell", func() {
 				It("returns shouldStart as false", func() {
-					changed, err := etcdDB.StartTask(logger, taskGuid, cellId)
+					_, _, changed, err := etcdDB.StartTask(logger, taskGuid, cellId)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(changed).To(BeFalse())
 				})
@@ -270,7 +507,7 @@ var _ = Describe("TaskDB", func() {
 					previousTime := clock.Now().UnixNano()
 					clock.IncrementBySeconds(1)
 
-					_, err := etcdDB.StartTask(logger, taskGuid, cellId)
+					_, _, _, err := etcdDB.StartTask(logger, taskGuid, cellId)
 					Expect(err).NotTo(HaveOccurred())
 
 					task, err := etcdDB.TaskByGuid(logger, taskGuid)
@@ -282,7 +519,7 @@ var _ = Describe("TaskDB", func() {
 
 			Context("on another cell", func() {
 				It("returns an error", func() {
-					_, err := etcdDB.StartTask(logger, taskGuid, "some-other-cell")
+					_, _, _, err := etcdDB.StartTask(logger, taskGuid, "some-other-cell")
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -292,7 +529,7 @@ var _ = Describe("TaskDB", func() {
 					previousTime := clock.Now().UnixNano()
 					clock.IncrementBySeconds(1)
 
-					_, err := etcdDB.StartTask(logger, taskGuid, cellId)
+					_, _, _, err := etcdDB.StartTask(logger, taskGuid, cellId)
 					Expect(err).NotTo(HaveOccurred())
 
 					task, err := etcdDB.TaskByGuid(logger, taskGuid)
@@ -313,7 +550,7 @@ var _ = Describe("TaskDB", func() {
 		)
 
 		JustBeforeEach(func() {
-			taskReturned, cellIDReturned, cancelError = etcdDB.CancelTask(logger, taskGuid)
+			_, taskReturned, cellIDReturned, cancelError = etcdDB.CancelTask(logger, taskGuid)
 			taskAfterCancel, _ = etcdDB.TaskByGuid(logger, taskGuid)
 		})
 
@@ -346,7 +583,7 @@ var _ = Describe("TaskDB", func() {
 		Context("when the task is in pending state", func() {
 			BeforeEach(func() {
 				taskDef = model_helpers.NewValidTaskDefinition()
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -360,10 +597,10 @@ var _ = Describe("TaskDB", func() {
 		Context("when the task is in running state", func() {
 			BeforeEach(func() {
 				taskDef = model_helpers.NewValidTaskDefinition()
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -377,13 +614,13 @@ var _ = Describe("TaskDB", func() {
 		Context("when the task is in completed state", func() {
 			BeforeEach(func() {
 				taskDef = model_helpers.NewValidTaskDefinition()
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, false, "", "")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(task.TaskGuid).To(Equal(taskGuid))
 			})
@@ -397,17 +634,17 @@ var _ = Describe("TaskDB", func() {
 		Context("when the task is in resolving state", func() {
 			BeforeEach(func() {
 				taskDef = model_helpers.NewValidTaskDefinition()
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, false, "", "")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(task.TaskGuid).To(Equal(taskGuid))
 
-				err = etcdDB.ResolvingTask(logger, taskGuid)
+				_, _, err = etcdDB.ResolvingTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -440,16 +677,183 @@ var _ = Describe("TaskDB", func() {
 		})
 	})
 
+	Describe("CancelTasks", func() {
+		var (
+			result      *models.CancelTasksResult
+			cancelError error
+		)
+
+		BeforeEach(func() {
+			taskDef := model_helpers.NewValidTaskDefinition()
+
+			_, err := etcdDB.DesireTask(logger, taskDef, "pending-task", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = etcdDB.DesireTask(logger, taskDef, "running-task", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = etcdDB.StartTask(logger, "running-task", "the-cell")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = etcdDB.DesireTask(logger, taskDef, "completed-task", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = etcdDB.StartTask(logger, "completed-task", "the-cell")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = etcdDB.CompleteTask(logger, "completed-task", "the-cell", false, "", "result")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = etcdDB.DesireTask(logger, taskDef, "other-domain-task", "other-domain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		cancelledGuids := func() []string {
+			guids := []string{}
+			for _, cancelled := range result.Cancelled {
+				guids = append(guids, cancelled.Task.TaskGuid)
+			}
+			return guids
+		}
+
+		Context("by domain", func() {
+			JustBeforeEach(func() {
+				result, cancelError = etcdDB.CancelTasks(logger, "the-domain", nil)
+			})
+
+			It("cancels the pending and running tasks in the domain", func() {
+				Expect(cancelError).NotTo(HaveOccurred())
+				Expect(cancelledGuids()).To(ConsistOf("pending-task", "running-task"))
+				Expect(result.NotCancellable).To(ConsistOf("completed-task"))
+				Expect(result.NotFound).To(BeEmpty())
+
+				for _, guid := range []string{"pending-task", "running-task"} {
+					task, err := etcdDB.TaskByGuid(logger, guid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.Failed).To(BeTrue())
+					Expect(task.FailureReason).To(Equal("task was cancelled"))
+					Expect(task.CellId).To(BeEmpty())
+				}
+			})
+
+			It("returns each task as it was before it was cancelled", func() {
+				for _, cancelled := range result.Cancelled {
+					Expect(cancelled.Before.TaskGuid).To(Equal(cancelled.Task.TaskGuid))
+					Expect(cancelled.Before.State).NotTo(Equal(models.Task_Completed))
+				}
+			})
+
+			It("returns the cell each running task was on", func() {
+				for _, cancelled := range result.Cancelled {
+					if cancelled.Task.TaskGuid == "running-task" {
+						Expect(cancelled.CellID).To(Equal("the-cell"))
+					} else {
+						Expect(cancelled.CellID).To(BeEmpty())
+					}
+				}
+			})
+
+			It("does not touch the completed task or tasks in other domains", func() {
+				task, err := etcdDB.TaskByGuid(logger, "completed-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.Failed).To(BeFalse())
+				Expect(task.Result).To(Equal("result"))
+
+				task, err = etcdDB.TaskByGuid(logger, "other-domain-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+			})
+		})
+
+		Context("by task guids", func() {
+			JustBeforeEach(func() {
+				result, cancelError = etcdDB.CancelTasks(logger, "", []string{"running-task", "completed-task", "missing-task", "other-domain-task"})
+			})
+
+			It("reports cancelled, not cancellable and not found tasks", func() {
+				Expect(cancelError).NotTo(HaveOccurred())
+				Expect(cancelledGuids()).To(ConsistOf("running-task", "other-domain-task"))
+				Expect(result.NotCancellable).To(ConsistOf("completed-task"))
+				Expect(result.NotFound).To(ConsistOf("missing-task"))
+			})
+
+			It("does not cancel tasks that were not requested", func() {
+				task, err := etcdDB.TaskByGuid(logger, "pending-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+			})
+		})
+
+		Context("by domain and task guids", func() {
+			JustBeforeEach(func() {
+				result, cancelError = etcdDB.CancelTasks(logger, "the-domain", []string{"pending-task", "other-domain-task"})
+			})
+
+			It("only cancels requested tasks in the domain", func() {
+				Expect(cancelError).NotTo(HaveOccurred())
+				Expect(cancelledGuids()).To(ConsistOf("pending-task"))
+				Expect(result.NotFound).To(ConsistOf("other-domain-task"))
+			})
+		})
+	})
+
+	Describe("DuplicateTasks", func() {
+		BeforeEach(func() {
+			taskDef := model_helpers.NewValidTaskDefinition()
+
+			otherTaskDef := model_helpers.NewValidTaskDefinition()
+			otherTaskDef.MemoryMb = 2048
+
+			_, err := etcdDB.DesireTask(logger, taskDef, "task-b", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = etcdDB.DesireTask(logger, taskDef, "task-a", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = etcdDB.DesireTask(logger, otherTaskDef, "other-task", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = etcdDB.DesireTask(logger, taskDef, "other-domain-task", "other-domain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records the content hash of the task definition", func() {
+			expectedHash, err := model_helpers.NewValidTaskDefinition().ContentHash()
+			Expect(err).NotTo(HaveOccurred())
+
+			task, err := etcdDB.TaskByGuid(logger, "task-a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.ContentHash).To(Equal(expectedHash))
+		})
+
+		It("groups tasks in the domain that share a content hash", func() {
+			expectedHash, err := model_helpers.NewValidTaskDefinition().ContentHash()
+			Expect(err).NotTo(HaveOccurred())
+
+			duplicates, err := etcdDB.DuplicateTasks(logger, "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duplicates).To(HaveLen(1))
+			Expect(duplicates[0].ContentHash).To(Equal(expectedHash))
+			Expect(duplicates[0].TaskGuids).To(Equal([]string{"task-a", "task-b"}))
+		})
+
+		Context("when the domain has no duplicate tasks", func() {
+			It("returns no groups", func() {
+				duplicates, err := etcdDB.DuplicateTasks(logger, "other-domain")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(duplicates).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("CompleteTask", func() {
 		Context("when completing a pending Task", func() {
 			JustBeforeEach(func() {
 				taskDef = model_helpers.NewValidTaskDefinition()
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("returns an error", func() {
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "another failure reason", "")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "another failure reason", "")
 				Expect(err).To(HaveOccurred())
 				Expect(task).To(BeNil())
 			})
@@ -461,16 +865,16 @@ var _ = Describe("TaskDB", func() {
 			})
 
 			JustBeforeEach(func() {
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			Context("when the cell id is not the same", func() {
 				It("returns an error", func() {
-					task, err := etcdDB.CompleteTask(logger, taskGuid, "another-cell", true, "another failure reason", "")
+					_, task, err := etcdDB.CompleteTask(logger, taskGuid, "another-cell", true, "another failure reason", "")
 					Expect(err).To(Equal(models.NewRunningOnDifferentCellError("another-cell", cellId)))
 					Expect(task).To(BeNil())
 				})
@@ -480,7 +884,7 @@ var _ = Describe("TaskDB", func() {
 				It("sets the Task in the completed state", func() {
 					clock.IncrementBySeconds(1)
 
-					returnedTask, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "because i said so", "a result")
+					_, returnedTask, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "because i said so", "a result")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(returnedTask.TaskGuid).To(Equal(taskGuid))
 
@@ -499,19 +903,19 @@ var _ = Describe("TaskDB", func() {
 		Context("When completing a Task that is already completed", func() {
 			BeforeEach(func() {
 				taskDef = model_helpers.NewValidTaskDefinition()
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "some failure reason", "")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "some failure reason", "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(task.TaskGuid).To(Equal(taskGuid))
 			})
 
 			It("returns an error", func() {
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "another failure reason", "")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "another failure reason", "")
 				Expect(err).To(HaveOccurred())
 				Expect(task).To(BeNil())
 			})
@@ -520,22 +924,22 @@ var _ = Describe("TaskDB", func() {
 		Context("When completing a Task that is resolving", func() {
 			BeforeEach(func() {
 				taskDef = model_helpers.NewValidTaskDefinition()
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, false, "", "")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, false, "", "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(task.TaskGuid).To(Equal(taskGuid))
 
-				err = etcdDB.ResolvingTask(logger, taskGuid)
+				_, _, err = etcdDB.ResolvingTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("returns an error", func() {
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, false, "", "")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, false, "", "")
 				Expect(err).To(HaveOccurred())
 				Expect(task).To(BeNil())
 			})
@@ -550,14 +954,14 @@ var _ = Describe("TaskDB", func() {
 		Context("when failing a Task", func() {
 			Context("when the task is pending", func() {
 				JustBeforeEach(func() {
-					err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+					_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 					Expect(err).NotTo(HaveOccurred())
 				})
 
 				It("sets the Task in the completed state", func() {
 					clock.IncrementBySeconds(1)
 
-					returnedTask, err := etcdDB.FailTask(logger, taskGuid, "because i said so")
+					_, returnedTask, err := etcdDB.FailTask(logger, taskGuid, "because i said so")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(returnedTask.TaskGuid).To(Equal(taskGuid))
 
@@ -575,19 +979,19 @@ var _ = Describe("TaskDB", func() {
 			Context("when the task is completed", func() {
 				JustBeforeEach(func() {
 					taskDef = model_helpers.NewValidTaskDefinition()
-					err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+					_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 					Expect(err).NotTo(HaveOccurred())
 
-					_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+					_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 					Expect(err).NotTo(HaveOccurred())
 
-					task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "some failure reason", "")
+					_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "some failure reason", "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(task.TaskGuid).To(Equal(taskGuid))
 				})
 
 				It("fails", func() {
-					task, err := etcdDB.FailTask(logger,
+					_, task, err := etcdDB.FailTask(logger,
 						taskGuid,
 						"because i said so",
 					)
@@ -599,22 +1003,22 @@ var _ = Describe("TaskDB", func() {
 			Context("when the task is resolving", func() {
 				JustBeforeEach(func() {
 					taskDef = model_helpers.NewValidTaskDefinition()
-					err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+					_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 					Expect(err).NotTo(HaveOccurred())
 
-					_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+					_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 					Expect(err).NotTo(HaveOccurred())
 
-					task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "some failure reason", "")
+					_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "some failure reason", "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(task.TaskGuid).To(Equal(taskGuid))
 
-					err = etcdDB.ResolvingTask(logger, taskGuid)
+					_, _, err = etcdDB.ResolvingTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 				})
 
 				It("fails", func() {
-					task, err := etcdDB.FailTask(logger,
+					_, task, err := etcdDB.FailTask(logger,
 						taskGuid,
 						"because i said so",
 					)
@@ -625,25 +1029,65 @@ var _ = Describe("TaskDB", func() {
 		})
 	})
 
+	Describe("RejectTask", func() {
+		BeforeEach(func() {
+			taskDef = model_helpers.NewValidTaskDefinition()
+			_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the task is pending", func() {
+			It("counts the rejection and keeps the reason, leaving the task pending", func() {
+				_, _, err := etcdDB.RejectTask(logger, taskGuid, "insufficient resources")
+				Expect(err).NotTo(HaveOccurred())
+
+				clock.IncrementBySeconds(1)
+				_, task, err := etcdDB.RejectTask(logger, taskGuid, "found no compatible cell")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.RejectionCount).To(BeEquivalentTo(2))
+
+				task, err = etcdDB.TaskByGuid(logger, taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+				Expect(task.RejectionCount).To(BeEquivalentTo(2))
+				Expect(task.RejectionReason).To(Equal("found no compatible cell"))
+				Expect(task.UpdatedAt).To(Equal(clock.Now().UnixNano()))
+			})
+		})
+
+		Context("when the task is not pending", func() {
+			BeforeEach(func() {
+				_, _, _, err := etcdDB.StartTask(logger, taskGuid, cellId)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an invalid state transition error", func() {
+				_, _, err := etcdDB.RejectTask(logger, taskGuid, "insufficient resources")
+				Expect(err).To(HaveOccurred())
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidStateTransition))
+			})
+		})
+	})
+
 	Describe("ResolvingTask", func() {
 		BeforeEach(func() {
 			taskDef = model_helpers.NewValidTaskDefinition()
-			err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+			_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 			Expect(err).NotTo(HaveOccurred())
 
-			_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+			_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
 		Context("when the task is complete", func() {
 			BeforeEach(func() {
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "because i said so", "a result")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "because i said so", "a result")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(task.TaskGuid).To(Equal(taskGuid))
 			})
 
 			It("swaps /task/<guid>'s state to resolving", func() {
-				err := etcdDB.ResolvingTask(logger, taskGuid)
+				_, _, err := etcdDB.ResolvingTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 
 				tasks := filterByState(models.Task_Resolving)
@@ -654,7 +1098,7 @@ var _ = Describe("TaskDB", func() {
 			It("bumps UpdatedAt", func() {
 				clock.IncrementBySeconds(1)
 
-				err := etcdDB.ResolvingTask(logger, taskGuid)
+				_, _, err := etcdDB.ResolvingTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 
 				tasks := filterByState(models.Task_Resolving)
@@ -663,12 +1107,12 @@ var _ = Describe("TaskDB", func() {
 
 			Context("when the Task is already resolving", func() {
 				BeforeEach(func() {
-					err := etcdDB.ResolvingTask(logger, taskGuid)
+					_, _, err := etcdDB.ResolvingTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 				})
 
 				It("fails", func() {
-					err := etcdDB.ResolvingTask(logger, taskGuid)
+					_, _, err := etcdDB.ResolvingTask(logger, taskGuid)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -676,7 +1120,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task is not complete", func() {
 			It("should fail", func() {
-				err := etcdDB.ResolvingTask(logger, taskGuid)
+				_, _, err := etcdDB.ResolvingTask(logger, taskGuid)
 				Expect(err).To(Equal(models.NewTaskTransitionError(models.Task_Running, models.Task_Resolving)))
 			})
 		})
@@ -685,26 +1129,28 @@ var _ = Describe("TaskDB", func() {
 	Describe("DeleteTask", func() {
 		BeforeEach(func() {
 			taskDef = model_helpers.NewValidTaskDefinition()
-			err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+			_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 			Expect(err).NotTo(HaveOccurred())
 
-			_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+			_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 			Expect(err).NotTo(HaveOccurred())
 
-			task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "because i said so", "a result")
+			_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "because i said so", "a result")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(task.TaskGuid).To(Equal(taskGuid))
 		})
 
 		Context("when the task is resolving", func() {
 			BeforeEach(func() {
-				err := etcdDB.ResolvingTask(logger, taskGuid)
+				_, _, err := etcdDB.ResolvingTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("should remove /task/<guid>", func() {
-				err := etcdDB.DeleteTask(logger, taskGuid)
+				task, err := etcdDB.DeleteTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
+				Expect(task.TaskGuid).To(Equal(taskGuid))
+				Expect(task.State).To(Equal(models.Task_Resolving))
 
 				tasks, err := etcdDB.Tasks(logger, models.TaskFilter{})
 				Expect(err).NotTo(HaveOccurred())
@@ -714,9 +1160,64 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task is not resolving", func() {
 			It("should fail", func() {
-				err := etcdDB.DeleteTask(logger, taskGuid)
+				_, err := etcdDB.DeleteTask(logger, taskGuid)
 				Expect(err).To(HaveOccurred())
 			})
 		})
 	})
+
+	Describe("PurgeCompletedTasks", func() {
+		const minAge = time.Hour
+
+		newTask := func(guid string, state models.Task_State, completedAgo time.Duration) *models.Task {
+			task := model_helpers.NewValidTask(guid)
+			task.State = state
+			task.FirstCompletedAt = clock.Now().Add(-completedAgo).UnixNano()
+			return task
+		}
+
+		BeforeEach(func() {
+			callbackTask := newTask("old-with-callback", models.Task_Completed, 2*minAge)
+			callbackTask.CompletionCallbackUrl = "http://example.com/callback"
+
+			for _, task := range []*models.Task{
+				newTask("old-completed", models.Task_Completed, 2*minAge),
+				newTask("old-resolving", models.Task_Resolving, 2*minAge),
+				newTask("new-completed", models.Task_Completed, minAge/2),
+				newTask("running", models.Task_Running, 2*minAge),
+				callbackTask,
+			} {
+				etcdHelper.SetRawTask(task)
+			}
+		})
+
+		It("deletes the old completed and resolving tasks without a callback and returns the count", func() {
+			purged, err := etcdDB.PurgeCompletedTasks(logger, minAge)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(purged).To(Equal(2))
+
+			tasks, err := etcdDB.Tasks(logger, models.TaskFilter{})
+			Expect(err).NotTo(HaveOccurred())
+
+			guids := []string{}
+			for _, task := range tasks {
+				guids = append(guids, task.TaskGuid)
+			}
+			Expect(guids).To(ConsistOf("new-completed", "running", "old-with-callback"))
+		})
+
+		Context("when there are no tasks", func() {
+			BeforeEach(func() {
+				for _, guid := range []string{"old-completed", "old-resolving", "new-completed", "running", "old-with-callback"} {
+					etcdHelper.DeleteTask(guid)
+				}
+			})
+
+			It("purges nothing", func() {
+				purged, err := etcdDB.PurgeCompletedTasks(logger, minAge)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(purged).To(BeZero())
+			})
+		})
+	})
 })
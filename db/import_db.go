@@ -0,0 +1,28 @@
+package db
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . ImportDB
+
+// ImportDB reads and writes the records of a backup of the store. Restored
+// Domains, DesiredLRPs, ActualLRPs and Tasks are written directly into the
+// store, without the auctions and events that creating them through the API
+// causes.
+type ImportDB interface {
+	// IsEmpty reports whether the store holds no Domains, DesiredLRPs,
+	// ActualLRPs or Tasks.
+	IsEmpty(logger lager.Logger) (bool, error)
+	// ImportRecords writes the validated records as a batch, replacing any
+	// existing record with the same domain, process guid or task guid. It
+	// returns an error for each record, in the same order, which is nil for
+	// the records that were written.
+	ImportRecords(logger lager.Logger, records []*models.ImportRecord) []error
+	// ExportRecords calls yield with every Domain, DesiredLRP, ActualLRP and
+	// Task in the store, all read as of a single point in time. Evacuating
+	// ActualLRPs are left out. It stops at the first error yield returns and
+	// returns it.
+	ExportRecords(logger lager.Logger, yield func(*models.ImportRecord) error) error
+}
@@ -6,13 +6,25 @@ import (
 	"code.cloudfoundry.org/lager"
 )
 
+// DomainLRPCounts are the number of desired instances and of running
+// instances of the LRPs in a domain.
+type DomainLRPCounts struct {
+	DesiredInstances int
+	RunningInstances int
+}
+
 //go:generate counterfeiter . LRPDB
 
 type LRPDB interface {
 	ActualLRPDB
 	DesiredLRPDB
 
-	ConvergeLRPs(logger lager.Logger, cellSet models.CellSet) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey)
+	// LRPCountsByDomain returns the desired and running instance counts of
+	// every domain that has a desired or a running LRP. The etcd DB returns
+	// those it counted during its last LRP convergence.
+	LRPCountsByDomain(logger lager.Logger) (map[string]DomainLRPCounts, error)
+
+	ConvergeLRPs(logger lager.Logger, cellSet models.CellSet, filter models.ConvergenceFilter) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey)
 
 	// Exposed For Test
 	GatherAndPruneLRPs(logger lager.Logger, cellSet models.CellSet) (*models.ConvergenceInput, error)
@@ -44,9 +44,10 @@ func (b *Base64ProtobufEncode) RequiresSQL() bool {
 	return false
 }
 
-func (b *Base64ProtobufEncode) SetRawSQLDB(*sql.DB)  {}
-func (b *Base64ProtobufEncode) SetClock(clock.Clock) {}
-func (b *Base64ProtobufEncode) SetDBFlavor(string)   {}
+func (b *Base64ProtobufEncode) SetRawSQLDB(*sql.DB)   {}
+func (b *Base64ProtobufEncode) SetClock(clock.Clock)  {}
+func (b *Base64ProtobufEncode) SetDBFlavor(string)    {}
+func (b *Base64ProtobufEncode) SetTablePrefix(string) {}
 
 func (b *Base64ProtobufEncode) Up(logger lager.Logger) error {
 	// Desired LRPs
@@ -46,6 +46,7 @@ func (m *SplitDesiredLRP) RequiresSQL() bool {
 func (m *SplitDesiredLRP) SetRawSQLDB(rawSQLDB *sql.DB) {}
 func (m *SplitDesiredLRP) SetClock(clock.Clock)         {}
 func (m *SplitDesiredLRP) SetDBFlavor(string)           {}
+func (m *SplitDesiredLRP) SetTablePrefix(string)        {}
 
 func (m *SplitDesiredLRP) Up(logger lager.Logger) error {
 	_, err := m.storeClient.Delete(etcd.DesiredLRPSchedulingInfoSchemaRoot, true)
@@ -45,6 +45,7 @@ func (a *AddCachedDependencies) RequiresSQL() bool {
 func (a *AddCachedDependencies) SetRawSQLDB(rawSQLDB *sql.DB) {}
 func (a *AddCachedDependencies) SetClock(clock.Clock)         {}
 func (a *AddCachedDependencies) SetDBFlavor(string)           {}
+func (a *AddCachedDependencies) SetTablePrefix(string)        {}
 
 func (a *AddCachedDependencies) Up(logger lager.Logger) error {
 	return nil
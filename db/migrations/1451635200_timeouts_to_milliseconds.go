@@ -110,9 +110,10 @@ func (b *TimeoutToMilliseconds) RequiresSQL() bool {
 	return false
 }
 
-func (b *TimeoutToMilliseconds) SetRawSQLDB(*sql.DB)  {}
-func (b *TimeoutToMilliseconds) SetClock(clock.Clock) {}
-func (b *TimeoutToMilliseconds) SetDBFlavor(string)   {}
+func (b *TimeoutToMilliseconds) SetRawSQLDB(*sql.DB)   {}
+func (b *TimeoutToMilliseconds) SetClock(clock.Clock)  {}
+func (b *TimeoutToMilliseconds) SetDBFlavor(string)    {}
+func (b *TimeoutToMilliseconds) SetTablePrefix(string) {}
 
 func updateTimeoutInAction(logger lager.Logger, action *models.Action) {
 	if action == nil {
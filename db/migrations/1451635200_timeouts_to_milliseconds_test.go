@@ -37,7 +37,7 @@ var _ = Describe("Change Timeouts to Milliseconds Migration", func() {
 		cryptor = encryption.NewCryptor(keyManager, rand.Reader)
 		serializer = format.NewSerializer(cryptor)
 		migration = migrations.NewTimeoutMilliseconds()
-		db = etcddb.NewETCD(format.ENCRYPTED_PROTO, 1, 1, 1*time.Minute, cryptor, storeClient, fakeClock)
+		db = etcddb.NewETCD(format.ENCRYPTED_PROTO, 1, 1, 1*time.Minute, 10*time.Minute, 0, cryptor, storeClient, storeClient, fakeClock)
 	})
 
 	It("appends itself to the migration list", func() {
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"path"
 	"time"
 
@@ -27,6 +28,7 @@ type ETCDToSQL struct {
 	clock       clock.Clock
 	rawSQLDB    *sql.DB
 	dbFlavor    string
+	tablePrefix string
 }
 
 type ETCDToSQLDesiredLRP struct {
@@ -116,23 +118,24 @@ func (e *ETCDToSQL) SetRawSQLDB(db *sql.DB) {
 	e.rawSQLDB = db
 }
 
-func (e *ETCDToSQL) RequiresSQL() bool         { return true }
-func (e *ETCDToSQL) SetClock(c clock.Clock)    { e.clock = c }
-func (e *ETCDToSQL) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+func (e *ETCDToSQL) RequiresSQL() bool            { return true }
+func (e *ETCDToSQL) SetClock(c clock.Clock)       { e.clock = c }
+func (e *ETCDToSQL) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *ETCDToSQL) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
 
 func (e *ETCDToSQL) Up(logger lager.Logger) error {
 	logger = logger.Session("etcd-to-sql")
 	logger.Info("truncating-tables")
 
 	// Ignore the error as the tables may not exist
-	_ = dropTables(e.rawSQLDB)
+	_ = dropTables(e.rawSQLDB, e.tablePrefix)
 
-	err := createTables(logger, e.rawSQLDB, e.dbFlavor)
+	err := createTables(logger, e.rawSQLDB, e.dbFlavor, e.tablePrefix)
 	if err != nil {
 		return err
 	}
 
-	err = createIndices(logger, e.rawSQLDB)
+	err = createIndices(logger, e.rawSQLDB, e.tablePrefix)
 	if err != nil {
 		return err
 	}
@@ -165,21 +168,23 @@ func (e *ETCDToSQL) Down(logger lager.Logger) error {
 	return errors.New("not implemented")
 }
 
-func dropTables(db *sql.DB) error {
+func dropTables(db *sql.DB, tablePrefix string) error {
 	tableNames := []string{
 		"domains",
 		"tasks",
 		"desired_lrps",
 		"actual_lrps",
+		"idempotency_keys",
+		"revisions",
 	}
 	for _, tableName := range tableNames {
 		var value int
 		// check whether the table exists before truncating
-		err := db.QueryRow("SELECT 1 FROM ? LIMIT 1;", tableName).Scan(&value)
+		err := db.QueryRow("SELECT 1 FROM ? LIMIT 1;", tablePrefix+tableName).Scan(&value)
 		if err == sql.ErrNoRows {
 			continue
 		}
-		_, err = db.Exec("DROP TABLE " + tableName)
+		_, err = db.Exec("DROP TABLE " + tablePrefix + tableName)
 		if err != nil {
 			return err
 		}
@@ -187,12 +192,12 @@ func dropTables(db *sql.DB) error {
 	return nil
 }
 
-func createTables(logger lager.Logger, db *sql.DB, flavor string) error {
+func createTables(logger lager.Logger, db *sql.DB, flavor, tablePrefix string) error {
 	var createTablesSQL = []string{
-		sqldb.RebindForFlavor(createDomainSQL, flavor),
-		sqldb.RebindForFlavor(createDesiredLRPsSQL, flavor),
-		sqldb.RebindForFlavor(createActualLRPsSQL, flavor),
-		sqldb.RebindForFlavor(createTasksSQL, flavor),
+		sqldb.RebindForFlavor(fmt.Sprintf(createDomainSQL, tablePrefix), flavor),
+		sqldb.RebindForFlavor(fmt.Sprintf(createDesiredLRPsSQL, tablePrefix), flavor),
+		sqldb.RebindForFlavor(fmt.Sprintf(createActualLRPsSQL, tablePrefix), flavor),
+		sqldb.RebindForFlavor(fmt.Sprintf(createTasksSQL, tablePrefix), flavor),
 	}
 
 	logger.Info("creating-tables")
@@ -209,7 +214,7 @@ func createTables(logger lager.Logger, db *sql.DB, flavor string) error {
 	return nil
 }
 
-func createIndices(logger lager.Logger, db *sql.DB) error {
+func createIndices(logger lager.Logger, db *sql.DB, tablePrefix string) error {
 	logger.Info("creating-indices")
 	createIndicesSQL := []string{}
 	createIndicesSQL = append(createIndicesSQL, createDomainsIndices...)
@@ -218,6 +223,7 @@ func createIndices(logger lager.Logger, db *sql.DB) error {
 	createIndicesSQL = append(createIndicesSQL, createTasksIndices...)
 
 	for _, query := range createIndicesSQL {
+		query = fmt.Sprintf(query, tablePrefix)
 		logger.Info("creating the index", lager.Data{"query": query})
 		_, err := db.Exec(query)
 		if err != nil {
@@ -246,7 +252,7 @@ func (e *ETCDToSQL) migrateDomains(logger lager.Logger) error {
 			expireTime := e.clock.Now().UnixNano() + int64(time.Second)*node.TTL
 
 			_, err := e.rawSQLDB.Exec(sqldb.RebindForFlavor(`
-				INSERT INTO domains
+				INSERT INTO `+e.tablePrefix+`domains
 				(domain, expire_time)
 				VALUES (?, ?)
 		  `, e.dbFlavor), domain, expireTime)
@@ -308,7 +314,7 @@ func (e *ETCDToSQL) migrateDesiredLRPs(logger lager.Logger) error {
 			}
 
 			_, err = e.rawSQLDB.Exec(sqldb.RebindForFlavor(`
-				INSERT INTO desired_lrps
+				INSERT INTO `+e.tablePrefix+`desired_lrps
 					(process_guid, domain, log_guid, annotation, instances, memory_mb,
 					disk_mb, rootfs, volume_placement, routes, modification_tag_epoch,
 					modification_tag_index, run_info)
@@ -354,7 +360,7 @@ func (e *ETCDToSQL) migrateActualLRPs(logger lager.Logger) error {
 						}
 
 						_, err = e.rawSQLDB.Exec(sqldb.RebindForFlavor(`
-							INSERT INTO actual_lrps
+							INSERT INTO `+e.tablePrefix+`actual_lrps
 								(process_guid, instance_index, domain, instance_guid, cell_id,
 								net_info, crash_count, crash_reason, state, placement_error, since,
 								modification_tag_epoch, modification_tag_index)
@@ -397,7 +403,7 @@ func (e *ETCDToSQL) migrateTasks(logger lager.Logger) error {
 			definitionData, err := e.serializer.Marshal(logger, format.ENCRYPTED_PROTO, task.TaskDefinition)
 
 			_, err = e.rawSQLDB.Exec(sqldb.RebindForFlavor(`
-							INSERT INTO tasks
+							INSERT INTO `+e.tablePrefix+`tasks
 								(guid, domain, updated_at, created_at, first_completed_at,
 								state, cell_id, result, failed, failure_reason,
 								task_definition)
@@ -416,12 +422,12 @@ func (e *ETCDToSQL) migrateTasks(logger lager.Logger) error {
 	return nil
 }
 
-const createDomainSQL = `CREATE TABLE domains(
+const createDomainSQL = `CREATE TABLE %sdomains(
 	domain VARCHAR(255) PRIMARY KEY,
 	expire_time BIGINT DEFAULT 0
 );`
 
-const createDesiredLRPsSQL = `CREATE TABLE desired_lrps(
+const createDesiredLRPsSQL = `CREATE TABLE %sdesired_lrps(
 	process_guid VARCHAR(255) PRIMARY KEY,
 	domain VARCHAR(255) NOT NULL,
 	log_guid VARCHAR(255) NOT NULL,
@@ -437,7 +443,7 @@ const createDesiredLRPsSQL = `CREATE TABLE desired_lrps(
 	run_info MEDIUMTEXT NOT NULL
 );`
 
-const createActualLRPsSQL = `CREATE TABLE actual_lrps(
+const createActualLRPsSQL = `CREATE TABLE %sactual_lrps(
 	process_guid VARCHAR(255),
 	instance_index INT,
 	evacuating BOOL DEFAULT false,
@@ -457,7 +463,7 @@ const createActualLRPsSQL = `CREATE TABLE actual_lrps(
 	PRIMARY KEY(process_guid, instance_index, evacuating)
 );`
 
-const createTasksSQL = `CREATE TABLE tasks(
+const createTasksSQL = `CREATE TABLE %stasks(
 	guid VARCHAR(255) PRIMARY KEY,
 	domain VARCHAR(255) NOT NULL,
 	updated_at BIGINT DEFAULT 0,
@@ -472,26 +478,26 @@ const createTasksSQL = `CREATE TABLE tasks(
 );`
 
 var createDomainsIndices = []string{
-	`CREATE INDEX domains_expire_time_idx ON domains (expire_time)`,
+	`CREATE INDEX %[1]sdomains_expire_time_idx ON %[1]sdomains (expire_time)`,
 }
 
 var createDesiredLRPsIndices = []string{
-	`CREATE INDEX desired_lrps_domain_idx ON desired_lrps (domain)`,
+	`CREATE INDEX %[1]sdesired_lrps_domain_idx ON %[1]sdesired_lrps (domain)`,
 }
 
 var createActualLRPsIndices = []string{
-	`CREATE INDEX actual_lrps_domain_idx ON actual_lrps (domain)`,
-	`CREATE INDEX actual_lrps_cell_id_idx ON actual_lrps (cell_id)`,
-	`CREATE INDEX actual_lrps_since_idx ON actual_lrps (since)`,
-	`CREATE INDEX actual_lrps_state_idx ON actual_lrps (state)`,
-	`CREATE INDEX actual_lrps_expire_time_idx ON actual_lrps (expire_time)`,
+	`CREATE INDEX %[1]sactual_lrps_domain_idx ON %[1]sactual_lrps (domain)`,
+	`CREATE INDEX %[1]sactual_lrps_cell_id_idx ON %[1]sactual_lrps (cell_id)`,
+	`CREATE INDEX %[1]sactual_lrps_since_idx ON %[1]sactual_lrps (since)`,
+	`CREATE INDEX %[1]sactual_lrps_state_idx ON %[1]sactual_lrps (state)`,
+	`CREATE INDEX %[1]sactual_lrps_expire_time_idx ON %[1]sactual_lrps (expire_time)`,
 }
 
 var createTasksIndices = []string{
-	`CREATE INDEX tasks_domain_idx ON tasks (domain)`,
-	`CREATE INDEX tasks_state_idx ON tasks (state)`,
-	`CREATE INDEX tasks_cell_id_idx ON tasks (cell_id)`,
-	`CREATE INDEX tasks_updated_at_idx ON tasks (updated_at)`,
-	`CREATE INDEX tasks_created_at_idx ON tasks (created_at)`,
-	`CREATE INDEX tasks_first_completed_at_idx ON tasks (first_completed_at)`,
+	`CREATE INDEX %[1]stasks_domain_idx ON %[1]stasks (domain)`,
+	`CREATE INDEX %[1]stasks_state_idx ON %[1]stasks (state)`,
+	`CREATE INDEX %[1]stasks_cell_id_idx ON %[1]stasks (cell_id)`,
+	`CREATE INDEX %[1]stasks_updated_at_idx ON %[1]stasks (updated_at)`,
+	`CREATE INDEX %[1]stasks_created_at_idx ON %[1]stasks (created_at)`,
+	`CREATE INDEX %[1]stasks_first_completed_at_idx ON %[1]stasks (first_completed_at)`,
 }
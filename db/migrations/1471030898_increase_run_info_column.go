@@ -3,6 +3,7 @@ package migrations
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"code.cloudfoundry.org/bbs/db/etcd"
 	"code.cloudfoundry.org/bbs/encryption"
@@ -22,6 +23,7 @@ type IncreaseRunInfoColumnSize struct {
 	clock       clock.Clock
 	rawSQLDB    *sql.DB
 	dbFlavor    string
+	tablePrefix string
 }
 
 func NewIncreaseRunInfoColumnSize() migration.Migration {
@@ -48,23 +50,24 @@ func (e *IncreaseRunInfoColumnSize) SetRawSQLDB(db *sql.DB) {
 	e.rawSQLDB = db
 }
 
-func (e *IncreaseRunInfoColumnSize) RequiresSQL() bool         { return true }
-func (e *IncreaseRunInfoColumnSize) SetClock(c clock.Clock)    { e.clock = c }
-func (e *IncreaseRunInfoColumnSize) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+func (e *IncreaseRunInfoColumnSize) RequiresSQL() bool            { return true }
+func (e *IncreaseRunInfoColumnSize) SetClock(c clock.Clock)       { e.clock = c }
+func (e *IncreaseRunInfoColumnSize) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *IncreaseRunInfoColumnSize) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
 
 func (e *IncreaseRunInfoColumnSize) Up(logger lager.Logger) error {
 	logger = logger.Session("increase-run-info-column")
 	logger.Info("starting")
 	defer logger.Info("completed")
 
-	return alterTables(logger, e.rawSQLDB, e.dbFlavor)
+	return alterTables(logger, e.rawSQLDB, e.dbFlavor, e.tablePrefix)
 }
 
 func (e *IncreaseRunInfoColumnSize) Down(logger lager.Logger) error {
 	return errors.New("not implemented")
 }
 
-func alterTables(logger lager.Logger, db *sql.DB, flavor string) error {
+func alterTables(logger lager.Logger, db *sql.DB, flavor, tablePrefix string) error {
 	if flavor != "mysql" {
 		return nil
 	}
@@ -77,6 +80,7 @@ func alterTables(logger lager.Logger, db *sql.DB, flavor string) error {
 
 	logger.Info("altering-tables")
 	for _, query := range alterTablesSQL {
+		query = fmt.Sprintf(query, tablePrefix)
 		logger.Info("altering the table", lager.Data{"query": query})
 		_, err := db.Exec(query)
 		if err != nil {
@@ -89,15 +93,15 @@ func alterTables(logger lager.Logger, db *sql.DB, flavor string) error {
 	return nil
 }
 
-const alterDesiredLRPsSQL = `ALTER TABLE desired_lrps
+const alterDesiredLRPsSQL = `ALTER TABLE %sdesired_lrps
 	MODIFY annotation MEDIUMTEXT,
 	MODIFY routes MEDIUMTEXT NOT NULL,
 	MODIFY volume_placement MEDIUMTEXT NOT NULL,
 	MODIFY run_info MEDIUMTEXT NOT NULL;`
 
-const alterActualLRPsSQL = `ALTER TABLE actual_lrps
+const alterActualLRPsSQL = `ALTER TABLE %sactual_lrps
 	MODIFY net_info MEDIUMTEXT NOT NULL;`
 
-const alterTasksSQL = `ALTER TABLE tasks
+const alterTasksSQL = `ALTER TABLE %stasks
 	MODIFY result MEDIUMTEXT,
 	MODIFY task_definition MEDIUMTEXT NOT NULL;`
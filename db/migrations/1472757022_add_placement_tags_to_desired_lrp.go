@@ -3,6 +3,7 @@ package migrations
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"code.cloudfoundry.org/bbs/db/etcd"
 	"code.cloudfoundry.org/bbs/encryption"
@@ -22,6 +23,7 @@ type AddPlacementTagsToDesiredLRPs struct {
 	clock       clock.Clock
 	rawSQLDB    *sql.DB
 	dbFlavor    string
+	tablePrefix string
 }
 
 func NewAddPlacementTagsToDesiredLRPs() migration.Migration {
@@ -48,23 +50,25 @@ func (e *AddPlacementTagsToDesiredLRPs) SetRawSQLDB(db *sql.DB) {
 	e.rawSQLDB = db
 }
 
-func (e *AddPlacementTagsToDesiredLRPs) RequiresSQL() bool         { return true }
-func (e *AddPlacementTagsToDesiredLRPs) SetClock(c clock.Clock)    { e.clock = c }
-func (e *AddPlacementTagsToDesiredLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+func (e *AddPlacementTagsToDesiredLRPs) RequiresSQL() bool            { return true }
+func (e *AddPlacementTagsToDesiredLRPs) SetClock(c clock.Clock)       { e.clock = c }
+func (e *AddPlacementTagsToDesiredLRPs) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *AddPlacementTagsToDesiredLRPs) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
 
 func (e *AddPlacementTagsToDesiredLRPs) Up(logger lager.Logger) error {
-	logger.Info("altering the table", lager.Data{"query": alterDesiredLRPAddPlacementTagSQL})
-	_, err := e.rawSQLDB.Exec(alterDesiredLRPAddPlacementTagSQL)
+	query := fmt.Sprintf(alterDesiredLRPAddPlacementTagSQL, e.tablePrefix)
+	logger.Info("altering the table", lager.Data{"query": query})
+	_, err := e.rawSQLDB.Exec(query)
 	if err != nil {
 		logger.Error("failed-altering-tables", err)
 		return err
 	}
-	logger.Info("altered the table", lager.Data{"query": alterDesiredLRPAddPlacementTagSQL})
+	logger.Info("altered the table", lager.Data{"query": query})
 
 	return nil
 }
 
-const alterDesiredLRPAddPlacementTagSQL = `ALTER TABLE desired_lrps
+const alterDesiredLRPAddPlacementTagSQL = `ALTER TABLE %sdesired_lrps
 	ADD COLUMN placement_tags TEXT;`
 
 func (e *AddPlacementTagsToDesiredLRPs) Down(logger lager.Logger) error {
@@ -3,6 +3,7 @@ package migrations
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"code.cloudfoundry.org/bbs/db/etcd"
 	"code.cloudfoundry.org/bbs/encryption"
@@ -22,6 +23,7 @@ type IncreaseErrorColumnsSize struct {
 	clock       clock.Clock
 	rawSQLDB    *sql.DB
 	dbFlavor    string
+	tablePrefix string
 }
 
 func NewIncreaseErrorColumnsSize() migration.Migration {
@@ -48,9 +50,10 @@ func (e *IncreaseErrorColumnsSize) SetRawSQLDB(db *sql.DB) {
 	e.rawSQLDB = db
 }
 
-func (e *IncreaseErrorColumnsSize) RequiresSQL() bool         { return true }
-func (e *IncreaseErrorColumnsSize) SetClock(c clock.Clock)    { e.clock = c }
-func (e *IncreaseErrorColumnsSize) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+func (e *IncreaseErrorColumnsSize) RequiresSQL() bool            { return true }
+func (e *IncreaseErrorColumnsSize) SetClock(c clock.Clock)       { e.clock = c }
+func (e *IncreaseErrorColumnsSize) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *IncreaseErrorColumnsSize) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
 
 func (e *IncreaseErrorColumnsSize) Up(logger lager.Logger) error {
 	logger = logger.Session("increase-run-info-column")
@@ -68,16 +71,18 @@ func (e *IncreaseErrorColumnsSize) alterTables(logger lager.Logger, db *sql.DB,
 	var alterActualLRPsSQL string
 
 	if e.dbFlavor == "mysql" {
-		alterActualLRPsSQL = `ALTER TABLE actual_lrps
+		alterActualLRPsSQL = `ALTER TABLE %sactual_lrps
 	MODIFY crash_reason VARCHAR(1024) NOT NULL DEFAULT '',
 	MODIFY placement_error VARCHAR(1024) NOT NULL DEFAULT ''`
 
 	} else {
-		alterActualLRPsSQL = `ALTER TABLE actual_lrps
+		alterActualLRPsSQL = `ALTER TABLE %sactual_lrps
 	ALTER crash_reason TYPE VARCHAR(1024),
 	ALTER placement_error TYPE VARCHAR(1024)`
 	}
 
+	alterActualLRPsSQL = fmt.Sprintf(alterActualLRPsSQL, e.tablePrefix)
+
 	logger.Info("altering-tables")
 	logger.Info("altering the table", lager.Data{"query": alterActualLRPsSQL})
 	_, err := db.Exec(alterActualLRPsSQL)
@@ -25,6 +25,7 @@ type EncryptRoutes struct {
 	clock       clock.Clock
 	rawSQLDB    *sql.DB
 	dbFlavor    string
+	tablePrefix string
 }
 
 func NewEncryptRoutes() migration.Migration {
@@ -51,16 +52,17 @@ func (e *EncryptRoutes) SetRawSQLDB(db *sql.DB) {
 	e.rawSQLDB = db
 }
 
-func (e *EncryptRoutes) RequiresSQL() bool         { return true }
-func (e *EncryptRoutes) SetClock(c clock.Clock)    { e.clock = c }
-func (e *EncryptRoutes) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+func (e *EncryptRoutes) RequiresSQL() bool            { return true }
+func (e *EncryptRoutes) SetClock(c clock.Clock)       { e.clock = c }
+func (e *EncryptRoutes) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *EncryptRoutes) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
 
 func (e *EncryptRoutes) Up(logger lager.Logger) error {
 	logger = logger.Session("encrypt-route-column")
 	logger.Info("starting")
 	defer logger.Info("completed")
 
-	query := fmt.Sprintf("SELECT process_guid, routes FROM desired_lrps")
+	query := fmt.Sprintf("SELECT process_guid, routes FROM %sdesired_lrps", e.tablePrefix)
 
 	rows, err := e.rawSQLDB.Query(query)
 	if err != nil {
@@ -85,7 +87,7 @@ func (e *EncryptRoutes) Up(logger lager.Logger) error {
 		}
 
 		bindings := make([]interface{}, 0, 3)
-		updateQuery := fmt.Sprintf("UPDATE desired_lrps SET routes = ? WHERE process_guid = ?")
+		updateQuery := fmt.Sprintf("UPDATE %sdesired_lrps SET routes = ? WHERE process_guid = ?", e.tablePrefix)
 		bindings = append(bindings, encodedData)
 		bindings = append(bindings, processGuid)
 		_, err = e.rawSQLDB.Exec(sqldb.RebindForFlavor(updateQuery, e.dbFlavor), bindings...)
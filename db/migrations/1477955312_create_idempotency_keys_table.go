@@ -0,0 +1,87 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewCreateIdempotencyKeysTable())
+}
+
+type CreateIdempotencyKeysTable struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewCreateIdempotencyKeysTable() migration.Migration {
+	return &CreateIdempotencyKeysTable{}
+}
+
+func (e *CreateIdempotencyKeysTable) String() string {
+	return "1477955312"
+}
+
+func (e *CreateIdempotencyKeysTable) Version() int64 {
+	return 1477955312
+}
+
+func (e *CreateIdempotencyKeysTable) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *CreateIdempotencyKeysTable) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateIdempotencyKeysTable) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *CreateIdempotencyKeysTable) RequiresSQL() bool            { return true }
+func (e *CreateIdempotencyKeysTable) SetClock(c clock.Clock)       { e.clock = c }
+func (e *CreateIdempotencyKeysTable) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *CreateIdempotencyKeysTable) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *CreateIdempotencyKeysTable) Up(logger lager.Logger) error {
+	logger = logger.Session("create-idempotency-keys-table")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	for _, query := range createIdempotencyKeysTableSQL {
+		query = fmt.Sprintf(query, e.tablePrefix)
+		logger.Info("executing-query", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-executing-query", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *CreateIdempotencyKeysTable) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
+
+var createIdempotencyKeysTableSQL = []string{
+	`CREATE TABLE %sidempotency_keys(
+	idempotency_key VARCHAR(255) PRIMARY KEY,
+	fingerprint VARCHAR(255) NOT NULL,
+	expire_time BIGINT DEFAULT 0
+);`,
+	`CREATE INDEX %[1]sidempotency_keys_expire_time_idx ON %[1]sidempotency_keys (expire_time)`,
+}
@@ -0,0 +1,92 @@
+package migrations_test
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Create Idempotency Keys Table", func() {
+	if test_helpers.UseSQL() {
+		var (
+			mig    migration.Migration
+			flavor string
+			migErr error
+		)
+
+		BeforeEach(func() {
+			flavor = os.Getenv("USE_SQL")
+			rawSQLDB.Exec("DROP TABLE idempotency_keys;")
+
+			mig = migrations.NewCreateIdempotencyKeysTable()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(migrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1477955312))
+			})
+		})
+
+		Describe("Up", func() {
+			BeforeEach(func() {
+				// Can't do this in the Describe BeforeEach
+				// as the test on line 29 will cause ginkgo to panic
+				mig.SetRawSQLDB(rawSQLDB)
+				mig.SetDBFlavor(flavor)
+			})
+
+			JustBeforeEach(func() {
+				migErr = mig.Up(logger)
+			})
+
+			It("does not error out", func() {
+				Expect(migErr).NotTo(HaveOccurred())
+			})
+
+			It("creates the idempotency_keys table", func() {
+				_, err := rawSQLDB.Exec(
+					sqldb.RebindForFlavor(
+						"INSERT INTO idempotency_keys (idempotency_key, fingerprint, expire_time) VALUES (?, ?, ?)",
+						flavor,
+					),
+					"some-key", "some-fingerprint", 1234,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				var fingerprint string
+				var expireTime int64
+				query := sqldb.RebindForFlavor("SELECT fingerprint, expire_time FROM idempotency_keys WHERE idempotency_key = ?", flavor)
+				row := rawSQLDB.QueryRow(query, "some-key")
+				Expect(row.Scan(&fingerprint, &expireTime)).To(Succeed())
+				Expect(fingerprint).To(Equal("some-fingerprint"))
+				Expect(expireTime).To(BeEquivalentTo(1234))
+			})
+
+			It("rejects a duplicate key", func() {
+				query := sqldb.RebindForFlavor(
+					"INSERT INTO idempotency_keys (idempotency_key, fingerprint, expire_time) VALUES (?, ?, ?)",
+					flavor,
+				)
+				_, err := rawSQLDB.Exec(query, "some-key", "some-fingerprint", 1234)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = rawSQLDB.Exec(query, "some-key", "other-fingerprint", 1234)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("Down", func() {
+			It("returns a not implemented error", func() {
+				Expect(mig.Down(logger)).To(HaveOccurred())
+			})
+		})
+	}
+})
@@ -0,0 +1,88 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewCreateRevisionsTable())
+}
+
+type CreateRevisionsTable struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewCreateRevisionsTable() migration.Migration {
+	return &CreateRevisionsTable{}
+}
+
+func (e *CreateRevisionsTable) String() string {
+	return "1477966104"
+}
+
+func (e *CreateRevisionsTable) Version() int64 {
+	return 1477966104
+}
+
+func (e *CreateRevisionsTable) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *CreateRevisionsTable) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateRevisionsTable) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *CreateRevisionsTable) RequiresSQL() bool            { return true }
+func (e *CreateRevisionsTable) SetClock(c clock.Clock)       { e.clock = c }
+func (e *CreateRevisionsTable) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *CreateRevisionsTable) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *CreateRevisionsTable) Up(logger lager.Logger) error {
+	logger = logger.Session("create-revisions-table")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	for _, query := range createRevisionsTableSQL {
+		query = fmt.Sprintf(query, e.tablePrefix)
+		logger.Info("executing-query", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-executing-query", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *CreateRevisionsTable) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
+
+// The desired LRPs revision starts at 0 and is increased by every write to
+// the desired_lrps table, so that clients can tell when it has changed.
+var createRevisionsTableSQL = []string{
+	`CREATE TABLE %srevisions(
+	resource VARCHAR(255) PRIMARY KEY,
+	revision BIGINT NOT NULL DEFAULT 0
+);`,
+	`INSERT INTO %srevisions (resource, revision) VALUES ('desired_lrps', 0)`,
+}
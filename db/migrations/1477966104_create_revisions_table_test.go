@@ -0,0 +1,68 @@
+package migrations_test
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Create Revisions Table", func() {
+	if test_helpers.UseSQL() {
+		var (
+			mig    migration.Migration
+			flavor string
+			migErr error
+		)
+
+		BeforeEach(func() {
+			flavor = os.Getenv("USE_SQL")
+			rawSQLDB.Exec("DROP TABLE revisions;")
+
+			mig = migrations.NewCreateRevisionsTable()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(migrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1477966104))
+			})
+		})
+
+		Describe("Up", func() {
+			BeforeEach(func() {
+				// Can't do this in the Describe BeforeEach
+				// as the test on line 29 will cause ginkgo to panic
+				mig.SetRawSQLDB(rawSQLDB)
+				mig.SetDBFlavor(flavor)
+			})
+
+			JustBeforeEach(func() {
+				migErr = mig.Up(logger)
+			})
+
+			It("does not error out", func() {
+				Expect(migErr).NotTo(HaveOccurred())
+			})
+
+			It("starts the desired LRPs revision at 0", func() {
+				var revision int64
+				row := rawSQLDB.QueryRow("SELECT revision FROM revisions WHERE resource = 'desired_lrps'")
+				Expect(row.Scan(&revision)).To(Succeed())
+				Expect(revision).To(BeEquivalentTo(0))
+			})
+		})
+
+		Describe("Down", func() {
+			It("returns a not implemented error", func() {
+				Expect(mig.Down(logger)).To(HaveOccurred())
+			})
+		})
+	}
+})
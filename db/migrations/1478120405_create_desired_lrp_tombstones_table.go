@@ -0,0 +1,102 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewCreateDesiredLRPTombstonesTable())
+}
+
+type CreateDesiredLRPTombstonesTable struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewCreateDesiredLRPTombstonesTable() migration.Migration {
+	return &CreateDesiredLRPTombstonesTable{}
+}
+
+func (e *CreateDesiredLRPTombstonesTable) String() string {
+	return "1478120405"
+}
+
+func (e *CreateDesiredLRPTombstonesTable) Version() int64 {
+	return 1478120405
+}
+
+func (e *CreateDesiredLRPTombstonesTable) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *CreateDesiredLRPTombstonesTable) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateDesiredLRPTombstonesTable) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *CreateDesiredLRPTombstonesTable) RequiresSQL() bool            { return true }
+func (e *CreateDesiredLRPTombstonesTable) SetClock(c clock.Clock)       { e.clock = c }
+func (e *CreateDesiredLRPTombstonesTable) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *CreateDesiredLRPTombstonesTable) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *CreateDesiredLRPTombstonesTable) Up(logger lager.Logger) error {
+	logger = logger.Session("create-desired-lrp-tombstones-table")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	for _, query := range createDesiredLRPTombstonesTableSQL {
+		query = sqldb.RebindForFlavor(fmt.Sprintf(query, e.tablePrefix), e.dbFlavor)
+		logger.Info("executing-query", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-executing-query", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *CreateDesiredLRPTombstonesTable) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
+
+// A tombstone is a copy of a removed desired LRP, with the time it was
+// removed, kept until convergence purges it after the retention window.
+var createDesiredLRPTombstonesTableSQL = []string{
+	`CREATE TABLE %sdesired_lrp_tombstones(
+	process_guid VARCHAR(255) PRIMARY KEY,
+	domain VARCHAR(255) NOT NULL,
+	log_guid VARCHAR(255) NOT NULL,
+	annotation MEDIUMTEXT,
+	instances INT NOT NULL,
+	memory_mb INT NOT NULL,
+	disk_mb INT NOT NULL,
+	rootfs VARCHAR(255) NOT NULL,
+	routes MEDIUMTEXT NOT NULL,
+	volume_placement MEDIUMTEXT NOT NULL,
+	modification_tag_epoch VARCHAR(255) NOT NULL,
+	modification_tag_index INT,
+	run_info MEDIUMTEXT NOT NULL,
+	placement_tags TEXT,
+	deleted_at BIGINT NOT NULL DEFAULT 0
+);`,
+	`CREATE INDEX %[1]sdesired_lrp_tombstones_deleted_at_idx ON %[1]sdesired_lrp_tombstones (deleted_at)`,
+}
@@ -0,0 +1,78 @@
+package migrations_test
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Create Desired LRP Tombstones Table", func() {
+	if test_helpers.UseSQL() {
+		var (
+			mig    migration.Migration
+			flavor string
+			migErr error
+		)
+
+		BeforeEach(func() {
+			flavor = os.Getenv("USE_SQL")
+			rawSQLDB.Exec("DROP TABLE desired_lrp_tombstones;")
+
+			mig = migrations.NewCreateDesiredLRPTombstonesTable()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(migrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1478120405))
+			})
+		})
+
+		Describe("Up", func() {
+			BeforeEach(func() {
+				mig.SetRawSQLDB(rawSQLDB)
+				mig.SetDBFlavor(flavor)
+			})
+
+			JustBeforeEach(func() {
+				migErr = mig.Up(logger)
+			})
+
+			It("does not error out", func() {
+				Expect(migErr).NotTo(HaveOccurred())
+			})
+
+			It("creates an empty desired LRP tombstones table", func() {
+				var count int
+				row := rawSQLDB.QueryRow("SELECT COUNT(*) FROM desired_lrp_tombstones")
+				Expect(row.Scan(&count)).To(Succeed())
+				Expect(count).To(Equal(0))
+			})
+
+			It("defaults the deletion time to 0", func() {
+				_, err := rawSQLDB.Exec(`INSERT INTO desired_lrp_tombstones
+					(process_guid, domain, log_guid, instances, memory_mb, disk_mb, rootfs, routes, volume_placement, modification_tag_epoch, run_info)
+					VALUES ('guid', 'domain', 'log-guid', 1, 1, 1, 'rootfs', 'routes', 'placement', 'epoch', 'run-info')`)
+				Expect(err).NotTo(HaveOccurred())
+
+				var deletedAt int64
+				row := rawSQLDB.QueryRow("SELECT deleted_at FROM desired_lrp_tombstones WHERE process_guid = 'guid'")
+				Expect(row.Scan(&deletedAt)).To(Succeed())
+				Expect(deletedAt).To(BeEquivalentTo(0))
+			})
+		})
+
+		Describe("Down", func() {
+			It("returns a not implemented error", func() {
+				Expect(mig.Down(logger)).To(HaveOccurred())
+			})
+		})
+	}
+})
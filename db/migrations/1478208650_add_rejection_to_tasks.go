@@ -0,0 +1,77 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddRejectionToTasks())
+}
+
+type AddRejectionToTasks struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewAddRejectionToTasks() migration.Migration {
+	return &AddRejectionToTasks{}
+}
+
+func (e *AddRejectionToTasks) String() string {
+	return "1478208650"
+}
+
+func (e *AddRejectionToTasks) Version() int64 {
+	return 1478208650
+}
+
+func (e *AddRejectionToTasks) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddRejectionToTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRejectionToTasks) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddRejectionToTasks) RequiresSQL() bool            { return true }
+func (e *AddRejectionToTasks) SetClock(c clock.Clock)       { e.clock = c }
+func (e *AddRejectionToTasks) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *AddRejectionToTasks) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *AddRejectionToTasks) Up(logger lager.Logger) error {
+	query := fmt.Sprintf(alterTasksAddRejectionSQL, e.tablePrefix)
+	logger.Info("altering the table", lager.Data{"query": query})
+	_, err := e.rawSQLDB.Exec(query)
+	if err != nil {
+		logger.Error("failed-altering-tables", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": query})
+
+	return nil
+}
+
+const alterTasksAddRejectionSQL = `ALTER TABLE %stasks
+	ADD COLUMN rejection_count INTEGER NOT NULL DEFAULT 0,
+	ADD COLUMN rejection_reason VARCHAR(1024) NOT NULL DEFAULT '';`
+
+func (e *AddRejectionToTasks) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
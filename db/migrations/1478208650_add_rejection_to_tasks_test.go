@@ -0,0 +1,91 @@
+package migrations_test
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Rejection to Tasks", func() {
+	if test_helpers.UseSQL() {
+		var (
+			mig       migration.Migration
+			flavor    string
+			migErr    error
+			fakeClock *fakeclock.FakeClock
+		)
+
+		BeforeEach(func() {
+			fakeClock = fakeclock.NewFakeClock(time.Now())
+			flavor = os.Getenv("USE_SQL")
+			rawSQLDB.Exec("DROP TABLE domains;")
+			rawSQLDB.Exec("DROP TABLE tasks;")
+			rawSQLDB.Exec("DROP TABLE desired_lrps;")
+			rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+			mig = migrations.NewAddRejectionToTasks()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(migrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1478208650))
+			})
+		})
+
+		Describe("Up", func() {
+			BeforeEach(func() {
+				etcdToSQL := migrations.NewETCDToSQL()
+				etcdToSQL.SetRawSQLDB(rawSQLDB)
+				etcdToSQL.SetDBFlavor(flavor)
+				etcdToSQL.SetClock(fakeClock)
+				Expect(etcdToSQL.Up(logger)).To(Succeed())
+
+				mig.SetRawSQLDB(rawSQLDB)
+				mig.SetDBFlavor(flavor)
+			})
+
+			JustBeforeEach(func() {
+				migErr = mig.Up(logger)
+			})
+
+			It("does not error out", func() {
+				Expect(migErr).NotTo(HaveOccurred())
+			})
+
+			It("adds rejection columns to tasks that default to no rejections", func() {
+				_, err := rawSQLDB.Exec(
+					sqldb.RebindForFlavor(
+						`INSERT INTO tasks (guid, domain, task_definition) VALUES (?, ?, ?)`,
+						flavor,
+					),
+					"task-guid", "domain", "task definition",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				var rejectionCount int
+				var rejectionReason string
+				row := rawSQLDB.QueryRow("SELECT rejection_count, rejection_reason FROM tasks LIMIT 1")
+				Expect(row.Scan(&rejectionCount, &rejectionReason)).To(Succeed())
+				Expect(rejectionCount).To(Equal(0))
+				Expect(rejectionReason).To(BeEmpty())
+			})
+		})
+
+		Describe("Down", func() {
+			It("returns a not implemented error", func() {
+				Expect(mig.Down(logger)).To(HaveOccurred())
+			})
+		})
+	}
+})
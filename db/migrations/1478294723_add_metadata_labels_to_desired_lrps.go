@@ -0,0 +1,84 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddMetadataLabelsToDesiredLRPs())
+}
+
+type AddMetadataLabelsToDesiredLRPs struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewAddMetadataLabelsToDesiredLRPs() migration.Migration {
+	return &AddMetadataLabelsToDesiredLRPs{}
+}
+
+func (e *AddMetadataLabelsToDesiredLRPs) String() string {
+	return "1478294723"
+}
+
+func (e *AddMetadataLabelsToDesiredLRPs) Version() int64 {
+	return 1478294723
+}
+
+func (e *AddMetadataLabelsToDesiredLRPs) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddMetadataLabelsToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddMetadataLabelsToDesiredLRPs) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddMetadataLabelsToDesiredLRPs) RequiresSQL() bool            { return true }
+func (e *AddMetadataLabelsToDesiredLRPs) SetClock(c clock.Clock)       { e.clock = c }
+func (e *AddMetadataLabelsToDesiredLRPs) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *AddMetadataLabelsToDesiredLRPs) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *AddMetadataLabelsToDesiredLRPs) Up(logger lager.Logger) error {
+	for _, statement := range alterDesiredLRPsAddMetadataLabelsSQL {
+		query := fmt.Sprintf(statement, e.tablePrefix)
+		logger.Info("altering the table", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-altering-tables", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": query})
+	}
+
+	return nil
+}
+
+// Tombstones are copied from desired_lrps column by column, so both tables
+// gain the column together.
+var alterDesiredLRPsAddMetadataLabelsSQL = []string{
+	`ALTER TABLE %sdesired_lrps
+	ADD COLUMN metadata_labels TEXT;`,
+	`ALTER TABLE %sdesired_lrp_tombstones
+	ADD COLUMN metadata_labels TEXT;`,
+}
+
+func (e *AddMetadataLabelsToDesiredLRPs) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
@@ -0,0 +1,145 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewCreateDesiredLRPLabelsTable())
+}
+
+type CreateDesiredLRPLabelsTable struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewCreateDesiredLRPLabelsTable() migration.Migration {
+	return &CreateDesiredLRPLabelsTable{}
+}
+
+func (e *CreateDesiredLRPLabelsTable) String() string {
+	return "1478381517"
+}
+
+func (e *CreateDesiredLRPLabelsTable) Version() int64 {
+	return 1478381517
+}
+
+func (e *CreateDesiredLRPLabelsTable) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *CreateDesiredLRPLabelsTable) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateDesiredLRPLabelsTable) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *CreateDesiredLRPLabelsTable) RequiresSQL() bool            { return true }
+func (e *CreateDesiredLRPLabelsTable) SetClock(c clock.Clock)       { e.clock = c }
+func (e *CreateDesiredLRPLabelsTable) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *CreateDesiredLRPLabelsTable) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *CreateDesiredLRPLabelsTable) Up(logger lager.Logger) error {
+	logger = logger.Session("create-desired-lrp-labels-table")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	for _, query := range createDesiredLRPLabelsTableSQL {
+		query = sqldb.RebindForFlavor(fmt.Sprintf(query, e.tablePrefix), e.dbFlavor)
+		logger.Info("executing-query", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-executing-query", err)
+			return err
+		}
+	}
+
+	return e.copyMetadataLabels(logger)
+}
+
+// copyMetadataLabels fills the new table with the labels of the desired LRPs
+// that were desired since metadata labels were added.
+func (e *CreateDesiredLRPLabelsTable) copyMetadataLabels(logger lager.Logger) error {
+	query := fmt.Sprintf("SELECT process_guid, metadata_labels FROM %sdesired_lrps WHERE metadata_labels IS NOT NULL", e.tablePrefix)
+	rows, err := e.rawSQLDB.Query(query)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return err
+	}
+	defer rows.Close()
+
+	labelsByGuid := map[string]map[string]string{}
+	for rows.Next() {
+		var processGuid string
+		var labelData []byte
+		err := rows.Scan(&processGuid, &labelData)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+
+		var labels map[string]string
+		err = json.Unmarshal(labelData, &labels)
+		if err != nil {
+			logger.Error("failed-parsing-metadata-labels", err, lager.Data{"process_guid": processGuid})
+			continue
+		}
+		labelsByGuid[processGuid] = labels
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return rows.Err()
+	}
+
+	insertQuery := sqldb.RebindForFlavor(
+		fmt.Sprintf("INSERT INTO %sdesired_lrp_labels (process_guid, label_key, label_value) VALUES (?, ?, ?)", e.tablePrefix),
+		e.dbFlavor,
+	)
+	for processGuid, labels := range labelsByGuid {
+		for key, value := range labels {
+			_, err := e.rawSQLDB.Exec(insertQuery, processGuid, key, value)
+			if err != nil {
+				logger.Error("failed-inserting-label", err, lager.Data{"process_guid": processGuid})
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// The labels of each desired LRP are kept in a row per label, indexed by key
+// and value, so that listings can be filtered by a label selector in SQL. The
+// metadata_labels column of the desired LRP stays the copy it is read from.
+var createDesiredLRPLabelsTableSQL = []string{
+	`CREATE TABLE %sdesired_lrp_labels(
+	process_guid VARCHAR(255) NOT NULL,
+	label_key VARCHAR(63) NOT NULL,
+	label_value VARCHAR(255) NOT NULL,
+	PRIMARY KEY (process_guid, label_key)
+);`,
+	`CREATE INDEX %[1]sdesired_lrp_labels_key_value_idx ON %[1]sdesired_lrp_labels (label_key, label_value)`,
+}
+
+func (e *CreateDesiredLRPLabelsTable) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
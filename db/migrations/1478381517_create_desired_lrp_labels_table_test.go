@@ -0,0 +1,106 @@
+package migrations_test
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Create Desired LRP Labels Table", func() {
+	if test_helpers.UseSQL() {
+		var (
+			mig       migration.Migration
+			flavor    string
+			migErr    error
+			fakeClock *fakeclock.FakeClock
+		)
+
+		BeforeEach(func() {
+			fakeClock = fakeclock.NewFakeClock(time.Now())
+			flavor = os.Getenv("USE_SQL")
+			rawSQLDB.Exec("DROP TABLE domains;")
+			rawSQLDB.Exec("DROP TABLE tasks;")
+			rawSQLDB.Exec("DROP TABLE desired_lrps;")
+			rawSQLDB.Exec("DROP TABLE actual_lrps;")
+			rawSQLDB.Exec("DROP TABLE desired_lrp_tombstones;")
+			rawSQLDB.Exec("DROP TABLE desired_lrp_labels;")
+
+			mig = migrations.NewCreateDesiredLRPLabelsTable()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(migrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1478381517))
+			})
+		})
+
+		Describe("Up", func() {
+			BeforeEach(func() {
+				for _, earlier := range []migration.Migration{
+					migrations.NewETCDToSQL(),
+					migrations.NewCreateDesiredLRPTombstonesTable(),
+					migrations.NewAddMetadataLabelsToDesiredLRPs(),
+				} {
+					earlier.SetRawSQLDB(rawSQLDB)
+					earlier.SetDBFlavor(flavor)
+					earlier.SetClock(fakeClock)
+					Expect(earlier.Up(logger)).To(Succeed())
+				}
+
+				_, err := rawSQLDB.Exec(
+					sqldb.RebindForFlavor(
+						`INSERT INTO desired_lrps
+						(process_guid, domain, log_guid, instances, memory_mb, disk_mb, rootfs, routes, volume_placement, modification_tag_epoch, run_info, metadata_labels)
+						VALUES (?, 'domain', 'log-guid', 1, 1, 1, 'rootfs', 'routes', 'placement', 'epoch', 'run-info', ?)`,
+						flavor,
+					),
+					"labelled-guid", `{"team":"payments","env":"prod"}`,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				mig.SetRawSQLDB(rawSQLDB)
+				mig.SetDBFlavor(flavor)
+			})
+
+			JustBeforeEach(func() {
+				migErr = mig.Up(logger)
+			})
+
+			It("does not error out", func() {
+				Expect(migErr).NotTo(HaveOccurred())
+			})
+
+			It("copies the metadata labels of existing desired LRPs into the labels table", func() {
+				rows, err := rawSQLDB.Query("SELECT process_guid, label_key, label_value FROM desired_lrp_labels")
+				Expect(err).NotTo(HaveOccurred())
+				defer rows.Close()
+
+				labels := map[string]string{}
+				for rows.Next() {
+					var processGuid, key, value string
+					Expect(rows.Scan(&processGuid, &key, &value)).To(Succeed())
+					Expect(processGuid).To(Equal("labelled-guid"))
+					labels[key] = value
+				}
+				Expect(labels).To(Equal(map[string]string{"team": "payments", "env": "prod"}))
+			})
+		})
+
+		Describe("Down", func() {
+			It("returns a not implemented error", func() {
+				Expect(mig.Down(logger)).To(HaveOccurred())
+			})
+		})
+	}
+})
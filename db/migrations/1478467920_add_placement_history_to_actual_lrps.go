@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddPlacementHistoryToActualLRPs())
+}
+
+type AddPlacementHistoryToActualLRPs struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewAddPlacementHistoryToActualLRPs() migration.Migration {
+	return &AddPlacementHistoryToActualLRPs{}
+}
+
+func (e *AddPlacementHistoryToActualLRPs) String() string {
+	return "1478467920"
+}
+
+func (e *AddPlacementHistoryToActualLRPs) Version() int64 {
+	return 1478467920
+}
+
+func (e *AddPlacementHistoryToActualLRPs) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddPlacementHistoryToActualLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddPlacementHistoryToActualLRPs) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddPlacementHistoryToActualLRPs) RequiresSQL() bool            { return true }
+func (e *AddPlacementHistoryToActualLRPs) SetClock(c clock.Clock)       { e.clock = c }
+func (e *AddPlacementHistoryToActualLRPs) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *AddPlacementHistoryToActualLRPs) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *AddPlacementHistoryToActualLRPs) Up(logger lager.Logger) error {
+	query := fmt.Sprintf(alterActualLRPsAddPlacementHistorySQL, e.tablePrefix)
+	logger.Info("altering the table", lager.Data{"query": query})
+	_, err := e.rawSQLDB.Exec(query)
+	if err != nil {
+		logger.Error("failed-altering-tables", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": query})
+
+	return nil
+}
+
+const alterActualLRPsAddPlacementHistorySQL = `ALTER TABLE %sactual_lrps
+	ADD COLUMN placement_history TEXT;`
+
+func (e *AddPlacementHistoryToActualLRPs) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
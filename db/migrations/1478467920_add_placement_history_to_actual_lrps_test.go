@@ -0,0 +1,91 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Placement History to Actual LRPs", func() {
+	if test_helpers.UseSQL() {
+		var (
+			mig       migration.Migration
+			flavor    string
+			migErr    error
+			fakeClock *fakeclock.FakeClock
+		)
+
+		BeforeEach(func() {
+			fakeClock = fakeclock.NewFakeClock(time.Now())
+			flavor = os.Getenv("USE_SQL")
+			rawSQLDB.Exec("DROP TABLE domains;")
+			rawSQLDB.Exec("DROP TABLE tasks;")
+			rawSQLDB.Exec("DROP TABLE desired_lrps;")
+			rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+			mig = migrations.NewAddPlacementHistoryToActualLRPs()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(migrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1478467920))
+			})
+		})
+
+		Describe("Up", func() {
+			BeforeEach(func() {
+				etcdToSQL := migrations.NewETCDToSQL()
+				etcdToSQL.SetRawSQLDB(rawSQLDB)
+				etcdToSQL.SetDBFlavor(flavor)
+				etcdToSQL.SetClock(fakeClock)
+				Expect(etcdToSQL.Up(logger)).To(Succeed())
+
+				mig.SetRawSQLDB(rawSQLDB)
+				mig.SetDBFlavor(flavor)
+			})
+
+			JustBeforeEach(func() {
+				migErr = mig.Up(logger)
+			})
+
+			It("does not error out", func() {
+				Expect(migErr).NotTo(HaveOccurred())
+			})
+
+			It("adds a placement history column to the actual LRPs that is empty for existing rows", func() {
+				_, err := rawSQLDB.Exec(
+					sqldb.RebindForFlavor(
+						`INSERT INTO actual_lrps (process_guid, instance_index, domain, state, net_info, modification_tag_epoch)
+						VALUES (?, ?, ?, ?, ?, ?)`,
+						flavor,
+					),
+					"process-guid", 0, "domain", "UNCLAIMED", "", "epoch",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				var placementHistory sql.NullString
+				row := rawSQLDB.QueryRow("SELECT placement_history FROM actual_lrps LIMIT 1")
+				Expect(row.Scan(&placementHistory)).To(Succeed())
+				Expect(placementHistory.Valid).To(BeFalse())
+			})
+		})
+
+		Describe("Down", func() {
+			It("returns a not implemented error", func() {
+				Expect(mig.Down(logger)).To(HaveOccurred())
+			})
+		})
+	}
+})
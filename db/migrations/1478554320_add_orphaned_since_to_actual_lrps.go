@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddOrphanedSinceToActualLRPs())
+}
+
+type AddOrphanedSinceToActualLRPs struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewAddOrphanedSinceToActualLRPs() migration.Migration {
+	return &AddOrphanedSinceToActualLRPs{}
+}
+
+func (e *AddOrphanedSinceToActualLRPs) String() string {
+	return "1478554320"
+}
+
+func (e *AddOrphanedSinceToActualLRPs) Version() int64 {
+	return 1478554320
+}
+
+func (e *AddOrphanedSinceToActualLRPs) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddOrphanedSinceToActualLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddOrphanedSinceToActualLRPs) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddOrphanedSinceToActualLRPs) RequiresSQL() bool            { return true }
+func (e *AddOrphanedSinceToActualLRPs) SetClock(c clock.Clock)       { e.clock = c }
+func (e *AddOrphanedSinceToActualLRPs) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *AddOrphanedSinceToActualLRPs) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *AddOrphanedSinceToActualLRPs) Up(logger lager.Logger) error {
+	query := fmt.Sprintf(alterActualLRPsAddOrphanedSinceSQL, e.tablePrefix)
+	logger.Info("altering the table", lager.Data{"query": query})
+	_, err := e.rawSQLDB.Exec(query)
+	if err != nil {
+		logger.Error("failed-altering-tables", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": query})
+
+	return nil
+}
+
+const alterActualLRPsAddOrphanedSinceSQL = `ALTER TABLE %sactual_lrps
+	ADD COLUMN orphaned_since BIGINT NOT NULL DEFAULT 0;`
+
+func (e *AddOrphanedSinceToActualLRPs) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
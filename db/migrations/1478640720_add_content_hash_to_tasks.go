@@ -0,0 +1,85 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddContentHashToTasks())
+}
+
+type AddContentHashToTasks struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewAddContentHashToTasks() migration.Migration {
+	return &AddContentHashToTasks{}
+}
+
+func (e *AddContentHashToTasks) String() string {
+	return "1478640720"
+}
+
+func (e *AddContentHashToTasks) Version() int64 {
+	return 1478640720
+}
+
+func (e *AddContentHashToTasks) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddContentHashToTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddContentHashToTasks) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddContentHashToTasks) RequiresSQL() bool            { return true }
+func (e *AddContentHashToTasks) SetClock(c clock.Clock)       { e.clock = c }
+func (e *AddContentHashToTasks) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *AddContentHashToTasks) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *AddContentHashToTasks) Up(logger lager.Logger) error {
+	logger = logger.Session("add-content-hash-to-tasks")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	for _, query := range alterTasksAddContentHashSQL {
+		query = fmt.Sprintf(query, e.tablePrefix)
+		logger.Info("executing-query", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-executing-query", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Tasks desired before this migration keep an empty content hash, which
+// DuplicateTasks ignores.
+var alterTasksAddContentHashSQL = []string{
+	`ALTER TABLE %[1]stasks ADD COLUMN content_hash VARCHAR(64) NOT NULL DEFAULT ''`,
+	`CREATE INDEX %[1]stasks_content_hash_idx ON %[1]stasks (domain, content_hash)`,
+}
+
+func (e *AddContentHashToTasks) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
@@ -0,0 +1,90 @@
+package migrations_test
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Content Hash to Tasks", func() {
+	if test_helpers.UseSQL() {
+		var (
+			mig       migration.Migration
+			flavor    string
+			migErr    error
+			fakeClock *fakeclock.FakeClock
+		)
+
+		BeforeEach(func() {
+			fakeClock = fakeclock.NewFakeClock(time.Now())
+			flavor = os.Getenv("USE_SQL")
+			rawSQLDB.Exec("DROP TABLE domains;")
+			rawSQLDB.Exec("DROP TABLE tasks;")
+			rawSQLDB.Exec("DROP TABLE desired_lrps;")
+			rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+			mig = migrations.NewAddContentHashToTasks()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(migrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1478640720))
+			})
+		})
+
+		Describe("Up", func() {
+			BeforeEach(func() {
+				etcdToSQL := migrations.NewETCDToSQL()
+				etcdToSQL.SetRawSQLDB(rawSQLDB)
+				etcdToSQL.SetDBFlavor(flavor)
+				etcdToSQL.SetClock(fakeClock)
+				Expect(etcdToSQL.Up(logger)).To(Succeed())
+
+				mig.SetRawSQLDB(rawSQLDB)
+				mig.SetDBFlavor(flavor)
+			})
+
+			JustBeforeEach(func() {
+				migErr = mig.Up(logger)
+			})
+
+			It("does not error out", func() {
+				Expect(migErr).NotTo(HaveOccurred())
+			})
+
+			It("adds a content hash column to the tasks that is empty for existing rows", func() {
+				_, err := rawSQLDB.Exec(
+					sqldb.RebindForFlavor(
+						`INSERT INTO tasks (guid, domain, task_definition)
+						VALUES (?, ?, ?)`,
+						flavor,
+					),
+					"task-guid", "domain", "",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				var contentHash string
+				row := rawSQLDB.QueryRow("SELECT content_hash FROM tasks LIMIT 1")
+				Expect(row.Scan(&contentHash)).To(Succeed())
+				Expect(contentHash).To(BeEmpty())
+			})
+		})
+
+		Describe("Down", func() {
+			It("returns a not implemented error", func() {
+				Expect(mig.Down(logger)).To(HaveOccurred())
+			})
+		})
+	}
+})
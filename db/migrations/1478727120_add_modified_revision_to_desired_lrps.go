@@ -0,0 +1,88 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddModifiedRevisionToDesiredLRPs())
+}
+
+type AddModifiedRevisionToDesiredLRPs struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewAddModifiedRevisionToDesiredLRPs() migration.Migration {
+	return &AddModifiedRevisionToDesiredLRPs{}
+}
+
+func (e *AddModifiedRevisionToDesiredLRPs) String() string {
+	return "1478727120"
+}
+
+func (e *AddModifiedRevisionToDesiredLRPs) Version() int64 {
+	return 1478727120
+}
+
+func (e *AddModifiedRevisionToDesiredLRPs) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddModifiedRevisionToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddModifiedRevisionToDesiredLRPs) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddModifiedRevisionToDesiredLRPs) RequiresSQL() bool            { return true }
+func (e *AddModifiedRevisionToDesiredLRPs) SetClock(c clock.Clock)       { e.clock = c }
+func (e *AddModifiedRevisionToDesiredLRPs) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *AddModifiedRevisionToDesiredLRPs) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *AddModifiedRevisionToDesiredLRPs) Up(logger lager.Logger) error {
+	logger = logger.Session("add-modified-revision-to-desired-lrps")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	for _, query := range alterDesiredLRPsAddModifiedRevisionSQL {
+		query = fmt.Sprintf(query, e.tablePrefix)
+		logger.Info("executing-query", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-executing-query", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DesiredLRPs written before this migration keep a modified revision of 0,
+// so they are only listed when no modified since revision is given. The
+// tombstones gain the column too, as they are stamped with the revision of
+// the removal.
+var alterDesiredLRPsAddModifiedRevisionSQL = []string{
+	`ALTER TABLE %[1]sdesired_lrps ADD COLUMN modified_revision BIGINT NOT NULL DEFAULT 0`,
+	`CREATE INDEX %[1]sdesired_lrps_modified_revision_idx ON %[1]sdesired_lrps (modified_revision)`,
+	`ALTER TABLE %[1]sdesired_lrp_tombstones ADD COLUMN modified_revision BIGINT NOT NULL DEFAULT 0`,
+}
+
+func (e *AddModifiedRevisionToDesiredLRPs) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
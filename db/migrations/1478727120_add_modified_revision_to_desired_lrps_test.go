@@ -0,0 +1,102 @@
+package migrations_test
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Modified Revision to Desired LRPs", func() {
+	if test_helpers.UseSQL() {
+		var (
+			mig       migration.Migration
+			flavor    string
+			migErr    error
+			fakeClock *fakeclock.FakeClock
+		)
+
+		BeforeEach(func() {
+			fakeClock = fakeclock.NewFakeClock(time.Now())
+			flavor = os.Getenv("USE_SQL")
+			rawSQLDB.Exec("DROP TABLE domains;")
+			rawSQLDB.Exec("DROP TABLE tasks;")
+			rawSQLDB.Exec("DROP TABLE desired_lrps;")
+			rawSQLDB.Exec("DROP TABLE actual_lrps;")
+			rawSQLDB.Exec("DROP TABLE desired_lrp_tombstones;")
+
+			mig = migrations.NewAddModifiedRevisionToDesiredLRPs()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(migrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1478727120))
+			})
+		})
+
+		Describe("Up", func() {
+			BeforeEach(func() {
+				etcdToSQL := migrations.NewETCDToSQL()
+				etcdToSQL.SetRawSQLDB(rawSQLDB)
+				etcdToSQL.SetDBFlavor(flavor)
+				etcdToSQL.SetClock(fakeClock)
+				Expect(etcdToSQL.Up(logger)).To(Succeed())
+
+				tombstones := migrations.NewCreateDesiredLRPTombstonesTable()
+				tombstones.SetRawSQLDB(rawSQLDB)
+				tombstones.SetDBFlavor(flavor)
+				Expect(tombstones.Up(logger)).To(Succeed())
+
+				mig.SetRawSQLDB(rawSQLDB)
+				mig.SetDBFlavor(flavor)
+			})
+
+			JustBeforeEach(func() {
+				migErr = mig.Up(logger)
+			})
+
+			It("does not error out", func() {
+				Expect(migErr).NotTo(HaveOccurred())
+			})
+
+			It("adds a modified revision column to the desired LRPs that is 0 for existing rows", func() {
+				_, err := rawSQLDB.Exec(
+					sqldb.RebindForFlavor(
+						`INSERT INTO desired_lrps (process_guid, domain, log_guid, instances, memory_mb,
+							disk_mb, rootfs, routes, volume_placement, modification_tag_epoch, run_info)
+						VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+						flavor,
+					),
+					"process-guid", "domain", "log-guid", 1, 1, 1, "rootfs", "", "", "epoch", "",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				var modifiedRevision uint64
+				row := rawSQLDB.QueryRow("SELECT modified_revision FROM desired_lrps LIMIT 1")
+				Expect(row.Scan(&modifiedRevision)).To(Succeed())
+				Expect(modifiedRevision).To(BeZero())
+			})
+
+			It("adds a modified revision column to the desired LRP tombstones", func() {
+				_, err := rawSQLDB.Exec(`SELECT modified_revision FROM desired_lrp_tombstones`)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Describe("Down", func() {
+			It("returns a not implemented error", func() {
+				Expect(mig.Down(logger)).To(HaveOccurred())
+			})
+		})
+	}
+})
@@ -0,0 +1,101 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewCreateQuarantinedRecordsTable())
+}
+
+type CreateQuarantinedRecordsTable struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewCreateQuarantinedRecordsTable() migration.Migration {
+	return &CreateQuarantinedRecordsTable{}
+}
+
+func (e *CreateQuarantinedRecordsTable) String() string {
+	return "1478813520"
+}
+
+func (e *CreateQuarantinedRecordsTable) Version() int64 {
+	return 1478813520
+}
+
+func (e *CreateQuarantinedRecordsTable) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *CreateQuarantinedRecordsTable) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateQuarantinedRecordsTable) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *CreateQuarantinedRecordsTable) RequiresSQL() bool            { return true }
+func (e *CreateQuarantinedRecordsTable) SetClock(c clock.Clock)       { e.clock = c }
+func (e *CreateQuarantinedRecordsTable) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *CreateQuarantinedRecordsTable) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *CreateQuarantinedRecordsTable) Up(logger lager.Logger) error {
+	logger = logger.Session("create-quarantined-records-table")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	for _, query := range createQuarantinedRecordsTableSQL {
+		query = sqldb.RebindForFlavor(fmt.Sprintf(query, e.tablePrefix), e.dbFlavor)
+		logger.Info("executing-query", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-executing-query", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *CreateQuarantinedRecordsTable) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
+
+// A quarantined record is the payload of a record whose encryption key was
+// missing when it was read, moved aside for an operator to inspect. The whole
+// row it was read from is kept along with the table it came from, so that it
+// can be restored once the key is configured again. Records are identified by
+// their type, their guid and the hash of the payload, as a guid can have
+// several records of the same type, such as the instances of an actual LRP.
+var createQuarantinedRecordsTableSQL = []string{
+	`CREATE TABLE %squarantined_records(
+	record_type VARCHAR(255) NOT NULL,
+	record_guid VARCHAR(255) NOT NULL,
+	payload_hash VARCHAR(64) NOT NULL,
+	key_label VARCHAR(255) NOT NULL,
+	payload MEDIUMTEXT NOT NULL,
+	quarantined_at BIGINT NOT NULL DEFAULT 0,
+	source_table VARCHAR(255) NOT NULL DEFAULT '',
+	row_data MEDIUMTEXT,
+
+	PRIMARY KEY(record_type, record_guid, payload_hash)
+);`,
+	`CREATE INDEX %[1]squarantined_records_key_label_idx ON %[1]squarantined_records (key_label)`,
+}
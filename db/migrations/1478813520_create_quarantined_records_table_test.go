@@ -0,0 +1,97 @@
+package migrations_test
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Create Quarantined Records Table", func() {
+	if test_helpers.UseSQL() {
+		var (
+			mig    migration.Migration
+			flavor string
+			migErr error
+		)
+
+		BeforeEach(func() {
+			flavor = os.Getenv("USE_SQL")
+			rawSQLDB.Exec("DROP TABLE quarantined_records;")
+
+			mig = migrations.NewCreateQuarantinedRecordsTable()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(migrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1478813520))
+			})
+		})
+
+		Describe("Up", func() {
+			BeforeEach(func() {
+				mig.SetRawSQLDB(rawSQLDB)
+				mig.SetDBFlavor(flavor)
+			})
+
+			JustBeforeEach(func() {
+				migErr = mig.Up(logger)
+			})
+
+			It("does not error out", func() {
+				Expect(migErr).NotTo(HaveOccurred())
+			})
+
+			It("creates the quarantined_records table", func() {
+				_, err := rawSQLDB.Exec(
+					sqldb.RebindForFlavor(
+						`INSERT INTO quarantined_records (record_type, record_guid, payload_hash, key_label, payload, quarantined_at, source_table, row_data)
+						VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+						flavor,
+					),
+					"TaskDefinition", "some-guid", "some-hash", "some-label", "some-payload", 1234, "tasks", "some-row",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				var keyLabel, payload, sourceTable, rowData string
+				var quarantinedAt int64
+				query := sqldb.RebindForFlavor("SELECT key_label, payload, quarantined_at, source_table, row_data FROM quarantined_records WHERE record_guid = ?", flavor)
+				row := rawSQLDB.QueryRow(query, "some-guid")
+				Expect(row.Scan(&keyLabel, &payload, &quarantinedAt, &sourceTable, &rowData)).To(Succeed())
+				Expect(keyLabel).To(Equal("some-label"))
+				Expect(payload).To(Equal("some-payload"))
+				Expect(quarantinedAt).To(BeEquivalentTo(1234))
+				Expect(sourceTable).To(Equal("tasks"))
+				Expect(rowData).To(Equal("some-row"))
+			})
+
+			It("keeps different payloads of the same record apart", func() {
+				query := sqldb.RebindForFlavor(
+					`INSERT INTO quarantined_records (record_type, record_guid, payload_hash, key_label, payload)
+					VALUES (?, ?, ?, ?, ?)`,
+					flavor,
+				)
+				_, err := rawSQLDB.Exec(query, "ActualLRPNetInfo", "some-guid", "some-hash", "some-label", "some-payload")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = rawSQLDB.Exec(query, "ActualLRPNetInfo", "some-guid", "other-hash", "some-label", "other-payload")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = rawSQLDB.Exec(query, "ActualLRPNetInfo", "some-guid", "some-hash", "some-label", "some-payload")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("Down", func() {
+			It("returns a not implemented error", func() {
+				Expect(mig.Down(logger)).To(HaveOccurred())
+			})
+		})
+	}
+})
@@ -0,0 +1,84 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddStartOrderToDesiredLRPs())
+}
+
+type AddStartOrderToDesiredLRPs struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+	tablePrefix string
+}
+
+func NewAddStartOrderToDesiredLRPs() migration.Migration {
+	return &AddStartOrderToDesiredLRPs{}
+}
+
+func (e *AddStartOrderToDesiredLRPs) String() string {
+	return "1478899920"
+}
+
+func (e *AddStartOrderToDesiredLRPs) Version() int64 {
+	return 1478899920
+}
+
+func (e *AddStartOrderToDesiredLRPs) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddStartOrderToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddStartOrderToDesiredLRPs) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddStartOrderToDesiredLRPs) RequiresSQL() bool            { return true }
+func (e *AddStartOrderToDesiredLRPs) SetClock(c clock.Clock)       { e.clock = c }
+func (e *AddStartOrderToDesiredLRPs) SetDBFlavor(flavor string)    { e.dbFlavor = flavor }
+func (e *AddStartOrderToDesiredLRPs) SetTablePrefix(prefix string) { e.tablePrefix = prefix }
+
+func (e *AddStartOrderToDesiredLRPs) Up(logger lager.Logger) error {
+	for _, statement := range alterDesiredLRPsAddStartOrderSQL {
+		query := fmt.Sprintf(statement, e.tablePrefix)
+		logger.Info("altering the table", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-altering-tables", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": query})
+	}
+
+	return nil
+}
+
+// The LRPs desired before the migration keep starting their instances in
+// parallel, which is the zero start order.
+var alterDesiredLRPsAddStartOrderSQL = []string{
+	`ALTER TABLE %sdesired_lrps
+	ADD COLUMN start_order INTEGER NOT NULL DEFAULT 0;`,
+	`ALTER TABLE %sdesired_lrp_tombstones
+	ADD COLUMN start_order INTEGER NOT NULL DEFAULT 0;`,
+}
+
+func (e *AddStartOrderToDesiredLRPs) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
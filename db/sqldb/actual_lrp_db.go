@@ -2,6 +2,8 @@ package sqldb
 
 import (
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -10,10 +12,19 @@ import (
 )
 
 func (db *SQLDB) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
+	filter.PartialResults = false
+	groups, _, err := db.ActualLRPGroupsWithRecordErrors(logger, filter)
+	return groups, err
+}
+
+func (db *SQLDB) ActualLRPGroupsWithRecordErrors(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
 	logger = logger.WithData(lager.Data{"filter": filter})
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
 	var wheres []string
 	var values []interface{}
 
@@ -27,16 +38,23 @@ func (db *SQLDB) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFil
 		values = append(values, filter.CellID)
 	}
 
+	if len(filter.States) > 0 {
+		wheres = append(wheres, fmt.Sprintf("state IN (%s)", questionMarks(len(filter.States))))
+		for _, state := range filter.States {
+			values = append(values, state)
+		}
+	}
+
 	rows, err := db.all(logger, db.db, actualLRPsTable,
 		actualLRPColumns, NoLockRow,
 		strings.Join(wheres, " AND "), values...,
 	)
 	if err != nil {
 		logger.Error("failed-query", err)
-		return nil, db.convertSQLError(err)
+		return nil, nil, db.convertSQLError(err)
 	}
 	defer rows.Close()
-	return db.scanAndCleanupActualLRPs(logger, db.db, rows)
+	return db.scanAndCleanupActualLRPsWithRecordErrors(logger, db.db, rows, filter.PartialResults)
 }
 
 func (db *SQLDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
@@ -44,6 +62,9 @@ func (db *SQLDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid s
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
 	rows, err := db.all(logger, db.db, actualLRPsTable,
 		actualLRPColumns, NoLockRow,
 		"process_guid = ?", processGuid,
@@ -61,6 +82,9 @@ func (db *SQLDB) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, proces
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
 	rows, err := db.all(logger, db.db, actualLRPsTable,
 		actualLRPColumns, NoLockRow,
 		"process_guid = ? AND instance_index = ?", processGuid, index,
@@ -83,11 +107,57 @@ func (db *SQLDB) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, proces
 	return groups[0], nil
 }
 
+func (db *SQLDB) CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error) {
+	logger = logger.Session("crashing-actual-lrps", lager.Data{"limit": limit})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE evacuating = ? AND crash_count > 0
+		ORDER BY crash_count DESC, process_guid, instance_index
+		LIMIT ?`,
+		strings.Join(actualLRPColumns, ", "), db.tableAs(actualLRPsTable),
+	)
+
+	rows, err := db.db.Query(db.rebind(query), false, limit)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	actualLRPs := []*models.ActualLRP{}
+	for rows.Next() {
+		actualLRP, _, err := db.scanToActualLRP(logger, rows)
+		if err == models.ErrDeserialize || err == errRecordSkipped || isRecordToQuarantine(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		actualLRPs = append(actualLRPs, actualLRP)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return actualLRPs, nil
+}
+
 func (db *SQLDB) CreateUnclaimedActualLRP(logger lager.Logger, key *models.ActualLRPKey) (*models.ActualLRPGroup, error) {
 	logger = logger.WithData(lager.Data{"key": key})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	guid, err := db.guidProvider.NextGUID()
 	if err != nil {
 		logger.Error("failed-to-generate-guid", err)
@@ -122,6 +192,9 @@ func (db *SQLDB) CreateUnclaimedActualLRP(logger lager.Logger, key *models.Actua
 }
 
 func (db *SQLDB) UnclaimActualLRP(logger lager.Logger, key *models.ActualLRPKey) (*models.ActualLRPGroup, *models.ActualLRPGroup, error) {
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	logger = logger.WithData(lager.Data{"key": key})
 
 	var beforeActualLRP models.ActualLRP
@@ -129,7 +202,7 @@ func (db *SQLDB) UnclaimActualLRP(logger lager.Logger, key *models.ActualLRPKey)
 	processGuid := key.ProcessGuid
 	index := key.Index
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		var err error
 		actualLRP, err = db.fetchActualLRPForUpdate(logger, processGuid, index, false, tx)
 		if err != nil {
@@ -181,9 +254,12 @@ func (db *SQLDB) ClaimActualLRP(logger lager.Logger, processGuid string, index i
 	logger.Info("starting")
 	defer logger.Info("complete")
 
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	var beforeActualLRP models.ActualLRP
 	var actualLRP *models.ActualLRP
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		var err error
 		actualLRP, err = db.fetchActualLRPForUpdate(logger, processGuid, index, false, tx)
 		if err != nil {
@@ -207,6 +283,13 @@ func (db *SQLDB) ClaimActualLRP(logger lager.Logger, processGuid string, index i
 		actualLRP.PlacementError = ""
 		actualLRP.ActualLRPNetInfo = models.ActualLRPNetInfo{}
 		actualLRP.Since = db.clock.Now().UnixNano()
+		actualLRP.RecordPlacement(actualLRP.CellId, actualLRP.Since, models.PlacementReasonClaimed, db.placementHistoryLength)
+
+		placementHistoryData, err := serializePlacementHistory(actualLRP.PlacementHistory)
+		if err != nil {
+			logger.Error("failed-to-serialize-placement-history", err)
+			return err
+		}
 
 		_, err = db.update(logger, tx, actualLRPsTable,
 			SQLAttributes{
@@ -217,6 +300,7 @@ func (db *SQLDB) ClaimActualLRP(logger lager.Logger, processGuid string, index i
 				"placement_error":        actualLRP.PlacementError,
 				"since":                  actualLRP.Since,
 				"net_info":               []byte{},
+				"placement_history":      placementHistoryData,
 			},
 			"process_guid = ? AND instance_index = ? AND evacuating = ?",
 			processGuid, index, false,
@@ -233,80 +317,204 @@ func (db *SQLDB) ClaimActualLRP(logger lager.Logger, processGuid string, index i
 }
 
 func (db *SQLDB) StartActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo) (*models.ActualLRPGroup, *models.ActualLRPGroup, error) {
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	logger = logger.WithData(lager.Data{"actual_lrp_key": key, "actual_lrp_instance_key": instanceKey, "net_info": netInfo})
 
 	var beforeActualLRP models.ActualLRP
 	var actualLRP *models.ActualLRP
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		var before *models.ActualLRP
 		var err error
-		actualLRP, err = db.fetchActualLRPForUpdate(logger, key.ProcessGuid, key.Index, false, tx)
-		if err == models.ErrResourceNotFound {
-			actualLRP, err = db.createRunningActualLRP(logger, key, instanceKey, netInfo, tx)
-			return err
+		before, actualLRP, err = db.startActualLRP(logger, key, instanceKey, netInfo, tx)
+		if before != nil {
+			beforeActualLRP = *before
 		}
+		return err
+	})
 
-		if err != nil {
-			logger.Error("failed-to-get-actual-lrp", err)
-			return err
-		}
+	return &models.ActualLRPGroup{Instance: &beforeActualLRP}, &models.ActualLRPGroup{Instance: actualLRP}, err
+}
 
-		beforeActualLRP = *actualLRP
+// StartActualLRPs starts the reported actual LRPs and, when authoritative,
+// unclaims the ones left out, all in one transaction. Only an error the
+// transaction fails with is returned; reported LRPs that cannot be started
+// are added to the result as rejected.
+func (db *SQLDB) StartActualLRPs(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) (*models.StartActualLRPsResult, error) {
+	logger = logger.Session("start-actual-lrps", lager.Data{"cell_id": cellID, "count": len(starts), "authoritative": authoritative})
+	logger.Info("starting")
+	defer logger.Info("complete")
 
-		if actualLRP.ActualLRPKey.Equal(key) &&
-			actualLRP.ActualLRPInstanceKey.Equal(instanceKey) &&
-			actualLRP.ActualLRPNetInfo.Equal(netInfo) &&
-			actualLRP.State == models.ActualLRPStateRunning {
-			logger.Debug("nothing-to-change")
-			return nil
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	var result *models.StartActualLRPsResult
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		result = &models.StartActualLRPsResult{}
+
+		reported := make(map[models.ActualLRPKey]bool, len(starts))
+		for _, start := range starts {
+			key := start.ActualLrpKey
+			reported[models.ActualLRPKey{ProcessGuid: key.ProcessGuid, Index: key.Index}] = true
+
+			before, after, err := db.startActualLRP(logger.WithData(lager.Data{"actual_lrp_key": key}), key, start.ActualLrpInstanceKey, start.ActualLrpNetInfo, tx)
+			if err == models.ErrActualLRPCannotBeStarted {
+				result.Rejected = append(result.Rejected, key)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			change := models.ActualLRPChange{After: &models.ActualLRPGroup{Instance: after}}
+			if before != nil {
+				change.Before = &models.ActualLRPGroup{Instance: before}
+			}
+			result.Started = append(result.Started, change)
 		}
 
-		if !actualLRP.AllowsTransitionTo(key, instanceKey, models.ActualLRPStateRunning) {
-			logger.Error("failed-to-transition-actual-lrp-to-started", nil)
-			return models.ErrActualLRPCannotBeStarted
+		if !authoritative {
+			return nil
 		}
 
-		logger.Info("starting")
-		defer logger.Info("completed")
-
-		now := db.clock.Now().UnixNano()
-		evacuating := false
-
-		actualLRP.ActualLRPInstanceKey = *instanceKey
-		actualLRP.ActualLRPNetInfo = *netInfo
-		actualLRP.State = models.ActualLRPStateRunning
-		actualLRP.Since = now
-		actualLRP.ModificationTag.Increment()
-		actualLRP.PlacementError = ""
-
-		netInfoData, err := db.serializeModel(logger, &actualLRP.ActualLRPNetInfo)
+		rows, err := db.all(logger, tx, actualLRPsTable,
+			actualLRPColumns, LockRow,
+			"cell_id = ? AND evacuating = ? AND state = ?",
+			cellID, false, models.ActualLRPStateRunning,
+		)
 		if err != nil {
-			logger.Error("failed-to-serialize-net-info", err)
-			return err
+			logger.Error("failed-query", err)
+			return db.convertSQLError(err)
 		}
-
-		_, err = db.update(logger, tx, actualLRPsTable,
-			SQLAttributes{
-				"state":                  actualLRP.State,
-				"cell_id":                actualLRP.CellId,
-				"instance_guid":          actualLRP.InstanceGuid,
-				"modification_tag_index": actualLRP.ModificationTag.Index,
-				"placement_error":        actualLRP.PlacementError,
-				"since":                  actualLRP.Since,
-				"net_info":               netInfoData,
-			},
-			"process_guid = ? AND instance_index = ? AND evacuating = ?",
-			key.ProcessGuid, key.Index, evacuating,
-		)
+		groups, err := db.scanAndCleanupActualLRPs(logger, tx, rows)
+		rows.Close()
 		if err != nil {
-			logger.Error("failed-starting-actual-lrp", err)
 			return db.convertSQLError(err)
 		}
 
+		now := db.clock.Now().UnixNano()
+		for _, group := range groups {
+			actualLRP := group.Instance
+			if reported[models.ActualLRPKey{ProcessGuid: actualLRP.ProcessGuid, Index: actualLRP.Index}] {
+				continue
+			}
+
+			beforeActualLRP := *actualLRP
+			actualLRP.ModificationTag.Increment()
+			actualLRP.State = models.ActualLRPStateUnclaimed
+			actualLRP.ActualLRPInstanceKey = models.ActualLRPInstanceKey{}
+			actualLRP.ActualLRPNetInfo = models.ActualLRPNetInfo{}
+			actualLRP.Since = now
+
+			_, err = db.update(logger, tx, actualLRPsTable,
+				SQLAttributes{
+					"state":                  actualLRP.State,
+					"cell_id":                "",
+					"instance_guid":          "",
+					"net_info":               []byte{},
+					"since":                  actualLRP.Since,
+					"modification_tag_index": actualLRP.ModificationTag.Index,
+				},
+				"process_guid = ? AND instance_index = ? AND evacuating = ?",
+				actualLRP.ProcessGuid, actualLRP.Index, false,
+			)
+			if err != nil {
+				logger.Error("failed-to-unclaim-actual-lrp", err, lager.Data{"actual_lrp_key": actualLRP.ActualLRPKey})
+				return db.convertSQLError(err)
+			}
+
+			result.Unclaimed = append(result.Unclaimed, models.ActualLRPChange{
+				Before: &models.ActualLRPGroup{Instance: &beforeActualLRP},
+				After:  &models.ActualLRPGroup{Instance: actualLRP},
+			})
+		}
+
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return &models.ActualLRPGroup{Instance: &beforeActualLRP}, &models.ActualLRPGroup{Instance: actualLRP}, err
+	return result, nil
+}
+
+// startActualLRP starts the actual LRP within the transaction, creating it if
+// it does not exist, in which case the returned before is nil.
+func (db *SQLDB) startActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo, tx Queryable) (*models.ActualLRP, *models.ActualLRP, error) {
+	actualLRP, err := db.fetchActualLRPForUpdate(logger, key.ProcessGuid, key.Index, false, tx)
+	if err == models.ErrResourceNotFound {
+		actualLRP, err = db.createRunningActualLRP(logger, key, instanceKey, netInfo, tx)
+		return nil, actualLRP, err
+	}
+
+	if err != nil {
+		logger.Error("failed-to-get-actual-lrp", err)
+		return nil, nil, err
+	}
+
+	beforeActualLRP := *actualLRP
+
+	if actualLRP.ActualLRPKey.Equal(key) &&
+		actualLRP.ActualLRPInstanceKey.Equal(instanceKey) &&
+		actualLRP.ActualLRPNetInfo.Equal(netInfo) &&
+		actualLRP.State == models.ActualLRPStateRunning {
+		logger.Debug("nothing-to-change")
+		return &beforeActualLRP, actualLRP, nil
+	}
+
+	if !actualLRP.AllowsTransitionTo(key, instanceKey, models.ActualLRPStateRunning) {
+		logger.Error("failed-to-transition-actual-lrp-to-started", nil)
+		return &beforeActualLRP, actualLRP, models.ErrActualLRPCannotBeStarted
+	}
+
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	now := db.clock.Now().UnixNano()
+	evacuating := false
+
+	actualLRP.ActualLRPInstanceKey = *instanceKey
+	actualLRP.ActualLRPNetInfo = *netInfo
+	actualLRP.State = models.ActualLRPStateRunning
+	actualLRP.Since = now
+	actualLRP.ModificationTag.Increment()
+	actualLRP.PlacementError = ""
+	actualLRP.RecordPlacement(actualLRP.CellId, now, models.PlacementReasonStarted, db.placementHistoryLength)
+
+	netInfoData, err := db.serializeModel(logger, &actualLRP.ActualLRPNetInfo)
+	if err != nil {
+		logger.Error("failed-to-serialize-net-info", err)
+		return &beforeActualLRP, actualLRP, err
+	}
+
+	placementHistoryData, err := serializePlacementHistory(actualLRP.PlacementHistory)
+	if err != nil {
+		logger.Error("failed-to-serialize-placement-history", err)
+		return &beforeActualLRP, actualLRP, err
+	}
+
+	_, err = db.update(logger, tx, actualLRPsTable,
+		SQLAttributes{
+			"state":                  actualLRP.State,
+			"cell_id":                actualLRP.CellId,
+			"instance_guid":          actualLRP.InstanceGuid,
+			"modification_tag_index": actualLRP.ModificationTag.Index,
+			"placement_error":        actualLRP.PlacementError,
+			"since":                  actualLRP.Since,
+			"net_info":               netInfoData,
+			"placement_history":      placementHistoryData,
+		},
+		"process_guid = ? AND instance_index = ? AND evacuating = ?",
+		key.ProcessGuid, key.Index, evacuating,
+	)
+	if err != nil {
+		logger.Error("failed-starting-actual-lrp", err)
+		return &beforeActualLRP, actualLRP, db.convertSQLError(err)
+	}
+
+	return &beforeActualLRP, actualLRP, nil
 }
 
 func truncateString(s string, maxLen int) string {
@@ -322,11 +530,14 @@ func (db *SQLDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, i
 	logger.Info("starting")
 	defer logger.Info("complete")
 
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	var immediateRestart = false
 	var beforeActualLRP models.ActualLRP
 	var actualLRP *models.ActualLRP
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		var err error
 		actualLRP, err = db.fetchActualLRPForUpdate(logger, key.ProcessGuid, key.Index, false, tx)
 		if err != nil {
@@ -349,6 +560,9 @@ func (db *SQLDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, i
 			return models.ErrActualLRPCannotBeCrashed
 		}
 
+		now := db.clock.Now().UnixNano()
+		actualLRP.RecordPlacement(actualLRP.CellId, now, models.PlacementReasonCrashed, db.placementHistoryLength)
+
 		actualLRP.ModificationTag.Increment()
 		actualLRP.State = models.ActualLRPStateCrashed
 
@@ -364,9 +578,14 @@ func (db *SQLDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, i
 			immediateRestart = true
 		}
 
-		now := db.clock.Now().UnixNano()
 		actualLRP.Since = now
 
+		placementHistoryData, err := serializePlacementHistory(actualLRP.PlacementHistory)
+		if err != nil {
+			logger.Error("failed-to-serialize-placement-history", err)
+			return err
+		}
+
 		_, err = db.update(logger, tx, actualLRPsTable,
 			SQLAttributes{
 				"state":                  actualLRP.State,
@@ -377,6 +596,7 @@ func (db *SQLDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, i
 				"crash_reason":           truncateString(actualLRP.CrashReason, 1024),
 				"since":                  actualLRP.Since,
 				"net_info":               []byte{},
+				"placement_history":      placementHistoryData,
 			},
 			"process_guid = ? AND instance_index = ? AND evacuating = ?",
 			key.ProcessGuid, key.Index, evacuating,
@@ -397,10 +617,13 @@ func (db *SQLDB) FailActualLRP(logger lager.Logger, key *models.ActualLRPKey, pl
 	logger.Info("starting")
 	defer logger.Info("complete")
 
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	var beforeActualLRP models.ActualLRP
 	var actualLRP *models.ActualLRP
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		var err error
 		actualLRP, err = db.fetchActualLRPForUpdate(logger, key.ProcessGuid, key.Index, false, tx)
 		if err != nil {
@@ -445,7 +668,10 @@ func (db *SQLDB) RemoveActualLRP(logger lager.Logger, processGuid string, index
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	return db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		var err error
 		var result sql.Result
 		if instanceKey == nil {
@@ -478,7 +704,72 @@ func (db *SQLDB) RemoveActualLRP(logger lager.Logger, processGuid string, index
 	})
 }
 
-func (db *SQLDB) createRunningActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo, tx *sql.Tx) (*models.ActualLRP, error) {
+func (db *SQLDB) RetireActualLRPsOnCell(logger lager.Logger, cellID string) ([]*models.ActualLRPGroup, []*models.ActualLRPGroup, error) {
+	logger = logger.Session("retire-actual-lrps-on-cell", lager.Data{"cell_id": cellID})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	var befores, afters []*models.ActualLRPGroup
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		befores, afters = nil, nil
+
+		wheres := "cell_id = ? AND evacuating = ? AND state IN (?, ?)"
+		bindings := []interface{}{cellID, false, models.ActualLRPStateClaimed, models.ActualLRPStateRunning}
+
+		rows, err := db.all(logger, tx, actualLRPsTable,
+			actualLRPColumns, LockRow, wheres, bindings...)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return db.convertSQLError(err)
+		}
+		groups, err := db.scanAndCleanupActualLRPs(logger, tx, rows)
+		rows.Close()
+		if err != nil {
+			return db.convertSQLError(err)
+		}
+
+		if len(groups) == 0 {
+			return nil
+		}
+
+		now := db.clock.Now().UnixNano()
+		query := fmt.Sprintf(`UPDATE %s SET
+			state = ?, cell_id = ?, instance_guid = ?, net_info = ?, since = ?,
+			modification_tag_index = modification_tag_index + 1
+			WHERE %s`, db.table(actualLRPsTable), wheres)
+		updateBindings := append([]interface{}{models.ActualLRPStateUnclaimed, "", "", []byte{}, now}, bindings...)
+		_, err = tx.Exec(db.rebind(query), updateBindings...)
+		if err != nil {
+			logger.Error("failed-to-unclaim-actual-lrps", err)
+			return db.convertSQLError(err)
+		}
+
+		for _, group := range groups {
+			beforeActualLRP := *group.Instance
+			actualLRP := group.Instance
+			actualLRP.ModificationTag.Increment()
+			actualLRP.State = models.ActualLRPStateUnclaimed
+			actualLRP.ActualLRPInstanceKey = models.ActualLRPInstanceKey{}
+			actualLRP.ActualLRPNetInfo = models.ActualLRPNetInfo{}
+			actualLRP.Since = now
+
+			befores = append(befores, &models.ActualLRPGroup{Instance: &beforeActualLRP})
+			afters = append(afters, &models.ActualLRPGroup{Instance: actualLRP})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return befores, afters, nil
+}
+
+func (db *SQLDB) createRunningActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo, tx Queryable) (*models.ActualLRP, error) {
 	now := db.clock.Now().UnixNano()
 	guid, err := db.guidProvider.NextGUID()
 	if err != nil {
@@ -492,12 +783,19 @@ func (db *SQLDB) createRunningActualLRP(logger lager.Logger, key *models.ActualL
 	actualLRP.ActualLRPNetInfo = *netInfo
 	actualLRP.State = models.ActualLRPStateRunning
 	actualLRP.Since = now
+	actualLRP.RecordPlacement(actualLRP.CellId, now, models.PlacementReasonStarted, db.placementHistoryLength)
 
 	netInfoData, err := db.serializeModel(logger, &actualLRP.ActualLRPNetInfo)
 	if err != nil {
 		return nil, err
 	}
 
+	placementHistoryData, err := serializePlacementHistory(actualLRP.PlacementHistory)
+	if err != nil {
+		logger.Error("failed-to-serialize-placement-history", err)
+		return nil, err
+	}
+
 	_, err = db.insert(logger, tx, actualLRPsTable,
 		SQLAttributes{
 			"process_guid":           actualLRP.ActualLRPKey.ProcessGuid,
@@ -510,6 +808,7 @@ func (db *SQLDB) createRunningActualLRP(logger lager.Logger, key *models.ActualL
 			"since":                  actualLRP.Since,
 			"modification_tag_epoch": actualLRP.ModificationTag.Epoch,
 			"modification_tag_index": actualLRP.ModificationTag.Index,
+			"placement_history":      placementHistoryData,
 		},
 	)
 	if err != nil {
@@ -520,7 +819,7 @@ func (db *SQLDB) createRunningActualLRP(logger lager.Logger, key *models.ActualL
 }
 
 func (db *SQLDB) scanToActualLRP(logger lager.Logger, row RowScanner) (*models.ActualLRP, bool, error) {
-	var netInfoData []byte
+	var netInfoData, placementHistoryData []byte
 	var actualLRP models.ActualLRP
 	var evacuating bool
 
@@ -539,6 +838,8 @@ func (db *SQLDB) scanToActualLRP(logger lager.Logger, row RowScanner) (*models.A
 		&actualLRP.ModificationTag.Index,
 		&actualLRP.CrashCount,
 		&actualLRP.CrashReason,
+		&placementHistoryData,
+		&actualLRP.OrphanedSince,
 	)
 	if err != nil {
 		logger.Error("failed-scanning-actual-lrp", err)
@@ -547,22 +848,42 @@ func (db *SQLDB) scanToActualLRP(logger lager.Logger, row RowScanner) (*models.A
 
 	if len(netInfoData) > 0 {
 		logger.Debug("unmarshalling-net-info-data", lager.Data{"net_info": string(netInfoData)})
-		err = db.deserializeModel(logger, netInfoData, &actualLRP.ActualLRPNetInfo)
+		err = db.deserializeModel(logger, actualLRP.ProcessGuid, netInfoData, &actualLRP.ActualLRPNetInfo)
+		if isMissingKeyError(err) || isRecordToQuarantine(err) {
+			return &actualLRP, evacuating, err
+		}
 		if err != nil {
 			logger.Error("failed-unmarshaling-net-info-data", err)
 			return &actualLRP, evacuating, models.ErrDeserialize
 		}
 	}
 
+	if len(placementHistoryData) > 0 {
+		err = json.Unmarshal(placementHistoryData, &actualLRP.PlacementHistory)
+		if err != nil {
+			logger.Error("failed-unmarshaling-placement-history", err)
+			return &actualLRP, evacuating, models.ErrDeserialize
+		}
+	}
+
 	return &actualLRP, evacuating, nil
 }
 
+// serializePlacementHistory stores the placement history as JSON, or as
+// nothing when it is empty.
+func serializePlacementHistory(history []*models.ActualLRPPlacement) ([]byte, error) {
+	if len(history) == 0 {
+		return []byte{}, nil
+	}
+	return json.Marshal(history)
+}
+
 type actualToDelete struct {
 	*models.ActualLRP
 	evacuating bool
 }
 
-func (db *SQLDB) fetchActualLRPForUpdate(logger lager.Logger, processGuid string, index int32, evacuating bool, tx *sql.Tx) (*models.ActualLRP, error) {
+func (db *SQLDB) fetchActualLRPForUpdate(logger lager.Logger, processGuid string, index int32, evacuating bool, tx Queryable) (*models.ActualLRP, error) {
 	expireTime := db.clock.Now().Round(time.Second).UnixNano()
 	wheres := "process_guid = ? AND instance_index = ? AND evacuating = ?"
 	bindings := []interface{}{processGuid, index, evacuating}
@@ -592,19 +913,48 @@ func (db *SQLDB) fetchActualLRPForUpdate(logger lager.Logger, processGuid string
 }
 
 func (db *SQLDB) scanAndCleanupActualLRPs(logger lager.Logger, q Queryable, rows *sql.Rows) ([]*models.ActualLRPGroup, error) {
+	groups, _, err := db.scanAndCleanupActualLRPsWithRecordErrors(logger, q, rows, false)
+	return groups, err
+}
+
+// scanAndCleanupActualLRPsWithRecordErrors is scanAndCleanupActualLRPs that,
+// with partialResults, leaves the actual LRPs that cannot be read out of the
+// groups and returns them as record errors under their process guid. The
+// malformed ones are still deleted.
+func (db *SQLDB) scanAndCleanupActualLRPsWithRecordErrors(logger lager.Logger, q Queryable, rows *sql.Rows, partialResults bool) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
 	mapOfGroups := map[models.ActualLRPKey]*models.ActualLRPGroup{}
 	result := []*models.ActualLRPGroup{}
 	actualsToDelete := []*actualToDelete{}
+	actualsToQuarantine := map[*actualToDelete]error{}
+	var recordErrors []*models.RecordError
 	for rows.Next() {
 		actualLRP, evacuating, err := db.scanToActualLRP(logger, rows)
+		if err == errRecordSkipped {
+			continue
+		}
+		if partialResults && actualLRP != nil && isUnreadableRecordError(err) {
+			logger.Info("leaving-out-unreadable-actual-lrp", lager.Data{"process_guid": actualLRP.ProcessGuid, "index": actualLRP.Index, "reason": err.Error()})
+			recordErrors = append(recordErrors, &models.RecordError{
+				Guid:   actualLRP.ProcessGuid,
+				Reason: fmt.Sprintf("index %d: %s", actualLRP.Index, err.Error()),
+			})
+		}
+		if isRecordToQuarantine(err) {
+			actualsToQuarantine[&actualToDelete{actualLRP, evacuating}] = err
+			continue
+		}
 		if err == models.ErrDeserialize {
 			actualsToDelete = append(actualsToDelete, &actualToDelete{actualLRP, evacuating})
 			continue
 		}
 
+		if err == errMissingEncryptionKey && partialResults {
+			continue
+		}
+
 		if err != nil {
 			logger.Error("failed-scanning-actual-lrp", err)
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Every actual LRP has potentially 2 rows in the database: one for the instance
@@ -624,7 +974,7 @@ func (db *SQLDB) scanAndCleanupActualLRPs(logger lager.Logger, q Queryable, rows
 
 	if rows.Err() != nil {
 		logger.Error("failed-getting-next-row", rows.Err())
-		return nil, db.convertSQLError(rows.Err())
+		return nil, nil, db.convertSQLError(rows.Err())
 	}
 
 	for _, actual := range actualsToDelete {
@@ -637,5 +987,12 @@ func (db *SQLDB) scanAndCleanupActualLRPs(logger lager.Logger, q Queryable, rows
 		}
 	}
 
-	return result, nil
+	for actual, err := range actualsToQuarantine {
+		db.quarantineRow(logger, q, err, actualLRPsTable,
+			"process_guid = ? AND instance_index = ? AND evacuating = ?",
+			actual.ProcessGuid, actual.Index, actual.evacuating,
+		)
+	}
+
+	return result, recordErrors, nil
 }
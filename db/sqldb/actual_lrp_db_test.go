@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/bbs/db/sqldb"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
@@ -368,6 +369,21 @@ var _ = Describe("ActualLRPDB", func() {
 			Expect(actualLRPGroups).NotTo(ContainElement(actualLRPWithInvalidData))
 		})
 
+		It("returns a record error for an actual lrp containing invalid data when partial results are requested", func() {
+			actualLRPWithInvalidData := model_helpers.NewValidActualLRP("invalid", 0)
+			_, _, err := sqlDB.StartActualLRP(logger, &actualLRPWithInvalidData.ActualLRPKey, &actualLRPWithInvalidData.ActualLRPInstanceKey, &actualLRPWithInvalidData.ActualLRPNetInfo)
+			Expect(err).NotTo(HaveOccurred())
+			queryStr := `UPDATE actual_lrps SET net_info = 'garbage' WHERE process_guid = 'invalid'`
+			_, err = db.Exec(queryStr)
+			Expect(err).NotTo(HaveOccurred())
+
+			actualLRPGroups, recordErrors, err := sqlDB.ActualLRPGroupsWithRecordErrors(logger, models.ActualLRPFilter{PartialResults: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPGroups).To(ConsistOf(allActualLRPGroups))
+			Expect(recordErrors).To(Equal([]*models.RecordError{{Guid: "invalid", Reason: recordErrors[0].Reason}}))
+			Expect(recordErrors[0].Reason).To(HavePrefix("index 0: "))
+		})
+
 		Context("when filtering on domains", func() {
 			It("returns the actual lrp groups in the domain", func() {
 				filter := models.ActualLRPFilter{
@@ -411,6 +427,121 @@ var _ = Describe("ActualLRPDB", func() {
 				Expect(actualLRPGroups).To(ContainElement(allActualLRPGroups[5]))
 			})
 		})
+
+		Context("when filtering on state", func() {
+			It("returns the actual lrp groups in the state", func() {
+				filter := models.ActualLRPFilter{
+					States: []string{models.ActualLRPStateUnclaimed},
+				}
+				actualLRPGroups, err := sqlDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualLRPGroups).To(ConsistOf(allActualLRPGroups[3]))
+			})
+
+			It("returns the actual lrp groups in any of several states", func() {
+				filter := models.ActualLRPFilter{
+					States: []string{models.ActualLRPStateUnclaimed, models.ActualLRPStateClaimed},
+				}
+				actualLRPGroups, err := sqlDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualLRPGroups).To(ConsistOf(allActualLRPGroups))
+			})
+
+			It("returns no actual lrp groups when none is in the state", func() {
+				filter := models.ActualLRPFilter{
+					States: []string{models.ActualLRPStateCrashed, models.ActualLRPStateRunning},
+				}
+				actualLRPGroups, err := sqlDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualLRPGroups).To(BeEmpty())
+			})
+
+			It("combines the state with the other filters", func() {
+				filter := models.ActualLRPFilter{
+					Domain: "domain2",
+					States: []string{models.ActualLRPStateClaimed},
+				}
+				actualLRPGroups, err := sqlDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualLRPGroups).To(ConsistOf(allActualLRPGroups[1], allActualLRPGroups[4]))
+			})
+		})
+	})
+
+	Describe("CrashingActualLRPs", func() {
+		setCrashCount := func(processGuid string, index int32, crashCount int32, evacuating bool) {
+			queryStr := `
+				UPDATE actual_lrps SET crash_count = ?, evacuating = ?
+				WHERE process_guid = ? AND instance_index = ?`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			_, err := db.Exec(queryStr, crashCount, evacuating, processGuid, index)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			keys := []models.ActualLRPKey{
+				models.NewActualLRPKey("guid-a", 0, "domain"),
+				models.NewActualLRPKey("guid-a", 1, "domain"),
+				models.NewActualLRPKey("guid-b", 0, "domain"),
+				models.NewActualLRPKey("guid-c", 0, "domain"),
+				models.NewActualLRPKey("guid-d", 0, "domain"),
+			}
+			for i := range keys {
+				_, err := sqlDB.CreateUnclaimedActualLRP(logger, &keys[i])
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			setCrashCount("guid-a", 0, 3, false)
+			setCrashCount("guid-a", 1, 7, false)
+			setCrashCount("guid-b", 0, 3, false)
+			setCrashCount("guid-c", 0, 9, true)
+		})
+
+		It("returns the crashing actual lrps ordered by crash count, process guid and index", func() {
+			actualLRPs, err := sqlDB.CrashingActualLRPs(logger, 10)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actualLRPs).To(HaveLen(3))
+			Expect(actualLRPs[0].ActualLRPKey).To(Equal(models.NewActualLRPKey("guid-a", 1, "domain")))
+			Expect(actualLRPs[0].CrashCount).To(BeEquivalentTo(7))
+			Expect(actualLRPs[1].ActualLRPKey).To(Equal(models.NewActualLRPKey("guid-a", 0, "domain")))
+			Expect(actualLRPs[2].ActualLRPKey).To(Equal(models.NewActualLRPKey("guid-b", 0, "domain")))
+		})
+
+		It("returns no more than the limit", func() {
+			actualLRPs, err := sqlDB.CrashingActualLRPs(logger, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actualLRPs).To(HaveLen(2))
+			Expect(actualLRPs[0].ProcessGuid).To(Equal("guid-a"))
+			Expect(actualLRPs[1].ProcessGuid).To(Equal("guid-a"))
+		})
+
+		Context("when there are invalid records", func() {
+			BeforeEach(func() {
+				queryStr := `
+					UPDATE actual_lrps SET net_info = ?
+					WHERE process_guid = ? AND instance_index = ?`
+				if test_helpers.UsePostgres() {
+					queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+				}
+				_, err := db.Exec(queryStr, "garbage", "guid-a", 1)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("skips them", func() {
+				actualLRPs, err := sqlDB.CrashingActualLRPs(logger, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualLRPs).To(HaveLen(2))
+				Expect(actualLRPs[0].ActualLRPKey).To(Equal(models.NewActualLRPKey("guid-a", 0, "domain")))
+			})
+		})
 	})
 
 	Describe("ActualLRPGroupsByProcessGuid", func() {
@@ -1722,4 +1853,283 @@ var _ = Describe("ActualLRPDB", func() {
 			})
 		})
 	})
+
+	Describe("RetireActualLRPsOnCell", func() {
+		var (
+			claimedKey, runningKey, otherCellKey, unclaimedKey models.ActualLRPKey
+			befores, afters                                    []*models.ActualLRPGroup
+		)
+
+		BeforeEach(func() {
+			claimedKey = models.NewActualLRPKey("claimed-guid", 0, "the-domain")
+			runningKey = models.NewActualLRPKey("running-guid", 1, "the-domain")
+			otherCellKey = models.NewActualLRPKey("other-cell-guid", 0, "the-domain")
+			unclaimedKey = models.NewActualLRPKey("unclaimed-guid", 0, "the-domain")
+			netInfo := models.NewActualLRPNetInfo("1.2.1.2", models.NewPortMapping(9090, 8080))
+
+			for _, key := range []models.ActualLRPKey{claimedKey, runningKey, otherCellKey, unclaimedKey} {
+				_, err := sqlDB.CreateUnclaimedActualLRP(logger, &key)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, _, err := sqlDB.ClaimActualLRP(logger, claimedKey.ProcessGuid, claimedKey.Index, &models.ActualLRPInstanceKey{InstanceGuid: "instance-1", CellId: "the-cell"})
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.StartActualLRP(logger, &runningKey, &models.ActualLRPInstanceKey{InstanceGuid: "instance-2", CellId: "the-cell"}, &netInfo)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.StartActualLRP(logger, &otherCellKey, &models.ActualLRPInstanceKey{InstanceGuid: "instance-3", CellId: "other-cell"}, &netInfo)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Increment(time.Hour)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			befores, afters, err = sqlDB.RetireActualLRPsOnCell(logger, "the-cell")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("unclaims the claimed and running actual LRPs on the cell", func() {
+			for _, key := range []models.ActualLRPKey{claimedKey, runningKey} {
+				group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, key.ProcessGuid, key.Index)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(group.Instance.State).To(Equal(models.ActualLRPStateUnclaimed))
+				Expect(group.Instance.ActualLRPInstanceKey).To(Equal(models.ActualLRPInstanceKey{}))
+				Expect(group.Instance.ActualLRPNetInfo).To(Equal(models.ActualLRPNetInfo{}))
+				Expect(group.Instance.Since).To(Equal(fakeClock.Now().UnixNano()))
+			}
+		})
+
+		It("leaves actual LRPs on other cells alone", func() {
+			group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, otherCellKey.ProcessGuid, otherCellKey.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.State).To(Equal(models.ActualLRPStateRunning))
+			Expect(group.Instance.CellId).To(Equal("other-cell"))
+		})
+
+		It("returns each retired actual LRP before and after the change", func() {
+			Expect(befores).To(HaveLen(2))
+			Expect(afters).To(HaveLen(2))
+
+			for i := range befores {
+				Expect(befores[i].Instance.CellId).To(Equal("the-cell"))
+				Expect(afters[i].Instance.ActualLRPKey).To(Equal(befores[i].Instance.ActualLRPKey))
+				Expect(afters[i].Instance.ModificationTag.Index).To(Equal(befores[i].Instance.ModificationTag.Index + 1))
+
+				group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, afters[i].Instance.ProcessGuid, afters[i].Instance.Index)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(afters[i]).To(BeEquivalentTo(group))
+			}
+		})
+
+		Context("when the cell has no actual LRPs", func() {
+			It("retires nothing", func() {
+				befores, afters, err := sqlDB.RetireActualLRPsOnCell(logger, "empty-cell")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(befores).To(BeEmpty())
+				Expect(afters).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("StartActualLRPs", func() {
+		var (
+			newKey, claimedKey, conflictingKey, staleKey, unreportedClaimKey models.ActualLRPKey
+			netInfo                                                          models.ActualLRPNetInfo
+			starts                                                           []*models.StartActualLRPRequest
+			authoritative                                                    bool
+			result                                                           *models.StartActualLRPsResult
+		)
+
+		newStart := func(key models.ActualLRPKey, instanceGuid string) *models.StartActualLRPRequest {
+			instanceKey := models.NewActualLRPInstanceKey(instanceGuid, "the-cell")
+			return &models.StartActualLRPRequest{
+				ActualLrpKey:         &key,
+				ActualLrpInstanceKey: &instanceKey,
+				ActualLrpNetInfo:     &netInfo,
+			}
+		}
+
+		BeforeEach(func() {
+			newKey = models.NewActualLRPKey("new-guid", 0, "the-domain")
+			claimedKey = models.NewActualLRPKey("claimed-guid", 0, "the-domain")
+			conflictingKey = models.NewActualLRPKey("conflicting-guid", 0, "the-domain")
+			staleKey = models.NewActualLRPKey("stale-guid", 0, "the-domain")
+			unreportedClaimKey = models.NewActualLRPKey("unreported-claim-guid", 0, "the-domain")
+			netInfo = models.NewActualLRPNetInfo("1.2.1.2", models.NewPortMapping(9090, 8080))
+
+			for _, key := range []models.ActualLRPKey{claimedKey, conflictingKey, staleKey, unreportedClaimKey} {
+				_, err := sqlDB.CreateUnclaimedActualLRP(logger, &key)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, _, err := sqlDB.ClaimActualLRP(logger, claimedKey.ProcessGuid, claimedKey.Index, &models.ActualLRPInstanceKey{InstanceGuid: "instance-claimed", CellId: "the-cell"})
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.ClaimActualLRP(logger, unreportedClaimKey.ProcessGuid, unreportedClaimKey.Index, &models.ActualLRPInstanceKey{InstanceGuid: "instance-unreported", CellId: "the-cell"})
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.StartActualLRP(logger, &conflictingKey, &models.ActualLRPInstanceKey{InstanceGuid: "instance-other", CellId: "other-cell"}, &netInfo)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.StartActualLRP(logger, &staleKey, &models.ActualLRPInstanceKey{InstanceGuid: "instance-stale", CellId: "the-cell"}, &netInfo)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeGUIDProvider.NextGUIDReturns("the-epoch", nil)
+			fakeClock.Increment(time.Hour)
+
+			starts = []*models.StartActualLRPRequest{
+				newStart(newKey, "instance-new"),
+				newStart(claimedKey, "instance-claimed"),
+				newStart(conflictingKey, "instance-conflicting"),
+			}
+			authoritative = true
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			result, err = sqlDB.StartActualLRPs(logger, "the-cell", starts, authoritative)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates the reported LRPs that do not exist and starts the claimed ones", func() {
+			Expect(result.Started).To(HaveLen(2))
+
+			Expect(result.Started[0].Before).To(BeNil())
+			Expect(result.Started[1].Before.Instance.State).To(Equal(models.ActualLRPStateClaimed))
+
+			for i, key := range []models.ActualLRPKey{newKey, claimedKey} {
+				group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, key.ProcessGuid, key.Index)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(group.Instance.State).To(Equal(models.ActualLRPStateRunning))
+				Expect(group.Instance.ActualLRPInstanceKey).To(Equal(*starts[i].ActualLrpInstanceKey))
+				Expect(group.Instance.ActualLRPNetInfo).To(Equal(netInfo))
+				Expect(result.Started[i].After).To(BeEquivalentTo(group))
+			}
+		})
+
+		It("rejects the reported LRPs running elsewhere and leaves them alone", func() {
+			Expect(result.Rejected).To(Equal([]*models.ActualLRPKey{&conflictingKey}))
+
+			group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, conflictingKey.ProcessGuid, conflictingKey.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.CellId).To(Equal("other-cell"))
+		})
+
+		It("unclaims the running LRPs on the cell that were not reported", func() {
+			Expect(result.Unclaimed).To(HaveLen(1))
+			Expect(result.Unclaimed[0].Before.Instance.ActualLRPKey).To(Equal(staleKey))
+			Expect(result.Unclaimed[0].Before.Instance.State).To(Equal(models.ActualLRPStateRunning))
+
+			group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, staleKey.ProcessGuid, staleKey.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.State).To(Equal(models.ActualLRPStateUnclaimed))
+			Expect(group.Instance.ActualLRPInstanceKey).To(Equal(models.ActualLRPInstanceKey{}))
+			Expect(group.Instance.Since).To(Equal(fakeClock.Now().UnixNano()))
+			Expect(result.Unclaimed[0].After).To(BeEquivalentTo(group))
+		})
+
+		It("leaves the claimed LRPs on the cell that were not reported alone", func() {
+			group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, unreportedClaimKey.ProcessGuid, unreportedClaimKey.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.State).To(Equal(models.ActualLRPStateClaimed))
+			Expect(group.Instance.InstanceGuid).To(Equal("instance-unreported"))
+		})
+
+		Context("when the report is not authoritative", func() {
+			BeforeEach(func() {
+				authoritative = false
+			})
+
+			It("starts the reported LRPs but leaves the rest of the cell alone", func() {
+				Expect(result.Started).To(HaveLen(2))
+				Expect(result.Unclaimed).To(BeEmpty())
+
+				group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, staleKey.ProcessGuid, staleKey.Index)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(group.Instance.State).To(Equal(models.ActualLRPStateRunning))
+			})
+		})
+
+		Context("when an authoritative report is empty", func() {
+			BeforeEach(func() {
+				starts = nil
+			})
+
+			It("unclaims every running LRP on the cell", func() {
+				Expect(result.Started).To(BeEmpty())
+				Expect(result.Unclaimed).To(HaveLen(1))
+				Expect(result.Unclaimed[0].After.Instance.ActualLRPKey).To(Equal(staleKey))
+			})
+		})
+	})
+
+	Describe("placement history", func() {
+		var (
+			historyDB   *sqldb.SQLDB
+			key         *models.ActualLRPKey
+			instanceKey *models.ActualLRPInstanceKey
+			netInfo     *models.ActualLRPNetInfo
+		)
+
+		BeforeEach(func() {
+			historyDB = sqldb.NewSQLDBWithOptions(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "", sqldb.SQLDBOptions{PlacementHistoryLength: 2})
+
+			key = &models.ActualLRPKey{ProcessGuid: "the-guid", Index: 0, Domain: "the-domain"}
+			instanceKey = &models.ActualLRPInstanceKey{InstanceGuid: "the-instance-guid", CellId: "the-cell-id"}
+			netInfo = &models.ActualLRPNetInfo{Address: "1.2.3.4"}
+
+			_, err := historyDB.CreateUnclaimedActualLRP(logger, key)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records the claims, starts and crashes, keeping the most recent", func() {
+			_, _, err := historyDB.ClaimActualLRP(logger, key.ProcessGuid, key.Index, instanceKey)
+			Expect(err).NotTo(HaveOccurred())
+			claimedAt := fakeClock.Now().UnixNano()
+
+			group, err := historyDB.ActualLRPGroupByProcessGuidAndIndex(logger, key.ProcessGuid, key.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.PlacementHistory).To(Equal([]*models.ActualLRPPlacement{
+				{CellId: "the-cell-id", Timestamp: claimedAt, Reason: models.PlacementReasonClaimed},
+			}))
+
+			fakeClock.Increment(time.Minute)
+			_, _, err = historyDB.StartActualLRP(logger, key, instanceKey, netInfo)
+			Expect(err).NotTo(HaveOccurred())
+			startedAt := fakeClock.Now().UnixNano()
+
+			fakeClock.Increment(time.Minute)
+			_, after, _, err := historyDB.CrashActualLRP(logger, key, instanceKey, "boom")
+			Expect(err).NotTo(HaveOccurred())
+			crashedAt := fakeClock.Now().UnixNano()
+
+			expectedHistory := []*models.ActualLRPPlacement{
+				{CellId: "the-cell-id", Timestamp: startedAt, Reason: models.PlacementReasonStarted},
+				{CellId: "the-cell-id", Timestamp: crashedAt, Reason: models.PlacementReasonCrashed},
+			}
+			Expect(after.Instance.PlacementHistory).To(Equal(expectedHistory))
+
+			group, err = historyDB.ActualLRPGroupByProcessGuidAndIndex(logger, key.ProcessGuid, key.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.PlacementHistory).To(Equal(expectedHistory))
+		})
+
+		It("records the start of an actual lrp that did not exist", func() {
+			otherKey := &models.ActualLRPKey{ProcessGuid: "other-guid", Index: 0, Domain: "the-domain"}
+			_, _, err := historyDB.StartActualLRP(logger, otherKey, instanceKey, netInfo)
+			Expect(err).NotTo(HaveOccurred())
+
+			group, err := historyDB.ActualLRPGroupByProcessGuidAndIndex(logger, otherKey.ProcessGuid, otherKey.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.PlacementHistory).To(Equal([]*models.ActualLRPPlacement{
+				{CellId: "the-cell-id", Timestamp: fakeClock.Now().UnixNano(), Reason: models.PlacementReasonStarted},
+			}))
+		})
+
+		It("records nothing when the history is disabled", func() {
+			_, _, err := sqlDB.ClaimActualLRP(logger, key.ProcessGuid, key.Index, instanceKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, key.ProcessGuid, key.Index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance.PlacementHistory).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,83 @@
+package sqldb
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+func (db *SQLDB) CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error) {
+	logger = logger.Session("cell-placements", lager.Data{"cell_id": cellID})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	wheres := []string{fmt.Sprintf("%s.state IN (?, ?)", actualLRPsTable)}
+	bindings := []interface{}{models.ActualLRPStateClaimed, models.ActualLRPStateRunning}
+	if cellID != "" {
+		wheres = append(wheres, fmt.Sprintf("%s.cell_id = ?", actualLRPsTable))
+		bindings = append(bindings, cellID)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COALESCE(%s.memory_mb, 0), COALESCE(%s.disk_mb, 0)
+		FROM %s LEFT OUTER JOIN %s ON %s.process_guid = %s.process_guid
+		WHERE %s
+		ORDER BY %s.cell_id, %s.process_guid, %s.instance_index, %s.evacuating`,
+		strings.Join(actualLRPColumns, ", "), desiredLRPsTable, desiredLRPsTable,
+		db.tableAs(actualLRPsTable), db.tableAs(desiredLRPsTable), desiredLRPsTable, actualLRPsTable,
+		strings.Join(wheres, " AND "),
+		actualLRPsTable, actualLRPsTable, actualLRPsTable, actualLRPsTable,
+	)
+
+	rows, err := db.db.Query(db.rebind(query), bindings...)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	placements := []*models.CellPlacement{}
+	var placement *models.CellPlacement
+	for rows.Next() {
+		var memoryMB, diskMB int32
+		actualLRP, _, err := db.scanToActualLRP(logger, placementRowScanner{rows, &memoryMB, &diskMB})
+		if err == models.ErrDeserialize || err == errRecordSkipped || isRecordToQuarantine(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if placement == nil || placement.CellId != actualLRP.CellId {
+			placement = &models.CellPlacement{CellId: actualLRP.CellId}
+			placements = append(placements, placement)
+		}
+		placement.ActualLrps = append(placement.ActualLrps, actualLRP)
+		placement.MemoryMb += memoryMB
+		placement.DiskMb += diskMB
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return placements, nil
+}
+
+// placementRowScanner scans the resources joined onto the end of an actual
+// LRP row along with the actual LRP columns.
+type placementRowScanner struct {
+	RowScanner
+	memoryMB *int32
+	diskMB   *int32
+}
+
+func (s placementRowScanner) Scan(dest ...interface{}) error {
+	return s.RowScanner.Scan(append(dest, s.memoryMB, s.diskMB)...)
+}
@@ -0,0 +1,96 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CellPlacements", func() {
+	BeforeEach(func() {
+		desiredLRP := model_helpers.NewValidDesiredLRP("guid-1")
+		desiredLRP.MemoryMb = 256
+		desiredLRP.DiskMb = 1024
+		Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+		desiredLRP = model_helpers.NewValidDesiredLRP("guid-2")
+		desiredLRP.MemoryMb = 128
+		desiredLRP.DiskMb = 512
+		Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+		netInfo := models.NewActualLRPNetInfo("127.0.0.1", models.NewPortMapping(8080, 80))
+
+		instanceKey := models.NewActualLRPInstanceKey("instance-guid-0", "cell-a")
+		key := models.NewActualLRPKey("guid-1", 0, "domain")
+		_, _, err := sqlDB.StartActualLRP(logger, &key, &instanceKey, &netInfo)
+		Expect(err).NotTo(HaveOccurred())
+
+		instanceKey = models.NewActualLRPInstanceKey("instance-guid-1", "cell-a")
+		key = models.NewActualLRPKey("guid-1", 1, "domain")
+		_, err = sqlDB.CreateUnclaimedActualLRP(logger, &key)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = sqlDB.ClaimActualLRP(logger, "guid-1", 1, &instanceKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		instanceKey = models.NewActualLRPInstanceKey("instance-guid-2", "cell-a")
+		key = models.NewActualLRPKey("guid-2", 0, "domain")
+		_, err = sqlDB.EvacuateActualLRP(logger, &key, &instanceKey, &netInfo, 100)
+		Expect(err).NotTo(HaveOccurred())
+
+		instanceKey = models.NewActualLRPInstanceKey("instance-guid-3", "cell-a")
+		key = models.NewActualLRPKey("guid-3", 0, "domain")
+		_, _, err = sqlDB.StartActualLRP(logger, &key, &instanceKey, &netInfo)
+		Expect(err).NotTo(HaveOccurred())
+
+		instanceKey = models.NewActualLRPInstanceKey("instance-guid-4", "cell-b")
+		key = models.NewActualLRPKey("guid-2", 1, "domain")
+		_, _, err = sqlDB.StartActualLRP(logger, &key, &instanceKey, &netInfo)
+		Expect(err).NotTo(HaveOccurred())
+
+		key = models.NewActualLRPKey("guid-2", 2, "domain")
+		_, err = sqlDB.CreateUnclaimedActualLRP(logger, &key)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns the claimed and running actual lrps on each cell with their resources", func() {
+		placements, err := sqlDB.CellPlacements(logger, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(placements).To(HaveLen(2))
+
+		Expect(placements[0].CellId).To(Equal("cell-a"))
+		Expect(placements[0].ActualLrps).To(HaveLen(4))
+		Expect(placements[0].ActualLrps[0].ActualLRPKey).To(Equal(models.NewActualLRPKey("guid-1", 0, "domain")))
+		Expect(placements[0].ActualLrps[1].ActualLRPKey).To(Equal(models.NewActualLRPKey("guid-1", 1, "domain")))
+		Expect(placements[0].ActualLrps[1].State).To(Equal(models.ActualLRPStateClaimed))
+		Expect(placements[0].ActualLrps[2].ActualLRPKey).To(Equal(models.NewActualLRPKey("guid-2", 0, "domain")))
+		Expect(placements[0].ActualLrps[3].ActualLRPKey).To(Equal(models.NewActualLRPKey("guid-3", 0, "domain")))
+		Expect(placements[0].MemoryMb).To(BeEquivalentTo(256 + 256 + 128))
+		Expect(placements[0].DiskMb).To(BeEquivalentTo(1024 + 1024 + 512))
+
+		Expect(placements[1].CellId).To(Equal("cell-b"))
+		Expect(placements[1].ActualLrps).To(HaveLen(1))
+		Expect(placements[1].ActualLrps[0].ActualLRPKey).To(Equal(models.NewActualLRPKey("guid-2", 1, "domain")))
+		Expect(placements[1].MemoryMb).To(BeEquivalentTo(128))
+		Expect(placements[1].DiskMb).To(BeEquivalentTo(512))
+	})
+
+	Context("when filtering by cell", func() {
+		It("only returns that cell", func() {
+			placements, err := sqlDB.CellPlacements(logger, "cell-b")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(placements).To(HaveLen(1))
+			Expect(placements[0].CellId).To(Equal("cell-b"))
+			Expect(placements[0].ActualLrps).To(HaveLen(1))
+		})
+	})
+
+	Context("when there are no actual lrps on the cell", func() {
+		It("returns no placements", func() {
+			placements, err := sqlDB.CellPlacements(logger, "cell-c")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(placements).To(BeEmpty())
+		})
+	})
+})
@@ -1,14 +1,12 @@
 package sqldb
 
 import (
-	"database/sql"
-
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
 
 func (db *SQLDB) setConfigurationValue(logger lager.Logger, key, value string) error {
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	return db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		_, err := db.upsert(logger, tx, "configurations",
 			SQLAttributes{"id": key},
 			SQLAttributes{"value": value},
@@ -0,0 +1,77 @@
+package sqldb
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+// maxBatchBindings is the most bindings a batched convergence statement
+// uses, which is the most Postgres accepts in a single statement.
+const maxBatchBindings = 65535
+
+// batching reports whether convergence writes rows in batches rather than
+// with a statement for each row.
+func (db *SQLDB) batching() bool {
+	return db.convergenceBatchSize > 1
+}
+
+// batchSize returns how many rows a batched convergence statement that binds
+// bindingsPerRow values for each row writes at most.
+func (db *SQLDB) batchSize(bindingsPerRow int) int {
+	size := db.convergenceBatchSize
+	if size < 1 {
+		size = 1
+	}
+	if size*bindingsPerRow > maxBatchBindings {
+		size = maxBatchBindings / bindingsPerRow
+	}
+	return size
+}
+
+// eachBatch calls f with the bounds of each consecutive batch of count rows,
+// the last of which may be smaller.
+func (db *SQLDB) eachBatch(count, bindingsPerRow int, f func(start, end int)) {
+	size := db.batchSize(bindingsPerRow)
+	for start := 0; start < count; start += size {
+		end := start + size
+		if end > count {
+			end = count
+		}
+		f(start, end)
+	}
+}
+
+// INSERT INTO <table> (...) VALUES (...), (...), ...
+func (db *SQLDB) insertRows(q Queryable, table string, columns ColumnList, rows [][]interface{}) (sql.Result, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	rowBindings := fmt.Sprintf("(%s)", questionMarks(len(columns)))
+	values := make([]string, 0, len(rows))
+	bindings := make([]interface{}, 0, len(rows)*len(columns))
+	for _, row := range rows {
+		values = append(values, rowBindings)
+		bindings = append(bindings, row...)
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s\n(%s)\nVALUES %s",
+		db.table(table), strings.Join(columns, ", "), strings.Join(values, ", "))
+
+	return q.Exec(db.rebind(query), bindings...)
+}
+
+// actualLRPKeysClause returns a where clause, and its bindings, that matches
+// the Actual LRPs with the given keys.
+func actualLRPKeysClause(keys []*models.ActualLRPKey) (string, []interface{}) {
+	clauses := make([]string, 0, len(keys))
+	bindings := make([]interface{}, 0, 2*len(keys))
+	for _, key := range keys {
+		clauses = append(clauses, "(process_guid = ? AND instance_index = ?)")
+		bindings = append(bindings, key.ProcessGuid, key.Index)
+	}
+	return "(" + strings.Join(clauses, " OR ") + ")", bindings
+}
@@ -0,0 +1,115 @@
+package sqldb_test
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Convergence benchmark", func() {
+	const (
+		domain      = "benchmark-domain"
+		lrpCount    = 100
+		instances   = 5
+		taskCount   = 500
+		sampleCount = 3
+
+		convergenceBatchSize = 100
+	)
+
+	var cellSet models.CellSet
+
+	BeforeEach(func() {
+		cellSet = models.NewCellSetFromList([]*models.CellPresence{
+			{CellId: "existing-cell"},
+		})
+
+		err := sqlDB.UpsertDomain(logger, domain, 300)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Every LRP has stale unclaimed instances, an instance on a missing
+		// cell and missing instances, and half of the Tasks are kickable, so
+		// that both passes have work for the convergence workers.
+		for i := 0; i < lrpCount; i++ {
+			processGuid := fmt.Sprintf("benchmark-lrp-%d", i)
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = domain
+			desiredLRP.Instances = instances
+			err = sqlDB.DesireLRP(logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Increment(-models.StaleUnclaimedActualLRPDuration)
+			_, err = sqlDB.CreateUnclaimedActualLRP(logger, &models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain})
+			Expect(err).NotTo(HaveOccurred())
+			fakeClock.Increment(models.StaleUnclaimedActualLRPDuration)
+
+			_, err = sqlDB.CreateUnclaimedActualLRP(logger, &models.ActualLRPKey{ProcessGuid: processGuid, Index: 1, Domain: domain})
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.ClaimActualLRP(logger, processGuid, 1, &models.ActualLRPInstanceKey{InstanceGuid: processGuid + "-instance", CellId: "missing-cell"})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		for i := 0; i < taskCount; i++ {
+			if i == taskCount/2 {
+				fakeClock.Increment(time.Minute)
+			}
+			_, err = sqlDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), fmt.Sprintf("benchmark-task-%d", i), domain)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	convergeLRPs := func() {
+		sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
+	}
+
+	convergeTasks := func() {
+		sqlDB.ConvergeTasks(logger, cellSet, 30*time.Second, time.Hour, time.Hour, 0)
+	}
+
+	newBatchingDB := func() *sqldb.SQLDB {
+		return sqldb.NewSQLDBWithOptions(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "", sqldb.SQLDBOptions{ConvergenceBatchSize: convergenceBatchSize})
+	}
+
+	// Each pass changes the store it converges, so every sample measures a
+	// single run against a freshly seeded store.
+	Measure("converging LRPs and then Tasks on a seeded store", func(b Benchmarker) {
+		b.Time("convergence", func() {
+			convergeLRPs()
+			convergeTasks()
+		})
+	}, sampleCount)
+
+	// Compared with the run above, this one writes the missing Actual LRPs
+	// with a statement for each batch of them instead of one for each.
+	Measure("converging LRPs and then Tasks on a seeded store in batches", func(b Benchmarker) {
+		batchingDB := newBatchingDB()
+		b.Time("convergence", func() {
+			batchingDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
+			batchingDB.ConvergeTasks(logger, cellSet, 30*time.Second, time.Hour, time.Hour, 0)
+		})
+	}, sampleCount)
+
+	Measure("converging LRPs and Tasks at the same time on a seeded store", func(b Benchmarker) {
+		b.Time("convergence", func() {
+			wg := sync.WaitGroup{}
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				convergeLRPs()
+			}()
+			go func() {
+				defer wg.Done()
+				convergeTasks()
+			}()
+			wg.Wait()
+		})
+	}, sampleCount)
+})
@@ -3,6 +3,7 @@ package sqldb
 import (
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"code.cloudfoundry.org/bbs/format"
@@ -15,71 +16,115 @@ func (db *SQLDB) DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) e
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		routesData, err := db.encodeRouteData(logger, desiredLRP.Routes)
-		if err != nil {
-			logger.Error("failed-encoding-route-data", err)
-			return err
-		}
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
 
-		runInfo := desiredLRP.DesiredLRPRunInfo(db.clock.Now())
+	return db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		return db.desireLRP(logger, tx, desiredLRP)
+	})
+}
 
-		runInfoData, err := db.serializeModel(logger, &runInfo)
-		if err != nil {
-			logger.Error("failed-to-serialize-model", err)
-			return err
-		}
+func (db *SQLDB) DesireLRPWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, desiredLRP *models.DesiredLRP) (bool, error) {
+	logger = logger.WithData(lager.Data{"process_guid": desiredLRP.ProcessGuid, "idempotency_key": key.Key})
+	logger.Info("starting")
+	defer logger.Info("complete")
 
-		volumePlacement := &models.VolumePlacement{}
-		volumePlacement.DriverNames = []string{}
-		for _, mount := range desiredLRP.VolumeMounts {
-			volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
-		}
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
 
-		volumePlacementData, err := db.serializeModel(logger, volumePlacement)
-		if err != nil {
-			logger.Error("failed-to-serialize-model", err)
+	var replayed bool
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		var err error
+		replayed, err = db.claimIdempotencyKey(logger, tx, key)
+		if err != nil || replayed {
 			return err
 		}
 
-		guid, err := db.guidProvider.NextGUID()
-		if err != nil {
-			logger.Error("failed-to-generate-guid", err)
-			return models.ErrGUIDGeneration
-		}
+		return db.desireLRP(logger, tx, desiredLRP)
+	})
 
-		placementTagData, err := json.Marshal(desiredLRP.PlacementTags)
-		if err != nil {
-			logger.Error("failed-to-serialize-model", err)
-			return err
-		}
+	return replayed, err
+}
 
-		desiredLRP.ModificationTag = &models.ModificationTag{Epoch: guid, Index: 0}
-
-		_, err = db.insert(logger, tx, desiredLRPsTable,
-			SQLAttributes{
-				"process_guid":           desiredLRP.ProcessGuid,
-				"domain":                 desiredLRP.Domain,
-				"log_guid":               desiredLRP.LogGuid,
-				"annotation":             desiredLRP.Annotation,
-				"instances":              desiredLRP.Instances,
-				"memory_mb":              desiredLRP.MemoryMb,
-				"disk_mb":                desiredLRP.DiskMb,
-				"rootfs":                 desiredLRP.RootFs,
-				"volume_placement":       volumePlacementData,
-				"modification_tag_epoch": desiredLRP.ModificationTag.Epoch,
-				"modification_tag_index": desiredLRP.ModificationTag.Index,
-				"routes":                 routesData,
-				"run_info":               runInfoData,
-				"placement_tags":         placementTagData,
-			},
-		)
-		if err != nil {
-			logger.Error("failed-inserting-desired", err)
-			return db.convertSQLError(err)
-		}
-		return nil
-	})
+func (db *SQLDB) desireLRP(logger lager.Logger, tx Queryable, desiredLRP *models.DesiredLRP) error {
+	routesData, err := db.encodeRouteData(logger, desiredLRP.Routes)
+	if err != nil {
+		logger.Error("failed-encoding-route-data", err)
+		return err
+	}
+
+	runInfo := desiredLRP.DesiredLRPRunInfo(db.clock.Now())
+
+	runInfoData, err := db.serializeModel(logger, &runInfo)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return err
+	}
+
+	volumePlacement := &models.VolumePlacement{}
+	volumePlacement.DriverNames = []string{}
+	for _, mount := range desiredLRP.VolumeMounts {
+		volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
+	}
+
+	volumePlacementData, err := db.serializeModel(logger, volumePlacement)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return err
+	}
+
+	guid, err := db.guidProvider.NextGUID()
+	if err != nil {
+		logger.Error("failed-to-generate-guid", err)
+		return models.ErrGUIDGeneration
+	}
+
+	placementTagData, err := json.Marshal(desiredLRP.PlacementTags)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return err
+	}
+
+	metadataLabelData, err := json.Marshal(desiredLRP.MetadataLabels)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return err
+	}
+
+	desiredLRP.ModificationTag = &models.ModificationTag{Epoch: guid, Index: 0}
+
+	revision, err := db.bumpRevision(logger, tx, desiredLRPsTable)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.insert(logger, tx, desiredLRPsTable,
+		SQLAttributes{
+			"process_guid":           desiredLRP.ProcessGuid,
+			"domain":                 desiredLRP.Domain,
+			"log_guid":               desiredLRP.LogGuid,
+			"annotation":             desiredLRP.Annotation,
+			"instances":              desiredLRP.Instances,
+			"memory_mb":              desiredLRP.MemoryMb,
+			"disk_mb":                desiredLRP.DiskMb,
+			"rootfs":                 desiredLRP.RootFs,
+			"volume_placement":       volumePlacementData,
+			"modification_tag_epoch": desiredLRP.ModificationTag.Epoch,
+			"modification_tag_index": desiredLRP.ModificationTag.Index,
+			"routes":                 routesData,
+			"run_info":               runInfoData,
+			"placement_tags":         placementTagData,
+			"metadata_labels":        metadataLabelData,
+			"start_order":            desiredLRP.StartOrder,
+			"modified_revision":      revision,
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-desired", err)
+		return db.convertSQLError(err)
+	}
+
+	return db.insertDesiredLRPLabels(logger, tx, desiredLRP.ProcessGuid, desiredLRP.MetadataLabels)
 }
 
 func (db *SQLDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
@@ -87,6 +132,9 @@ func (db *SQLDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
 	row := db.one(logger, db.db, desiredLRPsTable,
 		desiredLRPColumns, NoLockRow,
 		"process_guid = ?", processGuid,
@@ -95,10 +143,19 @@ func (db *SQLDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string
 }
 
 func (db *SQLDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	filter.PartialResults = false
+	desiredLRPs, _, err := db.DesiredLRPsWithRecordErrors(logger, filter)
+	return desiredLRPs, err
+}
+
+func (db *SQLDB) DesiredLRPsWithRecordErrors(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
 	logger = logger.WithData(lager.Data{"filter": filter})
 	logger.Debug("start")
 	defer logger.Debug("complete")
 
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
 	var wheres []string
 	var values []interface{}
 
@@ -107,21 +164,44 @@ func (db *SQLDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter
 		values = append(values, filter.Domain)
 	}
 
+	if len(filter.ProcessGuids) > 0 {
+		wheres = append(wheres, fmt.Sprintf("process_guid IN (%s)", questionMarks(len(filter.ProcessGuids))))
+		for _, guid := range filter.ProcessGuids {
+			values = append(values, guid)
+		}
+	}
+
+	if filter.ModifiedSinceRevision > 0 {
+		wheres = append(wheres, "modified_revision > ?")
+		values = append(values, filter.ModifiedSinceRevision)
+	}
+
+	labelWheres, labelValues := db.labelSelectorWheres(filter.LabelSelector)
+	liveWheres := append(append([]string{}, wheres...), labelWheres...)
+	liveValues := append(append([]interface{}{}, values...), labelValues...)
+
 	rows, err := db.all(logger, db.db, desiredLRPsTable,
 		desiredLRPColumns, NoLockRow,
-		strings.Join(wheres, " AND "), values...,
+		strings.Join(liveWheres, " AND "), liveValues...,
 	)
 	if err != nil {
 		logger.Error("failed-query", err)
-		return nil, db.convertSQLError(err)
+		return nil, nil, db.convertSQLError(err)
 	}
 	defer rows.Close()
 
 	results := []*models.DesiredLRP{}
+	var recordErrors []*models.RecordError
 	for rows.Next() {
-		desiredLRP, err := db.fetchDesiredLRP(logger, rows)
+		guid, desiredLRP, err := db.fetchDesiredLRPRecord(logger, rows)
+		if err == errMissingEncryptionKey && !filter.PartialResults {
+			return nil, nil, err
+		}
 		if err != nil {
 			logger.Error("failed-reading-row", err)
+			if recordError := unreadableDesiredLRP(guid, err); filter.PartialResults && recordError != nil {
+				recordErrors = append(recordErrors, recordError)
+			}
 			continue
 		}
 		results = append(results, desiredLRP)
@@ -129,17 +209,130 @@ func (db *SQLDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter
 
 	if rows.Err() != nil {
 		logger.Error("failed-fetching-row", rows.Err())
-		return nil, db.convertSQLError(rows.Err())
+		return nil, nil, db.convertSQLError(rows.Err())
 	}
 
-	return results, nil
+	if filter.IncludeDeleted {
+		tombstones, tombstoneRecordErrors, err := db.desiredLRPTombstones(logger, filter.LabelSelector, filter.PartialResults, strings.Join(wheres, " AND "), values...)
+		if err != nil {
+			return nil, nil, err
+		}
+		results = append(results, tombstones...)
+		recordErrors = append(recordErrors, tombstoneRecordErrors...)
+	}
+
+	return results, recordErrors, nil
+}
+
+// desiredLRPTombstones filters the tombstones by their labels as they are
+// read, as the labels table only has the labels of live desired LRPs.
+func (db *SQLDB) desiredLRPTombstones(logger lager.Logger, labelSelector models.LabelSelector, partialResults bool, wheres string, values ...interface{}) ([]*models.DesiredLRP, []*models.RecordError, error) {
+	rows, err := db.all(logger, db.db, desiredLRPTombstonesTable,
+		desiredLRPTombstoneColumns, NoLockRow,
+		wheres, values...,
+	)
+	if err != nil {
+		logger.Error("failed-query-tombstones", err)
+		return nil, nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	results := []*models.DesiredLRP{}
+	var recordErrors []*models.RecordError
+	for rows.Next() {
+		var runInfoData []byte
+		var deletedAt int64
+		guid, schedulingInfo, err := db.fetchDesiredLRPSchedulingInfoRecord(logger, rows, &runInfoData, &deletedAt)
+		err = db.quarantineRowByGuid(logger, db.db, err, desiredLRPTombstonesTable, "process_guid")
+		if err == errMissingEncryptionKey && !partialResults {
+			return nil, nil, err
+		}
+		if err != nil {
+			logger.Error("failed-reading-tombstone-row", err)
+			if recordError := unreadableDesiredLRP(guid, err); partialResults && recordError != nil {
+				recordErrors = append(recordErrors, recordError)
+			}
+			continue
+		}
+		if !labelSelector.Matches(schedulingInfo.MetadataLabels) {
+			continue
+		}
+
+		var runInfo models.DesiredLRPRunInfo
+		err = db.deserializeModel(logger, schedulingInfo.ProcessGuid, runInfoData, &runInfo)
+		err = db.quarantineRowByGuid(logger, db.db, err, desiredLRPTombstonesTable, "process_guid")
+		if err == errMissingEncryptionKey && !partialResults {
+			return nil, nil, err
+		}
+		if err != nil {
+			logger.Error("failed-parsing-tombstone-run-info", err)
+			if recordError := unreadableDesiredLRP(guid, err); partialResults && recordError != nil {
+				recordErrors = append(recordErrors, recordError)
+			}
+			continue
+		}
+
+		desiredLRP := models.NewDesiredLRP(*schedulingInfo, runInfo)
+		desiredLRP.DeletedAt = deletedAt
+		results = append(results, &desiredLRP)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-tombstone-row", rows.Err())
+		return nil, nil, db.convertSQLError(rows.Err())
+	}
+
+	return results, recordErrors, nil
+}
+
+// unreadableDesiredLRP returns the record error of a desired LRP row that was
+// scanned but could not be decoded, or nil when the row was skipped or could
+// not be scanned at all.
+func unreadableDesiredLRP(guid string, err error) *models.RecordError {
+	if guid == "" || err == errRecordSkipped || err == models.ErrTimeout {
+		return nil
+	}
+	if err == models.ErrResourceNotFound {
+		// fetchDesiredLRP reports a scanned row whose scheduling info cannot
+		// be decoded as not found
+		err = models.ErrDeserialize
+	}
+	return &models.RecordError{Guid: guid, Reason: err.Error()}
 }
 
 func (db *SQLDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+	filter.PartialResults = false
 	logger = logger.WithData(lager.Data{"filter": filter})
 	logger.Debug("start")
 	defer logger.Debug("complete")
 
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	results := []*models.DesiredLRPSchedulingInfo{}
+	_, err := db.streamDesiredLRPSchedulingInfos(logger, filter, func(schedulingInfo *models.DesiredLRPSchedulingInfo) error {
+		results = append(results, schedulingInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (db *SQLDB) StreamDesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error) {
+	logger = logger.Session("stream-desired-lrp-scheduling-infos", lager.Data{"filter": filter})
+	logger.Debug("start")
+	defer logger.Debug("complete")
+
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	return db.streamDesiredLRPSchedulingInfos(logger, filter, yield)
+}
+
+func (db *SQLDB) streamDesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error) {
 	var wheres []string
 	var values []interface{}
 
@@ -148,6 +341,22 @@ func (db *SQLDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.De
 		values = append(values, filter.Domain)
 	}
 
+	if len(filter.ProcessGuids) > 0 {
+		wheres = append(wheres, fmt.Sprintf("process_guid IN (%s)", questionMarks(len(filter.ProcessGuids))))
+		for _, guid := range filter.ProcessGuids {
+			values = append(values, guid)
+		}
+	}
+
+	if filter.ModifiedSinceRevision > 0 {
+		wheres = append(wheres, "modified_revision > ?")
+		values = append(values, filter.ModifiedSinceRevision)
+	}
+
+	labelWheres, labelValues := db.labelSelectorWheres(filter.LabelSelector)
+	wheres = append(wheres, labelWheres...)
+	values = append(values, labelValues...)
+
 	rows, err := db.all(logger, db.db, desiredLRPsTable,
 		schedulingInfoColumns, NoLockRow,
 		strings.Join(wheres, " AND "), values...,
@@ -158,14 +367,25 @@ func (db *SQLDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.De
 	}
 	defer rows.Close()
 
-	results := []*models.DesiredLRPSchedulingInfo{}
+	var recordErrors []*models.RecordError
 	for rows.Next() {
-		desiredLRPSchedulingInfo, err := db.fetchDesiredLRPSchedulingInfo(logger, rows)
+		guid, desiredLRPSchedulingInfo, err := db.fetchDesiredLRPSchedulingInfoRecord(logger, rows)
+		err = db.quarantineRowByGuid(logger, db.db, err, desiredLRPsTable, "process_guid")
+		if err == errMissingEncryptionKey && !filter.PartialResults {
+			return nil, err
+		}
 		if err != nil {
 			logger.Error("failed-reading-row", err)
+			if recordError := unreadableDesiredLRP(guid, err); filter.PartialResults && recordError != nil {
+				recordErrors = append(recordErrors, recordError)
+			}
 			continue
 		}
-		results = append(results, desiredLRPSchedulingInfo)
+
+		err = yield(desiredLRPSchedulingInfo)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if rows.Err() != nil {
@@ -173,7 +393,7 @@ func (db *SQLDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.De
 		return nil, db.convertSQLError(rows.Err())
 	}
 
-	return results, nil
+	return recordErrors, nil
 }
 
 func (db *SQLDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (*models.DesiredLRP, error) {
@@ -181,9 +401,18 @@ func (db *SQLDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, updat
 	logger.Info("starting")
 	defer logger.Info("complete")
 
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	var beforeDesiredLRP *models.DesiredLRP
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		var err error
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		// The revision row is locked before the desired LRP, as it is
+		// when desiring one, so that writes lock them in the same order.
+		revision, err := db.bumpRevision(logger, tx, desiredLRPsTable)
+		if err != nil {
+			return err
+		}
+
 		row := db.one(logger, tx, desiredLRPsTable,
 			desiredLRPColumns, LockRow,
 			"process_guid = ?", processGuid,
@@ -194,7 +423,10 @@ func (db *SQLDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, updat
 			return err
 		}
 
-		updateAttributes := SQLAttributes{"modification_tag_index": beforeDesiredLRP.ModificationTag.Index + 1}
+		updateAttributes := SQLAttributes{
+			"modification_tag_index": beforeDesiredLRP.ModificationTag.Index + 1,
+			"modified_revision":      revision,
+		}
 
 		if update.Annotation != nil {
 			updateAttributes["annotation"] = *update.Annotation
@@ -243,27 +475,87 @@ func (db *SQLDB) RemoveDesiredLRP(logger lager.Logger, processGuid string) error
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		err := db.lockDesiredLRPByGuidForUpdate(logger, processGuid, tx)
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	return db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		revision, err := db.bumpRevision(logger, tx, desiredLRPsTable)
+		if err != nil {
+			return err
+		}
+
+		err = db.lockDesiredLRPByGuidForUpdate(logger, processGuid, tx)
 		if err != nil {
 			logger.Error("failed-lock-desired", err)
 			return err
 		}
 
+		if db.tombstoneRetention > 0 {
+			err = db.tombstoneDesiredLRP(logger, tx, processGuid, revision)
+			if err != nil {
+				return err
+			}
+		}
+
 		_, err = db.delete(logger, tx, desiredLRPsTable, "process_guid = ?", processGuid)
 		if err != nil {
 			logger.Error("failed-deleting-from-db", err)
 			return db.convertSQLError(err)
 		}
 
-		return nil
+		return db.deleteDesiredLRPLabels(logger, tx, processGuid)
 	})
 }
 
+// tombstoneDesiredLRP copies the desired LRP into the tombstones table,
+// replacing an earlier tombstone of the same process guid, and stamps it with
+// the revision of the removal.
+func (db *SQLDB) tombstoneDesiredLRP(logger lager.Logger, tx Queryable, processGuid string, revision uint64) error {
+	_, err := db.delete(logger, tx, desiredLRPTombstonesTable, "process_guid = ?", processGuid)
+	if err != nil {
+		logger.Error("failed-deleting-previous-tombstone", err)
+		return db.convertSQLError(err)
+	}
+
+	columns := make([]string, len(desiredLRPColumns))
+	for i, column := range desiredLRPColumns {
+		columns[i] = strings.TrimPrefix(column, desiredLRPsTable+".")
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s WHERE process_guid = ?",
+		db.table(desiredLRPTombstonesTable), strings.Join(columns, ", "),
+		strings.Join(desiredLRPColumns, ", "), db.tableAs(desiredLRPsTable),
+	)
+	_, err = tx.Exec(db.rebind(query), processGuid)
+	if err != nil {
+		logger.Error("failed-inserting-tombstone", err)
+		return db.convertSQLError(err)
+	}
+
+	_, err = db.update(logger, tx, desiredLRPTombstonesTable,
+		SQLAttributes{"deleted_at": db.clock.Now().UnixNano(), "modified_revision": revision},
+		"process_guid = ?", processGuid,
+	)
+	if err != nil {
+		logger.Error("failed-stamping-tombstone", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
 // "rows" needs to have the columns defined in the schedulingInfoColumns constant
 func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scanner RowScanner, dest ...interface{}) (*models.DesiredLRPSchedulingInfo, error) {
+	_, schedulingInfo, err := db.fetchDesiredLRPSchedulingInfoRecord(logger, scanner, dest...)
+	return schedulingInfo, err
+}
+
+// fetchDesiredLRPSchedulingInfoRecord is fetchDesiredLRPSchedulingInfoAndMore
+// that also returns the process guid of the row once it is scanned, so that
+// the listings can report the rows they could not decode.
+func (db *SQLDB) fetchDesiredLRPSchedulingInfoRecord(logger lager.Logger, scanner RowScanner, dest ...interface{}) (string, *models.DesiredLRPSchedulingInfo, error) {
 	schedulingInfo := &models.DesiredLRPSchedulingInfo{}
-	var routeData, volumePlacementData, placementTagData []byte
+	var routeData, volumePlacementData, placementTagData, metadataLabelData []byte
 	values := []interface{}{
 		&schedulingInfo.ProcessGuid,
 		&schedulingInfo.Domain,
@@ -278,47 +570,60 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 		&schedulingInfo.ModificationTag.Epoch,
 		&schedulingInfo.ModificationTag.Index,
 		&placementTagData,
+		&metadataLabelData,
+		&schedulingInfo.StartOrder,
 	}
 	values = append(values, dest...)
 
 	err := scanner.Scan(values...)
 	if err != nil {
 		logger.Error("failed-scanning", err)
-		return nil, err
+		return "", nil, err
 	}
 
 	var routes models.Routes
 	encodedData, err := db.encoder.Decode(routeData)
 	if err != nil {
+		format.LogDecryptionFailure(logger, schedulingInfo.ProcessGuid, err)
 		logger.Error("failed-decrypting-routes", err)
-		return nil, err
+		if format.IsMissingKey(err) {
+			return schedulingInfo.ProcessGuid, nil, db.handleMissingKey(logger, "Routes", schedulingInfo.ProcessGuid, routeData, err)
+		}
+		return schedulingInfo.ProcessGuid, nil, err
 	}
 	err = json.Unmarshal(encodedData, &routes)
 	if err != nil {
 		logger.Error("failed-parsing-routes", err)
-		return nil, err
+		return schedulingInfo.ProcessGuid, nil, err
 	}
 	schedulingInfo.Routes = routes
 
 	var volumePlacement models.VolumePlacement
-	err = db.deserializeModel(logger, volumePlacementData, &volumePlacement)
+	err = db.deserializeModel(logger, schedulingInfo.ProcessGuid, volumePlacementData, &volumePlacement)
 	if err != nil {
 		logger.Error("failed-parsing-volume-placement", err)
-		return nil, err
+		return schedulingInfo.ProcessGuid, nil, err
 	}
 	schedulingInfo.VolumePlacement = &volumePlacement
 	if placementTagData != nil {
 		err = json.Unmarshal(placementTagData, &schedulingInfo.PlacementTags)
 		if err != nil {
 			logger.Error("failed-parsing-placement-tags", err)
-			return nil, err
+			return schedulingInfo.ProcessGuid, nil, err
+		}
+	}
+	if metadataLabelData != nil {
+		err = json.Unmarshal(metadataLabelData, &schedulingInfo.MetadataLabels)
+		if err != nil {
+			logger.Error("failed-parsing-metadata-labels", err)
+			return schedulingInfo.ProcessGuid, nil, err
 		}
 	}
 
-	return schedulingInfo, nil
+	return schedulingInfo.ProcessGuid, schedulingInfo, nil
 }
 
-func (db *SQLDB) lockDesiredLRPByGuidForUpdate(logger lager.Logger, processGuid string, tx *sql.Tx) error {
+func (db *SQLDB) lockDesiredLRPByGuidForUpdate(logger lager.Logger, processGuid string, tx Queryable) error {
 	row := db.one(logger, tx, desiredLRPsTable,
 		ColumnList{"1"}, LockRow,
 		"process_guid = ?", processGuid,
@@ -334,26 +639,59 @@ func (db *SQLDB) lockDesiredLRPByGuidForUpdate(logger lager.Logger, processGuid
 }
 
 func (db *SQLDB) fetchDesiredLRP(logger lager.Logger, scanner RowScanner) (*models.DesiredLRP, error) {
-	var runInfoData []byte
-	schedulingInfo, err := db.fetchDesiredLRPSchedulingInfoAndMore(logger, scanner, &runInfoData)
-	if err != nil {
-		logger.Error("failed-fetching-run-info", err)
-		return nil, models.ErrResourceNotFound
+	_, desiredLRP, err := db.fetchDesiredLRPRecord(logger, scanner)
+	return desiredLRP, err
+}
+
+// fetchDesiredLRPRecord is fetchDesiredLRP that also returns the process guid
+// of the row once it is scanned.
+func (db *SQLDB) fetchDesiredLRPRecord(logger lager.Logger, scanner RowScanner) (string, *models.DesiredLRP, error) {
+	guid, desiredLRP, schedulingInfoErr, runInfoErr := db.scanDesiredLRPRecord(logger, scanner)
+	if schedulingInfoErr != nil {
+		logger.Error("failed-fetching-run-info", schedulingInfoErr)
+		err := db.quarantineRowByGuid(logger, db.db, schedulingInfoErr, desiredLRPsTable, "process_guid")
+		if isMissingKeyError(err) {
+			return guid, nil, err
+		}
+		if db.convertSQLError(err) == models.ErrTimeout {
+			return guid, nil, models.ErrTimeout
+		}
+		return guid, nil, models.ErrResourceNotFound
 	}
 
-	var runInfo models.DesiredLRPRunInfo
-	err = db.deserializeModel(logger, runInfoData, &runInfo)
+	err := db.quarantineRowByGuid(logger, db.db, runInfoErr, desiredLRPsTable, "process_guid")
+	if isMissingKeyError(err) {
+		return guid, nil, err
+	}
 	if err != nil {
-		_, err := db.delete(logger, db.db, desiredLRPsTable, "process_guid = ?", schedulingInfo.ProcessGuid)
-		if err != nil {
-			logger.Error("failed-deleting-invalid-row", err)
+		_, deleteErr := db.delete(logger, db.db, desiredLRPsTable, "process_guid = ?", guid)
+		if deleteErr != nil {
+			logger.Error("failed-deleting-invalid-row", deleteErr)
+		} else {
+			db.deleteDesiredLRPLabels(logger, db.db, guid)
+			db.bumpRevision(logger, db.db, desiredLRPsTable)
 		}
-		return nil, models.ErrDeserialize
+		return guid, nil, models.ErrDeserialize
 	}
-	desiredLRP := models.NewDesiredLRP(*schedulingInfo, runInfo)
-	return &desiredLRP, nil
+	return guid, desiredLRP, nil
 }
 
-func (db *SQLDB) fetchDesiredLRPSchedulingInfo(logger lager.Logger, scanner RowScanner) (*models.DesiredLRPSchedulingInfo, error) {
-	return db.fetchDesiredLRPSchedulingInfoAndMore(logger, scanner)
+// scanDesiredLRPRecord scans and deserializes a DesiredLRP row without
+// writing to the database. An error reading the scheduling info is returned
+// as schedulingInfoErr and an error deserializing the run info as runInfoErr;
+// fetchDesiredLRPRecord decides what happens to the row in either case.
+func (db *SQLDB) scanDesiredLRPRecord(logger lager.Logger, scanner RowScanner) (guid string, desiredLRP *models.DesiredLRP, schedulingInfoErr, runInfoErr error) {
+	var runInfoData []byte
+	guid, schedulingInfo, err := db.fetchDesiredLRPSchedulingInfoRecord(logger, scanner, &runInfoData)
+	if err != nil {
+		return guid, nil, err, nil
+	}
+
+	var runInfo models.DesiredLRPRunInfo
+	err = db.deserializeModel(logger, schedulingInfo.ProcessGuid, runInfoData, &runInfo)
+	if err != nil {
+		return schedulingInfo.ProcessGuid, nil, nil, err
+	}
+	lrp := models.NewDesiredLRP(*schedulingInfo, runInfo)
+	return schedulingInfo.ProcessGuid, &lrp, nil, nil
 }
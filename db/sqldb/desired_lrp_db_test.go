@@ -2,8 +2,12 @@ package sqldb_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/test_helpers"
@@ -113,6 +117,9 @@ var _ = Describe("DesiredLRPDB", func() {
 			expectedDesiredLRPs = []*models.DesiredLRP{}
 			expectedDesiredLRPs = append(expectedDesiredLRPs, model_helpers.NewValidDesiredLRP("d-1"))
 			expectedDesiredLRPs = append(expectedDesiredLRPs, model_helpers.NewValidDesiredLRP("d-2"))
+			expectedDesiredLRPs[1].MetadataLabels = map[string]string{"team": "routing", "cost-center": "1234"}
+			expectedDesiredLRPs[1].StartOrder = models.StartOrder_LeaderFirst
+			expectedDesiredLRPs[1].ExtensionFields = map[string][]byte{"example.com/binary": {0x00, 0xff, 0x80}}
 			for i, expectedDesiredLRP := range expectedDesiredLRPs {
 				expectedDesiredLRP.Domain = fmt.Sprintf("domain-%d", i+1)
 				Expect(sqlDB.DesireLRP(logger, expectedDesiredLRP)).To(Succeed())
@@ -165,6 +172,59 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 		})
 
+		Context("when filtering by process guids", func() {
+			It("returns the filtered desired lrps", func() {
+				filter := models.DesiredLRPFilter{ProcessGuids: []string{expectedDesiredLRPs[1].ProcessGuid}}
+				desiredLRPs, err := sqlDB.DesiredLRPs(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(desiredLRPs).To(HaveLen(1))
+				Expect(desiredLRPs[0]).To(BeEquivalentTo(expectedDesiredLRPs[1]))
+			})
+		})
+
+		Context("when filtering by label selector", func() {
+			selectDesiredLRPs := func(expression string) []*models.DesiredLRP {
+				selector, err := models.ParseLabelSelector(expression)
+				Expect(err).NotTo(HaveOccurred())
+
+				desiredLRPs, err := sqlDB.DesiredLRPs(logger, models.DesiredLRPFilter{LabelSelector: selector})
+				Expect(err).NotTo(HaveOccurred())
+				return desiredLRPs
+			}
+
+			It("returns the desired lrps matching every requirement", func() {
+				Expect(selectDesiredLRPs("team=routing")).To(ConsistOf(expectedDesiredLRPs[1]))
+				Expect(selectDesiredLRPs("team=routing,cost-center in (1234,5678)")).To(ConsistOf(expectedDesiredLRPs[1]))
+				Expect(selectDesiredLRPs("team=routing,cost-center=5678")).To(BeEmpty())
+			})
+
+			It("supports the set based and existence requirements", func() {
+				Expect(selectDesiredLRPs("team")).To(ConsistOf(expectedDesiredLRPs[1]))
+				Expect(selectDesiredLRPs("!team")).To(ConsistOf(expectedDesiredLRPs[0]))
+				Expect(selectDesiredLRPs("team!=routing")).To(ConsistOf(expectedDesiredLRPs[0]))
+				Expect(selectDesiredLRPs("cost-center notin (5678)")).To(ConsistOf(expectedDesiredLRPs))
+			})
+
+			It("combines the selector with the other filters", func() {
+				selector, err := models.ParseLabelSelector("!team")
+				Expect(err).NotTo(HaveOccurred())
+
+				desiredLRPs, err := sqlDB.DesiredLRPs(logger, models.DesiredLRPFilter{Domain: "domain-2", LabelSelector: selector})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(BeEmpty())
+			})
+
+			It("no longer selects a desired lrp once it is removed", func() {
+				Expect(sqlDB.RemoveDesiredLRP(logger, expectedDesiredLRPs[1].ProcessGuid)).To(Succeed())
+				Expect(selectDesiredLRPs("team")).To(BeEmpty())
+
+				var count int
+				Expect(db.QueryRow("SELECT COUNT(*) FROM desired_lrp_labels").Scan(&count)).To(Succeed())
+				Expect(count).To(BeZero())
+			})
+		})
+
 		Context("when the run info is invalid", func() {
 			BeforeEach(func() {
 				queryStr := "UPDATE desired_lrps SET run_info = ? WHERE process_guid = ?"
@@ -183,6 +243,17 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(desiredLRPs).To(HaveLen(1))
 			})
+
+			Context("and partial results are requested", func() {
+				It("returns a record error for the invalid desired LRP", func() {
+					desiredLRPs, recordErrors, err := sqlDB.DesiredLRPsWithRecordErrors(logger, models.DesiredLRPFilter{PartialResults: true})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(desiredLRPs).To(HaveLen(1))
+					Expect(recordErrors).To(HaveLen(1))
+					Expect(recordErrors[0].Guid).To(Equal(expectedDesiredLRPs[0].ProcessGuid))
+					Expect(recordErrors[0].Reason).NotTo(BeEmpty())
+				})
+			})
 		})
 
 		Context("when the routes are invalid", func() {
@@ -215,6 +286,8 @@ var _ = Describe("DesiredLRPDB", func() {
 			expectedDesiredLRPSchedulingInfos = []*models.DesiredLRPSchedulingInfo{}
 			desiredLRP1 := model_helpers.NewValidDesiredLRP("d-1")
 			desiredLRP2 := model_helpers.NewValidDesiredLRP("d-2")
+			desiredLRP2.MetadataLabels = map[string]string{"team": "routing"}
+			desiredLRP2.StartOrder = models.StartOrder_LeaderFirst
 
 			expectedDesiredLRPs = append(expectedDesiredLRPs, desiredLRP1)
 			expectedDesiredLRPs = append(expectedDesiredLRPs, desiredLRP2)
@@ -242,6 +315,26 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 		})
 
+		Context("when filtering by process guids", func() {
+			It("returns the filtered scheduling infos", func() {
+				filter := models.DesiredLRPFilter{ProcessGuids: []string{expectedDesiredLRPSchedulingInfos[1].ProcessGuid}}
+				desiredLRPSchedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPSchedulingInfos).To(HaveLen(1))
+				Expect(desiredLRPSchedulingInfos[0]).To(BeEquivalentTo(expectedDesiredLRPSchedulingInfos[1]))
+			})
+		})
+
+		Context("when filtering by label selector", func() {
+			It("returns the scheduling infos carrying every selected label", func() {
+				filter := models.DesiredLRPFilter{LabelSelector: models.NewLabelSelectorFromMap(map[string]string{"team": "routing"})}
+				desiredLRPSchedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPSchedulingInfos).To(HaveLen(1))
+				Expect(desiredLRPSchedulingInfos[0]).To(BeEquivalentTo(expectedDesiredLRPSchedulingInfos[1]))
+			})
+		})
+
 		Context("when the routes are invalid", func() {
 			BeforeEach(func() {
 				queryStr := "UPDATE desired_lrps SET routes = ? WHERE process_guid = ?"
@@ -263,6 +356,184 @@ var _ = Describe("DesiredLRPDB", func() {
 		})
 	})
 
+	Describe("StreamDesiredLRPSchedulingInfos", func() {
+		var expectedSchedulingInfos []*models.DesiredLRPSchedulingInfo
+
+		BeforeEach(func() {
+			expectedSchedulingInfos = []*models.DesiredLRPSchedulingInfo{}
+			for i := 1; i <= 2; i++ {
+				desiredLRP := model_helpers.NewValidDesiredLRP(fmt.Sprintf("d-%d", i))
+				desiredLRP.Domain = fmt.Sprintf("domain-%d", i)
+				Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+				schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+				expectedSchedulingInfos = append(expectedSchedulingInfos, &schedulingInfo)
+			}
+		})
+
+		It("yields each scheduling info matching the filter", func() {
+			schedulingInfos := []*models.DesiredLRPSchedulingInfo{}
+			_, err := sqlDB.StreamDesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{Domain: "domain-1"}, func(schedulingInfo *models.DesiredLRPSchedulingInfo) error {
+				schedulingInfos = append(schedulingInfos, schedulingInfo)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedulingInfos).To(HaveLen(1))
+			Expect(schedulingInfos[0]).To(BeEquivalentTo(expectedSchedulingInfos[0]))
+		})
+
+		Context("when yield returns an error", func() {
+			It("stops and returns the error", func() {
+				calls := 0
+				_, err := sqlDB.StreamDesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{}, func(*models.DesiredLRPSchedulingInfo) error {
+					calls++
+					return errors.New("boom")
+				})
+				Expect(err).To(MatchError("boom"))
+				Expect(calls).To(Equal(1))
+			})
+		})
+
+		Context("when a scheduling info cannot be read and partial results are requested", func() {
+			BeforeEach(func() {
+				queryStr := "UPDATE desired_lrps SET routes = ? WHERE process_guid = ?"
+				if test_helpers.UsePostgres() {
+					queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+				}
+				_, err := db.Exec(queryStr, "{{", expectedSchedulingInfos[0].ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("yields the other scheduling infos and returns a record error for it", func() {
+				schedulingInfos := []*models.DesiredLRPSchedulingInfo{}
+				recordErrors, err := sqlDB.StreamDesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{PartialResults: true}, func(schedulingInfo *models.DesiredLRPSchedulingInfo) error {
+					schedulingInfos = append(schedulingInfos, schedulingInfo)
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(schedulingInfos).To(HaveLen(1))
+				Expect(recordErrors).To(HaveLen(1))
+				Expect(recordErrors[0].Guid).To(Equal(expectedSchedulingInfos[0].ProcessGuid))
+			})
+		})
+	})
+
+	Describe("DesiredLRPsRevision", func() {
+		var desiredLRP *models.DesiredLRP
+
+		revision := func() uint64 {
+			revision, err := sqlDB.DesiredLRPsRevision(logger)
+			Expect(err).NotTo(HaveOccurred())
+			return revision
+		}
+
+		BeforeEach(func() {
+			desiredLRP = model_helpers.NewValidDesiredLRP("the-guid")
+		})
+
+		It("increases when a desired LRP is desired, updated, and removed", func() {
+			initial := revision()
+
+			Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+			afterDesire := revision()
+			Expect(afterDesire).To(BeNumerically(">", initial))
+
+			instances := int32(3)
+			_, err := sqlDB.UpdateDesiredLRP(logger, desiredLRP.ProcessGuid, &models.DesiredLRPUpdate{Instances: &instances})
+			Expect(err).NotTo(HaveOccurred())
+			afterUpdate := revision()
+			Expect(afterUpdate).To(BeNumerically(">", afterDesire))
+
+			Expect(sqlDB.RemoveDesiredLRP(logger, desiredLRP.ProcessGuid)).To(Succeed())
+			Expect(revision()).To(BeNumerically(">", afterUpdate))
+		})
+
+		It("does not change when nothing is written", func() {
+			Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+			before := revision()
+
+			_, err := sqlDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision()).To(Equal(before))
+		})
+
+		Describe("listing the desired LRPs modified since a revision", func() {
+			var otherDesiredLRP *models.DesiredLRP
+
+			processGuids := func(filter models.DesiredLRPFilter) []string {
+				desiredLRPs, err := sqlDB.DesiredLRPs(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				guids := []string{}
+				for _, desiredLRP := range desiredLRPs {
+					guids = append(guids, desiredLRP.ProcessGuid)
+				}
+				return guids
+			}
+
+			BeforeEach(func() {
+				otherDesiredLRP = model_helpers.NewValidDesiredLRP("other-guid")
+				Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+				Expect(sqlDB.DesireLRP(logger, otherDesiredLRP)).To(Succeed())
+			})
+
+			It("lists every desired LRP when the revision is 0", func() {
+				Expect(processGuids(models.DesiredLRPFilter{})).To(ConsistOf("the-guid", "other-guid"))
+			})
+
+			It("lists no desired LRP at the current revision", func() {
+				Expect(processGuids(models.DesiredLRPFilter{ModifiedSinceRevision: revision()})).To(BeEmpty())
+			})
+
+			It("lists the desired LRPs desired after the revision", func() {
+				since := revision()
+				Expect(sqlDB.DesireLRP(logger, model_helpers.NewValidDesiredLRP("new-guid"))).To(Succeed())
+
+				Expect(processGuids(models.DesiredLRPFilter{ModifiedSinceRevision: since})).To(ConsistOf("new-guid"))
+			})
+
+			It("lists the desired LRPs updated after the revision", func() {
+				since := revision()
+				instances := int32(3)
+				_, err := sqlDB.UpdateDesiredLRP(logger, otherDesiredLRP.ProcessGuid, &models.DesiredLRPUpdate{Instances: &instances})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(processGuids(models.DesiredLRPFilter{ModifiedSinceRevision: since})).To(ConsistOf("other-guid"))
+			})
+
+			It("lists a desired LRP only before the revision of its change", func() {
+				instances := int32(3)
+				_, err := sqlDB.UpdateDesiredLRP(logger, otherDesiredLRP.ProcessGuid, &models.DesiredLRPUpdate{Instances: &instances})
+				Expect(err).NotTo(HaveOccurred())
+				updated := revision()
+
+				Expect(processGuids(models.DesiredLRPFilter{ModifiedSinceRevision: updated - 1})).To(ConsistOf("other-guid"))
+				Expect(processGuids(models.DesiredLRPFilter{ModifiedSinceRevision: updated})).To(BeEmpty())
+			})
+
+			It("applies to the scheduling infos too", func() {
+				since := revision()
+				instances := int32(3)
+				_, err := sqlDB.UpdateDesiredLRP(logger, otherDesiredLRP.ProcessGuid, &models.DesiredLRPUpdate{Instances: &instances})
+				Expect(err).NotTo(HaveOccurred())
+
+				schedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ModifiedSinceRevision: since})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(schedulingInfos).To(HaveLen(1))
+				Expect(schedulingInfos[0].ProcessGuid).To(Equal("other-guid"))
+			})
+		})
+
+		Context("when a write fails", func() {
+			It("does not change", func() {
+				Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+				before := revision()
+
+				Expect(sqlDB.DesireLRP(logger, desiredLRP)).NotTo(Succeed())
+				Expect(revision()).To(Equal(before))
+			})
+		})
+	})
+
 	Describe("UpdateDesiredLRP", func() {
 		var expectedDesiredLRP *models.DesiredLRP
 		var update *models.DesiredLRPUpdate
@@ -331,6 +602,43 @@ var _ = Describe("DesiredLRPDB", func() {
 			Expect(desiredLRP).To(BeEquivalentTo(expectedDesiredLRP))
 		})
 
+		It("updates only the routes when they are the only field present", func() {
+			routeContent := []byte(`{"port":8080}`)
+			routes := models.Routes{
+				"blah": (*json.RawMessage)(&routeContent),
+			}
+			update = &models.DesiredLRPUpdate{
+				Routes: &routes,
+			}
+			_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedDesiredLRP.Routes = &routes
+			expectedDesiredLRP.ModificationTag.Increment()
+
+			Expect(desiredLRP).To(BeEquivalentTo(expectedDesiredLRP))
+		})
+
+		It("updates only the annotation when it is the only field present", func() {
+			annotation := ""
+			update = &models.DesiredLRPUpdate{
+				Annotation: &annotation,
+			}
+			_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedDesiredLRP.Annotation = annotation
+			expectedDesiredLRP.ModificationTag.Increment()
+
+			Expect(desiredLRP).To(BeEquivalentTo(expectedDesiredLRP))
+		})
+
 		It("updates only the modification tag if update is empty", func() {
 			update = &models.DesiredLRPUpdate{}
 			_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update)
@@ -390,5 +698,106 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
+
+		It("does not keep a tombstone", func() {
+			Expect(sqlDB.RemoveDesiredLRP(logger, expectedDesiredLRP.ProcessGuid)).To(Succeed())
+
+			desiredLRPs, err := sqlDB.DesiredLRPs(logger, models.DesiredLRPFilter{IncludeDeleted: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRPs).To(BeEmpty())
+		})
+
+		Context("when soft delete is enabled", func() {
+			const retention = time.Hour
+
+			var tombstoningDB *sqldb.SQLDB
+
+			BeforeEach(func() {
+				tombstoningDB = sqldb.NewSQLDB(db, 5, 5, StuckEvacuationThreshold, retention, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "")
+
+				Expect(tombstoningDB.RemoveDesiredLRP(logger, expectedDesiredLRP.ProcessGuid)).To(Succeed())
+			})
+
+			It("removes the lrp", func() {
+				_, err := tombstoningDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+
+				desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(BeEmpty())
+
+				schedulingInfos, err := tombstoningDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(schedulingInfos).To(BeEmpty())
+			})
+
+			It("keeps a tombstone stamped with the time it was removed", func() {
+				desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{IncludeDeleted: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(HaveLen(1))
+
+				tombstone := *expectedDesiredLRP
+				tombstone.DeletedAt = fakeClock.Now().UnixNano()
+				Expect(desiredLRPs[0]).To(BeEquivalentTo(&tombstone))
+			})
+
+			It("stamps the tombstone with the revision of the removal", func() {
+				removed, err := tombstoningDB.DesiredLRPsRevision(logger)
+				Expect(err).NotTo(HaveOccurred())
+
+				desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{ModifiedSinceRevision: removed - 1, IncludeDeleted: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(HaveLen(1))
+
+				desiredLRPs, err = tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{ModifiedSinceRevision: removed, IncludeDeleted: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(BeEmpty())
+			})
+
+			It("filters the tombstones like the desired lrps", func() {
+				desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{Domain: "other-domain", IncludeDeleted: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(BeEmpty())
+
+				desiredLRPs, err = tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{ProcessGuids: []string{expectedDesiredLRP.ProcessGuid}, IncludeDeleted: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(HaveLen(1))
+			})
+
+			Context("when the process guid is desired and removed again", func() {
+				BeforeEach(func() {
+					fakeClock.Increment(time.Minute)
+					Expect(tombstoningDB.DesireLRP(logger, expectedDesiredLRP)).To(Succeed())
+					Expect(tombstoningDB.RemoveDesiredLRP(logger, expectedDesiredLRP.ProcessGuid)).To(Succeed())
+				})
+
+				It("replaces the tombstone", func() {
+					desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{IncludeDeleted: true})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(desiredLRPs).To(HaveLen(1))
+					Expect(desiredLRPs[0].DeletedAt).To(Equal(fakeClock.Now().UnixNano()))
+				})
+			})
+
+			Context("when convergence runs", func() {
+				It("keeps the tombstone within the retention window", func() {
+					fakeClock.Increment(retention - time.Second)
+					tombstoningDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
+
+					desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{IncludeDeleted: true})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(desiredLRPs).To(HaveLen(1))
+				})
+
+				It("purges the tombstone after the retention window", func() {
+					fakeClock.Increment(retention)
+					tombstoningDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
+
+					desiredLRPs, err := tombstoningDB.DesiredLRPs(logger, models.DesiredLRPFilter{IncludeDeleted: true})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(desiredLRPs).To(BeEmpty())
+				})
+			})
+		})
 	})
 })
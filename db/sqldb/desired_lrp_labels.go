@@ -0,0 +1,88 @@
+package sqldb
+
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// The metadata labels of a desired LRP are read from its metadata_labels
+// column. They are also kept a row per label in the desired_lrp_labels table,
+// indexed by key and value, which is only used to filter listings by a label
+// selector.
+
+func (db *SQLDB) insertDesiredLRPLabels(logger lager.Logger, tx Queryable, processGuid string, labels map[string]string) error {
+	err := db.deleteDesiredLRPLabels(logger, tx, processGuid)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		_, err := db.insert(logger, tx, desiredLRPLabelsTable,
+			SQLAttributes{
+				"process_guid": processGuid,
+				"label_key":    key,
+				"label_value":  labels[key],
+			},
+		)
+		if err != nil {
+			logger.Error("failed-inserting-label", err)
+			return db.convertSQLError(err)
+		}
+	}
+
+	return nil
+}
+
+func (db *SQLDB) deleteDesiredLRPLabels(logger lager.Logger, tx Queryable, processGuid string) error {
+	_, err := db.delete(logger, tx, desiredLRPLabelsTable, "process_guid = ?", processGuid)
+	if err != nil {
+		logger.Error("failed-deleting-labels", err)
+		return db.convertSQLError(err)
+	}
+	return nil
+}
+
+// labelSelectorWheres returns the conditions on the desired_lrps table that
+// select the desired LRPs matching selector. Each requirement is an EXISTS or
+// NOT EXISTS subquery looking up the labels of the desired LRP by key, and
+// value where the requirement has values.
+func (db *SQLDB) labelSelectorWheres(selector models.LabelSelector) ([]string, []interface{}) {
+	var wheres []string
+	var values []interface{}
+
+	for _, requirement := range selector {
+		subquery := fmt.Sprintf("SELECT 1 FROM %s WHERE %s.process_guid = %s.process_guid AND %s.label_key = ?",
+			db.tableAs(desiredLRPLabelsTable), desiredLRPLabelsTable, desiredLRPsTable, desiredLRPLabelsTable,
+		)
+		values = append(values, requirement.Key)
+
+		switch requirement.Operator {
+		case models.LabelOperatorIn, models.LabelOperatorNotIn:
+			subquery += fmt.Sprintf(" AND %s.label_value IN (%s)", desiredLRPLabelsTable, questionMarks(len(requirement.Values)))
+			for _, value := range requirement.Values {
+				values = append(values, value)
+			}
+		}
+
+		switch requirement.Operator {
+		case models.LabelOperatorIn, models.LabelOperatorExists:
+			wheres = append(wheres, "EXISTS ("+subquery+")")
+		case models.LabelOperatorNotIn, models.LabelOperatorDoesNotExist:
+			wheres = append(wheres, "NOT EXISTS ("+subquery+")")
+		default:
+			// like LabelRequirement.Matches, an unknown operator matches nothing
+			wheres = append(wheres, "1 = 0")
+		}
+	}
+
+	return wheres, values
+}
@@ -1,10 +1,10 @@
 package sqldb
 
 import (
-	"database/sql"
 	"math"
 	"time"
 
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
 
@@ -13,7 +13,10 @@ func (db *SQLDB) Domains(logger lager.Logger) ([]string, error) {
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
-	expireTime := db.clock.Now().Round(time.Second).UnixNano()
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	expireTime := db.freshnessCutoff(db.clock.Now()).Round(time.Second).UnixNano()
 	rows, err := db.all(logger, db.db, domainsTable,
 		domainColumns, NoLockRow,
 		"expire_time > ?", expireTime,
@@ -43,25 +46,118 @@ func (db *SQLDB) Domains(logger lager.Logger) ([]string, error) {
 	return results, nil
 }
 
+func (db *SQLDB) DomainFreshness(logger lager.Logger) ([]*models.DomainFreshness, error) {
+	logger = logger.Session("domain-freshness")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	now := db.clock.Now().Round(time.Second)
+	rows, err := db.all(logger, db.db, domainsTable,
+		ColumnList{"domain", "expire_time"}, NoLockRow,
+		"expire_time > ?", db.freshnessCutoff(now).UnixNano(),
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+
+	defer rows.Close()
+
+	results := []*models.DomainFreshness{}
+	for rows.Next() {
+		var domain string
+		var expireTime int64
+		err = rows.Scan(&domain, &expireTime)
+		if err != nil {
+			logger.Error("failed-scan-row", err)
+			return nil, db.convertSQLError(err)
+		}
+
+		freshness := &models.DomainFreshness{Domain: domain}
+		if expireTime != math.MaxInt64 {
+			freshness.ExpireTime = expireTime
+			if expireTime > now.UnixNano() {
+				freshness.TtlRemaining = uint32(time.Duration(expireTime-now.UnixNano()) / time.Second)
+			}
+		}
+		results = append(results, freshness)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	models.SortDomainFreshness(results)
+	return results, nil
+}
+
 func (db *SQLDB) UpsertDomain(logger lager.Logger, domain string, ttl uint32) error {
 	logger = logger.Session("upsert-domain", lager.Data{"domain": domain, "ttl": ttl})
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		expireTime := db.clock.Now().Add(time.Duration(ttl) * time.Second).UnixNano()
-		if ttl == 0 {
-			expireTime = math.MaxInt64
-		}
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
 
-		_, err := db.upsert(logger, tx, domainsTable,
-			SQLAttributes{"domain": domain},
-			SQLAttributes{"expire_time": expireTime},
-		)
-		if err != nil {
-			logger.Error("failed-upsert-domain", err)
-			return db.convertSQLError(err)
+	return db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		return db.upsertDomain(logger, tx, domain, ttl)
+	})
+}
+
+// UpsertDomains upserts the whole batch in a single transaction, so either
+// every domain is upserted or they all fail with the same error.
+func (db *SQLDB) UpsertDomains(logger lager.Logger, domains []*models.UpsertDomainRequest) []error {
+	logger = logger.Session("upsert-domains", lager.Data{"count": len(domains)})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		for _, domain := range domains {
+			err := db.upsertDomain(logger.WithData(lager.Data{"domain": domain.Domain, "ttl": domain.Ttl}), tx, domain.Domain, domain.Ttl)
+			if err != nil {
+				return err
+			}
 		}
 		return nil
 	})
+
+	errs := make([]error, len(domains))
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+	}
+	return errs
+}
+
+func (db *SQLDB) upsertDomain(logger lager.Logger, tx Queryable, domain string, ttl uint32) error {
+	expireTime := db.clock.Now().Add(time.Duration(ttl) * time.Second).UnixNano()
+	if ttl == 0 {
+		expireTime = math.MaxInt64
+	}
+
+	_, err := db.upsert(logger, tx, domainsTable,
+		SQLAttributes{"domain": domain},
+		SQLAttributes{"expire_time": expireTime},
+	)
+	if err != nil {
+		logger.Error("failed-upsert-domain", err)
+		return db.convertSQLError(err)
+	}
+	return nil
+}
+
+// freshnessCutoff returns the time after which a domain must expire to still
+// be fresh at now. The expiry is written by the clock of whichever BBS
+// upserted the domain, so domains stay fresh for the clock skew tolerance
+// after they expire by this clock.
+func (db *SQLDB) freshnessCutoff(now time.Time) time.Time {
+	return now.Add(-db.clockSkewTolerance)
 }
@@ -4,6 +4,9 @@ import (
 	"math"
 	"time"
 
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/test_helpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -51,6 +54,88 @@ var _ = Describe("DomainDB", func() {
 		})
 	})
 
+	Describe("DomainFreshness", func() {
+		Context("when there are domains in the DB", func() {
+			var futureTime int64
+
+			BeforeEach(func() {
+				futureTime = fakeClock.Now().Round(time.Second).Add(5 * time.Second).UnixNano()
+
+				queryStr := "INSERT INTO domains VALUES (?, ?)"
+				if test_helpers.UsePostgres() {
+					queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+				}
+				_, err := db.Exec(queryStr, "jims-domain", futureTime)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = db.Exec(queryStr, "amelias-domain", int64(math.MaxInt64))
+				Expect(err).NotTo(HaveOccurred())
+
+				pastTime := fakeClock.Now().Add(-5 * time.Second).UnixNano()
+				_, err = db.Exec(queryStr, "past-domain", pastTime)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the expiry of each non-expired domain, sorted by domain", func() {
+				freshness, err := sqlDB.DomainFreshness(logger)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(freshness).To(Equal([]*models.DomainFreshness{
+					{Domain: "amelias-domain"},
+					{Domain: "jims-domain", ExpireTime: futureTime, TtlRemaining: 5},
+				}))
+			})
+		})
+
+		Context("when there are no domains in the DB", func() {
+			It("returns no domains", func() {
+				freshness, err := sqlDB.DomainFreshness(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(freshness).To(HaveLen(0))
+			})
+		})
+	})
+
+	Context("with a clock skew tolerance", func() {
+		const tolerance = 2 * time.Second
+
+		var skewTolerantDB *sqldb.SQLDB
+
+		BeforeEach(func() {
+			skewTolerantDB = sqldb.NewSQLDBWithOptions(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "", sqldb.SQLDBOptions{ClockSkewTolerance: tolerance})
+
+			queryStr := "INSERT INTO domains VALUES (?, ?)"
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+
+			// written by a BBS whose clock runs a second behind this one
+			_, err := db.Exec(queryStr, "skewed-domain", fakeClock.Now().Add(-time.Second).UnixNano())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = db.Exec(queryStr, "past-domain", fakeClock.Now().Add(-5*time.Second).UnixNano())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("keeps a domain fresh until the tolerance after it expires", func() {
+			domains, err := sqlDB.Domains(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domains).To(BeEmpty())
+
+			domains, err = skewTolerantDB.Domains(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domains).To(ConsistOf("skewed-domain"))
+		})
+
+		It("reports no TTL remaining for a domain within the tolerance", func() {
+			freshness, err := skewTolerantDB.DomainFreshness(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freshness).To(HaveLen(1))
+			Expect(freshness[0].Domain).To(Equal("skewed-domain"))
+			Expect(freshness[0].TtlRemaining).To(BeZero())
+		})
+	})
+
 	Describe("UpsertDomain", func() {
 		Context("when the domain is not present in the DB", func() {
 			It("inserts a new domain with the requested TTL", func() {
@@ -143,4 +228,37 @@ var _ = Describe("DomainDB", func() {
 			})
 		})
 	})
+
+	Describe("UpsertDomains", func() {
+		It("upserts every domain with its TTL", func() {
+			errs := sqlDB.UpsertDomains(logger, []*models.UpsertDomainRequest{
+				{Domain: "domain-1", Ttl: 5432},
+				{Domain: "domain-2"},
+			})
+			Expect(errs).To(Equal([]error{nil, nil}))
+
+			freshness, err := sqlDB.DomainFreshness(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freshness).To(Equal([]*models.DomainFreshness{
+				{Domain: "domain-1", ExpireTime: fakeClock.Now().Add(5432 * time.Second).UnixNano(), TtlRemaining: 5432},
+				{Domain: "domain-2"},
+			}))
+		})
+
+		Context("when a domain fails to upsert", func() {
+			It("upserts none of the domains and returns the error for each", func() {
+				errs := sqlDB.UpsertDomains(logger, []*models.UpsertDomainRequest{
+					{Domain: "domain-1", Ttl: 5432},
+					{Domain: randStr(256), Ttl: 5432},
+				})
+				Expect(errs).To(HaveLen(2))
+				Expect(errs[0]).To(HaveOccurred())
+				Expect(errs[1]).To(Equal(errs[0]))
+
+				domains, err := sqlDB.Domains(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(domains).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -1,14 +1,16 @@
 package sqldb
 
 import (
-	"database/sql"
 	"fmt"
 
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/lager"
 )
 
-const EncryptionKeyID = "encryption_key_label"
+const (
+	EncryptionKeyID       = "encryption_key_label"
+	EncryptionAlgorithmID = "encryption_algorithm"
+)
 
 func (db *SQLDB) SetEncryptionKeyLabel(logger lager.Logger, label string) error {
 	logger = logger.Session("set-encrption-key-label", lager.Data{"label": label})
@@ -26,6 +28,22 @@ func (db *SQLDB) EncryptionKeyLabel(logger lager.Logger) (string, error) {
 	return db.getConfigurationValue(logger, EncryptionKeyID)
 }
 
+func (db *SQLDB) SetEncryptionAlgorithm(logger lager.Logger, algorithm string) error {
+	logger = logger.Session("set-encryption-algorithm", lager.Data{"algorithm": algorithm})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.setConfigurationValue(logger, EncryptionAlgorithmID, algorithm)
+}
+
+func (db *SQLDB) EncryptionAlgorithm(logger lager.Logger) (string, error) {
+	logger = logger.Session("encryption-algorithm")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.getConfigurationValue(logger, EncryptionAlgorithmID)
+}
+
 func (db *SQLDB) PerformEncryption(logger lager.Logger) error {
 	errCh := make(chan error)
 	go func() {
@@ -40,8 +58,14 @@ func (db *SQLDB) PerformEncryption(logger lager.Logger) error {
 	go func() {
 		errCh <- db.reEncrypt(logger, actualLRPsTable, "process_guid", "net_info")
 	}()
+	go func() {
+		errCh <- db.reEncrypt(logger, desiredLRPTombstonesTable, "process_guid", "run_info")
+	}()
+	go func() {
+		errCh <- db.reEncrypt(logger, desiredLRPTombstonesTable, "process_guid", "volume_placement")
+	}()
 
-	for i := 0; i < 4; i++ {
+	for i := 0; i < 6; i++ {
 		err := <-errCh
 		if err != nil {
 			return err
@@ -54,7 +78,7 @@ func (db *SQLDB) reEncrypt(logger lager.Logger, tableName, primaryKey, blobColum
 	logger = logger.WithData(
 		lager.Data{"table_name": tableName, "primary_key": primaryKey, "blob_column": blobColumn},
 	)
-	rows, err := db.db.Query(fmt.Sprintf("SELECT %s FROM %s", primaryKey, tableName))
+	rows, err := db.db.Query(fmt.Sprintf("SELECT %s FROM %s", primaryKey, db.table(tableName)))
 	if err != nil {
 		return db.convertSQLError(err)
 	}
@@ -69,7 +93,7 @@ func (db *SQLDB) reEncrypt(logger lager.Logger, tableName, primaryKey, blobColum
 			continue
 		}
 
-		err = db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		err = db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 			var blob []byte
 			row := db.one(logger, tx, tableName, ColumnList{blobColumn}, LockRow, where, guid)
 			err := row.Scan(&blob)
@@ -80,6 +104,7 @@ func (db *SQLDB) reEncrypt(logger lager.Logger, tableName, primaryKey, blobColum
 			encoder := format.NewEncoder(db.cryptor)
 			payload, err := encoder.Decode(blob)
 			if err != nil {
+				format.LogDecryptionFailure(logger, guid, err)
 				logger.Error("failed-to-decode-blob", err)
 				return nil
 			}
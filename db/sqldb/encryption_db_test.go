@@ -107,6 +107,27 @@ var _ = Describe("Encryption", func() {
 		})
 	})
 
+	Describe("EncryptionAlgorithm", func() {
+		Context("when the encryption algorithm has been set", func() {
+			It("retrieves it from the database", func() {
+				err := sqlDB.SetEncryptionAlgorithm(logger, "xchacha20-poly1305")
+				Expect(err).NotTo(HaveOccurred())
+
+				algorithm, err := sqlDB.EncryptionAlgorithm(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(algorithm).To(Equal("xchacha20-poly1305"))
+			})
+		})
+
+		Context("when the encryption algorithm has not been set", func() {
+			It("returns a ErrResourceNotFound", func() {
+				algorithm, err := sqlDB.EncryptionAlgorithm(logger)
+				Expect(err).To(MatchError(models.ErrResourceNotFound))
+				Expect(algorithm).To(Equal(""))
+			})
+		})
+	})
+
 	makeCryptor := func(activeLabel string, decryptionLabels ...string) encryption.Cryptor {
 		activeKey, err := encryption.NewKey(activeLabel, fmt.Sprintf("%s-passphrase", activeLabel))
 		Expect(err).NotTo(HaveOccurred())
@@ -185,7 +206,7 @@ var _ = Describe("Encryption", func() {
 
 			cryptor = makeCryptor("new", "old")
 
-			sqlDB := sqldb.NewSQLDB(db, 5, 5, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor)
+			sqlDB := sqldb.NewSQLDB(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "")
 			err = sqlDB.PerformEncryption(logger)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -254,7 +275,7 @@ var _ = Describe("Encryption", func() {
 
 			cryptor = makeCryptor("new", "old")
 
-			sqlDB := sqldb.NewSQLDB(db, 5, 5, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor)
+			sqlDB := sqldb.NewSQLDB(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "")
 			err = sqlDB.PerformEncryption(logger)
 			Expect(err).NotTo(HaveOccurred())
 		})
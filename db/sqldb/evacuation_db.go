@@ -1,7 +1,6 @@
 package sqldb
 
 import (
-	"database/sql"
 	"reflect"
 	"time"
 
@@ -20,9 +19,12 @@ func (db *SQLDB) EvacuateActualLRP(
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	var actualLRP *models.ActualLRP
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		var err error
 		processGuid := lrpKey.ProcessGuid
 		index := lrpKey.Index
@@ -88,7 +90,10 @@ func (db *SQLDB) RemoveEvacuatingActualLRP(logger lager.Logger, lrpKey *models.A
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	return db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		processGuid := lrpKey.ProcessGuid
 		index := lrpKey.Index
 
@@ -126,7 +131,7 @@ func (db *SQLDB) createEvacuatingActualLRP(logger lager.Logger,
 	instanceKey *models.ActualLRPInstanceKey,
 	netInfo *models.ActualLRPNetInfo,
 	ttl uint64,
-	tx *sql.Tx,
+	tx Queryable,
 ) (*models.ActualLRP, error) {
 	netInfoData, err := db.serializeModel(logger, netInfo)
 	if err != nil {
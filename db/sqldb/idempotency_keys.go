@@ -0,0 +1,68 @@
+package sqldb
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// claimIdempotencyKey records the key within the creation transaction, so the
+// key only survives if the creation commits. It returns true when the key
+// was already recorded for the same request, in which case the caller must
+// not create anything.
+func (db *SQLDB) claimIdempotencyKey(logger lager.Logger, tx Queryable, key models.IdempotencyKey) (bool, error) {
+	logger = logger.Session("claim-idempotency-key")
+
+	now := db.clock.Now()
+
+	_, err := db.delete(logger, tx, idempotencyKeysTable,
+		"idempotency_key = ? AND expire_time <= ?", key.Key, now.UnixNano(),
+	)
+	if err != nil {
+		logger.Error("failed-deleting-expired-key", err)
+		return false, db.convertSQLError(err)
+	}
+
+	var fingerprint string
+	row := db.one(logger, tx, idempotencyKeysTable,
+		ColumnList{"fingerprint"}, LockRow,
+		"idempotency_key = ?", key.Key,
+	)
+	err = row.Scan(&fingerprint)
+	switch {
+	case err == nil:
+		if fingerprint != key.Fingerprint {
+			logger.Info("idempotency-key-conflict")
+			return false, models.ErrIdempotencyKeyConflict
+		}
+		logger.Info("replaying-request")
+		return true, nil
+	case err != sql.ErrNoRows:
+		logger.Error("failed-fetching-key", err)
+		return false, db.convertSQLError(err)
+	}
+
+	_, err = db.insert(logger, tx, idempotencyKeysTable,
+		SQLAttributes{
+			"idempotency_key": key.Key,
+			"fingerprint":     key.Fingerprint,
+			"expire_time":     now.Add(models.IdempotencyKeyTTL).UnixNano(),
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-key", err)
+		return false, db.convertSQLError(err)
+	}
+
+	return false, nil
+}
+
+func (db *SQLDB) deleteExpiredIdempotencyKeys(logger lager.Logger) {
+	logger = logger.Session("delete-expired-idempotency-keys")
+
+	_, err := db.delete(logger, db.db, idempotencyKeysTable, "expire_time <= ?", db.clock.Now().UnixNano())
+	if err != nil {
+		logger.Error("failed-query", err)
+	}
+}
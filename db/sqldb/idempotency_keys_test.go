@@ -0,0 +1,164 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Idempotency keys", func() {
+	var key models.IdempotencyKey
+
+	BeforeEach(func() {
+		key = models.IdempotencyKey{Key: "retry-key", Fingerprint: "some-fingerprint"}
+	})
+
+	countRows := func(table string) int {
+		var count int
+		err := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count)
+		Expect(err).NotTo(HaveOccurred())
+		return count
+	}
+
+	Describe("DesireTaskWithIdempotencyKey", func() {
+		var task *models.Task
+
+		BeforeEach(func() {
+			task = model_helpers.NewValidTask("task-guid")
+		})
+
+		It("creates the task and records the key", func() {
+			_, replayed, err := sqlDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replayed).To(BeFalse())
+
+			_, err = sqlDB.TaskByGuid(logger, task.TaskGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(countRows("idempotency_keys")).To(Equal(1))
+		})
+
+		Context("when the key was already used for the same request", func() {
+			BeforeEach(func() {
+				_, _, err := sqlDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("replays the request without creating another task", func() {
+				_, replayed, err := sqlDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(replayed).To(BeTrue())
+				Expect(countRows("tasks")).To(Equal(1))
+			})
+
+			Context("and the key has expired", func() {
+				BeforeEach(func() {
+					fakeClock.Increment(models.IdempotencyKeyTTL)
+				})
+
+				It("tries to create the task again", func() {
+					_, _, err := sqlDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+					Expect(err).To(Equal(models.ErrResourceExists))
+				})
+			})
+		})
+
+		Context("when the key was already used for a different request", func() {
+			BeforeEach(func() {
+				otherKey := models.IdempotencyKey{Key: key.Key, Fingerprint: "other-fingerprint"}
+				_, _, err := sqlDB.DesireTaskWithIdempotencyKey(logger, otherKey, task.TaskDefinition, "other-task-guid", task.Domain)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a conflict without creating the task", func() {
+				_, _, err := sqlDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).To(Equal(models.ErrIdempotencyKeyConflict))
+
+				_, err = sqlDB.TaskByGuid(logger, task.TaskGuid)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when creating the task fails", func() {
+			BeforeEach(func() {
+				_, err := sqlDB.DesireTask(logger, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not record the key", func() {
+				_, _, err := sqlDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+				Expect(err).To(Equal(models.ErrResourceExists))
+				Expect(countRows("idempotency_keys")).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DesireLRPWithIdempotencyKey", func() {
+		var desiredLRP *models.DesiredLRP
+
+		BeforeEach(func() {
+			desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+		})
+
+		It("creates the desired lrp and records the key", func() {
+			replayed, err := sqlDB.DesireLRPWithIdempotencyKey(logger, key, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replayed).To(BeFalse())
+
+			_, err = sqlDB.DesiredLRPByProcessGuid(logger, desiredLRP.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(countRows("idempotency_keys")).To(Equal(1))
+		})
+
+		Context("when the key was already used for the same request", func() {
+			BeforeEach(func() {
+				_, err := sqlDB.DesireLRPWithIdempotencyKey(logger, key, desiredLRP)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("replays the request", func() {
+				replayed, err := sqlDB.DesireLRPWithIdempotencyKey(logger, key, desiredLRP)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(replayed).To(BeTrue())
+			})
+		})
+
+		Context("when the key was already used for a different request", func() {
+			BeforeEach(func() {
+				otherKey := models.IdempotencyKey{Key: key.Key, Fingerprint: "other-fingerprint"}
+				_, err := sqlDB.DesireLRPWithIdempotencyKey(logger, otherKey, model_helpers.NewValidDesiredLRP("other-guid"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a conflict without creating the desired lrp", func() {
+				_, err := sqlDB.DesireLRPWithIdempotencyKey(logger, key, desiredLRP)
+				Expect(err).To(Equal(models.ErrIdempotencyKeyConflict))
+
+				_, err = sqlDB.DesiredLRPByProcessGuid(logger, desiredLRP.ProcessGuid)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("expired key cleanup during task convergence", func() {
+		BeforeEach(func() {
+			task := model_helpers.NewValidTask("task-guid")
+			_, _, err := sqlDB.DesireTaskWithIdempotencyKey(logger, key, task.TaskDefinition, task.TaskGuid, task.Domain)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("keeps unexpired keys", func() {
+			sqlDB.ConvergeTasks(logger, models.CellSet{}, time.Minute, time.Hour, time.Hour, 0)
+			Expect(countRows("idempotency_keys")).To(Equal(1))
+		})
+
+		It("deletes expired keys", func() {
+			fakeClock.Increment(models.IdempotencyKeyTTL)
+			sqlDB.ConvergeTasks(logger, models.CellSet{}, time.Minute, time.Hour, time.Hour, 0)
+			Expect(countRows("idempotency_keys")).To(Equal(0))
+		})
+	})
+})
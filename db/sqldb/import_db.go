@@ -0,0 +1,412 @@
+package sqldb
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+func (db *SQLDB) IsEmpty(logger lager.Logger) (bool, error) {
+	logger = logger.Session("is-empty")
+
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	for _, table := range []string{domainsTable, desiredLRPsTable, actualLRPsTable, tasksTable} {
+		var count int
+		query := fmt.Sprintf("SELECT COUNT(*) FROM %s", db.table(table))
+		err := db.db.QueryRow(query).Scan(&count)
+		if err != nil {
+			logger.Error("failed-counting-rows", err, lager.Data{"table": table})
+			return false, db.convertSQLError(err)
+		}
+		if count > 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ImportRecords writes the batch in a single transaction. When that fails the
+// records are written again one per transaction, so that the error is only
+// reported for the records that caused it and the others are still imported.
+func (db *SQLDB) ImportRecords(logger lager.Logger, records []*models.ImportRecord) []error {
+	logger = logger.Session("import-records", lager.Data{"count": len(records)})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	errs := make([]error, len(records))
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		for _, record := range records {
+			err := db.importRecord(logger, tx, record)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		return errs
+	}
+
+	logger.Error("failed-importing-batch", err)
+	for i, record := range records {
+		errs[i] = db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+			return db.importRecord(logger, tx, record)
+		})
+	}
+	return errs
+}
+
+func (db *SQLDB) importRecord(logger lager.Logger, tx Queryable, record *models.ImportRecord) error {
+	switch {
+	case record.Domain != nil:
+		return db.upsertDomain(logger, tx, record.Domain.Domain, record.Domain.Ttl)
+	case record.DesiredLrp != nil:
+		return db.importDesiredLRP(logger, tx, record.DesiredLrp)
+	case record.Task != nil:
+		return db.importTask(logger, tx, record.Task)
+	case record.ActualLrp != nil:
+		return db.importActualLRP(logger, tx, record.ActualLrp)
+	default:
+		return models.ErrBadRequest
+	}
+}
+
+func (db *SQLDB) importDesiredLRP(logger lager.Logger, tx Queryable, desiredLRP *models.DesiredLRP) error {
+	logger = logger.WithData(lager.Data{"process_guid": desiredLRP.ProcessGuid})
+
+	_, err := db.delete(logger, tx, desiredLRPsTable, "process_guid = ?", desiredLRP.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-deleting-existing-desired-lrp", err)
+		return db.convertSQLError(err)
+	}
+
+	return db.desireLRP(logger, tx, desiredLRP)
+}
+
+func (db *SQLDB) importTask(logger lager.Logger, tx Queryable, task *models.Task) error {
+	logger = logger.WithData(lager.Data{"task_guid": task.TaskGuid})
+
+	taskDefData, err := db.serializeModel(logger, task.TaskDefinition)
+	if err != nil {
+		logger.Error("failed-serializing-task-definition", err)
+		return err
+	}
+
+	now := db.clock.Now().UnixNano()
+	createdAt, updatedAt := task.CreatedAt, task.UpdatedAt
+	if createdAt == 0 {
+		createdAt = now
+	}
+	if updatedAt == 0 {
+		updatedAt = now
+	}
+
+	_, err = db.delete(logger, tx, tasksTable, "guid = ?", task.TaskGuid)
+	if err != nil {
+		logger.Error("failed-deleting-existing-task", err)
+		return db.convertSQLError(err)
+	}
+
+	_, err = db.insert(logger, tx, tasksTable,
+		SQLAttributes{
+			"guid":               task.TaskGuid,
+			"domain":             task.Domain,
+			"created_at":         createdAt,
+			"updated_at":         updatedAt,
+			"first_completed_at": task.FirstCompletedAt,
+			"state":              task.State,
+			"cell_id":            task.CellId,
+			"result":             task.Result,
+			"failed":             task.Failed,
+			"failure_reason":     task.FailureReason,
+			"task_definition":    taskDefData,
+			"rejection_count":    task.RejectionCount,
+			"rejection_reason":   task.RejectionReason,
+			"content_hash":       task.ContentHash,
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-task", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+func (db *SQLDB) importActualLRP(logger lager.Logger, tx Queryable, actualLRP *models.ActualLRP) error {
+	logger = logger.WithData(lager.Data{"process_guid": actualLRP.ProcessGuid, "index": actualLRP.Index})
+
+	netInfoData, err := db.serializeModel(logger, &actualLRP.ActualLRPNetInfo)
+	if err != nil {
+		logger.Error("failed-serializing-net-info", err)
+		return err
+	}
+
+	placementHistoryData, err := serializePlacementHistory(actualLRP.PlacementHistory)
+	if err != nil {
+		logger.Error("failed-serializing-placement-history", err)
+		return err
+	}
+
+	_, err = db.delete(logger, tx, actualLRPsTable,
+		"process_guid = ? AND instance_index = ? AND evacuating = ?",
+		actualLRP.ProcessGuid, actualLRP.Index, false,
+	)
+	if err != nil {
+		logger.Error("failed-deleting-existing-actual-lrp", err)
+		return db.convertSQLError(err)
+	}
+
+	_, err = db.insert(logger, tx, actualLRPsTable,
+		SQLAttributes{
+			"process_guid":           actualLRP.ProcessGuid,
+			"instance_index":         actualLRP.Index,
+			"evacuating":             false,
+			"domain":                 actualLRP.Domain,
+			"state":                  actualLRP.State,
+			"instance_guid":          actualLRP.InstanceGuid,
+			"cell_id":                actualLRP.CellId,
+			"placement_error":        actualLRP.PlacementError,
+			"since":                  actualLRP.Since,
+			"net_info":               netInfoData,
+			"modification_tag_epoch": actualLRP.ModificationTag.Epoch,
+			"modification_tag_index": actualLRP.ModificationTag.Index,
+			"crash_count":            actualLRP.CrashCount,
+			"crash_reason":           actualLRP.CrashReason,
+			"placement_history":      placementHistoryData,
+			"orphaned_since":         actualLRP.OrphanedSince,
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-actual-lrp", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+// ExportRecords reads every table within a single repeatable read
+// transaction, so the records are those of the snapshot taken by its first
+// query even while the store is being written to. Rows that cannot be
+// deserialized are skipped, but unlike when listing them they are neither
+// deleted nor quarantined: exporting never writes to the store.
+func (db *SQLDB) ExportRecords(logger lager.Logger, yield func(*models.ImportRecord) error) error {
+	logger = logger.Session("export-records")
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	return db.snapshot(logger, func(logger lager.Logger, tx Queryable) error {
+		exports := []func(lager.Logger, Queryable, func(*models.ImportRecord) error) error{
+			db.exportDomains,
+			db.exportDesiredLRPs,
+			db.exportActualLRPs,
+			db.exportTasks,
+		}
+		for _, export := range exports {
+			err := export(logger, tx, yield)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// snapshot runs f in a read only transaction at the repeatable read
+// isolation level. The MySQL driver cannot begin a transaction at a given
+// level, so the level of the next transaction of a dedicated connection is
+// set instead.
+func (db *SQLDB) snapshot(logger lager.Logger, f func(logger lager.Logger, tx Queryable) error) error {
+	var tx *sql.Tx
+	var err error
+
+	if db.flavor == MySQL {
+		var conn *sql.Conn
+		conn, err = db.sqlDB.Conn(db.ctx)
+		if err != nil {
+			logger.Error("failed-getting-connection", err)
+			return db.convertSQLError(err)
+		}
+		defer conn.Close()
+
+		_, err = conn.ExecContext(db.ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ")
+		if err != nil {
+			logger.Error("failed-setting-isolation-level", err)
+			return db.convertSQLError(err)
+		}
+		tx, err = conn.BeginTx(db.ctx, nil)
+	} else {
+		tx, err = db.sqlDB.BeginTx(db.ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	}
+	if err != nil {
+		logger.Error("failed-beginning-transaction", err)
+		return db.convertSQLError(err)
+	}
+	defer tx.Rollback()
+
+	err = f(logger, queryableWithContext{ctx: db.ctx, q: tx})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error("failed-committing-transaction", err)
+		return db.convertSQLError(err)
+	}
+	return nil
+}
+
+func (db *SQLDB) exportDomains(logger lager.Logger, tx Queryable, yield func(*models.ImportRecord) error) error {
+	now := db.clock.Now()
+	rows, err := db.all(logger, tx, domainsTable,
+		ColumnList{"domain", "expire_time"}, NoLockRow,
+		"expire_time > ?", now.UnixNano(),
+	)
+	if err != nil {
+		logger.Error("failed-query-domains", err)
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var domain string
+		var expireTime int64
+		err = rows.Scan(&domain, &expireTime)
+		if err != nil {
+			logger.Error("failed-scan-domain", err)
+			return db.convertSQLError(err)
+		}
+
+		// a domain that never expires is upserted with a ttl of 0; the others
+		// are rounded up so that they do not become one
+		var ttl uint32
+		if expireTime != math.MaxInt64 {
+			remaining := time.Duration(expireTime - now.UnixNano())
+			ttl = uint32((remaining + time.Second - 1) / time.Second)
+		}
+
+		err = yield(&models.ImportRecord{Domain: &models.ImportDomain{Domain: domain, Ttl: ttl}})
+		if err != nil {
+			return err
+		}
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-domain-row", rows.Err())
+		return db.convertSQLError(rows.Err())
+	}
+	return nil
+}
+
+func (db *SQLDB) exportDesiredLRPs(logger lager.Logger, tx Queryable, yield func(*models.ImportRecord) error) error {
+	rows, err := db.all(logger, tx, desiredLRPsTable, desiredLRPColumns, NoLockRow, "")
+	if err != nil {
+		logger.Error("failed-query-desired-lrps", err)
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		guid, desiredLRP, schedulingInfoErr, runInfoErr := db.scanDesiredLRPRecord(logger, rows)
+		err := schedulingInfoErr
+		if err == nil {
+			err = runInfoErr
+		}
+		if err == errMissingEncryptionKey {
+			return err
+		}
+		if err != nil {
+			logger.Error("failed-reading-desired-lrp-row", err, lager.Data{"process_guid": guid})
+			continue
+		}
+
+		err = yield(&models.ImportRecord{DesiredLrp: desiredLRP})
+		if err != nil {
+			return err
+		}
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-desired-lrp-row", rows.Err())
+		return db.convertSQLError(rows.Err())
+	}
+	return nil
+}
+
+func (db *SQLDB) exportActualLRPs(logger lager.Logger, tx Queryable, yield func(*models.ImportRecord) error) error {
+	rows, err := db.all(logger, tx, actualLRPsTable, actualLRPColumns, NoLockRow, "evacuating = ?", false)
+	if err != nil {
+		logger.Error("failed-query-actual-lrps", err)
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		actualLRP, _, err := db.scanToActualLRP(logger, rows)
+		if err == models.ErrDeserialize || err == errRecordSkipped || isRecordToQuarantine(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = yield(&models.ImportRecord{ActualLrp: actualLRP})
+		if err != nil {
+			return err
+		}
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-actual-lrp-row", rows.Err())
+		return db.convertSQLError(rows.Err())
+	}
+	return nil
+}
+
+func (db *SQLDB) exportTasks(logger lager.Logger, tx Queryable, yield func(*models.ImportRecord) error) error {
+	rows, err := db.all(logger, tx, tasksTable, taskColumns, NoLockRow, "")
+	if err != nil {
+		logger.Error("failed-query-tasks", err)
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		guid, task, scanErr, taskDefErr := db.scanTaskRecord(logger, rows)
+		if scanErr != nil {
+			return scanErr
+		}
+		if taskDefErr == errMissingEncryptionKey {
+			return taskDefErr
+		}
+		if taskDefErr != nil {
+			logger.Error("failed-reading-task-row", taskDefErr, lager.Data{"task_guid": guid})
+			continue
+		}
+
+		err = yield(&models.ImportRecord{Task: task})
+		if err != nil {
+			return err
+		}
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-task-row", rows.Err())
+		return db.convertSQLError(rows.Err())
+	}
+	return nil
+}
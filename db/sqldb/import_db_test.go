@@ -0,0 +1,225 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ImportDB", func() {
+	Describe("IsEmpty", func() {
+		It("returns true when there are no records", func() {
+			empty, err := sqlDB.IsEmpty(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(empty).To(BeTrue())
+		})
+
+		It("returns false when there is a domain", func() {
+			Expect(sqlDB.UpsertDomain(logger, "some-domain", 100)).To(Succeed())
+
+			empty, err := sqlDB.IsEmpty(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(empty).To(BeFalse())
+		})
+
+		It("returns false when there is a task", func() {
+			task := model_helpers.NewValidTask("some-task-guid")
+			_, err := sqlDB.DesireTask(logger, task.TaskDefinition, task.TaskGuid, task.Domain)
+			Expect(err).NotTo(HaveOccurred())
+
+			empty, err := sqlDB.IsEmpty(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(empty).To(BeFalse())
+		})
+	})
+
+	Describe("ImportRecords", func() {
+		var (
+			desiredLRP *models.DesiredLRP
+			task       *models.Task
+			records    []*models.ImportRecord
+		)
+
+		BeforeEach(func() {
+			desiredLRP = model_helpers.NewValidDesiredLRP("some-process-guid")
+			task = model_helpers.NewValidTask("some-task-guid")
+			task.State = models.Task_Running
+			task.CellId = "some-cell"
+			records = []*models.ImportRecord{
+				{Domain: &models.ImportDomain{Domain: "some-domain", Ttl: 100}},
+				{DesiredLrp: desiredLRP},
+				{Task: task},
+			}
+		})
+
+		It("writes every record", func() {
+			errs := sqlDB.ImportRecords(logger, records)
+			Expect(errs).To(Equal([]error{nil, nil, nil}))
+
+			domains, err := sqlDB.Domains(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domains).To(ConsistOf("some-domain"))
+
+			imported, err := sqlDB.DesiredLRPByProcessGuid(logger, "some-process-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imported.Instances).To(Equal(desiredLRP.Instances))
+
+			importedTask, err := sqlDB.TaskByGuid(logger, "some-task-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(importedTask.State).To(Equal(models.Task_Running))
+			Expect(importedTask.CellId).To(Equal("some-cell"))
+		})
+
+		It("overwrites existing records", func() {
+			Expect(sqlDB.ImportRecords(logger, records)).To(Equal([]error{nil, nil, nil}))
+
+			desiredLRP.Instances = 7
+			task.CellId = "other-cell"
+			Expect(sqlDB.ImportRecords(logger, records)).To(Equal([]error{nil, nil, nil}))
+
+			imported, err := sqlDB.DesiredLRPByProcessGuid(logger, "some-process-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imported.Instances).To(BeEquivalentTo(7))
+
+			importedTask, err := sqlDB.TaskByGuid(logger, "some-task-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(importedTask.CellId).To(Equal("other-cell"))
+		})
+
+		It("writes actual LRPs", func() {
+			actualLRP := model_helpers.NewValidActualLRP("some-process-guid", 0)
+			errs := sqlDB.ImportRecords(logger, []*models.ImportRecord{{ActualLrp: actualLRP}})
+			Expect(errs).To(Equal([]error{nil}))
+
+			group, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, "some-process-guid", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Instance).To(Equal(actualLRP))
+			Expect(group.Evacuating).To(BeNil())
+		})
+
+		Context("when a record cannot be written", func() {
+			BeforeEach(func() {
+				records = append(records, &models.ImportRecord{})
+			})
+
+			It("writes the other records and reports the error for that record only", func() {
+				errs := sqlDB.ImportRecords(logger, records)
+				Expect(errs[:3]).To(Equal([]error{nil, nil, nil}))
+				Expect(errs[3]).To(HaveOccurred())
+
+				_, err := sqlDB.TaskByGuid(logger, "some-task-guid")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ExportRecords", func() {
+		var records []*models.ImportRecord
+
+		BeforeEach(func() {
+			records = []*models.ImportRecord{
+				{Domain: &models.ImportDomain{Domain: "some-domain", Ttl: 100}},
+				{Domain: &models.ImportDomain{Domain: "eternal-domain"}},
+				{DesiredLrp: model_helpers.NewValidDesiredLRP("some-process-guid")},
+				{ActualLrp: model_helpers.NewValidActualLRP("some-process-guid", 0)},
+				{Task: model_helpers.NewValidTask("some-task-guid")},
+			}
+			Expect(sqlDB.ImportRecords(logger, records)).To(Equal([]error{nil, nil, nil, nil, nil}))
+		})
+
+		It("yields every record in a form that can be imported again", func() {
+			exported := []*models.ImportRecord{}
+			err := sqlDB.ExportRecords(logger, func(record *models.ImportRecord) error {
+				exported = append(exported, record)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(exported).To(HaveLen(len(records)))
+			Expect(exported).To(ContainElement(&models.ImportRecord{Domain: &models.ImportDomain{Domain: "some-domain", Ttl: 100}}))
+			Expect(exported).To(ContainElement(&models.ImportRecord{Domain: &models.ImportDomain{Domain: "eternal-domain"}}))
+			Expect(exported).To(ContainElement(records[3]))
+			for _, record := range exported {
+				Expect(record.Validate()).To(Succeed())
+			}
+		})
+
+		It("leaves out evacuating actual LRPs", func() {
+			actualLRP := model_helpers.NewValidActualLRP("other-process-guid", 0)
+			_, err := sqlDB.EvacuateActualLRP(logger, &actualLRP.ActualLRPKey, &actualLRP.ActualLRPInstanceKey, &actualLRP.ActualLRPNetInfo, 60)
+			Expect(err).NotTo(HaveOccurred())
+
+			exported := []*models.ImportRecord{}
+			err = sqlDB.ExportRecords(logger, func(record *models.ImportRecord) error {
+				exported = append(exported, record)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exported).To(HaveLen(len(records)))
+		})
+
+		It("does not yield records written after the export started", func() {
+			exported := []*models.ImportRecord{}
+			err := sqlDB.ExportRecords(logger, func(record *models.ImportRecord) error {
+				if len(exported) == 0 {
+					task := model_helpers.NewValidTask("later-task-guid")
+					_, err := sqlDB.DesireTask(logger, task.TaskDefinition, task.TaskGuid, task.Domain)
+					Expect(err).NotTo(HaveOccurred())
+				}
+				exported = append(exported, record)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(exported).To(HaveLen(len(records)))
+			for _, record := range exported {
+				Expect(record.Guid()).NotTo(Equal("later-task-guid"))
+			}
+		})
+
+		Context("when rows cannot be deserialized", func() {
+			BeforeEach(func() {
+				for _, update := range []struct{ query, guid string }{
+					{"UPDATE desired_lrps SET run_info = ? WHERE process_guid = ?", "some-process-guid"},
+					{"UPDATE tasks SET task_definition = ? WHERE guid = ?", "some-task-guid"},
+				} {
+					queryStr := update.query
+					if test_helpers.UsePostgres() {
+						queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+					}
+					result, err := db.Exec(queryStr, "{{", update.guid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.RowsAffected()).To(BeEquivalentTo(1))
+				}
+			})
+
+			It("skips them without deleting them", func() {
+				exported := []*models.ImportRecord{}
+				err := sqlDB.ExportRecords(logger, func(record *models.ImportRecord) error {
+					exported = append(exported, record)
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exported).To(HaveLen(len(records) - 2))
+
+				var count int
+				Expect(db.QueryRow("SELECT COUNT(*) FROM desired_lrps").Scan(&count)).To(Succeed())
+				Expect(count).To(Equal(1))
+				Expect(db.QueryRow("SELECT COUNT(*) FROM tasks").Scan(&count)).To(Succeed())
+				Expect(count).To(Equal(1))
+			})
+		})
+
+		It("stops at the first error returned by yield", func() {
+			yielded := 0
+			err := sqlDB.ExportRecords(logger, func(record *models.ImportRecord) error {
+				yielded++
+				return models.ErrUnknownError
+			})
+			Expect(err).To(Equal(models.ErrUnknownError))
+			Expect(yielded).To(Equal(1))
+		})
+	})
+})
@@ -9,10 +9,10 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/runtimeschema/metric"
-	"code.cloudfoundry.org/workpool"
 )
 
 const (
@@ -31,46 +31,101 @@ const (
 
 	crashedActualLRPs   = metric.Metric("CrashedActualLRPs")
 	crashingDesiredLRPs = metric.Metric("CrashingDesiredLRPs")
+
+	evacuatingLRPs      = metric.Metric("LRPsEvacuating")
+	stuckEvacuatingLRPs = metric.Metric("LRPsStuckEvacuating")
 )
 
-func (db *SQLDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet) ([]*auctioneer.LRPStartRequest, []*models.ActualLRPKeyWithSchedulingInfo, []*models.ActualLRPKey) {
+// ConvergeLRPs reconciles the actual LRPs with the desired LRPs in the
+// domains selected by the filter. Domain freshness is always read from the
+// domains table, so a pass scoped to some domains sees the same freshness for
+// them as a global pass would. Scoped passes do not emit the foundation-wide
+// LRP metrics, which only a global pass can compute.
+func (db *SQLDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet, filter models.ConvergenceFilter) ([]*auctioneer.LRPStartRequest, []*models.ActualLRPKeyWithSchedulingInfo, []*models.ActualLRPKey) {
+	logger = logger.WithData(lager.Data{"domains": filter.Domains})
 	convergeStart := db.clock.Now()
-	convergeLRPRunsCounter.Increment()
 	logger.Info("starting")
 	defer logger.Info("completed")
 
-	defer func() {
-		err := convergeLRPDuration.Send(time.Since(convergeStart))
-		if err != nil {
-			logger.Error("failed-sending-converge-lrp-duration-metric", err)
-		}
-	}()
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	if !filter.IsScoped() {
+		convergeLRPRunsCounter.Increment()
+		defer func() {
+			err := convergeLRPDuration.Send(time.Since(convergeStart))
+			if err != nil {
+				logger.Error("failed-sending-converge-lrp-duration-metric", err)
+			}
+		}()
+	}
+
+	convergenceTrace := bbsdb.StartConvergenceTrace("converge-lrps")
+	defer convergenceTrace.End()
 
 	now := db.clock.Now()
 
+	phase := convergenceTrace.Phase("prune")
 	db.pruneDomains(logger, now)
 	db.pruneEvacuatingActualLRPs(logger, now)
+	db.purgeDesiredLRPTombstones(logger, now)
+	phase.End()
+
+	if !filter.IsScoped() {
+		phase = convergenceTrace.Phase("emit-evacuation-metrics")
+		db.emitEvacuationMetrics(logger, now)
+		phase.End()
+	}
 
+	phase = convergenceTrace.Phase("list-domains")
 	domainSet, err := db.domainSet(logger)
+	phase.End()
 	if err != nil {
 		return nil, nil, nil
 	}
 
+	for domain := range domainSet {
+		if !filter.IncludesDomain(domain) {
+			delete(domainSet, domain)
+		}
+	}
+
 	db.emitDomainMetrics(logger, domainSet)
 
-	converge := newConvergence(db)
+	converge := newConvergence(db, filter)
+
+	phase = convergenceTrace.Phase("stale-unclaimed-actual-lrps")
 	converge.staleUnclaimedActualLRPs(logger, now)
+	phase.End()
+
+	phase = convergenceTrace.Phase("actual-lrps-with-missing-cells")
 	converge.actualLRPsWithMissingCells(logger, cellSet)
+	phase.End()
+
+	phase = convergenceTrace.Phase("lrp-instance-counts")
 	converge.lrpInstanceCounts(logger, domainSet)
-	converge.orphanedActualLRPs(logger)
+	phase.End()
+
+	phase = convergenceTrace.Phase("orphaned-actual-lrps")
+	converge.readoptedActualLRPs(logger)
+	converge.orphanedActualLRPs(logger, now)
+	phase.End()
+
+	phase = convergenceTrace.Phase("crashed-actual-lrps")
 	converge.crashedActualLRPs(logger, now)
+	phase.End()
 
+	// the phases above only submit work to the shared convergence workers,
+	// which result waits for
+	defer convergenceTrace.Phase("wait-for-workers").End()
 	return converge.result(logger)
 }
 
 type convergence struct {
 	*SQLDB
 
+	filter models.ConvergenceFilter
+
 	guidsToStartRequests map[string]*auctioneer.LRPStartRequest
 	startRequestsMutex   sync.Mutex
 
@@ -79,21 +134,16 @@ type convergence struct {
 	keysToRetire []*models.ActualLRPKey
 	keysMutex    sync.Mutex
 
-	pool   *workpool.WorkPool
-	poolWg sync.WaitGroup
+	works *bbsdb.ConvergenceBatch
 }
 
-func newConvergence(db *SQLDB) *convergence {
-	pool, err := workpool.NewWorkPool(db.convergenceWorkersSize)
-	if err != nil {
-		panic(fmt.Sprintf("failing to create workpool is irrecoverable %v", err))
-	}
-
+func newConvergence(db *SQLDB, filter models.ConvergenceFilter) *convergence {
 	return &convergence{
 		SQLDB:                db,
+		filter:               filter,
 		guidsToStartRequests: map[string]*auctioneer.LRPStartRequest{},
 		keysToRetire:         []*models.ActualLRPKey{},
-		pool:                 pool,
+		works:                db.convergenceWorkers.NewBatch(),
 	}
 }
 
@@ -101,7 +151,7 @@ func newConvergence(db *SQLDB) *convergence {
 func (c *convergence) staleUnclaimedActualLRPs(logger lager.Logger, now time.Time) {
 	logger = logger.Session("stale-unclaimed-actual-lrps")
 
-	rows, err := c.selectStaleUnclaimedLRPs(logger, c.db, now)
+	rows, err := c.selectStaleUnclaimedLRPs(logger, c.db, now, c.filter)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
@@ -128,12 +178,13 @@ func (c *convergence) crashedActualLRPs(logger lager.Logger, now time.Time) {
 	logger = logger.Session("crashed-actual-lrps")
 	restartCalculator := models.NewDefaultRestartCalculator()
 
-	rows, err := c.selectCrashedLRPs(logger, c.db)
+	rows, err := c.selectCrashedLRPs(logger, c.db, c.filter)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
 	}
 
+	crashed := []*crashedActualLRP{}
 	for rows.Next() {
 		var index int
 		actual := &models.ActualLRP{}
@@ -147,6 +198,11 @@ func (c *convergence) crashedActualLRPs(logger lager.Logger, now time.Time) {
 		actual.State = models.ActualLRPStateCrashed
 
 		if actual.ShouldRestartCrash(now, restartCalculator) {
+			if c.batching() {
+				crashed = append(crashed, &crashedActualLRP{key: &actual.ActualLRPKey, schedulingInfo: schedulingInfo})
+				continue
+			}
+
 			c.submit(func() {
 				_, _, err = c.UnclaimActualLRP(logger, &actual.ActualLRPKey)
 				if err != nil {
@@ -163,39 +219,198 @@ func (c *convergence) crashedActualLRPs(logger lager.Logger, now time.Time) {
 		logger.Error("failed-getting-next-row", rows.Err())
 	}
 
-	return
+	c.unclaimCrashedActualLRPs(logger, crashed, now)
+}
+
+type crashedActualLRP struct {
+	key            *models.ActualLRPKey
+	schedulingInfo *models.DesiredLRPSchedulingInfo
+}
+
+// Transitions CRASHED Actual LRPs to UNCLAIMED, a batch of them per
+// transaction, and adds the ones that were still CRASHED to the list of start
+// requests once their batch has been committed.
+func (c *convergence) unclaimCrashedActualLRPs(logger lager.Logger, crashed []*crashedActualLRP, now time.Time) {
+	c.eachBatch(len(crashed), 2, func(start, end int) {
+		batch := crashed[start:end]
+
+		c.submit(func() {
+			keys := make([]*models.ActualLRPKey, 0, len(batch))
+			for _, lrp := range batch {
+				keys = append(keys, lrp.key)
+			}
+			keysClause, keyBindings := actualLRPKeysClause(keys)
+			wheres := "state = ? AND evacuating = ? AND " + keysClause
+			bindings := append([]interface{}{models.ActualLRPStateCrashed, false}, keyBindings...)
+
+			var unclaimed map[models.ActualLRPKey]struct{}
+			err := c.transact(logger, func(logger lager.Logger, tx Queryable) error {
+				unclaimed = map[models.ActualLRPKey]struct{}{}
+
+				rows, err := c.all(logger, tx, actualLRPsTable,
+					ColumnList{"process_guid", "instance_index"}, LockRow,
+					wheres, bindings...,
+				)
+				if err != nil {
+					return err
+				}
+
+				for rows.Next() {
+					var key models.ActualLRPKey
+					err := rows.Scan(&key.ProcessGuid, &key.Index)
+					if err != nil {
+						rows.Close()
+						return err
+					}
+					unclaimed[key] = struct{}{}
+				}
+				rows.Close()
+
+				if rows.Err() != nil {
+					return rows.Err()
+				}
+
+				if len(unclaimed) == 0 {
+					return nil
+				}
+
+				query := fmt.Sprintf(`UPDATE %s SET
+					state = ?, cell_id = ?, instance_guid = ?, since = ?, net_info = ?,
+					modification_tag_index = modification_tag_index + 1
+					WHERE %s`,
+					c.table(actualLRPsTable), wheres,
+				)
+				updateBindings := append([]interface{}{models.ActualLRPStateUnclaimed, "", "", now.UnixNano(), []byte{}}, bindings...)
+				_, err = tx.Exec(c.rebind(query), updateBindings...)
+				return err
+			})
+			if err != nil {
+				logger.Error("failed-unclaiming-actual-lrps", err, lager.Data{"count": len(batch)})
+				return
+			}
+
+			for _, lrp := range batch {
+				key := models.ActualLRPKey{ProcessGuid: lrp.key.ProcessGuid, Index: lrp.key.Index}
+				if _, ok := unclaimed[key]; ok {
+					c.addStartRequestFromSchedulingInfo(logger, lrp.schedulingInfo, int(lrp.key.Index))
+				}
+			}
+		})
+	})
 }
 
 // Adds orphaned Actual LRPs (ones with no corresponding Desired LRP) to the
-// list of keys to retire.
-func (c *convergence) orphanedActualLRPs(logger lager.Logger) {
+// list of keys to retire. With an orphan grace period, an orphan is first
+// marked with the time it was found and only retired once the grace period
+// has passed.
+func (c *convergence) orphanedActualLRPs(logger lager.Logger, now time.Time) {
 	logger = logger.Session("orphaned-actual-lrps")
 
-	rows, err := c.selectOrphanedActualLRPs(logger, c.db)
+	rows, err := c.selectOrphanedActualLRPs(logger, c.db, c.filter)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
 	}
 
+	keysToMark := []*models.ActualLRPKey{}
 	for rows.Next() {
-		actualLRPKey := &models.ActualLRPKey{}
+		actual := &models.ActualLRP{}
 
 		err := rows.Scan(
-			&actualLRPKey.ProcessGuid,
-			&actualLRPKey.Index,
-			&actualLRPKey.Domain,
+			&actual.ProcessGuid,
+			&actual.Index,
+			&actual.Domain,
+			&actual.OrphanedSince,
 		)
 		if err != nil {
 			logger.Error("failed-scanning", err)
 			continue
 		}
 
-		c.addKeyToRetire(logger, actualLRPKey)
+		if c.orphanGracePeriod > 0 && actual.OrphanedSince == 0 {
+			if c.batching() {
+				keysToMark = append(keysToMark, &actual.ActualLRPKey)
+				continue
+			}
+
+			c.submit(func() {
+				c.markActualLRPOrphaned(logger, &actual.ActualLRPKey, now)
+			})
+			continue
+		}
+
+		if !actual.ShouldRetireOrphan(now, c.orphanGracePeriod) {
+			logger.Debug("orphaned-within-grace-period", lager.Data{
+				"process_guid":   actual.ProcessGuid,
+				"index":          actual.Index,
+				"orphaned_since": actual.OrphanedSince,
+			})
+			continue
+		}
+
+		c.addKeyToRetire(logger, &actual.ActualLRPKey)
 	}
 
 	if rows.Err() != nil {
 		logger.Error("failed-getting-next-row", rows.Err())
 	}
+
+	c.markActualLRPsOrphaned(logger, keysToMark, now)
+}
+
+// Records when an orphaned Actual LRP was first found. The modification tag
+// is left alone, since the Actual LRP itself has not changed.
+func (c *convergence) markActualLRPOrphaned(logger lager.Logger, key *models.ActualLRPKey, now time.Time) {
+	_, err := c.update(logger, c.db, actualLRPsTable,
+		SQLAttributes{"orphaned_since": now.UnixNano()},
+		"process_guid = ? AND instance_index = ? AND evacuating = ? AND orphaned_since = 0",
+		key.ProcessGuid, key.Index, false,
+	)
+	if err != nil {
+		logger.Error("failed-marking-actual-lrp-orphaned", err, lager.Data{"process_guid": key.ProcessGuid, "index": key.Index})
+	}
+}
+
+// Records when orphaned Actual LRPs were first found, a batch of them per
+// statement.
+func (c *convergence) markActualLRPsOrphaned(logger lager.Logger, keys []*models.ActualLRPKey, now time.Time) {
+	c.eachBatch(len(keys), 2, func(start, end int) {
+		batch := keys[start:end]
+
+		c.submit(func() {
+			keysClause, keyBindings := actualLRPKeysClause(batch)
+			_, err := c.update(logger, c.db, actualLRPsTable,
+				SQLAttributes{"orphaned_since": now.UnixNano()},
+				"evacuating = ? AND orphaned_since = 0 AND "+keysClause,
+				append([]interface{}{false}, keyBindings...)...,
+			)
+			if err != nil {
+				logger.Error("failed-marking-actual-lrps-orphaned", err, lager.Data{"count": len(batch)})
+			}
+		})
+	})
+}
+
+// Clears the orphaned mark of Actual LRPs whose Desired LRP exists again, so
+// that they get a full grace period should they be orphaned later.
+func (c *convergence) readoptedActualLRPs(logger lager.Logger) {
+	logger = logger.Session("readopted-actual-lrps")
+
+	domainClause, bindings := domainFilterClause("domain", c.filter)
+	result, err := c.update(logger, c.db, actualLRPsTable,
+		SQLAttributes{"orphaned_since": 0},
+		fmt.Sprintf("orphaned_since <> 0 AND process_guid IN (SELECT process_guid FROM %s) AND %s", c.table(desiredLRPsTable), domainClause),
+		bindings...,
+	)
+	if err != nil {
+		logger.Error("failed-clearing-orphaned-since", err)
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err == nil && rowsAffected > 0 {
+		logger.Info("cleared-orphaned-since", lager.Data{"count": rowsAffected})
+	}
 }
 
 // Creates and adds missing Actual LRPs to the list of start requests.
@@ -203,13 +418,14 @@ func (c *convergence) orphanedActualLRPs(logger lager.Logger) {
 func (c *convergence) lrpInstanceCounts(logger lager.Logger, domainSet map[string]struct{}) {
 	logger = logger.Session("lrp-instance-counts")
 
-	rows, err := c.selectLRPInstanceCounts(logger, c.db)
+	rows, err := c.selectLRPInstanceCounts(logger, c.db, c.filter)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
 	}
 
 	missingLRPCount := 0
+	missingKeys := []*models.ActualLRPKey{}
 	for rows.Next() {
 		var existingIndicesStr sql.NullString
 		var actualInstances int
@@ -233,14 +449,7 @@ func (c *convergence) lrpInstanceCounts(logger lager.Logger, domainSet map[strin
 			if !found {
 				missingLRPCount++
 				indices = append(indices, i)
-				index := int32(i)
-
-				c.submit(func() {
-					_, err := c.CreateUnclaimedActualLRP(logger, &models.ActualLRPKey{ProcessGuid: schedulingInfo.ProcessGuid, Domain: schedulingInfo.Domain, Index: index})
-					if err != nil {
-						logger.Error("failed-creating-missing-actual-lrp", err)
-					}
-				})
+				missingKeys = append(missingKeys, &models.ActualLRPKey{ProcessGuid: schedulingInfo.ProcessGuid, Domain: schedulingInfo.Domain, Index: int32(i)})
 			}
 		}
 
@@ -263,7 +472,72 @@ func (c *convergence) lrpInstanceCounts(logger lager.Logger, domainSet map[strin
 		logger.Error("failed-getting-next-row", rows.Err())
 	}
 
-	missingLRPs.Send(missingLRPCount)
+	// the priority domains' missing actual LRPs are created first, so that
+	// they are the first to be auctioned when there are more than workers
+	c.filter.SortKeysByPriority(missingKeys)
+
+	if c.batching() {
+		c.createUnclaimedActualLRPs(logger, missingKeys)
+	} else {
+		for _, key := range missingKeys {
+			key := key
+			c.submit(func() {
+				_, err := c.CreateUnclaimedActualLRP(logger, key)
+				if err != nil {
+					logger.Error("failed-creating-missing-actual-lrp", err)
+				}
+			})
+		}
+	}
+
+	if !c.filter.IsScoped() {
+		missingLRPs.Send(missingLRPCount)
+	}
+}
+
+// Creates missing Actual LRPs, a batch of them per statement. An Actual LRP
+// created since it was found missing fails the whole statement, so the keys of
+// a batch that fails are then created one at a time, and those that already
+// exist are left alone.
+func (c *convergence) createUnclaimedActualLRPs(logger lager.Logger, keys []*models.ActualLRPKey) {
+	columns := ColumnList{
+		"process_guid", "instance_index", "domain", "state", "since", "net_info",
+		"modification_tag_epoch", "modification_tag_index",
+	}
+
+	c.eachBatch(len(keys), len(columns), func(start, end int) {
+		batch := keys[start:end]
+
+		c.submit(func() {
+			now := c.clock.Now().UnixNano()
+			rows := make([][]interface{}, 0, len(batch))
+			for _, key := range batch {
+				guid, err := c.guidProvider.NextGUID()
+				if err != nil {
+					logger.Error("failed-to-generate-guid", err)
+					return
+				}
+
+				rows = append(rows, []interface{}{
+					key.ProcessGuid, key.Index, key.Domain, models.ActualLRPStateUnclaimed, now, []byte{},
+					guid, 0,
+				})
+			}
+
+			_, err := c.insertRows(c.db, actualLRPsTable, columns, rows)
+			if err == nil {
+				return
+			}
+
+			logger.Error("failed-creating-missing-actual-lrps", err, lager.Data{"count": len(batch)})
+			for _, key := range batch {
+				_, err := c.CreateUnclaimedActualLRP(logger, key)
+				if err != nil && err != models.ErrResourceExists {
+					logger.Error("failed-creating-missing-actual-lrp", err)
+				}
+			}
+		})
+	})
 }
 
 // Unclaim Actual LRPs that have missing cells (not in the cell set passed to
@@ -274,7 +548,7 @@ func (c *convergence) actualLRPsWithMissingCells(logger lager.Logger, cellSet mo
 
 	keysWithMissingCells := make([]*models.ActualLRPKeyWithSchedulingInfo, 0)
 
-	rows, err := c.selectLRPsWithMissingCells(logger, c.db, cellSet)
+	rows, err := c.selectLRPsWithMissingCells(logger, c.db, cellSet, c.filter)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
@@ -327,16 +601,11 @@ func (c *convergence) addKeyToRetire(logger lager.Logger, key *models.ActualLRPK
 }
 
 func (c *convergence) submit(work func()) {
-	c.poolWg.Add(1)
-	c.pool.Submit(func() {
-		defer c.poolWg.Done()
-		work()
-	})
+	c.works.Submit(work)
 }
 
 func (c *convergence) result(logger lager.Logger) ([]*auctioneer.LRPStartRequest, []*models.ActualLRPKeyWithSchedulingInfo, []*models.ActualLRPKey) {
-	c.poolWg.Wait()
-	c.pool.Stop()
+	c.works.Wait()
 
 	c.startRequestsMutex.Lock()
 	defer c.startRequestsMutex.Unlock()
@@ -349,8 +618,10 @@ func (c *convergence) result(logger lager.Logger) ([]*auctioneer.LRPStartRequest
 		startRequests = append(startRequests, startRequest)
 	}
 
-	extraLRPs.Send(len(c.keysToRetire))
-	c.emitLRPMetrics(logger)
+	if !c.filter.IsScoped() {
+		extraLRPs.Send(len(c.keysToRetire))
+		c.emitLRPMetrics(logger)
+	}
 
 	return startRequests, c.keysWithMissingCells, c.keysToRetire
 }
@@ -358,7 +629,7 @@ func (c *convergence) result(logger lager.Logger) ([]*auctioneer.LRPStartRequest
 func (db *SQLDB) pruneDomains(logger lager.Logger, now time.Time) {
 	logger = logger.Session("prune-domains")
 
-	_, err := db.delete(logger, db.db, domainsTable, "expire_time <= ?", now.UnixNano())
+	_, err := db.delete(logger, db.db, domainsTable, "expire_time <= ?", db.freshnessCutoff(now).UnixNano())
 	if err != nil {
 		logger.Error("failed-query", err)
 	}
@@ -373,6 +644,73 @@ func (db *SQLDB) pruneEvacuatingActualLRPs(logger lager.Logger, now time.Time) {
 	}
 }
 
+// purgeDesiredLRPTombstones deletes the tombstones of desired LRPs that were
+// removed longer than the retention window ago.
+func (db *SQLDB) purgeDesiredLRPTombstones(logger lager.Logger, now time.Time) {
+	logger = logger.Session("purge-desired-lrp-tombstones")
+
+	_, err := db.delete(logger, db.db, desiredLRPTombstonesTable, "deleted_at <= ?", now.Add(-db.tombstoneRetention).UnixNano())
+	if err != nil {
+		logger.Error("failed-query", err)
+	}
+}
+
+// emitEvacuationMetrics counts the evacuating actual LRPs that survived
+// pruning, and logs and counts those that have been evacuating for longer than
+// the stuck evacuation threshold.
+func (db *SQLDB) emitEvacuationMetrics(logger lager.Logger, now time.Time) {
+	logger = logger.Session("emit-evacuation-metrics")
+
+	rows, err := db.all(logger, db.db, actualLRPsTable,
+		ColumnList{"process_guid", "instance_index", "cell_id", "since"}, NoLockRow,
+		"evacuating = ?", true,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return
+	}
+	defer rows.Close()
+
+	stuckBefore := now.Add(-db.stuckEvacuationThreshold).UnixNano()
+	var evacuatingCount, stuckCount int
+	for rows.Next() {
+		var processGuid, cellID string
+		var index int32
+		var since int64
+		err := rows.Scan(&processGuid, &index, &cellID, &since)
+		if err != nil {
+			logger.Error("failed-scanning", err)
+			continue
+		}
+
+		evacuatingCount++
+		if db.stuckEvacuationThreshold > 0 && since <= stuckBefore {
+			stuckCount++
+			logger.Info("stuck-evacuating-actual-lrp", lager.Data{
+				"process_guid":     processGuid,
+				"index":            index,
+				"cell_id":          cellID,
+				"evacuating_since": since,
+			})
+		}
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return
+	}
+
+	err = evacuatingLRPs.Send(evacuatingCount)
+	if err != nil {
+		logger.Error("failed-sending-evacuating-lrps-metric", err)
+	}
+
+	err = stuckEvacuatingLRPs.Send(stuckCount)
+	if err != nil {
+		logger.Error("failed-sending-stuck-evacuating-lrps-metric", err)
+	}
+}
+
 func (db *SQLDB) domainSet(logger lager.Logger) (map[string]struct{}, error) {
 	logger.Debug("listing-domains")
 	domains, err := db.Domains(logger)
@@ -3,9 +3,12 @@ package sqldb_test
 import (
 	"fmt"
 	"sort"
+	"sync/atomic"
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/test_helpers"
@@ -293,13 +296,13 @@ var _ = Describe("LRPConvergence", func() {
 
 	Describe("general metrics", func() {
 		It("emits a metric for domains", func() {
-			sqlDB.ConvergeLRPs(logger, cellSet)
+			sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 			Expect(sender.GetValue("Domain." + freshDomain).Value).To(Equal(float64(1)))
 		})
 
 		It("emits metrics for lrps", func() {
 			convergenceLogger := lagertest.NewTestLogger("convergence")
-			sqlDB.ConvergeLRPs(convergenceLogger, cellSet)
+			sqlDB.ConvergeLRPs(convergenceLogger, cellSet, models.ConvergenceFilter{})
 			Expect(sender.GetValue("LRPsDesired").Value).To(Equal(float64(38)))
 			Expect(sender.GetValue("LRPsClaimed").Value).To(Equal(float64(7)))
 			Expect(sender.GetValue("LRPsUnclaimed").Value).To(Equal(float64(32))) // 16 fresh + 5 expired + 11 evac
@@ -310,27 +313,61 @@ var _ = Describe("LRPConvergence", func() {
 		})
 
 		It("emits missing LRP metrics", func() {
-			sqlDB.ConvergeLRPs(logger, cellSet)
+			sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 			Expect(sender.GetValue("LRPsMissing").Value).To(Equal(float64(17)))
 		})
 
 		It("emits extra LRP metrics", func() {
-			sqlDB.ConvergeLRPs(logger, cellSet)
+			sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 			Expect(sender.GetValue("LRPsExtra").Value).To(Equal(float64(2)))
 		})
 	})
 
+	Describe("evacuation metrics", func() {
+		BeforeEach(func() {
+			netInfo := models.NewActualLRPNetInfo("1.2.3.4", models.NewPortMapping(1234, 5678))
+
+			stuckKey := models.NewActualLRPKey("stuck-guid", 0, freshDomain)
+			_, err := sqlDB.EvacuateActualLRP(logger, &stuckKey, &models.ActualLRPInstanceKey{InstanceGuid: "stuck-instance", CellId: "draining-cell"}, &netInfo, 3600)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Increment(StuckEvacuationThreshold + time.Second)
+
+			freshKey := models.NewActualLRPKey("fresh-guid", 0, freshDomain)
+			_, err = sqlDB.EvacuateActualLRP(logger, &freshKey, &models.ActualLRPInstanceKey{InstanceGuid: "fresh-instance", CellId: "draining-cell"}, &netInfo, 3600)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("emits the number of evacuating LRPs and of those stuck evacuating", func() {
+			sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
+			Expect(sender.GetValue("LRPsEvacuating").Value).To(Equal(float64(2)))
+			Expect(sender.GetValue("LRPsStuckEvacuating").Value).To(Equal(float64(1)))
+		})
+
+		It("logs the LRPs stuck evacuating", func() {
+			convergenceLogger := lagertest.NewTestLogger("convergence")
+			sqlDB.ConvergeLRPs(convergenceLogger, cellSet, models.ConvergenceFilter{})
+			Expect(convergenceLogger).To(gbytes.Say(`stuck-evacuating-actual-lrp.*"process_guid":"stuck-guid"`))
+			Expect(convergenceLogger).NotTo(gbytes.Say("fresh-guid"))
+		})
+
+		It("does not emit them when scoped to domains", func() {
+			sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{Domains: []string{freshDomain}})
+			Expect(sender.HasValue("LRPsEvacuating")).To(BeFalse())
+		})
+	})
+
 	Describe("convergence counters", func() {
 		It("bumps the convergence counter", func() {
 			Expect(sender.GetCounter("ConvergenceLRPRuns")).To(Equal(uint64(0)))
-			sqlDB.ConvergeLRPs(logger, models.CellSet{})
+			sqlDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 			Expect(sender.GetCounter("ConvergenceLRPRuns")).To(Equal(uint64(1)))
-			sqlDB.ConvergeLRPs(logger, models.CellSet{})
+			sqlDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 			Expect(sender.GetCounter("ConvergenceLRPRuns")).To(Equal(uint64(2)))
 		})
 
 		It("reports the duration that it took to converge", func() {
-			sqlDB.ConvergeLRPs(logger, models.CellSet{})
+			sqlDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 
 			reportedDuration := sender.GetValue("ConvergenceLRPDuration")
 			Expect(reportedDuration.Unit).To(Equal("nanos"))
@@ -338,8 +375,50 @@ var _ = Describe("LRPConvergence", func() {
 		})
 	})
 
+	Describe("scoped to domains", func() {
+		It("only converges the LRPs in those domains", func() {
+			filter := models.ConvergenceFilter{Domains: []string{freshDomain}}
+			startRequests, keysWithMissingCells, keysToRetire := sqlDB.ConvergeLRPs(logger, cellSet, filter)
+
+			Expect(startRequests).NotTo(BeEmpty())
+			for _, startRequest := range startRequests {
+				Expect(startRequest.Domain).To(Equal(freshDomain))
+			}
+
+			Expect(keysWithMissingCells).NotTo(BeEmpty())
+			for _, key := range keysWithMissingCells {
+				Expect(key.Key.Domain).To(Equal(freshDomain))
+			}
+
+			Expect(keysToRetire).To(ContainElement(&models.ActualLRPKey{
+				ProcessGuid: "desired-with-extra-actuals" + "-" + freshDomain,
+				Index:       1,
+				Domain:      freshDomain,
+			}))
+			for _, key := range keysToRetire {
+				Expect(key.Domain).To(Equal(freshDomain))
+			}
+
+			_, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, "desired-with-missing-all-actuals"+"-"+expiredDomain, 0)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("does not retire extra LRPs in a domain that is not fresh", func() {
+			filter := models.ConvergenceFilter{Domains: []string{expiredDomain}}
+			_, _, keysToRetire := sqlDB.ConvergeLRPs(logger, cellSet, filter)
+			Expect(keysToRetire).To(BeEmpty())
+		})
+
+		It("does not emit the foundation-wide metrics", func() {
+			sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{Domains: []string{freshDomain}})
+			Expect(sender.GetCounter("ConvergenceLRPRuns")).To(Equal(uint64(0)))
+			Expect(sender.GetValue("LRPsMissing").Unit).To(BeEmpty())
+			Expect(sender.GetValue("Domain." + freshDomain).Value).To(Equal(float64(1)))
+		})
+	})
+
 	It("returns start requests for stale unclaimed actual LRPs", func() {
-		startRequests, _, _ := sqlDB.ConvergeLRPs(logger, cellSet)
+		startRequests, _, _ := sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 
 		By("fresh domain", func() {
 			Expect(startRequests).NotTo(BeEmpty())
@@ -371,7 +450,7 @@ var _ = Describe("LRPConvergence", func() {
 	})
 
 	It("returns the start requests and actual lrp keys for actuals with missing cells", func() {
-		_, keysWithMissingCells, _ := sqlDB.ConvergeLRPs(logger, cellSet)
+		_, keysWithMissingCells, _ := sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 
 		By("fresh domain", func() {
 			processGuid := "desired-with-missing-cell-actuals" + "-" + freshDomain
@@ -403,7 +482,7 @@ var _ = Describe("LRPConvergence", func() {
 	})
 
 	It("creates actual LRPs with missing indices, and returns it to be started", func() {
-		startRequests, _, _ := sqlDB.ConvergeLRPs(logger, cellSet)
+		startRequests, _, _ := sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 		Expect(startRequests).NotTo(BeEmpty())
 
 		By("missing all actuals, fresh domain", func() {
@@ -472,7 +551,7 @@ var _ = Describe("LRPConvergence", func() {
 	})
 
 	It("unclaims actual LRPs that are crashed and restartable, and returns it to be started", func() {
-		startRequests, _, _ := sqlDB.ConvergeLRPs(logger, cellSet)
+		startRequests, _, _ := sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 		Expect(startRequests).NotTo(BeEmpty())
 
 		By("fresh domain", func() {
@@ -506,7 +585,7 @@ var _ = Describe("LRPConvergence", func() {
 	})
 
 	It("returns extra actual LRPs to be retired", func() {
-		_, _, keysToRetire := sqlDB.ConvergeLRPs(logger, cellSet)
+		_, _, keysToRetire := sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 		Expect(keysToRetire).NotTo(BeEmpty())
 
 		processGuid := "desired-with-extra-actuals" + "-" + freshDomain
@@ -518,8 +597,173 @@ var _ = Describe("LRPConvergence", func() {
 		Expect(keysToRetire).To(ContainElement(&actualLRPKey))
 	})
 
+	Describe("with an orphan grace period", func() {
+		const gracePeriod = time.Minute
+
+		var (
+			gracefulDB     *sqldb.SQLDB
+			orphanedLRPKey models.ActualLRPKey
+			keysToRetire   []*models.ActualLRPKey
+		)
+
+		BeforeEach(func() {
+			gracefulDB = sqldb.NewSQLDBWithOptions(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "", sqldb.SQLDBOptions{OrphanGracePeriod: gracePeriod})
+			orphanedLRPKey = models.ActualLRPKey{ProcessGuid: "actual-with-no-desired" + "-" + freshDomain, Index: 0, Domain: freshDomain}
+
+			_, _, keysToRetire = gracefulDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
+		})
+
+		It("marks the orphans instead of retiring them", func() {
+			Expect(keysToRetire).NotTo(ContainElement(&orphanedLRPKey))
+
+			actualLRPGroup, err := gracefulDB.ActualLRPGroupByProcessGuidAndIndex(logger, orphanedLRPKey.ProcessGuid, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPGroup.Instance.OrphanedSince).To(Equal(fakeClock.Now().UnixNano()))
+		})
+
+		It("still retires extra indices of desired LRPs", func() {
+			processGuid := "desired-with-extra-actuals" + "-" + freshDomain
+			Expect(keysToRetire).To(ContainElement(&models.ActualLRPKey{ProcessGuid: processGuid, Index: 1, Domain: freshDomain}))
+		})
+
+		Context("when the grace period has passed", func() {
+			BeforeEach(func() {
+				fakeClock.Increment(gracePeriod)
+				_, _, keysToRetire = gracefulDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
+			})
+
+			It("retires the orphans", func() {
+				Expect(keysToRetire).To(ContainElement(&orphanedLRPKey))
+			})
+		})
+
+		Context("when the desired LRP reappears within the grace period", func() {
+			BeforeEach(func() {
+				desiredLRP := model_helpers.NewValidDesiredLRP(orphanedLRPKey.ProcessGuid)
+				desiredLRP.Domain = freshDomain
+				desiredLRP.Instances = 1
+				Expect(gracefulDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+				fakeClock.Increment(gracePeriod)
+				_, _, keysToRetire = gracefulDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
+			})
+
+			It("keeps the actual LRP and clears its orphaned mark", func() {
+				Expect(keysToRetire).NotTo(ContainElement(&orphanedLRPKey))
+
+				actualLRPGroup, err := gracefulDB.ActualLRPGroupByProcessGuidAndIndex(logger, orphanedLRPKey.ProcessGuid, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualLRPGroup.Instance.OrphanedSince).To(BeZero())
+			})
+		})
+	})
+
+	Describe("with a convergence batch size", func() {
+		var (
+			batchingDB     *sqldb.SQLDB
+			startRequests  []*auctioneer.LRPStartRequest
+			crashedGuid    string
+			crashedBefores []*models.ActualLRPGroup
+		)
+
+		BeforeEach(func() {
+			batchingDB = sqldb.NewSQLDBWithOptions(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "", sqldb.SQLDBOptions{OrphanGracePeriod: time.Minute, ConvergenceBatchSize: 2})
+
+			crashedGuid = "desired-with-restartable-crashed-actuals" + "-" + freshDomain
+			var err error
+			crashedBefores, err = batchingDB.ActualLRPGroupsByProcessGuid(logger, crashedGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			startRequests, _, _ = batchingDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
+		})
+
+		startRequestFor := func(processGuid string) *auctioneer.LRPStartRequest {
+			for _, startRequest := range startRequests {
+				if startRequest.ProcessGuid == processGuid {
+					return startRequest
+				}
+			}
+			return nil
+		}
+
+		It("creates the missing actual LRPs", func() {
+			processGuid := "desired-with-missing-some-actuals" + "-" + freshDomain
+			for _, index := range []int32{1, 3} {
+				actualLRPGroup, err := batchingDB.ActualLRPGroupByProcessGuidAndIndex(logger, processGuid, index)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualLRPGroup.Instance.State).To(Equal(models.ActualLRPStateUnclaimed))
+			}
+
+			Expect(startRequestFor(processGuid)).NotTo(BeNil())
+			Expect(startRequestFor(processGuid).Indices).To(ConsistOf(1, 3))
+		})
+
+		It("unclaims the restartable crashed actual LRPs", func() {
+			for _, before := range crashedBefores {
+				actualLRPGroup, err := batchingDB.ActualLRPGroupByProcessGuidAndIndex(logger, crashedGuid, before.Instance.Index)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualLRPGroup.Instance.State).To(Equal(models.ActualLRPStateUnclaimed))
+				Expect(actualLRPGroup.Instance.CellId).To(BeEmpty())
+				Expect(actualLRPGroup.Instance.InstanceGuid).To(BeEmpty())
+				Expect(actualLRPGroup.Instance.ModificationTag.Index).To(Equal(before.Instance.ModificationTag.Index + 1))
+			}
+
+			Expect(startRequestFor(crashedGuid)).NotTo(BeNil())
+			Expect(startRequestFor(crashedGuid).Indices).To(ConsistOf(0, 1))
+		})
+
+		It("marks the orphaned actual LRPs", func() {
+			actualLRPGroup, err := batchingDB.ActualLRPGroupByProcessGuidAndIndex(logger, "actual-with-no-desired"+"-"+freshDomain, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPGroup.Instance.OrphanedSince).To(Equal(fakeClock.Now().UnixNano()))
+		})
+	})
+
+	Context("when one of a batch of missing actual LRPs is created before the batch", func() {
+		var (
+			processGuid   string
+			preCreated    *models.ActualLRPGroup
+			preCreatedErr error
+		)
+
+		BeforeEach(func() {
+			batchingDB := sqldb.NewSQLDBWithOptions(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "", sqldb.SQLDBOptions{OrphanGracePeriod: time.Minute, ConvergenceBatchSize: 100})
+			processGuid = "desired-with-missing-some-actuals" + "-" + freshDomain
+
+			// the guids are generated while the batch is built, after the keys
+			// were found missing and before they are inserted
+			var calls int32
+			fakeGUIDProvider.NextGUIDStub = func() (string, error) {
+				if atomic.AddInt32(&calls, 1) == 1 {
+					key := &models.ActualLRPKey{ProcessGuid: processGuid, Index: 3, Domain: freshDomain}
+					preCreated, preCreatedErr = batchingDB.CreateUnclaimedActualLRP(logger, key)
+				}
+				return "some-guid", nil
+			}
+
+			batchingDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
+		})
+
+		It("creates the other actual LRPs of the batch", func() {
+			actualLRPGroup, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, processGuid, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPGroup.Instance.State).To(Equal(models.ActualLRPStateUnclaimed))
+
+			actualLRPGroup, err = sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, "desired-with-missing-all-actuals"+"-"+freshDomain, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPGroup.Instance.State).To(Equal(models.ActualLRPStateUnclaimed))
+		})
+
+		It("leaves the actual LRP that already exists alone", func() {
+			Expect(preCreatedErr).NotTo(HaveOccurred())
+			actualLRPGroup, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, processGuid, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPGroup.Instance.ModificationTag).To(Equal(preCreated.Instance.ModificationTag))
+		})
+	})
+
 	It("creates unclaimed for evacuating instances that are missing the running record", func() {
-		startRequests, _, _ := sqlDB.ConvergeLRPs(logger, cellSet)
+		startRequests, _, _ := sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 		Expect(startRequests).NotTo(BeEmpty())
 
 		processGuids := []string{
@@ -570,7 +814,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Expect(fetchDomains()).To(ContainElement(expiredDomain))
 
-		sqlDB.ConvergeLRPs(logger, cellSet)
+		sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 
 		Expect(fetchDomains()).NotTo(ContainElement(expiredDomain))
 	})
@@ -593,7 +837,7 @@ var _ = Describe("LRPConvergence", func() {
 
 		Expect(fetchActuals()).To(ContainElement("expired-evacuating-actual-lrp"))
 
-		sqlDB.ConvergeLRPs(logger, cellSet)
+		sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 
 		Expect(fetchActuals()).NotTo(ContainElement("expired-evacuating-actual-lrp"))
 	})
@@ -622,7 +866,7 @@ var _ = Describe("LRPConvergence", func() {
 			beforeActuals = append(beforeActuals, actuals)
 		}
 
-		startRequests, keysWithMissingCells, keysToRetire := sqlDB.ConvergeLRPs(logger, cellSet)
+		startRequests, keysWithMissingCells, keysToRetire := sqlDB.ConvergeLRPs(logger, cellSet, models.ConvergenceFilter{})
 
 		startGuids := make([]string, 0, len(startRequests))
 		for _, startRequest := range startRequests {
@@ -667,7 +911,7 @@ var _ = Describe("LRPConvergence", func() {
 		})
 
 		It("reports all actual lrps as missing cells", func() {
-			_, actualsWithMissingCells, _ := sqlDB.ConvergeLRPs(logger, models.CellSet{})
+			_, actualsWithMissingCells, _ := sqlDB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
 			Expect(len(actualsWithMissingCells)).To(Equal(23))
 		})
 	})
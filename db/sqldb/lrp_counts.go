@@ -0,0 +1,68 @@
+package sqldb
+
+import (
+	"fmt"
+
+	bbsdb "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+func (db *SQLDB) LRPCountsByDomain(logger lager.Logger) (map[string]bbsdb.DomainLRPCounts, error) {
+	logger = logger.Session("lrp-counts-by-domain")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	counts := map[string]bbsdb.DomainLRPCounts{}
+
+	query := fmt.Sprintf("SELECT domain, SUM(instances) FROM %s GROUP BY domain", db.table(desiredLRPsTable))
+	err := db.countByDomain(logger, query, func(domain string, count int) {
+		domainCounts := counts[domain]
+		domainCounts.DesiredInstances = count
+		counts[domain] = domainCounts
+	})
+	if err != nil {
+		logger.Error("failed-counting-desired-instances", err)
+		return nil, err
+	}
+
+	query = fmt.Sprintf("SELECT domain, COUNT(*) FROM %s WHERE state = ? AND evacuating = ? GROUP BY domain", db.table(actualLRPsTable))
+	err = db.countByDomain(logger, query, func(domain string, count int) {
+		domainCounts := counts[domain]
+		domainCounts.RunningInstances = count
+		counts[domain] = domainCounts
+	}, models.ActualLRPStateRunning, false)
+	if err != nil {
+		logger.Error("failed-counting-running-instances", err)
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (db *SQLDB) countByDomain(logger lager.Logger, query string, record func(domain string, count int), args ...interface{}) error {
+	rows, err := db.db.Query(db.rebind(query), args...)
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var domain string
+		var count int
+		err := rows.Scan(&domain, &count)
+		if err != nil {
+			return db.convertSQLError(err)
+		}
+		record(domain, count)
+	}
+
+	if rows.Err() != nil {
+		return db.convertSQLError(rows.Err())
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package sqldb_test
+
+import (
+	thepackagedb "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LRPCountsByDomain", func() {
+	Context("when there are LRPs in several domains", func() {
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("guid-1")
+			desiredLRP.Domain = "domain-1"
+			desiredLRP.Instances = 3
+			Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+			desiredLRP = model_helpers.NewValidDesiredLRP("guid-2")
+			desiredLRP.Domain = "domain-1"
+			desiredLRP.Instances = 2
+			Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+			desiredLRP = model_helpers.NewValidDesiredLRP("guid-3")
+			desiredLRP.Domain = "domain-2"
+			desiredLRP.Instances = 1
+			Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+			instanceKey := models.NewActualLRPInstanceKey("instance-guid", "cell-id")
+			netInfo := models.NewActualLRPNetInfo("127.0.0.1", models.NewPortMapping(8080, 80))
+
+			runningKey := models.NewActualLRPKey("guid-1", 0, "domain-1")
+			_, _, err := sqlDB.StartActualLRP(logger, &runningKey, &instanceKey, &netInfo)
+			Expect(err).NotTo(HaveOccurred())
+
+			unclaimedKey := models.NewActualLRPKey("guid-1", 1, "domain-1")
+			_, err = sqlDB.CreateUnclaimedActualLRP(logger, &unclaimedKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			evacuatingKey := models.NewActualLRPKey("guid-3", 0, "domain-2")
+			_, err = sqlDB.EvacuateActualLRP(logger, &evacuatingKey, &instanceKey, &netInfo, 100)
+			Expect(err).NotTo(HaveOccurred())
+
+			orphanedKey := models.NewActualLRPKey("guid-4", 0, "domain-3")
+			_, _, err = sqlDB.StartActualLRP(logger, &orphanedKey, &instanceKey, &netInfo)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("counts the desired and running instances of each domain", func() {
+			counts, err := sqlDB.LRPCountsByDomain(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(Equal(map[string]thepackagedb.DomainLRPCounts{
+				"domain-1": {DesiredInstances: 5, RunningInstances: 1},
+				"domain-2": {DesiredInstances: 1, RunningInstances: 0},
+				"domain-3": {DesiredInstances: 0, RunningInstances: 1},
+			}))
+		})
+	})
+
+	Context("when there are no LRPs", func() {
+		It("returns no counts", func() {
+			counts, err := sqlDB.LRPCountsByDomain(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,356 @@
+package sqldb
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// errMissingEncryptionKey fails the reads of a record whose encryption key is
+// missing. Unlike models.ErrDeserialize, it never gets the record deleted.
+var errMissingEncryptionKey = models.NewError(models.Error_InvalidRecord, "the record was encrypted with a key that is missing")
+
+// errRecordSkipped leaves a record whose encryption key is missing out of the
+// reads: the bulk reads pass over it, and the reads of the record alone do
+// not find it.
+var errRecordSkipped = models.NewError(models.Error_ResourceNotFound, "the record was encrypted with a key that is missing")
+
+func isMissingKeyError(err error) bool {
+	return err == errMissingEncryptionKey || err == errRecordSkipped
+}
+
+// isUnreadableRecordError reports whether err fails the read of a single
+// record, malformed or encrypted with a missing key, which the bulk reads
+// with partial results leave out instead of failing.
+func isUnreadableRecordError(err error) bool {
+	return err == models.ErrDeserialize || err == errMissingEncryptionKey
+}
+
+// recordToQuarantine is returned for a record whose encryption key is missing
+// under the quarantine policy. Nothing is written until the read that owns the
+// row of the record moves it with quarantineRow.
+type recordToQuarantine struct {
+	recordType string
+	guid       string
+	keyLabel   string
+	payload    []byte
+}
+
+func (r *recordToQuarantine) Error() string {
+	return fmt.Sprintf("the %s of %s was encrypted with the missing key %q", r.recordType, r.guid, r.keyLabel)
+}
+
+func isRecordToQuarantine(err error) bool {
+	_, ok := err.(*recordToQuarantine)
+	return ok
+}
+
+// handleMissingKey applies the missing key policy to a record that could not
+// be decrypted for err.
+func (db *SQLDB) handleMissingKey(logger lager.Logger, recordType, guid string, data []byte, err error) error {
+	logger = logger.WithData(lager.Data{"record_type": recordType, "record": guid, "policy": db.missingKeyPolicy})
+
+	switch db.missingKeyPolicy {
+	case format.MissingKeySkip:
+		logger.Info("skipping-record-with-missing-key")
+		db.missingKeyPolicy.CountRecord()
+		return errRecordSkipped
+
+	case format.MissingKeyQuarantine:
+		return &recordToQuarantine{
+			recordType: recordType,
+			guid:       guid,
+			keyLabel:   err.(*format.DecryptionError).KeyLabel,
+			payload:    data,
+		}
+
+	default:
+		return errMissingEncryptionKey
+	}
+}
+
+// quarantineRowByGuid is quarantineRow for the tables keyed by a single guid
+// column.
+func (db *SQLDB) quarantineRowByGuid(logger lager.Logger, q Queryable, err error, table, guidColumn string) error {
+	record, ok := err.(*recordToQuarantine)
+	if !ok {
+		return err
+	}
+	return db.quarantineRow(logger, q, err, table, guidColumn+" = ?", record.guid)
+}
+
+// quarantineRow moves the row of table selected by wheres to the quarantined
+// records when err is a recordToQuarantine, and returns any other err as it
+// is. The whole row is copied before it is deleted, in q when it is a
+// transaction and in a transaction of its own otherwise, so that the row is
+// never lost. The record is then left out of the read as if it were skipped.
+// When the row cannot be moved it is kept, and the read fails instead.
+func (db *SQLDB) quarantineRow(logger lager.Logger, q Queryable, err error, table, wheres string, whereBindings ...interface{}) error {
+	record, ok := err.(*recordToQuarantine)
+	if !ok {
+		return err
+	}
+	logger = logger.WithData(lager.Data{"record_type": record.recordType, "record": record.guid, "policy": db.missingKeyPolicy})
+
+	if inTransaction(q) {
+		err = db.moveRowToQuarantine(logger, q, record, table, wheres, whereBindings...)
+	} else {
+		err = db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+			return db.moveRowToQuarantine(logger, tx, record, table, wheres, whereBindings...)
+		})
+	}
+	if err != nil {
+		logger.Error("failed-quarantining-record", err)
+		return errMissingEncryptionKey
+	}
+
+	logger.Info("quarantined-record-with-missing-key")
+	db.missingKeyPolicy.CountRecord()
+	return errRecordSkipped
+}
+
+func inTransaction(q Queryable) bool {
+	withContext, ok := q.(queryableWithContext)
+	if !ok {
+		return false
+	}
+	_, ok = withContext.q.(*sql.Tx)
+	return ok
+}
+
+// quarantinedColumn is a column of a quarantined row as the driver read it.
+// A nil Value is NULL. Binary columns are restored from their bytes and the
+// others from their text, which the database converts back to the type of
+// the column.
+type quarantinedColumn struct {
+	Value  []byte `json:"value"`
+	Binary bool   `json:"binary,omitempty"`
+}
+
+func (db *SQLDB) moveRowToQuarantine(logger lager.Logger, tx Queryable, record *recordToQuarantine, table, wheres string, whereBindings ...interface{}) error {
+	rowData, err := db.readRowData(tx, table, wheres, whereBindings...)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(record.payload)
+	_, err = db.upsert(logger, tx, quarantinedRecordsTable,
+		SQLAttributes{
+			"record_type":  record.recordType,
+			"record_guid":  record.guid,
+			"payload_hash": hex.EncodeToString(hash[:]),
+		},
+		SQLAttributes{
+			"key_label":      record.keyLabel,
+			"payload":        record.payload,
+			"source_table":   table,
+			"row_data":       rowData,
+			"quarantined_at": db.clock.Now().UnixNano(),
+		},
+	)
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+
+	_, err = db.delete(logger, tx, table, wheres, whereBindings...)
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+
+	if table == desiredLRPsTable {
+		err = db.deleteDesiredLRPLabels(logger, tx, record.guid)
+		if err != nil {
+			return err
+		}
+		_, err = db.bumpRevision(logger, tx, desiredLRPsTable)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readRowData reads every column of the one row of table selected by wheres,
+// encoded as a JSON object of quarantinedColumns by column name.
+func (db *SQLDB) readRowData(tx Queryable, table, wheres string, whereBindings ...interface{}) ([]byte, error) {
+	query := fmt.Sprintf("SELECT * FROM %s WHERE %s", db.table(table), wheres)
+	rows, err := tx.Query(db.rebind(query), whereBindings...)
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, db.convertSQLError(err)
+		}
+		return nil, models.ErrResourceNotFound
+	}
+
+	values := make([][]byte, len(columnTypes))
+	dest := make([]interface{}, len(columnTypes))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	err = rows.Scan(dest...)
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+
+	columns := make(map[string]quarantinedColumn, len(columnTypes))
+	for i, columnType := range columnTypes {
+		columns[columnType.Name()] = quarantinedColumn{
+			Value:  values[i],
+			Binary: isBinaryColumn(columnType.DatabaseTypeName()),
+		}
+	}
+	return json.Marshal(columns)
+}
+
+func isBinaryColumn(typeName string) bool {
+	typeName = strings.ToUpper(typeName)
+	return strings.Contains(typeName, "BLOB") || strings.Contains(typeName, "BINARY") || typeName == "BYTEA"
+}
+
+// quarantinedSourceTables are the tables quarantined rows are restored to.
+var quarantinedSourceTables = map[string]bool{
+	tasksTable:                true,
+	desiredLRPsTable:          true,
+	desiredLRPTombstonesTable: true,
+	actualLRPsTable:           true,
+}
+
+// RestoreQuarantinedRecords puts the rows quarantined because the key with
+// keyLabel was missing back in their tables, once the key is configured
+// again, and returns how many were restored. A row that conflicts with a
+// record created since is left in quarantine.
+func (db *SQLDB) RestoreQuarantinedRecords(logger lager.Logger, keyLabel string) (int, error) {
+	logger = logger.Session("restore-quarantined-records", lager.Data{"key_label": keyLabel})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	type quarantinedRow struct {
+		recordType, recordGuid, payloadHash, sourceTable string
+		rowData                                          []byte
+	}
+
+	rows, err := db.all(logger, db.db, quarantinedRecordsTable,
+		ColumnList{"record_type", "record_guid", "payload_hash", "source_table", "row_data"}, NoLockRow,
+		"key_label = ? AND row_data IS NOT NULL", keyLabel,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	quarantined := []quarantinedRow{}
+	for rows.Next() {
+		var row quarantinedRow
+		err = rows.Scan(&row.recordType, &row.recordGuid, &row.payloadHash, &row.sourceTable, &row.rowData)
+		if err != nil {
+			rows.Close()
+			logger.Error("failed-scanning-row", err)
+			return 0, db.convertSQLError(err)
+		}
+		quarantined = append(quarantined, row)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		logger.Error("failed-reading-rows", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	restored := 0
+	for _, row := range quarantined {
+		rowLogger := logger.WithData(lager.Data{"record_type": row.recordType, "record": row.recordGuid, "source_table": row.sourceTable})
+		err := db.transact(rowLogger, func(logger lager.Logger, tx Queryable) error {
+			return db.restoreRow(logger, tx, row.sourceTable, row.rowData, row.recordGuid)
+		})
+		if err != nil {
+			rowLogger.Error("failed-restoring-record", err)
+			continue
+		}
+
+		_, err = db.delete(rowLogger, db.db, quarantinedRecordsTable,
+			"record_type = ? AND record_guid = ? AND payload_hash = ?",
+			row.recordType, row.recordGuid, row.payloadHash,
+		)
+		if err != nil {
+			rowLogger.Error("failed-deleting-quarantined-record", err)
+			return restored, db.convertSQLError(err)
+		}
+		restored++
+	}
+
+	return restored, nil
+}
+
+func (db *SQLDB) restoreRow(logger lager.Logger, tx Queryable, table string, rowData []byte, guid string) error {
+	if !quarantinedSourceTables[table] {
+		return fmt.Errorf("unknown source table %q", table)
+	}
+
+	var columns map[string]quarantinedColumn
+	err := json.Unmarshal(rowData, &columns)
+	if err != nil {
+		return err
+	}
+
+	attributes := SQLAttributes{}
+	for name, column := range columns {
+		switch {
+		case column.Value == nil:
+			attributes[name] = nil
+		case column.Binary:
+			attributes[name] = column.Value
+		default:
+			attributes[name] = string(column.Value)
+		}
+	}
+
+	_, err = db.insert(logger, tx, table, attributes)
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+
+	if table == desiredLRPsTable {
+		var labels map[string]string
+		if column, ok := columns["metadata_labels"]; ok && column.Value != nil {
+			err = json.Unmarshal(column.Value, &labels)
+			if err != nil {
+				return err
+			}
+		}
+		err = db.insertDesiredLRPLabels(logger, tx, guid, labels)
+		if err != nil {
+			return err
+		}
+		_, err = db.bumpRevision(logger, tx, desiredLRPsTable)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordType names the kind of record a model is stored in, such as
+// "TaskDefinition".
+func recordType(model interface{}) string {
+	name := fmt.Sprintf("%T", model)
+	return name[strings.LastIndex(name, ".")+1:]
+}
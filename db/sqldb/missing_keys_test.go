@@ -0,0 +1,191 @@
+package sqldb_test
+
+import (
+	"crypto/rand"
+
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Records whose encryption key is missing", func() {
+	var (
+		policyDB     *sqldb.SQLDB
+		removedKeyDB *sqldb.SQLDB
+		policy       format.MissingKeyPolicy
+		metricSender *fake.FakeMetricSender
+	)
+
+	countRows := func(query string, args ...interface{}) int {
+		if test_helpers.UsePostgres() {
+			query = test_helpers.ReplaceQuestionMarks(query)
+		}
+		var count int
+		Expect(db.QueryRow(query, args...).Scan(&count)).To(Succeed())
+		return count
+	}
+
+	BeforeEach(func() {
+		metricSender = fake.NewFakeMetricSender()
+		dropsonde_metrics.Initialize(metricSender, nil)
+
+		removedKey, err := encryption.NewKey("removed-label", "removed passphrase")
+		Expect(err).NotTo(HaveOccurred())
+		keyManager, err := encryption.NewKeyManager(removedKey, nil)
+		Expect(err).NotTo(HaveOccurred())
+		removedKeyDB = sqldb.NewSQLDB(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, encryption.NewCryptor(keyManager, rand.Reader), fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "")
+
+		_, err = sqlDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), "readable-task", "domain")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = removedKeyDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), "unreadable-task", "domain")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		policyDB = sqldb.NewSQLDBWithOptions(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "", sqldb.SQLDBOptions{MissingKeyPolicy: policy})
+	})
+
+	Context("with the fail policy", func() {
+		BeforeEach(func() {
+			policy = format.MissingKeyFail
+		})
+
+		It("fails the bulk read", func() {
+			_, err := policyDB.Tasks(logger, models.TaskFilter{})
+			Expect(err).To(HaveOccurred())
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidRecord))
+		})
+
+		It("fails the read of the record", func() {
+			_, err := policyDB.TaskByGuid(logger, "unreadable-task")
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidRecord))
+		})
+
+		It("keeps the record", func() {
+			policyDB.Tasks(logger, models.TaskFilter{})
+			policyDB.TaskByGuid(logger, "unreadable-task")
+			Expect(countRows("SELECT COUNT(*) FROM tasks WHERE guid = ?", "unreadable-task")).To(Equal(1))
+		})
+	})
+
+	Context("with the skip policy", func() {
+		BeforeEach(func() {
+			policy = format.MissingKeySkip
+		})
+
+		It("leaves the record out of the bulk read", func() {
+			tasks, err := policyDB.Tasks(logger, models.TaskFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(HaveLen(1))
+			Expect(tasks[0].TaskGuid).To(Equal("readable-task"))
+		})
+
+		It("does not find the record", func() {
+			_, err := policyDB.TaskByGuid(logger, "unreadable-task")
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_ResourceNotFound))
+		})
+
+		It("keeps the record", func() {
+			policyDB.Tasks(logger, models.TaskFilter{})
+			Expect(countRows("SELECT COUNT(*) FROM tasks WHERE guid = ?", "unreadable-task")).To(Equal(1))
+		})
+
+		It("counts the skipped record", func() {
+			policyDB.Tasks(logger, models.TaskFilter{})
+			Expect(metricSender.GetCounter("MissingKeyRecordsSkipped")).To(BeEquivalentTo(1))
+		})
+	})
+
+	Context("with the quarantine policy", func() {
+		BeforeEach(func() {
+			policy = format.MissingKeyQuarantine
+		})
+
+		It("moves the record to the quarantined records", func() {
+			policyDB.TaskByGuid(logger, "unreadable-task")
+
+			Expect(countRows("SELECT COUNT(*) FROM tasks WHERE guid = ?", "unreadable-task")).To(Equal(0))
+			Expect(countRows(
+				"SELECT COUNT(*) FROM quarantined_records WHERE record_type = ? AND record_guid = ? AND key_label = ?",
+				"TaskDefinition", "unreadable-task", "removed-label",
+			)).To(Equal(1))
+			Expect(metricSender.GetCounter("MissingKeyRecordsQuarantined")).To(BeEquivalentTo(1))
+		})
+
+		It("keeps the payload as it was", func() {
+			var stored, quarantined []byte
+			query := "SELECT task_definition FROM tasks WHERE guid = ?"
+			if test_helpers.UsePostgres() {
+				query = test_helpers.ReplaceQuestionMarks(query)
+			}
+			Expect(db.QueryRow(query, "unreadable-task").Scan(&stored)).To(Succeed())
+
+			policyDB.TaskByGuid(logger, "unreadable-task")
+
+			query = "SELECT payload FROM quarantined_records WHERE record_guid = ?"
+			if test_helpers.UsePostgres() {
+				query = test_helpers.ReplaceQuestionMarks(query)
+			}
+			Expect(db.QueryRow(query, "unreadable-task").Scan(&quarantined)).To(Succeed())
+			Expect(quarantined).To(Equal(stored))
+		})
+
+		It("moves the whole row of a desired LRP", func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("unreadable-lrp")
+			Expect(removedKeyDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+			_, err := policyDB.DesiredLRPByProcessGuid(logger, "unreadable-lrp")
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_ResourceNotFound))
+
+			Expect(countRows("SELECT COUNT(*) FROM desired_lrps WHERE process_guid = ?", "unreadable-lrp")).To(Equal(0))
+			Expect(countRows(
+				"SELECT COUNT(*) FROM quarantined_records WHERE record_guid = ? AND source_table = ?",
+				"unreadable-lrp", "desired_lrps",
+			)).To(Equal(1))
+		})
+
+		It("restores the records intact once the key is configured again", func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("unreadable-lrp")
+			Expect(removedKeyDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+			task, err := removedKeyDB.TaskByGuid(logger, "unreadable-task")
+			Expect(err).NotTo(HaveOccurred())
+			storedLRP, err := removedKeyDB.DesiredLRPByProcessGuid(logger, "unreadable-lrp")
+			Expect(err).NotTo(HaveOccurred())
+
+			policyDB.TaskByGuid(logger, "unreadable-task")
+			policyDB.DesiredLRPByProcessGuid(logger, "unreadable-lrp")
+			Expect(countRows("SELECT COUNT(*) FROM quarantined_records")).To(Equal(2))
+
+			restored, err := removedKeyDB.RestoreQuarantinedRecords(logger, "removed-label")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(Equal(2))
+			Expect(countRows("SELECT COUNT(*) FROM quarantined_records")).To(Equal(0))
+
+			restoredTask, err := removedKeyDB.TaskByGuid(logger, "unreadable-task")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restoredTask).To(Equal(task))
+
+			restoredLRP, err := removedKeyDB.DesiredLRPByProcessGuid(logger, "unreadable-lrp")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restoredLRP).To(Equal(storedLRP))
+		})
+
+		It("leaves the record out of the reads once it is quarantined", func() {
+			policyDB.TaskByGuid(logger, "unreadable-task")
+
+			tasks, err := policyDB.Tasks(logger, models.TaskFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(HaveLen(1))
+			Expect(tasks[0].TaskGuid).To(Equal("readable-task"))
+		})
+	})
+})
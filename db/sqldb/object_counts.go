@@ -0,0 +1,125 @@
+package sqldb
+
+import (
+	"fmt"
+	"strconv"
+
+	bbsdb "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// ObjectCounts counts in a single repeatable read transaction, so that the
+// counts are consistent with each other.
+func (db *SQLDB) ObjectCounts(logger lager.Logger, byDomain, byState bool) (*bbsdb.ObjectCounts, error) {
+	logger = logger.Session("object-counts", lager.Data{"by_domain": byDomain, "by_state": byState})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	counts := &bbsdb.ObjectCounts{
+		DesiredLRPs: models.NewObjectCount(byDomain, false),
+		ActualLRPs:  models.NewObjectCount(byDomain, byState),
+		Tasks:       models.NewObjectCount(byDomain, byState),
+	}
+
+	err := db.snapshot(logger, func(logger lager.Logger, tx Queryable) error {
+		err := db.countObjects(logger, tx, desiredLRPsTable, "", nil, counts.DesiredLRPs, byDomain, false, nil)
+		if err != nil {
+			logger.Error("failed-counting-desired-lrps", err)
+			return err
+		}
+
+		err = db.countObjects(logger, tx, actualLRPsTable, "evacuating = ?", []interface{}{false}, counts.ActualLRPs, byDomain, byState, nil)
+		if err != nil {
+			logger.Error("failed-counting-actual-lrps", err)
+			return err
+		}
+
+		err = db.countObjects(logger, tx, tasksTable, "", nil, counts.Tasks, byDomain, byState, taskStateName)
+		if err != nil {
+			logger.Error("failed-counting-tasks", err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// countObjects counts the rows of the table matching wheresClause, and groups
+// them by their domain and state columns when asked to. stateName, when
+// given, converts a stored state to the name it is reported with.
+func (db *SQLDB) countObjects(logger lager.Logger, tx Queryable, table, wheresClause string, args []interface{}, count *models.ObjectCount, byDomain, byState bool, stateName func(string) string) error {
+	if wheresClause != "" {
+		wheresClause = " WHERE " + wheresClause
+	}
+
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s%s", db.table(table), wheresClause)
+	err := tx.QueryRow(db.rebind(query), args...).Scan(&count.Total)
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+
+	if byDomain {
+		err = db.countGroups(tx, table, "domain", wheresClause, args, func(domain string, n int32) {
+			count.ByDomain[domain] = n
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if byState {
+		err = db.countGroups(tx, table, "state", wheresClause, args, func(state string, n int32) {
+			if stateName != nil {
+				state = stateName(state)
+			}
+			count.ByState[state] = n
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *SQLDB) countGroups(tx Queryable, table, column, wheresClause string, args []interface{}, record func(group string, count int32)) error {
+	query := fmt.Sprintf("SELECT %s, COUNT(*) FROM %s%s GROUP BY %s", column, db.table(table), wheresClause, column)
+	rows, err := tx.Query(db.rebind(query), args...)
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var group string
+		var count int32
+		err := rows.Scan(&group, &count)
+		if err != nil {
+			return db.convertSQLError(err)
+		}
+		record(group, count)
+	}
+
+	if rows.Err() != nil {
+		return db.convertSQLError(rows.Err())
+	}
+
+	return nil
+}
+
+func taskStateName(state string) string {
+	n, err := strconv.Atoi(state)
+	if err != nil {
+		return state
+	}
+	return models.Task_State(n).String()
+}
@@ -0,0 +1,106 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObjectCounts", func() {
+	Context("when there are objects in several domains and states", func() {
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("guid-1")
+			desiredLRP.Domain = "domain-1"
+			Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+			desiredLRP = model_helpers.NewValidDesiredLRP("guid-2")
+			desiredLRP.Domain = "domain-2"
+			Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+			instanceKey := models.NewActualLRPInstanceKey("instance-guid", "cell-id")
+			netInfo := models.NewActualLRPNetInfo("127.0.0.1", models.NewPortMapping(8080, 80))
+
+			runningKey := models.NewActualLRPKey("guid-1", 0, "domain-1")
+			_, _, err := sqlDB.StartActualLRP(logger, &runningKey, &instanceKey, &netInfo)
+			Expect(err).NotTo(HaveOccurred())
+
+			unclaimedKey := models.NewActualLRPKey("guid-1", 1, "domain-1")
+			_, err = sqlDB.CreateUnclaimedActualLRP(logger, &unclaimedKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			evacuatingKey := models.NewActualLRPKey("guid-2", 0, "domain-2")
+			_, err = sqlDB.EvacuateActualLRP(logger, &evacuatingKey, &instanceKey, &netInfo, 100)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), "task-1", "domain-1")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), "task-2", "domain-1")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), "task-3", "domain-2")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = sqlDB.StartTask(logger, "task-2", "cell-id")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("counts every object, leaving out evacuating instances", func() {
+			counts, err := sqlDB.ObjectCounts(logger, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{Total: 2}))
+			Expect(counts.ActualLRPs).To(Equal(&models.ObjectCount{Total: 2}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{Total: 3}))
+		})
+
+		It("groups the counts by domain", func() {
+			counts, err := sqlDB.ObjectCounts(logger, true, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{
+				Total:    2,
+				ByDomain: map[string]int32{"domain-1": 1, "domain-2": 1},
+			}))
+			Expect(counts.ActualLRPs).To(Equal(&models.ObjectCount{
+				Total:    2,
+				ByDomain: map[string]int32{"domain-1": 2},
+			}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{
+				Total:    3,
+				ByDomain: map[string]int32{"domain-1": 2, "domain-2": 1},
+			}))
+		})
+
+		It("groups the counts by state, naming the task states", func() {
+			counts, err := sqlDB.ObjectCounts(logger, false, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{Total: 2}))
+			Expect(counts.ActualLRPs).To(Equal(&models.ObjectCount{
+				Total: 2,
+				ByState: map[string]int32{
+					models.ActualLRPStateRunning:   1,
+					models.ActualLRPStateUnclaimed: 1,
+				},
+			}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{
+				Total: 3,
+				ByState: map[string]int32{
+					models.Task_Pending.String(): 2,
+					models.Task_Running.String(): 1,
+				},
+			}))
+		})
+	})
+
+	Context("when there are no objects", func() {
+		It("returns zero counts with empty groups", func() {
+			counts, err := sqlDB.ObjectCounts(logger, true, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(counts.DesiredLRPs).To(Equal(&models.ObjectCount{ByDomain: map[string]int32{}}))
+			Expect(counts.ActualLRPs).To(Equal(&models.ObjectCount{ByDomain: map[string]int32{}, ByState: map[string]int32{}}))
+			Expect(counts.Tasks).To(Equal(&models.ObjectCount{ByDomain: map[string]int32{}, ByState: map[string]int32{}}))
+		})
+	})
+})
@@ -31,6 +31,13 @@ const (
 	desiredLRPsTable = "desired_lrps"
 	actualLRPsTable  = "actual_lrps"
 	domainsTable     = "domains"
+
+	idempotencyKeysTable      = "idempotency_keys"
+	revisionsTable            = "revisions"
+	desiredLRPTombstonesTable = "desired_lrp_tombstones"
+	desiredLRPLabelsTable     = "desired_lrp_labels"
+	configurationsTable       = "configurations"
+	quarantinedRecordsTable   = "quarantined_records"
 )
 
 var (
@@ -48,12 +55,18 @@ var (
 		desiredLRPsTable + ".modification_tag_epoch",
 		desiredLRPsTable + ".modification_tag_index",
 		desiredLRPsTable + ".placement_tags",
+		desiredLRPsTable + ".metadata_labels",
+		desiredLRPsTable + ".start_order",
 	}
 
 	desiredLRPColumns = append(schedulingInfoColumns,
 		desiredLRPsTable+".run_info",
 	)
 
+	desiredLRPTombstoneColumns = append(columnsOfTable(desiredLRPTombstonesTable, desiredLRPColumns),
+		desiredLRPTombstonesTable+".deleted_at",
+	)
+
 	taskColumns = ColumnList{
 		tasksTable + ".guid",
 		tasksTable + ".domain",
@@ -66,6 +79,9 @@ var (
 		tasksTable + ".failed",
 		tasksTable + ".failure_reason",
 		tasksTable + ".task_definition",
+		tasksTable + ".rejection_count",
+		tasksTable + ".rejection_reason",
+		tasksTable + ".content_hash",
 	}
 
 	actualLRPColumns = ColumnList{
@@ -83,6 +99,8 @@ var (
 		actualLRPsTable + ".modification_tag_index",
 		actualLRPsTable + ".crash_count",
 		actualLRPsTable + ".crash_reason",
+		actualLRPsTable + ".placement_history",
+		actualLRPsTable + ".orphaned_since",
 	}
 
 	domainColumns = ColumnList{
@@ -91,12 +109,12 @@ var (
 )
 
 func (db *SQLDB) CreateConfigurationsTable(logger lager.Logger) error {
-	_, err := db.db.Exec(`
-		CREATE TABLE IF NOT EXISTS configurations(
+	_, err := db.db.Exec(fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s(
 			id VARCHAR(255) PRIMARY KEY,
 			value VARCHAR(255)
 		)
-	`)
+	`, db.table(configurationsTable)))
 	if err != nil {
 		return err
 	}
@@ -131,7 +149,22 @@ func RebindForFlavor(query, flavor string) string {
 	return strings.Replace(strings.Join(strParts, ""), "MEDIUMTEXT", "TEXT", -1)
 }
 
-func (db *SQLDB) selectLRPInstanceCounts(logger lager.Logger, q Queryable) (*sql.Rows, error) {
+// domainFilterClause returns a condition restricting the given domain column
+// to the domains of a scoped convergence filter, or an always-true condition
+// when the filter is not scoped.
+func domainFilterClause(column string, filter models.ConvergenceFilter) (string, []interface{}) {
+	if !filter.IsScoped() {
+		return "1 = 1", nil
+	}
+
+	bindings := make([]interface{}, 0, len(filter.Domains))
+	for _, domain := range filter.Domains {
+		bindings = append(bindings, domain)
+	}
+	return fmt.Sprintf("%s IN (%s)", column, questionMarks(len(filter.Domains))), bindings
+}
+
+func (db *SQLDB) selectLRPInstanceCounts(logger lager.Logger, q Queryable, filter models.ConvergenceFilter) (*sql.Rows, error) {
 	var query string
 	columns := schedulingInfoColumns
 	columns = append(columns, "COUNT(actual_lrps.instance_index) AS actual_instances")
@@ -146,33 +179,47 @@ func (db *SQLDB) selectLRPInstanceCounts(logger lager.Logger, q Queryable) (*sql
 		panic("database flavor not implemented: " + db.flavor)
 	}
 
+	domainClause, bindings := domainFilterClause("desired_lrps.domain", filter)
+
 	query = fmt.Sprintf(`
 		SELECT %s
-			FROM desired_lrps
-			LEFT OUTER JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid AND actual_lrps.evacuating = false
+			FROM %s
+			LEFT OUTER JOIN %s ON desired_lrps.process_guid = actual_lrps.process_guid AND actual_lrps.evacuating = false
+			WHERE %s
 			GROUP BY desired_lrps.process_guid
 			HAVING COUNT(actual_lrps.instance_index) <> desired_lrps.instances
 		`,
 		strings.Join(columns, ", "),
+		db.tableAs(desiredLRPsTable),
+		db.tableAs(actualLRPsTable),
+		domainClause,
 	)
 
-	return q.Query(query)
+	return q.Query(db.rebind(query), bindings...)
 }
-func (db *SQLDB) selectOrphanedActualLRPs(logger lager.Logger, q Queryable) (*sql.Rows, error) {
-	query := `
-		SELECT actual_lrps.process_guid, actual_lrps.instance_index, actual_lrps.domain
-			FROM actual_lrps
-			JOIN domains ON actual_lrps.domain = domains.domain
+func (db *SQLDB) selectOrphanedActualLRPs(logger lager.Logger, q Queryable, filter models.ConvergenceFilter) (*sql.Rows, error) {
+	domainClause, bindings := domainFilterClause("actual_lrps.domain", filter)
+
+	query := fmt.Sprintf(`
+		SELECT actual_lrps.process_guid, actual_lrps.instance_index, actual_lrps.domain, actual_lrps.orphaned_since
+			FROM %s
+			JOIN %s ON actual_lrps.domain = domains.domain
 			WHERE actual_lrps.evacuating = false
-			AND actual_lrps.process_guid NOT IN (SELECT process_guid FROM desired_lrps)
-		`
+			AND actual_lrps.process_guid NOT IN (SELECT process_guid FROM %s)
+			AND %s
+		`,
+		db.tableAs(actualLRPsTable),
+		db.tableAs(domainsTable),
+		db.table(desiredLRPsTable),
+		domainClause,
+	)
 
-	return q.Query(query)
+	return q.Query(db.rebind(query), bindings...)
 }
 
-func (db *SQLDB) selectLRPsWithMissingCells(logger lager.Logger, q Queryable, cellSet models.CellSet) (*sql.Rows, error) {
-	wheres := []string{"actual_lrps.evacuating = false"}
-	bindings := make([]interface{}, 0, len(cellSet))
+func (db *SQLDB) selectLRPsWithMissingCells(logger lager.Logger, q Queryable, cellSet models.CellSet, filter models.ConvergenceFilter) (*sql.Rows, error) {
+	domainClause, bindings := domainFilterClause("desired_lrps.domain", filter)
+	wheres := []string{"actual_lrps.evacuating = false", domainClause}
 
 	if len(cellSet) > 0 {
 		wheres = append(wheres, fmt.Sprintf("actual_lrps.cell_id NOT IN (%s)", questionMarks(len(cellSet))))
@@ -184,55 +231,69 @@ func (db *SQLDB) selectLRPsWithMissingCells(logger lager.Logger, q Queryable, ce
 
 	query := fmt.Sprintf(`
 		SELECT %s
-			FROM desired_lrps
-			JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid
+			FROM %s
+			JOIN %s ON desired_lrps.process_guid = actual_lrps.process_guid
 			WHERE %s
 		`,
 		strings.Join(append(schedulingInfoColumns, "actual_lrps.instance_index"), ", "),
+		db.tableAs(desiredLRPsTable),
+		db.tableAs(actualLRPsTable),
 		strings.Join(wheres, " AND "),
 	)
 
 	return q.Query(db.rebind(query), bindings...)
 }
 
-func (db *SQLDB) selectCrashedLRPs(logger lager.Logger, q Queryable) (*sql.Rows, error) {
+func (db *SQLDB) selectCrashedLRPs(logger lager.Logger, q Queryable, filter models.ConvergenceFilter) (*sql.Rows, error) {
+	domainClause, domainBindings := domainFilterClause("desired_lrps.domain", filter)
+
 	query := fmt.Sprintf(`
 		SELECT %s
-			FROM desired_lrps
-			JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid
-			WHERE actual_lrps.state = ? AND actual_lrps.evacuating = ?
+			FROM %s
+			JOIN %s ON desired_lrps.process_guid = actual_lrps.process_guid
+			WHERE actual_lrps.state = ? AND actual_lrps.evacuating = ? AND %s
 		`,
 		strings.Join(
 			append(schedulingInfoColumns, "actual_lrps.instance_index", "actual_lrps.since", "actual_lrps.crash_count"),
 			", ",
 		),
+		db.tableAs(desiredLRPsTable),
+		db.tableAs(actualLRPsTable),
+		domainClause,
 	)
 
-	return q.Query(db.rebind(query), models.ActualLRPStateCrashed, false)
+	bindings := append([]interface{}{models.ActualLRPStateCrashed, false}, domainBindings...)
+	return q.Query(db.rebind(query), bindings...)
 }
 
-func (db *SQLDB) selectStaleUnclaimedLRPs(logger lager.Logger, q Queryable, now time.Time) (*sql.Rows, error) {
+func (db *SQLDB) selectStaleUnclaimedLRPs(logger lager.Logger, q Queryable, now time.Time, filter models.ConvergenceFilter) (*sql.Rows, error) {
+	domainClause, domainBindings := domainFilterClause("desired_lrps.domain", filter)
+
 	query := fmt.Sprintf(`
 		SELECT %s
-			FROM desired_lrps
-			JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid
-			WHERE actual_lrps.state = ? AND actual_lrps.since < ? AND actual_lrps.evacuating = ?
+			FROM %s
+			JOIN %s ON desired_lrps.process_guid = actual_lrps.process_guid
+			WHERE actual_lrps.state = ? AND actual_lrps.since < ? AND actual_lrps.evacuating = ? AND %s
 		`,
 		strings.Join(append(schedulingInfoColumns, "actual_lrps.instance_index"), ", "),
+		db.tableAs(desiredLRPsTable),
+		db.tableAs(actualLRPsTable),
+		domainClause,
 	)
 
-	return q.Query(db.rebind(query),
+	bindings := append([]interface{}{
 		models.ActualLRPStateUnclaimed,
 		now.Add(-models.StaleUnclaimedActualLRPDuration).UnixNano(),
 		false,
-	)
+	}, domainBindings...)
+	return q.Query(db.rebind(query), bindings...)
 }
 
 func (db *SQLDB) countDesiredInstances(logger lager.Logger, q Queryable) int {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT COALESCE(SUM(desired_lrps.instances), 0) AS desired_instances
-			FROM desired_lrps
-	`
+			FROM %s
+	`, db.tableAs(desiredLRPsTable))
 
 	var desiredInstances int
 	row := q.QueryRow(db.rebind(query))
@@ -254,7 +315,7 @@ func (db *SQLDB) countActualLRPsByState(logger lager.Logger, q Queryable) (claim
 				COUNT(*) FILTER (WHERE actual_lrps.state = $3) AS running_instances,
 				COUNT(*) FILTER (WHERE actual_lrps.state = $4) AS crashed_instances,
 				COUNT(DISTINCT process_guid) FILTER (WHERE actual_lrps.state = $5) AS crashing_desireds
-			FROM actual_lrps
+			FROM %s
 			WHERE evacuating = $6
 		`
 	case MySQL:
@@ -265,7 +326,7 @@ func (db *SQLDB) countActualLRPsByState(logger lager.Logger, q Queryable) (claim
 				COUNT(IF(actual_lrps.state = ?, 1, NULL)) AS running_instances,
 				COUNT(IF(actual_lrps.state = ?, 1, NULL)) AS crashed_instances,
 				COUNT(DISTINCT IF(state = ?, process_guid, NULL)) AS crashing_desireds
-			FROM actual_lrps
+			FROM %s
 			WHERE evacuating = ?
 		`
 	default:
@@ -273,6 +334,7 @@ func (db *SQLDB) countActualLRPsByState(logger lager.Logger, q Queryable) (claim
 		panic("database flavor not implemented: " + db.flavor)
 	}
 
+	query = fmt.Sprintf(query, db.tableAs(actualLRPsTable))
 	row := db.db.QueryRow(query, models.ActualLRPStateClaimed, models.ActualLRPStateUnclaimed, models.ActualLRPStateRunning, models.ActualLRPStateCrashed, models.ActualLRPStateCrashed, false)
 	err := row.Scan(&claimedCount, &unclaimedCount, &runningCount, &crashedCount, &crashingDesiredCount)
 	if err != nil {
@@ -291,7 +353,7 @@ func (db *SQLDB) countTasksByState(logger lager.Logger, q Queryable) (pendingCou
 				COUNT(*) FILTER (WHERE state = $2) AS running_tasks,
 				COUNT(*) FILTER (WHERE state = $3) AS completed_tasks,
 				COUNT(*) FILTER (WHERE state = $4) AS resolving_tasks
-			FROM tasks
+			FROM %s
 		`
 	case MySQL:
 		query = `
@@ -300,13 +362,14 @@ func (db *SQLDB) countTasksByState(logger lager.Logger, q Queryable) (pendingCou
 				COUNT(IF(state = ?, 1, NULL)) AS running_tasks,
 				COUNT(IF(state = ?, 1, NULL)) AS completed_tasks,
 				COUNT(IF(state = ?, 1, NULL)) AS resolving_tasks
-			FROM tasks
+			FROM %s
 		`
 	default:
 		// totally shouldn't happen
 		panic("database flavor not implemented: " + db.flavor)
 	}
 
+	query = fmt.Sprintf(query, db.table(tasksTable))
 	row := db.db.QueryRow(query, models.Task_Pending, models.Task_Running, models.Task_Completed, models.Task_Resolving)
 	err := row.Scan(&pendingCount, &runningCount, &completedCount, &resolvingCount)
 	if err != nil {
@@ -320,7 +383,7 @@ func (db *SQLDB) one(logger lager.Logger, q Queryable, table string,
 	columns ColumnList, lockRow RowLock,
 	wheres string, whereBindings ...interface{},
 ) *sql.Row {
-	query := fmt.Sprintf("SELECT %s FROM %s\n", strings.Join(columns, ", "), table)
+	query := fmt.Sprintf("SELECT %s FROM %s\n", strings.Join(columns, ", "), db.tableAs(table))
 
 	if len(wheres) > 0 {
 		query += "WHERE " + wheres
@@ -340,7 +403,7 @@ func (db *SQLDB) all(logger lager.Logger, q Queryable, table string,
 	columns ColumnList, lockRow RowLock,
 	wheres string, whereBindings ...interface{},
 ) (*sql.Rows, error) {
-	query := fmt.Sprintf("SELECT %s FROM %s\n", strings.Join(columns, ", "), table)
+	query := fmt.Sprintf("SELECT %s FROM %s\n", strings.Join(columns, ", "), db.tableAs(table))
 
 	if len(wheres) > 0 {
 		query += "WHERE " + wheres
@@ -388,7 +451,7 @@ func (db *SQLDB) upsert(logger lager.Logger, q Queryable, table string, keyAttri
 				INSERT INTO %s
 					(%s)
 				SELECT %s`,
-			table,
+			db.table(table),
 			strings.Join(columns, ", "),
 			insertBindings)
 
@@ -404,7 +467,7 @@ func (db *SQLDB) upsert(logger lager.Logger, q Queryable, table string, keyAttri
 					%s
 				WHERE %s
 				`,
-			table,
+			db.table(table),
 			strings.Join(updateBindings, ", "),
 			strings.Join(whereClause, " AND "),
 		)
@@ -417,7 +480,7 @@ func (db *SQLDB) upsert(logger lager.Logger, q Queryable, table string, keyAttri
 			upsert,
 			insert)
 
-		result, err := q.Exec(fmt.Sprintf("LOCK TABLE %s IN SHARE ROW EXCLUSIVE MODE", table))
+		result, err := q.Exec(fmt.Sprintf("LOCK TABLE %s IN SHARE ROW EXCLUSIVE MODE", db.table(table)))
 		if err != nil {
 			return result, err
 		}
@@ -434,7 +497,7 @@ func (db *SQLDB) upsert(logger lager.Logger, q Queryable, table string, keyAttri
 				ON DUPLICATE KEY UPDATE
 					%s
 			`,
-			table,
+			db.table(table),
 			strings.Join(columns, ", "),
 			insertBindings,
 			strings.Join(updateBindings, ", "),
@@ -453,7 +516,7 @@ func (db *SQLDB) insert(logger lager.Logger, q Queryable, table string, attribut
 		return nil, nil
 	}
 
-	query := fmt.Sprintf("INSERT INTO %s\n", table)
+	query := fmt.Sprintf("INSERT INTO %s\n", db.table(table))
 	attributeNames := make([]string, 0, attributeCount)
 	attributeBindings := make([]string, 0, attributeCount)
 	bindings := make([]interface{}, 0, attributeCount)
@@ -476,7 +539,7 @@ func (db *SQLDB) update(logger lager.Logger, q Queryable, table string, updates
 		return nil, nil
 	}
 
-	query := fmt.Sprintf("UPDATE %s SET\n", table)
+	query := fmt.Sprintf("UPDATE %s SET\n", db.table(table))
 	updateQueries := make([]string, 0, updateCount)
 	bindings := make([]interface{}, 0, updateCount+len(whereBindings))
 
@@ -495,7 +558,7 @@ func (db *SQLDB) update(logger lager.Logger, q Queryable, table string, updates
 
 // DELETE FROM <table> WHERE ...
 func (db *SQLDB) delete(logger lager.Logger, q Queryable, table string, wheres string, whereBindings ...interface{}) (sql.Result, error) {
-	query := fmt.Sprintf("DELETE FROM %s\n", table)
+	query := fmt.Sprintf("DELETE FROM %s\n", db.table(table))
 
 	if len(wheres) > 0 {
 		query += "WHERE " + wheres
@@ -514,3 +577,13 @@ func questionMarks(count int) string {
 	}
 	return strings.Repeat("?, ", count-1) + "?"
 }
+
+// columnsOfTable returns the columns with their table prefix replaced by
+// table, for reading the same columns from a table with the same schema.
+func columnsOfTable(table string, columns ColumnList) ColumnList {
+	renamed := make(ColumnList, len(columns))
+	for i, column := range columns {
+		renamed[i] = table + column[strings.Index(column, "."):]
+	}
+	return renamed
+}
@@ -0,0 +1,80 @@
+package sqldb
+
+import (
+	"database/sql"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// DesiredLRPsRevision returns the revision of the desired LRPs table, which
+// increases with every desire, update, and removal of a desired LRP.
+func (db *SQLDB) DesiredLRPsRevision(logger lager.Logger) (uint64, error) {
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	return db.revision(logger, desiredLRPsTable)
+}
+
+func (db *SQLDB) revision(logger lager.Logger, resource string) (uint64, error) {
+	logger = logger.Session("revision", lager.Data{"resource": resource})
+
+	var revision uint64
+	row := db.one(logger, db.db, revisionsTable,
+		ColumnList{"revision"}, NoLockRow,
+		"resource = ?", resource,
+	)
+	err := row.Scan(&revision)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	} else if err != nil {
+		logger.Error("failed-fetching-revision", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	return revision, nil
+}
+
+// bumpRevision increases the revision of the resource and returns the new
+// revision. It is called with the transaction making the change, so the new
+// revision becomes visible along with it. The revision row stays locked until
+// that transaction ends, which serializes writes to the resource and so hands
+// out revisions in the order the changes commit.
+func (db *SQLDB) bumpRevision(logger lager.Logger, q Queryable, resource string) (uint64, error) {
+	logger = logger.Session("bump-revision", lager.Data{"resource": resource})
+
+	query := fmt.Sprintf("UPDATE %s SET revision = revision + 1 WHERE resource = ?", db.table(revisionsTable))
+	result, err := q.Exec(db.rebind(query), resource)
+	if err != nil {
+		logger.Error("failed-updating-revision", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-updating-revision", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	if rowsAffected == 0 {
+		_, err = db.insert(logger, q, revisionsTable, SQLAttributes{"resource": resource, "revision": 1})
+		if err != nil {
+			logger.Error("failed-inserting-revision", err)
+			return 0, db.convertSQLError(err)
+		}
+		return 1, nil
+	}
+
+	var revision uint64
+	row := db.one(logger, q, revisionsTable,
+		ColumnList{"revision"}, NoLockRow,
+		"resource = ?", resource,
+	)
+	err = row.Scan(&revision)
+	if err != nil {
+		logger.Error("failed-fetching-revision", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	return revision, nil
+}
@@ -1,9 +1,11 @@
 package sqldb
 
 import (
+	"context"
 	"database/sql"
 	"time"
 
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/guidprovider"
@@ -15,16 +17,28 @@ import (
 )
 
 type SQLDB struct {
-	db                     *sql.DB
-	convergenceWorkersSize int
-	updateWorkersSize      int
-	clock                  clock.Clock
-	format                 *format.Format
-	guidProvider           guidprovider.GUIDProvider
-	serializer             format.Serializer
-	cryptor                encryption.Cryptor
-	encoder                format.Encoder
-	flavor                 string
+	db                       Queryable
+	sqlDB                    *sql.DB
+	ctx                      context.Context
+	readTimeout              time.Duration
+	writeTimeout             time.Duration
+	convergenceWorkers       *bbsdb.ConvergenceWorkers
+	updateWorkersSize        int
+	stuckEvacuationThreshold time.Duration
+	tombstoneRetention       time.Duration
+	placementHistoryLength   int
+	clockSkewTolerance       time.Duration
+	orphanGracePeriod        time.Duration
+	convergenceBatchSize     int
+	missingKeyPolicy         format.MissingKeyPolicy
+	clock                    clock.Clock
+	format                   *format.Format
+	guidProvider             guidprovider.GUIDProvider
+	serializer               format.Serializer
+	cryptor                  encryption.Cryptor
+	encoder                  format.Encoder
+	flavor                   string
+	tablePrefix              string
 }
 
 type RowScanner interface {
@@ -38,47 +52,178 @@ type Queryable interface {
 	QueryRow(query string, args ...interface{}) *sql.Row
 }
 
+type contextQueryable interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// queryableWithContext runs every statement with its context, so that the
+// driver gives up on statements still running when the context is done.
+type queryableWithContext struct {
+	ctx context.Context
+	q   contextQueryable
+}
+
+func (q queryableWithContext) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return q.q.ExecContext(q.ctx, query, args...)
+}
+
+func (q queryableWithContext) Prepare(query string) (*sql.Stmt, error) {
+	return q.q.PrepareContext(q.ctx, query)
+}
+
+func (q queryableWithContext) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return q.q.QueryContext(q.ctx, query, args...)
+}
+
+func (q queryableWithContext) QueryRow(query string, args ...interface{}) *sql.Row {
+	return q.q.QueryRowContext(q.ctx, query, args...)
+}
+
 const (
 	NoLock = iota
 	LockForUpdate
 )
 
+// SQLDBOptions holds the settings of a SQLDB that have a default. The zero
+// value of each field is its default.
+type SQLDBOptions struct {
+	// PlacementHistoryLength is how many placements an ActualLRP records. 0
+	// records none.
+	PlacementHistoryLength int
+	// ClockSkewTolerance is how long domains stay fresh after they expire, to
+	// allow for skew between the clocks of the BBS nodes sharing the database.
+	ClockSkewTolerance time.Duration
+	// OrphanGracePeriod is how long convergence keeps an ActualLRP whose
+	// DesiredLRP no longer exists. 0 retires it on the first pass.
+	OrphanGracePeriod time.Duration
+	// ConvergenceBatchSize is how many rows convergence writes per statement.
+	// 0 or 1 writes them one at a time.
+	ConvergenceBatchSize int
+	// MissingKeyPolicy is how records encrypted with a key that is no longer
+	// known are read. It defaults to format.MissingKeyFail.
+	MissingKeyPolicy format.MissingKeyPolicy
+}
+
 func NewSQLDB(
 	db *sql.DB,
 	convergenceWorkersSize int,
 	updateWorkersSize int,
+	stuckEvacuationThreshold time.Duration,
+	tombstoneRetention time.Duration,
 	serializationFormat *format.Format,
 	cryptor encryption.Cryptor,
 	guidProvider guidprovider.GUIDProvider,
 	clock clock.Clock,
 	flavor string,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+	tablePrefix string,
 ) *SQLDB {
+	return NewSQLDBWithOptions(db, convergenceWorkersSize, updateWorkersSize, stuckEvacuationThreshold, tombstoneRetention, serializationFormat, cryptor, guidProvider, clock, flavor, readTimeout, writeTimeout, tablePrefix, SQLDBOptions{})
+}
+
+func NewSQLDBWithOptions(
+	db *sql.DB,
+	convergenceWorkersSize int,
+	updateWorkersSize int,
+	stuckEvacuationThreshold time.Duration,
+	tombstoneRetention time.Duration,
+	serializationFormat *format.Format,
+	cryptor encryption.Cryptor,
+	guidProvider guidprovider.GUIDProvider,
+	clock clock.Clock,
+	flavor string,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+	tablePrefix string,
+	options SQLDBOptions,
+) *SQLDB {
+	missingKeyPolicy := options.MissingKeyPolicy
+	if missingKeyPolicy == "" {
+		missingKeyPolicy = format.MissingKeyFail
+	}
+
+	ctx := context.Background()
 	return &SQLDB{
-		db: db,
-		convergenceWorkersSize: convergenceWorkersSize,
-		updateWorkersSize:      updateWorkersSize,
-		clock:                  clock,
-		format:                 serializationFormat,
-		guidProvider:           guidProvider,
-		serializer:             format.NewSerializer(cryptor),
-		cryptor:                cryptor,
-		encoder:                format.NewEncoder(cryptor),
-		flavor:                 flavor,
+		db:                       queryableWithContext{ctx: ctx, q: db},
+		sqlDB:                    db,
+		ctx:                      ctx,
+		readTimeout:              readTimeout,
+		writeTimeout:             writeTimeout,
+		convergenceWorkers:       bbsdb.NewConvergenceWorkers(convergenceWorkersSize),
+		updateWorkersSize:        updateWorkersSize,
+		stuckEvacuationThreshold: stuckEvacuationThreshold,
+		tombstoneRetention:       tombstoneRetention,
+		placementHistoryLength:   options.PlacementHistoryLength,
+		clockSkewTolerance:       options.ClockSkewTolerance,
+		orphanGracePeriod:        options.OrphanGracePeriod,
+		convergenceBatchSize:     options.ConvergenceBatchSize,
+		missingKeyPolicy:         missingKeyPolicy,
+		clock:                    clock,
+		format:                   serializationFormat,
+		guidProvider:             guidProvider,
+		serializer:               format.NewSerializer(cryptor),
+		cryptor:                  cryptor,
+		encoder:                  format.NewEncoder(cryptor),
+		flavor:                   flavor,
+		tablePrefix:              tablePrefix,
 	}
 }
 
-func (db *SQLDB) transact(logger lager.Logger, f func(logger lager.Logger, tx *sql.Tx) error) error {
+// withTimeout returns a copy of db whose statements, including those of its
+// transactions, are cancelled once timeout has elapsed. The timeout can only
+// shorten that of db. A timeout of 0 or less leaves the statements without
+// one of their own. The returned function releases the timer and must be
+// called once the operation is done with the copy and any rows it returned.
+func (db *SQLDB) withTimeout(timeout time.Duration) (*SQLDB, context.CancelFunc) {
+	if timeout <= 0 {
+		return db, func() {}
+	}
+
+	ctx, cancel := context.WithTimeout(db.ctx, timeout)
+	timed := *db
+	timed.ctx = ctx
+	timed.db = queryableWithContext{ctx: ctx, q: db.sqlDB}
+	return &timed, cancel
+}
+
+// withQueryTimeout returns a copy of db like withTimeout, except that the
+// timeout stops applying once the returned queried function is called, so
+// that the rows a query returned in time can then be read for however long
+// their consumer takes. queried reports whether the timeout had elapsed
+// first. The returned cancel function must be called once the operation is
+// done with the copy and any rows it returned.
+func (db *SQLDB) withQueryTimeout(timeout time.Duration) (*SQLDB, func() bool, context.CancelFunc) {
+	if timeout <= 0 {
+		return db, func() bool { return true }, func() {}
+	}
+
+	ctx, cancel := context.WithCancel(db.ctx)
+	timer := time.AfterFunc(timeout, cancel)
+	timed := *db
+	timed.ctx = ctx
+	timed.db = queryableWithContext{ctx: ctx, q: db.sqlDB}
+	return &timed, timer.Stop, func() {
+		timer.Stop()
+		cancel()
+	}
+}
+
+func (db *SQLDB) transact(logger lager.Logger, f func(logger lager.Logger, tx Queryable) error) error {
 	var err error
 
 	for attempts := 0; attempts < 3; attempts++ {
 		err = func() error {
-			tx, err := db.db.Begin()
+			tx, err := db.sqlDB.BeginTx(db.ctx, nil)
 			if err != nil {
 				return err
 			}
 			defer tx.Rollback()
 
-			err = f(logger, tx)
+			err = f(logger, queryableWithContext{ctx: db.ctx, q: tx})
 			if err != nil {
 				return err
 			}
@@ -106,16 +251,27 @@ func (db *SQLDB) serializeModel(logger lager.Logger, model format.Versioner) ([]
 	return encodedPayload, nil
 }
 
-func (db *SQLDB) deserializeModel(logger lager.Logger, data []byte, model format.Versioner) error {
+// deserializeModel logs the guid of the record the data belongs to when it
+// cannot be decrypted, and applies the missing key policy when its key is
+// missing.
+func (db *SQLDB) deserializeModel(logger lager.Logger, guid string, data []byte, model format.Versioner) error {
 	err := db.serializer.Unmarshal(logger, data, model)
 	if err != nil {
+		format.LogDecryptionFailure(logger, guid, err)
 		logger.Error("failed-to-deserialize-model", err)
+		if format.IsMissingKey(err) {
+			return db.handleMissingKey(logger, recordType(model), guid, data, err)
+		}
 		return models.NewError(models.Error_InvalidRecord, err.Error())
 	}
 	return nil
 }
 
 func (db *SQLDB) convertSQLError(err error) *models.Error {
+	if err == context.DeadlineExceeded {
+		return models.ErrTimeout
+	}
+
 	if err != nil {
 		switch err.(type) {
 		case *mysql.MySQLError:
@@ -153,6 +309,8 @@ func (db *SQLDB) convertPostgresError(err *pq.Error) *models.Error {
 		return models.ErrResourceExists
 	case "42P01":
 		return models.NewUnrecoverableError(err)
+	case "57014":
+		return models.ErrTimeout
 	default:
 		return models.ErrUnknownError
 	}
@@ -26,6 +26,8 @@ import (
 	"testing"
 )
 
+const StuckEvacuationThreshold = 10 * time.Minute
+
 var (
 	db                                   *sql.DB
 	sqlDB                                *sqldb.SQLDB
@@ -87,7 +89,7 @@ var _ = BeforeSuite(func() {
 	cryptor = encryption.NewCryptor(keyManager, rand.Reader)
 	serializer = format.NewSerializer(cryptor)
 
-	sqlDB = sqldb.NewSQLDB(db, 5, 5, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor)
+	sqlDB = sqldb.NewSQLDB(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "")
 	err = sqlDB.CreateConfigurationsTable(logger)
 	if err != nil {
 		logger.Fatal("sql-failed-create-configurations-table", err)
@@ -114,6 +116,7 @@ var _ = BeforeEach(func() {
 		migrationsDone,
 		fakeClock,
 		dbDriverName,
+		"",
 	)
 
 	migrationProcess = ifrit.Invoke(migrationManager)
@@ -159,6 +162,9 @@ var truncateTablesSQL = []string{
 	"TRUNCATE TABLE tasks",
 	"TRUNCATE TABLE desired_lrps",
 	"TRUNCATE TABLE actual_lrps",
+	"TRUNCATE TABLE idempotency_keys",
+	"TRUNCATE TABLE desired_lrp_tombstones",
+	"TRUNCATE TABLE quarantined_records",
 }
 
 func randStr(strSize int) string {
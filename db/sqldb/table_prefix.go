@@ -0,0 +1,49 @@
+package sqldb
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// MaxTablePrefixLength keeps every prefixed table and index name within the
+// identifier length limits of both MySQL (64) and Postgres (63).
+const MaxTablePrefixLength = 24
+
+// tablePrefixPattern only allows prefixes that can be written into queries as
+// part of an unquoted identifier, so that a prefix can never change the
+// meaning of a query.
+var tablePrefixPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// ValidateTablePrefix returns an error unless prefix is empty or made of lower
+// case letters, digits and underscores, starting with a letter, and no longer
+// than MaxTablePrefixLength.
+func ValidateTablePrefix(prefix string) error {
+	if prefix == "" {
+		return nil
+	}
+
+	if len(prefix) > MaxTablePrefixLength {
+		return fmt.Errorf("table prefix '%s' is longer than %d characters", prefix, MaxTablePrefixLength)
+	}
+
+	if !tablePrefixPattern.MatchString(prefix) {
+		return fmt.Errorf("table prefix '%s' must start with a lower case letter and only contain lower case letters, digits and underscores", prefix)
+	}
+
+	return nil
+}
+
+// table returns the name of the table the BBS stores name in.
+func (db *SQLDB) table(name string) string {
+	return db.tablePrefix + name
+}
+
+// tableAs returns the table the BBS stores name in, aliased to name when it is
+// prefixed, for use in FROM and JOIN clauses so that columns qualified with
+// the unprefixed name keep working.
+func (db *SQLDB) tableAs(name string) string {
+	if db.tablePrefix == "" {
+		return name
+	}
+	return db.tablePrefix + name + " AS " + name
+}
@@ -0,0 +1,153 @@
+package sqldb_test
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/db/sqlmigrations"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Table prefix", func() {
+	Describe("ValidateTablePrefix", func() {
+		It("accepts an empty prefix", func() {
+			Expect(sqldb.ValidateTablePrefix("")).To(Succeed())
+		})
+
+		It("accepts lower case letters, digits and underscores starting with a letter", func() {
+			Expect(sqldb.ValidateTablePrefix("env_1_")).To(Succeed())
+		})
+
+		It("rejects prefixes that could change the meaning of a query", func() {
+			for _, prefix := range []string{
+				"1env_",
+				"_env",
+				"Env_",
+				"env-1_",
+				"env.",
+				"env;",
+				"env ",
+				"env`",
+				"env\"",
+				"tasks; DROP TABLE tasks; --",
+			} {
+				Expect(sqldb.ValidateTablePrefix(prefix)).NotTo(Succeed(), prefix)
+			}
+		})
+
+		It("rejects prefixes longer than MaxTablePrefixLength", func() {
+			prefix := "a"
+			for len(prefix) <= sqldb.MaxTablePrefixLength {
+				prefix += "a"
+			}
+			Expect(sqldb.ValidateTablePrefix(prefix[:sqldb.MaxTablePrefixLength])).To(Succeed())
+			Expect(sqldb.ValidateTablePrefix(prefix)).NotTo(Succeed())
+		})
+	})
+
+	Context("when two BBSes share the database with different prefixes", func() {
+		const (
+			prefixA = "env_a_"
+			prefixB = "env_b_"
+		)
+
+		var (
+			dbA, dbB           *sqldb.SQLDB
+			migrationProcesses []ifrit.Process
+		)
+
+		migrate := func(prefix string) *sqldb.SQLDB {
+			prefixedDB := sqldb.NewSQLDB(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, prefix)
+			Expect(prefixedDB.CreateConfigurationsTable(logger)).To(Succeed())
+
+			managerDone := make(chan struct{})
+			manager := migration.NewManager(logger, nil, nil, prefixedDB, db, cryptor, migrations.Migrations, managerDone, fakeClock, dbDriverName, prefix)
+			migrationProcesses = append(migrationProcesses, ifrit.Invoke(manager))
+			Eventually(managerDone).Should(BeClosed())
+
+			migrator := migration.NewSQLMigrator(logger, db, dbFlavor, prefix, sqlmigrations.Migrations, make(chan struct{}), fakeClock)
+			Expect(migrator.Migrate(logger)).To(Succeed())
+
+			return prefixedDB
+		}
+
+		BeforeEach(func() {
+			migrationProcesses = nil
+			dbA = migrate(prefixA)
+			dbB = migrate(prefixB)
+		})
+
+		AfterEach(func() {
+			for _, process := range migrationProcesses {
+				process.Signal(os.Kill)
+				Eventually(process.Wait()).Should(Receive())
+			}
+
+			for _, prefix := range []string{prefixA, prefixB} {
+				for _, table := range []string{
+					"domains", "configurations", "tasks", "desired_lrps", "actual_lrps",
+					"idempotency_keys", "revisions", "desired_lrp_tombstones", "desired_lrp_labels", "schema_migrations",
+				} {
+					_, err := db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s%s", prefix, table))
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+
+		It("keeps the tasks of each BBS apart", func() {
+			_, err := dbA.DesireTask(logger, model_helpers.NewValidTaskDefinition(), "task-guid", "domain-a")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = dbB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), "task-guid", "domain-b")
+			Expect(err).NotTo(HaveOccurred())
+
+			task, err := dbA.TaskByGuid(logger, "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.Domain).To(Equal("domain-a"))
+
+			_, _, _, err = dbA.CancelTask(logger, "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			task, err = dbB.TaskByGuid(logger, "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.Domain).To(Equal("domain-b"))
+			Expect(task.State).To(Equal(models.Task_Pending))
+
+			tasks, err := sqlDB.Tasks(logger, models.TaskFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(BeEmpty())
+		})
+
+		It("keeps the LRPs and domains of each BBS apart", func() {
+			Expect(dbA.UpsertDomain(logger, "domain-a", 100)).To(Succeed())
+			Expect(dbA.DesireLRP(logger, model_helpers.NewValidDesiredLRP("process-guid"))).To(Succeed())
+
+			domains, err := dbB.Domains(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domains).To(BeEmpty())
+
+			desiredLRPs, err := dbB.DesiredLRPs(logger, models.DesiredLRPFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRPs).To(BeEmpty())
+
+			revisionA, err := dbA.DesiredLRPsRevision(logger)
+			Expect(err).NotTo(HaveOccurred())
+			revisionB, err := dbB.DesiredLRPsRevision(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisionA).To(BeNumerically(">", revisionB))
+
+			startRequests, _, _ := dbA.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
+			Expect(startRequests).NotTo(BeEmpty())
+			startRequests, _, _ = dbB.ConvergeLRPs(logger, models.CellSet{}, models.ConvergenceFilter{})
+			Expect(startRequests).To(BeEmpty())
+		})
+	})
+})
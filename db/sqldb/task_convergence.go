@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/runtimeschema/metric"
@@ -24,10 +25,13 @@ const (
 	resolvingTasks = metric.Metric("TasksResolving")
 )
 
-func (db *SQLDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) ([]*auctioneer.TaskStartRequest, []*models.Task) {
+func (db *SQLDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration, maxTaskRejections int) ([]*auctioneer.TaskStartRequest, []*models.Task) {
 	logger.Info("starting")
 	defer logger.Info("completed")
 
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	convergeTaskRunsCounter.Increment()
 	convergeStart := db.clock.Now()
 
@@ -38,30 +42,58 @@ func (db *SQLDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kick
 		}
 	}()
 
+	convergenceTrace := bbsdb.StartConvergenceTrace("converge-tasks")
+	defer convergenceTrace.End()
+
 	var tasksPruned, tasksKicked uint64
 
-	rowsAffected := db.failExpiredPendingTasks(logger, expirePendingTaskDuration)
-	tasksKicked += uint64(rowsAffected)
+	var rowsAffected int64
+	if expirePendingTaskDuration > 0 {
+		phase := convergenceTrace.Phase("fail-expired-pending-tasks")
+		rowsAffected = db.failExpiredPendingTasks(logger, expirePendingTaskDuration)
+		tasksKicked += uint64(rowsAffected)
+		phase.End()
+	}
 
+	if maxTaskRejections > 0 {
+		phase := convergenceTrace.Phase("fail-rejected-pending-tasks")
+		rowsAffected = db.failRejectedPendingTasks(logger, maxTaskRejections)
+		tasksKicked += uint64(rowsAffected)
+		phase.End()
+	}
+
+	phase := convergenceTrace.Phase("kick-pending-tasks")
 	tasksToAuction, failedFetches := db.getTaskStartRequestsForKickablePendingTasks(logger, kickTasksDuration, expirePendingTaskDuration)
 	tasksPruned += failedFetches
 	tasksKicked += uint64(len(tasksToAuction))
+	phase.End()
 
+	phase = convergenceTrace.Phase("fail-tasks-with-disappeared-cells")
 	rowsAffected = db.failTasksWithDisappearedCells(logger, cellSet)
 	tasksKicked += uint64(rowsAffected)
+	phase.End()
 
 	// do this first so that we now have "Completed" tasks before cleaning up
 	// or re-sending the completion callback
+	phase = convergenceTrace.Phase("demote-resolving-tasks")
 	db.demoteKickableResolvingTasks(logger, kickTasksDuration)
+	phase.End()
 
+	phase = convergenceTrace.Phase("delete-expired-completed-tasks")
 	rowsAffected = db.deleteExpiredCompletedTasks(logger, expireCompletedTaskDuration)
 	tasksPruned += uint64(rowsAffected)
+	db.deleteExpiredIdempotencyKeys(logger)
+	phase.End()
 
+	phase = convergenceTrace.Phase("kick-completed-tasks")
 	tasksToComplete, failedFetches := db.getKickableCompleteTasksForCompletion(logger, kickTasksDuration)
 	tasksPruned += failedFetches
 	tasksKicked += uint64(len(tasksToComplete))
+	phase.End()
 
+	phase = convergenceTrace.Phase("count-tasks")
 	pendingCount, runningCount, completedCount, resolvingCount := db.countTasksByState(logger.Session("count-tasks"), db.db)
+	phase.End()
 
 	sendTaskMetrics(logger, pendingCount, runningCount, completedCount, resolvingCount)
 
@@ -79,7 +111,7 @@ func (db *SQLDB) failExpiredPendingTasks(logger lager.Logger, expirePendingTaskD
 	result, err := db.update(logger, db.db, tasksTable,
 		SQLAttributes{
 			"failed":             true,
-			"failure_reason":     "not started within time limit",
+			"failure_reason":     models.ExpiredPendingTaskFailureReason,
 			"result":             "",
 			"state":              models.Task_Completed,
 			"first_completed_at": now.UnixNano(),
@@ -99,13 +131,152 @@ func (db *SQLDB) failExpiredPendingTasks(logger lager.Logger, expirePendingTaskD
 	return rowsAffected
 }
 
+func (db *SQLDB) failRejectedPendingTasks(logger lager.Logger, maxTaskRejections int) int64 {
+	logger = logger.Session("fail-rejected-pending-tasks")
+
+	rows, err := db.all(logger, db.db, tasksTable,
+		ColumnList{"guid", "rejection_count", "rejection_reason"}, NoLockRow,
+		"state = ? AND rejection_count >= ?", models.Task_Pending, maxTaskRejections,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return 0
+	}
+
+	rejectedTasks := []rejectedTask{}
+	for rows.Next() {
+		var task rejectedTask
+		err := rows.Scan(&task.guid, &task.rejectionCount, &task.rejectionReason)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			continue
+		}
+		rejectedTasks = append(rejectedTasks, task)
+	}
+	rows.Close()
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+	}
+
+	now := db.clock.Now().UnixNano()
+	if db.batching() {
+		return db.failRejectedPendingTasksInBatches(logger, rejectedTasks, now)
+	}
+
+	var failed int64
+	for _, task := range rejectedTasks {
+		failureReason := task.failureReason()
+
+		// The state is checked again in case the task was placed since it was
+		// read.
+		result, err := db.update(logger, db.db, tasksTable,
+			SQLAttributes{
+				"failed":             true,
+				"failure_reason":     failureReason,
+				"result":             "",
+				"state":              models.Task_Completed,
+				"first_completed_at": now,
+				"updated_at":         now,
+			},
+			"guid = ? AND state = ?", task.guid, models.Task_Pending,
+		)
+		if err != nil {
+			logger.Error("failed-updating-task", err, lager.Data{"task_guid": task.guid})
+			continue
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("failed-rows-affected", err)
+			continue
+		}
+		failed += rowsAffected
+	}
+
+	return failed
+}
+
+type rejectedTask struct {
+	guid            string
+	rejectionCount  int32
+	rejectionReason string
+}
+
+func (t rejectedTask) failureReason() string {
+	// The failure reason column is narrower than the rejection reason one.
+	return truncateString(models.RejectedTaskFailureReason(t.rejectionCount, t.rejectionReason), 255)
+}
+
+// failRejectedPendingTasksInBatches fails the rejected tasks that share a
+// failure reason with a statement for each batch of them.
+func (db *SQLDB) failRejectedPendingTasksInBatches(logger lager.Logger, rejectedTasks []rejectedTask, now int64) int64 {
+	failureReasons := []string{}
+	guidsByFailureReason := map[string][]string{}
+	for _, task := range rejectedTasks {
+		failureReason := task.failureReason()
+		if _, ok := guidsByFailureReason[failureReason]; !ok {
+			failureReasons = append(failureReasons, failureReason)
+		}
+		guidsByFailureReason[failureReason] = append(guidsByFailureReason[failureReason], task.guid)
+	}
+
+	var failed int64
+	for _, failureReason := range failureReasons {
+		guids := guidsByFailureReason[failureReason]
+
+		db.eachBatch(len(guids), 1, func(start, end int) {
+			batch := guids[start:end]
+
+			bindings := make([]interface{}, 0, 1+len(batch))
+			bindings = append(bindings, models.Task_Pending)
+			for _, guid := range batch {
+				bindings = append(bindings, guid)
+			}
+
+			// The state is checked again in case the tasks were placed since
+			// they were read.
+			result, err := db.update(logger, db.db, tasksTable,
+				SQLAttributes{
+					"failed":             true,
+					"failure_reason":     failureReason,
+					"result":             "",
+					"state":              models.Task_Completed,
+					"first_completed_at": now,
+					"updated_at":         now,
+				},
+				fmt.Sprintf("state = ? AND guid IN (%s)", questionMarks(len(batch))), bindings...,
+			)
+			if err != nil {
+				logger.Error("failed-updating-tasks", err, lager.Data{"count": len(batch)})
+				return
+			}
+
+			rowsAffected, err := result.RowsAffected()
+			if err != nil {
+				logger.Error("failed-rows-affected", err)
+				return
+			}
+			failed += rowsAffected
+		})
+	}
+
+	return failed
+}
+
 func (db *SQLDB) getTaskStartRequestsForKickablePendingTasks(logger lager.Logger, kickTasksDuration, expirePendingTaskDuration time.Duration) ([]*auctioneer.TaskStartRequest, uint64) {
 	logger = logger.Session("get-task-start-requests-for-kickable-pending-tasks")
 
+	wheres := "state = ? AND updated_at < ?"
+	values := []interface{}{models.Task_Pending, db.clock.Now().Add(-kickTasksDuration).UnixNano()}
+	if expirePendingTaskDuration > 0 {
+		wheres += " AND created_at > ?"
+		values = append(values, db.clock.Now().Add(-expirePendingTaskDuration).UnixNano())
+	}
+
 	rows, err := db.all(logger, db.db, tasksTable,
 		taskColumns, NoLockRow,
-		"state = ? AND updated_at < ? AND created_at > ?",
-		models.Task_Pending, db.clock.Now().Add(-kickTasksDuration).UnixNano(), db.clock.Now().Add(-expirePendingTaskDuration).UnixNano(),
+		wheres, values...,
 	)
 
 	if err != nil {
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
@@ -40,119 +42,124 @@ var _ = Describe("Convergence of Tasks", func() {
 			tasksToComplete []*models.Task
 			cellSet         models.CellSet
 
+			maxTaskRejections int
+			convergingDB      *sqldb.SQLDB
+
 			taskDef *models.TaskDefinition
 		)
 
 		BeforeEach(func() {
 			var err error
 			domain = "my-domain"
+			maxTaskRejections = 0
+			convergingDB = sqlDB
 			cellSet = models.NewCellSetFromList([]*models.CellPresence{
 				{CellId: "existing-cell"},
 			})
 			taskDef = model_helpers.NewValidTaskDefinition()
 
 			fakeClock.IncrementBySeconds(-expirePendingTaskDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "pending-expired-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "pending-expired-task", domain)
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(expirePendingTaskDurationInSeconds)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "pending-kickable-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "pending-kickable-task", domain)
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "pending-kickable-invalid-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "pending-kickable-invalid-task", domain)
 			Expect(err).NotTo(HaveOccurred())
 			_, err = db.Exec("UPDATE tasks SET task_definition = 'garbage' WHERE guid = 'pending-kickable-invalid-task'")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
-			err = sqlDB.DesireTask(logger, taskDef, "pending-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "pending-task", domain)
 			Expect(err).NotTo(HaveOccurred())
 
-			err = sqlDB.DesireTask(logger, taskDef, "running-task-no-cell", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "running-task-no-cell", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "running-task-no-cell", "non-existant-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "running-task-no-cell", "non-existant-cell")
 			Expect(err).NotTo(HaveOccurred())
 
-			err = sqlDB.DesireTask(logger, taskDef, "running-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "running-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "running-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "running-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
 
 			fakeClock.Increment(-expireCompletedTaskDuration)
-			err = sqlDB.DesireTask(logger, taskDef, "completed-expired-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "completed-expired-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "completed-expired-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "completed-expired-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "completed-expired-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "completed-expired-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.Increment(expireCompletedTaskDuration)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "completed-kickable-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "completed-kickable-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "completed-kickable-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "completed-kickable-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "completed-kickable-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "completed-kickable-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "completed-kickable-invalid-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "completed-kickable-invalid-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "completed-kickable-invalid-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "completed-kickable-invalid-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "completed-kickable-invalid-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "completed-kickable-invalid-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			_, err = db.Exec("UPDATE tasks SET task_definition = 'garbage' WHERE guid = 'completed-kickable-invalid-task'")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
-			err = sqlDB.DesireTask(logger, taskDef, "completed-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "completed-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "completed-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "completed-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "completed-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "completed-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			fakeClock.Increment(-expireCompletedTaskDuration)
-			err = sqlDB.DesireTask(logger, taskDef, "resolving-expired-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "resolving-expired-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "resolving-expired-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "resolving-expired-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "resolving-expired-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "resolving-expired-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
-			err = sqlDB.ResolvingTask(logger, "resolving-expired-task")
+			_, _, err = sqlDB.ResolvingTask(logger, "resolving-expired-task")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.Increment(expireCompletedTaskDuration)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "resolving-kickable-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "resolving-kickable-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "resolving-kickable-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "resolving-kickable-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "resolving-kickable-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "resolving-kickable-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
-			err = sqlDB.ResolvingTask(logger, "resolving-kickable-task")
+			_, _, err = sqlDB.ResolvingTask(logger, "resolving-kickable-task")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
-			err = sqlDB.DesireTask(logger, taskDef, "resolving-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "resolving-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "resolving-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "resolving-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "resolving-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "resolving-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
-			err = sqlDB.ResolvingTask(logger, "resolving-task")
+			_, _, err = sqlDB.ResolvingTask(logger, "resolving-task")
 			Expect(err).NotTo(HaveOccurred())
 
 			fakeClock.IncrementBySeconds(1)
 		})
 
 		JustBeforeEach(func() {
-			tasksToAuction, tasksToComplete = sqlDB.ConvergeTasks(logger, cellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+			tasksToAuction, tasksToComplete = convergingDB.ConvergeTasks(logger, cellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections)
 		})
 
 		It("bumps the convergence counter", func() {
@@ -214,6 +221,86 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(task.FailureReason).NotTo(Equal("not started within time limit"))
 				Expect(task.Failed).NotTo(BeTrue())
 			})
+
+			Context("when pending tasks never expire", func() {
+				BeforeEach(func() {
+					expirePendingTaskDuration = 0
+				})
+
+				It("leaves the old tasks pending and kicks them for auctioning", func() {
+					task, err := sqlDB.TaskByGuid(logger, "pending-expired-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+					Expect(task.Failed).To(BeFalse())
+
+					taskRequest := auctioneer.NewTaskStartRequestFromModel("pending-expired-task", domain, taskDef)
+					Expect(tasksToAuction).To(ContainElement(&taskRequest))
+				})
+			})
+
+			Context("when tasks were rejected", func() {
+				BeforeEach(func() {
+					_, _, err := sqlDB.RejectTask(logger, "pending-task", "insufficient resources")
+					Expect(err).NotTo(HaveOccurred())
+					_, _, err = sqlDB.RejectTask(logger, "pending-task", "found no compatible cell")
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, err = sqlDB.RejectTask(logger, "pending-kickable-task", "insufficient resources")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("leaves them pending when there is no retry budget", func() {
+					task, err := sqlDB.TaskByGuid(logger, "pending-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+					Expect(task.RejectionCount).To(BeEquivalentTo(2))
+				})
+
+				Context("when a retry budget is given", func() {
+					BeforeEach(func() {
+						maxTaskRejections = 2
+					})
+
+					It("fails the tasks that spent it with the last rejection reason", func() {
+						task, err := sqlDB.TaskByGuid(logger, "pending-task")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(task.State).To(Equal(models.Task_Completed))
+						Expect(task.Failed).To(BeTrue())
+						Expect(task.FailureReason).To(Equal("task was rejected 2 times, last because: found no compatible cell"))
+						Expect(task.RejectionCount).To(BeEquivalentTo(2))
+						Expect(task.RejectionReason).To(Equal("found no compatible cell"))
+						Expect(task.FirstCompletedAt).To(Equal(fakeClock.Now().UnixNano()))
+					})
+
+					It("leaves the tasks within it pending", func() {
+						task, err := sqlDB.TaskByGuid(logger, "pending-kickable-task")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(task.State).To(Equal(models.Task_Pending))
+						Expect(task.RejectionCount).To(BeEquivalentTo(1))
+					})
+
+					Context("when convergence writes in batches", func() {
+						BeforeEach(func() {
+							_, _, err := sqlDB.RejectTask(logger, "pending-kickable-task", "insufficient resources")
+							Expect(err).NotTo(HaveOccurred())
+
+							convergingDB = sqldb.NewSQLDBWithOptions(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, 0, 0, "", sqldb.SQLDBOptions{ConvergenceBatchSize: 2})
+						})
+
+						It("fails each task with its own last rejection reason", func() {
+							task, err := sqlDB.TaskByGuid(logger, "pending-task")
+							Expect(err).NotTo(HaveOccurred())
+							Expect(task.State).To(Equal(models.Task_Completed))
+							Expect(task.FailureReason).To(Equal("task was rejected 2 times, last because: found no compatible cell"))
+
+							task, err = sqlDB.TaskByGuid(logger, "pending-kickable-task")
+							Expect(err).NotTo(HaveOccurred())
+							Expect(task.State).To(Equal(models.Task_Completed))
+							Expect(task.FailureReason).To(Equal("task was rejected 2 times, last because: insufficient resources"))
+						})
+					})
+				})
+			})
 		})
 
 		Context("running tasks", func() {
@@ -2,26 +2,72 @@ package sqldb
 
 import (
 	"database/sql"
+	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
 
-func (db *SQLDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition, taskGuid, domain string) error {
+// purgeTasksBatchSize bounds the number of task guids deleted by a single
+// statement when purging completed tasks.
+const purgeTasksBatchSize = 500
+
+func (db *SQLDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition, taskGuid, domain string) (*models.Task, error) {
 	logger = logger.Session("desire-task", lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	return db.desireTask(logger, db.db, taskDef, taskGuid, domain)
+}
+
+func (db *SQLDB) DesireTaskWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, taskDef *models.TaskDefinition, taskGuid, domain string) (*models.Task, bool, error) {
+	logger = logger.Session("desire-task", lager.Data{"task_guid": taskGuid, "idempotency_key": key.Key})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	var task *models.Task
+	var replayed bool
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		var err error
+		replayed, err = db.claimIdempotencyKey(logger, tx, key)
+		if err != nil || replayed {
+			return err
+		}
+
+		task, err = db.desireTask(logger, tx, taskDef, taskGuid, domain)
+		return err
+	})
+	if err != nil || replayed {
+		return nil, replayed, err
+	}
+
+	return task, false, nil
+}
+
+func (db *SQLDB) desireTask(logger lager.Logger, q Queryable, taskDef *models.TaskDefinition, taskGuid, domain string) (*models.Task, error) {
 	taskDefData, err := db.serializeModel(logger, taskDef)
 	if err != nil {
 		logger.Error("failed-serializing-task-definition", err)
-		return err
+		return nil, err
+	}
+
+	contentHash, err := taskDef.ContentHash()
+	if err != nil {
+		logger.Error("failed-hashing-task-definition", err)
+		return nil, err
 	}
 
 	now := db.clock.Now().UnixNano()
 
-	_, err = db.insert(logger, db.db, tasksTable,
+	_, err = db.insert(logger, q, tasksTable,
 		SQLAttributes{
 			"guid":               taskGuid,
 			"domain":             domain,
@@ -30,14 +76,76 @@ func (db *SQLDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition,
 			"first_completed_at": 0,
 			"state":              models.Task_Pending,
 			"task_definition":    taskDefData,
+			"content_hash":       contentHash,
 		},
 	)
 	if err != nil {
 		logger.Error("failed-inserting-task", err)
-		return db.convertSQLError(err)
+		return nil, db.convertSQLError(err)
 	}
 
-	return nil
+	return &models.Task{
+		TaskDefinition:   taskDef,
+		TaskGuid:         taskGuid,
+		Domain:           domain,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		FirstCompletedAt: 0,
+		State:            models.Task_Pending,
+		ContentHash:      contentHash,
+	}, nil
+}
+
+func (db *SQLDB) DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error) {
+	logger = logger.Session("duplicate-tasks", lager.Data{"domain": domain})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	query := fmt.Sprintf(`
+		SELECT content_hash, guid FROM %s
+		WHERE domain = ? AND content_hash IN (
+			SELECT content_hash FROM %s
+			WHERE domain = ? AND content_hash <> ''
+			GROUP BY content_hash
+			HAVING COUNT(*) > 1
+		)
+		ORDER BY content_hash, guid`,
+		db.table(tasksTable), db.table(tasksTable),
+	)
+
+	rows, err := db.db.Query(db.rebind(query), domain, domain)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	duplicates := []*models.DuplicateTasks{}
+	var current *models.DuplicateTasks
+	for rows.Next() {
+		var contentHash, guid string
+		err := rows.Scan(&contentHash, &guid)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+
+		if current == nil || current.ContentHash != contentHash {
+			current = &models.DuplicateTasks{ContentHash: contentHash}
+			duplicates = append(duplicates, current)
+		}
+		current.TaskGuids = append(current.TaskGuids, guid)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return duplicates, nil
 }
 
 func (db *SQLDB) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
@@ -45,6 +153,38 @@ func (db *SQLDB) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
+	results := []*models.Task{}
+	filter.PartialResults = false
+	_, err := db.streamTasks(logger, filter, func() bool { return true }, func(task *models.Task) error {
+		results = append(results, task)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (db *SQLDB) StreamTasks(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+	logger = logger.Session("stream-tasks", lager.Data{"filter": filter})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	// the read timeout only applies to the query, so that a slow consumer is
+	// not cut off while it reads the tasks
+	db, queried, cancel := db.withQueryTimeout(db.readTimeout)
+	defer cancel()
+
+	return db.streamTasks(logger, filter, queried, yield)
+}
+
+// streamTasks calls queried once the query has returned, and fails with
+// ErrTimeout if it reports that the query took too long.
+func (db *SQLDB) streamTasks(logger lager.Logger, filter models.TaskFilter, queried func() bool, yield func(*models.Task) error) ([]*models.RecordError, error) {
 	wheres := []string{}
 	values := []interface{}{}
 
@@ -58,24 +198,50 @@ func (db *SQLDB) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models
 		values = append(values, filter.CellID)
 	}
 
+	if len(filter.States) > 0 {
+		wheres = append(wheres, fmt.Sprintf("state IN (%s)", questionMarks(len(filter.States))))
+		for _, state := range filter.States {
+			values = append(values, state)
+		}
+	}
+
 	rows, err := db.all(logger, db.db, tasksTable,
 		taskColumns, NoLockRow,
 		strings.Join(wheres, " AND "), values...,
 	)
+	if !queried() {
+		if err == nil {
+			rows.Close()
+		}
+		logger.Error("failed-query", models.ErrTimeout)
+		return nil, models.ErrTimeout
+	}
 	if err != nil {
 		logger.Error("failed-query", err)
 		return nil, db.convertSQLError(err)
 	}
 	defer rows.Close()
 
-	results := []*models.Task{}
+	var recordErrors []*models.RecordError
 	for rows.Next() {
-		task, err := db.fetchTask(logger, rows, db.db)
+		guid, task, err := db.fetchTaskRecord(logger, rows, db.db)
+		if err == errRecordSkipped {
+			continue
+		}
+		if err != nil && filter.PartialResults && isUnreadableRecordError(err) {
+			logger.Info("leaving-out-unreadable-task", lager.Data{"guid": guid, "reason": err.Error()})
+			recordErrors = append(recordErrors, &models.RecordError{Guid: guid, Reason: err.Error()})
+			continue
+		}
 		if err != nil {
 			logger.Error("failed-fetch", err)
 			return nil, err
 		}
-		results = append(results, task)
+
+		err = yield(task)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if rows.Err() != nil {
@@ -83,7 +249,7 @@ func (db *SQLDB) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models
 		return nil, db.convertSQLError(rows.Err())
 	}
 
-	return results, nil
+	return recordErrors, nil
 }
 
 func (db *SQLDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
@@ -91,6 +257,9 @@ func (db *SQLDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task,
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
+	db, cancel := db.withTimeout(db.readTimeout)
+	defer cancel()
+
 	row := db.one(logger, db.db, tasksTable,
 		taskColumns, NoLockRow,
 		"guid = ?", taskGuid,
@@ -98,17 +267,23 @@ func (db *SQLDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task,
 	return db.fetchTask(logger, row, db.db)
 }
 
-func (db *SQLDB) StartTask(logger lager.Logger, taskGuid, cellId string) (bool, error) {
+func (db *SQLDB) StartTask(logger lager.Logger, taskGuid, cellId string) (*models.Task, *models.Task, bool, error) {
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
 	logger = logger.Session("start-task", lager.Data{"task_guid": taskGuid, "cell_id": cellId})
 
+	var beforeTask, task *models.Task
 	var started bool
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		task, err := db.fetchTaskForUpdate(logger, taskGuid, tx)
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		var err error
+		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
+		beforeTask = task.Copy()
 
 		if task.State == models.Task_Running && task.CellId == cellId {
 			logger.Debug("task-already-running-on-cell")
@@ -135,28 +310,38 @@ func (db *SQLDB) StartTask(logger lager.Logger, taskGuid, cellId string) (bool,
 			return db.convertSQLError(err)
 		}
 
+		task.State = models.Task_Running
+		task.UpdatedAt = now
+		task.CellId = cellId
 		started = true
 		return nil
 	})
+	if err != nil {
+		return nil, nil, false, err
+	}
 
-	return started, err
+	return beforeTask, task, started, nil
 }
 
-func (db *SQLDB) CancelTask(logger lager.Logger, taskGuid string) (*models.Task, string, error) {
+func (db *SQLDB) CancelTask(logger lager.Logger, taskGuid string) (*models.Task, *models.Task, string, error) {
 	logger = logger.Session("cancel-task", lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	var task *models.Task
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	var beforeTask, task *models.Task
 	var cellID string
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		var err error
 		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
+		beforeTask = task.Copy()
 
 		cellID = task.CellId
 
@@ -168,24 +353,145 @@ func (db *SQLDB) CancelTask(logger lager.Logger, taskGuid string) (*models.Task,
 		}
 		return db.completeTask(logger, task, true, "task was cancelled", "", tx)
 	})
+	if err != nil {
+		return nil, nil, "", err
+	}
 
-	return task, cellID, err
+	return beforeTask, task, cellID, nil
 }
 
-func (db *SQLDB) CompleteTask(logger lager.Logger, taskGuid, cellID string, failed bool, failureReason, taskResult string) (*models.Task, error) {
+func (db *SQLDB) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error) {
+	logger = logger.Session("cancel-tasks", lager.Data{"domain": domain, "task_guids": taskGuids})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	wheres := []string{}
+	values := []interface{}{}
+
+	if domain != "" {
+		wheres = append(wheres, "domain = ?")
+		values = append(values, domain)
+	}
+
+	if len(taskGuids) > 0 {
+		wheres = append(wheres, fmt.Sprintf("guid IN (%s)", questionMarks(len(taskGuids))))
+		for _, guid := range taskGuids {
+			values = append(values, guid)
+		}
+	}
+
+	var result *models.CancelTasksResult
+
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		result = &models.CancelTasksResult{}
+
+		rows, err := db.all(logger, tx, tasksTable,
+			taskColumns, LockRow,
+			strings.Join(wheres, " AND "), values...,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return db.convertSQLError(err)
+		}
+
+		tasks := []*models.Task{}
+		for rows.Next() {
+			task, err := db.fetchTask(logger, rows, tx)
+			if err != nil {
+				logger.Error("failed-fetch", err)
+				rows.Close()
+				return err
+			}
+			tasks = append(tasks, task)
+		}
+		rows.Close()
+
+		if rows.Err() != nil {
+			logger.Error("failed-getting-next-row", rows.Err())
+			return db.convertSQLError(rows.Err())
+		}
+
+		found := map[string]bool{}
+		cancelledGuids := []interface{}{}
+		for _, task := range tasks {
+			found[task.TaskGuid] = true
+			if !task.Cancellable() {
+				result.NotCancellable = append(result.NotCancellable, task.TaskGuid)
+				continue
+			}
+			result.Cancelled = append(result.Cancelled, models.CancelledTask{Before: task.Copy(), Task: task, CellID: task.CellId})
+			cancelledGuids = append(cancelledGuids, task.TaskGuid)
+		}
+
+		for _, guid := range taskGuids {
+			if !found[guid] {
+				result.NotFound = append(result.NotFound, guid)
+			}
+		}
+
+		if len(cancelledGuids) == 0 {
+			return nil
+		}
+
+		now := db.clock.Now().UnixNano()
+		_, err = db.update(logger, tx, tasksTable,
+			SQLAttributes{
+				"failed":             true,
+				"failure_reason":     "task was cancelled",
+				"result":             "",
+				"state":              models.Task_Completed,
+				"first_completed_at": now,
+				"updated_at":         now,
+				"cell_id":            "",
+			},
+			fmt.Sprintf("guid IN (%s)", questionMarks(len(cancelledGuids))), cancelledGuids...,
+		)
+		if err != nil {
+			logger.Error("failed-updating-tasks", err)
+			return db.convertSQLError(err)
+		}
+
+		for _, cancelled := range result.Cancelled {
+			task := cancelled.Task
+			task.State = models.Task_Completed
+			task.UpdatedAt = now
+			task.FirstCompletedAt = now
+			task.Failed = true
+			task.FailureReason = "task was cancelled"
+			task.Result = ""
+			task.CellId = ""
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (db *SQLDB) CompleteTask(logger lager.Logger, taskGuid, cellID string, failed bool, failureReason, taskResult string) (*models.Task, *models.Task, error) {
 	logger = logger.Session("complete-task", lager.Data{"task_guid": taskGuid, "cell_id": cellID})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	var task *models.Task
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	var beforeTask, task *models.Task
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		var err error
 		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
+		beforeTask = task.Copy()
 
 		if task.CellId != cellID && task.State == models.Task_Running {
 			logger.Error("failed-task-already-running-on-different-cell", err)
@@ -199,24 +505,31 @@ func (db *SQLDB) CompleteTask(logger lager.Logger, taskGuid, cellID string, fail
 
 		return db.completeTask(logger, task, failed, failureReason, taskResult, tx)
 	})
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return task, err
+	return beforeTask, task, nil
 }
 
-func (db *SQLDB) FailTask(logger lager.Logger, taskGuid, failureReason string) (*models.Task, error) {
+func (db *SQLDB) FailTask(logger lager.Logger, taskGuid, failureReason string) (*models.Task, *models.Task, error) {
 	logger = logger.Session("fail-task", lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	var task *models.Task
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	var beforeTask, task *models.Task
+
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
 		var err error
 		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
+		beforeTask = task.Copy()
 
 		if err = task.ValidateTransitionTo(models.Task_Completed); err != nil {
 			if task.State != models.Task_Pending {
@@ -227,23 +540,85 @@ func (db *SQLDB) FailTask(logger lager.Logger, taskGuid, failureReason string) (
 
 		return db.completeTask(logger, task, true, failureReason, "", tx)
 	})
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return task, err
+	return beforeTask, task, nil
+}
+
+func (db *SQLDB) RejectTask(logger lager.Logger, taskGuid, rejectionReason string) (*models.Task, *models.Task, error) {
+	logger = logger.Session("reject-task", lager.Data{"task_guid": taskGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	var beforeTask, task *models.Task
+
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		var err error
+		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
+		if err != nil {
+			logger.Error("failed-locking-task", err)
+			return err
+		}
+		beforeTask = task.Copy()
+
+		if task.State != models.Task_Pending {
+			err = models.NewTaskTransitionError(task.State, models.Task_Pending)
+			logger.Error("failed-to-reject-task", err)
+			return err
+		}
+
+		now := db.clock.Now().UnixNano()
+		rejectionReason = truncateString(rejectionReason, 1024)
+		_, err = db.update(logger, tx, tasksTable,
+			SQLAttributes{
+				"rejection_count":  task.RejectionCount + 1,
+				"rejection_reason": rejectionReason,
+				"updated_at":       now,
+			},
+			"guid = ?", taskGuid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-tasks", err)
+			return db.convertSQLError(err)
+		}
+
+		task.RejectionCount++
+		task.RejectionReason = rejectionReason
+		task.UpdatedAt = now
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beforeTask, task, nil
 }
 
 // The stager calls this when it wants to claim a completed task.  This ensures that only one
 // stager ever attempts to handle a completed task
-func (db *SQLDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
+func (db *SQLDB) ResolvingTask(logger lager.Logger, taskGuid string) (*models.Task, *models.Task, error) {
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		task, err := db.fetchTaskForUpdate(logger, taskGuid, tx)
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	var beforeTask, task *models.Task
+
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		var err error
+		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
+		beforeTask = task.Copy()
 
 		if err = task.ValidateTransitionTo(models.Task_Resolving); err != nil {
 			logger.Error("invalid-state-transition", err)
@@ -263,17 +638,30 @@ func (db *SQLDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
 			return db.convertSQLError(err)
 		}
 
+		task.State = models.Task_Resolving
+		task.UpdatedAt = now
 		return nil
 	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beforeTask, task, nil
 }
 
-func (db *SQLDB) DeleteTask(logger lager.Logger, taskGuid string) error {
+func (db *SQLDB) DeleteTask(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	logger = logger.Session("delete-task", lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		task, err := db.fetchTaskForUpdate(logger, taskGuid, tx)
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	var task *models.Task
+
+	err := db.transact(logger, func(logger lager.Logger, tx Queryable) error {
+		var err error
+		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
@@ -293,9 +681,89 @@ func (db *SQLDB) DeleteTask(logger lager.Logger, taskGuid string) error {
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
 }
 
-func (db *SQLDB) completeTask(logger lager.Logger, task *models.Task, failed bool, failureReason, result string, tx *sql.Tx) error {
+func (db *SQLDB) PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error) {
+	logger = logger.Session("purge-completed-tasks", lager.Data{"min_age": minAge.String()})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	db, cancel := db.withTimeout(db.writeTimeout)
+	defer cancel()
+
+	wheres := "state IN (?, ?) AND first_completed_at < ?"
+	bindings := []interface{}{models.Task_Completed, models.Task_Resolving, db.clock.Now().Add(-minAge).UnixNano()}
+
+	rows, err := db.all(logger, db.db, tasksTable, taskColumns, NoLockRow, wheres, bindings...)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	// The completion callback URL is only stored in the task definition, so
+	// tasks whose callback is pending are filtered out here rather than in
+	// the DELETE.
+	taskGuids := []string{}
+	for rows.Next() {
+		task, err := db.fetchTask(logger, rows, db.db)
+		if err != nil {
+			logger.Error("failed-fetch", err)
+			continue
+		}
+
+		if task.CompletionCallbackUrl != "" {
+			continue
+		}
+		taskGuids = append(taskGuids, task.TaskGuid)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		logger.Error("failed-getting-next-row", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	purged := 0
+	for start := 0; start < len(taskGuids); start += purgeTasksBatchSize {
+		end := start + purgeTasksBatchSize
+		if end > len(taskGuids) {
+			end = len(taskGuids)
+		}
+
+		values := append([]interface{}{}, bindings...)
+		for _, taskGuid := range taskGuids[start:end] {
+			values = append(values, taskGuid)
+		}
+
+		// The state and completion time are checked again in case a task was
+		// deleted and desired anew since it was read.
+		result, err := db.delete(logger, db.db, tasksTable,
+			wheres+fmt.Sprintf(" AND guid IN (%s)", questionMarks(end-start)),
+			values...,
+		)
+		if err != nil {
+			logger.Error("failed-deleting-tasks", err)
+			return purged, db.convertSQLError(err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("failed-rows-affected", err)
+			return purged, db.convertSQLError(err)
+		}
+		purged += int(rowsAffected)
+	}
+
+	logger.Info("purged-tasks", lager.Data{"count": purged})
+	return purged, nil
+}
+
+func (db *SQLDB) completeTask(logger lager.Logger, task *models.Task, failed bool, failureReason, result string, tx Queryable) error {
 	now := db.clock.Now().UnixNano()
 	_, err := db.update(logger, tx, tasksTable,
 		SQLAttributes{
@@ -325,7 +793,7 @@ func (db *SQLDB) completeTask(logger lager.Logger, task *models.Task, failed boo
 	return nil
 }
 
-func (db *SQLDB) fetchTaskForUpdate(logger lager.Logger, taskGuid string, tx *sql.Tx) (*models.Task, error) {
+func (db *SQLDB) fetchTaskForUpdate(logger lager.Logger, taskGuid string, tx Queryable) (*models.Task, error) {
 	row := db.one(logger, tx, tasksTable,
 		taskColumns, LockRow,
 		"guid = ?", taskGuid,
@@ -334,10 +802,43 @@ func (db *SQLDB) fetchTaskForUpdate(logger lager.Logger, taskGuid string, tx *sq
 }
 
 func (db *SQLDB) fetchTask(logger lager.Logger, scanner RowScanner, tx Queryable) (*models.Task, error) {
-	var guid, domain, cellID, failureReason string
+	_, task, err := db.fetchTaskRecord(logger, scanner, tx)
+	return task, err
+}
+
+// fetchTaskRecord also returns the guid of the task, when the row could be
+// scanned, so that a task that cannot be read can be reported.
+func (db *SQLDB) fetchTaskRecord(logger lager.Logger, scanner RowScanner, tx Queryable) (string, *models.Task, error) {
+	guid, task, scanErr, taskDefErr := db.scanTaskRecord(logger, scanner)
+	if scanErr != nil {
+		return "", nil, scanErr
+	}
+
+	err := db.quarantineRowByGuid(logger, tx, taskDefErr, tasksTable, "guid")
+	if isMissingKeyError(err) {
+		return guid, nil, err
+	}
+	if err != nil {
+		logger.Info("deleting-malformed-task-from-db", lager.Data{"guid": guid})
+		_, deleteErr := db.delete(logger, tx, tasksTable, "guid = ?", guid)
+		if deleteErr != nil {
+			logger.Error("failed-deleting-task", deleteErr)
+			return guid, nil, db.convertSQLError(deleteErr)
+		}
+		return guid, nil, models.ErrDeserialize
+	}
+	return guid, task, nil
+}
+
+// scanTaskRecord scans and deserializes a task row without writing to the
+// database. A row that cannot be scanned is returned as scanErr and a task
+// definition that cannot be deserialized as taskDefErr; fetchTaskRecord
+// decides what happens to the row in the latter case.
+func (db *SQLDB) scanTaskRecord(logger lager.Logger, scanner RowScanner) (guid string, task *models.Task, scanErr, taskDefErr error) {
+	var domain, cellID, failureReason, rejectionReason, contentHash string
 	var result sql.NullString
 	var createdAt, updatedAt, firstCompletedAt int64
-	var state int32
+	var state, rejectionCount int32
 	var failed bool
 	var taskDefData []byte
 
@@ -353,25 +854,25 @@ func (db *SQLDB) fetchTask(logger lager.Logger, scanner RowScanner, tx Queryable
 		&failed,
 		&failureReason,
 		&taskDefData,
+		&rejectionCount,
+		&rejectionReason,
+		&contentHash,
 	)
 	if err != nil {
 		logger.Error("failed-scanning-row", err)
-		return nil, models.ErrResourceNotFound
+		if db.convertSQLError(err) == models.ErrTimeout {
+			return "", nil, models.ErrTimeout, nil
+		}
+		return "", nil, models.ErrResourceNotFound, nil
 	}
 
 	var taskDef models.TaskDefinition
-	err = db.deserializeModel(logger, taskDefData, &taskDef)
+	err = db.deserializeModel(logger, guid, taskDefData, &taskDef)
 	if err != nil {
-		logger.Info("deleting-malformed-task-from-db", lager.Data{"guid": guid})
-		_, err = db.delete(logger, tx, tasksTable, "guid = ?", guid)
-		if err != nil {
-			logger.Error("failed-deleting-task", err)
-			return nil, db.convertSQLError(err)
-		}
-		return nil, models.ErrDeserialize
+		return guid, nil, nil, err
 	}
 
-	task := &models.Task{
+	task = &models.Task{
 		TaskGuid:         guid,
 		Domain:           domain,
 		CreatedAt:        createdAt,
@@ -382,7 +883,10 @@ func (db *SQLDB) fetchTask(logger lager.Logger, scanner RowScanner, tx Queryable
 		Result:           result.String,
 		Failed:           failed,
 		FailureReason:    failureReason,
+		RejectionCount:   rejectionCount,
+		RejectionReason:  rejectionReason,
+		ContentHash:      contentHash,
 		TaskDefinition:   &taskDef,
 	}
-	return task, nil
+	return guid, task, nil, nil
 }
@@ -2,6 +2,7 @@ package sqldb_test
 
 import (
 	"database/sql"
+	"errors"
 	"time"
 
 	"code.cloudfoundry.org/bbs/format"
@@ -22,7 +23,7 @@ var _ = Describe("TaskDB", func() {
 		)
 
 		JustBeforeEach(func() {
-			errDesire = sqlDB.DesireTask(logger, taskDef, taskGuid, taskDomain)
+			_, errDesire = sqlDB.DesireTask(logger, taskDef, taskGuid, taskDomain)
 		})
 
 		BeforeEach(func() {
@@ -83,12 +84,27 @@ var _ = Describe("TaskDB", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(actualTaskDef).To(Equal(*taskDef))
 			})
+
+			Context("when the task definition has extension fields", func() {
+				BeforeEach(func() {
+					taskDef.ExtensionFields = map[string][]byte{
+						"example.com/json":   []byte(`{"replicas":3}`),
+						"example.com/binary": {0x00, 0xff, 0x80},
+					}
+				})
+
+				It("stores them unchanged", func() {
+					persistedTask, err := sqlDB.TaskByGuid(logger, taskGuid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(persistedTask.ExtensionFields).To(Equal(taskDef.ExtensionFields))
+				})
+			})
 		})
 
 		Context("when a task is already present with the desired task guid", func() {
 			BeforeEach(func() {
 				otherDomain := "my-other-domain"
-				err := sqlDB.DesireTask(logger, taskDef, taskGuid, otherDomain)
+				_, err := sqlDB.DesireTask(logger, taskDef, taskGuid, otherDomain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -122,6 +138,7 @@ var _ = Describe("TaskDB", func() {
 				task3 := model_helpers.NewValidTask("c-guid")
 				task3.Domain = "domain-2"
 				task3.CellId = "cell-1"
+				task3.State = models.Task_Running
 				expectedTasks = []*models.Task{task1, task2, task3}
 
 				for _, t := range expectedTasks {
@@ -155,6 +172,26 @@ var _ = Describe("TaskDB", func() {
 				Expect(tasks).To(HaveLen(1))
 				Expect(tasks[0]).To(Equal(expectedTasks[2]))
 			})
+
+			It("can filter by cell id and states", func() {
+				tasks, err := sqlDB.Tasks(logger, models.TaskFilter{CellID: "cell-1", States: []models.Task_State{models.Task_Running}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal([]*models.Task{expectedTasks[2]}))
+
+				tasks, err = sqlDB.Tasks(logger, models.TaskFilter{CellID: "cell-1", States: []models.Task_State{models.Task_Pending, models.Task_Running}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(ConsistOf(expectedTasks[0], expectedTasks[2]))
+			})
+
+			It("returns an empty list when no task matches", func() {
+				tasks, err := sqlDB.Tasks(logger, models.TaskFilter{CellID: "cell-3"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(BeEmpty())
+
+				tasks, err = sqlDB.Tasks(logger, models.TaskFilter{CellID: "cell-2", States: []models.Task_State{models.Task_Running}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(BeEmpty())
+			})
 		})
 
 		Context("when there are no tasks", func() {
@@ -179,6 +216,79 @@ var _ = Describe("TaskDB", func() {
 		})
 	})
 
+	Describe("StreamTasks", func() {
+		var expectedTasks []*models.Task
+
+		BeforeEach(func() {
+			task1 := model_helpers.NewValidTask("a-guid")
+			task1.Domain = "domain-1"
+			task2 := model_helpers.NewValidTask("b-guid")
+			task2.Domain = "domain-2"
+			expectedTasks = []*models.Task{task1, task2}
+
+			for _, t := range expectedTasks {
+				insertTask(db, serializer, t, false)
+			}
+		})
+
+		It("yields each task matching the filter", func() {
+			tasks := []*models.Task{}
+			_, err := sqlDB.StreamTasks(logger, models.TaskFilter{Domain: "domain-2"}, func(task *models.Task) error {
+				tasks = append(tasks, task)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(ConsistOf(expectedTasks[1]))
+		})
+
+		Context("when yield returns an error", func() {
+			It("stops and returns the error", func() {
+				calls := 0
+				_, err := sqlDB.StreamTasks(logger, models.TaskFilter{}, func(task *models.Task) error {
+					calls++
+					return errors.New("boom")
+				})
+				Expect(err).To(MatchError("boom"))
+				Expect(calls).To(Equal(1))
+			})
+		})
+
+		Context("when a task cannot be read", func() {
+			BeforeEach(func() {
+				task := model_helpers.NewValidTask("c-guid")
+				task.Domain = "domain-2"
+				insertTask(db, serializer, task, true)
+			})
+
+			It("errors", func() {
+				_, err := sqlDB.StreamTasks(logger, models.TaskFilter{}, func(task *models.Task) error {
+					return nil
+				})
+				Expect(err).To(HaveOccurred())
+			})
+
+			Context("and partial results are requested", func() {
+				It("yields the other tasks and returns a record error for it", func() {
+					tasks := []*models.Task{}
+					recordErrors, err := sqlDB.StreamTasks(logger, models.TaskFilter{Domain: "domain-2", PartialResults: true}, func(task *models.Task) error {
+						tasks = append(tasks, task)
+						return nil
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(tasks).To(ConsistOf(expectedTasks[1]))
+					Expect(recordErrors).To(HaveLen(1))
+					Expect(recordErrors[0].Guid).To(Equal("c-guid"))
+					Expect(recordErrors[0].Reason).NotTo(BeEmpty())
+				})
+
+				It("still fails the whole list from Tasks", func() {
+					_, err := sqlDB.Tasks(logger, models.TaskFilter{PartialResults: true})
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+	})
+
 	Describe("TaskByGuid", func() {
 		Context("when there is a task", func() {
 			var expectedTask *models.Task
@@ -195,6 +305,24 @@ var _ = Describe("TaskDB", func() {
 			})
 		})
 
+		Context("when the task is resolving", func() {
+			var expectedTask *models.Task
+
+			BeforeEach(func() {
+				expectedTask = model_helpers.NewValidTask("resolving-task-guid")
+				expectedTask.State = models.Task_Resolving
+				expectedTask.Result = "some-result"
+				insertTask(db, serializer, expectedTask, false)
+			})
+
+			It("returns the task in its current state", func() {
+				task, err := sqlDB.TaskByGuid(logger, "resolving-task-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task).To(Equal(expectedTask))
+				Expect(task.State).To(Equal(models.Task_Resolving))
+			})
+		})
+
 		Context("when there is no task", func() {
 			It("returns a ResourceNotFound", func() {
 				_, err := sqlDB.TaskByGuid(logger, "nota-guid")
@@ -222,7 +350,7 @@ var _ = Describe("TaskDB", func() {
 
 		BeforeEach(func() {
 			expectedTask = model_helpers.NewValidTask("task-guid")
-			err := sqlDB.DesireTask(logger, expectedTask.TaskDefinition, expectedTask.TaskGuid, expectedTask.Domain)
+			_, err := sqlDB.DesireTask(logger, expectedTask.TaskDefinition, expectedTask.TaskGuid, expectedTask.Domain)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -237,12 +365,14 @@ var _ = Describe("TaskDB", func() {
 
 			expectedTask.CellId = "expectedCellId"
 
-			started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, expectedTask.CellId)
+			before, after, started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, expectedTask.CellId)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(started).To(BeTrue())
 
 			task, err := sqlDB.TaskByGuid(logger, expectedTask.TaskGuid)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(before).To(Equal(beforeTask))
+			Expect(after).To(Equal(task))
 
 			Expect(task.TaskGuid).To(Equal(expectedTask.TaskGuid))
 			Expect(task.State).To(Equal(models.Task_Running))
@@ -253,7 +383,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the cell id is toooooo long", func() {
 			It("returns a BadRequest error", func() {
-				started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, randStr(256))
+				_, _, started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, randStr(256))
 				Expect(err).To(Equal(models.ErrBadRequest))
 				Expect(started).To(BeFalse())
 			})
@@ -261,7 +391,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("When starting a Task that is already started", func() {
 			BeforeEach(func() {
-				started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "cell-id")
+				_, _, started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "cell-id")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(started).To(BeTrue())
 			})
@@ -270,7 +400,7 @@ var _ = Describe("TaskDB", func() {
 				It("returns shouldStart as false", func() {
 					fakeClock.IncrementBySeconds(1)
 
-					changed, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "cell-id")
+					_, _, changed, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "cell-id")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(changed).To(BeFalse())
 
@@ -284,7 +414,7 @@ var _ = Describe("TaskDB", func() {
 				It("returns an error", func() {
 					fakeClock.IncrementBySeconds(1)
 
-					_, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "some-other-cell")
+					_, _, _, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "some-other-cell")
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -298,7 +428,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("returns an error", func() {
-				started, err := sqlDB.StartTask(logger, "invalid-guid", "cell-id")
+				_, _, started, err := sqlDB.StartTask(logger, "invalid-guid", "cell-id")
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 				Expect(started).To(BeFalse())
 			})
@@ -313,7 +443,7 @@ var _ = Describe("TaskDB", func() {
 			})
 
 			It("returns an invalid state transition", func() {
-				started, err := sqlDB.StartTask(logger, "task-other-guid", "completed-guid")
+				_, _, started, err := sqlDB.StartTask(logger, "task-other-guid", "completed-guid")
 				modelErr := models.ConvertError(err)
 				Expect(modelErr).NotTo(BeNil())
 				Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -340,7 +470,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task is pending", func() {
 			BeforeEach(func() {
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -348,7 +478,7 @@ var _ = Describe("TaskDB", func() {
 				fakeClock.Increment(time.Second)
 				now := fakeClock.Now().UnixNano()
 
-				task, cellID, err := sqlDB.CancelTask(logger, taskGuid)
+				_, task, cellID, err := sqlDB.CancelTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(task.State).To(Equal(models.Task_Completed))
@@ -366,7 +496,7 @@ var _ = Describe("TaskDB", func() {
 
 				BeforeEach(func() {
 					anotherTaskGuid := "the-other-task-guid"
-					err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+					_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 					Expect(err).NotTo(HaveOccurred())
 
 					anotherTask, err = sqlDB.TaskByGuid(logger, anotherTaskGuid)
@@ -377,7 +507,7 @@ var _ = Describe("TaskDB", func() {
 					fakeClock.Increment(time.Second)
 					now := fakeClock.Now().UnixNano()
 
-					task, _, err := sqlDB.CancelTask(logger, taskGuid)
+					_, task, _, err := sqlDB.CancelTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(task.State).To(Equal(models.Task_Completed))
@@ -392,10 +522,10 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task is running", func() {
 			BeforeEach(func() {
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 
-				started, err := sqlDB.StartTask(logger, taskGuid, "the-cell")
+				_, _, started, err := sqlDB.StartTask(logger, taskGuid, "the-cell")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(started).To(BeTrue())
 			})
@@ -404,9 +534,11 @@ var _ = Describe("TaskDB", func() {
 				fakeClock.Increment(time.Second)
 				now := fakeClock.Now().UnixNano()
 
-				task, cellID, err := sqlDB.CancelTask(logger, taskGuid)
+				before, task, cellID, err := sqlDB.CancelTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 
+				Expect(before.State).To(Equal(models.Task_Running))
+				Expect(before.CellId).To(Equal("the-cell"))
 				Expect(task.State).To(Equal(models.Task_Completed))
 				Expect(task.UpdatedAt).To(Equal(now))
 				Expect(task.FirstCompletedAt).To(Equal(now))
@@ -422,10 +554,10 @@ var _ = Describe("TaskDB", func() {
 			var beforeTask *models.Task
 
 			BeforeEach(func() {
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, _, err = sqlDB.CancelTask(logger, taskGuid)
+				_, _, _, err = sqlDB.CancelTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 
 				beforeTask, err = sqlDB.TaskByGuid(logger, taskGuid)
@@ -433,7 +565,7 @@ var _ = Describe("TaskDB", func() {
 			})
 
 			It("returns an InvalidStateTransition error", func() {
-				_, _, err := sqlDB.CancelTask(logger, taskGuid)
+				_, _, _, err := sqlDB.CancelTask(logger, taskGuid)
 				modelErr := models.ConvertError(err)
 				Expect(modelErr).NotTo(BeNil())
 				Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -454,7 +586,7 @@ var _ = Describe("TaskDB", func() {
 			})
 
 			It("returns an InvalidStateTransition error", func() {
-				_, _, err := sqlDB.CancelTask(logger, taskGuid)
+				_, _, _, err := sqlDB.CancelTask(logger, taskGuid)
 				modelErr := models.ConvertError(err)
 				Expect(modelErr).NotTo(BeNil())
 				Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -467,12 +599,172 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("returns an InvalidStateTransition error", func() {
-				_, _, err := sqlDB.CancelTask(logger, taskGuid)
+				_, _, _, err := sqlDB.CancelTask(logger, taskGuid)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
 	})
 
+	Describe("CancelTasks", func() {
+		var (
+			result      *models.CancelTasksResult
+			cancelError error
+		)
+
+		BeforeEach(func() {
+			taskDef := model_helpers.NewValidTaskDefinition()
+
+			_, err := sqlDB.DesireTask(logger, taskDef, "pending-task", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(logger, taskDef, "running-task", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = sqlDB.StartTask(logger, "running-task", "the-cell")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(logger, taskDef, "completed-task", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = sqlDB.StartTask(logger, "completed-task", "the-cell")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.CompleteTask(logger, "completed-task", "the-cell", false, "", "result")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(logger, taskDef, "other-domain-task", "other-domain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		cancelledGuids := func() []string {
+			guids := []string{}
+			for _, cancelled := range result.Cancelled {
+				guids = append(guids, cancelled.Task.TaskGuid)
+			}
+			return guids
+		}
+
+		Context("by domain", func() {
+			JustBeforeEach(func() {
+				result, cancelError = sqlDB.CancelTasks(logger, "the-domain", nil)
+			})
+
+			It("cancels the pending and running tasks in the domain", func() {
+				Expect(cancelError).NotTo(HaveOccurred())
+				Expect(cancelledGuids()).To(ConsistOf("pending-task", "running-task"))
+				Expect(result.NotCancellable).To(ConsistOf("completed-task"))
+				Expect(result.NotFound).To(BeEmpty())
+
+				for _, guid := range []string{"pending-task", "running-task"} {
+					task, err := sqlDB.TaskByGuid(logger, guid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.Failed).To(BeTrue())
+					Expect(task.FailureReason).To(Equal("task was cancelled"))
+					Expect(task.CellId).To(BeEmpty())
+				}
+			})
+
+			It("returns the cell each running task was on", func() {
+				for _, cancelled := range result.Cancelled {
+					if cancelled.Task.TaskGuid == "running-task" {
+						Expect(cancelled.CellID).To(Equal("the-cell"))
+					} else {
+						Expect(cancelled.CellID).To(BeEmpty())
+					}
+				}
+			})
+
+			It("does not touch the completed task or tasks in other domains", func() {
+				task, err := sqlDB.TaskByGuid(logger, "completed-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.Failed).To(BeFalse())
+				Expect(task.Result).To(Equal("result"))
+
+				task, err = sqlDB.TaskByGuid(logger, "other-domain-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+			})
+		})
+
+		Context("by task guids", func() {
+			JustBeforeEach(func() {
+				result, cancelError = sqlDB.CancelTasks(logger, "", []string{"running-task", "completed-task", "missing-task", "other-domain-task"})
+			})
+
+			It("reports cancelled, not cancellable and not found tasks", func() {
+				Expect(cancelError).NotTo(HaveOccurred())
+				Expect(cancelledGuids()).To(ConsistOf("running-task", "other-domain-task"))
+				Expect(result.NotCancellable).To(ConsistOf("completed-task"))
+				Expect(result.NotFound).To(ConsistOf("missing-task"))
+			})
+
+			It("does not cancel tasks that were not requested", func() {
+				task, err := sqlDB.TaskByGuid(logger, "pending-task")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+			})
+		})
+
+		Context("by domain and task guids", func() {
+			JustBeforeEach(func() {
+				result, cancelError = sqlDB.CancelTasks(logger, "the-domain", []string{"pending-task", "other-domain-task"})
+			})
+
+			It("only cancels requested tasks in the domain", func() {
+				Expect(cancelError).NotTo(HaveOccurred())
+				Expect(cancelledGuids()).To(ConsistOf("pending-task"))
+				Expect(result.NotFound).To(ConsistOf("other-domain-task"))
+			})
+		})
+	})
+
+	Describe("DuplicateTasks", func() {
+		BeforeEach(func() {
+			taskDef := model_helpers.NewValidTaskDefinition()
+
+			otherTaskDef := model_helpers.NewValidTaskDefinition()
+			otherTaskDef.MemoryMb = 2048
+
+			_, err := sqlDB.DesireTask(logger, taskDef, "task-b", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(logger, taskDef, "task-a", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(logger, otherTaskDef, "other-task", "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(logger, taskDef, "other-domain-task", "other-domain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records the content hash of the task definition", func() {
+			expectedHash, err := model_helpers.NewValidTaskDefinition().ContentHash()
+			Expect(err).NotTo(HaveOccurred())
+
+			task, err := sqlDB.TaskByGuid(logger, "task-a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.ContentHash).To(Equal(expectedHash))
+		})
+
+		It("groups tasks in the domain that share a content hash", func() {
+			expectedHash, err := model_helpers.NewValidTaskDefinition().ContentHash()
+			Expect(err).NotTo(HaveOccurred())
+
+			duplicates, err := sqlDB.DuplicateTasks(logger, "the-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duplicates).To(HaveLen(1))
+			Expect(duplicates[0].ContentHash).To(Equal(expectedHash))
+			Expect(duplicates[0].TaskGuids).To(Equal([]string{"task-a", "task-b"}))
+		})
+
+		Context("when the domain has no duplicate tasks", func() {
+			It("returns no groups", func() {
+				duplicates, err := sqlDB.DuplicateTasks(logger, "other-domain")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(duplicates).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("CompleteTask", func() {
 		var (
 			taskGuid, taskDomain, cellID string
@@ -496,10 +788,10 @@ var _ = Describe("TaskDB", func() {
 
 			Context("when the task is running", func() {
 				BeforeEach(func() {
-					err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+					_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 					Expect(err).NotTo(HaveOccurred())
 
-					started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+					_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(started).To(BeTrue())
 				})
@@ -510,7 +802,7 @@ var _ = Describe("TaskDB", func() {
 						nowTruncateMicroseconds := fakeClock.Now()
 						now := fakeClock.Now()
 
-						task, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "i am the result")
+						_, task, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "i am the result")
 						Expect(err).NotTo(HaveOccurred())
 
 						Expect(task.State).To(Equal(models.Task_Completed))
@@ -535,7 +827,7 @@ var _ = Describe("TaskDB", func() {
 
 					Context("with an invalid failure reason", func() {
 						It("returns an error and does not update the record", func() {
-							_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, randStr(256), "i am the result")
+							_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, randStr(256), "i am the result")
 							Expect(err).To(Equal(models.ErrBadRequest))
 						})
 					})
@@ -545,10 +837,10 @@ var _ = Describe("TaskDB", func() {
 
 						BeforeEach(func() {
 							anotherTaskGuid := "another-task-guid"
-							err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+							_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 							Expect(err).NotTo(HaveOccurred())
 
-							started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
+							_, _, started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
 							Expect(err).NotTo(HaveOccurred())
 							Expect(started).To(BeTrue())
 
@@ -557,7 +849,7 @@ var _ = Describe("TaskDB", func() {
 						})
 
 						It("only updates the task with the corresponding guid", func() {
-							_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "i am the result")
+							_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "i am the result")
 							Expect(err).NotTo(HaveOccurred())
 
 							task, err := sqlDB.TaskByGuid(logger, anotherTask.TaskGuid)
@@ -569,7 +861,7 @@ var _ = Describe("TaskDB", func() {
 
 				Context("on a different cell", func() {
 					It("errors and does not change the task", func() {
-						_, err := sqlDB.CompleteTask(logger, taskGuid, "a-different-cell", true, "it blue up", "i am the result")
+						_, _, err := sqlDB.CompleteTask(logger, taskGuid, "a-different-cell", true, "it blue up", "i am the result")
 						modelErr := models.ConvertError(err)
 						Expect(modelErr).NotTo(BeNil())
 						Expect(modelErr.Type).To(Equal(models.Error_RunningOnDifferentCell))
@@ -591,7 +883,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("errors and does not change the task", func() {
-					_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blue up", "i am the result")
+					_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blue up", "i am the result")
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -605,7 +897,64 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("errors", func() {
-				_, err := sqlDB.CompleteTask(logger, "task-not-here", "a-different-cell", true, "it blue up", "i am the result")
+				_, _, err := sqlDB.CompleteTask(logger, "task-not-here", "a-different-cell", true, "it blue up", "i am the result")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("RejectTask", func() {
+		var taskGuid string
+
+		BeforeEach(func() {
+			taskGuid = "the-task-guid"
+			_, err := sqlDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), taskGuid, "the-task-domain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the task is pending", func() {
+			It("counts the rejection and keeps the reason, leaving the task pending", func() {
+				fakeClock.Increment(time.Second)
+
+				_, task, err := sqlDB.RejectTask(logger, taskGuid, "insufficient resources")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.RejectionCount).To(BeEquivalentTo(1))
+
+				fakeClock.Increment(time.Second)
+				_, _, err = sqlDB.RejectTask(logger, taskGuid, "found no compatible cell")
+				Expect(err).NotTo(HaveOccurred())
+
+				task, err = sqlDB.TaskByGuid(logger, taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Pending))
+				Expect(task.RejectionCount).To(BeEquivalentTo(2))
+				Expect(task.RejectionReason).To(Equal("found no compatible cell"))
+				Expect(task.UpdatedAt).To(Equal(fakeClock.Now().UnixNano()))
+			})
+
+			It("truncates a rejection reason longer than the column", func() {
+				_, task, err := sqlDB.RejectTask(logger, taskGuid, randStr(1025))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.RejectionReason).To(HaveLen(1024))
+			})
+		})
+
+		Context("when the task is not pending", func() {
+			BeforeEach(func() {
+				_, _, _, err := sqlDB.StartTask(logger, taskGuid, "the-cell-id")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an invalid state transition error", func() {
+				_, _, err := sqlDB.RejectTask(logger, taskGuid, "insufficient resources")
+				Expect(err).To(HaveOccurred())
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidStateTransition))
+			})
+		})
+
+		Context("when the task does not exist", func() {
+			It("returns a ResourceNotFound error", func() {
+				_, _, err := sqlDB.RejectTask(logger, "not-a-task", "insufficient resources")
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -624,7 +973,7 @@ var _ = Describe("TaskDB", func() {
 				taskDefinition = model_helpers.NewValidTaskDefinition()
 				failureReason = "I failed."
 
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -634,7 +983,7 @@ var _ = Describe("TaskDB", func() {
 					nowTruncateMicroseconds := fakeClock.Now()
 					now := fakeClock.Now()
 
-					task, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+					_, task, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(task.State).To(Equal(models.Task_Completed))
@@ -661,7 +1010,7 @@ var _ = Describe("TaskDB", func() {
 					var anotherTask *models.Task
 					BeforeEach(func() {
 						anotherTaskGuid := "another-task-guid"
-						err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+						_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 						Expect(err).NotTo(HaveOccurred())
 
 						anotherTask, err = sqlDB.TaskByGuid(logger, anotherTaskGuid)
@@ -669,7 +1018,7 @@ var _ = Describe("TaskDB", func() {
 					})
 
 					It("updates only the task with the corresponding guid", func() {
-						_, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+						_, _, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 						Expect(err).NotTo(HaveOccurred())
 
 						task, err := sqlDB.TaskByGuid(logger, anotherTask.TaskGuid)
@@ -680,7 +1029,7 @@ var _ = Describe("TaskDB", func() {
 
 				Context("with an invalid failure reason", func() {
 					It("returns an error and does not update the record", func() {
-						_, err := sqlDB.FailTask(logger, taskGuid, randStr(256))
+						_, _, err := sqlDB.FailTask(logger, taskGuid, randStr(256))
 						Expect(err).To(Equal(models.ErrBadRequest))
 					})
 				})
@@ -689,7 +1038,7 @@ var _ = Describe("TaskDB", func() {
 			Context("when the task is running", func() {
 				BeforeEach(func() {
 					cellID = "the-cell-id"
-					started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+					_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(started).To(BeTrue())
 				})
@@ -701,7 +1050,7 @@ var _ = Describe("TaskDB", func() {
 
 					failureReason := "I failed."
 
-					task, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+					_, task, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(task.State).To(Equal(models.Task_Completed))
@@ -730,11 +1079,11 @@ var _ = Describe("TaskDB", func() {
 
 				BeforeEach(func() {
 					cellID = "the-cell-id"
-					started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+					_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(started).To(BeTrue())
 
-					_, err = sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "I am the result.")
+					_, _, err = sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "I am the result.")
 					Expect(err).NotTo(HaveOccurred())
 
 					beforeTask, err = sqlDB.TaskByGuid(logger, taskGuid)
@@ -742,7 +1091,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("returns an InvalidStateTransition error", func() {
-					_, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+					_, _, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -769,7 +1118,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("returns an InvalidStateTransition error", func() {
-					_, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+					_, _, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -783,7 +1132,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("returns an ResourceNotFound error", func() {
-				_, err := sqlDB.FailTask(logger, "", "")
+				_, _, err := sqlDB.FailTask(logger, "", "")
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -807,17 +1156,17 @@ var _ = Describe("TaskDB", func() {
 				cellID = "the-cell-id"
 				taskDefinition = model_helpers.NewValidTaskDefinition()
 
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 
-				started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+				_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(started).To(BeTrue())
 			})
 
 			Context("when the task is completed", func() {
 				BeforeEach(func() {
-					_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
+					_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
 					Expect(err).NotTo(HaveOccurred())
 				})
 
@@ -825,7 +1174,7 @@ var _ = Describe("TaskDB", func() {
 					fakeClock.Increment(time.Second)
 					nowTruncateMicroseconds := fakeClock.Now()
 
-					err := sqlDB.ResolvingTask(logger, taskGuid)
+					before, after, err := sqlDB.ResolvingTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 
 					task, err := sqlDB.TaskByGuid(logger, taskGuid)
@@ -833,6 +1182,8 @@ var _ = Describe("TaskDB", func() {
 
 					Expect(task.State).To(Equal(models.Task_Resolving))
 					Expect(task.UpdatedAt).To(Equal(nowTruncateMicroseconds.UnixNano()))
+					Expect(before.State).To(Equal(models.Task_Completed))
+					Expect(after).To(Equal(task))
 				})
 
 				Context("with multiple completed tasks", func() {
@@ -840,14 +1191,14 @@ var _ = Describe("TaskDB", func() {
 
 					BeforeEach(func() {
 						anotherTaskGuid := "another-guid"
-						err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+						_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 						Expect(err).NotTo(HaveOccurred())
 
-						started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
+						_, _, started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
 						Expect(err).NotTo(HaveOccurred())
 						Expect(started).To(BeTrue())
 
-						_, err = sqlDB.CompleteTask(logger, anotherTaskGuid, cellID, false, "", "some-result")
+						_, _, err = sqlDB.CompleteTask(logger, anotherTaskGuid, cellID, false, "", "some-result")
 						Expect(err).NotTo(HaveOccurred())
 
 						anotherTask, err = sqlDB.TaskByGuid(logger, anotherTaskGuid)
@@ -855,7 +1206,7 @@ var _ = Describe("TaskDB", func() {
 					})
 
 					It("should only update the task with the corresponding guid", func() {
-						err := sqlDB.ResolvingTask(logger, taskGuid)
+						_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 						Expect(err).NotTo(HaveOccurred())
 
 						task, err := sqlDB.TaskByGuid(logger, anotherTask.TaskGuid)
@@ -876,7 +1227,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("errors and does not change the task", func() {
-					err := sqlDB.ResolvingTask(logger, taskGuid)
+					_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -891,10 +1242,10 @@ var _ = Describe("TaskDB", func() {
 				var taskBefore *models.Task
 
 				BeforeEach(func() {
-					_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
+					_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
 					Expect(err).NotTo(HaveOccurred())
 
-					err = sqlDB.ResolvingTask(logger, taskGuid)
+					_, _, err = sqlDB.ResolvingTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 
 					taskBefore, err = sqlDB.TaskByGuid(logger, taskGuid)
@@ -902,7 +1253,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("errors and does not change the task", func() {
-					err := sqlDB.ResolvingTask(logger, taskGuid)
+					_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -916,7 +1267,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("returns a ResourceNotFound error", func() {
-				err := sqlDB.ResolvingTask(logger, taskGuid)
+				_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -940,27 +1291,31 @@ var _ = Describe("TaskDB", func() {
 				cellID = "the-cell-id"
 				taskDefinition = model_helpers.NewValidTaskDefinition()
 
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 
-				started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+				_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(started).To(BeTrue())
 
-				_, err = sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
+				_, _, err = sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			Context("and the task is resolving", func() {
 				BeforeEach(func() {
-					err := sqlDB.ResolvingTask(logger, taskGuid)
+					_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 				})
 
 				It("removes the task from the database", func() {
-					err := sqlDB.DeleteTask(logger, taskGuid)
+					taskBefore, err := sqlDB.TaskByGuid(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 
+					task, err := sqlDB.DeleteTask(logger, taskGuid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task).To(Equal(taskBefore))
+
 					_, err = sqlDB.TaskByGuid(logger, taskGuid)
 					Expect(err).To(Equal(models.ErrResourceNotFound))
 				})
@@ -971,17 +1326,17 @@ var _ = Describe("TaskDB", func() {
 					BeforeEach(func() {
 						anotherTaskGuid := "another-guid"
 
-						err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+						_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 						Expect(err).NotTo(HaveOccurred())
 
-						started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
+						_, _, started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
 						Expect(err).NotTo(HaveOccurred())
 						Expect(started).To(BeTrue())
 
-						_, err = sqlDB.CompleteTask(logger, anotherTaskGuid, cellID, false, "", "some-result")
+						_, _, err = sqlDB.CompleteTask(logger, anotherTaskGuid, cellID, false, "", "some-result")
 						Expect(err).NotTo(HaveOccurred())
 
-						err = sqlDB.ResolvingTask(logger, anotherTaskGuid)
+						_, _, err = sqlDB.ResolvingTask(logger, anotherTaskGuid)
 						Expect(err).NotTo(HaveOccurred())
 
 						anotherTask, err = sqlDB.TaskByGuid(logger, anotherTaskGuid)
@@ -989,7 +1344,7 @@ var _ = Describe("TaskDB", func() {
 					})
 
 					It("only removes the task with the corresponding guid", func() {
-						err := sqlDB.DeleteTask(logger, taskGuid)
+						_, err := sqlDB.DeleteTask(logger, taskGuid)
 						Expect(err).NotTo(HaveOccurred())
 
 						task, err := sqlDB.TaskByGuid(logger, anotherTask.TaskGuid)
@@ -1001,7 +1356,7 @@ var _ = Describe("TaskDB", func() {
 
 			Context("and the task is not resolving", func() {
 				It("returns an error", func() {
-					err := sqlDB.DeleteTask(logger, taskGuid)
+					_, err := sqlDB.DeleteTask(logger, taskGuid)
 					expectedErr := models.NewTaskTransitionError(models.Task_Completed, models.Task_Resolving)
 					Expect(err).To(Equal(expectedErr))
 				})
@@ -1010,11 +1365,52 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("returns a ResourceNotFound error", func() {
-				err := sqlDB.DeleteTask(logger, taskGuid)
+				_, err := sqlDB.DeleteTask(logger, taskGuid)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
 	})
+
+	Describe("PurgeCompletedTasks", func() {
+		const minAge = time.Hour
+
+		newTask := func(guid string, state models.Task_State, completedAgo time.Duration) *models.Task {
+			task := model_helpers.NewValidTask(guid)
+			task.State = state
+			task.FirstCompletedAt = fakeClock.Now().Add(-completedAgo).UnixNano()
+			return task
+		}
+
+		BeforeEach(func() {
+			callbackTask := newTask("old-with-callback", models.Task_Completed, 2*minAge)
+			callbackTask.CompletionCallbackUrl = "http://example.com/callback"
+
+			for _, task := range []*models.Task{
+				newTask("old-completed", models.Task_Completed, 2*minAge),
+				newTask("old-resolving", models.Task_Resolving, 2*minAge),
+				newTask("new-completed", models.Task_Completed, minAge/2),
+				newTask("running", models.Task_Running, 2*minAge),
+				callbackTask,
+			} {
+				insertTask(db, serializer, task, false)
+			}
+		})
+
+		It("deletes the old completed and resolving tasks without a callback and returns the count", func() {
+			purged, err := sqlDB.PurgeCompletedTasks(logger, minAge)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(purged).To(Equal(2))
+
+			tasks, err := sqlDB.Tasks(logger, models.TaskFilter{})
+			Expect(err).NotTo(HaveOccurred())
+
+			guids := []string{}
+			for _, task := range tasks {
+				guids = append(guids, task.TaskGuid)
+			}
+			Expect(guids).To(ConsistOf("new-completed", "running", "old-with-callback"))
+		})
+	})
 })
 
 func insertTask(db *sql.DB, serializer format.Serializer, task *models.Task, malformedTaskDefinition bool) {
@@ -0,0 +1,158 @@
+package sqldb_test
+
+import (
+	"database/sql"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Operation timeouts", func() {
+	const (
+		taskGuid = "the-task-guid"
+		timeout  = 250 * time.Millisecond
+	)
+
+	var timedDB *sqldb.SQLDB
+
+	BeforeEach(func() {
+		timedDB = sqldb.NewSQLDB(db, 5, 5, StuckEvacuationThreshold, 0, format.ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, timeout, timeout, "")
+
+		_, err := sqlDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), taskGuid, "domain")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	expectTaskToBePending := func() {
+		task, err := sqlDB.TaskByGuid(logger, taskGuid)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(task.State).To(Equal(models.Task_Pending))
+	}
+
+	Context("when a write has to wait longer than the write timeout for a row lock", func() {
+		It("fails with a timeout error and rolls back", func() {
+			tx, err := db.Begin()
+			Expect(err).NotTo(HaveOccurred())
+			_, err = tx.Exec(sqldb.RebindForFlavor("SELECT guid FROM tasks WHERE guid = ? FOR UPDATE", dbFlavor), taskGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, _, err = timedDB.StartTask(logger, taskGuid, "cell-id")
+			Expect(err).To(Equal(models.ErrTimeout))
+
+			Expect(tx.Rollback()).To(Succeed())
+			expectTaskToBePending()
+
+			_, _, started, err := timedDB.StartTask(logger, taskGuid, "cell-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+		})
+	})
+
+	Context("when a read has to wait longer than the read timeout for a table lock", func() {
+		It("fails with a timeout error", func() {
+			tx, err := db.Begin()
+			Expect(err).NotTo(HaveOccurred())
+			if dbFlavor == sqldb.MySQL {
+				_, err = tx.Exec("LOCK TABLES tasks WRITE")
+			} else {
+				_, err = tx.Exec("LOCK TABLE tasks IN ACCESS EXCLUSIVE MODE")
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = timedDB.TaskByGuid(logger, taskGuid)
+			Expect(err).To(Equal(models.ErrTimeout))
+
+			if dbFlavor == sqldb.MySQL {
+				_, err = tx.Exec("UNLOCK TABLES")
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(tx.Rollback()).To(Succeed())
+
+			_, err = timedDB.TaskByGuid(logger, taskGuid)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("when streaming tasks", func() {
+		lockTasks := func() *sql.Tx {
+			tx, err := db.Begin()
+			Expect(err).NotTo(HaveOccurred())
+			if dbFlavor == sqldb.MySQL {
+				_, err = tx.Exec("LOCK TABLES tasks WRITE")
+			} else {
+				_, err = tx.Exec("LOCK TABLE tasks IN ACCESS EXCLUSIVE MODE")
+			}
+			Expect(err).NotTo(HaveOccurred())
+			return tx
+		}
+
+		unlockTasks := func(tx *sql.Tx) {
+			if dbFlavor == sqldb.MySQL {
+				_, err := tx.Exec("UNLOCK TABLES")
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(tx.Rollback()).To(Succeed())
+		}
+
+		It("fails with a timeout error when the query waits longer than the read timeout", func() {
+			tx := lockTasks()
+
+			_, err := timedDB.StreamTasks(logger, models.TaskFilter{}, func(*models.Task) error { return nil })
+			Expect(err).To(Equal(models.ErrTimeout))
+
+			unlockTasks(tx)
+		})
+
+		It("does not cut off a consumer that reads for longer than the read timeout", func() {
+			_, err := sqlDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), "other-task-guid", "domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			guids := []string{}
+			_, err = timedDB.StreamTasks(logger, models.TaskFilter{}, func(task *models.Task) error {
+				time.Sleep(2 * timeout)
+				guids = append(guids, task.TaskGuid)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(guids).To(ConsistOf(taskGuid, "other-task-guid"))
+		})
+	})
+
+	Context("when a slow query holds the row a write needs", func() {
+		BeforeEach(func() {
+			if dbFlavor != sqldb.MySQL {
+				Skip("SELECT SLEEP is only available on MySQL")
+			}
+		})
+
+		It("gives up on the write after the write timeout and leaves the row unchanged", func() {
+			slowQueryDone := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(slowQueryDone)
+				_, err := db.Exec("SELECT SLEEP(2) FROM tasks WHERE guid = ? FOR UPDATE", taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+			}()
+
+			Eventually(func() int {
+				var running int
+				err := db.QueryRow("SELECT COUNT(*) FROM information_schema.processlist WHERE info LIKE 'SELECT SLEEP(2)%'").Scan(&running)
+				Expect(err).NotTo(HaveOccurred())
+				return running
+			}).Should(Equal(1))
+
+			start := time.Now()
+			_, _, _, err := timedDB.StartTask(logger, taskGuid, "cell-id")
+			Expect(err).To(Equal(models.ErrTimeout))
+			Expect(time.Since(start)).To(BeNumerically("<", 2*time.Second))
+
+			Eventually(slowQueryDone, 5*time.Second).Should(BeClosed())
+			expectTaskToBePending()
+		})
+	})
+})
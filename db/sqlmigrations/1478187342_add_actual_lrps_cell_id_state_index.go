@@ -0,0 +1,44 @@
+package sqlmigrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddActualLRPsCellIDStateIndex())
+}
+
+// AddActualLRPsCellIDStateIndex covers lookups of the claimed or running
+// instances on a given cell, such as retiring every ActualLRP on a cell.
+type AddActualLRPsCellIDStateIndex struct{}
+
+func NewAddActualLRPsCellIDStateIndex() migration.SQLMigration {
+	return &AddActualLRPsCellIDStateIndex{}
+}
+
+func (e *AddActualLRPsCellIDStateIndex) String() string {
+	return "1478187342"
+}
+
+func (e *AddActualLRPsCellIDStateIndex) Version() int64 {
+	return 1478187342
+}
+
+func (e *AddActualLRPsCellIDStateIndex) Up(logger lager.Logger, tx *sql.Tx, flavor, tablePrefix string) error {
+	return e.up(logger, tx, flavor, tablePrefix)
+}
+
+func (e *AddActualLRPsCellIDStateIndex) UpWithoutTransaction(logger lager.Logger, db *sql.DB, flavor, tablePrefix string) error {
+	return e.up(logger, db, flavor, tablePrefix)
+}
+
+func (e *AddActualLRPsCellIDStateIndex) up(logger lager.Logger, q queryExecer, flavor, tablePrefix string) error {
+	logger = logger.Session("add-actual-lrps-cell-id-state-index")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	return createIndexIfMissing(logger, q, flavor, tablePrefix+"actual_lrps", tablePrefix+"actual_lrps_cell_id_state_idx", "cell_id, evacuating, state")
+}
@@ -0,0 +1,50 @@
+package sqlmigrations_test
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqlmigrations"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add ActualLRPs Cell ID State Index", func() {
+	if test_helpers.UseSQL() {
+		var mig migration.SQLMigration
+
+		BeforeEach(func() {
+			mig = sqlmigrations.NewAddActualLRPsCellIDStateIndex()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(sqlmigrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1478187342))
+			})
+		})
+
+		Describe("Up", func() {
+			up := func() error {
+				return runInTransaction(func(tx *sql.Tx) error {
+					return mig.Up(logger, tx, flavor, "")
+				})
+			}
+
+			It("creates the index", func() {
+				Expect(up()).To(Succeed())
+				Expect(indexExists("actual_lrps", "actual_lrps_cell_id_state_idx")).To(BeTrue())
+			})
+
+			It("can be run again", func() {
+				Expect(up()).To(Succeed())
+				Expect(up()).To(Succeed())
+				Expect(indexExists("actual_lrps", "actual_lrps_cell_id_state_idx")).To(BeTrue())
+			})
+		})
+	}
+})
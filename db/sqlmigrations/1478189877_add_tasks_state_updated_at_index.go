@@ -0,0 +1,44 @@
+package sqlmigrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddTasksStateUpdatedAtIndex())
+}
+
+// AddTasksStateUpdatedAtIndex covers the task convergence queries, which look
+// for tasks that have been in a given state since before some cutoff.
+type AddTasksStateUpdatedAtIndex struct{}
+
+func NewAddTasksStateUpdatedAtIndex() migration.SQLMigration {
+	return &AddTasksStateUpdatedAtIndex{}
+}
+
+func (e *AddTasksStateUpdatedAtIndex) String() string {
+	return "1478189877"
+}
+
+func (e *AddTasksStateUpdatedAtIndex) Version() int64 {
+	return 1478189877
+}
+
+func (e *AddTasksStateUpdatedAtIndex) Up(logger lager.Logger, tx *sql.Tx, flavor, tablePrefix string) error {
+	return e.up(logger, tx, flavor, tablePrefix)
+}
+
+func (e *AddTasksStateUpdatedAtIndex) UpWithoutTransaction(logger lager.Logger, db *sql.DB, flavor, tablePrefix string) error {
+	return e.up(logger, db, flavor, tablePrefix)
+}
+
+func (e *AddTasksStateUpdatedAtIndex) up(logger lager.Logger, q queryExecer, flavor, tablePrefix string) error {
+	logger = logger.Session("add-tasks-state-updated-at-index")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	return createIndexIfMissing(logger, q, flavor, tablePrefix+"tasks", tablePrefix+"tasks_state_updated_at_idx", "state, updated_at")
+}
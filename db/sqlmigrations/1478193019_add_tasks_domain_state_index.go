@@ -0,0 +1,44 @@
+package sqlmigrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddTasksDomainStateIndex())
+}
+
+// AddTasksDomainStateIndex lets queries on the tasks in a domain narrow by
+// state from the same index, instead of reading every task in the domain.
+type AddTasksDomainStateIndex struct{}
+
+func NewAddTasksDomainStateIndex() migration.SQLMigration {
+	return &AddTasksDomainStateIndex{}
+}
+
+func (e *AddTasksDomainStateIndex) String() string {
+	return "1478193019"
+}
+
+func (e *AddTasksDomainStateIndex) Version() int64 {
+	return 1478193019
+}
+
+func (e *AddTasksDomainStateIndex) Up(logger lager.Logger, tx *sql.Tx, flavor, tablePrefix string) error {
+	return e.up(logger, tx, flavor, tablePrefix)
+}
+
+func (e *AddTasksDomainStateIndex) UpWithoutTransaction(logger lager.Logger, db *sql.DB, flavor, tablePrefix string) error {
+	return e.up(logger, db, flavor, tablePrefix)
+}
+
+func (e *AddTasksDomainStateIndex) up(logger lager.Logger, q queryExecer, flavor, tablePrefix string) error {
+	logger = logger.Session("add-tasks-domain-state-index")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	return createIndexIfMissing(logger, q, flavor, tablePrefix+"tasks", tablePrefix+"tasks_domain_state_idx", "domain, state")
+}
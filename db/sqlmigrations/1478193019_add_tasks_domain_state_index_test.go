@@ -0,0 +1,108 @@
+package sqlmigrations_test
+
+import (
+	"database/sql"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/db/sqlmigrations"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Tasks Domain State Index", func() {
+	if test_helpers.UseSQL() {
+		var mig migration.SQLMigration
+
+		BeforeEach(func() {
+			mig = sqlmigrations.NewAddTasksDomainStateIndex()
+		})
+
+		It("appends itself to the migration list", func() {
+			Expect(sqlmigrations.Migrations).To(ContainElement(mig))
+		})
+
+		Describe("Version", func() {
+			It("returns the timestamp from which it was created", func() {
+				Expect(mig.Version()).To(BeEquivalentTo(1478193019))
+			})
+		})
+
+		Describe("Up", func() {
+			up := func() error {
+				return runInTransaction(func(tx *sql.Tx) error {
+					return mig.Up(logger, tx, flavor, "")
+				})
+			}
+
+			It("creates the index", func() {
+				Expect(up()).To(Succeed())
+				Expect(indexExists("tasks", "tasks_domain_state_idx")).To(BeTrue())
+			})
+
+			It("can be run again", func() {
+				Expect(up()).To(Succeed())
+				Expect(up()).To(Succeed())
+				Expect(indexExists("tasks", "tasks_domain_state_idx")).To(BeTrue())
+			})
+
+			Context("with tasks spread across domains and states", func() {
+				var query string
+
+				BeforeEach(func() {
+					for i := 0; i < 1000; i++ {
+						_, err := rawSQLDB.Exec(
+							sqldb.RebindForFlavor("INSERT INTO tasks (domain, state, updated_at) VALUES (?, ?, ?)", flavor),
+							fmt.Sprintf("domain-%d", i%20), i%4, i,
+						)
+						Expect(err).NotTo(HaveOccurred())
+					}
+
+					query = sqldb.RebindForFlavor("SELECT updated_at FROM tasks WHERE domain = ? AND state = ?", flavor)
+				})
+
+				It("is used to find the tasks in a domain by state", func() {
+					Expect(queryPlan(query, "domain-3", 1)).NotTo(ContainSubstring("tasks_domain_state_idx"))
+
+					Expect(up()).To(Succeed())
+
+					Expect(queryPlan(query, "domain-3", 1)).To(ContainSubstring("tasks_domain_state_idx"))
+				})
+			})
+		})
+
+		Describe("UpWithoutTransaction", func() {
+			up := func() error {
+				return mig.(migration.NonTransactionalSQLMigration).UpWithoutTransaction(logger, rawSQLDB, flavor, "")
+			}
+
+			It("creates the index", func() {
+				Expect(up()).To(Succeed())
+				Expect(indexExists("tasks", "tasks_domain_state_idx")).To(BeTrue())
+			})
+
+			It("can be run again", func() {
+				Expect(up()).To(Succeed())
+				Expect(up()).To(Succeed())
+				Expect(indexExists("tasks", "tasks_domain_state_idx")).To(BeTrue())
+			})
+
+			if test_helpers.UsePostgres() {
+				It("rebuilds an index left invalid by an interrupted build", func() {
+					Expect(up()).To(Succeed())
+					_, err := rawSQLDB.Exec("UPDATE pg_index SET indisvalid = false WHERE indexrelid = 'tasks_domain_state_idx'::regclass")
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(up()).To(Succeed())
+
+					var valid bool
+					err = rawSQLDB.QueryRow("SELECT indisvalid FROM pg_index WHERE indexrelid = 'tasks_domain_state_idx'::regclass").Scan(&valid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(valid).To(BeTrue())
+				})
+			}
+		})
+	}
+})
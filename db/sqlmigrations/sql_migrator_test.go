@@ -0,0 +1,141 @@
+package sqlmigrations_test
+
+import (
+	"database/sql"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/sqlmigrations"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/migration/migrationfakes"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	"code.cloudfoundry.org/lager"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/ginkgomon"
+)
+
+var _ = Describe("SQL Migrator", func() {
+	if test_helpers.UseSQL() {
+		var (
+			migrationsDone chan struct{}
+			migrations     migration.SQLMigrations
+			migrator       migration.SQLMigrator
+		)
+
+		appliedVersions := func() []int64 {
+			rows, err := rawSQLDB.Query("SELECT version FROM schema_migrations ORDER BY version")
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+
+			versions := []int64{}
+			for rows.Next() {
+				var version int64
+				Expect(rows.Scan(&version)).To(Succeed())
+				versions = append(versions, version)
+			}
+			return versions
+		}
+
+		BeforeEach(func() {
+			migrationsDone = make(chan struct{})
+			migrations = sqlmigrations.Migrations
+		})
+
+		JustBeforeEach(func() {
+			migrator = migration.NewSQLMigrator(logger, rawSQLDB, flavor, "", migrations, migrationsDone, fakeClock)
+		})
+
+		Describe("Run", func() {
+			var process ifrit.Process
+
+			JustBeforeEach(func() {
+				process = ifrit.Background(migrator)
+			})
+
+			AfterEach(func() {
+				ginkgomon.Kill(process)
+			})
+
+			It("applies every migration and then closes the migrations done channel", func() {
+				Eventually(process.Ready()).Should(BeClosed())
+				Expect(migrationsDone).To(BeClosed())
+
+				expectedVersions := []int64{}
+				for _, m := range sqlmigrations.Migrations {
+					expectedVersions = append(expectedVersions, m.Version())
+				}
+				Expect(appliedVersions()).To(ConsistOf(expectedVersions))
+				Expect(indexExists("actual_lrps", "actual_lrps_cell_id_state_idx")).To(BeTrue())
+				Expect(indexExists("tasks", "tasks_state_updated_at_idx")).To(BeTrue())
+			})
+
+			Context("when a migration fails", func() {
+				BeforeEach(func() {
+					failing := &migrationfakes.FakeSQLMigration{}
+					failing.VersionReturns(1)
+					failing.UpReturns(errors.New("boom"))
+					migrations = migration.SQLMigrations{failing}
+				})
+
+				It("exits with the error without closing the migrations done channel", func() {
+					Eventually(process.Wait()).Should(Receive(MatchError("boom")))
+					Expect(migrationsDone).NotTo(BeClosed())
+				})
+			})
+		})
+
+		Describe("Migrate", func() {
+			var (
+				first, second *migrationfakes.FakeSQLMigration
+			)
+
+			BeforeEach(func() {
+				first = &migrationfakes.FakeSQLMigration{}
+				first.VersionReturns(10)
+				second = &migrationfakes.FakeSQLMigration{}
+				second.VersionReturns(20)
+				migrations = migration.SQLMigrations{second, first}
+			})
+
+			It("runs the migrations in version order and records them", func() {
+				order := []int64{}
+				first.UpStub = func(lager.Logger, *sql.Tx, string, string) error {
+					order = append(order, 10)
+					return nil
+				}
+				second.UpStub = func(lager.Logger, *sql.Tx, string, string) error {
+					order = append(order, 20)
+					return nil
+				}
+
+				Expect(migrator.Migrate(logger)).To(Succeed())
+				Expect(order).To(Equal([]int64{10, 20}))
+				Expect(appliedVersions()).To(Equal([]int64{10, 20}))
+
+				_, _, passedFlavor, passedTablePrefix := first.UpArgsForCall(0)
+				Expect(passedFlavor).To(Equal(flavor))
+				Expect(passedTablePrefix).To(BeEmpty())
+			})
+
+			It("skips migrations that have already been applied", func() {
+				Expect(migrator.Migrate(logger)).To(Succeed())
+				Expect(migrator.Migrate(logger)).To(Succeed())
+
+				Expect(first.UpCallCount()).To(Equal(1))
+				Expect(second.UpCallCount()).To(Equal(1))
+			})
+
+			Context("when a migration fails", func() {
+				BeforeEach(func() {
+					second.UpReturns(errors.New("boom"))
+				})
+
+				It("keeps the earlier migrations and does not record the failed one", func() {
+					Expect(migrator.Migrate(logger)).To(MatchError("boom"))
+					Expect(appliedVersions()).To(Equal([]int64{10}))
+				})
+			})
+		})
+	}
+})
@@ -0,0 +1,98 @@
+package sqlmigrations
+
+import (
+	"database/sql"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/lager"
+)
+
+var Migrations = migration.SQLMigrations{}
+
+func AppendMigration(migration migration.SQLMigration) {
+	for _, m := range Migrations {
+		if m.Version() == migration.Version() {
+			panic("cannot have two migrations with the same version")
+		}
+	}
+
+	Migrations = append(Migrations, migration)
+}
+
+// queryExecer is either a transaction or the database itself.
+type queryExecer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// createIndexIfMissing lets index migrations be rerun safely on MySQL, where a
+// CREATE INDEX commits implicitly and is not undone when the surrounding
+// transaction rolls back. On MySQL the index is built in place without
+// locking the table, so that the BBS keeps serving writes while it is built;
+// the statement fails rather than silently taking a lock if that is not
+// possible. On Postgres the index is built with CREATE INDEX CONCURRENTLY for
+// the same reason when q is not a transaction, which that statement cannot
+// run in. An interrupted concurrent build leaves an invalid index behind,
+// which is dropped and built again.
+func createIndexIfMissing(logger lager.Logger, q queryExecer, flavor, table, index, columns string) error {
+	_, inTransaction := q.(*sql.Tx)
+	concurrently := flavor == sqldb.Postgres && !inTransaction
+
+	var query string
+	switch flavor {
+	case sqldb.MySQL:
+		query = `SELECT COUNT(*), COUNT(*) FROM information_schema.statistics
+			WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?`
+	case sqldb.Postgres:
+		query = `SELECT COUNT(*), COUNT(NULLIF(i.indisvalid, false)) FROM pg_indexes x
+			JOIN pg_namespace n ON n.nspname = x.schemaname
+			JOIN pg_class c ON c.relnamespace = n.oid AND c.relname = x.indexname
+			JOIN pg_index i ON i.indexrelid = c.oid
+			WHERE x.schemaname = current_schema() AND x.tablename = ? AND x.indexname = ?`
+	default:
+		return fmt.Errorf("Unrecognized DB flavor '%s'", flavor)
+	}
+
+	var count, valid int
+	err := q.QueryRow(sqldb.RebindForFlavor(query, flavor), table, index).Scan(&count, &valid)
+	if err != nil {
+		logger.Error("failed-checking-index", err, lager.Data{"index": index})
+		return err
+	}
+
+	if valid > 0 {
+		logger.Info("index-already-exists", lager.Data{"index": index})
+		return nil
+	}
+
+	if count > 0 {
+		query = "DROP INDEX " + index
+		if concurrently {
+			query = "DROP INDEX CONCURRENTLY " + index
+		}
+		logger.Info("dropping-invalid-index", lager.Data{"query": query})
+		_, err = q.Exec(query)
+		if err != nil {
+			logger.Error("failed-dropping-invalid-index", err)
+			return err
+		}
+	}
+
+	query = fmt.Sprintf("CREATE INDEX %s ON %s (%s)", index, table, columns)
+	switch {
+	case flavor == sqldb.MySQL:
+		query += " ALGORITHM = INPLACE LOCK = NONE"
+	case concurrently:
+		query = fmt.Sprintf("CREATE INDEX CONCURRENTLY %s ON %s (%s)", index, table, columns)
+	}
+	logger.Info("executing-query", lager.Data{"query": query})
+	_, err = q.Exec(query)
+	if err != nil {
+		logger.Error("failed-executing-query", err)
+		return err
+	}
+
+	return nil
+}
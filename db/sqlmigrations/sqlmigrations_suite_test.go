@@ -0,0 +1,144 @@
+package sqlmigrations_test
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/test_helpers"
+	"code.cloudfoundry.org/bbs/test_helpers/sqlrunner"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/ginkgomon"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+
+	"testing"
+)
+
+var (
+	rawSQLDB   *sql.DB
+	sqlProcess ifrit.Process
+	sqlRunner  sqlrunner.SQLRunner
+	flavor     string
+
+	fakeClock *fakeclock.FakeClock
+	logger    *lagertest.TestLogger
+)
+
+func TestSQLMigrations(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SQL Migrations Suite")
+}
+
+var _ = BeforeSuite(func() {
+	logger = lagertest.NewTestLogger("test")
+	fakeClock = fakeclock.NewFakeClock(time.Now())
+
+	if test_helpers.UseSQL() {
+		flavor = os.Getenv("USE_SQL")
+		dbName := fmt.Sprintf("diego_sql_migrations_%d", GinkgoParallelNode())
+		sqlRunner = test_helpers.NewSQLRunner(dbName)
+		sqlProcess = ginkgomon.Invoke(sqlRunner)
+
+		var err error
+		rawSQLDB, err = sql.Open(sqlRunner.DriverName(), sqlRunner.ConnectionString())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rawSQLDB.Ping()).NotTo(HaveOccurred())
+	}
+})
+
+var _ = AfterSuite(func() {
+	if test_helpers.UseSQL() {
+		Expect(rawSQLDB.Close()).NotTo(HaveOccurred())
+		ginkgomon.Kill(sqlProcess, 5*time.Second)
+	}
+})
+
+var _ = BeforeEach(func() {
+	if test_helpers.UseSQL() {
+		for _, query := range []string{
+			"DROP TABLE IF EXISTS schema_migrations",
+			"DROP TABLE IF EXISTS actual_lrps",
+			"DROP TABLE IF EXISTS tasks",
+			"CREATE TABLE actual_lrps (cell_id VARCHAR(255), evacuating BOOL, state VARCHAR(255))",
+			"CREATE TABLE tasks (domain VARCHAR(255), state INT, updated_at BIGINT)",
+		} {
+			_, err := rawSQLDB.Exec(query)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+})
+
+func indexExists(table, index string) bool {
+	var query string
+	if flavor == "postgres" {
+		query = "SELECT COUNT(*) FROM pg_indexes WHERE schemaname = current_schema() AND tablename = $1 AND indexname = $2"
+	} else {
+		query = "SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?"
+	}
+
+	var count int
+	Expect(rawSQLDB.QueryRow(query, table, index).Scan(&count)).To(Succeed())
+	return count > 0
+}
+
+// queryPlan returns the plan the database picks for query. Postgres is told
+// to avoid sequential scans, since it would otherwise prefer them on tables
+// as small as the ones seeded in these tests.
+func queryPlan(query string, args ...interface{}) string {
+	tx, err := rawSQLDB.Begin()
+	Expect(err).NotTo(HaveOccurred())
+	defer tx.Rollback()
+
+	if flavor == "postgres" {
+		_, err = tx.Exec("SET LOCAL enable_seqscan = off")
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	rows, err := tx.Query("EXPLAIN "+query, args...)
+	Expect(err).NotTo(HaveOccurred())
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	Expect(err).NotTo(HaveOccurred())
+
+	plan := ""
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		Expect(rows.Scan(pointers...)).To(Succeed())
+
+		for i, column := range columns {
+			// postgres returns a single "QUERY PLAN" column, mysql names the
+			// chosen index in the "key" column
+			if column == "QUERY PLAN" || column == "key" {
+				plan += values[i].String + "\n"
+			}
+		}
+	}
+	Expect(rows.Err()).NotTo(HaveOccurred())
+
+	return plan
+}
+
+func runInTransaction(f func(tx *sql.Tx) error) error {
+	tx, err := rawSQLDB.Begin()
+	Expect(err).NotTo(HaveOccurred())
+
+	err = f(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
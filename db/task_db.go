@@ -13,19 +13,50 @@ type CompleteTaskWork func(logger lager.Logger, taskDB TaskDB, task *models.Task
 //go:generate counterfeiter . TaskDB
 type TaskDB interface {
 	Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
+	// StreamTasks calls yield with each task matching the filter as it is
+	// read, without collecting them. It stops at the first error yield returns.
+	// With filter.PartialResults, the tasks that cannot be read are left out
+	// and returned as record errors instead of failing the whole read.
+	StreamTasks(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error)
 	TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error)
 
-	DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
-	StartTask(logger lager.Logger, taskGuid, cellId string) (bool, error)
-	CancelTask(logger lager.Logger, taskGuid string) (task *models.Task, cellID string, err error)
-	FailTask(logger lager.Logger, taskGuid, failureReason string) (task *models.Task, err error)
-	CompleteTask(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (task *models.Task, err error)
-	ResolvingTask(logger lager.Logger, taskGuid string) error
-	DeleteTask(logger lager.Logger, taskGuid string) error
+	DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) (*models.Task, error)
+	// DesireTaskWithIdempotencyKey records the key along with the task. If the
+	// key was already recorded for the same request, no task is created, task
+	// is nil and replayed is true. A different request returns
+	// ErrIdempotencyKeyConflict.
+	DesireTaskWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid, domain string) (task *models.Task, replayed bool, err error)
+	// DuplicateTasks finds the tasks in the domain whose definitions have the
+	// same content hash, sorted by hash and then by guid. Tasks without a
+	// content hash, such as those desired before hashes were recorded, are
+	// left out.
+	DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error)
+	// The methods that change a task return it as it was before and after
+	// the change, so callers need not read it again.
+	StartTask(logger lager.Logger, taskGuid, cellId string) (before *models.Task, after *models.Task, started bool, err error)
+	CancelTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error)
+	CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error)
+	FailTask(logger lager.Logger, taskGuid, failureReason string) (before *models.Task, after *models.Task, err error)
+	// RejectTask records a failed attempt to place the pending task by
+	// counting it and keeping the reason. The task stays pending, so
+	// convergence keeps trying to place it until the retry budget passed to
+	// ConvergeTasks is spent.
+	RejectTask(logger lager.Logger, taskGuid, rejectionReason string) (before *models.Task, after *models.Task, err error)
+	CompleteTask(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (before *models.Task, after *models.Task, err error)
+	ResolvingTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error)
+	// DeleteTask returns the task as it was when it was deleted.
+	DeleteTask(logger lager.Logger, taskGuid string) (*models.Task, error)
+	// PurgeCompletedTasks deletes the Completed and Resolving tasks that
+	// completed more than minAge ago, except those with a completion callback,
+	// which is still to be delivered. It returns how many tasks it deleted.
+	PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error)
 
+	// ConvergeTasks fails the pending tasks that were rejected
+	// maxTaskRejections times or more, unless maxTaskRejections is 0.
 	ConvergeTasks(
 		logger lager.Logger,
 		cellSet models.CellSet,
 		kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration,
+		maxTaskRejections int,
 	) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task)
 }
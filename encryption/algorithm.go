@@ -0,0 +1,62 @@
+package encryption
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Algorithm identifies how a record was encrypted. Its value is recorded in
+// the envelope of every record it encrypts, so it must never be reused for a
+// different algorithm.
+type Algorithm byte
+
+const (
+	// AES256GCM is AES-256 in GCM mode with a random 96-bit nonce. Every
+	// record written before algorithms were recorded uses it.
+	AES256GCM Algorithm = 0
+
+	// XChaCha20Poly1305 is ChaCha20-Poly1305 with a random 192-bit nonce,
+	// which is long enough that records can be encrypted with the same key
+	// without a limit on their number.
+	XChaCha20Poly1305 Algorithm = 1
+
+	// DefaultAlgorithm is used when no algorithm is configured.
+	DefaultAlgorithm = AES256GCM
+)
+
+var algorithmNames = map[Algorithm]string{
+	AES256GCM:         "aes-256-gcm",
+	XChaCha20Poly1305: "xchacha20-poly1305",
+}
+
+// ParseAlgorithm returns the algorithm with the given name.
+func ParseAlgorithm(name string) (Algorithm, error) {
+	for algorithm, algorithmName := range algorithmNames {
+		if algorithmName == name {
+			return algorithm, nil
+		}
+	}
+	return 0, fmt.Errorf("Unknown encryption algorithm: %q", name)
+}
+
+func (a Algorithm) String() string {
+	if name, ok := algorithmNames[a]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown-algorithm-%d", byte(a))
+}
+
+// Valid reports whether the algorithm is one this package implements.
+func (a Algorithm) Valid() bool {
+	_, ok := algorithmNames[a]
+	return ok
+}
+
+// NonceSize is the length of the nonces the algorithm generates.
+func (a Algorithm) NonceSize() int {
+	if a == XChaCha20Poly1305 {
+		return chacha20poly1305.NonceSizeX
+	}
+	return NonceSize
+}
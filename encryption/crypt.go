@@ -2,13 +2,18 @@ package encryption
 
 import (
 	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
 	"fmt"
 	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
 )
 
 const NonceSize = 12
 
 type Encrypted struct {
+	Algorithm  Algorithm
 	Nonce      []byte
 	KeyLabel   string
 	CipherText []byte
@@ -27,26 +32,46 @@ type Decryptor interface {
 type Cryptor interface {
 	Encryptor
 	Decryptor
+
+	// Algorithm is the algorithm Encrypt uses. Decrypt accepts records
+	// encrypted with any algorithm.
+	Algorithm() Algorithm
 }
 
 type cryptor struct {
 	keyManager KeyManager
+	algorithm  Algorithm
 	prng       io.Reader
 }
 
 func NewCryptor(keyManager KeyManager, prng io.Reader) Cryptor {
+	return NewCryptorWithAlgorithm(keyManager, DefaultAlgorithm, prng)
+}
+
+// NewCryptorWithAlgorithm returns a Cryptor that encrypts with the given
+// algorithm.
+func NewCryptorWithAlgorithm(keyManager KeyManager, algorithm Algorithm, prng io.Reader) Cryptor {
 	return &cryptor{
 		keyManager: keyManager,
+		algorithm:  algorithm,
 		prng:       prng,
 	}
 }
 
+func (c *cryptor) Algorithm() Algorithm {
+	return c.algorithm
+}
+
 func (c *cryptor) Encrypt(plaintext []byte) (Encrypted, error) {
+	if !c.algorithm.Valid() {
+		return Encrypted{}, fmt.Errorf("Unsupported encryption algorithm: %s", c.algorithm)
+	}
+
 	key := c.keyManager.EncryptionKey()
 
-	aead, err := cipher.NewGCM(key.Block())
+	aead, err := newAEAD(c.algorithm, key)
 	if err != nil {
-		return Encrypted{}, fmt.Errorf("Unable to create GCM-wrapped cipher: %q", err)
+		return Encrypted{}, err
 	}
 
 	nonce := make([]byte, aead.NonceSize())
@@ -56,19 +81,61 @@ func (c *cryptor) Encrypt(plaintext []byte) (Encrypted, error) {
 	}
 
 	ciphertext := aead.Seal(nil, nonce, plaintext, nil)
-	return Encrypted{KeyLabel: key.Label(), Nonce: nonce, CipherText: ciphertext}, nil
+	return Encrypted{Algorithm: c.algorithm, KeyLabel: key.Label(), Nonce: nonce, CipherText: ciphertext}, nil
+}
+
+// KeyNotFoundError is returned by Decrypt when the key a payload was encrypted
+// with is not in the key manager.
+type KeyNotFoundError struct {
+	Label string
 }
 
+func (e *KeyNotFoundError) Error() string {
+	return fmt.Sprintf("Key with label %q was not found", e.Label)
+}
+
+// Decrypt looks up the key before it checks anything else, so that any error
+// other than a KeyNotFoundError is for a payload whose key is known.
 func (d *cryptor) Decrypt(encrypted Encrypted) ([]byte, error) {
 	key := d.keyManager.DecryptionKey(encrypted.KeyLabel)
 	if key == nil {
-		return nil, fmt.Errorf("Key with label %q was not found", encrypted.KeyLabel)
+		return nil, &KeyNotFoundError{Label: encrypted.KeyLabel}
 	}
 
-	aead, err := cipher.NewGCM(key.Block())
+	if !encrypted.Algorithm.Valid() {
+		return nil, fmt.Errorf("Unsupported encryption algorithm: %s", encrypted.Algorithm)
+	}
+
+	aead, err := newAEAD(encrypted.Algorithm, key)
 	if err != nil {
-		return nil, fmt.Errorf("Unable to create GCM-wrapped cipher: %q", err)
+		return nil, err
+	}
+
+	if len(encrypted.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("Invalid nonce length: %d", len(encrypted.Nonce))
 	}
 
 	return aead.Open(nil, encrypted.Nonce, encrypted.CipherText, nil)
 }
+
+// xChaCha20Poly1305KeyContext separates the XChaCha20-Poly1305 key from the
+// AES key derived from the same passphrase.
+const xChaCha20Poly1305KeyContext = "bbs xchacha20-poly1305"
+
+func newAEAD(algorithm Algorithm, key Key) (cipher.AEAD, error) {
+	if algorithm == XChaCha20Poly1305 {
+		mac := hmac.New(sha256.New, key.Secret())
+		mac.Write([]byte(xChaCha20Poly1305KeyContext))
+		aead, err := chacha20poly1305.NewX(mac.Sum(nil))
+		if err != nil {
+			return nil, fmt.Errorf("Unable to create XChaCha20-Poly1305 cipher: %q", err)
+		}
+		return aead, nil
+	}
+
+	aead, err := cipher.NewGCM(key.Block())
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create GCM-wrapped cipher: %q", err)
+	}
+	return aead, nil
+}
@@ -80,6 +80,16 @@ var _ = Describe("Crypt", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err).To(MatchError(`Key with label "doesnt-exist" was not found`))
 		})
+
+		It("returns a key not found error with the label", func() {
+			encrypted := encryption.Encrypted{
+				KeyLabel: "doesnt-exist",
+				Nonce:    []byte("123456789012"),
+			}
+
+			_, err := cryptor.Decrypt(encrypted)
+			Expect(err).To(Equal(&encryption.KeyNotFoundError{Label: "doesnt-exist"}))
+		})
 	})
 
 	Context("when the ciphertext is modified", func() {
@@ -132,4 +142,99 @@ var _ = Describe("Crypt", func() {
 			Expect(err).To(MatchError(HavePrefix("Unable to create GCM-wrapped cipher:")))
 		})
 	})
+
+	It("encrypts with AES-256-GCM by default", func() {
+		Expect(cryptor.Algorithm()).To(Equal(encryption.AES256GCM))
+
+		encrypted, err := cryptor.Encrypt([]byte("some plaintext data"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encrypted.Algorithm).To(Equal(encryption.AES256GCM))
+	})
+
+	Context("when the nonce has the wrong length", func() {
+		It("fails to decrypt", func() {
+			encrypted, err := cryptor.Encrypt([]byte("some plaintext data"))
+			Expect(err).NotTo(HaveOccurred())
+
+			encrypted.Nonce = encrypted.Nonce[1:]
+
+			_, err = cryptor.Decrypt(encrypted)
+			Expect(err).To(MatchError("Invalid nonce length: 11"))
+		})
+	})
+
+	Context("when the algorithm is unknown", func() {
+		It("fails to decrypt", func() {
+			encrypted, err := cryptor.Encrypt([]byte("some plaintext data"))
+			Expect(err).NotTo(HaveOccurred())
+
+			encrypted.Algorithm = 42
+
+			_, err = cryptor.Decrypt(encrypted)
+			Expect(err).To(MatchError("Unsupported encryption algorithm: unknown-algorithm-42"))
+		})
+	})
+
+	Describe("XChaCha20-Poly1305", func() {
+		var xchachaCryptor encryption.Cryptor
+
+		JustBeforeEach(func() {
+			xchachaCryptor = encryption.NewCryptorWithAlgorithm(keyManager, encryption.XChaCha20Poly1305, prng)
+		})
+
+		It("encrypts and decrypts with a 192-bit nonce", func() {
+			input := []byte("some plaintext data")
+
+			encrypted, err := xchachaCryptor.Encrypt(input)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encrypted.Algorithm).To(Equal(encryption.XChaCha20Poly1305))
+			Expect(encrypted.Nonce).To(HaveLen(24))
+			Expect(encrypted.CipherText).NotTo(ContainSubstring(string(input)))
+
+			plaintext, err := xchachaCryptor.Decrypt(encrypted)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plaintext).To(Equal(input))
+		})
+
+		It("decrypts the records encrypted with AES-256-GCM", func() {
+			encrypted, err := cryptor.Encrypt([]byte("old data"))
+			Expect(err).NotTo(HaveOccurred())
+
+			plaintext, err := xchachaCryptor.Decrypt(encrypted)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plaintext).To(Equal([]byte("old data")))
+		})
+
+		It("can be decrypted by a cryptor that encrypts with AES-256-GCM", func() {
+			encrypted, err := xchachaCryptor.Encrypt([]byte("new data"))
+			Expect(err).NotTo(HaveOccurred())
+
+			plaintext, err := cryptor.Decrypt(encrypted)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plaintext).To(Equal([]byte("new data")))
+		})
+
+		It("does not decrypt a record as the wrong algorithm", func() {
+			encrypted, err := xchachaCryptor.Encrypt([]byte("new data"))
+			Expect(err).NotTo(HaveOccurred())
+
+			encrypted.Algorithm = encryption.AES256GCM
+			encrypted.Nonce = encrypted.Nonce[:encryption.NonceSize]
+
+			_, err = cryptor.Decrypt(encrypted)
+			Expect(err).To(MatchError("cipher: message authentication failed"))
+		})
+
+		Context("when the nonce has the wrong length", func() {
+			It("fails to decrypt", func() {
+				encrypted, err := xchachaCryptor.Encrypt([]byte("some plaintext data"))
+				Expect(err).NotTo(HaveOccurred())
+
+				encrypted.Nonce = encrypted.Nonce[:encryption.NonceSize]
+
+				_, err = xchachaCryptor.Decrypt(encrypted)
+				Expect(err).To(MatchError("Invalid nonce length: 12"))
+			})
+		})
+	})
 })
@@ -1,8 +1,11 @@
 package encryption
 
 import (
+	"bufio"
 	"errors"
 	"flag"
+	"fmt"
+	"os"
 	"strings"
 )
 
@@ -17,14 +20,31 @@ func (e EncryptionKeys) Set(key string) error {
 	return nil
 }
 
+// keyReferences lists the files or environment variables that hold encryption
+// keys, so that the keys themselves need not appear on the command line.
+type keyReferences []string
+
+func (keyReferences) String() string {
+	return ""
+}
+
+func (r *keyReferences) Set(reference string) error {
+	*r = append(*r, reference)
+	return nil
+}
+
 type EncryptionFlags struct {
-	activeKeyLabel string
-	encryptionKeys EncryptionKeys
+	activeKeyLabel      string
+	encryptionKeys      EncryptionKeys
+	encryptionKeyFiles  keyReferences
+	encryptionKeyEnvs   keyReferences
+	encryptionAlgorithm string
 }
 
 func NewEncryptionFlags() EncryptionFlags {
 	return EncryptionFlags{
-		encryptionKeys: make(EncryptionKeys),
+		encryptionKeys:      make(EncryptionKeys),
+		encryptionAlgorithm: DefaultAlgorithm.String(),
 	}
 }
 
@@ -35,17 +55,38 @@ func AddEncryptionFlags(flagSet *flag.FlagSet) *EncryptionFlags {
 		"encryptionKey",
 		"Encryption key in label:passphrase format (may be specified multiple times)",
 	)
+	flagSet.Var(
+		&ef.encryptionKeyFiles,
+		"encryptionKeyFile",
+		"Path to a file holding encryption keys in label:passphrase format, one per line (may be specified multiple times)",
+	)
+	flagSet.Var(
+		&ef.encryptionKeyEnvs,
+		"encryptionKeyEnv",
+		"Name of an environment variable holding an encryption key in label:passphrase format (may be specified multiple times)",
+	)
 	flagSet.StringVar(
 		&ef.activeKeyLabel,
 		"activeKeyLabel",
 		"",
 		"Label of the encryption key to be used when writing to the database",
 	)
+	flagSet.StringVar(
+		&ef.encryptionAlgorithm,
+		"encryptionAlgorithm",
+		ef.encryptionAlgorithm,
+		"Algorithm used when writing to the database (aes-256-gcm or xchacha20-poly1305); records written with either can be read",
+	)
 	return &ef
 }
 
 func (ef *EncryptionFlags) Parse() (Key, []Key, error) {
-	if len(ef.encryptionKeys) == 0 {
+	encryptionKeys, err := ef.resolveEncryptionKeys()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(encryptionKeys) == 0 {
 		return nil, nil, errors.New("Must have at least one encryption key set")
 	}
 
@@ -54,9 +95,9 @@ func (ef *EncryptionFlags) Parse() (Key, []Key, error) {
 	}
 
 	var encryptionKey Key
-	keys := make([]Key, 0, len(ef.encryptionKeys))
+	keys := make([]Key, 0, len(encryptionKeys))
 
-	for key := range ef.encryptionKeys {
+	for key := range encryptionKeys {
 		splitKey := strings.SplitN(key, ":", 2)
 		if len(splitKey) != 2 {
 			return nil, nil, errors.New("Could not parse encryption keys")
@@ -81,6 +122,73 @@ func (ef *EncryptionFlags) Parse() (Key, []Key, error) {
 	return encryptionKey, keys, nil
 }
 
+// resolveEncryptionKeys returns the keys given inline together with those read
+// from the referenced files and environment variables. A reference that
+// cannot be read, or that holds no key, is an error rather than being
+// skipped, so that a misconfigured reference cannot silently drop a key.
+func (ef *EncryptionFlags) resolveEncryptionKeys() (EncryptionKeys, error) {
+	encryptionKeys := make(EncryptionKeys, len(ef.encryptionKeys))
+	for key := range ef.encryptionKeys {
+		encryptionKeys[key] = struct{}{}
+	}
+
+	for _, path := range ef.encryptionKeyFiles {
+		keys, err := readEncryptionKeyFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			encryptionKeys[key] = struct{}{}
+		}
+	}
+
+	for _, name := range ef.encryptionKeyEnvs {
+		key, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, fmt.Errorf("Encryption key environment variable %s is not set", name)
+		}
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, fmt.Errorf("Encryption key environment variable %s is empty", name)
+		}
+		encryptionKeys[key] = struct{}{}
+	}
+
+	return encryptionKeys, nil
+}
+
+// readEncryptionKeyFile returns the keys in the file, one per non-blank line.
+func readEncryptionKeyFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read encryption key file: %s", err)
+	}
+	defer file.Close()
+
+	keys := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key := strings.TrimSpace(scanner.Text())
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Could not read encryption key file: %s", err)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("Encryption key file %s holds no keys", path)
+	}
+
+	return keys, nil
+}
+
+// Algorithm returns the algorithm selected to encrypt new records.
+func (ef *EncryptionFlags) Algorithm() (Algorithm, error) {
+	return ParseAlgorithm(ef.encryptionAlgorithm)
+}
+
 // keyManager, err := NewKeyManager(encryptionKey, keys)
 // if err != nil {
 // 	return nil, nil, err
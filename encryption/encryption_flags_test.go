@@ -2,6 +2,8 @@ package encryption_test
 
 import (
 	"flag"
+	"io/ioutil"
+	"os"
 
 	"code.cloudfoundry.org/bbs/encryption"
 
@@ -95,5 +97,134 @@ var _ = Describe("Encryption Flags", func() {
 			Expect(keyLabels).To(ContainElement("label"))
 			Expect(keyLabels).To(ContainElement("old-label"))
 		})
+
+		Context("when keys are read from environment variables", func() {
+			BeforeEach(func() {
+				os.Setenv("BBS_TEST_ENCRYPTION_KEY", "env-label:env-key")
+				os.Setenv("BBS_TEST_EMPTY_ENCRYPTION_KEY", " ")
+				os.Unsetenv("BBS_TEST_UNSET_ENCRYPTION_KEY")
+			})
+
+			AfterEach(func() {
+				os.Unsetenv("BBS_TEST_ENCRYPTION_KEY")
+				os.Unsetenv("BBS_TEST_EMPTY_ENCRYPTION_KEY")
+			})
+
+			It("returns them alongside the inline keys", func() {
+				args = append(args, "-encryptionKey="+"label:key")
+				args = append(args, "-encryptionKeyEnv="+"BBS_TEST_ENCRYPTION_KEY")
+				args = append(args, "-activeKeyLabel="+"env-label")
+				flagSet.Parse(args)
+
+				activeKey, keys, err := encryptionFlags.Parse()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(activeKey.Label()).To(Equal("env-label"))
+				Expect(keys).To(HaveLen(2))
+			})
+
+			It("fails if a variable is not set", func() {
+				args = append(args, "-encryptionKey="+"label:key")
+				args = append(args, "-encryptionKeyEnv="+"BBS_TEST_UNSET_ENCRYPTION_KEY")
+				args = append(args, "-activeKeyLabel="+"label")
+				flagSet.Parse(args)
+
+				_, _, err := encryptionFlags.Parse()
+				Expect(err).To(MatchError("Encryption key environment variable BBS_TEST_UNSET_ENCRYPTION_KEY is not set"))
+			})
+
+			It("fails if a variable is empty", func() {
+				args = append(args, "-encryptionKey="+"label:key")
+				args = append(args, "-encryptionKeyEnv="+"BBS_TEST_EMPTY_ENCRYPTION_KEY")
+				args = append(args, "-activeKeyLabel="+"label")
+				flagSet.Parse(args)
+
+				_, _, err := encryptionFlags.Parse()
+				Expect(err).To(MatchError("Encryption key environment variable BBS_TEST_EMPTY_ENCRYPTION_KEY is empty"))
+			})
+		})
+
+		Context("when keys are read from files", func() {
+			var keyFile *os.File
+
+			BeforeEach(func() {
+				var err error
+				keyFile, err = ioutil.TempFile("", "encryption-keys")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				os.Remove(keyFile.Name())
+			})
+
+			It("returns a key for each non-blank line", func() {
+				_, err := keyFile.WriteString("file-label:file-key\n\nold-label:old-key\n")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyFile.Close()).To(Succeed())
+
+				args = append(args, "-encryptionKeyFile="+keyFile.Name())
+				args = append(args, "-activeKeyLabel="+"file-label")
+				flagSet.Parse(args)
+
+				activeKey, keys, err := encryptionFlags.Parse()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(activeKey.Label()).To(Equal("file-label"))
+				Expect(keys).To(HaveLen(2))
+			})
+
+			It("fails if a file holds no keys", func() {
+				Expect(keyFile.Close()).To(Succeed())
+
+				args = append(args, "-encryptionKeyFile="+keyFile.Name())
+				args = append(args, "-activeKeyLabel="+"label")
+				flagSet.Parse(args)
+
+				_, _, err := encryptionFlags.Parse()
+				Expect(err).To(MatchError("Encryption key file " + keyFile.Name() + " holds no keys"))
+			})
+
+			It("fails if a file cannot be read", func() {
+				Expect(keyFile.Close()).To(Succeed())
+
+				args = append(args, "-encryptionKeyFile="+keyFile.Name()+"-missing")
+				args = append(args, "-activeKeyLabel="+"label")
+				flagSet.Parse(args)
+
+				_, _, err := encryptionFlags.Parse()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Algorithm", func() {
+		It("defaults to AES-256-GCM", func() {
+			flagSet.Parse([]string{})
+
+			algorithm, err := encryptionFlags.Algorithm()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(algorithm).To(Equal(encryption.AES256GCM))
+		})
+
+		It("returns the selected algorithm", func() {
+			flagSet.Parse([]string{"-encryptionAlgorithm=aes-256-gcm"})
+
+			algorithm, err := encryptionFlags.Algorithm()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(algorithm).To(Equal(encryption.AES256GCM))
+		})
+
+		It("returns XChaCha20-Poly1305 when it is selected", func() {
+			flagSet.Parse([]string{"-encryptionAlgorithm=xchacha20-poly1305"})
+
+			algorithm, err := encryptionFlags.Algorithm()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(algorithm).To(Equal(encryption.XChaCha20Poly1305))
+		})
+
+		It("rejects an unknown algorithm", func() {
+			flagSet.Parse([]string{"-encryptionAlgorithm=rot13"})
+
+			_, err := encryptionFlags.Algorithm()
+			Expect(err).To(MatchError(`Unknown encryption algorithm: "rot13"`))
+		})
 	})
 })
@@ -26,6 +26,12 @@ type FakeCryptor struct {
 		result1 []byte
 		result2 error
 	}
+	AlgorithmStub        func() encryption.Algorithm
+	algorithmMutex       sync.RWMutex
+	algorithmArgsForCall []struct{}
+	algorithmReturns     struct {
+		result1 encryption.Algorithm
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -103,6 +109,31 @@ func (fake *FakeCryptor) DecryptReturns(result1 []byte, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeCryptor) Algorithm() encryption.Algorithm {
+	fake.algorithmMutex.Lock()
+	fake.algorithmArgsForCall = append(fake.algorithmArgsForCall, struct{}{})
+	fake.recordInvocation("Algorithm", []interface{}{})
+	fake.algorithmMutex.Unlock()
+	if fake.AlgorithmStub != nil {
+		return fake.AlgorithmStub()
+	} else {
+		return fake.algorithmReturns.result1
+	}
+}
+
+func (fake *FakeCryptor) AlgorithmCallCount() int {
+	fake.algorithmMutex.RLock()
+	defer fake.algorithmMutex.RUnlock()
+	return len(fake.algorithmArgsForCall)
+}
+
+func (fake *FakeCryptor) AlgorithmReturns(result1 encryption.Algorithm) {
+	fake.AlgorithmStub = nil
+	fake.algorithmReturns = struct {
+		result1 encryption.Algorithm
+	}{result1}
+}
+
 func (fake *FakeCryptor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -110,6 +141,8 @@ func (fake *FakeCryptor) Invocations() map[string][][]interface{} {
 	defer fake.encryptMutex.RUnlock()
 	fake.decryptMutex.RLock()
 	defer fake.decryptMutex.RUnlock()
+	fake.algorithmMutex.RLock()
+	defer fake.algorithmMutex.RUnlock()
 	return fake.invocations
 }
 
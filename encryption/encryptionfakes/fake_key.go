@@ -21,6 +21,12 @@ type FakeKey struct {
 	blockReturns     struct {
 		result1 cipher.Block
 	}
+	SecretStub        func() []byte
+	secretMutex       sync.RWMutex
+	secretArgsForCall []struct{}
+	secretReturns     struct {
+		result1 []byte
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -75,6 +81,31 @@ func (fake *FakeKey) BlockReturns(result1 cipher.Block) {
 	}{result1}
 }
 
+func (fake *FakeKey) Secret() []byte {
+	fake.secretMutex.Lock()
+	fake.secretArgsForCall = append(fake.secretArgsForCall, struct{}{})
+	fake.recordInvocation("Secret", []interface{}{})
+	fake.secretMutex.Unlock()
+	if fake.SecretStub != nil {
+		return fake.SecretStub()
+	} else {
+		return fake.secretReturns.result1
+	}
+}
+
+func (fake *FakeKey) SecretCallCount() int {
+	fake.secretMutex.RLock()
+	defer fake.secretMutex.RUnlock()
+	return len(fake.secretArgsForCall)
+}
+
+func (fake *FakeKey) SecretReturns(result1 []byte) {
+	fake.SecretStub = nil
+	fake.secretReturns = struct {
+		result1 []byte
+	}{result1}
+}
+
 func (fake *FakeKey) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -82,6 +113,8 @@ func (fake *FakeKey) Invocations() map[string][][]interface{} {
 	defer fake.labelMutex.RUnlock()
 	fake.blockMutex.RLock()
 	defer fake.blockMutex.RUnlock()
+	fake.secretMutex.RLock()
+	defer fake.secretMutex.RUnlock()
 	return fake.invocations
 }
 
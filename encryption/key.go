@@ -12,11 +12,15 @@ import (
 type Key interface {
 	Label() string
 	Block() cipher.Block
+
+	// Secret is the 256-bit key the block cipher was created with.
+	Secret() []byte
 }
 
 type key struct {
-	block cipher.Block
-	label string
+	block  cipher.Block
+	secret []byte
+	label  string
 }
 
 func NewKey(label, phrase string) (Key, error) {
@@ -35,8 +39,9 @@ func NewKey(label, phrase string) (Key, error) {
 	}
 
 	return &key{
-		label: label,
-		block: block,
+		label:  label,
+		block:  block,
+		secret: hash[:],
 	}, nil
 }
 
@@ -47,3 +52,7 @@ func (k *key) Label() string {
 func (k *key) Block() cipher.Block {
 	return k.block
 }
+
+func (k *key) Secret() []byte {
+	return k.secret
+}
@@ -33,6 +33,7 @@ var _ = Describe("Key", func() {
 				block, err := aes.NewCipher(phraseHash[:])
 				Expect(err).NotTo(HaveOccurred())
 				Expect(key.Block()).To(Equal(block))
+				Expect(key.Secret()).To(Equal(phraseHash[:]))
 			}
 		})
 
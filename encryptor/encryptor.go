@@ -55,18 +55,29 @@ func (m Encryptor) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 		}
 	}
 
+	currentAlgorithm, err := m.currentAlgorithm(logger)
+	if err != nil {
+		return err
+	}
+
 	close(ready)
 	logger.Info("started")
 	defer logger.Info("finished")
 
-	if currentEncryptionKey != m.keyManager.EncryptionKey().Label() {
+	encryptionKey := m.keyManager.EncryptionKey().Label()
+	algorithm := m.cryptor.Algorithm()
+	if currentEncryptionKey != encryptionKey || currentAlgorithm != algorithm {
 		encryptionStart := m.clock.Now()
-		logger.Debug("encryption-started")
+		logger.Debug("encryption-started", lager.Data{
+			"previous_algorithm": currentAlgorithm.String(),
+			"algorithm":          algorithm.String(),
+		})
 		err := m.db.PerformEncryption(logger)
 		if err != nil {
 			logger.Error("encryption-failed", err)
 		} else {
-			m.db.SetEncryptionKeyLabel(logger, m.keyManager.EncryptionKey().Label())
+			m.db.SetEncryptionKeyLabel(logger, encryptionKey)
+			m.db.SetEncryptionAlgorithm(logger, algorithm.String())
 		}
 		totalTime := m.clock.Since(encryptionStart)
 		logger.Debug("encryption-finished", lager.Data{"total_time": totalTime})
@@ -79,3 +90,24 @@ func (m Encryptor) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	<-signals
 	return nil
 }
+
+// currentAlgorithm returns the algorithm the records were last encrypted with,
+// which is encryption.AES256GCM if none was recorded.
+func (m Encryptor) currentAlgorithm(logger lager.Logger) (encryption.Algorithm, error) {
+	name, err := m.db.EncryptionAlgorithm(logger)
+	if err != nil {
+		if models.ConvertError(err) != models.ErrResourceNotFound {
+			return 0, err
+		}
+		return encryption.AES256GCM, nil
+	}
+	if name == "" {
+		return encryption.AES256GCM, nil
+	}
+
+	algorithm, err := encryption.ParseAlgorithm(name)
+	if err != nil {
+		return 0, errors.New("Existing encryption algorithm (" + name + ") is not supported")
+	}
+	return algorithm, nil
+}
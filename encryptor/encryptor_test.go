@@ -162,4 +162,86 @@ var _ = Describe("Encryptor", func() {
 			Expect(newLabel).To(Equal("label"))
 		})
 	})
+
+	Context("when the records were encrypted with another algorithm", func() {
+		BeforeEach(func() {
+			fakeDB.EncryptionKeyLabelReturns("label", nil)
+			fakeDB.EncryptionAlgorithmReturns("xchacha20-poly1305", nil)
+			cryptor = encryption.NewCryptor(keyManager, rand.Reader)
+		})
+
+		It("re-encrypts all the existing records", func() {
+			Eventually(encryptorProcess.Ready()).Should(BeClosed())
+			Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
+
+			Expect(fakeDB.PerformEncryptionCallCount()).To(Equal(1))
+		})
+
+		It("writes the current encryption algorithm", func() {
+			Eventually(fakeDB.SetEncryptionAlgorithmCallCount).Should(Equal(1))
+			_, algorithm := fakeDB.SetEncryptionAlgorithmArgsForCall(0)
+			Expect(algorithm).To(Equal("aes-256-gcm"))
+		})
+
+		Context("when encrypting fails", func() {
+			BeforeEach(func() {
+				fakeDB.PerformEncryptionReturns(errors.New("something is broken"))
+			})
+
+			It("does not change the algorithm in the db", func() {
+				Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
+				Consistently(fakeDB.SetEncryptionAlgorithmCallCount).Should(Equal(0))
+			})
+		})
+	})
+
+	Context("when the records were encrypted with the current algorithm", func() {
+		BeforeEach(func() {
+			fakeDB.EncryptionKeyLabelReturns("label", nil)
+			fakeDB.EncryptionAlgorithmReturns("aes-256-gcm", nil)
+		})
+
+		It("does not re-encrypt the records", func() {
+			Eventually(encryptorProcess.Ready()).Should(BeClosed())
+			Consistently(fakeDB.PerformEncryptionCallCount).Should(Equal(0))
+		})
+	})
+
+	Context("when no algorithm has been recorded", func() {
+		BeforeEach(func() {
+			fakeDB.EncryptionKeyLabelReturns("label", nil)
+			fakeDB.EncryptionAlgorithmReturns("", models.ErrResourceNotFound)
+		})
+
+		It("assumes the records use AES-256-GCM", func() {
+			Eventually(encryptorProcess.Ready()).Should(BeClosed())
+			Consistently(fakeDB.PerformEncryptionCallCount).Should(Equal(0))
+		})
+	})
+
+	Context("when fetching the current encryption algorithm fails", func() {
+		BeforeEach(func() {
+			fakeDB.EncryptionAlgorithmReturns("", errors.New("can't fetch"))
+		})
+
+		It("fails early", func() {
+			var err error
+			Eventually(encryptorProcess.Wait()).Should(Receive(&err))
+			Expect(err).To(MatchError("can't fetch"))
+			Expect(encryptorProcess.Ready()).ToNot(BeClosed())
+		})
+	})
+
+	Context("when the current encryption algorithm is not supported", func() {
+		BeforeEach(func() {
+			fakeDB.EncryptionAlgorithmReturns("rot13", nil)
+		})
+
+		It("shuts down without signalling ready", func() {
+			var err error
+			Eventually(encryptorProcess.Wait()).Should(Receive(&err))
+			Expect(err).To(MatchError("Existing encryption algorithm (rot13) is not supported"))
+			Expect(encryptorProcess.Ready()).ToNot(BeClosed())
+		})
+	})
 })
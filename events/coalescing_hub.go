@@ -0,0 +1,180 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+)
+
+// NewCoalescingHub returns a Hub that holds back the LRP changed events
+// emitted to it for up to window, and collapses the changes made to the same
+// LRP in that time into a single event going from the state before the first
+// change to the state after the last one. Desired LRP changes are collapsed
+// per process guid, and actual LRP changes per process guid and index, so
+// the changes of different instances are never merged.
+//
+// Any other event for a process guid first flushes the changes held back for
+// it, so the events of a single LRP keep their order. Events of different
+// LRPs may be reordered with respect to each other.
+func NewCoalescingHub(hub Hub, clock clock.Clock, window time.Duration) Hub {
+	return &coalescingHub{
+		Hub:     hub,
+		clock:   clock,
+		window:  window,
+		pending: map[coalescingKey]models.Event{},
+	}
+}
+
+type coalescingHub struct {
+	Hub
+
+	clock  clock.Clock
+	window time.Duration
+
+	lock         sync.Mutex
+	pending      map[coalescingKey]models.Event
+	order        []coalescingKey
+	timerRunning bool
+	closed       bool
+}
+
+type coalescingKey struct {
+	eventType   string
+	processGuid string
+	index       int32
+}
+
+func (h *coalescingHub) Emit(event models.Event) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.closed {
+		h.Hub.Emit(event)
+		return
+	}
+
+	if key, ok := coalescingKeyFor(event); ok {
+		if previous, exists := h.pending[key]; exists {
+			h.pending[key] = coalesce(previous, event)
+			return
+		}
+
+		h.pending[key] = event
+		h.order = append(h.order, key)
+		if !h.timerRunning {
+			h.timerRunning = true
+			timer := h.clock.NewTimer(h.window)
+			go func() {
+				<-timer.C()
+				h.flush()
+			}()
+		}
+		return
+	}
+
+	if processGuid, ok := processGuidOf(event); ok {
+		h.emitPending(func(key coalescingKey) bool {
+			return key.processGuid == processGuid
+		})
+	}
+	h.Hub.Emit(event)
+}
+
+// Close emits the changes still held back before closing the hub, so that
+// they are kept in the replay buffer of the hub.
+func (h *coalescingHub) Close() error {
+	h.lock.Lock()
+	h.closed = true
+	h.emitPending(func(coalescingKey) bool { return true })
+	h.lock.Unlock()
+
+	return h.Hub.Close()
+}
+
+func (h *coalescingHub) flush() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.timerRunning = false
+	h.emitPending(func(coalescingKey) bool { return true })
+}
+
+// emitPending emits the held back changes whose key matches, in the order
+// their first change was emitted. The lock must be held.
+func (h *coalescingHub) emitPending(matches func(coalescingKey) bool) {
+	remaining := h.order[:0]
+	for _, key := range h.order {
+		if !matches(key) {
+			remaining = append(remaining, key)
+			continue
+		}
+
+		h.Hub.Emit(h.pending[key])
+		delete(h.pending, key)
+	}
+	h.order = remaining
+}
+
+func coalescingKeyFor(event models.Event) (coalescingKey, bool) {
+	switch event := event.(type) {
+	case *models.DesiredLRPChangedEvent:
+		if event.After == nil {
+			return coalescingKey{}, false
+		}
+		return coalescingKey{
+			eventType:   event.EventType(),
+			processGuid: event.After.ProcessGuid,
+		}, true
+	case *models.ActualLRPChangedEvent:
+		actualLRP, ok := resolveGroup(event.After)
+		if !ok {
+			return coalescingKey{}, false
+		}
+		return coalescingKey{
+			eventType:   event.EventType(),
+			processGuid: actualLRP.ProcessGuid,
+			index:       actualLRP.Index,
+		}, true
+	}
+	return coalescingKey{}, false
+}
+
+func coalesce(previous, next models.Event) models.Event {
+	switch next := next.(type) {
+	case *models.DesiredLRPChangedEvent:
+		return models.NewDesiredLRPChangedEvent(previous.(*models.DesiredLRPChangedEvent).Before, next.After)
+	case *models.ActualLRPChangedEvent:
+		return models.NewActualLRPChangedEvent(previous.(*models.ActualLRPChangedEvent).Before, next.After)
+	}
+	return next
+}
+
+func processGuidOf(event models.Event) (string, bool) {
+	switch event := event.(type) {
+	case *models.DesiredLRPCreatedEvent:
+		return event.DesiredLrp.GetProcessGuid(), event.DesiredLrp != nil
+	case *models.DesiredLRPRemovedEvent:
+		return event.DesiredLrp.GetProcessGuid(), event.DesiredLrp != nil
+	case *models.ActualLRPCreatedEvent:
+		if actualLRP, ok := resolveGroup(event.ActualLrpGroup); ok {
+			return actualLRP.ProcessGuid, true
+		}
+	case *models.ActualLRPRemovedEvent:
+		if actualLRP, ok := resolveGroup(event.ActualLrpGroup); ok {
+			return actualLRP.ProcessGuid, true
+		}
+	case *models.ActualLRPCrashedEvent:
+		return event.ProcessGuid, true
+	}
+	return "", false
+}
+
+func resolveGroup(group *models.ActualLRPGroup) (*models.ActualLRP, bool) {
+	if group == nil || (group.Instance == nil && group.Evacuating == nil) {
+		return nil, false
+	}
+	actualLRP, _ := group.Resolve()
+	return actualLRP, true
+}
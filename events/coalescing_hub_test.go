@@ -0,0 +1,155 @@
+package events_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CoalescingHub", func() {
+	const window = time.Second
+
+	var (
+		fakeClock *fakeclock.FakeClock
+		hub       events.Hub
+		received  chan models.Event
+	)
+
+	actualLRPGroup := func(processGuid string, index int32, state string) *models.ActualLRPGroup {
+		return &models.ActualLRPGroup{
+			Instance: &models.ActualLRP{
+				ActualLRPKey: models.NewActualLRPKey(processGuid, index, "domain"),
+				State:        state,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		innerHub := events.NewHub()
+		hub = events.NewCoalescingHub(innerHub, fakeClock, window)
+
+		source, err := innerHub.Subscribe()
+		Expect(err).NotTo(HaveOccurred())
+
+		received = make(chan models.Event, 10)
+		events := received
+		go func() {
+			for {
+				event, err := source.Next()
+				if err != nil {
+					close(events)
+					return
+				}
+				events <- event
+			}
+		}()
+	})
+
+	AfterEach(func() {
+		hub.Close()
+	})
+
+	It("collapses rapid changes to the same LRP into one event", func() {
+		unclaimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateUnclaimed)
+		claimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateClaimed)
+		running := actualLRPGroup("some-guid", 0, models.ActualLRPStateRunning)
+
+		hub.Emit(models.NewActualLRPChangedEvent(unclaimed, claimed))
+		hub.Emit(models.NewActualLRPChangedEvent(claimed, running))
+		Consistently(received).ShouldNot(Receive())
+
+		fakeClock.WaitForWatcherAndIncrement(window)
+
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPChangedEvent(unclaimed, running))))
+		Consistently(received).ShouldNot(Receive())
+	})
+
+	It("collapses rapid changes to the same desired LRP into one event", func() {
+		before := &models.DesiredLRP{ProcessGuid: "some-guid", Instances: 1}
+		middle := &models.DesiredLRP{ProcessGuid: "some-guid", Instances: 2}
+		after := &models.DesiredLRP{ProcessGuid: "some-guid", Instances: 3}
+
+		hub.Emit(models.NewDesiredLRPChangedEvent(before, middle))
+		hub.Emit(models.NewDesiredLRPChangedEvent(middle, after))
+
+		fakeClock.WaitForWatcherAndIncrement(window)
+
+		Eventually(received).Should(Receive(Equal(models.NewDesiredLRPChangedEvent(before, after))))
+		Consistently(received).ShouldNot(Receive())
+	})
+
+	It("keeps the changes to different LRPs and instances apart", func() {
+		unclaimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateUnclaimed)
+		claimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateClaimed)
+		otherIndexUnclaimed := actualLRPGroup("some-guid", 1, models.ActualLRPStateUnclaimed)
+		otherIndexClaimed := actualLRPGroup("some-guid", 1, models.ActualLRPStateClaimed)
+		otherGuidUnclaimed := actualLRPGroup("other-guid", 0, models.ActualLRPStateUnclaimed)
+		otherGuidClaimed := actualLRPGroup("other-guid", 0, models.ActualLRPStateClaimed)
+
+		hub.Emit(models.NewActualLRPChangedEvent(unclaimed, claimed))
+		hub.Emit(models.NewActualLRPChangedEvent(otherIndexUnclaimed, otherIndexClaimed))
+		hub.Emit(models.NewActualLRPChangedEvent(otherGuidUnclaimed, otherGuidClaimed))
+
+		fakeClock.WaitForWatcherAndIncrement(window)
+
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPChangedEvent(unclaimed, claimed))))
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPChangedEvent(otherIndexUnclaimed, otherIndexClaimed))))
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPChangedEvent(otherGuidUnclaimed, otherGuidClaimed))))
+	})
+
+	It("passes other events through immediately", func() {
+		desiredLRP := &models.DesiredLRP{ProcessGuid: "some-guid"}
+		hub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
+
+		Eventually(received).Should(Receive(Equal(models.NewDesiredLRPCreatedEvent(desiredLRP))))
+	})
+
+	It("emits the held back changes to an LRP before its other events", func() {
+		unclaimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateUnclaimed)
+		claimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateClaimed)
+		otherGuidUnclaimed := actualLRPGroup("other-guid", 0, models.ActualLRPStateUnclaimed)
+		otherGuidClaimed := actualLRPGroup("other-guid", 0, models.ActualLRPStateClaimed)
+
+		hub.Emit(models.NewActualLRPChangedEvent(unclaimed, claimed))
+		hub.Emit(models.NewActualLRPChangedEvent(otherGuidUnclaimed, otherGuidClaimed))
+		hub.Emit(models.NewActualLRPRemovedEvent(claimed))
+
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPChangedEvent(unclaimed, claimed))))
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPRemovedEvent(claimed))))
+		Consistently(received).ShouldNot(Receive())
+
+		fakeClock.WaitForWatcherAndIncrement(window)
+
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPChangedEvent(otherGuidUnclaimed, otherGuidClaimed))))
+	})
+
+	It("starts a new window once the held back changes are emitted", func() {
+		unclaimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateUnclaimed)
+		claimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateClaimed)
+		running := actualLRPGroup("some-guid", 0, models.ActualLRPStateRunning)
+
+		hub.Emit(models.NewActualLRPChangedEvent(unclaimed, claimed))
+		fakeClock.WaitForWatcherAndIncrement(window)
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPChangedEvent(unclaimed, claimed))))
+
+		hub.Emit(models.NewActualLRPChangedEvent(claimed, running))
+		fakeClock.WaitForWatcherAndIncrement(window)
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPChangedEvent(claimed, running))))
+	})
+
+	It("emits the held back changes when closed", func() {
+		unclaimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateUnclaimed)
+		claimed := actualLRPGroup("some-guid", 0, models.ActualLRPStateClaimed)
+
+		hub.Emit(models.NewActualLRPChangedEvent(unclaimed, claimed))
+		Expect(hub.Close()).To(Succeed())
+
+		Eventually(received).Should(Receive(Equal(models.NewActualLRPChangedEvent(unclaimed, claimed))))
+	})
+})
@@ -0,0 +1,75 @@
+package events
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+)
+
+// NewGzipTransport returns a RoundTripper that asks the BBS to gzip the event
+// stream and decompresses the response as it is read, so that the
+// RawEventSource reading the body sees the stream as if it were sent
+// uncompressed. Responses that are not gzipped are passed through as is.
+//
+// A nil base uses http.DefaultTransport.
+func NewGzipTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &gzipTransport{base: base}
+}
+
+type gzipTransport struct {
+	base http.RoundTripper
+}
+
+func (t *gzipTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Encoding") == "" {
+		// A RoundTripper must not modify the request it is given.
+		clone := *req
+		clone.Header = make(http.Header, len(req.Header)+1)
+		for k, v := range req.Header {
+			clone.Header[k] = v
+		}
+		clone.Header.Set("Accept-Encoding", "gzip")
+		req = &clone
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+		resp.Body = &gzipBody{body: resp.Body}
+	}
+
+	return resp, nil
+}
+
+// gzipBody reads the gzip header on the first Read rather than when the
+// response arrives, so that a stream with no events yet does not hold up the
+// round trip.
+type gzipBody struct {
+	body   io.ReadCloser
+	reader *gzip.Reader
+	err    error
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	if b.reader == nil && b.err == nil {
+		b.reader, b.err = gzip.NewReader(b.body)
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.reader.Read(p)
+}
+
+func (b *gzipBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,87 @@
+package events_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GzipTransport", func() {
+	var (
+		server         *httptest.Server
+		gzipResponse   bool
+		acceptEncoding chan string
+		client         *http.Client
+	)
+
+	BeforeEach(func() {
+		gzipResponse = true
+		acceptEncoding = make(chan string, 1)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			acceptEncoding <- r.Header.Get("Accept-Encoding")
+			if !gzipResponse {
+				w.Write([]byte("plain"))
+				return
+			}
+
+			w.Header().Set("Content-Encoding", "gzip")
+			gzipWriter := gzip.NewWriter(w)
+			gzipWriter.Write([]byte("compressed"))
+			gzipWriter.Close()
+		}))
+
+		client = &http.Client{Transport: events.NewGzipTransport(&http.Transport{DisableCompression: true})}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("asks for gzip without modifying the request", func() {
+		request, err := http.NewRequest("GET", server.URL, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		response, err := client.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+		response.Body.Close()
+
+		Expect(acceptEncoding).To(Receive(Equal("gzip")))
+		Expect(request.Header.Get("Accept-Encoding")).To(BeEmpty())
+	})
+
+	It("decompresses gzipped responses", func() {
+		response, err := client.Get(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+
+		Expect(response.Header.Get("Content-Encoding")).To(BeEmpty())
+		Expect(response.Uncompressed).To(BeTrue())
+
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(Equal("compressed"))
+	})
+
+	Context("when the response is not gzipped", func() {
+		BeforeEach(func() {
+			gzipResponse = false
+		})
+
+		It("passes the response through", func() {
+			response, err := client.Get(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			defer response.Body.Close()
+
+			Expect(response.Uncompressed).To(BeFalse())
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("plain"))
+		})
+	})
+})
@@ -5,7 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"strconv"
+	"net/http"
 
 	"code.cloudfoundry.org/bbs/models"
 	"github.com/gogo/protobuf/proto"
@@ -53,7 +53,7 @@ func (e closeError) Error() string {
 	return fmt.Sprintf("error closing raw source: %s", e.err.Error())
 }
 
-func NewEventFromModelEvent(eventID int, event models.Event) (sse.Event, error) {
+func NewEventFromModelEvent(eventID string, event models.Event) (sse.Event, error) {
 	payload, err := proto.Marshal(event)
 	if err != nil {
 		return sse.Event{}, err
@@ -61,12 +61,36 @@ func NewEventFromModelEvent(eventID int, event models.Event) (sse.Event, error)
 
 	encodedPayload := base64.StdEncoding.EncodeToString(payload)
 	return sse.Event{
-		ID:   strconv.Itoa(eventID),
+		ID:   eventID,
 		Name: string(event.EventType()),
 		Data: []byte(encodedPayload),
 	}, nil
 }
 
+// NewEnvelopeFromModelEvent wraps an event for the gRPC event stream. The
+// envelope carries the same event type and protobuf payload as an SSE event.
+func NewEnvelopeFromModelEvent(event models.Event) (*models.EventEnvelope, error) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.EventEnvelope{
+		Type:    string(event.EventType()),
+		Payload: payload,
+	}, nil
+}
+
+// NewModelEventFromEnvelope unwraps an event received from the gRPC event
+// stream.
+func NewModelEventFromEnvelope(envelope *models.EventEnvelope) (models.Event, error) {
+	if len(envelope.Payload) == 0 {
+		return nil, NewInvalidPayloadError(envelope.Type, errors.New("empty payload"))
+	}
+
+	return decodeEvent(envelope.Type, envelope.Payload)
+}
+
 //go:generate counterfeiter -o eventfakes/fake_event_source.go . EventSource
 
 // EventSource provides sequential access to a stream of events.
@@ -76,7 +100,9 @@ type EventSource interface {
 	//
 	// If the end of the stream is reached cleanly (which should actually never
 	// happen), io.EOF is returned. If called after or during Close,
-	// ErrSourceClosed is returned.
+	// ErrSourceClosed is returned. If the connection cannot be resumed without
+	// missing events, ErrResyncRequired is returned; the caller should resync
+	// its state and subscribe again.
 	Next() (models.Event, error)
 
 	// Close releases the underlying response, interrupts any in-flight Next, and
@@ -104,6 +130,15 @@ func NewEventSource(raw RawEventSource) EventSource {
 func (e *eventSource) Next() (models.Event, error) {
 	rawEvent, err := e.rawEventSource.Next()
 	if err != nil {
+		switch err := err.(type) {
+		case sse.BadResponseError:
+			// The BBS responds with 410 Gone to a reconnection it cannot
+			// resume from the last event received.
+			if err.Response.StatusCode == http.StatusGone {
+				return nil, ErrResyncRequired
+			}
+		}
+
 		switch err {
 		case io.EOF:
 			return nil, err
@@ -133,12 +168,17 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 	if len(data) == 0 || err != nil {
 		return nil, NewInvalidPayloadError(rawEvent.Name, err)
 	}
-	switch rawEvent.Name {
+
+	return decodeEvent(rawEvent.Name, data)
+}
+
+func decodeEvent(eventType string, data []byte) (models.Event, error) {
+	switch eventType {
 	case models.EventTypeDesiredLRPCreated:
 		event := new(models.DesiredLRPCreatedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -147,7 +187,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.DesiredLRPChangedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -156,7 +196,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.DesiredLRPRemovedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -165,7 +205,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPCreatedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -174,7 +214,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPChangedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -183,7 +223,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPRemovedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -192,7 +232,34 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPCrashedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeTaskCreated:
+		event := new(models.TaskCreatedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeTaskChanged:
+		event := new(models.TaskChangedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeTaskRemoved:
+		event := new(models.TaskRemovedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
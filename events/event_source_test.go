@@ -4,10 +4,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"io"
+	"net/http"
 
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"github.com/gogo/protobuf/proto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -253,6 +255,103 @@ var _ = Describe("EventSource", func() {
 			})
 		})
 
+		Describe("Task Events", func() {
+			var task, changedTask *models.Task
+
+			BeforeEach(func() {
+				task = model_helpers.NewValidTask("some-guid")
+				changedTask = model_helpers.NewValidTask("some-guid")
+				changedTask.State = models.Task_Running
+			})
+
+			Context("when receiving a TaskCreatedEvent", func() {
+				var expectedEvent *models.TaskCreatedEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewTaskCreatedEvent(task)
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					taskCreatedEvent, ok := event.(*models.TaskCreatedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(taskCreatedEvent).To(Equal(expectedEvent))
+				})
+			})
+
+			Context("when receiving a TaskChangedEvent", func() {
+				var expectedEvent *models.TaskChangedEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewTaskChangedEvent(task, changedTask)
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					taskChangedEvent, ok := event.(*models.TaskChangedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(taskChangedEvent).To(Equal(expectedEvent))
+				})
+			})
+
+			Context("when receiving a TaskRemovedEvent", func() {
+				var expectedEvent *models.TaskRemovedEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewTaskRemovedEvent(task)
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					taskRemovedEvent, ok := event.(*models.TaskRemovedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(taskRemovedEvent).To(Equal(expectedEvent))
+				})
+			})
+		})
+
 		Context("when receiving an unrecognized event", func() {
 			BeforeEach(func() {
 				payload := []byte(base64.StdEncoding.EncodeToString([]byte("garbage")))
@@ -362,6 +461,19 @@ var _ = Describe("EventSource", func() {
 				Expect(err).To(Equal(events.ErrSourceClosed))
 			})
 		})
+
+		Context("when the BBS refuses to resume the event stream", func() {
+			BeforeEach(func() {
+				fakeRawEventSource.NextReturns(sse.Event{}, sse.BadResponseError{
+					Response: &http.Response{StatusCode: http.StatusGone, Status: "410 Gone"},
+				})
+			})
+
+			It("returns events.ErrResyncRequired", func() {
+				_, err := eventSource.Next()
+				Expect(err).To(Equal(events.ErrResyncRequired))
+			})
+		})
 	})
 
 	Describe("Close", func() {
@@ -397,3 +509,33 @@ var _ = Describe("EventSource", func() {
 		})
 	})
 })
+
+var _ = Describe("Event envelopes", func() {
+	It("round-trips an event through an envelope", func() {
+		event := models.NewActualLRPCrashedEvent(&models.ActualLRP{
+			ActualLRPKey:         models.NewActualLRPKey("some-guid", 1, "some-domain"),
+			ActualLRPInstanceKey: models.NewActualLRPInstanceKey("instance-guid", "cell-id"),
+			CrashCount:           2,
+			CrashReason:          "oops",
+			Since:                1,
+		})
+
+		envelope, err := events.NewEnvelopeFromModelEvent(event)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(envelope.Type).To(Equal(models.EventTypeActualLRPCrashed))
+
+		unwrapped, err := events.NewModelEventFromEnvelope(envelope)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unwrapped).To(Equal(event))
+	})
+
+	It("rejects an envelope of an unknown type", func() {
+		_, err := events.NewModelEventFromEnvelope(&models.EventEnvelope{Type: "unknown", Payload: []byte{0}})
+		Expect(err).To(Equal(events.ErrUnrecognizedEventType))
+	})
+
+	It("rejects an envelope without a payload", func() {
+		_, err := events.NewModelEventFromEnvelope(&models.EventEnvelope{Type: models.EventTypeActualLRPCrashed})
+		Expect(err).To(HaveOccurred())
+	})
+})
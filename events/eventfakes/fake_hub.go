@@ -9,11 +9,20 @@ import (
 )
 
 type FakeHub struct {
-	SubscribeStub        func() (events.EventSource, error)
+	SubscribeStub        func() (events.ResumableEventSource, error)
 	subscribeMutex       sync.RWMutex
 	subscribeArgsForCall []struct{}
 	subscribeReturns     struct {
-		result1 events.EventSource
+		result1 events.ResumableEventSource
+		result2 error
+	}
+	SubscribeFromStub        func(token events.ResumeToken) (events.ResumableEventSource, error)
+	subscribeFromMutex       sync.RWMutex
+	subscribeFromArgsForCall []struct {
+		token events.ResumeToken
+	}
+	subscribeFromReturns struct {
+		result1 events.ResumableEventSource
 		result2 error
 	}
 	EmitStub        func(models.Event)
@@ -27,6 +36,12 @@ type FakeHub struct {
 	closeReturns     struct {
 		result1 error
 	}
+	SubscriberCountStub        func() int
+	subscriberCountMutex       sync.RWMutex
+	subscriberCountArgsForCall []struct{}
+	subscriberCountReturns     struct {
+		result1 int
+	}
 	RegisterCallbackStub        func(func(count int))
 	registerCallbackMutex       sync.RWMutex
 	registerCallbackArgsForCall []struct {
@@ -35,11 +50,17 @@ type FakeHub struct {
 	UnregisterCallbackStub        func()
 	unregisterCallbackMutex       sync.RWMutex
 	unregisterCallbackArgsForCall []struct{}
-	invocations                   map[string][][]interface{}
-	invocationsMutex              sync.RWMutex
+	StatsStub                     func() events.HubStats
+	statsMutex                    sync.RWMutex
+	statsArgsForCall              []struct{}
+	statsReturns                  struct {
+		result1 events.HubStats
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeHub) Subscribe() (events.EventSource, error) {
+func (fake *FakeHub) Subscribe() (events.ResumableEventSource, error) {
 	fake.subscribeMutex.Lock()
 	fake.subscribeArgsForCall = append(fake.subscribeArgsForCall, struct{}{})
 	fake.recordInvocation("Subscribe", []interface{}{})
@@ -57,10 +78,44 @@ func (fake *FakeHub) SubscribeCallCount() int {
 	return len(fake.subscribeArgsForCall)
 }
 
-func (fake *FakeHub) SubscribeReturns(result1 events.EventSource, result2 error) {
+func (fake *FakeHub) SubscribeReturns(result1 events.ResumableEventSource, result2 error) {
 	fake.SubscribeStub = nil
 	fake.subscribeReturns = struct {
-		result1 events.EventSource
+		result1 events.ResumableEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHub) SubscribeFrom(token events.ResumeToken) (events.ResumableEventSource, error) {
+	fake.subscribeFromMutex.Lock()
+	fake.subscribeFromArgsForCall = append(fake.subscribeFromArgsForCall, struct {
+		token events.ResumeToken
+	}{token})
+	fake.recordInvocation("SubscribeFrom", []interface{}{token})
+	fake.subscribeFromMutex.Unlock()
+	if fake.SubscribeFromStub != nil {
+		return fake.SubscribeFromStub(token)
+	} else {
+		return fake.subscribeFromReturns.result1, fake.subscribeFromReturns.result2
+	}
+}
+
+func (fake *FakeHub) SubscribeFromCallCount() int {
+	fake.subscribeFromMutex.RLock()
+	defer fake.subscribeFromMutex.RUnlock()
+	return len(fake.subscribeFromArgsForCall)
+}
+
+func (fake *FakeHub) SubscribeFromArgsForCall(i int) events.ResumeToken {
+	fake.subscribeFromMutex.RLock()
+	defer fake.subscribeFromMutex.RUnlock()
+	return fake.subscribeFromArgsForCall[i].token
+}
+
+func (fake *FakeHub) SubscribeFromReturns(result1 events.ResumableEventSource, result2 error) {
+	fake.SubscribeFromStub = nil
+	fake.subscribeFromReturns = struct {
+		result1 events.ResumableEventSource
 		result2 error
 	}{result1, result2}
 }
@@ -114,6 +169,31 @@ func (fake *FakeHub) CloseReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHub) SubscriberCount() int {
+	fake.subscriberCountMutex.Lock()
+	fake.subscriberCountArgsForCall = append(fake.subscriberCountArgsForCall, struct{}{})
+	fake.recordInvocation("SubscriberCount", []interface{}{})
+	fake.subscriberCountMutex.Unlock()
+	if fake.SubscriberCountStub != nil {
+		return fake.SubscriberCountStub()
+	} else {
+		return fake.subscriberCountReturns.result1
+	}
+}
+
+func (fake *FakeHub) SubscriberCountCallCount() int {
+	fake.subscriberCountMutex.RLock()
+	defer fake.subscriberCountMutex.RUnlock()
+	return len(fake.subscriberCountArgsForCall)
+}
+
+func (fake *FakeHub) SubscriberCountReturns(result1 int) {
+	fake.SubscriberCountStub = nil
+	fake.subscriberCountReturns = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeHub) RegisterCallback(arg1 func(count int)) {
 	fake.registerCallbackMutex.Lock()
 	fake.registerCallbackArgsForCall = append(fake.registerCallbackArgsForCall, struct {
@@ -154,19 +234,50 @@ func (fake *FakeHub) UnregisterCallbackCallCount() int {
 	return len(fake.unregisterCallbackArgsForCall)
 }
 
+func (fake *FakeHub) Stats() events.HubStats {
+	fake.statsMutex.Lock()
+	fake.statsArgsForCall = append(fake.statsArgsForCall, struct{}{})
+	fake.recordInvocation("Stats", []interface{}{})
+	fake.statsMutex.Unlock()
+	if fake.StatsStub != nil {
+		return fake.StatsStub()
+	} else {
+		return fake.statsReturns.result1
+	}
+}
+
+func (fake *FakeHub) StatsCallCount() int {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return len(fake.statsArgsForCall)
+}
+
+func (fake *FakeHub) StatsReturns(result1 events.HubStats) {
+	fake.StatsStub = nil
+	fake.statsReturns = struct {
+		result1 events.HubStats
+	}{result1}
+}
+
 func (fake *FakeHub) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.subscribeMutex.RLock()
 	defer fake.subscribeMutex.RUnlock()
+	fake.subscribeFromMutex.RLock()
+	defer fake.subscribeFromMutex.RUnlock()
 	fake.emitMutex.RLock()
 	defer fake.emitMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.subscriberCountMutex.RLock()
+	defer fake.subscriberCountMutex.RUnlock()
 	fake.registerCallbackMutex.RLock()
 	defer fake.registerCallbackMutex.RUnlock()
 	fake.unregisterCallbackMutex.RLock()
 	defer fake.unregisterCallbackMutex.RUnlock()
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -2,7 +2,11 @@ package events
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/bbs/models"
 )
@@ -16,15 +20,100 @@ var ErrSlowConsumer = errors.New("slow consumer")
 
 var ErrSubscribedToClosedHub = errors.New("subscribed to closed hub")
 var ErrHubAlreadyClosed = errors.New("hub already closed")
+var ErrResyncRequired = errors.New("cannot resume event stream, resync required")
+
+// ResyncPolicy decides what happens when a subscriber resumes from a position
+// the hub can no longer replay from, because its replay buffer has rolled
+// past it or it was handed out by another hub.
+type ResyncPolicy int
+
+const (
+	// ContinueWithoutReplay subscribes from the current position, as if no
+	// resume token had been given. The subscriber misses the events in between.
+	ContinueWithoutReplay ResyncPolicy = iota
+	// RequireResync refuses the subscription with ErrResyncRequired, so that the
+	// subscriber knows to resync its state before subscribing again.
+	RequireResync
+)
+
+// ResumeToken identifies the position of an event in the stream of a hub.
+// Sequence numbers increase by one for every emitted event, and are only
+// meaningful for the hub with the same Generation.
+type ResumeToken struct {
+	Generation string
+	Sequence   uint64
+}
+
+func (t ResumeToken) String() string {
+	return fmt.Sprintf("%s:%d", t.Generation, t.Sequence)
+}
+
+func ParseResumeToken(token string) (ResumeToken, error) {
+	parts := strings.Split(token, ":")
+	if len(parts) != 2 || parts[0] == "" {
+		return ResumeToken{}, fmt.Errorf("invalid resume token: %q", token)
+	}
+
+	sequence, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return ResumeToken{}, fmt.Errorf("invalid resume token: %q", token)
+	}
+
+	return ResumeToken{Generation: parts[0], Sequence: sequence}, nil
+}
+
+// ResumableEventSource is an EventSource that reports the position of each
+// event it reads, so that its subscriber can resume from there.
+type ResumableEventSource interface {
+	EventSource
+
+	// NextWithToken is Next, also returning the token to resume after the
+	// event.
+	NextWithToken() (models.Event, ResumeToken, error)
+
+	// StartToken returns the token to resume from the position the source was
+	// subscribed at, before any of its events.
+	StartToken() ResumeToken
+}
 
 //go:generate counterfeiter -o eventfakes/fake_hub.go . Hub
 type Hub interface {
-	Subscribe() (EventSource, error)
+	Subscribe() (ResumableEventSource, error)
+
+	// SubscribeFrom subscribes after the position of the token, first replaying
+	// the buffered events emitted since then. If the hub cannot replay from
+	// there, it applies its ResyncPolicy.
+	SubscribeFrom(token ResumeToken) (ResumableEventSource, error)
+
 	Emit(models.Event)
 	Close() error
 
+	// SubscriberCount returns the number of subscribers that have not been
+	// closed.
+	SubscriberCount() int
+
 	RegisterCallback(func(count int))
 	UnregisterCallback()
+
+	// Stats reports how far behind the subscribers are in reading the events
+	// emitted to them.
+	Stats() HubStats
+}
+
+// HubStats reports how far behind the subscribers of a hub are. A subscriber
+// whose queue fills up is closed as a slow consumer, so a MaxQueuedEvents
+// approaching MAX_PENDING_SUBSCRIBER_EVENTS warns of evictions to come.
+type HubStats struct {
+	Subscribers int
+	// QueuedEvents is the number of emitted events that the subscribers have
+	// yet to read, summed over all of them.
+	QueuedEvents int
+	// MaxQueuedEvents is the most events any single subscriber has yet to
+	// read.
+	MaxQueuedEvents int
+	// OldestQueuedEventAge is how long ago the oldest event that a subscriber
+	// has yet to read was emitted, or 0 when every event has been read.
+	OldestQueuedEventAge time.Duration
 }
 
 type hub struct {
@@ -32,12 +121,33 @@ type hub struct {
 	closed      bool
 	lock        sync.Mutex
 
+	generation   string
+	sequence     uint64
+	replay       *replayBuffer
+	resyncPolicy ResyncPolicy
+
+	// emitTimes holds when the most recent events were emitted, indexed by
+	// sequence modulo its length, for the age reported by Stats.
+	emitTimes []time.Time
+
 	cb func(count int)
 }
 
+// NewHub returns a hub without a replay buffer, whose subscribers cannot be
+// resumed.
 func NewHub() Hub {
+	return NewReplayingHub(0, ContinueWithoutReplay)
+}
+
+// NewReplayingHub returns a hub that retains its last replayBufferSize events
+// for subscribers resuming with SubscribeFrom.
+func NewReplayingHub(replayBufferSize int, resyncPolicy ResyncPolicy) Hub {
 	return &hub{
-		subscribers: make(map[*hubSource]struct{}),
+		subscribers:  make(map[*hubSource]struct{}),
+		generation:   strconv.FormatInt(time.Now().UnixNano(), 36),
+		replay:       newReplayBuffer(replayBufferSize),
+		resyncPolicy: resyncPolicy,
+		emitTimes:    make([]time.Time, MAX_PENDING_SUBSCRIBER_EVENTS),
 	}
 }
 
@@ -57,7 +167,22 @@ func (hub *hub) UnregisterCallback() {
 	hub.lock.Unlock()
 }
 
-func (hub *hub) Subscribe() (EventSource, error) {
+func (hub *hub) SubscriberCount() int {
+	hub.lock.Lock()
+	defer hub.lock.Unlock()
+
+	return len(hub.subscribers)
+}
+
+func (hub *hub) Subscribe() (ResumableEventSource, error) {
+	return hub.subscribe(nil)
+}
+
+func (hub *hub) SubscribeFrom(token ResumeToken) (ResumableEventSource, error) {
+	return hub.subscribe(&token)
+}
+
+func (hub *hub) subscribe(token *ResumeToken) (ResumableEventSource, error) {
 	hub.lock.Lock()
 
 	if hub.closed {
@@ -66,7 +191,22 @@ func (hub *hub) Subscribe() (EventSource, error) {
 		return nil, ErrSubscribedToClosedHub
 	}
 
-	sub := newSource(MAX_PENDING_SUBSCRIBER_EVENTS, hub.subscriberClosed)
+	var missed []sequencedEvent
+	if token != nil {
+		var ok bool
+		missed, ok = hub.eventsSince(*token)
+		if !ok && hub.resyncPolicy == RequireResync {
+			hub.lock.Unlock()
+
+			return nil, ErrResyncRequired
+		}
+	}
+
+	start := ResumeToken{Generation: hub.generation, Sequence: hub.sequence - uint64(len(missed))}
+	sub := newSource(MAX_PENDING_SUBSCRIBER_EVENTS+len(missed), start, hub.subscriberClosed)
+	for _, event := range missed {
+		sub.events <- event
+	}
 	hub.subscribers[sub] = struct{}{}
 	cb := hub.cb
 	size := len(hub.subscribers)
@@ -78,12 +218,27 @@ func (hub *hub) Subscribe() (EventSource, error) {
 	return sub, nil
 }
 
+// eventsSince returns the buffered events emitted after the position of the
+// token, or false if the hub cannot replay all of them.
+func (hub *hub) eventsSince(token ResumeToken) ([]sequencedEvent, bool) {
+	if token.Generation != hub.generation || token.Sequence > hub.sequence {
+		return nil, false
+	}
+
+	return hub.replay.last(hub.sequence - token.Sequence)
+}
+
 func (hub *hub) Emit(event models.Event) {
 	hub.lock.Lock()
 	size := len(hub.subscribers)
 
+	hub.sequence++
+	sequenced := sequencedEvent{event: event, sequence: hub.sequence}
+	hub.replay.add(sequenced)
+	hub.emitTimes[hub.sequence%uint64(len(hub.emitTimes))] = time.Now()
+
 	for sub, _ := range hub.subscribers {
-		err := sub.send(event)
+		err := sub.send(sequenced)
 		if err != nil {
 			delete(hub.subscribers, sub)
 		}
@@ -101,6 +256,42 @@ func (hub *hub) Emit(event models.Event) {
 	}
 }
 
+// Stats relies on every subscriber being sent each event as it is emitted,
+// so that the queue of a subscriber always ends with the latest event and the
+// longest queue starts with the oldest unread one.
+func (hub *hub) Stats() HubStats {
+	hub.lock.Lock()
+	defer hub.lock.Unlock()
+
+	stats := HubStats{Subscribers: len(hub.subscribers)}
+	for sub := range hub.subscribers {
+		queued := len(sub.events)
+		stats.QueuedEvents += queued
+		if queued > stats.MaxQueuedEvents {
+			stats.MaxQueuedEvents = queued
+		}
+	}
+
+	if stats.MaxQueuedEvents > 0 {
+		stats.OldestQueuedEventAge = time.Since(hub.emitTime(stats.MaxQueuedEvents))
+	}
+
+	return stats
+}
+
+// emitTime returns when the first event of a subscriber queue of the given
+// length was emitted. A queue of replayed events can be longer than emitTimes,
+// in which case it returns when the oldest event emitTimes holds was emitted,
+// understating the age of the queue.
+func (hub *hub) emitTime(queued int) time.Time {
+	size := uint64(len(hub.emitTimes))
+	behind := uint64(queued - 1)
+	if behind >= size {
+		behind = size - 1
+	}
+	return hub.emitTimes[(hub.sequence-behind)%size]
+}
+
 func (hub *hub) Close() error {
 	hub.lock.Lock()
 	defer hub.lock.Unlock()
@@ -136,26 +327,81 @@ func (hub *hub) subscriberClosed(source *hubSource) {
 	}
 }
 
+type sequencedEvent struct {
+	event    models.Event
+	sequence uint64
+}
+
+// replayBuffer is a ring buffer of the most recently emitted events.
+type replayBuffer struct {
+	events []sequencedEvent
+	next   int
+	count  int
+}
+
+func newReplayBuffer(size int) *replayBuffer {
+	return &replayBuffer{events: make([]sequencedEvent, size)}
+}
+
+func (b *replayBuffer) add(event sequencedEvent) {
+	if len(b.events) == 0 {
+		return
+	}
+
+	b.events[b.next] = event
+	b.next = (b.next + 1) % len(b.events)
+	if b.count < len(b.events) {
+		b.count++
+	}
+}
+
+// last returns the n most recent events, oldest first, or false if fewer
+// than n are buffered.
+func (b *replayBuffer) last(n uint64) ([]sequencedEvent, bool) {
+	if n > uint64(b.count) {
+		return nil, false
+	}
+
+	events := make([]sequencedEvent, 0, n)
+	for i := len(b.events) - int(n); i < len(b.events); i++ {
+		events = append(events, b.events[(b.next+i)%len(b.events)])
+	}
+	return events, true
+}
+
 type hubSource struct {
-	events        chan models.Event
+	events        chan sequencedEvent
+	generation    string
+	start         uint64
 	closeCallback func(*hubSource)
 	closed        bool
 	lock          sync.Mutex
 }
 
-func newSource(maxPendingEvents int, closeCallback func(*hubSource)) *hubSource {
+func newSource(maxPendingEvents int, start ResumeToken, closeCallback func(*hubSource)) *hubSource {
 	return &hubSource{
-		events:        make(chan models.Event, maxPendingEvents),
+		events:        make(chan sequencedEvent, maxPendingEvents),
+		generation:    start.Generation,
+		start:         start.Sequence,
 		closeCallback: closeCallback,
 	}
 }
 
 func (source *hubSource) Next() (models.Event, error) {
+	event, _, err := source.NextWithToken()
+	return event, err
+}
+
+func (source *hubSource) NextWithToken() (models.Event, ResumeToken, error) {
 	event, ok := <-source.events
 	if !ok {
-		return nil, ErrReadFromClosedSource
+		return nil, ResumeToken{}, ErrReadFromClosedSource
 	}
-	return event, nil
+	return event.event, ResumeToken{Generation: source.generation, Sequence: event.sequence}, nil
+}
+
+func (source *hubSource) StartToken() ResumeToken {
+	return ResumeToken{Generation: source.generation, Sequence: source.start}
 }
 
 func (source *hubSource) Close() error {
@@ -171,7 +417,7 @@ func (source *hubSource) Close() error {
 	return nil
 }
 
-func (source *hubSource) send(event models.Event) error {
+func (source *hubSource) send(event sequencedEvent) error {
 	source.lock.Lock()
 
 	if source.closed {
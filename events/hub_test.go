@@ -2,6 +2,7 @@ package events_test
 
 import (
 	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
@@ -134,6 +135,102 @@ var _ = Describe("Hub", func() {
 		Expect(err).To(Equal(events.ErrReadFromClosedSource))
 	})
 
+	Describe("resuming a subscription", func() {
+		var (
+			resyncPolicy events.ResyncPolicy
+			token        events.ResumeToken
+		)
+
+		BeforeEach(func() {
+			resyncPolicy = events.RequireResync
+		})
+
+		JustBeforeEach(func() {
+			hub = events.NewReplayingHub(3, resyncPolicy)
+
+			source, err := hub.Subscribe()
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(eventfakes.FakeEvent{Token: "1"})
+			_, token, err = source.NextWithToken()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(source.Close()).To(Succeed())
+		})
+
+		Context("when the missed events are still buffered", func() {
+			JustBeforeEach(func() {
+				hub.Emit(eventfakes.FakeEvent{Token: "2"})
+				hub.Emit(eventfakes.FakeEvent{Token: "3"})
+			})
+
+			It("replays the missed events before new ones", func() {
+				source, err := hub.SubscribeFrom(token)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(source.StartToken()).To(Equal(token))
+
+				hub.Emit(eventfakes.FakeEvent{Token: "4"})
+
+				Expect(source.Next()).To(Equal(eventfakes.FakeEvent{Token: "2"}))
+				Expect(source.Next()).To(Equal(eventfakes.FakeEvent{Token: "3"}))
+
+				event, lastToken, err := source.NextWithToken()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event).To(Equal(eventfakes.FakeEvent{Token: "4"}))
+				Expect(lastToken).To(Equal(events.ResumeToken{Generation: token.Generation, Sequence: token.Sequence + 3}))
+			})
+		})
+
+		Context("when the buffer has rolled past the token", func() {
+			JustBeforeEach(func() {
+				for eventToken := 2; eventToken <= 5; eventToken++ {
+					hub.Emit(eventfakes.FakeEvent{Token: strconv.Itoa(eventToken)})
+				}
+			})
+
+			It("requires a resync", func() {
+				_, err := hub.SubscribeFrom(token)
+				Expect(err).To(Equal(events.ErrResyncRequired))
+			})
+
+			Context("when the hub continues without replay", func() {
+				BeforeEach(func() {
+					resyncPolicy = events.ContinueWithoutReplay
+				})
+
+				It("subscribes from the current position", func() {
+					source, err := hub.SubscribeFrom(token)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(source.StartToken().Sequence).To(Equal(token.Sequence + 4))
+
+					hub.Emit(eventfakes.FakeEvent{Token: "6"})
+					Expect(source.Next()).To(Equal(eventfakes.FakeEvent{Token: "6"}))
+				})
+			})
+		})
+
+		Context("when the token was handed out by another hub", func() {
+			It("requires a resync", func() {
+				_, err := hub.SubscribeFrom(events.ResumeToken{Generation: "other", Sequence: token.Sequence})
+				Expect(err).To(Equal(events.ErrResyncRequired))
+			})
+		})
+	})
+
+	Describe("ResumeToken", func() {
+		It("round-trips through its string form", func() {
+			token := events.ResumeToken{Generation: "gen", Sequence: 42}
+			Expect(token.String()).To(Equal("gen:42"))
+			Expect(events.ParseResumeToken(token.String())).To(Equal(token))
+		})
+
+		It("fails to parse invalid tokens", func() {
+			for _, invalid := range []string{"", "42", "gen:", ":42", "gen:nope", "a:b:1"} {
+				_, err := events.ParseResumeToken(invalid)
+				Expect(err).To(HaveOccurred(), invalid)
+			}
+		})
+	})
+
 	Describe("closing an event source", func() {
 		It("prevents current events from propagating to the source", func() {
 			source, err := hub.Subscribe()
@@ -194,6 +291,62 @@ var _ = Describe("Hub", func() {
 		})
 	})
 
+	Describe("SubscriberCount", func() {
+		It("counts the subscribers until they are closed", func() {
+			Expect(hub.SubscriberCount()).To(BeZero())
+
+			source, err := hub.Subscribe()
+			Expect(err).NotTo(HaveOccurred())
+			_, err = hub.Subscribe()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hub.SubscriberCount()).To(Equal(2))
+
+			Expect(source.Close()).To(Succeed())
+			Eventually(hub.SubscriberCount).Should(Equal(1))
+		})
+	})
+
+	Describe("Stats", func() {
+		It("reports no queued events without subscribers", func() {
+			hub.Emit(eventfakes.FakeEvent{Token: "A"})
+			Expect(hub.Stats()).To(Equal(events.HubStats{}))
+		})
+
+		It("reports how far behind the subscribers are", func() {
+			behind, err := hub.Subscribe()
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(eventfakes.FakeEvent{Token: "A"})
+			time.Sleep(10 * time.Millisecond)
+
+			caughtUp, err := hub.Subscribe()
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(eventfakes.FakeEvent{Token: "B"})
+			hub.Emit(eventfakes.FakeEvent{Token: "C"})
+
+			_, err = caughtUp.Next()
+			Expect(err).NotTo(HaveOccurred())
+
+			stats := hub.Stats()
+			Expect(stats.Subscribers).To(Equal(2))
+			Expect(stats.QueuedEvents).To(Equal(4))
+			Expect(stats.MaxQueuedEvents).To(Equal(3))
+			Expect(stats.OldestQueuedEventAge).To(BeNumerically(">=", 10*time.Millisecond))
+
+			for i := 0; i < 3; i++ {
+				_, err = behind.Next()
+				Expect(err).NotTo(HaveOccurred())
+			}
+			_, err = caughtUp.Next()
+			Expect(err).NotTo(HaveOccurred())
+
+			stats = hub.Stats()
+			Expect(stats.QueuedEvents).To(BeZero())
+			Expect(stats.OldestQueuedEventAge).To(BeZero())
+		})
+	})
+
 	Describe("closing the hub", func() {
 		It("all subscribers receive errors", func() {
 			source, err := hub.Subscribe()
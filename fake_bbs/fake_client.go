@@ -23,6 +23,18 @@ type FakeClient struct {
 	desireTaskReturns struct {
 		result1 error
 	}
+	DesireTaskWithIdempotencyKeyStub        func(logger lager.Logger, idempotencyKey, guid, domain string, def *models.TaskDefinition) error
+	desireTaskWithIdempotencyKeyMutex       sync.RWMutex
+	desireTaskWithIdempotencyKeyArgsForCall []struct {
+		logger         lager.Logger
+		idempotencyKey string
+		guid           string
+		domain         string
+		def            *models.TaskDefinition
+	}
+	desireTaskWithIdempotencyKeyReturns struct {
+		result1 error
+	}
 	TasksStub        func(logger lager.Logger) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -52,6 +64,27 @@ type FakeClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	TasksWithFilterStub        func(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
+	tasksWithFilterMutex       sync.RWMutex
+	tasksWithFilterArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}
+	tasksWithFilterReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksWithRecordErrorsStub        func(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, []*models.RecordError, error)
+	tasksWithRecordErrorsMutex       sync.RWMutex
+	tasksWithRecordErrorsArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}
+	tasksWithRecordErrorsReturns struct {
+		result1 []*models.Task
+		result2 []*models.RecordError
+		result3 error
+	}
 	TaskByGuidStub        func(logger lager.Logger, guid string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -62,6 +95,16 @@ type FakeClient struct {
 		result1 *models.Task
 		result2 error
 	}
+	DuplicateTasksStub        func(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error)
+	duplicateTasksMutex       sync.RWMutex
+	duplicateTasksArgsForCall []struct {
+		logger lager.Logger
+		domain string
+	}
+	duplicateTasksReturns struct {
+		result1 []*models.DuplicateTasks
+		result2 error
+	}
 	CancelTaskStub        func(logger lager.Logger, taskGuid string) error
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -71,6 +114,17 @@ type FakeClient struct {
 	cancelTaskReturns struct {
 		result1 error
 	}
+	CancelTasksStub        func(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResponse, error)
+	cancelTasksMutex       sync.RWMutex
+	cancelTasksArgsForCall []struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}
+	cancelTasksReturns struct {
+		result1 *models.CancelTasksResponse
+		result2 error
+	}
 	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) error
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
@@ -108,6 +162,25 @@ type FakeClient struct {
 	upsertDomainReturns struct {
 		result1 error
 	}
+	UpsertDomainsStub        func(logger lager.Logger, domains []*models.UpsertDomainRequest) ([]*models.UpsertDomainResult, error)
+	upsertDomainsMutex       sync.RWMutex
+	upsertDomainsArgsForCall []struct {
+		logger  lager.Logger
+		domains []*models.UpsertDomainRequest
+	}
+	upsertDomainsReturns struct {
+		result1 []*models.UpsertDomainResult
+		result2 error
+	}
+	DomainFreshnessStub        func(logger lager.Logger) ([]*models.DomainFreshness, error)
+	domainFreshnessMutex       sync.RWMutex
+	domainFreshnessArgsForCall []struct {
+		logger lager.Logger
+	}
+	domainFreshnessReturns struct {
+		result1 []*models.DomainFreshness
+		result2 error
+	}
 	ActualLRPGroupsStub        func(lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsMutex       sync.RWMutex
 	actualLRPGroupsArgsForCall []struct {
@@ -118,6 +191,17 @@ type FakeClient struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPGroupsWithRecordErrorsStub        func(lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error)
+	actualLRPGroupsWithRecordErrorsMutex       sync.RWMutex
+	actualLRPGroupsWithRecordErrorsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.ActualLRPFilter
+	}
+	actualLRPGroupsWithRecordErrorsReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}
 	ActualLRPGroupsByProcessGuidStub        func(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
 	actualLRPGroupsByProcessGuidArgsForCall []struct {
@@ -139,6 +223,26 @@ type FakeClient struct {
 		result1 *models.ActualLRPGroup
 		result2 error
 	}
+	CrashingActualLRPsStub        func(logger lager.Logger, limit int) ([]*models.ActualLRP, error)
+	crashingActualLRPsMutex       sync.RWMutex
+	crashingActualLRPsArgsForCall []struct {
+		logger lager.Logger
+		limit  int
+	}
+	crashingActualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	CellPlacementsStub        func(logger lager.Logger, cellID string) ([]*models.CellPlacement, error)
+	cellPlacementsMutex       sync.RWMutex
+	cellPlacementsArgsForCall []struct {
+		logger lager.Logger
+		cellID string
+	}
+	cellPlacementsReturns struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}
 	RetireActualLRPStub        func(logger lager.Logger, key *models.ActualLRPKey) error
 	retireActualLRPMutex       sync.RWMutex
 	retireActualLRPArgsForCall []struct {
@@ -158,6 +262,28 @@ type FakeClient struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsWithRevisionStub        func(lager.Logger, models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, revision uint64, err error)
+	desiredLRPsWithRevisionMutex       sync.RWMutex
+	desiredLRPsWithRevisionArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}
+	desiredLRPsWithRevisionReturns struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}
+	DesiredLRPsWithRecordErrorsStub        func(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error)
+	desiredLRPsWithRecordErrorsMutex       sync.RWMutex
+	desiredLRPsWithRecordErrorsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}
+	desiredLRPsWithRecordErrorsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}
 	DesiredLRPByProcessGuidStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -178,6 +304,53 @@ type FakeClient struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	DesiredLRPSchedulingInfosWithRecordErrorsStub        func(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, []*models.RecordError, error)
+	desiredLRPSchedulingInfosWithRecordErrorsMutex       sync.RWMutex
+	desiredLRPSchedulingInfosWithRecordErrorsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}
+	desiredLRPSchedulingInfosWithRecordErrorsReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 []*models.RecordError
+		result3 error
+	}
+	DesiredLRPSchedulingInfosIfModifiedStub        func(logger lager.Logger, filter models.DesiredLRPFilter, etag string) (schedulingInfos []*models.DesiredLRPSchedulingInfo, newETag string, modified bool, err error)
+	desiredLRPSchedulingInfosIfModifiedMutex       sync.RWMutex
+	desiredLRPSchedulingInfosIfModifiedArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		etag   string
+	}
+	desiredLRPSchedulingInfosIfModifiedReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 string
+		result3 bool
+		result4 error
+	}
+	DesiredLRPDiffStub        func(logger lager.Logger, domain string, contentHashes []*models.DesiredLRPContentHash) (missing []string, changed []string, extra []string, err error)
+	desiredLRPDiffMutex       sync.RWMutex
+	desiredLRPDiffArgsForCall []struct {
+		logger        lager.Logger
+		domain        string
+		contentHashes []*models.DesiredLRPContentHash
+	}
+	desiredLRPDiffReturns struct {
+		result1 []string
+		result2 []string
+		result3 []string
+		result4 error
+	}
+	ValidateDesiredLRPStub        func(logger lager.Logger, desiredLRP *models.DesiredLRP) (validationErrors []string, err error)
+	validateDesiredLRPMutex       sync.RWMutex
+	validateDesiredLRPArgsForCall []struct {
+		logger     lager.Logger
+		desiredLRP *models.DesiredLRP
+	}
+	validateDesiredLRPReturns struct {
+		result1 []string
+		result2 error
+	}
 	DesireLRPStub        func(lager.Logger, *models.DesiredLRP) error
 	desireLRPMutex       sync.RWMutex
 	desireLRPArgsForCall []struct {
@@ -187,6 +360,26 @@ type FakeClient struct {
 	desireLRPReturns struct {
 		result1 error
 	}
+	DesireLRPWithWarningsStub        func(logger lager.Logger, desiredLRP *models.DesiredLRP) ([]*models.LintWarning, error)
+	desireLRPWithWarningsMutex       sync.RWMutex
+	desireLRPWithWarningsArgsForCall []struct {
+		logger     lager.Logger
+		desiredLRP *models.DesiredLRP
+	}
+	desireLRPWithWarningsReturns struct {
+		result1 []*models.LintWarning
+		result2 error
+	}
+	DesireLRPWithIdempotencyKeyStub        func(logger lager.Logger, idempotencyKey string, desiredLRP *models.DesiredLRP) error
+	desireLRPWithIdempotencyKeyMutex       sync.RWMutex
+	desireLRPWithIdempotencyKeyArgsForCall []struct {
+		logger         lager.Logger
+		idempotencyKey string
+		desiredLRP     *models.DesiredLRP
+	}
+	desireLRPWithIdempotencyKeyReturns struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -197,6 +390,17 @@ type FakeClient struct {
 	updateDesiredLRPReturns struct {
 		result1 error
 	}
+	UpdateDesiredLRPWithWarningsStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) ([]*models.LintWarning, error)
+	updateDesiredLRPWithWarningsMutex       sync.RWMutex
+	updateDesiredLRPWithWarningsArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		update      *models.DesiredLRPUpdate
+	}
+	updateDesiredLRPWithWarningsReturns struct {
+		result1 []*models.LintWarning
+		result2 error
+	}
 	RemoveDesiredLRPStub        func(logger lager.Logger, processGuid string) error
 	removeDesiredLRPMutex       sync.RWMutex
 	removeDesiredLRPArgsForCall []struct {
@@ -215,6 +419,15 @@ type FakeClient struct {
 		result1 events.EventSource
 		result2 error
 	}
+	SubscribeToTaskEventsStub        func(logger lager.Logger) (events.EventSource, error)
+	subscribeToTaskEventsMutex       sync.RWMutex
+	subscribeToTaskEventsArgsForCall []struct {
+		logger lager.Logger
+	}
+	subscribeToTaskEventsReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
 	PingStub        func(logger lager.Logger) bool
 	pingMutex       sync.RWMutex
 	pingArgsForCall []struct {
@@ -232,6 +445,16 @@ type FakeClient struct {
 		result1 []*models.CellPresence
 		result2 error
 	}
+	ObjectCountsStub        func(logger lager.Logger, groupBy ...string) (*models.ObjectCountsResponse, error)
+	objectCountsMutex       sync.RWMutex
+	objectCountsArgsForCall []struct {
+		logger  lager.Logger
+		groupBy []string
+	}
+	objectCountsReturns struct {
+		result1 *models.ObjectCountsResponse
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -272,6 +495,43 @@ func (fake *FakeClient) DesireTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) DesireTaskWithIdempotencyKey(logger lager.Logger, idempotencyKey string, guid string, domain string, def *models.TaskDefinition) error {
+	fake.desireTaskWithIdempotencyKeyMutex.Lock()
+	fake.desireTaskWithIdempotencyKeyArgsForCall = append(fake.desireTaskWithIdempotencyKeyArgsForCall, struct {
+		logger         lager.Logger
+		idempotencyKey string
+		guid           string
+		domain         string
+		def            *models.TaskDefinition
+	}{logger, idempotencyKey, guid, domain, def})
+	fake.recordInvocation("DesireTaskWithIdempotencyKey", []interface{}{logger, idempotencyKey, guid, domain, def})
+	fake.desireTaskWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireTaskWithIdempotencyKeyStub != nil {
+		return fake.DesireTaskWithIdempotencyKeyStub(logger, idempotencyKey, guid, domain, def)
+	} else {
+		return fake.desireTaskWithIdempotencyKeyReturns.result1
+	}
+}
+
+func (fake *FakeClient) DesireTaskWithIdempotencyKeyCallCount() int {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireTaskWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeClient) DesireTaskWithIdempotencyKeyArgsForCall(i int) (lager.Logger, string, string, string, *models.TaskDefinition) {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireTaskWithIdempotencyKeyArgsForCall[i].logger, fake.desireTaskWithIdempotencyKeyArgsForCall[i].idempotencyKey, fake.desireTaskWithIdempotencyKeyArgsForCall[i].guid, fake.desireTaskWithIdempotencyKeyArgsForCall[i].domain, fake.desireTaskWithIdempotencyKeyArgsForCall[i].def
+}
+
+func (fake *FakeClient) DesireTaskWithIdempotencyKeyReturns(result1 error) {
+	fake.DesireTaskWithIdempotencyKeyStub = nil
+	fake.desireTaskWithIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) Tasks(logger lager.Logger) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
@@ -376,6 +636,77 @@ func (fake *FakeClient) TasksByCellIDReturns(result1 []*models.Task, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeClient) TasksWithFilter(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
+	fake.tasksWithFilterMutex.Lock()
+	fake.tasksWithFilterArgsForCall = append(fake.tasksWithFilterArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}{logger, filter})
+	fake.recordInvocation("TasksWithFilter", []interface{}{logger, filter})
+	fake.tasksWithFilterMutex.Unlock()
+	if fake.TasksWithFilterStub != nil {
+		return fake.TasksWithFilterStub(logger, filter)
+	} else {
+		return fake.tasksWithFilterReturns.result1, fake.tasksWithFilterReturns.result2
+	}
+}
+
+func (fake *FakeClient) TasksWithFilterCallCount() int {
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	return len(fake.tasksWithFilterArgsForCall)
+}
+
+func (fake *FakeClient) TasksWithFilterArgsForCall(i int) (lager.Logger, models.TaskFilter) {
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	return fake.tasksWithFilterArgsForCall[i].logger, fake.tasksWithFilterArgsForCall[i].filter
+}
+
+func (fake *FakeClient) TasksWithFilterReturns(result1 []*models.Task, result2 error) {
+	fake.TasksWithFilterStub = nil
+	fake.tasksWithFilterReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TasksWithRecordErrors(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, []*models.RecordError, error) {
+	fake.tasksWithRecordErrorsMutex.Lock()
+	fake.tasksWithRecordErrorsArgsForCall = append(fake.tasksWithRecordErrorsArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}{logger, filter})
+	fake.recordInvocation("TasksWithRecordErrors", []interface{}{logger, filter})
+	fake.tasksWithRecordErrorsMutex.Unlock()
+	if fake.TasksWithRecordErrorsStub != nil {
+		return fake.TasksWithRecordErrorsStub(logger, filter)
+	} else {
+		return fake.tasksWithRecordErrorsReturns.result1, fake.tasksWithRecordErrorsReturns.result2, fake.tasksWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeClient) TasksWithRecordErrorsCallCount() int {
+	fake.tasksWithRecordErrorsMutex.RLock()
+	defer fake.tasksWithRecordErrorsMutex.RUnlock()
+	return len(fake.tasksWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeClient) TasksWithRecordErrorsArgsForCall(i int) (lager.Logger, models.TaskFilter) {
+	fake.tasksWithRecordErrorsMutex.RLock()
+	defer fake.tasksWithRecordErrorsMutex.RUnlock()
+	return fake.tasksWithRecordErrorsArgsForCall[i].logger, fake.tasksWithRecordErrorsArgsForCall[i].filter
+}
+
+func (fake *FakeClient) TasksWithRecordErrorsReturns(result1 []*models.Task, result2 []*models.RecordError, result3 error) {
+	fake.TasksWithRecordErrorsStub = nil
+	fake.tasksWithRecordErrorsReturns = struct {
+		result1 []*models.Task
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) TaskByGuid(logger lager.Logger, guid string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
@@ -411,6 +742,41 @@ func (fake *FakeClient) TaskByGuidReturns(result1 *models.Task, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error) {
+	fake.duplicateTasksMutex.Lock()
+	fake.duplicateTasksArgsForCall = append(fake.duplicateTasksArgsForCall, struct {
+		logger lager.Logger
+		domain string
+	}{logger, domain})
+	fake.recordInvocation("DuplicateTasks", []interface{}{logger, domain})
+	fake.duplicateTasksMutex.Unlock()
+	if fake.DuplicateTasksStub != nil {
+		return fake.DuplicateTasksStub(logger, domain)
+	} else {
+		return fake.duplicateTasksReturns.result1, fake.duplicateTasksReturns.result2
+	}
+}
+
+func (fake *FakeClient) DuplicateTasksCallCount() int {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return len(fake.duplicateTasksArgsForCall)
+}
+
+func (fake *FakeClient) DuplicateTasksArgsForCall(i int) (lager.Logger, string) {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return fake.duplicateTasksArgsForCall[i].logger, fake.duplicateTasksArgsForCall[i].domain
+}
+
+func (fake *FakeClient) DuplicateTasksReturns(result1 []*models.DuplicateTasks, result2 error) {
+	fake.DuplicateTasksStub = nil
+	fake.duplicateTasksReturns = struct {
+		result1 []*models.DuplicateTasks
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) CancelTask(logger lager.Logger, taskGuid string) error {
 	fake.cancelTaskMutex.Lock()
 	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
@@ -445,6 +811,42 @@ func (fake *FakeClient) CancelTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResponse, error) {
+	fake.cancelTasksMutex.Lock()
+	fake.cancelTasksArgsForCall = append(fake.cancelTasksArgsForCall, struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}{logger, domain, taskGuids})
+	fake.recordInvocation("CancelTasks", []interface{}{logger, domain, taskGuids})
+	fake.cancelTasksMutex.Unlock()
+	if fake.CancelTasksStub != nil {
+		return fake.CancelTasksStub(logger, domain, taskGuids)
+	} else {
+		return fake.cancelTasksReturns.result1, fake.cancelTasksReturns.result2
+	}
+}
+
+func (fake *FakeClient) CancelTasksCallCount() int {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return len(fake.cancelTasksArgsForCall)
+}
+
+func (fake *FakeClient) CancelTasksArgsForCall(i int) (lager.Logger, string, []string) {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return fake.cancelTasksArgsForCall[i].logger, fake.cancelTasksArgsForCall[i].domain, fake.cancelTasksArgsForCall[i].taskGuids
+}
+
+func (fake *FakeClient) CancelTasksReturns(result1 *models.CancelTasksResponse, result2 error) {
+	fake.CancelTasksStub = nil
+	fake.cancelTasksReturns = struct {
+		result1 *models.CancelTasksResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	fake.resolvingTaskMutex.Lock()
 	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
@@ -582,6 +984,75 @@ func (fake *FakeClient) UpsertDomainReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) UpsertDomains(logger lager.Logger, domains []*models.UpsertDomainRequest) ([]*models.UpsertDomainResult, error) {
+	fake.upsertDomainsMutex.Lock()
+	fake.upsertDomainsArgsForCall = append(fake.upsertDomainsArgsForCall, struct {
+		logger  lager.Logger
+		domains []*models.UpsertDomainRequest
+	}{logger, domains})
+	fake.recordInvocation("UpsertDomains", []interface{}{logger, domains})
+	fake.upsertDomainsMutex.Unlock()
+	if fake.UpsertDomainsStub != nil {
+		return fake.UpsertDomainsStub(logger, domains)
+	} else {
+		return fake.upsertDomainsReturns.result1, fake.upsertDomainsReturns.result2
+	}
+}
+
+func (fake *FakeClient) UpsertDomainsCallCount() int {
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	return len(fake.upsertDomainsArgsForCall)
+}
+
+func (fake *FakeClient) UpsertDomainsArgsForCall(i int) (lager.Logger, []*models.UpsertDomainRequest) {
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	return fake.upsertDomainsArgsForCall[i].logger, fake.upsertDomainsArgsForCall[i].domains
+}
+
+func (fake *FakeClient) UpsertDomainsReturns(result1 []*models.UpsertDomainResult, result2 error) {
+	fake.UpsertDomainsStub = nil
+	fake.upsertDomainsReturns = struct {
+		result1 []*models.UpsertDomainResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DomainFreshness(logger lager.Logger) ([]*models.DomainFreshness, error) {
+	fake.domainFreshnessMutex.Lock()
+	fake.domainFreshnessArgsForCall = append(fake.domainFreshnessArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DomainFreshness", []interface{}{logger})
+	fake.domainFreshnessMutex.Unlock()
+	if fake.DomainFreshnessStub != nil {
+		return fake.DomainFreshnessStub(logger)
+	} else {
+		return fake.domainFreshnessReturns.result1, fake.domainFreshnessReturns.result2
+	}
+}
+
+func (fake *FakeClient) DomainFreshnessCallCount() int {
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
+	return len(fake.domainFreshnessArgsForCall)
+}
+
+func (fake *FakeClient) DomainFreshnessArgsForCall(i int) lager.Logger {
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
+	return fake.domainFreshnessArgsForCall[i].logger
+}
+
+func (fake *FakeClient) DomainFreshnessReturns(result1 []*models.DomainFreshness, result2 error) {
+	fake.DomainFreshnessStub = nil
+	fake.domainFreshnessReturns = struct {
+		result1 []*models.DomainFreshness
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ActualLRPGroups(arg1 lager.Logger, arg2 models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsMutex.Lock()
 	fake.actualLRPGroupsArgsForCall = append(fake.actualLRPGroupsArgsForCall, struct {
@@ -617,6 +1088,42 @@ func (fake *FakeClient) ActualLRPGroupsReturns(result1 []*models.ActualLRPGroup,
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ActualLRPGroupsWithRecordErrors(arg1 lager.Logger, arg2 models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.Lock()
+	fake.actualLRPGroupsWithRecordErrorsArgsForCall = append(fake.actualLRPGroupsWithRecordErrorsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.ActualLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("ActualLRPGroupsWithRecordErrors", []interface{}{arg1, arg2})
+	fake.actualLRPGroupsWithRecordErrorsMutex.Unlock()
+	if fake.ActualLRPGroupsWithRecordErrorsStub != nil {
+		return fake.ActualLRPGroupsWithRecordErrorsStub(arg1, arg2)
+	} else {
+		return fake.actualLRPGroupsWithRecordErrorsReturns.result1, fake.actualLRPGroupsWithRecordErrorsReturns.result2, fake.actualLRPGroupsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeClient) ActualLRPGroupsWithRecordErrorsCallCount() int {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return len(fake.actualLRPGroupsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeClient) ActualLRPGroupsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].arg1, fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].arg2
+}
+
+func (fake *FakeClient) ActualLRPGroupsWithRecordErrorsReturns(result1 []*models.ActualLRPGroup, result2 []*models.RecordError, result3 error) {
+	fake.ActualLRPGroupsWithRecordErrorsStub = nil
+	fake.actualLRPGroupsWithRecordErrorsReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsByProcessGuidMutex.Lock()
 	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
@@ -688,6 +1195,76 @@ func (fake *FakeClient) ActualLRPGroupByProcessGuidAndIndexReturns(result1 *mode
 	}{result1, result2}
 }
 
+func (fake *FakeClient) CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error) {
+	fake.crashingActualLRPsMutex.Lock()
+	fake.crashingActualLRPsArgsForCall = append(fake.crashingActualLRPsArgsForCall, struct {
+		logger lager.Logger
+		limit  int
+	}{logger, limit})
+	fake.recordInvocation("CrashingActualLRPs", []interface{}{logger, limit})
+	fake.crashingActualLRPsMutex.Unlock()
+	if fake.CrashingActualLRPsStub != nil {
+		return fake.CrashingActualLRPsStub(logger, limit)
+	} else {
+		return fake.crashingActualLRPsReturns.result1, fake.crashingActualLRPsReturns.result2
+	}
+}
+
+func (fake *FakeClient) CrashingActualLRPsCallCount() int {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return len(fake.crashingActualLRPsArgsForCall)
+}
+
+func (fake *FakeClient) CrashingActualLRPsArgsForCall(i int) (lager.Logger, int) {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return fake.crashingActualLRPsArgsForCall[i].logger, fake.crashingActualLRPsArgsForCall[i].limit
+}
+
+func (fake *FakeClient) CrashingActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.CrashingActualLRPsStub = nil
+	fake.crashingActualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error) {
+	fake.cellPlacementsMutex.Lock()
+	fake.cellPlacementsArgsForCall = append(fake.cellPlacementsArgsForCall, struct {
+		logger lager.Logger
+		cellID string
+	}{logger, cellID})
+	fake.recordInvocation("CellPlacements", []interface{}{logger, cellID})
+	fake.cellPlacementsMutex.Unlock()
+	if fake.CellPlacementsStub != nil {
+		return fake.CellPlacementsStub(logger, cellID)
+	} else {
+		return fake.cellPlacementsReturns.result1, fake.cellPlacementsReturns.result2
+	}
+}
+
+func (fake *FakeClient) CellPlacementsCallCount() int {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return len(fake.cellPlacementsArgsForCall)
+}
+
+func (fake *FakeClient) CellPlacementsArgsForCall(i int) (lager.Logger, string) {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return fake.cellPlacementsArgsForCall[i].logger, fake.cellPlacementsArgsForCall[i].cellID
+}
+
+func (fake *FakeClient) CellPlacementsReturns(result1 []*models.CellPlacement, result2 error) {
+	fake.CellPlacementsStub = nil
+	fake.cellPlacementsReturns = struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) RetireActualLRP(logger lager.Logger, key *models.ActualLRPKey) error {
 	fake.retireActualLRPMutex.Lock()
 	fake.retireActualLRPArgsForCall = append(fake.retireActualLRPArgsForCall, struct {
@@ -757,6 +1334,78 @@ func (fake *FakeClient) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DesiredLRPsWithRevision(arg1 lager.Logger, arg2 models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, revision uint64, err error) {
+	fake.desiredLRPsWithRevisionMutex.Lock()
+	fake.desiredLRPsWithRevisionArgsForCall = append(fake.desiredLRPsWithRevisionArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("DesiredLRPsWithRevision", []interface{}{arg1, arg2})
+	fake.desiredLRPsWithRevisionMutex.Unlock()
+	if fake.DesiredLRPsWithRevisionStub != nil {
+		return fake.DesiredLRPsWithRevisionStub(arg1, arg2)
+	} else {
+		return fake.desiredLRPsWithRevisionReturns.result1, fake.desiredLRPsWithRevisionReturns.result2, fake.desiredLRPsWithRevisionReturns.result3
+	}
+}
+
+func (fake *FakeClient) DesiredLRPsWithRevisionCallCount() int {
+	fake.desiredLRPsWithRevisionMutex.RLock()
+	defer fake.desiredLRPsWithRevisionMutex.RUnlock()
+	return len(fake.desiredLRPsWithRevisionArgsForCall)
+}
+
+func (fake *FakeClient) DesiredLRPsWithRevisionArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsWithRevisionMutex.RLock()
+	defer fake.desiredLRPsWithRevisionMutex.RUnlock()
+	return fake.desiredLRPsWithRevisionArgsForCall[i].arg1, fake.desiredLRPsWithRevisionArgsForCall[i].arg2
+}
+
+func (fake *FakeClient) DesiredLRPsWithRevisionReturns(result1 []*models.DesiredLRP, result2 uint64, result3 error) {
+	fake.DesiredLRPsWithRevisionStub = nil
+	fake.desiredLRPsWithRevisionReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) DesiredLRPsWithRecordErrors(arg1 lager.Logger, arg2 models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
+	fake.desiredLRPsWithRecordErrorsMutex.Lock()
+	fake.desiredLRPsWithRecordErrorsArgsForCall = append(fake.desiredLRPsWithRecordErrorsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("DesiredLRPsWithRecordErrors", []interface{}{arg1, arg2})
+	fake.desiredLRPsWithRecordErrorsMutex.Unlock()
+	if fake.DesiredLRPsWithRecordErrorsStub != nil {
+		return fake.DesiredLRPsWithRecordErrorsStub(arg1, arg2)
+	} else {
+		return fake.desiredLRPsWithRecordErrorsReturns.result1, fake.desiredLRPsWithRecordErrorsReturns.result2, fake.desiredLRPsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeClient) DesiredLRPsWithRecordErrorsCallCount() int {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return len(fake.desiredLRPsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeClient) DesiredLRPsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return fake.desiredLRPsWithRecordErrorsArgsForCall[i].arg1, fake.desiredLRPsWithRecordErrorsArgsForCall[i].arg2
+}
+
+func (fake *FakeClient) DesiredLRPsWithRecordErrorsReturns(result1 []*models.DesiredLRP, result2 []*models.RecordError, result3 error) {
+	fake.DesiredLRPsWithRecordErrorsStub = nil
+	fake.desiredLRPsWithRecordErrorsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
@@ -827,6 +1476,153 @@ func (fake *FakeClient) DesiredLRPSchedulingInfosReturns(result1 []*models.Desir
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DesiredLRPSchedulingInfosWithRecordErrors(arg1 lager.Logger, arg2 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, []*models.RecordError, error) {
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.Lock()
+	fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall = append(fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("DesiredLRPSchedulingInfosWithRecordErrors", []interface{}{arg1, arg2})
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.Unlock()
+	if fake.DesiredLRPSchedulingInfosWithRecordErrorsStub != nil {
+		return fake.DesiredLRPSchedulingInfosWithRecordErrorsStub(arg1, arg2)
+	} else {
+		return fake.desiredLRPSchedulingInfosWithRecordErrorsReturns.result1, fake.desiredLRPSchedulingInfosWithRecordErrorsReturns.result2, fake.desiredLRPSchedulingInfosWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeClient) DesiredLRPSchedulingInfosWithRecordErrorsCallCount() int {
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeClient) DesiredLRPSchedulingInfosWithRecordErrorsArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RUnlock()
+	return fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall[i].arg1, fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall[i].arg2
+}
+
+func (fake *FakeClient) DesiredLRPSchedulingInfosWithRecordErrorsReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 []*models.RecordError, result3 error) {
+	fake.DesiredLRPSchedulingInfosWithRecordErrorsStub = nil
+	fake.desiredLRPSchedulingInfosWithRecordErrorsReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) DesiredLRPSchedulingInfosIfModified(logger lager.Logger, filter models.DesiredLRPFilter, etag string) (schedulingInfos []*models.DesiredLRPSchedulingInfo, newETag string, modified bool, err error) {
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.Lock()
+	fake.desiredLRPSchedulingInfosIfModifiedArgsForCall = append(fake.desiredLRPSchedulingInfosIfModifiedArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		etag   string
+	}{logger, filter, etag})
+	fake.recordInvocation("DesiredLRPSchedulingInfosIfModified", []interface{}{logger, filter, etag})
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.Unlock()
+	if fake.DesiredLRPSchedulingInfosIfModifiedStub != nil {
+		return fake.DesiredLRPSchedulingInfosIfModifiedStub(logger, filter, etag)
+	} else {
+		return fake.desiredLRPSchedulingInfosIfModifiedReturns.result1, fake.desiredLRPSchedulingInfosIfModifiedReturns.result2, fake.desiredLRPSchedulingInfosIfModifiedReturns.result3, fake.desiredLRPSchedulingInfosIfModifiedReturns.result4
+	}
+}
+
+func (fake *FakeClient) DesiredLRPSchedulingInfosIfModifiedCallCount() int {
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosIfModifiedMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfosIfModifiedArgsForCall)
+}
+
+func (fake *FakeClient) DesiredLRPSchedulingInfosIfModifiedArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter, string) {
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosIfModifiedMutex.RUnlock()
+	return fake.desiredLRPSchedulingInfosIfModifiedArgsForCall[i].logger, fake.desiredLRPSchedulingInfosIfModifiedArgsForCall[i].filter, fake.desiredLRPSchedulingInfosIfModifiedArgsForCall[i].etag
+}
+
+func (fake *FakeClient) DesiredLRPSchedulingInfosIfModifiedReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 string, result3 bool, result4 error) {
+	fake.DesiredLRPSchedulingInfosIfModifiedStub = nil
+	fake.desiredLRPSchedulingInfosIfModifiedReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 string
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeClient) DesiredLRPDiff(logger lager.Logger, domain string, contentHashes []*models.DesiredLRPContentHash) (missing []string, changed []string, extra []string, err error) {
+	fake.desiredLRPDiffMutex.Lock()
+	fake.desiredLRPDiffArgsForCall = append(fake.desiredLRPDiffArgsForCall, struct {
+		logger        lager.Logger
+		domain        string
+		contentHashes []*models.DesiredLRPContentHash
+	}{logger, domain, contentHashes})
+	fake.recordInvocation("DesiredLRPDiff", []interface{}{logger, domain, contentHashes})
+	fake.desiredLRPDiffMutex.Unlock()
+	if fake.DesiredLRPDiffStub != nil {
+		return fake.DesiredLRPDiffStub(logger, domain, contentHashes)
+	} else {
+		return fake.desiredLRPDiffReturns.result1, fake.desiredLRPDiffReturns.result2, fake.desiredLRPDiffReturns.result3, fake.desiredLRPDiffReturns.result4
+	}
+}
+
+func (fake *FakeClient) DesiredLRPDiffCallCount() int {
+	fake.desiredLRPDiffMutex.RLock()
+	defer fake.desiredLRPDiffMutex.RUnlock()
+	return len(fake.desiredLRPDiffArgsForCall)
+}
+
+func (fake *FakeClient) DesiredLRPDiffArgsForCall(i int) (lager.Logger, string, []*models.DesiredLRPContentHash) {
+	fake.desiredLRPDiffMutex.RLock()
+	defer fake.desiredLRPDiffMutex.RUnlock()
+	return fake.desiredLRPDiffArgsForCall[i].logger, fake.desiredLRPDiffArgsForCall[i].domain, fake.desiredLRPDiffArgsForCall[i].contentHashes
+}
+
+func (fake *FakeClient) DesiredLRPDiffReturns(result1 []string, result2 []string, result3 []string, result4 error) {
+	fake.DesiredLRPDiffStub = nil
+	fake.desiredLRPDiffReturns = struct {
+		result1 []string
+		result2 []string
+		result3 []string
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeClient) ValidateDesiredLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) (validationErrors []string, err error) {
+	fake.validateDesiredLRPMutex.Lock()
+	fake.validateDesiredLRPArgsForCall = append(fake.validateDesiredLRPArgsForCall, struct {
+		logger     lager.Logger
+		desiredLRP *models.DesiredLRP
+	}{logger, desiredLRP})
+	fake.recordInvocation("ValidateDesiredLRP", []interface{}{logger, desiredLRP})
+	fake.validateDesiredLRPMutex.Unlock()
+	if fake.ValidateDesiredLRPStub != nil {
+		return fake.ValidateDesiredLRPStub(logger, desiredLRP)
+	} else {
+		return fake.validateDesiredLRPReturns.result1, fake.validateDesiredLRPReturns.result2
+	}
+}
+
+func (fake *FakeClient) ValidateDesiredLRPCallCount() int {
+	fake.validateDesiredLRPMutex.RLock()
+	defer fake.validateDesiredLRPMutex.RUnlock()
+	return len(fake.validateDesiredLRPArgsForCall)
+}
+
+func (fake *FakeClient) ValidateDesiredLRPArgsForCall(i int) (lager.Logger, *models.DesiredLRP) {
+	fake.validateDesiredLRPMutex.RLock()
+	defer fake.validateDesiredLRPMutex.RUnlock()
+	return fake.validateDesiredLRPArgsForCall[i].logger, fake.validateDesiredLRPArgsForCall[i].desiredLRP
+}
+
+func (fake *FakeClient) ValidateDesiredLRPReturns(result1 []string, result2 error) {
+	fake.ValidateDesiredLRPStub = nil
+	fake.validateDesiredLRPReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) DesireLRP(arg1 lager.Logger, arg2 *models.DesiredLRP) error {
 	fake.desireLRPMutex.Lock()
 	fake.desireLRPArgsForCall = append(fake.desireLRPArgsForCall, struct {
@@ -861,6 +1657,76 @@ func (fake *FakeClient) DesireLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) DesireLRPWithWarnings(logger lager.Logger, desiredLRP *models.DesiredLRP) ([]*models.LintWarning, error) {
+	fake.desireLRPWithWarningsMutex.Lock()
+	fake.desireLRPWithWarningsArgsForCall = append(fake.desireLRPWithWarningsArgsForCall, struct {
+		logger     lager.Logger
+		desiredLRP *models.DesiredLRP
+	}{logger, desiredLRP})
+	fake.recordInvocation("DesireLRPWithWarnings", []interface{}{logger, desiredLRP})
+	fake.desireLRPWithWarningsMutex.Unlock()
+	if fake.DesireLRPWithWarningsStub != nil {
+		return fake.DesireLRPWithWarningsStub(logger, desiredLRP)
+	} else {
+		return fake.desireLRPWithWarningsReturns.result1, fake.desireLRPWithWarningsReturns.result2
+	}
+}
+
+func (fake *FakeClient) DesireLRPWithWarningsCallCount() int {
+	fake.desireLRPWithWarningsMutex.RLock()
+	defer fake.desireLRPWithWarningsMutex.RUnlock()
+	return len(fake.desireLRPWithWarningsArgsForCall)
+}
+
+func (fake *FakeClient) DesireLRPWithWarningsArgsForCall(i int) (lager.Logger, *models.DesiredLRP) {
+	fake.desireLRPWithWarningsMutex.RLock()
+	defer fake.desireLRPWithWarningsMutex.RUnlock()
+	return fake.desireLRPWithWarningsArgsForCall[i].logger, fake.desireLRPWithWarningsArgsForCall[i].desiredLRP
+}
+
+func (fake *FakeClient) DesireLRPWithWarningsReturns(result1 []*models.LintWarning, result2 error) {
+	fake.DesireLRPWithWarningsStub = nil
+	fake.desireLRPWithWarningsReturns = struct {
+		result1 []*models.LintWarning
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DesireLRPWithIdempotencyKey(logger lager.Logger, idempotencyKey string, desiredLRP *models.DesiredLRP) error {
+	fake.desireLRPWithIdempotencyKeyMutex.Lock()
+	fake.desireLRPWithIdempotencyKeyArgsForCall = append(fake.desireLRPWithIdempotencyKeyArgsForCall, struct {
+		logger         lager.Logger
+		idempotencyKey string
+		desiredLRP     *models.DesiredLRP
+	}{logger, idempotencyKey, desiredLRP})
+	fake.recordInvocation("DesireLRPWithIdempotencyKey", []interface{}{logger, idempotencyKey, desiredLRP})
+	fake.desireLRPWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireLRPWithIdempotencyKeyStub != nil {
+		return fake.DesireLRPWithIdempotencyKeyStub(logger, idempotencyKey, desiredLRP)
+	} else {
+		return fake.desireLRPWithIdempotencyKeyReturns.result1
+	}
+}
+
+func (fake *FakeClient) DesireLRPWithIdempotencyKeyCallCount() int {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireLRPWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeClient) DesireLRPWithIdempotencyKeyArgsForCall(i int) (lager.Logger, string, *models.DesiredLRP) {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireLRPWithIdempotencyKeyArgsForCall[i].logger, fake.desireLRPWithIdempotencyKeyArgsForCall[i].idempotencyKey, fake.desireLRPWithIdempotencyKeyArgsForCall[i].desiredLRP
+}
+
+func (fake *FakeClient) DesireLRPWithIdempotencyKeyReturns(result1 error) {
+	fake.DesireLRPWithIdempotencyKeyStub = nil
+	fake.desireLRPWithIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error {
 	fake.updateDesiredLRPMutex.Lock()
 	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
@@ -896,6 +1762,42 @@ func (fake *FakeClient) UpdateDesiredLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) UpdateDesiredLRPWithWarnings(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) ([]*models.LintWarning, error) {
+	fake.updateDesiredLRPWithWarningsMutex.Lock()
+	fake.updateDesiredLRPWithWarningsArgsForCall = append(fake.updateDesiredLRPWithWarningsArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		update      *models.DesiredLRPUpdate
+	}{logger, processGuid, update})
+	fake.recordInvocation("UpdateDesiredLRPWithWarnings", []interface{}{logger, processGuid, update})
+	fake.updateDesiredLRPWithWarningsMutex.Unlock()
+	if fake.UpdateDesiredLRPWithWarningsStub != nil {
+		return fake.UpdateDesiredLRPWithWarningsStub(logger, processGuid, update)
+	} else {
+		return fake.updateDesiredLRPWithWarningsReturns.result1, fake.updateDesiredLRPWithWarningsReturns.result2
+	}
+}
+
+func (fake *FakeClient) UpdateDesiredLRPWithWarningsCallCount() int {
+	fake.updateDesiredLRPWithWarningsMutex.RLock()
+	defer fake.updateDesiredLRPWithWarningsMutex.RUnlock()
+	return len(fake.updateDesiredLRPWithWarningsArgsForCall)
+}
+
+func (fake *FakeClient) UpdateDesiredLRPWithWarningsArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPUpdate) {
+	fake.updateDesiredLRPWithWarningsMutex.RLock()
+	defer fake.updateDesiredLRPWithWarningsMutex.RUnlock()
+	return fake.updateDesiredLRPWithWarningsArgsForCall[i].logger, fake.updateDesiredLRPWithWarningsArgsForCall[i].processGuid, fake.updateDesiredLRPWithWarningsArgsForCall[i].update
+}
+
+func (fake *FakeClient) UpdateDesiredLRPWithWarningsReturns(result1 []*models.LintWarning, result2 error) {
+	fake.UpdateDesiredLRPWithWarningsStub = nil
+	fake.updateDesiredLRPWithWarningsReturns = struct {
+		result1 []*models.LintWarning
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) RemoveDesiredLRP(logger lager.Logger, processGuid string) error {
 	fake.removeDesiredLRPMutex.Lock()
 	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
@@ -964,6 +1866,40 @@ func (fake *FakeClient) SubscribeToEventsReturns(result1 events.EventSource, res
 	}{result1, result2}
 }
 
+func (fake *FakeClient) SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	fake.subscribeToTaskEventsArgsForCall = append(fake.subscribeToTaskEventsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("SubscribeToTaskEvents", []interface{}{logger})
+	fake.subscribeToTaskEventsMutex.Unlock()
+	if fake.SubscribeToTaskEventsStub != nil {
+		return fake.SubscribeToTaskEventsStub(logger)
+	} else {
+		return fake.subscribeToTaskEventsReturns.result1, fake.subscribeToTaskEventsReturns.result2
+	}
+}
+
+func (fake *FakeClient) SubscribeToTaskEventsCallCount() int {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsArgsForCall)
+}
+
+func (fake *FakeClient) SubscribeToTaskEventsArgsForCall(i int) lager.Logger {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	return fake.subscribeToTaskEventsArgsForCall[i].logger
+}
+
+func (fake *FakeClient) SubscribeToTaskEventsReturns(result1 events.EventSource, result2 error) {
+	fake.SubscribeToTaskEventsStub = nil
+	fake.subscribeToTaskEventsReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Ping(logger lager.Logger) bool {
 	fake.pingMutex.Lock()
 	fake.pingArgsForCall = append(fake.pingArgsForCall, struct {
@@ -1031,21 +1967,66 @@ func (fake *FakeClient) CellsReturns(result1 []*models.CellPresence, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ObjectCounts(logger lager.Logger, groupBy ...string) (*models.ObjectCountsResponse, error) {
+	fake.objectCountsMutex.Lock()
+	fake.objectCountsArgsForCall = append(fake.objectCountsArgsForCall, struct {
+		logger  lager.Logger
+		groupBy []string
+	}{logger, groupBy})
+	fake.recordInvocation("ObjectCounts", []interface{}{logger, groupBy})
+	fake.objectCountsMutex.Unlock()
+	if fake.ObjectCountsStub != nil {
+		return fake.ObjectCountsStub(logger, groupBy...)
+	} else {
+		return fake.objectCountsReturns.result1, fake.objectCountsReturns.result2
+	}
+}
+
+func (fake *FakeClient) ObjectCountsCallCount() int {
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
+	return len(fake.objectCountsArgsForCall)
+}
+
+func (fake *FakeClient) ObjectCountsArgsForCall(i int) (lager.Logger, []string) {
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
+	return fake.objectCountsArgsForCall[i].logger, fake.objectCountsArgsForCall[i].groupBy
+}
+
+func (fake *FakeClient) ObjectCountsReturns(result1 *models.ObjectCountsResponse, result2 error) {
+	fake.ObjectCountsStub = nil
+	fake.objectCountsReturns = struct {
+		result1 *models.ObjectCountsResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	fake.tasksByDomainMutex.RLock()
 	defer fake.tasksByDomainMutex.RUnlock()
 	fake.tasksByCellIDMutex.RLock()
 	defer fake.tasksByCellIDMutex.RUnlock()
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	fake.tasksWithRecordErrorsMutex.RLock()
+	defer fake.tasksWithRecordErrorsMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
@@ -1054,32 +2035,64 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.domainsMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
 	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
 	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
 	fake.retireActualLRPMutex.RLock()
 	defer fake.retireActualLRPMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsWithRevisionMutex.RLock()
+	defer fake.desiredLRPsWithRevisionMutex.RUnlock()
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RUnlock()
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosIfModifiedMutex.RUnlock()
+	fake.desiredLRPDiffMutex.RLock()
+	defer fake.desiredLRPDiffMutex.RUnlock()
+	fake.validateDesiredLRPMutex.RLock()
+	defer fake.validateDesiredLRPMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireLRPWithWarningsMutex.RLock()
+	defer fake.desireLRPWithWarningsMutex.RUnlock()
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateDesiredLRPWithWarningsMutex.RLock()
+	defer fake.updateDesiredLRPWithWarningsMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
 	fake.subscribeToEventsMutex.RLock()
 	defer fake.subscribeToEventsMutex.RUnlock()
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
 	fake.pingMutex.RLock()
 	defer fake.pingMutex.RUnlock()
 	fake.cellsMutex.RLock()
 	defer fake.cellsMutex.RUnlock()
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -23,6 +23,18 @@ type FakeInternalClient struct {
 	desireTaskReturns struct {
 		result1 error
 	}
+	DesireTaskWithIdempotencyKeyStub        func(logger lager.Logger, idempotencyKey, guid, domain string, def *models.TaskDefinition) error
+	desireTaskWithIdempotencyKeyMutex       sync.RWMutex
+	desireTaskWithIdempotencyKeyArgsForCall []struct {
+		logger         lager.Logger
+		idempotencyKey string
+		guid           string
+		domain         string
+		def            *models.TaskDefinition
+	}
+	desireTaskWithIdempotencyKeyReturns struct {
+		result1 error
+	}
 	TasksStub        func(logger lager.Logger) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -52,6 +64,27 @@ type FakeInternalClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	TasksWithFilterStub        func(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
+	tasksWithFilterMutex       sync.RWMutex
+	tasksWithFilterArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}
+	tasksWithFilterReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksWithRecordErrorsStub        func(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, []*models.RecordError, error)
+	tasksWithRecordErrorsMutex       sync.RWMutex
+	tasksWithRecordErrorsArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}
+	tasksWithRecordErrorsReturns struct {
+		result1 []*models.Task
+		result2 []*models.RecordError
+		result3 error
+	}
 	TaskByGuidStub        func(logger lager.Logger, guid string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -62,6 +95,16 @@ type FakeInternalClient struct {
 		result1 *models.Task
 		result2 error
 	}
+	DuplicateTasksStub        func(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error)
+	duplicateTasksMutex       sync.RWMutex
+	duplicateTasksArgsForCall []struct {
+		logger lager.Logger
+		domain string
+	}
+	duplicateTasksReturns struct {
+		result1 []*models.DuplicateTasks
+		result2 error
+	}
 	CancelTaskStub        func(logger lager.Logger, taskGuid string) error
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -71,6 +114,17 @@ type FakeInternalClient struct {
 	cancelTaskReturns struct {
 		result1 error
 	}
+	CancelTasksStub        func(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResponse, error)
+	cancelTasksMutex       sync.RWMutex
+	cancelTasksArgsForCall []struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}
+	cancelTasksReturns struct {
+		result1 *models.CancelTasksResponse
+		result2 error
+	}
 	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) error
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
@@ -108,6 +162,25 @@ type FakeInternalClient struct {
 	upsertDomainReturns struct {
 		result1 error
 	}
+	UpsertDomainsStub        func(logger lager.Logger, domains []*models.UpsertDomainRequest) ([]*models.UpsertDomainResult, error)
+	upsertDomainsMutex       sync.RWMutex
+	upsertDomainsArgsForCall []struct {
+		logger  lager.Logger
+		domains []*models.UpsertDomainRequest
+	}
+	upsertDomainsReturns struct {
+		result1 []*models.UpsertDomainResult
+		result2 error
+	}
+	DomainFreshnessStub        func(logger lager.Logger) ([]*models.DomainFreshness, error)
+	domainFreshnessMutex       sync.RWMutex
+	domainFreshnessArgsForCall []struct {
+		logger lager.Logger
+	}
+	domainFreshnessReturns struct {
+		result1 []*models.DomainFreshness
+		result2 error
+	}
 	ActualLRPGroupsStub        func(lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsMutex       sync.RWMutex
 	actualLRPGroupsArgsForCall []struct {
@@ -118,6 +191,17 @@ type FakeInternalClient struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPGroupsWithRecordErrorsStub        func(lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error)
+	actualLRPGroupsWithRecordErrorsMutex       sync.RWMutex
+	actualLRPGroupsWithRecordErrorsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.ActualLRPFilter
+	}
+	actualLRPGroupsWithRecordErrorsReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}
 	ActualLRPGroupsByProcessGuidStub        func(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
 	actualLRPGroupsByProcessGuidArgsForCall []struct {
@@ -139,6 +223,26 @@ type FakeInternalClient struct {
 		result1 *models.ActualLRPGroup
 		result2 error
 	}
+	CrashingActualLRPsStub        func(logger lager.Logger, limit int) ([]*models.ActualLRP, error)
+	crashingActualLRPsMutex       sync.RWMutex
+	crashingActualLRPsArgsForCall []struct {
+		logger lager.Logger
+		limit  int
+	}
+	crashingActualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	CellPlacementsStub        func(logger lager.Logger, cellID string) ([]*models.CellPlacement, error)
+	cellPlacementsMutex       sync.RWMutex
+	cellPlacementsArgsForCall []struct {
+		logger lager.Logger
+		cellID string
+	}
+	cellPlacementsReturns struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}
 	RetireActualLRPStub        func(logger lager.Logger, key *models.ActualLRPKey) error
 	retireActualLRPMutex       sync.RWMutex
 	retireActualLRPArgsForCall []struct {
@@ -158,6 +262,28 @@ type FakeInternalClient struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsWithRevisionStub        func(lager.Logger, models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, revision uint64, err error)
+	desiredLRPsWithRevisionMutex       sync.RWMutex
+	desiredLRPsWithRevisionArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}
+	desiredLRPsWithRevisionReturns struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}
+	DesiredLRPsWithRecordErrorsStub        func(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error)
+	desiredLRPsWithRecordErrorsMutex       sync.RWMutex
+	desiredLRPsWithRecordErrorsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}
+	desiredLRPsWithRecordErrorsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}
 	DesiredLRPByProcessGuidStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -178,6 +304,53 @@ type FakeInternalClient struct {
 		result1 []*models.DesiredLRPSchedulingInfo
 		result2 error
 	}
+	DesiredLRPSchedulingInfosWithRecordErrorsStub        func(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, []*models.RecordError, error)
+	desiredLRPSchedulingInfosWithRecordErrorsMutex       sync.RWMutex
+	desiredLRPSchedulingInfosWithRecordErrorsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}
+	desiredLRPSchedulingInfosWithRecordErrorsReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 []*models.RecordError
+		result3 error
+	}
+	DesiredLRPSchedulingInfosIfModifiedStub        func(logger lager.Logger, filter models.DesiredLRPFilter, etag string) (schedulingInfos []*models.DesiredLRPSchedulingInfo, newETag string, modified bool, err error)
+	desiredLRPSchedulingInfosIfModifiedMutex       sync.RWMutex
+	desiredLRPSchedulingInfosIfModifiedArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		etag   string
+	}
+	desiredLRPSchedulingInfosIfModifiedReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 string
+		result3 bool
+		result4 error
+	}
+	DesiredLRPDiffStub        func(logger lager.Logger, domain string, contentHashes []*models.DesiredLRPContentHash) (missing []string, changed []string, extra []string, err error)
+	desiredLRPDiffMutex       sync.RWMutex
+	desiredLRPDiffArgsForCall []struct {
+		logger        lager.Logger
+		domain        string
+		contentHashes []*models.DesiredLRPContentHash
+	}
+	desiredLRPDiffReturns struct {
+		result1 []string
+		result2 []string
+		result3 []string
+		result4 error
+	}
+	ValidateDesiredLRPStub        func(logger lager.Logger, desiredLRP *models.DesiredLRP) (validationErrors []string, err error)
+	validateDesiredLRPMutex       sync.RWMutex
+	validateDesiredLRPArgsForCall []struct {
+		logger     lager.Logger
+		desiredLRP *models.DesiredLRP
+	}
+	validateDesiredLRPReturns struct {
+		result1 []string
+		result2 error
+	}
 	DesireLRPStub        func(lager.Logger, *models.DesiredLRP) error
 	desireLRPMutex       sync.RWMutex
 	desireLRPArgsForCall []struct {
@@ -187,6 +360,26 @@ type FakeInternalClient struct {
 	desireLRPReturns struct {
 		result1 error
 	}
+	DesireLRPWithWarningsStub        func(logger lager.Logger, desiredLRP *models.DesiredLRP) ([]*models.LintWarning, error)
+	desireLRPWithWarningsMutex       sync.RWMutex
+	desireLRPWithWarningsArgsForCall []struct {
+		logger     lager.Logger
+		desiredLRP *models.DesiredLRP
+	}
+	desireLRPWithWarningsReturns struct {
+		result1 []*models.LintWarning
+		result2 error
+	}
+	DesireLRPWithIdempotencyKeyStub        func(logger lager.Logger, idempotencyKey string, desiredLRP *models.DesiredLRP) error
+	desireLRPWithIdempotencyKeyMutex       sync.RWMutex
+	desireLRPWithIdempotencyKeyArgsForCall []struct {
+		logger         lager.Logger
+		idempotencyKey string
+		desiredLRP     *models.DesiredLRP
+	}
+	desireLRPWithIdempotencyKeyReturns struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -197,6 +390,17 @@ type FakeInternalClient struct {
 	updateDesiredLRPReturns struct {
 		result1 error
 	}
+	UpdateDesiredLRPWithWarningsStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) ([]*models.LintWarning, error)
+	updateDesiredLRPWithWarningsMutex       sync.RWMutex
+	updateDesiredLRPWithWarningsArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		update      *models.DesiredLRPUpdate
+	}
+	updateDesiredLRPWithWarningsReturns struct {
+		result1 []*models.LintWarning
+		result2 error
+	}
 	RemoveDesiredLRPStub        func(logger lager.Logger, processGuid string) error
 	removeDesiredLRPMutex       sync.RWMutex
 	removeDesiredLRPArgsForCall []struct {
@@ -215,6 +419,15 @@ type FakeInternalClient struct {
 		result1 events.EventSource
 		result2 error
 	}
+	SubscribeToTaskEventsStub        func(logger lager.Logger) (events.EventSource, error)
+	subscribeToTaskEventsMutex       sync.RWMutex
+	subscribeToTaskEventsArgsForCall []struct {
+		logger lager.Logger
+	}
+	subscribeToTaskEventsReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
 	PingStub        func(logger lager.Logger) bool
 	pingMutex       sync.RWMutex
 	pingArgsForCall []struct {
@@ -232,6 +445,16 @@ type FakeInternalClient struct {
 		result1 []*models.CellPresence
 		result2 error
 	}
+	ObjectCountsStub        func(logger lager.Logger, groupBy ...string) (*models.ObjectCountsResponse, error)
+	objectCountsMutex       sync.RWMutex
+	objectCountsArgsForCall []struct {
+		logger  lager.Logger
+		groupBy []string
+	}
+	objectCountsReturns struct {
+		result1 *models.ObjectCountsResponse
+		result2 error
+	}
 	ClaimActualLRPStub        func(logger lager.Logger, processGuid string, index int, instanceKey *models.ActualLRPInstanceKey) error
 	claimActualLRPMutex       sync.RWMutex
 	claimActualLRPArgsForCall []struct {
@@ -254,6 +477,18 @@ type FakeInternalClient struct {
 	startActualLRPReturns struct {
 		result1 error
 	}
+	StartActualLRPsStub        func(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) ([]*models.ActualLRPKey, error)
+	startActualLRPsMutex       sync.RWMutex
+	startActualLRPsArgsForCall []struct {
+		logger        lager.Logger
+		cellID        string
+		starts        []*models.StartActualLRPRequest
+		authoritative bool
+	}
+	startActualLRPsReturns struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}
 	CrashActualLRPStub        func(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) error
 	crashActualLRPMutex       sync.RWMutex
 	crashActualLRPArgsForCall []struct {
@@ -286,6 +521,16 @@ type FakeInternalClient struct {
 	removeActualLRPReturns struct {
 		result1 error
 	}
+	RetireActualLRPsOnCellStub        func(logger lager.Logger, cellID string) (int, error)
+	retireActualLRPsOnCellMutex       sync.RWMutex
+	retireActualLRPsOnCellArgsForCall []struct {
+		logger lager.Logger
+		cellID string
+	}
+	retireActualLRPsOnCellReturns struct {
+		result1 int
+		result2 error
+	}
 	EvacuateClaimedActualLRPStub        func(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)
 	evacuateClaimedActualLRPMutex       sync.RWMutex
 	evacuateClaimedActualLRPArgsForCall []struct {
@@ -364,6 +609,16 @@ type FakeInternalClient struct {
 	failTaskReturns struct {
 		result1 error
 	}
+	RejectTaskStub        func(logger lager.Logger, taskGuid, rejectionReason string) error
+	rejectTaskMutex       sync.RWMutex
+	rejectTaskArgsForCall []struct {
+		logger          lager.Logger
+		taskGuid        string
+		rejectionReason string
+	}
+	rejectTaskReturns struct {
+		result1 error
+	}
 	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) error
 	completeTaskMutex       sync.RWMutex
 	completeTaskArgsForCall []struct {
@@ -377,6 +632,108 @@ type FakeInternalClient struct {
 	completeTaskReturns struct {
 		result1 error
 	}
+	ConvergeLRPsStub        func(logger lager.Logger, domains []string) error
+	convergeLRPsMutex       sync.RWMutex
+	convergeLRPsArgsForCall []struct {
+		logger  lager.Logger
+		domains []string
+	}
+	convergeLRPsReturns struct {
+		result1 error
+	}
+	ReleaseLockStub        func(logger lager.Logger) error
+	releaseLockMutex       sync.RWMutex
+	releaseLockArgsForCall []struct {
+		logger lager.Logger
+	}
+	releaseLockReturns struct {
+		result1 error
+	}
+	DesiredLRPsIncludingDeletedStub        func(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+	desiredLRPsIncludingDeletedMutex       sync.RWMutex
+	desiredLRPsIncludingDeletedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}
+	desiredLRPsIncludingDeletedReturns struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}
+	PurgeCompletedTasksStub        func(logger lager.Logger, minAge time.Duration) (int, error)
+	purgeCompletedTasksMutex       sync.RWMutex
+	purgeCompletedTasksArgsForCall []struct {
+		logger lager.Logger
+		minAge time.Duration
+	}
+	purgeCompletedTasksReturns struct {
+		result1 int
+		result2 error
+	}
+	ConvergenceStatusesStub        func(logger lager.Logger) ([]*models.ConvergenceStatus, error)
+	convergenceStatusesMutex       sync.RWMutex
+	convergenceStatusesArgsForCall []struct {
+		logger lager.Logger
+	}
+	convergenceStatusesReturns struct {
+		result1 []*models.ConvergenceStatus
+		result2 error
+	}
+	PauseConvergenceStub        func(logger lager.Logger, duration time.Duration) (time.Time, error)
+	pauseConvergenceMutex       sync.RWMutex
+	pauseConvergenceArgsForCall []struct {
+		logger   lager.Logger
+		duration time.Duration
+	}
+	pauseConvergenceReturns struct {
+		result1 time.Time
+		result2 error
+	}
+	ResumeConvergenceStub        func(logger lager.Logger) error
+	resumeConvergenceMutex       sync.RWMutex
+	resumeConvergenceArgsForCall []struct {
+		logger lager.Logger
+	}
+	resumeConvergenceReturns struct {
+		result1 error
+	}
+	ImportStub        func(logger lager.Logger, force bool, records []*models.ImportRecord) ([]*models.ImportResult, error)
+	importMutex       sync.RWMutex
+	importArgsForCall []struct {
+		logger  lager.Logger
+		force   bool
+		records []*models.ImportRecord
+	}
+	importReturns struct {
+		result1 []*models.ImportResult
+		result2 error
+	}
+	ExportStub        func(logger lager.Logger) ([]*models.ImportRecord, error)
+	exportMutex       sync.RWMutex
+	exportArgsForCall []struct {
+		logger lager.Logger
+	}
+	exportReturns struct {
+		result1 []*models.ImportRecord
+		result2 error
+	}
+	CellPresenceStatusesStub        func(logger lager.Logger) ([]*models.CellPresenceStatus, error)
+	cellPresenceStatusesMutex       sync.RWMutex
+	cellPresenceStatusesArgsForCall []struct {
+		logger lager.Logger
+	}
+	cellPresenceStatusesReturns struct {
+		result1 []*models.CellPresenceStatus
+		result2 error
+	}
+	CoordinationStateStub        func(logger lager.Logger) (*models.CoordinationStateResponse, error)
+	coordinationStateMutex       sync.RWMutex
+	coordinationStateArgsForCall []struct {
+		logger lager.Logger
+	}
+	coordinationStateReturns struct {
+		result1 *models.CoordinationStateResponse
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -417,6 +774,43 @@ func (fake *FakeInternalClient) DesireTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) DesireTaskWithIdempotencyKey(logger lager.Logger, idempotencyKey string, guid string, domain string, def *models.TaskDefinition) error {
+	fake.desireTaskWithIdempotencyKeyMutex.Lock()
+	fake.desireTaskWithIdempotencyKeyArgsForCall = append(fake.desireTaskWithIdempotencyKeyArgsForCall, struct {
+		logger         lager.Logger
+		idempotencyKey string
+		guid           string
+		domain         string
+		def            *models.TaskDefinition
+	}{logger, idempotencyKey, guid, domain, def})
+	fake.recordInvocation("DesireTaskWithIdempotencyKey", []interface{}{logger, idempotencyKey, guid, domain, def})
+	fake.desireTaskWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireTaskWithIdempotencyKeyStub != nil {
+		return fake.DesireTaskWithIdempotencyKeyStub(logger, idempotencyKey, guid, domain, def)
+	} else {
+		return fake.desireTaskWithIdempotencyKeyReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) DesireTaskWithIdempotencyKeyCallCount() int {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireTaskWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesireTaskWithIdempotencyKeyArgsForCall(i int) (lager.Logger, string, string, string, *models.TaskDefinition) {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireTaskWithIdempotencyKeyArgsForCall[i].logger, fake.desireTaskWithIdempotencyKeyArgsForCall[i].idempotencyKey, fake.desireTaskWithIdempotencyKeyArgsForCall[i].guid, fake.desireTaskWithIdempotencyKeyArgsForCall[i].domain, fake.desireTaskWithIdempotencyKeyArgsForCall[i].def
+}
+
+func (fake *FakeInternalClient) DesireTaskWithIdempotencyKeyReturns(result1 error) {
+	fake.DesireTaskWithIdempotencyKeyStub = nil
+	fake.desireTaskWithIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) Tasks(logger lager.Logger) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
@@ -521,6 +915,77 @@ func (fake *FakeInternalClient) TasksByCellIDReturns(result1 []*models.Task, res
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) TasksWithFilter(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
+	fake.tasksWithFilterMutex.Lock()
+	fake.tasksWithFilterArgsForCall = append(fake.tasksWithFilterArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}{logger, filter})
+	fake.recordInvocation("TasksWithFilter", []interface{}{logger, filter})
+	fake.tasksWithFilterMutex.Unlock()
+	if fake.TasksWithFilterStub != nil {
+		return fake.TasksWithFilterStub(logger, filter)
+	} else {
+		return fake.tasksWithFilterReturns.result1, fake.tasksWithFilterReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) TasksWithFilterCallCount() int {
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	return len(fake.tasksWithFilterArgsForCall)
+}
+
+func (fake *FakeInternalClient) TasksWithFilterArgsForCall(i int) (lager.Logger, models.TaskFilter) {
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	return fake.tasksWithFilterArgsForCall[i].logger, fake.tasksWithFilterArgsForCall[i].filter
+}
+
+func (fake *FakeInternalClient) TasksWithFilterReturns(result1 []*models.Task, result2 error) {
+	fake.TasksWithFilterStub = nil
+	fake.tasksWithFilterReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) TasksWithRecordErrors(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, []*models.RecordError, error) {
+	fake.tasksWithRecordErrorsMutex.Lock()
+	fake.tasksWithRecordErrorsArgsForCall = append(fake.tasksWithRecordErrorsArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}{logger, filter})
+	fake.recordInvocation("TasksWithRecordErrors", []interface{}{logger, filter})
+	fake.tasksWithRecordErrorsMutex.Unlock()
+	if fake.TasksWithRecordErrorsStub != nil {
+		return fake.TasksWithRecordErrorsStub(logger, filter)
+	} else {
+		return fake.tasksWithRecordErrorsReturns.result1, fake.tasksWithRecordErrorsReturns.result2, fake.tasksWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeInternalClient) TasksWithRecordErrorsCallCount() int {
+	fake.tasksWithRecordErrorsMutex.RLock()
+	defer fake.tasksWithRecordErrorsMutex.RUnlock()
+	return len(fake.tasksWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeInternalClient) TasksWithRecordErrorsArgsForCall(i int) (lager.Logger, models.TaskFilter) {
+	fake.tasksWithRecordErrorsMutex.RLock()
+	defer fake.tasksWithRecordErrorsMutex.RUnlock()
+	return fake.tasksWithRecordErrorsArgsForCall[i].logger, fake.tasksWithRecordErrorsArgsForCall[i].filter
+}
+
+func (fake *FakeInternalClient) TasksWithRecordErrorsReturns(result1 []*models.Task, result2 []*models.RecordError, result3 error) {
+	fake.TasksWithRecordErrorsStub = nil
+	fake.tasksWithRecordErrorsReturns = struct {
+		result1 []*models.Task
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInternalClient) TaskByGuid(logger lager.Logger, guid string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
@@ -556,6 +1021,41 @@ func (fake *FakeInternalClient) TaskByGuidReturns(result1 *models.Task, result2
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error) {
+	fake.duplicateTasksMutex.Lock()
+	fake.duplicateTasksArgsForCall = append(fake.duplicateTasksArgsForCall, struct {
+		logger lager.Logger
+		domain string
+	}{logger, domain})
+	fake.recordInvocation("DuplicateTasks", []interface{}{logger, domain})
+	fake.duplicateTasksMutex.Unlock()
+	if fake.DuplicateTasksStub != nil {
+		return fake.DuplicateTasksStub(logger, domain)
+	} else {
+		return fake.duplicateTasksReturns.result1, fake.duplicateTasksReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DuplicateTasksCallCount() int {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return len(fake.duplicateTasksArgsForCall)
+}
+
+func (fake *FakeInternalClient) DuplicateTasksArgsForCall(i int) (lager.Logger, string) {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return fake.duplicateTasksArgsForCall[i].logger, fake.duplicateTasksArgsForCall[i].domain
+}
+
+func (fake *FakeInternalClient) DuplicateTasksReturns(result1 []*models.DuplicateTasks, result2 error) {
+	fake.DuplicateTasksStub = nil
+	fake.duplicateTasksReturns = struct {
+		result1 []*models.DuplicateTasks
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) CancelTask(logger lager.Logger, taskGuid string) error {
 	fake.cancelTaskMutex.Lock()
 	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
@@ -590,6 +1090,42 @@ func (fake *FakeInternalClient) CancelTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResponse, error) {
+	fake.cancelTasksMutex.Lock()
+	fake.cancelTasksArgsForCall = append(fake.cancelTasksArgsForCall, struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}{logger, domain, taskGuids})
+	fake.recordInvocation("CancelTasks", []interface{}{logger, domain, taskGuids})
+	fake.cancelTasksMutex.Unlock()
+	if fake.CancelTasksStub != nil {
+		return fake.CancelTasksStub(logger, domain, taskGuids)
+	} else {
+		return fake.cancelTasksReturns.result1, fake.cancelTasksReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) CancelTasksCallCount() int {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return len(fake.cancelTasksArgsForCall)
+}
+
+func (fake *FakeInternalClient) CancelTasksArgsForCall(i int) (lager.Logger, string, []string) {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return fake.cancelTasksArgsForCall[i].logger, fake.cancelTasksArgsForCall[i].domain, fake.cancelTasksArgsForCall[i].taskGuids
+}
+
+func (fake *FakeInternalClient) CancelTasksReturns(result1 *models.CancelTasksResponse, result2 error) {
+	fake.CancelTasksStub = nil
+	fake.cancelTasksReturns = struct {
+		result1 *models.CancelTasksResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	fake.resolvingTaskMutex.Lock()
 	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
@@ -727,6 +1263,75 @@ func (fake *FakeInternalClient) UpsertDomainReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) UpsertDomains(logger lager.Logger, domains []*models.UpsertDomainRequest) ([]*models.UpsertDomainResult, error) {
+	fake.upsertDomainsMutex.Lock()
+	fake.upsertDomainsArgsForCall = append(fake.upsertDomainsArgsForCall, struct {
+		logger  lager.Logger
+		domains []*models.UpsertDomainRequest
+	}{logger, domains})
+	fake.recordInvocation("UpsertDomains", []interface{}{logger, domains})
+	fake.upsertDomainsMutex.Unlock()
+	if fake.UpsertDomainsStub != nil {
+		return fake.UpsertDomainsStub(logger, domains)
+	} else {
+		return fake.upsertDomainsReturns.result1, fake.upsertDomainsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) UpsertDomainsCallCount() int {
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	return len(fake.upsertDomainsArgsForCall)
+}
+
+func (fake *FakeInternalClient) UpsertDomainsArgsForCall(i int) (lager.Logger, []*models.UpsertDomainRequest) {
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	return fake.upsertDomainsArgsForCall[i].logger, fake.upsertDomainsArgsForCall[i].domains
+}
+
+func (fake *FakeInternalClient) UpsertDomainsReturns(result1 []*models.UpsertDomainResult, result2 error) {
+	fake.UpsertDomainsStub = nil
+	fake.upsertDomainsReturns = struct {
+		result1 []*models.UpsertDomainResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DomainFreshness(logger lager.Logger) ([]*models.DomainFreshness, error) {
+	fake.domainFreshnessMutex.Lock()
+	fake.domainFreshnessArgsForCall = append(fake.domainFreshnessArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DomainFreshness", []interface{}{logger})
+	fake.domainFreshnessMutex.Unlock()
+	if fake.DomainFreshnessStub != nil {
+		return fake.DomainFreshnessStub(logger)
+	} else {
+		return fake.domainFreshnessReturns.result1, fake.domainFreshnessReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DomainFreshnessCallCount() int {
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
+	return len(fake.domainFreshnessArgsForCall)
+}
+
+func (fake *FakeInternalClient) DomainFreshnessArgsForCall(i int) lager.Logger {
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
+	return fake.domainFreshnessArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) DomainFreshnessReturns(result1 []*models.DomainFreshness, result2 error) {
+	fake.DomainFreshnessStub = nil
+	fake.domainFreshnessReturns = struct {
+		result1 []*models.DomainFreshness
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) ActualLRPGroups(arg1 lager.Logger, arg2 models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsMutex.Lock()
 	fake.actualLRPGroupsArgsForCall = append(fake.actualLRPGroupsArgsForCall, struct {
@@ -762,6 +1367,42 @@ func (fake *FakeInternalClient) ActualLRPGroupsReturns(result1 []*models.ActualL
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) ActualLRPGroupsWithRecordErrors(arg1 lager.Logger, arg2 models.ActualLRPFilter) ([]*models.ActualLRPGroup, []*models.RecordError, error) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.Lock()
+	fake.actualLRPGroupsWithRecordErrorsArgsForCall = append(fake.actualLRPGroupsWithRecordErrorsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.ActualLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("ActualLRPGroupsWithRecordErrors", []interface{}{arg1, arg2})
+	fake.actualLRPGroupsWithRecordErrorsMutex.Unlock()
+	if fake.ActualLRPGroupsWithRecordErrorsStub != nil {
+		return fake.ActualLRPGroupsWithRecordErrorsStub(arg1, arg2)
+	} else {
+		return fake.actualLRPGroupsWithRecordErrorsReturns.result1, fake.actualLRPGroupsWithRecordErrorsReturns.result2, fake.actualLRPGroupsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeInternalClient) ActualLRPGroupsWithRecordErrorsCallCount() int {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return len(fake.actualLRPGroupsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeInternalClient) ActualLRPGroupsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
+	return fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].arg1, fake.actualLRPGroupsWithRecordErrorsArgsForCall[i].arg2
+}
+
+func (fake *FakeInternalClient) ActualLRPGroupsWithRecordErrorsReturns(result1 []*models.ActualLRPGroup, result2 []*models.RecordError, result3 error) {
+	fake.ActualLRPGroupsWithRecordErrorsStub = nil
+	fake.actualLRPGroupsWithRecordErrorsReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInternalClient) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsByProcessGuidMutex.Lock()
 	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
@@ -833,18 +1474,88 @@ func (fake *FakeInternalClient) ActualLRPGroupByProcessGuidAndIndexReturns(resul
 	}{result1, result2}
 }
 
-func (fake *FakeInternalClient) RetireActualLRP(logger lager.Logger, key *models.ActualLRPKey) error {
-	fake.retireActualLRPMutex.Lock()
-	fake.retireActualLRPArgsForCall = append(fake.retireActualLRPArgsForCall, struct {
+func (fake *FakeInternalClient) CrashingActualLRPs(logger lager.Logger, limit int) ([]*models.ActualLRP, error) {
+	fake.crashingActualLRPsMutex.Lock()
+	fake.crashingActualLRPsArgsForCall = append(fake.crashingActualLRPsArgsForCall, struct {
 		logger lager.Logger
-		key    *models.ActualLRPKey
-	}{logger, key})
-	fake.recordInvocation("RetireActualLRP", []interface{}{logger, key})
-	fake.retireActualLRPMutex.Unlock()
-	if fake.RetireActualLRPStub != nil {
-		return fake.RetireActualLRPStub(logger, key)
+		limit  int
+	}{logger, limit})
+	fake.recordInvocation("CrashingActualLRPs", []interface{}{logger, limit})
+	fake.crashingActualLRPsMutex.Unlock()
+	if fake.CrashingActualLRPsStub != nil {
+		return fake.CrashingActualLRPsStub(logger, limit)
 	} else {
-		return fake.retireActualLRPReturns.result1
+		return fake.crashingActualLRPsReturns.result1, fake.crashingActualLRPsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) CrashingActualLRPsCallCount() int {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return len(fake.crashingActualLRPsArgsForCall)
+}
+
+func (fake *FakeInternalClient) CrashingActualLRPsArgsForCall(i int) (lager.Logger, int) {
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	return fake.crashingActualLRPsArgsForCall[i].logger, fake.crashingActualLRPsArgsForCall[i].limit
+}
+
+func (fake *FakeInternalClient) CrashingActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.CrashingActualLRPsStub = nil
+	fake.crashingActualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CellPlacements(logger lager.Logger, cellID string) ([]*models.CellPlacement, error) {
+	fake.cellPlacementsMutex.Lock()
+	fake.cellPlacementsArgsForCall = append(fake.cellPlacementsArgsForCall, struct {
+		logger lager.Logger
+		cellID string
+	}{logger, cellID})
+	fake.recordInvocation("CellPlacements", []interface{}{logger, cellID})
+	fake.cellPlacementsMutex.Unlock()
+	if fake.CellPlacementsStub != nil {
+		return fake.CellPlacementsStub(logger, cellID)
+	} else {
+		return fake.cellPlacementsReturns.result1, fake.cellPlacementsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) CellPlacementsCallCount() int {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return len(fake.cellPlacementsArgsForCall)
+}
+
+func (fake *FakeInternalClient) CellPlacementsArgsForCall(i int) (lager.Logger, string) {
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
+	return fake.cellPlacementsArgsForCall[i].logger, fake.cellPlacementsArgsForCall[i].cellID
+}
+
+func (fake *FakeInternalClient) CellPlacementsReturns(result1 []*models.CellPlacement, result2 error) {
+	fake.CellPlacementsStub = nil
+	fake.cellPlacementsReturns = struct {
+		result1 []*models.CellPlacement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) RetireActualLRP(logger lager.Logger, key *models.ActualLRPKey) error {
+	fake.retireActualLRPMutex.Lock()
+	fake.retireActualLRPArgsForCall = append(fake.retireActualLRPArgsForCall, struct {
+		logger lager.Logger
+		key    *models.ActualLRPKey
+	}{logger, key})
+	fake.recordInvocation("RetireActualLRP", []interface{}{logger, key})
+	fake.retireActualLRPMutex.Unlock()
+	if fake.RetireActualLRPStub != nil {
+		return fake.RetireActualLRPStub(logger, key)
+	} else {
+		return fake.retireActualLRPReturns.result1
 	}
 }
 
@@ -902,6 +1613,78 @@ func (fake *FakeInternalClient) DesiredLRPsReturns(result1 []*models.DesiredLRP,
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) DesiredLRPsWithRevision(arg1 lager.Logger, arg2 models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, revision uint64, err error) {
+	fake.desiredLRPsWithRevisionMutex.Lock()
+	fake.desiredLRPsWithRevisionArgsForCall = append(fake.desiredLRPsWithRevisionArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("DesiredLRPsWithRevision", []interface{}{arg1, arg2})
+	fake.desiredLRPsWithRevisionMutex.Unlock()
+	if fake.DesiredLRPsWithRevisionStub != nil {
+		return fake.DesiredLRPsWithRevisionStub(arg1, arg2)
+	} else {
+		return fake.desiredLRPsWithRevisionReturns.result1, fake.desiredLRPsWithRevisionReturns.result2, fake.desiredLRPsWithRevisionReturns.result3
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPsWithRevisionCallCount() int {
+	fake.desiredLRPsWithRevisionMutex.RLock()
+	defer fake.desiredLRPsWithRevisionMutex.RUnlock()
+	return len(fake.desiredLRPsWithRevisionArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPsWithRevisionArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsWithRevisionMutex.RLock()
+	defer fake.desiredLRPsWithRevisionMutex.RUnlock()
+	return fake.desiredLRPsWithRevisionArgsForCall[i].arg1, fake.desiredLRPsWithRevisionArgsForCall[i].arg2
+}
+
+func (fake *FakeInternalClient) DesiredLRPsWithRevisionReturns(result1 []*models.DesiredLRP, result2 uint64, result3 error) {
+	fake.DesiredLRPsWithRevisionStub = nil
+	fake.desiredLRPsWithRevisionReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) DesiredLRPsWithRecordErrors(arg1 lager.Logger, arg2 models.DesiredLRPFilter) ([]*models.DesiredLRP, []*models.RecordError, error) {
+	fake.desiredLRPsWithRecordErrorsMutex.Lock()
+	fake.desiredLRPsWithRecordErrorsArgsForCall = append(fake.desiredLRPsWithRecordErrorsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("DesiredLRPsWithRecordErrors", []interface{}{arg1, arg2})
+	fake.desiredLRPsWithRecordErrorsMutex.Unlock()
+	if fake.DesiredLRPsWithRecordErrorsStub != nil {
+		return fake.DesiredLRPsWithRecordErrorsStub(arg1, arg2)
+	} else {
+		return fake.desiredLRPsWithRecordErrorsReturns.result1, fake.desiredLRPsWithRecordErrorsReturns.result2, fake.desiredLRPsWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPsWithRecordErrorsCallCount() int {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return len(fake.desiredLRPsWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPsWithRecordErrorsArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
+	return fake.desiredLRPsWithRecordErrorsArgsForCall[i].arg1, fake.desiredLRPsWithRecordErrorsArgsForCall[i].arg2
+}
+
+func (fake *FakeInternalClient) DesiredLRPsWithRecordErrorsReturns(result1 []*models.DesiredLRP, result2 []*models.RecordError, result3 error) {
+	fake.DesiredLRPsWithRecordErrorsStub = nil
+	fake.desiredLRPsWithRecordErrorsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInternalClient) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
@@ -972,6 +1755,153 @@ func (fake *FakeInternalClient) DesiredLRPSchedulingInfosReturns(result1 []*mode
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) DesiredLRPSchedulingInfosWithRecordErrors(arg1 lager.Logger, arg2 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, []*models.RecordError, error) {
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.Lock()
+	fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall = append(fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("DesiredLRPSchedulingInfosWithRecordErrors", []interface{}{arg1, arg2})
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.Unlock()
+	if fake.DesiredLRPSchedulingInfosWithRecordErrorsStub != nil {
+		return fake.DesiredLRPSchedulingInfosWithRecordErrorsStub(arg1, arg2)
+	} else {
+		return fake.desiredLRPSchedulingInfosWithRecordErrorsReturns.result1, fake.desiredLRPSchedulingInfosWithRecordErrorsReturns.result2, fake.desiredLRPSchedulingInfosWithRecordErrorsReturns.result3
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPSchedulingInfosWithRecordErrorsCallCount() int {
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPSchedulingInfosWithRecordErrorsArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RUnlock()
+	return fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall[i].arg1, fake.desiredLRPSchedulingInfosWithRecordErrorsArgsForCall[i].arg2
+}
+
+func (fake *FakeInternalClient) DesiredLRPSchedulingInfosWithRecordErrorsReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 []*models.RecordError, result3 error) {
+	fake.DesiredLRPSchedulingInfosWithRecordErrorsStub = nil
+	fake.desiredLRPSchedulingInfosWithRecordErrorsReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 []*models.RecordError
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) DesiredLRPSchedulingInfosIfModified(logger lager.Logger, filter models.DesiredLRPFilter, etag string) (schedulingInfos []*models.DesiredLRPSchedulingInfo, newETag string, modified bool, err error) {
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.Lock()
+	fake.desiredLRPSchedulingInfosIfModifiedArgsForCall = append(fake.desiredLRPSchedulingInfosIfModifiedArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+		etag   string
+	}{logger, filter, etag})
+	fake.recordInvocation("DesiredLRPSchedulingInfosIfModified", []interface{}{logger, filter, etag})
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.Unlock()
+	if fake.DesiredLRPSchedulingInfosIfModifiedStub != nil {
+		return fake.DesiredLRPSchedulingInfosIfModifiedStub(logger, filter, etag)
+	} else {
+		return fake.desiredLRPSchedulingInfosIfModifiedReturns.result1, fake.desiredLRPSchedulingInfosIfModifiedReturns.result2, fake.desiredLRPSchedulingInfosIfModifiedReturns.result3, fake.desiredLRPSchedulingInfosIfModifiedReturns.result4
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPSchedulingInfosIfModifiedCallCount() int {
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosIfModifiedMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfosIfModifiedArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPSchedulingInfosIfModifiedArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter, string) {
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosIfModifiedMutex.RUnlock()
+	return fake.desiredLRPSchedulingInfosIfModifiedArgsForCall[i].logger, fake.desiredLRPSchedulingInfosIfModifiedArgsForCall[i].filter, fake.desiredLRPSchedulingInfosIfModifiedArgsForCall[i].etag
+}
+
+func (fake *FakeInternalClient) DesiredLRPSchedulingInfosIfModifiedReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 string, result3 bool, result4 error) {
+	fake.DesiredLRPSchedulingInfosIfModifiedStub = nil
+	fake.desiredLRPSchedulingInfosIfModifiedReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 string
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeInternalClient) DesiredLRPDiff(logger lager.Logger, domain string, contentHashes []*models.DesiredLRPContentHash) (missing []string, changed []string, extra []string, err error) {
+	fake.desiredLRPDiffMutex.Lock()
+	fake.desiredLRPDiffArgsForCall = append(fake.desiredLRPDiffArgsForCall, struct {
+		logger        lager.Logger
+		domain        string
+		contentHashes []*models.DesiredLRPContentHash
+	}{logger, domain, contentHashes})
+	fake.recordInvocation("DesiredLRPDiff", []interface{}{logger, domain, contentHashes})
+	fake.desiredLRPDiffMutex.Unlock()
+	if fake.DesiredLRPDiffStub != nil {
+		return fake.DesiredLRPDiffStub(logger, domain, contentHashes)
+	} else {
+		return fake.desiredLRPDiffReturns.result1, fake.desiredLRPDiffReturns.result2, fake.desiredLRPDiffReturns.result3, fake.desiredLRPDiffReturns.result4
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPDiffCallCount() int {
+	fake.desiredLRPDiffMutex.RLock()
+	defer fake.desiredLRPDiffMutex.RUnlock()
+	return len(fake.desiredLRPDiffArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPDiffArgsForCall(i int) (lager.Logger, string, []*models.DesiredLRPContentHash) {
+	fake.desiredLRPDiffMutex.RLock()
+	defer fake.desiredLRPDiffMutex.RUnlock()
+	return fake.desiredLRPDiffArgsForCall[i].logger, fake.desiredLRPDiffArgsForCall[i].domain, fake.desiredLRPDiffArgsForCall[i].contentHashes
+}
+
+func (fake *FakeInternalClient) DesiredLRPDiffReturns(result1 []string, result2 []string, result3 []string, result4 error) {
+	fake.DesiredLRPDiffStub = nil
+	fake.desiredLRPDiffReturns = struct {
+		result1 []string
+		result2 []string
+		result3 []string
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeInternalClient) ValidateDesiredLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) (validationErrors []string, err error) {
+	fake.validateDesiredLRPMutex.Lock()
+	fake.validateDesiredLRPArgsForCall = append(fake.validateDesiredLRPArgsForCall, struct {
+		logger     lager.Logger
+		desiredLRP *models.DesiredLRP
+	}{logger, desiredLRP})
+	fake.recordInvocation("ValidateDesiredLRP", []interface{}{logger, desiredLRP})
+	fake.validateDesiredLRPMutex.Unlock()
+	if fake.ValidateDesiredLRPStub != nil {
+		return fake.ValidateDesiredLRPStub(logger, desiredLRP)
+	} else {
+		return fake.validateDesiredLRPReturns.result1, fake.validateDesiredLRPReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) ValidateDesiredLRPCallCount() int {
+	fake.validateDesiredLRPMutex.RLock()
+	defer fake.validateDesiredLRPMutex.RUnlock()
+	return len(fake.validateDesiredLRPArgsForCall)
+}
+
+func (fake *FakeInternalClient) ValidateDesiredLRPArgsForCall(i int) (lager.Logger, *models.DesiredLRP) {
+	fake.validateDesiredLRPMutex.RLock()
+	defer fake.validateDesiredLRPMutex.RUnlock()
+	return fake.validateDesiredLRPArgsForCall[i].logger, fake.validateDesiredLRPArgsForCall[i].desiredLRP
+}
+
+func (fake *FakeInternalClient) ValidateDesiredLRPReturns(result1 []string, result2 error) {
+	fake.ValidateDesiredLRPStub = nil
+	fake.validateDesiredLRPReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) DesireLRP(arg1 lager.Logger, arg2 *models.DesiredLRP) error {
 	fake.desireLRPMutex.Lock()
 	fake.desireLRPArgsForCall = append(fake.desireLRPArgsForCall, struct {
@@ -1006,6 +1936,76 @@ func (fake *FakeInternalClient) DesireLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) DesireLRPWithWarnings(logger lager.Logger, desiredLRP *models.DesiredLRP) ([]*models.LintWarning, error) {
+	fake.desireLRPWithWarningsMutex.Lock()
+	fake.desireLRPWithWarningsArgsForCall = append(fake.desireLRPWithWarningsArgsForCall, struct {
+		logger     lager.Logger
+		desiredLRP *models.DesiredLRP
+	}{logger, desiredLRP})
+	fake.recordInvocation("DesireLRPWithWarnings", []interface{}{logger, desiredLRP})
+	fake.desireLRPWithWarningsMutex.Unlock()
+	if fake.DesireLRPWithWarningsStub != nil {
+		return fake.DesireLRPWithWarningsStub(logger, desiredLRP)
+	} else {
+		return fake.desireLRPWithWarningsReturns.result1, fake.desireLRPWithWarningsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DesireLRPWithWarningsCallCount() int {
+	fake.desireLRPWithWarningsMutex.RLock()
+	defer fake.desireLRPWithWarningsMutex.RUnlock()
+	return len(fake.desireLRPWithWarningsArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesireLRPWithWarningsArgsForCall(i int) (lager.Logger, *models.DesiredLRP) {
+	fake.desireLRPWithWarningsMutex.RLock()
+	defer fake.desireLRPWithWarningsMutex.RUnlock()
+	return fake.desireLRPWithWarningsArgsForCall[i].logger, fake.desireLRPWithWarningsArgsForCall[i].desiredLRP
+}
+
+func (fake *FakeInternalClient) DesireLRPWithWarningsReturns(result1 []*models.LintWarning, result2 error) {
+	fake.DesireLRPWithWarningsStub = nil
+	fake.desireLRPWithWarningsReturns = struct {
+		result1 []*models.LintWarning
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesireLRPWithIdempotencyKey(logger lager.Logger, idempotencyKey string, desiredLRP *models.DesiredLRP) error {
+	fake.desireLRPWithIdempotencyKeyMutex.Lock()
+	fake.desireLRPWithIdempotencyKeyArgsForCall = append(fake.desireLRPWithIdempotencyKeyArgsForCall, struct {
+		logger         lager.Logger
+		idempotencyKey string
+		desiredLRP     *models.DesiredLRP
+	}{logger, idempotencyKey, desiredLRP})
+	fake.recordInvocation("DesireLRPWithIdempotencyKey", []interface{}{logger, idempotencyKey, desiredLRP})
+	fake.desireLRPWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireLRPWithIdempotencyKeyStub != nil {
+		return fake.DesireLRPWithIdempotencyKeyStub(logger, idempotencyKey, desiredLRP)
+	} else {
+		return fake.desireLRPWithIdempotencyKeyReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) DesireLRPWithIdempotencyKeyCallCount() int {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireLRPWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesireLRPWithIdempotencyKeyArgsForCall(i int) (lager.Logger, string, *models.DesiredLRP) {
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireLRPWithIdempotencyKeyArgsForCall[i].logger, fake.desireLRPWithIdempotencyKeyArgsForCall[i].idempotencyKey, fake.desireLRPWithIdempotencyKeyArgsForCall[i].desiredLRP
+}
+
+func (fake *FakeInternalClient) DesireLRPWithIdempotencyKeyReturns(result1 error) {
+	fake.DesireLRPWithIdempotencyKeyStub = nil
+	fake.desireLRPWithIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error {
 	fake.updateDesiredLRPMutex.Lock()
 	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
@@ -1041,6 +2041,42 @@ func (fake *FakeInternalClient) UpdateDesiredLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) UpdateDesiredLRPWithWarnings(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) ([]*models.LintWarning, error) {
+	fake.updateDesiredLRPWithWarningsMutex.Lock()
+	fake.updateDesiredLRPWithWarningsArgsForCall = append(fake.updateDesiredLRPWithWarningsArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		update      *models.DesiredLRPUpdate
+	}{logger, processGuid, update})
+	fake.recordInvocation("UpdateDesiredLRPWithWarnings", []interface{}{logger, processGuid, update})
+	fake.updateDesiredLRPWithWarningsMutex.Unlock()
+	if fake.UpdateDesiredLRPWithWarningsStub != nil {
+		return fake.UpdateDesiredLRPWithWarningsStub(logger, processGuid, update)
+	} else {
+		return fake.updateDesiredLRPWithWarningsReturns.result1, fake.updateDesiredLRPWithWarningsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) UpdateDesiredLRPWithWarningsCallCount() int {
+	fake.updateDesiredLRPWithWarningsMutex.RLock()
+	defer fake.updateDesiredLRPWithWarningsMutex.RUnlock()
+	return len(fake.updateDesiredLRPWithWarningsArgsForCall)
+}
+
+func (fake *FakeInternalClient) UpdateDesiredLRPWithWarningsArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPUpdate) {
+	fake.updateDesiredLRPWithWarningsMutex.RLock()
+	defer fake.updateDesiredLRPWithWarningsMutex.RUnlock()
+	return fake.updateDesiredLRPWithWarningsArgsForCall[i].logger, fake.updateDesiredLRPWithWarningsArgsForCall[i].processGuid, fake.updateDesiredLRPWithWarningsArgsForCall[i].update
+}
+
+func (fake *FakeInternalClient) UpdateDesiredLRPWithWarningsReturns(result1 []*models.LintWarning, result2 error) {
+	fake.UpdateDesiredLRPWithWarningsStub = nil
+	fake.updateDesiredLRPWithWarningsReturns = struct {
+		result1 []*models.LintWarning
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) RemoveDesiredLRP(logger lager.Logger, processGuid string) error {
 	fake.removeDesiredLRPMutex.Lock()
 	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
@@ -1109,6 +2145,40 @@ func (fake *FakeInternalClient) SubscribeToEventsReturns(result1 events.EventSou
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	fake.subscribeToTaskEventsArgsForCall = append(fake.subscribeToTaskEventsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("SubscribeToTaskEvents", []interface{}{logger})
+	fake.subscribeToTaskEventsMutex.Unlock()
+	if fake.SubscribeToTaskEventsStub != nil {
+		return fake.SubscribeToTaskEventsStub(logger)
+	} else {
+		return fake.subscribeToTaskEventsReturns.result1, fake.subscribeToTaskEventsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsCallCount() int {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsArgsForCall)
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsArgsForCall(i int) lager.Logger {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	return fake.subscribeToTaskEventsArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsReturns(result1 events.EventSource, result2 error) {
+	fake.SubscribeToTaskEventsStub = nil
+	fake.subscribeToTaskEventsReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Ping(logger lager.Logger) bool {
 	fake.pingMutex.Lock()
 	fake.pingArgsForCall = append(fake.pingArgsForCall, struct {
@@ -1176,6 +2246,41 @@ func (fake *FakeInternalClient) CellsReturns(result1 []*models.CellPresence, res
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) ObjectCounts(logger lager.Logger, groupBy ...string) (*models.ObjectCountsResponse, error) {
+	fake.objectCountsMutex.Lock()
+	fake.objectCountsArgsForCall = append(fake.objectCountsArgsForCall, struct {
+		logger  lager.Logger
+		groupBy []string
+	}{logger, groupBy})
+	fake.recordInvocation("ObjectCounts", []interface{}{logger, groupBy})
+	fake.objectCountsMutex.Unlock()
+	if fake.ObjectCountsStub != nil {
+		return fake.ObjectCountsStub(logger, groupBy...)
+	} else {
+		return fake.objectCountsReturns.result1, fake.objectCountsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) ObjectCountsCallCount() int {
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
+	return len(fake.objectCountsArgsForCall)
+}
+
+func (fake *FakeInternalClient) ObjectCountsArgsForCall(i int) (lager.Logger, []string) {
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
+	return fake.objectCountsArgsForCall[i].logger, fake.objectCountsArgsForCall[i].groupBy
+}
+
+func (fake *FakeInternalClient) ObjectCountsReturns(result1 *models.ObjectCountsResponse, result2 error) {
+	fake.ObjectCountsStub = nil
+	fake.objectCountsReturns = struct {
+		result1 *models.ObjectCountsResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) ClaimActualLRP(logger lager.Logger, processGuid string, index int, instanceKey *models.ActualLRPInstanceKey) error {
 	fake.claimActualLRPMutex.Lock()
 	fake.claimActualLRPArgsForCall = append(fake.claimActualLRPArgsForCall, struct {
@@ -1248,6 +2353,43 @@ func (fake *FakeInternalClient) StartActualLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) StartActualLRPs(logger lager.Logger, cellID string, starts []*models.StartActualLRPRequest, authoritative bool) ([]*models.ActualLRPKey, error) {
+	fake.startActualLRPsMutex.Lock()
+	fake.startActualLRPsArgsForCall = append(fake.startActualLRPsArgsForCall, struct {
+		logger        lager.Logger
+		cellID        string
+		starts        []*models.StartActualLRPRequest
+		authoritative bool
+	}{logger, cellID, starts, authoritative})
+	fake.recordInvocation("StartActualLRPs", []interface{}{logger, cellID, starts, authoritative})
+	fake.startActualLRPsMutex.Unlock()
+	if fake.StartActualLRPsStub != nil {
+		return fake.StartActualLRPsStub(logger, cellID, starts, authoritative)
+	} else {
+		return fake.startActualLRPsReturns.result1, fake.startActualLRPsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) StartActualLRPsCallCount() int {
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
+	return len(fake.startActualLRPsArgsForCall)
+}
+
+func (fake *FakeInternalClient) StartActualLRPsArgsForCall(i int) (lager.Logger, string, []*models.StartActualLRPRequest, bool) {
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
+	return fake.startActualLRPsArgsForCall[i].logger, fake.startActualLRPsArgsForCall[i].cellID, fake.startActualLRPsArgsForCall[i].starts, fake.startActualLRPsArgsForCall[i].authoritative
+}
+
+func (fake *FakeInternalClient) StartActualLRPsReturns(result1 []*models.ActualLRPKey, result2 error) {
+	fake.StartActualLRPsStub = nil
+	fake.startActualLRPsReturns = struct {
+		result1 []*models.ActualLRPKey
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) error {
 	fake.crashActualLRPMutex.Lock()
 	fake.crashActualLRPArgsForCall = append(fake.crashActualLRPArgsForCall, struct {
@@ -1355,6 +2497,41 @@ func (fake *FakeInternalClient) RemoveActualLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) RetireActualLRPsOnCell(logger lager.Logger, cellID string) (int, error) {
+	fake.retireActualLRPsOnCellMutex.Lock()
+	fake.retireActualLRPsOnCellArgsForCall = append(fake.retireActualLRPsOnCellArgsForCall, struct {
+		logger lager.Logger
+		cellID string
+	}{logger, cellID})
+	fake.recordInvocation("RetireActualLRPsOnCell", []interface{}{logger, cellID})
+	fake.retireActualLRPsOnCellMutex.Unlock()
+	if fake.RetireActualLRPsOnCellStub != nil {
+		return fake.RetireActualLRPsOnCellStub(logger, cellID)
+	} else {
+		return fake.retireActualLRPsOnCellReturns.result1, fake.retireActualLRPsOnCellReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) RetireActualLRPsOnCellCallCount() int {
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
+	return len(fake.retireActualLRPsOnCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) RetireActualLRPsOnCellArgsForCall(i int) (lager.Logger, string) {
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
+	return fake.retireActualLRPsOnCellArgsForCall[i].logger, fake.retireActualLRPsOnCellArgsForCall[i].cellID
+}
+
+func (fake *FakeInternalClient) RetireActualLRPsOnCellReturns(result1 int, result2 error) {
+	fake.RetireActualLRPsOnCellStub = nil
+	fake.retireActualLRPsOnCellReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) EvacuateClaimedActualLRP(arg1 lager.Logger, arg2 *models.ActualLRPKey, arg3 *models.ActualLRPInstanceKey) (bool, error) {
 	fake.evacuateClaimedActualLRPMutex.Lock()
 	fake.evacuateClaimedActualLRPArgsForCall = append(fake.evacuateClaimedActualLRPArgsForCall, struct {
@@ -1608,6 +2785,41 @@ func (fake *FakeInternalClient) FailTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) RejectTask(logger lager.Logger, taskGuid string, rejectionReason string) error {
+	fake.rejectTaskMutex.Lock()
+	fake.rejectTaskArgsForCall = append(fake.rejectTaskArgsForCall, struct {
+		logger          lager.Logger
+		taskGuid        string
+		rejectionReason string
+	}{logger, taskGuid, rejectionReason})
+	fake.recordInvocation("RejectTask", []interface{}{logger, taskGuid, rejectionReason})
+	fake.rejectTaskMutex.Unlock()
+	if fake.RejectTaskStub != nil {
+		return fake.RejectTaskStub(logger, taskGuid, rejectionReason)
+	} else {
+		return fake.rejectTaskReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) RejectTaskCallCount() int {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return len(fake.rejectTaskArgsForCall)
+}
+
+func (fake *FakeInternalClient) RejectTaskArgsForCall(i int) (lager.Logger, string, string) {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return fake.rejectTaskArgsForCall[i].logger, fake.rejectTaskArgsForCall[i].taskGuid, fake.rejectTaskArgsForCall[i].rejectionReason
+}
+
+func (fake *FakeInternalClient) RejectTaskReturns(result1 error) {
+	fake.RejectTaskStub = nil
+	fake.rejectTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) error {
 	fake.completeTaskMutex.Lock()
 	fake.completeTaskArgsForCall = append(fake.completeTaskArgsForCall, struct {
@@ -1646,21 +2858,408 @@ func (fake *FakeInternalClient) CompleteTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) ConvergeLRPs(logger lager.Logger, domains []string) error {
+	fake.convergeLRPsMutex.Lock()
+	fake.convergeLRPsArgsForCall = append(fake.convergeLRPsArgsForCall, struct {
+		logger  lager.Logger
+		domains []string
+	}{logger, domains})
+	fake.recordInvocation("ConvergeLRPs", []interface{}{logger, domains})
+	fake.convergeLRPsMutex.Unlock()
+	if fake.ConvergeLRPsStub != nil {
+		return fake.ConvergeLRPsStub(logger, domains)
+	} else {
+		return fake.convergeLRPsReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) ConvergeLRPsCallCount() int {
+	fake.convergeLRPsMutex.RLock()
+	defer fake.convergeLRPsMutex.RUnlock()
+	return len(fake.convergeLRPsArgsForCall)
+}
+
+func (fake *FakeInternalClient) ConvergeLRPsArgsForCall(i int) (lager.Logger, []string) {
+	fake.convergeLRPsMutex.RLock()
+	defer fake.convergeLRPsMutex.RUnlock()
+	return fake.convergeLRPsArgsForCall[i].logger, fake.convergeLRPsArgsForCall[i].domains
+}
+
+func (fake *FakeInternalClient) ConvergeLRPsReturns(result1 error) {
+	fake.ConvergeLRPsStub = nil
+	fake.convergeLRPsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) ReleaseLock(logger lager.Logger) error {
+	fake.releaseLockMutex.Lock()
+	fake.releaseLockArgsForCall = append(fake.releaseLockArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("ReleaseLock", []interface{}{logger})
+	fake.releaseLockMutex.Unlock()
+	if fake.ReleaseLockStub != nil {
+		return fake.ReleaseLockStub(logger)
+	} else {
+		return fake.releaseLockReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) ReleaseLockCallCount() int {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	return len(fake.releaseLockArgsForCall)
+}
+
+func (fake *FakeInternalClient) ReleaseLockArgsForCall(i int) lager.Logger {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	return fake.releaseLockArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) ReleaseLockReturns(result1 error) {
+	fake.ReleaseLockStub = nil
+	fake.releaseLockReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DesiredLRPsIncludingDeleted(arg1 lager.Logger, arg2 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	fake.desiredLRPsIncludingDeletedMutex.Lock()
+	fake.desiredLRPsIncludingDeletedArgsForCall = append(fake.desiredLRPsIncludingDeletedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("DesiredLRPsIncludingDeleted", []interface{}{arg1, arg2})
+	fake.desiredLRPsIncludingDeletedMutex.Unlock()
+	if fake.DesiredLRPsIncludingDeletedStub != nil {
+		return fake.DesiredLRPsIncludingDeletedStub(arg1, arg2)
+	} else {
+		return fake.desiredLRPsIncludingDeletedReturns.result1, fake.desiredLRPsIncludingDeletedReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPsIncludingDeletedCallCount() int {
+	fake.desiredLRPsIncludingDeletedMutex.RLock()
+	defer fake.desiredLRPsIncludingDeletedMutex.RUnlock()
+	return len(fake.desiredLRPsIncludingDeletedArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPsIncludingDeletedArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsIncludingDeletedMutex.RLock()
+	defer fake.desiredLRPsIncludingDeletedMutex.RUnlock()
+	return fake.desiredLRPsIncludingDeletedArgsForCall[i].arg1, fake.desiredLRPsIncludingDeletedArgsForCall[i].arg2
+}
+
+func (fake *FakeInternalClient) DesiredLRPsIncludingDeletedReturns(result1 []*models.DesiredLRP, result2 error) {
+	fake.DesiredLRPsIncludingDeletedStub = nil
+	fake.desiredLRPsIncludingDeletedReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error) {
+	fake.purgeCompletedTasksMutex.Lock()
+	fake.purgeCompletedTasksArgsForCall = append(fake.purgeCompletedTasksArgsForCall, struct {
+		logger lager.Logger
+		minAge time.Duration
+	}{logger, minAge})
+	fake.recordInvocation("PurgeCompletedTasks", []interface{}{logger, minAge})
+	fake.purgeCompletedTasksMutex.Unlock()
+	if fake.PurgeCompletedTasksStub != nil {
+		return fake.PurgeCompletedTasksStub(logger, minAge)
+	} else {
+		return fake.purgeCompletedTasksReturns.result1, fake.purgeCompletedTasksReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) PurgeCompletedTasksCallCount() int {
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
+	return len(fake.purgeCompletedTasksArgsForCall)
+}
+
+func (fake *FakeInternalClient) PurgeCompletedTasksArgsForCall(i int) (lager.Logger, time.Duration) {
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
+	return fake.purgeCompletedTasksArgsForCall[i].logger, fake.purgeCompletedTasksArgsForCall[i].minAge
+}
+
+func (fake *FakeInternalClient) PurgeCompletedTasksReturns(result1 int, result2 error) {
+	fake.PurgeCompletedTasksStub = nil
+	fake.purgeCompletedTasksReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ConvergenceStatuses(logger lager.Logger) ([]*models.ConvergenceStatus, error) {
+	fake.convergenceStatusesMutex.Lock()
+	fake.convergenceStatusesArgsForCall = append(fake.convergenceStatusesArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("ConvergenceStatuses", []interface{}{logger})
+	fake.convergenceStatusesMutex.Unlock()
+	if fake.ConvergenceStatusesStub != nil {
+		return fake.ConvergenceStatusesStub(logger)
+	} else {
+		return fake.convergenceStatusesReturns.result1, fake.convergenceStatusesReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) ConvergenceStatusesCallCount() int {
+	fake.convergenceStatusesMutex.RLock()
+	defer fake.convergenceStatusesMutex.RUnlock()
+	return len(fake.convergenceStatusesArgsForCall)
+}
+
+func (fake *FakeInternalClient) ConvergenceStatusesArgsForCall(i int) lager.Logger {
+	fake.convergenceStatusesMutex.RLock()
+	defer fake.convergenceStatusesMutex.RUnlock()
+	return fake.convergenceStatusesArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) ConvergenceStatusesReturns(result1 []*models.ConvergenceStatus, result2 error) {
+	fake.ConvergenceStatusesStub = nil
+	fake.convergenceStatusesReturns = struct {
+		result1 []*models.ConvergenceStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) PauseConvergence(logger lager.Logger, duration time.Duration) (time.Time, error) {
+	fake.pauseConvergenceMutex.Lock()
+	fake.pauseConvergenceArgsForCall = append(fake.pauseConvergenceArgsForCall, struct {
+		logger   lager.Logger
+		duration time.Duration
+	}{logger, duration})
+	fake.recordInvocation("PauseConvergence", []interface{}{logger, duration})
+	fake.pauseConvergenceMutex.Unlock()
+	if fake.PauseConvergenceStub != nil {
+		return fake.PauseConvergenceStub(logger, duration)
+	} else {
+		return fake.pauseConvergenceReturns.result1, fake.pauseConvergenceReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) PauseConvergenceCallCount() int {
+	fake.pauseConvergenceMutex.RLock()
+	defer fake.pauseConvergenceMutex.RUnlock()
+	return len(fake.pauseConvergenceArgsForCall)
+}
+
+func (fake *FakeInternalClient) PauseConvergenceArgsForCall(i int) (lager.Logger, time.Duration) {
+	fake.pauseConvergenceMutex.RLock()
+	defer fake.pauseConvergenceMutex.RUnlock()
+	return fake.pauseConvergenceArgsForCall[i].logger, fake.pauseConvergenceArgsForCall[i].duration
+}
+
+func (fake *FakeInternalClient) PauseConvergenceReturns(result1 time.Time, result2 error) {
+	fake.PauseConvergenceStub = nil
+	fake.pauseConvergenceReturns = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ResumeConvergence(logger lager.Logger) error {
+	fake.resumeConvergenceMutex.Lock()
+	fake.resumeConvergenceArgsForCall = append(fake.resumeConvergenceArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("ResumeConvergence", []interface{}{logger})
+	fake.resumeConvergenceMutex.Unlock()
+	if fake.ResumeConvergenceStub != nil {
+		return fake.ResumeConvergenceStub(logger)
+	} else {
+		return fake.resumeConvergenceReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) ResumeConvergenceCallCount() int {
+	fake.resumeConvergenceMutex.RLock()
+	defer fake.resumeConvergenceMutex.RUnlock()
+	return len(fake.resumeConvergenceArgsForCall)
+}
+
+func (fake *FakeInternalClient) ResumeConvergenceArgsForCall(i int) lager.Logger {
+	fake.resumeConvergenceMutex.RLock()
+	defer fake.resumeConvergenceMutex.RUnlock()
+	return fake.resumeConvergenceArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) ResumeConvergenceReturns(result1 error) {
+	fake.ResumeConvergenceStub = nil
+	fake.resumeConvergenceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) Import(logger lager.Logger, force bool, records []*models.ImportRecord) ([]*models.ImportResult, error) {
+	fake.importMutex.Lock()
+	fake.importArgsForCall = append(fake.importArgsForCall, struct {
+		logger  lager.Logger
+		force   bool
+		records []*models.ImportRecord
+	}{logger, force, records})
+	fake.recordInvocation("Import", []interface{}{logger, force, records})
+	fake.importMutex.Unlock()
+	if fake.ImportStub != nil {
+		return fake.ImportStub(logger, force, records)
+	} else {
+		return fake.importReturns.result1, fake.importReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) ImportCallCount() int {
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	return len(fake.importArgsForCall)
+}
+
+func (fake *FakeInternalClient) ImportArgsForCall(i int) (lager.Logger, bool, []*models.ImportRecord) {
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	return fake.importArgsForCall[i].logger, fake.importArgsForCall[i].force, fake.importArgsForCall[i].records
+}
+
+func (fake *FakeInternalClient) ImportReturns(result1 []*models.ImportResult, result2 error) {
+	fake.ImportStub = nil
+	fake.importReturns = struct {
+		result1 []*models.ImportResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) Export(logger lager.Logger) ([]*models.ImportRecord, error) {
+	fake.exportMutex.Lock()
+	fake.exportArgsForCall = append(fake.exportArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Export", []interface{}{logger})
+	fake.exportMutex.Unlock()
+	if fake.ExportStub != nil {
+		return fake.ExportStub(logger)
+	} else {
+		return fake.exportReturns.result1, fake.exportReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) ExportCallCount() int {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return len(fake.exportArgsForCall)
+}
+
+func (fake *FakeInternalClient) ExportArgsForCall(i int) lager.Logger {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return fake.exportArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) ExportReturns(result1 []*models.ImportRecord, result2 error) {
+	fake.ExportStub = nil
+	fake.exportReturns = struct {
+		result1 []*models.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CellPresenceStatuses(logger lager.Logger) ([]*models.CellPresenceStatus, error) {
+	fake.cellPresenceStatusesMutex.Lock()
+	fake.cellPresenceStatusesArgsForCall = append(fake.cellPresenceStatusesArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("CellPresenceStatuses", []interface{}{logger})
+	fake.cellPresenceStatusesMutex.Unlock()
+	if fake.CellPresenceStatusesStub != nil {
+		return fake.CellPresenceStatusesStub(logger)
+	} else {
+		return fake.cellPresenceStatusesReturns.result1, fake.cellPresenceStatusesReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) CellPresenceStatusesCallCount() int {
+	fake.cellPresenceStatusesMutex.RLock()
+	defer fake.cellPresenceStatusesMutex.RUnlock()
+	return len(fake.cellPresenceStatusesArgsForCall)
+}
+
+func (fake *FakeInternalClient) CellPresenceStatusesArgsForCall(i int) lager.Logger {
+	fake.cellPresenceStatusesMutex.RLock()
+	defer fake.cellPresenceStatusesMutex.RUnlock()
+	return fake.cellPresenceStatusesArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) CellPresenceStatusesReturns(result1 []*models.CellPresenceStatus, result2 error) {
+	fake.CellPresenceStatusesStub = nil
+	fake.cellPresenceStatusesReturns = struct {
+		result1 []*models.CellPresenceStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CoordinationState(logger lager.Logger) (*models.CoordinationStateResponse, error) {
+	fake.coordinationStateMutex.Lock()
+	fake.coordinationStateArgsForCall = append(fake.coordinationStateArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("CoordinationState", []interface{}{logger})
+	fake.coordinationStateMutex.Unlock()
+	if fake.CoordinationStateStub != nil {
+		return fake.CoordinationStateStub(logger)
+	} else {
+		return fake.coordinationStateReturns.result1, fake.coordinationStateReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) CoordinationStateCallCount() int {
+	fake.coordinationStateMutex.RLock()
+	defer fake.coordinationStateMutex.RUnlock()
+	return len(fake.coordinationStateArgsForCall)
+}
+
+func (fake *FakeInternalClient) CoordinationStateArgsForCall(i int) lager.Logger {
+	fake.coordinationStateMutex.RLock()
+	defer fake.coordinationStateMutex.RUnlock()
+	return fake.coordinationStateArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) CoordinationStateReturns(result1 *models.CoordinationStateResponse, result2 error) {
+	fake.CoordinationStateStub = nil
+	fake.coordinationStateReturns = struct {
+		result1 *models.CoordinationStateResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	fake.tasksByDomainMutex.RLock()
 	defer fake.tasksByDomainMutex.RUnlock()
 	fake.tasksByCellIDMutex.RLock()
 	defer fake.tasksByCellIDMutex.RUnlock()
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	fake.tasksWithRecordErrorsMutex.RLock()
+	defer fake.tasksWithRecordErrorsMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
@@ -1669,42 +3268,78 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.domainsMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
+	fake.upsertDomainsMutex.RLock()
+	defer fake.upsertDomainsMutex.RUnlock()
+	fake.domainFreshnessMutex.RLock()
+	defer fake.domainFreshnessMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
 	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsWithRecordErrorsMutex.RLock()
+	defer fake.actualLRPGroupsWithRecordErrorsMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
 	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	fake.crashingActualLRPsMutex.RLock()
+	defer fake.crashingActualLRPsMutex.RUnlock()
+	fake.cellPlacementsMutex.RLock()
+	defer fake.cellPlacementsMutex.RUnlock()
 	fake.retireActualLRPMutex.RLock()
 	defer fake.retireActualLRPMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsWithRevisionMutex.RLock()
+	defer fake.desiredLRPsWithRevisionMutex.RUnlock()
+	fake.desiredLRPsWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPsWithRecordErrorsMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithRecordErrorsMutex.RUnlock()
+	fake.desiredLRPSchedulingInfosIfModifiedMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosIfModifiedMutex.RUnlock()
+	fake.desiredLRPDiffMutex.RLock()
+	defer fake.desiredLRPDiffMutex.RUnlock()
+	fake.validateDesiredLRPMutex.RLock()
+	defer fake.validateDesiredLRPMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireLRPWithWarningsMutex.RLock()
+	defer fake.desireLRPWithWarningsMutex.RUnlock()
+	fake.desireLRPWithIdempotencyKeyMutex.RLock()
+	defer fake.desireLRPWithIdempotencyKeyMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateDesiredLRPWithWarningsMutex.RLock()
+	defer fake.updateDesiredLRPWithWarningsMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
 	fake.subscribeToEventsMutex.RLock()
 	defer fake.subscribeToEventsMutex.RUnlock()
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
 	fake.pingMutex.RLock()
 	defer fake.pingMutex.RUnlock()
 	fake.cellsMutex.RLock()
 	defer fake.cellsMutex.RUnlock()
+	fake.objectCountsMutex.RLock()
+	defer fake.objectCountsMutex.RUnlock()
 	fake.claimActualLRPMutex.RLock()
 	defer fake.claimActualLRPMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
+	fake.startActualLRPsMutex.RLock()
+	defer fake.startActualLRPsMutex.RUnlock()
 	fake.crashActualLRPMutex.RLock()
 	defer fake.crashActualLRPMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
+	fake.retireActualLRPsOnCellMutex.RLock()
+	defer fake.retireActualLRPsOnCellMutex.RUnlock()
 	fake.evacuateClaimedActualLRPMutex.RLock()
 	defer fake.evacuateClaimedActualLRPMutex.RUnlock()
 	fake.evacuateRunningActualLRPMutex.RLock()
@@ -1719,8 +3354,32 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.startTaskMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
 	fake.completeTaskMutex.RLock()
 	defer fake.completeTaskMutex.RUnlock()
+	fake.convergeLRPsMutex.RLock()
+	defer fake.convergeLRPsMutex.RUnlock()
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	fake.desiredLRPsIncludingDeletedMutex.RLock()
+	defer fake.desiredLRPsIncludingDeletedMutex.RUnlock()
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
+	fake.convergenceStatusesMutex.RLock()
+	defer fake.convergenceStatusesMutex.RUnlock()
+	fake.pauseConvergenceMutex.RLock()
+	defer fake.pauseConvergenceMutex.RUnlock()
+	fake.resumeConvergenceMutex.RLock()
+	defer fake.resumeConvergenceMutex.RUnlock()
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	fake.cellPresenceStatusesMutex.RLock()
+	defer fake.cellPresenceStatusesMutex.RUnlock()
+	fake.coordinationStateMutex.RLock()
+	defer fake.coordinationStateMutex.RUnlock()
 	return fake.invocations
 }
 
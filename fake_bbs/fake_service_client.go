@@ -31,6 +31,15 @@ type FakeServiceClient struct {
 		result1 models.CellSet
 		result2 error
 	}
+	CellPresenceStatusesStub        func(logger lager.Logger) ([]*models.CellPresenceStatus, error)
+	cellPresenceStatusesMutex       sync.RWMutex
+	cellPresenceStatusesArgsForCall []struct {
+		logger lager.Logger
+	}
+	cellPresenceStatusesReturns struct {
+		result1 []*models.CellPresenceStatus
+		result2 error
+	}
 	CellEventsStub        func(logger lager.Logger) <-chan models.CellEvent
 	cellEventsMutex       sync.RWMutex
 	cellEventsArgsForCall []struct {
@@ -80,6 +89,45 @@ type FakeServiceClient struct {
 		result1 string
 		result2 error
 	}
+	NewBBSReadPresenceRunnerStub        func(logger lager.Logger, bbsPresence *models.BBSPresence, retryInterval, lockTTL time.Duration) (ifrit.Runner, error)
+	newBBSReadPresenceRunnerMutex       sync.RWMutex
+	newBBSReadPresenceRunnerArgsForCall []struct {
+		logger        lager.Logger
+		bbsPresence   *models.BBSPresence
+		retryInterval time.Duration
+		lockTTL       time.Duration
+	}
+	newBBSReadPresenceRunnerReturns struct {
+		result1 ifrit.Runner
+		result2 error
+	}
+	BBSReadPresencesStub        func(logger lager.Logger) ([]*models.BBSPresence, error)
+	bBSReadPresencesMutex       sync.RWMutex
+	bBSReadPresencesArgsForCall []struct {
+		logger lager.Logger
+	}
+	bBSReadPresencesReturns struct {
+		result1 []*models.BBSPresence
+		result2 error
+	}
+	CurrentBBSStatusStub        func(logger lager.Logger) (*models.BBSPresenceStatus, error)
+	currentBBSStatusMutex       sync.RWMutex
+	currentBBSStatusArgsForCall []struct {
+		logger lager.Logger
+	}
+	currentBBSStatusReturns struct {
+		result1 *models.BBSPresenceStatus
+		result2 error
+	}
+	BBSReadPresenceStatusesStub        func(logger lager.Logger) ([]*models.BBSPresenceStatus, error)
+	bBSReadPresenceStatusesMutex       sync.RWMutex
+	bBSReadPresenceStatusesArgsForCall []struct {
+		logger lager.Logger
+	}
+	bBSReadPresenceStatusesReturns struct {
+		result1 []*models.BBSPresenceStatus
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -153,6 +201,40 @@ func (fake *FakeServiceClient) CellsReturns(result1 models.CellSet, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeServiceClient) CellPresenceStatuses(logger lager.Logger) ([]*models.CellPresenceStatus, error) {
+	fake.cellPresenceStatusesMutex.Lock()
+	fake.cellPresenceStatusesArgsForCall = append(fake.cellPresenceStatusesArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("CellPresenceStatuses", []interface{}{logger})
+	fake.cellPresenceStatusesMutex.Unlock()
+	if fake.CellPresenceStatusesStub != nil {
+		return fake.CellPresenceStatusesStub(logger)
+	} else {
+		return fake.cellPresenceStatusesReturns.result1, fake.cellPresenceStatusesReturns.result2
+	}
+}
+
+func (fake *FakeServiceClient) CellPresenceStatusesCallCount() int {
+	fake.cellPresenceStatusesMutex.RLock()
+	defer fake.cellPresenceStatusesMutex.RUnlock()
+	return len(fake.cellPresenceStatusesArgsForCall)
+}
+
+func (fake *FakeServiceClient) CellPresenceStatusesArgsForCall(i int) lager.Logger {
+	fake.cellPresenceStatusesMutex.RLock()
+	defer fake.cellPresenceStatusesMutex.RUnlock()
+	return fake.cellPresenceStatusesArgsForCall[i].logger
+}
+
+func (fake *FakeServiceClient) CellPresenceStatusesReturns(result1 []*models.CellPresenceStatus, result2 error) {
+	fake.CellPresenceStatusesStub = nil
+	fake.cellPresenceStatusesReturns = struct {
+		result1 []*models.CellPresenceStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceClient) CellEvents(logger lager.Logger) <-chan models.CellEvent {
 	fake.cellEventsMutex.Lock()
 	fake.cellEventsArgsForCall = append(fake.cellEventsArgsForCall, struct {
@@ -327,6 +409,145 @@ func (fake *FakeServiceClient) CurrentBBSURLReturns(result1 string, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeServiceClient) NewBBSReadPresenceRunner(logger lager.Logger, bbsPresence *models.BBSPresence, retryInterval time.Duration, lockTTL time.Duration) (ifrit.Runner, error) {
+	fake.newBBSReadPresenceRunnerMutex.Lock()
+	fake.newBBSReadPresenceRunnerArgsForCall = append(fake.newBBSReadPresenceRunnerArgsForCall, struct {
+		logger        lager.Logger
+		bbsPresence   *models.BBSPresence
+		retryInterval time.Duration
+		lockTTL       time.Duration
+	}{logger, bbsPresence, retryInterval, lockTTL})
+	fake.recordInvocation("NewBBSReadPresenceRunner", []interface{}{logger, bbsPresence, retryInterval, lockTTL})
+	fake.newBBSReadPresenceRunnerMutex.Unlock()
+	if fake.NewBBSReadPresenceRunnerStub != nil {
+		return fake.NewBBSReadPresenceRunnerStub(logger, bbsPresence, retryInterval, lockTTL)
+	} else {
+		return fake.newBBSReadPresenceRunnerReturns.result1, fake.newBBSReadPresenceRunnerReturns.result2
+	}
+}
+
+func (fake *FakeServiceClient) NewBBSReadPresenceRunnerCallCount() int {
+	fake.newBBSReadPresenceRunnerMutex.RLock()
+	defer fake.newBBSReadPresenceRunnerMutex.RUnlock()
+	return len(fake.newBBSReadPresenceRunnerArgsForCall)
+}
+
+func (fake *FakeServiceClient) NewBBSReadPresenceRunnerArgsForCall(i int) (lager.Logger, *models.BBSPresence, time.Duration, time.Duration) {
+	fake.newBBSReadPresenceRunnerMutex.RLock()
+	defer fake.newBBSReadPresenceRunnerMutex.RUnlock()
+	return fake.newBBSReadPresenceRunnerArgsForCall[i].logger, fake.newBBSReadPresenceRunnerArgsForCall[i].bbsPresence, fake.newBBSReadPresenceRunnerArgsForCall[i].retryInterval, fake.newBBSReadPresenceRunnerArgsForCall[i].lockTTL
+}
+
+func (fake *FakeServiceClient) NewBBSReadPresenceRunnerReturns(result1 ifrit.Runner, result2 error) {
+	fake.NewBBSReadPresenceRunnerStub = nil
+	fake.newBBSReadPresenceRunnerReturns = struct {
+		result1 ifrit.Runner
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceClient) BBSReadPresences(logger lager.Logger) ([]*models.BBSPresence, error) {
+	fake.bBSReadPresencesMutex.Lock()
+	fake.bBSReadPresencesArgsForCall = append(fake.bBSReadPresencesArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("BBSReadPresences", []interface{}{logger})
+	fake.bBSReadPresencesMutex.Unlock()
+	if fake.BBSReadPresencesStub != nil {
+		return fake.BBSReadPresencesStub(logger)
+	} else {
+		return fake.bBSReadPresencesReturns.result1, fake.bBSReadPresencesReturns.result2
+	}
+}
+
+func (fake *FakeServiceClient) BBSReadPresencesCallCount() int {
+	fake.bBSReadPresencesMutex.RLock()
+	defer fake.bBSReadPresencesMutex.RUnlock()
+	return len(fake.bBSReadPresencesArgsForCall)
+}
+
+func (fake *FakeServiceClient) BBSReadPresencesArgsForCall(i int) lager.Logger {
+	fake.bBSReadPresencesMutex.RLock()
+	defer fake.bBSReadPresencesMutex.RUnlock()
+	return fake.bBSReadPresencesArgsForCall[i].logger
+}
+
+func (fake *FakeServiceClient) BBSReadPresencesReturns(result1 []*models.BBSPresence, result2 error) {
+	fake.BBSReadPresencesStub = nil
+	fake.bBSReadPresencesReturns = struct {
+		result1 []*models.BBSPresence
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceClient) CurrentBBSStatus(logger lager.Logger) (*models.BBSPresenceStatus, error) {
+	fake.currentBBSStatusMutex.Lock()
+	fake.currentBBSStatusArgsForCall = append(fake.currentBBSStatusArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("CurrentBBSStatus", []interface{}{logger})
+	fake.currentBBSStatusMutex.Unlock()
+	if fake.CurrentBBSStatusStub != nil {
+		return fake.CurrentBBSStatusStub(logger)
+	} else {
+		return fake.currentBBSStatusReturns.result1, fake.currentBBSStatusReturns.result2
+	}
+}
+
+func (fake *FakeServiceClient) CurrentBBSStatusCallCount() int {
+	fake.currentBBSStatusMutex.RLock()
+	defer fake.currentBBSStatusMutex.RUnlock()
+	return len(fake.currentBBSStatusArgsForCall)
+}
+
+func (fake *FakeServiceClient) CurrentBBSStatusArgsForCall(i int) lager.Logger {
+	fake.currentBBSStatusMutex.RLock()
+	defer fake.currentBBSStatusMutex.RUnlock()
+	return fake.currentBBSStatusArgsForCall[i].logger
+}
+
+func (fake *FakeServiceClient) CurrentBBSStatusReturns(result1 *models.BBSPresenceStatus, result2 error) {
+	fake.CurrentBBSStatusStub = nil
+	fake.currentBBSStatusReturns = struct {
+		result1 *models.BBSPresenceStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceClient) BBSReadPresenceStatuses(logger lager.Logger) ([]*models.BBSPresenceStatus, error) {
+	fake.bBSReadPresenceStatusesMutex.Lock()
+	fake.bBSReadPresenceStatusesArgsForCall = append(fake.bBSReadPresenceStatusesArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("BBSReadPresenceStatuses", []interface{}{logger})
+	fake.bBSReadPresenceStatusesMutex.Unlock()
+	if fake.BBSReadPresenceStatusesStub != nil {
+		return fake.BBSReadPresenceStatusesStub(logger)
+	} else {
+		return fake.bBSReadPresenceStatusesReturns.result1, fake.bBSReadPresenceStatusesReturns.result2
+	}
+}
+
+func (fake *FakeServiceClient) BBSReadPresenceStatusesCallCount() int {
+	fake.bBSReadPresenceStatusesMutex.RLock()
+	defer fake.bBSReadPresenceStatusesMutex.RUnlock()
+	return len(fake.bBSReadPresenceStatusesArgsForCall)
+}
+
+func (fake *FakeServiceClient) BBSReadPresenceStatusesArgsForCall(i int) lager.Logger {
+	fake.bBSReadPresenceStatusesMutex.RLock()
+	defer fake.bBSReadPresenceStatusesMutex.RUnlock()
+	return fake.bBSReadPresenceStatusesArgsForCall[i].logger
+}
+
+func (fake *FakeServiceClient) BBSReadPresenceStatusesReturns(result1 []*models.BBSPresenceStatus, result2 error) {
+	fake.BBSReadPresenceStatusesStub = nil
+	fake.bBSReadPresenceStatusesReturns = struct {
+		result1 []*models.BBSPresenceStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -334,6 +555,8 @@ func (fake *FakeServiceClient) Invocations() map[string][][]interface{} {
 	defer fake.cellByIdMutex.RUnlock()
 	fake.cellsMutex.RLock()
 	defer fake.cellsMutex.RUnlock()
+	fake.cellPresenceStatusesMutex.RLock()
+	defer fake.cellPresenceStatusesMutex.RUnlock()
 	fake.cellEventsMutex.RLock()
 	defer fake.cellEventsMutex.RUnlock()
 	fake.newCellPresenceRunnerMutex.RLock()
@@ -344,6 +567,14 @@ func (fake *FakeServiceClient) Invocations() map[string][][]interface{} {
 	defer fake.currentBBSMutex.RUnlock()
 	fake.currentBBSURLMutex.RLock()
 	defer fake.currentBBSURLMutex.RUnlock()
+	fake.newBBSReadPresenceRunnerMutex.RLock()
+	defer fake.newBBSReadPresenceRunnerMutex.RUnlock()
+	fake.bBSReadPresencesMutex.RLock()
+	defer fake.bBSReadPresencesMutex.RUnlock()
+	fake.currentBBSStatusMutex.RLock()
+	defer fake.currentBBSStatusMutex.RUnlock()
+	fake.bBSReadPresenceStatusesMutex.RLock()
+	defer fake.bBSReadPresenceStatusesMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -2,9 +2,13 @@ package format
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 
 	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+	"github.com/cloudfoundry/sonde-go/events"
 )
 
 type Encoding [EncodingOffset]byte
@@ -18,6 +22,97 @@ var (
 
 const EncodingOffset int = 2
 
+// decryptionFailures counts the payloads that could not be decrypted. When a
+// TaggedEmitter is set, it is tagged with keyLabelTag.
+const decryptionFailures = metric.Counter("DecryptionFailures")
+
+// keyLabelTag is the label of the key a payload that failed to decrypt was
+// encrypted with, if the key manager has that key, and unknownKeyLabel
+// otherwise, so that corrupt payloads cannot add tag values.
+const (
+	keyLabelTag     = "key_label"
+	unknownKeyLabel = "unknown"
+)
+
+// TaggedEmitter emits an event with tags of its own, such as
+// metrics.TaggedEventEmitter.
+type TaggedEmitter interface {
+	EmitWithTags(event events.Event, tags map[string]string) error
+}
+
+var taggedEmitter TaggedEmitter
+
+// SetTaggedEmitter sets the emitter the DecryptionFailures counter is sent
+// through with its key label tag. Until it is set, the counter is sent
+// untagged.
+func SetTaggedEmitter(emitter TaggedEmitter) {
+	taggedEmitter = emitter
+}
+
+func countDecryptionFailure(err *DecryptionError) {
+	if taggedEmitter == nil {
+		decryptionFailures.Increment()
+		return
+	}
+
+	name, delta := string(decryptionFailures), uint64(1)
+	taggedEmitter.EmitWithTags(
+		&events.CounterEvent{Name: &name, Delta: &delta},
+		map[string]string{keyLabelTag: decryptionFailureKeyLabel(err)},
+	)
+}
+
+// decryptionFailureKeyLabel is the label of the key the payload was encrypted
+// with when the cryptor found that key. The cryptor looks the key up before it
+// fails for any other reason, so only a truncated payload or a missing key
+// leaves the label unknown.
+func decryptionFailureKeyLabel(err *DecryptionError) string {
+	if err.KeyLabel == "" || err.Err == errTruncatedPayload {
+		return unknownKeyLabel
+	}
+	if _, ok := err.Err.(*encryption.KeyNotFoundError); ok {
+		return unknownKeyLabel
+	}
+	return err.KeyLabel
+}
+
+var errTruncatedPayload = errors.New("Encrypted payload is truncated")
+
+// DecryptionError is returned by Decode when an encrypted payload cannot be
+// decrypted: it is corrupt, truncated, or its key is no longer configured.
+// KeyLabel is the label of the key the payload was encrypted with, or empty
+// when the payload is too short to hold one. It reads as the underlying error.
+type DecryptionError struct {
+	KeyLabel string
+	Err      error
+}
+
+func (e *DecryptionError) Error() string {
+	return e.Err.Error()
+}
+
+// LogDecryptionFailure logs the record and the label of the key it was
+// encrypted with when err is a DecryptionError, so that the records left
+// behind by a key that was removed can be found.
+func LogDecryptionFailure(logger lager.Logger, record string, err error) {
+	decryptionErr, ok := err.(*DecryptionError)
+	if !ok {
+		return
+	}
+	logger.Error("failed-to-decrypt-record", decryptionErr.Err, lager.Data{"record": record, "key_label": decryptionErr.KeyLabel})
+}
+
+// IsMissingKey reports whether err is a DecryptionError for a payload whose key
+// is no longer configured, as opposed to one that is corrupt or truncated.
+func IsMissingKey(err error) bool {
+	decryptionErr, ok := err.(*DecryptionError)
+	if !ok {
+		return false
+	}
+	_, ok = decryptionErr.Err.(*encryption.KeyNotFoundError)
+	return ok
+}
+
 type encoder struct {
 	cryptor encryption.Cryptor
 }
@@ -66,12 +161,23 @@ func (e *encoder) Decode(payload []byte) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		return e.decrypt(encrypted)
+		decrypted, err := e.decrypt(encrypted)
+		if decryptionErr, ok := err.(*DecryptionError); ok {
+			countDecryptionFailure(decryptionErr)
+		}
+		return decrypted, err
 	default:
 		return nil, fmt.Errorf("Unknown encoding: %v", encoding)
 	}
 }
 
+// algorithmMarker is set on the first byte of an encrypted payload that
+// records its algorithm. Payloads written before algorithms were recorded start
+// with the length of the key label, which is never more than 127, and use
+// encryption.AES256GCM. Payloads encrypted with AES256GCM are still written in
+// that form so that older versions can read them.
+const algorithmMarker byte = 0x80
+
 func (e *encoder) encrypt(cleartext []byte) ([]byte, error) {
 	encrypted, err := e.cryptor.Encrypt(cleartext)
 	if err != nil {
@@ -79,6 +185,9 @@ func (e *encoder) encrypt(cleartext []byte) ([]byte, error) {
 	}
 
 	payload := []byte{}
+	if encrypted.Algorithm != encryption.AES256GCM {
+		payload = append(payload, algorithmMarker|byte(encrypted.Algorithm))
+	}
 	payload = append(payload, byte(len(encrypted.KeyLabel)))
 	payload = append(payload, []byte(encrypted.KeyLabel)...)
 	payload = append(payload, encrypted.Nonce...)
@@ -88,20 +197,44 @@ func (e *encoder) encrypt(cleartext []byte) ([]byte, error) {
 }
 
 func (e *encoder) decrypt(encryptedData []byte) ([]byte, error) {
-	labelLength := encryptedData[0]
+	if len(encryptedData) == 0 {
+		return nil, &DecryptionError{Err: errTruncatedPayload}
+	}
+
+	algorithm := encryption.AES256GCM
+	if encryptedData[0]&algorithmMarker != 0 {
+		algorithm = encryption.Algorithm(encryptedData[0] &^ algorithmMarker)
+		encryptedData = encryptedData[1:]
+		if len(encryptedData) == 0 {
+			return nil, &DecryptionError{Err: errTruncatedPayload}
+		}
+	}
+
+	labelLength := int(encryptedData[0])
 	encryptedData = encryptedData[1:]
+	if len(encryptedData) < labelLength {
+		return nil, &DecryptionError{Err: errTruncatedPayload}
+	}
 
 	label := string(encryptedData[:labelLength])
 	encryptedData = encryptedData[labelLength:]
+	if len(encryptedData) < algorithm.NonceSize() {
+		return nil, &DecryptionError{KeyLabel: label, Err: errTruncatedPayload}
+	}
 
-	nonce := encryptedData[:encryption.NonceSize]
-	ciphertext := encryptedData[encryption.NonceSize:]
+	nonce := encryptedData[:algorithm.NonceSize()]
+	ciphertext := encryptedData[algorithm.NonceSize():]
 
-	return e.cryptor.Decrypt(encryption.Encrypted{
+	cleartext, err := e.cryptor.Decrypt(encryption.Encrypted{
+		Algorithm:  algorithm,
 		KeyLabel:   label,
 		Nonce:      nonce,
 		CipherText: ciphertext,
 	})
+	if err != nil {
+		return nil, &DecryptionError{KeyLabel: label, Err: err}
+	}
+	return cleartext, nil
 }
 
 func encodeBase64(unencodedPayload []byte) []byte {
@@ -8,6 +8,11 @@ import (
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
 	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+	"github.com/cloudfoundry/sonde-go/events"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -98,6 +103,53 @@ var _ = Describe("Encoding", func() {
 				Expect(decrypted).To(Equal(payload))
 			})
 
+			Context("when the cryptor uses an algorithm other than AES-256-GCM", func() {
+				var keyManager encryption.KeyManager
+
+				BeforeEach(func() {
+					key, err := encryption.NewKey("label", "some pass phrase")
+					Expect(err).NotTo(HaveOccurred())
+
+					keyManager, err = encryption.NewKeyManager(key, nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					cryptor = encryption.NewCryptorWithAlgorithm(keyManager, encryption.XChaCha20Poly1305, prng)
+				})
+
+				It("records the algorithm before the key label", func() {
+					payload := []byte("some-payload")
+					encoded, err := encoder.Encode(format.BASE64_ENCRYPTED, payload)
+					Expect(err).NotTo(HaveOccurred())
+
+					decoded, err := base64.StdEncoding.DecodeString(string(encoded[2:]))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decoded[0]).To(Equal(byte(0x80 | encryption.XChaCha20Poly1305)))
+					Expect(decoded[1]).To(BeEquivalentTo(len("label")))
+					Expect(string(decoded[2:7])).To(Equal("label"))
+
+					decrypted, err := encoder.Decode(encoded)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decrypted).To(Equal(payload))
+				})
+
+				It("can be decoded by an encoder that encrypts with AES-256-GCM", func() {
+					payload := []byte("some-payload")
+					encoded, err := encoder.Encode(format.BASE64_ENCRYPTED, payload)
+					Expect(err).NotTo(HaveOccurred())
+
+					aesEncoder := format.NewEncoder(encryption.NewCryptor(keyManager, prng))
+					decrypted, err := aesEncoder.Decode(encoded)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decrypted).To(Equal(payload))
+
+					reencoded, err := aesEncoder.Encode(format.BASE64_ENCRYPTED, decrypted)
+					Expect(err).NotTo(HaveOccurred())
+					decrypted, err = encoder.Decode(reencoded)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decrypted).To(Equal(payload))
+				})
+			})
+
 			Context("when encryption fails", func() {
 				var cryptError = errors.New("boom")
 
@@ -180,6 +232,147 @@ var _ = Describe("Encoding", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(decoded).To(Equal(payload))
 			})
+
+			It("decrypts payloads that record their algorithm", func() {
+				payload := []byte("payload")
+				encrypted, err := cryptor.Encrypt(payload)
+				Expect(err).NotTo(HaveOccurred())
+
+				encoded := []byte{0x80 | byte(encryption.AES256GCM)}
+				encoded = append(encoded, byte(len(encrypted.KeyLabel)))
+				encoded = append(encoded, []byte(encrypted.KeyLabel)...)
+				encoded = append(encoded, encrypted.Nonce...)
+				encoded = append(encoded, encrypted.CipherText...)
+				encoded = append(format.BASE64_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString(encoded))...)
+
+				decoded, err := encoder.Decode(encoded)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decoded).To(Equal(payload))
+			})
+
+			It("fails when the payload is truncated", func() {
+				encoded := []byte{0x80, 5, 'l', 'a', 'b'}
+				encoded = append(format.BASE64_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString(encoded))...)
+
+				_, err := encoder.Decode(encoded)
+				Expect(err).To(MatchError("Encrypted payload is truncated"))
+			})
+
+			Context("when the payload cannot be decrypted", func() {
+				var (
+					metricSender *fake.FakeMetricSender
+					encoded      []byte
+				)
+
+				BeforeEach(func() {
+					metricSender = fake.NewFakeMetricSender()
+					dropsonde_metrics.Initialize(metricSender, nil)
+
+					encrypted, err := cryptor.Encrypt([]byte("payload"))
+					Expect(err).NotTo(HaveOccurred())
+
+					otherKey, err := encryption.NewKey("removed-label", "other pass phrase")
+					Expect(err).NotTo(HaveOccurred())
+					keyManager, err := encryption.NewKeyManager(otherKey, nil)
+					Expect(err).NotTo(HaveOccurred())
+					cryptor = encryption.NewCryptor(keyManager, prng)
+
+					encoded = []byte{byte(len(encrypted.KeyLabel))}
+					encoded = append(encoded, []byte(encrypted.KeyLabel)...)
+					encoded = append(encoded, encrypted.Nonce...)
+					encoded = append(encoded, encrypted.CipherText...)
+					encoded = append(format.BASE64_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString(encoded))...)
+				})
+
+				It("returns a decryption error with the label of the key", func() {
+					_, err := encoder.Decode(encoded)
+					Expect(err).To(MatchError(`Key with label "label" was not found`))
+
+					decryptionErr, ok := err.(*format.DecryptionError)
+					Expect(ok).To(BeTrue())
+					Expect(decryptionErr.KeyLabel).To(Equal("label"))
+				})
+
+				It("reports the key as missing", func() {
+					_, err := encoder.Decode(encoded)
+					Expect(format.IsMissingKey(err)).To(BeTrue())
+				})
+
+				It("does not report a truncated payload as missing its key", func() {
+					_, err := encoder.Decode(append(format.BASE64_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString([]byte{0x80}))...))
+					Expect(err).To(HaveOccurred())
+					Expect(format.IsMissingKey(err)).To(BeFalse())
+				})
+
+				It("counts the failures in a metric that does not name the key", func() {
+					encoder.Decode(encoded)
+					encoder.Decode(encoded)
+					Expect(metricSender.GetCounter("DecryptionFailures")).To(BeEquivalentTo(2))
+					Expect(metricSender.GetCounter("DecryptionFailures.label")).To(BeZero())
+				})
+
+				It("counts truncated payloads", func() {
+					_, err := encoder.Decode(append(format.BASE64_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString([]byte{0x80}))...))
+					Expect(err).To(MatchError("Encrypted payload is truncated"))
+					Expect(metricSender.GetCounter("DecryptionFailures")).To(BeEquivalentTo(1))
+				})
+
+				Context("when a tagged emitter is set", func() {
+					var taggedEmitter *recordingTaggedEmitter
+
+					BeforeEach(func() {
+						taggedEmitter = &recordingTaggedEmitter{}
+						format.SetTaggedEmitter(taggedEmitter)
+					})
+
+					AfterEach(func() {
+						format.SetTaggedEmitter(nil)
+					})
+
+					It("tags the failure with an unknown key label when the key is missing", func() {
+						encoder.Decode(encoded)
+
+						Expect(taggedEmitter.tags).To(Equal([]map[string]string{{"key_label": "unknown"}}))
+						Expect(taggedEmitter.counters).To(Equal([]string{"DecryptionFailures"}))
+						Expect(metricSender.GetCounter("DecryptionFailures")).To(BeZero())
+					})
+
+					It("tags the failure with the label of the key when the key manager has it", func() {
+						encrypted, err := cryptor.Encrypt([]byte("payload"))
+						Expect(err).NotTo(HaveOccurred())
+						encrypted.CipherText[0] ^= 1
+
+						corrupt := []byte{byte(len(encrypted.KeyLabel))}
+						corrupt = append(corrupt, []byte(encrypted.KeyLabel)...)
+						corrupt = append(corrupt, encrypted.Nonce...)
+						corrupt = append(corrupt, encrypted.CipherText...)
+						corrupt = append(format.BASE64_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString(corrupt))...)
+
+						_, err = encoder.Decode(corrupt)
+						Expect(err).To(MatchError("cipher: message authentication failed"))
+						Expect(taggedEmitter.tags).To(Equal([]map[string]string{{"key_label": "removed-label"}}))
+					})
+
+					It("tags a truncated payload with an unknown key label", func() {
+						truncated := []byte{byte(len("removed-label"))}
+						truncated = append(truncated, []byte("removed-label")...)
+						encoder.Decode(append(format.BASE64_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString(truncated))...))
+
+						Expect(taggedEmitter.tags).To(Equal([]map[string]string{{"key_label": "unknown"}}))
+					})
+				})
+
+				It("logs the record and the label of the key", func() {
+					logger := lagertest.NewTestLogger("test")
+					_, err := encoder.Decode(encoded)
+					format.LogDecryptionFailure(logger, "some-guid", err)
+
+					Expect(logger.LogMessages()).To(ConsistOf("test.failed-to-decrypt-record"))
+					Expect(logger.Logs()[0].LogLevel).To(Equal(lager.ERROR))
+					Expect(logger.Logs()[0].Data).To(HaveKeyWithValue("record", "some-guid"))
+					Expect(logger.Logs()[0].Data).To(HaveKeyWithValue("key_label", "label"))
+				})
+			})
 		})
 
 		Describe("unkown encoding", func() {
@@ -201,3 +394,16 @@ func (zr *zeroReader) Read(target []byte) (int, error) {
 	}
 	return len(target), nil
 }
+
+type recordingTaggedEmitter struct {
+	counters []string
+	tags     []map[string]string
+}
+
+func (e *recordingTaggedEmitter) EmitWithTags(event events.Event, tags map[string]string) error {
+	if counter, ok := event.(*events.CounterEvent); ok {
+		e.counters = append(e.counters, counter.GetName())
+	}
+	e.tags = append(e.tags, tags)
+	return nil
+}
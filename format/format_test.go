@@ -1,7 +1,10 @@
 package format_test
 
 import (
+	"crypto/rand"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
@@ -155,4 +158,53 @@ var _ = Describe("Format", func() {
 			})
 		})
 	})
+
+	Describe("extension fields", func() {
+		var (
+			extensionFields map[string][]byte
+			runInfo         models.DesiredLRPRunInfo
+		)
+
+		BeforeEach(func() {
+			key, err := encryption.NewKey("label", "passphrase")
+			Expect(err).NotTo(HaveOccurred())
+			keyManager, err := encryption.NewKeyManager(key, nil)
+			Expect(err).NotTo(HaveOccurred())
+			serializer = format.NewSerializer(encryption.NewCryptor(keyManager, rand.Reader))
+
+			extensionFields = map[string][]byte{
+				"example.com/json":   []byte(`{"replicas":[1,2,3]}`),
+				"example.com/binary": {0x00, 0xff, 0x10, 0x80},
+				"example.com/empty":  {},
+			}
+			task.ExtensionFields = extensionFields
+
+			desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+			desiredLRP.ExtensionFields = extensionFields
+			runInfo = desiredLRP.DesiredLRPRunInfo(time.Unix(123, 0))
+		})
+
+		formats := map[string]*format.Format{
+			"FORMATTED_JSON":  format.FORMATTED_JSON,
+			"ENCODED_PROTO":   format.ENCODED_PROTO,
+			"ENCRYPTED_PROTO": format.ENCRYPTED_PROTO,
+		}
+		for name, f := range formats {
+			f := f
+
+			It(fmt.Sprintf("round trips them unchanged through %s", name), func() {
+				payload, err := serializer.Marshal(logger, f, task)
+				Expect(err).NotTo(HaveOccurred())
+				var decodedTask models.Task
+				Expect(serializer.Unmarshal(logger, payload, &decodedTask)).To(Succeed())
+				Expect(decodedTask.ExtensionFields).To(Equal(extensionFields))
+
+				payload, err = serializer.Marshal(logger, f, &runInfo)
+				Expect(err).NotTo(HaveOccurred())
+				var decodedRunInfo models.DesiredLRPRunInfo
+				Expect(serializer.Unmarshal(logger, payload, &decodedRunInfo)).To(Succeed())
+				Expect(decodedRunInfo.ExtensionFields).To(Equal(extensionFields))
+			})
+		}
+	})
 })
@@ -0,0 +1,50 @@
+package format
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/runtimeschema/metric"
+)
+
+// MissingKeyPolicy is how the DBs read a record encrypted with a key that is
+// no longer in the key manager.
+type MissingKeyPolicy string
+
+const (
+	// MissingKeyFail fails the reads that come across the record, and leaves
+	// it where it is. It is the default.
+	MissingKeyFail MissingKeyPolicy = "fail"
+	// MissingKeySkip leaves the record out of the reads, as if it were not
+	// there, and leaves it where it is.
+	MissingKeySkip MissingKeyPolicy = "skip"
+	// MissingKeyQuarantine moves the record aside, where it can be inspected
+	// and restored by hand, and leaves it out of the reads.
+	MissingKeyQuarantine MissingKeyPolicy = "quarantine"
+)
+
+// The records skipped and quarantined under the policy. The records that fail
+// the reads are already counted in DecryptionFailures.
+const (
+	missingKeyRecordsSkipped     = metric.Counter("MissingKeyRecordsSkipped")
+	missingKeyRecordsQuarantined = metric.Counter("MissingKeyRecordsQuarantined")
+)
+
+func (p MissingKeyPolicy) Validate() error {
+	switch p {
+	case MissingKeyFail, MissingKeySkip, MissingKeyQuarantine:
+		return nil
+	default:
+		return fmt.Errorf("Unknown missing key policy: %q", string(p))
+	}
+}
+
+// CountRecord counts a record the policy was applied to, ignoring errors
+// emitting the count.
+func (p MissingKeyPolicy) CountRecord() {
+	switch p {
+	case MissingKeySkip:
+		missingKeyRecordsSkipped.Increment()
+	case MissingKeyQuarantine:
+		missingKeyRecordsQuarantined.Increment()
+	}
+}
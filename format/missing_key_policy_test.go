@@ -0,0 +1,50 @@
+package format_test
+
+import (
+	"code.cloudfoundry.org/bbs/format"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MissingKeyPolicy", func() {
+	Describe("Validate", func() {
+		It("accepts the known policies", func() {
+			Expect(format.MissingKeyFail.Validate()).To(Succeed())
+			Expect(format.MissingKeySkip.Validate()).To(Succeed())
+			Expect(format.MissingKeyQuarantine.Validate()).To(Succeed())
+		})
+
+		It("rejects any other policy", func() {
+			Expect(format.MissingKeyPolicy("delete").Validate()).To(MatchError(`Unknown missing key policy: "delete"`))
+			Expect(format.MissingKeyPolicy("").Validate()).To(HaveOccurred())
+		})
+	})
+
+	Describe("CountRecord", func() {
+		var metricSender *fake.FakeMetricSender
+
+		BeforeEach(func() {
+			metricSender = fake.NewFakeMetricSender()
+			dropsonde_metrics.Initialize(metricSender, nil)
+		})
+
+		It("counts the skipped and quarantined records", func() {
+			format.MissingKeySkip.CountRecord()
+			format.MissingKeySkip.CountRecord()
+			format.MissingKeyQuarantine.CountRecord()
+
+			Expect(metricSender.GetCounter("MissingKeyRecordsSkipped")).To(BeEquivalentTo(2))
+			Expect(metricSender.GetCounter("MissingKeyRecordsQuarantined")).To(BeEquivalentTo(1))
+		})
+
+		It("does not count the records that fail the reads", func() {
+			format.MissingKeyFail.CountRecord()
+
+			Expect(metricSender.GetCounter("MissingKeyRecordsSkipped")).To(BeZero())
+			Expect(metricSender.GetCounter("MissingKeyRecordsQuarantined")).To(BeZero())
+		})
+	})
+})
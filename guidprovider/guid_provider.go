@@ -1,9 +1,17 @@
 package guidprovider
 
-import "github.com/nu7hatch/gouuid"
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/nu7hatch/gouuid"
+)
 
 //go:generate counterfeiter . GUIDProvider
 
+// GUIDProvider generates the guids the BBS assigns to the records it creates,
+// such as modification tag epochs. Implementations must be safe for
+// concurrent use and must not return the same guid twice.
 type GUIDProvider interface {
 	NextGUID() (string, error)
 }
@@ -19,3 +27,30 @@ func (*guidProvider) NextGUID() (string, error) {
 	}
 	return guid.String(), nil
 }
+
+// SequentialGuidProvider generates UUID-formatted guids from a seed and a
+// counter, so the same seed always yields the same guids in the same order.
+// It is meant for tests and replay; production should use
+// DefaultGuidProvider.
+type SequentialGuidProvider struct {
+	seed uint64
+
+	lock sync.Mutex
+	next uint64
+}
+
+func NewSequentialGuidProvider(seed uint64) *SequentialGuidProvider {
+	return &SequentialGuidProvider{seed: seed}
+}
+
+func (p *SequentialGuidProvider) NextGUID() (string, error) {
+	p.lock.Lock()
+	n := p.next
+	p.next++
+	p.lock.Unlock()
+
+	var guid uuid.UUID
+	binary.BigEndian.PutUint64(guid[:8], p.seed)
+	binary.BigEndian.PutUint64(guid[8:], n)
+	return guid.String(), nil
+}
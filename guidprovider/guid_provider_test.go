@@ -0,0 +1,85 @@
+package guidprovider_test
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/guidprovider"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const uuidPattern = `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`
+
+var _ = Describe("GUIDProvider", func() {
+	nextGUIDs := func(provider guidprovider.GUIDProvider, count int) []string {
+		guids := []string{}
+		for i := 0; i < count; i++ {
+			guid, err := provider.NextGUID()
+			Expect(err).NotTo(HaveOccurred())
+			guids = append(guids, guid)
+		}
+		return guids
+	}
+
+	Describe("DefaultGuidProvider", func() {
+		It("returns distinct uuids", func() {
+			guids := nextGUIDs(guidprovider.DefaultGuidProvider, 2)
+			Expect(guids[0]).To(MatchRegexp(uuidPattern))
+			Expect(guids[0]).NotTo(Equal(guids[1]))
+		})
+	})
+
+	Describe("SequentialGuidProvider", func() {
+		It("returns uuid-formatted guids", func() {
+			guid, err := guidprovider.NewSequentialGuidProvider(42).NextGUID()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(guid).To(MatchRegexp(uuidPattern))
+		})
+
+		It("returns the same sequence for the same seed", func() {
+			first := nextGUIDs(guidprovider.NewSequentialGuidProvider(42), 10)
+			second := nextGUIDs(guidprovider.NewSequentialGuidProvider(42), 10)
+			Expect(first).To(Equal(second))
+		})
+
+		It("returns stable guids across releases", func() {
+			guids := nextGUIDs(guidprovider.NewSequentialGuidProvider(1), 2)
+			Expect(guids).To(Equal([]string{
+				"00000000-0000-0001-0000-000000000000",
+				"00000000-0000-0001-0000-000000000001",
+			}))
+		})
+
+		It("returns different sequences for different seeds", func() {
+			first := nextGUIDs(guidprovider.NewSequentialGuidProvider(1), 10)
+			second := nextGUIDs(guidprovider.NewSequentialGuidProvider(2), 10)
+			for _, guid := range first {
+				Expect(second).NotTo(ContainElement(guid))
+			}
+		})
+
+		It("never repeats a guid when used concurrently", func() {
+			provider := guidprovider.NewSequentialGuidProvider(42)
+
+			var lock sync.Mutex
+			seen := map[string]bool{}
+			wg := sync.WaitGroup{}
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					for _, guid := range nextGUIDs(provider, 100) {
+						lock.Lock()
+						seen[guid] = true
+						lock.Unlock()
+					}
+				}()
+			}
+			wg.Wait()
+
+			Expect(seen).To(HaveLen(1000))
+		})
+	})
+})
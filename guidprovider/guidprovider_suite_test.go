@@ -0,0 +1,13 @@
+package guidprovider_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestGuidprovider(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Guidprovider Suite")
+}
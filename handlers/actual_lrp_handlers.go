@@ -8,6 +8,16 @@ import (
 	"code.cloudfoundry.org/lager"
 )
 
+const (
+	// DefaultCrashingActualLRPsLimit is the number of actual LRPs returned by
+	// CrashingActualLRPs when the request does not set a limit.
+	DefaultCrashingActualLRPsLimit = 50
+
+	// MaxCrashingActualLRPsLimit caps the number of actual LRPs returned by
+	// CrashingActualLRPs, whatever limit the request sets.
+	MaxCrashingActualLRPsLimit = 500
+)
+
 type ActualLRPHandler struct {
 	db       db.ActualLRPDB
 	exitChan chan<- struct{}
@@ -29,13 +39,12 @@ func (h *ActualLRPHandler) ActualLRPGroups(logger lager.Logger, w http.ResponseW
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.ActualLRPFilter{Domain: request.Domain, CellID: request.CellId}
-		response.ActualLrpGroups, err = h.db.ActualLRPGroups(logger, filter)
+		err = h.actualLRPGroups(logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -48,12 +57,12 @@ func (h *ActualLRPHandler) ActualLRPGroupsByProcessGuid(logger lager.Logger, w h
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		response.ActualLrpGroups, err = h.db.ActualLRPGroupsByProcessGuid(logger, request.ProcessGuid)
+		err = h.actualLRPGroupsByProcessGuid(logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -66,11 +75,104 @@ func (h *ActualLRPHandler) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logg
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		response.ActualLrpGroup, err = h.db.ActualLRPGroupByProcessGuidAndIndex(logger, request.ProcessGuid, request.Index)
+		err = h.actualLRPGroupByProcessGuidAndIndex(logger, request, response)
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+// actualLRPGroups, actualLRPGroupsByProcessGuid and
+// actualLRPGroupByProcessGuidAndIndex fill in the response to a validated
+// request. They are shared by the HTTP and gRPC transports.
+func (h *ActualLRPHandler) actualLRPGroups(logger lager.Logger, request *models.ActualLRPGroupsRequest, response *models.ActualLRPGroupsResponse) error {
+	var err error
+	filter := models.ActualLRPFilter{Domain: request.Domain, CellID: request.CellId, States: request.States, PartialResults: request.PartialResults}
+	response.ActualLrpGroups, response.RecordErrors, err = h.db.ActualLRPGroupsWithRecordErrors(logger, filter)
+	if err != nil {
+		return err
+	}
+
+	if len(filter.States) > 0 {
+		response.StateCounts = countActualLRPStates(filter.States, response.ActualLrpGroups)
+	}
+	return nil
+}
+
+// countActualLRPStates counts the actual LRPs of the groups, instance and
+// evacuating alike, in each of the states, including the states none of them
+// is in.
+func countActualLRPStates(states []string, groups []*models.ActualLRPGroup) map[string]int32 {
+	counts := make(map[string]int32, len(states))
+	for _, state := range states {
+		counts[state] = 0
+	}
+
+	for _, group := range groups {
+		for _, lrp := range []*models.ActualLRP{group.Instance, group.Evacuating} {
+			if lrp == nil {
+				continue
+			}
+			if _, ok := counts[lrp.State]; ok {
+				counts[lrp.State]++
+			}
+		}
+	}
+	return counts
+}
+
+func (h *ActualLRPHandler) actualLRPGroupsByProcessGuid(logger lager.Logger, request *models.ActualLRPGroupsByProcessGuidRequest, response *models.ActualLRPGroupsResponse) error {
+	var err error
+	response.ActualLrpGroups, err = h.db.ActualLRPGroupsByProcessGuid(logger, request.ProcessGuid)
+	return err
+}
+
+func (h *ActualLRPHandler) actualLRPGroupByProcessGuidAndIndex(logger lager.Logger, request *models.ActualLRPGroupByProcessGuidAndIndexRequest, response *models.ActualLRPGroupResponse) error {
+	var err error
+	response.ActualLrpGroup, err = h.db.ActualLRPGroupByProcessGuidAndIndex(logger, request.ProcessGuid, request.Index)
+	return err
+}
+
+func (h *ActualLRPHandler) CrashingActualLRPs(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("crashing-actual-lrps")
+
+	request := &models.CrashingActualLRPsRequest{}
+	response := &models.ActualLRPsResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		limit := int(request.Limit)
+		if limit == 0 {
+			limit = DefaultCrashingActualLRPsLimit
+		} else if limit > MaxCrashingActualLRPsLimit {
+			limit = MaxCrashingActualLRPsLimit
+		}
+		response.ActualLrps, err = h.db.CrashingActualLRPs(logger, limit)
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *ActualLRPHandler) CellPlacements(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("cell-placements")
+
+	request := &models.CellPlacementsRequest{}
+	response := &models.CellPlacementsResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		response.CellPlacements, err = h.db.CellPlacements(logger, request.CellId)
 	}
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
@@ -86,7 +86,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 						{Instance: &actualLRP1},
 						{Instance: &actualLRP2, Evacuating: &evacuatingLRP2},
 					}
-				fakeActualLRPDB.ActualLRPGroupsReturns(actualLRPGroups, nil)
+				fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsReturns(actualLRPGroups, nil, nil)
 			})
 
 			It("returns a list of actual lrp groups", func() {
@@ -101,10 +101,17 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 			Context("and no filter is provided", func() {
 				It("call the DB with no filters to retrieve the actual lrp groups", func() {
-					Expect(fakeActualLRPDB.ActualLRPGroupsCallCount()).To(Equal(1))
-					_, filter := fakeActualLRPDB.ActualLRPGroupsArgsForCall(0)
+					Expect(fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsArgsForCall(0)
 					Expect(filter).To(Equal(models.ActualLRPFilter{}))
 				})
+
+				It("does not return state counts", func() {
+					response := models.ActualLRPGroupsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.StateCounts).To(BeEmpty())
+				})
 			})
 
 			Context("and filtering by domain", func() {
@@ -113,8 +120,8 @@ var _ = Describe("ActualLRP Handlers", func() {
 				})
 
 				It("call the DB with the domain filter to retrieve the actual lrp groups", func() {
-					Expect(fakeActualLRPDB.ActualLRPGroupsCallCount()).To(Equal(1))
-					_, filter := fakeActualLRPDB.ActualLRPGroupsArgsForCall(0)
+					Expect(fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsArgsForCall(0)
 					Expect(filter.Domain).To(Equal("domain-1"))
 				})
 			})
@@ -125,29 +132,94 @@ var _ = Describe("ActualLRP Handlers", func() {
 				})
 
 				It("call the DB with the cell id filter to retrieve the actual lrp groups", func() {
-					Expect(fakeActualLRPDB.ActualLRPGroupsCallCount()).To(Equal(1))
-					_, filter := fakeActualLRPDB.ActualLRPGroupsArgsForCall(0)
+					Expect(fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsArgsForCall(0)
 					Expect(filter.CellID).To(Equal("cellid-1"))
 				})
 			})
 
+			Context("and filtering by state", func() {
+				BeforeEach(func() {
+					requestBody = &models.ActualLRPGroupsRequest{
+						States: []string{models.ActualLRPStateRunning, models.ActualLRPStateClaimed, models.ActualLRPStateCrashed},
+					}
+				})
+
+				It("call the DB with the state filter to retrieve the actual lrp groups", func() {
+					Expect(fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsArgsForCall(0)
+					Expect(filter.States).To(ConsistOf(models.ActualLRPStateRunning, models.ActualLRPStateClaimed, models.ActualLRPStateCrashed))
+				})
+
+				It("returns the number of actual lrps in each state", func() {
+					response := models.ActualLRPGroupsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(BeNil())
+					Expect(response.StateCounts).To(Equal(map[string]int32{
+						models.ActualLRPStateRunning: 2,
+						models.ActualLRPStateClaimed: 1,
+						models.ActualLRPStateCrashed: 0,
+					}))
+				})
+			})
+
+			Context("and filtering by an unknown state", func() {
+				BeforeEach(func() {
+					requestBody = &models.ActualLRPGroupsRequest{States: []string{"EXPLODED"}}
+				})
+
+				It("does not call the DB and returns a validation error", func() {
+					Expect(fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsCallCount()).To(Equal(0))
+
+					response := models.ActualLRPGroupsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				})
+			})
+
 			Context("and filtering by cellId and domain", func() {
 				BeforeEach(func() {
 					requestBody = &models.ActualLRPGroupsRequest{Domain: "potato", CellId: "cellid-1"}
 				})
 
 				It("call the DB with the both filters to retrieve the actual lrp groups", func() {
-					Expect(fakeActualLRPDB.ActualLRPGroupsCallCount()).To(Equal(1))
-					_, filter := fakeActualLRPDB.ActualLRPGroupsArgsForCall(0)
+					Expect(fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsArgsForCall(0)
 					Expect(filter.CellID).To(Equal("cellid-1"))
 					Expect(filter.Domain).To(Equal("potato"))
 				})
 			})
 		})
 
+		Context("when partial results are requested", func() {
+			var recordErrors []*models.RecordError
+
+			BeforeEach(func() {
+				requestBody = &models.ActualLRPGroupsRequest{PartialResults: true}
+				recordErrors = []*models.RecordError{{Guid: "process-guid-2", Reason: "index 0: kaboom"}}
+				fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsReturns([]*models.ActualLRPGroup{{Instance: &actualLRP1}}, recordErrors, nil)
+			})
+
+			It("asks the DB for partial results and returns the record errors with the readable groups", func() {
+				_, filter := fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsArgsForCall(0)
+				Expect(filter.PartialResults).To(BeTrue())
+
+				response := &models.ActualLRPGroupsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ActualLrpGroups).To(ConsistOf(&models.ActualLRPGroup{Instance: &actualLRP1}))
+				Expect(response.RecordErrors).To(Equal(recordErrors))
+			})
+		})
+
 		Context("when the DB returns no actual lrp groups", func() {
 			BeforeEach(func() {
-				fakeActualLRPDB.ActualLRPGroupsReturns([]*models.ActualLRPGroup{}, nil)
+				fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsReturns([]*models.ActualLRPGroup{}, nil, nil)
 			})
 
 			It("returns an empty list", func() {
@@ -163,7 +235,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeActualLRPDB.ActualLRPGroupsReturns([]*models.ActualLRPGroup{}, models.NewUnrecoverableError(nil))
+				fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsReturns([]*models.ActualLRPGroup{}, nil, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -174,7 +246,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeActualLRPDB.ActualLRPGroupsReturns([]*models.ActualLRPGroup{}, models.ErrUnknownError)
+				fakeActualLRPDB.ActualLRPGroupsWithRecordErrorsReturns([]*models.ActualLRPGroup{}, nil, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -447,4 +519,192 @@ var _ = Describe("ActualLRP Handlers", func() {
 			})
 		})
 	})
+
+	Describe("CrashingActualLRPs", func() {
+		var request *models.CrashingActualLRPsRequest
+
+		BeforeEach(func() {
+			request = &models.CrashingActualLRPsRequest{}
+		})
+
+		JustBeforeEach(func() {
+			handler.CrashingActualLRPs(logger, responseRecorder, newTestRequest(request))
+		})
+
+		Context("when reading actual lrps from DB succeeds", func() {
+			var actualLRPs []*models.ActualLRP
+
+			BeforeEach(func() {
+				actualLRPs = []*models.ActualLRP{
+					{ActualLRPKey: models.NewActualLRPKey("process-guid-0", 0, "domain-0"), CrashCount: 7, CrashReason: "oom"},
+					{ActualLRPKey: models.NewActualLRPKey("process-guid-1", 2, "domain-1"), CrashCount: 3, CrashReason: "exit 1"},
+				}
+				fakeActualLRPDB.CrashingActualLRPsReturns(actualLRPs, nil)
+			})
+
+			It("returns the actual lrps in the order the DB returned them", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.ActualLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ActualLrps).To(Equal(actualLRPs))
+			})
+
+			It("asks for the default number of actual lrps", func() {
+				Expect(fakeActualLRPDB.CrashingActualLRPsCallCount()).To(Equal(1))
+				_, limit := fakeActualLRPDB.CrashingActualLRPsArgsForCall(0)
+				Expect(limit).To(Equal(handlers.DefaultCrashingActualLRPsLimit))
+			})
+
+			Context("when the request sets a limit", func() {
+				BeforeEach(func() {
+					request.Limit = 5
+				})
+
+				It("asks for that many actual lrps", func() {
+					_, limit := fakeActualLRPDB.CrashingActualLRPsArgsForCall(0)
+					Expect(limit).To(Equal(5))
+				})
+			})
+
+			Context("when the request sets a limit at the maximum", func() {
+				BeforeEach(func() {
+					request.Limit = handlers.MaxCrashingActualLRPsLimit
+				})
+
+				It("asks for that many actual lrps", func() {
+					_, limit := fakeActualLRPDB.CrashingActualLRPsArgsForCall(0)
+					Expect(limit).To(Equal(handlers.MaxCrashingActualLRPsLimit))
+				})
+			})
+
+			Context("when the request sets a limit beyond the maximum", func() {
+				BeforeEach(func() {
+					request.Limit = handlers.MaxCrashingActualLRPsLimit + 1
+				})
+
+				It("asks for the maximum number of actual lrps", func() {
+					_, limit := fakeActualLRPDB.CrashingActualLRPsArgsForCall(0)
+					Expect(limit).To(Equal(handlers.MaxCrashingActualLRPsLimit))
+				})
+			})
+		})
+
+		Context("when the request sets a negative limit", func() {
+			BeforeEach(func() {
+				request.Limit = -1
+			})
+
+			It("responds with an invalid request error", func() {
+				response := &models.ActualLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeActualLRPDB.CrashingActualLRPsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.CrashingActualLRPsReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.CrashingActualLRPsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.ActualLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
+	Describe("CellPlacements", func() {
+		var request *models.CellPlacementsRequest
+
+		BeforeEach(func() {
+			request = &models.CellPlacementsRequest{CellId: "cell-a"}
+		})
+
+		JustBeforeEach(func() {
+			handler.CellPlacements(logger, responseRecorder, newTestRequest(request))
+		})
+
+		Context("when reading the placements from DB succeeds", func() {
+			var placements []*models.CellPlacement
+
+			BeforeEach(func() {
+				placements = []*models.CellPlacement{
+					{
+						CellId: "cell-a",
+						ActualLrps: []*models.ActualLRP{
+							{ActualLRPKey: models.NewActualLRPKey("process-guid-0", 0, "domain-0"), State: models.ActualLRPStateRunning},
+							{ActualLRPKey: models.NewActualLRPKey("process-guid-1", 1, "domain-1"), State: models.ActualLRPStateClaimed},
+						},
+						MemoryMb: 384,
+						DiskMb:   1536,
+					},
+				}
+				fakeActualLRPDB.CellPlacementsReturns(placements, nil)
+			})
+
+			It("returns the placements", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.CellPlacementsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.CellPlacements).To(Equal(placements))
+			})
+
+			It("asks the DB for the requested cell", func() {
+				Expect(fakeActualLRPDB.CellPlacementsCallCount()).To(Equal(1))
+				_, cellID := fakeActualLRPDB.CellPlacementsArgsForCall(0)
+				Expect(cellID).To(Equal("cell-a"))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.CellPlacementsReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.CellPlacementsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.CellPlacementsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
 })
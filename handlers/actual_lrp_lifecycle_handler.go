@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/auctioneerclient"
+	"code.cloudfoundry.org/bbs/audit"
 	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
@@ -15,8 +17,9 @@ type ActualLRPLifecycleHandler struct {
 	db               db.ActualLRPDB
 	desiredLRPDB     db.DesiredLRPDB
 	actualHub        events.Hub
-	auctioneerClient auctioneer.Client
+	auctioneerClient auctioneerclient.Client
 	retirer          controllers.ActualLRPRetirer
+	startOrderGate   *controllers.StartOrderGate
 	exitChan         chan<- struct{}
 }
 
@@ -24,7 +27,7 @@ func NewActualLRPLifecycleHandler(
 	db db.ActualLRPDB,
 	desiredLRPDB db.DesiredLRPDB,
 	actualHub events.Hub,
-	auctioneerClient auctioneer.Client,
+	auctioneerClient auctioneerclient.Client,
 	retirer controllers.ActualLRPRetirer,
 	exitChan chan<- struct{},
 ) *ActualLRPLifecycleHandler {
@@ -34,6 +37,7 @@ func NewActualLRPLifecycleHandler(
 		actualHub:        actualHub,
 		auctioneerClient: auctioneerClient,
 		retirer:          retirer,
+		startOrderGate:   controllers.NewStartOrderGate(db, desiredLRPDB),
 		exitChan:         exitChan,
 	}
 }
@@ -45,7 +49,8 @@ func (h *ActualLRPLifecycleHandler) ClaimActualLRP(logger lager.Logger, w http.R
 	request := &models.ClaimActualLRPRequest{}
 	response := &models.ActualLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -53,11 +58,14 @@ func (h *ActualLRPLifecycleHandler) ClaimActualLRP(logger lager.Logger, w http.R
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ProcessGuid, request.Index)
+
 	before, after, err := h.db.ClaimActualLRP(logger, request.ProcessGuid, request.Index, request.ActualLrpInstanceKey)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
+	audit.SetModificationTags(req, instanceModificationTag(before), instanceModificationTag(after))
 
 	if !after.Equal(before) {
 		go h.actualHub.Emit(models.NewActualLRPChangedEvent(before, after))
@@ -73,7 +81,8 @@ func (h *ActualLRPLifecycleHandler) StartActualLRP(logger lager.Logger, w http.R
 	response := &models.ActualLRPLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -81,17 +90,24 @@ func (h *ActualLRPLifecycleHandler) StartActualLRP(logger lager.Logger, w http.R
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+
 	before, after, err := h.db.StartActualLRP(logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ActualLrpNetInfo)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
+	audit.SetModificationTags(req, instanceModificationTag(before), instanceModificationTag(after))
 
 	if before == nil {
 		go h.actualHub.Emit(models.NewActualLRPCreatedEvent(after))
 	} else if !before.Equal(after) {
 		go h.actualHub.Emit(models.NewActualLRPChangedEvent(before, after))
 	}
+
+	if startedLeader(before, after) {
+		h.releaseWithheldStarts(logger, request.ActualLrpKey.ProcessGuid)
+	}
 }
 
 func (h *ActualLRPLifecycleHandler) CrashActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -100,7 +116,8 @@ func (h *ActualLRPLifecycleHandler) CrashActualLRP(logger lager.Logger, w http.R
 	request := &models.CrashActualLRPRequest{}
 	response := &models.ActualLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -110,12 +127,14 @@ func (h *ActualLRPLifecycleHandler) CrashActualLRP(logger lager.Logger, w http.R
 
 	actualLRPKey := request.ActualLrpKey
 	actualLRPInstanceKey := request.ActualLrpInstanceKey
+	audit.SetTargetInstance(req, actualLRPKey.ProcessGuid, actualLRPKey.Index)
 
 	before, after, shouldRestart, err := h.db.CrashActualLRP(logger, actualLRPKey, actualLRPInstanceKey, request.ErrorMessage)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
+	audit.SetModificationTags(req, instanceModificationTag(before), instanceModificationTag(after))
 
 	if shouldRestart {
 		desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger, actualLRPKey.ProcessGuid)
@@ -126,14 +145,17 @@ func (h *ActualLRPLifecycleHandler) CrashActualLRP(logger lager.Logger, w http.R
 		}
 
 		schedInfo := desiredLRP.DesiredLRPSchedulingInfo()
-		startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(&schedInfo, int(actualLRPKey.Index))
-		logger.Info("start-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "index": int(actualLRPKey.Index)})
-		err = h.auctioneerClient.RequestLRPAuctions([]*auctioneer.LRPStartRequest{&startRequest})
-		logger.Info("finished-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "index": int(actualLRPKey.Index)})
-		if err != nil {
-			logger.Error("failed-requesting-auction", err)
-			response.Error = models.ConvertError(err)
-			return
+		indices := h.startOrderGate.Indices(logger, &schedInfo, []int{int(actualLRPKey.Index)})
+		if len(indices) > 0 {
+			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(&schedInfo, indices...)
+			logger.Info("start-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "index": int(actualLRPKey.Index)})
+			err = h.auctioneerClient.RequestLRPAuctions([]*auctioneer.LRPStartRequest{&startRequest})
+			logger.Info("finished-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "index": int(actualLRPKey.Index)})
+			if err != nil {
+				logger.Error("failed-requesting-auction", err)
+				response.Error = models.ConvertError(err)
+				return
+			}
 		}
 	}
 
@@ -150,7 +172,8 @@ func (h *ActualLRPLifecycleHandler) FailActualLRP(logger lager.Logger, w http.Re
 	response := &models.ActualLRPLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -158,11 +181,14 @@ func (h *ActualLRPLifecycleHandler) FailActualLRP(logger lager.Logger, w http.Re
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+
 	before, after, err := h.db.FailActualLRP(logger, request.ActualLrpKey, request.ErrorMessage)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
+	audit.SetModificationTags(req, instanceModificationTag(before), instanceModificationTag(after))
 
 	go h.actualHub.Emit(models.NewActualLRPChangedEvent(before, after))
 }
@@ -175,7 +201,8 @@ func (h *ActualLRPLifecycleHandler) RemoveActualLRP(logger lager.Logger, w http.
 	response := &models.ActualLRPLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -183,6 +210,8 @@ func (h *ActualLRPLifecycleHandler) RemoveActualLRP(logger lager.Logger, w http.
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ProcessGuid, request.Index)
+
 	beforeActualLRPGroup, err := h.db.ActualLRPGroupByProcessGuidAndIndex(logger, request.ProcessGuid, request.Index)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -205,7 +234,8 @@ func (h *ActualLRPLifecycleHandler) RetireActualLRP(logger lager.Logger, w http.
 
 	var err error
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -213,6 +243,184 @@ func (h *ActualLRPLifecycleHandler) RetireActualLRP(logger lager.Logger, w http.
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+
 	err = h.retirer.RetireActualLRP(logger, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
 	response.Error = models.ConvertError(err)
 }
+
+func (h *ActualLRPLifecycleHandler) RetireActualLRPsOnCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("retire-actual-lrps-on-cell")
+	request := &models.RetireActualLRPsOnCellRequest{}
+	response := &models.RetireActualLRPsOnCellResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	audit.SetTarget(req, request.CellId)
+
+	retired, err := h.retirer.RetireActualLRPsOnCell(logger, request.CellId)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+	response.RetiredCount = int32(len(retired))
+
+	err = h.requestAuctions(logger, retired)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+	}
+}
+
+// StartActualLRPs records the actual LRPs a cell reports as running in one
+// request. See doc/api-lrps-internal.md for how a report is reconciled.
+func (h *ActualLRPLifecycleHandler) StartActualLRPs(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("start-actual-lrps")
+	request := &models.StartActualLRPsRequest{}
+	response := &models.StartActualLRPsResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	audit.SetTarget(req, request.CellId)
+
+	result, err := h.db.StartActualLRPs(logger, request.CellId, request.ActualLrps, request.Authoritative)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	for _, change := range result.Started {
+		if change.Before == nil {
+			go h.actualHub.Emit(models.NewActualLRPCreatedEvent(change.After))
+		} else if !change.Before.Equal(change.After) {
+			go h.actualHub.Emit(models.NewActualLRPChangedEvent(change.Before, change.After))
+		}
+
+		if startedLeader(change.Before, change.After) {
+			h.releaseWithheldStarts(logger, change.After.Instance.ProcessGuid)
+		}
+	}
+
+	unclaimed := make([]*models.ActualLRP, 0, len(result.Unclaimed))
+	for _, change := range result.Unclaimed {
+		go h.actualHub.Emit(models.NewActualLRPChangedEvent(change.Before, change.After))
+		unclaimed = append(unclaimed, change.After.Instance)
+	}
+
+	response.StartedCount = int32(len(result.Started))
+	response.RejectedActualLrpKeys = result.Rejected
+	response.UnclaimedCount = int32(len(unclaimed))
+
+	err = h.requestAuctions(logger, unclaimed)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+	}
+}
+
+// requestAuctions requests start auctions for the unclaimed actual LRPs. It
+// only fails when their scheduling infos cannot be fetched; failing to request
+// the auctions is left for convergence to recover from.
+func (h *ActualLRPLifecycleHandler) requestAuctions(logger lager.Logger, lrps []*models.ActualLRP) error {
+	if len(lrps) == 0 {
+		return nil
+	}
+
+	processGuids := []string{}
+	seen := map[string]bool{}
+	for _, lrp := range lrps {
+		if !seen[lrp.ProcessGuid] {
+			seen[lrp.ProcessGuid] = true
+			processGuids = append(processGuids, lrp.ProcessGuid)
+		}
+	}
+
+	schedulingInfos, err := h.desiredLRPDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: processGuids})
+	if err != nil {
+		logger.Error("failed-fetching-scheduling-infos", err)
+		return err
+	}
+
+	schedulingInfoMap := make(map[string]*models.DesiredLRPSchedulingInfo, len(schedulingInfos))
+	for _, schedulingInfo := range schedulingInfos {
+		schedulingInfoMap[schedulingInfo.ProcessGuid] = schedulingInfo
+	}
+
+	startRequests := []*auctioneer.LRPStartRequest{}
+	for _, lrp := range lrps {
+		schedulingInfo, ok := schedulingInfoMap[lrp.ProcessGuid]
+		if !ok {
+			logger.Info("desired-lrp-not-found", lager.Data{"process_guid": lrp.ProcessGuid})
+			continue
+		}
+		indices := h.startOrderGate.Indices(logger, schedulingInfo, []int{int(lrp.Index)})
+		if len(indices) == 0 {
+			continue
+		}
+		startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedulingInfo, indices...)
+		startRequests = append(startRequests, &startRequest)
+	}
+
+	if len(startRequests) == 0 {
+		return nil
+	}
+
+	logger.Info("start-lrp-auction-requests", lager.Data{"count": len(startRequests)})
+	err = h.auctioneerClient.RequestLRPAuctions(startRequests)
+	if err != nil {
+		// convergence will request auctions for the unclaimed LRPs
+		logger.Error("failed-requesting-auctions", err)
+	}
+
+	return nil
+}
+
+// startedLeader reports whether the change started instance 0 of its LRP,
+// which releases the instances withheld by the start order of the LRP.
+func startedLeader(before, after *models.ActualLRPGroup) bool {
+	if after == nil || after.Instance == nil || after.Instance.Index != 0 || after.Instance.State != models.ActualLRPStateRunning {
+		return false
+	}
+	return before == nil || before.Instance == nil || before.Instance.State != models.ActualLRPStateRunning
+}
+
+// releaseWithheldStarts requests the auctions of the instances that waited for
+// instance 0 to be running. Failing to request them is left for convergence to
+// recover from.
+func (h *ActualLRPLifecycleHandler) releaseWithheldStarts(logger lager.Logger, processGuid string) {
+	startRequest, err := h.startOrderGate.Released(logger, processGuid)
+	if err != nil {
+		logger.Error("failed-fetching-withheld-starts", err, lager.Data{"process_guid": processGuid})
+		return
+	}
+	if startRequest == nil {
+		return
+	}
+
+	logger.Info("requesting-withheld-starts", lager.Data{"process_guid": processGuid, "indices": startRequest.Indices})
+	err = h.auctioneerClient.RequestLRPAuctions([]*auctioneer.LRPStartRequest{startRequest})
+	if err != nil {
+		logger.Error("failed-requesting-withheld-starts", err)
+	}
+}
+
+func instanceModificationTag(group *models.ActualLRPGroup) *models.ModificationTag {
+	if group == nil || group.Instance == nil {
+		return nil
+	}
+	return &group.Instance.ModificationTag
+}
@@ -7,12 +7,16 @@ import (
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/audit/auditfakes"
 	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/fake_bbs"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"code.cloudfoundry.org/rep/repfakes"
 	. "github.com/onsi/ginkgo"
@@ -63,6 +67,7 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 			index       int32 = 1
 			instanceKey models.ActualLRPInstanceKey
 			requestBody interface{}
+			auditSink   *auditfakes.FakeSink
 		)
 
 		BeforeEach(func() {
@@ -94,11 +99,16 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 				State: models.ActualLRPStateClaimed,
 				Since: 1140,
 			}
+			actualLRP.ModificationTag = models.ModificationTag{Epoch: "some-epoch", Index: 1}
+			afterActualLRP.ModificationTag = models.ModificationTag{Epoch: "some-epoch", Index: 2}
+			auditSink = new(auditfakes.FakeSink)
 		})
 
 		JustBeforeEach(func() {
 			request := newTestRequest(requestBody)
-			handler.ClaimActualLRP(logger, responseRecorder, request)
+			audit.Wrap(auditSink, "ClaimActualLRP", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				handler.ClaimActualLRP(logger, w, req)
+			})).ServeHTTP(responseRecorder, request)
 		})
 
 		Context("when claiming the actual lrp in the DB succeeds", func() {
@@ -115,6 +125,17 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 				Expect(response.Error).To(BeNil())
 			})
 
+			It("audits the claim", func() {
+				Expect(auditSink.EmitCallCount()).To(Equal(1))
+				record := auditSink.EmitArgsForCall(0)
+				Expect(record.Operation).To(Equal("ClaimActualLRP"))
+				Expect(record.Target).To(Equal(processGuid))
+				Expect(*record.Index).To(Equal(index))
+				Expect(record.BeforeModificationTag).To(Equal(&audit.ModificationTag{Epoch: "some-epoch", Index: 1}))
+				Expect(record.AfterModificationTag).To(Equal(&audit.ModificationTag{Epoch: "some-epoch", Index: 2}))
+				Expect(record.Error).To(BeEmpty())
+			})
+
 			It("claims the actual lrp by process guid and index", func() {
 				Expect(fakeActualLRPDB.ClaimActualLRPCallCount()).To(Equal(1))
 				_, actualProcessGuid, actualIndex, actualInstanceKey := fakeActualLRPDB.ClaimActualLRPArgsForCall(0)
@@ -172,6 +193,14 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 				Expect(response.Error).To(Equal(models.ErrUnknownError))
 			})
 
+			It("audits the failed claim", func() {
+				Expect(auditSink.EmitCallCount()).To(Equal(1))
+				record := auditSink.EmitArgsForCall(0)
+				Expect(record.Target).To(Equal(processGuid))
+				Expect(record.AfterModificationTag).To(BeNil())
+				Expect(record.Error).To(Equal(models.ErrUnknownError.Error()))
+			})
+
 			It("does not emits a change event to the hub", func() {
 				Consistently(actualHub.EmitCallCount).Should(Equal(0))
 			})
@@ -302,6 +331,43 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 					Consistently(actualHub.EmitCallCount).Should(Equal(0))
 				})
 			})
+
+			Context("when instance 0 of an lrp that starts its leader first is started", func() {
+				BeforeEach(func() {
+					key.Index = 0
+					actualLRP.Index = 0
+					afterActualLRP.Index = 0
+
+					schedulingInfo := model_helpers.NewValidDesiredLRP(processGuid).DesiredLRPSchedulingInfo()
+					schedulingInfo.StartOrder = models.StartOrder_LeaderFirst
+					fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil)
+
+					withheld := model_helpers.NewValidActualLRP(processGuid, 1)
+					withheld.State = models.ActualLRPStateUnclaimed
+					fakeActualLRPDB.ActualLRPGroupsByProcessGuidReturns([]*models.ActualLRPGroup{
+						{Instance: &afterActualLRP},
+						{Instance: withheld},
+					}, nil)
+				})
+
+				It("requests the start auctions of the instances that waited for it", func() {
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+					startAuctions := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+					Expect(startAuctions).To(HaveLen(1))
+					Expect(startAuctions[0].ProcessGuid).To(Equal(processGuid))
+					Expect(startAuctions[0].Indices).To(Equal([]int{1}))
+				})
+
+				Context("when it was already running", func() {
+					BeforeEach(func() {
+						actualLRP.State = models.ActualLRPStateRunning
+					})
+
+					It("does not request them again", func() {
+						Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+					})
+				})
+			})
 		})
 
 		Context("when the DB returns an unrecoverable error when doing the actual LRP lookup", func() {
@@ -1163,4 +1229,464 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 			})
 		})
 	})
+
+	Describe("RetireActualLRPsOnCell", func() {
+		var (
+			cellID       string
+			requestBody  interface{}
+			response     *models.RetireActualLRPsOnCellResponse
+			auditSink    *auditfakes.FakeSink
+			cellPresence models.CellPresence
+
+			befores, afters []*models.ActualLRPGroup
+			schedulingInfo  models.DesiredLRPSchedulingInfo
+		)
+
+		BeforeEach(func() {
+			cellID = "cell-id"
+			requestBody = &models.RetireActualLRPsOnCellRequest{CellId: cellID}
+			auditSink = new(auditfakes.FakeSink)
+
+			befores = []*models.ActualLRPGroup{}
+			afters = []*models.ActualLRPGroup{}
+			for i := int32(0); i < 2; i++ {
+				before := &models.ActualLRP{
+					ActualLRPKey:         models.NewActualLRPKey("process-guid", i, "some-domain"),
+					ActualLRPInstanceKey: models.NewActualLRPInstanceKey("instance-guid", cellID),
+					State:                models.ActualLRPStateRunning,
+					Since:                1138,
+				}
+				after := &models.ActualLRP{
+					ActualLRPKey: before.ActualLRPKey,
+					State:        models.ActualLRPStateUnclaimed,
+					Since:        1140,
+				}
+				befores = append(befores, &models.ActualLRPGroup{Instance: before})
+				afters = append(afters, &models.ActualLRPGroup{Instance: after})
+			}
+			fakeActualLRPDB.RetireActualLRPsOnCellReturns(befores, afters, nil)
+
+			desiredLRP := &models.DesiredLRP{
+				ProcessGuid: "process-guid",
+				Domain:      "some-domain",
+				RootFs:      "some-stack",
+				MemoryMb:    128,
+				DiskMb:      512,
+			}
+			schedulingInfo = desiredLRP.DesiredLRPSchedulingInfo()
+			fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil)
+
+			cellPresence = models.NewCellPresence(
+				cellID,
+				"cell1.addr",
+				"the-zone",
+				models.NewCellCapacity(128, 1024, 6),
+				[]string{},
+				[]string{},
+				[]string{},
+				[]string{},
+			)
+			fakeServiceClient.CellByIdReturns(&cellPresence, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			audit.Wrap(auditSink, "RetireActualLRPsOnCell", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				handler.RetireActualLRPsOnCell(logger, w, req)
+			})).ServeHTTP(responseRecorder, request)
+
+			response = &models.RetireActualLRPsOnCellResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("retires the actual LRPs on the cell and reports how many it retired", func() {
+			Expect(response.Error).To(BeNil())
+			Expect(response.RetiredCount).To(BeEquivalentTo(2))
+
+			Expect(fakeActualLRPDB.RetireActualLRPsOnCellCallCount()).To(Equal(1))
+			_, actualCellID := fakeActualLRPDB.RetireActualLRPsOnCellArgsForCall(0)
+			Expect(actualCellID).To(Equal(cellID))
+		})
+
+		It("emits a change event for each retired LRP", func() {
+			Eventually(actualHub.EmitCallCount).Should(Equal(2))
+
+			events := []*models.ActualLRPChangedEvent{
+				actualHub.EmitArgsForCall(0).(*models.ActualLRPChangedEvent),
+				actualHub.EmitArgsForCall(1).(*models.ActualLRPChangedEvent),
+			}
+			Expect(events).To(ConsistOf(
+				models.NewActualLRPChangedEvent(befores[0], afters[0]),
+				models.NewActualLRPChangedEvent(befores[1], afters[1]),
+			))
+		})
+
+		It("stops the instances on the cell", func() {
+			Expect(fakeServiceClient.CellByIdCallCount()).To(Equal(1))
+			_, fetchedCellID := fakeServiceClient.CellByIdArgsForCall(0)
+			Expect(fetchedCellID).To(Equal(cellID))
+
+			Expect(fakeRepClientFactory.CreateClientArgsForCall(0)).To(Equal("cell1.addr"))
+			Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(2))
+			for i := 0; i < 2; i++ {
+				key, instanceKey := fakeRepClient.StopLRPInstanceArgsForCall(i)
+				Expect(key).To(Equal(befores[i].Instance.ActualLRPKey))
+				Expect(instanceKey).To(Equal(befores[i].Instance.ActualLRPInstanceKey))
+			}
+		})
+
+		It("requests a single batch of auctions for the retired LRPs", func() {
+			Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+			_, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+			Expect(filter.ProcessGuids).To(ConsistOf("process-guid"))
+
+			Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			startRequests := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+			Expect(startRequests).To(HaveLen(2))
+			for i := 0; i < 2; i++ {
+				expectedStartRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(&schedulingInfo, i)
+				Expect(startRequests[i]).To(BeEquivalentTo(&expectedStartRequest))
+			}
+		})
+
+		It("audits the retirement against the cell", func() {
+			Expect(auditSink.EmitCallCount()).To(Equal(1))
+			record := auditSink.EmitArgsForCall(0)
+			Expect(record.Operation).To(Equal("RetireActualLRPsOnCell"))
+			Expect(record.Target).To(Equal(cellID))
+			Expect(record.Error).To(BeEmpty())
+		})
+
+		Context("when the cell has no LRPs", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.RetireActualLRPsOnCellReturns(nil, nil, nil)
+			})
+
+			It("retires nothing and does not request auctions", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.RetiredCount).To(BeZero())
+				Expect(fakeServiceClient.CellByIdCallCount()).To(Equal(0))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the cell is no longer present", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellByIdReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("still retires the LRPs and requests auctions", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.RetiredCount).To(BeEquivalentTo(2))
+				Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(0))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when stopping an instance fails", func() {
+			BeforeEach(func() {
+				fakeRepClient.StopLRPInstanceReturns(errors.New("boom"))
+			})
+
+			It("still retires the LRPs and requests auctions", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.RetiredCount).To(BeEquivalentTo(2))
+				Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(2))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when requesting the auctions fails", func() {
+			BeforeEach(func() {
+				fakeAuctioneerClient.RequestLRPAuctionsReturns(errors.New("boom"))
+			})
+
+			It("leaves the LRPs for convergence and reports them as retired", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.RetiredCount).To(BeEquivalentTo(2))
+			})
+		})
+
+		Context("when the request has no cell id", func() {
+			BeforeEach(func() {
+				requestBody = &models.RetireActualLRPsOnCellRequest{}
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeActualLRPDB.RetireActualLRPsOnCellCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the DB fails", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.RetireActualLRPsOnCellReturns(nil, nil, errors.New("boom"))
+			})
+
+			It("responds with the error and audits it", func() {
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Message).To(Equal("boom"))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+				Expect(auditSink.EmitArgsForCall(0).Error).To(Equal("boom"))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.RetireActualLRPsOnCellReturns(nil, nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+
+	Describe("StartActualLRPs", func() {
+		var (
+			cellID      string
+			requestBody interface{}
+			response    *models.StartActualLRPsResponse
+			auditSink   *auditfakes.FakeSink
+
+			starts         []*models.StartActualLRPRequest
+			result         *models.StartActualLRPsResult
+			schedulingInfo models.DesiredLRPSchedulingInfo
+		)
+
+		BeforeEach(func() {
+			cellID = "cell-id"
+			auditSink = new(auditfakes.FakeSink)
+
+			starts = []*models.StartActualLRPRequest{}
+			for i := int32(0); i < 3; i++ {
+				key := models.NewActualLRPKey("process-guid", i, "some-domain")
+				instanceKey := models.NewActualLRPInstanceKey("instance-guid", cellID)
+				netInfo := models.NewActualLRPNetInfo("1.2.3.4", models.NewPortMapping(10, 20))
+				starts = append(starts, &models.StartActualLRPRequest{
+					ActualLrpKey:         &key,
+					ActualLrpInstanceKey: &instanceKey,
+					ActualLrpNetInfo:     &netInfo,
+				})
+			}
+			requestBody = &models.StartActualLRPsRequest{CellId: cellID, ActualLrps: starts, Authoritative: true}
+
+			running := func(start *models.StartActualLRPRequest) *models.ActualLRPGroup {
+				return &models.ActualLRPGroup{Instance: &models.ActualLRP{
+					ActualLRPKey:         *start.ActualLrpKey,
+					ActualLRPInstanceKey: *start.ActualLrpInstanceKey,
+					ActualLRPNetInfo:     *start.ActualLrpNetInfo,
+					State:                models.ActualLRPStateRunning,
+					Since:                1140,
+				}}
+			}
+			claimed := &models.ActualLRPGroup{Instance: &models.ActualLRP{
+				ActualLRPKey:         *starts[1].ActualLrpKey,
+				ActualLRPInstanceKey: *starts[1].ActualLrpInstanceKey,
+				State:                models.ActualLRPStateClaimed,
+				Since:                1138,
+			}}
+			unreported := models.NewActualLRPKey("other-process-guid", 0, "some-domain")
+
+			result = &models.StartActualLRPsResult{
+				Started: []models.ActualLRPChange{
+					{Before: nil, After: running(starts[0])},
+					{Before: claimed, After: running(starts[1])},
+				},
+				Rejected: []*models.ActualLRPKey{starts[2].ActualLrpKey},
+				Unclaimed: []models.ActualLRPChange{{
+					Before: &models.ActualLRPGroup{Instance: &models.ActualLRP{
+						ActualLRPKey:         unreported,
+						ActualLRPInstanceKey: models.NewActualLRPInstanceKey("other-instance-guid", cellID),
+						State:                models.ActualLRPStateRunning,
+						Since:                1138,
+					}},
+					After: &models.ActualLRPGroup{Instance: &models.ActualLRP{
+						ActualLRPKey: unreported,
+						State:        models.ActualLRPStateUnclaimed,
+						Since:        1140,
+					}},
+				}},
+			}
+			fakeActualLRPDB.StartActualLRPsReturns(result, nil)
+
+			desiredLRP := &models.DesiredLRP{
+				ProcessGuid: "other-process-guid",
+				Domain:      "some-domain",
+				RootFs:      "some-stack",
+				MemoryMb:    128,
+				DiskMb:      512,
+			}
+			schedulingInfo = desiredLRP.DesiredLRPSchedulingInfo()
+			fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			audit.Wrap(auditSink, "StartActualLRPs", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				handler.StartActualLRPs(logger, w, req)
+			})).ServeHTTP(responseRecorder, request)
+
+			response = &models.StartActualLRPsResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("starts the reported LRPs and reports the outcome", func() {
+			Expect(response.Error).To(BeNil())
+			Expect(response.StartedCount).To(BeEquivalentTo(2))
+			Expect(response.RejectedActualLrpKeys).To(Equal([]*models.ActualLRPKey{starts[2].ActualLrpKey}))
+			Expect(response.UnclaimedCount).To(BeEquivalentTo(1))
+
+			Expect(fakeActualLRPDB.StartActualLRPsCallCount()).To(Equal(1))
+			_, actualCellID, actualStarts, authoritative := fakeActualLRPDB.StartActualLRPsArgsForCall(0)
+			Expect(actualCellID).To(Equal(cellID))
+			Expect(actualStarts).To(Equal(starts))
+			Expect(authoritative).To(BeTrue())
+		})
+
+		It("emits a created or changed event for each LRP that changed", func() {
+			Eventually(actualHub.EmitCallCount).Should(Equal(3))
+
+			events := []models.Event{}
+			for i := 0; i < 3; i++ {
+				events = append(events, actualHub.EmitArgsForCall(i))
+			}
+			Expect(events).To(ConsistOf(
+				models.NewActualLRPCreatedEvent(result.Started[0].After),
+				models.NewActualLRPChangedEvent(result.Started[1].Before, result.Started[1].After),
+				models.NewActualLRPChangedEvent(result.Unclaimed[0].Before, result.Unclaimed[0].After),
+			))
+		})
+
+		It("requests auctions for the unclaimed LRPs", func() {
+			Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(2))
+			_, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(1)
+			Expect(filter.ProcessGuids).To(ConsistOf("other-process-guid"))
+
+			Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			expectedStartRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(&schedulingInfo, 0)
+			Expect(fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)).To(Equal([]*auctioneer.LRPStartRequest{&expectedStartRequest}))
+		})
+
+		It("audits the report against the cell", func() {
+			Expect(auditSink.EmitCallCount()).To(Equal(1))
+			record := auditSink.EmitArgsForCall(0)
+			Expect(record.Operation).To(Equal("StartActualLRPs"))
+			Expect(record.Target).To(Equal(cellID))
+			Expect(record.Error).To(BeEmpty())
+		})
+
+		It("checks whether the started leader releases withheld starts", func() {
+			_, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+			Expect(filter.ProcessGuids).To(ConsistOf("process-guid"))
+		})
+
+		Context("when instance 0 of an lrp that starts its leader first is started", func() {
+			BeforeEach(func() {
+				leaderFirst := model_helpers.NewValidDesiredLRP("process-guid").DesiredLRPSchedulingInfo()
+				leaderFirst.StartOrder = models.StartOrder_LeaderFirst
+				fakeDesiredLRPDB.DesiredLRPSchedulingInfosStub = func(_ lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+					if len(filter.ProcessGuids) == 1 && filter.ProcessGuids[0] == "process-guid" {
+						return []*models.DesiredLRPSchedulingInfo{&leaderFirst}, nil
+					}
+					return []*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil
+				}
+
+				withheld := model_helpers.NewValidActualLRP("process-guid", 2)
+				withheld.State = models.ActualLRPStateUnclaimed
+				fakeActualLRPDB.ActualLRPGroupsByProcessGuidReturns([]*models.ActualLRPGroup{
+					result.Started[0].After,
+					{Instance: withheld},
+				}, nil)
+			})
+
+			It("requests the start auctions of the instances that waited for it", func() {
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(2))
+				startAuctions := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+				Expect(startAuctions).To(HaveLen(1))
+				Expect(startAuctions[0].ProcessGuid).To(Equal("process-guid"))
+				Expect(startAuctions[0].Indices).To(Equal([]int{2}))
+			})
+
+			Context("when it was already running", func() {
+				BeforeEach(func() {
+					group := result.Started[0].After
+					result.Started = []models.ActualLRPChange{{Before: group, After: group}}
+					result.Unclaimed = nil
+				})
+
+				It("does not request them again", func() {
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when no LRPs changed", func() {
+			BeforeEach(func() {
+				group := result.Started[0].After
+				fakeActualLRPDB.StartActualLRPsReturns(&models.StartActualLRPsResult{
+					Started: []models.ActualLRPChange{{Before: group, After: group}},
+				}, nil)
+			})
+
+			It("emits no events and does not request auctions", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.StartedCount).To(BeEquivalentTo(1))
+				Consistently(actualHub.EmitCallCount).Should(Equal(0))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when requesting the auctions fails", func() {
+			BeforeEach(func() {
+				fakeAuctioneerClient.RequestLRPAuctionsReturns(errors.New("boom"))
+			})
+
+			It("leaves the LRPs for convergence", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.UnclaimedCount).To(BeEquivalentTo(1))
+			})
+		})
+
+		Context("when a reported LRP is placed on another cell", func() {
+			BeforeEach(func() {
+				instanceKey := models.NewActualLRPInstanceKey("instance-guid", "other-cell-id")
+				starts[0].ActualLrpInstanceKey = &instanceKey
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeActualLRPDB.StartActualLRPsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the DB fails", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.StartActualLRPsReturns(nil, errors.New("boom"))
+			})
+
+			It("responds with the error and audits it", func() {
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Message).To(Equal("boom"))
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+				Expect(auditSink.EmitArgsForCall(0).Error).To(Equal("boom"))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.StartActualLRPsReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
 })
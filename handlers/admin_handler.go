@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type LockHolder interface {
+	HoldsLock() bool
+}
+
+//go:generate counterfeiter -o fake_controllers/fake_lock_releaser.go . LockReleaser
+
+type LockReleaser interface {
+	LockHolder
+	Release(logger lager.Logger) error
+}
+
+type AdminHandler struct {
+	lockReleaser LockReleaser
+	exitChan     chan<- struct{}
+}
+
+func NewAdminHandler(lockReleaser LockReleaser, exitChan chan<- struct{}) *AdminHandler {
+	return &AdminHandler{
+		lockReleaser: lockReleaser,
+		exitChan:     exitChan,
+	}
+}
+
+// ReleaseLock makes this BBS give up the lock and stop writing, so that
+// another instance can take over without this one being restarted. It is only
+// available to clients that authenticated with a certificate.
+func (h *AdminHandler) ReleaseLock(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("release-lock")
+
+	response := &models.ReleaseLockResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	identity, ok := middleware.PeerIdentity(req)
+	if !ok {
+		logger.Error("unauthenticated-request", nil, lager.Data{"remote-addr": req.RemoteAddr})
+		response.Error = models.NewError(models.Error_Unauthorized, "releasing the lock requires a client certificate")
+		return
+	}
+
+	logger = logger.WithData(lager.Data{"requested-by": identity, "remote-addr": req.RemoteAddr})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	err := h.lockReleaser.Release(logger)
+	if err != nil {
+		logger.Error("failed-to-release-lock", err)
+	}
+	response.Error = models.ConvertError(err)
+}
@@ -0,0 +1,100 @@
+package handlers_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/lockmaintainer"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Admin Handler", func() {
+	var (
+		logger           *lagertest.TestLogger
+		lockReleaser     *fake_controllers.FakeLockReleaser
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.AdminHandler
+		exitCh           chan struct{}
+
+		request *http.Request
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		lockReleaser = new(fake_controllers.FakeLockReleaser)
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewAdminHandler(lockReleaser, exitCh)
+
+		request = newTestRequest("")
+		request.TLS = &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{
+				{Subject: pkix.Name{CommonName: "some-operator"}},
+			},
+		}
+	})
+
+	Describe("ReleaseLock", func() {
+		var response *models.ReleaseLockResponse
+
+		JustBeforeEach(func() {
+			handler.ReleaseLock(logger, responseRecorder, request)
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response = &models.ReleaseLockResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("releases the lock", func() {
+			Expect(lockReleaser.ReleaseCallCount()).To(Equal(1))
+			Expect(response.Error).To(BeNil())
+		})
+
+		It("logs the identity of the requester", func() {
+			Expect(logger).To(gbytes.Say("release-lock.starting"))
+			Expect(logger).To(gbytes.Say("some-operator"))
+		})
+
+		Context("when the lock is not held", func() {
+			BeforeEach(func() {
+				lockReleaser.ReleaseReturns(errors.New("not held"))
+			})
+
+			It("responds with the error", func() {
+				Expect(response.Error).To(Equal(models.ConvertError(errors.New("not held"))))
+			})
+		})
+
+		Context("when this instance does not hold the lock", func() {
+			BeforeEach(func() {
+				lockReleaser.ReleaseReturns(lockmaintainer.ErrLockNotHeld)
+			})
+
+			It("responds with a ResourceConflict error", func() {
+				Expect(response.Error.Type).To(Equal(models.Error_ResourceConflict))
+			})
+		})
+
+		Context("when the client did not present a certificate", func() {
+			BeforeEach(func() {
+				request.TLS = nil
+			})
+
+			It("responds with an unauthorized error and does not release the lock", func() {
+				Expect(response.Error.Type).To(Equal(models.Error_Unauthorized))
+				Expect(lockReleaser.ReleaseCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
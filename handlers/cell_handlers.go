@@ -31,6 +31,21 @@ func (h *CellHandler) Cells(logger lager.Logger, w http.ResponseWriter, req *htt
 	}
 	response.Cells = cells
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+// CellPresenceStatuses lists the registered cells with the TTL and expiry of
+// their presences, flagging the presences that are stale.
+func (h *CellHandler) CellPresenceStatuses(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("cell-presence-statuses")
+	response := &models.CellPresenceStatusesResponse{}
+	statuses, err := h.serviceClient.CellPresenceStatuses(logger)
+	if err != nil {
+		logger.Error("failed-fetching-cell-presence-statuses", err)
+	}
+	response.Statuses = statuses
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
@@ -133,4 +133,61 @@ var _ = Describe("Cell Handlers", func() {
 			})
 		})
 	})
+
+	Describe("CellPresenceStatuses", func() {
+		var statuses []*models.CellPresenceStatus
+
+		BeforeEach(func() {
+			statuses = []*models.CellPresenceStatus{
+				{Presence: cells[0], Ttl: 10000000000, ExpiresAt: 1234, Stale: true},
+				{Presence: cells[1], Ttl: 10000000000, ExpiresAt: 5678},
+			}
+		})
+
+		JustBeforeEach(func() {
+			handler.CellPresenceStatuses(logger, responseRecorder, newTestRequest(""))
+		})
+
+		Context("when reading the statuses succeeds", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellPresenceStatusesReturns(statuses, nil)
+			})
+
+			It("returns the statuses", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+				response := &models.CellPresenceStatusesResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Statuses).To(Equal(statuses))
+			})
+		})
+
+		Context("when the serviceClient errors out", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellPresenceStatusesReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := &models.CellPresenceStatusesResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(response.Statuses).To(BeEmpty())
+			})
+		})
+
+		Context("when the serviceClient returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellPresenceStatusesReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("writes to the exit channel", func() {
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
 })
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/bbs"
+)
+
+// wireFormat is the encoding of a request or response body. The models are
+// protobuf messages that also encode to JSON, so every endpoint speaks both.
+type wireFormat int
+
+const (
+	protoFormat wireFormat = iota
+	jsonFormat
+)
+
+func (f wireFormat) contentType() string {
+	if f == jsonFormat {
+		return bbs.JSONContentType
+	}
+	return bbs.ProtoContentType
+}
+
+func parseWireFormat(mediaType string) (wireFormat, bool) {
+	parsed, _, err := mime.ParseMediaType(strings.TrimSpace(mediaType))
+	if err != nil {
+		return protoFormat, false
+	}
+
+	switch parsed {
+	case bbs.JSONContentType:
+		return jsonFormat, true
+	case bbs.ProtoContentType:
+		return protoFormat, true
+	}
+	return protoFormat, false
+}
+
+// requestFormat is the format of the request body. Bodies are protobuf unless
+// their Content-Type is application/json, as existing clients do not always
+// set it.
+func requestFormat(req *http.Request) wireFormat {
+	format, _ := parseWireFormat(req.Header.Get("Content-Type"))
+	return format
+}
+
+// responseFormat is the first of application/json and application/x-protobuf
+// listed in the request's Accept header. Without either, the response is
+// encoded like the request.
+func responseFormat(req *http.Request) wireFormat {
+	for _, accept := range req.Header["Accept"] {
+		for _, mediaType := range strings.Split(accept, ",") {
+			if format, ok := parseWireFormat(mediaType); ok {
+				return format
+			}
+		}
+	}
+
+	return requestFormat(req)
+}
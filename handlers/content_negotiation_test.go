@@ -0,0 +1,193 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Content negotiation", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeDesiredLRPDB *dbfakes.FakeDesiredLRPDB
+		handler          *handlers.DesiredLRPHandler
+		desiredLRP       *models.DesiredLRP
+	)
+
+	newJSONRequest := func(body interface{}) *http.Request {
+		jsonBytes, err := json.Marshal(body)
+		Expect(err).NotTo(HaveOccurred())
+
+		request := newTestRequest(bytes.NewReader(jsonBytes))
+		request.Header.Set("Content-Type", "application/json")
+		return request
+	}
+
+	desire := func(request *http.Request) *httptest.ResponseRecorder {
+		responseRecorder := httptest.NewRecorder()
+		handler.DesireDesiredLRP(logger, responseRecorder, request)
+		return responseRecorder
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
+		handler = handlers.NewDesiredLRPHandler(
+			5,
+			fakeDesiredLRPDB,
+			new(dbfakes.FakeActualLRPDB),
+			new(eventfakes.FakeHub),
+			new(eventfakes.FakeHub),
+			new(auctioneerfakes.FakeClient),
+			fakeRepClientFactory,
+			fakeServiceClient,
+			models.ResourceRequestLimits{},
+			0,
+			make(chan struct{}, 1),
+		)
+
+		desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+		fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(desiredLRP, nil)
+	})
+
+	It("stores the same desired LRP whether it is posted as JSON or as protobuf", func() {
+		protoRecorder := desire(newTestRequest(&models.DesireLRPRequest{DesiredLrp: desiredLRP}))
+		jsonRecorder := desire(newJSONRequest(&models.DesireLRPRequest{DesiredLrp: desiredLRP}))
+
+		Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(2))
+		_, protoDesiredLRP := fakeDesiredLRPDB.DesireLRPArgsForCall(0)
+		_, jsonDesiredLRP := fakeDesiredLRPDB.DesireLRPArgsForCall(1)
+		Expect(jsonDesiredLRP).To(Equal(protoDesiredLRP))
+		Expect(jsonDesiredLRP).To(Equal(desiredLRP))
+
+		Expect(protoRecorder.Header().Get("Content-Type")).To(Equal("application/x-protobuf"))
+		protoResponse := &models.DesiredLRPLifecycleResponse{}
+		Expect(protoResponse.Unmarshal(protoRecorder.Body.Bytes())).To(Succeed())
+		Expect(protoResponse.Error).To(BeNil())
+
+		Expect(jsonRecorder.Header().Get("Content-Type")).To(Equal("application/json"))
+		jsonResponse := &models.DesiredLRPLifecycleResponse{}
+		Expect(json.Unmarshal(jsonRecorder.Body.Bytes(), jsonResponse)).To(Succeed())
+		Expect(jsonResponse.Error).To(BeNil())
+	})
+
+	It("answers in the format the Accept header asks for", func() {
+		request := newTestRequest(&models.DesireLRPRequest{})
+		request.Header.Set("Accept", "text/html, application/json;q=0.9, application/x-protobuf")
+		responseRecorder := desire(request)
+
+		Expect(responseRecorder.Header().Get("Content-Type")).To(Equal("application/json"))
+		response := &models.DesiredLRPLifecycleResponse{}
+		Expect(json.Unmarshal(responseRecorder.Body.Bytes(), response)).To(Succeed())
+		Expect(response.Error).NotTo(BeNil())
+		Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+
+		request = newJSONRequest(&models.DesireLRPRequest{})
+		request.Header.Set("Accept", "application/x-protobuf")
+		responseRecorder = desire(request)
+
+		Expect(responseRecorder.Header().Get("Content-Type")).To(Equal("application/x-protobuf"))
+		response = &models.DesiredLRPLifecycleResponse{}
+		Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+		Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+	})
+
+	It("responds with an invalid JSON error when the JSON body does not parse", func() {
+		request := newTestRequest("{not json")
+		request.Header.Set("Content-Type", "application/json; charset=utf-8")
+		responseRecorder := desire(request)
+
+		response := &models.DesiredLRPLifecycleResponse{}
+		Expect(json.Unmarshal(responseRecorder.Body.Bytes(), response)).To(Succeed())
+		Expect(response.Error.Type).To(Equal(models.Error_InvalidJSON))
+		Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(0))
+	})
+
+	Context("when a list response is streamed", func() {
+		var (
+			controller *fake_controllers.FakeTaskController
+			tasks      []*models.Task
+		)
+
+		listTasks := func() *httptest.ResponseRecorder {
+			request := newJSONRequest(&models.TasksRequest{})
+			responseRecorder := httptest.NewRecorder()
+			handlers.NewTaskHandler(controller, models.ResourceRequestLimits{}, make(chan struct{}, 1)).Tasks(logger, responseRecorder, request)
+			return responseRecorder
+		}
+
+		BeforeEach(func() {
+			controller = new(fake_controllers.FakeTaskController)
+			tasks = []*models.Task{model_helpers.NewValidTask("task-1"), model_helpers.NewValidTask("task-2")}
+			controller.StreamTasksStub = func(_ lager.Logger, _ models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+				for _, task := range tasks {
+					if err := yield(task); err != nil {
+						return nil, err
+					}
+				}
+				return nil, nil
+			}
+		})
+
+		It("writes JSON that decodes as the full response", func() {
+			responseRecorder := listTasks()
+			Expect(responseRecorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			response := &models.TasksResponse{}
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), response)).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Tasks).To(Equal(tasks))
+		})
+
+		It("writes the error after the items", func() {
+			controller.StreamTasksStub = func(_ lager.Logger, _ models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+				Expect(yield(tasks[0])).To(Succeed())
+				return nil, models.ErrUnknownError
+			}
+
+			response := &models.TasksResponse{}
+			Expect(json.Unmarshal(listTasks().Body.Bytes(), response)).To(Succeed())
+			Expect(response.Tasks).To(Equal(tasks[:1]))
+			Expect(response.Error).To(Equal(models.ErrUnknownError))
+		})
+
+		It("writes the record errors after the items", func() {
+			recordErrors := []*models.RecordError{{Guid: "unreadable-guid", Reason: "kaboom"}}
+			controller.StreamTasksStub = func(_ lager.Logger, _ models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+				Expect(yield(tasks[0])).To(Succeed())
+				return recordErrors, nil
+			}
+
+			responseRecorder := listTasks()
+			Expect(responseRecorder.Body.String()).To(ContainSubstring(`],"record_errors":[{"guid":"unreadable-guid","reason":"kaboom"}]}`))
+
+			response := &models.TasksResponse{}
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), response)).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Tasks).To(Equal(tasks[:1]))
+			Expect(response.RecordErrors).To(Equal(recordErrors))
+		})
+
+		It("writes an empty list when there are no items", func() {
+			tasks = nil
+
+			response := &models.TasksResponse{}
+			Expect(json.Unmarshal(listTasks().Body.Bytes(), response)).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Tasks).To(BeEmpty())
+		})
+	})
+})
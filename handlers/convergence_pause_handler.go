@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_convergence_pauser.go . ConvergencePauser
+
+type ConvergencePauser interface {
+	Pause(logger lager.Logger, duration time.Duration) time.Time
+	Resume(logger lager.Logger)
+}
+
+type ConvergencePauseHandler struct {
+	pauser ConvergencePauser
+}
+
+func NewConvergencePauseHandler(pauser ConvergencePauser) *ConvergencePauseHandler {
+	return &ConvergencePauseHandler{
+		pauser: pauser,
+	}
+}
+
+// PauseConvergence stops the scheduled LRP and Task convergence passes of
+// this BBS until ResumeConvergence is called or the pause runs out. Requests
+// to converge LRPs are still served.
+func (h *ConvergencePauseHandler) PauseConvergence(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("pause-convergence")
+
+	request := &models.PauseConvergenceRequest{}
+	response := &models.PauseConvergenceResponse{}
+
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	pausedUntil := h.pauser.Pause(logger, time.Duration(request.Duration))
+	response.PausedUntil = pausedUntil.UnixNano()
+}
+
+func (h *ConvergencePauseHandler) ResumeConvergence(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("resume-convergence")
+
+	response := &models.ResumeConvergenceResponse{}
+
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	h.pauser.Resume(logger)
+}
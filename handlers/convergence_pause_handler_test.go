@@ -0,0 +1,91 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Convergence Pause Handler", func() {
+	var (
+		logger           *lagertest.TestLogger
+		pauser           *fake_controllers.FakeConvergencePauser
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.ConvergencePauseHandler
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		pauser = new(fake_controllers.FakeConvergencePauser)
+		responseRecorder = httptest.NewRecorder()
+		handler = handlers.NewConvergencePauseHandler(pauser)
+	})
+
+	Describe("PauseConvergence", func() {
+		var (
+			requestBody interface{}
+			response    *models.PauseConvergenceResponse
+		)
+
+		JustBeforeEach(func() {
+			handler.PauseConvergence(logger, responseRecorder, newTestRequest(requestBody))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response = &models.PauseConvergenceResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the request is valid", func() {
+			var pausedUntil time.Time
+
+			BeforeEach(func() {
+				requestBody = &models.PauseConvergenceRequest{Duration: int64(10 * time.Minute)}
+				pausedUntil = time.Unix(1000, 0)
+				pauser.PauseReturns(pausedUntil)
+			})
+
+			It("pauses convergence for the duration and returns when the pause ends", func() {
+				Expect(pauser.PauseCallCount()).To(Equal(1))
+				_, duration := pauser.PauseArgsForCall(0)
+				Expect(duration).To(Equal(10 * time.Minute))
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.PausedUntil).To(Equal(pausedUntil.UnixNano()))
+			})
+		})
+
+		Context("when the duration is negative", func() {
+			BeforeEach(func() {
+				requestBody = &models.PauseConvergenceRequest{Duration: -1}
+			})
+
+			It("does not pause convergence", func() {
+				Expect(pauser.PauseCallCount()).To(Equal(0))
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+	})
+
+	Describe("ResumeConvergence", func() {
+		It("resumes convergence", func() {
+			handler.ResumeConvergence(logger, responseRecorder, newTestRequest(""))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.ResumeConvergenceResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(pauser.ResumeCallCount()).To(Equal(1))
+		})
+	})
+})
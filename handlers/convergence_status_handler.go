@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_convergence_status_source.go . ConvergenceStatusSource
+
+type ConvergenceStatusSource interface {
+	ConvergenceStatuses() []*models.ConvergenceStatus
+}
+
+type ConvergenceStatusHandler struct {
+	source ConvergenceStatusSource
+}
+
+func NewConvergenceStatusHandler(source ConvergenceStatusSource) *ConvergenceStatusHandler {
+	return &ConvergenceStatusHandler{
+		source: source,
+	}
+}
+
+// ConvergenceStatus reports when the last LRP and Task convergence passes run
+// by this BBS completed, how long they took and what they did. Types that have
+// not completed a pass since the BBS started are omitted.
+func (h *ConvergenceStatusHandler) ConvergenceStatus(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	response := &models.ConvergenceStatusResponse{}
+	defer func() { writeResponse(w, req, response) }()
+
+	response.Statuses = h.source.ConvergenceStatuses()
+}
@@ -0,0 +1,76 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Convergence Status Handler", func() {
+	var (
+		logger           *lagertest.TestLogger
+		source           *fake_controllers.FakeConvergenceStatusSource
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.ConvergenceStatusHandler
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		source = new(fake_controllers.FakeConvergenceStatusSource)
+		responseRecorder = httptest.NewRecorder()
+		handler = handlers.NewConvergenceStatusHandler(source)
+	})
+
+	Describe("ConvergenceStatus", func() {
+		var response *models.ConvergenceStatusResponse
+
+		JustBeforeEach(func() {
+			handler.ConvergenceStatus(logger, responseRecorder, newTestRequest(""))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response = &models.ConvergenceStatusResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when convergence has completed", func() {
+			var statuses []*models.ConvergenceStatus
+
+			BeforeEach(func() {
+				statuses = []*models.ConvergenceStatus{
+					{
+						Type:        models.ConvergenceTypeLRP,
+						CompletedAt: 1000,
+						Duration:    20,
+						Operations:  map[string]int32{models.ConvergenceOperationLRPsAuctioned: 3},
+					},
+					{
+						Type:        models.ConvergenceTypeTask,
+						CompletedAt: 2000,
+						Duration:    10,
+						Operations:  map[string]int32{models.ConvergenceOperationTasksAuctioned: 1},
+					},
+				}
+				source.ConvergenceStatusesReturns(statuses)
+			})
+
+			It("responds with the status of each type", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.Statuses).To(Equal(statuses))
+			})
+		})
+
+		Context("when no convergence has completed yet", func() {
+			It("responds with no statuses", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.Statuses).To(BeEmpty())
+			})
+		})
+	})
+})
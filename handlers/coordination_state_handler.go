@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type CoordinationStateHandler struct {
+	serviceClient bbs.ServiceClient
+	lockHolder    LockHolder
+	bbsID         string
+	exitChan      chan<- struct{}
+}
+
+func NewCoordinationStateHandler(serviceClient bbs.ServiceClient, lockHolder LockHolder, bbsID string, exitChan chan<- struct{}) *CoordinationStateHandler {
+	return &CoordinationStateHandler{
+		serviceClient: serviceClient,
+		lockHolder:    lockHolder,
+		bbsID:         bbsID,
+		exitChan:      exitChan,
+	}
+}
+
+// CoordinationState reports whether this BBS holds the lock, which BBS holds
+// it according to the store, and every BBS serving reads. It is served by
+// every BBS, whether or not it holds the lock. When part of the state cannot
+// be read, the rest is still reported along with the error.
+func (h *CoordinationStateHandler) CoordinationState(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("coordination-state")
+	response := &models.CoordinationStateResponse{
+		BbsId:     h.bbsID,
+		HoldsLock: h.lockHolder.HoldsLock(),
+	}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	lockHolder, err := h.serviceClient.CurrentBBSStatus(logger)
+	if err != nil && models.ConvertError(err).Type != models.Error_ResourceNotFound {
+		logger.Error("failed-fetching-lock-holder", err)
+		response.Error = models.ConvertError(err)
+	}
+	response.LockHolder = lockHolder
+
+	readPresences, err := h.serviceClient.BBSReadPresenceStatuses(logger)
+	if err != nil {
+		logger.Error("failed-fetching-read-presences", err)
+		if response.Error == nil {
+			response.Error = models.ConvertError(err)
+		}
+	}
+	response.ReadPresences = readPresences
+}
@@ -0,0 +1,126 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs/fake_bbs"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("CoordinationState Handler", func() {
+	var (
+		logger            *lagertest.TestLogger
+		fakeServiceClient *fake_bbs.FakeServiceClient
+		fakeLockHolder    *fake_controllers.FakeLockReleaser
+		responseRecorder  *httptest.ResponseRecorder
+		handler           *handlers.CoordinationStateHandler
+		exitCh            chan struct{}
+		response          *models.CoordinationStateResponse
+
+		lockHolder    *models.BBSPresenceStatus
+		readPresences []*models.BBSPresenceStatus
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeServiceClient = new(fake_bbs.FakeServiceClient)
+		fakeLockHolder = new(fake_controllers.FakeLockReleaser)
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewCoordinationStateHandler(fakeServiceClient, fakeLockHolder, "bbs-2", exitCh)
+
+		bbs1 := models.NewBBSPresence("bbs-1", "https://bbs-1.example.com")
+		bbs2 := models.NewBBSPresence("bbs-2", "https://bbs-2.example.com")
+		lockHolder = models.NewBBSPresenceStatus(&bbs1, "session-1", "bbs-lock", 15*time.Second, 0)
+		readPresences = []*models.BBSPresenceStatus{
+			models.NewBBSPresenceStatus(&bbs1, "session-2", "bbs-read", 15*time.Second, 0),
+			models.NewBBSPresenceStatus(&bbs2, "session-3", "bbs-read", 15*time.Second, 0),
+		}
+
+		fakeServiceClient.CurrentBBSStatusReturns(lockHolder, nil)
+		fakeServiceClient.BBSReadPresenceStatusesReturns(readPresences, nil)
+	})
+
+	JustBeforeEach(func() {
+		request := newTestRequest("")
+		handler.CoordinationState(logger, responseRecorder, request)
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+		response = &models.CoordinationStateResponse{}
+		err := response.Unmarshal(responseRecorder.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when this BBS is a standby", func() {
+		It("reports the lock holder and the read presences", func() {
+			Expect(response.Error).To(BeNil())
+			Expect(response.BbsId).To(Equal("bbs-2"))
+			Expect(response.HoldsLock).To(BeFalse())
+			Expect(response.LockHolder).To(Equal(lockHolder))
+			Expect(response.ReadPresences).To(Equal(readPresences))
+		})
+	})
+
+	Context("when this BBS holds the lock", func() {
+		BeforeEach(func() {
+			fakeLockHolder.HoldsLockReturns(true)
+		})
+
+		It("reports that it holds the lock", func() {
+			Expect(response.HoldsLock).To(BeTrue())
+		})
+	})
+
+	Context("when no BBS holds the lock", func() {
+		BeforeEach(func() {
+			fakeServiceClient.CurrentBBSStatusReturns(nil, models.ErrResourceNotFound)
+		})
+
+		It("reports no lock holder and no error", func() {
+			Expect(response.Error).To(BeNil())
+			Expect(response.LockHolder).To(BeNil())
+			Expect(response.ReadPresences).To(Equal(readPresences))
+		})
+	})
+
+	Context("when the lock holder cannot be read", func() {
+		BeforeEach(func() {
+			fakeServiceClient.CurrentBBSStatusReturns(nil, models.ErrUnknownError)
+		})
+
+		It("reports the error along with the read presences", func() {
+			Expect(response.Error).To(Equal(models.ErrUnknownError))
+			Expect(response.ReadPresences).To(Equal(readPresences))
+		})
+	})
+
+	Context("when the read presences cannot be read", func() {
+		BeforeEach(func() {
+			fakeServiceClient.BBSReadPresenceStatusesReturns(nil, models.ErrUnknownError)
+		})
+
+		It("reports the error along with the lock holder", func() {
+			Expect(response.Error).To(Equal(models.ErrUnknownError))
+			Expect(response.LockHolder).To(Equal(lockHolder))
+		})
+	})
+
+	Context("when the service client returns an unrecoverable error", func() {
+		BeforeEach(func() {
+			fakeServiceClient.BBSReadPresenceStatusesReturns(nil, models.NewUnrecoverableError(nil))
+		})
+
+		It("logs and writes to the exit channel", func() {
+			Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+			Eventually(exitCh).Should(Receive())
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+// contentHashCache remembers the content hash of each DesiredLRP along with
+// its modification tag, which changes whenever the DesiredLRP does, so that
+// DesiredLRPDiff only reads and hashes the DesiredLRPs that changed since the
+// last diff.
+type contentHashCache struct {
+	lock   sync.Mutex
+	hashes map[string]cachedContentHash
+}
+
+type cachedContentHash struct {
+	domain string
+	tag    models.ModificationTag
+	hash   string
+}
+
+func newContentHashCache() *contentHashCache {
+	return &contentHashCache{hashes: map[string]cachedContentHash{}}
+}
+
+// get returns the cached hash of the DesiredLRP, if it was cached with the
+// given modification tag.
+func (c *contentHashCache) get(processGuid string, tag models.ModificationTag) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cached, ok := c.hashes[processGuid]
+	if !ok || cached.tag.Epoch != tag.Epoch || cached.tag.Index != tag.Index {
+		return "", false
+	}
+	return cached.hash, true
+}
+
+func (c *contentHashCache) set(desiredLRP *models.DesiredLRP, hash string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cached := cachedContentHash{domain: desiredLRP.Domain, hash: hash}
+	if desiredLRP.ModificationTag != nil {
+		cached.tag = *desiredLRP.ModificationTag
+	}
+	c.hashes[desiredLRP.ProcessGuid] = cached
+}
+
+// prune forgets the DesiredLRPs of the domain, or of every domain when it is
+// empty, that are no longer stored.
+func (c *contentHashCache) prune(domain string, stored map[string]bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for processGuid, cached := range c.hashes {
+		if (domain == "" || cached.domain == domain) && !stored[processGuid] {
+			delete(c.hashes, processGuid)
+		}
+	}
+}
@@ -2,9 +2,14 @@ package handlers
 
 import (
 	"net/http"
+	"sort"
+	"time"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/auctioneerclient"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
@@ -18,10 +23,14 @@ type DesiredLRPHandler struct {
 	actualLRPDB        db.ActualLRPDB
 	desiredHub         events.Hub
 	actualHub          events.Hub
-	auctioneerClient   auctioneer.Client
+	auctioneerClient   auctioneerclient.Client
 	repClientFactory   rep.ClientFactory
 	serviceClient      bbs.ServiceClient
 	updateWorkersCount int
+	resourceLimits     models.ResourceRequestLimits
+	orphanGracePeriod  time.Duration
+	startOrderGate     *controllers.StartOrderGate
+	contentHashes      *contentHashCache
 	exitChan           chan<- struct{}
 }
 
@@ -31,9 +40,11 @@ func NewDesiredLRPHandler(
 	actualLRPDB db.ActualLRPDB,
 	desiredHub events.Hub,
 	actualHub events.Hub,
-	auctioneerClient auctioneer.Client,
+	auctioneerClient auctioneerclient.Client,
 	repClientFactory rep.ClientFactory,
 	serviceClient bbs.ServiceClient,
+	resourceLimits models.ResourceRequestLimits,
+	orphanGracePeriod time.Duration,
 	exitChan chan<- struct{},
 ) *DesiredLRPHandler {
 	return &DesiredLRPHandler{
@@ -45,6 +56,10 @@ func NewDesiredLRPHandler(
 		repClientFactory:   repClientFactory,
 		serviceClient:      serviceClient,
 		updateWorkersCount: updateWorkersCount,
+		resourceLimits:     resourceLimits,
+		orphanGracePeriod:  orphanGracePeriod,
+		startOrderGate:     controllers.NewStartOrderGate(actualLRPDB, desiredLRPDB),
+		contentHashes:      newContentHashCache(),
 		exitChan:           exitChan,
 	}
 }
@@ -58,12 +73,35 @@ func (h *DesiredLRPHandler) DesiredLRPs(logger lager.Logger, w http.ResponseWrit
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.DesiredLRPFilter{Domain: request.Domain}
-		response.DesiredLrps, err = h.desiredLRPDB.DesiredLRPs(logger, filter)
+		err = h.desiredLRPs(logger, request, response)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+// DesiredLRPsIncludingDeleted is DesiredLRPs that also lists the tombstones
+// of removed DesiredLRPs. It is served on an admin route.
+func (h *DesiredLRPHandler) DesiredLRPsIncludingDeleted(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("desired-lrps-including-deleted")
+
+	request := &models.DesiredLRPsRequest{}
+	response := &models.DesiredLRPsResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		var filter models.DesiredLRPFilter
+		filter, err = desiredLRPFilter(request)
+		if err == nil {
+			filter.IncludeDeleted = true
+			err = h.listDesiredLRPs(logger, filter, response)
+		}
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -76,11 +114,11 @@ func (h *DesiredLRPHandler) DesiredLRPByProcessGuid(logger lager.Logger, w http.
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		response.DesiredLrp, err = h.desiredLRPDB.DesiredLRPByProcessGuid(logger, request.ProcessGuid)
+		err = h.desiredLRPByProcessGuid(logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -89,26 +127,247 @@ func (h *DesiredLRPHandler) DesiredLRPSchedulingInfos(logger lager.Logger, w htt
 	logger = logger.Session("desired-lrp-scheduling-infos")
 
 	request := &models.DesiredLRPsRequest{}
-	response := &models.DesiredLRPSchedulingInfosResponse{}
+	stream := newResponseStream(w, req, "desired_lrp_scheduling_infos")
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.DesiredLRPFilter{Domain: request.Domain}
-		response.DesiredLrpSchedulingInfos, err = h.desiredLRPDB.DesiredLRPSchedulingInfos(logger, filter)
+		if h.respondNotModified(logger, w, req, request) {
+			return
+		}
+
+		var recordErrors []*models.RecordError
+		recordErrors, err = h.streamDesiredLRPSchedulingInfos(logger, request, func(schedulingInfo *models.DesiredLRPSchedulingInfo) error {
+			return stream.WriteItem(schedulingInfo)
+		})
+		stream.WriteRecordErrors(recordErrors)
+	}
+
+	bbsErr := models.ConvertError(err)
+	stream.Finish(logger, bbsErr)
+	exitIfUnrecoverable(logger, h.exitChan, bbsErr)
+}
+
+// DesiredLRPDiff compares the content hashes a client holds with those of the
+// stored DesiredLRPs in the domain, or in every domain when the request has
+// none, so that the client can create, update and remove just the DesiredLRPs
+// that differ. Only the DesiredLRPs that changed since the last diff are read
+// in full and hashed.
+func (h *DesiredLRPHandler) DesiredLRPDiff(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("desired-lrp-diff")
+
+	request := &models.DesiredLRPDiffRequest{}
+	response := &models.DesiredLRPDiffResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		err = h.desiredLRPDiff(logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
+func (h *DesiredLRPHandler) desiredLRPDiff(logger lager.Logger, request *models.DesiredLRPDiffRequest, response *models.DesiredLRPDiffResponse) error {
+	schedulingInfos, err := h.desiredLRPDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{Domain: request.Domain})
+	if err != nil {
+		return err
+	}
+
+	storedHashes := make(map[string]string, len(schedulingInfos))
+	stored := make(map[string]bool, len(schedulingInfos))
+	stale := []string{}
+	for _, schedulingInfo := range schedulingInfos {
+		stored[schedulingInfo.ProcessGuid] = true
+		hash, ok := h.contentHashes.get(schedulingInfo.ProcessGuid, schedulingInfo.ModificationTag)
+		if ok {
+			storedHashes[schedulingInfo.ProcessGuid] = hash
+		} else {
+			stale = append(stale, schedulingInfo.ProcessGuid)
+		}
+	}
+	h.contentHashes.prune(request.Domain, stored)
+
+	if len(stale) > 0 {
+		// reading the whole domain is cheaper than listing most of it
+		filter := models.DesiredLRPFilter{Domain: request.Domain}
+		if len(stale) <= len(schedulingInfos)/2 {
+			filter.ProcessGuids = stale
+		}
+
+		desiredLRPs, err := h.desiredLRPDB.DesiredLRPs(logger, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, desiredLRP := range desiredLRPs {
+			hash, err := desiredLRP.ContentHash()
+			if err != nil {
+				logger.Error("failed-hashing-desired-lrp", err, lager.Data{"process_guid": desiredLRP.ProcessGuid})
+				return models.NewError(models.Error_UnknownError, err.Error())
+			}
+			h.contentHashes.set(desiredLRP, hash)
+			storedHashes[desiredLRP.ProcessGuid] = hash
+		}
+	}
+
+	for _, held := range request.DesiredLrps {
+		storedHash, ok := storedHashes[held.ProcessGuid]
+		switch {
+		case !ok:
+			response.MissingProcessGuids = append(response.MissingProcessGuids, held.ProcessGuid)
+		case storedHash != held.ContentHash:
+			response.ChangedProcessGuids = append(response.ChangedProcessGuids, held.ProcessGuid)
+		}
+		delete(storedHashes, held.ProcessGuid)
+	}
+
+	for processGuid := range storedHashes {
+		response.ExtraProcessGuids = append(response.ExtraProcessGuids, processGuid)
+	}
+
+	sort.Strings(response.MissingProcessGuids)
+	sort.Strings(response.ChangedProcessGuids)
+	sort.Strings(response.ExtraProcessGuids)
+	return nil
+}
+
+// ValidateDesiredLRP checks a DesiredLRP the way DesireDesiredLRP would,
+// including against the resource limits of the BBS, without storing it. The
+// response lists every validation error rather than just the first.
+func (h *DesiredLRPHandler) ValidateDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("validate-desired-lrp")
+
+	request := &models.ValidateDesiredLRPRequest{}
+	response := &models.ValidateDesiredLRPResponse{}
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer writeResponse(w, req, response)
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	desiredLRP := request.DesiredLrp
+	desiredLRP.NormalizeEgressRules()
+
+	var validationError models.ValidationError
+	if err := desiredLRP.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+	if err := h.resourceLimits.Validate(desiredLRP.MemoryMb, desiredLRP.DiskMb); err != nil {
+		validationError = validationError.Append(err)
+	}
+	if err := h.resourceLimits.ValidateInstances(desiredLRP.Instances); err != nil {
+		validationError = validationError.Append(err)
+	}
+
+	for _, err := range validationError {
+		response.ValidationErrors = append(response.ValidationErrors, err.Error())
+	}
+}
+
+// desiredLRPs, desiredLRPByProcessGuid and streamDesiredLRPSchedulingInfos
+// answer a validated request. They are shared by the HTTP and gRPC
+// transports.
+func (h *DesiredLRPHandler) desiredLRPs(logger lager.Logger, request *models.DesiredLRPsRequest, response *models.DesiredLRPsResponse) error {
+	filter, err := desiredLRPFilter(request)
+	if err != nil {
+		return err
+	}
+
+	return h.listDesiredLRPs(logger, filter, response)
+}
+
+// listDesiredLRPs reads the revision before listing, so that a change made
+// while listing is listed again rather than missed by the next listing
+// modified since the revision.
+func (h *DesiredLRPHandler) listDesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter, response *models.DesiredLRPsResponse) error {
+	revision, err := h.desiredLRPDB.DesiredLRPsRevision(logger)
+	if err != nil {
+		logger.Error("failed-fetching-revision", err)
+		return err
+	}
+
+	response.DesiredLrps, response.RecordErrors, err = h.desiredLRPDB.DesiredLRPsWithRecordErrors(logger, filter)
+	if err != nil {
+		return err
+	}
+
+	response.Revision = revision
+	return nil
+}
+
+func (h *DesiredLRPHandler) desiredLRPByProcessGuid(logger lager.Logger, request *models.DesiredLRPByProcessGuidRequest, response *models.DesiredLRPResponse) error {
+	var err error
+	response.DesiredLrp, err = h.desiredLRPDB.DesiredLRPByProcessGuid(logger, request.ProcessGuid)
+	return err
+}
+
+func (h *DesiredLRPHandler) streamDesiredLRPSchedulingInfos(logger lager.Logger, request *models.DesiredLRPsRequest, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error) {
+	filter, err := desiredLRPFilter(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.desiredLRPDB.StreamDesiredLRPSchedulingInfos(logger, filter, yield)
+}
+
+// desiredLRPFilter returns the filter of a listing request. The request has
+// been validated, so its label selector only fails to parse when the request
+// skipped validation.
+func desiredLRPFilter(request *models.DesiredLRPsRequest) (models.DesiredLRPFilter, error) {
+	selector, err := request.ParseLabelSelector()
+	if err != nil {
+		return models.DesiredLRPFilter{}, models.NewError(models.Error_InvalidRequest, err.Error())
+	}
+
+	return models.DesiredLRPFilter{
+		Domain:                request.Domain,
+		ProcessGuids:          request.ProcessGuids,
+		LabelSelector:         selector,
+		ModifiedSinceRevision: request.ModifiedSinceRevision,
+		PartialResults:        request.PartialResults,
+	}, nil
+}
+
+// respondNotModified sets the ETag of the scheduling info listing and, when
+// the client already has that listing, responds with 304 Not Modified without
+// reading it. Failing to read the revision only means the listing is sent in
+// full without an ETag.
+func (h *DesiredLRPHandler) respondNotModified(logger lager.Logger, w http.ResponseWriter, req *http.Request, request *models.DesiredLRPsRequest) bool {
+	revision, err := h.desiredLRPDB.DesiredLRPsRevision(logger)
+	if err != nil {
+		logger.Error("failed-fetching-revision", err)
+		return false
+	}
+
+	etag, err := listingETag(revision, request)
+	if err != nil {
+		logger.Error("failed-computing-etag", err)
+		return false
+	}
+	w.Header().Set(bbs.ETagHeader, etag)
+
+	if etagMatches(req.Header.Get(bbs.IfNoneMatchHeader), etag) {
+		logger.Debug("not-modified", lager.Data{"etag": etag})
+		w.WriteHeader(http.StatusNotModified)
+		return true
+	}
+
+	return false
+}
+
 func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("desire-lrp")
 
 	request := &models.DesireLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -116,7 +375,32 @@ func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.Respons
 		return
 	}
 
-	err = h.desiredLRPDB.DesireLRP(logger, request.DesiredLrp)
+	audit.SetTarget(req, request.DesiredLrp.ProcessGuid)
+
+	err = h.prepareDesiredLRP(logger, request.DesiredLrp)
+	response.Warnings = request.DesiredLrp.Lint()
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	if request.IdempotencyKey == "" {
+		err = h.desiredLRPDB.DesireLRP(logger, request.DesiredLrp)
+	} else {
+		var key models.IdempotencyKey
+		key, err = models.NewIdempotencyKey(request.IdempotencyKey, request.DesiredLrp)
+		if err != nil {
+			logger.Error("failed-fingerprinting-request", err)
+			response.Error = models.ConvertError(err)
+			return
+		}
+
+		var replayed bool
+		replayed, err = h.desiredLRPDB.DesireLRPWithIdempotencyKey(logger, key, request.DesiredLrp)
+		if err == nil && replayed {
+			return
+		}
+	}
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
@@ -128,19 +412,36 @@ func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.Respons
 		return
 	}
 
+	audit.SetModificationTags(req, nil, desiredLRP.ModificationTag)
+
 	go h.desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
 
 	schedulingInfo := request.DesiredLrp.DesiredLRPSchedulingInfo()
 	h.startInstanceRange(logger, 0, schedulingInfo.Instances, &schedulingInfo)
 }
 
+// prepareDesiredLRP normalizes a DesiredLRP that is about to be desired and
+// checks it against the resource limits. Every revision of the desire route
+// goes through it.
+func (h *DesiredLRPHandler) prepareDesiredLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) error {
+	desiredLRP.NormalizeEgressRules()
+
+	err := validateResourceLimits(logger, h.resourceLimits, desiredLRP.MemoryMb, desiredLRP.DiskMb)
+	if err != nil {
+		return err
+	}
+
+	return validateInstanceLimit(logger, h.resourceLimits, desiredLRP.Instances)
+}
+
 func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("update-desired-lrp")
 
 	request := &models.UpdateDesiredLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -149,8 +450,18 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 		return
 	}
 
+	audit.SetTarget(req, request.ProcessGuid)
+
 	logger = logger.WithData(lager.Data{"guid": request.ProcessGuid})
 
+	if request.Update.Instances != nil {
+		err = h.validateInstanceUpdate(logger, request.ProcessGuid, *request.Update.Instances)
+		if err != nil {
+			response.Error = models.ConvertError(err)
+			return
+		}
+	}
+
 	logger.Debug("updating-desired-lrp")
 	beforeDesiredLRP, err := h.desiredLRPDB.UpdateDesiredLRP(logger, request.ProcessGuid, request.Update)
 	if err != nil {
@@ -166,6 +477,9 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 		return
 	}
 
+	audit.SetModificationTags(req, beforeDesiredLRP.ModificationTag, desiredLRP.ModificationTag)
+	response.Warnings = desiredLRP.Lint()
+
 	if request.Update.Instances != nil {
 		logger.Debug("updating-lrp-instances")
 		previousInstanceCount := beforeDesiredLRP.Instances
@@ -189,19 +503,44 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 	go h.desiredHub.Emit(models.NewDesiredLRPChangedEvent(beforeDesiredLRP, desiredLRP))
 }
 
+// validateInstanceUpdate enforces the instance limit on an update. An update
+// that does not raise the instance count is always allowed, so that an LRP
+// desired above the limit, e.g. before the limit was set, can still be scaled
+// down.
+func (h *DesiredLRPHandler) validateInstanceUpdate(logger lager.Logger, processGuid string, instances int32) error {
+	if h.resourceLimits.ValidateInstances(instances) == nil {
+		return nil
+	}
+
+	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger, processGuid)
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		return err
+	}
+
+	if instances <= desiredLRP.Instances {
+		return nil
+	}
+
+	return validateInstanceLimit(logger, h.resourceLimits, instances)
+}
+
 func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("remove-desired-lrp")
 
 	request := &models.RemoveDesiredLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
+
+	audit.SetTarget(req, request.ProcessGuid)
 	logger = logger.WithData(lager.Data{"process_guid": request.ProcessGuid})
 
 	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger.Session("fetch-desired"), request.ProcessGuid)
@@ -216,8 +555,18 @@ func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.Respons
 		return
 	}
 
+	audit.SetModificationTags(req, desiredLRP.ModificationTag, nil)
+
 	go h.desiredHub.Emit(models.NewDesiredLRPRemovedEvent(desiredLRP))
 
+	// when asked to, and there is a grace period, the instances are left for
+	// convergence to retire once they have been orphaned for it, so that
+	// re-creating the DesiredLRP in time keeps them
+	if request.KeepInstances && h.orphanGracePeriod > 0 {
+		logger.Info("leaving-instances-for-orphan-grace-period", lager.Data{"grace_period": h.orphanGracePeriod.String()})
+		return
+	}
+
 	h.stopInstancesFrom(logger, request.ProcessGuid, 0)
 }
 
@@ -235,11 +584,16 @@ func (h *DesiredLRPHandler) startInstanceRange(logger lager.Logger, lower, upper
 	}
 
 	createdIndices := h.createUnclaimedActualLRPs(logger, keys)
-	start := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedulingInfo, createdIndices...)
+	startIndices := h.startOrderGate.Indices(logger, schedulingInfo, createdIndices)
+	if len(startIndices) == 0 && len(createdIndices) > 0 {
+		// every created instance waits for instance 0 to be running
+		return
+	}
+	start := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedulingInfo, startIndices...)
 
-	logger.Info("start-lrp-auction-request", lager.Data{"app_guid": schedulingInfo.ProcessGuid, "indices": createdIndices})
+	logger.Info("start-lrp-auction-request", lager.Data{"app_guid": schedulingInfo.ProcessGuid, "indices": startIndices})
 	err := h.auctioneerClient.RequestLRPAuctions([]*auctioneer.LRPStartRequest{&start})
-	logger.Info("finished-lrp-auction-request", lager.Data{"app_guid": schedulingInfo.ProcessGuid, "indices": createdIndices})
+	logger.Info("finished-lrp-auction-request", lager.Data{"app_guid": schedulingInfo.ProcessGuid, "indices": startIndices})
 	if err != nil {
 		logger.Error("failed-to-request-auction", err)
 	}
@@ -1,9 +1,9 @@
 package handlers
 
 import (
-	"io/ioutil"
 	"net/http"
 
+	"code.cloudfoundry.org/bbs/audit"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
@@ -32,7 +32,7 @@ func (h *DesiredLRPHandler) DesiredLRPs_r0(logger lager.Logger, w http.ResponseW
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -59,7 +59,7 @@ func (h *DesiredLRPHandler) DesiredLRPs_r1(logger lager.Logger, w http.ResponseW
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -82,7 +82,7 @@ func (h *DesiredLRPHandler) DesiredLRPByProcessGuid_r0(logger lager.Logger, w ht
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -105,7 +105,7 @@ func (h *DesiredLRPHandler) DesiredLRPByProcessGuid_r1(logger lager.Logger, w ht
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -115,7 +115,8 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r1(logger lager.Logger, w http.Resp
 	request := &models.DesireLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequestForDesireDesiredLRP_r1(logger, req, request)
 	if err != nil {
@@ -123,6 +124,14 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r1(logger lager.Logger, w http.Resp
 		return
 	}
 
+	audit.SetTarget(req, request.DesiredLrp.ProcessGuid)
+
+	err = h.prepareDesiredLRP(logger, request.DesiredLrp)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.desiredLRPDB.DesireLRP(logger, request.DesiredLrp)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -135,6 +144,8 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r1(logger lager.Logger, w http.Resp
 		return
 	}
 
+	audit.SetModificationTags(req, nil, desiredLRP.ModificationTag)
+
 	go h.desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
 
 	schedulingInfo := request.DesiredLrp.DesiredLRPSchedulingInfo()
@@ -142,10 +153,9 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r1(logger lager.Logger, w http.Resp
 }
 
 func parseRequestForDesireDesiredLRP_r1(logger lager.Logger, req *http.Request, request *models.DesireLRPRequest) error {
-	data, err := ioutil.ReadAll(req.Body)
+	data, err := readRequestBody(logger, req)
 	if err != nil {
-		logger.Error("failed-to-read-body", err)
-		return models.ErrUnknownError
+		return err
 	}
 
 	err = request.Unmarshal(data)
@@ -172,7 +182,8 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r0(logger lager.Logger, w http.Resp
 	request := &models.DesireLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequestForDesireDesiredLRP_r0(logger, req, request)
 	if err != nil {
@@ -180,6 +191,14 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r0(logger lager.Logger, w http.Resp
 		return
 	}
 
+	audit.SetTarget(req, request.DesiredLrp.ProcessGuid)
+
+	err = h.prepareDesiredLRP(logger, request.DesiredLrp)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.desiredLRPDB.DesireLRP(logger, request.DesiredLrp)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -192,6 +211,8 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r0(logger lager.Logger, w http.Resp
 		return
 	}
 
+	audit.SetModificationTags(req, nil, desiredLRP.ModificationTag)
+
 	go h.desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
 
 	schedulingInfo := request.DesiredLrp.DesiredLRPSchedulingInfo()
@@ -199,10 +220,9 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r0(logger lager.Logger, w http.Resp
 }
 
 func parseRequestForDesireDesiredLRP_r0(logger lager.Logger, req *http.Request, request *models.DesireLRPRequest) error {
-	data, err := ioutil.ReadAll(req.Body)
+	data, err := readRequestBody(logger, req)
 	if err != nil {
-		logger.Error("failed-to-read-body", err)
-		return models.ErrUnknownError
+		return err
 	}
 
 	err = request.Unmarshal(data)
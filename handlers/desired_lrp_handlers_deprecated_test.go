@@ -53,7 +53,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			desiredHub,
 			actualHub,
 			fakeAuctioneerClient,
-			nil, nil, exitCh)
+			nil, nil, models.ResourceRequestLimits{}, 0, exitCh)
 	})
 
 	Describe("DesiredLRPs_r0", func() {
@@ -910,5 +910,20 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(response.Error).To(BeNil())
 			})
 		})
+
+		Context("when the egress rules are not in canonical form", func() {
+			BeforeEach(func() {
+				desiredLRP.EgressRules = []*models.SecurityGroupRule{
+					{Protocol: models.AllProtocol, Destinations: []string{"10.1.2.3/8", "10.0.0.0/8", "10.0.0.5-10.0.0.5"}},
+				}
+				fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(expectedDesiredLRP, nil)
+			})
+
+			It("stores them normalized", func() {
+				Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(1))
+				_, actualDesiredLRP := fakeDesiredLRPDB.DesireLRPArgsForCall(0)
+				Expect(actualDesiredLRP.EgressRules[0].Destinations).To(Equal([]string{"10.0.0.0/8", "10.0.0.5"}))
+			})
+		})
 	})
 })
@@ -1,12 +1,16 @@
 package handlers_test
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/audit/auditfakes"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/handlers"
@@ -58,6 +62,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			fakeAuctioneerClient,
 			fakeRepClientFactory,
 			fakeServiceClient,
+			models.ResourceRequestLimits{},
+			0,
 			exitCh,
 		)
 	})
@@ -81,7 +87,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			BeforeEach(func() {
 				desiredLRPs = []*models.DesiredLRP{&desiredLRP1, &desiredLRP2}
-				fakeDesiredLRPDB.DesiredLRPsReturns(desiredLRPs, nil)
+				fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsReturns(desiredLRPs, nil, nil)
 			})
 
 			It("returns a list of desired lrp groups", func() {
@@ -96,8 +102,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			Context("and no filter is provided", func() {
 				It("call the DB with no filters to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsArgsForCall(0)
 					Expect(filter).To(Equal(models.DesiredLRPFilter{}))
 				})
 			})
@@ -108,16 +114,137 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the domain filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsArgsForCall(0)
 					Expect(filter.Domain).To(Equal("domain-1"))
 				})
 			})
+
+			Context("and filtering by process guids", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{ProcessGuids: []string{"guid-1", "guid-2"}}
+				})
+
+				It("call the DB with the process guid filter to retrieve the desired lrps", func() {
+					Expect(fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsArgsForCall(0)
+					Expect(filter.ProcessGuids).To(ConsistOf("guid-1", "guid-2"))
+				})
+			})
+
+			Context("and filtering by label selector", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{Selector: "team=payments,env in (prod,staging)"}
+				})
+
+				It("call the DB with the parsed label selector to retrieve the desired lrps", func() {
+					Expect(fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsArgsForCall(0)
+					Expect(filter.LabelSelector).To(Equal(models.LabelSelector{
+						{Key: "team", Operator: models.LabelOperatorIn, Values: []string{"payments"}},
+						{Key: "env", Operator: models.LabelOperatorIn, Values: []string{"prod", "staging"}},
+					}))
+				})
+			})
+
+			Context("and filtering by modified since revision", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{ModifiedSinceRevision: 41}
+				})
+
+				It("call the DB with the modified since revision to retrieve the desired lrps", func() {
+					Expect(fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsArgsForCall(0)
+					Expect(filter.ModifiedSinceRevision).To(BeEquivalentTo(41))
+				})
+			})
+
+			Context("and the revision is read", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.DesiredLRPsRevisionReturns(42, nil)
+				})
+
+				It("returns the revision", func() {
+					response := models.DesiredLRPsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Revision).To(BeEquivalentTo(42))
+				})
+			})
+
+			Context("and reading the revision fails", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.DesiredLRPsRevisionReturns(0, models.ErrUnknownError)
+				})
+
+				It("responds with the error without listing the desired lrps", func() {
+					Expect(fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsCallCount()).To(Equal(0))
+
+					response := models.DesiredLRPsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Error).To(Equal(models.ErrUnknownError))
+				})
+			})
+
+			Context("and filtering by the deprecated label selector map", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{LabelSelector: map[string]string{"team": "payments"}, Selector: "!deprecated"}
+				})
+
+				It("call the DB with both label selectors combined", func() {
+					Expect(fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsArgsForCall(0)
+					Expect(filter.LabelSelector).To(Equal(models.LabelSelector{
+						{Key: "team", Operator: models.LabelOperatorIn, Values: []string{"payments"}},
+						{Key: "deprecated", Operator: models.LabelOperatorDoesNotExist},
+					}))
+				})
+			})
+
+			Context("and the label selector is malformed", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{Selector: "team in (payments"}
+				})
+
+				It("responds with an invalid request error without reading the DB", func() {
+					Expect(fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsCallCount()).To(Equal(0))
+
+					response := models.DesiredLRPsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+					Expect(response.Error.Message).To(ContainSubstring("invalid label selector"))
+				})
+			})
+		})
+
+		Context("when partial results are requested", func() {
+			var recordErrors []*models.RecordError
+
+			BeforeEach(func() {
+				requestBody = &models.DesiredLRPsRequest{PartialResults: true}
+				recordErrors = []*models.RecordError{{Guid: "unreadable-guid", Reason: "kaboom"}}
+				fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsReturns([]*models.DesiredLRP{&desiredLRP1}, recordErrors, nil)
+			})
+
+			It("asks the DB for partial results and returns the record errors with the readable desired lrps", func() {
+				_, filter := fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsArgsForCall(0)
+				Expect(filter.PartialResults).To(BeTrue())
+
+				response := models.DesiredLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.DesiredLrps).To(Equal([]*models.DesiredLRP{&desiredLRP1}))
+				Expect(response.RecordErrors).To(Equal(recordErrors))
+			})
 		})
 
 		Context("when the DB returns no desired lrp groups", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, nil)
+				fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsReturns([]*models.DesiredLRP{}, nil, nil)
 			})
 
 			It("returns an empty list", func() {
@@ -133,7 +260,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, models.NewUnrecoverableError(nil))
+				fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsReturns([]*models.DesiredLRP{}, nil, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -144,7 +271,67 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, models.ErrUnknownError)
+				fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsReturns([]*models.DesiredLRP{}, nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DesiredLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
+	Describe("DesiredLRPsIncludingDeleted", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			requestBody = &models.DesiredLRPsRequest{Domain: "domain-1", ProcessGuids: []string{"guid-1"}}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.DesiredLRPsIncludingDeleted(logger, responseRecorder, request)
+		})
+
+		Context("when reading desired lrps from DB succeeds", func() {
+			var desiredLRPs []*models.DesiredLRP
+
+			BeforeEach(func() {
+				desiredLRPs = []*models.DesiredLRP{
+					{ProcessGuid: "guid-1"},
+					{ProcessGuid: "guid-1", DeletedAt: 1234},
+				}
+				fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsReturns(desiredLRPs, nil, nil)
+			})
+
+			It("asks the DB to include the deleted desired lrps", func() {
+				Expect(fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsCallCount()).To(Equal(1))
+				_, filter := fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsArgsForCall(0)
+				Expect(filter).To(Equal(models.DesiredLRPFilter{
+					Domain:         "domain-1",
+					ProcessGuids:   []string{"guid-1"},
+					IncludeDeleted: true,
+				}))
+			})
+
+			It("returns the desired lrps and their tombstones", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DesiredLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.DesiredLrps).To(Equal(desiredLRPs))
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPsWithRecordErrorsReturns(nil, nil, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -241,30 +428,392 @@ var _ = Describe("DesiredLRP Handlers", func() {
 		})
 	})
 
+	Describe("DesiredLRPDiff", func() {
+		var (
+			requestBody                  interface{}
+			unchanged, changed, extraLRP *models.DesiredLRP
+			unchangedHash, changedHash   string
+		)
+
+		contentHash := func(desiredLRP *models.DesiredLRP) string {
+			hash, err := desiredLRP.ContentHash()
+			Expect(err).NotTo(HaveOccurred())
+			return hash
+		}
+
+		BeforeEach(func() {
+			unchanged = model_helpers.NewValidDesiredLRP("unchanged")
+			changed = model_helpers.NewValidDesiredLRP("changed")
+			extraLRP = model_helpers.NewValidDesiredLRP("extra")
+			unchangedHash = contentHash(unchanged)
+			changedHash = contentHash(changed)
+			changed.Instances++
+
+			fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{unchanged, changed, extraLRP}, nil)
+			schedulingInfos := []*models.DesiredLRPSchedulingInfo{}
+			for _, desiredLRP := range []*models.DesiredLRP{unchanged, changed, extraLRP} {
+				schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+				schedulingInfos = append(schedulingInfos, &schedulingInfo)
+			}
+			fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns(schedulingInfos, nil)
+
+			requestBody = &models.DesiredLRPDiffRequest{
+				Domain: "some-domain",
+				DesiredLrps: []*models.DesiredLRPContentHash{
+					{ProcessGuid: "unchanged", ContentHash: unchangedHash},
+					{ProcessGuid: "changed", ContentHash: changedHash},
+					{ProcessGuid: "missing-2", ContentHash: "some-hash"},
+					{ProcessGuid: "missing-1", ContentHash: "some-hash"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.DesiredLRPDiff(logger, responseRecorder, request)
+		})
+
+		It("reads the desired lrps in the domain", func() {
+			Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+			_, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+			Expect(filter).To(Equal(models.DesiredLRPFilter{Domain: "some-domain"}))
+
+			Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
+			_, filter = fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+			Expect(filter).To(Equal(models.DesiredLRPFilter{Domain: "some-domain"}))
+		})
+
+		Context("when the desired lrps were hashed by an earlier diff", func() {
+			diffAgain := func() *models.DesiredLRPDiffResponse {
+				recorder := httptest.NewRecorder()
+				handler.DesiredLRPDiff(logger, recorder, newTestRequest(requestBody))
+
+				response := &models.DesiredLRPDiffResponse{}
+				Expect(response.Unmarshal(recorder.Body.Bytes())).To(Succeed())
+				return response
+			}
+
+			It("does not read them again while they are unchanged", func() {
+				response := diffAgain()
+				Expect(response.Error).To(BeNil())
+				Expect(response.ChangedProcessGuids).To(Equal([]string{"changed"}))
+				Expect(response.ExtraProcessGuids).To(Equal([]string{"extra"}))
+				Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
+			})
+
+			It("reads and hashes only the ones whose modification tag changed", func() {
+				changed.Instances--
+				changed.ModificationTag = &models.ModificationTag{Epoch: changed.ModificationTag.Epoch, Index: changed.ModificationTag.Index + 1}
+				schedulingInfos := []*models.DesiredLRPSchedulingInfo{}
+				for _, desiredLRP := range []*models.DesiredLRP{unchanged, changed, extraLRP} {
+					schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+					schedulingInfos = append(schedulingInfos, &schedulingInfo)
+				}
+				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns(schedulingInfos, nil)
+				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{changed}, nil)
+
+				response := diffAgain()
+				Expect(response.Error).To(BeNil())
+				Expect(response.ChangedProcessGuids).To(BeEmpty())
+				Expect(response.ExtraProcessGuids).To(Equal([]string{"extra"}))
+
+				Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(2))
+				_, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(1)
+				Expect(filter).To(Equal(models.DesiredLRPFilter{Domain: "some-domain", ProcessGuids: []string{"changed"}}))
+			})
+		})
+
+		It("returns the missing, changed and extra process guids", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := models.DesiredLRPDiffResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.MissingProcessGuids).To(Equal([]string{"missing-1", "missing-2"}))
+			Expect(response.ChangedProcessGuids).To(Equal([]string{"changed"}))
+			Expect(response.ExtraProcessGuids).To(Equal([]string{"extra"}))
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.DesiredLRPDiffRequest{
+					DesiredLrps: []*models.DesiredLRPContentHash{{ProcessGuid: "some-guid"}},
+				}
+			})
+
+			It("responds with an invalid request error without reading the desired lrps", func() {
+				Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(0))
+				Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(0))
+
+				response := models.DesiredLRPDiffResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPsReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := models.DesiredLRPDiffResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(response.MissingProcessGuids).To(BeEmpty())
+			})
+		})
+
+		Context("when reading the scheduling infos fails", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information without reading the desired lrps", func() {
+				response := models.DesiredLRPDiffResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ValidateDesiredLRP", func() {
+		var (
+			requestBody interface{}
+			desiredLRP  *models.DesiredLRP
+		)
+
+		BeforeEach(func() {
+			desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+			requestBody = &models.ValidateDesiredLRPRequest{DesiredLrp: desiredLRP}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.ValidateDesiredLRP(logger, responseRecorder, request)
+		})
+
+		validationErrors := func() []string {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := models.ValidateDesiredLRPResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			return response.ValidationErrors
+		}
+
+		Context("when the desired lrp is valid", func() {
+			It("responds without validation errors", func() {
+				Expect(validationErrors()).To(BeEmpty())
+			})
+
+			It("does not touch the store", func() {
+				Expect(fakeDesiredLRPDB.Invocations()).To(BeEmpty())
+				Expect(fakeActualLRPDB.Invocations()).To(BeEmpty())
+			})
+		})
+
+		Context("when the desired lrp is invalid in several ways", func() {
+			BeforeEach(func() {
+				desiredLRP.Domain = ""
+				desiredLRP.RootFs = ""
+				desiredLRP.Instances = -1
+			})
+
+			It("lists every validation error", func() {
+				errs := validationErrors()
+				Expect(errs).To(ContainElement(ContainSubstring("domain")))
+				Expect(errs).To(ContainElement(ContainSubstring("rootfs")))
+				Expect(errs).To(ContainElement(ContainSubstring("instances")))
+			})
+
+			It("does not touch the store", func() {
+				Expect(fakeDesiredLRPDB.Invocations()).To(BeEmpty())
+			})
+		})
+
+		Context("when the desired lrp exceeds the resource limits", func() {
+			BeforeEach(func() {
+				desiredLRP.MemoryMb = 1024
+				desiredLRP.DiskMb = 8192
+
+				handler = handlers.NewDesiredLRPHandler(
+					5,
+					fakeDesiredLRPDB,
+					fakeActualLRPDB,
+					desiredHub,
+					actualHub,
+					fakeAuctioneerClient,
+					fakeRepClientFactory,
+					fakeServiceClient,
+					models.ResourceRequestLimits{MaxMemoryMb: 512, MaxDiskMb: 4096},
+					0,
+					exitCh,
+				)
+			})
+
+			It("lists both limits", func() {
+				errs := validationErrors()
+				Expect(errs).To(ContainElement(ContainSubstring("memory_mb")))
+				Expect(errs).To(ContainElement(ContainSubstring("disk_mb")))
+			})
+		})
+
+		Context("when the desired lrp exceeds the instance limit", func() {
+			BeforeEach(func() {
+				desiredLRP.Instances = 11
+
+				handler = handlers.NewDesiredLRPHandler(
+					5,
+					fakeDesiredLRPDB,
+					fakeActualLRPDB,
+					desiredHub,
+					actualHub,
+					fakeAuctioneerClient,
+					fakeRepClientFactory,
+					fakeServiceClient,
+					models.ResourceRequestLimits{MaxInstances: 10},
+					0,
+					exitCh,
+				)
+			})
+
+			It("lists the instance limit", func() {
+				Expect(validationErrors()).To(ContainElement(ContainSubstring("instances")))
+			})
+		})
+
+		Context("when the request has no desired lrp", func() {
+			BeforeEach(func() {
+				requestBody = &models.ValidateDesiredLRPRequest{}
+			})
+
+			It("responds with an invalid request error", func() {
+				response := models.ValidateDesiredLRPResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+	})
+
 	Describe("DesiredLRPSchedulingInfos", func() {
 		var (
 			requestBody     interface{}
+			ifNoneMatch     string
 			schedulingInfo1 models.DesiredLRPSchedulingInfo
 			schedulingInfo2 models.DesiredLRPSchedulingInfo
 		)
 
 		BeforeEach(func() {
 			requestBody = &models.DesiredLRPsRequest{}
+			ifNoneMatch = ""
 			schedulingInfo1 = models.DesiredLRPSchedulingInfo{}
 			schedulingInfo2 = models.DesiredLRPSchedulingInfo{}
+			fakeDesiredLRPDB.DesiredLRPsRevisionReturns(42, nil)
 		})
 
 		JustBeforeEach(func() {
 			request := newTestRequest(requestBody)
+			if ifNoneMatch != "" {
+				request.Header.Set("If-None-Match", ifNoneMatch)
+			}
 			handler.DesiredLRPSchedulingInfos(logger, responseRecorder, request)
 		})
 
+		Context("when the client has not seen the listing before", func() {
+			It("responds with the listing and its ETag", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(responseRecorder.Header().Get("ETag")).To(HavePrefix(`"42-`))
+				Expect(fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the client sends the ETag of the current listing", func() {
+			BeforeEach(func() {
+				recorder := httptest.NewRecorder()
+				handler.DesiredLRPSchedulingInfos(logger, recorder, newTestRequest(requestBody))
+				ifNoneMatch = recorder.Header().Get("ETag")
+				Expect(ifNoneMatch).NotTo(BeEmpty())
+				responseRecorder = httptest.NewRecorder()
+			})
+
+			It("responds with 304 without reading the scheduling infos", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusNotModified))
+				Expect(responseRecorder.Header().Get("ETag")).To(Equal(ifNoneMatch))
+				Expect(responseRecorder.Body.Len()).To(BeZero())
+				Expect(fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+			})
+
+			Context("and the desired LRPs have changed since", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.DesiredLRPsRevisionReturns(43, nil)
+				})
+
+				It("responds with the listing and the new ETag", func() {
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(responseRecorder.Header().Get("ETag")).To(HavePrefix(`"43-`))
+					Expect(fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("but for a different filter", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{Domain: "domain-1"}
+				})
+
+				It("responds with the listing", func() {
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(responseRecorder.Header().Get("ETag")).NotTo(Equal(ifNoneMatch))
+				})
+			})
+		})
+
+		Context("when reading the revision fails", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPsRevisionReturns(0, models.ErrUnknownError)
+				ifNoneMatch = "*"
+			})
+
+			It("responds with the listing without an ETag", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(responseRecorder.Header().Get("ETag")).To(BeEmpty())
+				Expect(fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when reading scheduling infos from DB succeeds", func() {
 			var schedulingInfos []*models.DesiredLRPSchedulingInfo
 
 			BeforeEach(func() {
 				schedulingInfos = []*models.DesiredLRPSchedulingInfo{&schedulingInfo1, &schedulingInfo2}
-				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns(schedulingInfos, nil)
+				fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosStub = func(_ lager.Logger, _ models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error) {
+					for _, schedulingInfo := range schedulingInfos {
+						err := yield(schedulingInfo)
+						if err != nil {
+							return nil, err
+						}
+					}
+					return nil, nil
+				}
 			})
 
 			It("returns a list of desired lrp groups", func() {
@@ -279,8 +828,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			Context("and no filter is provided", func() {
 				It("call the DB with no filters to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
-					_, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+					Expect(fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+					_, filter, _ := fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosArgsForCall(0)
 					Expect(filter).To(Equal(models.DesiredLRPFilter{}))
 				})
 			})
@@ -291,16 +840,67 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the domain filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
-					_, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+					Expect(fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+					_, filter, _ := fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosArgsForCall(0)
 					Expect(filter.Domain).To(Equal("domain-1"))
 				})
 			})
+
+			Context("and filtering by process guids", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{ProcessGuids: []string{"guid-1", "guid-2"}}
+				})
+
+				It("call the DB with the process guid filter to retrieve the desired lrps", func() {
+					Expect(fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+					_, filter, _ := fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosArgsForCall(0)
+					Expect(filter.ProcessGuids).To(ConsistOf("guid-1", "guid-2"))
+				})
+			})
+
+			Context("and filtering by label selector", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{Selector: "team!=payments"}
+				})
+
+				It("call the DB with the parsed label selector to retrieve the scheduling infos", func() {
+					Expect(fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+					_, filter, _ := fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosArgsForCall(0)
+					Expect(filter.LabelSelector).To(Equal(models.LabelSelector{
+						{Key: "team", Operator: models.LabelOperatorNotIn, Values: []string{"payments"}},
+					}))
+				})
+			})
+		})
+
+		Context("when partial results are requested", func() {
+			var recordErrors []*models.RecordError
+
+			BeforeEach(func() {
+				requestBody = &models.DesiredLRPsRequest{PartialResults: true}
+				recordErrors = []*models.RecordError{{Guid: "unreadable-guid", Reason: "kaboom"}}
+				fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosStub = func(_ lager.Logger, _ models.DesiredLRPFilter, yield func(*models.DesiredLRPSchedulingInfo) error) ([]*models.RecordError, error) {
+					return recordErrors, yield(&schedulingInfo1)
+				}
+			})
+
+			It("asks the DB for partial results and returns the record errors with the scheduling infos", func() {
+				_, filter, _ := fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosArgsForCall(0)
+				Expect(filter.PartialResults).To(BeTrue())
+
+				response := models.DesiredLRPSchedulingInfosResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.DesiredLrpSchedulingInfos).To(Equal([]*models.DesiredLRPSchedulingInfo{&schedulingInfo1}))
+				Expect(response.RecordErrors).To(Equal(recordErrors))
+			})
 		})
 
 		Context("when the DB returns no desired lrp groups", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, nil)
+				fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosReturns(nil, nil)
 			})
 
 			It("returns an empty list", func() {
@@ -316,7 +916,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, models.NewUnrecoverableError(nil))
+				fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosReturns(nil, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -327,7 +927,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, models.ErrUnknownError)
+				fakeDesiredLRPDB.StreamDesiredLRPSchedulingInfosReturns(nil, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -361,6 +961,110 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			handler.DesireDesiredLRP(logger, responseRecorder, request)
 		})
 
+		Context("when the desired lrp exceeds the configured resource limits", func() {
+			BeforeEach(func() {
+				handler = handlers.NewDesiredLRPHandler(
+					5,
+					fakeDesiredLRPDB,
+					fakeActualLRPDB,
+					desiredHub,
+					actualHub,
+					fakeAuctioneerClient,
+					fakeRepClientFactory,
+					fakeServiceClient,
+					models.ResourceRequestLimits{MaxMemoryMb: 512, MaxDiskMb: 4096},
+					0,
+					exitCh,
+				)
+			})
+
+			It("rejects the request with an invalid request error naming the field", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(response.Error.Message).To(ContainSubstring("memory_mb"))
+				Expect(response.Error.Message).NotTo(ContainSubstring("disk_mb"))
+			})
+
+			It("does not desire the lrp", func() {
+				Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an instance limit is configured", func() {
+			BeforeEach(func() {
+				handler = handlers.NewDesiredLRPHandler(
+					5,
+					fakeDesiredLRPDB,
+					fakeActualLRPDB,
+					desiredHub,
+					actualHub,
+					fakeAuctioneerClient,
+					fakeRepClientFactory,
+					fakeServiceClient,
+					models.ResourceRequestLimits{MaxInstances: 5},
+					0,
+					exitCh,
+				)
+				fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(desiredLRP, nil)
+			})
+
+			Context("when the desired lrp is below the limit", func() {
+				BeforeEach(func() {
+					desiredLRP.Instances = 4
+				})
+
+				It("desires the lrp", func() {
+					Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the desired lrp is at the limit", func() {
+				It("desires the lrp", func() {
+					Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the desired lrp is above the limit", func() {
+				BeforeEach(func() {
+					desiredLRP.Instances = 6
+				})
+
+				It("rejects the request with an invalid request error naming the field", func() {
+					response := &models.DesiredLRPLifecycleResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).NotTo(BeNil())
+					Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+					Expect(response.Error.Message).To(ContainSubstring("instances"))
+				})
+
+				It("does not desire the lrp", func() {
+					Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the egress rules are not in canonical form", func() {
+			BeforeEach(func() {
+				desiredLRP.EgressRules = []*models.SecurityGroupRule{
+					{Protocol: models.AllProtocol, Destinations: []string{"10.1.2.3/8", "10.0.0.0/8", "10.0.0.5-10.0.0.5"}},
+				}
+				fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(desiredLRP, nil)
+			})
+
+			It("stores them normalized", func() {
+				Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(1))
+				_, actualDesiredLRP := fakeDesiredLRPDB.DesireLRPArgsForCall(0)
+				Expect(actualDesiredLRP.EgressRules[0].Destinations).To(Equal([]string{"10.0.0.0/8", "10.0.0.5"}))
+			})
+		})
+
 		Context("when creating desired lrp in DB succeeds", func() {
 			var createdActualLRPGroups []*models.ActualLRPGroup
 
@@ -390,6 +1094,25 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(response.Error).To(BeNil())
+				Expect(response.Warnings).To(BeEmpty())
+			})
+
+			Context("when the desired lrp has lint warnings", func() {
+				BeforeEach(func() {
+					desiredLRP.MemoryMb = 16
+				})
+
+				It("desires the lrp and reports the warnings", func() {
+					Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(1))
+
+					response := models.DesiredLRPLifecycleResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(BeNil())
+					Expect(response.Warnings).To(Equal(desiredLRP.Lint()))
+					Expect(response.Warnings).NotTo(BeEmpty())
+				})
 			})
 
 			It("emits a create event to the hub", func() {
@@ -460,6 +1183,37 @@ var _ = Describe("DesiredLRP Handlers", func() {
 					Expect(startAuctions[0].Resource).To(Equal(expectedStartRequest.Resource))
 				})
 			})
+
+			Context("when the desired lrp starts its leader first", func() {
+				BeforeEach(func() {
+					desiredLRP.StartOrder = models.StartOrder_LeaderFirst
+					fakeActualLRPDB.ActualLRPGroupByProcessGuidAndIndexReturns(createdActualLRPGroups[0], nil)
+				})
+
+				It("creates every actual lrp", func() {
+					Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(5))
+				})
+
+				Context("when instance 0 is not running", func() {
+					BeforeEach(func() {
+						createdActualLRPGroups[0].Instance.State = models.ActualLRPStateUnclaimed
+					})
+
+					It("only requests the start auction of instance 0", func() {
+						Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+						startAuctions := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+						Expect(startAuctions).To(HaveLen(1))
+						Expect(startAuctions[0].Indices).To(Equal([]int{0}))
+					})
+				})
+
+				Context("when instance 0 is running", func() {
+					It("requests the start auctions of every instance", func() {
+						startAuctions := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+						Expect(startAuctions[0].Indices).To(ConsistOf(0, 1, 2, 3, 4))
+					})
+				})
+			})
 		})
 
 		Context("when the DB returns an unrecoverable error", func() {
@@ -491,6 +1245,75 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
 			})
 		})
+
+		Context("when the request has an idempotency key", func() {
+			BeforeEach(func() {
+				requestBody.(*models.DesireLRPRequest).IdempotencyKey = "retry-key"
+				fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(desiredLRP, nil)
+			})
+
+			It("desires the lrp under the key, fingerprinting the desired lrp", func() {
+				Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(0))
+				Expect(fakeDesiredLRPDB.DesireLRPWithIdempotencyKeyCallCount()).To(Equal(1))
+
+				_, key, actualDesiredLRP := fakeDesiredLRPDB.DesireLRPWithIdempotencyKeyArgsForCall(0)
+				Expect(actualDesiredLRP).To(Equal(desiredLRP))
+
+				expectedKey, err := models.NewIdempotencyKey("retry-key", desiredLRP)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(key).To(Equal(expectedKey))
+			})
+
+			Context("when the lrp is created", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.DesireLRPWithIdempotencyKeyReturns(false, nil)
+				})
+
+				It("creates the actual lrps and emits a create event", func() {
+					Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(5))
+					Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+				})
+			})
+
+			Context("when the request is a replay", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.DesireLRPWithIdempotencyKeyReturns(true, nil)
+				})
+
+				It("succeeds", func() {
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					response := models.DesiredLRPLifecycleResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(BeNil())
+				})
+
+				It("does not create actual lrps, emit events or request auctions again", func() {
+					Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
+					Consistently(desiredHub.EmitCallCount).Should(Equal(0))
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the key was used for a different request", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.DesireLRPWithIdempotencyKeyReturns(false, models.ErrIdempotencyKeyConflict)
+				})
+
+				It("responds with the conflict", func() {
+					response := models.DesiredLRPLifecycleResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(Equal(models.ErrIdempotencyKeyConflict))
+				})
+
+				It("does not try to create actual LRPs", func() {
+					Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 
 	Describe("UpdateDesiredLRP", func() {
@@ -499,6 +1322,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			update           *models.DesiredLRPUpdate
 			beforeDesiredLRP *models.DesiredLRP
 			afterDesiredLRP  *models.DesiredLRP
+			auditSink        *auditfakes.FakeSink
 
 			requestBody interface{}
 		)
@@ -510,6 +1334,9 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			beforeDesiredLRP.Instances = 4
 			afterDesiredLRP = model_helpers.NewValidDesiredLRP(processGuid)
 			afterDesiredLRP.Annotation = someText
+			beforeDesiredLRP.ModificationTag = &models.ModificationTag{Epoch: "some-epoch", Index: 1}
+			afterDesiredLRP.ModificationTag = &models.ModificationTag{Epoch: "some-epoch", Index: 2}
+			auditSink = new(auditfakes.FakeSink)
 
 			update = &models.DesiredLRPUpdate{
 				Annotation: &someText,
@@ -522,7 +1349,132 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		JustBeforeEach(func() {
 			request := newTestRequest(requestBody)
-			handler.UpdateDesiredLRP(logger, responseRecorder, request)
+			audit.Wrap(auditSink, "UpdateDesiredLRP", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				handler.UpdateDesiredLRP(logger, w, req)
+			})).ServeHTTP(responseRecorder, request)
+		})
+
+		Context("when an instance limit is configured", func() {
+			var instances int32
+
+			BeforeEach(func() {
+				handler = handlers.NewDesiredLRPHandler(
+					5,
+					fakeDesiredLRPDB,
+					fakeActualLRPDB,
+					desiredHub,
+					actualHub,
+					fakeAuctioneerClient,
+					fakeRepClientFactory,
+					fakeServiceClient,
+					models.ResourceRequestLimits{MaxInstances: 10},
+					0,
+					exitCh,
+				)
+
+				fakeDesiredLRPDB.UpdateDesiredLRPReturns(beforeDesiredLRP, nil)
+				fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(beforeDesiredLRP, nil)
+			})
+
+			responseError := func() *models.Error {
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				return response.Error
+			}
+
+			Context("when the update raises the instances below the limit", func() {
+				BeforeEach(func() {
+					instances = 9
+					update.Instances = &instances
+				})
+
+				It("updates the desired lrp", func() {
+					Expect(responseError()).To(BeNil())
+					Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the update raises the instances to the limit", func() {
+				BeforeEach(func() {
+					instances = 10
+					update.Instances = &instances
+				})
+
+				It("updates the desired lrp", func() {
+					Expect(responseError()).To(BeNil())
+					Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the update raises the instances above the limit", func() {
+				BeforeEach(func() {
+					instances = 11
+					update.Instances = &instances
+				})
+
+				It("rejects the request with an invalid request error naming the field", func() {
+					Expect(responseError().Type).To(Equal(models.Error_InvalidRequest))
+					Expect(responseError().Message).To(ContainSubstring("instances"))
+					Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the desired lrp is already above the limit", func() {
+				BeforeEach(func() {
+					beforeDesiredLRP.Instances = 20
+				})
+
+				Context("when the update reduces the instances below the limit", func() {
+					BeforeEach(func() {
+						instances = 5
+						update.Instances = &instances
+					})
+
+					It("updates the desired lrp", func() {
+						Expect(responseError()).To(BeNil())
+						Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the update reduces the instances but stays above the limit", func() {
+					BeforeEach(func() {
+						instances = 15
+						update.Instances = &instances
+					})
+
+					It("updates the desired lrp", func() {
+						Expect(responseError()).To(BeNil())
+						Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the update raises the instances further", func() {
+					BeforeEach(func() {
+						instances = 21
+						update.Instances = &instances
+					})
+
+					It("rejects the request with an invalid request error naming the field", func() {
+						Expect(responseError().Type).To(Equal(models.Error_InvalidRequest))
+						Expect(responseError().Message).To(ContainSubstring("instances"))
+						Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when fetching the desired lrp fails", func() {
+				BeforeEach(func() {
+					instances = 11
+					update.Instances = &instances
+					fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(nil, models.ErrResourceNotFound)
+				})
+
+				It("responds with the error and does not update the desired lrp", func() {
+					Expect(responseError()).To(Equal(models.ErrResourceNotFound))
+					Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(0))
+				})
+			})
 		})
 
 		Context("when updating desired lrp in DB succeeds", func() {
@@ -544,6 +1496,31 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(response.Error).To(BeNil())
 			})
 
+			Context("when the updated desired lrp has lint warnings", func() {
+				BeforeEach(func() {
+					afterDesiredLRP.Monitor = nil
+				})
+
+				It("reports the warnings of the updated desired lrp", func() {
+					response := models.DesiredLRPLifecycleResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Error).To(BeNil())
+					Expect(response.Warnings).To(Equal(afterDesiredLRP.Lint()))
+					Expect(response.Warnings).NotTo(BeEmpty())
+				})
+			})
+
+			It("audits the update", func() {
+				Expect(auditSink.EmitCallCount()).To(Equal(1))
+				record := auditSink.EmitArgsForCall(0)
+				Expect(record.Operation).To(Equal("UpdateDesiredLRP"))
+				Expect(record.Target).To(Equal(processGuid))
+				Expect(record.Index).To(BeNil())
+				Expect(record.BeforeModificationTag).To(Equal(&audit.ModificationTag{Epoch: "some-epoch", Index: 1}))
+				Expect(record.AfterModificationTag).To(Equal(&audit.ModificationTag{Epoch: "some-epoch", Index: 2}))
+			})
+
 			It("emits a create event to the hub", func(done Done) {
 				Eventually(desiredHub.EmitCallCount).Should(Equal(1))
 				event := desiredHub.EmitArgsForCall(0)
@@ -554,6 +1531,56 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				close(done)
 			})
 
+			Context("when only the routes are updated", func() {
+				BeforeEach(func() {
+					routeMessage := json.RawMessage(`[{"port":8080,"hostnames":["new-route"]}]`)
+					update = &models.DesiredLRPUpdate{
+						Routes: &models.Routes{"cf-router": &routeMessage},
+					}
+					requestBody = &models.UpdateDesiredLRPRequest{
+						ProcessGuid: processGuid,
+						Update:      update,
+					}
+				})
+
+				It("passes only the routes to the DB", func() {
+					Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(1))
+					_, _, actualUpdate := fakeDesiredLRPDB.UpdateDesiredLRPArgsForCall(0)
+					Expect(actualUpdate.Routes).To(Equal(update.Routes))
+					Expect(actualUpdate.Instances).To(BeNil())
+					Expect(actualUpdate.Annotation).To(BeNil())
+				})
+
+				It("does not start or stop any instances", func() {
+					Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+					Expect(fakeActualLRPDB.ActualLRPGroupsByProcessGuidCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when instances, routes and annotation are updated together", func() {
+				BeforeEach(func() {
+					instances := int32(4)
+					annotation := "new-annotation"
+					routeMessage := json.RawMessage(`[{"port":8080,"hostnames":["new-route"]}]`)
+					update = &models.DesiredLRPUpdate{
+						Instances:  &instances,
+						Routes:     &models.Routes{"cf-router": &routeMessage},
+						Annotation: &annotation,
+					}
+					requestBody = &models.UpdateDesiredLRPRequest{
+						ProcessGuid: processGuid,
+						Update:      update,
+					}
+				})
+
+				It("passes every field to the DB in a single update", func() {
+					Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(1))
+					_, _, actualUpdate := fakeDesiredLRPDB.UpdateDesiredLRPArgsForCall(0)
+					Expect(actualUpdate).To(Equal(update))
+				})
+			})
+
 			Context("when the number of instances changes", func() {
 				BeforeEach(func() {
 					instances := int32(3)
@@ -757,6 +1784,25 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(response.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the update sets no fields", func() {
+			BeforeEach(func() {
+				requestBody = &models.UpdateDesiredLRPRequest{
+					ProcessGuid: processGuid,
+					Update:      &models.DesiredLRPUpdate{},
+				}
+			})
+
+			It("responds with an invalid request error without updating the DB", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(0))
+			})
+		})
 	})
 
 	Describe("RemoveDesiredLRP", func() {
@@ -854,6 +1900,60 @@ var _ = Describe("DesiredLRP Handlers", func() {
 					fakeActualLRPDB.ActualLRPGroupsByProcessGuidReturns(actualLRPGroups, nil)
 				})
 
+				Context("when the BBS has an orphan grace period", func() {
+					BeforeEach(func() {
+						handler = handlers.NewDesiredLRPHandler(
+							5,
+							fakeDesiredLRPDB,
+							fakeActualLRPDB,
+							desiredHub,
+							actualHub,
+							fakeAuctioneerClient,
+							fakeRepClientFactory,
+							fakeServiceClient,
+							models.ResourceRequestLimits{},
+							time.Minute,
+							exitCh,
+						)
+					})
+
+					It("still stops the running actual lrps", func() {
+						Expect(fakeDesiredLRPDB.RemoveDesiredLRPCallCount()).To(Equal(1))
+						Expect(fakeActualLRPDB.ActualLRPGroupsByProcessGuidCallCount()).To(Equal(1))
+						Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(2))
+					})
+
+					Context("when the request keeps the instances", func() {
+						BeforeEach(func() {
+							requestBody = &models.RemoveDesiredLRPRequest{
+								ProcessGuid:   processGuid,
+								KeepInstances: true,
+							}
+						})
+
+						It("removes the desired lrp but leaves its instances for convergence", func() {
+							Expect(fakeDesiredLRPDB.RemoveDesiredLRPCallCount()).To(Equal(1))
+							Expect(fakeActualLRPDB.ActualLRPGroupsByProcessGuidCallCount()).To(Equal(0))
+							Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(0))
+							Expect(fakeActualLRPDB.RemoveActualLRPCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				Context("when the request keeps the instances but the BBS has no orphan grace period", func() {
+					BeforeEach(func() {
+						requestBody = &models.RemoveDesiredLRPRequest{
+							ProcessGuid:   processGuid,
+							KeepInstances: true,
+						}
+					})
+
+					It("stops the running actual lrps", func() {
+						Expect(fakeActualLRPDB.ActualLRPGroupsByProcessGuidCallCount()).To(Equal(1))
+						Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(2))
+					})
+				})
+
 				It("stops all of the corresponding running actual lrps", func() {
 					Expect(fakeActualLRPDB.ActualLRPGroupsByProcessGuidCallCount()).To(Equal(1))
 
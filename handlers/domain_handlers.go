@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"code.cloudfoundry.org/bbs/audit"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
@@ -33,7 +34,17 @@ func (h *DomainHandler) Domains(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.DomainsResponse{}
 	response.Domains, err = h.db.Domains(logger)
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *DomainHandler) DomainFreshness(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("domain-freshness")
+	response := &models.DomainFreshnessResponse{}
+	response.Domains, err = h.db.DomainFreshness(logger)
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -46,10 +57,71 @@ func (h *DomainHandler) Upsert(logger lager.Logger, w http.ResponseWriter, req *
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
+		audit.SetTarget(req, request.Domain)
 		err = h.db.UpsertDomain(logger, request.Domain, request.Ttl)
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	audit.SetError(req, response.Error)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
+
+// UpsertDomains upserts a batch of domains in one request. Entries that fail
+// validation are reported in their result and do not keep the others from
+// being upserted.
+func (h *DomainHandler) UpsertDomains(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("upsert-domains")
+	request := &models.UpsertDomainsRequest{}
+	response := &models.UpsertDomainsResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.Results = make([]*models.UpsertDomainResult, len(request.Domains))
+	valid := make([]*models.UpsertDomainRequest, 0, len(request.Domains))
+	validIndices := make([]int, 0, len(request.Domains))
+	for i, domain := range request.Domains {
+		response.Results[i] = &models.UpsertDomainResult{Domain: domain.GetDomain()}
+
+		if domain == nil {
+			err = models.ValidationError{models.ErrInvalidField{Field: "domains"}}
+		} else {
+			err = domain.ValidateInBatch()
+		}
+		if err != nil {
+			logger.Error("invalid-domain", err, lager.Data{"index": i})
+			response.Results[i].Error = models.NewError(models.Error_InvalidRequest, err.Error())
+			continue
+		}
+
+		valid = append(valid, domain)
+		validIndices = append(validIndices, i)
+	}
+
+	failed := len(request.Domains) - len(valid)
+	if len(valid) > 0 {
+		errs := h.db.UpsertDomains(logger, valid)
+		for i, err := range errs {
+			if err == nil {
+				continue
+			}
+
+			modelErr := models.ConvertError(err)
+			response.Results[validIndices[i]].Error = modelErr
+			failed++
+			if modelErr.Type == models.Error_Unrecoverable {
+				response.Error = modelErr
+			}
+		}
+	}
+
+	logger.Info("upserted-domains", lager.Data{"upserted": len(request.Domains) - failed, "failed": failed})
+}
@@ -1,12 +1,15 @@
 package handlers_test
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -138,6 +141,194 @@ var _ = Describe("Domain Handlers", func() {
 				Expect(upsertDomainResponse.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the DB returns an error without a type", func() {
+			BeforeEach(func() {
+				fakeDomainDB.UpsertDomainReturns(errors.New("boom"))
+			})
+
+			It("responds with an unknown error", func() {
+				var upsertDomainResponse models.UpsertDomainResponse
+				err := upsertDomainResponse.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(upsertDomainResponse.Error).To(Equal(models.NewError(models.Error_UnknownError, "boom")))
+			})
+		})
+
+		Context("when the request body is larger than the maximum request body size", func() {
+			BeforeEach(func() {
+				requestBody = limitedRequestBody(&models.UpsertDomainRequest{Domain: domain, Ttl: ttl}, 4)
+			})
+
+			It("responds with a request entity too large error without upserting the domain", func() {
+				var upsertDomainResponse models.UpsertDomainResponse
+				err := upsertDomainResponse.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(upsertDomainResponse.Error).To(Equal(models.ErrRequestEntityTooLarge))
+				Expect(fakeDomainDB.UpsertDomainCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the JSON body cannot be decoded", func() {
+			It("responds with an invalid JSON error", func() {
+				request := newTestRequest("{not json")
+				request.Header.Set("Content-Type", "application/json")
+				recorder := httptest.NewRecorder()
+				handler.Upsert(logger, recorder, request)
+
+				var upsertDomainResponse models.UpsertDomainResponse
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &upsertDomainResponse)).To(Succeed())
+				Expect(upsertDomainResponse.Error.Type).To(Equal(models.Error_InvalidJSON))
+			})
+		})
+	})
+
+	Describe("UpsertDomains", func() {
+		var response *models.UpsertDomainsResponse
+
+		BeforeEach(func() {
+			requestBody = &models.UpsertDomainsRequest{
+				Domains: []*models.UpsertDomainRequest{
+					{Domain: "domain-1", Ttl: 60},
+					{Domain: "domain-2"},
+				},
+			}
+			fakeDomainDB.UpsertDomainsStub = func(_ lager.Logger, domains []*models.UpsertDomainRequest) []error {
+				return make([]error, len(domains))
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.UpsertDomains(logger, responseRecorder, request)
+
+			response = &models.UpsertDomainsResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when upserting the domains succeeds", func() {
+			It("upserts every domain in one call to the DB", func() {
+				Expect(fakeDomainDB.UpsertDomainsCallCount()).To(Equal(1))
+				_, domains := fakeDomainDB.UpsertDomainsArgsForCall(0)
+				Expect(domains).To(Equal([]*models.UpsertDomainRequest{
+					{Domain: "domain-1", Ttl: 60},
+					{Domain: "domain-2"},
+				}))
+			})
+
+			It("responds with a result per domain and no error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(response.Error).To(BeNil())
+				Expect(response.Results).To(Equal([]*models.UpsertDomainResult{
+					{Domain: "domain-1"},
+					{Domain: "domain-2"},
+				}))
+			})
+		})
+
+		Context("when some of the domains are invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.UpsertDomainsRequest{
+					Domains: []*models.UpsertDomainRequest{
+						{Domain: ""},
+						{Domain: "domain-1", Ttl: 60},
+						{Domain: "domain/2"},
+						{Domain: "domain-3", Ttl: models.MaxDomainTTL + 1},
+						{Domain: "domain-4"},
+					},
+				}
+			})
+
+			It("upserts only the valid domains", func() {
+				Expect(fakeDomainDB.UpsertDomainsCallCount()).To(Equal(1))
+				_, domains := fakeDomainDB.UpsertDomainsArgsForCall(0)
+				Expect(domains).To(Equal([]*models.UpsertDomainRequest{
+					{Domain: "domain-1", Ttl: 60},
+					{Domain: "domain-4"},
+				}))
+			})
+
+			It("reports the invalid domains in their results, in request order", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.Results).To(HaveLen(5))
+
+				for _, i := range []int{0, 2, 3} {
+					Expect(response.Results[i].Error).NotTo(BeNil())
+					Expect(response.Results[i].Error.Type).To(Equal(models.Error_InvalidRequest))
+				}
+				Expect(response.Results[2].Domain).To(Equal("domain/2"))
+				Expect(response.Results[3].Domain).To(Equal("domain-3"))
+
+				Expect(response.Results[1]).To(Equal(&models.UpsertDomainResult{Domain: "domain-1"}))
+				Expect(response.Results[4]).To(Equal(&models.UpsertDomainResult{Domain: "domain-4"}))
+			})
+		})
+
+		Context("when every domain is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.UpsertDomainsRequest{
+					Domains: []*models.UpsertDomainRequest{{Domain: ""}},
+				}
+			})
+
+			It("does not call the DB", func() {
+				Expect(fakeDomainDB.UpsertDomainsCallCount()).To(Equal(0))
+				Expect(response.Results[0].Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the batch is too large", func() {
+			BeforeEach(func() {
+				domains := make([]*models.UpsertDomainRequest, models.MaxUpsertDomainsBatchSize+1)
+				for i := range domains {
+					domains[i] = &models.UpsertDomainRequest{Domain: "domain"}
+				}
+				requestBody = &models.UpsertDomainsRequest{Domains: domains}
+			})
+
+			It("rejects the whole request", func() {
+				Expect(fakeDomainDB.UpsertDomainsCallCount()).To(Equal(0))
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(response.Results).To(BeEmpty())
+			})
+		})
+
+		Context("when parsing the body fails", func() {
+			BeforeEach(func() {
+				requestBody = "beep boop beep boop -- i am a robot"
+			})
+
+			It("responds with an error", func() {
+				Expect(response.Error).To(Equal(models.ErrBadRequest))
+			})
+		})
+
+		Context("when the DB fails to upsert some of the domains", func() {
+			BeforeEach(func() {
+				fakeDomainDB.UpsertDomainsReturns([]error{models.ErrUnknownError, nil})
+			})
+
+			It("reports the error in the failed domain's result", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(response.Results[0].Error).To(Equal(models.ErrUnknownError))
+				Expect(response.Results[1].Error).To(BeNil())
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDomainDB.UpsertDomainsReturns([]error{models.NewUnrecoverableError(nil), nil})
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
 	})
 
 	Describe("Domains", func() {
@@ -217,4 +408,68 @@ var _ = Describe("Domain Handlers", func() {
 			})
 		})
 	})
+
+	Describe("DomainFreshness", func() {
+		var freshness []*models.DomainFreshness
+
+		BeforeEach(func() {
+			freshness = []*models.DomainFreshness{
+				{Domain: "domain-a", ExpireTime: 1000, TtlRemaining: 30},
+				{Domain: "domain-b"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			handler.DomainFreshness(logger, responseRecorder, newTestRequest(""))
+		})
+
+		Context("when reading domain freshness from DB succeeds", func() {
+			BeforeEach(func() {
+				fakeDomainDB.DomainFreshnessReturns(freshness, nil)
+			})
+
+			It("calls the DB to retrieve the domain freshness", func() {
+				Expect(fakeDomainDB.DomainFreshnessCallCount()).To(Equal(1))
+			})
+
+			It("returns the freshness of each domain", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+				response := &models.DomainFreshnessResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Domains).To(Equal(freshness))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDomainDB.DomainFreshnessReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeDomainDB.DomainFreshnessReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+				response := &models.DomainFreshnessResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(response.Domains).To(BeNil())
+			})
+		})
+	})
 })
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+// listingETag identifies a listing by the revision it was read at and the
+// request it answers, so that listings with different filters never share
+// an ETag.
+func listingETag(revision uint64, request marshaler) (string, error) {
+	requestBytes, err := request.Marshal()
+	if err != nil {
+		return "", err
+	}
+
+	hash := fnv.New64a()
+	hash.Write(requestBytes)
+	return fmt.Sprintf(`"%d-%x"`, revision, hash.Sum64()), nil
+}
+
+// etagMatches reports whether an If-None-Match header value names the etag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,9 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/auctioneerclient"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
@@ -16,7 +19,8 @@ type EvacuationHandler struct {
 	actualLRPDB      db.ActualLRPDB
 	desiredLRPDB     db.DesiredLRPDB
 	actualHub        events.Hub
-	auctioneerClient auctioneer.Client
+	auctioneerClient auctioneerclient.Client
+	startOrderGate   *controllers.StartOrderGate
 	exitChan         chan<- struct{}
 }
 
@@ -25,7 +29,7 @@ func NewEvacuationHandler(
 	actualLRPDB db.ActualLRPDB,
 	desiredLRPDB db.DesiredLRPDB,
 	actualHub events.Hub,
-	auctioneerClient auctioneer.Client,
+	auctioneerClient auctioneerclient.Client,
 	exitChan chan<- struct{},
 ) *EvacuationHandler {
 	return &EvacuationHandler{
@@ -34,6 +38,7 @@ func NewEvacuationHandler(
 		desiredLRPDB:     desiredLRPDB,
 		actualHub:        actualHub,
 		auctioneerClient: auctioneerClient,
+		startOrderGate:   controllers.NewStartOrderGate(actualLRPDB, desiredLRPDB),
 		exitChan:         exitChan,
 	}
 }
@@ -54,7 +59,8 @@ func (h *EvacuationHandler) RemoveEvacuatingActualLRP(logger lager.Logger, w htt
 	response := &models.RemoveEvacuatingActualLRPResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -62,6 +68,8 @@ func (h *EvacuationHandler) RemoveEvacuatingActualLRP(logger lager.Logger, w htt
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+
 	beforeActualLRPGroup, err := h.actualLRPDB.ActualLRPGroupByProcessGuidAndIndex(logger, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -85,7 +93,8 @@ func (h *EvacuationHandler) EvacuateClaimedActualLRP(logger lager.Logger, w http
 	request := &models.EvacuateClaimedActualLRPRequest{}
 	response := &models.EvacuationResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -95,6 +104,8 @@ func (h *EvacuationHandler) EvacuateClaimedActualLRP(logger lager.Logger, w http
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+
 	beforeActualLRPGroup, err := h.actualLRPDB.ActualLRPGroupByProcessGuidAndIndex(logger, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
 	if err == nil {
 		err = h.db.RemoveEvacuatingActualLRP(logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
@@ -123,7 +134,8 @@ func (h *EvacuationHandler) EvacuateCrashedActualLRP(logger lager.Logger, w http
 	request := &models.EvacuateCrashedActualLRPRequest{}
 	response := &models.EvacuationResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -132,6 +144,8 @@ func (h *EvacuationHandler) EvacuateCrashedActualLRP(logger lager.Logger, w http
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+
 	beforeActualLRPGroup, err := h.actualLRPDB.ActualLRPGroupByProcessGuidAndIndex(logger, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
 	if err == nil {
 		err = h.db.RemoveEvacuatingActualLRP(logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
@@ -158,7 +172,8 @@ func (h *EvacuationHandler) EvacuateRunningActualLRP(logger lager.Logger, w http
 	response := &models.EvacuationResponse{}
 	response.KeepContainer = true
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	request := &models.EvacuateRunningActualLRPRequest{}
 	err := parseRequest(logger, req, request)
@@ -167,6 +182,8 @@ func (h *EvacuationHandler) EvacuateRunningActualLRP(logger lager.Logger, w http
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+
 	guid := request.ActualLrpKey.ProcessGuid
 	index := request.ActualLrpKey.Index
 	actualLRPGroup, err := h.actualLRPDB.ActualLRPGroupByProcessGuidAndIndex(logger, guid, index)
@@ -271,7 +288,8 @@ func (h *EvacuationHandler) EvacuateStoppedActualLRP(logger lager.Logger, w http
 	var bbsErr *models.Error
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, bbsErr) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -281,6 +299,8 @@ func (h *EvacuationHandler) EvacuateStoppedActualLRP(logger lager.Logger, w http
 		return
 	}
 
+	audit.SetTargetInstance(req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+
 	guid := request.ActualLrpKey.ProcessGuid
 	index := request.ActualLrpKey.Index
 
@@ -332,7 +352,12 @@ func (h *EvacuationHandler) unclaimAndRequestAuction(logger lager.Logger, lrpKey
 	}
 
 	schedInfo := desiredLRP.DesiredLRPSchedulingInfo()
-	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(&schedInfo, int(lrpKey.Index))
+	indices := h.startOrderGate.Indices(logger, &schedInfo, []int{int(lrpKey.Index)})
+	if len(indices) == 0 {
+		return nil
+	}
+
+	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(&schedInfo, indices...)
 	err = h.auctioneerClient.RequestLRPAuctions([]*auctioneer.LRPStartRequest{&startRequest})
 	if err != nil {
 		logger.Error("failed-requesting-auction", err)
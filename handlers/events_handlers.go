@@ -1,36 +1,144 @@
 package handlers
 
 import (
+	"compress/gzip"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
 
+// EventSubscriberRetryAfterSeconds is the Retry-After sent with the
+// TooManySubscribers error.
+const EventSubscriberRetryAfterSeconds = 5
+
 type EventHandler struct {
-	desiredHub events.Hub
-	actualHub  events.Hub
+	desiredHub     events.Hub
+	actualHub      events.Hub
+	maxSubscribers int
+
+	// subscribeLock makes checking the number of subscribers and subscribing
+	// a single step, so that concurrent subscriptions cannot exceed
+	// maxSubscribers.
+	subscribeLock sync.Mutex
 }
 
-func NewEventHandler(desiredHub, actualHub events.Hub) *EventHandler {
+// NewEventHandler returns a handler that refuses new subscriptions with
+// ErrTooManySubscribers while the hubs already have maxSubscribers
+// subscribers. A maxSubscribers of 0 or less disables the limit.
+func NewEventHandler(desiredHub, actualHub events.Hub, maxSubscribers int) *EventHandler {
 	return &EventHandler{
-		desiredHub: desiredHub,
-		actualHub:  actualHub,
+		desiredHub:     desiredHub,
+		actualHub:      actualHub,
+		maxSubscribers: maxSubscribers,
+	}
+}
+
+// writeTooManySubscribers answers a subscription refused because the event
+// hubs have too many subscribers.
+func writeTooManySubscribers(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Retry-After", strconv.Itoa(EventSubscriberRetryAfterSeconds))
+	writeErrorResponse(w, req, http.StatusServiceUnavailable, models.ErrTooManySubscribers)
+}
+
+// tooManySubscribers reports whether any of the hubs has reached
+// maxSubscribers.
+func tooManySubscribers(maxSubscribers int, hubs ...events.Hub) bool {
+	if maxSubscribers <= 0 {
+		return false
+	}
+
+	for _, hub := range hubs {
+		if hub.SubscriberCount() >= maxSubscribers {
+			return true
+		}
+	}
+	return false
+}
+
+// streamPosition is the resume token of the merged event stream: the
+// positions in the desired and actual hubs it has sent the events up to.
+type streamPosition struct {
+	desired events.ResumeToken
+	actual  events.ResumeToken
+}
+
+func (p streamPosition) String() string {
+	return p.desired.String() + "," + p.actual.String()
+}
+
+func parseStreamPosition(position string) (streamPosition, error) {
+	tokens := strings.Split(position, ",")
+	if len(tokens) != 2 {
+		return streamPosition{}, fmt.Errorf("invalid event stream position: %q", position)
+	}
+
+	desired, err := events.ParseResumeToken(tokens[0])
+	if err != nil {
+		return streamPosition{}, err
+	}
+
+	actual, err := events.ParseResumeToken(tokens[1])
+	if err != nil {
+		return streamPosition{}, err
 	}
+
+	return streamPosition{desired: desired, actual: actual}, nil
 }
 
-func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventChan <-chan models.Event, errorChan <-chan error) {
+// positionedEvent is an event of a stream, together with the position of the
+// stream after it.
+type positionedEvent struct {
+	event    models.Event
+	position fmt.Stringer
+}
+
+// streamEventsToResponse writes the events as server-sent events until the
+// client goes away or the events fail. The stream is gzipped when the client
+// accepts it, and flushed after every event either way.
+func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, req *http.Request, eventChan <-chan positionedEvent, errorChan <-chan error) {
 	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
 	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
 	w.Header().Add("Connection", "keep-alive")
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	var out io.Writer = w
+	var gzipWriter *gzip.Writer
+	if acceptsGzip(req) {
+		w.Header().Set("Content-Encoding", "gzip")
+		gzipWriter = gzip.NewWriter(w)
+		defer gzipWriter.Close()
+		out = gzipWriter
+	}
 
 	w.WriteHeader(http.StatusOK)
 
 	flusher := w.(http.Flusher)
-	flusher.Flush()
-	var event models.Event
-	eventID := 0
+	flush := func() error {
+		if gzipWriter != nil {
+			// Sends the gzip header before the first event, and each event as
+			// soon as it is written.
+			err := gzipWriter.Flush()
+			if err != nil {
+				return err
+			}
+		}
+		flusher.Flush()
+		return nil
+	}
+
+	err := flush()
+	if err != nil {
+		return
+	}
+
+	var event positionedEvent
 	closeNotifier := w.(http.CloseNotifier).CloseNotify()
 
 	for {
@@ -43,39 +151,166 @@ func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventCha
 			return
 		}
 
-		sseEvent, err := events.NewEventFromModelEvent(eventID, event)
+		// The ID of each event is the position of the stream after it, which
+		// SSE clients send back as the Last-Event-ID header when reconnecting.
+		sseEvent, err := events.NewEventFromModelEvent(event.position.String(), event.event)
 		if err != nil {
 			logger.Error("failed-to-marshal-event", err)
 			return
 		}
 
-		err = sseEvent.Write(w)
+		err = sseEvent.Write(out)
 		if err != nil {
 			return
 		}
 
-		flusher.Flush()
+		err = flush()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// acceptsGzip reports whether the Accept-Encoding of the request allows a
+// gzipped response.
+func acceptsGzip(req *http.Request) bool {
+	for _, coding := range strings.Split(req.Header.Get("Accept-Encoding"), ",") {
+		params := strings.Split(coding, ";")
+		if strings.TrimSpace(params[0]) != "gzip" {
+			continue
+		}
 
-		eventID++
+		for _, param := range params[1:] {
+			param = strings.Replace(param, " ", "", -1)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				return err == nil && q > 0
+			}
+		}
+		return true
 	}
+	return false
 }
 
-type EventFetcher func() (models.Event, error)
+type EventFetcher func() (models.Event, events.ResumeToken, error)
+
+// eventMerger sends the events of both hubs onto a single channel, each with
+// the position of the merged stream after it.
+type eventMerger struct {
+	eventChan chan positionedEvent
+	errorChan chan error
+	closeChan chan struct{}
+
+	lock     sync.Mutex
+	position streamPosition
+}
 
-func streamSource(eventChan chan<- models.Event, errorChan chan<- error, closeChan chan struct{}, fetchEvent EventFetcher) {
+func (m *eventMerger) streamSource(fetchEvent EventFetcher, advance func(*streamPosition, events.ResumeToken)) {
 	for {
-		event, err := fetchEvent()
+		event, token, err := fetchEvent()
 		if err != nil {
 			select {
-			case errorChan <- err:
-			case <-closeChan:
+			case m.errorChan <- err:
+			case <-m.closeChan:
 			}
 			return
 		}
+
+		// Advance the position and send the event under the lock, so that
+		// positions reach the stream in the order they were advanced in.
+		m.lock.Lock()
+		advance(&m.position, token)
+		positioned := positionedEvent{event: event, position: m.position}
 		select {
-		case eventChan <- event:
-		case <-closeChan:
+		case m.eventChan <- positioned:
+			m.lock.Unlock()
+		case <-m.closeChan:
+			m.lock.Unlock()
 			return
 		}
 	}
 }
+
+// subscribe merges the events of the desired and actual hubs onto a single
+// channel until the returned close function is called. Events from the
+// desired hub are passed through versionDesiredEvent, so that each
+// transport can send them in the version its clients expect.
+//
+// If lastPosition is not empty, the subscription resumes after that position
+// of a previous stream. It fails with events.ErrResyncRequired if a hub
+// cannot replay the events since then and requires a resync, and with
+// models.ErrTooManySubscribers if the hubs already have the maximum number
+// of subscribers.
+func (h *EventHandler) subscribe(logger lager.Logger, versionDesiredEvent func(models.Event) models.Event, lastPosition string) (<-chan positionedEvent, <-chan error, func(), error) {
+	h.subscribeLock.Lock()
+	defer h.subscribeLock.Unlock()
+
+	if tooManySubscribers(h.maxSubscribers, h.desiredHub, h.actualHub) {
+		logger.Info("too-many-subscribers", lager.Data{"max-subscribers": h.maxSubscribers})
+		return nil, nil, nil, models.ErrTooManySubscribers
+	}
+
+	subscribeDesired := h.desiredHub.Subscribe
+	subscribeActual := h.actualHub.Subscribe
+	if lastPosition != "" {
+		// An unparseable position, such as an event ID sent by an older BBS,
+		// resumes from the zero position, which no hub can replay from.
+		position, err := parseStreamPosition(lastPosition)
+		if err != nil {
+			logger.Info("invalid-last-event-position", lager.Data{"position": lastPosition})
+		}
+
+		subscribeDesired = func() (events.ResumableEventSource, error) {
+			return h.desiredHub.SubscribeFrom(position.desired)
+		}
+		subscribeActual = func() (events.ResumableEventSource, error) {
+			return h.actualHub.SubscribeFrom(position.actual)
+		}
+	}
+
+	desiredSource, err := subscribeDesired()
+	if err != nil {
+		logger.Error("failed-to-subscribe-to-desired-event-hub", err)
+		return nil, nil, nil, err
+	}
+
+	actualSource, err := subscribeActual()
+	if err != nil {
+		logger.Error("failed-to-subscribe-to-actual-event-hub", err)
+		desiredSource.Close()
+		return nil, nil, nil, err
+	}
+
+	merger := &eventMerger{
+		eventChan: make(chan positionedEvent),
+		errorChan: make(chan error),
+		closeChan: make(chan struct{}),
+		position: streamPosition{
+			desired: desiredSource.StartToken(),
+			actual:  actualSource.StartToken(),
+		},
+	}
+
+	desiredEventsFetcher := func() (models.Event, events.ResumeToken, error) {
+		event, token, err := desiredSource.NextWithToken()
+		if err != nil {
+			return event, token, err
+		}
+		return versionDesiredEvent(event), token, nil
+	}
+
+	go merger.streamSource(desiredEventsFetcher, func(position *streamPosition, token events.ResumeToken) {
+		position.desired = token
+	})
+	go merger.streamSource(actualSource.NextWithToken, func(position *streamPosition, token events.ResumeToken) {
+		position.actual = token
+	})
+
+	closeSubscription := func() {
+		close(merger.closeChan)
+		actualSource.Close()
+		desiredSource.Close()
+	}
+
+	return merger.eventChan, merger.errorChan, closeSubscription, nil
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -10,38 +11,20 @@ import (
 func (h *EventHandler) Subscribe_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("subscribe-r0")
 
-	desiredSource, err := h.desiredHub.Subscribe()
-	if err != nil {
-		logger.Error("failed-to-subscribe-to-desired-event-hub", err)
-		w.WriteHeader(http.StatusInternalServerError)
+	eventChan, errorChan, closeSubscription, err := h.subscribe(logger, models.VersionDesiredLRPsToV0, req.Header.Get("Last-Event-ID"))
+	if err == events.ErrResyncRequired {
+		w.WriteHeader(http.StatusGone)
+		return
+	}
+	if err == models.ErrTooManySubscribers {
+		writeTooManySubscribers(w, req)
 		return
 	}
-	defer desiredSource.Close()
-
-	actualSource, err := h.actualHub.Subscribe()
 	if err != nil {
-		logger.Error("failed-to-subscribe-to-actual-event-hub", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	defer actualSource.Close()
-
-	eventChan := make(chan models.Event)
-	errorChan := make(chan error)
-	closeChan := make(chan struct{})
-	defer close(closeChan)
-
-	desiredEventsFetcher := func() (models.Event, error) {
-		event, err := desiredSource.Next()
-		if err != nil {
-			return event, err
-		}
-		event = models.VersionDesiredLRPsToV0(event)
-		return event, err
-	}
-
-	go streamSource(eventChan, errorChan, closeChan, desiredEventsFetcher)
-	go streamSource(eventChan, errorChan, closeChan, actualSource.Next)
+	defer closeSubscription()
 
-	streamEventsToResponse(logger, w, eventChan, errorChan)
+	streamEventsToResponse(logger, w, req, eventChan, errorChan)
 }
@@ -35,7 +35,7 @@ var _ = Describe("Event Handlers", func() {
 		logger = lagertest.NewTestLogger("test")
 		desiredHub = events.NewHub()
 		actualHub = events.NewHub()
-		handler = handlers.NewEventHandler(desiredHub, actualHub)
+		handler = handlers.NewEventHandler(desiredHub, actualHub, 0)
 
 		eventStreamDone = make(chan struct{})
 	})
@@ -78,20 +78,21 @@ var _ = Describe("Event Handlers", func() {
 					hub.Emit(&eventfakes.FakeEvent{Token: "A"})
 					encodedPayload := base64.StdEncoding.EncodeToString([]byte("A"))
 
-					Expect(reader.Next()).To(Equal(sse.Event{
-						ID:   "0",
-						Name: "fake",
-						Data: []byte(encodedPayload),
-					}))
+					first, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(first.Name).To(Equal("fake"))
+					Expect(first.Data).To(Equal([]byte(encodedPayload)))
 
 					hub.Emit(&eventfakes.FakeEvent{Token: "B"})
 
 					encodedPayload = base64.StdEncoding.EncodeToString([]byte("B"))
-					Expect(reader.Next()).To(Equal(sse.Event{
-						ID:   "1",
-						Name: "fake",
-						Data: []byte(encodedPayload),
-					}))
+					second, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(second.Name).To(Equal("fake"))
+					Expect(second.Data).To(Equal([]byte(encodedPayload)))
+
+					Expect(first.ID).NotTo(BeEmpty())
+					Expect(second.ID).NotTo(Equal(first.ID))
 				})
 
 				It("returns Content-Type as text/event-stream", func() {
@@ -170,6 +171,152 @@ var _ = Describe("Event Handlers", func() {
 		Describe("Subscribe to Actual Events", func() {
 			ItStreamsEventsFromHub(&actualHub)
 		})
+
+		Describe("Compressing the stream", func() {
+			subscribeWith := func(transport http.RoundTripper) *http.Response {
+				client := &http.Client{Transport: transport}
+				response, err := client.Get(server.URL)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				return response
+			}
+
+			Context("when the client accepts gzip", func() {
+				It("gzips the stream", func() {
+					request, err := http.NewRequest("GET", server.URL, nil)
+					Expect(err).NotTo(HaveOccurred())
+					request.Header.Set("Accept-Encoding", "gzip")
+
+					response, err := (&http.Transport{DisableCompression: true}).RoundTrip(request)
+					Expect(err).NotTo(HaveOccurred())
+					defer response.Body.Close()
+
+					Expect(response.Header.Get("Content-Encoding")).To(Equal("gzip"))
+					Expect(response.Header.Get("Vary")).To(Equal("Accept-Encoding"))
+				})
+
+				It("round-trips the events through a compression-aware event source", func() {
+					response := subscribeWith(events.NewGzipTransport(&http.Transport{DisableCompression: true}))
+					Expect(response.Uncompressed).To(BeTrue())
+
+					eventSource := events.NewEventSource(sse.NewReadCloser(response.Body))
+					defer eventSource.Close()
+
+					desiredLRP := model_helpers.NewValidDesiredLRP("guid")
+					for i := 0; i < 2; i++ {
+						desiredHub.Emit(models.NewDesiredLRPRemovedEvent(desiredLRP))
+
+						event, err := eventSource.Next()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(event).To(Equal(models.NewDesiredLRPRemovedEvent(desiredLRP.VersionDownTo(format.V0))))
+					}
+				})
+			})
+
+			Context("when the client does not accept gzip", func() {
+				It("streams the events uncompressed", func() {
+					response := subscribeWith(&http.Transport{DisableCompression: true})
+					Expect(response.Header.Get("Content-Encoding")).To(BeEmpty())
+
+					reader := sse.NewReadCloser(response.Body)
+					defer reader.Close()
+
+					actualHub.Emit(&eventfakes.FakeEvent{Token: "A"})
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(event.Data).To(Equal([]byte(base64.StdEncoding.EncodeToString([]byte("A")))))
+				})
+			})
+
+			Context("when the client refuses gzip", func() {
+				It("streams the events uncompressed", func() {
+					request, err := http.NewRequest("GET", server.URL, nil)
+					Expect(err).NotTo(HaveOccurred())
+					request.Header.Set("Accept-Encoding", "gzip;q=0, identity")
+
+					response, err := (&http.Transport{DisableCompression: true}).RoundTrip(request)
+					Expect(err).NotTo(HaveOccurred())
+					defer response.Body.Close()
+
+					Expect(response.Header.Get("Content-Encoding")).To(BeEmpty())
+				})
+			})
+		})
+
+		Describe("Resuming from the Last-Event-ID", func() {
+			var resyncPolicy events.ResyncPolicy
+
+			BeforeEach(func() {
+				resyncPolicy = events.RequireResync
+			})
+
+			JustBeforeEach(func() {
+				desiredHub = events.NewReplayingHub(2, resyncPolicy)
+				actualHub = events.NewReplayingHub(2, resyncPolicy)
+				handler = handlers.NewEventHandler(desiredHub, actualHub, 0)
+			})
+
+			subscribeFrom := func(lastEventID string) *http.Response {
+				request, err := http.NewRequest("GET", server.URL, nil)
+				Expect(err).NotTo(HaveOccurred())
+				request.Header.Set("Last-Event-ID", lastEventID)
+
+				response, err := http.DefaultClient.Do(request)
+				Expect(err).NotTo(HaveOccurred())
+				return response
+			}
+
+			readEvent := func(reader *sse.ReadCloser) (string, string) {
+				sseEvent, err := reader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				payload, err := base64.StdEncoding.DecodeString(string(sseEvent.Data))
+				Expect(err).NotTo(HaveOccurred())
+				return sseEvent.ID, string(payload)
+			}
+
+			It("replays the events of both hubs since that event", func() {
+				response := subscribeFrom("")
+				reader := sse.NewReadCloser(response.Body)
+
+				desiredHub.Emit(&eventfakes.FakeEvent{Token: "A"})
+				lastEventID, _ := readEvent(reader)
+				reader.Close()
+
+				actualHub.Emit(&eventfakes.FakeEvent{Token: "B"})
+				desiredHub.Emit(&eventfakes.FakeEvent{Token: "C"})
+
+				response = subscribeFrom(lastEventID)
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				reader = sse.NewReadCloser(response.Body)
+
+				_, first := readEvent(reader)
+				_, second := readEvent(reader)
+				Expect([]string{first, second}).To(ConsistOf("B", "C"))
+			})
+
+			Context("when the hubs can no longer replay from that event", func() {
+				It("responds with 410 Gone", func() {
+					response := subscribeFrom("unknown:1,unknown:1")
+					Expect(response.StatusCode).To(Equal(http.StatusGone))
+				})
+
+				Context("when the hubs continue without replay", func() {
+					BeforeEach(func() {
+						resyncPolicy = events.ContinueWithoutReplay
+					})
+
+					It("streams new events", func() {
+						response := subscribeFrom("not-a-position")
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						reader := sse.NewReadCloser(response.Body)
+
+						actualHub.Emit(&eventfakes.FakeEvent{Token: "A"})
+						_, payload := readEvent(reader)
+						Expect(payload).To(Equal("A"))
+					})
+				})
+			})
+		})
 	})
 
 })
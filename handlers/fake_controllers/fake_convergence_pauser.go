@@ -0,0 +1,111 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeConvergencePauser struct {
+	PauseStub        func(logger lager.Logger, duration time.Duration) time.Time
+	pauseMutex       sync.RWMutex
+	pauseArgsForCall []struct {
+		logger   lager.Logger
+		duration time.Duration
+	}
+	pauseReturns struct {
+		result1 time.Time
+	}
+	ResumeStub        func(logger lager.Logger)
+	resumeMutex       sync.RWMutex
+	resumeArgsForCall []struct {
+		logger lager.Logger
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeConvergencePauser) Pause(logger lager.Logger, duration time.Duration) time.Time {
+	fake.pauseMutex.Lock()
+	fake.pauseArgsForCall = append(fake.pauseArgsForCall, struct {
+		logger   lager.Logger
+		duration time.Duration
+	}{logger, duration})
+	fake.recordInvocation("Pause", []interface{}{logger, duration})
+	fake.pauseMutex.Unlock()
+	if fake.PauseStub != nil {
+		return fake.PauseStub(logger, duration)
+	} else {
+		return fake.pauseReturns.result1
+	}
+}
+
+func (fake *FakeConvergencePauser) PauseCallCount() int {
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
+	return len(fake.pauseArgsForCall)
+}
+
+func (fake *FakeConvergencePauser) PauseArgsForCall(i int) (lager.Logger, time.Duration) {
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
+	return fake.pauseArgsForCall[i].logger, fake.pauseArgsForCall[i].duration
+}
+
+func (fake *FakeConvergencePauser) PauseReturns(result1 time.Time) {
+	fake.PauseStub = nil
+	fake.pauseReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeConvergencePauser) Resume(logger lager.Logger) {
+	fake.resumeMutex.Lock()
+	fake.resumeArgsForCall = append(fake.resumeArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Resume", []interface{}{logger})
+	fake.resumeMutex.Unlock()
+	if fake.ResumeStub != nil {
+		fake.ResumeStub(logger)
+	}
+}
+
+func (fake *FakeConvergencePauser) ResumeCallCount() int {
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	return len(fake.resumeArgsForCall)
+}
+
+func (fake *FakeConvergencePauser) ResumeArgsForCall(i int) lager.Logger {
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	return fake.resumeArgsForCall[i].logger
+}
+
+func (fake *FakeConvergencePauser) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeConvergencePauser) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.ConvergencePauser = new(FakeConvergencePauser)
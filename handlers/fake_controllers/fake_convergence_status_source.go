@@ -0,0 +1,67 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+)
+
+type FakeConvergenceStatusSource struct {
+	ConvergenceStatusesStub        func() []*models.ConvergenceStatus
+	convergenceStatusesMutex       sync.RWMutex
+	convergenceStatusesArgsForCall []struct{}
+	convergenceStatusesReturns     struct {
+		result1 []*models.ConvergenceStatus
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeConvergenceStatusSource) ConvergenceStatuses() []*models.ConvergenceStatus {
+	fake.convergenceStatusesMutex.Lock()
+	fake.convergenceStatusesArgsForCall = append(fake.convergenceStatusesArgsForCall, struct{}{})
+	fake.recordInvocation("ConvergenceStatuses", []interface{}{})
+	fake.convergenceStatusesMutex.Unlock()
+	if fake.ConvergenceStatusesStub != nil {
+		return fake.ConvergenceStatusesStub()
+	} else {
+		return fake.convergenceStatusesReturns.result1
+	}
+}
+
+func (fake *FakeConvergenceStatusSource) ConvergenceStatusesCallCount() int {
+	fake.convergenceStatusesMutex.RLock()
+	defer fake.convergenceStatusesMutex.RUnlock()
+	return len(fake.convergenceStatusesArgsForCall)
+}
+
+func (fake *FakeConvergenceStatusSource) ConvergenceStatusesReturns(result1 []*models.ConvergenceStatus) {
+	fake.ConvergenceStatusesStub = nil
+	fake.convergenceStatusesReturns = struct {
+		result1 []*models.ConvergenceStatus
+	}{result1}
+}
+
+func (fake *FakeConvergenceStatusSource) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.convergenceStatusesMutex.RLock()
+	defer fake.convergenceStatusesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeConvergenceStatusSource) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.ConvergenceStatusSource = new(FakeConvergenceStatusSource)
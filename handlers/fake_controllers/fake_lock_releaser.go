@@ -0,0 +1,110 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeLockReleaser struct {
+	HoldsLockStub        func() bool
+	holdsLockMutex       sync.RWMutex
+	holdsLockArgsForCall []struct{}
+	holdsLockReturns     struct {
+		result1 bool
+	}
+	ReleaseStub        func(logger lager.Logger) error
+	releaseMutex       sync.RWMutex
+	releaseArgsForCall []struct {
+		logger lager.Logger
+	}
+	releaseReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLockReleaser) HoldsLock() bool {
+	fake.holdsLockMutex.Lock()
+	fake.holdsLockArgsForCall = append(fake.holdsLockArgsForCall, struct{}{})
+	fake.recordInvocation("HoldsLock", []interface{}{})
+	fake.holdsLockMutex.Unlock()
+	if fake.HoldsLockStub != nil {
+		return fake.HoldsLockStub()
+	} else {
+		return fake.holdsLockReturns.result1
+	}
+}
+
+func (fake *FakeLockReleaser) HoldsLockCallCount() int {
+	fake.holdsLockMutex.RLock()
+	defer fake.holdsLockMutex.RUnlock()
+	return len(fake.holdsLockArgsForCall)
+}
+
+func (fake *FakeLockReleaser) HoldsLockReturns(result1 bool) {
+	fake.HoldsLockStub = nil
+	fake.holdsLockReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeLockReleaser) Release(logger lager.Logger) error {
+	fake.releaseMutex.Lock()
+	fake.releaseArgsForCall = append(fake.releaseArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Release", []interface{}{logger})
+	fake.releaseMutex.Unlock()
+	if fake.ReleaseStub != nil {
+		return fake.ReleaseStub(logger)
+	} else {
+		return fake.releaseReturns.result1
+	}
+}
+
+func (fake *FakeLockReleaser) ReleaseCallCount() int {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	return len(fake.releaseArgsForCall)
+}
+
+func (fake *FakeLockReleaser) ReleaseArgsForCall(i int) lager.Logger {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	return fake.releaseArgsForCall[i].logger
+}
+
+func (fake *FakeLockReleaser) ReleaseReturns(result1 error) {
+	fake.ReleaseStub = nil
+	fake.releaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLockReleaser) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.holdsLockMutex.RLock()
+	defer fake.holdsLockMutex.RUnlock()
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeLockReleaser) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.LockReleaser = new(FakeLockReleaser)
@@ -0,0 +1,80 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeLRPConvergenceController struct {
+	ConvergeLRPsStub        func(logger lager.Logger, filter models.ConvergenceFilter) error
+	convergeLRPsMutex       sync.RWMutex
+	convergeLRPsArgsForCall []struct {
+		logger lager.Logger
+		filter models.ConvergenceFilter
+	}
+	convergeLRPsReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLRPConvergenceController) ConvergeLRPs(logger lager.Logger, filter models.ConvergenceFilter) error {
+	fake.convergeLRPsMutex.Lock()
+	fake.convergeLRPsArgsForCall = append(fake.convergeLRPsArgsForCall, struct {
+		logger lager.Logger
+		filter models.ConvergenceFilter
+	}{logger, filter})
+	fake.recordInvocation("ConvergeLRPs", []interface{}{logger, filter})
+	fake.convergeLRPsMutex.Unlock()
+	if fake.ConvergeLRPsStub != nil {
+		return fake.ConvergeLRPsStub(logger, filter)
+	} else {
+		return fake.convergeLRPsReturns.result1
+	}
+}
+
+func (fake *FakeLRPConvergenceController) ConvergeLRPsCallCount() int {
+	fake.convergeLRPsMutex.RLock()
+	defer fake.convergeLRPsMutex.RUnlock()
+	return len(fake.convergeLRPsArgsForCall)
+}
+
+func (fake *FakeLRPConvergenceController) ConvergeLRPsArgsForCall(i int) (lager.Logger, models.ConvergenceFilter) {
+	fake.convergeLRPsMutex.RLock()
+	defer fake.convergeLRPsMutex.RUnlock()
+	return fake.convergeLRPsArgsForCall[i].logger, fake.convergeLRPsArgsForCall[i].filter
+}
+
+func (fake *FakeLRPConvergenceController) ConvergeLRPsReturns(result1 error) {
+	fake.ConvergeLRPsStub = nil
+	fake.convergeLRPsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLRPConvergenceController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.convergeLRPsMutex.RLock()
+	defer fake.convergeLRPsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeLRPConvergenceController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.LRPConvergenceController = new(FakeLRPConvergenceController)
@@ -11,17 +11,27 @@ import (
 )
 
 type FakeTaskController struct {
-	TasksStub        func(logger lager.Logger, domain, cellId string) ([]*models.Task, error)
+	TasksStub        func(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
 		logger lager.Logger
-		domain string
-		cellId string
+		filter models.TaskFilter
 	}
 	tasksReturns struct {
 		result1 []*models.Task
 		result2 error
 	}
+	StreamTasksStub        func(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error)
+	streamTasksMutex       sync.RWMutex
+	streamTasksArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+		yield  func(*models.Task) error
+	}
+	streamTasksReturns struct {
+		result1 []*models.RecordError
+		result2 error
+	}
 	TaskByGuidStub        func(logger lager.Logger, taskGuid string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -32,6 +42,16 @@ type FakeTaskController struct {
 		result1 *models.Task
 		result2 error
 	}
+	DuplicateTasksStub        func(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error)
+	duplicateTasksMutex       sync.RWMutex
+	duplicateTasksArgsForCall []struct {
+		logger lager.Logger
+		domain string
+	}
+	duplicateTasksReturns struct {
+		result1 []*models.DuplicateTasks
+		result2 error
+	}
 	DesireTaskStub        func(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
@@ -43,6 +63,18 @@ type FakeTaskController struct {
 	desireTaskReturns struct {
 		result1 error
 	}
+	DesireTaskWithIdempotencyKeyStub        func(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
+	desireTaskWithIdempotencyKeyMutex       sync.RWMutex
+	desireTaskWithIdempotencyKeyArgsForCall []struct {
+		logger         lager.Logger
+		key            models.IdempotencyKey
+		taskDefinition *models.TaskDefinition
+		taskGuid       string
+		domain         string
+	}
+	desireTaskWithIdempotencyKeyReturns struct {
+		result1 error
+	}
 	StartTaskStub        func(logger lager.Logger, taskGuid, cellId string) (shouldStart bool, err error)
 	startTaskMutex       sync.RWMutex
 	startTaskArgsForCall []struct {
@@ -63,6 +95,17 @@ type FakeTaskController struct {
 	cancelTaskReturns struct {
 		result1 error
 	}
+	CancelTasksStub        func(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error)
+	cancelTasksMutex       sync.RWMutex
+	cancelTasksArgsForCall []struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}
+	cancelTasksReturns struct {
+		result1 *models.CancelTasksResult
+		result2 error
+	}
 	FailTaskStub        func(logger lager.Logger, taskGuid, failureReason string) error
 	failTaskMutex       sync.RWMutex
 	failTaskArgsForCall []struct {
@@ -73,6 +116,16 @@ type FakeTaskController struct {
 	failTaskReturns struct {
 		result1 error
 	}
+	RejectTaskStub        func(logger lager.Logger, taskGuid, rejectionReason string) error
+	rejectTaskMutex       sync.RWMutex
+	rejectTaskArgsForCall []struct {
+		logger          lager.Logger
+		taskGuid        string
+		rejectionReason string
+	}
+	rejectTaskReturns struct {
+		result1 error
+	}
 	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) error
 	completeTaskMutex       sync.RWMutex
 	completeTaskArgsForCall []struct {
@@ -104,13 +157,24 @@ type FakeTaskController struct {
 	deleteTaskReturns struct {
 		result1 error
 	}
-	ConvergeTasksStub        func(logger lager.Logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) error
+	PurgeCompletedTasksStub        func(logger lager.Logger, minAge time.Duration) (int, error)
+	purgeCompletedTasksMutex       sync.RWMutex
+	purgeCompletedTasksArgsForCall []struct {
+		logger lager.Logger
+		minAge time.Duration
+	}
+	purgeCompletedTasksReturns struct {
+		result1 int
+		result2 error
+	}
+	ConvergeTasksStub        func(logger lager.Logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration, maxTaskRejections int) error
 	convergeTasksMutex       sync.RWMutex
 	convergeTasksArgsForCall []struct {
 		logger                      lager.Logger
 		kickTaskDuration            time.Duration
 		expirePendingTaskDuration   time.Duration
 		expireCompletedTaskDuration time.Duration
+		maxTaskRejections           int
 	}
 	convergeTasksReturns struct {
 		result1 error
@@ -119,17 +183,16 @@ type FakeTaskController struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeTaskController) Tasks(logger lager.Logger, domain string, cellId string) ([]*models.Task, error) {
+func (fake *FakeTaskController) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
 		logger lager.Logger
-		domain string
-		cellId string
-	}{logger, domain, cellId})
-	fake.recordInvocation("Tasks", []interface{}{logger, domain, cellId})
+		filter models.TaskFilter
+	}{logger, filter})
+	fake.recordInvocation("Tasks", []interface{}{logger, filter})
 	fake.tasksMutex.Unlock()
 	if fake.TasksStub != nil {
-		return fake.TasksStub(logger, domain, cellId)
+		return fake.TasksStub(logger, filter)
 	} else {
 		return fake.tasksReturns.result1, fake.tasksReturns.result2
 	}
@@ -141,10 +204,10 @@ func (fake *FakeTaskController) TasksCallCount() int {
 	return len(fake.tasksArgsForCall)
 }
 
-func (fake *FakeTaskController) TasksArgsForCall(i int) (lager.Logger, string, string) {
+func (fake *FakeTaskController) TasksArgsForCall(i int) (lager.Logger, models.TaskFilter) {
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
-	return fake.tasksArgsForCall[i].logger, fake.tasksArgsForCall[i].domain, fake.tasksArgsForCall[i].cellId
+	return fake.tasksArgsForCall[i].logger, fake.tasksArgsForCall[i].filter
 }
 
 func (fake *FakeTaskController) TasksReturns(result1 []*models.Task, result2 error) {
@@ -155,6 +218,42 @@ func (fake *FakeTaskController) TasksReturns(result1 []*models.Task, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeTaskController) StreamTasks(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+	fake.streamTasksMutex.Lock()
+	fake.streamTasksArgsForCall = append(fake.streamTasksArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+		yield  func(*models.Task) error
+	}{logger, filter, yield})
+	fake.recordInvocation("StreamTasks", []interface{}{logger, filter, yield})
+	fake.streamTasksMutex.Unlock()
+	if fake.StreamTasksStub != nil {
+		return fake.StreamTasksStub(logger, filter, yield)
+	} else {
+		return fake.streamTasksReturns.result1, fake.streamTasksReturns.result2
+	}
+}
+
+func (fake *FakeTaskController) StreamTasksCallCount() int {
+	fake.streamTasksMutex.RLock()
+	defer fake.streamTasksMutex.RUnlock()
+	return len(fake.streamTasksArgsForCall)
+}
+
+func (fake *FakeTaskController) StreamTasksArgsForCall(i int) (lager.Logger, models.TaskFilter, func(*models.Task) error) {
+	fake.streamTasksMutex.RLock()
+	defer fake.streamTasksMutex.RUnlock()
+	return fake.streamTasksArgsForCall[i].logger, fake.streamTasksArgsForCall[i].filter, fake.streamTasksArgsForCall[i].yield
+}
+
+func (fake *FakeTaskController) StreamTasksReturns(result1 []*models.RecordError, result2 error) {
+	fake.StreamTasksStub = nil
+	fake.streamTasksReturns = struct {
+		result1 []*models.RecordError
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskController) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
@@ -190,6 +289,41 @@ func (fake *FakeTaskController) TaskByGuidReturns(result1 *models.Task, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTaskController) DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error) {
+	fake.duplicateTasksMutex.Lock()
+	fake.duplicateTasksArgsForCall = append(fake.duplicateTasksArgsForCall, struct {
+		logger lager.Logger
+		domain string
+	}{logger, domain})
+	fake.recordInvocation("DuplicateTasks", []interface{}{logger, domain})
+	fake.duplicateTasksMutex.Unlock()
+	if fake.DuplicateTasksStub != nil {
+		return fake.DuplicateTasksStub(logger, domain)
+	} else {
+		return fake.duplicateTasksReturns.result1, fake.duplicateTasksReturns.result2
+	}
+}
+
+func (fake *FakeTaskController) DuplicateTasksCallCount() int {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return len(fake.duplicateTasksArgsForCall)
+}
+
+func (fake *FakeTaskController) DuplicateTasksArgsForCall(i int) (lager.Logger, string) {
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
+	return fake.duplicateTasksArgsForCall[i].logger, fake.duplicateTasksArgsForCall[i].domain
+}
+
+func (fake *FakeTaskController) DuplicateTasksReturns(result1 []*models.DuplicateTasks, result2 error) {
+	fake.DuplicateTasksStub = nil
+	fake.duplicateTasksReturns = struct {
+		result1 []*models.DuplicateTasks
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskController) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid string, domain string) error {
 	fake.desireTaskMutex.Lock()
 	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
@@ -226,6 +360,43 @@ func (fake *FakeTaskController) DesireTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTaskController) DesireTaskWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid string, domain string) error {
+	fake.desireTaskWithIdempotencyKeyMutex.Lock()
+	fake.desireTaskWithIdempotencyKeyArgsForCall = append(fake.desireTaskWithIdempotencyKeyArgsForCall, struct {
+		logger         lager.Logger
+		key            models.IdempotencyKey
+		taskDefinition *models.TaskDefinition
+		taskGuid       string
+		domain         string
+	}{logger, key, taskDefinition, taskGuid, domain})
+	fake.recordInvocation("DesireTaskWithIdempotencyKey", []interface{}{logger, key, taskDefinition, taskGuid, domain})
+	fake.desireTaskWithIdempotencyKeyMutex.Unlock()
+	if fake.DesireTaskWithIdempotencyKeyStub != nil {
+		return fake.DesireTaskWithIdempotencyKeyStub(logger, key, taskDefinition, taskGuid, domain)
+	} else {
+		return fake.desireTaskWithIdempotencyKeyReturns.result1
+	}
+}
+
+func (fake *FakeTaskController) DesireTaskWithIdempotencyKeyCallCount() int {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return len(fake.desireTaskWithIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeTaskController) DesireTaskWithIdempotencyKeyArgsForCall(i int) (lager.Logger, models.IdempotencyKey, *models.TaskDefinition, string, string) {
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
+	return fake.desireTaskWithIdempotencyKeyArgsForCall[i].logger, fake.desireTaskWithIdempotencyKeyArgsForCall[i].key, fake.desireTaskWithIdempotencyKeyArgsForCall[i].taskDefinition, fake.desireTaskWithIdempotencyKeyArgsForCall[i].taskGuid, fake.desireTaskWithIdempotencyKeyArgsForCall[i].domain
+}
+
+func (fake *FakeTaskController) DesireTaskWithIdempotencyKeyReturns(result1 error) {
+	fake.DesireTaskWithIdempotencyKeyStub = nil
+	fake.desireTaskWithIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskController) StartTask(logger lager.Logger, taskGuid string, cellId string) (shouldStart bool, err error) {
 	fake.startTaskMutex.Lock()
 	fake.startTaskArgsForCall = append(fake.startTaskArgsForCall, struct {
@@ -296,6 +467,42 @@ func (fake *FakeTaskController) CancelTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTaskController) CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error) {
+	fake.cancelTasksMutex.Lock()
+	fake.cancelTasksArgsForCall = append(fake.cancelTasksArgsForCall, struct {
+		logger    lager.Logger
+		domain    string
+		taskGuids []string
+	}{logger, domain, taskGuids})
+	fake.recordInvocation("CancelTasks", []interface{}{logger, domain, taskGuids})
+	fake.cancelTasksMutex.Unlock()
+	if fake.CancelTasksStub != nil {
+		return fake.CancelTasksStub(logger, domain, taskGuids)
+	} else {
+		return fake.cancelTasksReturns.result1, fake.cancelTasksReturns.result2
+	}
+}
+
+func (fake *FakeTaskController) CancelTasksCallCount() int {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return len(fake.cancelTasksArgsForCall)
+}
+
+func (fake *FakeTaskController) CancelTasksArgsForCall(i int) (lager.Logger, string, []string) {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return fake.cancelTasksArgsForCall[i].logger, fake.cancelTasksArgsForCall[i].domain, fake.cancelTasksArgsForCall[i].taskGuids
+}
+
+func (fake *FakeTaskController) CancelTasksReturns(result1 *models.CancelTasksResult, result2 error) {
+	fake.CancelTasksStub = nil
+	fake.cancelTasksReturns = struct {
+		result1 *models.CancelTasksResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskController) FailTask(logger lager.Logger, taskGuid string, failureReason string) error {
 	fake.failTaskMutex.Lock()
 	fake.failTaskArgsForCall = append(fake.failTaskArgsForCall, struct {
@@ -331,6 +538,41 @@ func (fake *FakeTaskController) FailTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTaskController) RejectTask(logger lager.Logger, taskGuid string, rejectionReason string) error {
+	fake.rejectTaskMutex.Lock()
+	fake.rejectTaskArgsForCall = append(fake.rejectTaskArgsForCall, struct {
+		logger          lager.Logger
+		taskGuid        string
+		rejectionReason string
+	}{logger, taskGuid, rejectionReason})
+	fake.recordInvocation("RejectTask", []interface{}{logger, taskGuid, rejectionReason})
+	fake.rejectTaskMutex.Unlock()
+	if fake.RejectTaskStub != nil {
+		return fake.RejectTaskStub(logger, taskGuid, rejectionReason)
+	} else {
+		return fake.rejectTaskReturns.result1
+	}
+}
+
+func (fake *FakeTaskController) RejectTaskCallCount() int {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return len(fake.rejectTaskArgsForCall)
+}
+
+func (fake *FakeTaskController) RejectTaskArgsForCall(i int) (lager.Logger, string, string) {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return fake.rejectTaskArgsForCall[i].logger, fake.rejectTaskArgsForCall[i].taskGuid, fake.rejectTaskArgsForCall[i].rejectionReason
+}
+
+func (fake *FakeTaskController) RejectTaskReturns(result1 error) {
+	fake.RejectTaskStub = nil
+	fake.rejectTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskController) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) error {
 	fake.completeTaskMutex.Lock()
 	fake.completeTaskArgsForCall = append(fake.completeTaskArgsForCall, struct {
@@ -437,18 +679,54 @@ func (fake *FakeTaskController) DeleteTaskReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTaskController) ConvergeTasks(logger lager.Logger, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration) error {
+func (fake *FakeTaskController) PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error) {
+	fake.purgeCompletedTasksMutex.Lock()
+	fake.purgeCompletedTasksArgsForCall = append(fake.purgeCompletedTasksArgsForCall, struct {
+		logger lager.Logger
+		minAge time.Duration
+	}{logger, minAge})
+	fake.recordInvocation("PurgeCompletedTasks", []interface{}{logger, minAge})
+	fake.purgeCompletedTasksMutex.Unlock()
+	if fake.PurgeCompletedTasksStub != nil {
+		return fake.PurgeCompletedTasksStub(logger, minAge)
+	} else {
+		return fake.purgeCompletedTasksReturns.result1, fake.purgeCompletedTasksReturns.result2
+	}
+}
+
+func (fake *FakeTaskController) PurgeCompletedTasksCallCount() int {
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
+	return len(fake.purgeCompletedTasksArgsForCall)
+}
+
+func (fake *FakeTaskController) PurgeCompletedTasksArgsForCall(i int) (lager.Logger, time.Duration) {
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
+	return fake.purgeCompletedTasksArgsForCall[i].logger, fake.purgeCompletedTasksArgsForCall[i].minAge
+}
+
+func (fake *FakeTaskController) PurgeCompletedTasksReturns(result1 int, result2 error) {
+	fake.PurgeCompletedTasksStub = nil
+	fake.purgeCompletedTasksReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskController) ConvergeTasks(logger lager.Logger, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration, maxTaskRejections int) error {
 	fake.convergeTasksMutex.Lock()
 	fake.convergeTasksArgsForCall = append(fake.convergeTasksArgsForCall, struct {
 		logger                      lager.Logger
 		kickTaskDuration            time.Duration
 		expirePendingTaskDuration   time.Duration
 		expireCompletedTaskDuration time.Duration
-	}{logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration})
-	fake.recordInvocation("ConvergeTasks", []interface{}{logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration})
+		maxTaskRejections           int
+	}{logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections})
+	fake.recordInvocation("ConvergeTasks", []interface{}{logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections})
 	fake.convergeTasksMutex.Unlock()
 	if fake.ConvergeTasksStub != nil {
-		return fake.ConvergeTasksStub(logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+		return fake.ConvergeTasksStub(logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration, maxTaskRejections)
 	} else {
 		return fake.convergeTasksReturns.result1
 	}
@@ -460,10 +738,10 @@ func (fake *FakeTaskController) ConvergeTasksCallCount() int {
 	return len(fake.convergeTasksArgsForCall)
 }
 
-func (fake *FakeTaskController) ConvergeTasksArgsForCall(i int) (lager.Logger, time.Duration, time.Duration, time.Duration) {
+func (fake *FakeTaskController) ConvergeTasksArgsForCall(i int) (lager.Logger, time.Duration, time.Duration, time.Duration, int) {
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
-	return fake.convergeTasksArgsForCall[i].logger, fake.convergeTasksArgsForCall[i].kickTaskDuration, fake.convergeTasksArgsForCall[i].expirePendingTaskDuration, fake.convergeTasksArgsForCall[i].expireCompletedTaskDuration
+	return fake.convergeTasksArgsForCall[i].logger, fake.convergeTasksArgsForCall[i].kickTaskDuration, fake.convergeTasksArgsForCall[i].expirePendingTaskDuration, fake.convergeTasksArgsForCall[i].expireCompletedTaskDuration, fake.convergeTasksArgsForCall[i].maxTaskRejections
 }
 
 func (fake *FakeTaskController) ConvergeTasksReturns(result1 error) {
@@ -478,22 +756,34 @@ func (fake *FakeTaskController) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
+	fake.streamTasksMutex.RLock()
+	defer fake.streamTasksMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.duplicateTasksMutex.RLock()
+	defer fake.duplicateTasksMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTaskWithIdempotencyKeyMutex.RLock()
+	defer fake.desireTaskWithIdempotencyKeyMutex.RUnlock()
 	fake.startTaskMutex.RLock()
 	defer fake.startTaskMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
 	fake.completeTaskMutex.RLock()
 	defer fake.completeTaskMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
+	fake.purgeCompletedTasksMutex.RLock()
+	defer fake.purgeCompletedTasksMutex.RUnlock()
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
 	return fake.invocations
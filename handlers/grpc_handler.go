@@ -0,0 +1,361 @@
+package handlers
+
+import (
+	"crypto/tls"
+
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// GRPCHandler serves the LRP and task reads and the event stream over gRPC.
+// It answers requests with the same logic as the HTTP handlers, against the
+// same database and event hubs.
+//
+// Unary RPCs report failures in the error field of their response, as the
+// HTTP API does. Streaming RPCs have no response to carry it, so they end
+// with a gRPC status instead; see GRPCError.
+type GRPCHandler struct {
+	logger            lager.Logger
+	actualLRPHandler  *ActualLRPHandler
+	desiredLRPHandler *DesiredLRPHandler
+	taskHandler       *TaskHandler
+	eventHandler      *EventHandler
+	exitChan          chan<- struct{}
+}
+
+// errPartialResultsOverGRPCStream refuses partial results on the streaming
+// RPCs. Their streams only carry the records, leaving nowhere to report the
+// ones that could not be read.
+var errPartialResultsOverGRPCStream = models.NewError(models.Error_InvalidRequest, "partial results are not supported by streaming RPCs")
+
+func NewGRPCHandler(
+	logger lager.Logger,
+	db db.DB,
+	desiredHub, actualHub events.Hub,
+	taskController TaskController,
+	maxEventSubscribers int,
+	exitChan chan<- struct{},
+) *GRPCHandler {
+	return &GRPCHandler{
+		logger:           logger.Session("grpc"),
+		actualLRPHandler: NewActualLRPHandler(db, exitChan),
+		// Only the read paths of the desired LRP and task handlers are
+		// served over gRPC, so they need neither the clients nor the resource
+		// limits used by their write paths.
+		desiredLRPHandler: &DesiredLRPHandler{
+			desiredLRPDB: db,
+			actualLRPDB:  db,
+			desiredHub:   desiredHub,
+			actualHub:    actualHub,
+			exitChan:     exitChan,
+		},
+		taskHandler:  &TaskHandler{controller: taskController, exitChan: exitChan},
+		eventHandler: NewEventHandler(desiredHub, actualHub, maxEventSubscribers),
+		exitChan:     exitChan,
+	}
+}
+
+// NewGRPCServer returns a gRPC server for the handler. Requests are refused
+// as Unavailable until readsReady is closed, event subscriptions as
+// Unavailable while lockHolder does not hold the lock and, when a policy is
+// given, every request as PermissionDenied unless the client may perform read
+// operations.
+func NewGRPCServer(
+	logger lager.Logger,
+	handler *GRPCHandler,
+	readsReady <-chan struct{},
+	lockHolder LockHolder,
+	policy *authorization.Policy,
+	opts ...grpc.ServerOption,
+) *grpc.Server {
+	admission := &grpcAdmission{
+		logger:     logger.Session("grpc-admission"),
+		readsReady: readsReady,
+		lockHolder: lockHolder,
+		policy:     policy,
+	}
+
+	opts = append(opts,
+		grpc.UnaryInterceptor(admission.unaryInterceptor),
+		grpc.StreamInterceptor(admission.streamInterceptor),
+	)
+
+	server := grpc.NewServer(opts...)
+	models.RegisterBBSServer(server, handler)
+	return server
+}
+
+func (h *GRPCHandler) ActualLRPGroups(ctx context.Context, request *models.ActualLRPGroupsRequest) (*models.ActualLRPGroupsResponse, error) {
+	logger := h.logger.Session("actual-lrp-groups")
+	response := &models.ActualLRPGroupsResponse{}
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = h.actualLRPHandler.actualLRPGroups(logger, request, response)
+	}
+
+	response.Error = models.ConvertError(err)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+	return response, nil
+}
+
+func (h *GRPCHandler) ActualLRPGroupsByProcessGuid(ctx context.Context, request *models.ActualLRPGroupsByProcessGuidRequest) (*models.ActualLRPGroupsResponse, error) {
+	logger := h.logger.Session("actual-lrp-groups-by-process-guid")
+	response := &models.ActualLRPGroupsResponse{}
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = h.actualLRPHandler.actualLRPGroupsByProcessGuid(logger, request, response)
+	}
+
+	response.Error = models.ConvertError(err)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+	return response, nil
+}
+
+func (h *GRPCHandler) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, request *models.ActualLRPGroupByProcessGuidAndIndexRequest) (*models.ActualLRPGroupResponse, error) {
+	logger := h.logger.Session("actual-lrp-group-by-process-guid-and-index")
+	response := &models.ActualLRPGroupResponse{}
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = h.actualLRPHandler.actualLRPGroupByProcessGuidAndIndex(logger, request, response)
+	}
+
+	response.Error = models.ConvertError(err)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+	return response, nil
+}
+
+func (h *GRPCHandler) DesiredLRPs(ctx context.Context, request *models.DesiredLRPsRequest) (*models.DesiredLRPsResponse, error) {
+	logger := h.logger.Session("desired-lrps")
+	response := &models.DesiredLRPsResponse{}
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = h.desiredLRPHandler.desiredLRPs(logger, request, response)
+	}
+
+	response.Error = models.ConvertError(err)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+	return response, nil
+}
+
+func (h *GRPCHandler) DesiredLRPByProcessGuid(ctx context.Context, request *models.DesiredLRPByProcessGuidRequest) (*models.DesiredLRPResponse, error) {
+	logger := h.logger.Session("desired-lrp-by-process-guid")
+	response := &models.DesiredLRPResponse{}
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = h.desiredLRPHandler.desiredLRPByProcessGuid(logger, request, response)
+	}
+
+	response.Error = models.ConvertError(err)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+	return response, nil
+}
+
+func (h *GRPCHandler) DesiredLRPSchedulingInfos(request *models.DesiredLRPsRequest, stream models.BBS_DesiredLRPSchedulingInfosServer) error {
+	logger := h.logger.Session("desired-lrp-scheduling-infos")
+
+	err := validateRequest(logger, request)
+	if err == nil && request.PartialResults {
+		err = errPartialResultsOverGRPCStream
+	}
+	if err == nil {
+		_, err = h.desiredLRPHandler.streamDesiredLRPSchedulingInfos(logger, request, stream.Send)
+	}
+
+	bbsErr := models.ConvertError(err)
+	exitIfUnrecoverable(logger, h.exitChan, bbsErr)
+	return GRPCError(bbsErr)
+}
+
+func (h *GRPCHandler) Tasks(request *models.TasksRequest, stream models.BBS_TasksServer) error {
+	logger := h.logger.Session("tasks")
+
+	err := validateRequest(logger, request)
+	if err == nil && request.PartialResults {
+		err = errPartialResultsOverGRPCStream
+	}
+	if err == nil {
+		_, err = h.taskHandler.controller.StreamTasks(logger, request.Filter(), stream.Send)
+	}
+
+	bbsErr := models.ConvertError(err)
+	exitIfUnrecoverable(logger, h.exitChan, bbsErr)
+	return GRPCError(bbsErr)
+}
+
+func (h *GRPCHandler) TaskByGuid(ctx context.Context, request *models.TaskByGuidRequest) (*models.TaskResponse, error) {
+	logger := h.logger.Session("task-by-guid")
+	response := &models.TaskResponse{}
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		response.Task, err = h.taskHandler.controller.TaskByGuid(logger, request.TaskGuid)
+	}
+
+	response.Error = models.ConvertError(err)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+	return response, nil
+}
+
+// SubscribeToEvents streams the events of both hubs until the client goes
+// away. Unlike the HTTP event stream, desired LRP events are sent in their
+// current version.
+func (h *GRPCHandler) SubscribeToEvents(request *models.EventsRequest, stream models.BBS_SubscribeToEventsServer) error {
+	logger := h.logger.Session("subscribe")
+
+	eventChan, errorChan, closeSubscription, err := h.eventHandler.subscribe(logger, currentEventVersion, "")
+	if err == models.ErrTooManySubscribers {
+		return GRPCError(models.ErrTooManySubscribers)
+	}
+	if err != nil {
+		return grpc.Errorf(codes.Unavailable, err.Error())
+	}
+	defer closeSubscription()
+
+	for {
+		var event models.Event
+		select {
+		case positioned := <-eventChan:
+			event = positioned.event
+		case err := <-errorChan:
+			logger.Error("failed-to-get-next-event", err)
+			return grpc.Errorf(codes.Unavailable, err.Error())
+		case <-stream.Context().Done():
+			return nil
+		}
+
+		envelope, err := events.NewEnvelopeFromModelEvent(event)
+		if err != nil {
+			logger.Error("failed-to-marshal-event", err)
+			return grpc.Errorf(codes.Internal, err.Error())
+		}
+
+		err = stream.Send(envelope)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func currentEventVersion(event models.Event) models.Event {
+	return event
+}
+
+// GRPCError converts the error a streaming RPC failed with to a gRPC status
+// error, keeping the message of the models.Error. It returns nil for a nil
+// error.
+func GRPCError(bbsErr *models.Error) error {
+	if bbsErr == nil {
+		return nil
+	}
+
+	code := codes.Unknown
+	switch bbsErr.Type {
+	case models.Error_InvalidRequest, models.Error_InvalidProtobufMessage, models.Error_InvalidJSON:
+		code = codes.InvalidArgument
+	case models.Error_ResourceNotFound:
+		code = codes.NotFound
+	case models.Error_ResourceExists:
+		code = codes.AlreadyExists
+	case models.Error_ResourceConflict, models.Error_Deadlock:
+		code = codes.Aborted
+	case models.Error_Unauthorized:
+		code = codes.Unauthenticated
+	case models.Error_Forbidden:
+		code = codes.PermissionDenied
+	case models.Error_RequestEntityTooLarge, models.Error_TooManySubscribers:
+		code = codes.ResourceExhausted
+	case models.Error_Timeout:
+		code = codes.DeadlineExceeded
+	case models.Error_MigrationInProgress, models.Error_Unavailable:
+		code = codes.Unavailable
+	case models.Error_Unrecoverable:
+		code = codes.Internal
+	}
+
+	return grpc.Errorf(code, bbsErr.Error())
+}
+
+// grpcSubscribeToEventsMethod is the full name of the event subscription RPC.
+const grpcSubscribeToEventsMethod = "/models.BBS/SubscribeToEvents"
+
+// grpcAdmission applies the checks the HTTP API makes in
+// StandbyUnavailableHandler and authorization.Wrap to gRPC requests. Every
+// RPC of the BBS service is a read, but events are only emitted by the BBS
+// holding the lock, so new event subscriptions are refused once it is
+// released, as they are by the HTTP API on a standby.
+type grpcAdmission struct {
+	logger     lager.Logger
+	readsReady <-chan struct{}
+	lockHolder LockHolder
+	policy     *authorization.Policy
+}
+
+func (a *grpcAdmission) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.admit(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *grpcAdmission) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.admit(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+func (a *grpcAdmission) admit(ctx context.Context, method string) error {
+	select {
+	case <-a.readsReady:
+	default:
+		return grpc.Errorf(codes.Unavailable, "BBS is not ready to serve reads")
+	}
+
+	if method == grpcSubscribeToEventsMethod && !a.lockHolder.HoldsLock() {
+		return grpc.Errorf(codes.Unavailable, "BBS does not hold the lock and emits no events")
+	}
+
+	if a.policy == nil {
+		return nil
+	}
+
+	identity, names := grpcClientNames(ctx)
+	if a.policy.Allows(names, authorization.Read) {
+		return nil
+	}
+
+	a.logger.Info("forbidden", lager.Data{
+		"identity":        identity,
+		"operation-class": authorization.Read,
+		"method":          method,
+	})
+	return grpc.Errorf(codes.PermissionDenied, "%s is not allowed to perform %s operations", identity, authorization.Read)
+}
+
+// grpcClientNames is authorization.ClientNames for the client of a gRPC
+// request.
+func grpcClientNames(ctx context.Context) (string, []string) {
+	var state *tls.ConnectionState
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &tlsInfo.State
+		}
+	}
+
+	identity, _ := middleware.ConnectionIdentity(state)
+	return identity, append([]string{identity}, authorization.CertificateNames(state)...)
+}
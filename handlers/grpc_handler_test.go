@@ -0,0 +1,349 @@
+package handlers_test
+
+import (
+	"io"
+	"net"
+
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("GRPC Handler", func() {
+	var (
+		logger             *lagertest.TestLogger
+		fakeDB             *dbfakes.FakeDB
+		fakeTaskController *fake_controllers.FakeTaskController
+		desiredHub         events.Hub
+		actualHub          events.Hub
+		readsReady         chan struct{}
+		lockHolder         *fake_controllers.FakeLockReleaser
+		policy             *authorization.Policy
+		exitCh             chan struct{}
+
+		server *grpc.Server
+		conn   *grpc.ClientConn
+		client models.BBSClient
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeDB = new(dbfakes.FakeDB)
+		fakeTaskController = new(fake_controllers.FakeTaskController)
+		desiredHub = events.NewHub()
+		actualHub = events.NewHub()
+		readsReady = make(chan struct{})
+		close(readsReady)
+		lockHolder = new(fake_controllers.FakeLockReleaser)
+		lockHolder.HoldsLockReturns(true)
+		policy = nil
+		exitCh = make(chan struct{}, 1)
+	})
+
+	JustBeforeEach(func() {
+		handler := handlers.NewGRPCHandler(logger, fakeDB, desiredHub, actualHub, fakeTaskController, 0, exitCh)
+		server = handlers.NewGRPCServer(logger, handler, readsReady, lockHolder, policy)
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		go server.Serve(listener)
+
+		conn, err = grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+		Expect(err).NotTo(HaveOccurred())
+		client = models.NewBBSClient(conn)
+	})
+
+	AfterEach(func() {
+		conn.Close()
+		server.Stop()
+		desiredHub.Close()
+		actualHub.Close()
+	})
+
+	Describe("ActualLRPGroups", func() {
+		var (
+			request  *models.ActualLRPGroupsRequest
+			response *models.ActualLRPGroupsResponse
+			err      error
+		)
+
+		BeforeEach(func() {
+			request = &models.ActualLRPGroupsRequest{Domain: "domain-1", CellId: "cell-1"}
+		})
+
+		JustBeforeEach(func() {
+			response, err = client.ActualLRPGroups(context.Background(), request)
+		})
+
+		Context("when reading actual lrps from the DB succeeds", func() {
+			var actualLRPGroups []*models.ActualLRPGroup
+
+			BeforeEach(func() {
+				actualLRPGroups = []*models.ActualLRPGroup{
+					{Instance: model_helpers.NewValidActualLRP("process-guid", 0)},
+				}
+				fakeDB.ActualLRPGroupsWithRecordErrorsReturns(actualLRPGroups, nil, nil)
+			})
+
+			It("returns the actual lrp groups, filtered as the HTTP handler does", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(BeNil())
+				Expect(response.ActualLrpGroups).To(Equal(actualLRPGroups))
+
+				Expect(fakeDB.ActualLRPGroupsWithRecordErrorsCallCount()).To(Equal(1))
+				_, filter := fakeDB.ActualLRPGroupsWithRecordErrorsArgsForCall(0)
+				Expect(filter).To(Equal(models.ActualLRPFilter{Domain: "domain-1", CellID: "cell-1"}))
+			})
+		})
+
+		Context("when partial results are requested", func() {
+			var recordErrors []*models.RecordError
+
+			BeforeEach(func() {
+				request = &models.ActualLRPGroupsRequest{PartialResults: true}
+				recordErrors = []*models.RecordError{{Guid: "unreadable-guid", Reason: "index 0: kaboom"}}
+				fakeDB.ActualLRPGroupsWithRecordErrorsReturns(nil, recordErrors, nil)
+			})
+
+			It("asks the DB for partial results and returns the record errors", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(BeNil())
+				Expect(response.RecordErrors).To(Equal(recordErrors))
+
+				_, filter := fakeDB.ActualLRPGroupsWithRecordErrorsArgsForCall(0)
+				Expect(filter.PartialResults).To(BeTrue())
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDB.ActualLRPGroupsWithRecordErrorsReturns(nil, nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("returns the error in the response and logs and writes to the exit channel", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.NewUnrecoverableError(nil)))
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the BBS is not ready to serve reads", func() {
+			BeforeEach(func() {
+				readsReady = make(chan struct{})
+			})
+
+			It("fails as unavailable without reading from the DB", func() {
+				Expect(grpc.Code(err)).To(Equal(codes.Unavailable))
+				Expect(fakeDB.ActualLRPGroupsWithRecordErrorsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the policy does not allow the client to read", func() {
+			BeforeEach(func() {
+				policy = &authorization.Policy{Rules: []authorization.Rule{
+					{Identities: []string{"reader"}, Classes: []authorization.OperationClass{authorization.Read}},
+				}}
+			})
+
+			It("fails as permission denied", func() {
+				Expect(grpc.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(fakeDB.ActualLRPGroupsWithRecordErrorsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ActualLRPGroupByProcessGuidAndIndex", func() {
+		Context("when the request is invalid", func() {
+			It("returns an invalid request error without reading from the DB", func() {
+				response, err := client.ActualLRPGroupByProcessGuidAndIndex(
+					context.Background(),
+					&models.ActualLRPGroupByProcessGuidAndIndexRequest{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeDB.ActualLRPGroupByProcessGuidAndIndexCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DesiredLRPByProcessGuid", func() {
+		It("returns the desired lrp", func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("process-guid")
+			fakeDB.DesiredLRPByProcessGuidReturns(desiredLRP, nil)
+
+			response, err := client.DesiredLRPByProcessGuid(
+				context.Background(),
+				&models.DesiredLRPByProcessGuidRequest{ProcessGuid: "process-guid"},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.DesiredLrp).To(Equal(desiredLRP))
+		})
+	})
+
+	Describe("DesiredLRPSchedulingInfos", func() {
+		It("rejects partial results, which the stream cannot report, without reading the DB", func() {
+			stream, err := client.DesiredLRPSchedulingInfos(context.Background(), &models.DesiredLRPsRequest{PartialResults: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(grpc.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(fakeDB.StreamDesiredLRPSchedulingInfosCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("Tasks", func() {
+		var (
+			tasks   []*models.Task
+			request *models.TasksRequest
+		)
+
+		BeforeEach(func() {
+			tasks = []*models.Task{
+				model_helpers.NewValidTask("task-1"),
+				model_helpers.NewValidTask("task-2"),
+			}
+			request = &models.TasksRequest{Domain: "domain-1"}
+		})
+
+		receiveTasks := func() ([]*models.Task, error) {
+			stream, err := client.Tasks(context.Background(), request)
+			Expect(err).NotTo(HaveOccurred())
+
+			var received []*models.Task
+			for {
+				task, err := stream.Recv()
+				if err == io.EOF {
+					return received, nil
+				} else if err != nil {
+					return received, err
+				}
+				received = append(received, task)
+			}
+		}
+
+		Context("when streaming the tasks succeeds", func() {
+			BeforeEach(func() {
+				fakeTaskController.StreamTasksStub = func(_ lager.Logger, _ models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+					for _, task := range tasks {
+						if err := yield(task); err != nil {
+							return nil, err
+						}
+					}
+					return nil, nil
+				}
+			})
+
+			It("streams every task", func() {
+				received, err := receiveTasks()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(received).To(Equal(tasks))
+
+				_, filter, _ := fakeTaskController.StreamTasksArgsForCall(0)
+				Expect(filter).To(Equal(models.TaskFilter{Domain: "domain-1"}))
+			})
+
+			It("rejects partial results, which the stream cannot report, without reading the tasks", func() {
+				request = &models.TasksRequest{PartialResults: true}
+				_, err := receiveTasks()
+				Expect(grpc.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(fakeTaskController.StreamTasksCallCount()).To(Equal(0))
+			})
+
+			It("filters by cell and states", func() {
+				request = &models.TasksRequest{CellId: "cell-1", States: []string{"Running"}}
+				_, err := receiveTasks()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, filter, _ := fakeTaskController.StreamTasksArgsForCall(0)
+				Expect(filter).To(Equal(models.TaskFilter{CellID: "cell-1", States: []models.Task_State{models.Task_Running}}))
+			})
+		})
+
+		Context("when streaming the tasks fails", func() {
+			BeforeEach(func() {
+				fakeTaskController.StreamTasksReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("ends the stream with the matching status", func() {
+				_, err := receiveTasks()
+				Expect(grpc.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Describe("SubscribeToEvents", func() {
+		var subscriberCounts chan int
+
+		BeforeEach(func() {
+			subscriberCounts = make(chan int, 10)
+			desiredHub.RegisterCallback(func(count int) {
+				subscriberCounts <- count
+			})
+		})
+
+		It("streams the events of the hubs", func() {
+			stream, err := client.SubscribeToEvents(context.Background(), &models.EventsRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(subscriberCounts).Should(Receive(Equal(1)))
+
+			desiredEvent := models.NewDesiredLRPCreatedEvent(model_helpers.NewValidDesiredLRP("process-guid"))
+			desiredHub.Emit(desiredEvent)
+
+			envelope, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(envelope.Type).To(Equal(models.EventTypeDesiredLRPCreated))
+
+			event, err := events.NewModelEventFromEnvelope(envelope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event).To(Equal(desiredEvent))
+		})
+
+		Context("when the lock is not held", func() {
+			BeforeEach(func() {
+				lockHolder.HoldsLockReturns(false)
+			})
+
+			It("fails as unavailable without subscribing", func() {
+				stream, err := client.SubscribeToEvents(context.Background(), &models.EventsRequest{})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = stream.Recv()
+				Expect(grpc.Code(err)).To(Equal(codes.Unavailable))
+				Consistently(subscriberCounts).ShouldNot(Receive(Equal(1)))
+			})
+		})
+	})
+})
+
+var _ = Describe("GRPCError", func() {
+	It("returns nil for a nil error", func() {
+		Expect(handlers.GRPCError(nil)).To(BeNil())
+	})
+
+	It("maps the error type to a status code, keeping the message", func() {
+		err := handlers.GRPCError(models.NewError(models.Error_InvalidRequest, "bad"))
+		Expect(grpc.Code(err)).To(Equal(codes.InvalidArgument))
+		Expect(grpc.ErrorDesc(err)).To(ContainSubstring("bad"))
+
+		err = handlers.GRPCError(models.ErrUnknownError)
+		Expect(grpc.Code(err)).To(Equal(codes.Unknown))
+
+		err = handlers.GRPCError(models.ErrTooManySubscribers)
+		Expect(grpc.Code(err)).To(Equal(codes.ResourceExhausted))
+	})
+})
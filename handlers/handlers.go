@@ -1,17 +1,22 @@
 package handlers
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"time"
 
-	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/auctioneerclient"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/authorization"
 	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/ratelimit"
 	"code.cloudfoundry.org/bbs/taskworkpool"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/rep"
@@ -23,26 +28,48 @@ func New(
 	logger, accessLogger lager.Logger,
 	updateWorkers int,
 	convergenceWorkersSize int,
-	db db.DB,
-	desiredHub, actualHub events.Hub,
+	db, bulkReadDB db.DB,
+	desiredHub, actualHub, taskHub events.Hub,
 	taskCompletionClient taskworkpool.TaskCompletionClient,
 	serviceClient bbs.ServiceClient,
-	auctioneerClient auctioneer.Client,
+	auctioneerClient auctioneerclient.Client,
 	repClientFactory rep.ClientFactory,
+	lrpConvergenceController LRPConvergenceController,
+	convergenceStatus *controllers.ConvergenceStatusTracker,
+	convergencePauser ConvergencePauser,
 	migrationsDone <-chan struct{},
+	readsReady <-chan struct{},
+	resourceLimits models.ResourceRequestLimits,
+	maxRequestBodySize int64,
+	maxEventSubscribers int,
+	maxTaskRejections int,
+	orphanGracePeriod time.Duration,
+	lockReleaser LockReleaser,
+	bbsID string,
+	auditSink audit.Sink,
+	policy *authorization.Policy,
+	limiter *ratelimit.Limiter,
 	exitChan chan struct{},
 ) http.Handler {
 	retirer := controllers.NewActualLRPRetirer(db, actualHub, repClientFactory, serviceClient)
 	pingHandler := NewPingHandler()
-	domainHandler := NewDomainHandler(db, exitChan)
-	actualLRPHandler := NewActualLRPHandler(db, exitChan)
+	domainHandler := NewDomainHandler(bulkReadDB, exitChan)
+	actualLRPHandler := NewActualLRPHandler(bulkReadDB, exitChan)
 	actualLRPLifecycleHandler := NewActualLRPLifecycleHandler(db, db, actualHub, auctioneerClient, retirer, exitChan)
 	evacuationHandler := NewEvacuationHandler(db, db, db, actualHub, auctioneerClient, exitChan)
-	desiredLRPHandler := NewDesiredLRPHandler(updateWorkers, db, db, desiredHub, actualHub, auctioneerClient, repClientFactory, serviceClient, exitChan)
-	taskController := controllers.NewTaskController(db, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory)
-	taskHandler := NewTaskHandler(taskController, exitChan)
-	eventsHandler := NewEventHandler(desiredHub, actualHub)
+	desiredLRPHandler := NewDesiredLRPHandler(updateWorkers, bulkReadDB, db, desiredHub, actualHub, auctioneerClient, repClientFactory, serviceClient, resourceLimits, orphanGracePeriod, exitChan)
+	taskController := controllers.NewTaskController(db, bulkReadDB, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory, taskHub, convergenceStatus, maxTaskRejections)
+	taskHandler := NewTaskHandler(taskController, resourceLimits, exitChan)
+	eventsHandler := NewEventHandler(desiredHub, actualHub, maxEventSubscribers)
+	taskEventsHandler := NewTaskEventHandler(taskHub, maxEventSubscribers)
 	cellsHandler := NewCellHandler(serviceClient, exitChan)
+	objectCountsHandler := NewObjectCountsHandler(bulkReadDB, exitChan)
+	lrpConvergenceHandler := NewLRPConvergenceHandler(lrpConvergenceController, exitChan)
+	adminHandler := NewAdminHandler(lockReleaser, exitChan)
+	coordinationStateHandler := NewCoordinationStateHandler(serviceClient, lockReleaser, bbsID, exitChan)
+	convergenceStatusHandler := NewConvergenceStatusHandler(convergenceStatus)
+	convergencePauseHandler := NewConvergencePauseHandler(convergencePauser)
+	importHandler := NewImportHandler(db, maxRequestBodySize, exitChan)
 
 	emitter := middleware.NewLatencyEmitter(logger)
 
@@ -51,21 +78,27 @@ func New(
 		bbs.PingRoute: emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, pingHandler.Ping)),
 
 		// Domains
-		bbs.DomainsRoute:      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Domains))),
-		bbs.UpsertDomainRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Upsert))),
+		bbs.DomainsRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Domains))),
+		bbs.UpsertDomainRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Upsert))),
+		bbs.UpsertDomainsRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, domainHandler.UpsertDomains))),
+		bbs.DomainFreshnessRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, domainHandler.DomainFreshness))),
 
 		// Actual LRPs
 		bbs.ActualLRPGroupsRoute:                     route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroups))),
 		bbs.ActualLRPGroupsByProcessGuidRoute:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroupsByProcessGuid))),
 		bbs.ActualLRPGroupByProcessGuidAndIndexRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroupByProcessGuidAndIndex))),
+		bbs.CrashingActualLRPsRoute:                  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.CrashingActualLRPs))),
+		bbs.CellPlacementsRoute:                      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.CellPlacements))),
 
 		// Actual LRP Lifecycle
-		bbs.ClaimActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.ClaimActualLRP))),
-		bbs.StartActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.StartActualLRP))),
-		bbs.CrashActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.CrashActualLRP))),
-		bbs.RetireActualLRPRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RetireActualLRP))),
-		bbs.FailActualLRPRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.FailActualLRP))),
-		bbs.RemoveActualLRPRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RemoveActualLRP))),
+		bbs.ClaimActualLRPRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.ClaimActualLRP))),
+		bbs.StartActualLRPRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.StartActualLRP))),
+		bbs.StartActualLRPsRoute:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.StartActualLRPs))),
+		bbs.CrashActualLRPRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.CrashActualLRP))),
+		bbs.RetireActualLRPRoute:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RetireActualLRP))),
+		bbs.RetireActualLRPsOnCellRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RetireActualLRPsOnCell))),
+		bbs.FailActualLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.FailActualLRP))),
+		bbs.RemoveActualLRPRoute:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RemoveActualLRP))),
 
 		// Evacuation
 		bbs.RemoveEvacuatingActualLRPRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.RemoveEvacuatingActualLRP))),
@@ -78,6 +111,8 @@ func New(
 		bbs.DesiredLRPsRoute:               route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs))),
 		bbs.DesiredLRPByProcessGuidRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPByProcessGuid))),
 		bbs.DesiredLRPSchedulingInfosRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPSchedulingInfos))),
+		bbs.DesiredLRPDiffRoute:            route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPDiff))),
+		bbs.ValidateDesiredLRPRoute:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.ValidateDesiredLRP))),
 		bbs.DesireDesiredLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesireDesiredLRP))),
 		bbs.UpdateDesiredLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.UpdateDesiredLRP))),
 		bbs.RemoveDesiredLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.RemoveDesiredLRP))),
@@ -89,16 +124,22 @@ func New(
 		bbs.DesireDesiredLRPRoute_r0:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesireDesiredLRP_r0))),
 		bbs.DesireDesiredLRPRoute_r1:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesireDesiredLRP_r1))),
 
+		// LRP Convergence
+		bbs.ConvergeLRPsRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, lrpConvergenceHandler.ConvergeLRPs))),
+
 		// Tasks
-		bbs.TasksRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks))),
-		bbs.TaskByGuidRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskByGuid))),
-		bbs.DesireTaskRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DesireTask))),
-		bbs.StartTaskRoute:     route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.StartTask))),
-		bbs.CancelTaskRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CancelTask))),
-		bbs.FailTaskRoute:      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.FailTask))),
-		bbs.CompleteTaskRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CompleteTask))),
-		bbs.ResolvingTaskRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.ResolvingTask))),
-		bbs.DeleteTaskRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DeleteTask))),
+		bbs.TasksRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks))),
+		bbs.TaskByGuidRoute:     route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskByGuid))),
+		bbs.DuplicateTasksRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DuplicateTasks))),
+		bbs.DesireTaskRoute:     route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DesireTask))),
+		bbs.StartTaskRoute:      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.StartTask))),
+		bbs.CancelTaskRoute:     route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CancelTask))),
+		bbs.CancelTasksRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CancelTasks))),
+		bbs.FailTaskRoute:       route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.FailTask))),
+		bbs.RejectTaskRoute:     route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.RejectTask))),
+		bbs.CompleteTaskRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CompleteTask))),
+		bbs.ResolvingTaskRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.ResolvingTask))),
+		bbs.DeleteTaskRoute:     route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DeleteTask))),
 
 		bbs.TasksRoute_r1:      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks_r1))),
 		bbs.TasksRoute_r0:      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks_r0))),
@@ -108,11 +149,53 @@ func New(
 		bbs.DesireTaskRoute_r0: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DesireTask_r0))),
 
 		// Events
-		bbs.EventStreamRoute_r0: route(middleware.LogWrap(logger, accessLogger, eventsHandler.Subscribe_r0)),
+		bbs.EventStreamRoute_r0:  route(middleware.LogWrap(logger, accessLogger, eventsHandler.Subscribe_r0)),
+		bbs.TaskEventStreamRoute: route(middleware.LogWrap(logger, accessLogger, taskEventsHandler.Subscribe)),
+
+		// Counts
+		bbs.ObjectCountsRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, objectCountsHandler.ObjectCounts))),
 
 		// Cells
-		bbs.CellsRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.Cells))),
-		bbs.CellsRoute_r1: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.Cells))),
+		bbs.CellsRoute:                route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.Cells))),
+		bbs.CellsRoute_r1:             route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.Cells))),
+		bbs.CellPresenceStatusesRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.CellPresenceStatuses))),
+
+		// Admin
+		bbs.ReleaseLockRoute:                 route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, adminHandler.ReleaseLock))),
+		bbs.DesiredLRPsIncludingDeletedRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPsIncludingDeleted))),
+		bbs.PurgeCompletedTasksRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.PurgeCompletedTasks))),
+		bbs.ConvergenceStatusRoute:           route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, convergenceStatusHandler.ConvergenceStatus))),
+		bbs.PauseConvergenceRoute:            route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, convergencePauseHandler.PauseConvergence))),
+		bbs.ResumeConvergenceRoute:           route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, convergencePauseHandler.ResumeConvergence))),
+		bbs.ImportRoute:                      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, importHandler.Import))),
+		bbs.ExportRoute:                      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, importHandler.Export))),
+		bbs.CoordinationStateRoute:           route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, coordinationStateHandler.CoordinationState))),
+	}
+
+	for name, handler := range actions {
+		if name == bbs.PingRoute {
+			continue
+		}
+
+		class := authorization.Write
+		if name == bbs.EventStreamRoute_r0 || name == bbs.TaskEventStreamRoute || bbs.ReadRoutes[name] {
+			class = authorization.Read
+		} else if bbs.AdminRoutes[name] {
+			class = authorization.Admin
+		}
+		handler = authorization.Wrap(logger, policy, class, handler)
+
+		// Every route that can change state is audited, including requests
+		// that were not authorized.
+		if class != authorization.Read {
+			handler = audit.Wrap(auditSink, name, handler)
+		}
+
+		// Throttled requests are turned away before they are authorized or
+		// audited.
+		handler = ratelimit.Wrap(logger, limiter, class, handler)
+
+		actions[name] = handler
 	}
 
 	handler, err := rata.NewRouter(bbs.Routes, actions)
@@ -121,29 +204,77 @@ func New(
 	}
 
 	return middleware.RequestCountWrap(
-		UnavailableWrap(handler,
-			migrationsDone,
+		middleware.IdentityWrap(
+			middleware.MaxBodySizeWrapExcept(maxRequestBodySize, isImportRequest,
+				NewStandbyUnavailableHandler(handler,
+					bbs.Routes,
+					bbs.ReadRoutes,
+					bbs.DiagnosticRoutes,
+					readsReady,
+					migrationsDone,
+					lockReleaser,
+				),
+			),
 		),
 	)
 }
 
+// isImportRequest reports whether req is for the import route, whose body may
+// be much larger than the maximum request body size. The import handler
+// limits the size of each record instead.
+func isImportRequest(req *http.Request) bool {
+	importRoute, ok := bbs.Routes.FindRouteByName(bbs.ImportRoute)
+	return ok && req.Method == importRoute.Method && req.URL.Path == importRoute.Path
+}
+
 func route(f http.HandlerFunc) http.Handler {
 	return f
 }
 
-func parseRequest(logger lager.Logger, req *http.Request, request MessageValidator) error {
+// readRequestBody reads the whole request body, reporting bodies cut off by
+// middleware.MaxBodySizeWrap as ErrRequestEntityTooLarge.
+func readRequestBody(logger lager.Logger, req *http.Request) ([]byte, error) {
 	data, err := ioutil.ReadAll(req.Body)
-	if err != nil {
+	if err == middleware.ErrRequestBodyTooLarge {
+		logger.Error("request-body-too-large", err)
+		return nil, models.ErrRequestEntityTooLarge
+	} else if err != nil {
 		logger.Error("failed-to-read-body", err)
-		return models.ErrUnknownError
+		return nil, models.ErrUnknownError
 	}
 
-	err = request.Unmarshal(data)
+	return data, nil
+}
+
+// parseRequest decodes the request body as JSON when its Content-Type is
+// application/json, and as protobuf otherwise.
+func parseRequest(logger lager.Logger, req *http.Request, request MessageValidator) error {
+	data, err := readRequestBody(logger, req)
 	if err != nil {
-		logger.Error("failed-to-parse-request-body", err)
-		return models.ErrBadRequest
+		return err
+	}
+
+	if requestFormat(req) == jsonFormat {
+		err = json.Unmarshal(data, request)
+		if err != nil {
+			logger.Error("failed-to-parse-json-request-body", err)
+			return models.NewError(models.Error_InvalidJSON, err.Error())
+		}
+	} else {
+		err = request.Unmarshal(data)
+		if err != nil {
+			logger.Error("failed-to-parse-request-body", err)
+			return models.ErrBadRequest
+		}
 	}
 
+	return validateRequest(logger, request)
+}
+
+// validateRequest reports a request that fails validation as
+// Error_InvalidRequest. Requests that do not arrive over HTTP, e.g. gRPC
+// requests, are checked with it directly.
+func validateRequest(logger lager.Logger, request MessageValidator) error {
 	if err := request.Validate(); err != nil {
 		logger.Error("invalid-request", err)
 		return models.NewError(models.Error_InvalidRequest, err.Error())
@@ -152,6 +283,24 @@ func parseRequest(logger lager.Logger, req *http.Request, request MessageValidat
 	return nil
 }
 
+func validateResourceLimits(logger lager.Logger, limits models.ResourceRequestLimits, memoryMb, diskMb int32) error {
+	if err := limits.Validate(memoryMb, diskMb); err != nil {
+		logger.Error("resource-limits-exceeded", err)
+		return models.NewError(models.Error_InvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func validateInstanceLimit(logger lager.Logger, limits models.ResourceRequestLimits, instances int32) error {
+	if err := limits.ValidateInstances(instances); err != nil {
+		logger.Error("instance-limit-exceeded", err, lager.Data{"instances": instances, "max_instances": limits.MaxInstances})
+		return models.NewError(models.Error_InvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
 func exitIfUnrecoverable(logger lager.Logger, exitCh chan<- struct{}, err *models.Error) {
 	if err != nil && err.Type == models.Error_Unrecoverable {
 		logger.Error("unrecoverable-error", err)
@@ -162,15 +311,35 @@ func exitIfUnrecoverable(logger lager.Logger, exitCh chan<- struct{}, err *model
 	}
 }
 
-func writeResponse(w http.ResponseWriter, message proto.Message) {
-	responseBytes, err := proto.Marshal(message)
+// writeResponse encodes the response in the format negotiated for the
+// request; see responseFormat.
+func writeResponse(w http.ResponseWriter, req *http.Request, message proto.Message) {
+	writeResponseWithStatus(w, req, http.StatusOK, message)
+}
+
+// writeErrorResponse answers a request the handlers never saw. The
+// ErrorResponse only sets the error field, which every response shares, so
+// clients decode it as the response they expected.
+func writeErrorResponse(w http.ResponseWriter, req *http.Request, status int, bbsErr *models.Error) {
+	writeResponseWithStatus(w, req, status, &models.ErrorResponse{Error: bbsErr})
+}
+
+func writeResponseWithStatus(w http.ResponseWriter, req *http.Request, status int, message proto.Message) {
+	var responseBytes []byte
+	var err error
+	format := responseFormat(req)
+	if format == jsonFormat {
+		responseBytes, err = json.Marshal(message)
+	} else {
+		responseBytes, err = proto.Marshal(message)
+	}
 	if err != nil {
-		panic("Unable to encode Proto: " + err.Error())
+		panic("Unable to encode response: " + err.Error())
 	}
 
 	w.Header().Set("Content-Length", strconv.Itoa(len(responseBytes)))
-	w.Header().Set("Content-Type", "application/x-protobuf")
-	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", format.contentType())
+	w.WriteHeader(status)
 
 	w.Write(responseBytes)
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/bbs/fake_bbs"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/rep/repfakes"
 	"github.com/gogo/protobuf/proto"
 	. "github.com/onsi/ginkgo"
@@ -54,3 +55,20 @@ func newTestRequest(body interface{}) *http.Request {
 	Expect(err).NotTo(HaveOccurred())
 	return request
 }
+
+// limitedRequestBody returns the body a handler is given for a request of
+// unknown length sent with body through middleware.MaxBodySizeWrap.
+func limitedRequestBody(body proto.Message, maxBytes int64) io.Reader {
+	protoBytes, err := proto.Marshal(body)
+	Expect(err).NotTo(HaveOccurred())
+
+	request, err := http.NewRequest("POST", "", bytes.NewReader(protoBytes))
+	Expect(err).NotTo(HaveOccurred())
+	request.ContentLength = -1
+
+	var limited io.Reader
+	middleware.MaxBodySizeWrap(maxBytes, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limited = r.Body
+	})).ServeHTTP(nil, request)
+	return limited
+}
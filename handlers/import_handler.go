@@ -0,0 +1,391 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/gogo/protobuf/proto"
+)
+
+// ImportBatchSize is the number of records written in each transaction.
+const ImportBatchSize = 100
+
+// Field numbers of ImportRequest.
+const (
+	importRequestForceField   = 1
+	importRequestRecordsField = 2
+)
+
+// errImportFailedExport refuses an ExportResponse that ended with an error,
+// whose records are not the whole store. The error is field 1, like force,
+// but is an embedded message rather than a bool.
+var errImportFailedExport = models.NewError(models.Error_InvalidRequest, "cannot import an export that failed")
+
+type ImportHandler struct {
+	db            db.ImportDB
+	maxRecordSize int64
+	exitChan      chan<- struct{}
+}
+
+// NewImportHandler returns a handler that rejects protobuf records longer than
+// maxRecordSize, and JSON requests longer than it as a whole. A maxRecordSize
+// of 0 or less disables the limit.
+func NewImportHandler(db db.ImportDB, maxRecordSize int64, exitChan chan<- struct{}) *ImportHandler {
+	return &ImportHandler{
+		db:            db,
+		maxRecordSize: maxRecordSize,
+		exitChan:      exitChan,
+	}
+}
+
+// Import writes the Domains, DesiredLRPs, ActualLRPs and Tasks of the request
+// directly into the store, for restoring a backup into an empty one. Protobuf
+// requests are read and written as they arrive, in batches of ImportBatchSize
+// records, so that the request does not have to fit in memory. Unless the
+// request sets force, it is refused with ErrImportStoreNotEmpty when the store
+// already holds records. The response has a result for every record read,
+// with the error of those that were invalid or could not be written.
+func (h *ImportHandler) Import(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("import")
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	response := &models.ImportResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	var records importRecordReader
+	if requestFormat(req) == jsonFormat {
+		reader, err := h.newJSONImportReader(logger, req)
+		if err != nil {
+			response.Error = models.ConvertError(err)
+			return
+		}
+		records = reader
+	} else {
+		records = &protoImportReader{reader: bufio.NewReader(req.Body), maxRecordSize: h.maxRecordSize}
+	}
+
+	importer := &importBatch{logger: logger, db: h.db, response: response}
+	checkedStore := false
+	for {
+		record, err := records.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logger.Error("failed-reading-record", err)
+			response.Error = models.ConvertError(err)
+			return
+		}
+
+		if !checkedStore {
+			checkedStore = true
+			err = h.checkStore(logger, records.Force())
+			if err != nil {
+				response.Error = models.ConvertError(err)
+				return
+			}
+		}
+
+		err = importer.add(record)
+		if err != nil {
+			response.Error = models.ConvertError(err)
+			return
+		}
+	}
+
+	response.Error = models.ConvertError(importer.flush())
+	logger.Info("imported", lager.Data{"records": len(response.Results), "failed": importer.failed})
+}
+
+func (h *ImportHandler) checkStore(logger lager.Logger, force bool) error {
+	if force {
+		logger.Info("forcing-import")
+		return nil
+	}
+
+	empty, err := h.db.IsEmpty(logger)
+	if err != nil {
+		logger.Error("failed-checking-store", err)
+		return err
+	}
+	if !empty {
+		logger.Error("store-not-empty", models.ErrImportStoreNotEmpty)
+		return models.ErrImportStoreNotEmpty
+	}
+	return nil
+}
+
+func (h *ImportHandler) newJSONImportReader(logger lager.Logger, req *http.Request) (*jsonImportReader, error) {
+	body := io.Reader(req.Body)
+	if h.maxRecordSize > 0 {
+		body = io.LimitReader(req.Body, h.maxRecordSize+1)
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		logger.Error("failed-to-read-body", err)
+		return nil, models.ErrUnknownError
+	}
+	if h.maxRecordSize > 0 && int64(len(data)) > h.maxRecordSize {
+		logger.Error("request-body-too-large", nil)
+		return nil, models.ErrRequestEntityTooLarge
+	}
+
+	request := &models.ImportRequest{}
+	err = json.Unmarshal(data, request)
+	if err != nil {
+		logger.Error("failed-to-parse-json-request-body", err)
+		return nil, models.NewError(models.Error_InvalidJSON, err.Error())
+	}
+
+	export := &models.ExportResponse{}
+	if json.Unmarshal(data, export) == nil && export.Error != nil {
+		logger.Error("failed-export", export.Error)
+		return nil, errImportFailedExport
+	}
+
+	return &jsonImportReader{request: request}, nil
+}
+
+// importBatch validates the records as they are added and writes the valid
+// ones in batches of ImportBatchSize, recording the result of each in the
+// response.
+type importBatch struct {
+	logger   lager.Logger
+	db       db.ImportDB
+	response *models.ImportResponse
+
+	records []*models.ImportRecord
+	results []*models.ImportResult
+	failed  int
+}
+
+func (b *importBatch) add(record *models.ImportRecord) error {
+	result := &models.ImportResult{
+		Index: int32(len(b.response.Results)),
+		Kind:  record.Kind(),
+		Guid:  record.Guid(),
+	}
+	b.response.Results = append(b.response.Results, result)
+
+	err := record.Validate()
+	if err != nil {
+		b.logger.Error("invalid-record", err, lager.Data{"index": result.Index, "kind": result.Kind, "guid": result.Guid})
+		result.Error = models.NewError(models.Error_InvalidRecord, err.Error())
+		b.failed++
+		return nil
+	}
+
+	b.records = append(b.records, record)
+	b.results = append(b.results, result)
+	if len(b.records) < ImportBatchSize {
+		return nil
+	}
+	return b.flush()
+}
+
+// flush writes the pending records. It only returns an error that is
+// unrecoverable, after which the import stops.
+func (b *importBatch) flush() error {
+	if len(b.records) == 0 {
+		return nil
+	}
+
+	errs := b.db.ImportRecords(b.logger, b.records)
+
+	var unrecoverable error
+	for i, err := range errs {
+		bbsErr := models.ConvertError(err)
+		if bbsErr == nil {
+			continue
+		}
+
+		b.results[i].Error = bbsErr
+		b.failed++
+		if bbsErr.Type == models.Error_Unrecoverable {
+			unrecoverable = bbsErr
+		}
+	}
+
+	b.records = nil
+	b.results = nil
+	return unrecoverable
+}
+
+type importRecordReader interface {
+	// Next returns the next record of the request, or io.EOF after the last.
+	Next() (*models.ImportRecord, error)
+	// Force returns the force field of the request. It is only known once
+	// Next has been called.
+	Force() bool
+}
+
+type jsonImportReader struct {
+	request *models.ImportRequest
+	next    int
+}
+
+func (r *jsonImportReader) Next() (*models.ImportRecord, error) {
+	if r.next == len(r.request.Records) {
+		return nil, io.EOF
+	}
+	r.next++
+	return r.request.Records[r.next-1], nil
+}
+
+func (r *jsonImportReader) Force() bool {
+	return r.request.Force
+}
+
+// protoImportReader decodes a marshaled ImportRequest one field at a time, so
+// that records can be written before the whole request is received. The
+// force field must come before the first record, as it does when the request
+// is marshaled; unknown fields are skipped, except for the error of an
+// ExportResponse.
+type protoImportReader struct {
+	reader        *bufio.Reader
+	maxRecordSize int64
+
+	force       bool
+	readRecords bool
+}
+
+func (r *protoImportReader) Next() (*models.ImportRecord, error) {
+	for {
+		key, err := binary.ReadUvarint(r.reader)
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, r.readError(err)
+		}
+
+		field, wireType := int(key>>3), int(key&7)
+		switch {
+		case field == importRequestForceField && wireType == proto.WireVarint:
+			value, err := binary.ReadUvarint(r.reader)
+			if err != nil {
+				return nil, r.readError(err)
+			}
+			if r.readRecords {
+				return nil, models.NewError(models.Error_InvalidRequest, "force must precede the records")
+			}
+			r.force = value != 0
+
+		case field == importRequestForceField && wireType == proto.WireBytes:
+			return nil, errImportFailedExport
+
+		case field == importRequestRecordsField && wireType == proto.WireBytes:
+			data, err := r.readBytes()
+			if err != nil {
+				return nil, err
+			}
+
+			record := &models.ImportRecord{}
+			err = record.Unmarshal(data)
+			if err != nil {
+				return nil, models.ErrBadRequest
+			}
+			r.readRecords = true
+			return record, nil
+
+		default:
+			err = r.skip(wireType)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (r *protoImportReader) Force() bool {
+	return r.force
+}
+
+func (r *protoImportReader) readBytes() ([]byte, error) {
+	length, err := binary.ReadUvarint(r.reader)
+	if err != nil {
+		return nil, r.readError(err)
+	}
+	if r.maxRecordSize > 0 && length > uint64(r.maxRecordSize) {
+		return nil, models.ErrRequestEntityTooLarge
+	}
+
+	data := make([]byte, length)
+	_, err = io.ReadFull(r.reader, data)
+	if err != nil {
+		return nil, r.readError(err)
+	}
+	return data, nil
+}
+
+func (r *protoImportReader) skip(wireType int) error {
+	var err error
+	switch wireType {
+	case proto.WireVarint:
+		_, err = binary.ReadUvarint(r.reader)
+	case proto.WireFixed64:
+		_, err = r.reader.Discard(8)
+	case proto.WireFixed32:
+		_, err = r.reader.Discard(4)
+	case proto.WireBytes:
+		_, err = r.readBytes()
+		return err
+	default:
+		return models.ErrBadRequest
+	}
+	if err != nil {
+		return r.readError(err)
+	}
+	return nil
+}
+
+// readError reports a request that ends in the middle of a field as a bad
+// request.
+func (r *protoImportReader) readError(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return models.ErrBadRequest
+	}
+	return models.ErrUnknownError
+}
+
+// Export streams every Domain, DesiredLRP, ActualLRP and Task in the store,
+// all read as of a single point in time, as an ExportResponse. Unless it ends
+// with an error, the response is also a valid ImportRequest, without force,
+// so that a backup can be restored by sending it to Import unchanged.
+func (h *ImportHandler) Export(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("export")
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	stream := newResponseStream(w, req, "records")
+	var bbsErr *models.Error
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, bbsErr) }()
+	defer func() { stream.Finish(logger, bbsErr) }()
+	defer func() { audit.SetError(req, bbsErr) }()
+
+	exported := 0
+	err := h.db.ExportRecords(logger, func(record *models.ImportRecord) error {
+		exported++
+		return stream.WriteItem(record)
+	})
+	if err != nil {
+		logger.Error("failed-exporting-records", err)
+		bbsErr = models.ConvertError(err)
+		return
+	}
+	logger.Info("exported", lager.Data{"records": exported})
+}
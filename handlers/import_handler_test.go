@@ -0,0 +1,435 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Import Handler", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeImportDB     *dbfakes.FakeImportDB
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.ImportHandler
+		exitCh           chan struct{}
+
+		records     []*models.ImportRecord
+		force       bool
+		requestBody interface{}
+		jsonRequest bool
+		response    *models.ImportResponse
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeImportDB = new(dbfakes.FakeImportDB)
+		fakeImportDB.IsEmptyReturns(true, nil)
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewImportHandler(fakeImportDB, 1024*1024, exitCh)
+
+		force = false
+		records = []*models.ImportRecord{
+			{Domain: &models.ImportDomain{Domain: "some-domain", Ttl: 60}},
+			{DesiredLrp: model_helpers.NewValidDesiredLRP("some-process-guid")},
+			{Task: model_helpers.NewValidTask("some-task-guid")},
+		}
+		requestBody = nil
+		jsonRequest = false
+	})
+
+	JustBeforeEach(func() {
+		if requestBody == nil {
+			requestBody = &models.ImportRequest{Force: force, Records: records}
+		}
+
+		var request *http.Request
+		if jsonRequest {
+			jsonBytes, err := json.Marshal(requestBody)
+			Expect(err).NotTo(HaveOccurred())
+			request = newTestRequest(bytes.NewReader(jsonBytes))
+			request.Header.Set("Content-Type", "application/json")
+		} else {
+			request = newTestRequest(requestBody)
+		}
+		handler.Import(logger, responseRecorder, request)
+
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		response = &models.ImportResponse{}
+		if jsonRequest {
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), response)).To(Succeed())
+		} else {
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+		}
+	})
+
+	It("imports the records", func() {
+		Expect(response.Error).To(BeNil())
+		Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(1))
+		_, imported := fakeImportDB.ImportRecordsArgsForCall(0)
+		Expect(imported).To(Equal(records))
+	})
+
+	It("responds with a result for each record", func() {
+		Expect(response.Results).To(Equal([]*models.ImportResult{
+			{Index: 0, Kind: models.ImportKindDomain, Guid: "some-domain"},
+			{Index: 1, Kind: models.ImportKindDesiredLRP, Guid: "some-process-guid"},
+			{Index: 2, Kind: models.ImportKindTask, Guid: "some-task-guid"},
+		}))
+	})
+
+	Context("when the store is not empty", func() {
+		BeforeEach(func() {
+			fakeImportDB.IsEmptyReturns(false, nil)
+		})
+
+		It("refuses the import", func() {
+			Expect(response.Error).To(Equal(models.ErrImportStoreNotEmpty))
+			Expect(response.Results).To(BeEmpty())
+			Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(0))
+		})
+
+		Context("and the import is forced", func() {
+			BeforeEach(func() {
+				force = true
+			})
+
+			It("imports the records without checking the store", func() {
+				Expect(response.Error).To(BeNil())
+				Expect(fakeImportDB.IsEmptyCallCount()).To(Equal(0))
+				Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when checking the store fails", func() {
+		BeforeEach(func() {
+			fakeImportDB.IsEmptyReturns(false, models.ErrUnknownError)
+		})
+
+		It("responds with the error and imports nothing", func() {
+			Expect(response.Error).To(Equal(models.ErrUnknownError))
+			Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there are no records", func() {
+		BeforeEach(func() {
+			records = nil
+			fakeImportDB.IsEmptyReturns(false, nil)
+		})
+
+		It("does nothing", func() {
+			Expect(response.Error).To(BeNil())
+			Expect(response.Results).To(BeEmpty())
+			Expect(fakeImportDB.IsEmptyCallCount()).To(Equal(0))
+			Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a record is invalid", func() {
+		BeforeEach(func() {
+			invalidTask := model_helpers.NewValidTask("invalid-task-guid")
+			invalidTask.Domain = ""
+			records = append(records, &models.ImportRecord{Task: invalidTask}, &models.ImportRecord{})
+		})
+
+		It("imports only the valid records", func() {
+			Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(1))
+			_, imported := fakeImportDB.ImportRecordsArgsForCall(0)
+			Expect(imported).To(Equal(records[:3]))
+		})
+
+		It("reports the invalid records in their results", func() {
+			Expect(response.Error).To(BeNil())
+			Expect(response.Results).To(HaveLen(5))
+			Expect(response.Results[3].Guid).To(Equal("invalid-task-guid"))
+			Expect(response.Results[3].Error.Type).To(Equal(models.Error_InvalidRecord))
+			Expect(response.Results[3].Error.Message).To(ContainSubstring("domain"))
+			Expect(response.Results[4].Error.Type).To(Equal(models.Error_InvalidRecord))
+		})
+	})
+
+	Context("when some records fail to be written", func() {
+		BeforeEach(func() {
+			fakeImportDB.ImportRecordsReturns([]error{nil, models.ErrResourceConflict, nil})
+		})
+
+		It("reports the failures in their results", func() {
+			Expect(response.Error).To(BeNil())
+			Expect(response.Results[0].Error).To(BeNil())
+			Expect(response.Results[1].Error).To(Equal(models.ErrResourceConflict))
+			Expect(response.Results[2].Error).To(BeNil())
+		})
+	})
+
+	Context("when writing the records fails unrecoverably", func() {
+		BeforeEach(func() {
+			fakeImportDB.ImportRecordsReturns([]error{models.NewUnrecoverableError(nil), nil, nil})
+		})
+
+		It("responds with the error and exits", func() {
+			Expect(response.Error.Type).To(Equal(models.Error_Unrecoverable))
+			Eventually(exitCh).Should(Receive())
+		})
+	})
+
+	Context("when there are more records than fit in a batch", func() {
+		BeforeEach(func() {
+			records = nil
+			for i := 0; i < handlers.ImportBatchSize*2+1; i++ {
+				records = append(records, &models.ImportRecord{Domain: &models.ImportDomain{Domain: fmt.Sprintf("domain-%d", i)}})
+			}
+		})
+
+		It("writes the records in batches", func() {
+			Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(3))
+			for i, size := range []int{handlers.ImportBatchSize, handlers.ImportBatchSize, 1} {
+				_, imported := fakeImportDB.ImportRecordsArgsForCall(i)
+				Expect(imported).To(Equal(records[i*handlers.ImportBatchSize : i*handlers.ImportBatchSize+size]))
+			}
+			Expect(response.Results).To(HaveLen(len(records)))
+		})
+
+		Context("and a batch fails unrecoverably", func() {
+			BeforeEach(func() {
+				fakeImportDB.ImportRecordsStub = func(logger lager.Logger, records []*models.ImportRecord) []error {
+					errs := make([]error, len(records))
+					errs[0] = models.NewUnrecoverableError(nil)
+					return errs
+				}
+			})
+
+			It("stops importing", func() {
+				Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(1))
+				Expect(response.Error.Type).To(Equal(models.Error_Unrecoverable))
+			})
+		})
+	})
+
+	Context("when force follows the records", func() {
+		BeforeEach(func() {
+			record, err := records[0].Marshal()
+			Expect(err).NotTo(HaveOccurred())
+
+			body := append([]byte{0x12, byte(len(record))}, record...)
+			requestBody = append(body, 0x08, 0x01)
+		})
+
+		It("responds with an invalid request error", func() {
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a record is larger than the limit", func() {
+		BeforeEach(func() {
+			handler = handlers.NewImportHandler(fakeImportDB, 16, exitCh)
+		})
+
+		It("responds with a request entity too large error", func() {
+			Expect(response.Error).To(Equal(models.ErrRequestEntityTooLarge))
+			Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the request is cut off in the middle of a record", func() {
+		BeforeEach(func() {
+			body, err := (&models.ImportRequest{Records: records}).Marshal()
+			Expect(err).NotTo(HaveOccurred())
+			requestBody = body[:len(body)-1]
+		})
+
+		It("responds with a bad request error", func() {
+			Expect(response.Error).To(Equal(models.ErrBadRequest))
+		})
+	})
+
+	Context("when the request is an export that failed", func() {
+		BeforeEach(func() {
+			requestBody = &models.ExportResponse{Error: models.ErrUnknownError, Records: records}
+		})
+
+		It("responds with an invalid request error and imports nothing", func() {
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the request is JSON", func() {
+		BeforeEach(func() {
+			jsonRequest = true
+		})
+
+		It("imports the records", func() {
+			Expect(response.Error).To(BeNil())
+			Expect(response.Results).To(HaveLen(3))
+			Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(1))
+			_, imported := fakeImportDB.ImportRecordsArgsForCall(0)
+			Expect(imported).To(HaveLen(3))
+			Expect(imported[2].Task.TaskGuid).To(Equal("some-task-guid"))
+		})
+
+		Context("and the store is not empty", func() {
+			BeforeEach(func() {
+				fakeImportDB.IsEmptyReturns(false, nil)
+			})
+
+			It("refuses the import", func() {
+				Expect(response.Error).To(Equal(models.ErrImportStoreNotEmpty))
+				Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("and the request is an export that failed", func() {
+			BeforeEach(func() {
+				requestBody = &models.ExportResponse{Error: models.ErrUnknownError, Records: records}
+			})
+
+			It("responds with an invalid request error and imports nothing", func() {
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("and the request is larger than the limit", func() {
+			BeforeEach(func() {
+				handler = handlers.NewImportHandler(fakeImportDB, 16, exitCh)
+			})
+
+			It("responds with a request entity too large error", func() {
+				Expect(response.Error).To(Equal(models.ErrRequestEntityTooLarge))
+			})
+		})
+	})
+})
+
+var _ = Describe("Export Handler", func() {
+	var (
+		logger         *lagertest.TestLogger
+		fakeImportDB   *dbfakes.FakeImportDB
+		handler        *handlers.ImportHandler
+		exitCh         chan struct{}
+		records        []*models.ImportRecord
+		jsonResponse   bool
+		recorder       *httptest.ResponseRecorder
+		exportResponse *models.ExportResponse
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeImportDB = new(dbfakes.FakeImportDB)
+		fakeImportDB.IsEmptyReturns(true, nil)
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewImportHandler(fakeImportDB, 1024*1024, exitCh)
+		jsonResponse = false
+
+		records = []*models.ImportRecord{
+			{Domain: &models.ImportDomain{Domain: "some-domain", Ttl: 60}},
+			{DesiredLrp: model_helpers.NewValidDesiredLRP("some-process-guid")},
+			{ActualLrp: model_helpers.NewValidActualLRP("some-process-guid", 0)},
+			{Task: model_helpers.NewValidTask("some-task-guid")},
+		}
+		fakeImportDB.ExportRecordsStub = func(_ lager.Logger, yield func(*models.ImportRecord) error) error {
+			for _, record := range records {
+				err := yield(record)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		request := newTestRequest("")
+		if jsonResponse {
+			request.Header.Set("Accept", "application/json")
+		}
+		handler.Export(logger, recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		exportResponse = &models.ExportResponse{}
+		if jsonResponse {
+			Expect(json.Unmarshal(recorder.Body.Bytes(), exportResponse)).To(Succeed())
+		} else {
+			Expect(exportResponse.Unmarshal(recorder.Body.Bytes())).To(Succeed())
+		}
+	})
+
+	It("streams every record of the store", func() {
+		Expect(exportResponse.Error).To(BeNil())
+		Expect(exportResponse.Records).To(Equal(records))
+	})
+
+	It("responds with a request that imports the records", func() {
+		importRequest := &models.ImportRequest{}
+		Expect(importRequest.Unmarshal(recorder.Body.Bytes())).To(Succeed())
+		Expect(importRequest.Force).To(BeFalse())
+		Expect(importRequest.Records).To(Equal(records))
+
+		importRecorder := httptest.NewRecorder()
+		handler.Import(logger, importRecorder, newTestRequest(recorder.Body.Bytes()))
+		response := &models.ImportResponse{}
+		Expect(response.Unmarshal(importRecorder.Body.Bytes())).To(Succeed())
+		Expect(response.Error).To(BeNil())
+		Expect(response.Results).To(HaveLen(len(records)))
+
+		Expect(fakeImportDB.ImportRecordsCallCount()).To(Equal(1))
+		_, imported := fakeImportDB.ImportRecordsArgsForCall(0)
+		Expect(imported).To(Equal(records))
+	})
+
+	Context("when the response is JSON", func() {
+		BeforeEach(func() {
+			jsonResponse = true
+		})
+
+		It("writes the records under the key of the import request", func() {
+			Expect(exportResponse.Records).To(HaveLen(len(records)))
+
+			importRequest := &models.ImportRequest{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), importRequest)).To(Succeed())
+			Expect(importRequest.Records).To(HaveLen(len(records)))
+		})
+	})
+
+	Context("when reading the store fails", func() {
+		BeforeEach(func() {
+			fakeImportDB.ExportRecordsStub = func(_ lager.Logger, yield func(*models.ImportRecord) error) error {
+				Expect(yield(records[0])).To(Succeed())
+				return models.ErrUnknownError
+			}
+		})
+
+		It("ends the response with the error", func() {
+			Expect(exportResponse.Records).To(HaveLen(1))
+			Expect(exportResponse.Error).To(Equal(models.ErrUnknownError))
+		})
+	})
+
+	Context("when reading the store fails unrecoverably", func() {
+		BeforeEach(func() {
+			fakeImportDB.ExportRecordsStub = nil
+			fakeImportDB.ExportRecordsReturns(models.NewUnrecoverableError(nil))
+		})
+
+		It("responds with the error and exits", func() {
+			Expect(exportResponse.Error.Type).To(Equal(models.Error_Unrecoverable))
+			Eventually(exitCh).Should(Receive())
+		})
+	})
+})
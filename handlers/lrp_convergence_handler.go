@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_lrp_convergence_controller.go . LRPConvergenceController
+
+type LRPConvergenceController interface {
+	ConvergeLRPs(logger lager.Logger, filter models.ConvergenceFilter) error
+}
+
+type LRPConvergenceHandler struct {
+	controller LRPConvergenceController
+	exitChan   chan<- struct{}
+}
+
+func NewLRPConvergenceHandler(controller LRPConvergenceController, exitChan chan<- struct{}) *LRPConvergenceHandler {
+	return &LRPConvergenceHandler{
+		controller: controller,
+		exitChan:   exitChan,
+	}
+}
+
+// ConvergeLRPs runs a convergence pass over the requested domains, or over
+// every domain when none are given.
+func (h *LRPConvergenceHandler) ConvergeLRPs(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("converge-lrps")
+
+	request := &models.ConvergeLRPsRequest{}
+	response := &models.ConvergeLRPsResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.controller.ConvergeLRPs(logger, models.ConvergenceFilter{Domains: request.Domains})
+	response.Error = models.ConvertError(err)
+}
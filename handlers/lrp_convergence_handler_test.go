@@ -0,0 +1,95 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("LRP Convergence Handler", func() {
+	var (
+		logger           *lagertest.TestLogger
+		controller       *fake_controllers.FakeLRPConvergenceController
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.LRPConvergenceHandler
+		exitCh           chan struct{}
+
+		requestBody *models.ConvergeLRPsRequest
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = new(fake_controllers.FakeLRPConvergenceController)
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewLRPConvergenceHandler(controller, exitCh)
+
+		requestBody = &models.ConvergeLRPsRequest{}
+	})
+
+	Describe("ConvergeLRPs", func() {
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.ConvergeLRPs(logger, responseRecorder, request)
+		})
+
+		Context("when no domains are given", func() {
+			It("converges every domain", func() {
+				Expect(controller.ConvergeLRPsCallCount()).To(Equal(1))
+				_, filter := controller.ConvergeLRPsArgsForCall(0)
+				Expect(filter.IsScoped()).To(BeFalse())
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.ConvergeLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(BeNil())
+			})
+		})
+
+		Context("when domains are given", func() {
+			BeforeEach(func() {
+				requestBody.Domains = []string{"domain-1", "domain-2"}
+			})
+
+			It("converges only those domains", func() {
+				Expect(controller.ConvergeLRPsCallCount()).To(Equal(1))
+				_, filter := controller.ConvergeLRPsArgsForCall(0)
+				Expect(filter).To(Equal(models.ConvergenceFilter{Domains: []string{"domain-1", "domain-2"}}))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody.Domains = []string{""}
+			})
+
+			It("responds with an error and does not converge", func() {
+				Expect(controller.ConvergeLRPsCallCount()).To(Equal(0))
+
+				response := &models.ConvergeLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when convergence returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				controller.ConvergeLRPsReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+})
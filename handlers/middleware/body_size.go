@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs/models"
+	"github.com/gogo/protobuf/proto"
+)
+
+// ErrRequestBodyTooLarge is returned when reading more of a request body
+// than MaxBodySizeWrap allows.
+var ErrRequestBodyTooLarge = errors.New("request body too large")
+
+// MaxBodySizeWrap rejects requests whose body is longer than maxBytes.
+// Requests that declare a larger Content-Length are answered with 413 Request
+// Entity Too Large and a models.Error of type Error_RequestEntityTooLarge
+// without being served. The bodies of all other requests fail with
+// ErrRequestBodyTooLarge once more than maxBytes have been read, which
+// catches chunked requests that do not declare their length. A maxBytes of 0
+// or less disables the limit.
+func MaxBodySizeWrap(maxBytes int64, handler http.Handler) http.Handler {
+	return MaxBodySizeWrapExcept(maxBytes, nil, handler)
+}
+
+// MaxBodySizeWrapExcept is MaxBodySizeWrap for every request but those for
+// which exempt returns true, which are served without a limit. Handlers of
+// exempt requests are expected to bound what they read themselves.
+func MaxBodySizeWrapExcept(maxBytes int64, exempt func(*http.Request) bool, handler http.Handler) http.Handler {
+	if maxBytes <= 0 {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exempt != nil && exempt(r) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		if r.ContentLength > maxBytes {
+			writeRequestEntityTooLarge(w)
+			return
+		}
+
+		if r.Body != nil {
+			r.Body = &limitedBody{ReadCloser: r.Body, remaining: maxBytes}
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, ErrRequestBodyTooLarge
+	}
+
+	// read one byte past the limit so that a body of exactly maxBytes can
+	// still reach io.EOF
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n + int(b.remaining), ErrRequestBodyTooLarge
+	}
+	return n, err
+}
+
+func writeRequestEntityTooLarge(w http.ResponseWriter) {
+	responseBytes, err := proto.Marshal(&models.ErrorResponse{Error: models.ErrRequestEntityTooLarge})
+	if err != nil {
+		panic("Unable to encode Proto: " + err.Error())
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(responseBytes)))
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+
+	w.Write(responseBytes)
+}
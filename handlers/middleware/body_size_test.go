@@ -0,0 +1,135 @@
+package middleware_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MaxBodySizeWrap", func() {
+	const maxBytes = 10
+
+	var (
+		served   bool
+		body     []byte
+		readErr  error
+		handler  http.Handler
+		recorder *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		served = false
+		body = nil
+		readErr = nil
+		recorder = httptest.NewRecorder()
+
+		handler = middleware.MaxBodySizeWrap(maxBytes, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served = true
+			body, readErr = ioutil.ReadAll(r.Body)
+		}))
+	})
+
+	newRequest := func(body string, declareLength bool) *http.Request {
+		request, err := http.NewRequest("POST", "/v1/some/route", strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		if !declareLength {
+			request.ContentLength = -1
+		}
+		return request
+	}
+
+	Context("when the body is within the limit", func() {
+		It("serves the request with the whole body", func() {
+			handler.ServeHTTP(recorder, newRequest("123456789", true))
+			Expect(served).To(BeTrue())
+			Expect(readErr).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("123456789"))
+		})
+	})
+
+	Context("when the body is exactly at the limit", func() {
+		It("serves the request with the whole body", func() {
+			handler.ServeHTTP(recorder, newRequest("1234567890", false))
+			Expect(served).To(BeTrue())
+			Expect(readErr).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("1234567890"))
+		})
+	})
+
+	Context("when the declared content length is beyond the limit", func() {
+		BeforeEach(func() {
+			handler.ServeHTTP(recorder, newRequest("12345678901", true))
+		})
+
+		It("does not serve the request", func() {
+			Expect(served).To(BeFalse())
+		})
+
+		It("responds with 413 and a request entity too large error", func() {
+			Expect(recorder.Code).To(Equal(http.StatusRequestEntityTooLarge))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/x-protobuf"))
+
+			response := &models.ErrorResponse{}
+			Expect(response.Unmarshal(recorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(Equal(models.ErrRequestEntityTooLarge))
+		})
+	})
+
+	Context("when a body of unknown length goes beyond the limit", func() {
+		It("fails reading the body once the limit is passed", func() {
+			handler.ServeHTTP(recorder, newRequest("12345678901", false))
+			Expect(served).To(BeTrue())
+			Expect(readErr).To(Equal(middleware.ErrRequestBodyTooLarge))
+			Expect(string(body)).To(Equal("1234567890"))
+		})
+	})
+
+	Context("when the limit is not positive", func() {
+		BeforeEach(func() {
+			handler = middleware.MaxBodySizeWrap(0, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				served = true
+				body, readErr = ioutil.ReadAll(r.Body)
+			}))
+		})
+
+		It("does not limit the body", func() {
+			handler.ServeHTTP(recorder, newRequest(strings.Repeat("a", 1024), true))
+			Expect(served).To(BeTrue())
+			Expect(readErr).NotTo(HaveOccurred())
+			Expect(body).To(HaveLen(1024))
+		})
+	})
+
+	Context("when the request is exempt", func() {
+		BeforeEach(func() {
+			exempt := func(r *http.Request) bool { return r.URL.Path == "/v1/exempt/route" }
+			handler = middleware.MaxBodySizeWrapExcept(maxBytes, exempt, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				served = true
+				body, readErr = ioutil.ReadAll(r.Body)
+			}))
+		})
+
+		It("does not limit the body", func() {
+			request, err := http.NewRequest("POST", "/v1/exempt/route", strings.NewReader(strings.Repeat("a", 1024)))
+			Expect(err).NotTo(HaveOccurred())
+
+			handler.ServeHTTP(recorder, request)
+			Expect(served).To(BeTrue())
+			Expect(readErr).NotTo(HaveOccurred())
+			Expect(body).To(HaveLen(1024))
+		})
+
+		It("still limits the bodies of other requests", func() {
+			handler.ServeHTTP(recorder, newRequest("12345678901", true))
+			Expect(served).To(BeFalse())
+			Expect(recorder.Code).To(Equal(http.StatusRequestEntityTooLarge))
+		})
+	})
+})
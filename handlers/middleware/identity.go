@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+)
+
+// AnonymousIdentity is the identity of clients that did not present a
+// certificate, including every client when the BBS does not require one.
+const AnonymousIdentity = "anonymous"
+
+type identityKey struct{}
+
+// IdentityWrap attaches the identity of the client to the request's context,
+// where Identity finds it.
+func IdentityWrap(handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		identity, _ := PeerIdentity(r)
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), identityKey{}, identity)))
+	}
+}
+
+// Identity returns the identity of the client making the request. Requests
+// that did not pass through IdentityWrap have it extracted from their
+// certificate.
+func Identity(r *http.Request) string {
+	if identity, ok := r.Context().Value(identityKey{}).(string); ok {
+		return identity
+	}
+
+	identity, _ := PeerIdentity(r)
+	return identity
+}
+
+// PeerIdentity names the client by the subject common name of its
+// certificate, falling back to the first subject alternative name when the
+// common name is empty. It returns AnonymousIdentity and false when the
+// client did not present a certificate.
+func PeerIdentity(r *http.Request) (string, bool) {
+	return ConnectionIdentity(r.TLS)
+}
+
+// ConnectionIdentity is PeerIdentity for the client of a TLS connection
+// that was not made over HTTP, e.g. a gRPC client. A nil state means the
+// connection does not use TLS.
+func ConnectionIdentity(state *tls.ConnectionState) (string, bool) {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return AnonymousIdentity, false
+	}
+
+	cert := state.PeerCertificates[0]
+	switch {
+	case cert.Subject.CommonName != "":
+		return cert.Subject.CommonName, true
+	case len(cert.DNSNames) > 0:
+		return cert.DNSNames[0], true
+	case len(cert.EmailAddresses) > 0:
+		return cert.EmailAddresses[0], true
+	case len(cert.IPAddresses) > 0:
+		return cert.IPAddresses[0].String(), true
+	}
+
+	return AnonymousIdentity, true
+}
@@ -0,0 +1,170 @@
+package middleware_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Identity", func() {
+	var (
+		ca     *testCA
+		server *httptest.Server
+		logger *lagertest.TestLogger
+	)
+
+	BeforeEach(func() {
+		ca = newTestCA()
+		logger = lagertest.NewTestLogger("test")
+
+		loggableHandler := func(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+			logger.Info("handling")
+			w.Write([]byte(middleware.Identity(r)))
+		}
+
+		server = httptest.NewUnstartedServer(middleware.IdentityWrap(middleware.LogWrap(logger, nil, loggableHandler)))
+		server.TLS = &tls.Config{
+			ClientAuth: tls.VerifyClientCertIfGiven,
+			ClientCAs:  ca.pool(),
+		}
+		server.StartTLS()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	get := func(certs ...tls.Certificate) string {
+		client := &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					Certificates:       certs,
+					InsecureSkipVerify: true,
+				},
+			},
+		}
+
+		resp, err := client.Get(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(body)
+	}
+
+	Context("when the client presents a certificate", func() {
+		It("identifies the client by its common name", func() {
+			Expect(get(ca.issue(pkix.Name{CommonName: "some-client"}, nil))).To(Equal("some-client"))
+		})
+
+		It("adds the identity to the request's logger", func() {
+			get(ca.issue(pkix.Name{CommonName: "some-client"}, nil))
+			Expect(logger).To(gbytes.Say(`"identity":"some-client"`))
+		})
+
+		Context("without a common name", func() {
+			It("identifies the client by its first subject alternative name", func() {
+				identity := get(ca.issue(pkix.Name{}, []string{"client.example.com", "other.example.com"}))
+				Expect(identity).To(Equal("client.example.com"))
+			})
+		})
+	})
+
+	Context("when the client does not present a certificate", func() {
+		It("identifies the client as anonymous", func() {
+			Expect(get()).To(Equal(middleware.AnonymousIdentity))
+			Expect(logger).To(gbytes.Say(`"identity":"anonymous"`))
+		})
+	})
+
+	Describe("a request that did not pass through IdentityWrap", func() {
+		It("is identified by its certificate", func() {
+			cert := ca.issue(pkix.Name{CommonName: "some-client"}, nil)
+			leaf, err := x509.ParseCertificate(cert.Certificate[0])
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("GET", "http://example.com", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(middleware.Identity(req)).To(Equal(middleware.AnonymousIdentity))
+
+			req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}}
+			Expect(middleware.Identity(req)).To(Equal("some-client"))
+
+			identity, ok := middleware.PeerIdentity(req)
+			Expect(ok).To(BeTrue())
+			Expect(identity).To(Equal("some-client"))
+		})
+	})
+})
+
+type testCA struct {
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	serial int64
+}
+
+func newTestCA() *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "testCA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+
+	return &testCA{cert: cert, key: key, serial: 1}
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+func (ca *testCA) issue(subject pkix.Name, dnsNames []string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	ca.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial),
+		Subject:      subject,
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
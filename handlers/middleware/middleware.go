@@ -18,8 +18,9 @@ type LoggableHandlerFunc func(logger lager.Logger, w http.ResponseWriter, r *htt
 func LogWrap(logger, accessLogger lager.Logger, loggableHandlerFunc LoggableHandlerFunc) http.HandlerFunc {
 	lagerDataFromReq := func(r *http.Request) lager.Data {
 		return lager.Data{
-			"method":  r.Method,
-			"request": r.URL.String(),
+			"method":   r.Method,
+			"request":  r.URL.String(),
+			"identity": Identity(r),
 		}
 	}
 
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type ObjectCountsHandler struct {
+	db       db.CountsDB
+	exitChan chan<- struct{}
+}
+
+func NewObjectCountsHandler(db db.CountsDB, exitChan chan<- struct{}) *ObjectCountsHandler {
+	return &ObjectCountsHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *ObjectCountsHandler) ObjectCounts(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("object-counts")
+
+	request := &models.ObjectCountsRequest{}
+	response := &models.ObjectCountsResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer writeResponse(w, req, response)
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	counts, err := h.db.ObjectCounts(logger, request.GroupsByDomain(), request.GroupsByState())
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.DesiredLrps = counts.DesiredLRPs
+	response.ActualLrps = counts.ActualLRPs
+	response.Tasks = counts.Tasks
+}
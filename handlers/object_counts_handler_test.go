@@ -0,0 +1,125 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ObjectCounts Handler", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeCountsDB     *dbfakes.FakeCountsDB
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.ObjectCountsHandler
+		exitCh           chan struct{}
+		requestBody      interface{}
+		response         *models.ObjectCountsResponse
+		counts           *db.ObjectCounts
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeCountsDB = new(dbfakes.FakeCountsDB)
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewObjectCountsHandler(fakeCountsDB, exitCh)
+		requestBody = &models.ObjectCountsRequest{}
+
+		counts = &db.ObjectCounts{
+			DesiredLRPs: &models.ObjectCount{Total: 2},
+			ActualLRPs:  &models.ObjectCount{Total: 5},
+			Tasks:       &models.ObjectCount{Total: 3},
+		}
+		fakeCountsDB.ObjectCountsReturns(counts, nil)
+	})
+
+	JustBeforeEach(func() {
+		request := newTestRequest(requestBody)
+		handler.ObjectCounts(logger, responseRecorder, request)
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+		response = &models.ObjectCountsResponse{}
+		err := response.Unmarshal(responseRecorder.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns the counts without grouping them", func() {
+		Expect(fakeCountsDB.ObjectCountsCallCount()).To(Equal(1))
+		_, byDomain, byState := fakeCountsDB.ObjectCountsArgsForCall(0)
+		Expect(byDomain).To(BeFalse())
+		Expect(byState).To(BeFalse())
+
+		Expect(response.Error).To(BeNil())
+		Expect(response.DesiredLrps).To(Equal(counts.DesiredLRPs))
+		Expect(response.ActualLrps).To(Equal(counts.ActualLRPs))
+		Expect(response.Tasks).To(Equal(counts.Tasks))
+	})
+
+	Context("when grouping by domain", func() {
+		BeforeEach(func() {
+			requestBody = &models.ObjectCountsRequest{GroupBy: []string{models.CountGroupByDomain}}
+			counts.Tasks.ByDomain = map[string]int32{"domain-1": 3}
+		})
+
+		It("groups the counts by domain", func() {
+			_, byDomain, byState := fakeCountsDB.ObjectCountsArgsForCall(0)
+			Expect(byDomain).To(BeTrue())
+			Expect(byState).To(BeFalse())
+			Expect(response.Tasks).To(Equal(counts.Tasks))
+		})
+	})
+
+	Context("when grouping by domain and state", func() {
+		BeforeEach(func() {
+			requestBody = &models.ObjectCountsRequest{GroupBy: []string{models.CountGroupByState, models.CountGroupByDomain}}
+		})
+
+		It("groups the counts by both", func() {
+			_, byDomain, byState := fakeCountsDB.ObjectCountsArgsForCall(0)
+			Expect(byDomain).To(BeTrue())
+			Expect(byState).To(BeTrue())
+		})
+	})
+
+	Context("when grouping by an unsupported dimension", func() {
+		BeforeEach(func() {
+			requestBody = &models.ObjectCountsRequest{GroupBy: []string{"cell"}}
+		})
+
+		It("responds with an invalid request error", func() {
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			Expect(fakeCountsDB.ObjectCountsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when counting fails", func() {
+		BeforeEach(func() {
+			fakeCountsDB.ObjectCountsReturns(nil, models.ErrUnknownError)
+		})
+
+		It("responds with the error", func() {
+			Expect(response.Error).To(Equal(models.ErrUnknownError))
+			Expect(response.Tasks).To(BeNil())
+		})
+	})
+
+	Context("when the DB returns an unrecoverable error", func() {
+		BeforeEach(func() {
+			fakeCountsDB.ObjectCountsReturns(nil, models.NewUnrecoverableError(nil))
+		})
+
+		It("logs and writes to the exit channel", func() {
+			Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+			Eventually(exitCh).Should(Receive())
+		})
+	})
+})
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Field numbers shared by the list responses that are streamed, e.g.
+// TasksResponse and DesiredLRPSchedulingInfosResponse.
+const (
+	listResponseErrorField        = 1
+	listResponseItemsField        = 2
+	listResponseRecordErrorsField = 3
+)
+
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// responseStream writes a list response one element at a time instead of
+// marshaling the whole response up front. Each element is encoded as an
+// embedded message in the repeated field, so the bytes on the wire are
+// identical to proto.Marshal of the full response and clients need no
+// changes to read it.
+//
+// When the client asked for JSON, the response is written as a JSON object
+// with the elements in an array under itemsKey, the JSON name of the repeated
+// field, so that it decodes as the full response would.
+type responseStream struct {
+	w            http.ResponseWriter
+	format       wireFormat
+	itemsKey     string
+	started      bool
+	items        int
+	recordErrors []*models.RecordError
+	writeErr     error
+}
+
+func newResponseStream(w http.ResponseWriter, req *http.Request, itemsKey string) *responseStream {
+	return &responseStream{w: w, format: responseFormat(req), itemsKey: itemsKey}
+}
+
+func (s *responseStream) WriteItem(item marshaler) error {
+	if s.format == jsonFormat {
+		return s.writeJSONItem(item)
+	}
+	return s.writeField(listResponseItemsField, item)
+}
+
+// WriteRecordErrors writes the records that could not be read, in the
+// record_errors field of responses that return partial results. In JSON they
+// are held until Finish, after the items array is closed.
+func (s *responseStream) WriteRecordErrors(recordErrors []*models.RecordError) {
+	if s.format == jsonFormat {
+		s.recordErrors = append(s.recordErrors, recordErrors...)
+		return
+	}
+	for _, recordError := range recordErrors {
+		s.writeField(listResponseRecordErrorsField, recordError)
+	}
+}
+
+// Finish writes the error, if any, as the last field of the response. The
+// headers are sent here if no items were written.
+func (s *responseStream) Finish(logger lager.Logger, bbsErr *models.Error) {
+	if s.format == jsonFormat {
+		s.finishJSON(bbsErr)
+	} else if bbsErr != nil {
+		s.writeField(listResponseErrorField, bbsErr)
+	} else {
+		s.start()
+	}
+
+	if s.writeErr != nil {
+		logger.Error("failed-writing-response", s.writeErr)
+	}
+}
+
+func (s *responseStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+
+	s.w.Header().Set("Content-Type", s.format.contentType())
+	s.w.WriteHeader(http.StatusOK)
+
+	if s.format == jsonFormat {
+		s.write([]byte(`{"` + s.itemsKey + `":[`))
+	}
+}
+
+func (s *responseStream) writeField(field int, message marshaler) error {
+	if s.writeErr != nil {
+		return s.writeErr
+	}
+
+	messageBytes, err := message.Marshal()
+	if err != nil {
+		panic("Unable to encode Proto: " + err.Error())
+	}
+
+	s.start()
+
+	buf := proto.EncodeVarint(uint64(field<<3 | proto.WireBytes))
+	buf = append(buf, proto.EncodeVarint(uint64(len(messageBytes)))...)
+	buf = append(buf, messageBytes...)
+
+	return s.write(buf)
+}
+
+func (s *responseStream) writeJSONItem(item marshaler) error {
+	if s.writeErr != nil {
+		return s.writeErr
+	}
+
+	itemBytes, err := json.Marshal(item)
+	if err != nil {
+		panic("Unable to encode JSON: " + err.Error())
+	}
+
+	s.start()
+	if s.items > 0 {
+		itemBytes = append([]byte(","), itemBytes...)
+	}
+	s.items++
+
+	return s.write(itemBytes)
+}
+
+func (s *responseStream) finishJSON(bbsErr *models.Error) {
+	s.start()
+
+	buf := []byte("]")
+	if len(s.recordErrors) > 0 {
+		recordErrorsBytes, err := json.Marshal(s.recordErrors)
+		if err != nil {
+			panic("Unable to encode JSON: " + err.Error())
+		}
+		buf = append(buf, `,"record_errors":`...)
+		buf = append(buf, recordErrorsBytes...)
+	}
+	if bbsErr != nil {
+		errBytes, err := json.Marshal(bbsErr)
+		if err != nil {
+			panic("Unable to encode JSON: " + err.Error())
+		}
+		buf = append(buf, `,"error":`...)
+		buf = append(buf, errBytes...)
+	}
+	buf = append(buf, '}')
+
+	s.write(buf)
+}
+
+func (s *responseStream) write(buf []byte) error {
+	if s.writeErr != nil {
+		return s.writeErr
+	}
+
+	_, s.writeErr = s.w.Write(buf)
+	return s.writeErr
+}
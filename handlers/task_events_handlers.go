@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type TaskEventHandler struct {
+	taskHub        events.Hub
+	maxSubscribers int
+	subscribeLock  sync.Mutex
+}
+
+// NewTaskEventHandler returns a handler that refuses new subscriptions with
+// ErrTooManySubscribers while the task hub already has maxSubscribers
+// subscribers. A maxSubscribers of 0 or less disables the limit.
+func NewTaskEventHandler(taskHub events.Hub, maxSubscribers int) *TaskEventHandler {
+	return &TaskEventHandler{
+		taskHub:        taskHub,
+		maxSubscribers: maxSubscribers,
+	}
+}
+
+func (h *TaskEventHandler) Subscribe(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("subscribe-to-task-events")
+
+	source, err := h.subscribe(logger, req.Header.Get("Last-Event-ID"))
+	if err == events.ErrResyncRequired {
+		w.WriteHeader(http.StatusGone)
+		return
+	}
+	if err == models.ErrTooManySubscribers {
+		writeTooManySubscribers(w, req)
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	eventChan := make(chan positionedEvent)
+	errorChan := make(chan error)
+	closeChan := make(chan struct{})
+	defer func() {
+		close(closeChan)
+		source.Close()
+	}()
+
+	go func() {
+		for {
+			event, token, err := source.NextWithToken()
+			if err != nil {
+				select {
+				case errorChan <- err:
+				case <-closeChan:
+				}
+				return
+			}
+
+			select {
+			case eventChan <- positionedEvent{event: event, position: token}:
+			case <-closeChan:
+				return
+			}
+		}
+	}()
+
+	streamEventsToResponse(logger, w, req, eventChan, errorChan)
+}
+
+// subscribe subscribes to the task hub, resuming after lastPosition if it is
+// not empty, or fails with models.ErrTooManySubscribers if the hub already
+// has the maximum number of subscribers.
+func (h *TaskEventHandler) subscribe(logger lager.Logger, lastPosition string) (events.ResumableEventSource, error) {
+	h.subscribeLock.Lock()
+	defer h.subscribeLock.Unlock()
+
+	if tooManySubscribers(h.maxSubscribers, h.taskHub) {
+		logger.Info("too-many-subscribers", lager.Data{"max-subscribers": h.maxSubscribers})
+		return nil, models.ErrTooManySubscribers
+	}
+
+	var source events.ResumableEventSource
+	var err error
+	if lastPosition == "" {
+		source, err = h.taskHub.Subscribe()
+	} else {
+		// An unparseable position resumes from the zero position, which the
+		// hub cannot replay from.
+		token, parseErr := events.ParseResumeToken(lastPosition)
+		if parseErr != nil {
+			logger.Info("invalid-last-event-position", lager.Data{"position": lastPosition})
+		}
+		source, err = h.taskHub.SubscribeFrom(token)
+	}
+
+	if err != nil {
+		logger.Error("failed-to-subscribe-to-task-event-hub", err)
+		return nil, err
+	}
+	return source, nil
+}
@@ -0,0 +1,157 @@
+package handlers_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/vito/go-sse/sse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task Event Handlers", func() {
+	var (
+		logger  lager.Logger
+		taskHub events.Hub
+
+		handler         *handlers.TaskEventHandler
+		eventStreamDone chan struct{}
+		server          *httptest.Server
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		taskHub = events.NewReplayingHub(2, events.RequireResync)
+		handler = handlers.NewTaskEventHandler(taskHub, 0)
+
+		eventStreamDone = make(chan struct{})
+		var doneOnce sync.Once
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.Subscribe(logger, w, r)
+			doneOnce.Do(func() { close(eventStreamDone) })
+		}))
+	})
+
+	AfterEach(func() {
+		taskHub.Close()
+		server.Close()
+	})
+
+	subscribeFrom := func(lastEventID string) *http.Response {
+		request, err := http.NewRequest("GET", server.URL, nil)
+		Expect(err).NotTo(HaveOccurred())
+		if lastEventID != "" {
+			request.Header.Set("Last-Event-ID", lastEventID)
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+		return response
+	}
+
+	Describe("Subscribe", func() {
+		It("streams the task events from the hub", func() {
+			response := subscribeFrom("")
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream; charset=utf-8"))
+
+			task := model_helpers.NewValidTask("some-guid")
+			taskHub.Emit(models.NewTaskCreatedEvent(task))
+			taskHub.Emit(models.NewTaskRemovedEvent(task))
+
+			eventSource := events.NewEventSource(sse.NewReadCloser(response.Body))
+			event, err := eventSource.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event).To(Equal(models.NewTaskCreatedEvent(task)))
+
+			event, err = eventSource.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event).To(Equal(models.NewTaskRemovedEvent(task)))
+		})
+
+		It("replays the events since the Last-Event-ID", func() {
+			response := subscribeFrom("")
+			reader := sse.NewReadCloser(response.Body)
+
+			taskHub.Emit(models.NewTaskCreatedEvent(model_helpers.NewValidTask("task-a")))
+			first, err := reader.Next()
+			Expect(err).NotTo(HaveOccurred())
+			reader.Close()
+
+			taskB := model_helpers.NewValidTask("task-b")
+			taskHub.Emit(models.NewTaskCreatedEvent(taskB))
+
+			response = subscribeFrom(first.ID)
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			eventSource := events.NewEventSource(sse.NewReadCloser(response.Body))
+			event, err := eventSource.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event).To(Equal(models.NewTaskCreatedEvent(taskB)))
+		})
+
+		Context("when the hub can no longer replay from the Last-Event-ID", func() {
+			It("responds with 410 Gone", func() {
+				response := subscribeFrom("unknown:1")
+				Expect(response.StatusCode).To(Equal(http.StatusGone))
+			})
+		})
+
+		Context("when the hub has the maximum number of subscribers", func() {
+			BeforeEach(func() {
+				handler = handlers.NewTaskEventHandler(taskHub, 1)
+			})
+
+			It("refuses further subscribers until one disconnects", func() {
+				first := subscribeFrom("")
+				Expect(first.StatusCode).To(Equal(http.StatusOK))
+
+				second := subscribeFrom("")
+				Expect(second.StatusCode).To(Equal(http.StatusServiceUnavailable))
+				Expect(second.Header.Get("Retry-After")).To(Equal("5"))
+
+				body, err := ioutil.ReadAll(second.Body)
+				Expect(err).NotTo(HaveOccurred())
+				errorResponse := &models.ErrorResponse{}
+				Expect(errorResponse.Unmarshal(body)).To(Succeed())
+				Expect(errorResponse.Error).To(Equal(models.ErrTooManySubscribers))
+
+				Expect(first.Body.Close()).To(Succeed())
+				Eventually(taskHub.SubscriberCount).Should(BeZero())
+
+				third := subscribeFrom("")
+				Expect(third.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when failing to subscribe to the hub", func() {
+			BeforeEach(func() {
+				taskHub.Close()
+			})
+
+			It("returns an internal server error", func() {
+				response := subscribeFrom("")
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when the client closes the response body", func() {
+			It("returns early", func() {
+				response := subscribeFrom("")
+				reader := sse.NewReadCloser(response.Body)
+				taskHub.Emit(models.NewTaskCreatedEvent(model_helpers.NewValidTask("some-guid")))
+				Expect(reader.Close()).To(Succeed())
+				Eventually(eventStreamDone, 10).Should(BeClosed())
+			})
+		})
+	})
+})
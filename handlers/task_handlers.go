@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"code.cloudfoundry.org/bbs/audit"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -11,30 +12,39 @@ import (
 //go:generate counterfeiter -o fake_controllers/fake_task_controller.go . TaskController
 
 type TaskController interface {
-	Tasks(logger lager.Logger, domain, cellId string) ([]*models.Task, error)
+	Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
+	StreamTasks(logger lager.Logger, filter models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error)
 	TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error)
+	DuplicateTasks(logger lager.Logger, domain string) ([]*models.DuplicateTasks, error)
 	DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
+	DesireTaskWithIdempotencyKey(logger lager.Logger, key models.IdempotencyKey, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
 	StartTask(logger lager.Logger, taskGuid, cellId string) (shouldStart bool, err error)
 	CancelTask(logger lager.Logger, taskGuid string) error
+	CancelTasks(logger lager.Logger, domain string, taskGuids []string) (*models.CancelTasksResult, error)
 	FailTask(logger lager.Logger, taskGuid, failureReason string) error
+	RejectTask(logger lager.Logger, taskGuid, rejectionReason string) error
 	CompleteTask(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) error
 	ResolvingTask(logger lager.Logger, taskGuid string) error
 	DeleteTask(logger lager.Logger, taskGuid string) error
-	ConvergeTasks(logger lager.Logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) error
+	PurgeCompletedTasks(logger lager.Logger, minAge time.Duration) (int, error)
+	ConvergeTasks(logger lager.Logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration, maxTaskRejections int) error
 }
 
 type TaskHandler struct {
-	controller TaskController
-	exitChan   chan<- struct{}
+	controller     TaskController
+	resourceLimits models.ResourceRequestLimits
+	exitChan       chan<- struct{}
 }
 
 func NewTaskHandler(
 	controller TaskController,
+	resourceLimits models.ResourceRequestLimits,
 	exitChan chan<- struct{},
 ) *TaskHandler {
 	return &TaskHandler{
-		controller: controller,
-		exitChan:   exitChan,
+		controller:     controller,
+		resourceLimits: resourceLimits,
+		exitChan:       exitChan,
 	}
 }
 
@@ -43,20 +53,24 @@ func (h *TaskHandler) Tasks(logger lager.Logger, w http.ResponseWriter, req *htt
 	logger = logger.Session("tasks")
 
 	request := &models.TasksRequest{}
-	response := &models.TasksResponse{}
+	stream := newResponseStream(w, req, "tasks")
+	var bbsErr *models.Error
 
-	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, bbsErr) }()
+	defer func() { stream.Finish(logger, bbsErr) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
 		logger.Error("failed-parsing-request", err)
-		response.Error = models.ConvertError(err)
+		bbsErr = models.ConvertError(err)
 		return
 	}
 
-	response.Tasks, err = h.controller.Tasks(logger, request.Domain, request.CellId)
-	response.Error = models.ConvertError(err)
+	recordErrors, err := h.controller.StreamTasks(logger, request.Filter(), func(task *models.Task) error {
+		return stream.WriteItem(task)
+	})
+	stream.WriteRecordErrors(recordErrors)
+	bbsErr = models.ConvertError(err)
 }
 
 func (h *TaskHandler) TaskByGuid(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -67,7 +81,7 @@ func (h *TaskHandler) TaskByGuid(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -80,6 +94,27 @@ func (h *TaskHandler) TaskByGuid(logger lager.Logger, w http.ResponseWriter, req
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) DuplicateTasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("duplicate-tasks")
+
+	request := &models.DuplicateTasksRequest{}
+	response := &models.DuplicateTasksResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.Duplicates, err = h.controller.DuplicateTasks(logger, request.Domain)
+	response.Error = models.ConvertError(err)
+}
+
 func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("desire-task")
@@ -88,7 +123,8 @@ func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -97,7 +133,32 @@ func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
-	err = h.controller.DesireTask(logger, request.TaskDefinition, request.TaskGuid, request.Domain)
+	audit.SetTarget(req, request.TaskGuid)
+
+	err = validateResourceLimits(logger, h.resourceLimits, request.TaskDefinition.MemoryMb, request.TaskDefinition.DiskMb)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	if request.IdempotencyKey == "" {
+		err = h.controller.DesireTask(logger, request.TaskDefinition, request.TaskGuid, request.Domain)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	key, err := models.NewIdempotencyKey(request.IdempotencyKey, &models.DesireTaskRequest{
+		TaskDefinition: request.TaskDefinition,
+		TaskGuid:       request.TaskGuid,
+		Domain:         request.Domain,
+	})
+	if err != nil {
+		logger.Error("failed-fingerprinting-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.controller.DesireTaskWithIdempotencyKey(logger, key, request.TaskDefinition, request.TaskGuid, request.Domain)
 	response.Error = models.ConvertError(err)
 }
 
@@ -109,7 +170,8 @@ func (h *TaskHandler) StartTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.StartTaskResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -118,6 +180,8 @@ func (h *TaskHandler) StartTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	audit.SetTarget(req, request.TaskGuid)
+
 	response.ShouldStart, err = h.controller.StartTask(logger, request.TaskGuid, request.CellId)
 	response.Error = models.ConvertError(err)
 }
@@ -129,7 +193,8 @@ func (h *TaskHandler) CancelTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -138,10 +203,42 @@ func (h *TaskHandler) CancelTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	audit.SetTarget(req, request.TaskGuid)
+
 	err = h.controller.CancelTask(logger, request.TaskGuid)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) CancelTasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("cancel-tasks")
+
+	request := &models.CancelTasksRequest{}
+	response := &models.CancelTasksResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	result, err := h.controller.CancelTasks(logger, request.Domain, request.TaskGuids)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	for _, cancelled := range result.Cancelled {
+		response.CancelledTaskGuids = append(response.CancelledTaskGuids, cancelled.Task.TaskGuid)
+	}
+	response.NotCancellableTaskGuids = result.NotCancellable
+	response.NotFoundTaskGuids = result.NotFound
+}
+
 func (h *TaskHandler) FailTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("fail-task")
@@ -150,7 +247,8 @@ func (h *TaskHandler) FailTask(logger lager.Logger, w http.ResponseWriter, req *
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -159,10 +257,36 @@ func (h *TaskHandler) FailTask(logger lager.Logger, w http.ResponseWriter, req *
 		return
 	}
 
+	audit.SetTarget(req, request.TaskGuid)
+
 	err = h.controller.FailTask(logger, request.TaskGuid, request.FailureReason)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) RejectTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("reject-task")
+
+	request := &models.RejectTaskRequest{}
+	response := &models.TaskLifecycleResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	audit.SetTarget(req, request.TaskGuid)
+
+	err = h.controller.RejectTask(logger, request.TaskGuid, request.RejectionReason)
+	response.Error = models.ConvertError(err)
+}
+
 func (h *TaskHandler) CompleteTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("complete-task")
@@ -171,7 +295,8 @@ func (h *TaskHandler) CompleteTask(logger lager.Logger, w http.ResponseWriter, r
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -180,6 +305,8 @@ func (h *TaskHandler) CompleteTask(logger lager.Logger, w http.ResponseWriter, r
 		return
 	}
 
+	audit.SetTarget(req, request.TaskGuid)
+
 	err = h.controller.CompleteTask(logger, request.TaskGuid, request.CellId, request.Failed, request.FailureReason, request.Result)
 	response.Error = models.ConvertError(err)
 }
@@ -192,7 +319,8 @@ func (h *TaskHandler) ResolvingTask(logger lager.Logger, w http.ResponseWriter,
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -201,6 +329,8 @@ func (h *TaskHandler) ResolvingTask(logger lager.Logger, w http.ResponseWriter,
 		return
 	}
 
+	audit.SetTarget(req, request.TaskGuid)
+
 	err = h.controller.ResolvingTask(logger, request.TaskGuid)
 	response.Error = models.ConvertError(err)
 }
@@ -213,7 +343,8 @@ func (h *TaskHandler) DeleteTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -222,6 +353,32 @@ func (h *TaskHandler) DeleteTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	audit.SetTarget(req, request.TaskGuid)
+
 	err = h.controller.DeleteTask(logger, request.TaskGuid)
 	response.Error = models.ConvertError(err)
 }
+
+func (h *TaskHandler) PurgeCompletedTasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("purge-completed-tasks")
+
+	request := &models.PurgeCompletedTasksRequest{}
+	response := &models.PurgeCompletedTasksResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	minAge := time.Duration(request.MinAgeInSeconds) * time.Second
+	purged, err := h.controller.PurgeCompletedTasks(logger, minAge)
+	response.PurgedCount = int32(purged)
+	response.Error = models.ConvertError(err)
+}
@@ -1,9 +1,9 @@
 package handlers
 
 import (
-	"io/ioutil"
 	"net/http"
 
+	"code.cloudfoundry.org/bbs/audit"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
@@ -17,7 +17,7 @@ func (h *TaskHandler) commonTasks(logger lager.Logger, w http.ResponseWriter, re
 	response := &models.TasksResponse{}
 
 	defer exitIfUnrecoverable(logger, h.exitChan, response.Error)
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -26,8 +26,7 @@ func (h *TaskHandler) commonTasks(logger lager.Logger, w http.ResponseWriter, re
 		return
 	}
 
-	filter := models.TaskFilter{Domain: request.Domain, CellID: request.CellId}
-	response.Tasks, err = h.controller.Tasks(logger, filter.Domain, filter.CellID)
+	response.Tasks, err = h.controller.Tasks(logger, request.Filter())
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
@@ -58,7 +57,7 @@ func (h *TaskHandler) commonTaskByGuid(logger lager.Logger, w http.ResponseWrite
 	response := &models.TaskResponse{}
 
 	defer exitIfUnrecoverable(logger, h.exitChan, response.Error)
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -95,7 +94,8 @@ func (h *TaskHandler) DesireTask_r1(logger lager.Logger, w http.ResponseWriter,
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -104,10 +104,18 @@ func (h *TaskHandler) DesireTask_r1(logger lager.Logger, w http.ResponseWriter,
 		return
 	}
 
+	audit.SetTarget(req, request.TaskGuid)
+
 	for i, mount := range request.TaskDefinition.VolumeMounts {
 		request.TaskDefinition.VolumeMounts[i] = mount.VersionUpToV1()
 	}
 
+	err = validateResourceLimits(logger, h.resourceLimits, request.TaskDefinition.MemoryMb, request.TaskDefinition.DiskMb)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.DesireTask(logger, request.TaskDefinition, request.TaskGuid, request.Domain)
 	response.Error = models.ConvertError(err)
 }
@@ -120,7 +128,8 @@ func (h *TaskHandler) DesireTask_r0(logger lager.Logger, w http.ResponseWriter,
 	response := &models.TaskLifecycleResponse{}
 
 	defer exitIfUnrecoverable(logger, h.exitChan, response.Error)
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
+	defer func() { audit.SetError(req, response.Error) }()
 
 	err = parseRequestForDesireTask_r0(logger, req, request)
 	if err != nil {
@@ -129,6 +138,14 @@ func (h *TaskHandler) DesireTask_r0(logger lager.Logger, w http.ResponseWriter,
 		return
 	}
 
+	audit.SetTarget(req, request.TaskGuid)
+
+	err = validateResourceLimits(logger, h.resourceLimits, request.TaskDefinition.MemoryMb, request.TaskDefinition.DiskMb)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.DesireTask(logger, request.TaskDefinition, request.TaskGuid, request.Domain)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -137,10 +154,9 @@ func (h *TaskHandler) DesireTask_r0(logger lager.Logger, w http.ResponseWriter,
 }
 
 func parseRequestForDesireTask_r0(logger lager.Logger, req *http.Request, request *models.DesireTaskRequest) error {
-	data, err := ioutil.ReadAll(req.Body)
+	data, err := readRequestBody(logger, req)
 	if err != nil {
-		logger.Error("failed-to-read-body", err)
-		return models.ErrUnknownError
+		return err
 	}
 
 	err = request.Unmarshal(data)
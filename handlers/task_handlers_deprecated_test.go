@@ -33,7 +33,7 @@ var _ = Describe("Task Handlers", func() {
 		responseRecorder = httptest.NewRecorder()
 		exitCh = make(chan struct{}, 1)
 		controller = &fake_controllers.FakeTaskController{}
-		handler = handlers.NewTaskHandler(controller, exitCh)
+		handler = handlers.NewTaskHandler(controller, models.ResourceRequestLimits{}, exitCh)
 	})
 
 	Describe("DesireTask", func() {
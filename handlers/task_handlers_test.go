@@ -3,12 +3,15 @@ package handlers_test
 import (
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/gogo/protobuf/proto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -34,7 +37,7 @@ var _ = Describe("Task Handlers", func() {
 		responseRecorder = httptest.NewRecorder()
 		exitCh = make(chan struct{}, 1)
 		controller = &fake_controllers.FakeTaskController{}
-		handler = handlers.NewTaskHandler(controller, exitCh)
+		handler = handlers.NewTaskHandler(controller, models.ResourceRequestLimits{}, exitCh)
 	})
 
 	Describe("Tasks", func() {
@@ -42,18 +45,24 @@ var _ = Describe("Task Handlers", func() {
 			task1          models.Task
 			task2          models.Task
 			cellId, domain string
+			states         []string
+			partialResults bool
 		)
 
 		BeforeEach(func() {
 			task1 = models.Task{Domain: "domain-1"}
 			task2 = models.Task{CellId: "cell-id"}
+			states = nil
+			partialResults = false
 			requestBody = &models.TasksRequest{}
 		})
 
 		JustBeforeEach(func() {
 			requestBody = &models.TasksRequest{
-				Domain: domain,
-				CellId: cellId,
+				Domain:         domain,
+				CellId:         cellId,
+				States:         states,
+				PartialResults: partialResults,
 			}
 			request = newTestRequest(requestBody)
 			handler.Tasks(logger, responseRecorder, request)
@@ -64,7 +73,15 @@ var _ = Describe("Task Handlers", func() {
 
 			BeforeEach(func() {
 				tasks = []*models.Task{&task1, &task2}
-				controller.TasksReturns(tasks, nil)
+				controller.StreamTasksStub = func(_ lager.Logger, _ models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+					for _, task := range tasks {
+						err := yield(task)
+						if err != nil {
+							return nil, err
+						}
+					}
+					return nil, nil
+				}
 			})
 
 			It("returns a list of task", func() {
@@ -77,11 +94,16 @@ var _ = Describe("Task Handlers", func() {
 				Expect(response.Tasks).To(Equal(tasks))
 			})
 
+			It("streams the same bytes as the marshaled response", func() {
+				expected, err := proto.Marshal(&models.TasksResponse{Tasks: tasks})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(responseRecorder.Body.Bytes()).To(Equal(expected))
+			})
+
 			It("calls the controller with no filter", func() {
-				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-				Expect(actualDomain).To(Equal(domain))
-				Expect(actualCellId).To(Equal(cellId))
+				Expect(controller.StreamTasksCallCount()).To(Equal(1))
+				_, filter, _ := controller.StreamTasksArgsForCall(0)
+				Expect(filter).To(Equal(models.TaskFilter{Domain: domain, CellID: cellId}))
 			})
 
 			Context("and filtering by domain", func() {
@@ -90,10 +112,9 @@ var _ = Describe("Task Handlers", func() {
 				})
 
 				It("calls the controller with a domain filter", func() {
-					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					Expect(controller.StreamTasksCallCount()).To(Equal(1))
+					_, filter, _ := controller.StreamTasksArgsForCall(0)
+					Expect(filter).To(Equal(models.TaskFilter{Domain: domain, CellID: cellId}))
 				})
 			})
 
@@ -103,17 +124,100 @@ var _ = Describe("Task Handlers", func() {
 				})
 
 				It("calls the controller with a cell filter", func() {
-					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					Expect(controller.StreamTasksCallCount()).To(Equal(1))
+					_, filter, _ := controller.StreamTasksArgsForCall(0)
+					Expect(filter).To(Equal(models.TaskFilter{Domain: domain, CellID: cellId}))
+				})
+
+				Context("and by states", func() {
+					BeforeEach(func() {
+						states = []string{"Running"}
+					})
+
+					It("calls the controller with the cell and the states", func() {
+						Expect(controller.StreamTasksCallCount()).To(Equal(1))
+						_, filter, _ := controller.StreamTasksArgsForCall(0)
+						Expect(filter).To(Equal(models.TaskFilter{Domain: domain, CellID: cellId, States: []models.Task_State{models.Task_Running}}))
+					})
 				})
 			})
 		})
 
+		Context("when the request lists an unknown state", func() {
+			BeforeEach(func() {
+				states = []string{"Exploded"}
+			})
+
+			It("fails the request without calling the controller", func() {
+				response := models.TasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(controller.StreamTasksCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when partial results are requested and some tasks cannot be read", func() {
+			var recordErrors []*models.RecordError
+
+			BeforeEach(func() {
+				partialResults = true
+				recordErrors = []*models.RecordError{{Guid: "unreadable-guid", Reason: "kaboom"}}
+				controller.StreamTasksStub = func(_ lager.Logger, _ models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+					err := yield(&task1)
+					Expect(err).NotTo(HaveOccurred())
+					return recordErrors, nil
+				}
+			})
+
+			It("calls the controller asking for partial results", func() {
+				Expect(controller.StreamTasksCallCount()).To(Equal(1))
+				_, filter, _ := controller.StreamTasksArgsForCall(0)
+				Expect(filter.PartialResults).To(BeTrue())
+			})
+
+			It("returns the tasks it read along with the record errors", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.TasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Tasks).To(Equal([]*models.Task{&task1}))
+				Expect(response.RecordErrors).To(Equal(recordErrors))
+			})
+
+			It("streams the same bytes as the marshaled response", func() {
+				expected, err := proto.Marshal(&models.TasksResponse{Tasks: []*models.Task{&task1}, RecordErrors: recordErrors})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(responseRecorder.Body.Bytes()).To(Equal(expected))
+			})
+		})
+
+		Context("when the controller fails after streaming some tasks", func() {
+			BeforeEach(func() {
+				controller.StreamTasksStub = func(_ lager.Logger, _ models.TaskFilter, yield func(*models.Task) error) ([]*models.RecordError, error) {
+					err := yield(&task1)
+					Expect(err).NotTo(HaveOccurred())
+					return nil, models.ErrUnknownError
+				}
+			})
+
+			It("returns the streamed tasks along with the error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.TasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Tasks).To(Equal([]*models.Task{&task1}))
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+
 		Context("when the controller returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.NewUnrecoverableError(nil))
+				controller.StreamTasksReturns(nil, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -124,7 +228,7 @@ var _ = Describe("Task Handlers", func() {
 
 		Context("when the controller errors out", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.ErrUnknownError)
+				controller.StreamTasksReturns(nil, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -177,6 +281,28 @@ var _ = Describe("Task Handlers", func() {
 			})
 		})
 
+		Context("when the task is resolving", func() {
+			var task *models.Task
+
+			BeforeEach(func() {
+				task = model_helpers.NewValidTask(taskGuid)
+				task.State = models.Task_Resolving
+				task.Result = "some-result"
+				controller.TaskByGuidReturns(task, nil)
+			})
+
+			It("returns the task with its current state", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.TaskResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Task).To(Equal(task))
+				Expect(response.Task.State).To(Equal(models.Task_Resolving))
+			})
+		})
+
 		Context("when the controller returns no task", func() {
 			BeforeEach(func() {
 				controller.TaskByGuidReturns(nil, models.ErrResourceNotFound)
@@ -219,6 +345,76 @@ var _ = Describe("Task Handlers", func() {
 		})
 	})
 
+	Describe("DuplicateTasks", func() {
+		BeforeEach(func() {
+			requestBody = &models.DuplicateTasksRequest{
+				Domain: "some-domain",
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.DuplicateTasks(logger, responseRecorder, request)
+		})
+
+		Context("when the controller finds duplicate tasks", func() {
+			var duplicates []*models.DuplicateTasks
+
+			BeforeEach(func() {
+				duplicates = []*models.DuplicateTasks{
+					{ContentHash: "hash-a", TaskGuids: []string{"guid-1", "guid-2"}},
+					{ContentHash: "hash-b", TaskGuids: []string{"guid-3", "guid-4", "guid-5"}},
+				}
+				controller.DuplicateTasksReturns(duplicates, nil)
+			})
+
+			It("looks for duplicates in the requested domain", func() {
+				Expect(controller.DuplicateTasksCallCount()).To(Equal(1))
+				_, domain := controller.DuplicateTasksArgsForCall(0)
+				Expect(domain).To(Equal("some-domain"))
+			})
+
+			It("returns the duplicates", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DuplicateTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Duplicates).To(Equal(duplicates))
+			})
+		})
+
+		Context("when the request has no domain", func() {
+			BeforeEach(func() {
+				requestBody = &models.DuplicateTasksRequest{}
+			})
+
+			It("returns an invalid request error", func() {
+				Expect(controller.DuplicateTasksCallCount()).To(Equal(0))
+
+				response := models.DuplicateTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the controller errors out", func() {
+			BeforeEach(func() {
+				controller.DuplicateTasksReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				response := models.DuplicateTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
 	Describe("DesireTask", func() {
 		var (
 			taskGuid = "task-guid"
@@ -240,6 +436,61 @@ var _ = Describe("Task Handlers", func() {
 			handler.DesireTask(logger, responseRecorder, request)
 		})
 
+		Context("when the task exceeds the configured resource limits", func() {
+			BeforeEach(func() {
+				handler = handlers.NewTaskHandler(controller, models.ResourceRequestLimits{MaxMemoryMb: 4096, MaxDiskMb: 512}, exitCh)
+			})
+
+			It("rejects the request with an invalid request error naming the field", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(response.Error.Message).To(ContainSubstring("disk_mb"))
+				Expect(response.Error.Message).NotTo(ContainSubstring("memory_mb"))
+			})
+
+			It("does not desire the task", func() {
+				Expect(controller.DesireTaskCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the request body is larger than the maximum request body size", func() {
+			BeforeEach(func() {
+				requestBody = limitedRequestBody(&models.DesireTaskRequest{
+					TaskGuid:       taskGuid,
+					Domain:         domain,
+					TaskDefinition: taskDef,
+				}, 16)
+			})
+
+			It("responds with a request entity too large error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrRequestEntityTooLarge))
+			})
+
+			It("does not desire the task", func() {
+				Expect(controller.DesireTaskCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the task is within the configured resource limits", func() {
+			BeforeEach(func() {
+				handler = handlers.NewTaskHandler(controller, models.ResourceRequestLimits{MaxMemoryMb: 256, MaxDiskMb: 1024}, exitCh)
+			})
+
+			It("desires the task", func() {
+				Expect(controller.DesireTaskCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when the desire is successful", func() {
 			It("desires the task with the requested definitions", func() {
 				Expect(controller.DesireTaskCallCount()).To(Equal(1))
@@ -282,6 +533,45 @@ var _ = Describe("Task Handlers", func() {
 				Expect(response.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the request has an idempotency key", func() {
+			BeforeEach(func() {
+				requestBody.(*models.DesireTaskRequest).IdempotencyKey = "retry-key"
+			})
+
+			It("desires the task under the key, fingerprinting the request", func() {
+				Expect(controller.DesireTaskCallCount()).To(Equal(0))
+				Expect(controller.DesireTaskWithIdempotencyKeyCallCount()).To(Equal(1))
+
+				_, key, actualTaskDef, actualTaskGuid, actualDomain := controller.DesireTaskWithIdempotencyKeyArgsForCall(0)
+				Expect(actualTaskDef).To(Equal(taskDef))
+				Expect(actualTaskGuid).To(Equal(taskGuid))
+				Expect(actualDomain).To(Equal(domain))
+
+				expectedKey, err := models.NewIdempotencyKey("retry-key", &models.DesireTaskRequest{
+					TaskGuid:       taskGuid,
+					Domain:         domain,
+					TaskDefinition: taskDef,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(key).To(Equal(expectedKey))
+			})
+
+			Context("when the key was used for a different request", func() {
+				BeforeEach(func() {
+					controller.DesireTaskWithIdempotencyKeyReturns(models.ErrIdempotencyKeyConflict)
+				})
+
+				It("responds with the conflict", func() {
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					response := &models.TaskLifecycleResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(Equal(models.ErrIdempotencyKeyConflict))
+				})
+			})
+		})
 	})
 
 	Describe("StartTask", func() {
@@ -447,6 +737,76 @@ var _ = Describe("Task Handlers", func() {
 		})
 	})
 
+	Describe("CancelTasks", func() {
+		var request *http.Request
+
+		BeforeEach(func() {
+			requestBody = &models.CancelTasksRequest{
+				Domain:    "some-domain",
+				TaskGuids: []string{"cancelled-guid", "completed-guid", "missing-guid"},
+			}
+
+			controller.CancelTasksReturns(&models.CancelTasksResult{
+				Cancelled:      []models.CancelledTask{{Task: &models.Task{TaskGuid: "cancelled-guid"}}},
+				NotCancellable: []string{"completed-guid"},
+				NotFound:       []string{"missing-guid"},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request = newTestRequest(requestBody)
+			handler.CancelTasks(logger, responseRecorder, request)
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("cancels the tasks through the controller", func() {
+			Expect(controller.CancelTasksCallCount()).To(Equal(1))
+			_, domain, guids := controller.CancelTasksArgsForCall(0)
+			Expect(domain).To(Equal("some-domain"))
+			Expect(guids).To(Equal([]string{"cancelled-guid", "completed-guid", "missing-guid"}))
+		})
+
+		It("reports which tasks were cancelled, not cancellable or not found", func() {
+			response := &models.CancelTasksResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.CancelledTaskGuids).To(Equal([]string{"cancelled-guid"}))
+			Expect(response.NotCancellableTaskGuids).To(Equal([]string{"completed-guid"}))
+			Expect(response.NotFoundTaskGuids).To(Equal([]string{"missing-guid"}))
+		})
+
+		Context("when the controller fails", func() {
+			BeforeEach(func() {
+				controller.CancelTasksReturns(nil, models.ErrUnknownError)
+			})
+
+			It("responds with an error", func() {
+				response := &models.CancelTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+
+		Context("when neither a domain nor task guids are given", func() {
+			BeforeEach(func() {
+				requestBody = &models.CancelTasksRequest{}
+			})
+
+			It("responds with an invalid request error", func() {
+				response := &models.CancelTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(controller.CancelTasksCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("FailTask", func() {
 		var (
 			taskGuid      string
@@ -512,6 +872,77 @@ var _ = Describe("Task Handlers", func() {
 		})
 	})
 
+	Describe("RejectTask", func() {
+		BeforeEach(func() {
+			requestBody = &models.RejectTaskRequest{
+				TaskGuid:        "task-guid",
+				RejectionReason: "insufficient resources",
+			}
+		})
+
+		JustBeforeEach(func() {
+			request = newTestRequest(requestBody)
+			handler.RejectTask(logger, responseRecorder, request)
+		})
+
+		Context("when rejecting the task succeeds", func() {
+			It("returns no error", func() {
+				Expect(controller.RejectTaskCallCount()).To(Equal(1))
+				_, actualTaskGuid, actualRejectionReason := controller.RejectTaskArgsForCall(0)
+				Expect(actualTaskGuid).To(Equal("task-guid"))
+				Expect(actualRejectionReason).To(Equal("insufficient resources"))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+			})
+		})
+
+		Context("when the request has no rejection reason", func() {
+			BeforeEach(func() {
+				requestBody = &models.RejectTaskRequest{TaskGuid: "task-guid"}
+			})
+
+			It("responds with an invalid request error without rejecting the task", func() {
+				Expect(controller.RejectTaskCallCount()).To(Equal(0))
+
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the controller returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				controller.RejectTaskReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when rejecting the task fails", func() {
+			BeforeEach(func() {
+				controller.RejectTaskReturns(models.ErrUnknownError)
+			})
+
+			It("responds with an error", func() {
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
 	Describe("CompleteTask", func() {
 		var (
 			taskGuid      string
@@ -700,4 +1131,84 @@ var _ = Describe("Task Handlers", func() {
 			})
 		})
 	})
+
+	Describe("PurgeCompletedTasks", func() {
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.PurgeCompletedTasks(logger, responseRecorder, request)
+		})
+
+		Context("when the request is valid", func() {
+			BeforeEach(func() {
+				requestBody = &models.PurgeCompletedTasksRequest{
+					MinAgeInSeconds: 3600,
+				}
+			})
+
+			Context("when purging the tasks succeeds", func() {
+				BeforeEach(func() {
+					controller.PurgeCompletedTasksReturns(3, nil)
+				})
+
+				It("purges the tasks older than the given age and returns how many were purged", func() {
+					Expect(controller.PurgeCompletedTasksCallCount()).To(Equal(1))
+					_, minAge := controller.PurgeCompletedTasksArgsForCall(0)
+					Expect(minAge).To(Equal(time.Hour))
+
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					response := &models.PurgeCompletedTasksResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(BeNil())
+					Expect(response.PurgedCount).To(BeEquivalentTo(3))
+				})
+			})
+
+			Context("when the controller returns an unrecoverable error", func() {
+				BeforeEach(func() {
+					controller.PurgeCompletedTasksReturns(0, models.NewUnrecoverableError(nil))
+				})
+
+				It("logs and writes to the exit channel", func() {
+					Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+					Eventually(exitCh).Should(Receive())
+				})
+			})
+
+			Context("when purging the tasks fails", func() {
+				BeforeEach(func() {
+					controller.PurgeCompletedTasksReturns(0, models.ErrUnknownError)
+				})
+
+				It("responds with an error", func() {
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					response := &models.PurgeCompletedTasksResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(Equal(models.ErrUnknownError))
+				})
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.PurgeCompletedTasksRequest{
+					MinAgeInSeconds: -1,
+				}
+			})
+
+			It("responds with a bad request error without purging", func() {
+				Expect(controller.PurgeCompletedTasksCallCount()).To(Equal(0))
+
+				response := &models.PurgeCompletedTasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+	})
 })
@@ -1,6 +1,16 @@
 package handlers
 
-import "net/http"
+import (
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs/models"
+	"github.com/tedsuo/rata"
+)
+
+// MigrationRetryAfterSeconds is the Retry-After sent with the
+// MigrationInProgress error.
+const MigrationRetryAfterSeconds = 5
 
 type UnavailableHandler struct {
 	handler          http.Handler
@@ -21,7 +31,7 @@ func (u *UnavailableHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	case <-u.serviceReadyChan:
 		u.handler.ServeHTTP(w, r)
 	default:
-		w.WriteHeader(http.StatusServiceUnavailable)
+		writeErrorResponse(w, r, http.StatusServiceUnavailable, models.ErrUnavailable)
 	}
 }
 
@@ -32,3 +42,75 @@ func UnavailableWrap(handler http.Handler, serviceReady <-chan struct{}) http.Ha
 		handler.ServeHTTP(w, r)
 	}
 }
+
+// StandbyUnavailableHandler serves the diagnostic routes at all times, the read
+// routes once readsReady is closed, and all other routes once serviceReady is
+// closed and the lock is held. This
+// lets a BBS that does not hold the lock, or has just released it, answer
+// reads while rejecting writes. A BBS that holds the lock but has not closed
+// serviceReady is still running migrations; it rejects requests with a
+// MigrationInProgress error and a Retry-After header. Other rejected requests
+// get an Unavailable error.
+type StandbyUnavailableHandler struct {
+	handler          http.Handler
+	readRoutes       map[string]bool
+	diagnosticRoutes map[string]bool
+	readsReadyChan   <-chan struct{}
+	serviceReadyChan <-chan struct{}
+	lockHolder       LockHolder
+}
+
+func NewStandbyUnavailableHandler(handler http.Handler, routes rata.Routes, readRouteNames, diagnosticRouteNames map[string]bool, readsReady, serviceReady <-chan struct{}, lockHolder LockHolder) *StandbyUnavailableHandler {
+	readRoutes := map[string]bool{}
+	diagnosticRoutes := map[string]bool{}
+	for _, route := range routes {
+		if readRouteNames[route.Name] {
+			readRoutes[route.Method+" "+route.Path] = true
+		}
+		if diagnosticRouteNames[route.Name] {
+			diagnosticRoutes[route.Method+" "+route.Path] = true
+		}
+	}
+
+	return &StandbyUnavailableHandler{
+		handler:          handler,
+		readRoutes:       readRoutes,
+		diagnosticRoutes: diagnosticRoutes,
+		readsReadyChan:   readsReady,
+		serviceReadyChan: serviceReady,
+		lockHolder:       lockHolder,
+	}
+}
+
+func (u *StandbyUnavailableHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if u.diagnosticRoutes[r.Method+" "+r.URL.Path] {
+		u.handler.ServeHTTP(w, r)
+		return
+	}
+
+	select {
+	case <-u.serviceReadyChan:
+		if u.lockHolder.HoldsLock() {
+			u.handler.ServeHTTP(w, r)
+			return
+		}
+	default:
+	}
+
+	if u.readRoutes[r.Method+" "+r.URL.Path] {
+		select {
+		case <-u.readsReadyChan:
+			u.handler.ServeHTTP(w, r)
+			return
+		default:
+		}
+	}
+
+	if u.lockHolder.HoldsLock() {
+		w.Header().Set("Retry-After", strconv.Itoa(MigrationRetryAfterSeconds))
+		writeErrorResponse(w, r, http.StatusServiceUnavailable, models.ErrMigrationInProgress)
+		return
+	}
+
+	writeErrorResponse(w, r, http.StatusServiceUnavailable, models.ErrUnavailable)
+}
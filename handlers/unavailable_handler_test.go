@@ -1,14 +1,18 @@
 package handlers_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
 )
 
 var _ = Describe("Unavailable Handler", func() {
@@ -51,4 +55,178 @@ var _ = Describe("Unavailable Handler", func() {
 		verifyResponse(http.StatusOK, handler)
 		verifyResponse(http.StatusOK, handler)
 	})
+
+	It("responds with an Unavailable error while the service is not ready", func() {
+		responseRecorder := httptest.NewRecorder()
+		handler.ServeHTTP(responseRecorder, request)
+
+		response := &models.ErrorResponse{}
+		Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+		Expect(response.Error.Type).To(Equal(models.Error_Unavailable))
+	})
+})
+
+var _ = Describe("Standby Unavailable Handler", func() {
+	var (
+		fakeServer   *ghttp.Server
+		handler      *handlers.StandbyUnavailableHandler
+		readsReady   chan struct{}
+		serviceReady chan struct{}
+		lockHolder   *fake_controllers.FakeLockReleaser
+	)
+
+	BeforeEach(func() {
+		readsReady = make(chan struct{})
+		serviceReady = make(chan struct{})
+		lockHolder = new(fake_controllers.FakeLockReleaser)
+		lockHolder.HoldsLockReturns(true)
+
+		fakeServer = ghttp.NewServer()
+		routes := rata.Routes{
+			{Path: "/read", Method: "POST", Name: "Read"},
+			{Path: "/write", Method: "POST", Name: "Write"},
+			{Path: "/diagnostic", Method: "POST", Name: "Diagnostic"},
+		}
+		handler = handlers.NewStandbyUnavailableHandler(fakeServer, routes, map[string]bool{"Read": true}, map[string]bool{"Diagnostic": true}, readsReady, serviceReady, lockHolder)
+
+		fakeServer.RouteToHandler("POST", "/read", ghttp.RespondWith(200, nil, nil))
+		fakeServer.RouteToHandler("POST", "/write", ghttp.RespondWith(200, nil, nil))
+		fakeServer.RouteToHandler("POST", "/diagnostic", ghttp.RespondWith(200, nil, nil))
+	})
+
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	verifyResponse := func(path string, expectedStatus int) {
+		request, err := http.NewRequest("POST", path, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		responseRecorder := httptest.NewRecorder()
+		handler.ServeHTTP(responseRecorder, request)
+		Expect(responseRecorder.Code).To(Equal(expectedStatus))
+	}
+
+	It("responds with 503 to every route until reads are ready", func() {
+		verifyResponse("/read", http.StatusServiceUnavailable)
+		verifyResponse("/write", http.StatusServiceUnavailable)
+	})
+
+	It("serves the diagnostic routes whether or not it holds the lock", func() {
+		verifyResponse("/diagnostic", http.StatusOK)
+
+		lockHolder.HoldsLockReturns(false)
+		verifyResponse("/diagnostic", http.StatusOK)
+	})
+
+	Context("while the lock holder is running migrations", func() {
+		serve := func(path string, header http.Header) *httptest.ResponseRecorder {
+			request, err := http.NewRequest("POST", path, nil)
+			Expect(err).NotTo(HaveOccurred())
+			for key, values := range header {
+				request.Header[key] = values
+			}
+
+			responseRecorder := httptest.NewRecorder()
+			handler.ServeHTTP(responseRecorder, request)
+			return responseRecorder
+		}
+
+		It("responds with a MigrationInProgress error and a Retry-After header", func() {
+			responseRecorder := serve("/write", nil)
+			Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(responseRecorder.Header().Get("Retry-After")).To(Equal("5"))
+			Expect(responseRecorder.Header().Get("Content-Type")).To(Equal("application/x-protobuf"))
+
+			response := &models.ErrorResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(Equal(models.ErrMigrationInProgress))
+		})
+
+		It("can be decoded as the response the route returns", func() {
+			responseRecorder := serve("/write", nil)
+
+			response := &models.DesiredLRPLifecycleResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error.GetType()).To(Equal(models.Error_MigrationInProgress))
+		})
+
+		It("encodes the error as JSON when the client accepts it", func() {
+			responseRecorder := serve("/read", http.Header{"Accept": []string{"application/json"}})
+			Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(responseRecorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			response := &models.ErrorResponse{}
+			Expect(json.Unmarshal(responseRecorder.Body.Bytes(), response)).To(Succeed())
+			Expect(response.Error.GetType()).To(Equal(models.Error_MigrationInProgress))
+		})
+
+		It("serves every route once the migrations finish", func() {
+			close(readsReady)
+			close(serviceReady)
+
+			verifyResponse("/read", http.StatusOK)
+			verifyResponse("/write", http.StatusOK)
+		})
+
+		Context("when the lock is not held", func() {
+			BeforeEach(func() {
+				lockHolder.HoldsLockReturns(false)
+			})
+
+			It("responds with a 503 and an Unavailable error, without a Retry-After", func() {
+				responseRecorder := serve("/write", nil)
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(responseRecorder.Header().Get("Retry-After")).To(BeEmpty())
+
+				response := &models.ErrorResponse{}
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error).To(Equal(models.ErrUnavailable))
+			})
+		})
+	})
+
+	Context("when reads are ready", func() {
+		BeforeEach(func() {
+			close(readsReady)
+		})
+
+		It("serves the read routes", func() {
+			verifyResponse("/read", http.StatusOK)
+		})
+
+		It("responds with 503 to the other routes until the service is ready", func() {
+			verifyResponse("/write", http.StatusServiceUnavailable)
+
+			close(serviceReady)
+
+			verifyResponse("/write", http.StatusOK)
+		})
+	})
+
+	Context("when the service is ready", func() {
+		BeforeEach(func() {
+			close(serviceReady)
+		})
+
+		It("serves every route", func() {
+			verifyResponse("/read", http.StatusOK)
+			verifyResponse("/write", http.StatusOK)
+		})
+
+		Context("but the lock has been released", func() {
+			BeforeEach(func() {
+				close(readsReady)
+				lockHolder.HoldsLockReturns(false)
+			})
+
+			It("serves the read routes", func() {
+				verifyResponse("/read", http.StatusOK)
+			})
+
+			It("responds with 503 to the other routes", func() {
+				verifyResponse("/write", http.StatusServiceUnavailable)
+			})
+		})
+	})
 })
@@ -0,0 +1,13 @@
+package lockmaintainer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLockMaintainer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Lock Maintainer Suite")
+}
@@ -0,0 +1,110 @@
+package lockmaintainer
+
+import (
+	"os"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/tedsuo/ifrit"
+)
+
+// ErrLockNotHeld is a conflict with the state of this instance, which another
+// instance may not share.
+var ErrLockNotHeld = models.NewError(models.Error_ResourceConflict, "this instance does not hold the lock")
+
+// Maintainer runs the lock runner and lets an operator hand the lock over to
+// another instance without restarting this one. Release stops this instance
+// from writing, gives up the lock, and contends for it again after a hold-off
+// long enough for the other instances to take their turn.
+//
+// Losing the lock for any other reason still makes Run exit, as before.
+type Maintainer struct {
+	logger  lager.Logger
+	lock    ifrit.Runner
+	clock   clock.Clock
+	holdOff time.Duration
+	release chan struct{}
+	held    int32
+}
+
+func New(logger lager.Logger, lock ifrit.Runner, clock clock.Clock, holdOff time.Duration) *Maintainer {
+	return &Maintainer{
+		logger:  logger,
+		lock:    lock,
+		clock:   clock,
+		holdOff: holdOff,
+		release: make(chan struct{}, 1),
+	}
+}
+
+// HoldsLock reports whether this instance currently holds the lock and may
+// therefore write.
+func (m *Maintainer) HoldsLock() bool {
+	return atomic.LoadInt32(&m.held) == 1
+}
+
+// Release makes this instance read-only and asks Run to give up the lock. It
+// returns ErrLockNotHeld when there is no lock to give up.
+func (m *Maintainer) Release(logger lager.Logger) error {
+	if !atomic.CompareAndSwapInt32(&m.held, 1, 0) {
+		return ErrLockNotHeld
+	}
+
+	logger.Info("lock-release-requested")
+	m.release <- struct{}{}
+	return nil
+}
+
+func (m *Maintainer) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := m.logger.Session("lock-maintainer")
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	defer atomic.StoreInt32(&m.held, 0)
+
+	for {
+		process := ifrit.Background(m.lock)
+
+		select {
+		case <-process.Ready():
+		case err := <-process.Wait():
+			return err
+		case signal := <-signals:
+			process.Signal(signal)
+			return <-process.Wait()
+		}
+
+		atomic.StoreInt32(&m.held, 1)
+		logger.Info("lock-acquired")
+		if ready != nil {
+			close(ready)
+			ready = nil
+		}
+
+		select {
+		case err := <-process.Wait():
+			return err
+		case signal := <-signals:
+			process.Signal(signal)
+			return <-process.Wait()
+		case <-m.release:
+		}
+
+		process.Signal(os.Interrupt)
+		if err := <-process.Wait(); err != nil {
+			logger.Error("failed-releasing-lock", err)
+		}
+		logger.Info("lock-released", lager.Data{"hold-off": m.holdOff.String()})
+
+		timer := m.clock.NewTimer(m.holdOff)
+		select {
+		case <-timer.C():
+		case <-signals:
+			timer.Stop()
+			return nil
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package lockmaintainer_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/lockmaintainer"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Maintainer", func() {
+	const holdOff = 10 * time.Second
+
+	var (
+		logger    *lagertest.TestLogger
+		fakeClock *fakeclock.FakeClock
+
+		acquireLock chan struct{}
+		loseLock    chan error
+		releases    chan struct{}
+
+		maintainer *lockmaintainer.Maintainer
+		process    ifrit.Process
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+
+		acquireLock = make(chan struct{})
+		loseLock = make(chan error)
+		releases = make(chan struct{}, 10)
+
+		lock := ifrit.RunFunc(func(signals <-chan os.Signal, ready chan<- struct{}) error {
+			select {
+			case <-acquireLock:
+			case <-signals:
+				return nil
+			}
+
+			close(ready)
+
+			select {
+			case err := <-loseLock:
+				return err
+			case <-signals:
+				releases <- struct{}{}
+				return nil
+			}
+		})
+
+		maintainer = lockmaintainer.New(logger, lock, fakeClock, holdOff)
+		process = ifrit.Background(maintainer)
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	Context("before the lock is acquired", func() {
+		It("does not become ready", func() {
+			Consistently(process.Ready()).ShouldNot(BeClosed())
+		})
+
+		It("does not hold the lock", func() {
+			Expect(maintainer.HoldsLock()).To(BeFalse())
+		})
+
+		It("refuses to release the lock", func() {
+			Expect(maintainer.Release(logger)).To(Equal(lockmaintainer.ErrLockNotHeld))
+		})
+	})
+
+	Context("when the lock is acquired", func() {
+		BeforeEach(func() {
+			acquireLock <- struct{}{}
+			Eventually(process.Ready()).Should(BeClosed())
+		})
+
+		It("holds the lock", func() {
+			Eventually(maintainer.HoldsLock).Should(BeTrue())
+		})
+
+		Context("and then lost", func() {
+			It("exits with the lock's error", func() {
+				lockErr := errors.New("lock lost")
+				loseLock <- lockErr
+				Eventually(process.Wait()).Should(Receive(Equal(lockErr)))
+				Expect(maintainer.HoldsLock()).To(BeFalse())
+			})
+		})
+
+		Context("and then released", func() {
+			BeforeEach(func() {
+				Eventually(maintainer.HoldsLock).Should(BeTrue())
+				Expect(maintainer.Release(logger)).To(Succeed())
+			})
+
+			It("stops holding the lock immediately", func() {
+				Expect(maintainer.HoldsLock()).To(BeFalse())
+			})
+
+			It("gives up the lock without exiting", func() {
+				Eventually(releases).Should(Receive())
+				Consistently(process.Wait()).ShouldNot(Receive())
+				Eventually(logger).Should(gbytes.Say("lock-released"))
+			})
+
+			It("refuses a second release", func() {
+				Expect(maintainer.Release(logger)).To(Equal(lockmaintainer.ErrLockNotHeld))
+			})
+
+			It("contends for the lock again after the hold-off", func() {
+				Eventually(releases).Should(Receive())
+				Consistently(acquireLock).ShouldNot(BeSent(struct{}{}))
+
+				Eventually(fakeClock.WatcherCount).Should(Equal(1))
+				fakeClock.Increment(holdOff)
+
+				Eventually(acquireLock).Should(BeSent(struct{}{}))
+				Eventually(maintainer.HoldsLock).Should(BeTrue())
+			})
+
+			It("exits when signalled during the hold-off", func() {
+				Eventually(fakeClock.WatcherCount).Should(Equal(1))
+				process.Signal(os.Interrupt)
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+			})
+		})
+	})
+})
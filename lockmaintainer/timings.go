@@ -0,0 +1,72 @@
+package lockmaintainer
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MinTTLToRetryIntervalRatio is the smallest ratio of the lock TTL to the
+	// retry interval that is accepted. The lock and presences are refreshed
+	// once every retry interval, so with a smaller ratio a single slow or
+	// failed refresh lets the entry expire while its owner still believes it
+	// holds it, and another instance can take the lock in the meantime.
+	MinTTLToRetryIntervalRatio = 2
+
+	// RecommendedTTLToRetryIntervalRatio is the ratio used by the defaults,
+	// which tolerates two missed refreshes before the lock expires.
+	RecommendedTTLToRetryIntervalRatio = 3
+
+	// MaxClockSkewTolerance bounds the tolerance for clock skew between the
+	// nodes sharing the SQL database. The tolerance is a safety margin for
+	// clocks that are synchronized but drift apart slightly, not a way to run
+	// with clocks that are not synchronized.
+	MaxClockSkewTolerance = 5 * time.Second
+)
+
+// ValidateTimings checks that the lock TTL leaves room for at least
+// MinTTLToRetryIntervalRatio refreshes at the given retry interval.
+func ValidateTimings(ttl, retryInterval time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("lock TTL must be positive, got %s", ttl)
+	}
+
+	if retryInterval <= 0 {
+		return fmt.Errorf("lock retry interval must be positive, got %s", retryInterval)
+	}
+
+	if retryInterval*MinTTLToRetryIntervalRatio > ttl {
+		return fmt.Errorf(
+			"lock retry interval %s is too long for lock TTL %s: the TTL must be at least %d times the retry interval, and %d times is recommended",
+			retryInterval, ttl, MinTTLToRetryIntervalRatio, RecommendedTTLToRetryIntervalRatio,
+		)
+	}
+
+	return nil
+}
+
+// TimingsBelowRecommendation reports whether the lock TTL, although valid,
+// leaves room for fewer than RecommendedTTLToRetryIntervalRatio refreshes.
+func TimingsBelowRecommendation(ttl, retryInterval time.Duration) bool {
+	return retryInterval*RecommendedTTLToRetryIntervalRatio > ttl
+}
+
+// ValidateClockSkewTolerance checks that the clock skew tolerance is at most
+// MaxClockSkewTolerance and shorter than the retry interval, so that a lock or
+// presence that is no longer refreshed is still given up within one more
+// refresh.
+func ValidateClockSkewTolerance(tolerance, retryInterval time.Duration) error {
+	if tolerance < 0 {
+		return fmt.Errorf("clock skew tolerance must not be negative, got %s", tolerance)
+	}
+
+	if tolerance > MaxClockSkewTolerance {
+		return fmt.Errorf("clock skew tolerance %s is longer than the maximum of %s", tolerance, MaxClockSkewTolerance)
+	}
+
+	if tolerance > 0 && tolerance >= retryInterval {
+		return fmt.Errorf("clock skew tolerance %s must be shorter than the lock retry interval %s", tolerance, retryInterval)
+	}
+
+	return nil
+}
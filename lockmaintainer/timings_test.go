@@ -0,0 +1,64 @@
+package lockmaintainer_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/lockmaintainer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timings", func() {
+	Describe("ValidateTimings", func() {
+		It("accepts a TTL of at least twice the retry interval", func() {
+			Expect(lockmaintainer.ValidateTimings(15*time.Second, 5*time.Second)).To(Succeed())
+			Expect(lockmaintainer.ValidateTimings(10*time.Second, 5*time.Second)).To(Succeed())
+		})
+
+		It("rejects a retry interval longer than half the TTL", func() {
+			err := lockmaintainer.ValidateTimings(10*time.Second, 6*time.Second)
+			Expect(err).To(MatchError(ContainSubstring("lock retry interval 6s is too long for lock TTL 10s")))
+		})
+
+		It("rejects a non-positive TTL", func() {
+			Expect(lockmaintainer.ValidateTimings(0, 5*time.Second)).To(MatchError(ContainSubstring("lock TTL must be positive")))
+		})
+
+		It("rejects a non-positive retry interval", func() {
+			Expect(lockmaintainer.ValidateTimings(15*time.Second, 0)).To(MatchError(ContainSubstring("lock retry interval must be positive")))
+		})
+	})
+
+	Describe("TimingsBelowRecommendation", func() {
+		It("reports whether the TTL is less than three times the retry interval", func() {
+			Expect(lockmaintainer.TimingsBelowRecommendation(15*time.Second, 5*time.Second)).To(BeFalse())
+			Expect(lockmaintainer.TimingsBelowRecommendation(10*time.Second, 5*time.Second)).To(BeTrue())
+		})
+	})
+
+	Describe("ValidateClockSkewTolerance", func() {
+		It("accepts no tolerance", func() {
+			Expect(lockmaintainer.ValidateClockSkewTolerance(0, 5*time.Second)).To(Succeed())
+		})
+
+		It("accepts a small tolerance shorter than the retry interval", func() {
+			Expect(lockmaintainer.ValidateClockSkewTolerance(time.Second, 5*time.Second)).To(Succeed())
+			Expect(lockmaintainer.ValidateClockSkewTolerance(lockmaintainer.MaxClockSkewTolerance, 10*time.Second)).To(Succeed())
+		})
+
+		It("rejects a negative tolerance", func() {
+			Expect(lockmaintainer.ValidateClockSkewTolerance(-time.Second, 5*time.Second)).To(MatchError(ContainSubstring("must not be negative")))
+		})
+
+		It("rejects a tolerance longer than the maximum", func() {
+			err := lockmaintainer.ValidateClockSkewTolerance(lockmaintainer.MaxClockSkewTolerance+time.Second, time.Minute)
+			Expect(err).To(MatchError(ContainSubstring("clock skew tolerance 6s is longer than the maximum of 5s")))
+		})
+
+		It("rejects a tolerance that is not shorter than the retry interval", func() {
+			err := lockmaintainer.ValidateClockSkewTolerance(2*time.Second, 2*time.Second)
+			Expect(err).To(MatchError(ContainSubstring("must be shorter than the lock retry interval 2s")))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+	"github.com/tedsuo/ifrit"
+)
+
+const (
+	lockHeld          = metric.Metric("LockHeld")
+	lockTTL           = metric.Duration("LockTTL")
+	lockRetryInterval = metric.Duration("LockRetryInterval")
+)
+
+// LockHeldMetronNotifier wraps the lock maintainer and emits the LockHeld
+// metric (1 while this instance holds the lock, 0 otherwise) on every
+// transition, so operators can alert when no BBS holds the lock or when the
+// lock flaps between instances. It also emits the LockTTL and
+// LockRetryInterval the lock is maintained with when it starts.
+type LockHeldMetronNotifier struct {
+	Logger         lager.Logger
+	LockMaintainer ifrit.Runner
+	HolderID       string
+	TTL            time.Duration
+	RetryInterval  time.Duration
+}
+
+func NewLockHeldMetronNotifier(logger lager.Logger, lockMaintainer ifrit.Runner, holderID string, ttl, retryInterval time.Duration) *LockHeldMetronNotifier {
+	return &LockHeldMetronNotifier{
+		Logger:         logger,
+		LockMaintainer: lockMaintainer,
+		HolderID:       holderID,
+		TTL:            ttl,
+		RetryInterval:  retryInterval,
+	}
+}
+
+func (notifier LockHeldMetronNotifier) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := notifier.Logger.Session("lock-held-notifier", lager.Data{"holder-id": notifier.HolderID})
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	notifier.sendLockTimings(logger)
+	notifier.sendLockHeld(logger, false)
+
+	process := ifrit.Background(notifier.LockMaintainer)
+
+	select {
+	case <-process.Ready():
+		logger.Info("lock-acquired")
+		notifier.sendLockHeld(logger, true)
+	case err := <-process.Wait():
+		logger.Error("lock-maintainer-exited-before-acquiring", err)
+		return err
+	case signal := <-signals:
+		process.Signal(signal)
+		return <-process.Wait()
+	}
+
+	close(ready)
+
+	select {
+	case err := <-process.Wait():
+		logger.Error("lock-lost", err)
+		notifier.sendLockHeld(logger, false)
+		return err
+	case signal := <-signals:
+		process.Signal(signal)
+		err := <-process.Wait()
+		logger.Info("lock-released")
+		notifier.sendLockHeld(logger, false)
+		return err
+	}
+}
+
+func (notifier LockHeldMetronNotifier) sendLockTimings(logger lager.Logger) {
+	err := lockTTL.Send(notifier.TTL)
+	if err != nil {
+		logger.Error("failed-to-send-lock-ttl-metric", err)
+	}
+
+	err = lockRetryInterval.Send(notifier.RetryInterval)
+	if err != nil {
+		logger.Error("failed-to-send-lock-retry-interval-metric", err)
+	}
+}
+
+func (notifier LockHeldMetronNotifier) sendLockHeld(logger lager.Logger, held bool) {
+	value := 0
+	if held {
+		value = 1
+	}
+
+	err := lockHeld.Send(value)
+	if err != nil {
+		logger.Error("failed-to-send-lock-held-metric", err)
+	}
+}
@@ -0,0 +1,148 @@
+package metrics_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/metrics"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("LockHeldMetronNotifier", func() {
+	var (
+		sender *fake.FakeMetricSender
+		logger *lagertest.TestLogger
+
+		acquireLock chan struct{}
+		loseLock    chan error
+
+		process ifrit.Process
+	)
+
+	lockHeld := func() float64 {
+		return sender.GetValue("LockHeld").Value
+	}
+
+	BeforeEach(func() {
+		sender = fake.NewFakeMetricSender()
+		dropsonde_metrics.Initialize(sender, nil)
+
+		logger = lagertest.NewTestLogger("test")
+		acquireLock = make(chan struct{})
+		loseLock = make(chan error)
+
+		lockMaintainer := ifrit.RunFunc(func(signals <-chan os.Signal, ready chan<- struct{}) error {
+			select {
+			case <-acquireLock:
+			case <-signals:
+				return nil
+			}
+
+			close(ready)
+
+			select {
+			case err := <-loseLock:
+				return err
+			case <-signals:
+				return nil
+			}
+		})
+
+		process = ifrit.Background(metrics.NewLockHeldMetronNotifier(logger, lockMaintainer, "some-uuid", 15*time.Second, 5*time.Second))
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	It("reports the lock TTL and retry interval", func() {
+		Eventually(func() bool { return sender.HasValue("LockRetryInterval") }).Should(BeTrue())
+		Expect(sender.GetValue("LockTTL")).To(Equal(fake.Metric{Value: float64(15 * time.Second), Unit: "nanos"}))
+		Expect(sender.GetValue("LockRetryInterval")).To(Equal(fake.Metric{Value: float64(5 * time.Second), Unit: "nanos"}))
+	})
+
+	Context("before the lock is acquired", func() {
+		It("reports that the lock is not held", func() {
+			Eventually(func() bool { return sender.HasValue("LockHeld") }).Should(BeTrue())
+			Expect(lockHeld()).To(Equal(float64(0)))
+		})
+
+		It("does not become ready", func() {
+			Consistently(process.Ready()).ShouldNot(BeClosed())
+		})
+
+		Context("when signalled", func() {
+			It("exits without becoming ready", func() {
+				process.Signal(os.Interrupt)
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+				Expect(process.Ready()).NotTo(BeClosed())
+			})
+		})
+	})
+
+	Context("when the lock is acquired", func() {
+		BeforeEach(func() {
+			acquireLock <- struct{}{}
+		})
+
+		It("becomes ready", func() {
+			Eventually(process.Ready()).Should(BeClosed())
+		})
+
+		It("reports that the lock is held", func() {
+			Eventually(lockHeld).Should(Equal(float64(1)))
+		})
+
+		It("logs the transition with the holder id", func() {
+			Eventually(logger).Should(gbytes.Say("lock-acquired"))
+			Expect(logger).To(gbytes.Say("some-uuid"))
+		})
+
+		Context("and then lost", func() {
+			var lockErr error
+
+			BeforeEach(func() {
+				Eventually(lockHeld).Should(Equal(float64(1)))
+				lockErr = errors.New("lock lost")
+				loseLock <- lockErr
+			})
+
+			It("reports that the lock is no longer held", func() {
+				Eventually(lockHeld).Should(Equal(float64(0)))
+			})
+
+			It("exits with the lock maintainer's error", func() {
+				Eventually(process.Wait()).Should(Receive(Equal(lockErr)))
+			})
+
+			It("logs the transition", func() {
+				Eventually(logger).Should(gbytes.Say("lock-lost"))
+			})
+		})
+
+		Context("and then signalled", func() {
+			BeforeEach(func() {
+				Eventually(lockHeld).Should(Equal(float64(1)))
+				process.Signal(os.Interrupt)
+			})
+
+			It("reports that the lock is no longer held", func() {
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+				Expect(lockHeld()).To(Equal(float64(0)))
+			})
+
+			It("logs the transition", func() {
+				Eventually(logger).Should(gbytes.Say("lock-released"))
+			})
+		})
+	})
+})
@@ -0,0 +1,80 @@
+// This file was generated by counterfeiter
+package metricsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/metrics"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeCellPresenceStatusSource struct {
+	CellPresenceStatusesStub        func(logger lager.Logger) ([]*models.CellPresenceStatus, error)
+	cellPresenceStatusesMutex       sync.RWMutex
+	cellPresenceStatusesArgsForCall []struct {
+		logger lager.Logger
+	}
+	cellPresenceStatusesReturns struct {
+		result1 []*models.CellPresenceStatus
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCellPresenceStatusSource) CellPresenceStatuses(logger lager.Logger) ([]*models.CellPresenceStatus, error) {
+	fake.cellPresenceStatusesMutex.Lock()
+	fake.cellPresenceStatusesArgsForCall = append(fake.cellPresenceStatusesArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("CellPresenceStatuses", []interface{}{logger})
+	fake.cellPresenceStatusesMutex.Unlock()
+	if fake.CellPresenceStatusesStub != nil {
+		return fake.CellPresenceStatusesStub(logger)
+	} else {
+		return fake.cellPresenceStatusesReturns.result1, fake.cellPresenceStatusesReturns.result2
+	}
+}
+
+func (fake *FakeCellPresenceStatusSource) CellPresenceStatusesCallCount() int {
+	fake.cellPresenceStatusesMutex.RLock()
+	defer fake.cellPresenceStatusesMutex.RUnlock()
+	return len(fake.cellPresenceStatusesArgsForCall)
+}
+
+func (fake *FakeCellPresenceStatusSource) CellPresenceStatusesArgsForCall(i int) lager.Logger {
+	fake.cellPresenceStatusesMutex.RLock()
+	defer fake.cellPresenceStatusesMutex.RUnlock()
+	return fake.cellPresenceStatusesArgsForCall[i].logger
+}
+
+func (fake *FakeCellPresenceStatusSource) CellPresenceStatusesReturns(result1 []*models.CellPresenceStatus, result2 error) {
+	fake.CellPresenceStatusesStub = nil
+	fake.cellPresenceStatusesReturns = struct {
+		result1 []*models.CellPresenceStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellPresenceStatusSource) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cellPresenceStatusesMutex.RLock()
+	defer fake.cellPresenceStatusesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCellPresenceStatusSource) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ metrics.CellPresenceStatusSource = new(FakeCellPresenceStatusSource)
@@ -0,0 +1,67 @@
+// This file was generated by counterfeiter
+package metricsfakes
+
+import (
+	"database/sql"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/metrics"
+)
+
+type FakeDBStatsSource struct {
+	StatsStub        func() sql.DBStats
+	statsMutex       sync.RWMutex
+	statsArgsForCall []struct{}
+	statsReturns     struct {
+		result1 sql.DBStats
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDBStatsSource) Stats() sql.DBStats {
+	fake.statsMutex.Lock()
+	fake.statsArgsForCall = append(fake.statsArgsForCall, struct{}{})
+	fake.recordInvocation("Stats", []interface{}{})
+	fake.statsMutex.Unlock()
+	if fake.StatsStub != nil {
+		return fake.StatsStub()
+	} else {
+		return fake.statsReturns.result1
+	}
+}
+
+func (fake *FakeDBStatsSource) StatsCallCount() int {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return len(fake.statsArgsForCall)
+}
+
+func (fake *FakeDBStatsSource) StatsReturns(result1 sql.DBStats) {
+	fake.StatsStub = nil
+	fake.statsReturns = struct {
+		result1 sql.DBStats
+	}{result1}
+}
+
+func (fake *FakeDBStatsSource) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDBStatsSource) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ metrics.DBStatsSource = new(FakeDBStatsSource)
@@ -0,0 +1,67 @@
+// This file was generated by counterfeiter
+package metricsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/metrics"
+)
+
+type FakeEventHubStatsSource struct {
+	StatsStub        func() events.HubStats
+	statsMutex       sync.RWMutex
+	statsArgsForCall []struct{}
+	statsReturns     struct {
+		result1 events.HubStats
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEventHubStatsSource) Stats() events.HubStats {
+	fake.statsMutex.Lock()
+	fake.statsArgsForCall = append(fake.statsArgsForCall, struct{}{})
+	fake.recordInvocation("Stats", []interface{}{})
+	fake.statsMutex.Unlock()
+	if fake.StatsStub != nil {
+		return fake.StatsStub()
+	} else {
+		return fake.statsReturns.result1
+	}
+}
+
+func (fake *FakeEventHubStatsSource) StatsCallCount() int {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return len(fake.statsArgsForCall)
+}
+
+func (fake *FakeEventHubStatsSource) StatsReturns(result1 events.HubStats) {
+	fake.StatsStub = nil
+	fake.statsReturns = struct {
+		result1 events.HubStats
+	}{result1}
+}
+
+func (fake *FakeEventHubStatsSource) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeEventHubStatsSource) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ metrics.EventHubStatsSource = new(FakeEventHubStatsSource)
@@ -0,0 +1,67 @@
+// This file was generated by counterfeiter
+package metricsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/metrics"
+	"code.cloudfoundry.org/bbs/taskworkpool"
+)
+
+type FakeTaskCallbackStatsSource struct {
+	StatsStub        func() taskworkpool.Stats
+	statsMutex       sync.RWMutex
+	statsArgsForCall []struct{}
+	statsReturns     struct {
+		result1 taskworkpool.Stats
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTaskCallbackStatsSource) Stats() taskworkpool.Stats {
+	fake.statsMutex.Lock()
+	fake.statsArgsForCall = append(fake.statsArgsForCall, struct{}{})
+	fake.recordInvocation("Stats", []interface{}{})
+	fake.statsMutex.Unlock()
+	if fake.StatsStub != nil {
+		return fake.StatsStub()
+	} else {
+		return fake.statsReturns.result1
+	}
+}
+
+func (fake *FakeTaskCallbackStatsSource) StatsCallCount() int {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return len(fake.statsArgsForCall)
+}
+
+func (fake *FakeTaskCallbackStatsSource) StatsReturns(result1 taskworkpool.Stats) {
+	fake.StatsStub = nil
+	fake.statsReturns = struct {
+		result1 taskworkpool.Stats
+	}{result1}
+}
+
+func (fake *FakeTaskCallbackStatsSource) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeTaskCallbackStatsSource) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ metrics.TaskCallbackStatsSource = new(FakeTaskCallbackStatsSource)
@@ -1,10 +1,17 @@
 package metrics
 
 import (
+	"database/sql"
+	"fmt"
 	"os"
+	"sort"
 	"time"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/taskworkpool"
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/runtimeschema/metric"
@@ -14,25 +21,107 @@ const (
 	metricsReportingDuration = metric.Duration("MetricsReportingDuration")
 
 	bbsMasterElected = metric.Counter("BBSMasterElected")
+
+	dbMaxOpenConnections = metric.Metric("DBMaxOpenConnections")
+	dbOpenConnections    = metric.Metric("DBOpenConnections")
+	dbInUseConnections   = metric.Metric("DBInUseConnections")
+	dbIdleConnections    = metric.Metric("DBIdleConnections")
+	dbWaitCount          = metric.Metric("DBWaitCount")
+	dbWaitDuration       = metric.Duration("DBWaitDuration")
+
+	taskCallbacksQueued       = metric.Metric("TaskCallbacksQueued")
+	taskCallbackWorkersActive = metric.Metric("TaskCallbackWorkersActive")
+
+	staleCellPresences = metric.Metric("StaleCellPresences")
+
+	etcdReachable   = metric.Metric("ETCDReachable")
+	etcdReadLatency = metric.Duration("ETCDReadLatency")
+
+	eventHubSubscribersPrefix          = "EventHubSubscribers."
+	eventHubQueuedEventsPrefix         = "EventHubQueuedEvents."
+	eventHubMaxQueuedEventsPrefix      = "EventHubMaxQueuedEvents."
+	eventHubOldestQueuedEventAgePrefix = "EventHubOldestQueuedEventAge."
 )
 
+//go:generate counterfeiter -o metricsfakes/fake_dbstats_source.go . DBStatsSource
+
+// DBStatsSource reports the state of a database connection pool. *sql.DB is
+// a DBStatsSource.
+type DBStatsSource interface {
+	Stats() sql.DBStats
+}
+
+//go:generate counterfeiter -o metricsfakes/fake_task_callback_stats_source.go . TaskCallbackStatsSource
+
+// TaskCallbackStatsSource reports the state of the task callback work pool.
+// *taskworkpool.TaskCompletionWorkPool is a TaskCallbackStatsSource.
+type TaskCallbackStatsSource interface {
+	Stats() taskworkpool.Stats
+}
+
+//go:generate counterfeiter -o metricsfakes/fake_cell_presence_status_source.go . CellPresenceStatusSource
+
+// CellPresenceStatusSource reports the registered cells and whether their
+// presences are stale. bbs.ServiceClient is a CellPresenceStatusSource.
+type CellPresenceStatusSource interface {
+	CellPresenceStatuses(logger lager.Logger) ([]*models.CellPresenceStatus, error)
+}
+
+//go:generate counterfeiter -o metricsfakes/fake_event_hub_stats_source.go . EventHubStatsSource
+
+// EventHubStatsSource reports how far behind the subscribers of an event hub
+// are. events.Hub is an EventHubStatsSource.
+type EventHubStatsSource interface {
+	Stats() events.HubStats
+}
+
+// DomainMetricsConfig limits how many domains the per-domain LRP metrics are
+// emitted for. Only domains with at least MinInstances desired instances are
+// reported, and of those only the MaxDomains with the most desired instances.
+// A MaxDomains of 0 turns the per-domain metrics off.
+type DomainMetricsConfig struct {
+	MaxDomains   int
+	MinInstances int
+}
+
 type PeriodicMetronNotifier struct {
-	Interval    time.Duration
-	ETCDOptions *etcd.ETCDOptions
-	Logger      lager.Logger
-	Clock       clock.Clock
+	Interval      time.Duration
+	ETCDOptions   *etcd.ETCDOptions
+	Logger        lager.Logger
+	Clock         clock.Clock
+	LRPDB         db.LRPDB
+	DomainMetrics DomainMetricsConfig
+	DBStats       DBStatsSource
+	TaskCallbacks TaskCallbackStatsSource
+	CellPresences CellPresenceStatusSource
+	EventHubs     map[string]EventHubStatsSource
+	ETCDStore     etcd.StoreClient
 }
 
 func NewPeriodicMetronNotifier(logger lager.Logger,
 	interval time.Duration,
 	etcdOptions *etcd.ETCDOptions,
 	clock clock.Clock,
+	lrpDB db.LRPDB,
+	domainMetrics DomainMetricsConfig,
+	dbStats DBStatsSource,
+	taskCallbacks TaskCallbackStatsSource,
+	cellPresences CellPresenceStatusSource,
+	eventHubs map[string]EventHubStatsSource,
+	etcdStore etcd.StoreClient,
 ) *PeriodicMetronNotifier {
 	return &PeriodicMetronNotifier{
-		Interval:    interval,
-		ETCDOptions: etcdOptions,
-		Logger:      logger,
-		Clock:       clock,
+		Interval:      interval,
+		ETCDOptions:   etcdOptions,
+		Logger:        logger,
+		Clock:         clock,
+		LRPDB:         lrpDB,
+		DomainMetrics: domainMetrics,
+		DBStats:       dbStats,
+		TaskCallbacks: taskCallbacks,
+		CellPresences: cellPresences,
+		EventHubs:     eventHubs,
+		ETCDStore:     etcdStore,
 	}
 }
 
@@ -68,6 +157,13 @@ func (notifier PeriodicMetronNotifier) Run(signals <-chan os.Signal, ready chan<
 				etcdMetrics.Send()
 			}
 
+			notifier.sendDomainMetrics(logger)
+			notifier.sendDBStatsMetrics(logger)
+			notifier.sendTaskCallbackMetrics(logger)
+			notifier.sendCellPresenceMetrics(logger)
+			notifier.sendEventHubMetrics(logger)
+			notifier.sendETCDReachabilityMetrics(logger)
+
 			finishedAt := notifier.Clock.Now()
 
 			err = metricsReportingDuration.Send(finishedAt.Sub(startedAt))
@@ -82,3 +178,226 @@ func (notifier PeriodicMetronNotifier) Run(signals <-chan os.Signal, ready chan<
 
 	return nil
 }
+
+func (notifier PeriodicMetronNotifier) sendDomainMetrics(logger lager.Logger) {
+	if notifier.LRPDB == nil || notifier.DomainMetrics.MaxDomains <= 0 {
+		return
+	}
+
+	counts, err := notifier.LRPDB.LRPCountsByDomain(logger)
+	if err != nil {
+		logger.Error("failed-fetching-lrp-counts-by-domain", err)
+		return
+	}
+
+	domains := ReportedDomains(counts, notifier.DomainMetrics)
+	if len(domains) < len(counts) {
+		logger.Debug("omitting-domain-metrics", lager.Data{"reported": len(domains), "total": len(counts)})
+	}
+
+	for _, domain := range domains {
+		err := metric.Metric(fmt.Sprintf("LRPsDesired.%s", domain)).Send(counts[domain].DesiredInstances)
+		if err != nil {
+			logger.Error("failed-to-send-domain-desired-lrps-metric", err, lager.Data{"domain": domain})
+		}
+
+		err = metric.Metric(fmt.Sprintf("LRPsRunning.%s", domain)).Send(counts[domain].RunningInstances)
+		if err != nil {
+			logger.Error("failed-to-send-domain-running-lrps-metric", err, lager.Data{"domain": domain})
+		}
+	}
+}
+
+// sendDBStatsMetrics reports the state of the SQL connection pool. The wait
+// count and wait duration are totals since the BBS started, so their growth
+// over an interval shows how often and how long queries waited for a free
+// connection.
+func (notifier PeriodicMetronNotifier) sendDBStatsMetrics(logger lager.Logger) {
+	if notifier.DBStats == nil {
+		return
+	}
+
+	stats := notifier.DBStats.Stats()
+
+	err := dbMaxOpenConnections.Send(stats.MaxOpenConnections)
+	if err != nil {
+		logger.Error("failed-to-send-db-max-open-connections-metric", err)
+	}
+
+	err = dbOpenConnections.Send(stats.OpenConnections)
+	if err != nil {
+		logger.Error("failed-to-send-db-open-connections-metric", err)
+	}
+
+	err = dbInUseConnections.Send(stats.InUse)
+	if err != nil {
+		logger.Error("failed-to-send-db-in-use-connections-metric", err)
+	}
+
+	err = dbIdleConnections.Send(stats.Idle)
+	if err != nil {
+		logger.Error("failed-to-send-db-idle-connections-metric", err)
+	}
+
+	err = dbWaitCount.Send(int(stats.WaitCount))
+	if err != nil {
+		logger.Error("failed-to-send-db-wait-count-metric", err)
+	}
+
+	err = dbWaitDuration.Send(stats.WaitDuration)
+	if err != nil {
+		logger.Error("failed-to-send-db-wait-duration-metric", err)
+	}
+}
+
+// sendTaskCallbackMetrics reports how many task callbacks are waiting for a
+// worker and how many workers are busy. A queue that keeps growing while all
+// workers are active means the pool is saturated.
+func (notifier PeriodicMetronNotifier) sendTaskCallbackMetrics(logger lager.Logger) {
+	if notifier.TaskCallbacks == nil {
+		return
+	}
+
+	stats := notifier.TaskCallbacks.Stats()
+
+	err := taskCallbacksQueued.Send(stats.Queued)
+	if err != nil {
+		logger.Error("failed-to-send-task-callbacks-queued-metric", err)
+	}
+
+	err = taskCallbackWorkersActive.Send(stats.Active)
+	if err != nil {
+		logger.Error("failed-to-send-task-callback-workers-active-metric", err)
+	}
+}
+
+// sendCellPresenceMetrics reports how many cells have a stale presence, one
+// that has missed refreshes and expires soon. Cells that keep showing up here
+// are at risk of silently unregistering, after which their LRPs are
+// rescheduled elsewhere.
+func (notifier PeriodicMetronNotifier) sendCellPresenceMetrics(logger lager.Logger) {
+	if notifier.CellPresences == nil {
+		return
+	}
+
+	statuses, err := notifier.CellPresences.CellPresenceStatuses(logger)
+	if err != nil {
+		logger.Error("failed-fetching-cell-presence-statuses", err)
+		return
+	}
+
+	stale := 0
+	for _, status := range statuses {
+		if status.Stale {
+			stale++
+		}
+	}
+
+	err = staleCellPresences.Send(stale)
+	if err != nil {
+		logger.Error("failed-to-send-stale-cell-presences-metric", err)
+	}
+}
+
+// sendEventHubMetrics reports, for each event hub by name, how many
+// subscribers it has and how far behind they are in reading their events. A
+// subscriber is closed once its queue fills up, so a deepest queue that keeps
+// growing, or an oldest unread event that keeps ageing, shows consumers that
+// are about to be evicted.
+func (notifier PeriodicMetronNotifier) sendEventHubMetrics(logger lager.Logger) {
+	names := make([]string, 0, len(notifier.EventHubs))
+	for name := range notifier.EventHubs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		stats := notifier.EventHubs[name].Stats()
+
+		err := metric.Metric(eventHubSubscribersPrefix + name).Send(stats.Subscribers)
+		if err != nil {
+			logger.Error("failed-to-send-event-hub-subscribers-metric", err, lager.Data{"hub": name})
+		}
+
+		err = metric.Metric(eventHubQueuedEventsPrefix + name).Send(stats.QueuedEvents)
+		if err != nil {
+			logger.Error("failed-to-send-event-hub-queued-events-metric", err, lager.Data{"hub": name})
+		}
+
+		err = metric.Metric(eventHubMaxQueuedEventsPrefix + name).Send(stats.MaxQueuedEvents)
+		if err != nil {
+			logger.Error("failed-to-send-event-hub-max-queued-events-metric", err, lager.Data{"hub": name})
+		}
+
+		err = metric.Duration(eventHubOldestQueuedEventAgePrefix + name).Send(stats.OldestQueuedEventAge)
+		if err != nil {
+			logger.Error("failed-to-send-event-hub-oldest-queued-event-age-metric", err, lager.Data{"hub": name})
+		}
+	}
+}
+
+// sendETCDReachabilityMetrics reports whether a quorum read of the etcd
+// cluster succeeds, and how long it took. A read of a key that does not exist
+// still reached the cluster. Reads that time out or fail report the cluster
+// as unreachable, which tells an etcd outage apart from a BBS that is stuck.
+func (notifier PeriodicMetronNotifier) sendETCDReachabilityMetrics(logger lager.Logger) {
+	if notifier.ETCDStore == nil {
+		return
+	}
+
+	startedAt := notifier.Clock.Now()
+	_, err := notifier.ETCDStore.Get(etcd.VersionKey, false, false)
+	latency := notifier.Clock.Now().Sub(startedAt)
+
+	reachable := 1
+	if err != nil && !etcd.IsKeyNotFound(err) {
+		logger.Error("failed-to-reach-etcd", err, lager.Data{"latency": latency.String()})
+		reachable = 0
+	}
+
+	err = etcdReachable.Send(reachable)
+	if err != nil {
+		logger.Error("failed-to-send-etcd-reachable-metric", err)
+	}
+
+	err = etcdReadLatency.Send(latency)
+	if err != nil {
+		logger.Error("failed-to-send-etcd-read-latency-metric", err)
+	}
+}
+
+// ReportedDomains returns the domains that per-domain metrics are emitted
+// for, ordered by their number of desired instances, largest first.
+func ReportedDomains(counts map[string]db.DomainLRPCounts, config DomainMetricsConfig) []string {
+	domains := []string{}
+	for domain, domainCounts := range counts {
+		if domainCounts.DesiredInstances >= config.MinInstances {
+			domains = append(domains, domain)
+		}
+	}
+
+	sort.Sort(domainsByDesiredInstances{domains: domains, counts: counts})
+
+	if config.MaxDomains > 0 && len(domains) > config.MaxDomains {
+		domains = domains[:config.MaxDomains]
+	}
+
+	return domains
+}
+
+type domainsByDesiredInstances struct {
+	domains []string
+	counts  map[string]db.DomainLRPCounts
+}
+
+func (d domainsByDesiredInstances) Len() int { return len(d.domains) }
+func (d domainsByDesiredInstances) Swap(i, j int) {
+	d.domains[i], d.domains[j] = d.domains[j], d.domains[i]
+}
+func (d domainsByDesiredInstances) Less(i, j int) bool {
+	a, b := d.counts[d.domains[i]], d.counts[d.domains[j]]
+	if a.DesiredInstances != b.DesiredInstances {
+		return a.DesiredInstances > b.DesiredInstances
+	}
+	return d.domains[i] < d.domains[j]
+}
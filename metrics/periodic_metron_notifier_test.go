@@ -1,19 +1,30 @@
 package metrics_test
 
 import (
+	"database/sql"
+	"errors"
 	"net/http"
 	"os"
 	"time"
 
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/etcd/fakes"
+	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/metrics"
+	"code.cloudfoundry.org/bbs/metrics/metricsfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/taskworkpool"
 	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
 	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+	etcdclient "github.com/coreos/go-etcd/etcd"
 	"github.com/tedsuo/ifrit"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
 )
@@ -28,6 +39,13 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 		etcdOptions    etcd.ETCDOptions
 		reportInterval time.Duration
 		fakeClock      *fakeclock.FakeClock
+		fakeLRPDB      *dbfakes.FakeLRPDB
+		domainMetrics  metrics.DomainMetricsConfig
+		dbStats        metrics.DBStatsSource
+		taskCallbacks  metrics.TaskCallbackStatsSource
+		cellPresences  metrics.CellPresenceStatusSource
+		eventHubs      map[string]metrics.EventHubStatsSource
+		etcdStore      etcd.StoreClient
 
 		pmn ifrit.Process
 	)
@@ -40,6 +58,13 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 		sender = fake.NewFakeMetricSender()
 		dropsonde_metrics.Initialize(sender, nil)
 		etcdOptions.IsConfigured = true
+		fakeLRPDB = &dbfakes.FakeLRPDB{}
+		domainMetrics = metrics.DomainMetricsConfig{}
+		dbStats = nil
+		taskCallbacks = nil
+		cellPresences = nil
+		eventHubs = nil
+		etcdStore = nil
 	})
 
 	JustBeforeEach(func() {
@@ -48,6 +73,13 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 			reportInterval,
 			&etcdOptions,
 			fakeClock,
+			fakeLRPDB,
+			domainMetrics,
+			dbStats,
+			taskCallbacks,
+			cellPresences,
+			eventHubs,
+			etcdStore,
 		))
 	})
 
@@ -285,4 +317,289 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 			})
 		})
 	})
+
+	Context("when reporting LRP counts by domain", func() {
+		BeforeEach(func() {
+			etcdOptions.IsConfigured = false
+			domainMetrics = metrics.DomainMetricsConfig{MaxDomains: 2, MinInstances: 2}
+			fakeLRPDB.LRPCountsByDomainReturns(map[string]db.DomainLRPCounts{
+				"big":    {DesiredInstances: 10, RunningInstances: 8},
+				"medium": {DesiredInstances: 5, RunningInstances: 5},
+				"small":  {DesiredInstances: 3, RunningInstances: 1},
+				"tiny":   {DesiredInstances: 1, RunningInstances: 1},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			fakeClock.Increment(reportInterval)
+		})
+
+		It("emits the desired and running counts of the largest domains", func() {
+			Eventually(func() fake.Metric {
+				return sender.GetValue("LRPsDesired.big")
+			}).Should(Equal(fake.Metric{Value: 10, Unit: "Metric"}))
+			Expect(sender.GetValue("LRPsRunning.big")).To(Equal(fake.Metric{Value: 8, Unit: "Metric"}))
+			Expect(sender.GetValue("LRPsDesired.medium")).To(Equal(fake.Metric{Value: 5, Unit: "Metric"}))
+			Expect(sender.GetValue("LRPsRunning.medium")).To(Equal(fake.Metric{Value: 5, Unit: "Metric"}))
+
+			Expect(sender.HasValue("LRPsDesired.small")).To(BeFalse())
+			Expect(sender.HasValue("LRPsDesired.tiny")).To(BeFalse())
+		})
+
+		Context("when the per-domain metrics are turned off", func() {
+			BeforeEach(func() {
+				domainMetrics.MaxDomains = 0
+			})
+
+			It("does not fetch the counts", func() {
+				Consistently(fakeLRPDB.LRPCountsByDomainCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when fetching the counts fails", func() {
+			BeforeEach(func() {
+				fakeLRPDB.LRPCountsByDomainReturns(nil, errors.New("boom"))
+			})
+
+			It("does not emit any per-domain metrics", func() {
+				Eventually(fakeLRPDB.LRPCountsByDomainCallCount).Should(Equal(1))
+				Consistently(func() bool {
+					return sender.HasValue("LRPsDesired.big")
+				}).Should(BeFalse())
+			})
+		})
+	})
+
+	Context("when reporting the state of the SQL connection pool", func() {
+		var fakeDBStats *metricsfakes.FakeDBStatsSource
+
+		BeforeEach(func() {
+			etcdOptions.IsConfigured = false
+			fakeDBStats = new(metricsfakes.FakeDBStatsSource)
+			fakeDBStats.StatsReturns(sql.DBStats{
+				MaxOpenConnections: 200,
+				OpenConnections:    12,
+				InUse:              10,
+				Idle:               2,
+				WaitCount:          7,
+				WaitDuration:       3 * time.Second,
+			})
+			dbStats = fakeDBStats
+		})
+
+		JustBeforeEach(func() {
+			fakeClock.Increment(reportInterval)
+		})
+
+		It("emits the connection counts and the time spent waiting for a connection", func() {
+			Eventually(func() fake.Metric {
+				return sender.GetValue("DBWaitDuration")
+			}).Should(Equal(fake.Metric{Value: float64(3 * time.Second), Unit: "nanos"}))
+			Expect(sender.GetValue("DBMaxOpenConnections")).To(Equal(fake.Metric{Value: 200, Unit: "Metric"}))
+			Expect(sender.GetValue("DBOpenConnections")).To(Equal(fake.Metric{Value: 12, Unit: "Metric"}))
+			Expect(sender.GetValue("DBInUseConnections")).To(Equal(fake.Metric{Value: 10, Unit: "Metric"}))
+			Expect(sender.GetValue("DBIdleConnections")).To(Equal(fake.Metric{Value: 2, Unit: "Metric"}))
+			Expect(sender.GetValue("DBWaitCount")).To(Equal(fake.Metric{Value: 7, Unit: "Metric"}))
+		})
+
+		Context("when there is no SQL database", func() {
+			BeforeEach(func() {
+				dbStats = nil
+			})
+
+			It("does not emit the connection pool metrics", func() {
+				Consistently(func() bool {
+					return sender.HasValue("DBOpenConnections")
+				}).Should(BeFalse())
+			})
+		})
+	})
+
+	Context("when reporting the state of the task callback work pool", func() {
+		BeforeEach(func() {
+			etcdOptions.IsConfigured = false
+			fakeTaskCallbacks := new(metricsfakes.FakeTaskCallbackStatsSource)
+			fakeTaskCallbacks.StatsReturns(taskworkpool.Stats{Queued: 42, Active: 1000})
+			taskCallbacks = fakeTaskCallbacks
+		})
+
+		JustBeforeEach(func() {
+			fakeClock.Increment(reportInterval)
+		})
+
+		It("emits the queued callbacks and the active workers", func() {
+			Eventually(func() fake.Metric {
+				return sender.GetValue("TaskCallbacksQueued")
+			}).Should(Equal(fake.Metric{Value: 42, Unit: "Metric"}))
+			Expect(sender.GetValue("TaskCallbackWorkersActive")).To(Equal(fake.Metric{Value: 1000, Unit: "Metric"}))
+		})
+	})
+
+	Context("when reporting the cell presences", func() {
+		var fakeCellPresences *metricsfakes.FakeCellPresenceStatusSource
+
+		BeforeEach(func() {
+			etcdOptions.IsConfigured = false
+			fakeCellPresences = new(metricsfakes.FakeCellPresenceStatusSource)
+			fakeCellPresences.CellPresenceStatusesReturns([]*models.CellPresenceStatus{
+				{Presence: &models.CellPresence{CellId: "cell-1"}, Stale: true},
+				{Presence: &models.CellPresence{CellId: "cell-2"}},
+				{Presence: &models.CellPresence{CellId: "cell-3"}, Stale: true},
+			}, nil)
+			cellPresences = fakeCellPresences
+		})
+
+		JustBeforeEach(func() {
+			fakeClock.Increment(reportInterval)
+		})
+
+		It("emits the number of stale cell presences", func() {
+			Eventually(func() fake.Metric {
+				return sender.GetValue("StaleCellPresences")
+			}).Should(Equal(fake.Metric{Value: 2, Unit: "Metric"}))
+		})
+
+		Context("when fetching the cell presences fails", func() {
+			BeforeEach(func() {
+				fakeCellPresences.CellPresenceStatusesReturns(nil, errors.New("boom"))
+			})
+
+			It("does not emit the metric", func() {
+				Eventually(fakeCellPresences.CellPresenceStatusesCallCount).Should(BeNumerically(">", 0))
+				Consistently(func() bool {
+					return sender.HasValue("StaleCellPresences")
+				}).Should(BeFalse())
+			})
+		})
+	})
+
+	Context("when reporting the event hubs", func() {
+		BeforeEach(func() {
+			etcdOptions.IsConfigured = false
+			fakeActualHub := new(metricsfakes.FakeEventHubStatsSource)
+			fakeActualHub.StatsReturns(events.HubStats{
+				Subscribers:          3,
+				QueuedEvents:         120,
+				MaxQueuedEvents:      100,
+				OldestQueuedEventAge: 2 * time.Second,
+			})
+			fakeTaskHub := new(metricsfakes.FakeEventHubStatsSource)
+			fakeTaskHub.StatsReturns(events.HubStats{Subscribers: 1})
+			eventHubs = map[string]metrics.EventHubStatsSource{
+				"ActualLRP": fakeActualHub,
+				"Task":      fakeTaskHub,
+			}
+		})
+
+		JustBeforeEach(func() {
+			fakeClock.Increment(reportInterval)
+		})
+
+		It("emits the subscribers and how far behind they are for each hub", func() {
+			Eventually(func() fake.Metric {
+				return sender.GetValue("EventHubOldestQueuedEventAge.Task")
+			}).Should(Equal(fake.Metric{Value: 0, Unit: "nanos"}))
+
+			Expect(sender.GetValue("EventHubSubscribers.ActualLRP")).To(Equal(fake.Metric{Value: 3, Unit: "Metric"}))
+			Expect(sender.GetValue("EventHubQueuedEvents.ActualLRP")).To(Equal(fake.Metric{Value: 120, Unit: "Metric"}))
+			Expect(sender.GetValue("EventHubMaxQueuedEvents.ActualLRP")).To(Equal(fake.Metric{Value: 100, Unit: "Metric"}))
+			Expect(sender.GetValue("EventHubOldestQueuedEventAge.ActualLRP")).To(Equal(fake.Metric{Value: float64(2 * time.Second), Unit: "nanos"}))
+			Expect(sender.GetValue("EventHubSubscribers.Task")).To(Equal(fake.Metric{Value: 1, Unit: "Metric"}))
+		})
+	})
+
+	Context("when reporting the reachability of etcd", func() {
+		var fakeStoreClient *fakes.FakeStoreClient
+
+		BeforeEach(func() {
+			fakeStoreClient = &fakes.FakeStoreClient{}
+			fakeStoreClient.GetStub = func(key string, sort, recursive bool) (*etcdclient.Response, error) {
+				fakeClock.Increment(20 * time.Millisecond)
+				return &etcdclient.Response{}, nil
+			}
+			etcdStore = fakeStoreClient
+		})
+
+		JustBeforeEach(func() {
+			fakeClock.Increment(reportInterval)
+		})
+
+		It("reads the version key with the store client", func() {
+			Eventually(fakeStoreClient.GetCallCount).Should(Equal(1))
+
+			key, sort, recursive := fakeStoreClient.GetArgsForCall(0)
+			Expect(key).To(Equal(etcd.VersionKey))
+			Expect(sort).To(BeFalse())
+			Expect(recursive).To(BeFalse())
+		})
+
+		It("emits that etcd is reachable and the latency of the read", func() {
+			Eventually(func() fake.Metric {
+				return sender.GetValue("ETCDReadLatency")
+			}).Should(Equal(fake.Metric{Value: float64(20 * time.Millisecond), Unit: "nanos"}))
+			Expect(sender.GetValue("ETCDReachable")).To(Equal(fake.Metric{Value: 1, Unit: "Metric"}))
+		})
+
+		Context("when the key does not exist", func() {
+			BeforeEach(func() {
+				fakeStoreClient.GetStub = nil
+				fakeStoreClient.GetReturns(nil, etcdclient.EtcdError{ErrorCode: etcd.ETCDErrKeyNotFound})
+			})
+
+			It("emits that etcd is reachable", func() {
+				Eventually(func() fake.Metric {
+					return sender.GetValue("ETCDReachable")
+				}).Should(Equal(fake.Metric{Value: 1, Unit: "Metric"}))
+			})
+		})
+
+		Context("when etcd cannot be reached", func() {
+			BeforeEach(func() {
+				fakeStoreClient.GetStub = func(key string, sort, recursive bool) (*etcdclient.Response, error) {
+					fakeClock.Increment(50 * time.Millisecond)
+					return nil, errors.New("etcd cluster is unreachable")
+				}
+			})
+
+			It("emits that etcd is unreachable and how long the read took to fail", func() {
+				Eventually(func() fake.Metric {
+					return sender.GetValue("ETCDReachable")
+				}).Should(Equal(fake.Metric{Value: 0, Unit: "Metric"}))
+				Expect(sender.GetValue("ETCDReadLatency")).To(Equal(fake.Metric{Value: float64(50 * time.Millisecond), Unit: "nanos"}))
+			})
+		})
+
+		Context("when there is no etcd store", func() {
+			BeforeEach(func() {
+				etcdStore = nil
+			})
+
+			It("does not emit the metrics", func() {
+				Consistently(func() fake.Metric {
+					return sender.GetValue("ETCDReachable")
+				}).Should(Equal(fake.Metric{}))
+			})
+		})
+	})
+
+	Describe("ReportedDomains", func() {
+		counts := map[string]db.DomainLRPCounts{
+			"b":     {DesiredInstances: 5},
+			"a":     {DesiredInstances: 5},
+			"c":     {DesiredInstances: 10},
+			"d":     {DesiredInstances: 1, RunningInstances: 3},
+			"empty": {},
+		}
+
+		DescribeTable("selecting domains",
+			func(config metrics.DomainMetricsConfig, expected []string) {
+				Expect(metrics.ReportedDomains(counts, config)).To(Equal(expected))
+			},
+			Entry("orders by desired instances, then name", metrics.DomainMetricsConfig{}, []string{"c", "a", "b", "d", "empty"}),
+			Entry("caps the number of domains", metrics.DomainMetricsConfig{MaxDomains: 2}, []string{"c", "a"}),
+			Entry("drops domains below the threshold", metrics.DomainMetricsConfig{MinInstances: 5}, []string{"c", "a", "b"}),
+			Entry("applies both", metrics.DomainMetricsConfig{MaxDomains: 1, MinInstances: 5}, []string{"c"}),
+			Entry("may select nothing", metrics.DomainMetricsConfig{MinInstances: 100}, []string{}),
+		)
+	})
 })
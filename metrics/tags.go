@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/cloudfoundry/dropsonde/emitter"
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+// ParseTags parses a comma separated list of key:value pairs, e.g.
+// "deployment:cf-prod,region:us-east-1,index:0", into a set of tags.
+func ParseTags(value string) (map[string]string, error) {
+	tags := map[string]string{}
+	if strings.TrimSpace(value) == "" {
+		return tags, nil
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid tag '%s': expected key:value", pair)
+		}
+
+		key := strings.TrimSpace(parts[0])
+		if key == "" {
+			return nil, fmt.Errorf("invalid tag '%s': key is empty", pair)
+		}
+		if _, ok := tags[key]; ok {
+			return nil, fmt.Errorf("invalid tag '%s': key is repeated", pair)
+		}
+
+		tags[key] = strings.TrimSpace(parts[1])
+	}
+
+	return tags, nil
+}
+
+// TagLogger returns a logger that includes the tags in every line that it, or
+// any session created from it, writes.
+func TagLogger(logger lager.Logger, tags map[string]string) lager.Logger {
+	if len(tags) == 0 {
+		return logger
+	}
+
+	return logger.WithData(lager.Data{"tags": tags})
+}
+
+// TaggedEventEmitter is an emitter.EventEmitter that sets a fixed set of tags
+// on every envelope.
+type TaggedEventEmitter struct {
+	byteEmitter emitter.ByteEmitter
+	origin      string
+	tags        map[string]string
+}
+
+// NewTaggedEventEmitter returns an emitter that sets the given tags on every
+// envelope before writing it to byteEmitter. Tags already present on an
+// envelope take precedence.
+func NewTaggedEventEmitter(byteEmitter emitter.ByteEmitter, origin string, tags map[string]string) *TaggedEventEmitter {
+	return &TaggedEventEmitter{
+		byteEmitter: byteEmitter,
+		origin:      origin,
+		tags:        tags,
+	}
+}
+
+func (e *TaggedEventEmitter) Emit(event events.Event) error {
+	return e.EmitWithTags(event, nil)
+}
+
+// EmitWithTags emits the event with the given tags in addition to the fixed
+// ones, which they take precedence over.
+func (e *TaggedEventEmitter) EmitWithTags(event events.Event, tags map[string]string) error {
+	envelope, err := emitter.Wrap(event, e.origin)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) > 0 {
+		envelope.Tags = make(map[string]string, len(tags))
+		for key, value := range tags {
+			envelope.Tags[key] = value
+		}
+	}
+
+	return e.EmitEnvelope(envelope)
+}
+
+func (e *TaggedEventEmitter) EmitEnvelope(envelope *events.Envelope) error {
+	if len(e.tags) > 0 {
+		if envelope.Tags == nil {
+			envelope.Tags = make(map[string]string, len(e.tags))
+		}
+		for key, value := range e.tags {
+			if _, ok := envelope.Tags[key]; !ok {
+				envelope.Tags[key] = value
+			}
+		}
+	}
+
+	data, err := envelope.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return e.byteEmitter.Emit(data)
+}
+
+func (e *TaggedEventEmitter) Close() {
+	e.byteEmitter.Close()
+}
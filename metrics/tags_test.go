@@ -0,0 +1,152 @@
+package metrics_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/bbs/metrics"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/sonde-go/events"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+type recordingByteEmitter struct {
+	messages [][]byte
+	emitErr  error
+	closed   bool
+}
+
+func (e *recordingByteEmitter) Emit(data []byte) error {
+	if e.emitErr != nil {
+		return e.emitErr
+	}
+	e.messages = append(e.messages, data)
+	return nil
+}
+
+func (e *recordingByteEmitter) Close() {
+	e.closed = true
+}
+
+var _ = Describe("Tags", func() {
+	Describe("ParseTags", func() {
+		DescribeTable("valid tags",
+			func(value string, expected map[string]string) {
+				tags, err := metrics.ParseTags(value)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tags).To(Equal(expected))
+			},
+			Entry("empty", "", map[string]string{}),
+			Entry("a single tag", "deployment:cf", map[string]string{"deployment": "cf"}),
+			Entry("several tags with spaces", "deployment:cf, region : us-east-1,index:0", map[string]string{
+				"deployment": "cf",
+				"region":     "us-east-1",
+				"index":      "0",
+			}),
+			Entry("a value containing a colon", "url:http://example.com", map[string]string{"url": "http://example.com"}),
+			Entry("an empty value", "region:", map[string]string{"region": ""}),
+		)
+
+		DescribeTable("invalid tags",
+			func(value string) {
+				_, err := metrics.ParseTags(value)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("missing separator", "deployment"),
+			Entry("empty key", ":cf"),
+			Entry("repeated key", "deployment:cf,deployment:other"),
+			Entry("trailing comma", "deployment:cf,"),
+		)
+	})
+
+	Describe("TagLogger", func() {
+		It("adds the tags to every log line", func() {
+			logger := lagertest.NewTestLogger("test")
+			tagged := metrics.TagLogger(logger, map[string]string{"deployment": "cf"})
+
+			tagged.Session("some-session").Info("some-message")
+			Expect(logger).To(gbytes.Say(`"tags":{"deployment":"cf"}`))
+		})
+
+		It("leaves the logger alone when there are no tags", func() {
+			logger := lagertest.NewTestLogger("test")
+			Expect(metrics.TagLogger(logger, map[string]string{})).To(Equal(logger))
+		})
+	})
+
+	Describe("TaggedEventEmitter", func() {
+		var (
+			byteEmitter  *recordingByteEmitter
+			eventEmitter *metrics.TaggedEventEmitter
+		)
+
+		lastEnvelope := func() *events.Envelope {
+			Expect(byteEmitter.messages).NotTo(BeEmpty())
+			envelope := &events.Envelope{}
+			Expect(envelope.Unmarshal(byteEmitter.messages[len(byteEmitter.messages)-1])).To(Succeed())
+			return envelope
+		}
+
+		BeforeEach(func() {
+			byteEmitter = &recordingByteEmitter{}
+			eventEmitter = metrics.NewTaggedEventEmitter(byteEmitter, "bbs", map[string]string{
+				"deployment": "cf",
+				"index":      "0",
+			})
+		})
+
+		It("wraps events with the origin and tags", func() {
+			name, value, unit := "some-metric", 3.0, "Metric"
+			err := eventEmitter.Emit(&events.ValueMetric{Name: &name, Value: &value, Unit: &unit})
+			Expect(err).NotTo(HaveOccurred())
+
+			envelope := lastEnvelope()
+			Expect(envelope.GetOrigin()).To(Equal("bbs"))
+			Expect(envelope.GetValueMetric().GetName()).To(Equal("some-metric"))
+			Expect(envelope.GetTags()).To(Equal(map[string]string{"deployment": "cf", "index": "0"}))
+		})
+
+		It("keeps tags already set on an envelope", func() {
+			origin := "bbs"
+			err := eventEmitter.EmitEnvelope(&events.Envelope{
+				Origin: &origin,
+				Tags:   map[string]string{"index": "1", "source": "test"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(lastEnvelope().GetTags()).To(Equal(map[string]string{
+				"deployment": "cf",
+				"index":      "1",
+				"source":     "test",
+			}))
+		})
+
+		It("adds the tags given with an event to the fixed ones", func() {
+			name, delta := "some-counter", uint64(1)
+			err := eventEmitter.EmitWithTags(&events.CounterEvent{Name: &name, Delta: &delta}, map[string]string{"index": "1", "key_label": "some-key"})
+			Expect(err).NotTo(HaveOccurred())
+
+			envelope := lastEnvelope()
+			Expect(envelope.GetCounterEvent().GetName()).To(Equal("some-counter"))
+			Expect(envelope.GetTags()).To(Equal(map[string]string{
+				"deployment": "cf",
+				"index":      "1",
+				"key_label":  "some-key",
+			}))
+		})
+
+		It("returns errors from the underlying emitter", func() {
+			byteEmitter.emitErr = errors.New("boom")
+			origin := "bbs"
+			Expect(eventEmitter.EmitEnvelope(&events.Envelope{Origin: &origin})).To(MatchError("boom"))
+		})
+
+		It("closes the underlying emitter", func() {
+			eventEmitter.Close()
+			Expect(byteEmitter.closed).To(BeTrue())
+		})
+	})
+})
@@ -32,6 +32,7 @@ type Manager struct {
 	migrationsDone chan<- struct{}
 	clock          clock.Clock
 	databaseDriver string
+	tablePrefix    string
 }
 
 func NewManager(
@@ -45,6 +46,7 @@ func NewManager(
 	migrationsDone chan<- struct{},
 	clock clock.Clock,
 	databaseDriver string,
+	tablePrefix string,
 ) Manager {
 	sort.Sort(migrations)
 
@@ -59,6 +61,7 @@ func NewManager(
 		migrationsDone: migrationsDone,
 		clock:          clock,
 		databaseDriver: databaseDriver,
+		tablePrefix:    tablePrefix,
 	}
 }
 
@@ -199,6 +202,7 @@ func (m *Manager) performMigration(
 				currentMigration.SetRawSQLDB(m.rawSQLDB)
 				currentMigration.SetClock(m.clock)
 				currentMigration.SetDBFlavor(m.databaseDriver)
+				currentMigration.SetTablePrefix(m.tablePrefix)
 
 				err := currentMigration.Up(m.logger.Session("migration"))
 				if err != nil {
@@ -262,6 +266,18 @@ func (m *Manager) lastETCDMigrationVersion() int64 {
 	return 0
 }
 
+// maxMigrationVersion is the version the data ends up at once every migration
+// supported by the configured databases has run.
+func (m *Manager) maxMigrationVersion() int64 {
+	if !m.hasSQLConfigured() {
+		return m.lastETCDMigrationVersion()
+	}
+	if len(m.migrations) > 0 {
+		return m.migrations[len(m.migrations)-1].Version()
+	}
+	return 0
+}
+
 // returns nil, nil if no version is found
 func (m *Manager) resolveStoredVersion(logger lager.Logger) (*models.Version, error) {
 	var (
@@ -20,5 +20,6 @@ type Migration interface {
 	SetClock(c clock.Clock)
 	SetRawSQLDB(rawSQLDB *sql.DB)
 	SetDBFlavor(flavor string)
+	SetTablePrefix(prefix string)
 	RequiresSQL() bool
 }
@@ -60,6 +60,11 @@ type FakeMigration struct {
 	setDBFlavorArgsForCall []struct {
 		flavor string
 	}
+	SetTablePrefixStub        func(prefix string)
+	setTablePrefixMutex       sync.RWMutex
+	setTablePrefixArgsForCall []struct {
+		prefix string
+	}
 	RequiresSQLStub        func() bool
 	requiresSQLMutex       sync.RWMutex
 	requiresSQLArgsForCall []struct{}
@@ -281,6 +286,30 @@ func (fake *FakeMigration) SetDBFlavorArgsForCall(i int) string {
 	return fake.setDBFlavorArgsForCall[i].flavor
 }
 
+func (fake *FakeMigration) SetTablePrefix(prefix string) {
+	fake.setTablePrefixMutex.Lock()
+	fake.setTablePrefixArgsForCall = append(fake.setTablePrefixArgsForCall, struct {
+		prefix string
+	}{prefix})
+	fake.recordInvocation("SetTablePrefix", []interface{}{prefix})
+	fake.setTablePrefixMutex.Unlock()
+	if fake.SetTablePrefixStub != nil {
+		fake.SetTablePrefixStub(prefix)
+	}
+}
+
+func (fake *FakeMigration) SetTablePrefixCallCount() int {
+	fake.setTablePrefixMutex.RLock()
+	defer fake.setTablePrefixMutex.RUnlock()
+	return len(fake.setTablePrefixArgsForCall)
+}
+
+func (fake *FakeMigration) SetTablePrefixArgsForCall(i int) string {
+	fake.setTablePrefixMutex.RLock()
+	defer fake.setTablePrefixMutex.RUnlock()
+	return fake.setTablePrefixArgsForCall[i].prefix
+}
+
 func (fake *FakeMigration) RequiresSQL() bool {
 	fake.requiresSQLMutex.Lock()
 	fake.requiresSQLArgsForCall = append(fake.requiresSQLArgsForCall, struct{}{})
@@ -325,6 +354,8 @@ func (fake *FakeMigration) Invocations() map[string][][]interface{} {
 	defer fake.setRawSQLDBMutex.RUnlock()
 	fake.setDBFlavorMutex.RLock()
 	defer fake.setDBFlavorMutex.RUnlock()
+	fake.setTablePrefixMutex.RLock()
+	defer fake.setTablePrefixMutex.RUnlock()
 	fake.requiresSQLMutex.RLock()
 	defer fake.requiresSQLMutex.RUnlock()
 	return fake.invocations
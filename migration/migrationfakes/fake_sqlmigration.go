@@ -0,0 +1,117 @@
+// This file was generated by counterfeiter
+package migrationfakes
+
+import (
+	"database/sql"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeSQLMigration struct {
+	VersionStub        func() int64
+	versionMutex       sync.RWMutex
+	versionArgsForCall []struct{}
+	versionReturns     struct {
+		result1 int64
+	}
+	UpStub        func(logger lager.Logger, tx *sql.Tx, flavor, tablePrefix string) error
+	upMutex       sync.RWMutex
+	upArgsForCall []struct {
+		logger      lager.Logger
+		tx          *sql.Tx
+		flavor      string
+		tablePrefix string
+	}
+	upReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSQLMigration) Version() int64 {
+	fake.versionMutex.Lock()
+	fake.versionArgsForCall = append(fake.versionArgsForCall, struct{}{})
+	fake.recordInvocation("Version", []interface{}{})
+	fake.versionMutex.Unlock()
+	if fake.VersionStub != nil {
+		return fake.VersionStub()
+	} else {
+		return fake.versionReturns.result1
+	}
+}
+
+func (fake *FakeSQLMigration) VersionCallCount() int {
+	fake.versionMutex.RLock()
+	defer fake.versionMutex.RUnlock()
+	return len(fake.versionArgsForCall)
+}
+
+func (fake *FakeSQLMigration) VersionReturns(result1 int64) {
+	fake.VersionStub = nil
+	fake.versionReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeSQLMigration) Up(logger lager.Logger, tx *sql.Tx, flavor string, tablePrefix string) error {
+	fake.upMutex.Lock()
+	fake.upArgsForCall = append(fake.upArgsForCall, struct {
+		logger      lager.Logger
+		tx          *sql.Tx
+		flavor      string
+		tablePrefix string
+	}{logger, tx, flavor, tablePrefix})
+	fake.recordInvocation("Up", []interface{}{logger, tx, flavor, tablePrefix})
+	fake.upMutex.Unlock()
+	if fake.UpStub != nil {
+		return fake.UpStub(logger, tx, flavor, tablePrefix)
+	} else {
+		return fake.upReturns.result1
+	}
+}
+
+func (fake *FakeSQLMigration) UpCallCount() int {
+	fake.upMutex.RLock()
+	defer fake.upMutex.RUnlock()
+	return len(fake.upArgsForCall)
+}
+
+func (fake *FakeSQLMigration) UpArgsForCall(i int) (lager.Logger, *sql.Tx, string, string) {
+	fake.upMutex.RLock()
+	defer fake.upMutex.RUnlock()
+	return fake.upArgsForCall[i].logger, fake.upArgsForCall[i].tx, fake.upArgsForCall[i].flavor, fake.upArgsForCall[i].tablePrefix
+}
+
+func (fake *FakeSQLMigration) UpReturns(result1 error) {
+	fake.UpStub = nil
+	fake.upReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSQLMigration) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.versionMutex.RLock()
+	defer fake.versionMutex.RUnlock()
+	fake.upMutex.RLock()
+	defer fake.upMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSQLMigration) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ migration.SQLMigration = new(FakeSQLMigration)
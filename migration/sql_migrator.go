@@ -0,0 +1,205 @@
+package migration
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"sort"
+
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o migrationfakes/fake_sqlmigration.go . SQLMigration
+
+// SQLMigration is a schema change that only applies to the SQL backend. Each
+// one runs inside its own transaction, and should be safe to run again if it
+// was interrupted on an engine that does not roll back DDL (e.g. MySQL).
+// The tables and indexes it touches are named with tablePrefix in front.
+type SQLMigration interface {
+	Version() int64
+	Up(logger lager.Logger, tx *sql.Tx, flavor, tablePrefix string) error
+}
+
+// NonTransactionalSQLMigration is a SQLMigration whose statements cannot run
+// inside a transaction, e.g. CREATE INDEX CONCURRENTLY on Postgres. The
+// SQLMigrator calls UpWithoutTransaction instead of Up for it, and records it
+// once that returns, so it must be safe to run again if it was interrupted.
+type NonTransactionalSQLMigration interface {
+	SQLMigration
+	UpWithoutTransaction(logger lager.Logger, db *sql.DB, flavor, tablePrefix string) error
+}
+
+type SQLMigrations []SQLMigration
+
+func (m SQLMigrations) Len() int           { return len(m) }
+func (m SQLMigrations) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+func (m SQLMigrations) Less(i, j int) bool { return m[i].Version() < m[j].Version() }
+
+const createSchemaMigrationsTableSQL = `CREATE TABLE IF NOT EXISTS %sschema_migrations(
+	version BIGINT PRIMARY KEY,
+	applied_at BIGINT NOT NULL
+);`
+
+// SQLMigrator applies every SQLMigration that is not yet recorded in the
+// schema_migrations table. It is meant to run once the Manager has finished,
+// so that the initial schema created by the etcd to SQL migration exists, and
+// closes migrationsDone once the SQL schema is up to date.
+type SQLMigrator struct {
+	logger         lager.Logger
+	rawSQLDB       *sql.DB
+	flavor         string
+	tablePrefix    string
+	migrations     SQLMigrations
+	migrationsDone chan<- struct{}
+	clock          clock.Clock
+}
+
+func NewSQLMigrator(
+	logger lager.Logger,
+	rawSQLDB *sql.DB,
+	flavor string,
+	tablePrefix string,
+	migrations SQLMigrations,
+	migrationsDone chan<- struct{},
+	clock clock.Clock,
+) SQLMigrator {
+	sorted := make(SQLMigrations, len(migrations))
+	copy(sorted, migrations)
+	sort.Sort(sorted)
+
+	return SQLMigrator{
+		logger:         logger,
+		rawSQLDB:       rawSQLDB,
+		flavor:         flavor,
+		tablePrefix:    tablePrefix,
+		migrations:     sorted,
+		migrationsDone: migrationsDone,
+		clock:          clock,
+	}
+}
+
+func (m SQLMigrator) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := m.logger.Session("sql-migrator")
+	logger.Info("starting")
+	defer logger.Info("exited")
+
+	if m.rawSQLDB != nil {
+		err := m.Migrate(logger)
+		if err != nil {
+			logger.Error("migration-failed", err)
+			return err
+		}
+	} else {
+		logger.Info("no-sql-configuration")
+	}
+
+	close(ready)
+	close(m.migrationsDone)
+	logger.Info("finished-migrations")
+
+	<-signals
+	return nil
+}
+
+// Migrate creates the schema_migrations table if necessary and runs every
+// pending migration in version order, recording each one in the same
+// transaction that applied it.
+func (m SQLMigrator) Migrate(logger lager.Logger) error {
+	_, err := m.rawSQLDB.Exec(fmt.Sprintf(createSchemaMigrationsTableSQL, m.tablePrefix))
+	if err != nil {
+		logger.Error("failed-creating-schema-migrations-table", err)
+		return err
+	}
+
+	applied, err := m.appliedVersions()
+	if err != nil {
+		logger.Error("failed-fetching-applied-versions", err)
+		return err
+	}
+
+	for _, currentMigration := range m.migrations {
+		version := currentMigration.Version()
+		if applied[version] {
+			continue
+		}
+
+		logger.Info("running-migration", lager.Data{"migration_version": version})
+		err := m.apply(logger, currentMigration)
+		if err != nil {
+			logger.Error("failed-running-migration", err, lager.Data{"migration_version": version})
+			return err
+		}
+		logger.Debug("completed-migration", lager.Data{"migration_version": version})
+	}
+
+	return nil
+}
+
+func (m SQLMigrator) appliedVersions() (map[int64]bool, error) {
+	rows, err := m.rawSQLDB.Query(fmt.Sprintf("SELECT version FROM %sschema_migrations", m.tablePrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int64]bool{}
+	for rows.Next() {
+		var version int64
+		err := rows.Scan(&version)
+		if err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
+
+func (m SQLMigrator) apply(logger lager.Logger, sqlMigration SQLMigration) error {
+	if nonTransactional, ok := sqlMigration.(NonTransactionalSQLMigration); ok {
+		return m.applyWithoutTransaction(logger, nonTransactional)
+	}
+
+	tx, err := m.rawSQLDB.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = sqlMigration.Up(logger.Session("migration"), tx, m.flavor, m.tablePrefix)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(
+		sqldb.RebindForFlavor(fmt.Sprintf("INSERT INTO %sschema_migrations (version, applied_at) VALUES (?, ?)", m.tablePrefix), m.flavor),
+		sqlMigration.Version(),
+		m.clock.Now().UnixNano(),
+	)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("recording migration %d: %s", sqlMigration.Version(), err)
+	}
+
+	return tx.Commit()
+}
+
+func (m SQLMigrator) applyWithoutTransaction(logger lager.Logger, sqlMigration NonTransactionalSQLMigration) error {
+	err := sqlMigration.UpWithoutTransaction(logger.Session("migration"), m.rawSQLDB, m.flavor, m.tablePrefix)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.rawSQLDB.Exec(
+		sqldb.RebindForFlavor(fmt.Sprintf("INSERT INTO %sschema_migrations (version, applied_at) VALUES (?, ?)", m.tablePrefix), m.flavor),
+		sqlMigration.Version(),
+		m.clock.Now().UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("recording migration %d: %s", sqlMigration.Version(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package migration
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// VersionWatcher waits for the stored data to reach the version this BBS
+// would migrate to, without running any migrations itself. A standby BBS uses
+// it to decide when it is safe to serve reads, since the lock holder may still
+// be migrating the data.
+//
+// The watcher is ready as soon as it starts, so that it never holds back the
+// processes started after it, such as the lock maintainer: the migrations it
+// waits for only run once some BBS holds the lock. Only versionReady, which
+// gates the read routes, waits for the version.
+type VersionWatcher struct {
+	manager      Manager
+	versionReady chan<- struct{}
+	pollInterval time.Duration
+}
+
+func NewVersionWatcher(manager Manager, versionReady chan<- struct{}, pollInterval time.Duration) VersionWatcher {
+	return VersionWatcher{
+		manager:      manager,
+		versionReady: versionReady,
+		pollInterval: pollInterval,
+	}
+}
+
+func (w VersionWatcher) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := w.manager.logger.Session("version-watcher")
+	logger.Info("starting")
+	defer logger.Info("exited")
+
+	expectedVersion := w.manager.maxMigrationVersion()
+	close(ready)
+
+	for {
+		version, err := w.manager.resolveStoredVersion(logger)
+		if err != nil {
+			logger.Error("failed-to-fetch-version", err)
+		} else if version != nil && version.CurrentVersion == expectedVersion && version.TargetVersion == expectedVersion {
+			close(w.versionReady)
+			logger.Info("version-is-current", lager.Data{"version": expectedVersion})
+			<-signals
+			return nil
+		} else {
+			logger.Info("waiting-for-migrations", lager.Data{"stored_version": version, "expected_version": expectedVersion})
+		}
+
+		timer := w.manager.clock.NewTimer(w.pollInterval)
+		select {
+		case <-timer.C():
+		case <-signals:
+			timer.Stop()
+			return nil
+		}
+	}
+}
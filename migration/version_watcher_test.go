@@ -0,0 +1,114 @@
+package migration_test
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/migration/migrationfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/ginkgomon"
+)
+
+var _ = Describe("Version Watcher", func() {
+	const pollInterval = 5 * time.Second
+
+	var (
+		logger    *lagertest.TestLogger
+		fakeSQLDB *dbfakes.FakeDB
+		fakeClock *fakeclock.FakeClock
+
+		versionReady   chan struct{}
+		watcherProcess ifrit.Process
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeSQLDB = &dbfakes.FakeDB{}
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		versionReady = make(chan struct{})
+	})
+
+	JustBeforeEach(func() {
+		fakeMigration := &migrationfakes.FakeMigration{}
+		fakeMigration.VersionReturns(100)
+
+		manager := migration.NewManager(
+			logger,
+			nil,
+			nil,
+			fakeSQLDB,
+			&sql.DB{},
+			&encryptionfakes.FakeCryptor{},
+			migration.Migrations{fakeMigration},
+			make(chan struct{}),
+			fakeClock,
+			"db-driver",
+			"",
+		)
+		watcherProcess = ifrit.Background(migration.NewVersionWatcher(manager, versionReady, pollInterval))
+	})
+
+	AfterEach(func() {
+		ginkgomon.Interrupt(watcherProcess)
+	})
+
+	Context("when the stored version is current", func() {
+		BeforeEach(func() {
+			fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 100, TargetVersion: 100}, nil)
+		})
+
+		It("closes the version ready channel", func() {
+			Eventually(versionReady).Should(BeClosed())
+		})
+
+		It("never writes the version", func() {
+			Eventually(versionReady).Should(BeClosed())
+			Expect(fakeSQLDB.SetVersionCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a migration is still in progress", func() {
+		BeforeEach(func() {
+			fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 99, TargetVersion: 100}, nil)
+		})
+
+		It("becomes ready without closing the version ready channel", func() {
+			Eventually(watcherProcess.Ready()).Should(BeClosed())
+			Eventually(fakeSQLDB.VersionCallCount).Should(Equal(1))
+			Consistently(versionReady).ShouldNot(BeClosed())
+		})
+
+		Context("and then finishes", func() {
+			It("closes the version ready channel after the next poll", func() {
+				Eventually(fakeSQLDB.VersionCallCount).Should(Equal(1))
+				fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 100, TargetVersion: 100}, nil)
+
+				fakeClock.WaitForWatcherAndIncrement(pollInterval)
+
+				Eventually(versionReady).Should(BeClosed())
+			})
+		})
+	})
+
+	Context("when fetching the version fails", func() {
+		BeforeEach(func() {
+			fakeSQLDB.VersionReturns(nil, errors.New("boom"))
+		})
+
+		It("keeps polling", func() {
+			Eventually(fakeSQLDB.VersionCallCount).Should(Equal(1))
+			fakeClock.WaitForWatcherAndIncrement(pollInterval)
+			Eventually(fakeSQLDB.VersionCallCount).Should(Equal(2))
+			Expect(versionReady).NotTo(BeClosed())
+		})
+	})
+})
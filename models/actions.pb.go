@@ -11,6 +11,7 @@
 		actual_lrp_requests.proto
 		cached_dependency.proto
 		cells.proto
+		convergence_status.proto
 		desired_lrp.proto
 		desired_lrp_requests.proto
 		domain.proto
@@ -18,9 +19,13 @@
 		error.proto
 		evacuation.proto
 		events.proto
+		grpc_service.proto
+		import.proto
+		lock.proto
 		lrp_convergence_request.proto
 		modification_tag.proto
 		network.proto
+		object_counts.proto
 		ping.proto
 		security_group.proto
 		task.proto
@@ -44,6 +49,7 @@
 		ActualLRPKey
 		ActualLRPInstanceKey
 		ActualLRPNetInfo
+		ActualLRPPlacement
 		ActualLRP
 		ActualLRPLifecycleResponse
 		ActualLRPGroupsResponse
@@ -51,17 +57,33 @@
 		ActualLRPGroupsRequest
 		ActualLRPGroupsByProcessGuidRequest
 		ActualLRPGroupByProcessGuidAndIndexRequest
+		CrashingActualLRPsRequest
+		ActualLRPsResponse
+		CellPlacementsRequest
+		CellPlacement
+		CellPlacementsResponse
 		ClaimActualLRPRequest
 		StartActualLRPRequest
 		CrashActualLRPRequest
 		FailActualLRPRequest
 		RetireActualLRPRequest
+		RetireActualLRPsOnCellRequest
+		RetireActualLRPsOnCellResponse
+		StartActualLRPsRequest
+		StartActualLRPsResponse
 		RemoveActualLRPRequest
 		CachedDependency
 		CellCapacity
 		CellPresence
 		Provider
 		CellsResponse
+		CellPresenceStatus
+		CellPresenceStatusesResponse
+		ConvergenceStatus
+		ConvergenceStatusResponse
+		PauseConvergenceRequest
+		PauseConvergenceResponse
+		ResumeConvergenceResponse
 		DesiredLRPSchedulingInfo
 		DesiredLRPRunInfo
 		ProtoRoutes
@@ -70,6 +92,7 @@
 		DesiredLRPResource
 		DesiredLRP
 		DesiredLRPLifecycleResponse
+		LintWarning
 		DesiredLRPsResponse
 		DesiredLRPsRequest
 		DesiredLRPResponse
@@ -78,11 +101,23 @@
 		DesireLRPRequest
 		UpdateDesiredLRPRequest
 		RemoveDesiredLRPRequest
+		DesiredLRPContentHash
+		DesiredLRPDiffRequest
+		DesiredLRPDiffResponse
+		ValidateDesiredLRPRequest
+		ValidateDesiredLRPResponse
 		DomainsResponse
 		UpsertDomainResponse
 		UpsertDomainRequest
+		UpsertDomainsRequest
+		UpsertDomainResult
+		UpsertDomainsResponse
+		DomainFreshness
+		DomainFreshnessResponse
 		EnvironmentVariable
 		Error
+		ErrorResponse
+		RecordError
 		EvacuationResponse
 		EvacuateClaimedActualLRPRequest
 		EvacuateRunningActualLRPRequest
@@ -97,9 +132,28 @@
 		DesiredLRPChangedEvent
 		DesiredLRPRemovedEvent
 		ActualLRPCrashedEvent
+		TaskCreatedEvent
+		TaskChangedEvent
+		TaskRemovedEvent
+		EventsRequest
+		EventEnvelope
+		ImportDomain
+		ImportRecord
+		ImportRequest
+		ImportResult
+		ImportResponse
+		ExportRequest
+		ExportResponse
+		ReleaseLockResponse
+		BBSPresenceStatus
+		CoordinationStateResponse
+		ConvergeLRPsRequest
 		ConvergeLRPsResponse
 		ModificationTag
 		Network
+		ObjectCountsRequest
+		ObjectCount
+		ObjectCountsResponse
 		PingResponse
 		PortRange
 		ICMPInfo
@@ -111,11 +165,19 @@
 		StartTaskRequest
 		StartTaskResponse
 		FailTaskRequest
+		RejectTaskRequest
 		TaskGuidRequest
+		CancelTasksRequest
+		CancelTasksResponse
+		DuplicateTasksRequest
+		DuplicateTasks
+		DuplicateTasksResponse
 		CompleteTaskRequest
 		TaskCallbackResponse
 		ConvergeTasksRequest
 		ConvergeTasksResponse
+		PurgeCompletedTasksRequest
+		PurgeCompletedTasksResponse
 		TasksRequest
 		TasksResponse
 		TaskByGuidRequest
@@ -4352,71 +4414,71 @@ var (
 func init() { proto.RegisterFile("actions.proto", fileDescriptorActions) }
 
 var fileDescriptorActions = []byte{
-	// 1046 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xda, 0xae, 0x93, 0x7d, 0x8d, 0x1d, 0x3c, 0x75, 0x52, 0x37, 0xa9, 0xd6, 0xc1, 0x20,
-	0x08, 0x52, 0x93, 0xa2, 0x0a, 0x71, 0x80, 0x03, 0xea, 0x16, 0xc4, 0x81, 0x96, 0x44, 0x4e, 0x29,
-	0xc7, 0xd5, 0x66, 0x77, 0xbc, 0x5e, 0x75, 0x77, 0x67, 0x99, 0x99, 0x4d, 0x31, 0x27, 0xee, 0x1c,
-	0xe8, 0xcf, 0xe0, 0xa7, 0xe4, 0x82, 0xd4, 0x23, 0x27, 0x43, 0xcc, 0x05, 0xf9, 0xd4, 0x9f, 0x80,
-	0x76, 0x76, 0xc6, 0x99, 0x89, 0x25, 0xd4, 0x50, 0x29, 0x37, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0x99,
-	0xf7, 0xde, 0xbc, 0x67, 0x68, 0xf9, 0x01, 0x8f, 0x49, 0xc6, 0x0e, 0x72, 0x4a, 0x38, 0x41, 0xcd,
-	0x94, 0x84, 0x38, 0x61, 0xdb, 0xfb, 0x51, 0xcc, 0xc7, 0xc5, 0xc9, 0x41, 0x40, 0xd2, 0xfb, 0x11,
-	0x89, 0xc8, 0x7d, 0x01, 0x9f, 0x14, 0x23, 0xf1, 0x25, 0x3e, 0xc4, 0xaf, 0xca, 0x6d, 0x7b, 0x07,
-	0x67, 0xa7, 0x31, 0x25, 0x59, 0x8a, 0x33, 0xee, 0x9d, 0xfa, 0x34, 0xf6, 0x4f, 0x12, 0x2c, 0x35,
-	0x07, 0xbf, 0x34, 0xa1, 0xf9, 0x50, 0x44, 0x41, 0xdf, 0xc3, 0x46, 0x48, 0x5e, 0x64, 0x09, 0xf1,
-	0x43, 0xaf, 0x0a, 0xdc, 0xb3, 0x76, 0xad, 0xbd, 0x9b, 0x0f, 0xb6, 0x0e, 0xaa, 0xc0, 0x07, 0x5f,
-	0x4a, 0xb8, 0x72, 0x70, 0xb7, 0xe6, 0xd3, 0x3e, 0x52, 0x2e, 0xf7, 0x48, 0x1a, 0x73, 0x9c, 0xe6,
-	0x7c, 0x32, 0x6c, 0x87, 0x06, 0x0f, 0x1d, 0x42, 0xab, 0xc8, 0x75, 0xd9, 0x9a, 0x90, 0xed, 0x2a,
-	0xd9, 0xef, 0x72, 0x4d, 0xb4, 0x3b, 0x9f, 0xf6, 0xdf, 0xa9, 0xe8, 0x9a, 0xe4, 0x7a, 0xa1, 0x71,
-	0xd0, 0x23, 0x00, 0x5a, 0x64, 0x4a, 0xad, 0x2e, 0xd4, 0x3a, 0x4a, 0x6d, 0x58, 0x64, 0x52, 0xaa,
-	0x33, 0x9f, 0xf6, 0x5b, 0xb4, 0xc8, 0x34, 0x1d, 0x9b, 0x2a, 0x14, 0x1d, 0x43, 0x9b, 0xc7, 0x29,
-	0x26, 0x05, 0x57, 0x42, 0x0d, 0x21, 0xb4, 0xa9, 0x84, 0x9e, 0x56, 0xa8, 0x14, 0xdb, 0x9c, 0x4f,
-	0xfb, 0x1d, 0xe9, 0xa0, 0x09, 0xb6, 0xb8, 0xce, 0x42, 0x31, 0x74, 0x71, 0x1a, 0x73, 0x2f, 0xa7,
-	0x24, 0xa2, 0x98, 0x31, 0x25, 0x7d, 0x43, 0x48, 0x6f, 0x2b, 0xe9, 0xaf, 0xd2, 0x98, 0x1f, 0x49,
-	0x8a, 0xd4, 0xdf, 0x99, 0x4f, 0xfb, 0xb7, 0x0d, 0x5f, 0x2d, 0x0a, 0xc2, 0x4b, 0x0e, 0x65, 0x12,
-	0x38, 0x9d, 0xa8, 0x00, 0x4d, 0x33, 0x09, 0x4f, 0xe9, 0x44, 0x4f, 0x02, 0xa7, 0x13, 0x3d, 0x09,
-	0x5c, 0xa1, 0x65, 0xcd, 0x73, 0x9f, 0xfa, 0x49, 0x82, 0x13, 0xa5, 0xb4, 0x6a, 0xd6, 0xfc, 0x48,
-	0xc2, 0x7a, 0xcd, 0x95, 0x8b, 0x5e, 0xf3, 0xdc, 0xe0, 0x95, 0x35, 0x67, 0x98, 0xc6, 0xfe, 0x42,
-	0x76, 0xcd, 0xac, 0xf9, 0xb1, 0x00, 0xf5, 0x9a, 0x57, 0x74, 0xbd, 0xe6, 0x4c, 0xe3, 0xa0, 0x00,
-	0x50, 0x40, 0x42, 0x9c, 0xe3, 0x2c, 0x2c, 0xfb, 0x58, 0xaa, 0xda, 0x42, 0xf5, 0x8e, 0x52, 0x7d,
-	0x74, 0xc1, 0x90, 0xd2, 0x77, 0xe6, 0xd3, 0xfe, 0xa6, 0xe6, 0xa8, 0xe9, 0x77, 0x82, 0xcb, 0xec,
-	0xc1, 0xcb, 0x3a, 0xb4, 0xcd, 0x26, 0x47, 0x9f, 0xc2, 0x9a, 0x4f, 0x79, 0x3c, 0xf2, 0x03, 0x2e,
-	0x9e, 0x83, 0xed, 0x6e, 0x9f, 0x4d, 0xfb, 0x2b, 0x65, 0x1a, 0x94, 0x5d, 0xd3, 0x5c, 0x70, 0x51,
-	0x0f, 0x1a, 0x23, 0x4a, 0x52, 0xd1, 0xeb, 0xb6, 0xdb, 0x28, 0x7d, 0x86, 0xc2, 0x82, 0xba, 0x50,
-	0xe3, 0x44, 0x74, 0xad, 0xb2, 0xd7, 0x38, 0x41, 0xef, 0x82, 0x1d, 0xf8, 0xc1, 0x18, 0x7b, 0xcf,
-	0xf1, 0x44, 0x74, 0xa2, 0x02, 0xd7, 0x84, 0xf9, 0x1b, 0x3c, 0x41, 0x9f, 0x03, 0x24, 0x24, 0xf2,
-	0x18, 0x29, 0x68, 0x80, 0x45, 0x4b, 0xd9, 0xee, 0x5d, 0x79, 0x98, 0xee, 0x05, 0xa2, 0x57, 0x3a,
-	0x21, 0xd1, 0xb1, 0x30, 0x96, 0xe7, 0x29, 0x18, 0xa6, 0xa2, 0x51, 0x16, 0xe7, 0x29, 0x2d, 0xe8,
-	0x18, 0x50, 0x30, 0xc6, 0xc1, 0x73, 0x56, 0xa4, 0x9e, 0x9f, 0x44, 0x84, 0xc6, 0x7c, 0x9c, 0x8a,
-	0x36, 0xb0, 0xdd, 0xf7, 0xa5, 0xfc, 0xdd, 0x65, 0x86, 0x91, 0x49, 0x89, 0x3e, 0x54, 0x20, 0xfa,
-	0x1a, 0xda, 0x0b, 0x97, 0x53, 0x3f, 0x29, 0xb0, 0x68, 0x00, 0xdb, 0xdd, 0x95, 0x82, 0x3d, 0x13,
-	0xd5, 0x5f, 0x94, 0x42, 0x9e, 0x95, 0xc0, 0xe0, 0x77, 0x0b, 0xd6, 0xf5, 0x01, 0x71, 0x6d, 0x05,
-	0x31, 0xb3, 0xdd, 0xf8, 0x7f, 0xd9, 0xbe, 0x71, 0x39, 0xdb, 0x83, 0x3f, 0x6b, 0x60, 0x2f, 0x46,
-	0x54, 0xc9, 0xcb, 0x7d, 0x3e, 0x96, 0x17, 0x91, 0xbc, 0xd2, 0x82, 0x10, 0x34, 0x7c, 0x1a, 0xb1,
-	0x5e, 0x6d, 0xb7, 0xbe, 0x67, 0x0f, 0xc5, 0x6f, 0xf4, 0x21, 0xd4, 0xc3, 0x98, 0xca, 0x93, 0x6e,
-	0xca, 0xb3, 0xb4, 0xc2, 0x98, 0x6a, 0x87, 0x28, 0x19, 0x68, 0x1f, 0xea, 0x38, 0x3b, 0xed, 0x35,
-	0x76, 0xeb, 0x7b, 0x37, 0x1f, 0xec, 0x2c, 0xa6, 0xce, 0xc5, 0x1e, 0x78, 0x26, 0xd7, 0xc0, 0xb0,
-	0xe4, 0xa1, 0x2f, 0x60, 0x83, 0xe2, 0xea, 0x3a, 0x5e, 0x12, 0xa7, 0x31, 0x67, 0x72, 0x60, 0x2d,
-	0xa6, 0xc0, 0x50, 0xc2, 0x8f, 0x05, 0x3a, 0x6c, 0x53, 0xe3, 0xfb, 0x3f, 0x9a, 0xcb, 0xcc, 0xe2,
-	0xea, 0xd5, 0xb2, 0xf8, 0x09, 0xdc, 0x62, 0x45, 0x9e, 0x8b, 0x41, 0x5a, 0x72, 0x49, 0xc1, 0xf3,
-	0x82, 0x8b, 0x4e, 0x5a, 0x93, 0x51, 0x3a, 0x8a, 0xf0, 0x98, 0x44, 0x87, 0x02, 0x1e, 0xcc, 0x2d,
-	0x68, 0x19, 0xb3, 0x1b, 0x7d, 0x00, 0x4d, 0x63, 0xa1, 0xb5, 0xd5, 0xb5, 0x2a, 0x7c, 0x28, 0x51,
-	0x74, 0x08, 0x9b, 0x21, 0xce, 0x29, 0x0e, 0x7c, 0x8e, 0x43, 0x4f, 0x6d, 0x87, 0x8c, 0x89, 0x9e,
-	0xa9, 0xbb, 0x3b, 0xf2, 0xdc, 0xcb, 0x6b, 0xa0, 0x67, 0x0d, 0x6f, 0x5d, 0x78, 0xca, 0xe0, 0xdf,
-	0xb2, 0x4b, 0xb7, 0xaf, 0x5f, 0xed, 0xf6, 0xef, 0x01, 0xa8, 0x23, 0xa4, 0x4c, 0x34, 0x60, 0x5d,
-	0x5e, 0xda, 0x96, 0xf6, 0x27, 0x6c, 0xf0, 0x6b, 0x0d, 0xd0, 0xf2, 0x36, 0x79, 0xe3, 0x1b, 0x7f,
-	0x04, 0x2d, 0xc6, 0x7d, 0xca, 0xbd, 0x14, 0x33, 0xe6, 0x47, 0xd8, 0x78, 0x1d, 0xeb, 0x02, 0x7a,
-	0x52, 0x21, 0x68, 0x1f, 0x36, 0x58, 0x11, 0x04, 0x65, 0x2d, 0x14, 0x59, 0x7f, 0x32, 0x6d, 0x09,
-	0x2a, 0xfa, 0x67, 0xb0, 0x35, 0xf2, 0xe3, 0xa4, 0xa0, 0x58, 0xd1, 0xbd, 0x9c, 0xe2, 0x51, 0xfc,
-	0xa3, 0x31, 0xdc, 0xba, 0x92, 0x23, 0xbd, 0x8e, 0x04, 0xe3, 0xad, 0x06, 0xdd, 0x20, 0x07, 0x7b,
-	0xb1, 0xfd, 0xde, 0x38, 0x0f, 0x66, 0xc4, 0xda, 0xd5, 0x22, 0xbe, 0x80, 0xb6, 0xb9, 0x25, 0xd1,
-	0x1e, 0xac, 0xca, 0xbf, 0x6e, 0x3d, 0x4b, 0xbc, 0xc1, 0xcb, 0x71, 0x15, 0xfc, 0x76, 0x81, 0x0b,
-	0x58, 0xd7, 0xf7, 0xe8, 0x75, 0x85, 0xfd, 0x09, 0x3a, 0x4b, 0x8b, 0xf6, 0xba, 0x62, 0xff, 0x00,
-	0x6d, 0x73, 0x16, 0xa1, 0x8f, 0xa1, 0x99, 0x91, 0x51, 0x9c, 0x60, 0x51, 0xe2, 0x86, 0xdb, 0x3b,
-	0x9b, 0xf6, 0xad, 0xf2, 0x0f, 0x45, 0x65, 0xd5, 0x64, 0x24, 0x0f, 0xed, 0xc3, 0x8d, 0x2c, 0xa7,
-	0x24, 0x10, 0xb1, 0x1b, 0xee, 0x6d, 0xe9, 0xb0, 0x21, 0x8c, 0x1a, 0xbf, 0x62, 0xb9, 0xf7, 0x5e,
-	0x9d, 0x3b, 0x2b, 0x7f, 0x9c, 0x3b, 0x2b, 0xaf, 0xcf, 0x1d, 0xeb, 0xe7, 0x99, 0x63, 0xfd, 0x36,
-	0x73, 0xac, 0xb3, 0x99, 0x63, 0xbd, 0x9a, 0x39, 0xd6, 0x5f, 0x33, 0xc7, 0xfa, 0x67, 0xe6, 0xac,
-	0xbc, 0x9e, 0x39, 0xd6, 0xcb, 0xbf, 0x9d, 0x95, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x55, 0x83,
-	0x52, 0x31, 0xb4, 0x0b, 0x00, 0x00,
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x7a, 0x1d, 0x27, 0xfb, 0x1a, 0x3b, 0x78, 0xea, 0xa4, 0xdb, 0xa4, 0x5a, 0x07, 0x83,
+	0x20, 0x48, 0x4d, 0x8a, 0x2a, 0xc4, 0x01, 0x0e, 0xa8, 0x2e, 0x88, 0x03, 0x2d, 0x89, 0x9c, 0xb6,
+	0x1c, 0xad, 0xcd, 0xee, 0x78, 0xb3, 0xea, 0xee, 0xce, 0x32, 0x33, 0x9b, 0x62, 0x4e, 0xdc, 0x39,
+	0xd0, 0x9f, 0xc1, 0x4f, 0xc9, 0x05, 0xa9, 0x47, 0x4e, 0x86, 0x98, 0x0b, 0xf2, 0xa9, 0x3f, 0x01,
+	0xed, 0xec, 0x8c, 0x33, 0x13, 0x23, 0xd4, 0x50, 0xa9, 0xbd, 0x79, 0xdf, 0xf7, 0xbd, 0xef, 0xcd,
+	0xbc, 0xf7, 0xe6, 0x3d, 0x43, 0xd3, 0x0f, 0x78, 0x4c, 0x32, 0xb6, 0x9f, 0x53, 0xc2, 0x09, 0x6a,
+	0xa4, 0x24, 0xc4, 0x09, 0xdb, 0xda, 0x8b, 0x62, 0x7e, 0x52, 0x1c, 0xef, 0x07, 0x24, 0xbd, 0x13,
+	0x91, 0x88, 0xdc, 0x11, 0xf0, 0x71, 0x31, 0x12, 0x5f, 0xe2, 0x43, 0xfc, 0xaa, 0xdc, 0xb6, 0xb6,
+	0x71, 0x76, 0x1a, 0x53, 0x92, 0xa5, 0x38, 0xe3, 0xc3, 0x53, 0x9f, 0xc6, 0xfe, 0x71, 0x82, 0xa5,
+	0x66, 0xef, 0xe7, 0x06, 0x34, 0xee, 0x89, 0x28, 0xe8, 0x3b, 0x58, 0x0f, 0xc9, 0xb3, 0x2c, 0x21,
+	0x7e, 0x38, 0xac, 0x02, 0xbb, 0xd6, 0x8e, 0xb5, 0x7b, 0xed, 0xee, 0xe6, 0x7e, 0x15, 0x78, 0xff,
+	0x4b, 0x09, 0x57, 0x0e, 0xfd, 0xcd, 0xd9, 0xa4, 0x8b, 0x94, 0xcb, 0x6d, 0x92, 0xc6, 0x1c, 0xa7,
+	0x39, 0x1f, 0x0f, 0x5a, 0xa1, 0xc1, 0x43, 0x07, 0xd0, 0x2c, 0x72, 0x5d, 0xb6, 0x26, 0x64, 0x3b,
+	0x4a, 0xf6, 0x71, 0xae, 0x89, 0x76, 0x66, 0x93, 0xee, 0x3b, 0x45, 0x7e, 0x49, 0x72, 0xad, 0xd0,
+	0x38, 0xe8, 0x3e, 0x00, 0x2d, 0x32, 0xa5, 0x66, 0x0b, 0xb5, 0xb6, 0x52, 0x1b, 0x14, 0x99, 0x94,
+	0x6a, 0xcf, 0x26, 0xdd, 0x26, 0x2d, 0x32, 0x4d, 0xc7, 0xa1, 0x0a, 0x45, 0x47, 0xd0, 0xe2, 0x71,
+	0x8a, 0x49, 0xc1, 0x95, 0x50, 0x5d, 0x08, 0x6d, 0x28, 0xa1, 0x47, 0x15, 0x2a, 0xc5, 0x36, 0x66,
+	0x93, 0x6e, 0x5b, 0x3a, 0x68, 0x82, 0x4d, 0xae, 0xb3, 0x50, 0x0c, 0x1d, 0x9c, 0xc6, 0x7c, 0x98,
+	0x53, 0x12, 0x51, 0xcc, 0x98, 0x92, 0x5e, 0x16, 0xd2, 0x5b, 0x4a, 0xfa, 0xab, 0x34, 0xe6, 0x87,
+	0x92, 0x22, 0xf5, 0xb7, 0x67, 0x93, 0xee, 0x0d, 0xc3, 0x57, 0x8b, 0x82, 0xf0, 0x82, 0x43, 0x99,
+	0x04, 0x4e, 0xc7, 0x2a, 0x40, 0xc3, 0x4c, 0xc2, 0x23, 0x3a, 0xd6, 0x93, 0xc0, 0xe9, 0x58, 0x4f,
+	0x02, 0xa7, 0xe3, 0x8b, 0x9a, 0xe7, 0x3e, 0xf5, 0x93, 0x04, 0x27, 0x4a, 0x69, 0xc5, 0xac, 0xf9,
+	0xa1, 0x84, 0xf5, 0x9a, 0x2b, 0x17, 0xbd, 0xe6, 0xb9, 0xc1, 0x2b, 0x6b, 0xce, 0x30, 0x8d, 0xfd,
+	0xb9, 0xec, 0xaa, 0x59, 0xf3, 0x23, 0x01, 0xea, 0x35, 0xaf, 0xe8, 0x7a, 0xcd, 0x99, 0xc6, 0x41,
+	0x01, 0xa0, 0x80, 0x84, 0x38, 0xc7, 0x59, 0x58, 0xf6, 0xb1, 0x54, 0x75, 0x84, 0xea, 0x4d, 0xa5,
+	0x7a, 0xff, 0x82, 0x21, 0xa5, 0x6f, 0xce, 0x26, 0xdd, 0x0d, 0xcd, 0x51, 0xd3, 0x6f, 0x07, 0x97,
+	0xd9, 0xbd, 0xe7, 0x36, 0xb4, 0xcc, 0x26, 0x47, 0x9f, 0xc2, 0xaa, 0x4f, 0x79, 0x3c, 0xf2, 0x03,
+	0x2e, 0x9e, 0x83, 0xd3, 0xdf, 0x3a, 0x9b, 0x74, 0x97, 0xca, 0x34, 0x28, 0xbb, 0xa6, 0x39, 0xe7,
+	0x22, 0x17, 0xea, 0x23, 0x4a, 0x52, 0xd1, 0xeb, 0x4e, 0xbf, 0x5e, 0xfa, 0x0c, 0x84, 0x05, 0x75,
+	0xa0, 0xc6, 0x89, 0x6b, 0x6b, 0xf6, 0x1a, 0x27, 0xe8, 0x5d, 0x70, 0x02, 0x3f, 0x38, 0xc1, 0xc3,
+	0xa7, 0x78, 0xec, 0xd6, 0x35, 0x70, 0x55, 0x98, 0xbf, 0xc1, 0x63, 0xf4, 0x39, 0x40, 0x42, 0xa2,
+	0x21, 0x23, 0x05, 0x0d, 0xb0, 0x68, 0x29, 0xa7, 0x7f, 0x4b, 0x1e, 0xa6, 0x73, 0x81, 0xe8, 0x95,
+	0x4e, 0x48, 0x74, 0x24, 0x8c, 0xe5, 0x79, 0x0a, 0x86, 0xa9, 0xdb, 0xd0, 0xa4, 0x85, 0x05, 0x1d,
+	0x01, 0x0a, 0x4e, 0x70, 0xf0, 0x94, 0x15, 0xe9, 0xd0, 0x4f, 0x22, 0x42, 0x63, 0x7e, 0x92, 0x8a,
+	0x36, 0x70, 0xfa, 0xef, 0x4b, 0xf9, 0x5b, 0x8b, 0x0c, 0x23, 0x93, 0x12, 0xbd, 0xa7, 0x40, 0xf4,
+	0x35, 0xb4, 0xe6, 0x2e, 0xa7, 0x7e, 0x52, 0x60, 0xd1, 0x00, 0x4e, 0x7f, 0x47, 0x0a, 0xba, 0x26,
+	0xaa, 0xbf, 0x28, 0x85, 0x3c, 0x29, 0x81, 0xde, 0x6f, 0x16, 0xac, 0x3d, 0xce, 0xdf, 0x42, 0x41,
+	0xcc, 0x6c, 0xd7, 0xff, 0x5f, 0xb6, 0x97, 0x2f, 0x67, 0xbb, 0xf7, 0x47, 0x0d, 0x9c, 0xf9, 0x88,
+	0x2a, 0x79, 0xb9, 0xcf, 0x4f, 0x5c, 0x4b, 0xe7, 0x95, 0x16, 0x84, 0xa0, 0xee, 0xd3, 0x88, 0xb9,
+	0xb5, 0x1d, 0x7b, 0xd7, 0x19, 0x88, 0xdf, 0xe8, 0x43, 0xb0, 0xc3, 0x98, 0xca, 0x93, 0x6e, 0xc8,
+	0xb3, 0x34, 0xc3, 0x98, 0x6a, 0x87, 0x28, 0x19, 0x68, 0x0f, 0x6c, 0x9c, 0x9d, 0xba, 0xf5, 0x1d,
+	0x7b, 0xf7, 0xda, 0xdd, 0xed, 0xf9, 0xd4, 0xb9, 0xd8, 0x03, 0x4f, 0xe4, 0x1a, 0x18, 0x94, 0x3c,
+	0xf4, 0x05, 0xac, 0x53, 0x5c, 0x5d, 0x67, 0x98, 0xc4, 0x69, 0xcc, 0x99, 0xbb, 0x6c, 0x4e, 0x81,
+	0x81, 0x84, 0x1f, 0x08, 0x74, 0xd0, 0xa2, 0xc6, 0xf7, 0x7f, 0x34, 0x97, 0x99, 0xc5, 0x95, 0xab,
+	0x65, 0xf1, 0x13, 0xb8, 0xce, 0x8a, 0x3c, 0x17, 0x83, 0xb4, 0xe4, 0x92, 0x82, 0xe7, 0x05, 0x17,
+	0x9d, 0xb4, 0x2a, 0xa3, 0xb4, 0x15, 0xe1, 0x01, 0x89, 0x0e, 0x04, 0xdc, 0x9b, 0x59, 0xd0, 0x34,
+	0x66, 0x37, 0xfa, 0x00, 0x1a, 0xc6, 0x42, 0x6b, 0xa9, 0x6b, 0x55, 0xf8, 0x40, 0xa2, 0xe8, 0x00,
+	0x36, 0x42, 0x9c, 0x53, 0x1c, 0xf8, 0x1c, 0x87, 0x43, 0xb5, 0x1d, 0x32, 0x26, 0x7a, 0xc6, 0xee,
+	0x6f, 0xcb, 0x73, 0x2f, 0xae, 0x01, 0xd7, 0x1a, 0x5c, 0xbf, 0xf0, 0x94, 0xc1, 0xbf, 0x65, 0x97,
+	0x6e, 0x6f, 0x5f, 0xed, 0xf6, 0xef, 0x01, 0xa8, 0x23, 0xa4, 0x4c, 0x34, 0xa0, 0x2d, 0x2f, 0xed,
+	0x48, 0xfb, 0x43, 0xd6, 0xfb, 0xa5, 0x06, 0x68, 0x71, 0x9b, 0xbc, 0xf2, 0x8d, 0x3f, 0x82, 0x26,
+	0xe3, 0x3e, 0xe5, 0xc3, 0x14, 0x33, 0xe6, 0x47, 0xd8, 0x78, 0x1d, 0x6b, 0x02, 0x7a, 0x58, 0x21,
+	0x68, 0x0f, 0xd6, 0x59, 0x11, 0x04, 0x65, 0x2d, 0x14, 0x59, 0x7f, 0x32, 0x2d, 0x09, 0x2a, 0xfa,
+	0x67, 0xb0, 0x39, 0xf2, 0xe3, 0xa4, 0xa0, 0x58, 0xd1, 0x87, 0x39, 0xc5, 0xa3, 0xf8, 0x07, 0x63,
+	0xb8, 0x75, 0x24, 0x47, 0x7a, 0x1d, 0x0a, 0xc6, 0x6b, 0x0d, 0xba, 0x5e, 0x0e, 0xce, 0x7c, 0xfb,
+	0xbd, 0x72, 0x1e, 0xcc, 0x88, 0xb5, 0xab, 0x45, 0x7c, 0x06, 0x2d, 0x73, 0x4b, 0xa2, 0x5d, 0x58,
+	0xa9, 0x84, 0x99, 0x6b, 0xed, 0xd8, 0xff, 0x12, 0x57, 0xc1, 0xaf, 0x17, 0xb8, 0x80, 0x35, 0x7d,
+	0x8f, 0xbe, 0xa9, 0xb0, 0x3f, 0x42, 0x7b, 0x61, 0xd1, 0xbe, 0xa9, 0xd8, 0xdf, 0x43, 0xcb, 0x9c,
+	0x45, 0xe8, 0x63, 0x68, 0x64, 0x64, 0x14, 0x27, 0x58, 0x94, 0xb8, 0xde, 0x77, 0xcf, 0x26, 0x5d,
+	0xab, 0xfc, 0x43, 0x51, 0x59, 0x35, 0x19, 0xc9, 0x43, 0x7b, 0xb0, 0x9c, 0xe5, 0x94, 0x04, 0x22,
+	0x76, 0xbd, 0x7f, 0x43, 0x3a, 0xac, 0x0b, 0xa3, 0xc6, 0xaf, 0x58, 0xfd, 0xdb, 0x2f, 0xce, 0xbd,
+	0xa5, 0xdf, 0xcf, 0xbd, 0xa5, 0x97, 0xe7, 0x9e, 0xf5, 0xd3, 0xd4, 0xb3, 0x7e, 0x9d, 0x7a, 0xd6,
+	0xd9, 0xd4, 0xb3, 0x5e, 0x4c, 0x3d, 0xeb, 0xcf, 0xa9, 0x67, 0xfd, 0x3d, 0xf5, 0x96, 0x5e, 0x4e,
+	0x3d, 0xeb, 0xf9, 0x5f, 0xde, 0xd2, 0x3f, 0x03, 0x00, 0x55, 0x83, 0x52, 0x31, 0xb4, 0x0b, 0x00,
+	0x00,
 }
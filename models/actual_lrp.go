@@ -30,9 +30,42 @@ type ActualLRPChange struct {
 	After  *ActualLRPGroup
 }
 
+// ActualLRPFilter selects actual LRPs. An empty field matches every actual LRP;
+// States matches an actual LRP in any of the listed states.
+//
+// PartialResults has ActualLRPGroupsWithRecordErrors leave the actual LRPs
+// that cannot be read out of the groups and return them as RecordErrors,
+// under their process guid, instead of failing. ActualLRPGroups ignores it.
 type ActualLRPFilter struct {
-	Domain string
-	CellID string
+	Domain         string
+	CellID         string
+	States         []string
+	PartialResults bool
+}
+
+// MatchesState reports whether the state is one of the states of the filter.
+func (filter ActualLRPFilter) MatchesState(state string) bool {
+	if len(filter.States) == 0 {
+		return true
+	}
+	for _, s := range filter.States {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// StartActualLRPsResult is the outcome of reporting the actual LRPs running on
+// a cell in one batch. Started holds every reported LRP that is now running on
+// the cell, including those that were already recorded as running; Before is
+// nil for LRPs that did not exist. Rejected holds the keys of the reported
+// LRPs that could not be started on the cell, and Unclaimed the running LRPs
+// that an authoritative report left out.
+type StartActualLRPsResult struct {
+	Started   []ActualLRPChange
+	Rejected  []*ActualLRPKey
+	Unclaimed []ActualLRPChange
 }
 
 func NewActualLRPKey(processGuid string, index int32, domain string) ActualLRPKey {
@@ -109,6 +142,56 @@ func (actual ActualLRP) ShouldRestartCrash(now time.Time, calc RestartCalculator
 	return calc.ShouldRestart(now.UnixNano(), actual.Since, actual.CrashCount)
 }
 
+const (
+	PlacementReasonClaimed = "claimed"
+	PlacementReasonStarted = "started"
+	PlacementReasonCrashed = "crashed"
+
+	// MaximumPlacementHistoryLength bounds the placement history kept on an
+	// actual LRP whatever the configured length.
+	MaximumPlacementHistoryLength = 100
+)
+
+// RecordPlacement appends a placement to the history of the actual LRP,
+// dropping the oldest placements to keep at most maxLength of them. Nothing is
+// recorded when maxLength is 0, so that the history is off by default.
+func (actual *ActualLRP) RecordPlacement(cellID string, timestamp int64, reason string, maxLength int) {
+	if maxLength <= 0 {
+		return
+	}
+	if maxLength > MaximumPlacementHistoryLength {
+		maxLength = MaximumPlacementHistoryLength
+	}
+
+	history := append(actual.PlacementHistory, &ActualLRPPlacement{
+		CellId:    cellID,
+		Timestamp: timestamp,
+		Reason:    reason,
+	})
+	if len(history) > maxLength {
+		history = append([]*ActualLRPPlacement(nil), history[len(history)-maxLength:]...)
+	}
+	actual.PlacementHistory = history
+}
+
+// ShouldRetireOrphan reports whether an orphaned actual LRP, one whose
+// DesiredLRP no longer exists, has been orphaned for the grace period since
+// OrphanedSince was set. Convergence sets OrphanedSince when it first finds the
+// actual LRP orphaned, so that a DesiredLRP that is only missing for a moment,
+// such as during a bulk update, does not get its instances retired. Without a
+// grace period an orphan is retired as soon as it is found.
+func (actual ActualLRP) ShouldRetireOrphan(now time.Time, gracePeriod time.Duration) bool {
+	if gracePeriod <= 0 {
+		return true
+	}
+
+	if actual.OrphanedSince == 0 {
+		return false
+	}
+
+	return now.Sub(time.Unix(0, actual.OrphanedSince)) >= gracePeriod
+}
+
 func (before ActualLRP) AllowsTransitionTo(lrpKey *ActualLRPKey, instanceKey *ActualLRPInstanceKey, newState string) bool {
 	if !before.ActualLRPKey.Equal(lrpKey) {
 		return false
@@ -145,21 +145,54 @@ func (m *ActualLRPNetInfo) GetPorts() []*PortMapping {
 	return nil
 }
 
+type ActualLRPPlacement struct {
+	CellId    string `protobuf:"bytes,1,opt,name=cell_id,json=cellId" json:"cell_id"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp" json:"timestamp"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason" json:"reason"`
+}
+
+func (m *ActualLRPPlacement) Reset()                    { *m = ActualLRPPlacement{} }
+func (*ActualLRPPlacement) ProtoMessage()               {}
+func (*ActualLRPPlacement) Descriptor() ([]byte, []int) { return fileDescriptorActualLrp, []int{5} }
+
+func (m *ActualLRPPlacement) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *ActualLRPPlacement) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ActualLRPPlacement) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type ActualLRP struct {
 	ActualLRPKey         `protobuf:"bytes,1,opt,name=actual_lrp_key,json=actualLrpKey,embedded=actual_lrp_key" json:""`
 	ActualLRPInstanceKey `protobuf:"bytes,2,opt,name=actual_lrp_instance_key,json=actualLrpInstanceKey,embedded=actual_lrp_instance_key" json:""`
 	ActualLRPNetInfo     `protobuf:"bytes,3,opt,name=actual_lrp_net_info,json=actualLrpNetInfo,embedded=actual_lrp_net_info" json:""`
-	CrashCount           int32           `protobuf:"varint,4,opt,name=crash_count,json=crashCount" json:"crash_count"`
-	CrashReason          string          `protobuf:"bytes,5,opt,name=crash_reason,json=crashReason" json:"crash_reason,omitempty"`
-	State                string          `protobuf:"bytes,6,opt,name=state" json:"state"`
-	PlacementError       string          `protobuf:"bytes,7,opt,name=placement_error,json=placementError" json:"placement_error,omitempty"`
-	Since                int64           `protobuf:"varint,8,opt,name=since" json:"since"`
-	ModificationTag      ModificationTag `protobuf:"bytes,9,opt,name=modification_tag,json=modificationTag" json:"modification_tag"`
+	CrashCount           int32                 `protobuf:"varint,4,opt,name=crash_count,json=crashCount" json:"crash_count"`
+	CrashReason          string                `protobuf:"bytes,5,opt,name=crash_reason,json=crashReason" json:"crash_reason,omitempty"`
+	State                string                `protobuf:"bytes,6,opt,name=state" json:"state"`
+	PlacementError       string                `protobuf:"bytes,7,opt,name=placement_error,json=placementError" json:"placement_error,omitempty"`
+	Since                int64                 `protobuf:"varint,8,opt,name=since" json:"since"`
+	ModificationTag      ModificationTag       `protobuf:"bytes,9,opt,name=modification_tag,json=modificationTag" json:"modification_tag"`
+	PlacementHistory     []*ActualLRPPlacement `protobuf:"bytes,10,rep,name=placement_history,json=placementHistory" json:"placement_history,omitempty"`
+	OrphanedSince        int64                 `protobuf:"varint,11,opt,name=orphaned_since,json=orphanedSince" json:"orphaned_since,omitempty"`
 }
 
 func (m *ActualLRP) Reset()                    { *m = ActualLRP{} }
 func (*ActualLRP) ProtoMessage()               {}
-func (*ActualLRP) Descriptor() ([]byte, []int) { return fileDescriptorActualLrp, []int{5} }
+func (*ActualLRP) Descriptor() ([]byte, []int) { return fileDescriptorActualLrp, []int{6} }
 
 func (m *ActualLRP) GetCrashCount() int32 {
 	if m != nil {
@@ -203,12 +236,27 @@ func (m *ActualLRP) GetModificationTag() ModificationTag {
 	return ModificationTag{}
 }
 
+func (m *ActualLRP) GetPlacementHistory() []*ActualLRPPlacement {
+	if m != nil {
+		return m.PlacementHistory
+	}
+	return nil
+}
+
+func (m *ActualLRP) GetOrphanedSince() int64 {
+	if m != nil {
+		return m.OrphanedSince
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ActualLRPGroup)(nil), "models.ActualLRPGroup")
 	proto.RegisterType((*PortMapping)(nil), "models.PortMapping")
 	proto.RegisterType((*ActualLRPKey)(nil), "models.ActualLRPKey")
 	proto.RegisterType((*ActualLRPInstanceKey)(nil), "models.ActualLRPInstanceKey")
 	proto.RegisterType((*ActualLRPNetInfo)(nil), "models.ActualLRPNetInfo")
+	proto.RegisterType((*ActualLRPPlacement)(nil), "models.ActualLRPPlacement")
 	proto.RegisterType((*ActualLRP)(nil), "models.ActualLRP")
 }
 func (this *ActualLRPGroup) Equal(that interface{}) bool {
@@ -384,6 +432,42 @@ func (this *ActualLRPNetInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ActualLRPPlacement) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ActualLRPPlacement)
+	if !ok {
+		that2, ok := that.(ActualLRPPlacement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
 func (this *ActualLRP) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
@@ -436,6 +520,17 @@ func (this *ActualLRP) Equal(that interface{}) bool {
 	if !this.ModificationTag.Equal(&that1.ModificationTag) {
 		return false
 	}
+	if len(this.PlacementHistory) != len(that1.PlacementHistory) {
+		return false
+	}
+	for i := range this.PlacementHistory {
+		if !this.PlacementHistory[i].Equal(that1.PlacementHistory[i]) {
+			return false
+		}
+	}
+	if this.OrphanedSince != that1.OrphanedSince {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPGroup) GoString() string {
@@ -500,11 +595,23 @@ func (this *ActualLRPNetInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ActualLRPPlacement) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.ActualLRPPlacement{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *ActualLRP) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 15)
 	s = append(s, "&models.ActualLRP{")
 	s = append(s, "ActualLRPKey: "+strings.Replace(this.ActualLRPKey.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "ActualLRPInstanceKey: "+strings.Replace(this.ActualLRPInstanceKey.GoString(), `&`, ``, 1)+",\n")
@@ -515,6 +622,10 @@ func (this *ActualLRP) GoString() string {
 	s = append(s, "PlacementError: "+fmt.Sprintf("%#v", this.PlacementError)+",\n")
 	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
 	s = append(s, "ModificationTag: "+strings.Replace(this.ModificationTag.GoString(), `&`, ``, 1)+",\n")
+	if this.PlacementHistory != nil {
+		s = append(s, "PlacementHistory: "+fmt.Sprintf("%#v", this.PlacementHistory)+",\n")
+	}
+	s = append(s, "OrphanedSince: "+fmt.Sprintf("%#v", this.OrphanedSince)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -695,6 +806,35 @@ func (m *ActualLRPNetInfo) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ActualLRPPlacement) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ActualLRPPlacement) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrp(data, i, uint64(len(m.CellId)))
+	i += copy(data[i:], m.CellId)
+	data[i] = 0x10
+	i++
+	i = encodeVarintActualLrp(data, i, uint64(m.Timestamp))
+	data[i] = 0x1a
+	i++
+	i = encodeVarintActualLrp(data, i, uint64(len(m.Reason)))
+	i += copy(data[i:], m.Reason)
+	return i, nil
+}
+
 func (m *ActualLRP) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -760,6 +900,21 @@ func (m *ActualLRP) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n6
+	if len(m.PlacementHistory) > 0 {
+		for _, msg := range m.PlacementHistory {
+			data[i] = 0x52
+			i++
+			i = encodeVarintActualLrp(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x58
+	i++
+	i = encodeVarintActualLrp(data, i, uint64(m.OrphanedSince))
 	return i, nil
 }
 
@@ -847,6 +1002,17 @@ func (m *ActualLRPNetInfo) Size() (n int) {
 	return n
 }
 
+func (m *ActualLRPPlacement) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CellId)
+	n += 1 + l + sovActualLrp(uint64(l))
+	n += 1 + sovActualLrp(uint64(m.Timestamp))
+	l = len(m.Reason)
+	n += 1 + l + sovActualLrp(uint64(l))
+	return n
+}
+
 func (m *ActualLRP) Size() (n int) {
 	var l int
 	_ = l
@@ -866,6 +1032,13 @@ func (m *ActualLRP) Size() (n int) {
 	n += 1 + sovActualLrp(uint64(m.Since))
 	l = m.ModificationTag.Size()
 	n += 1 + l + sovActualLrp(uint64(l))
+	if len(m.PlacementHistory) > 0 {
+		for _, e := range m.PlacementHistory {
+			l = e.Size()
+			n += 1 + l + sovActualLrp(uint64(l))
+		}
+	}
+	n += 1 + sovActualLrp(uint64(m.OrphanedSince))
 	return n
 }
 
@@ -938,6 +1111,18 @@ func (this *ActualLRPNetInfo) String() string {
 	}, "")
 	return s
 }
+func (this *ActualLRPPlacement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPPlacement{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ActualLRP) String() string {
 	if this == nil {
 		return "nil"
@@ -952,6 +1137,8 @@ func (this *ActualLRP) String() string {
 		`PlacementError:` + fmt.Sprintf("%v", this.PlacementError) + `,`,
 		`Since:` + fmt.Sprintf("%v", this.Since) + `,`,
 		`ModificationTag:` + strings.Replace(strings.Replace(this.ModificationTag.String(), "ModificationTag", "ModificationTag", 1), `&`, ``, 1) + `,`,
+		`PlacementHistory:` + strings.Replace(fmt.Sprintf("%v", this.PlacementHistory), "ActualLRPPlacement", "ActualLRPPlacement", 1) + `,`,
+		`OrphanedSince:` + fmt.Sprintf("%v", this.OrphanedSince) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1513,6 +1700,133 @@ func (m *ActualLRPNetInfo) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *ActualLRPPlacement) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPPlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPPlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrp(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ActualLRP) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -1787,6 +2101,56 @@ func (m *ActualLRP) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacementHistory = append(m.PlacementHistory, &ActualLRPPlacement{})
+			if err := m.PlacementHistory[len(m.PlacementHistory)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedSince", wireType)
+			}
+			m.OrphanedSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OrphanedSince |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrp(data[iNdEx:])
@@ -1916,46 +2280,52 @@ var (
 func init() { proto.RegisterFile("actual_lrp.proto", fileDescriptorActualLrp) }
 
 var fileDescriptorActualLrp = []byte{
-	// 650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x54, 0xd1, 0x4e, 0x13, 0x4d,
-	0x18, 0xed, 0x02, 0x05, 0xfa, 0xb5, 0x94, 0xfe, 0x03, 0x81, 0xfd, 0x09, 0x6e, 0xa1, 0x89, 0x11,
-	0x23, 0x94, 0x48, 0x7c, 0x01, 0x30, 0x06, 0x10, 0x30, 0xa4, 0xd1, 0x4b, 0xb3, 0x0e, 0xbb, 0xd3,
-	0xed, 0xc4, 0xee, 0xcc, 0x3a, 0x3b, 0x6b, 0xec, 0x9d, 0x8f, 0xe0, 0x63, 0xf8, 0x26, 0x72, 0xc9,
-	0xa5, 0x57, 0x8d, 0xd4, 0x1b, 0xc3, 0x15, 0x8f, 0x60, 0x66, 0x76, 0x3b, 0x8e, 0x6d, 0xbc, 0x6a,
-	0xf7, 0x9c, 0xf3, 0x9d, 0xd3, 0x9e, 0xef, 0x6b, 0xa1, 0x81, 0x03, 0x99, 0xe1, 0xbe, 0xdf, 0x17,
-	0x49, 0x3b, 0x11, 0x5c, 0x72, 0x34, 0x1f, 0xf3, 0x90, 0xf4, 0xd3, 0x8d, 0xbd, 0x88, 0xca, 0x5e,
-	0x76, 0xd5, 0x0e, 0x78, 0xbc, 0x1f, 0xf1, 0x88, 0xef, 0x6b, 0xfa, 0x2a, 0xeb, 0xea, 0x27, 0xfd,
-	0xa0, 0xdf, 0xe5, 0x63, 0x1b, 0x6b, 0x31, 0x0f, 0x69, 0x97, 0x06, 0x58, 0x52, 0xce, 0x7c, 0x89,
-	0xa3, 0x1c, 0x6f, 0x09, 0xa8, 0x1f, 0xea, 0x88, 0xf3, 0xce, 0xe5, 0xb1, 0xe0, 0x59, 0x82, 0xf6,
-	0x60, 0x91, 0xb2, 0x54, 0x62, 0x16, 0x10, 0xd7, 0xd9, 0x72, 0x76, 0xaa, 0x07, 0xff, 0xb5, 0xf3,
-	0xcc, 0xb6, 0x51, 0x76, 0x8c, 0x04, 0x3d, 0x05, 0x20, 0x1f, 0x71, 0x90, 0x61, 0x49, 0x59, 0xe4,
-	0xce, 0xfc, 0x6b, 0xc0, 0x12, 0xb5, 0xde, 0x42, 0xf5, 0x92, 0x0b, 0x79, 0x81, 0x93, 0x84, 0xb2,
-	0x08, 0x3d, 0x81, 0x7a, 0xc0, 0x99, 0xc4, 0x94, 0x11, 0xe1, 0x27, 0x5c, 0x48, 0x1d, 0xbb, 0x74,
-	0x34, 0x77, 0x3d, 0x6c, 0x96, 0x3a, 0x4b, 0x86, 0x53, 0x33, 0x68, 0x1b, 0x2a, 0x3d, 0x9e, 0xca,
-	0x5c, 0x37, 0x63, 0xe9, 0x16, 0x15, 0xac, 0x24, 0xad, 0x0f, 0x50, 0x33, 0xb9, 0x67, 0x64, 0x80,
-	0x1e, 0x41, 0x2d, 0x11, 0x3c, 0x20, 0x69, 0xea, 0x47, 0x19, 0x0d, 0xb5, 0x7b, 0xa5, 0x98, 0xaa,
-	0x16, 0xcc, 0x71, 0x46, 0x43, 0xb4, 0x01, 0x65, 0xca, 0x42, 0xf2, 0x49, 0xfb, 0x96, 0x0b, 0x45,
-	0x0e, 0xa1, 0x4d, 0x98, 0x0f, 0x79, 0x8c, 0x29, 0x73, 0x67, 0xad, 0xf1, 0x02, 0x6b, 0xbd, 0x83,
-	0x55, 0x13, 0x79, 0x5a, 0x34, 0xa3, 0xa2, 0x1f, 0xc3, 0xd2, 0xb8, 0xa8, 0xe9, 0xec, 0xda, 0x98,
-	0xd2, 0xe1, 0x0f, 0x60, 0x21, 0x20, 0xfd, 0xbe, 0x4f, 0x43, 0x1d, 0x6f, 0x12, 0x14, 0x78, 0x1a,
-	0xb6, 0x7a, 0xd0, 0x30, 0x09, 0xaf, 0x88, 0x3c, 0x65, 0x5d, 0x8e, 0x3c, 0x58, 0xc0, 0x61, 0x28,
-	0x48, 0x9a, 0xfe, 0xe5, 0x3b, 0x06, 0xd1, 0x33, 0x28, 0xab, 0x9a, 0x52, 0x77, 0x66, 0x6b, 0x76,
-	0xa7, 0x7a, 0xb0, 0x32, 0xde, 0x8a, 0x55, 0xfe, 0x51, 0xe5, 0x6e, 0xd8, 0xcc, 0x55, 0x9d, 0xfc,
-	0xa5, 0xf5, 0x6d, 0x0e, 0x2a, 0x26, 0x0a, 0x9d, 0x40, 0xfd, 0xcf, 0x09, 0xfa, 0xef, 0xc9, 0xa0,
-	0xb8, 0x89, 0xd5, 0xa9, 0x15, 0x9f, 0x91, 0xc1, 0x51, 0x4d, 0x7d, 0x80, 0x9b, 0x61, 0xd3, 0xb9,
-	0xd3, 0x5f, 0x30, 0x9f, 0x3c, 0x17, 0x89, 0xea, 0x02, 0xc3, 0xba, 0xe5, 0x64, 0x6a, 0x51, 0x96,
-	0xf9, 0xd5, 0x6c, 0x4e, 0x59, 0x5a, 0x55, 0x4e, 0x58, 0xaf, 0x1a, 0x6b, 0xbb, 0xee, 0x37, 0xb0,
-	0x62, 0x45, 0x30, 0x22, 0x7d, 0xca, 0xba, 0x5c, 0x6f, 0xac, 0x7a, 0xe0, 0x4e, 0xd9, 0x17, 0x3d,
-	0x4e, 0x58, 0x37, 0x8c, 0xf5, 0xb8, 0xe7, 0x87, 0x50, 0x0d, 0x04, 0x4e, 0x7b, 0x7e, 0xc0, 0x33,
-	0x26, 0xdd, 0x39, 0xeb, 0x3a, 0x40, 0x13, 0xcf, 0x15, 0x8e, 0x0e, 0xa1, 0x96, 0xcb, 0x04, 0xc1,
-	0x29, 0x67, 0x6e, 0x59, 0xef, 0xc4, 0x53, 0xba, 0xbb, 0x61, 0x73, 0xcd, 0xe6, 0x76, 0x79, 0x4c,
-	0x25, 0x89, 0x13, 0x39, 0xe8, 0xe4, 0xd6, 0x1d, 0x0d, 0xab, 0x0b, 0x4c, 0x25, 0x96, 0xc4, 0x9d,
-	0xb7, 0xf6, 0x99, 0x43, 0xe8, 0x25, 0x2c, 0x27, 0x7d, 0x1c, 0x90, 0x98, 0x30, 0xe9, 0x13, 0x21,
-	0xb8, 0x70, 0x17, 0xb4, 0x6a, 0xbb, 0x48, 0xf8, 0x7f, 0x82, 0xb6, 0x42, 0xea, 0x86, 0x7a, 0xa1,
-	0x18, 0x9d, 0x43, 0xd5, 0x0f, 0x7c, 0x71, 0xcb, 0xd9, 0x99, 0x35, 0x39, 0x0a, 0x42, 0x27, 0xd0,
-	0x98, 0xfc, 0xaf, 0x70, 0x2b, 0xba, 0xc1, 0xf5, 0x71, 0x83, 0x17, 0x16, 0xff, 0x1a, 0x47, 0xc5,
-	0xfc, 0x72, 0x3c, 0x01, 0xef, 0xde, 0xdc, 0x7a, 0xa5, 0xef, 0xb7, 0x5e, 0xe9, 0xfe, 0xd6, 0x73,
-	0x3e, 0x8f, 0x3c, 0xe7, 0xeb, 0xc8, 0x73, 0xae, 0x47, 0x9e, 0x73, 0x33, 0xf2, 0x9c, 0x1f, 0x23,
-	0xcf, 0xf9, 0x35, 0xf2, 0x4a, 0xf7, 0x23, 0xcf, 0xf9, 0xf2, 0xd3, 0x2b, 0xfd, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0xad, 0x78, 0xb2, 0xeb, 0x04, 0x00, 0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0x8d, 0xd3, 0x2f, 0x69, 0x73, 0xf3, 0xf3, 0xa5, 0xd3, 0xaa, 0x35, 0xa1, 0x38, 0xad, 0x25,
+	0x44, 0x11, 0x6d, 0x2a, 0x2a, 0x5e, 0xa0, 0x41, 0xa8, 0x2d, 0x6d, 0x51, 0x15, 0x60, 0x89, 0xcc,
+	0xd4, 0x9e, 0x38, 0x23, 0x62, 0x8f, 0x19, 0x8f, 0x11, 0xd9, 0xf1, 0x08, 0x3c, 0x06, 0x8f, 0xd2,
+	0x65, 0x97, 0xac, 0x22, 0x1a, 0x36, 0x28, 0xab, 0xae, 0x59, 0xa1, 0x99, 0xb1, 0xdd, 0x69, 0x22,
+	0x56, 0x89, 0xcf, 0x39, 0xf7, 0x9c, 0x99, 0x7b, 0xaf, 0x0d, 0x5d, 0xec, 0x8b, 0x0c, 0x4f, 0xbd,
+	0x29, 0x4f, 0x06, 0x09, 0x67, 0x82, 0xa1, 0x7a, 0xc4, 0x02, 0x32, 0x4d, 0x7b, 0xa7, 0x21, 0x15,
+	0x93, 0xec, 0x61, 0xe0, 0xb3, 0xe8, 0x2c, 0x64, 0x21, 0x3b, 0x53, 0xf4, 0x43, 0x36, 0x56, 0x4f,
+	0xea, 0x41, 0xfd, 0xd3, 0x65, 0xbd, 0xbd, 0x88, 0x05, 0x74, 0x4c, 0x7d, 0x2c, 0x28, 0x8b, 0x3d,
+	0x81, 0x43, 0x8d, 0xbb, 0x1c, 0x3a, 0x17, 0x2a, 0xe2, 0x76, 0x74, 0x7f, 0xc9, 0x59, 0x96, 0xa0,
+	0x53, 0xd8, 0xa2, 0x71, 0x2a, 0x70, 0xec, 0x13, 0xdb, 0x3a, 0xb4, 0x8e, 0x9b, 0xe7, 0xdb, 0x03,
+	0x9d, 0x39, 0x28, 0x95, 0xa3, 0x52, 0x82, 0x3e, 0x07, 0x20, 0xbf, 0x60, 0x3f, 0xc3, 0x82, 0xc6,
+	0xa1, 0x5d, 0xfd, 0xbf, 0x02, 0x43, 0xe4, 0xfe, 0x00, 0xcd, 0x7b, 0xc6, 0xc5, 0x1d, 0x4e, 0x12,
+	0x1a, 0x87, 0xe8, 0x33, 0xe8, 0xf8, 0x2c, 0x16, 0x98, 0xc6, 0x84, 0x7b, 0x09, 0xe3, 0x42, 0xc5,
+	0xb6, 0x87, 0xef, 0x1e, 0xe7, 0xfd, 0xca, 0xa8, 0x5d, 0x72, 0xb2, 0x06, 0x1d, 0x41, 0x63, 0xc2,
+	0x52, 0xa1, 0x75, 0x55, 0x43, 0xb7, 0x25, 0x61, 0x29, 0x71, 0x7f, 0x86, 0x56, 0x99, 0x7b, 0x43,
+	0x66, 0xe8, 0x13, 0x68, 0x25, 0x9c, 0xf9, 0x24, 0x4d, 0xbd, 0x30, 0xa3, 0x81, 0x72, 0x6f, 0xe4,
+	0x55, 0xcd, 0x9c, 0xb9, 0xcc, 0x68, 0x80, 0x7a, 0x50, 0xa3, 0x71, 0x40, 0x7e, 0x55, 0xbe, 0xb5,
+	0x5c, 0xa1, 0x21, 0x74, 0x00, 0xf5, 0x80, 0x45, 0x98, 0xc6, 0xf6, 0x86, 0x51, 0x9e, 0x63, 0xee,
+	0x8f, 0xb0, 0x5b, 0x46, 0x5e, 0xe7, 0x9d, 0x91, 0xd1, 0x9f, 0x42, 0xbb, 0x68, 0xd4, 0x7a, 0x76,
+	0xab, 0xa0, 0x54, 0xf8, 0x47, 0xb0, 0xe9, 0x93, 0xe9, 0xd4, 0xa3, 0x81, 0x5d, 0x35, 0x44, 0x75,
+	0x09, 0x5e, 0x07, 0xee, 0x04, 0xba, 0x65, 0xc2, 0x37, 0x44, 0x5c, 0xc7, 0x63, 0x86, 0x1c, 0xd8,
+	0xc4, 0x41, 0xc0, 0x49, 0x9a, 0xbe, 0xf1, 0x2d, 0x40, 0xf4, 0x05, 0xd4, 0x64, 0x9b, 0x52, 0xbb,
+	0x7a, 0xb8, 0x71, 0xdc, 0x3c, 0xdf, 0x29, 0xa6, 0x62, 0x34, 0x7f, 0xd8, 0x58, 0xce, 0xfb, 0x5a,
+	0x35, 0xd2, 0x3f, 0x6e, 0x06, 0xa8, 0x4c, 0xba, 0x9f, 0x62, 0x9f, 0x44, 0x24, 0x16, 0xe6, 0xf1,
+	0xac, 0xf5, 0xe3, 0x21, 0x17, 0x1a, 0x82, 0x46, 0x24, 0x15, 0x38, 0x4a, 0xd4, 0xf9, 0x37, 0x72,
+	0xc1, 0x2b, 0x2c, 0x5b, 0xc8, 0x09, 0x4e, 0xd9, 0x4a, 0x0b, 0x35, 0xe6, 0xfe, 0x5b, 0x83, 0x46,
+	0x99, 0x8b, 0xae, 0xa0, 0xf3, 0xba, 0xf9, 0xde, 0x4f, 0x64, 0x96, 0xaf, 0xe2, 0xee, 0xda, 0x66,
+	0xdd, 0x90, 0xd9, 0xb0, 0x25, 0x9d, 0x9e, 0xe6, 0x7d, 0x6b, 0xa9, 0xfa, 0xaa, 0x2b, 0x6f, 0x79,
+	0x22, 0x47, 0x80, 0x61, 0xdf, 0x70, 0x2a, 0xa7, 0x21, 0x2d, 0xf5, 0xb2, 0x1e, 0xac, 0x59, 0x1a,
+	0x13, 0x5c, 0xb1, 0xde, 0x2d, 0xad, 0xcd, 0x29, 0x7f, 0x0f, 0x3b, 0x46, 0x44, 0x4c, 0x84, 0x47,
+	0xe3, 0x31, 0x53, 0xb7, 0x6c, 0x9e, 0xdb, 0x6b, 0xf6, 0xf9, 0xf8, 0x56, 0xac, 0xbb, 0xa5, 0x75,
+	0x31, 0xde, 0x8f, 0xa1, 0xe9, 0x73, 0x9c, 0x4e, 0x3c, 0x9f, 0x65, 0xb1, 0xb0, 0xdf, 0x19, 0x4b,
+	0x09, 0x8a, 0xf8, 0x52, 0xe2, 0xe8, 0x02, 0x5a, 0x5a, 0x96, 0x37, 0xb7, 0xa6, 0x9a, 0xeb, 0x48,
+	0xdd, 0x72, 0xde, 0xdf, 0x33, 0xb9, 0x13, 0x16, 0x51, 0x41, 0xa2, 0x44, 0xcc, 0x46, 0xda, 0x7a,
+	0xa4, 0x60, 0xb9, 0xf8, 0xa9, 0xc0, 0x82, 0xd8, 0x75, 0x63, 0x30, 0x1a, 0x42, 0x5f, 0xc3, 0xfb,
+	0xa4, 0xd8, 0x02, 0x8f, 0x70, 0xce, 0xb8, 0xbd, 0xa9, 0x54, 0x47, 0x79, 0xc2, 0x07, 0x2b, 0xb4,
+	0x11, 0xd2, 0x29, 0xa9, 0xaf, 0x24, 0xa3, 0x72, 0xa8, 0xfc, 0xae, 0x6c, 0x19, 0x1b, 0xa2, 0x21,
+	0x74, 0x05, 0xdd, 0xd5, 0x4f, 0x94, 0xdd, 0x50, 0x1d, 0xdc, 0x2f, 0x3a, 0x78, 0x67, 0xf0, 0xdf,
+	0xe1, 0x30, 0xaf, 0x7f, 0x1f, 0xbd, 0x85, 0xd1, 0x04, 0xb6, 0x5f, 0x8f, 0x34, 0xa1, 0xa9, 0x60,
+	0x7c, 0x66, 0x83, 0x7a, 0x05, 0x7a, 0x6b, 0xc3, 0x28, 0x37, 0x7c, 0xd8, 0x5f, 0xce, 0xfb, 0x1f,
+	0xae, 0x15, 0x1a, 0xb7, 0xe9, 0x96, 0xe4, 0x95, 0xe6, 0xd0, 0x25, 0x74, 0x18, 0x4f, 0x26, 0x38,
+	0x26, 0x81, 0xa7, 0x2f, 0xd6, 0x54, 0x17, 0x3b, 0xcc, 0x5b, 0x63, 0xbf, 0x65, 0x0d, 0xaf, 0x76,
+	0xc1, 0x7c, 0x2b, 0x89, 0xe1, 0xc9, 0xd3, 0xb3, 0x53, 0xf9, 0xf3, 0xd9, 0xa9, 0xbc, 0x3c, 0x3b,
+	0xd6, 0x6f, 0x0b, 0xc7, 0xfa, 0x63, 0xe1, 0x58, 0x8f, 0x0b, 0xc7, 0x7a, 0x5a, 0x38, 0xd6, 0x5f,
+	0x0b, 0xc7, 0xfa, 0x67, 0xe1, 0x54, 0x5e, 0x16, 0x8e, 0xf5, 0xfb, 0xdf, 0x4e, 0xe5, 0xbf, 0x01,
+	0x00, 0x65, 0xbf, 0x7c, 0xf8, 0x15, 0x06, 0x00, 0x00,
 }
@@ -1,9 +1,31 @@
 package models
 
 func (request *ActualLRPGroupsRequest) Validate() error {
+	var validationError ValidationError
+
+	for _, state := range request.States {
+		if !validActualLRPState(state) {
+			validationError = validationError.Append(ErrInvalidField{"states"})
+			break
+		}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
 	return nil
 }
 
+func validActualLRPState(state string) bool {
+	for _, s := range ActualLRPStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
 func (request *ActualLRPGroupsByProcessGuidRequest) Validate() error {
 	var validationError ValidationError
 
@@ -36,6 +58,24 @@ func (request *ActualLRPGroupByProcessGuidAndIndexRequest) Validate() error {
 	return nil
 }
 
+func (request *CrashingActualLRPsRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Limit < 0 {
+		validationError = validationError.Append(ErrInvalidField{"limit"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *CellPlacementsRequest) Validate() error {
+	return nil
+}
+
 func (request *RemoveActualLRPRequest) Validate() error {
 	var validationError ValidationError
 
@@ -160,6 +200,57 @@ func (request *RetireActualLRPRequest) Validate() error {
 	return nil
 }
 
+func (request *RetireActualLRPsOnCellRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.CellId == "" {
+		validationError = validationError.Append(ErrInvalidField{"cell_id"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *StartActualLRPsRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.CellId == "" {
+		validationError = validationError.Append(ErrInvalidField{"cell_id"})
+	}
+
+	seen := make(map[ActualLRPKey]bool, len(request.ActualLrps))
+	for _, start := range request.ActualLrps {
+		if start == nil {
+			validationError = validationError.Append(ErrInvalidField{"actual_lrps"})
+			continue
+		}
+
+		if err := start.Validate(); err != nil {
+			validationError = validationError.Append(err)
+			continue
+		}
+
+		if start.ActualLrpInstanceKey.CellId != request.CellId {
+			validationError = validationError.Append(ErrInvalidField{"actual_lrp_instance_key.cell_id"})
+		}
+
+		key := ActualLRPKey{ProcessGuid: start.ActualLrpKey.ProcessGuid, Index: start.ActualLrpKey.Index}
+		if seen[key] {
+			validationError = validationError.Append(ErrInvalidField{"actual_lrps"})
+		}
+		seen[key] = true
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
 func (request *RemoveEvacuatingActualLRPRequest) Validate() error {
 	var validationError ValidationError
 
@@ -14,6 +14,7 @@ import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
 import sort "sort"
 import strconv "strconv"
 import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -42,6 +43,8 @@ func (m *ActualLRPLifecycleResponse) GetError() *Error {
 type ActualLRPGroupsResponse struct {
 	Error           *Error            `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	ActualLrpGroups []*ActualLRPGroup `protobuf:"bytes,2,rep,name=actual_lrp_groups,json=actualLrpGroups" json:"actual_lrp_groups,omitempty"`
+	StateCounts     map[string]int32  `protobuf:"bytes,3,rep,name=state_counts,json=stateCounts" json:"state_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	RecordErrors    []*RecordError    `protobuf:"bytes,4,rep,name=record_errors,json=recordErrors" json:"record_errors,omitempty"`
 }
 
 func (m *ActualLRPGroupsResponse) Reset()      { *m = ActualLRPGroupsResponse{} }
@@ -64,6 +67,20 @@ func (m *ActualLRPGroupsResponse) GetActualLrpGroups() []*ActualLRPGroup {
 	return nil
 }
 
+func (m *ActualLRPGroupsResponse) GetStateCounts() map[string]int32 {
+	if m != nil {
+		return m.StateCounts
+	}
+	return nil
+}
+
+func (m *ActualLRPGroupsResponse) GetRecordErrors() []*RecordError {
+	if m != nil {
+		return m.RecordErrors
+	}
+	return nil
+}
+
 type ActualLRPGroupResponse struct {
 	Error          *Error          `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	ActualLrpGroup *ActualLRPGroup `protobuf:"bytes,2,opt,name=actual_lrp_group,json=actualLrpGroup" json:"actual_lrp_group,omitempty"`
@@ -90,8 +107,10 @@ func (m *ActualLRPGroupResponse) GetActualLrpGroup() *ActualLRPGroup {
 }
 
 type ActualLRPGroupsRequest struct {
-	Domain string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
-	CellId string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
+	Domain         string   `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	CellId         string   `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
+	States         []string `protobuf:"bytes,3,rep,name=states" json:"states,omitempty"`
+	PartialResults bool     `protobuf:"varint,4,opt,name=partial_results,json=partialResults" json:"partial_results,omitempty"`
 }
 
 func (m *ActualLRPGroupsRequest) Reset()      { *m = ActualLRPGroupsRequest{} }
@@ -114,6 +133,20 @@ func (m *ActualLRPGroupsRequest) GetCellId() string {
 	return ""
 }
 
+func (m *ActualLRPGroupsRequest) GetStates() []string {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *ActualLRPGroupsRequest) GetPartialResults() bool {
+	if m != nil {
+		return m.PartialResults
+	}
+	return false
+}
+
 type ActualLRPGroupsByProcessGuidRequest struct {
 	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
 }
@@ -158,6 +191,129 @@ func (m *ActualLRPGroupByProcessGuidAndIndexRequest) GetIndex() int32 {
 	return 0
 }
 
+type CrashingActualLRPsRequest struct {
+	Limit int32 `protobuf:"varint,1,opt,name=limit" json:"limit"`
+}
+
+func (m *CrashingActualLRPsRequest) Reset()      { *m = CrashingActualLRPsRequest{} }
+func (*CrashingActualLRPsRequest) ProtoMessage() {}
+func (*CrashingActualLRPsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorActualLrpRequests, []int{6}
+}
+
+func (m *CrashingActualLRPsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ActualLRPsResponse struct {
+	Error      *Error       `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	ActualLrps []*ActualLRP `protobuf:"bytes,2,rep,name=actual_lrps,json=actualLrps" json:"actual_lrps,omitempty"`
+}
+
+func (m *ActualLRPsResponse) Reset()      { *m = ActualLRPsResponse{} }
+func (*ActualLRPsResponse) ProtoMessage() {}
+func (*ActualLRPsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorActualLrpRequests, []int{7}
+}
+
+func (m *ActualLRPsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ActualLRPsResponse) GetActualLrps() []*ActualLRP {
+	if m != nil {
+		return m.ActualLrps
+	}
+	return nil
+}
+
+type CellPlacementsRequest struct {
+	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId" json:"cell_id"`
+}
+
+func (m *CellPlacementsRequest) Reset()      { *m = CellPlacementsRequest{} }
+func (*CellPlacementsRequest) ProtoMessage() {}
+func (*CellPlacementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorActualLrpRequests, []int{8}
+}
+
+func (m *CellPlacementsRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+type CellPlacement struct {
+	CellId     string       `protobuf:"bytes,1,opt,name=cell_id,json=cellId" json:"cell_id"`
+	ActualLrps []*ActualLRP `protobuf:"bytes,2,rep,name=actual_lrps,json=actualLrps" json:"actual_lrps,omitempty"`
+	MemoryMb   int32        `protobuf:"varint,3,opt,name=memory_mb,json=memoryMb" json:"memory_mb"`
+	DiskMb     int32        `protobuf:"varint,4,opt,name=disk_mb,json=diskMb" json:"disk_mb"`
+}
+
+func (m *CellPlacement) Reset()                    { *m = CellPlacement{} }
+func (*CellPlacement) ProtoMessage()               {}
+func (*CellPlacement) Descriptor() ([]byte, []int) { return fileDescriptorActualLrpRequests, []int{9} }
+
+func (m *CellPlacement) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *CellPlacement) GetActualLrps() []*ActualLRP {
+	if m != nil {
+		return m.ActualLrps
+	}
+	return nil
+}
+
+func (m *CellPlacement) GetMemoryMb() int32 {
+	if m != nil {
+		return m.MemoryMb
+	}
+	return 0
+}
+
+func (m *CellPlacement) GetDiskMb() int32 {
+	if m != nil {
+		return m.DiskMb
+	}
+	return 0
+}
+
+type CellPlacementsResponse struct {
+	Error          *Error           `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	CellPlacements []*CellPlacement `protobuf:"bytes,2,rep,name=cell_placements,json=cellPlacements" json:"cell_placements,omitempty"`
+}
+
+func (m *CellPlacementsResponse) Reset()      { *m = CellPlacementsResponse{} }
+func (*CellPlacementsResponse) ProtoMessage() {}
+func (*CellPlacementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorActualLrpRequests, []int{10}
+}
+
+func (m *CellPlacementsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *CellPlacementsResponse) GetCellPlacements() []*CellPlacement {
+	if m != nil {
+		return m.CellPlacements
+	}
+	return nil
+}
+
 type ClaimActualLRPRequest struct {
 	ProcessGuid          string                `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
 	Index                int32                 `protobuf:"varint,2,opt,name=index" json:"index"`
@@ -167,7 +323,7 @@ type ClaimActualLRPRequest struct {
 func (m *ClaimActualLRPRequest) Reset()      { *m = ClaimActualLRPRequest{} }
 func (*ClaimActualLRPRequest) ProtoMessage() {}
 func (*ClaimActualLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorActualLrpRequests, []int{6}
+	return fileDescriptorActualLrpRequests, []int{11}
 }
 
 func (m *ClaimActualLRPRequest) GetProcessGuid() string {
@@ -200,7 +356,7 @@ type StartActualLRPRequest struct {
 func (m *StartActualLRPRequest) Reset()      { *m = StartActualLRPRequest{} }
 func (*StartActualLRPRequest) ProtoMessage() {}
 func (*StartActualLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorActualLrpRequests, []int{7}
+	return fileDescriptorActualLrpRequests, []int{12}
 }
 
 func (m *StartActualLRPRequest) GetActualLrpKey() *ActualLRPKey {
@@ -233,7 +389,7 @@ type CrashActualLRPRequest struct {
 func (m *CrashActualLRPRequest) Reset()      { *m = CrashActualLRPRequest{} }
 func (*CrashActualLRPRequest) ProtoMessage() {}
 func (*CrashActualLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorActualLrpRequests, []int{8}
+	return fileDescriptorActualLrpRequests, []int{13}
 }
 
 func (m *CrashActualLRPRequest) GetActualLrpKey() *ActualLRPKey {
@@ -265,7 +421,7 @@ type FailActualLRPRequest struct {
 func (m *FailActualLRPRequest) Reset()      { *m = FailActualLRPRequest{} }
 func (*FailActualLRPRequest) ProtoMessage() {}
 func (*FailActualLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorActualLrpRequests, []int{9}
+	return fileDescriptorActualLrpRequests, []int{14}
 }
 
 func (m *FailActualLRPRequest) GetActualLrpKey() *ActualLRPKey {
@@ -289,7 +445,7 @@ type RetireActualLRPRequest struct {
 func (m *RetireActualLRPRequest) Reset()      { *m = RetireActualLRPRequest{} }
 func (*RetireActualLRPRequest) ProtoMessage() {}
 func (*RetireActualLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorActualLrpRequests, []int{10}
+	return fileDescriptorActualLrpRequests, []int{15}
 }
 
 func (m *RetireActualLRPRequest) GetActualLrpKey() *ActualLRPKey {
@@ -299,6 +455,122 @@ func (m *RetireActualLRPRequest) GetActualLrpKey() *ActualLRPKey {
 	return nil
 }
 
+type RetireActualLRPsOnCellRequest struct {
+	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId" json:"cell_id"`
+}
+
+func (m *RetireActualLRPsOnCellRequest) Reset()      { *m = RetireActualLRPsOnCellRequest{} }
+func (*RetireActualLRPsOnCellRequest) ProtoMessage() {}
+func (*RetireActualLRPsOnCellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorActualLrpRequests, []int{16}
+}
+
+func (m *RetireActualLRPsOnCellRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+type RetireActualLRPsOnCellResponse struct {
+	Error        *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	RetiredCount int32  `protobuf:"varint,2,opt,name=retired_count,json=retiredCount" json:"retired_count"`
+}
+
+func (m *RetireActualLRPsOnCellResponse) Reset()      { *m = RetireActualLRPsOnCellResponse{} }
+func (*RetireActualLRPsOnCellResponse) ProtoMessage() {}
+func (*RetireActualLRPsOnCellResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorActualLrpRequests, []int{17}
+}
+
+func (m *RetireActualLRPsOnCellResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *RetireActualLRPsOnCellResponse) GetRetiredCount() int32 {
+	if m != nil {
+		return m.RetiredCount
+	}
+	return 0
+}
+
+type StartActualLRPsRequest struct {
+	CellId        string                   `protobuf:"bytes,1,opt,name=cell_id,json=cellId" json:"cell_id"`
+	ActualLrps    []*StartActualLRPRequest `protobuf:"bytes,2,rep,name=actual_lrps,json=actualLrps" json:"actual_lrps,omitempty"`
+	Authoritative bool                     `protobuf:"varint,3,opt,name=authoritative" json:"authoritative"`
+}
+
+func (m *StartActualLRPsRequest) Reset()      { *m = StartActualLRPsRequest{} }
+func (*StartActualLRPsRequest) ProtoMessage() {}
+func (*StartActualLRPsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorActualLrpRequests, []int{18}
+}
+
+func (m *StartActualLRPsRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *StartActualLRPsRequest) GetActualLrps() []*StartActualLRPRequest {
+	if m != nil {
+		return m.ActualLrps
+	}
+	return nil
+}
+
+func (m *StartActualLRPsRequest) GetAuthoritative() bool {
+	if m != nil {
+		return m.Authoritative
+	}
+	return false
+}
+
+type StartActualLRPsResponse struct {
+	Error                 *Error          `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	StartedCount          int32           `protobuf:"varint,2,opt,name=started_count,json=startedCount" json:"started_count"`
+	RejectedActualLrpKeys []*ActualLRPKey `protobuf:"bytes,3,rep,name=rejected_actual_lrp_keys,json=rejectedActualLrpKeys" json:"rejected_actual_lrp_keys,omitempty"`
+	UnclaimedCount        int32           `protobuf:"varint,4,opt,name=unclaimed_count,json=unclaimedCount" json:"unclaimed_count"`
+}
+
+func (m *StartActualLRPsResponse) Reset()      { *m = StartActualLRPsResponse{} }
+func (*StartActualLRPsResponse) ProtoMessage() {}
+func (*StartActualLRPsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorActualLrpRequests, []int{19}
+}
+
+func (m *StartActualLRPsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *StartActualLRPsResponse) GetStartedCount() int32 {
+	if m != nil {
+		return m.StartedCount
+	}
+	return 0
+}
+
+func (m *StartActualLRPsResponse) GetRejectedActualLrpKeys() []*ActualLRPKey {
+	if m != nil {
+		return m.RejectedActualLrpKeys
+	}
+	return nil
+}
+
+func (m *StartActualLRPsResponse) GetUnclaimedCount() int32 {
+	if m != nil {
+		return m.UnclaimedCount
+	}
+	return 0
+}
+
 type RemoveActualLRPRequest struct {
 	ProcessGuid          string                `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
 	Index                int32                 `protobuf:"varint,2,opt,name=index" json:"index"`
@@ -308,7 +580,7 @@ type RemoveActualLRPRequest struct {
 func (m *RemoveActualLRPRequest) Reset()      { *m = RemoveActualLRPRequest{} }
 func (*RemoveActualLRPRequest) ProtoMessage() {}
 func (*RemoveActualLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorActualLrpRequests, []int{11}
+	return fileDescriptorActualLrpRequests, []int{20}
 }
 
 func (m *RemoveActualLRPRequest) GetProcessGuid() string {
@@ -339,11 +611,20 @@ func init() {
 	proto.RegisterType((*ActualLRPGroupsRequest)(nil), "models.ActualLRPGroupsRequest")
 	proto.RegisterType((*ActualLRPGroupsByProcessGuidRequest)(nil), "models.ActualLRPGroupsByProcessGuidRequest")
 	proto.RegisterType((*ActualLRPGroupByProcessGuidAndIndexRequest)(nil), "models.ActualLRPGroupByProcessGuidAndIndexRequest")
+	proto.RegisterType((*CrashingActualLRPsRequest)(nil), "models.CrashingActualLRPsRequest")
+	proto.RegisterType((*ActualLRPsResponse)(nil), "models.ActualLRPsResponse")
+	proto.RegisterType((*CellPlacementsRequest)(nil), "models.CellPlacementsRequest")
+	proto.RegisterType((*CellPlacement)(nil), "models.CellPlacement")
+	proto.RegisterType((*CellPlacementsResponse)(nil), "models.CellPlacementsResponse")
 	proto.RegisterType((*ClaimActualLRPRequest)(nil), "models.ClaimActualLRPRequest")
 	proto.RegisterType((*StartActualLRPRequest)(nil), "models.StartActualLRPRequest")
 	proto.RegisterType((*CrashActualLRPRequest)(nil), "models.CrashActualLRPRequest")
 	proto.RegisterType((*FailActualLRPRequest)(nil), "models.FailActualLRPRequest")
 	proto.RegisterType((*RetireActualLRPRequest)(nil), "models.RetireActualLRPRequest")
+	proto.RegisterType((*RetireActualLRPsOnCellRequest)(nil), "models.RetireActualLRPsOnCellRequest")
+	proto.RegisterType((*RetireActualLRPsOnCellResponse)(nil), "models.RetireActualLRPsOnCellResponse")
+	proto.RegisterType((*StartActualLRPsRequest)(nil), "models.StartActualLRPsRequest")
+	proto.RegisterType((*StartActualLRPsResponse)(nil), "models.StartActualLRPsResponse")
 	proto.RegisterType((*RemoveActualLRPRequest)(nil), "models.RemoveActualLRPRequest")
 }
 func (this *ActualLRPLifecycleResponse) Equal(that interface{}) bool {
@@ -412,6 +693,22 @@ func (this *ActualLRPGroupsResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.StateCounts) != len(that1.StateCounts) {
+		return false
+	}
+	for i := range this.StateCounts {
+		if this.StateCounts[i] != that1.StateCounts[i] {
+			return false
+		}
+	}
+	if len(this.RecordErrors) != len(that1.RecordErrors) {
+		return false
+	}
+	for i := range this.RecordErrors {
+		if !this.RecordErrors[i].Equal(that1.RecordErrors[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ActualLRPGroupResponse) Equal(that interface{}) bool {
@@ -478,6 +775,17 @@ func (this *ActualLRPGroupsRequest) Equal(that interface{}) bool {
 	if this.CellId != that1.CellId {
 		return false
 	}
+	if len(this.States) != len(that1.States) {
+		return false
+	}
+	for i := range this.States {
+		if this.States[i] != that1.States[i] {
+			return false
+		}
+	}
+	if this.PartialResults != that1.PartialResults {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPGroupsByProcessGuidRequest) Equal(that interface{}) bool {
@@ -543,7 +851,7 @@ func (this *ActualLRPGroupByProcessGuidAndIndexRequest) Equal(that interface{})
 	}
 	return true
 }
-func (this *ClaimActualLRPRequest) Equal(that interface{}) bool {
+func (this *CrashingActualLRPsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -551,9 +859,9 @@ func (this *ClaimActualLRPRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*ClaimActualLRPRequest)
+	that1, ok := that.(*CrashingActualLRPsRequest)
 	if !ok {
-		that2, ok := that.(ClaimActualLRPRequest)
+		that2, ok := that.(CrashingActualLRPsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -568,18 +876,12 @@ func (this *ClaimActualLRPRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ProcessGuid != that1.ProcessGuid {
-		return false
-	}
-	if this.Index != that1.Index {
-		return false
-	}
-	if !this.ActualLrpInstanceKey.Equal(that1.ActualLrpInstanceKey) {
+	if this.Limit != that1.Limit {
 		return false
 	}
 	return true
 }
-func (this *StartActualLRPRequest) Equal(that interface{}) bool {
+func (this *ActualLRPsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -587,9 +889,9 @@ func (this *StartActualLRPRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*StartActualLRPRequest)
+	that1, ok := that.(*ActualLRPsResponse)
 	if !ok {
-		that2, ok := that.(StartActualLRPRequest)
+		that2, ok := that.(ActualLRPsResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -604,18 +906,20 @@ func (this *StartActualLRPRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ActualLrpKey.Equal(that1.ActualLrpKey) {
+	if !this.Error.Equal(that1.Error) {
 		return false
 	}
-	if !this.ActualLrpInstanceKey.Equal(that1.ActualLrpInstanceKey) {
+	if len(this.ActualLrps) != len(that1.ActualLrps) {
 		return false
 	}
-	if !this.ActualLrpNetInfo.Equal(that1.ActualLrpNetInfo) {
-		return false
+	for i := range this.ActualLrps {
+		if !this.ActualLrps[i].Equal(that1.ActualLrps[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *CrashActualLRPRequest) Equal(that interface{}) bool {
+func (this *CellPlacementsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -623,9 +927,9 @@ func (this *CrashActualLRPRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*CrashActualLRPRequest)
+	that1, ok := that.(*CellPlacementsRequest)
 	if !ok {
-		that2, ok := that.(CrashActualLRPRequest)
+		that2, ok := that.(CellPlacementsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -640,18 +944,12 @@ func (this *CrashActualLRPRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ActualLrpKey.Equal(that1.ActualLrpKey) {
-		return false
-	}
-	if !this.ActualLrpInstanceKey.Equal(that1.ActualLrpInstanceKey) {
-		return false
-	}
-	if this.ErrorMessage != that1.ErrorMessage {
+	if this.CellId != that1.CellId {
 		return false
 	}
 	return true
 }
-func (this *FailActualLRPRequest) Equal(that interface{}) bool {
+func (this *CellPlacement) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -659,9 +957,9 @@ func (this *FailActualLRPRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*FailActualLRPRequest)
+	that1, ok := that.(*CellPlacement)
 	if !ok {
-		that2, ok := that.(FailActualLRPRequest)
+		that2, ok := that.(CellPlacement)
 		if ok {
 			that1 = &that2
 		} else {
@@ -676,15 +974,26 @@ func (this *FailActualLRPRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ActualLrpKey.Equal(that1.ActualLrpKey) {
+	if this.CellId != that1.CellId {
 		return false
 	}
-	if this.ErrorMessage != that1.ErrorMessage {
+	if len(this.ActualLrps) != len(that1.ActualLrps) {
+		return false
+	}
+	for i := range this.ActualLrps {
+		if !this.ActualLrps[i].Equal(that1.ActualLrps[i]) {
+			return false
+		}
+	}
+	if this.MemoryMb != that1.MemoryMb {
+		return false
+	}
+	if this.DiskMb != that1.DiskMb {
 		return false
 	}
 	return true
 }
-func (this *RetireActualLRPRequest) Equal(that interface{}) bool {
+func (this *CellPlacementsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -692,9 +1001,9 @@ func (this *RetireActualLRPRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*RetireActualLRPRequest)
+	that1, ok := that.(*CellPlacementsResponse)
 	if !ok {
-		that2, ok := that.(RetireActualLRPRequest)
+		that2, ok := that.(CellPlacementsResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -709,12 +1018,20 @@ func (this *RetireActualLRPRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ActualLrpKey.Equal(that1.ActualLrpKey) {
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.CellPlacements) != len(that1.CellPlacements) {
 		return false
 	}
+	for i := range this.CellPlacements {
+		if !this.CellPlacements[i].Equal(that1.CellPlacements[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *RemoveActualLRPRequest) Equal(that interface{}) bool {
+func (this *ClaimActualLRPRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -722,9 +1039,9 @@ func (this *RemoveActualLRPRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*RemoveActualLRPRequest)
+	that1, ok := that.(*ClaimActualLRPRequest)
 	if !ok {
-		that2, ok := that.(RemoveActualLRPRequest)
+		that2, ok := that.(ClaimActualLRPRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -750,558 +1067,652 @@ func (this *RemoveActualLRPRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ActualLRPLifecycleResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.ActualLRPLifecycleResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ActualLRPGroupsResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *StartActualLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.ActualLRPGroupsResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+
+	that1, ok := that.(*StartActualLRPRequest)
+	if !ok {
+		that2, ok := that.(StartActualLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.ActualLrpGroups != nil {
-		s = append(s, "ActualLrpGroups: "+fmt.Sprintf("%#v", this.ActualLrpGroups)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ActualLRPGroupResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.ActualLrpKey.Equal(that1.ActualLrpKey) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.ActualLRPGroupResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if !this.ActualLrpInstanceKey.Equal(that1.ActualLrpInstanceKey) {
+		return false
 	}
-	if this.ActualLrpGroup != nil {
-		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+	if !this.ActualLrpNetInfo.Equal(that1.ActualLrpNetInfo) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ActualLRPGroupsRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *CrashActualLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.ActualLRPGroupsRequest{")
-	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
-	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ActualLRPGroupsByProcessGuidRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*CrashActualLRPRequest)
+	if !ok {
+		that2, ok := that.(CrashActualLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.ActualLRPGroupsByProcessGuidRequest{")
-	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ActualLRPGroupByProcessGuidAndIndexRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.ActualLRPGroupByProcessGuidAndIndexRequest{")
-	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
-	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ClaimActualLRPRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.ActualLrpKey.Equal(that1.ActualLrpKey) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&models.ClaimActualLRPRequest{")
-	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
-	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
-	if this.ActualLrpInstanceKey != nil {
-		s = append(s, "ActualLrpInstanceKey: "+fmt.Sprintf("%#v", this.ActualLrpInstanceKey)+",\n")
+	if !this.ActualLrpInstanceKey.Equal(that1.ActualLrpInstanceKey) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.ErrorMessage != that1.ErrorMessage {
+		return false
+	}
+	return true
 }
-func (this *StartActualLRPRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *FailActualLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&models.StartActualLRPRequest{")
-	if this.ActualLrpKey != nil {
-		s = append(s, "ActualLrpKey: "+fmt.Sprintf("%#v", this.ActualLrpKey)+",\n")
+
+	that1, ok := that.(*FailActualLRPRequest)
+	if !ok {
+		that2, ok := that.(FailActualLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.ActualLrpInstanceKey != nil {
-		s = append(s, "ActualLrpInstanceKey: "+fmt.Sprintf("%#v", this.ActualLrpInstanceKey)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if this.ActualLrpNetInfo != nil {
-		s = append(s, "ActualLrpNetInfo: "+fmt.Sprintf("%#v", this.ActualLrpNetInfo)+",\n")
+	if !this.ActualLrpKey.Equal(that1.ActualLrpKey) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.ErrorMessage != that1.ErrorMessage {
+		return false
+	}
+	return true
 }
-func (this *CrashActualLRPRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RetireActualLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&models.CrashActualLRPRequest{")
-	if this.ActualLrpKey != nil {
-		s = append(s, "ActualLrpKey: "+fmt.Sprintf("%#v", this.ActualLrpKey)+",\n")
+
+	that1, ok := that.(*RetireActualLRPRequest)
+	if !ok {
+		that2, ok := that.(RetireActualLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.ActualLrpInstanceKey != nil {
-		s = append(s, "ActualLrpInstanceKey: "+fmt.Sprintf("%#v", this.ActualLrpInstanceKey)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ErrorMessage: "+fmt.Sprintf("%#v", this.ErrorMessage)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.ActualLrpKey.Equal(that1.ActualLrpKey) {
+		return false
+	}
+	return true
 }
-func (this *FailActualLRPRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RetireActualLRPsOnCellRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.FailActualLRPRequest{")
-	if this.ActualLrpKey != nil {
-		s = append(s, "ActualLrpKey: "+fmt.Sprintf("%#v", this.ActualLrpKey)+",\n")
+
+	that1, ok := that.(*RetireActualLRPsOnCellRequest)
+	if !ok {
+		that2, ok := that.(RetireActualLRPsOnCellRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ErrorMessage: "+fmt.Sprintf("%#v", this.ErrorMessage)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RetireActualLRPRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.RetireActualLRPRequest{")
-	if this.ActualLrpKey != nil {
-		s = append(s, "ActualLrpKey: "+fmt.Sprintf("%#v", this.ActualLrpKey)+",\n")
+	if this.CellId != that1.CellId {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RemoveActualLRPRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RetireActualLRPsOnCellResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&models.RemoveActualLRPRequest{")
-	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
-	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
-	if this.ActualLrpInstanceKey != nil {
-		s = append(s, "ActualLrpInstanceKey: "+fmt.Sprintf("%#v", this.ActualLrpInstanceKey)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringActualLrpRequests(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	that1, ok := that.(*RetireActualLRPsOnCellResponse)
+	if !ok {
+		that2, ok := that.(RetireActualLRPsOnCellResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func extensionToGoStringActualLrpRequests(m github_com_gogo_protobuf_proto.Message) string {
-	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
-	if e == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
-	keys := make([]int, 0, len(e))
-	for k := range e {
-		keys = append(keys, int(k))
+	if !this.Error.Equal(that1.Error) {
+		return false
 	}
-	sort.Ints(keys)
-	ss := []string{}
-	for _, k := range keys {
-		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	if this.RetiredCount != that1.RetiredCount {
+		return false
 	}
-	s += strings.Join(ss, ",") + "})"
-	return s
+	return true
 }
-func (m *ActualLRPLifecycleResponse) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *StartActualLRPsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	return data[:n], nil
-}
 
-func (m *ActualLRPLifecycleResponse) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
-		n1, err := m.Error.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*StartActualLRPsRequest)
+	if !ok {
+		that2, ok := that.(StartActualLRPsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n1
 	}
-	return i, nil
-}
-
-func (m *ActualLRPGroupsResponse) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return data[:n], nil
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if len(this.ActualLrps) != len(that1.ActualLrps) {
+		return false
+	}
+	for i := range this.ActualLrps {
+		if !this.ActualLrps[i].Equal(that1.ActualLrps[i]) {
+			return false
+		}
+	}
+	if this.Authoritative != that1.Authoritative {
+		return false
+	}
+	return true
 }
+func (this *StartActualLRPsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *ActualLRPGroupsResponse) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
-		n2, err := m.Error.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*StartActualLRPsResponse)
+	if !ok {
+		that2, ok := that.(StartActualLRPsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n2
 	}
-	if len(m.ActualLrpGroups) > 0 {
-		for _, msg := range m.ActualLrpGroups {
-			data[i] = 0x12
-			i++
-			i = encodeVarintActualLrpRequests(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *ActualLRPGroupResponse) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if !this.Error.Equal(that1.Error) {
+		return false
 	}
-	return data[:n], nil
+	if this.StartedCount != that1.StartedCount {
+		return false
+	}
+	if len(this.RejectedActualLrpKeys) != len(that1.RejectedActualLrpKeys) {
+		return false
+	}
+	for i := range this.RejectedActualLrpKeys {
+		if !this.RejectedActualLrpKeys[i].Equal(that1.RejectedActualLrpKeys[i]) {
+			return false
+		}
+	}
+	if this.UnclaimedCount != that1.UnclaimedCount {
+		return false
+	}
+	return true
 }
+func (this *RemoveActualLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *ActualLRPGroupResponse) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
-		n3, err := m.Error.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*RemoveActualLRPRequest)
+	if !ok {
+		that2, ok := that.(RemoveActualLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n3
 	}
-	if m.ActualLrpGroup != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpGroup.Size()))
-		n4, err := m.ActualLrpGroup.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
-		i += n4
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *ActualLRPGroupsRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
 	}
-	return data[:n], nil
-}
-
-func (m *ActualLRPGroupsRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.Domain)))
-	i += copy(data[i:], m.Domain)
-	data[i] = 0x12
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.CellId)))
-	i += copy(data[i:], m.CellId)
-	return i, nil
-}
-
-func (m *ActualLRPGroupsByProcessGuidRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if this.Index != that1.Index {
+		return false
 	}
-	return data[:n], nil
+	if !this.ActualLrpInstanceKey.Equal(that1.ActualLrpInstanceKey) {
+		return false
+	}
+	return true
 }
-
-func (m *ActualLRPGroupsByProcessGuidRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ProcessGuid)))
-	i += copy(data[i:], m.ProcessGuid)
-	return i, nil
-}
-
-func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *ActualLRPLifecycleResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return data[:n], nil
-}
-
-func (m *ActualLRPGroupByProcessGuidAndIndexRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ProcessGuid)))
-	i += copy(data[i:], m.ProcessGuid)
-	data[i] = 0x10
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(m.Index))
-	return i, nil
-}
-
-func (m *ClaimActualLRPRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ActualLRPLifecycleResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
 	}
-	return data[:n], nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ClaimActualLRPRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ProcessGuid)))
-	i += copy(data[i:], m.ProcessGuid)
-	data[i] = 0x10
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(m.Index))
-	if m.ActualLrpInstanceKey != nil {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpInstanceKey.Size()))
-		n5, err := m.ActualLrpInstanceKey.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+func (this *ActualLRPGroupsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return i, nil
-}
-
-func (m *StartActualLRPRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 8)
+	s = append(s, "&models.ActualLRPGroupsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
 	}
-	return data[:n], nil
-}
-
-func (m *StartActualLRPRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ActualLrpKey != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpKey.Size()))
-		n6, err := m.ActualLrpKey.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+	if this.ActualLrpGroups != nil {
+		s = append(s, "ActualLrpGroups: "+fmt.Sprintf("%#v", this.ActualLrpGroups)+",\n")
 	}
-	if m.ActualLrpInstanceKey != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpInstanceKey.Size()))
-		n7, err := m.ActualLrpInstanceKey.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+	keysForStateCounts := make([]string, 0, len(this.StateCounts))
+	for k, _ := range this.StateCounts {
+		keysForStateCounts = append(keysForStateCounts, k)
 	}
-	if m.ActualLrpNetInfo != nil {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpNetInfo.Size()))
-		n8, err := m.ActualLrpNetInfo.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStateCounts)
+	mapStringForStateCounts := "map[string]int32{"
+	for _, k := range keysForStateCounts {
+		mapStringForStateCounts += fmt.Sprintf("%#v: %#v,", k, this.StateCounts[k])
 	}
-	return i, nil
-}
-
-func (m *CrashActualLRPRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	mapStringForStateCounts += "}"
+	if this.StateCounts != nil {
+		s = append(s, "StateCounts: "+mapStringForStateCounts+",\n")
 	}
-	return data[:n], nil
+	if this.RecordErrors != nil {
+		s = append(s, "RecordErrors: "+fmt.Sprintf("%#v", this.RecordErrors)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CrashActualLRPRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ActualLrpKey != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpKey.Size()))
-		n9, err := m.ActualLrpKey.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+func (this *ActualLRPGroupResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ActualLrpInstanceKey != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpInstanceKey.Size()))
-		n10, err := m.ActualLrpInstanceKey.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPGroupResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
 	}
-	data[i] = 0x1a
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ErrorMessage)))
-	i += copy(data[i:], m.ErrorMessage)
-	return i, nil
+	if this.ActualLrpGroup != nil {
+		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *FailActualLRPRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *ActualLRPGroupsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return data[:n], nil
+	s := make([]string, 0, 8)
+	s = append(s, "&models.ActualLRPGroupsRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	if this.States != nil {
+		s = append(s, "States: "+fmt.Sprintf("%#v", this.States)+",\n")
+	}
+	s = append(s, "PartialResults: "+fmt.Sprintf("%#v", this.PartialResults)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *FailActualLRPRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ActualLrpKey != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpKey.Size()))
-		n11, err := m.ActualLrpKey.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+func (this *ActualLRPGroupsByProcessGuidRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	data[i] = 0x12
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ErrorMessage)))
-	i += copy(data[i:], m.ErrorMessage)
-	return i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ActualLRPGroupsByProcessGuidRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RetireActualLRPRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *ActualLRPGroupByProcessGuidAndIndexRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return data[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPGroupByProcessGuidAndIndexRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RetireActualLRPRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ActualLrpKey != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpKey.Size()))
-		n12, err := m.ActualLrpKey.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
+func (this *CrashingActualLRPsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&models.CrashingActualLRPsRequest{")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RemoveActualLRPRequest) Marshal() (data []byte, err error) {
+func (this *ActualLRPsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.ActualLrps != nil {
+		s = append(s, "ActualLrps: "+fmt.Sprintf("%#v", this.ActualLrps)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellPlacementsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.CellPlacementsRequest{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellPlacement) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.CellPlacement{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	if this.ActualLrps != nil {
+		s = append(s, "ActualLrps: "+fmt.Sprintf("%#v", this.ActualLrps)+",\n")
+	}
+	s = append(s, "MemoryMb: "+fmt.Sprintf("%#v", this.MemoryMb)+",\n")
+	s = append(s, "DiskMb: "+fmt.Sprintf("%#v", this.DiskMb)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellPlacementsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CellPlacementsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.CellPlacements != nil {
+		s = append(s, "CellPlacements: "+fmt.Sprintf("%#v", this.CellPlacements)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClaimActualLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.ClaimActualLRPRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	if this.ActualLrpInstanceKey != nil {
+		s = append(s, "ActualLrpInstanceKey: "+fmt.Sprintf("%#v", this.ActualLrpInstanceKey)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartActualLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.StartActualLRPRequest{")
+	if this.ActualLrpKey != nil {
+		s = append(s, "ActualLrpKey: "+fmt.Sprintf("%#v", this.ActualLrpKey)+",\n")
+	}
+	if this.ActualLrpInstanceKey != nil {
+		s = append(s, "ActualLrpInstanceKey: "+fmt.Sprintf("%#v", this.ActualLrpInstanceKey)+",\n")
+	}
+	if this.ActualLrpNetInfo != nil {
+		s = append(s, "ActualLrpNetInfo: "+fmt.Sprintf("%#v", this.ActualLrpNetInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CrashActualLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.CrashActualLRPRequest{")
+	if this.ActualLrpKey != nil {
+		s = append(s, "ActualLrpKey: "+fmt.Sprintf("%#v", this.ActualLrpKey)+",\n")
+	}
+	if this.ActualLrpInstanceKey != nil {
+		s = append(s, "ActualLrpInstanceKey: "+fmt.Sprintf("%#v", this.ActualLrpInstanceKey)+",\n")
+	}
+	s = append(s, "ErrorMessage: "+fmt.Sprintf("%#v", this.ErrorMessage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FailActualLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.FailActualLRPRequest{")
+	if this.ActualLrpKey != nil {
+		s = append(s, "ActualLrpKey: "+fmt.Sprintf("%#v", this.ActualLrpKey)+",\n")
+	}
+	s = append(s, "ErrorMessage: "+fmt.Sprintf("%#v", this.ErrorMessage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RetireActualLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RetireActualLRPRequest{")
+	if this.ActualLrpKey != nil {
+		s = append(s, "ActualLrpKey: "+fmt.Sprintf("%#v", this.ActualLrpKey)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RetireActualLRPsOnCellRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RetireActualLRPsOnCellRequest{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RetireActualLRPsOnCellResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.RetireActualLRPsOnCellResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "RetiredCount: "+fmt.Sprintf("%#v", this.RetiredCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartActualLRPsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.StartActualLRPsRequest{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	if this.ActualLrps != nil {
+		s = append(s, "ActualLrps: "+fmt.Sprintf("%#v", this.ActualLrps)+",\n")
+	}
+	s = append(s, "Authoritative: "+fmt.Sprintf("%#v", this.Authoritative)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartActualLRPsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.StartActualLRPsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "StartedCount: "+fmt.Sprintf("%#v", this.StartedCount)+",\n")
+	if this.RejectedActualLrpKeys != nil {
+		s = append(s, "RejectedActualLrpKeys: "+fmt.Sprintf("%#v", this.RejectedActualLrpKeys)+",\n")
+	}
+	s = append(s, "UnclaimedCount: "+fmt.Sprintf("%#v", this.UnclaimedCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveActualLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.RemoveActualLRPRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	if this.ActualLrpInstanceKey != nil {
+		s = append(s, "ActualLrpInstanceKey: "+fmt.Sprintf("%#v", this.ActualLrpInstanceKey)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringActualLrpRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringActualLrpRequests(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+func (m *ActualLRPLifecycleResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1311,362 +1722,2378 @@ func (m *RemoveActualLRPRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *RemoveActualLRPRequest) MarshalTo(data []byte) (int, error) {
+func (m *ActualLRPLifecycleResponse) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ProcessGuid)))
-	i += copy(data[i:], m.ProcessGuid)
-	data[i] = 0x10
-	i++
-	i = encodeVarintActualLrpRequests(data, i, uint64(m.Index))
-	if m.ActualLrpInstanceKey != nil {
-		data[i] = 0x1a
+	if m.Error != nil {
+		data[i] = 0xa
 		i++
-		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpInstanceKey.Size()))
-		n13, err := m.ActualLrpInstanceKey.MarshalTo(data[i:])
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n1
 	}
 	return i, nil
 }
 
-func encodeFixed64ActualLrpRequests(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32ActualLrpRequests(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintActualLrpRequests(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	data[offset] = uint8(v)
-	return offset + 1
-}
-func (m *ActualLRPLifecycleResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
+func (m *ActualLRPGroupsResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *ActualLRPGroupsResponse) Size() (n int) {
+func (m *ActualLRPGroupsResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
-	}
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
+		n2, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	if len(m.ActualLrpGroups) > 0 {
-		for _, e := range m.ActualLrpGroups {
-			l = e.Size()
-			n += 1 + l + sovActualLrpRequests(uint64(l))
+		for _, msg := range m.ActualLrpGroups {
+			data[i] = 0x12
+			i++
+			i = encodeVarintActualLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	if len(m.StateCounts) > 0 {
+		for k, _ := range m.StateCounts {
+			data[i] = 0x1a
+			i++
+			v := m.StateCounts[k]
+			mapSize := 1 + len(k) + sovActualLrpRequests(uint64(len(k))) + 1 + sovActualLrpRequests(uint64(v))
+			i = encodeVarintActualLrpRequests(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintActualLrpRequests(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x10
+			i++
+			i = encodeVarintActualLrpRequests(data, i, uint64(v))
+		}
+	}
+	if len(m.RecordErrors) > 0 {
+		for _, msg := range m.RecordErrors {
+			data[i] = 0x22
+			i++
+			i = encodeVarintActualLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
 
-func (m *ActualLRPGroupResponse) Size() (n int) {
+func (m *ActualLRPGroupResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ActualLRPGroupResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
+		n3, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
 	}
 	if m.ActualLrpGroup != nil {
-		l = m.ActualLrpGroup.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
+		data[i] = 0x12
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpGroup.Size()))
+		n4, err := m.ActualLrpGroup.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	return n
+	return i, nil
 }
 
-func (m *ActualLRPGroupsRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Domain)
-	n += 1 + l + sovActualLrpRequests(uint64(l))
-	l = len(m.CellId)
-	n += 1 + l + sovActualLrpRequests(uint64(l))
-	return n
+func (m *ActualLRPGroupsRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func (m *ActualLRPGroupsByProcessGuidRequest) Size() (n int) {
+func (m *ActualLRPGroupsRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProcessGuid)
-	n += 1 + l + sovActualLrpRequests(uint64(l))
-	return n
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.Domain)))
+	i += copy(data[i:], m.Domain)
+	data[i] = 0x12
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.CellId)))
+	i += copy(data[i:], m.CellId)
+	if len(m.States) > 0 {
+		for _, s := range m.States {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	data[i] = 0x20
+	i++
+	if m.PartialResults {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	return i, nil
 }
 
-func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ProcessGuid)
-	n += 1 + l + sovActualLrpRequests(uint64(l))
-	n += 1 + sovActualLrpRequests(uint64(m.Index))
-	return n
+func (m *ActualLRPGroupsByProcessGuidRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func (m *ClaimActualLRPRequest) Size() (n int) {
+func (m *ActualLRPGroupsByProcessGuidRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProcessGuid)
-	n += 1 + l + sovActualLrpRequests(uint64(l))
-	n += 1 + sovActualLrpRequests(uint64(m.Index))
-	if m.ActualLrpInstanceKey != nil {
-		l = m.ActualLrpInstanceKey.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ProcessGuid)))
+	i += copy(data[i:], m.ProcessGuid)
+	return i, nil
+}
+
+func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *StartActualLRPRequest) Size() (n int) {
+func (m *ActualLRPGroupByProcessGuidAndIndexRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ActualLrpKey != nil {
-		l = m.ActualLrpKey.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
-	}
-	if m.ActualLrpInstanceKey != nil {
-		l = m.ActualLrpInstanceKey.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
-	}
-	if m.ActualLrpNetInfo != nil {
-		l = m.ActualLrpNetInfo.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ProcessGuid)))
+	i += copy(data[i:], m.ProcessGuid)
+	data[i] = 0x10
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(m.Index))
+	return i, nil
+}
+
+func (m *CrashingActualLRPsRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *CrashActualLRPRequest) Size() (n int) {
+func (m *CrashingActualLRPsRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ActualLrpKey != nil {
-		l = m.ActualLrpKey.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
-	}
-	if m.ActualLrpInstanceKey != nil {
-		l = m.ActualLrpInstanceKey.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
+	data[i] = 0x8
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(m.Limit))
+	return i, nil
+}
+
+func (m *ActualLRPsResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ErrorMessage)
-	n += 1 + l + sovActualLrpRequests(uint64(l))
-	return n
+	return data[:n], nil
 }
 
-func (m *FailActualLRPRequest) Size() (n int) {
+func (m *ActualLRPsResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ActualLrpKey != nil {
-		l = m.ActualLrpKey.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
+		n5, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
-	l = len(m.ErrorMessage)
-	n += 1 + l + sovActualLrpRequests(uint64(l))
-	return n
+	if len(m.ActualLrps) > 0 {
+		for _, msg := range m.ActualLrps {
+			data[i] = 0x12
+			i++
+			i = encodeVarintActualLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
 
-func (m *RetireActualLRPRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.ActualLrpKey != nil {
-		l = m.ActualLrpKey.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
+func (m *CellPlacementsRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *RemoveActualLRPRequest) Size() (n int) {
+func (m *CellPlacementsRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProcessGuid)
-	n += 1 + l + sovActualLrpRequests(uint64(l))
-	n += 1 + sovActualLrpRequests(uint64(m.Index))
-	if m.ActualLrpInstanceKey != nil {
-		l = m.ActualLrpInstanceKey.Size()
-		n += 1 + l + sovActualLrpRequests(uint64(l))
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.CellId)))
+	i += copy(data[i:], m.CellId)
+	return i, nil
+}
+
+func (m *CellPlacement) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func sovActualLrpRequests(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *CellPlacement) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.CellId)))
+	i += copy(data[i:], m.CellId)
+	if len(m.ActualLrps) > 0 {
+		for _, msg := range m.ActualLrps {
+			data[i] = 0x12
+			i++
+			i = encodeVarintActualLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
-}
-func sozActualLrpRequests(x uint64) (n int) {
-	return sovActualLrpRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	data[i] = 0x18
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(m.MemoryMb))
+	data[i] = 0x20
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(m.DiskMb))
+	return i, nil
 }
-func (this *ActualLRPLifecycleResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CellPlacementsResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ActualLRPLifecycleResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *ActualLRPGroupsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CellPlacementsResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
+		n6, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
 	}
-	s := strings.Join([]string{`&ActualLRPGroupsResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`ActualLrpGroups:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpGroups), "ActualLRPGroup", "ActualLRPGroup", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ActualLRPGroupResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.CellPlacements) > 0 {
+		for _, msg := range m.CellPlacements {
+			data[i] = 0x12
+			i++
+			i = encodeVarintActualLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	s := strings.Join([]string{`&ActualLRPGroupResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`ActualLrpGroup:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpGroup), "ActualLRPGroup", "ActualLRPGroup", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *ActualLRPGroupsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ClaimActualLRPRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ActualLRPGroupsRequest{`,
-		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
-		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *ActualLRPGroupsByProcessGuidRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ClaimActualLRPRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ProcessGuid)))
+	i += copy(data[i:], m.ProcessGuid)
+	data[i] = 0x10
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(m.Index))
+	if m.ActualLrpInstanceKey != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpInstanceKey.Size()))
+		n7, err := m.ActualLrpInstanceKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
-	s := strings.Join([]string{`&ActualLRPGroupsByProcessGuidRequest{`,
-		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *ActualLRPGroupByProcessGuidAndIndexRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StartActualLRPRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ActualLRPGroupByProcessGuidAndIndexRequest{`,
-		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
-		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *ClaimActualLRPRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StartActualLRPRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpKey.Size()))
+		n8, err := m.ActualLrpKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
 	}
-	s := strings.Join([]string{`&ClaimActualLRPRequest{`,
-		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
-		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
-		`ActualLrpInstanceKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceKey), "ActualLRPInstanceKey", "ActualLRPInstanceKey", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StartActualLRPRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ActualLrpInstanceKey != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpInstanceKey.Size()))
+		n9, err := m.ActualLrpInstanceKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
 	}
-	s := strings.Join([]string{`&StartActualLRPRequest{`,
-		`ActualLrpKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpKey), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
-		`ActualLrpInstanceKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceKey), "ActualLRPInstanceKey", "ActualLRPInstanceKey", 1) + `,`,
-		`ActualLrpNetInfo:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpNetInfo), "ActualLRPNetInfo", "ActualLRPNetInfo", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CrashActualLRPRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ActualLrpNetInfo != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpNetInfo.Size()))
+		n10, err := m.ActualLrpNetInfo.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
-	s := strings.Join([]string{`&CrashActualLRPRequest{`,
-		`ActualLrpKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpKey), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
-		`ActualLrpInstanceKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceKey), "ActualLRPInstanceKey", "ActualLRPInstanceKey", 1) + `,`,
-		`ErrorMessage:` + fmt.Sprintf("%v", this.ErrorMessage) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *FailActualLRPRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CrashActualLRPRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&FailActualLRPRequest{`,
-		`ActualLrpKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpKey), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
-		`ErrorMessage:` + fmt.Sprintf("%v", this.ErrorMessage) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *RetireActualLRPRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RetireActualLRPRequest{`,
-		`ActualLrpKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpKey), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *CrashActualLRPRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpKey.Size()))
+		n11, err := m.ActualLrpKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.ActualLrpInstanceKey != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpInstanceKey.Size()))
+		n12, err := m.ActualLrpInstanceKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	data[i] = 0x1a
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ErrorMessage)))
+	i += copy(data[i:], m.ErrorMessage)
+	return i, nil
+}
+
+func (m *FailActualLRPRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *FailActualLRPRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpKey.Size()))
+		n13, err := m.ActualLrpKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ErrorMessage)))
+	i += copy(data[i:], m.ErrorMessage)
+	return i, nil
+}
+
+func (m *RetireActualLRPRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RetireActualLRPRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpKey.Size()))
+		n14, err := m.ActualLrpKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+
+func (m *RetireActualLRPsOnCellRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RetireActualLRPsOnCellRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.CellId)))
+	i += copy(data[i:], m.CellId)
+	return i, nil
+}
+
+func (m *RetireActualLRPsOnCellResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RetireActualLRPsOnCellResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
+		n15, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	data[i] = 0x10
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(m.RetiredCount))
+	return i, nil
+}
+
+func (m *StartActualLRPsRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StartActualLRPsRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.CellId)))
+	i += copy(data[i:], m.CellId)
+	if len(m.ActualLrps) > 0 {
+		for _, msg := range m.ActualLrps {
+			data[i] = 0x12
+			i++
+			i = encodeVarintActualLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x18
+	i++
+	if m.Authoritative {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *StartActualLRPsResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StartActualLRPsResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.Error.Size()))
+		n16, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	data[i] = 0x10
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(m.StartedCount))
+	if len(m.RejectedActualLrpKeys) > 0 {
+		for _, msg := range m.RejectedActualLrpKeys {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintActualLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x20
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(m.UnclaimedCount))
+	return i, nil
+}
+
+func (m *RemoveActualLRPRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RemoveActualLRPRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(len(m.ProcessGuid)))
+	i += copy(data[i:], m.ProcessGuid)
+	data[i] = 0x10
+	i++
+	i = encodeVarintActualLrpRequests(data, i, uint64(m.Index))
+	if m.ActualLrpInstanceKey != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintActualLrpRequests(data, i, uint64(m.ActualLrpInstanceKey.Size()))
+		n17, err := m.ActualLrpInstanceKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+
+func encodeFixed64ActualLrpRequests(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32ActualLrpRequests(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintActualLrpRequests(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ActualLRPLifecycleResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ActualLRPGroupsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if len(m.ActualLrpGroups) > 0 {
+		for _, e := range m.ActualLrpGroups {
+			l = e.Size()
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
+	if len(m.StateCounts) > 0 {
+		for k, v := range m.StateCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovActualLrpRequests(uint64(len(k))) + 1 + sovActualLrpRequests(uint64(v))
+			n += mapEntrySize + 1 + sovActualLrpRequests(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RecordErrors) > 0 {
+		for _, e := range m.RecordErrors {
+			l = e.Size()
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActualLRPGroupResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if m.ActualLrpGroup != nil {
+		l = m.ActualLrpGroup.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ActualLRPGroupsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	l = len(m.CellId)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	if len(m.States) > 0 {
+		for _, s := range m.States {
+			l = len(s)
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *ActualLRPGroupsByProcessGuidRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	return n
+}
+
+func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	n += 1 + sovActualLrpRequests(uint64(m.Index))
+	return n
+}
+
+func (m *CrashingActualLRPsRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovActualLrpRequests(uint64(m.Limit))
+	return n
+}
+
+func (m *ActualLRPsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if len(m.ActualLrps) > 0 {
+		for _, e := range m.ActualLrps {
+			l = e.Size()
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CellPlacementsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CellId)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	return n
+}
+
+func (m *CellPlacement) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CellId)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	if len(m.ActualLrps) > 0 {
+		for _, e := range m.ActualLrps {
+			l = e.Size()
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
+	n += 1 + sovActualLrpRequests(uint64(m.MemoryMb))
+	n += 1 + sovActualLrpRequests(uint64(m.DiskMb))
+	return n
+}
+
+func (m *CellPlacementsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if len(m.CellPlacements) > 0 {
+		for _, e := range m.CellPlacements {
+			l = e.Size()
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimActualLRPRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	n += 1 + sovActualLrpRequests(uint64(m.Index))
+	if m.ActualLrpInstanceKey != nil {
+		l = m.ActualLrpInstanceKey.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *StartActualLRPRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		l = m.ActualLrpKey.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if m.ActualLrpInstanceKey != nil {
+		l = m.ActualLrpInstanceKey.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if m.ActualLrpNetInfo != nil {
+		l = m.ActualLrpNetInfo.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *CrashActualLRPRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		l = m.ActualLrpKey.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if m.ActualLrpInstanceKey != nil {
+		l = m.ActualLrpInstanceKey.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	return n
+}
+
+func (m *FailActualLRPRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		l = m.ActualLrpKey.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	return n
+}
+
+func (m *RetireActualLRPRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ActualLrpKey != nil {
+		l = m.ActualLrpKey.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *RetireActualLRPsOnCellRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CellId)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	return n
+}
+
+func (m *RetireActualLRPsOnCellResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	n += 1 + sovActualLrpRequests(uint64(m.RetiredCount))
+	return n
+}
+
+func (m *StartActualLRPsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CellId)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	if len(m.ActualLrps) > 0 {
+		for _, e := range m.ActualLrps {
+			l = e.Size()
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *StartActualLRPsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	n += 1 + sovActualLrpRequests(uint64(m.StartedCount))
+	if len(m.RejectedActualLrpKeys) > 0 {
+		for _, e := range m.RejectedActualLrpKeys {
+			l = e.Size()
+			n += 1 + l + sovActualLrpRequests(uint64(l))
+		}
+	}
+	n += 1 + sovActualLrpRequests(uint64(m.UnclaimedCount))
+	return n
+}
+
+func (m *RemoveActualLRPRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
+	n += 1 + sovActualLrpRequests(uint64(m.Index))
+	if m.ActualLrpInstanceKey != nil {
+		l = m.ActualLrpInstanceKey.Size()
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func sovActualLrpRequests(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozActualLrpRequests(x uint64) (n int) {
+	return sovActualLrpRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ActualLRPLifecycleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPLifecycleResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPGroupsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForStateCounts := make([]string, 0, len(this.StateCounts))
+	for k, _ := range this.StateCounts {
+		keysForStateCounts = append(keysForStateCounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStateCounts)
+	mapStringForStateCounts := "map[string]int32{"
+	for _, k := range keysForStateCounts {
+		mapStringForStateCounts += fmt.Sprintf("%v: %v,", k, this.StateCounts[k])
+	}
+	mapStringForStateCounts += "}"
+	s := strings.Join([]string{`&ActualLRPGroupsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ActualLrpGroups:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpGroups), "ActualLRPGroup", "ActualLRPGroup", 1) + `,`,
+		`StateCounts:` + mapStringForStateCounts + `,`,
+		`RecordErrors:` + strings.Replace(fmt.Sprintf("%v", this.RecordErrors), "RecordError", "RecordError", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPGroupResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPGroupResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ActualLrpGroup:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpGroup), "ActualLRPGroup", "ActualLRPGroup", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPGroupsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPGroupsRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`States:` + fmt.Sprintf("%v", this.States) + `,`,
+		`PartialResults:` + fmt.Sprintf("%v", this.PartialResults) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPGroupsByProcessGuidRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPGroupsByProcessGuidRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPGroupByProcessGuidAndIndexRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPGroupByProcessGuidAndIndexRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CrashingActualLRPsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CrashingActualLRPsRequest{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ActualLrps:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrps), "ActualLRP", "ActualLRP", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellPlacementsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellPlacementsRequest{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellPlacement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellPlacement{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`ActualLrps:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrps), "ActualLRP", "ActualLRP", 1) + `,`,
+		`MemoryMb:` + fmt.Sprintf("%v", this.MemoryMb) + `,`,
+		`DiskMb:` + fmt.Sprintf("%v", this.DiskMb) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellPlacementsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellPlacementsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`CellPlacements:` + strings.Replace(fmt.Sprintf("%v", this.CellPlacements), "CellPlacement", "CellPlacement", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClaimActualLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClaimActualLRPRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`ActualLrpInstanceKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceKey), "ActualLRPInstanceKey", "ActualLRPInstanceKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartActualLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartActualLRPRequest{`,
+		`ActualLrpKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpKey), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
+		`ActualLrpInstanceKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceKey), "ActualLRPInstanceKey", "ActualLRPInstanceKey", 1) + `,`,
+		`ActualLrpNetInfo:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpNetInfo), "ActualLRPNetInfo", "ActualLRPNetInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CrashActualLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CrashActualLRPRequest{`,
+		`ActualLrpKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpKey), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
+		`ActualLrpInstanceKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceKey), "ActualLRPInstanceKey", "ActualLRPInstanceKey", 1) + `,`,
+		`ErrorMessage:` + fmt.Sprintf("%v", this.ErrorMessage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FailActualLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FailActualLRPRequest{`,
+		`ActualLrpKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpKey), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
+		`ErrorMessage:` + fmt.Sprintf("%v", this.ErrorMessage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetireActualLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetireActualLRPRequest{`,
+		`ActualLrpKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpKey), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetireActualLRPsOnCellRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetireActualLRPsOnCellRequest{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetireActualLRPsOnCellResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetireActualLRPsOnCellResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`RetiredCount:` + fmt.Sprintf("%v", this.RetiredCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartActualLRPsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartActualLRPsRequest{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`ActualLrps:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrps), "StartActualLRPRequest", "StartActualLRPRequest", 1) + `,`,
+		`Authoritative:` + fmt.Sprintf("%v", this.Authoritative) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartActualLRPsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartActualLRPsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`StartedCount:` + fmt.Sprintf("%v", this.StartedCount) + `,`,
+		`RejectedActualLrpKeys:` + strings.Replace(fmt.Sprintf("%v", this.RejectedActualLrpKeys), "ActualLRPKey", "ActualLRPKey", 1) + `,`,
+		`UnclaimedCount:` + fmt.Sprintf("%v", this.UnclaimedCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveActualLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveActualLRPRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`ActualLrpInstanceKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceKey), "ActualLRPInstanceKey", "ActualLRPInstanceKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringActualLrpRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ActualLRPLifecycleResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPLifecycleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPGroupsResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActualLrpGroups = append(m.ActualLrpGroups, &ActualLRPGroup{})
+			if err := m.ActualLrpGroups[len(m.ActualLrpGroups)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.StateCounts == nil {
+				m.StateCounts = make(map[string]int32)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowActualLrpRequests
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowActualLrpRequests
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapvalue |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.StateCounts[mapkey] = mapvalue
+			} else {
+				var mapvalue int32
+				m.StateCounts[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordErrors = append(m.RecordErrors, &RecordError{})
+			if err := m.RecordErrors[len(m.RecordErrors)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPGroupResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrpGroup == nil {
+				m.ActualLrpGroup = &ActualLRPGroup{}
+			}
+			if err := m.ActualLrpGroup.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPGroupsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPGroupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialResults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialResults = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPGroupsByProcessGuidRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPGroupsByProcessGuidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPGroupsByProcessGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPGroupByProcessGuidAndIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPGroupByProcessGuidAndIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Index |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (this *RemoveActualLRPRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *CrashingActualLRPsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrashingActualLRPsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrashingActualLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Limit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPsResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActualLrps = append(m.ActualLrps, &ActualLRP{})
+			if err := m.ActualLrps[len(m.ActualLrps)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellPlacementsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellPlacementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellPlacementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&RemoveActualLRPRequest{`,
-		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
-		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
-		`ActualLrpInstanceKey:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceKey), "ActualLRPInstanceKey", "ActualLRPInstanceKey", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringActualLrpRequests(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ActualLRPLifecycleResponse) Unmarshal(data []byte) error {
+func (m *CellPlacement) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1689,15 +4116,44 @@ func (m *ActualLRPLifecycleResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPLifecycleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CellPlacement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CellPlacement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1721,13 +4177,49 @@ func (m *ActualLRPLifecycleResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
-			}
-			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.ActualLrps = append(m.ActualLrps, &ActualLRP{})
+			if err := m.ActualLrps[len(m.ActualLrps)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMb", wireType)
+			}
+			m.MemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MemoryMb |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskMb", wireType)
+			}
+			m.DiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DiskMb |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(data[iNdEx:])
@@ -1749,7 +4241,7 @@ func (m *ActualLRPLifecycleResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ActualLRPGroupsResponse) Unmarshal(data []byte) error {
+func (m *CellPlacementsResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1772,10 +4264,10 @@ func (m *ActualLRPGroupsResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CellPlacementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CellPlacementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1813,7 +4305,7 @@ func (m *ActualLRPGroupsResponse) Unmarshal(data []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellPlacements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1837,8 +4329,139 @@ func (m *ActualLRPGroupsResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActualLrpGroups = append(m.ActualLrpGroups, &ActualLRPGroup{})
-			if err := m.ActualLrpGroups[len(m.ActualLrpGroups)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.CellPlacements = append(m.CellPlacements, &CellPlacement{})
+			if err := m.CellPlacements[len(m.CellPlacements)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimActualLRPRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimActualLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Index |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpInstanceKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrpInstanceKey == nil {
+				m.ActualLrpInstanceKey = &ActualLRPInstanceKey{}
+			}
+			if err := m.ActualLrpInstanceKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1863,7 +4486,7 @@ func (m *ActualLRPGroupsResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ActualLRPGroupResponse) Unmarshal(data []byte) error {
+func (m *StartActualLRPRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1886,15 +4509,15 @@ func (m *ActualLRPGroupResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartActualLRPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1918,16 +4541,16 @@ func (m *ActualLRPGroupResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
+			if m.ActualLrpKey == nil {
+				m.ActualLrpKey = &ActualLRPKey{}
 			}
-			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.ActualLrpKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpInstanceKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1951,10 +4574,43 @@ func (m *ActualLRPGroupResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpGroup == nil {
-				m.ActualLrpGroup = &ActualLRPGroup{}
+			if m.ActualLrpInstanceKey == nil {
+				m.ActualLrpInstanceKey = &ActualLRPInstanceKey{}
 			}
-			if err := m.ActualLrpGroup.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.ActualLrpInstanceKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpNetInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrpNetInfo == nil {
+				m.ActualLrpNetInfo = &ActualLRPNetInfo{}
+			}
+			if err := m.ActualLrpNetInfo.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1979,7 +4635,7 @@ func (m *ActualLRPGroupResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ActualLRPGroupsRequest) Unmarshal(data []byte) error {
+func (m *CrashActualLRPRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2002,17 +4658,17 @@ func (m *ActualLRPGroupsRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPGroupsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CrashActualLRPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CrashActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowActualLrpRequests
@@ -2022,26 +4678,30 @@ func (m *ActualLRPGroupsRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthActualLrpRequests
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(data[iNdEx:postIndex])
+			if m.ActualLrpKey == nil {
+				m.ActualLrpKey = &ActualLRPKey{}
+			}
+			if err := m.ActualLrpKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpInstanceKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowActualLrpRequests
@@ -2051,74 +4711,28 @@ func (m *ActualLRPGroupsRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthActualLrpRequests
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellId = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipActualLrpRequests(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthActualLrpRequests
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActualLRPGroupsByProcessGuidRequest) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowActualLrpRequests
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ActualLrpInstanceKey == nil {
+				m.ActualLrpInstanceKey = &ActualLRPInstanceKey{}
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ActualLrpInstanceKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPGroupsByProcessGuidRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPGroupsByProcessGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2143,7 +4757,7 @@ func (m *ActualLRPGroupsByProcessGuidRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessGuid = string(data[iNdEx:postIndex])
+			m.ErrorMessage = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2166,7 +4780,7 @@ func (m *ActualLRPGroupsByProcessGuidRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Unmarshal(data []byte) error {
+func (m *FailActualLRPRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2189,17 +4803,17 @@ func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Unmarshal(data []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPGroupByProcessGuidAndIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailActualLRPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPGroupByProcessGuidAndIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowActualLrpRequests
@@ -2209,26 +4823,30 @@ func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Unmarshal(data []byte) erro
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthActualLrpRequests
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessGuid = string(data[iNdEx:postIndex])
+			if m.ActualLrpKey == nil {
+				m.ActualLrpKey = &ActualLRPKey{}
+			}
+			if err := m.ActualLrpKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
 			}
-			m.Index = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowActualLrpRequests
@@ -2238,11 +4856,21 @@ func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Unmarshal(data []byte) erro
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Index |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(data[iNdEx:])
@@ -2264,7 +4892,7 @@ func (m *ActualLRPGroupByProcessGuidAndIndexRequest) Unmarshal(data []byte) erro
 	}
 	return nil
 }
-func (m *ClaimActualLRPRequest) Unmarshal(data []byte) error {
+func (m *RetireActualLRPRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2283,67 +4911,19 @@ func (m *ClaimActualLRPRequest) Unmarshal(data []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimActualLRPRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowActualLrpRequests
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthActualLrpRequests
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProcessGuid = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowActualLrpRequests
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Index |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetireActualLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetireActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpInstanceKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2367,10 +4947,10 @@ func (m *ClaimActualLRPRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpInstanceKey == nil {
-				m.ActualLrpInstanceKey = &ActualLRPInstanceKey{}
+			if m.ActualLrpKey == nil {
+				m.ActualLrpKey = &ActualLRPKey{}
 			}
-			if err := m.ActualLrpInstanceKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.ActualLrpKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2395,7 +4975,7 @@ func (m *ClaimActualLRPRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *StartActualLRPRequest) Unmarshal(data []byte) error {
+func (m *RetireActualLRPsOnCellRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2418,17 +4998,17 @@ func (m *StartActualLRPRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartActualLRPRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetireActualLRPsOnCellRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetireActualLRPsOnCellRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowActualLrpRequests
@@ -2438,28 +5018,74 @@ func (m *StartActualLRPRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthActualLrpRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpKey == nil {
-				m.ActualLrpKey = &ActualLRPKey{}
-			}
-			if err := m.ActualLrpKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.CellId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpRequests(data[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if skippy < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetireActualLRPsOnCellResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetireActualLRPsOnCellResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetireActualLRPsOnCellResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpInstanceKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2483,18 +5109,18 @@ func (m *StartActualLRPRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpInstanceKey == nil {
-				m.ActualLrpInstanceKey = &ActualLRPInstanceKey{}
+			if m.Error == nil {
+				m.Error = &Error{}
 			}
-			if err := m.ActualLrpInstanceKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpNetInfo", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredCount", wireType)
 			}
-			var msglen int
+			m.RetiredCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowActualLrpRequests
@@ -2504,25 +5130,11 @@ func (m *StartActualLRPRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RetiredCount |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthActualLrpRequests
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ActualLrpNetInfo == nil {
-				m.ActualLrpNetInfo = &ActualLRPNetInfo{}
-			}
-			if err := m.ActualLrpNetInfo.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(data[iNdEx:])
@@ -2544,7 +5156,7 @@ func (m *StartActualLRPRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *CrashActualLRPRequest) Unmarshal(data []byte) error {
+func (m *StartActualLRPsRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2567,17 +5179,17 @@ func (m *CrashActualLRPRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CrashActualLRPRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartActualLRPsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CrashActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartActualLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowActualLrpRequests
@@ -2587,28 +5199,24 @@ func (m *CrashActualLRPRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthActualLrpRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpKey == nil {
-				m.ActualLrpKey = &ActualLRPKey{}
-			}
-			if err := m.ActualLrpKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CellId = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpInstanceKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2632,18 +5240,16 @@ func (m *CrashActualLRPRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpInstanceKey == nil {
-				m.ActualLrpInstanceKey = &ActualLRPInstanceKey{}
-			}
-			if err := m.ActualLrpInstanceKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.ActualLrps = append(m.ActualLrps, &StartActualLRPRequest{})
+			if err := m.ActualLrps[len(m.ActualLrps)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authoritative", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowActualLrpRequests
@@ -2653,21 +5259,12 @@ func (m *CrashActualLRPRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthActualLrpRequests
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ErrorMessage = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Authoritative = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(data[iNdEx:])
@@ -2689,7 +5286,7 @@ func (m *CrashActualLRPRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *FailActualLRPRequest) Unmarshal(data []byte) error {
+func (m *StartActualLRPsResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2712,15 +5309,15 @@ func (m *FailActualLRPRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FailActualLRPRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartActualLRPsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FailActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartActualLRPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2744,18 +5341,18 @@ func (m *FailActualLRPRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpKey == nil {
-				m.ActualLrpKey = &ActualLRPKey{}
+			if m.Error == nil {
+				m.Error = &Error{}
 			}
-			if err := m.ActualLrpKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedCount", wireType)
 			}
-			var stringLen uint64
+			m.StartedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowActualLrpRequests
@@ -2765,74 +5362,14 @@ func (m *FailActualLRPRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.StartedCount |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthActualLrpRequests
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ErrorMessage = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipActualLrpRequests(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthActualLrpRequests
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetireActualLRPRequest) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowActualLrpRequests
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetireActualLRPRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetireActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedActualLrpKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2856,13 +5393,30 @@ func (m *RetireActualLRPRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpKey == nil {
-				m.ActualLrpKey = &ActualLRPKey{}
-			}
-			if err := m.ActualLrpKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.RejectedActualLrpKeys = append(m.RejectedActualLrpKeys, &ActualLRPKey{})
+			if err := m.RejectedActualLrpKeys[len(m.RejectedActualLrpKeys)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedCount", wireType)
+			}
+			m.UnclaimedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.UnclaimedCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(data[iNdEx:])
@@ -3123,41 +5677,70 @@ var (
 func init() { proto.RegisterFile("actual_lrp_requests.proto", fileDescriptorActualLrpRequests) }
 
 var fileDescriptorActualLrpRequests = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0x33, 0xe9, 0xd7, 0x7e, 0x62, 0xd2, 0x96, 0x60, 0xd2, 0x34, 0x44, 0xc5, 0x54, 0xd3,
-	0x05, 0x05, 0x41, 0x2a, 0x75, 0xc9, 0x8a, 0x04, 0x41, 0x14, 0x35, 0x54, 0x95, 0x0b, 0x6b, 0xcb,
-	0xb5, 0x6f, 0xdc, 0x11, 0xb6, 0xc7, 0x9d, 0xb1, 0x11, 0x59, 0x20, 0x10, 0x4f, 0xc0, 0x63, 0xb0,
-	0x85, 0xa7, 0xe8, 0xb2, 0x12, 0x1b, 0x56, 0x88, 0x98, 0x0d, 0xcb, 0xf2, 0x06, 0xc8, 0x33, 0x8e,
-	0x9b, 0x3f, 0x2a, 0x52, 0x50, 0x16, 0xb0, 0xf3, 0x9c, 0x7b, 0xe7, 0x77, 0xce, 0xd5, 0xf5, 0xe0,
-	0x1b, 0x96, 0x1d, 0xc5, 0x96, 0x67, 0x7a, 0x3c, 0x34, 0x39, 0x9c, 0xc4, 0x20, 0x22, 0xd1, 0x08,
-	0x39, 0x8b, 0x98, 0xb6, 0xe4, 0x33, 0x07, 0x3c, 0x51, 0xbf, 0xef, 0xd2, 0xe8, 0x38, 0x3e, 0x6a,
-	0xd8, 0xcc, 0xdf, 0x71, 0x99, 0xcb, 0x76, 0x64, 0xf9, 0x28, 0xee, 0xc9, 0x93, 0x3c, 0xc8, 0x2f,
-	0x75, 0xad, 0x5e, 0xbe, 0x20, 0x66, 0x4a, 0x09, 0x38, 0x67, 0x5c, 0x1d, 0x48, 0x13, 0xd7, 0x9b,
-	0xb2, 0xa1, 0x6b, 0x1c, 0x74, 0x69, 0x0f, 0xec, 0xbe, 0xed, 0x81, 0x01, 0x22, 0x64, 0x81, 0x00,
-	0x6d, 0x0b, 0x2f, 0xca, 0xe6, 0x1a, 0xda, 0x44, 0xdb, 0xa5, 0xdd, 0x95, 0x86, 0xca, 0xd0, 0x78,
-	0x9c, 0x8a, 0x86, 0xaa, 0x91, 0x77, 0x08, 0xaf, 0xe7, 0x8c, 0x36, 0x67, 0x71, 0x28, 0x66, 0x02,
-	0x68, 0x2d, 0x7c, 0x6d, 0x64, 0x6c, 0x57, 0x12, 0x6a, 0xc5, 0xcd, 0x85, 0xed, 0xd2, 0x6e, 0x75,
-	0x78, 0x61, 0xdc, 0xc0, 0xb8, 0xaa, 0x2e, 0x74, 0x79, 0xa8, 0x0c, 0xc9, 0x1b, 0x5c, 0x9d, 0x68,
-	0x99, 0x29, 0xc2, 0x43, 0x5c, 0x9e, 0x8c, 0x50, 0x2b, 0xca, 0xfe, 0xcb, 0x12, 0xac, 0x8e, 0x27,
-	0x20, 0xcf, 0x27, 0x03, 0x08, 0x43, 0xed, 0x4f, 0xdb, 0xc0, 0x4b, 0x0e, 0xf3, 0x2d, 0x1a, 0xc8,
-	0x04, 0x57, 0x5a, 0xff, 0x9d, 0x7e, 0xbd, 0x55, 0x30, 0x32, 0x4d, 0xbb, 0x89, 0xff, 0xb7, 0xc1,
-	0xf3, 0x4c, 0xea, 0x48, 0xc3, 0xbc, 0x9c, 0x8a, 0x1d, 0x87, 0xec, 0xe3, 0xad, 0x09, 0x6c, 0xab,
-	0x7f, 0xc0, 0x99, 0x0d, 0x42, 0xb4, 0x63, 0xea, 0x0c, 0x3d, 0x6e, 0xe3, 0xe5, 0x50, 0xa9, 0xa6,
-	0x1b, 0x53, 0x67, 0xcc, 0xa9, 0x14, 0x5e, 0xf4, 0x93, 0x13, 0x7c, 0x77, 0x9c, 0x37, 0x86, 0x6b,
-	0x06, 0x4e, 0x27, 0x70, 0xe0, 0xd5, 0xac, 0x58, 0xad, 0x8e, 0x17, 0x69, 0x7a, 0x51, 0xce, 0xb0,
-	0x98, 0x75, 0x28, 0x89, 0x7c, 0x44, 0x78, 0xed, 0x91, 0x67, 0x51, 0x3f, 0x37, 0x9e, 0x27, 0x5e,
-	0x3b, 0xc4, 0xeb, 0x23, 0xab, 0xa3, 0x81, 0x88, 0xac, 0xc0, 0x06, 0xf3, 0x05, 0xf4, 0x6b, 0x0b,
-	0x72, 0x83, 0x1b, 0x53, 0x1b, 0xec, 0x64, 0x4d, 0x7b, 0xd0, 0x37, 0x2a, 0xf9, 0x1e, 0x47, 0x54,
-	0xf2, 0x13, 0xe1, 0xb5, 0xc3, 0xc8, 0xe2, 0xd1, 0x54, 0xe6, 0x07, 0x78, 0x75, 0xc4, 0x2e, 0x75,
-	0x51, 0xff, 0x55, 0x65, 0xca, 0x25, 0xa5, 0x2f, 0xe7, 0xf4, 0x3d, 0xe8, 0xff, 0x2e, 0x6a, 0xf1,
-	0x4f, 0xa3, 0x6a, 0x6d, 0x7c, 0x7d, 0x04, 0x1a, 0x40, 0x64, 0xd2, 0xa0, 0xc7, 0xb2, 0xd9, 0x6b,
-	0x53, 0xc0, 0x7d, 0x88, 0x3a, 0x41, 0x8f, 0x19, 0xe5, 0x1c, 0x96, 0x29, 0xe4, 0x73, 0xba, 0x27,
-	0x6e, 0x89, 0xe3, 0xbf, 0x7f, 0xe6, 0x3b, 0x78, 0x45, 0xbe, 0x5b, 0xd3, 0x07, 0x21, 0x2c, 0x17,
-	0xe4, 0xb4, 0xc3, 0x3f, 0x67, 0x59, 0x96, 0x9e, 0xaa, 0x0a, 0x79, 0x8d, 0x2b, 0x4f, 0x2c, 0xea,
-	0xcd, 0x75, 0xa6, 0x29, 0xfb, 0xe2, 0xa5, 0xf6, 0xcf, 0x70, 0xd5, 0x80, 0x88, 0x72, 0x98, 0x67,
-	0x00, 0xf2, 0x09, 0xa5, 0x58, 0x9f, 0xbd, 0x84, 0x7f, 0xe7, 0x4d, 0xb5, 0xee, 0x9d, 0x0d, 0xf4,
-	0xc2, 0x97, 0x81, 0x5e, 0x38, 0x1f, 0xe8, 0xe8, 0x6d, 0xa2, 0xa3, 0x0f, 0x89, 0x8e, 0x4e, 0x13,
-	0x1d, 0x9d, 0x25, 0x3a, 0xfa, 0x96, 0xe8, 0xe8, 0x47, 0xa2, 0x17, 0xce, 0x13, 0x1d, 0xbd, 0xff,
-	0xae, 0x17, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x81, 0x21, 0xf4, 0x0a, 0x07, 0x00, 0x00,
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x53, 0xdb, 0x46,
+	0x14, 0x67, 0x6d, 0xa0, 0xf0, 0x6c, 0xfe, 0x44, 0x01, 0x23, 0xdc, 0x20, 0x88, 0x72, 0x28, 0xc9,
+	0x10, 0xd2, 0xe1, 0xd0, 0x76, 0x72, 0x60, 0x8a, 0x99, 0x94, 0x21, 0x81, 0x94, 0x11, 0xed, 0x59,
+	0x23, 0xa4, 0xc5, 0x6c, 0x23, 0x69, 0x95, 0xdd, 0x15, 0x53, 0x1f, 0x32, 0xed, 0x47, 0xe8, 0xa9,
+	0x5f, 0xa0, 0x3d, 0xf4, 0xda, 0x7e, 0x8a, 0xdc, 0x9a, 0x99, 0x5e, 0x7a, 0xf2, 0x14, 0xf7, 0xd0,
+	0x0e, 0xa7, 0xf4, 0x1b, 0x74, 0x76, 0x25, 0xcb, 0x92, 0x6d, 0xda, 0x38, 0xc3, 0xa1, 0xbd, 0xe1,
+	0xf7, 0xde, 0xef, 0xf7, 0xfe, 0xfd, 0xf6, 0x21, 0x58, 0x76, 0x5c, 0x11, 0x3b, 0xbe, 0xed, 0xb3,
+	0xc8, 0x66, 0xf8, 0x79, 0x8c, 0xb9, 0xe0, 0x9b, 0x11, 0xa3, 0x82, 0x6a, 0x93, 0x01, 0xf5, 0xb0,
+	0xcf, 0xeb, 0xf7, 0x9b, 0x44, 0x9c, 0xc5, 0x27, 0x9b, 0x2e, 0x0d, 0x1e, 0x34, 0x69, 0x93, 0x3e,
+	0x50, 0xee, 0x93, 0xf8, 0x54, 0xfd, 0x52, 0x3f, 0xd4, 0x5f, 0x09, 0xac, 0x3e, 0xdf, 0x63, 0x4c,
+	0x2d, 0x15, 0xcc, 0x18, 0x65, 0xc9, 0x0f, 0x73, 0x07, 0xea, 0x3b, 0x2a, 0xe0, 0xc0, 0x3a, 0x3a,
+	0x20, 0xa7, 0xd8, 0x6d, 0xb9, 0x3e, 0xb6, 0x30, 0x8f, 0x68, 0xc8, 0xb1, 0x76, 0x07, 0x26, 0x54,
+	0xb0, 0x8e, 0xd6, 0xd0, 0x7a, 0x65, 0x6b, 0x66, 0x33, 0xa9, 0x61, 0xf3, 0x91, 0x34, 0x5a, 0x89,
+	0xcf, 0xfc, 0xb6, 0x0c, 0x4b, 0x19, 0xc7, 0x1e, 0xa3, 0x71, 0xc4, 0x47, 0x22, 0xd0, 0x1a, 0x70,
+	0x23, 0xd7, 0x76, 0x53, 0x31, 0xe8, 0xa5, 0xb5, 0xf2, 0x7a, 0x65, 0xab, 0xd6, 0x05, 0x14, 0x13,
+	0x58, 0x73, 0x09, 0xe0, 0x80, 0x45, 0x49, 0x42, 0x8d, 0x42, 0x95, 0x0b, 0x47, 0x60, 0xdb, 0xa5,
+	0x71, 0x28, 0xb8, 0x5e, 0x56, 0xf0, 0xf7, 0x87, 0xc3, 0xb3, 0xfa, 0x36, 0x8f, 0x25, 0x66, 0x57,
+	0x41, 0x1e, 0x85, 0x82, 0xb5, 0x1a, 0xf5, 0xcb, 0xf6, 0x6a, 0x2d, 0xcf, 0xb4, 0x41, 0x03, 0x22,
+	0x70, 0x10, 0x89, 0x96, 0x55, 0xe1, 0xbd, 0x68, 0xed, 0x73, 0x98, 0x61, 0xd8, 0xa5, 0xcc, 0xb3,
+	0x55, 0x13, 0x5c, 0x1f, 0x57, 0x19, 0x6f, 0x76, 0x33, 0x5a, 0xca, 0xa9, 0xfa, 0x6c, 0xbc, 0x7b,
+	0xd9, 0x5e, 0x5d, 0x2a, 0x44, 0xe7, 0x58, 0xab, 0xac, 0x17, 0xc9, 0xeb, 0x8f, 0x61, 0xbe, 0xbf,
+	0x26, 0xad, 0x06, 0xe5, 0x67, 0xb8, 0xa5, 0x46, 0x38, 0xdd, 0x18, 0x7f, 0xd9, 0x5e, 0x1d, 0xb3,
+	0xa4, 0x41, 0xab, 0xc3, 0xc4, 0xb9, 0xe3, 0xc7, 0x58, 0x2f, 0xad, 0xa1, 0xf5, 0x89, 0xd4, 0x93,
+	0x98, 0x1e, 0x96, 0x3e, 0x42, 0xe6, 0x57, 0x50, 0xeb, 0x1b, 0xdb, 0x48, 0x6b, 0xf9, 0x18, 0xe6,
+	0xfb, 0xd7, 0xa2, 0x32, 0x5d, 0xbd, 0x95, 0xd9, 0xe2, 0x56, 0xcc, 0x9f, 0x51, 0x7f, 0x05, 0xdc,
+	0x4a, 0x44, 0xad, 0xdd, 0x82, 0x49, 0x8f, 0x06, 0x0e, 0x09, 0x0b, 0x6d, 0xa5, 0x36, 0x6d, 0x05,
+	0xde, 0x71, 0xb1, 0xef, 0xdb, 0xc4, 0xd3, 0x4b, 0x79, 0xb7, 0x34, 0xee, 0x7b, 0xda, 0x06, 0x4c,
+	0xaa, 0x55, 0x24, 0x6b, 0x9e, 0x6e, 0x2c, 0x5c, 0xb6, 0x57, 0xe7, 0x13, 0x4b, 0x6e, 0xb0, 0x69,
+	0x8c, 0xf6, 0x18, 0xe6, 0x22, 0x87, 0x09, 0xe2, 0xf8, 0x36, 0xc3, 0x3c, 0xf6, 0x85, 0xdc, 0x15,
+	0x5a, 0x9f, 0x6a, 0xdc, 0x96, 0xa4, 0x97, 0xed, 0xd5, 0xe5, 0x3e, 0x77, 0x8e, 0x63, 0x36, 0x75,
+	0x59, 0x89, 0xc7, 0x7c, 0x0a, 0x77, 0xfa, 0x1a, 0x6a, 0xb4, 0x8e, 0x18, 0x75, 0x31, 0xe7, 0x7b,
+	0x31, 0xf1, 0xba, 0xdd, 0xbd, 0x07, 0xd5, 0x28, 0xb1, 0xda, 0xcd, 0x98, 0x78, 0x85, 0x1e, 0x2b,
+	0x51, 0x2f, 0xde, 0x7c, 0x0e, 0xf7, 0x8a, 0x7c, 0x05, 0xba, 0x9d, 0xd0, 0xdb, 0x0f, 0x3d, 0xfc,
+	0xe5, 0xa8, 0xb4, 0x52, 0x19, 0x44, 0x02, 0x8b, 0xca, 0x50, 0x26, 0xf3, 0x43, 0x58, 0xde, 0x65,
+	0x0e, 0x3f, 0x23, 0x61, 0x33, 0x4b, 0x9d, 0xad, 0xa5, 0x0e, 0x13, 0x3e, 0x09, 0x88, 0xd0, 0x51,
+	0x1e, 0xa8, 0x4c, 0x66, 0x00, 0x5a, 0x1e, 0x30, 0x8a, 0x94, 0xb6, 0xa0, 0xd2, 0x93, 0x52, 0xf7,
+	0x6d, 0xdf, 0x18, 0x50, 0x91, 0x05, 0x99, 0x80, 0xb8, 0xf9, 0x01, 0x2c, 0xee, 0x62, 0xdf, 0x3f,
+	0xf2, 0x1d, 0x17, 0x07, 0x38, 0x14, 0x59, 0x8d, 0x39, 0x71, 0xa0, 0x41, 0x71, 0x98, 0xdf, 0x23,
+	0x98, 0x29, 0x00, 0xff, 0x05, 0xf0, 0x36, 0xc5, 0x69, 0xb7, 0x61, 0x3a, 0xc0, 0x01, 0x65, 0x2d,
+	0x3b, 0x38, 0xd1, 0xcb, 0xb9, 0x59, 0x4d, 0x25, 0xe6, 0xc3, 0x13, 0x99, 0xd5, 0x23, 0xfc, 0x99,
+	0x0c, 0x18, 0xcf, 0x05, 0x4c, 0x4a, 0xe3, 0xe1, 0x89, 0xf9, 0x02, 0x6a, 0xfd, 0xed, 0x8d, 0x32,
+	0xd1, 0x6d, 0x98, 0x53, 0x3d, 0x45, 0x19, 0x3e, 0x2d, 0x7c, 0xb1, 0x1b, 0x5e, 0x60, 0xb7, 0x66,
+	0xdd, 0x42, 0x32, 0xf3, 0x47, 0x04, 0x8b, 0xbb, 0xbe, 0x43, 0x82, 0x5e, 0x7f, 0xd7, 0x28, 0x32,
+	0xed, 0x18, 0x96, 0x72, 0xb7, 0x83, 0x84, 0x5c, 0x38, 0xa1, 0x8b, 0x6d, 0x79, 0xc6, 0xca, 0xaa,
+	0xab, 0x5b, 0x03, 0xf3, 0xdd, 0x4f, 0x83, 0x9e, 0xe0, 0x96, 0xb5, 0x90, 0x8d, 0x3a, 0x67, 0x35,
+	0xff, 0x42, 0xb0, 0x78, 0x2c, 0x1c, 0x26, 0x06, 0x6a, 0x7e, 0x08, 0xb3, 0xb9, 0x74, 0xdd, 0x63,
+	0x59, 0xd9, 0x5a, 0x18, 0xc8, 0x22, 0xd9, 0xab, 0x19, 0xfb, 0x13, 0xdc, 0xfa, 0xa7, 0x52, 0x4b,
+	0x6f, 0x5b, 0xaa, 0xb6, 0x07, 0x37, 0x73, 0xa4, 0x21, 0x16, 0x36, 0x09, 0x4f, 0x69, 0xda, 0xbb,
+	0x3e, 0x40, 0xf8, 0x14, 0x8b, 0xfd, 0xf0, 0x94, 0x5a, 0xf3, 0x19, 0x59, 0x6a, 0x31, 0x7f, 0x91,
+	0x7b, 0x92, 0xcf, 0xf5, 0xbf, 0xdf, 0xf3, 0x5d, 0x98, 0x51, 0xda, 0xb4, 0x03, 0xcc, 0xb9, 0xd3,
+	0xc4, 0x7a, 0x39, 0xa7, 0x9c, 0xaa, 0x72, 0x1d, 0x26, 0x1e, 0xf3, 0x05, 0x2c, 0x7c, 0xe2, 0x10,
+	0xff, 0x5a, 0x7b, 0x1a, 0x48, 0x5f, 0xba, 0x32, 0xfd, 0x67, 0x50, 0xb3, 0xb0, 0x20, 0x0c, 0x5f,
+	0x67, 0x01, 0xe6, 0x36, 0xac, 0xf4, 0xb1, 0xf2, 0x4f, 0x43, 0xf9, 0x0a, 0xdf, 0xf0, 0x70, 0x45,
+	0x60, 0x5c, 0x85, 0x1f, 0xe5, 0x32, 0xdc, 0x95, 0x1f, 0x26, 0x92, 0xc6, 0x4b, 0xbe, 0x60, 0x0a,
+	0xcf, 0xb3, 0x9a, 0xba, 0xd4, 0xe7, 0x85, 0xf9, 0x1d, 0x82, 0x5a, 0xf1, 0x41, 0xbd, 0xe1, 0x91,
+	0xd5, 0xb6, 0x87, 0xdd, 0xcc, 0x95, 0x6e, 0x3d, 0x43, 0x1f, 0x69, 0xe1, 0x7e, 0xde, 0x83, 0x19,
+	0x27, 0x16, 0x67, 0x94, 0x11, 0xe1, 0x08, 0x72, 0x9e, 0x68, 0x65, 0x2a, 0x4d, 0x52, 0x74, 0x99,
+	0x7f, 0x20, 0x58, 0x1a, 0xa8, 0x72, 0xc4, 0x89, 0x70, 0x89, 0x1f, 0x3e, 0x91, 0xd4, 0xa5, 0x26,
+	0xa2, 0x1d, 0x82, 0xce, 0xf0, 0x17, 0xd8, 0x95, 0xb1, 0x45, 0x21, 0x74, 0x3f, 0x29, 0x87, 0x2b,
+	0x61, 0xb1, 0x8b, 0xda, 0xc9, 0x29, 0x82, 0x6b, 0xf7, 0x61, 0x2e, 0x0e, 0x5d, 0x79, 0x66, 0xb3,
+	0xdc, 0xf9, 0xff, 0x05, 0xb3, 0x99, 0x33, 0xd9, 0xc7, 0x4f, 0x48, 0x0a, 0x33, 0xa0, 0xe7, 0xf8,
+	0xff, 0x73, 0x95, 0x1b, 0x1b, 0xaf, 0x2e, 0x8c, 0xb1, 0x5f, 0x2f, 0x8c, 0xb1, 0xd7, 0x17, 0x06,
+	0xfa, 0xba, 0x63, 0xa0, 0x1f, 0x3a, 0x06, 0x7a, 0xd9, 0x31, 0xd0, 0xab, 0x8e, 0x81, 0x7e, 0xeb,
+	0x18, 0xe8, 0xcf, 0x8e, 0x31, 0xf6, 0xba, 0x63, 0xa0, 0x6f, 0x7e, 0x37, 0xc6, 0xfe, 0x1e, 0x00,
+	0xef, 0x67, 0xd4, 0xb9, 0xe1, 0x0c, 0x00, 0x00,
 }
@@ -21,6 +21,26 @@ var _ = Describe("ActualLRP Requests", func() {
 					Expect(request.Validate()).To(BeNil())
 				})
 			})
+
+			Context("when filtering by valid states", func() {
+				BeforeEach(func() {
+					request.States = []string{models.ActualLRPStateCrashed, models.ActualLRPStateUnclaimed}
+				})
+
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when filtering by an unknown state", func() {
+				BeforeEach(func() {
+					request.States = []string{models.ActualLRPStateCrashed, "EXPLODED"}
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"states"}))
+				})
+			})
 		})
 	})
 
@@ -52,6 +72,42 @@ var _ = Describe("ActualLRP Requests", func() {
 		})
 	})
 
+	Describe("CrashingActualLRPsRequest", func() {
+		Describe("Validate", func() {
+			var request models.CrashingActualLRPsRequest
+
+			BeforeEach(func() {
+				request = models.CrashingActualLRPsRequest{}
+			})
+
+			Context("when the limit is not set", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the limit is positive", func() {
+				BeforeEach(func() {
+					request.Limit = 10
+				})
+
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the limit is negative", func() {
+				BeforeEach(func() {
+					request.Limit = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"limit"}))
+				})
+			})
+		})
+	})
+
 	Describe("ActualLRPGroupByProcessGuidAndIndexRequest", func() {
 		Describe("Validate", func() {
 			var request models.ActualLRPGroupByProcessGuidAndIndexRequest
@@ -358,6 +414,115 @@ var _ = Describe("ActualLRP Requests", func() {
 		})
 	})
 
+	Describe("RetireActualLRPsOnCellRequest", func() {
+		Describe("Validate", func() {
+			var request models.RetireActualLRPsOnCellRequest
+
+			BeforeEach(func() {
+				request = models.RetireActualLRPsOnCellRequest{
+					CellId: "cell-id",
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the CellId is blank", func() {
+				BeforeEach(func() {
+					request.CellId = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"cell_id"}))
+				})
+			})
+		})
+	})
+
+	Describe("StartActualLRPsRequest", func() {
+		Describe("Validate", func() {
+			var request models.StartActualLRPsRequest
+
+			newStart := func(index int32, cellID string) *models.StartActualLRPRequest {
+				key := models.NewActualLRPKey("process-guid", index, "domain")
+				instanceKey := models.NewActualLRPInstanceKey("instance-guid", cellID)
+				netInfo := models.NewActualLRPNetInfo("1.2.3.4")
+				return &models.StartActualLRPRequest{
+					ActualLrpKey:         &key,
+					ActualLrpInstanceKey: &instanceKey,
+					ActualLrpNetInfo:     &netInfo,
+				}
+			}
+
+			BeforeEach(func() {
+				request = models.StartActualLRPsRequest{
+					CellId:     "cell-id",
+					ActualLrps: []*models.StartActualLRPRequest{newStart(0, "cell-id"), newStart(1, "cell-id")},
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when it reports no LRPs", func() {
+				BeforeEach(func() {
+					request.ActualLrps = nil
+				})
+
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the CellId is blank", func() {
+				BeforeEach(func() {
+					request.CellId = ""
+					request.ActualLrps = nil
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"cell_id"}))
+				})
+			})
+
+			Context("when a reported LRP is invalid", func() {
+				BeforeEach(func() {
+					request.ActualLrps[1].ActualLrpNetInfo = nil
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"actual_lrp_net_info"}))
+				})
+			})
+
+			Context("when a reported LRP is on another cell", func() {
+				BeforeEach(func() {
+					request.ActualLrps[1] = newStart(1, "other-cell-id")
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"actual_lrp_instance_key.cell_id"}))
+				})
+			})
+
+			Context("when an LRP is reported twice", func() {
+				BeforeEach(func() {
+					request.ActualLrps[1] = newStart(0, "cell-id")
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"actual_lrps"}))
+				})
+			})
+		})
+	})
+
 	Describe("FailActualLRPRequest", func() {
 		Describe("Validate", func() {
 			var request models.FailActualLRPRequest
@@ -192,6 +192,90 @@ var _ = Describe("ActualLRP", func() {
 		})
 	})
 
+	Describe("ShouldRetireOrphan", func() {
+		var (
+			actual models.ActualLRP
+			now    time.Time
+		)
+
+		BeforeEach(func() {
+			now = time.Unix(1000, 0)
+			actual = models.ActualLRP{ActualLRPKey: models.NewActualLRPKey("p-guid", 0, "domain")}
+		})
+
+		It("retires the orphan right away without a grace period", func() {
+			Expect(actual.ShouldRetireOrphan(now, 0)).To(BeTrue())
+		})
+
+		It("does not retire an orphan that has not been marked orphaned", func() {
+			Expect(actual.ShouldRetireOrphan(now, time.Minute)).To(BeFalse())
+		})
+
+		It("does not retire the orphan within the grace period", func() {
+			actual.OrphanedSince = now.Add(-30 * time.Second).UnixNano()
+			Expect(actual.ShouldRetireOrphan(now, time.Minute)).To(BeFalse())
+		})
+
+		It("retires the orphan once the grace period has passed", func() {
+			actual.OrphanedSince = now.Add(-time.Minute).UnixNano()
+			Expect(actual.ShouldRetireOrphan(now, time.Minute)).To(BeTrue())
+		})
+	})
+
+	Describe("RecordPlacement", func() {
+		var actual *models.ActualLRP
+
+		BeforeEach(func() {
+			actual = &models.ActualLRP{ActualLRPKey: models.NewActualLRPKey("p-guid", 0, "domain")}
+		})
+
+		It("appends the placement to the history", func() {
+			actual.RecordPlacement("cell-1", 1, models.PlacementReasonClaimed, 3)
+			actual.RecordPlacement("cell-1", 2, models.PlacementReasonStarted, 3)
+
+			Expect(actual.PlacementHistory).To(Equal([]*models.ActualLRPPlacement{
+				{CellId: "cell-1", Timestamp: 1, Reason: models.PlacementReasonClaimed},
+				{CellId: "cell-1", Timestamp: 2, Reason: models.PlacementReasonStarted},
+			}))
+		})
+
+		It("drops the oldest placements once the history is full", func() {
+			for i := 1; i <= 5; i++ {
+				actual.RecordPlacement(fmt.Sprintf("cell-%d", i), int64(i), models.PlacementReasonClaimed, 3)
+			}
+
+			Expect(actual.PlacementHistory).To(Equal([]*models.ActualLRPPlacement{
+				{CellId: "cell-3", Timestamp: 3, Reason: models.PlacementReasonClaimed},
+				{CellId: "cell-4", Timestamp: 4, Reason: models.PlacementReasonClaimed},
+				{CellId: "cell-5", Timestamp: 5, Reason: models.PlacementReasonClaimed},
+			}))
+		})
+
+		It("trims a history longer than a newly configured length", func() {
+			for i := 1; i <= 5; i++ {
+				actual.RecordPlacement("cell", int64(i), models.PlacementReasonStarted, 5)
+			}
+
+			actual.RecordPlacement("cell", 6, models.PlacementReasonCrashed, 2)
+			Expect(actual.PlacementHistory).To(HaveLen(2))
+			Expect(actual.PlacementHistory[1].Reason).To(Equal(models.PlacementReasonCrashed))
+		})
+
+		It("caps the length at the maximum", func() {
+			for i := 0; i < models.MaximumPlacementHistoryLength+10; i++ {
+				actual.RecordPlacement("cell", int64(i), models.PlacementReasonStarted, 1000)
+			}
+
+			Expect(actual.PlacementHistory).To(HaveLen(models.MaximumPlacementHistoryLength))
+			Expect(actual.PlacementHistory[0].Timestamp).To(BeEquivalentTo(10))
+		})
+
+		It("records nothing when the length is 0", func() {
+			actual.RecordPlacement("cell", 1, models.PlacementReasonClaimed, 0)
+			Expect(actual.PlacementHistory).To(BeEmpty())
+		})
+	})
+
 	Describe("ActualLRPKey", func() {
 		Describe("Validate", func() {
 			var actualLRPKey models.ActualLRPKey
@@ -1,6 +1,10 @@
 package models
 
-import "net/url"
+import (
+	"net/url"
+	"sort"
+	"time"
+)
 
 type BBSPresence struct {
 	ID  string `json:"id"`
@@ -36,3 +40,27 @@ func (p BBSPresence) Validate() error {
 
 	return nil
 }
+
+// NewBBSPresenceStatus reports a BBS presence along with the session or
+// owner holding it in the store.
+func NewBBSPresenceStatus(presence *BBSPresence, sessionID, sessionName string, ttl time.Duration, expiresAt int64) *BBSPresenceStatus {
+	return &BBSPresenceStatus{
+		Id:          presence.ID,
+		Url:         presence.URL,
+		SessionId:   sessionID,
+		SessionName: sessionName,
+		Ttl:         int64(ttl),
+		ExpiresAt:   expiresAt,
+	}
+}
+
+// SortBBSPresenceStatuses orders the statuses by BBS ID.
+func SortBBSPresenceStatuses(statuses []*BBSPresenceStatus) {
+	sort.Sort(bbsPresenceStatusesByID(statuses))
+}
+
+type bbsPresenceStatusesByID []*BBSPresenceStatus
+
+func (s bbsPresenceStatusesByID) Len() int           { return len(s) }
+func (s bbsPresenceStatusesByID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s bbsPresenceStatusesByID) Less(i, j int) bool { return s[i].Id < s[j].Id }
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type CellSet map[string]*CellPresence
 
 func NewCellSet() CellSet {
@@ -145,3 +147,20 @@ func (c *CellPresence) Copy() *CellPresence {
 	newCellPresense := *c
 	return &newCellPresense
 }
+
+// NewCellPresenceStatus reports a cell presence registered for ttl that
+// expires at expiresAt, in nanoseconds. A cell refreshes its presence many
+// times per TTL, so the time it has left normally stays close to the whole
+// TTL. The presence is stale once less than half its TTL is left, which means
+// it has missed refreshes and expires soon unless the cell catches up. A zero
+// ttl or expiresAt means the figure is not known, and the presence is then
+// never stale.
+func NewCellPresenceStatus(presence *CellPresence, ttl time.Duration, expiresAt int64, now time.Time) *CellPresenceStatus {
+	remaining := time.Duration(expiresAt - now.UnixNano())
+	return &CellPresenceStatus{
+		Presence:  presence,
+		Ttl:       int64(ttl),
+		ExpiresAt: expiresAt,
+		Stale:     ttl > 0 && expiresAt > 0 && remaining < ttl/2,
+	}
+}
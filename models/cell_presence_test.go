@@ -1,6 +1,8 @@
 package models_test
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/bbs/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -26,6 +28,29 @@ var _ = Describe("CellPresence", func() {
 		}
 	})
 
+	Describe("NewCellPresenceStatus", func() {
+		const ttl = 10 * time.Second
+		now := time.Unix(1000, 0)
+
+		It("reports the ttl and expiry of the presence", func() {
+			status := models.NewCellPresenceStatus(&cellPresence, ttl, now.Add(8*time.Second).UnixNano(), now)
+			Expect(status.Presence).To(Equal(&cellPresence))
+			Expect(status.Ttl).To(Equal(int64(ttl)))
+			Expect(status.ExpiresAt).To(Equal(now.Add(8 * time.Second).UnixNano()))
+			Expect(status.Stale).To(BeFalse())
+		})
+
+		It("is stale when less than half the ttl is left", func() {
+			status := models.NewCellPresenceStatus(&cellPresence, ttl, now.Add(4*time.Second).UnixNano(), now)
+			Expect(status.Stale).To(BeTrue())
+		})
+
+		It("is not stale when the ttl or expiry is unknown", func() {
+			Expect(models.NewCellPresenceStatus(&cellPresence, 0, now.UnixNano(), now).Stale).To(BeFalse())
+			Expect(models.NewCellPresenceStatus(&cellPresence, ttl, 0, now).Stale).To(BeFalse())
+		})
+	})
+
 	Describe("Validate", func() {
 		Context("when cell presence is valid", func() {
 			It("does not return an error", func() {
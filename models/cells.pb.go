@@ -162,11 +162,77 @@ func (m *CellsResponse) GetCells() []*CellPresence {
 	return nil
 }
 
+type CellPresenceStatus struct {
+	Presence  *CellPresence `protobuf:"bytes,1,opt,name=presence" json:"presence,omitempty"`
+	Ttl       int64         `protobuf:"varint,2,opt,name=ttl" json:"ttl"`
+	ExpiresAt int64         `protobuf:"varint,3,opt,name=expires_at,json=expiresAt" json:"expires_at"`
+	Stale     bool          `protobuf:"varint,4,opt,name=stale" json:"stale"`
+}
+
+func (m *CellPresenceStatus) Reset()                    { *m = CellPresenceStatus{} }
+func (*CellPresenceStatus) ProtoMessage()               {}
+func (*CellPresenceStatus) Descriptor() ([]byte, []int) { return fileDescriptorCells, []int{4} }
+
+func (m *CellPresenceStatus) GetPresence() *CellPresence {
+	if m != nil {
+		return m.Presence
+	}
+	return nil
+}
+
+func (m *CellPresenceStatus) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *CellPresenceStatus) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *CellPresenceStatus) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+type CellPresenceStatusesResponse struct {
+	Error    *Error                `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Statuses []*CellPresenceStatus `protobuf:"bytes,2,rep,name=statuses" json:"statuses,omitempty"`
+}
+
+func (m *CellPresenceStatusesResponse) Reset()      { *m = CellPresenceStatusesResponse{} }
+func (*CellPresenceStatusesResponse) ProtoMessage() {}
+func (*CellPresenceStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorCells, []int{5}
+}
+
+func (m *CellPresenceStatusesResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *CellPresenceStatusesResponse) GetStatuses() []*CellPresenceStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CellCapacity)(nil), "models.CellCapacity")
 	proto.RegisterType((*CellPresence)(nil), "models.CellPresence")
 	proto.RegisterType((*Provider)(nil), "models.Provider")
 	proto.RegisterType((*CellsResponse)(nil), "models.CellsResponse")
+	proto.RegisterType((*CellPresenceStatus)(nil), "models.CellPresenceStatus")
+	proto.RegisterType((*CellPresenceStatusesResponse)(nil), "models.CellPresenceStatusesResponse")
 }
 func (this *CellCapacity) Equal(that interface{}) bool {
 	if that == nil {
@@ -343,6 +409,83 @@ func (this *CellsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CellPresenceStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*CellPresenceStatus)
+	if !ok {
+		that2, ok := that.(CellPresenceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Presence.Equal(that1.Presence) {
+		return false
+	}
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if this.Stale != that1.Stale {
+		return false
+	}
+	return true
+}
+func (this *CellPresenceStatusesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*CellPresenceStatusesResponse)
+	if !ok {
+		that2, ok := that.(CellPresenceStatusesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Statuses) != len(that1.Statuses) {
+		return false
+	}
+	for i := range this.Statuses {
+		if !this.Statuses[i].Equal(that1.Statuses[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *CellCapacity) GoString() string {
 	if this == nil {
 		return "nil"
@@ -407,6 +550,36 @@ func (this *CellsResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *CellPresenceStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.CellPresenceStatus{")
+	if this.Presence != nil {
+		s = append(s, "Presence: "+fmt.Sprintf("%#v", this.Presence)+",\n")
+	}
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "Stale: "+fmt.Sprintf("%#v", this.Stale)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellPresenceStatusesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CellPresenceStatusesResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Statuses != nil {
+		s = append(s, "Statuses: "+fmt.Sprintf("%#v", this.Statuses)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringCells(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -619,6 +792,88 @@ func (m *CellsResponse) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *CellPresenceStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CellPresenceStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Presence != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintCells(data, i, uint64(m.Presence.Size()))
+		n3, err := m.Presence.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	data[i] = 0x10
+	i++
+	i = encodeVarintCells(data, i, uint64(m.Ttl))
+	data[i] = 0x18
+	i++
+	i = encodeVarintCells(data, i, uint64(m.ExpiresAt))
+	data[i] = 0x20
+	i++
+	if m.Stale {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *CellPresenceStatusesResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CellPresenceStatusesResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintCells(data, i, uint64(m.Error.Size()))
+		n4, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Statuses) > 0 {
+		for _, msg := range m.Statuses {
+			data[i] = 0x12
+			i++
+			i = encodeVarintCells(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeFixed64Cells(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
 	data[offset+1] = uint8(v >> 8)
@@ -719,6 +974,35 @@ func (m *CellsResponse) Size() (n int) {
 	return n
 }
 
+func (m *CellPresenceStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Presence != nil {
+		l = m.Presence.Size()
+		n += 1 + l + sovCells(uint64(l))
+	}
+	n += 1 + sovCells(uint64(m.Ttl))
+	n += 1 + sovCells(uint64(m.ExpiresAt))
+	n += 2
+	return n
+}
+
+func (m *CellPresenceStatusesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCells(uint64(l))
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovCells(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovCells(x uint64) (n int) {
 	for {
 		n++
@@ -782,6 +1066,30 @@ func (this *CellsResponse) String() string {
 	}, "")
 	return s
 }
+func (this *CellPresenceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellPresenceStatus{`,
+		`Presence:` + strings.Replace(fmt.Sprintf("%v", this.Presence), "CellPresence", "CellPresence", 1) + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`Stale:` + fmt.Sprintf("%v", this.Stale) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellPresenceStatusesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellPresenceStatusesResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Statuses:` + strings.Replace(fmt.Sprintf("%v", this.Statuses), "CellPresenceStatus", "CellPresenceStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringCells(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1378,6 +1686,261 @@ func (m *CellsResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *CellPresenceStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCells
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellPresenceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellPresenceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCells
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Presence == nil {
+				m.Presence = &CellPresence{}
+			}
+			if err := m.Presence.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ttl |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCells(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCells
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellPresenceStatusesResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCells
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellPresenceStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellPresenceStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCells
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCells
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &CellPresenceStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCells(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCells
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCells(data []byte) (n int, err error) {
 	l := len(data)
 	iNdEx := 0
@@ -1486,37 +2049,43 @@ var (
 func init() { proto.RegisterFile("cells.proto", fileDescriptorCells) }
 
 var fileDescriptorCells = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x92, 0x8f, 0x26, 0x6b, 0x02, 0xd5, 0xaa, 0x08, 0xab, 0x82, 0x4d, 0x1a, 0xa8, 0x14,
-	0xa1, 0x92, 0xa2, 0x9c, 0xb8, 0x92, 0x88, 0x03, 0x87, 0x4a, 0x91, 0xc5, 0x15, 0x8c, 0x63, 0x4f,
-	0xcd, 0x0a, 0xdb, 0xbb, 0xda, 0xdd, 0x56, 0x0a, 0x27, 0x7e, 0x02, 0x7f, 0x02, 0x89, 0x9f, 0xd2,
-	0x63, 0x8f, 0x9c, 0x2c, 0x62, 0x2e, 0x28, 0xa7, 0xfe, 0x04, 0xb4, 0xeb, 0xba, 0xb8, 0x91, 0x7a,
-	0xcb, 0xbc, 0xf7, 0xe6, 0xbd, 0xcc, 0xcc, 0x1a, 0x3b, 0x21, 0x24, 0x89, 0x9a, 0x08, 0xc9, 0x35,
-	0x27, 0x9d, 0x94, 0x47, 0x90, 0xa8, 0xfd, 0x97, 0x31, 0xd3, 0x9f, 0xcf, 0x96, 0x93, 0x90, 0xa7,
-	0xc7, 0x31, 0x8f, 0xf9, 0xb1, 0xa5, 0x97, 0x67, 0xa7, 0xb6, 0xb2, 0x85, 0xfd, 0x55, 0xb6, 0xed,
-	0x3b, 0x20, 0x25, 0x97, 0x65, 0x31, 0x3a, 0xc7, 0xf7, 0xe7, 0x90, 0x24, 0xf3, 0x40, 0x04, 0x21,
-	0xd3, 0x2b, 0x72, 0x80, 0x7b, 0x29, 0xa4, 0x5c, 0xae, 0xfc, 0x74, 0xe9, 0xa2, 0x21, 0x1a, 0xb7,
-	0x67, 0xad, 0x8b, 0x7c, 0xd0, 0xf0, 0xba, 0x25, 0x7c, 0xb2, 0x24, 0x4f, 0xf1, 0x4e, 0xc4, 0xd4,
-	0x17, 0x23, 0xb8, 0x57, 0x13, 0x74, 0x0c, 0x78, 0xb2, 0x24, 0xcf, 0x31, 0x0e, 0x79, 0xa6, 0x03,
-	0x96, 0x81, 0x54, 0x6e, 0xb3, 0xa6, 0xa8, 0xe1, 0xa3, 0x1f, 0xcd, 0x32, 0x78, 0x21, 0x41, 0x41,
-	0x16, 0x82, 0x71, 0x35, 0xb3, 0xf9, 0x2c, 0xb2, 0xb1, 0xbd, 0xca, 0xd5, 0x80, 0xef, 0x22, 0x72,
-	0x88, 0x1d, 0x09, 0xc2, 0x0f, 0xa2, 0x48, 0x82, 0x52, 0x36, 0xb8, 0x92, 0x60, 0x09, 0xe2, 0x4d,
-	0x89, 0x13, 0x17, 0xb7, 0xbe, 0xf2, 0x0c, 0x6c, 0x6c, 0xc5, 0x5b, 0x84, 0xbc, 0xc2, 0xdd, 0xf0,
-	0x7a, 0x48, 0xb7, 0x35, 0x44, 0x63, 0x67, 0xba, 0x37, 0x29, 0xf7, 0x37, 0xa9, 0x2f, 0xc0, 0xbb,
-	0x51, 0x11, 0x1f, 0xef, 0x4a, 0xce, 0xf5, 0xa9, 0xf2, 0x85, 0xe4, 0xe7, 0x2c, 0x32, 0xe3, 0xb4,
-	0x87, 0xcd, 0xb1, 0x33, 0xdd, 0xad, 0x3a, 0x17, 0xd7, 0xc4, 0x6c, 0xb4, 0xc9, 0x07, 0x74, 0x4b,
-	0xed, 0x27, 0x4c, 0xe9, 0x23, 0x9e, 0x32, 0x0d, 0xa9, 0xd0, 0x2b, 0xef, 0x61, 0xc9, 0x57, 0x3d,
-	0x8a, 0xcc, 0xf1, 0x03, 0x91, 0x04, 0x21, 0xa4, 0x90, 0x69, 0x5f, 0x07, 0xb1, 0x72, 0x3b, 0xc3,
-	0xe6, 0xb8, 0x37, 0x7b, 0xb2, 0xc9, 0x07, 0xee, 0x6d, 0xa6, 0x66, 0xd3, 0xbf, 0x61, 0xde, 0x07,
-	0xb1, 0x22, 0x1f, 0xf0, 0x63, 0x2e, 0x34, 0xe3, 0x59, 0x90, 0xf8, 0x5b, 0x6e, 0x3b, 0xd6, 0xed,
-	0x70, 0x93, 0x0f, 0x0e, 0xee, 0x90, 0xd4, 0x6c, 0x1f, 0x55, 0x92, 0x45, 0xdd, 0x7e, 0xf4, 0x11,
-	0x77, 0xab, 0x3f, 0x6c, 0x96, 0x9b, 0x05, 0x29, 0xdc, 0xba, 0x8f, 0x45, 0xc8, 0x6b, 0x8c, 0x85,
-	0xe4, 0x02, 0xa4, 0x66, 0x60, 0x8e, 0x63, 0x72, 0xdd, 0x4d, 0x3e, 0xd8, 0xfb, 0x8f, 0xd6, 0xa2,
-	0x6a, 0xda, 0xd1, 0x27, 0xdc, 0x37, 0xeb, 0x57, 0x1e, 0x28, 0xc1, 0x33, 0x05, 0xe4, 0x19, 0x6e,
-	0xdb, 0xf7, 0x69, 0x53, 0x9c, 0x69, 0xbf, 0x5a, 0xf5, 0x5b, 0x03, 0x7a, 0x25, 0x47, 0x5e, 0xe0,
-	0xb6, 0xfd, 0x10, 0x6c, 0xd4, 0xd6, 0x25, 0xab, 0x17, 0xe5, 0x95, 0x92, 0xd9, 0xd1, 0xe5, 0x9a,
-	0x36, 0x7e, 0xad, 0x69, 0xe3, 0x6a, 0x4d, 0xd1, 0xb7, 0x82, 0xa2, 0x9f, 0x05, 0x45, 0x17, 0x05,
-	0x45, 0x97, 0x05, 0x45, 0xbf, 0x0b, 0x8a, 0xfe, 0x16, 0xb4, 0x71, 0x55, 0x50, 0xf4, 0xfd, 0x0f,
-	0x6d, 0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe8, 0xcd, 0x44, 0xeb, 0x61, 0x03, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0x8d, 0xeb, 0xa6, 0x4d, 0x26, 0x5f, 0x3f, 0xaa, 0x55, 0x01, 0x2b, 0x2a, 0x4e, 0xea, 0x52,
+	0x29, 0x42, 0x25, 0x45, 0x3d, 0x20, 0xae, 0x4d, 0xc5, 0x81, 0x43, 0xa5, 0xca, 0x70, 0x05, 0xe3,
+	0xd8, 0xd3, 0x60, 0x61, 0x7b, 0x57, 0xbb, 0xdb, 0x8a, 0xc2, 0x85, 0x9f, 0xc0, 0x3f, 0xe0, 0x84,
+	0xc4, 0x4f, 0xe9, 0xb1, 0x47, 0x4e, 0x11, 0x35, 0x17, 0x94, 0x53, 0x7f, 0x02, 0xda, 0xb5, 0x1d,
+	0xb6, 0xa5, 0x1c, 0xb8, 0x79, 0xdf, 0x7b, 0xf3, 0x66, 0xe7, 0xed, 0x18, 0x3a, 0x11, 0xa6, 0xa9,
+	0x18, 0x32, 0x4e, 0x25, 0x25, 0x4b, 0x19, 0x8d, 0x31, 0x15, 0xdd, 0x87, 0x93, 0x44, 0xbe, 0x39,
+	0x1e, 0x0f, 0x23, 0x9a, 0xed, 0x4c, 0xe8, 0x84, 0xee, 0x68, 0x7a, 0x7c, 0x7c, 0xa4, 0x4f, 0xfa,
+	0xa0, 0xbf, 0xca, 0xb2, 0x6e, 0x07, 0x39, 0xa7, 0xbc, 0x3c, 0x78, 0x27, 0xf0, 0xdf, 0x3e, 0xa6,
+	0xe9, 0x7e, 0xc8, 0xc2, 0x28, 0x91, 0xa7, 0x64, 0x03, 0xda, 0x19, 0x66, 0x94, 0x9f, 0x06, 0xd9,
+	0xd8, 0xb1, 0xfa, 0xd6, 0xa0, 0x39, 0x5a, 0x3c, 0x9b, 0xf6, 0x1a, 0x7e, 0xab, 0x84, 0x0f, 0xc6,
+	0xe4, 0x1e, 0x2c, 0xc7, 0x89, 0x78, 0xab, 0x04, 0x0b, 0x86, 0x60, 0x49, 0x81, 0x07, 0x63, 0x72,
+	0x1f, 0x20, 0xa2, 0xb9, 0x0c, 0x93, 0x1c, 0xb9, 0x70, 0x6c, 0x43, 0x61, 0xe0, 0xde, 0x17, 0xbb,
+	0x6c, 0x7c, 0xc8, 0x51, 0x60, 0x1e, 0xa1, 0x72, 0x55, 0xb3, 0x05, 0x49, 0xac, 0xdb, 0xb6, 0x6b,
+	0x57, 0x05, 0x3e, 0x8b, 0xc9, 0x16, 0x74, 0x38, 0xb2, 0x20, 0x8c, 0x63, 0x8e, 0x42, 0x38, 0x0b,
+	0x86, 0x04, 0x38, 0xb2, 0xbd, 0x12, 0x27, 0x0e, 0x2c, 0xbe, 0xa7, 0x39, 0x3a, 0xb6, 0xc1, 0x6b,
+	0x84, 0x3c, 0x82, 0x56, 0x54, 0x0d, 0xe9, 0x2c, 0xf6, 0xad, 0x41, 0x67, 0x77, 0x6d, 0x58, 0xe6,
+	0x37, 0x34, 0x03, 0xf0, 0xe7, 0x2a, 0x12, 0xc0, 0x2a, 0xa7, 0x54, 0x1e, 0x89, 0x80, 0x71, 0x7a,
+	0x92, 0xc4, 0x6a, 0x9c, 0x66, 0xdf, 0x1e, 0x74, 0x76, 0x57, 0xeb, 0xca, 0xc3, 0x8a, 0x18, 0x79,
+	0xb3, 0x69, 0xcf, 0xbd, 0xa6, 0x0e, 0xd2, 0x44, 0xc8, 0x6d, 0x9a, 0x25, 0x12, 0x33, 0x26, 0x4f,
+	0xfd, 0x5b, 0x25, 0x5f, 0xd7, 0x08, 0xb2, 0x0f, 0xff, 0xb3, 0x34, 0x8c, 0x30, 0xc3, 0x5c, 0x06,
+	0x32, 0x9c, 0x08, 0x67, 0xa9, 0x6f, 0x0f, 0xda, 0xa3, 0xf5, 0xd9, 0xb4, 0xe7, 0x5c, 0x65, 0x0c,
+	0x9b, 0x95, 0x39, 0xf3, 0x22, 0x9c, 0x08, 0xf2, 0x12, 0xee, 0x52, 0x26, 0x13, 0x9a, 0x87, 0x69,
+	0x70, 0xcd, 0x6d, 0x59, 0xbb, 0x6d, 0xcd, 0xa6, 0xbd, 0x8d, 0xbf, 0x48, 0x0c, 0xdb, 0xdb, 0xb5,
+	0xe4, 0xd0, 0xb4, 0xf7, 0x5e, 0x41, 0xab, 0xbe, 0xb0, 0x0a, 0x37, 0x0f, 0x33, 0xbc, 0xf2, 0x3e,
+	0x1a, 0x21, 0x4f, 0x00, 0x18, 0xa7, 0x0c, 0xb9, 0x4c, 0x50, 0x3d, 0x8e, 0xea, 0xeb, 0xcc, 0xa6,
+	0xbd, 0xb5, 0xdf, 0xa8, 0xd1, 0xca, 0xd0, 0x7a, 0xaf, 0x61, 0x45, 0xc5, 0x2f, 0x7c, 0x14, 0x8c,
+	0xe6, 0x02, 0xc9, 0x26, 0x34, 0xf5, 0x7e, 0xea, 0x2e, 0x9d, 0xdd, 0x95, 0x3a, 0xea, 0xa7, 0x0a,
+	0xf4, 0x4b, 0x8e, 0x3c, 0x80, 0xa6, 0xfe, 0x11, 0x74, 0xab, 0x6b, 0x2f, 0x59, 0x6f, 0x94, 0x5f,
+	0x4a, 0xbc, 0xcf, 0x16, 0x10, 0x13, 0x7f, 0x2e, 0x43, 0x79, 0x2c, 0xd4, 0x3e, 0xb0, 0x0a, 0xa9,
+	0x5a, 0xdd, 0xec, 0x32, 0x57, 0x91, 0x3b, 0x60, 0x4b, 0x99, 0xea, 0xd5, 0xb3, 0xab, 0xe9, 0x15,
+	0x40, 0x36, 0x01, 0xf0, 0x1d, 0x4b, 0x38, 0x8a, 0x20, 0x94, 0x8e, 0x6d, 0xd0, 0xed, 0x0a, 0xdf,
+	0x93, 0xa4, 0x0b, 0x4d, 0x21, 0xc3, 0x14, 0xf5, 0xee, 0xb5, 0x2a, 0xbe, 0x84, 0xbc, 0x0f, 0xb0,
+	0xfe, 0xe7, 0x05, 0xf1, 0x1f, 0x23, 0x79, 0x0c, 0x2d, 0x51, 0x15, 0x56, 0xa9, 0x74, 0x6f, 0x9a,
+	0xa7, 0x34, 0xf7, 0xe7, 0xda, 0xd1, 0xf6, 0xf9, 0x85, 0xdb, 0xf8, 0x76, 0xe1, 0x36, 0x2e, 0x2f,
+	0x5c, 0xeb, 0x63, 0xe1, 0x5a, 0x5f, 0x0b, 0xd7, 0x3a, 0x2b, 0x5c, 0xeb, 0xbc, 0x70, 0xad, 0xef,
+	0x85, 0x6b, 0xfd, 0x2c, 0xdc, 0xc6, 0x65, 0xe1, 0x5a, 0x9f, 0x7e, 0xb8, 0x8d, 0x5f, 0x03, 0x00,
+	0xf8, 0xda, 0x2e, 0x96, 0x80, 0x04, 0x00, 0x00,
 }
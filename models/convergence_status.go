@@ -0,0 +1,29 @@
+package models
+
+// The types of convergence a ConvergenceStatus is reported for.
+const (
+	ConvergenceTypeLRP  = "lrp"
+	ConvergenceTypeTask = "task"
+)
+
+// The operations counted in the ConvergenceStatus of each type.
+const (
+	ConvergenceOperationLRPsAuctioned = "lrps_auctioned"
+	ConvergenceOperationLRPsUnclaimed = "lrps_unclaimed"
+	ConvergenceOperationLRPsRetired   = "lrps_retired"
+
+	ConvergenceOperationTasksAuctioned         = "tasks_auctioned"
+	ConvergenceOperationTaskCallbacksSubmitted = "task_callbacks_submitted"
+)
+
+// Validate rejects negative durations. A duration of 0 asks for the longest
+// pause the BBS allows.
+func (request *PauseConvergenceRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Duration < 0 {
+		validationError = validationError.Append(ErrInvalidField{"duration"})
+	}
+
+	return validationError.ToError()
+}
@@ -0,0 +1,1500 @@
+// Code generated by protoc-gen-gogo.
+// source: convergence_status.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import strings "strings"
+import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
+import sort "sort"
+import strconv "strconv"
+import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ConvergenceStatus struct {
+	Type        string           `protobuf:"bytes,1,opt,name=type" json:"type"`
+	CompletedAt int64            `protobuf:"varint,2,opt,name=completed_at,json=completedAt" json:"completed_at"`
+	Duration    int64            `protobuf:"varint,3,opt,name=duration" json:"duration"`
+	Operations  map[string]int32 `protobuf:"bytes,4,rep,name=operations" json:"operations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+}
+
+func (m *ConvergenceStatus) Reset()      { *m = ConvergenceStatus{} }
+func (*ConvergenceStatus) ProtoMessage() {}
+func (*ConvergenceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptorConvergenceStatus, []int{0}
+}
+
+func (m *ConvergenceStatus) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ConvergenceStatus) GetCompletedAt() int64 {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return 0
+}
+
+func (m *ConvergenceStatus) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ConvergenceStatus) GetOperations() map[string]int32 {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+type ConvergenceStatusResponse struct {
+	Error    *Error               `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Statuses []*ConvergenceStatus `protobuf:"bytes,2,rep,name=statuses" json:"statuses,omitempty"`
+}
+
+func (m *ConvergenceStatusResponse) Reset()      { *m = ConvergenceStatusResponse{} }
+func (*ConvergenceStatusResponse) ProtoMessage() {}
+func (*ConvergenceStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorConvergenceStatus, []int{1}
+}
+
+func (m *ConvergenceStatusResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ConvergenceStatusResponse) GetStatuses() []*ConvergenceStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type PauseConvergenceRequest struct {
+	Duration int64 `protobuf:"varint,1,opt,name=duration" json:"duration"`
+}
+
+func (m *PauseConvergenceRequest) Reset()      { *m = PauseConvergenceRequest{} }
+func (*PauseConvergenceRequest) ProtoMessage() {}
+func (*PauseConvergenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorConvergenceStatus, []int{2}
+}
+
+func (m *PauseConvergenceRequest) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type PauseConvergenceResponse struct {
+	Error       *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	PausedUntil int64  `protobuf:"varint,2,opt,name=paused_until,json=pausedUntil" json:"paused_until"`
+}
+
+func (m *PauseConvergenceResponse) Reset()      { *m = PauseConvergenceResponse{} }
+func (*PauseConvergenceResponse) ProtoMessage() {}
+func (*PauseConvergenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorConvergenceStatus, []int{3}
+}
+
+func (m *PauseConvergenceResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *PauseConvergenceResponse) GetPausedUntil() int64 {
+	if m != nil {
+		return m.PausedUntil
+	}
+	return 0
+}
+
+type ResumeConvergenceResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ResumeConvergenceResponse) Reset()      { *m = ResumeConvergenceResponse{} }
+func (*ResumeConvergenceResponse) ProtoMessage() {}
+func (*ResumeConvergenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorConvergenceStatus, []int{4}
+}
+
+func (m *ResumeConvergenceResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ConvergenceStatus)(nil), "models.ConvergenceStatus")
+	proto.RegisterType((*ConvergenceStatusResponse)(nil), "models.ConvergenceStatusResponse")
+	proto.RegisterType((*PauseConvergenceRequest)(nil), "models.PauseConvergenceRequest")
+	proto.RegisterType((*PauseConvergenceResponse)(nil), "models.PauseConvergenceResponse")
+	proto.RegisterType((*ResumeConvergenceResponse)(nil), "models.ResumeConvergenceResponse")
+}
+func (this *ConvergenceStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ConvergenceStatus)
+	if !ok {
+		that2, ok := that.(ConvergenceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.CompletedAt != that1.CompletedAt {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if len(this.Operations) != len(that1.Operations) {
+		return false
+	}
+	for i := range this.Operations {
+		if this.Operations[i] != that1.Operations[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ConvergenceStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ConvergenceStatusResponse)
+	if !ok {
+		that2, ok := that.(ConvergenceStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Statuses) != len(that1.Statuses) {
+		return false
+	}
+	for i := range this.Statuses {
+		if !this.Statuses[i].Equal(that1.Statuses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PauseConvergenceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*PauseConvergenceRequest)
+	if !ok {
+		that2, ok := that.(PauseConvergenceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
+}
+func (this *PauseConvergenceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*PauseConvergenceResponse)
+	if !ok {
+		that2, ok := that.(PauseConvergenceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if this.PausedUntil != that1.PausedUntil {
+		return false
+	}
+	return true
+}
+func (this *ResumeConvergenceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ResumeConvergenceResponse)
+	if !ok {
+		that2, ok := that.(ResumeConvergenceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *ConvergenceStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.ConvergenceStatus{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "CompletedAt: "+fmt.Sprintf("%#v", this.CompletedAt)+",\n")
+	s = append(s, "Duration: "+fmt.Sprintf("%#v", this.Duration)+",\n")
+	keysForOperations := make([]string, 0, len(this.Operations))
+	for k, _ := range this.Operations {
+		keysForOperations = append(keysForOperations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForOperations)
+	mapStringForOperations := "map[string]int32{"
+	for _, k := range keysForOperations {
+		mapStringForOperations += fmt.Sprintf("%#v: %#v,", k, this.Operations[k])
+	}
+	mapStringForOperations += "}"
+	if this.Operations != nil {
+		s = append(s, "Operations: "+mapStringForOperations+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConvergenceStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ConvergenceStatusResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Statuses != nil {
+		s = append(s, "Statuses: "+fmt.Sprintf("%#v", this.Statuses)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseConvergenceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.PauseConvergenceRequest{")
+	s = append(s, "Duration: "+fmt.Sprintf("%#v", this.Duration)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseConvergenceResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.PauseConvergenceResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "PausedUntil: "+fmt.Sprintf("%#v", this.PausedUntil)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResumeConvergenceResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ResumeConvergenceResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringConvergenceStatus(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringConvergenceStatus(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+func (m *ConvergenceStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ConvergenceStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintConvergenceStatus(data, i, uint64(len(m.Type)))
+	i += copy(data[i:], m.Type)
+	data[i] = 0x10
+	i++
+	i = encodeVarintConvergenceStatus(data, i, uint64(m.CompletedAt))
+	data[i] = 0x18
+	i++
+	i = encodeVarintConvergenceStatus(data, i, uint64(m.Duration))
+	if len(m.Operations) > 0 {
+		for k, _ := range m.Operations {
+			data[i] = 0x22
+			i++
+			v := m.Operations[k]
+			mapSize := 1 + len(k) + sovConvergenceStatus(uint64(len(k))) + 1 + sovConvergenceStatus(uint64(v))
+			i = encodeVarintConvergenceStatus(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintConvergenceStatus(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x10
+			i++
+			i = encodeVarintConvergenceStatus(data, i, uint64(v))
+		}
+	}
+	return i, nil
+}
+
+func (m *ConvergenceStatusResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ConvergenceStatusResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintConvergenceStatus(data, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Statuses) > 0 {
+		for _, msg := range m.Statuses {
+			data[i] = 0x12
+			i++
+			i = encodeVarintConvergenceStatus(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PauseConvergenceRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PauseConvergenceRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintConvergenceStatus(data, i, uint64(m.Duration))
+	return i, nil
+}
+
+func (m *PauseConvergenceResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PauseConvergenceResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintConvergenceStatus(data, i, uint64(m.Error.Size()))
+		n2, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	data[i] = 0x10
+	i++
+	i = encodeVarintConvergenceStatus(data, i, uint64(m.PausedUntil))
+	return i, nil
+}
+
+func (m *ResumeConvergenceResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ResumeConvergenceResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintConvergenceStatus(data, i, uint64(m.Error.Size()))
+		n3, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func encodeFixed64ConvergenceStatus(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32ConvergenceStatus(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintConvergenceStatus(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ConvergenceStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovConvergenceStatus(uint64(l))
+	n += 1 + sovConvergenceStatus(uint64(m.CompletedAt))
+	n += 1 + sovConvergenceStatus(uint64(m.Duration))
+	if len(m.Operations) > 0 {
+		for k, v := range m.Operations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovConvergenceStatus(uint64(len(k))) + 1 + sovConvergenceStatus(uint64(v))
+			n += mapEntrySize + 1 + sovConvergenceStatus(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ConvergenceStatusResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovConvergenceStatus(uint64(l))
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovConvergenceStatus(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PauseConvergenceRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovConvergenceStatus(uint64(m.Duration))
+	return n
+}
+
+func (m *PauseConvergenceResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovConvergenceStatus(uint64(l))
+	}
+	n += 1 + sovConvergenceStatus(uint64(m.PausedUntil))
+	return n
+}
+
+func (m *ResumeConvergenceResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovConvergenceStatus(uint64(l))
+	}
+	return n
+}
+
+func sovConvergenceStatus(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozConvergenceStatus(x uint64) (n int) {
+	return sovConvergenceStatus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ConvergenceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForOperations := make([]string, 0, len(this.Operations))
+	for k, _ := range this.Operations {
+		keysForOperations = append(keysForOperations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForOperations)
+	mapStringForOperations := "map[string]int32{"
+	for _, k := range keysForOperations {
+		mapStringForOperations += fmt.Sprintf("%v: %v,", k, this.Operations[k])
+	}
+	mapStringForOperations += "}"
+	s := strings.Join([]string{`&ConvergenceStatus{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`CompletedAt:` + fmt.Sprintf("%v", this.CompletedAt) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Operations:` + mapStringForOperations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvergenceStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvergenceStatusResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Statuses:` + strings.Replace(fmt.Sprintf("%v", this.Statuses), "ConvergenceStatus", "ConvergenceStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseConvergenceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseConvergenceRequest{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseConvergenceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseConvergenceResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`PausedUntil:` + fmt.Sprintf("%v", this.PausedUntil) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResumeConvergenceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResumeConvergenceResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringConvergenceStatus(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ConvergenceStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergenceStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvergenceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvergenceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			m.CompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.CompletedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Operations == nil {
+				m.Operations = make(map[string]int32)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConvergenceStatus
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConvergenceStatus
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapvalue |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Operations[mapkey] = mapvalue
+			} else {
+				var mapvalue int32
+				m.Operations[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergenceStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvergenceStatusResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergenceStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvergenceStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvergenceStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &ConvergenceStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergenceStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseConvergenceRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergenceStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseConvergenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseConvergenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergenceStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseConvergenceResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergenceStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseConvergenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseConvergenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedUntil", wireType)
+			}
+			m.PausedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.PausedUntil |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergenceStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeConvergenceResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergenceStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeConvergenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeConvergenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergenceStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConvergenceStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConvergenceStatus(data []byte) (n int, err error) {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConvergenceStatus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if data[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConvergenceStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthConvergenceStatus
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowConvergenceStatus
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipConvergenceStatus(data[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthConvergenceStatus = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConvergenceStatus   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("convergence_status.proto", fileDescriptorConvergenceStatus) }
+
+var fileDescriptorConvergenceStatus = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0x3d, 0x6f, 0xd4, 0x40,
+	0x10, 0xf5, 0xde, 0x07, 0x0a, 0x73, 0x41, 0x88, 0x15, 0x82, 0xbd, 0x2b, 0x36, 0x96, 0x29, 0x72,
+	0x48, 0xc1, 0x91, 0x22, 0x21, 0x21, 0x68, 0xe0, 0x50, 0x0a, 0x2a, 0x90, 0x11, 0xf5, 0xc9, 0xb1,
+	0x07, 0xc7, 0xc2, 0xf6, 0x1a, 0xef, 0x6e, 0x90, 0x3b, 0x7e, 0x02, 0x3f, 0x23, 0x3f, 0x25, 0x65,
+	0x4a, 0xaa, 0x88, 0x33, 0x0d, 0xa2, 0xca, 0x4f, 0x40, 0xde, 0x0d, 0xbe, 0x3b, 0x0e, 0x0a, 0xd2,
+	0xed, 0xbc, 0xf7, 0xe6, 0xed, 0xbc, 0x19, 0x60, 0x91, 0x28, 0x4e, 0xb0, 0x4a, 0xb0, 0x88, 0x70,
+	0x2e, 0x55, 0xa8, 0xb4, 0xf4, 0xcb, 0x4a, 0x28, 0x41, 0x6f, 0xe4, 0x22, 0xc6, 0x4c, 0x4e, 0x1e,
+	0x25, 0xa9, 0x3a, 0xd6, 0x47, 0x7e, 0x24, 0xf2, 0xfd, 0x44, 0x24, 0x62, 0xdf, 0xd0, 0x47, 0xfa,
+	0xbd, 0xa9, 0x4c, 0x61, 0x5e, 0xb6, 0x6d, 0x32, 0xc2, 0xaa, 0x12, 0x95, 0x2d, 0xbc, 0xd3, 0x1e,
+	0xdc, 0x79, 0xb9, 0xfc, 0xe0, 0xad, 0xf1, 0xa7, 0x0c, 0x06, 0xaa, 0x2e, 0x91, 0x11, 0x97, 0x4c,
+	0x6f, 0xce, 0x06, 0x67, 0x17, 0x3b, 0x4e, 0x60, 0x10, 0xba, 0x0b, 0xdb, 0x91, 0xc8, 0xcb, 0x0c,
+	0x15, 0xc6, 0xf3, 0x50, 0xb1, 0x9e, 0x4b, 0xa6, 0xfd, 0x2b, 0xc5, 0xa8, 0x63, 0x5e, 0x28, 0xea,
+	0xc2, 0x56, 0xac, 0xab, 0x50, 0xa5, 0xa2, 0x60, 0xfd, 0x15, 0x51, 0x87, 0xd2, 0x08, 0x40, 0x94,
+	0x68, 0x0b, 0xc9, 0x06, 0x6e, 0x7f, 0x3a, 0x3a, 0x78, 0xe8, 0xdb, 0x4c, 0xfe, 0xc6, 0x4c, 0xfe,
+	0xeb, 0x4e, 0x7b, 0x58, 0xa8, 0xaa, 0x9e, 0xb1, 0x9f, 0x17, 0x3b, 0x77, 0x97, 0x06, 0x7b, 0x22,
+	0x4f, 0x15, 0xe6, 0xa5, 0xaa, 0x83, 0x15, 0xdb, 0xc9, 0x2b, 0xb8, 0xfd, 0x47, 0x23, 0xbd, 0x07,
+	0xfd, 0x0f, 0x58, 0xaf, 0x65, 0x6b, 0x01, 0x3a, 0x81, 0xe1, 0x49, 0x98, 0x69, 0x34, 0x99, 0x86,
+	0x57, 0x8c, 0x85, 0x9e, 0xf6, 0x9e, 0x10, 0xef, 0x13, 0x8c, 0x37, 0xa6, 0x0a, 0x50, 0x96, 0xa2,
+	0x90, 0x48, 0x1f, 0xc0, 0xd0, 0xac, 0xd5, 0xd8, 0x8e, 0x0e, 0x6e, 0xfd, 0xce, 0x71, 0xd8, 0x82,
+	0x81, 0xe5, 0xe8, 0x63, 0xd8, 0xb2, 0x07, 0x44, 0xc9, 0x7a, 0x26, 0xef, 0xf8, 0x9f, 0x79, 0x83,
+	0x4e, 0xea, 0x3d, 0x83, 0xfb, 0x6f, 0x42, 0x2d, 0x71, 0x45, 0x13, 0xe0, 0x47, 0x8d, 0x72, 0x7d,
+	0xcb, 0xe4, 0x6f, 0x5b, 0xf6, 0x8e, 0x81, 0x6d, 0x36, 0xff, 0xcf, 0xd0, 0xbb, 0xb0, 0x5d, 0xb6,
+	0x06, 0xf1, 0x5c, 0x17, 0x2a, 0xcd, 0xd6, 0x2f, 0x6e, 0x99, 0x77, 0x2d, 0xe1, 0x3d, 0x87, 0x71,
+	0x80, 0x52, 0xe7, 0xd7, 0xfe, 0x6a, 0xb6, 0x77, 0xbe, 0xe0, 0xce, 0xd7, 0x05, 0x77, 0x2e, 0x17,
+	0x9c, 0x7c, 0x6e, 0x38, 0x39, 0x6d, 0x38, 0x39, 0x6b, 0x38, 0x39, 0x6f, 0x38, 0xf9, 0xd6, 0x70,
+	0xf2, 0xa3, 0xe1, 0xce, 0x65, 0xc3, 0xc9, 0x97, 0xef, 0xdc, 0xf9, 0x35, 0x00, 0x22, 0x7f, 0xab,
+	0x3c, 0x19, 0x03, 0x00, 0x00,
+}
@@ -1,6 +1,10 @@
 package models
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"net/url"
 	"regexp"
 	"time"
@@ -18,7 +22,26 @@ type DesiredLRPChange struct {
 }
 
 type DesiredLRPFilter struct {
-	Domain string
+	Domain       string
+	ProcessGuids []string
+
+	// IncludeDeleted also lists the tombstones of removed DesiredLRPs that
+	// are still within their retention window. They have DeletedAt set.
+	IncludeDeleted bool
+
+	// LabelSelector only lists the DesiredLRPs whose metadata labels it
+	// matches.
+	LabelSelector LabelSelector
+
+	// ModifiedSinceRevision only lists the DesiredLRPs desired, updated or
+	// removed after the given DesiredLRPsRevision. 0 lists them all.
+	ModifiedSinceRevision uint64
+
+	// PartialResults has DesiredLRPsWithRecordErrors and
+	// StreamDesiredLRPSchedulingInfos leave the DesiredLRPs that cannot be
+	// read out of the listing and return them as RecordErrors, instead of
+	// failing. DesiredLRPs and DesiredLRPSchedulingInfos ignore it.
+	PartialResults bool
 }
 
 func PreloadedRootFS(stack string) string {
@@ -67,6 +90,9 @@ func NewDesiredLRP(schedInfo DesiredLRPSchedulingInfo, runInfo DesiredLRPRunInfo
 		VolumeMounts:                  runInfo.VolumeMounts,
 		Network:                       runInfo.Network,
 		PlacementTags:                 schedInfo.PlacementTags,
+		MetadataLabels:                schedInfo.MetadataLabels,
+		StartOrder:                    schedInfo.StartOrder,
+		ExtensionFields:               runInfo.ExtensionFields,
 	}
 }
 
@@ -97,6 +123,7 @@ func (desiredLRP *DesiredLRP) AddRunInfo(runInfo DesiredLRPRunInfo) {
 	desiredLRP.TrustedSystemCertificatesPath = runInfo.TrustedSystemCertificatesPath
 	desiredLRP.VolumeMounts = runInfo.VolumeMounts
 	desiredLRP.Network = runInfo.Network
+	desiredLRP.ExtensionFields = runInfo.ExtensionFields
 }
 
 func newDesiredLRPWithCachedDependenciesAsSetupActions(d *DesiredLRP) *DesiredLRP {
@@ -173,6 +200,8 @@ func (d *DesiredLRP) DesiredLRPSchedulingInfo() DesiredLRPSchedulingInfo {
 		modificationTag,
 		&volumePlacement,
 		d.PlacementTags,
+		d.MetadataLabels,
+		d.StartOrder,
 	)
 }
 
@@ -205,6 +234,7 @@ func (d *DesiredLRP) DesiredLRPRunInfo(createdAt time.Time) DesiredLRPRunInfo {
 		d.TrustedSystemCertificatesPath,
 		d.VolumeMounts,
 		d.Network,
+		d.ExtensionFields,
 	)
 }
 
@@ -213,6 +243,37 @@ func (d *DesiredLRP) Copy() *DesiredLRP {
 	return &newDesired
 }
 
+// ContentHash returns a hex-encoded SHA-256 hash of the definition of the
+// DesiredLRP, so that a client can tell whether the stored DesiredLRP is the
+// one it holds without comparing them field by field. The modification tag
+// and the removal time are left out, since the BBS sets them, and the egress
+// rules are normalized, as they are when stored. The hash is taken over the
+// JSON encoding, whose map keys are sorted.
+func (d *DesiredLRP) ContentHash() (string, error) {
+	definition := *d
+	definition.ModificationTag = nil
+	definition.DeletedAt = 0
+
+	// Normalize replaces the destinations of a rule rather than changing
+	// them, so copies of the rules keep d as it is
+	if len(d.EgressRules) > 0 {
+		definition.EgressRules = make([]*SecurityGroupRule, len(d.EgressRules))
+		for i, rule := range d.EgressRules {
+			ruleCopy := *rule
+			definition.EgressRules[i] = &ruleCopy
+		}
+		definition.NormalizeEgressRules()
+	}
+
+	encoded, err := json.Marshal(&definition)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 func (d *DesiredLRP) CreateComponents(createdAt time.Time) (DesiredLRPSchedulingInfo, DesiredLRPRunInfo) {
 	return d.DesiredLRPSchedulingInfo(), d.DesiredLRPRunInfo(createdAt)
 }
@@ -233,6 +294,14 @@ func (d *DesiredLRP) actionsFromCachedDependencies() []ActionInterface {
 	return actions
 }
 
+// NormalizeEgressRules rewrites the destinations of the egress rules in
+// canonical form; see SecurityGroupRule.Normalize.
+func (d *DesiredLRP) NormalizeEgressRules() {
+	for _, rule := range d.EgressRules {
+		rule.Normalize()
+	}
+}
+
 func (desired DesiredLRP) Validate() error {
 	var validationError ValidationError
 
@@ -294,17 +363,20 @@ func (desired DesiredLRP) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"annotation"})
 	}
 
-	totalRoutesLength := 0
 	if desired.Routes != nil {
-		for _, value := range *desired.Routes {
-			totalRoutesLength += len(*value)
-			if totalRoutesLength > maximumRouteLength {
-				validationError = validationError.Append(ErrInvalidField{"routes"})
-				break
-			}
+		if err := desired.Routes.Validate(); err != nil {
+			validationError = validationError.Append(err)
 		}
 	}
 
+	if len(desired.EnvironmentVariables) > maximumEnvironmentVariables {
+		validationError = validationError.Append(ErrInvalidField{"env"})
+	}
+
+	if len(desired.EgressRules) > maximumEgressRules {
+		validationError = validationError.Append(ErrInvalidField{"egress_rules"})
+	}
+
 	for _, rule := range desired.EgressRules {
 		err := rule.Validate()
 		if err != nil {
@@ -318,16 +390,43 @@ func (desired DesiredLRP) Validate() error {
 		validationError = validationError.Append(err)
 	}
 
+	if len(desired.VolumeMounts) > maximumVolumeMounts {
+		validationError = validationError.Append(ErrInvalidField{"volume_mounts"})
+	}
+
 	for _, mount := range desired.VolumeMounts {
 		validationError = validationError.Check(mount)
 	}
 
+	if err := validateMetadataLabels(desired.MetadataLabels); err != nil {
+		validationError = validationError.Append(err)
+	}
+
+	if !desired.StartOrder.IsValid() {
+		validationError = validationError.Append(ErrInvalidField{"start_order"})
+	}
+
+	if err := validateExtensionFields(desired.ExtensionFields); err != nil {
+		validationError = validationError.Append(err)
+	}
+
 	return validationError.ToError()
 }
 
+// IsEmpty reports whether the update leaves every field of the DesiredLRP as it
+// is. Only the fields that are present, even if set to their zero value, are
+// changed by an update.
+func (desired *DesiredLRPUpdate) IsEmpty() bool {
+	return desired.Instances == nil && desired.Routes == nil && desired.Annotation == nil
+}
+
 func (desired *DesiredLRPUpdate) Validate() error {
 	var validationError ValidationError
 
+	if desired.IsEmpty() {
+		validationError = validationError.Append(errors.New("update must set at least one of instances, routes or annotation"))
+	}
+
 	if desired.GetInstances() < 0 {
 		validationError = validationError.Append(ErrInvalidField{"instances"})
 	}
@@ -336,14 +435,9 @@ func (desired *DesiredLRPUpdate) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"annotation"})
 	}
 
-	totalRoutesLength := 0
 	if desired.Routes != nil {
-		for _, value := range *desired.Routes {
-			totalRoutesLength += len(*value)
-			if totalRoutesLength > maximumRouteLength {
-				validationError = validationError.Append(ErrInvalidField{"routes"})
-				break
-			}
+		if err := desired.Routes.Validate(); err != nil {
+			validationError = validationError.Append(err)
 		}
 	}
 
@@ -380,6 +474,8 @@ func NewDesiredLRPSchedulingInfo(
 	modTag ModificationTag,
 	volumePlacement *VolumePlacement,
 	placementTags []string,
+	metadataLabels map[string]string,
+	startOrder StartOrder,
 ) DesiredLRPSchedulingInfo {
 	return DesiredLRPSchedulingInfo{
 		DesiredLRPKey:      key,
@@ -390,6 +486,8 @@ func NewDesiredLRPSchedulingInfo(
 		ModificationTag:    modTag,
 		VolumePlacement:    volumePlacement,
 		PlacementTags:      placementTags,
+		MetadataLabels:     metadataLabels,
+		StartOrder:         startOrder,
 	}
 }
 
@@ -423,6 +521,14 @@ func (s DesiredLRPSchedulingInfo) Validate() error {
 		ve = ve.Append(ErrInvalidField{"annotation"})
 	}
 
+	if err := validateMetadataLabels(s.MetadataLabels); err != nil {
+		ve = ve.Append(err)
+	}
+
+	if !s.StartOrder.IsValid() {
+		ve = ve.Append(ErrInvalidField{"start_order"})
+	}
+
 	return ve.ToError()
 }
 
@@ -472,6 +578,7 @@ func NewDesiredLRPRunInfo(
 	trustedSystemCertificatesPath string,
 	volumeMounts []*VolumeMount,
 	network *Network,
+	extensionFields map[string][]byte,
 ) DesiredLRPRunInfo {
 	return DesiredLRPRunInfo{
 		DesiredLRPKey:                 key,
@@ -492,6 +599,7 @@ func NewDesiredLRPRunInfo(
 		TrustedSystemCertificatesPath: trustedSystemCertificatesPath,
 		VolumeMounts:                  volumeMounts,
 		Network:                       network,
+		ExtensionFields:               extensionFields,
 	}
 }
 
@@ -505,10 +613,18 @@ func (runInfo DesiredLRPRunInfo) Validate() error {
 		runInfo.Monitor,
 	)
 
+	if len(runInfo.EnvironmentVariables) > maximumEnvironmentVariables {
+		ve = ve.Append(ErrInvalidField{"env"})
+	}
+
 	for _, envVar := range runInfo.EnvironmentVariables {
 		ve = ve.Check(envVar)
 	}
 
+	if len(runInfo.EgressRules) > maximumEgressRules {
+		ve = ve.Append(ErrInvalidField{"egress_rules"})
+	}
+
 	for _, rule := range runInfo.EgressRules {
 		ve = ve.Check(rule)
 	}
@@ -522,10 +638,18 @@ func (runInfo DesiredLRPRunInfo) Validate() error {
 		ve = ve.Append(err)
 	}
 
+	if len(runInfo.VolumeMounts) > maximumVolumeMounts {
+		ve = ve.Append(ErrInvalidField{"volume_mounts"})
+	}
+
 	for _, mount := range runInfo.VolumeMounts {
 		ve = ve.Check(mount)
 	}
 
+	if err := validateExtensionFields(runInfo.ExtensionFields); err != nil {
+		ve = ve.Append(err)
+	}
+
 	return ve.ToError()
 }
 
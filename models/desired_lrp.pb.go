@@ -9,12 +9,13 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 
+import strconv "strconv"
+
 import bytes "bytes"
 
 import strings "strings"
 import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
 import sort "sort"
-import strconv "strconv"
 import reflect "reflect"
 import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 
@@ -25,6 +26,43 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+// The order in which the instances of an LRP are started.
+type StartOrder int32
+
+const (
+	// Every instance is started as soon as it is desired.
+	StartOrder_Parallel StartOrder = 0
+	// Instance 0 is started first; the other instances wait for it to be running.
+	StartOrder_LeaderFirst StartOrder = 1
+)
+
+var StartOrder_name = map[int32]string{
+	0: "Parallel",
+	1: "LeaderFirst",
+}
+var StartOrder_value = map[string]int32{
+	"Parallel":    0,
+	"LeaderFirst": 1,
+}
+
+func (x StartOrder) Enum() *StartOrder {
+	p := new(StartOrder)
+	*p = x
+	return p
+}
+func (x StartOrder) MarshalJSON() ([]byte, error) {
+	return proto.MarshalJSONEnum(StartOrder_name, int32(x))
+}
+func (x *StartOrder) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(StartOrder_value, data, "StartOrder")
+	if err != nil {
+		return err
+	}
+	*x = StartOrder(value)
+	return nil
+}
+func (StartOrder) EnumDescriptor() ([]byte, []int) { return fileDescriptorDesiredLrp, []int{0} }
+
 type DesiredLRPSchedulingInfo struct {
 	DesiredLRPKey      `protobuf:"bytes,1,opt,name=desired_lrp_key,json=desiredLrpKey,embedded=desired_lrp_key" json:""`
 	Annotation         string `protobuf:"bytes,2,opt,name=annotation" json:"annotation"`
@@ -32,8 +70,10 @@ type DesiredLRPSchedulingInfo struct {
 	DesiredLRPResource `protobuf:"bytes,4,opt,name=desired_lrp_resource,json=desiredLrpResource,embedded=desired_lrp_resource" json:""`
 	Routes             Routes `protobuf:"bytes,5,opt,name=routes,customtype=Routes" json:"routes"`
 	ModificationTag    `protobuf:"bytes,6,opt,name=modification_tag,json=modificationTag,embedded=modification_tag" json:""`
-	VolumePlacement    *VolumePlacement `protobuf:"bytes,7,opt,name=volume_placement,json=volumePlacement" json:"volume_placement,omitempty"`
-	PlacementTags      []string         `protobuf:"bytes,8,rep,name=PlacementTags,json=placementTags" json:"placement_tags,omitempty"`
+	VolumePlacement    *VolumePlacement  `protobuf:"bytes,7,opt,name=volume_placement,json=volumePlacement" json:"volume_placement,omitempty"`
+	PlacementTags      []string          `protobuf:"bytes,8,rep,name=PlacementTags" json:"placement_tags,omitempty"`
+	MetadataLabels     map[string]string `protobuf:"bytes,10,rep,name=metadata_labels,json=metadataLabels" json:"metadata_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StartOrder         StartOrder        `protobuf:"varint,11,opt,name=start_order,json=startOrder,enum=models.StartOrder" json:"start_order,omitempty"`
 }
 
 func (m *DesiredLRPSchedulingInfo) Reset()      { *m = DesiredLRPSchedulingInfo{} }
@@ -70,6 +110,20 @@ func (m *DesiredLRPSchedulingInfo) GetPlacementTags() []string {
 	return nil
 }
 
+func (m *DesiredLRPSchedulingInfo) GetMetadataLabels() map[string]string {
+	if m != nil {
+		return m.MetadataLabels
+	}
+	return nil
+}
+
+func (m *DesiredLRPSchedulingInfo) GetStartOrder() StartOrder {
+	if m != nil {
+		return m.StartOrder
+	}
+	return StartOrder_Parallel
+}
+
 type DesiredLRPRunInfo struct {
 	DesiredLRPKey                 `protobuf:"bytes,1,opt,name=desired_lrp_key,json=desiredLrpKey,embedded=desired_lrp_key" json:""`
 	EnvironmentVariables          []EnvironmentVariable `protobuf:"bytes,2,rep,name=environment_variables,json=environmentVariables" json:"env"`
@@ -90,6 +144,7 @@ type DesiredLRPRunInfo struct {
 	VolumeMounts                  []*VolumeMount        `protobuf:"bytes,17,rep,name=volume_mounts,json=volumeMounts" json:"volume_mounts,omitempty"`
 	Network                       *Network              `protobuf:"bytes,18,opt,name=network" json:"network,omitempty"`
 	StartTimeoutMs                int64                 `protobuf:"varint,19,opt,name=start_timeout_ms,json=startTimeoutMs" json:"start_timeout_ms"`
+	ExtensionFields               map[string][]byte     `protobuf:"bytes,20,rep,name=extension_fields,json=extensionFields" json:"extension_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *DesiredLRPRunInfo) Reset()                    { *m = DesiredLRPRunInfo{} }
@@ -222,6 +277,13 @@ func (m *DesiredLRPRunInfo) GetStartTimeoutMs() int64 {
 	return 0
 }
 
+func (m *DesiredLRPRunInfo) GetExtensionFields() map[string][]byte {
+	if m != nil {
+		return m.ExtensionFields
+	}
+	return nil
+}
+
 // helper message for marshalling routes
 type ProtoRoutes struct {
 	Routes map[string][]byte `protobuf:"bytes,1,rep,name=routes" json:"routes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -353,6 +415,10 @@ type DesiredLRP struct {
 	VolumeMounts                  []*VolumeMount         `protobuf:"bytes,25,rep,name=volume_mounts,json=volumeMounts" json:"volume_mounts,omitempty"`
 	Network                       *Network               `protobuf:"bytes,26,opt,name=network" json:"network,omitempty"`
 	PlacementTags                 []string               `protobuf:"bytes,28,rep,name=PlacementTags,json=placementTags" json:"placement_tags,omitempty"`
+	DeletedAt                     int64                  `protobuf:"varint,30,opt,name=deleted_at,json=deletedAt" json:"deleted_at,omitempty"`
+	MetadataLabels                map[string]string      `protobuf:"bytes,31,rep,name=metadata_labels,json=metadataLabels" json:"metadata_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StartOrder                    StartOrder             `protobuf:"varint,32,opt,name=start_order,json=startOrder,enum=models.StartOrder" json:"start_order,omitempty"`
+	ExtensionFields               map[string][]byte      `protobuf:"bytes,33,rep,name=extension_fields,json=extensionFields" json:"extension_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *DesiredLRP) Reset()                    { *m = DesiredLRP{} }
@@ -548,6 +614,34 @@ func (m *DesiredLRP) GetPlacementTags() []string {
 	return nil
 }
 
+func (m *DesiredLRP) GetDeletedAt() int64 {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return 0
+}
+
+func (m *DesiredLRP) GetMetadataLabels() map[string]string {
+	if m != nil {
+		return m.MetadataLabels
+	}
+	return nil
+}
+
+func (m *DesiredLRP) GetStartOrder() StartOrder {
+	if m != nil {
+		return m.StartOrder
+	}
+	return StartOrder_Parallel
+}
+
+func (m *DesiredLRP) GetExtensionFields() map[string][]byte {
+	if m != nil {
+		return m.ExtensionFields
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DesiredLRPSchedulingInfo)(nil), "models.DesiredLRPSchedulingInfo")
 	proto.RegisterType((*DesiredLRPRunInfo)(nil), "models.DesiredLRPRunInfo")
@@ -556,6 +650,14 @@ func init() {
 	proto.RegisterType((*DesiredLRPKey)(nil), "models.DesiredLRPKey")
 	proto.RegisterType((*DesiredLRPResource)(nil), "models.DesiredLRPResource")
 	proto.RegisterType((*DesiredLRP)(nil), "models.DesiredLRP")
+	proto.RegisterEnum("models.StartOrder", StartOrder_name, StartOrder_value)
+}
+func (x StartOrder) String() string {
+	s, ok := StartOrder_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
 func (this *DesiredLRPSchedulingInfo) Equal(that interface{}) bool {
 	if that == nil {
@@ -611,6 +713,17 @@ func (this *DesiredLRPSchedulingInfo) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.MetadataLabels) != len(that1.MetadataLabels) {
+		return false
+	}
+	for i := range this.MetadataLabels {
+		if this.MetadataLabels[i] != that1.MetadataLabels[i] {
+			return false
+		}
+	}
+	if this.StartOrder != that1.StartOrder {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPRunInfo) Equal(that interface{}) bool {
@@ -720,6 +833,14 @@ func (this *DesiredLRPRunInfo) Equal(that interface{}) bool {
 	if this.StartTimeoutMs != that1.StartTimeoutMs {
 		return false
 	}
+	if len(this.ExtensionFields) != len(that1.ExtensionFields) {
+		return false
+	}
+	for i := range this.ExtensionFields {
+		if !bytes.Equal(this.ExtensionFields[i], that1.ExtensionFields[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ProtoRoutes) Equal(that interface{}) bool {
@@ -1024,13 +1145,35 @@ func (this *DesiredLRP) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.DeletedAt != that1.DeletedAt {
+		return false
+	}
+	if len(this.MetadataLabels) != len(that1.MetadataLabels) {
+		return false
+	}
+	for i := range this.MetadataLabels {
+		if this.MetadataLabels[i] != that1.MetadataLabels[i] {
+			return false
+		}
+	}
+	if this.StartOrder != that1.StartOrder {
+		return false
+	}
+	if len(this.ExtensionFields) != len(that1.ExtensionFields) {
+		return false
+	}
+	for i := range this.ExtensionFields {
+		if !bytes.Equal(this.ExtensionFields[i], that1.ExtensionFields[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *DesiredLRPSchedulingInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 14)
 	s = append(s, "&models.DesiredLRPSchedulingInfo{")
 	s = append(s, "DesiredLRPKey: "+strings.Replace(this.DesiredLRPKey.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "Annotation: "+fmt.Sprintf("%#v", this.Annotation)+",\n")
@@ -1044,6 +1187,20 @@ func (this *DesiredLRPSchedulingInfo) GoString() string {
 	if this.PlacementTags != nil {
 		s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
 	}
+	keysForMetadataLabels := make([]string, 0, len(this.MetadataLabels))
+	for k, _ := range this.MetadataLabels {
+		keysForMetadataLabels = append(keysForMetadataLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadataLabels)
+	mapStringForMetadataLabels := "map[string]string{"
+	for _, k := range keysForMetadataLabels {
+		mapStringForMetadataLabels += fmt.Sprintf("%#v: %#v,", k, this.MetadataLabels[k])
+	}
+	mapStringForMetadataLabels += "}"
+	if this.MetadataLabels != nil {
+		s = append(s, "MetadataLabels: "+mapStringForMetadataLabels+",\n")
+	}
+	s = append(s, "StartOrder: "+fmt.Sprintf("%#v", this.StartOrder)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1051,7 +1208,7 @@ func (this *DesiredLRPRunInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 23)
+	s := make([]string, 0, 24)
 	s = append(s, "&models.DesiredLRPRunInfo{")
 	s = append(s, "DesiredLRPKey: "+strings.Replace(this.DesiredLRPKey.GoString(), `&`, ``, 1)+",\n")
 	if this.EnvironmentVariables != nil {
@@ -1090,6 +1247,19 @@ func (this *DesiredLRPRunInfo) GoString() string {
 		s = append(s, "Network: "+fmt.Sprintf("%#v", this.Network)+",\n")
 	}
 	s = append(s, "StartTimeoutMs: "+fmt.Sprintf("%#v", this.StartTimeoutMs)+",\n")
+	keysForExtensionFields := make([]string, 0, len(this.ExtensionFields))
+	for k, _ := range this.ExtensionFields {
+		keysForExtensionFields = append(keysForExtensionFields, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensionFields)
+	mapStringForExtensionFields := "map[string][]byte{"
+	for _, k := range keysForExtensionFields {
+		mapStringForExtensionFields += fmt.Sprintf("%#v: %#v,", k, this.ExtensionFields[k])
+	}
+	mapStringForExtensionFields += "}"
+	if this.ExtensionFields != nil {
+		s = append(s, "ExtensionFields: "+mapStringForExtensionFields+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1161,7 +1331,7 @@ func (this *DesiredLRP) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 32)
+	s := make([]string, 0, 36)
 	s = append(s, "&models.DesiredLRP{")
 	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
@@ -1215,6 +1385,34 @@ func (this *DesiredLRP) GoString() string {
 	if this.PlacementTags != nil {
 		s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
 	}
+	s = append(s, "DeletedAt: "+fmt.Sprintf("%#v", this.DeletedAt)+",\n")
+	keysForMetadataLabels := make([]string, 0, len(this.MetadataLabels))
+	for k, _ := range this.MetadataLabels {
+		keysForMetadataLabels = append(keysForMetadataLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadataLabels)
+	mapStringForMetadataLabels := "map[string]string{"
+	for _, k := range keysForMetadataLabels {
+		mapStringForMetadataLabels += fmt.Sprintf("%#v: %#v,", k, this.MetadataLabels[k])
+	}
+	mapStringForMetadataLabels += "}"
+	if this.MetadataLabels != nil {
+		s = append(s, "MetadataLabels: "+mapStringForMetadataLabels+",\n")
+	}
+	s = append(s, "StartOrder: "+fmt.Sprintf("%#v", this.StartOrder)+",\n")
+	keysForExtensionFields := make([]string, 0, len(this.ExtensionFields))
+	for k, _ := range this.ExtensionFields {
+		keysForExtensionFields = append(keysForExtensionFields, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensionFields)
+	mapStringForExtensionFields := "map[string][]byte{"
+	for _, k := range keysForExtensionFields {
+		mapStringForExtensionFields += fmt.Sprintf("%#v: %#v,", k, this.ExtensionFields[k])
+	}
+	mapStringForExtensionFields += "}"
+	if this.ExtensionFields != nil {
+		s = append(s, "ExtensionFields: "+mapStringForExtensionFields+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1323,6 +1521,26 @@ func (m *DesiredLRPSchedulingInfo) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], s)
 		}
 	}
+	if len(m.MetadataLabels) > 0 {
+		for k, _ := range m.MetadataLabels {
+			data[i] = 0x52
+			i++
+			v := m.MetadataLabels[k]
+			mapSize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			i = encodeVarintDesiredLrp(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintDesiredLrp(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintDesiredLrp(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	data[i] = 0x58
+	i++
+	i = encodeVarintDesiredLrp(data, i, uint64(m.StartOrder))
 	return i, nil
 }
 
@@ -1488,6 +1706,31 @@ func (m *DesiredLRPRunInfo) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x1
 	i++
 	i = encodeVarintDesiredLrp(data, i, uint64(m.StartTimeoutMs))
+	if len(m.ExtensionFields) > 0 {
+		for k, _ := range m.ExtensionFields {
+			data[i] = 0xa2
+			i++
+			data[i] = 0x1
+			i++
+			v := m.ExtensionFields[k]
+			byteSize := 0
+			if v != nil {
+				byteSize = 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			}
+			mapSize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + byteSize
+			i = encodeVarintDesiredLrp(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintDesiredLrp(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			if v != nil {
+				data[i] = 0x12
+				i++
+				i = encodeVarintDesiredLrp(data, i, uint64(len(v)))
+				i += copy(data[i:], v)
+			}
+		}
+	}
 	return i, nil
 }
 
@@ -1862,6 +2105,60 @@ func (m *DesiredLRP) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], s)
 		}
 	}
+	data[i] = 0xf0
+	i++
+	data[i] = 0x1
+	i++
+	i = encodeVarintDesiredLrp(data, i, uint64(m.DeletedAt))
+	if len(m.MetadataLabels) > 0 {
+		for k, _ := range m.MetadataLabels {
+			data[i] = 0xfa
+			i++
+			data[i] = 0x1
+			i++
+			v := m.MetadataLabels[k]
+			mapSize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			i = encodeVarintDesiredLrp(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintDesiredLrp(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintDesiredLrp(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	data[i] = 0x80
+	i++
+	data[i] = 0x2
+	i++
+	i = encodeVarintDesiredLrp(data, i, uint64(m.StartOrder))
+	if len(m.ExtensionFields) > 0 {
+		for k, _ := range m.ExtensionFields {
+			data[i] = 0x8a
+			i++
+			data[i] = 0x2
+			i++
+			v := m.ExtensionFields[k]
+			byteSize := 0
+			if v != nil {
+				byteSize = 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			}
+			mapSize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + byteSize
+			i = encodeVarintDesiredLrp(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintDesiredLrp(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			if v != nil {
+				data[i] = 0x12
+				i++
+				i = encodeVarintDesiredLrp(data, i, uint64(len(v)))
+				i += copy(data[i:], v)
+			}
+		}
+	}
 	return i, nil
 }
 
@@ -1916,6 +2213,15 @@ func (m *DesiredLRPSchedulingInfo) Size() (n int) {
 			n += 1 + l + sovDesiredLrp(uint64(l))
 		}
 	}
+	if len(m.MetadataLabels) > 0 {
+		for k, v := range m.MetadataLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDesiredLrp(uint64(mapEntrySize))
+		}
+	}
+	n += 1 + sovDesiredLrp(uint64(m.StartOrder))
 	return n
 }
 
@@ -1982,6 +2288,18 @@ func (m *DesiredLRPRunInfo) Size() (n int) {
 		n += 2 + l + sovDesiredLrp(uint64(l))
 	}
 	n += 2 + sovDesiredLrp(uint64(m.StartTimeoutMs))
+	if len(m.ExtensionFields) > 0 {
+		for k, v := range m.ExtensionFields {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovDesiredLrp(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2129,6 +2447,28 @@ func (m *DesiredLRP) Size() (n int) {
 			n += 2 + l + sovDesiredLrp(uint64(l))
 		}
 	}
+	n += 2 + sovDesiredLrp(uint64(m.DeletedAt))
+	if len(m.MetadataLabels) > 0 {
+		for k, v := range m.MetadataLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			n += mapEntrySize + 2 + sovDesiredLrp(uint64(mapEntrySize))
+		}
+	}
+	n += 2 + sovDesiredLrp(uint64(m.StartOrder))
+	if len(m.ExtensionFields) > 0 {
+		for k, v := range m.ExtensionFields {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovDesiredLrp(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2149,6 +2489,16 @@ func (this *DesiredLRPSchedulingInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForMetadataLabels := make([]string, 0, len(this.MetadataLabels))
+	for k, _ := range this.MetadataLabels {
+		keysForMetadataLabels = append(keysForMetadataLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadataLabels)
+	mapStringForMetadataLabels := "map[string]string{"
+	for _, k := range keysForMetadataLabels {
+		mapStringForMetadataLabels += fmt.Sprintf("%v: %v,", k, this.MetadataLabels[k])
+	}
+	mapStringForMetadataLabels += "}"
 	s := strings.Join([]string{`&DesiredLRPSchedulingInfo{`,
 		`DesiredLRPKey:` + strings.Replace(strings.Replace(this.DesiredLRPKey.String(), "DesiredLRPKey", "DesiredLRPKey", 1), `&`, ``, 1) + `,`,
 		`Annotation:` + fmt.Sprintf("%v", this.Annotation) + `,`,
@@ -2158,6 +2508,8 @@ func (this *DesiredLRPSchedulingInfo) String() string {
 		`ModificationTag:` + strings.Replace(strings.Replace(this.ModificationTag.String(), "ModificationTag", "ModificationTag", 1), `&`, ``, 1) + `,`,
 		`VolumePlacement:` + strings.Replace(fmt.Sprintf("%v", this.VolumePlacement), "VolumePlacement", "VolumePlacement", 1) + `,`,
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
+		`MetadataLabels:` + mapStringForMetadataLabels + `,`,
+		`StartOrder:` + fmt.Sprintf("%v", this.StartOrder) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2166,6 +2518,16 @@ func (this *DesiredLRPRunInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForExtensionFields := make([]string, 0, len(this.ExtensionFields))
+	for k, _ := range this.ExtensionFields {
+		keysForExtensionFields = append(keysForExtensionFields, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensionFields)
+	mapStringForExtensionFields := "map[string][]byte{"
+	for _, k := range keysForExtensionFields {
+		mapStringForExtensionFields += fmt.Sprintf("%v: %v,", k, this.ExtensionFields[k])
+	}
+	mapStringForExtensionFields += "}"
 	s := strings.Join([]string{`&DesiredLRPRunInfo{`,
 		`DesiredLRPKey:` + strings.Replace(strings.Replace(this.DesiredLRPKey.String(), "DesiredLRPKey", "DesiredLRPKey", 1), `&`, ``, 1) + `,`,
 		`EnvironmentVariables:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EnvironmentVariables), "EnvironmentVariable", "EnvironmentVariable", 1), `&`, ``, 1) + `,`,
@@ -2186,6 +2548,7 @@ func (this *DesiredLRPRunInfo) String() string {
 		`VolumeMounts:` + strings.Replace(fmt.Sprintf("%v", this.VolumeMounts), "VolumeMount", "VolumeMount", 1) + `,`,
 		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "Network", "Network", 1) + `,`,
 		`StartTimeoutMs:` + fmt.Sprintf("%v", this.StartTimeoutMs) + `,`,
+		`ExtensionFields:` + mapStringForExtensionFields + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2250,6 +2613,26 @@ func (this *DesiredLRP) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForMetadataLabels := make([]string, 0, len(this.MetadataLabels))
+	for k, _ := range this.MetadataLabels {
+		keysForMetadataLabels = append(keysForMetadataLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadataLabels)
+	mapStringForMetadataLabels := "map[string]string{"
+	for _, k := range keysForMetadataLabels {
+		mapStringForMetadataLabels += fmt.Sprintf("%v: %v,", k, this.MetadataLabels[k])
+	}
+	mapStringForMetadataLabels += "}"
+	keysForExtensionFields := make([]string, 0, len(this.ExtensionFields))
+	for k, _ := range this.ExtensionFields {
+		keysForExtensionFields = append(keysForExtensionFields, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensionFields)
+	mapStringForExtensionFields := "map[string][]byte{"
+	for _, k := range keysForExtensionFields {
+		mapStringForExtensionFields += fmt.Sprintf("%v: %v,", k, this.ExtensionFields[k])
+	}
+	mapStringForExtensionFields += "}"
 	s := strings.Join([]string{`&DesiredLRP{`,
 		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
 		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
@@ -2279,6 +2662,10 @@ func (this *DesiredLRP) String() string {
 		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "Network", "Network", 1) + `,`,
 		`StartTimeoutMs:` + fmt.Sprintf("%v", this.StartTimeoutMs) + `,`,
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
+		`DeletedAt:` + fmt.Sprintf("%v", this.DeletedAt) + `,`,
+		`MetadataLabels:` + mapStringForMetadataLabels + `,`,
+		`StartOrder:` + fmt.Sprintf("%v", this.StartOrder) + `,`,
+		`ExtensionFields:` + mapStringForExtensionFields + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2550,59 +2937,9 @@ func (m *DesiredLRPSchedulingInfo) Unmarshal(data []byte) error {
 			}
 			m.PlacementTags = append(m.PlacementTags, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDesiredLrp(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDesiredLrp
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DesiredLRPRunInfo) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDesiredLrp
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPRunInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPRunInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLRPKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLabels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2626,12 +2963,197 @@ func (m *DesiredLRPRunInfo) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DesiredLRPKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.MetadataLabels == nil {
+				m.MetadataLabels = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthDesiredLrp
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.MetadataLabels[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.MetadataLabels[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartOrder", wireType)
+			}
+			m.StartOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.StartOrder |= (StartOrder(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrp(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRunInfo) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRunInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRunInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLRPKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DesiredLRPKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EnvironmentVariables", wireType)
 			}
 			var msglen int
@@ -3111,13 +3633,130 @@ func (m *DesiredLRPRunInfo) Unmarshal(data []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := data[iNdEx]
-				iNdEx++
-				m.StartTimeoutMs |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				b := data[iNdEx]
+				iNdEx++
+				m.StartTimeoutMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.ExtensionFields == nil {
+				m.ExtensionFields = make(map[string][]byte)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapbyteLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapbyteLen |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intMapbyteLen := int(mapbyteLen)
+				if intMapbyteLen < 0 {
+					return ErrInvalidLengthDesiredLrp
+				}
+				postbytesIndex := iNdEx + intMapbyteLen
+				if postbytesIndex > l {
+					return io.ErrUnexpectedEOF
 				}
+				mapvalue := make([]byte, mapbyteLen)
+				copy(mapvalue, data[iNdEx:postbytesIndex])
+				iNdEx = postbytesIndex
+				m.ExtensionFields[mapkey] = mapvalue
+			} else {
+				var mapvalue []byte
+				m.ExtensionFields[mapkey] = mapvalue
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrp(data[iNdEx:])
@@ -4486,6 +5125,277 @@ func (m *DesiredLRP) Unmarshal(data []byte) error {
 			}
 			m.PlacementTags = append(m.PlacementTags, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			m.DeletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DeletedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.MetadataLabels == nil {
+				m.MetadataLabels = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthDesiredLrp
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.MetadataLabels[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.MetadataLabels[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartOrder", wireType)
+			}
+			m.StartOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.StartOrder |= (StartOrder(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.ExtensionFields == nil {
+				m.ExtensionFields = make(map[string][]byte)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapbyteLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapbyteLen |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intMapbyteLen := int(mapbyteLen)
+				if intMapbyteLen < 0 {
+					return ErrInvalidLengthDesiredLrp
+				}
+				postbytesIndex := iNdEx + intMapbyteLen
+				if postbytesIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := make([]byte, mapbyteLen)
+				copy(mapvalue, data[iNdEx:postbytesIndex])
+				iNdEx = postbytesIndex
+				m.ExtensionFields[mapkey] = mapvalue
+			} else {
+				var mapvalue []byte
+				m.ExtensionFields[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrp(data[iNdEx:])
@@ -4615,91 +5525,106 @@ var (
 func init() { proto.RegisterFile("desired_lrp.proto", fileDescriptorDesiredLrp) }
 
 var fileDescriptorDesiredLrp = []byte{
-	// 1376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0x2d, 0x5b, 0xb2, 0x56, 0x92, 0x3f, 0xd6, 0x76, 0xcc, 0xc8, 0x09, 0xa5, 0xe8, 0x0d,
-	0x12, 0xbd, 0x45, 0xea, 0x00, 0x3e, 0x05, 0x45, 0x0f, 0x0d, 0x93, 0x34, 0x28, 0x12, 0x17, 0x06,
-	0x9d, 0xa4, 0x1f, 0x40, 0x4b, 0x50, 0xe4, 0x9a, 0x26, 0x42, 0x72, 0x89, 0xdd, 0xa5, 0x0c, 0xa1,
-	0x87, 0xf6, 0xd0, 0x7b, 0xfa, 0x33, 0x7a, 0xeb, 0x2f, 0xe8, 0x3d, 0xc7, 0x1c, 0x8b, 0x1e, 0x84,
-	0x46, 0xbd, 0x14, 0x3e, 0xe5, 0x27, 0x14, 0x5c, 0x2e, 0xc5, 0xa5, 0x24, 0x3b, 0x2e, 0x60, 0xe4,
-	0x26, 0xce, 0x3c, 0xf3, 0xb5, 0x3b, 0x33, 0xcf, 0x0a, 0xac, 0x3b, 0x88, 0x7a, 0x04, 0x39, 0xa6,
-	0x4f, 0xa2, 0xdd, 0x88, 0x60, 0x86, 0x61, 0x25, 0xc0, 0x0e, 0xf2, 0x69, 0xeb, 0x63, 0xd7, 0x63,
-	0xc7, 0x71, 0x7f, 0xd7, 0xc6, 0xc1, 0x5d, 0x17, 0xbb, 0xf8, 0x2e, 0x57, 0xf7, 0xe3, 0x23, 0xfe,
-	0xc5, 0x3f, 0xf8, 0xaf, 0xd4, 0xac, 0x75, 0x25, 0xc0, 0x8e, 0x77, 0xe4, 0xd9, 0x16, 0xf3, 0x70,
-	0x68, 0x32, 0xcb, 0x15, 0xf2, 0xa6, 0x65, 0x27, 0x12, 0x2a, 0x3e, 0xb7, 0x6d, 0xcb, 0x3e, 0x46,
-	0x8e, 0xe9, 0xa0, 0x08, 0x85, 0x0e, 0x0a, 0xed, 0xa1, 0x50, 0x6c, 0x52, 0x64, 0xc7, 0xc4, 0x63,
-	0x43, 0xd3, 0x25, 0x38, 0x16, 0xc9, 0xb4, 0x76, 0x50, 0x38, 0xf0, 0x08, 0x0e, 0x03, 0x14, 0x32,
-	0x73, 0x60, 0x11, 0xcf, 0xea, 0xfb, 0x28, 0xf3, 0x05, 0x07, 0xd8, 0x8f, 0x03, 0x64, 0x06, 0x38,
-	0x0e, 0x59, 0x16, 0x2e, 0x44, 0xec, 0x04, 0x93, 0x97, 0xe9, 0x67, 0xf7, 0xb7, 0x45, 0xa0, 0x3e,
-	0x4c, 0x4b, 0x7c, 0x6a, 0x1c, 0x1c, 0x26, 0xa1, 0x63, 0xdf, 0x0b, 0xdd, 0x2f, 0xc2, 0x23, 0x0c,
-	0x9f, 0x80, 0x55, 0xa9, 0x7c, 0xf3, 0x25, 0x1a, 0xaa, 0x4a, 0x47, 0xe9, 0xd5, 0xf7, 0xb6, 0x76,
-	0xd3, 0x33, 0xd8, 0xcd, 0x4d, 0x9f, 0xa0, 0xa1, 0xde, 0x78, 0x3d, 0x6a, 0x97, 0xde, 0x8c, 0xda,
-	0xca, 0xe9, 0xa8, 0x5d, 0x32, 0x9a, 0xc2, 0xf6, 0x29, 0x89, 0x9e, 0xa0, 0x21, 0xbc, 0x09, 0x80,
-	0x15, 0x86, 0x98, 0xf1, 0xfa, 0xd5, 0x85, 0x8e, 0xd2, 0xab, 0xe9, 0x8b, 0x89, 0x81, 0x21, 0xc9,
-	0x61, 0x17, 0xd4, 0xbc, 0x90, 0x32, 0x2b, 0xb4, 0x11, 0x55, 0xcb, 0x1d, 0xa5, 0xb7, 0x24, 0x40,
-	0xb9, 0x18, 0x7e, 0x0b, 0x36, 0xe5, 0xb4, 0x08, 0xa2, 0x38, 0x26, 0x36, 0x52, 0x17, 0x79, 0x6e,
-	0xad, 0xd9, 0xdc, 0x0c, 0x81, 0x98, 0x4a, 0x10, 0xe6, 0x09, 0x66, 0x08, 0x78, 0x0b, 0x54, 0x08,
-	0x8e, 0x19, 0xa2, 0xea, 0x52, 0x47, 0xe9, 0x35, 0xf4, 0x95, 0xc4, 0xe2, 0xcf, 0x51, 0xbb, 0x62,
-	0x70, 0xa9, 0x21, 0xb4, 0xf0, 0x00, 0xac, 0x4d, 0xdf, 0xa7, 0x5a, 0xe1, 0xf1, 0xb7, 0xb3, 0xf8,
-	0xfb, 0x92, 0xfe, 0x99, 0xe5, 0x4e, 0x05, 0x5f, 0x0d, 0x8a, 0x6a, 0xd8, 0x07, 0x6b, 0xe2, 0xba,
-	0x22, 0xdf, 0xb2, 0x51, 0x72, 0xa1, 0x6a, 0xb5, 0xe8, 0xf1, 0x05, 0xd7, 0x1f, 0x64, 0x6a, 0x5d,
-	0x3b, 0x1d, 0xb5, 0x5b, 0xd3, 0x46, 0x77, 0x70, 0xe0, 0x31, 0x14, 0x44, 0x6c, 0x68, 0xac, 0x0e,
-	0x8a, 0x06, 0x50, 0x07, 0xcd, 0xc9, 0xc7, 0x33, 0xcb, 0xa5, 0xea, 0x72, 0xa7, 0xdc, 0xab, 0xe9,
-	0xd7, 0x4e, 0x47, 0x6d, 0x75, 0xe2, 0x20, 0xa9, 0x85, 0x4a, 0x5e, 0x9a, 0x91, 0x6c, 0xd2, 0xfd,
-	0xbd, 0x06, 0xd6, 0xa5, 0xa3, 0x8d, 0xc3, 0xcb, 0x6f, 0x95, 0xef, 0xc0, 0xd6, 0xdc, 0xb6, 0x56,
-	0x17, 0x3a, 0xe5, 0x5e, 0x7d, 0x6f, 0x27, 0x73, 0xf9, 0x28, 0x07, 0xbd, 0x10, 0x18, 0xbd, 0x9e,
-	0x38, 0x3e, 0x1d, 0xb5, 0xcb, 0x28, 0x1c, 0x18, 0x9b, 0x68, 0x16, 0x41, 0xe1, 0x4d, 0xb0, 0x44,
-	0x11, 0x8b, 0x23, 0xde, 0x5f, 0xf5, 0xbd, 0x95, 0xcc, 0xdd, 0x7d, 0x3e, 0x88, 0x46, 0xaa, 0x4c,
-	0x3a, 0x21, 0x9d, 0x4c, 0xd1, 0x57, 0xd3, 0x30, 0xa1, 0x85, 0x3d, 0x50, 0x0d, 0x70, 0xe8, 0x31,
-	0x4c, 0x78, 0xcb, 0xcc, 0x02, 0x33, 0x35, 0xfc, 0x1e, 0xb4, 0x1c, 0x14, 0x11, 0x64, 0x5b, 0x0c,
-	0x39, 0x26, 0x65, 0x16, 0x61, 0x26, 0xf3, 0x02, 0x84, 0x63, 0x66, 0x52, 0xde, 0x3d, 0x4d, 0xfd,
-	0x86, 0x48, 0x7f, 0xbb, 0xa0, 0xce, 0x6f, 0x43, 0x55, 0x8c, 0xed, 0xdc, 0xc9, 0x61, 0x02, 0x7a,
-	0x96, 0x62, 0x0e, 0x93, 0x09, 0x8b, 0x88, 0x37, 0xf0, 0x7c, 0xe4, 0x22, 0x87, 0xf7, 0xce, 0x72,
-	0x36, 0x61, 0xb9, 0x1c, 0xfe, 0x0f, 0x00, 0x3b, 0x8a, 0xcd, 0x13, 0xe4, 0xb9, 0xc7, 0x4c, 0x5d,
-	0xe6, 0x51, 0xc5, 0x88, 0xd9, 0x51, 0xfc, 0x15, 0x17, 0xc3, 0x4d, 0xb0, 0x14, 0x61, 0xc2, 0xa8,
-	0x5a, 0xeb, 0x94, 0x7b, 0x4d, 0x23, 0xfd, 0x80, 0x3a, 0x68, 0x20, 0x97, 0x20, 0x4a, 0x4d, 0x12,
-	0x27, 0xd7, 0x01, 0xf8, 0x75, 0x5c, 0xcd, 0xea, 0x3d, 0x14, 0x0b, 0xea, 0x71, 0xb2, 0x9f, 0x8c,
-	0xd8, 0x47, 0xc2, 0x6f, 0x3d, 0x35, 0x4a, 0x24, 0x34, 0x09, 0xef, 0x63, 0xd7, 0x14, 0x23, 0x5b,
-	0x97, 0xd6, 0x40, 0xcd, 0xc7, 0xee, 0x61, 0x3a, 0x85, 0xb7, 0x41, 0x23, 0x40, 0x8c, 0x78, 0x36,
-	0x35, 0xdd, 0xd8, 0x73, 0xd4, 0x86, 0x04, 0xab, 0x0b, 0xcd, 0xe3, 0xd8, 0x4b, 0x8b, 0x21, 0x88,
-	0x9f, 0xa7, 0xc5, 0xd4, 0x66, 0x47, 0xe9, 0x95, 0x27, 0xc5, 0xa4, 0xf2, 0xfb, 0x0c, 0xfa, 0x60,
-	0x63, 0x7a, 0xa9, 0x7a, 0x88, 0xaa, 0x2b, 0x3c, 0x7b, 0x35, 0xcb, 0xfe, 0x01, 0x87, 0x3c, 0x9c,
-	0xac, 0x5d, 0xfd, 0xc6, 0xe9, 0xa8, 0x7d, 0x7d, 0x8e, 0xa1, 0x34, 0x1a, 0xd0, 0x2e, 0x1a, 0x79,
-	0x88, 0xc2, 0xaf, 0xc1, 0xa6, 0x8f, 0x5c, 0xcb, 0x1e, 0x9a, 0x0e, 0x3e, 0x09, 0x7d, 0x6c, 0x39,
-	0x66, 0x4c, 0x11, 0x51, 0x57, 0x79, 0x0d, 0xb7, 0xc4, 0xfd, 0x6a, 0xf3, 0x30, 0xb2, 0xe7, 0x54,
-	0xff, 0x50, 0xa8, 0x9f, 0x53, 0x44, 0xe0, 0x0f, 0xa0, 0xc3, 0x48, 0x4c, 0x79, 0xf3, 0x0c, 0x29,
-	0x43, 0x81, 0x69, 0x23, 0xc2, 0xd2, 0x25, 0x82, 0xa8, 0x19, 0x59, 0xec, 0x58, 0x5d, 0xe3, 0x51,
-	0xf6, 0x44, 0x94, 0x8f, 0xde, 0x87, 0x97, 0x22, 0x5e, 0x17, 0xd8, 0x43, 0x0e, 0x7d, 0x20, 0x21,
-	0x0f, 0x2c, 0x76, 0x0c, 0x9f, 0x83, 0xa6, 0xcc, 0x26, 0x54, 0x5d, 0xe7, 0xc7, 0xb7, 0x51, 0xdc,
-	0x4d, 0xfb, 0x89, 0x4e, 0xdf, 0x49, 0x1a, 0xb8, 0x80, 0x96, 0xe2, 0x34, 0x06, 0x39, 0x92, 0xc2,
-	0xcf, 0x40, 0x55, 0x10, 0x92, 0x0a, 0xf9, 0xf4, 0xac, 0x66, 0x0e, 0xbf, 0x4c, 0xc5, 0xfa, 0xd6,
-	0xe9, 0xa8, 0xbd, 0x2e, 0x30, 0x92, 0x9b, 0xcc, 0x0c, 0xee, 0x82, 0xb5, 0xe2, 0x28, 0x05, 0x54,
-	0xdd, 0x90, 0x1a, 0x61, 0x85, 0x4a, 0x43, 0xb2, 0x4f, 0xbb, 0xaf, 0x14, 0xd0, 0xe0, 0xdc, 0x67,
-	0x8a, 0x55, 0x7e, 0x6f, 0xb2, 0xf2, 0x15, 0x5e, 0x52, 0x27, 0xcb, 0x40, 0x46, 0xed, 0xa6, 0xfb,
-	0xff, 0x51, 0xc8, 0xc8, 0x30, 0x23, 0x81, 0xd6, 0x23, 0x50, 0x97, 0xc4, 0xf0, 0x0a, 0x28, 0x67,
-	0x7b, 0x2f, 0x6b, 0xd6, 0x44, 0x00, 0x5b, 0x60, 0x69, 0x60, 0xf9, 0x31, 0xe2, 0xa4, 0xd7, 0x10,
-	0x9a, 0x54, 0xf4, 0xc9, 0xc2, 0x3d, 0xa5, 0xfb, 0xb3, 0x02, 0xd6, 0xf2, 0xed, 0xf8, 0x3c, 0x72,
-	0x2c, 0x86, 0x8a, 0x44, 0xa8, 0x4c, 0x88, 0x50, 0x91, 0x89, 0x30, 0x27, 0xab, 0x85, 0x09, 0x59,
-	0x29, 0x73, 0xc8, 0xaa, 0x48, 0xbd, 0xe5, 0x49, 0x7e, 0x8a, 0x4c, 0xbd, 0xdd, 0x13, 0xd0, 0x2c,
-	0xec, 0xe8, 0x64, 0x0a, 0x23, 0x82, 0xed, 0x64, 0xde, 0xf9, 0x14, 0xca, 0x85, 0xd5, 0x85, 0x86,
-	0x4f, 0xe1, 0x35, 0x50, 0x71, 0x70, 0x60, 0x79, 0x45, 0x5a, 0x17, 0x32, 0xd8, 0x06, 0xcb, 0xc9,
-	0xc4, 0x73, 0x17, 0x65, 0x49, 0x5f, 0xf5, 0xb1, 0x9b, 0x98, 0x77, 0x7f, 0x04, 0x70, 0x96, 0xab,
-	0xe1, 0x0d, 0x50, 0x0b, 0x50, 0x80, 0xc9, 0xd0, 0x0c, 0xfa, 0xd2, 0x01, 0x94, 0x8c, 0xe5, 0x54,
-	0xbc, 0xdf, 0x87, 0xd7, 0x41, 0xd5, 0xf1, 0xe8, 0xcb, 0x04, 0xb0, 0x20, 0x01, 0x2a, 0x89, 0x70,
-	0xbf, 0x0f, 0x6f, 0x83, 0x2a, 0xc1, 0x98, 0x99, 0x47, 0x54, 0xc4, 0x5d, 0x11, 0x63, 0x51, 0x49,
-	0xc4, 0x47, 0xfc, 0x7c, 0x30, 0xfb, 0x9c, 0x76, 0x5f, 0x35, 0x00, 0xc8, 0x33, 0xb8, 0xac, 0xba,
-	0x2f, 0x1a, 0xbe, 0x78, 0xd5, 0x8b, 0xf3, 0xdf, 0x3c, 0xdf, 0x9c, 0x45, 0x89, 0x4b, 0xef, 0xa7,
-	0xc4, 0xea, 0x05, 0xe9, 0xb0, 0x72, 0x31, 0x3a, 0xac, 0x9e, 0x4b, 0x87, 0x47, 0xe7, 0x92, 0x5c,
-	0x4a, 0x37, 0xff, 0x17, 0x07, 0xd1, 0x96, 0x90, 0x19, 0x26, 0xa4, 0x17, 0x23, 0x3b, 0x89, 0x76,
-	0x6b, 0xe7, 0xd3, 0xae, 0xd4, 0x25, 0x60, 0x4e, 0x97, 0x14, 0xfa, 0xac, 0x3e, 0xb7, 0xcf, 0x8a,
-	0x94, 0xd9, 0x98, 0x4f, 0x99, 0x45, 0xf6, 0x6d, 0x9e, 0xc1, 0xbe, 0x13, 0x62, 0x5d, 0x91, 0x89,
-	0x35, 0x1f, 0xe4, 0xd5, 0x73, 0x07, 0xb9, 0x48, 0x9e, 0x6b, 0xf3, 0xc9, 0x53, 0x9e, 0xb7, 0xf5,
-	0x39, 0xf3, 0x36, 0xc3, 0xae, 0xf0, 0x2c, 0x76, 0x2d, 0xee, 0x8d, 0x8d, 0x33, 0x9e, 0xec, 0x9f,
-	0x4e, 0xbd, 0x0a, 0x36, 0xdf, 0xf3, 0x2a, 0x28, 0xbe, 0x07, 0xf4, 0x39, 0x0f, 0xe9, 0xad, 0x73,
-	0x1f, 0xd2, 0xb3, 0x4f, 0xe7, 0x33, 0x08, 0xfe, 0xca, 0x87, 0x25, 0xf8, 0xed, 0x0f, 0x42, 0xf0,
-	0xea, 0x07, 0x23, 0xf8, 0xab, 0x97, 0x4d, 0xf0, 0xad, 0xcb, 0x23, 0xf8, 0x9d, 0xb3, 0x09, 0x7e,
-	0xf6, 0x4f, 0xce, 0xb5, 0xff, 0xfc, 0x27, 0x47, 0xbf, 0xf3, 0xe6, 0xad, 0x56, 0xfa, 0xe3, 0xad,
-	0x56, 0x7a, 0xf7, 0x56, 0x53, 0x7e, 0x1a, 0x6b, 0xca, 0xaf, 0x63, 0x4d, 0x79, 0x3d, 0xd6, 0x94,
-	0x37, 0x63, 0x4d, 0xf9, 0x6b, 0xac, 0x29, 0xff, 0x8c, 0xb5, 0xd2, 0xbb, 0xb1, 0xa6, 0xfc, 0xf2,
-	0xb7, 0x56, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xe1, 0xa5, 0xda, 0x29, 0x25, 0x10, 0x00, 0x00,
+	// 1607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcb, 0x6f, 0xdb, 0xc8,
+	0x19, 0x37, 0x2d, 0x5b, 0xb2, 0x46, 0x4f, 0x8f, 0xe5, 0x98, 0x91, 0x6d, 0x49, 0x76, 0x83, 0x44,
+	0x4d, 0x53, 0x05, 0x30, 0x7a, 0x08, 0xda, 0x1e, 0x1a, 0x26, 0x4e, 0x50, 0xc4, 0x4e, 0x0d, 0x39,
+	0x49, 0x1f, 0x40, 0x4b, 0x50, 0xe4, 0x98, 0x26, 0x42, 0x72, 0x84, 0x99, 0xa1, 0x5c, 0xa1, 0x05,
+	0xda, 0xc3, 0x1e, 0xf6, 0xb6, 0xfb, 0x3f, 0xec, 0x65, 0xff, 0x94, 0x1c, 0x7d, 0x5c, 0xec, 0x41,
+	0xd8, 0x78, 0x2f, 0x0b, 0x9f, 0xf2, 0x27, 0x2c, 0x38, 0x1c, 0x4a, 0x43, 0x89, 0x76, 0xbc, 0x0b,
+	0x6d, 0x6e, 0xe4, 0xf7, 0x9c, 0xef, 0x9b, 0xef, 0xf1, 0x1b, 0xb0, 0x6a, 0x21, 0xea, 0x10, 0x64,
+	0xe9, 0x2e, 0xe9, 0x77, 0xfa, 0x04, 0x33, 0x0c, 0xb3, 0x1e, 0xb6, 0x90, 0x4b, 0xeb, 0xbf, 0xb5,
+	0x1d, 0x76, 0x1a, 0xf4, 0x3a, 0x26, 0xf6, 0x1e, 0xda, 0xd8, 0xc6, 0x0f, 0x39, 0xbb, 0x17, 0x9c,
+	0xf0, 0x3f, 0xfe, 0xc3, 0xbf, 0x22, 0xb5, 0xfa, 0x2d, 0x0f, 0x5b, 0xce, 0x89, 0x63, 0x1a, 0xcc,
+	0xc1, 0xbe, 0xce, 0x0c, 0x5b, 0xd0, 0x4b, 0x86, 0x19, 0x52, 0xa8, 0xf8, 0xdd, 0x30, 0x0d, 0xf3,
+	0x14, 0x59, 0xba, 0x85, 0xfa, 0xc8, 0xb7, 0x90, 0x6f, 0x0e, 0x05, 0xa3, 0x46, 0x91, 0x19, 0x10,
+	0x87, 0x0d, 0x75, 0x9b, 0xe0, 0x40, 0x1c, 0xa6, 0xbe, 0x89, 0xfc, 0x81, 0x43, 0xb0, 0xef, 0x21,
+	0x9f, 0xe9, 0x03, 0x83, 0x38, 0x46, 0xcf, 0x45, 0xb1, 0x2d, 0x38, 0xc0, 0x6e, 0xe0, 0x21, 0xdd,
+	0xc3, 0x81, 0xcf, 0x62, 0x77, 0x3e, 0x62, 0x67, 0x98, 0xbc, 0x8d, 0x7e, 0x77, 0xcf, 0xb3, 0x40,
+	0x7d, 0x1a, 0x85, 0x78, 0xd0, 0x3d, 0x3a, 0x0e, 0x5d, 0x07, 0xae, 0xe3, 0xdb, 0x7f, 0xf6, 0x4f,
+	0x30, 0x7c, 0x01, 0x2a, 0x52, 0xf8, 0xfa, 0x5b, 0x34, 0x54, 0x95, 0x96, 0xd2, 0x2e, 0xec, 0xad,
+	0x77, 0xa2, 0x1c, 0x74, 0x26, 0xaa, 0x2f, 0xd0, 0x50, 0x2b, 0xbe, 0x1b, 0x35, 0x17, 0xce, 0x47,
+	0x4d, 0xe5, 0x72, 0xd4, 0x5c, 0xe8, 0x96, 0x84, 0xee, 0x01, 0xe9, 0xbf, 0x40, 0x43, 0x78, 0x07,
+	0x00, 0xc3, 0xf7, 0x31, 0xe3, 0xf1, 0xab, 0x8b, 0x2d, 0xa5, 0x9d, 0xd7, 0x96, 0x42, 0x85, 0xae,
+	0x44, 0x87, 0xbb, 0x20, 0xef, 0xf8, 0x94, 0x19, 0xbe, 0x89, 0xa8, 0x9a, 0x69, 0x29, 0xed, 0x65,
+	0x21, 0x34, 0x21, 0xc3, 0x7f, 0x80, 0x9a, 0x7c, 0x2c, 0x82, 0x28, 0x0e, 0x88, 0x89, 0xd4, 0x25,
+	0x7e, 0xb6, 0xfa, 0xec, 0xd9, 0xba, 0x42, 0x62, 0xea, 0x80, 0x70, 0x72, 0xc0, 0x58, 0x02, 0xde,
+	0x05, 0x59, 0x82, 0x03, 0x86, 0xa8, 0xba, 0xdc, 0x52, 0xda, 0x45, 0xad, 0x1c, 0x6a, 0x7c, 0x3b,
+	0x6a, 0x66, 0xbb, 0x9c, 0xda, 0x15, 0x5c, 0x78, 0x04, 0xaa, 0xd3, 0xf7, 0xa9, 0x66, 0xb9, 0xff,
+	0x8d, 0xd8, 0xff, 0xa1, 0xc4, 0x7f, 0x65, 0xd8, 0x53, 0xce, 0x2b, 0x5e, 0x92, 0x0d, 0x7b, 0xa0,
+	0x2a, 0xae, 0xab, 0xef, 0x1a, 0x26, 0x0a, 0x2f, 0x54, 0xcd, 0x25, 0x2d, 0xbe, 0xe1, 0xfc, 0xa3,
+	0x98, 0xad, 0x35, 0x2e, 0x47, 0xcd, 0xfa, 0xb4, 0xd2, 0x03, 0xec, 0x39, 0x0c, 0x79, 0x7d, 0x36,
+	0xec, 0x56, 0x06, 0x49, 0x05, 0xa8, 0x81, 0xd2, 0xf8, 0xe7, 0x95, 0x61, 0x53, 0x75, 0xa5, 0x95,
+	0x69, 0xe7, 0xb5, 0xad, 0xcb, 0x51, 0x53, 0x1d, 0x1b, 0x08, 0x63, 0xa1, 0x92, 0x95, 0xa4, 0x0a,
+	0xfc, 0x2f, 0xa8, 0x78, 0x88, 0x19, 0x96, 0xc1, 0x0c, 0xdd, 0x35, 0x7a, 0xc8, 0xa5, 0x2a, 0x68,
+	0x65, 0xda, 0x85, 0xbd, 0xdf, 0xcd, 0x26, 0x3e, 0x59, 0x4f, 0x9d, 0x43, 0xa1, 0x77, 0xc0, 0xd5,
+	0xf6, 0x7d, 0x46, 0x86, 0xda, 0xf6, 0xe5, 0xa8, 0x79, 0x7b, 0xca, 0xa0, 0xe4, 0xbc, 0xec, 0x25,
+	0x74, 0x60, 0x17, 0x14, 0x28, 0x33, 0x08, 0xd3, 0x31, 0xb1, 0x10, 0x51, 0x0b, 0x2d, 0xa5, 0x5d,
+	0xde, 0x83, 0xb1, 0xe7, 0xe3, 0x90, 0xf5, 0x97, 0x90, 0xa3, 0x6d, 0x87, 0xd9, 0xbe, 0x1c, 0x35,
+	0xd7, 0x25, 0x71, 0xc9, 0x2e, 0xa0, 0x63, 0xd1, 0xfa, 0x21, 0x58, 0x4b, 0x39, 0x19, 0xbc, 0x05,
+	0x32, 0x71, 0xc5, 0xc7, 0x95, 0x1a, 0x12, 0x60, 0x1d, 0x2c, 0x0f, 0x0c, 0x37, 0x40, 0x89, 0x1a,
+	0x8e, 0x48, 0xbf, 0x5f, 0x7c, 0xa4, 0xec, 0x7e, 0x5e, 0x00, 0xab, 0x52, 0xed, 0x05, 0xfe, 0xfc,
+	0x7b, 0xe9, 0x9f, 0x60, 0x3d, 0xb5, 0xef, 0xd5, 0x45, 0x7e, 0x13, 0x9b, 0xb1, 0xc9, 0xfd, 0x89,
+	0xd0, 0x1b, 0x21, 0xa3, 0x15, 0x44, 0x62, 0x32, 0xc8, 0x1f, 0x74, 0x6b, 0x68, 0x56, 0x82, 0xc2,
+	0x3b, 0x60, 0x99, 0x22, 0x16, 0xf4, 0x79, 0x03, 0x16, 0xf6, 0xca, 0xb1, 0xb9, 0xc7, 0x7c, 0x52,
+	0x75, 0x23, 0x66, 0xd8, 0x2a, 0xd1, 0xe8, 0x52, 0x97, 0x52, 0xc5, 0x04, 0x17, 0xb6, 0x41, 0xce,
+	0xc3, 0xbe, 0xc3, 0x30, 0x51, 0x97, 0x53, 0x05, 0x63, 0x36, 0xfc, 0x17, 0xa8, 0x5b, 0xa8, 0x4f,
+	0x90, 0x69, 0x30, 0x64, 0xe9, 0xd1, 0xc5, 0x31, 0xc7, 0x43, 0x38, 0x60, 0x3a, 0xe5, 0xed, 0x55,
+	0xd2, 0x76, 0xc4, 0xf1, 0x37, 0x12, 0xec, 0xc9, 0xcd, 0xaa, 0x4a, 0x77, 0x63, 0x62, 0x84, 0x17,
+	0xc4, 0xab, 0x48, 0xe6, 0x38, 0x1c, 0x41, 0x7d, 0xe2, 0x0c, 0x1c, 0x17, 0xd9, 0xc8, 0xe2, 0xcd,
+	0xb5, 0x12, 0x8f, 0xa0, 0x09, 0x1d, 0xfe, 0x0a, 0x00, 0xb3, 0x1f, 0xe8, 0x67, 0xc8, 0xb1, 0x4f,
+	0x99, 0xba, 0xc2, 0xbd, 0x8a, 0x19, 0x64, 0xf6, 0x83, 0xbf, 0x72, 0x32, 0xac, 0x81, 0xe5, 0x3e,
+	0x26, 0x8c, 0xaa, 0xf9, 0x56, 0xa6, 0x5d, 0xea, 0x46, 0x3f, 0x50, 0x03, 0x45, 0x64, 0x13, 0x44,
+	0xa9, 0x4e, 0x02, 0x17, 0xc5, 0x8d, 0x71, 0x7b, 0x5c, 0x9e, 0x62, 0x82, 0x3f, 0x0f, 0x07, 0x78,
+	0x37, 0x70, 0x91, 0xb0, 0x5b, 0x88, 0x94, 0x42, 0x0a, 0x0d, 0xdd, 0xbb, 0xd8, 0xd6, 0xc5, 0x4c,
+	0x2b, 0x48, 0x35, 0x96, 0x77, 0xb1, 0x7d, 0xcc, 0xc9, 0xf0, 0x1e, 0x28, 0x7a, 0x88, 0x11, 0xc7,
+	0xa4, 0xba, 0x1d, 0x38, 0x96, 0x5a, 0x94, 0xc4, 0x0a, 0x82, 0xf3, 0x3c, 0x70, 0xa2, 0x60, 0x08,
+	0xe2, 0xf9, 0x34, 0x98, 0x5a, 0x6a, 0x29, 0xed, 0xcc, 0x38, 0x98, 0x88, 0xfe, 0x98, 0x41, 0x17,
+	0xac, 0x4d, 0x6f, 0x1d, 0x07, 0x51, 0xb5, 0xcc, 0x4f, 0xaf, 0xc6, 0xa7, 0x7f, 0xc2, 0x45, 0x9e,
+	0x8e, 0xf7, 0x92, 0xb6, 0x73, 0x39, 0x6a, 0x6e, 0xa7, 0x28, 0x4a, 0x6d, 0x06, 0xcd, 0xa4, 0x92,
+	0x83, 0x28, 0xfc, 0x1b, 0xa8, 0xb9, 0xc8, 0x36, 0xcc, 0xa1, 0x6e, 0xe1, 0x33, 0xdf, 0xc5, 0x86,
+	0xa5, 0x07, 0x14, 0x11, 0xb5, 0xc2, 0x63, 0xb8, 0x2b, 0xee, 0xb7, 0x91, 0x26, 0x23, 0x5b, 0x8e,
+	0xf8, 0x4f, 0x05, 0xfb, 0x35, 0x45, 0x04, 0xfe, 0x07, 0xb4, 0x18, 0x09, 0x28, 0x2f, 0x9e, 0x21,
+	0x65, 0xc8, 0xd3, 0x4d, 0x44, 0x58, 0x34, 0x65, 0x11, 0xd5, 0xfb, 0x06, 0x3b, 0x55, 0xab, 0xdc,
+	0xcb, 0x9e, 0xf0, 0x72, 0xff, 0x63, 0xf2, 0x92, 0xc7, 0x6d, 0x21, 0x7b, 0xcc, 0x45, 0x9f, 0x48,
+	0x92, 0x47, 0x06, 0x3b, 0x85, 0xaf, 0x41, 0x49, 0x5e, 0xb7, 0x54, 0x5d, 0xe5, 0xe9, 0x5b, 0x4b,
+	0x0e, 0xef, 0xc3, 0x90, 0xa7, 0x6d, 0x86, 0x05, 0x9c, 0x90, 0x96, 0xfc, 0x14, 0x07, 0x13, 0x49,
+	0x0a, 0xff, 0x04, 0x72, 0x62, 0x63, 0xab, 0x90, 0x77, 0x4f, 0x25, 0x36, 0xf8, 0x32, 0x22, 0x6b,
+	0xeb, 0x97, 0xa3, 0xe6, 0xaa, 0x90, 0x91, 0xcc, 0xc4, 0x6a, 0xb0, 0x03, 0xaa, 0xc9, 0x56, 0xf2,
+	0xa8, 0xba, 0x26, 0x15, 0x42, 0x99, 0x4a, 0x4d, 0x72, 0x48, 0xe1, 0x10, 0x54, 0xd1, 0xbf, 0x19,
+	0xf2, 0x69, 0xb8, 0xd7, 0x4e, 0x1c, 0xe4, 0x5a, 0x54, 0xad, 0xf1, 0x58, 0x3a, 0x29, 0xab, 0x35,
+	0x1a, 0x6f, 0x9d, 0xfd, 0x58, 0xe3, 0x19, 0x57, 0x88, 0x66, 0x3b, 0xdf, 0x4f, 0xd3, 0xb6, 0xe4,
+	0xfd, 0x84, 0x92, 0x5a, 0xf5, 0x97, 0xa0, 0x96, 0x66, 0xe8, 0x66, 0xa3, 0xb8, 0x38, 0x3b, 0x8a,
+	0xbf, 0x50, 0x40, 0x91, 0xe3, 0x1c, 0x5d, 0xac, 0xed, 0x47, 0xe3, 0xf5, 0xae, 0xf0, 0x88, 0x5a,
+	0x71, 0x44, 0xb2, 0x54, 0x27, 0xda, 0xf5, 0xdc, 0x75, 0xbc, 0xf0, 0xeb, 0xfb, 0xa0, 0x20, 0x91,
+	0x7f, 0xf6, 0x89, 0x3e, 0x53, 0x40, 0x75, 0x92, 0xbd, 0xd7, 0x7d, 0xcb, 0x60, 0x28, 0x09, 0x7a,
+	0x94, 0x31, 0xe8, 0x51, 0x64, 0xd0, 0x33, 0x01, 0x26, 0x8b, 0x63, 0x60, 0xa2, 0xa4, 0x00, 0x93,
+	0x24, 0xcc, 0xca, 0x8c, 0xcf, 0xa7, 0xc8, 0x30, 0x6b, 0xf7, 0x0c, 0x94, 0x12, 0xeb, 0x26, 0x1c,
+	0x28, 0x7d, 0x82, 0x4d, 0x44, 0xc5, 0x40, 0x91, 0x03, 0x2b, 0x08, 0x0e, 0x1f, 0x28, 0x5b, 0x20,
+	0x6b, 0x61, 0xcf, 0x70, 0x92, 0x10, 0x4e, 0xd0, 0x60, 0x13, 0xac, 0x84, 0xc3, 0x8b, 0x9b, 0xc8,
+	0x48, 0xfc, 0x9c, 0x8b, 0xed, 0x50, 0x7d, 0xf7, 0x7f, 0x00, 0xce, 0xe2, 0x32, 0xb8, 0x03, 0xf2,
+	0x1e, 0xf2, 0x30, 0x19, 0xea, 0x5e, 0x4f, 0x4a, 0xc0, 0x42, 0x77, 0x25, 0x22, 0x1f, 0xf6, 0xe0,
+	0x36, 0xc8, 0x59, 0x0e, 0x7d, 0x1b, 0x0a, 0x2c, 0x4a, 0x02, 0xd9, 0x90, 0x78, 0xd8, 0x83, 0xf7,
+	0x40, 0x8e, 0x60, 0xcc, 0xf4, 0x13, 0x2a, 0xfc, 0x96, 0x45, 0x87, 0x67, 0x43, 0xf2, 0x09, 0xcf,
+	0x0f, 0x66, 0xcf, 0xe8, 0xee, 0x57, 0x55, 0x00, 0x26, 0x27, 0x98, 0x57, 0xdc, 0x37, 0x75, 0x9f,
+	0xbc, 0xea, 0xa5, 0x74, 0x7c, 0xfb, 0xf7, 0xab, 0xb6, 0xfb, 0xf2, 0xc7, 0xb7, 0x7b, 0xee, 0x86,
+	0x9b, 0x3d, 0x7b, 0xb3, 0xcd, 0x9e, 0xbb, 0x76, 0xb3, 0x9f, 0x5c, 0xbb, 0xaf, 0xa3, 0xcd, 0xf9,
+	0x6b, 0x91, 0x88, 0xa6, 0x24, 0x19, 0xcb, 0xf8, 0xf4, 0x66, 0x7b, 0x5b, 0x42, 0x10, 0xf9, 0xeb,
+	0x11, 0x84, 0x54, 0x25, 0x20, 0xa5, 0x4a, 0x12, 0x75, 0x56, 0x48, 0xad, 0xb3, 0xe4, 0xf6, 0x2f,
+	0xa6, 0x6f, 0xff, 0x24, 0x90, 0x28, 0x5d, 0x01, 0x24, 0xc6, 0x18, 0xa1, 0x2c, 0x63, 0x84, 0x49,
+	0x23, 0x57, 0xae, 0x6d, 0xe4, 0x24, 0x0e, 0xa8, 0xa6, 0xe3, 0x00, 0xb9, 0xdf, 0x56, 0x53, 0xfa,
+	0x6d, 0x06, 0x28, 0xc0, 0xab, 0x80, 0x42, 0x72, 0x6e, 0xac, 0x5d, 0xf1, 0x3c, 0xfb, 0xe3, 0x14,
+	0xc0, 0xa9, 0x7d, 0x04, 0xe0, 0x24, 0xa1, 0x8d, 0x96, 0xf2, 0x68, 0x5a, 0xbf, 0xf6, 0xd1, 0x34,
+	0xfb, 0x4c, 0xba, 0x02, 0xab, 0xdc, 0xfa, 0xb4, 0x58, 0x65, 0xe3, 0x93, 0x60, 0x15, 0xf5, 0x93,
+	0x61, 0x95, 0xdb, 0xf3, 0xc6, 0x2a, 0xf5, 0xf9, 0x61, 0x95, 0xcd, 0x6b, 0xb0, 0xca, 0xcc, 0x83,
+	0x76, 0xeb, 0xa7, 0x3f, 0x68, 0xff, 0x00, 0x80, 0x85, 0x5c, 0x24, 0x20, 0x72, 0x83, 0x7b, 0xdb,
+	0x12, 0x39, 0xaf, 0x4d, 0x38, 0x92, 0x81, 0xbc, 0xa0, 0x3e, 0x66, 0xd0, 0x9f, 0x7d, 0x0d, 0x37,
+	0x79, 0x2e, 0xef, 0xce, 0x62, 0xa5, 0x5f, 0xe0, 0xfd, 0xdb, 0x9a, 0xc3, 0xfb, 0x17, 0x92, 0x14,
+	0xc0, 0xb7, 0xc3, 0x83, 0xb8, 0x97, 0x12, 0xc4, 0x7c, 0x90, 0xde, 0x7c, 0xdf, 0xdc, 0xf3, 0x06,
+	0x8e, 0xf7, 0x7f, 0x03, 0xc0, 0x24, 0x97, 0xb0, 0x08, 0x56, 0x8e, 0x0c, 0x62, 0xb8, 0x2e, 0x72,
+	0xab, 0x0b, 0xb0, 0x02, 0x0a, 0x07, 0xc8, 0xb0, 0x10, 0x79, 0xe6, 0x10, 0xca, 0xaa, 0x8a, 0xf6,
+	0xe0, 0xfc, 0x7d, 0x43, 0xf9, 0xe6, 0x7d, 0x63, 0xe1, 0xc3, 0xfb, 0x86, 0xf2, 0xff, 0x8b, 0x86,
+	0xf2, 0xf5, 0x45, 0x43, 0x79, 0x77, 0xd1, 0x50, 0xce, 0x2f, 0x1a, 0xca, 0x77, 0x17, 0x0d, 0xe5,
+	0x87, 0x8b, 0xc6, 0xc2, 0x87, 0x8b, 0x86, 0xf2, 0xe5, 0xf7, 0x8d, 0x85, 0x1f, 0x07, 0x00, 0x7a,
+	0x37, 0xb2, 0xe6, 0x52, 0x14, 0x00, 0x00,
 }
@@ -0,0 +1,76 @@
+package models
+
+import "fmt"
+
+// LintWarningMemoryMB is the memory limit below which a DesiredLRP is warned
+// that it is likely to be killed for running out of memory.
+const LintWarningMemoryMB = 64
+
+const (
+	LintCheckLowMemory      = "low-memory"
+	LintCheckNoHealthCheck  = "no-health-check"
+	LintCheckNoStartTimeout = "no-start-timeout"
+)
+
+// DesiredLRPLintChecks are the checks run by DesiredLRP.Lint. Each returns a
+// warning when the DesiredLRP is valid but set up in a way that is likely to
+// be a mistake, and nil otherwise.
+var DesiredLRPLintChecks = []func(*DesiredLRP) *LintWarning{
+	lintLowMemory,
+	lintNoHealthCheck,
+	lintNoStartTimeout,
+}
+
+func NewLintWarning(check, field, message string) *LintWarning {
+	return &LintWarning{
+		Check:   check,
+		Field:   field,
+		Message: message,
+	}
+}
+
+// Lint reports the warnings of the DesiredLRPLintChecks. Unlike Validate, the
+// warnings do not make the DesiredLRP invalid, and a DesiredLRP is desired
+// whatever they are.
+func (desired *DesiredLRP) Lint() []*LintWarning {
+	var warnings []*LintWarning
+	for _, check := range DesiredLRPLintChecks {
+		if warning := check(desired); warning != nil {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
+
+func lintLowMemory(desired *DesiredLRP) *LintWarning {
+	if desired.MemoryMb <= 0 || desired.MemoryMb >= LintWarningMemoryMB {
+		return nil
+	}
+	return NewLintWarning(
+		LintCheckLowMemory,
+		"memory_mb",
+		fmt.Sprintf("a memory limit of %d MB is likely to get the instances killed for running out of memory", desired.MemoryMb),
+	)
+}
+
+func lintNoHealthCheck(desired *DesiredLRP) *LintWarning {
+	if desired.Monitor != nil {
+		return nil
+	}
+	return NewLintWarning(
+		LintCheckNoHealthCheck,
+		"monitor",
+		"without a monitor the instances are considered healthy as soon as they start",
+	)
+}
+
+func lintNoStartTimeout(desired *DesiredLRP) *LintWarning {
+	if desired.Monitor == nil || desired.StartTimeoutMs > 0 {
+		return nil
+	}
+	return NewLintWarning(
+		LintCheckNoStartTimeout,
+		"start_timeout_ms",
+		"without a start timeout an instance that never becomes healthy is never restarted",
+	)
+}
@@ -0,0 +1,90 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRP Lint", func() {
+	var desiredLRP *models.DesiredLRP
+
+	BeforeEach(func() {
+		desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+	})
+
+	checks := func(warnings []*models.LintWarning) []string {
+		names := []string{}
+		for _, warning := range warnings {
+			names = append(names, warning.Check)
+		}
+		return names
+	}
+
+	It("has no warnings for a well configured DesiredLRP", func() {
+		Expect(desiredLRP.Lint()).To(BeEmpty())
+	})
+
+	It("does not affect validation", func() {
+		desiredLRP.MemoryMb = 1
+		desiredLRP.Monitor = nil
+		Expect(desiredLRP.Lint()).NotTo(BeEmpty())
+		Expect(desiredLRP.Validate()).To(Succeed())
+	})
+
+	Describe("low-memory", func() {
+		It("warns about a memory limit below the threshold", func() {
+			desiredLRP.MemoryMb = models.LintWarningMemoryMB - 1
+			Expect(desiredLRP.Lint()).To(ConsistOf(&models.LintWarning{
+				Check:   models.LintCheckLowMemory,
+				Field:   "memory_mb",
+				Message: "a memory limit of 63 MB is likely to get the instances killed for running out of memory",
+			}))
+		})
+
+		It("does not warn at the threshold", func() {
+			desiredLRP.MemoryMb = models.LintWarningMemoryMB
+			Expect(checks(desiredLRP.Lint())).NotTo(ContainElement(models.LintCheckLowMemory))
+		})
+
+		It("does not warn when memory is unlimited", func() {
+			desiredLRP.MemoryMb = 0
+			Expect(checks(desiredLRP.Lint())).NotTo(ContainElement(models.LintCheckLowMemory))
+		})
+	})
+
+	Describe("no-health-check", func() {
+		It("warns when there is no monitor", func() {
+			desiredLRP.Monitor = nil
+			Expect(checks(desiredLRP.Lint())).To(Equal([]string{models.LintCheckNoHealthCheck}))
+		})
+
+		It("does not also warn about the start timeout", func() {
+			desiredLRP.Monitor = nil
+			desiredLRP.StartTimeoutMs = 0
+			Expect(checks(desiredLRP.Lint())).To(Equal([]string{models.LintCheckNoHealthCheck}))
+		})
+	})
+
+	Describe("no-start-timeout", func() {
+		It("warns when a monitored DesiredLRP has no start timeout", func() {
+			desiredLRP.StartTimeoutMs = 0
+			Expect(desiredLRP.Lint()).To(ConsistOf(&models.LintWarning{
+				Check:   models.LintCheckNoStartTimeout,
+				Field:   "start_timeout_ms",
+				Message: "without a start timeout an instance that never becomes healthy is never restarted",
+			}))
+		})
+	})
+
+	It("reports every warning in the order of the checks", func() {
+		desiredLRP.MemoryMb = 16
+		desiredLRP.Monitor = nil
+		Expect(checks(desiredLRP.Lint())).To(Equal([]string{
+			models.LintCheckLowMemory,
+			models.LintCheckNoHealthCheck,
+		}))
+	})
+})
@@ -1,7 +1,18 @@
 package models
 
 func (request *DesiredLRPsRequest) Validate() error {
-	return nil
+	_, err := request.ParseLabelSelector()
+	return err
+}
+
+// ParseLabelSelector returns the selector of the request, requiring the
+// labels of the deprecated LabelSelector as well as those of Selector.
+func (request *DesiredLRPsRequest) ParseLabelSelector() (LabelSelector, error) {
+	selector, err := ParseLabelSelector(request.Selector)
+	if err != nil {
+		return nil, err
+	}
+	return append(NewLabelSelectorFromMap(request.LabelSelector), selector...), nil
 }
 
 func (request *DesiredLRPByProcessGuidRequest) Validate() error {
@@ -27,6 +38,10 @@ func (request *DesireLRPRequest) Validate() error {
 		validationError = validationError.Append(err)
 	}
 
+	if !validIdempotencyKey(request.IdempotencyKey) {
+		validationError = validationError.Append(ErrInvalidField{"idempotency_key"})
+	}
+
 	if !validationError.Empty() {
 		return validationError
 	}
@@ -41,10 +56,10 @@ func (request *UpdateDesiredLRPRequest) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"process_guid"})
 	}
 
-	if request.Update != nil {
-		if err := request.Update.Validate(); err != nil {
-			validationError = validationError.Append(err)
-		}
+	if request.Update == nil {
+		validationError = validationError.Append(ErrInvalidField{"update"})
+	} else if err := request.Update.Validate(); err != nil {
+		validationError = validationError.Append(err)
 	}
 
 	if !validationError.Empty() {
@@ -67,3 +82,40 @@ func (request *RemoveDesiredLRPRequest) Validate() error {
 
 	return nil
 }
+
+func (request *DesiredLRPDiffRequest) Validate() error {
+	var validationError ValidationError
+
+	processGuids := make(map[string]struct{}, len(request.DesiredLrps))
+	for _, desiredLRP := range request.DesiredLrps {
+		if desiredLRP == nil || desiredLRP.ProcessGuid == "" {
+			validationError = validationError.Append(ErrInvalidField{"process_guid"})
+			break
+		}
+		if desiredLRP.ContentHash == "" {
+			validationError = validationError.Append(ErrInvalidField{"content_hash"})
+			break
+		}
+		if _, ok := processGuids[desiredLRP.ProcessGuid]; ok {
+			validationError = validationError.Append(ErrInvalidField{"desired_lrps"})
+			break
+		}
+		processGuids[desiredLRP.ProcessGuid] = struct{}{}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// Validate only checks that the request carries a DesiredLRP. The DesiredLRP
+// itself is what the request asks the BBS to validate.
+func (request *ValidateDesiredLRPRequest) Validate() error {
+	if request.DesiredLrp == nil {
+		return ValidationError{ErrInvalidField{"desired_lrp"}}
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
 import sort "sort"
 import strconv "strconv"
 import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -24,6 +25,9 @@ var _ = math.Inf
 
 type DesiredLRPLifecycleResponse struct {
 	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	// Lint warnings about the DesiredLRP. They never cause the request to be
+	// rejected.
+	Warnings []*LintWarning `protobuf:"bytes,2,rep,name=warnings" json:"warnings,omitempty"`
 }
 
 func (m *DesiredLRPLifecycleResponse) Reset()      { *m = DesiredLRPLifecycleResponse{} }
@@ -39,15 +43,57 @@ func (m *DesiredLRPLifecycleResponse) GetError() *Error {
 	return nil
 }
 
+func (m *DesiredLRPLifecycleResponse) GetWarnings() []*LintWarning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type LintWarning struct {
+	Check   string `protobuf:"bytes,1,opt,name=check" json:"check"`
+	Field   string `protobuf:"bytes,2,opt,name=field" json:"field"`
+	Message string `protobuf:"bytes,3,opt,name=message" json:"message"`
+}
+
+func (m *LintWarning) Reset()                    { *m = LintWarning{} }
+func (*LintWarning) ProtoMessage()               {}
+func (*LintWarning) Descriptor() ([]byte, []int) { return fileDescriptorDesiredLrpRequests, []int{1} }
+
+func (m *LintWarning) GetCheck() string {
+	if m != nil {
+		return m.Check
+	}
+	return ""
+}
+
+func (m *LintWarning) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *LintWarning) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type DesiredLRPsResponse struct {
 	Error       *Error        `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	DesiredLrps []*DesiredLRP `protobuf:"bytes,2,rep,name=desired_lrps,json=desiredLrps" json:"desired_lrps,omitempty"`
+	// The revision of the desired LRPs read before listing them. Passing it as
+	// modified_since_revision lists the DesiredLRPs changed since.
+	Revision     uint64         `protobuf:"varint,3,opt,name=revision" json:"revision"`
+	RecordErrors []*RecordError `protobuf:"bytes,4,rep,name=record_errors,json=recordErrors" json:"record_errors,omitempty"`
 }
 
 func (m *DesiredLRPsResponse) Reset()      { *m = DesiredLRPsResponse{} }
 func (*DesiredLRPsResponse) ProtoMessage() {}
 func (*DesiredLRPsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorDesiredLrpRequests, []int{1}
+	return fileDescriptorDesiredLrpRequests, []int{2}
 }
 
 func (m *DesiredLRPsResponse) GetError() *Error {
@@ -64,14 +110,37 @@ func (m *DesiredLRPsResponse) GetDesiredLrps() []*DesiredLRP {
 	return nil
 }
 
+func (m *DesiredLRPsResponse) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *DesiredLRPsResponse) GetRecordErrors() []*RecordError {
+	if m != nil {
+		return m.RecordErrors
+	}
+	return nil
+}
+
 type DesiredLRPsRequest struct {
-	Domain string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	Domain       string   `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	ProcessGuids []string `protobuf:"bytes,2,rep,name=process_guids,json=processGuids" json:"process_guids,omitempty"`
+	// Deprecated: use selector, which can express every label_selector.
+	LabelSelector map[string]string `protobuf:"bytes,3,rep,name=label_selector,json=labelSelector" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// A label selector in the grammar described on models.LabelSelector.
+	Selector string `protobuf:"bytes,4,opt,name=selector" json:"selector,omitempty"`
+	// Only lists the DesiredLRPs desired, updated or removed after the given
+	// revision of a previous listing. 0 lists them all.
+	ModifiedSinceRevision uint64 `protobuf:"varint,5,opt,name=modified_since_revision,json=modifiedSinceRevision" json:"modified_since_revision,omitempty"`
+	PartialResults        bool   `protobuf:"varint,6,opt,name=partial_results,json=partialResults" json:"partial_results,omitempty"`
 }
 
 func (m *DesiredLRPsRequest) Reset()      { *m = DesiredLRPsRequest{} }
 func (*DesiredLRPsRequest) ProtoMessage() {}
 func (*DesiredLRPsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorDesiredLrpRequests, []int{2}
+	return fileDescriptorDesiredLrpRequests, []int{3}
 }
 
 func (m *DesiredLRPsRequest) GetDomain() string {
@@ -81,6 +150,41 @@ func (m *DesiredLRPsRequest) GetDomain() string {
 	return ""
 }
 
+func (m *DesiredLRPsRequest) GetProcessGuids() []string {
+	if m != nil {
+		return m.ProcessGuids
+	}
+	return nil
+}
+
+func (m *DesiredLRPsRequest) GetLabelSelector() map[string]string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
+func (m *DesiredLRPsRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *DesiredLRPsRequest) GetModifiedSinceRevision() uint64 {
+	if m != nil {
+		return m.ModifiedSinceRevision
+	}
+	return 0
+}
+
+func (m *DesiredLRPsRequest) GetPartialResults() bool {
+	if m != nil {
+		return m.PartialResults
+	}
+	return false
+}
+
 type DesiredLRPResponse struct {
 	Error      *Error      `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	DesiredLrp *DesiredLRP `protobuf:"bytes,2,opt,name=desired_lrp,json=desiredLrp" json:"desired_lrp,omitempty"`
@@ -89,7 +193,7 @@ type DesiredLRPResponse struct {
 func (m *DesiredLRPResponse) Reset()      { *m = DesiredLRPResponse{} }
 func (*DesiredLRPResponse) ProtoMessage() {}
 func (*DesiredLRPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorDesiredLrpRequests, []int{3}
+	return fileDescriptorDesiredLrpRequests, []int{4}
 }
 
 func (m *DesiredLRPResponse) GetError() *Error {
@@ -109,12 +213,13 @@ func (m *DesiredLRPResponse) GetDesiredLrp() *DesiredLRP {
 type DesiredLRPSchedulingInfosResponse struct {
 	Error                     *Error                      `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	DesiredLrpSchedulingInfos []*DesiredLRPSchedulingInfo `protobuf:"bytes,2,rep,name=desired_lrp_scheduling_infos,json=desiredLrpSchedulingInfos" json:"desired_lrp_scheduling_infos,omitempty"`
+	RecordErrors              []*RecordError              `protobuf:"bytes,3,rep,name=record_errors,json=recordErrors" json:"record_errors,omitempty"`
 }
 
 func (m *DesiredLRPSchedulingInfosResponse) Reset()      { *m = DesiredLRPSchedulingInfosResponse{} }
 func (*DesiredLRPSchedulingInfosResponse) ProtoMessage() {}
 func (*DesiredLRPSchedulingInfosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorDesiredLrpRequests, []int{4}
+	return fileDescriptorDesiredLrpRequests, []int{5}
 }
 
 func (m *DesiredLRPSchedulingInfosResponse) GetError() *Error {
@@ -131,6 +236,13 @@ func (m *DesiredLRPSchedulingInfosResponse) GetDesiredLrpSchedulingInfos() []*De
 	return nil
 }
 
+func (m *DesiredLRPSchedulingInfosResponse) GetRecordErrors() []*RecordError {
+	if m != nil {
+		return m.RecordErrors
+	}
+	return nil
+}
+
 type DesiredLRPByProcessGuidRequest struct {
 	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
 }
@@ -138,7 +250,7 @@ type DesiredLRPByProcessGuidRequest struct {
 func (m *DesiredLRPByProcessGuidRequest) Reset()      { *m = DesiredLRPByProcessGuidRequest{} }
 func (*DesiredLRPByProcessGuidRequest) ProtoMessage() {}
 func (*DesiredLRPByProcessGuidRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorDesiredLrpRequests, []int{5}
+	return fileDescriptorDesiredLrpRequests, []int{6}
 }
 
 func (m *DesiredLRPByProcessGuidRequest) GetProcessGuid() string {
@@ -149,13 +261,14 @@ func (m *DesiredLRPByProcessGuidRequest) GetProcessGuid() string {
 }
 
 type DesireLRPRequest struct {
-	DesiredLrp *DesiredLRP `protobuf:"bytes,1,opt,name=desired_lrp,json=desiredLrp" json:"desired_lrp,omitempty"`
+	DesiredLrp     *DesiredLRP `protobuf:"bytes,1,opt,name=desired_lrp,json=desiredLrp" json:"desired_lrp,omitempty"`
+	IdempotencyKey string      `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey" json:"idempotency_key,omitempty"`
 }
 
 func (m *DesireLRPRequest) Reset()      { *m = DesireLRPRequest{} }
 func (*DesireLRPRequest) ProtoMessage() {}
 func (*DesireLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorDesiredLrpRequests, []int{6}
+	return fileDescriptorDesiredLrpRequests, []int{7}
 }
 
 func (m *DesireLRPRequest) GetDesiredLrp() *DesiredLRP {
@@ -165,6 +278,13 @@ func (m *DesireLRPRequest) GetDesiredLrp() *DesiredLRP {
 	return nil
 }
 
+func (m *DesireLRPRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 type UpdateDesiredLRPRequest struct {
 	ProcessGuid string            `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
 	Update      *DesiredLRPUpdate `protobuf:"bytes,2,opt,name=update" json:"update,omitempty"`
@@ -173,7 +293,7 @@ type UpdateDesiredLRPRequest struct {
 func (m *UpdateDesiredLRPRequest) Reset()      { *m = UpdateDesiredLRPRequest{} }
 func (*UpdateDesiredLRPRequest) ProtoMessage() {}
 func (*UpdateDesiredLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorDesiredLrpRequests, []int{7}
+	return fileDescriptorDesiredLrpRequests, []int{8}
 }
 
 func (m *UpdateDesiredLRPRequest) GetProcessGuid() string {
@@ -192,12 +312,15 @@ func (m *UpdateDesiredLRPRequest) GetUpdate() *DesiredLRPUpdate {
 
 type RemoveDesiredLRPRequest struct {
 	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	// Leave the instances running for convergence to retire once they have been
+	// orphaned for -orphanedActualLRPGracePeriod, rather than stopping them.
+	KeepInstances bool `protobuf:"varint,2,opt,name=keep_instances,json=keepInstances" json:"keep_instances,omitempty"`
 }
 
 func (m *RemoveDesiredLRPRequest) Reset()      { *m = RemoveDesiredLRPRequest{} }
 func (*RemoveDesiredLRPRequest) ProtoMessage() {}
 func (*RemoveDesiredLRPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorDesiredLrpRequests, []int{8}
+	return fileDescriptorDesiredLrpRequests, []int{9}
 }
 
 func (m *RemoveDesiredLRPRequest) GetProcessGuid() string {
@@ -207,8 +330,149 @@ func (m *RemoveDesiredLRPRequest) GetProcessGuid() string {
 	return ""
 }
 
+func (m *RemoveDesiredLRPRequest) GetKeepInstances() bool {
+	if m != nil {
+		return m.KeepInstances
+	}
+	return false
+}
+
+type DesiredLRPContentHash struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	ContentHash string `protobuf:"bytes,2,opt,name=content_hash,json=contentHash" json:"content_hash"`
+}
+
+func (m *DesiredLRPContentHash) Reset()      { *m = DesiredLRPContentHash{} }
+func (*DesiredLRPContentHash) ProtoMessage() {}
+func (*DesiredLRPContentHash) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{10}
+}
+
+func (m *DesiredLRPContentHash) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *DesiredLRPContentHash) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+type DesiredLRPDiffRequest struct {
+	Domain      string                   `protobuf:"bytes,1,opt,name=domain" json:"domain,omitempty"`
+	DesiredLrps []*DesiredLRPContentHash `protobuf:"bytes,2,rep,name=desired_lrps,json=desiredLrps" json:"desired_lrps,omitempty"`
+}
+
+func (m *DesiredLRPDiffRequest) Reset()      { *m = DesiredLRPDiffRequest{} }
+func (*DesiredLRPDiffRequest) ProtoMessage() {}
+func (*DesiredLRPDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{11}
+}
+
+func (m *DesiredLRPDiffRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DesiredLRPDiffRequest) GetDesiredLrps() []*DesiredLRPContentHash {
+	if m != nil {
+		return m.DesiredLrps
+	}
+	return nil
+}
+
+type DesiredLRPDiffResponse struct {
+	Error               *Error   `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	MissingProcessGuids []string `protobuf:"bytes,2,rep,name=missing_process_guids,json=missingProcessGuids" json:"missing_process_guids,omitempty"`
+	ChangedProcessGuids []string `protobuf:"bytes,3,rep,name=changed_process_guids,json=changedProcessGuids" json:"changed_process_guids,omitempty"`
+	ExtraProcessGuids   []string `protobuf:"bytes,4,rep,name=extra_process_guids,json=extraProcessGuids" json:"extra_process_guids,omitempty"`
+}
+
+func (m *DesiredLRPDiffResponse) Reset()      { *m = DesiredLRPDiffResponse{} }
+func (*DesiredLRPDiffResponse) ProtoMessage() {}
+func (*DesiredLRPDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{12}
+}
+
+func (m *DesiredLRPDiffResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DesiredLRPDiffResponse) GetMissingProcessGuids() []string {
+	if m != nil {
+		return m.MissingProcessGuids
+	}
+	return nil
+}
+
+func (m *DesiredLRPDiffResponse) GetChangedProcessGuids() []string {
+	if m != nil {
+		return m.ChangedProcessGuids
+	}
+	return nil
+}
+
+func (m *DesiredLRPDiffResponse) GetExtraProcessGuids() []string {
+	if m != nil {
+		return m.ExtraProcessGuids
+	}
+	return nil
+}
+
+type ValidateDesiredLRPRequest struct {
+	DesiredLrp *DesiredLRP `protobuf:"bytes,1,opt,name=desired_lrp,json=desiredLrp" json:"desired_lrp,omitempty"`
+}
+
+func (m *ValidateDesiredLRPRequest) Reset()      { *m = ValidateDesiredLRPRequest{} }
+func (*ValidateDesiredLRPRequest) ProtoMessage() {}
+func (*ValidateDesiredLRPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{13}
+}
+
+func (m *ValidateDesiredLRPRequest) GetDesiredLrp() *DesiredLRP {
+	if m != nil {
+		return m.DesiredLrp
+	}
+	return nil
+}
+
+type ValidateDesiredLRPResponse struct {
+	Error            *Error   `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	ValidationErrors []string `protobuf:"bytes,2,rep,name=validation_errors,json=validationErrors" json:"validation_errors,omitempty"`
+}
+
+func (m *ValidateDesiredLRPResponse) Reset()      { *m = ValidateDesiredLRPResponse{} }
+func (*ValidateDesiredLRPResponse) ProtoMessage() {}
+func (*ValidateDesiredLRPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{14}
+}
+
+func (m *ValidateDesiredLRPResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ValidateDesiredLRPResponse) GetValidationErrors() []string {
+	if m != nil {
+		return m.ValidationErrors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DesiredLRPLifecycleResponse)(nil), "models.DesiredLRPLifecycleResponse")
+	proto.RegisterType((*LintWarning)(nil), "models.LintWarning")
 	proto.RegisterType((*DesiredLRPsResponse)(nil), "models.DesiredLRPsResponse")
 	proto.RegisterType((*DesiredLRPsRequest)(nil), "models.DesiredLRPsRequest")
 	proto.RegisterType((*DesiredLRPResponse)(nil), "models.DesiredLRPResponse")
@@ -217,6 +481,11 @@ func init() {
 	proto.RegisterType((*DesireLRPRequest)(nil), "models.DesireLRPRequest")
 	proto.RegisterType((*UpdateDesiredLRPRequest)(nil), "models.UpdateDesiredLRPRequest")
 	proto.RegisterType((*RemoveDesiredLRPRequest)(nil), "models.RemoveDesiredLRPRequest")
+	proto.RegisterType((*DesiredLRPContentHash)(nil), "models.DesiredLRPContentHash")
+	proto.RegisterType((*DesiredLRPDiffRequest)(nil), "models.DesiredLRPDiffRequest")
+	proto.RegisterType((*DesiredLRPDiffResponse)(nil), "models.DesiredLRPDiffResponse")
+	proto.RegisterType((*ValidateDesiredLRPRequest)(nil), "models.ValidateDesiredLRPRequest")
+	proto.RegisterType((*ValidateDesiredLRPResponse)(nil), "models.ValidateDesiredLRPResponse")
 }
 func (this *DesiredLRPLifecycleResponse) Equal(that interface{}) bool {
 	if that == nil {
@@ -246,6 +515,50 @@ func (this *DesiredLRPLifecycleResponse) Equal(that interface{}) bool {
 	if !this.Error.Equal(that1.Error) {
 		return false
 	}
+	if len(this.Warnings) != len(that1.Warnings) {
+		return false
+	}
+	for i := range this.Warnings {
+		if !this.Warnings[i].Equal(that1.Warnings[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *LintWarning) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*LintWarning)
+	if !ok {
+		that2, ok := that.(LintWarning)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Check != that1.Check {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPsResponse) Equal(that interface{}) bool {
@@ -284,6 +597,17 @@ func (this *DesiredLRPsResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Revision != that1.Revision {
+		return false
+	}
+	if len(this.RecordErrors) != len(that1.RecordErrors) {
+		return false
+	}
+	for i := range this.RecordErrors {
+		if !this.RecordErrors[i].Equal(that1.RecordErrors[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *DesiredLRPsRequest) Equal(that interface{}) bool {
@@ -314,6 +638,31 @@ func (this *DesiredLRPsRequest) Equal(that interface{}) bool {
 	if this.Domain != that1.Domain {
 		return false
 	}
+	if len(this.ProcessGuids) != len(that1.ProcessGuids) {
+		return false
+	}
+	for i := range this.ProcessGuids {
+		if this.ProcessGuids[i] != that1.ProcessGuids[i] {
+			return false
+		}
+	}
+	if len(this.LabelSelector) != len(that1.LabelSelector) {
+		return false
+	}
+	for i := range this.LabelSelector {
+		if this.LabelSelector[i] != that1.LabelSelector[i] {
+			return false
+		}
+	}
+	if this.Selector != that1.Selector {
+		return false
+	}
+	if this.ModifiedSinceRevision != that1.ModifiedSinceRevision {
+		return false
+	}
+	if this.PartialResults != that1.PartialResults {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPResponse) Equal(that interface{}) bool {
@@ -385,6 +734,14 @@ func (this *DesiredLRPSchedulingInfosResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.RecordErrors) != len(that1.RecordErrors) {
+		return false
+	}
+	for i := range this.RecordErrors {
+		if !this.RecordErrors[i].Equal(that1.RecordErrors[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *DesiredLRPByProcessGuidRequest) Equal(that interface{}) bool {
@@ -445,6 +802,9 @@ func (this *DesireLRPRequest) Equal(that interface{}) bool {
 	if !this.DesiredLrp.Equal(that1.DesiredLrp) {
 		return false
 	}
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
+	}
 	return true
 }
 func (this *UpdateDesiredLRPRequest) Equal(that interface{}) bool {
@@ -508,25 +868,236 @@ func (this *RemoveDesiredLRPRequest) Equal(that interface{}) bool {
 	if this.ProcessGuid != that1.ProcessGuid {
 		return false
 	}
+	if this.KeepInstances != that1.KeepInstances {
+		return false
+	}
 	return true
 }
-func (this *DesiredLRPLifecycleResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DesiredLRPContentHash) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.DesiredLRPLifecycleResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+
+	that1, ok := that.(*DesiredLRPContentHash)
+	if !ok {
+		that2, ok := that.(DesiredLRPContentHash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DesiredLRPsResponse) GoString() string {
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.ContentHash != that1.ContentHash {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPDiffRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPDiffRequest)
+	if !ok {
+		that2, ok := that.(DesiredLRPDiffRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if len(this.DesiredLrps) != len(that1.DesiredLrps) {
+		return false
+	}
+	for i := range this.DesiredLrps {
+		if !this.DesiredLrps[i].Equal(that1.DesiredLrps[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DesiredLRPDiffResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPDiffResponse)
+	if !ok {
+		that2, ok := that.(DesiredLRPDiffResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.MissingProcessGuids) != len(that1.MissingProcessGuids) {
+		return false
+	}
+	for i := range this.MissingProcessGuids {
+		if this.MissingProcessGuids[i] != that1.MissingProcessGuids[i] {
+			return false
+		}
+	}
+	if len(this.ChangedProcessGuids) != len(that1.ChangedProcessGuids) {
+		return false
+	}
+	for i := range this.ChangedProcessGuids {
+		if this.ChangedProcessGuids[i] != that1.ChangedProcessGuids[i] {
+			return false
+		}
+	}
+	if len(this.ExtraProcessGuids) != len(that1.ExtraProcessGuids) {
+		return false
+	}
+	for i := range this.ExtraProcessGuids {
+		if this.ExtraProcessGuids[i] != that1.ExtraProcessGuids[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidateDesiredLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ValidateDesiredLRPRequest)
+	if !ok {
+		that2, ok := that.(ValidateDesiredLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.DesiredLrp.Equal(that1.DesiredLrp) {
+		return false
+	}
+	return true
+}
+func (this *ValidateDesiredLRPResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ValidateDesiredLRPResponse)
+	if !ok {
+		that2, ok := that.(ValidateDesiredLRPResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.ValidationErrors) != len(that1.ValidationErrors) {
+		return false
+	}
+	for i := range this.ValidationErrors {
+		if this.ValidationErrors[i] != that1.ValidationErrors[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DesiredLRPLifecycleResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPLifecycleResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Warnings != nil {
+		s = append(s, "Warnings: "+fmt.Sprintf("%#v", this.Warnings)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LintWarning) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.LintWarning{")
+	s = append(s, "Check: "+fmt.Sprintf("%#v", this.Check)+",\n")
+	s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
 	s = append(s, "&models.DesiredLRPsResponse{")
 	if this.Error != nil {
 		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
@@ -534,6 +1105,10 @@ func (this *DesiredLRPsResponse) GoString() string {
 	if this.DesiredLrps != nil {
 		s = append(s, "DesiredLrps: "+fmt.Sprintf("%#v", this.DesiredLrps)+",\n")
 	}
+	s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	if this.RecordErrors != nil {
+		s = append(s, "RecordErrors: "+fmt.Sprintf("%#v", this.RecordErrors)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -541,9 +1116,28 @@ func (this *DesiredLRPsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 10)
 	s = append(s, "&models.DesiredLRPsRequest{")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	if this.ProcessGuids != nil {
+		s = append(s, "ProcessGuids: "+fmt.Sprintf("%#v", this.ProcessGuids)+",\n")
+	}
+	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
+	for k, _ := range this.LabelSelector {
+		keysForLabelSelector = append(keysForLabelSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
+	mapStringForLabelSelector := "map[string]string{"
+	for _, k := range keysForLabelSelector {
+		mapStringForLabelSelector += fmt.Sprintf("%#v: %#v,", k, this.LabelSelector[k])
+	}
+	mapStringForLabelSelector += "}"
+	if this.LabelSelector != nil {
+		s = append(s, "LabelSelector: "+mapStringForLabelSelector+",\n")
+	}
+	s = append(s, "Selector: "+fmt.Sprintf("%#v", this.Selector)+",\n")
+	s = append(s, "ModifiedSinceRevision: "+fmt.Sprintf("%#v", this.ModifiedSinceRevision)+",\n")
+	s = append(s, "PartialResults: "+fmt.Sprintf("%#v", this.PartialResults)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -566,7 +1160,7 @@ func (this *DesiredLRPSchedulingInfosResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.DesiredLRPSchedulingInfosResponse{")
 	if this.Error != nil {
 		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
@@ -574,6 +1168,9 @@ func (this *DesiredLRPSchedulingInfosResponse) GoString() string {
 	if this.DesiredLrpSchedulingInfos != nil {
 		s = append(s, "DesiredLrpSchedulingInfos: "+fmt.Sprintf("%#v", this.DesiredLrpSchedulingInfos)+",\n")
 	}
+	if this.RecordErrors != nil {
+		s = append(s, "RecordErrors: "+fmt.Sprintf("%#v", this.RecordErrors)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -591,11 +1188,12 @@ func (this *DesireLRPRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&models.DesireLRPRequest{")
 	if this.DesiredLrp != nil {
 		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
 	}
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -616,9 +1214,82 @@ func (this *RemoveDesiredLRPRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&models.RemoveDesiredLRPRequest{")
 	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "KeepInstances: "+fmt.Sprintf("%#v", this.KeepInstances)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPContentHash) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPContentHash{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "ContentHash: "+fmt.Sprintf("%#v", this.ContentHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPDiffRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPDiffRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	if this.DesiredLrps != nil {
+		s = append(s, "DesiredLrps: "+fmt.Sprintf("%#v", this.DesiredLrps)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPDiffResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.DesiredLRPDiffResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.MissingProcessGuids != nil {
+		s = append(s, "MissingProcessGuids: "+fmt.Sprintf("%#v", this.MissingProcessGuids)+",\n")
+	}
+	if this.ChangedProcessGuids != nil {
+		s = append(s, "ChangedProcessGuids: "+fmt.Sprintf("%#v", this.ChangedProcessGuids)+",\n")
+	}
+	if this.ExtraProcessGuids != nil {
+		s = append(s, "ExtraProcessGuids: "+fmt.Sprintf("%#v", this.ExtraProcessGuids)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidateDesiredLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ValidateDesiredLRPRequest{")
+	if this.DesiredLrp != nil {
+		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidateDesiredLRPResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ValidateDesiredLRPResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.ValidationErrors != nil {
+		s = append(s, "ValidationErrors: "+fmt.Sprintf("%#v", this.ValidationErrors)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -673,10 +1344,22 @@ func (m *DesiredLRPLifecycleResponse) MarshalTo(data []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.Warnings) > 0 {
+		for _, msg := range m.Warnings {
+			data[i] = 0x12
+			i++
+			i = encodeVarintDesiredLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *DesiredLRPsResponse) Marshal() (data []byte, err error) {
+func (m *LintWarning) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -686,20 +1369,50 @@ func (m *DesiredLRPsResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *DesiredLRPsResponse) MarshalTo(data []byte) (int, error) {
+func (m *LintWarning) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintDesiredLrpRequests(data, i, uint64(m.Error.Size()))
-		n2, err := m.Error.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	data[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.Check)))
+	i += copy(data[i:], m.Check)
+	data[i] = 0x12
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.Field)))
+	i += copy(data[i:], m.Field)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.Message)))
+	i += copy(data[i:], m.Message)
+	return i, nil
+}
+
+func (m *DesiredLRPsResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DesiredLRPsResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintDesiredLrpRequests(data, i, uint64(m.Error.Size()))
+		n2, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
 	}
 	if len(m.DesiredLrps) > 0 {
 		for _, msg := range m.DesiredLrps {
@@ -713,6 +1426,21 @@ func (m *DesiredLRPsResponse) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	data[i] = 0x18
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(m.Revision))
+	if len(m.RecordErrors) > 0 {
+		for _, msg := range m.RecordErrors {
+			data[i] = 0x22
+			i++
+			i = encodeVarintDesiredLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -735,6 +1463,58 @@ func (m *DesiredLRPsRequest) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.Domain)))
 	i += copy(data[i:], m.Domain)
+	if len(m.ProcessGuids) > 0 {
+		for _, s := range m.ProcessGuids {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.LabelSelector) > 0 {
+		keysForLabelSelector := make([]string, 0, len(m.LabelSelector))
+		for k, _ := range m.LabelSelector {
+			keysForLabelSelector = append(keysForLabelSelector, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
+		for _, k := range keysForLabelSelector {
+			data[i] = 0x1a
+			i++
+			v := m.LabelSelector[string(k)]
+			mapSize := 1 + len(k) + sovDesiredLrpRequests(uint64(len(k))) + 1 + len(v) + sovDesiredLrpRequests(uint64(len(v)))
+			i = encodeVarintDesiredLrpRequests(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintDesiredLrpRequests(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintDesiredLrpRequests(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	data[i] = 0x22
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.Selector)))
+	i += copy(data[i:], m.Selector)
+	data[i] = 0x28
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(m.ModifiedSinceRevision))
+	data[i] = 0x30
+	i++
+	if m.PartialResults {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -813,6 +1593,18 @@ func (m *DesiredLRPSchedulingInfosResponse) MarshalTo(data []byte) (int, error)
 			i += n
 		}
 	}
+	if len(m.RecordErrors) > 0 {
+		for _, msg := range m.RecordErrors {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintDesiredLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -863,6 +1655,10 @@ func (m *DesireLRPRequest) MarshalTo(data []byte) (int, error) {
 		}
 		i += n6
 	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.IdempotencyKey)))
+	i += copy(data[i:], m.IdempotencyKey)
 	return i, nil
 }
 
@@ -917,6 +1713,218 @@ func (m *RemoveDesiredLRPRequest) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.ProcessGuid)))
 	i += copy(data[i:], m.ProcessGuid)
+	data[i] = 0x10
+	i++
+	if m.KeepInstances {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *DesiredLRPContentHash) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DesiredLRPContentHash) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.ProcessGuid)))
+	i += copy(data[i:], m.ProcessGuid)
+	data[i] = 0x12
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.ContentHash)))
+	i += copy(data[i:], m.ContentHash)
+	return i, nil
+}
+
+func (m *DesiredLRPDiffRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DesiredLRPDiffRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRequests(data, i, uint64(len(m.Domain)))
+	i += copy(data[i:], m.Domain)
+	if len(m.DesiredLrps) > 0 {
+		for _, msg := range m.DesiredLrps {
+			data[i] = 0x12
+			i++
+			i = encodeVarintDesiredLrpRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DesiredLRPDiffResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DesiredLRPDiffResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintDesiredLrpRequests(data, i, uint64(m.Error.Size()))
+		n8, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.MissingProcessGuids) > 0 {
+		for _, s := range m.MissingProcessGuids {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.ChangedProcessGuids) > 0 {
+		for _, s := range m.ChangedProcessGuids {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.ExtraProcessGuids) > 0 {
+		for _, s := range m.ExtraProcessGuids {
+			data[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ValidateDesiredLRPRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ValidateDesiredLRPRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DesiredLrp != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintDesiredLrpRequests(data, i, uint64(m.DesiredLrp.Size()))
+		n9, err := m.DesiredLrp.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *ValidateDesiredLRPResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ValidateDesiredLRPResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintDesiredLrpRequests(data, i, uint64(m.Error.Size()))
+		n10, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.ValidationErrors) > 0 {
+		for _, s := range m.ValidationErrors {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -954,6 +1962,24 @@ func (m *DesiredLRPLifecycleResponse) Size() (n int) {
 		l = m.Error.Size()
 		n += 1 + l + sovDesiredLrpRequests(uint64(l))
 	}
+	if len(m.Warnings) > 0 {
+		for _, e := range m.Warnings {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LintWarning) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Check)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	l = len(m.Field)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
 	return n
 }
 
@@ -970,6 +1996,13 @@ func (m *DesiredLRPsResponse) Size() (n int) {
 			n += 1 + l + sovDesiredLrpRequests(uint64(l))
 		}
 	}
+	n += 1 + sovDesiredLrpRequests(uint64(m.Revision))
+	if len(m.RecordErrors) > 0 {
+		for _, e := range m.RecordErrors {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -978,6 +2011,24 @@ func (m *DesiredLRPsRequest) Size() (n int) {
 	_ = l
 	l = len(m.Domain)
 	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	if len(m.ProcessGuids) > 0 {
+		for _, s := range m.ProcessGuids {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	if len(m.LabelSelector) > 0 {
+		for k, v := range m.LabelSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDesiredLrpRequests(uint64(len(k))) + 1 + len(v) + sovDesiredLrpRequests(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDesiredLrpRequests(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Selector)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	n += 1 + sovDesiredLrpRequests(uint64(m.ModifiedSinceRevision))
+	n += 2
 	return n
 }
 
@@ -1008,6 +2059,12 @@ func (m *DesiredLRPSchedulingInfosResponse) Size() (n int) {
 			n += 1 + l + sovDesiredLrpRequests(uint64(l))
 		}
 	}
+	if len(m.RecordErrors) > 0 {
+		for _, e := range m.RecordErrors {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1026,6 +2083,8 @@ func (m *DesireLRPRequest) Size() (n int) {
 		l = m.DesiredLrp.Size()
 		n += 1 + l + sovDesiredLrpRequests(uint64(l))
 	}
+	l = len(m.IdempotencyKey)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
 	return n
 }
 
@@ -1046,19 +2105,98 @@ func (m *RemoveDesiredLRPRequest) Size() (n int) {
 	_ = l
 	l = len(m.ProcessGuid)
 	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	n += 2
 	return n
 }
 
-func sovDesiredLrpRequests(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
-}
+func (m *DesiredLRPContentHash) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	l = len(m.ContentHash)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	return n
+}
+
+func (m *DesiredLRPDiffRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	if len(m.DesiredLrps) > 0 {
+		for _, e := range m.DesiredLrps {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DesiredLRPDiffResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if len(m.MissingProcessGuids) > 0 {
+		for _, s := range m.MissingProcessGuids {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	if len(m.ChangedProcessGuids) > 0 {
+		for _, s := range m.ChangedProcessGuids {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	if len(m.ExtraProcessGuids) > 0 {
+		for _, s := range m.ExtraProcessGuids {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidateDesiredLRPRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.DesiredLrp != nil {
+		l = m.DesiredLrp.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidateDesiredLRPResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if len(m.ValidationErrors) > 0 {
+		for _, s := range m.ValidationErrors {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDesiredLrpRequests(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
 func sozDesiredLrpRequests(x uint64) (n int) {
 	return sovDesiredLrpRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -1068,6 +2206,19 @@ func (this *DesiredLRPLifecycleResponse) String() string {
 	}
 	s := strings.Join([]string{`&DesiredLRPLifecycleResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Warnings:` + strings.Replace(fmt.Sprintf("%v", this.Warnings), "LintWarning", "LintWarning", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LintWarning) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LintWarning{`,
+		`Check:` + fmt.Sprintf("%v", this.Check) + `,`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1079,6 +2230,8 @@ func (this *DesiredLRPsResponse) String() string {
 	s := strings.Join([]string{`&DesiredLRPsResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`DesiredLrps:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrps), "DesiredLRP", "DesiredLRP", 1) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`RecordErrors:` + strings.Replace(fmt.Sprintf("%v", this.RecordErrors), "RecordError", "RecordError", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1087,8 +2240,23 @@ func (this *DesiredLRPsRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
+	for k, _ := range this.LabelSelector {
+		keysForLabelSelector = append(keysForLabelSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
+	mapStringForLabelSelector := "map[string]string{"
+	for _, k := range keysForLabelSelector {
+		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
+	}
+	mapStringForLabelSelector += "}"
 	s := strings.Join([]string{`&DesiredLRPsRequest{`,
 		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`ProcessGuids:` + fmt.Sprintf("%v", this.ProcessGuids) + `,`,
+		`LabelSelector:` + mapStringForLabelSelector + `,`,
+		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
+		`ModifiedSinceRevision:` + fmt.Sprintf("%v", this.ModifiedSinceRevision) + `,`,
+		`PartialResults:` + fmt.Sprintf("%v", this.PartialResults) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1111,6 +2279,7 @@ func (this *DesiredLRPSchedulingInfosResponse) String() string {
 	s := strings.Join([]string{`&DesiredLRPSchedulingInfosResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`DesiredLrpSchedulingInfos:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrpSchedulingInfos), "DesiredLRPSchedulingInfo", "DesiredLRPSchedulingInfo", 1) + `,`,
+		`RecordErrors:` + strings.Replace(fmt.Sprintf("%v", this.RecordErrors), "RecordError", "RecordError", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1131,6 +2300,7 @@ func (this *DesireLRPRequest) String() string {
 	}
 	s := strings.Join([]string{`&DesireLRPRequest{`,
 		`DesiredLrp:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrp), "DesiredLRP", "DesiredLRP", 1) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1152,6 +2322,63 @@ func (this *RemoveDesiredLRPRequest) String() string {
 	}
 	s := strings.Join([]string{`&RemoveDesiredLRPRequest{`,
 		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`KeepInstances:` + fmt.Sprintf("%v", this.KeepInstances) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPContentHash) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPContentHash{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`ContentHash:` + fmt.Sprintf("%v", this.ContentHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPDiffRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPDiffRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`DesiredLrps:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrps), "DesiredLRPContentHash", "DesiredLRPContentHash", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPDiffResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPDiffResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`MissingProcessGuids:` + fmt.Sprintf("%v", this.MissingProcessGuids) + `,`,
+		`ChangedProcessGuids:` + fmt.Sprintf("%v", this.ChangedProcessGuids) + `,`,
+		`ExtraProcessGuids:` + fmt.Sprintf("%v", this.ExtraProcessGuids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidateDesiredLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidateDesiredLRPRequest{`,
+		`DesiredLrp:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrp), "DesiredLRP", "DesiredLRP", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidateDesiredLRPResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidateDesiredLRPResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ValidationErrors:` + fmt.Sprintf("%v", this.ValidationErrors) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1226,6 +2453,37 @@ func (m *DesiredLRPLifecycleResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, &LintWarning{})
+			if err := m.Warnings[len(m.Warnings)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
@@ -1247,7 +2505,7 @@ func (m *DesiredLRPLifecycleResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPsResponse) Unmarshal(data []byte) error {
+func (m *LintWarning) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1270,17 +2528,17 @@ func (m *DesiredLRPsResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LintWarning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LintWarning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDesiredLrpRequests
@@ -1290,30 +2548,55 @@ func (m *DesiredLRPsResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDesiredLrpRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
+			m.Check = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Field = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDesiredLrpRequests
@@ -1323,23 +2606,1140 @@ func (m *DesiredLRPsResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDesiredLrpRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredLrps = append(m.DesiredLrps, &DesiredLRP{})
-			if err := m.DesiredLrps[len(m.DesiredLrps)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Message = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPsResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredLrps = append(m.DesiredLrps, &DesiredLRP{})
+			if err := m.DesiredLrps[len(m.DesiredLrps)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Revision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordErrors = append(m.RecordErrors, &RecordError{})
+			if err := m.RecordErrors[len(m.RecordErrors)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuids = append(m.ProcessGuids, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.LabelSelector == nil {
+				m.LabelSelector = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrpRequests
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrpRequests
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthDesiredLrpRequests
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.LabelSelector[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.LabelSelector[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedSinceRevision", wireType)
+			}
+			m.ModifiedSinceRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ModifiedSinceRevision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialResults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialResults = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredLrp == nil {
+				m.DesiredLrp = &DesiredLRP{}
+			}
+			if err := m.DesiredLrp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPSchedulingInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPSchedulingInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrpSchedulingInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredLrpSchedulingInfos = append(m.DesiredLrpSchedulingInfos, &DesiredLRPSchedulingInfo{})
+			if err := m.DesiredLrpSchedulingInfos[len(m.DesiredLrpSchedulingInfos)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordErrors = append(m.RecordErrors, &RecordError{})
+			if err := m.RecordErrors[len(m.RecordErrors)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPByProcessGuidRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPByProcessGuidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPByProcessGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesireLRPRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesireLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesireLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredLrp == nil {
+				m.DesiredLrp = &DesiredLRP{}
+			}
+			if err := m.DesiredLrp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDesiredLRPRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDesiredLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &DesiredLRPUpdate{}
+			}
+			if err := m.Update.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDesiredLRPRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDesiredLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepInstances", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepInstances = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
@@ -1361,7 +3761,7 @@ func (m *DesiredLRPsResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPsRequest) Unmarshal(data []byte) error {
+func (m *DesiredLRPContentHash) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1384,15 +3784,15 @@ func (m *DesiredLRPsRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DesiredLRPContentHash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DesiredLRPContentHash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1417,7 +3817,36 @@ func (m *DesiredLRPsRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(data[iNdEx:postIndex])
+			m.ProcessGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1440,7 +3869,7 @@ func (m *DesiredLRPsRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPResponse) Unmarshal(data []byte) error {
+func (m *DesiredLRPDiffRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1463,17 +3892,17 @@ func (m *DesiredLRPResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DesiredLRPDiffRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DesiredLRPDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDesiredLrpRequests
@@ -1483,28 +3912,24 @@ func (m *DesiredLRPResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDesiredLrpRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
-			}
-			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1528,10 +3953,8 @@ func (m *DesiredLRPResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredLrp == nil {
-				m.DesiredLrp = &DesiredLRP{}
-			}
-			if err := m.DesiredLrp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.DesiredLrps = append(m.DesiredLrps, &DesiredLRPContentHash{})
+			if err := m.DesiredLrps[len(m.DesiredLrps)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1556,7 +3979,7 @@ func (m *DesiredLRPResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(data []byte) error {
+func (m *DesiredLRPDiffResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1579,10 +4002,10 @@ func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPSchedulingInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DesiredLRPDiffResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPSchedulingInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DesiredLRPDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1620,9 +4043,9 @@ func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(data []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrpSchedulingInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingProcessGuids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDesiredLrpRequests
@@ -1632,76 +4055,53 @@ func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDesiredLrpRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredLrpSchedulingInfos = append(m.DesiredLrpSchedulingInfos, &DesiredLRPSchedulingInfo{})
-			if err := m.DesiredLrpSchedulingInfos[len(m.DesiredLrpSchedulingInfos)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MissingProcessGuids = append(m.MissingProcessGuids, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDesiredLrpRequests
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedProcessGuids", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DesiredLRPByProcessGuidRequest) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDesiredLrpRequests
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPByProcessGuidRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPByProcessGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ChangedProcessGuids = append(m.ChangedProcessGuids, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraProcessGuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1726,7 +4126,7 @@ func (m *DesiredLRPByProcessGuidRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessGuid = string(data[iNdEx:postIndex])
+			m.ExtraProcessGuids = append(m.ExtraProcessGuids, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1749,7 +4149,7 @@ func (m *DesiredLRPByProcessGuidRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *DesireLRPRequest) Unmarshal(data []byte) error {
+func (m *ValidateDesiredLRPRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1772,10 +4172,10 @@ func (m *DesireLRPRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesireLRPRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateDesiredLRPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesireLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1832,7 +4232,7 @@ func (m *DesireLRPRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *UpdateDesiredLRPRequest) Unmarshal(data []byte) error {
+func (m *ValidateDesiredLRPResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1855,44 +4255,15 @@ func (m *UpdateDesiredLRPRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateDesiredLRPRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateDesiredLRPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateDesiredLRPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDesiredLrpRequests
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDesiredLrpRequests
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProcessGuid = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1916,66 +4287,16 @@ func (m *UpdateDesiredLRPRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Update == nil {
-				m.Update = &DesiredLRPUpdate{}
+			if m.Error == nil {
+				m.Error = &Error{}
 			}
-			if err := m.Update.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDesiredLrpRequests(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDesiredLrpRequests
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveDesiredLRPRequest) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDesiredLrpRequests
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDesiredLRPRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationErrors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2000,7 +4321,7 @@ func (m *RemoveDesiredLRPRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessGuid = string(data[iNdEx:postIndex])
+			m.ValidationErrors = append(m.ValidationErrors, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2131,32 +4452,68 @@ var (
 func init() { proto.RegisterFile("desired_lrp_requests.proto", fileDescriptorDesiredLrpRequests) }
 
 var fileDescriptorDesiredLrpRequests = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x52, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xf7, 0x15, 0x88, 0xc4, 0xe7, 0x22, 0x81, 0x19, 0x6a, 0x42, 0x75, 0x84, 0x63, 0xa0, 0x03,
-	0xb8, 0x28, 0x88, 0x17, 0xb0, 0x40, 0x55, 0xa5, 0x0c, 0xd5, 0x21, 0x66, 0x2b, 0xf5, 0x5d, 0xdc,
-	0x93, 0x6c, 0x9f, 0x7b, 0x67, 0x23, 0x75, 0xe3, 0x11, 0x78, 0x0c, 0x24, 0x5e, 0x24, 0x63, 0x46,
-	0x26, 0x44, 0xcc, 0xc2, 0x98, 0x47, 0x40, 0xb9, 0x73, 0xb0, 0x93, 0x30, 0x60, 0xb1, 0xf9, 0xfb,
-	0xbe, 0xdf, 0x3f, 0xfd, 0xce, 0x30, 0x64, 0x5c, 0x0b, 0xc5, 0x59, 0x94, 0xaa, 0x22, 0x52, 0xfc,
-	0xba, 0xe2, 0xba, 0xd4, 0x41, 0xa1, 0x64, 0x29, 0xbd, 0x41, 0x26, 0x19, 0x4f, 0xf5, 0xf0, 0x65,
-	0x22, 0xca, 0xab, 0xea, 0x32, 0x88, 0x65, 0x76, 0x9a, 0xc8, 0x44, 0x9e, 0x9a, 0xf3, 0x65, 0x35,
-	0x33, 0x93, 0x19, 0xcc, 0x97, 0xa5, 0x0d, 0x1f, 0x74, 0x24, 0x9b, 0x95, 0xcb, 0x95, 0x92, 0xca,
-	0x0e, 0x24, 0x84, 0xc7, 0x6f, 0x2d, 0x62, 0x42, 0x2f, 0x26, 0x62, 0xc6, 0xe3, 0x9b, 0x38, 0xe5,
-	0x94, 0xeb, 0x42, 0xe6, 0x9a, 0x7b, 0xcf, 0xe0, 0x8e, 0x41, 0xfb, 0x68, 0x84, 0x4e, 0xdc, 0xf1,
-	0xbd, 0xc0, 0xa6, 0x08, 0xde, 0xad, 0x97, 0xd4, 0xde, 0xc8, 0x35, 0x3c, 0x6c, 0x35, 0x74, 0x2f,
-	0xae, 0xf7, 0x06, 0x0e, 0x3b, 0x09, 0xb5, 0x7f, 0x30, 0xba, 0x75, 0xe2, 0x8e, 0xbd, 0x0d, 0xb6,
-	0xd5, 0xa5, 0x6e, 0x83, 0x9b, 0xa8, 0x42, 0x93, 0x31, 0x78, 0x5b, 0x96, 0xa6, 0x2a, 0xef, 0x18,
-	0x06, 0x4c, 0x66, 0x53, 0x91, 0x1b, 0xcb, 0xbb, 0xe1, 0xed, 0xf9, 0xf7, 0x27, 0x0e, 0x6d, 0x76,
-	0x24, 0xef, 0x72, 0xfa, 0xa5, 0x7c, 0x0d, 0x6e, 0x27, 0xa5, 0x7f, 0x60, 0xa0, 0x7f, 0x0b, 0x09,
-	0x6d, 0x48, 0xf2, 0x15, 0xc1, 0xd3, 0xf6, 0xf4, 0x3e, 0xbe, 0xe2, 0xac, 0x4a, 0x45, 0x9e, 0x9c,
-	0xe7, 0x33, 0xd9, 0xb3, 0xa5, 0x29, 0x1c, 0x77, 0x7f, 0x0d, 0xfd, 0x47, 0x2b, 0x12, 0x6b, 0xb1,
-	0xa6, 0xb5, 0xd1, 0x7e, 0xa0, 0x6d, 0x57, 0xfa, 0xa8, 0x8d, 0xb7, 0x93, 0x87, 0x9c, 0x03, 0x6e,
-	0x69, 0xe1, 0xcd, 0x85, 0x92, 0x31, 0xd7, 0xfa, 0xac, 0x12, 0x6c, 0xd3, 0xee, 0x73, 0x38, 0x2c,
-	0xec, 0x36, 0x4a, 0x2a, 0xc1, 0xb6, 0x3a, 0x76, 0x8b, 0x16, 0x4f, 0xce, 0xe0, 0xbe, 0x95, 0x32,
-	0x3d, 0x5b, 0xf2, 0x4e, 0x83, 0xe8, 0x9f, 0x1a, 0x2c, 0xe1, 0xe8, 0x43, 0xc1, 0xa6, 0x25, 0xef,
-	0xbe, 0x5b, 0xbf, 0x30, 0xde, 0x2b, 0x18, 0x54, 0x46, 0xa3, 0x79, 0x35, 0x7f, 0xdf, 0xd3, 0x7a,
-	0xd0, 0x06, 0x47, 0x42, 0x38, 0xa2, 0x3c, 0x93, 0x1f, 0xff, 0xc3, 0x35, 0x7c, 0xb1, 0x58, 0x62,
-	0xe7, 0xdb, 0x12, 0x3b, 0xab, 0x25, 0x46, 0x9f, 0x6a, 0x8c, 0xbe, 0xd4, 0x18, 0xcd, 0x6b, 0x8c,
-	0x16, 0x35, 0x46, 0x3f, 0x6a, 0x8c, 0x7e, 0xd5, 0xd8, 0x59, 0xd5, 0x18, 0x7d, 0xfe, 0x89, 0x9d,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x42, 0x0e, 0x58, 0xf8, 0x03, 0x00, 0x00,
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x6f, 0xe3, 0xc4,
+	0x17, 0x8e, 0x93, 0xb4, 0xbf, 0xec, 0x4b, 0xd2, 0x5f, 0xeb, 0xaa, 0x5b, 0x37, 0xdd, 0xb5, 0x53,
+	0xf7, 0xb0, 0x45, 0xda, 0x4d, 0x57, 0x8b, 0x40, 0x68, 0x4f, 0x28, 0xec, 0x6a, 0x29, 0xe4, 0x50,
+	0x5c, 0x2d, 0x3d, 0x5a, 0xae, 0xfd, 0x92, 0x8c, 0x6a, 0x7b, 0x8c, 0xc7, 0x29, 0xe4, 0x86, 0x38,
+	0x21, 0x71, 0x00, 0x89, 0x0b, 0x57, 0x6e, 0xfc, 0x1d, 0x9c, 0xf6, 0xb8, 0x47, 0x4e, 0x11, 0x0d,
+	0x17, 0x94, 0xd3, 0xfe, 0x09, 0xc8, 0x63, 0x3b, 0x1e, 0x27, 0x41, 0xda, 0x00, 0xb7, 0xe4, 0x7d,
+	0xdf, 0x7c, 0xef, 0x7b, 0xf3, 0xde, 0xf3, 0x40, 0xcb, 0x41, 0x46, 0x42, 0x74, 0x4c, 0x37, 0x0c,
+	0xcc, 0x10, 0xbf, 0x18, 0x21, 0x8b, 0x58, 0x27, 0x08, 0x69, 0x44, 0xe5, 0x4d, 0x8f, 0x3a, 0xe8,
+	0xb2, 0xd6, 0xa3, 0x01, 0x89, 0x86, 0xa3, 0xab, 0x8e, 0x4d, 0xbd, 0xd3, 0x01, 0x1d, 0xd0, 0x53,
+	0x0e, 0x5f, 0x8d, 0xfa, 0xfc, 0x1f, 0xff, 0xc3, 0x7f, 0x25, 0xc7, 0x5a, 0x3b, 0x82, 0x64, 0x1a,
+	0xaa, 0x63, 0x18, 0xd2, 0x30, 0xf9, 0xa3, 0x33, 0x38, 0x7c, 0x96, 0x30, 0x7a, 0xc6, 0x79, 0x8f,
+	0xf4, 0xd1, 0x1e, 0xdb, 0x2e, 0x1a, 0xc8, 0x02, 0xea, 0x33, 0x94, 0x8f, 0x61, 0x83, 0xb3, 0x15,
+	0xa9, 0x2d, 0x9d, 0xd4, 0x9f, 0x34, 0x3b, 0x89, 0x8b, 0xce, 0xf3, 0x38, 0x68, 0x24, 0x98, 0x7c,
+	0x0a, 0xb5, 0x2f, 0xad, 0xd0, 0x27, 0xfe, 0x80, 0x29, 0xe5, 0x76, 0xe5, 0xa4, 0xfe, 0x64, 0x37,
+	0xe3, 0xf5, 0x88, 0x1f, 0x5d, 0x26, 0x98, 0x31, 0x27, 0xe9, 0x08, 0x75, 0x01, 0x90, 0x5b, 0xb0,
+	0x61, 0x0f, 0xd1, 0xbe, 0xe6, 0x49, 0xee, 0x74, 0xab, 0xaf, 0x26, 0x5a, 0xc9, 0x48, 0x42, 0x31,
+	0xd6, 0x27, 0xe8, 0x3a, 0x4a, 0x59, 0xc4, 0x78, 0x48, 0x56, 0xe1, 0x7f, 0x1e, 0x32, 0x66, 0x0d,
+	0x50, 0xa9, 0x08, 0x68, 0x16, 0xd4, 0x67, 0x12, 0xec, 0xe6, 0xc5, 0xb1, 0xf5, 0x8a, 0x7a, 0x0f,
+	0x1a, 0xc2, 0xd5, 0x65, 0x85, 0xc9, 0x19, 0x37, 0xd7, 0x35, 0xea, 0x29, 0xaf, 0x17, 0x06, 0x4c,
+	0x6e, 0x43, 0x2d, 0xc4, 0x1b, 0xc2, 0x08, 0xf5, 0xb9, 0xa9, 0x6a, 0x6a, 0x6a, 0x1e, 0x95, 0x5f,
+	0x42, 0x33, 0x44, 0x9b, 0x86, 0x8e, 0xc9, 0x13, 0x31, 0xa5, 0x5a, 0xbc, 0x32, 0x83, 0x83, 0xdc,
+	0x4b, 0xf7, 0x70, 0x36, 0xd1, 0xf6, 0x0b, 0xec, 0x87, 0xd4, 0x23, 0x11, 0x7a, 0x41, 0x34, 0x36,
+	0x1a, 0x61, 0xce, 0x64, 0xfa, 0xb7, 0x55, 0x90, 0x0b, 0xc5, 0xf2, 0xe9, 0x91, 0xef, 0xc1, 0xa6,
+	0x43, 0x3d, 0x8b, 0xf8, 0x85, 0xcb, 0x4d, 0x63, 0xf2, 0x31, 0x34, 0x83, 0x90, 0xda, 0xc8, 0x98,
+	0x39, 0x18, 0x11, 0x27, 0xa9, 0xf2, 0x8e, 0xd1, 0x48, 0x83, 0x2f, 0xe2, 0x98, 0x1c, 0xc1, 0x96,
+	0x6b, 0x5d, 0xa1, 0x6b, 0x32, 0x74, 0xd1, 0x8e, 0x68, 0xa8, 0x54, 0xb8, 0xe3, 0x47, 0xcb, 0x77,
+	0x91, 0xa5, 0xed, 0xf4, 0xe2, 0x03, 0x17, 0x29, 0xff, 0xb9, 0x1f, 0x85, 0xe3, 0xae, 0x3a, 0x9b,
+	0x68, 0x4a, 0x51, 0x28, 0x2f, 0x46, 0x91, 0x8c, 0xa6, 0x2b, 0x9e, 0x91, 0xdf, 0x87, 0xda, 0x3c,
+	0x5f, 0x95, 0x5b, 0x6f, 0xc5, 0xd6, 0x67, 0x13, 0x4d, 0x5e, 0x3e, 0x6e, 0xcc, 0xb9, 0xb2, 0x05,
+	0xfb, 0x1e, 0x75, 0x48, 0x9f, 0xa0, 0x63, 0x32, 0xe2, 0xdb, 0x68, 0xce, 0xfb, 0xb1, 0xc1, 0xfb,
+	0xf1, 0x4e, 0x2a, 0x73, 0xf4, 0x37, 0x34, 0x41, 0x75, 0x2f, 0xa3, 0x5c, 0xc4, 0x0c, 0x23, 0xeb,
+	0xe0, 0x27, 0xf0, 0xff, 0xc0, 0x0a, 0x23, 0x62, 0xb9, 0x66, 0x88, 0x6c, 0xe4, 0x46, 0x4c, 0xd9,
+	0x6c, 0x4b, 0x27, 0xb5, 0xee, 0x51, 0x2a, 0x7d, 0xb0, 0x00, 0x0b, 0x92, 0x5b, 0x29, 0x64, 0x24,
+	0x48, 0xab, 0x07, 0xf2, 0xf2, 0x5d, 0xc9, 0x77, 0xa1, 0x72, 0x8d, 0xe3, 0x42, 0xcb, 0xe2, 0x40,
+	0xbc, 0x0d, 0x37, 0x96, 0x3b, 0xc2, 0xe2, 0x36, 0xf0, 0xd0, 0xd3, 0xf2, 0x07, 0xd2, 0xd3, 0xea,
+	0x4f, 0x3f, 0x6b, 0x92, 0xee, 0x8b, 0x93, 0xb0, 0xde, 0xd4, 0xbf, 0x0b, 0x75, 0x61, 0xea, 0x79,
+	0x9a, 0xd5, 0x43, 0x0f, 0xf9, 0xd0, 0xeb, 0xdf, 0x94, 0xe1, 0x28, 0x87, 0x2e, 0xec, 0x21, 0x3a,
+	0x23, 0x97, 0xf8, 0x83, 0x33, 0xbf, 0x4f, 0xd7, 0xdc, 0x3a, 0x0b, 0xee, 0x89, 0xdf, 0x40, 0x36,
+	0xd7, 0x32, 0x49, 0x2c, 0x96, 0x6e, 0x61, 0x7b, 0xd9, 0x50, 0x31, 0xab, 0x71, 0x90, 0xdb, 0x5b,
+	0xf0, 0xb3, 0xbc, 0x7f, 0x95, 0xff, 0x64, 0xff, 0xce, 0x40, 0xcd, 0xdd, 0x74, 0xc7, 0xe7, 0xf9,
+	0x06, 0x65, 0xab, 0xf8, 0x00, 0x1a, 0xe2, 0xb2, 0x15, 0xba, 0x5b, 0x17, 0x36, 0x4e, 0xff, 0x51,
+	0x82, 0xed, 0x44, 0x8b, 0xf7, 0x2f, 0x39, 0xbd, 0xd0, 0x19, 0xe9, 0x6d, 0x3a, 0x13, 0x4f, 0x2a,
+	0x71, 0xd0, 0x0b, 0x68, 0x84, 0xbe, 0x3d, 0x36, 0xe3, 0x99, 0x4a, 0x26, 0x67, 0x3e, 0xa9, 0x0b,
+	0xb0, 0x38, 0xa9, 0x02, 0xf4, 0x29, 0x8e, 0xf5, 0x08, 0xf6, 0x5f, 0x06, 0x8e, 0x15, 0xa1, 0x90,
+	0x6b, 0xcd, 0xca, 0xe4, 0xc7, 0xb0, 0x39, 0xe2, 0x1a, 0xe9, 0x64, 0x29, 0xcb, 0xfe, 0x93, 0x1c,
+	0x46, 0xca, 0xd3, 0xbf, 0x93, 0x60, 0xdf, 0x40, 0x8f, 0xde, 0xfc, 0x9b, 0xb4, 0x2f, 0x60, 0xeb,
+	0x1a, 0x31, 0x30, 0x89, 0xcf, 0x22, 0xcb, 0xb7, 0x91, 0xf1, 0xf4, 0xb5, 0x6e, 0x3b, 0xbd, 0x05,
+	0xa5, 0x88, 0x0a, 0x97, 0xd0, 0x8c, 0x91, 0xb3, 0x0c, 0xd0, 0x09, 0xec, 0xe5, 0x36, 0x3e, 0xa2,
+	0x7e, 0x84, 0x7e, 0xf4, 0xb1, 0xc5, 0x86, 0x6f, 0x6f, 0xe5, 0x01, 0x34, 0xec, 0xe4, 0x9c, 0x39,
+	0xb4, 0xd8, 0xb0, 0xb0, 0xc8, 0x75, 0x3b, 0x57, 0x8c, 0x0b, 0x17, 0x72, 0x3d, 0x23, 0xfd, 0x7e,
+	0x56, 0xf6, 0xe3, 0x85, 0x4f, 0xba, 0x92, 0x56, 0xb1, 0x9d, 0x44, 0x05, 0xf7, 0xd9, 0x67, 0xfe,
+	0xc3, 0x95, 0x6f, 0xd9, 0xfd, 0xe5, 0xcb, 0x17, 0x4a, 0x2a, 0x3c, 0x6b, 0xfa, 0xaf, 0x65, 0xb8,
+	0xbb, 0xe8, 0x66, 0x9d, 0xbd, 0xbe, 0x84, 0x3d, 0x8f, 0x30, 0x16, 0x2f, 0xf2, 0x8a, 0x07, 0xa7,
+	0x7b, 0x3c, 0x9b, 0x68, 0xda, 0x4a, 0x82, 0x50, 0xcd, 0x6e, 0x4a, 0x38, 0x17, 0x1f, 0xa7, 0x4b,
+	0xd8, 0xb3, 0x87, 0x96, 0x3f, 0x40, 0x67, 0x41, 0xb8, 0x92, 0x0b, 0xaf, 0x24, 0x88, 0xc2, 0x29,
+	0xa1, 0x20, 0xfc, 0x19, 0xec, 0xe2, 0x57, 0x51, 0x68, 0x2d, 0xc8, 0x56, 0xb9, 0xec, 0xd1, 0x6c,
+	0xa2, 0xdd, 0x5f, 0x01, 0x0b, 0xa2, 0x3b, 0x1c, 0x16, 0x25, 0xf5, 0x73, 0x38, 0xf8, 0xdc, 0x72,
+	0xc9, 0xea, 0x1d, 0xfa, 0x27, 0xfb, 0xad, 0x7f, 0x2f, 0x41, 0x6b, 0x95, 0xe4, 0x3a, 0xad, 0xe9,
+	0xc1, 0xce, 0x4d, 0x22, 0x41, 0xa8, 0x9f, 0x7d, 0x13, 0x93, 0xb6, 0x68, 0xb3, 0x89, 0x76, 0xb8,
+	0x04, 0x0a, 0x45, 0x6e, 0xe7, 0x20, 0xd7, 0x64, 0xdd, 0x87, 0xaf, 0x6f, 0xd5, 0xd2, 0x6f, 0xb7,
+	0x6a, 0xe9, 0xcd, 0xad, 0x2a, 0x7d, 0x3d, 0x55, 0xa5, 0x5f, 0xa6, 0xaa, 0xf4, 0x6a, 0xaa, 0x4a,
+	0xaf, 0xa7, 0xaa, 0xf4, 0xfb, 0x54, 0x95, 0xfe, 0x9c, 0xaa, 0xa5, 0x37, 0x53, 0x55, 0xfa, 0xe1,
+	0x0f, 0xb5, 0xf4, 0xd7, 0x00, 0x89, 0x59, 0x96, 0x9c, 0xf1, 0x0a, 0x00, 0x00,
 }
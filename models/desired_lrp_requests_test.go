@@ -9,6 +9,33 @@ import (
 )
 
 var _ = Describe("DesiredLRP Requests", func() {
+	Describe("DesiredLRPsRequest", func() {
+		Describe("Validate", func() {
+			It("accepts a well formed selector", func() {
+				request := models.DesiredLRPsRequest{Selector: "team=payments,env in (prod)"}
+				Expect(request.Validate()).To(Succeed())
+			})
+
+			It("rejects a malformed selector", func() {
+				request := models.DesiredLRPsRequest{Selector: "team=payments,"}
+				Expect(request.Validate()).To(BeAssignableToTypeOf(models.ErrInvalidLabelSelector{}))
+			})
+		})
+
+		Describe("ParseLabelSelector", func() {
+			It("requires the labels of the deprecated map before those of the selector", func() {
+				request := models.DesiredLRPsRequest{
+					LabelSelector: map[string]string{"team": "payments", "env": "prod"},
+					Selector:      "tier notin (db)",
+				}
+
+				selector, err := request.ParseLabelSelector()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(selector.String()).To(Equal("env=prod,team=payments,tier!=db"))
+			})
+		})
+	})
+
 	Describe("DesiredLRPsByProcessGuidRequest", func() {
 		Describe("Validate", func() {
 			var request models.DesiredLRPByProcessGuidRequest
@@ -72,6 +99,16 @@ var _ = Describe("DesiredLRP Requests", func() {
 					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guid"}))
 				})
 			})
+
+			Context("when the idempotency key is invalid", func() {
+				BeforeEach(func() {
+					request.IdempotencyKey = "retry key"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"idempotency_key"}))
+				})
+			})
 		})
 	})
 
@@ -80,8 +117,12 @@ var _ = Describe("DesiredLRP Requests", func() {
 			var request models.UpdateDesiredLRPRequest
 
 			BeforeEach(func() {
+				annotation := "some-annotation"
 				request = models.UpdateDesiredLRPRequest{
 					ProcessGuid: "some-guid",
+					Update: &models.DesiredLRPUpdate{
+						Annotation: &annotation,
+					},
 				}
 			})
 
@@ -91,6 +132,28 @@ var _ = Describe("DesiredLRP Requests", func() {
 				})
 			})
 
+			Context("when the Update is missing", func() {
+				BeforeEach(func() {
+					request.Update = nil
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"update"}))
+				})
+			})
+
+			Context("when the Update sets no fields", func() {
+				BeforeEach(func() {
+					request.Update = &models.DesiredLRPUpdate{}
+				})
+
+				It("returns a validation error", func() {
+					err := request.Validate()
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("at least one of instances, routes or annotation"))
+				})
+			})
+
 			Context("when the ProcessGuid is blank", func() {
 				BeforeEach(func() {
 					request.ProcessGuid = ""
@@ -143,4 +206,79 @@ var _ = Describe("DesiredLRP Requests", func() {
 			})
 		})
 	})
+
+	Describe("DesiredLRPDiffRequest", func() {
+		Describe("Validate", func() {
+			var request models.DesiredLRPDiffRequest
+
+			BeforeEach(func() {
+				request = models.DesiredLRPDiffRequest{
+					DesiredLrps: []*models.DesiredLRPContentHash{
+						{ProcessGuid: "guid-1", ContentHash: "hash-1"},
+						{ProcessGuid: "guid-2", ContentHash: "hash-2"},
+					},
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when there are no desired lrps", func() {
+				BeforeEach(func() {
+					request.DesiredLrps = nil
+				})
+
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when a ProcessGuid is blank", func() {
+				BeforeEach(func() {
+					request.DesiredLrps[1].ProcessGuid = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guid"}))
+				})
+			})
+
+			Context("when a ContentHash is blank", func() {
+				BeforeEach(func() {
+					request.DesiredLrps[0].ContentHash = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"content_hash"}))
+				})
+			})
+
+			Context("when a ProcessGuid is repeated", func() {
+				BeforeEach(func() {
+					request.DesiredLrps[1].ProcessGuid = "guid-1"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"desired_lrps"}))
+				})
+			})
+		})
+	})
+
+	Describe("ValidateDesiredLRPRequest", func() {
+		Describe("Validate", func() {
+			It("accepts an invalid desired lrp, which the handler reports on", func() {
+				request := models.ValidateDesiredLRPRequest{DesiredLrp: &models.DesiredLRP{}}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("requires a desired lrp", func() {
+				request := models.ValidateDesiredLRPRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"desired_lrp"}))
+			})
+		})
+	})
 })
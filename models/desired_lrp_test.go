@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/bbs/format"
@@ -247,6 +248,14 @@ var _ = Describe("DesiredLRP", func() {
       "index": 0
     },
 		"placement_tags": ["red-tag", "blue-tag"],
+		"metadata_labels": {
+			"team": "routing",
+			"cost-center": "1234"
+		},
+		"start_order": "LeaderFirst",
+		"extension_fields": {
+			"example.com/replicas": "eyJyZXBsaWNhcyI6M30="
+		},
     "trusted_system_certificates_path": "/etc/cf-system-certificates",
     "network": {
 			"properties": {
@@ -275,6 +284,57 @@ var _ = Describe("DesiredLRP", func() {
 		})
 	})
 
+	Describe("ContentHash", func() {
+		hash := func(desiredLRP *models.DesiredLRP) string {
+			hash, err := desiredLRP.ContentHash()
+			Expect(err).NotTo(HaveOccurred())
+			return hash
+		}
+
+		It("is the same for a desired lrp that round trips through protobuf", func() {
+			desiredLRP.MetadataLabels = map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"}
+			encoded, err := desiredLRP.Marshal()
+			Expect(err).NotTo(HaveOccurred())
+
+			var decoded models.DesiredLRP
+			Expect(decoded.Unmarshal(encoded)).To(Succeed())
+			Expect(hash(&decoded)).To(Equal(hash(&desiredLRP)))
+		})
+
+		It("ignores the modification tag and the removal time", func() {
+			stored := desiredLRP
+			stored.ModificationTag = &models.ModificationTag{Epoch: "some-other-epoch", Index: 7}
+			stored.DeletedAt = 1138
+			Expect(hash(&stored)).To(Equal(hash(&desiredLRP)))
+		})
+
+		It("changes with the definition", func() {
+			changed := desiredLRP
+			changed.Instances++
+			Expect(hash(&changed)).NotTo(Equal(hash(&desiredLRP)))
+		})
+
+		It("is the same as that of the stored desired lrp, whose egress rules are normalized", func() {
+			held := desiredLRP
+			held.EgressRules = []*models.SecurityGroupRule{{
+				Protocol:     models.TCPProtocol,
+				Destinations: []string{"10.0.0.1-10.0.0.1", "10.0.0.1"},
+				Ports:        []uint32{80},
+			}}
+
+			stored := held
+			stored.EgressRules = []*models.SecurityGroupRule{{
+				Protocol:     models.TCPProtocol,
+				Destinations: []string{"10.0.0.1-10.0.0.1", "10.0.0.1"},
+				Ports:        []uint32{80},
+			}}
+			stored.NormalizeEgressRules()
+
+			Expect(hash(&held)).To(Equal(hash(&stored)))
+			Expect(held.EgressRules[0].Destinations).To(Equal([]string{"10.0.0.1-10.0.0.1", "10.0.0.1"}))
+		})
+	})
+
 	Describe("serialization", func() {
 		It("successfully round trips through json and protobuf", func() {
 			jsonSerialization, err := json.Marshal(desiredLRP)
@@ -746,6 +806,139 @@ var _ = Describe("DesiredLRP", func() {
 				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "value")
 			})
 		})
+
+		Context("when repeated fields have many entries", func() {
+			routes := func(n int) *models.Routes {
+				routes := models.Routes{}
+				for i := 0; i < n; i++ {
+					value := json.RawMessage(`{}`)
+					routes[fmt.Sprintf("router-%d", i)] = &value
+				}
+				return &routes
+			}
+
+			environmentVariables := func(n int) []*models.EnvironmentVariable {
+				vars := make([]*models.EnvironmentVariable, n)
+				for i := range vars {
+					vars[i] = &models.EnvironmentVariable{Name: "NAME", Value: "value"}
+				}
+				return vars
+			}
+
+			volumeMounts := func(n int) []*models.VolumeMount {
+				mounts := make([]*models.VolumeMount, n)
+				for i := range mounts {
+					mounts[i] = &models.VolumeMount{Driver: "driver", ContainerDir: "/dir", Mode: "r"}
+				}
+				return mounts
+			}
+
+			egressRules := func(n int) []*models.SecurityGroupRule {
+				rules := make([]*models.SecurityGroupRule, n)
+				for i := range rules {
+					rules[i] = &models.SecurityGroupRule{Protocol: models.AllProtocol, Destinations: []string{"0.0.0.0/0"}}
+				}
+				return rules
+			}
+
+			It("allows as many entries as the limits", func() {
+				desiredLRP.Routes = routes(100)
+				desiredLRP.EnvironmentVariables = environmentVariables(1024)
+				desiredLRP.VolumeMounts = volumeMounts(128)
+				desiredLRP.EgressRules = egressRules(2048)
+				Expect(desiredLRP.Validate()).To(Succeed())
+			})
+
+			It("limits the number of routes", func() {
+				desiredLRP.Routes = routes(101)
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "routes")
+			})
+
+			It("limits the number of environment variables", func() {
+				desiredLRP.EnvironmentVariables = environmentVariables(1025)
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "env")
+			})
+
+			It("limits the number of volume mounts", func() {
+				desiredLRP.VolumeMounts = volumeMounts(129)
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "volume_mounts")
+			})
+
+			It("limits the number of egress rules", func() {
+				desiredLRP.EgressRules = egressRules(2049)
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "egress_rules")
+			})
+		})
+
+		Context("when metadata labels are specified", func() {
+			It("accepts labels within the limits", func() {
+				desiredLRP.MetadataLabels = map[string]string{
+					"team":                    "routing",
+					"example.com/cost-center": "",
+				}
+				Expect(desiredLRP.Validate()).To(Succeed())
+			})
+
+			It("limits the number of labels", func() {
+				desiredLRP.MetadataLabels = map[string]string{}
+				for i := 0; i < 65; i++ {
+					desiredLRP.MetadataLabels[fmt.Sprintf("label-%d", i)] = "value"
+				}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "metadata_labels")
+			})
+
+			It("requires the keys to be non-empty", func() {
+				desiredLRP.MetadataLabels = map[string]string{"": "value"}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "metadata_labels")
+			})
+
+			It("requires the keys to start and end with a letter or digit", func() {
+				desiredLRP.MetadataLabels = map[string]string{"-team": "value"}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "metadata_labels")
+			})
+
+			It("limits the length of the keys", func() {
+				desiredLRP.MetadataLabels = map[string]string{strings.Repeat("a", 64): "value"}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "metadata_labels")
+			})
+
+			It("limits the length of the values", func() {
+				desiredLRP.MetadataLabels = map[string]string{"team": strings.Repeat("a", 256)}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "metadata_labels")
+			})
+		})
+
+		Context("when extension fields are specified", func() {
+			It("accepts fields within the limits", func() {
+				desiredLRP.ExtensionFields = map[string][]byte{
+					"example.com/replicas": []byte(`{"replicas":3}`),
+					"example.com/empty":    nil,
+				}
+				Expect(desiredLRP.Validate()).To(Succeed())
+			})
+
+			It("requires the names to be non-empty", func() {
+				desiredLRP.ExtensionFields = map[string][]byte{"": []byte("value")}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "extension_fields")
+			})
+
+			It("limits the size of the fields", func() {
+				desiredLRP.ExtensionFields = map[string][]byte{"field": make([]byte, 16*1024)}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "extension_fields")
+			})
+		})
+
+		Context("when a start order is specified", func() {
+			It("accepts the known start orders", func() {
+				desiredLRP.StartOrder = models.StartOrder_LeaderFirst
+				Expect(desiredLRP.Validate()).To(Succeed())
+			})
+
+			It("rejects an unknown start order", func() {
+				desiredLRP.StartOrder = models.StartOrder(7)
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "start_order")
+			})
+		})
 	})
 })
 
@@ -787,6 +980,33 @@ var _ = Describe("DesiredLRPUpdate", func() {
 			desiredLRPUpdate.Annotation = &largeString
 			assertDesiredLRPValidationFailsWithMessage(desiredLRPUpdate, "annotation")
 		})
+
+		It("limits the number of routes", func() {
+			routes := models.Routes{}
+			for i := 0; i < 101; i++ {
+				value := json.RawMessage(`{}`)
+				routes[fmt.Sprintf("router-%d", i)] = &value
+			}
+			desiredLRPUpdate.Routes = &routes
+			assertDesiredLRPValidationFailsWithMessage(desiredLRPUpdate, "routes")
+
+			delete(routes, "router-0")
+			Expect(desiredLRPUpdate.Validate()).To(Succeed())
+		})
+
+		It("requires at least one field to be present", func() {
+			assertDesiredLRPValidationFailsWithMessage(models.DesiredLRPUpdate{}, "at least one of instances, routes or annotation")
+		})
+
+		DescribeTable("accepts any single field, even when set to its zero value",
+			func(update models.DesiredLRPUpdate) {
+				Expect(update.IsEmpty()).To(BeFalse())
+				Expect(update.Validate()).To(Succeed())
+			},
+			Entry("instances", models.DesiredLRPUpdate{Instances: new(int32)}),
+			Entry("routes", models.DesiredLRPUpdate{Routes: &models.Routes{}}),
+			Entry("annotation", models.DesiredLRPUpdate{Annotation: new(string)}),
+		)
 	})
 })
 
@@ -891,13 +1111,29 @@ var _ = Describe("DesiredLRPSchedulingInfo", func() {
 				Expect(err.Error()).To(ContainSubstring(expectedErr))
 			}
 		},
-		Entry("valid scheduling info", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), routes, tag, nil, nil), ""),
-		Entry("invalid annotation", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), largeString, instances, newValidResource(), routes, tag, nil, nil), "annotation"),
-		Entry("invalid instances", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, -2, newValidResource(), routes, tag, nil, nil), "instances"),
-		Entry("invalid key", models.NewDesiredLRPSchedulingInfo(models.DesiredLRPKey{}, annotation, instances, newValidResource(), routes, tag, nil, nil), "process_guid"),
-		Entry("invalid resource", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, models.DesiredLRPResource{}, routes, tag, nil, nil), "rootfs"),
-		Entry("invalid routes", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), largeRoutes, tag, nil, nil), "routes"),
+		Entry("valid scheduling info", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), routes, tag, nil, nil, nil, models.StartOrder_Parallel), ""),
+		Entry("invalid annotation", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), largeString, instances, newValidResource(), routes, tag, nil, nil, nil, models.StartOrder_Parallel), "annotation"),
+		Entry("invalid instances", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, -2, newValidResource(), routes, tag, nil, nil, nil, models.StartOrder_Parallel), "instances"),
+		Entry("invalid key", models.NewDesiredLRPSchedulingInfo(models.DesiredLRPKey{}, annotation, instances, newValidResource(), routes, tag, nil, nil, nil, models.StartOrder_Parallel), "process_guid"),
+		Entry("invalid resource", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, models.DesiredLRPResource{}, routes, tag, nil, nil, nil, models.StartOrder_Parallel), "rootfs"),
+		Entry("invalid routes", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), largeRoutes, tag, nil, nil, nil, models.StartOrder_Parallel), "routes"),
+		Entry("invalid start order", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), routes, tag, nil, nil, nil, models.StartOrder(7)), "start_order"),
 	)
+
+	Describe("WaitsForLeader", func() {
+		It("never holds back an instance when the instances start in parallel", func() {
+			schedulingInfo := models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), routes, tag, nil, nil, nil, models.StartOrder_Parallel)
+			Expect(schedulingInfo.WaitsForLeader(0)).To(BeFalse())
+			Expect(schedulingInfo.WaitsForLeader(1)).To(BeFalse())
+		})
+
+		It("holds back every instance but instance 0 when the leader starts first", func() {
+			schedulingInfo := models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), routes, tag, nil, nil, nil, models.StartOrder_LeaderFirst)
+			Expect(schedulingInfo.WaitsForLeader(0)).To(BeFalse())
+			Expect(schedulingInfo.WaitsForLeader(1)).To(BeTrue())
+			Expect(schedulingInfo.WaitsForLeader(2)).To(BeTrue())
+		})
+	})
 })
 
 var _ = Describe("DesiredLRPRunInfo", func() {
@@ -923,16 +1159,17 @@ var _ = Describe("DesiredLRPRunInfo", func() {
 				Expect(err.Error()).To(ContainSubstring(expectedErr))
 			}
 		},
-		Entry("valid run info", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil), ""),
-		Entry("invalid key", models.NewDesiredLRPRunInfo(models.DesiredLRPKey{}, createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil), "process_guid"),
-		Entry("invalid env vars", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, append(envVars, models.EnvironmentVariable{}), nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil), "name"),
-		Entry("invalid setup action", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, &models.Action{}, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil), "inner-action"),
-		Entry("invalid run action", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, &models.Action{}, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil), "inner-action"),
-		Entry("invalid monitor action", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, &models.Action{}, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil), "inner-action"),
-		Entry("invalid cpu weight", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, 150, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil), "cpu_weight"),
-		Entry("invalid legacy download user", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, []*models.CachedDependency{{To: "here", From: "there"}}, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil), "legacy_download_user"),
-		Entry("invalid cached dependency", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, []*models.CachedDependency{{To: "here"}}, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "user", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil), "cached_dependency"),
-		Entry("invalid volume mount", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "user", trustedSystemCertificatesPath, []*models.VolumeMount{{DeprecatedConfig: []byte(`lol`)}}, nil), "volume_mount"),
+		Entry("valid run info", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil), ""),
+		Entry("invalid key", models.NewDesiredLRPRunInfo(models.DesiredLRPKey{}, createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil), "process_guid"),
+		Entry("invalid env vars", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, append(envVars, models.EnvironmentVariable{}), nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil), "name"),
+		Entry("invalid setup action", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, &models.Action{}, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil), "inner-action"),
+		Entry("invalid run action", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, &models.Action{}, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil), "inner-action"),
+		Entry("invalid monitor action", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, &models.Action{}, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil), "inner-action"),
+		Entry("invalid cpu weight", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, 150, ports, egressRules, logSource, metricsGuid, "legacy-jim", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil), "cpu_weight"),
+		Entry("invalid legacy download user", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, []*models.CachedDependency{{To: "here", From: "there"}}, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil), "legacy_download_user"),
+		Entry("invalid cached dependency", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, []*models.CachedDependency{{To: "here"}}, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "user", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, nil), "cached_dependency"),
+		Entry("invalid volume mount", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "user", trustedSystemCertificatesPath, []*models.VolumeMount{{DeprecatedConfig: []byte(`lol`)}}, nil, nil), "volume_mount"),
+		Entry("invalid extension fields", models.NewDesiredLRPRunInfo(newValidLRPKey(), createdAt, envVars, nil, action, action, action, startTimeoutMs, privileged, cpuWeight, ports, egressRules, logSource, metricsGuid, "user", trustedSystemCertificatesPath, []*models.VolumeMount{}, nil, map[string][]byte{"": nil}), "extension_fields"),
 	)
 })
 
@@ -83,10 +83,133 @@ func (m *UpsertDomainRequest) GetTtl() uint32 {
 	return 0
 }
 
+type UpsertDomainsRequest struct {
+	Domains []*UpsertDomainRequest `protobuf:"bytes,1,rep,name=domains" json:"domains,omitempty"`
+}
+
+func (m *UpsertDomainsRequest) Reset()                    { *m = UpsertDomainsRequest{} }
+func (*UpsertDomainsRequest) ProtoMessage()               {}
+func (*UpsertDomainsRequest) Descriptor() ([]byte, []int) { return fileDescriptorDomain, []int{3} }
+
+func (m *UpsertDomainsRequest) GetDomains() []*UpsertDomainRequest {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
+type UpsertDomainResult struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	Error  *Error `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *UpsertDomainResult) Reset()                    { *m = UpsertDomainResult{} }
+func (*UpsertDomainResult) ProtoMessage()               {}
+func (*UpsertDomainResult) Descriptor() ([]byte, []int) { return fileDescriptorDomain, []int{4} }
+
+func (m *UpsertDomainResult) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *UpsertDomainResult) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// The results are in the order of the request's domains.
+type UpsertDomainsResponse struct {
+	Error   *Error                `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Results []*UpsertDomainResult `protobuf:"bytes,2,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *UpsertDomainsResponse) Reset()                    { *m = UpsertDomainsResponse{} }
+func (*UpsertDomainsResponse) ProtoMessage()               {}
+func (*UpsertDomainsResponse) Descriptor() ([]byte, []int) { return fileDescriptorDomain, []int{5} }
+
+func (m *UpsertDomainsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *UpsertDomainsResponse) GetResults() []*UpsertDomainResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type DomainFreshness struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	// unix nanoseconds at which the domain stops being fresh, 0 if it never does
+	ExpireTime int64 `protobuf:"varint,2,opt,name=expire_time,json=expireTime" json:"expire_time"`
+	// whole seconds of freshness left, 0 if the domain never expires
+	TtlRemaining uint32 `protobuf:"varint,3,opt,name=ttl_remaining,json=ttlRemaining" json:"ttl_remaining"`
+}
+
+func (m *DomainFreshness) Reset()                    { *m = DomainFreshness{} }
+func (*DomainFreshness) ProtoMessage()               {}
+func (*DomainFreshness) Descriptor() ([]byte, []int) { return fileDescriptorDomain, []int{6} }
+
+func (m *DomainFreshness) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DomainFreshness) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *DomainFreshness) GetTtlRemaining() uint32 {
+	if m != nil {
+		return m.TtlRemaining
+	}
+	return 0
+}
+
+type DomainFreshnessResponse struct {
+	Error   *Error             `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Domains []*DomainFreshness `protobuf:"bytes,2,rep,name=domains" json:"domains,omitempty"`
+}
+
+func (m *DomainFreshnessResponse) Reset()                    { *m = DomainFreshnessResponse{} }
+func (*DomainFreshnessResponse) ProtoMessage()               {}
+func (*DomainFreshnessResponse) Descriptor() ([]byte, []int) { return fileDescriptorDomain, []int{7} }
+
+func (m *DomainFreshnessResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DomainFreshnessResponse) GetDomains() []*DomainFreshness {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DomainsResponse)(nil), "models.DomainsResponse")
 	proto.RegisterType((*UpsertDomainResponse)(nil), "models.UpsertDomainResponse")
 	proto.RegisterType((*UpsertDomainRequest)(nil), "models.UpsertDomainRequest")
+	proto.RegisterType((*UpsertDomainsRequest)(nil), "models.UpsertDomainsRequest")
+	proto.RegisterType((*UpsertDomainResult)(nil), "models.UpsertDomainResult")
+	proto.RegisterType((*UpsertDomainsResponse)(nil), "models.UpsertDomainsResponse")
+	proto.RegisterType((*DomainFreshness)(nil), "models.DomainFreshness")
+	proto.RegisterType((*DomainFreshnessResponse)(nil), "models.DomainFreshnessResponse")
 }
 func (this *DomainsResponse) GoString() string {
 	if this == nil {
@@ -126,6 +249,73 @@ func (this *UpsertDomainRequest) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *UpsertDomainsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.UpsertDomainsRequest{")
+	if this.Domains != nil {
+		s = append(s, "Domains: "+fmt.Sprintf("%#v", this.Domains)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpsertDomainResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.UpsertDomainResult{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpsertDomainsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.UpsertDomainsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Results != nil {
+		s = append(s, "Results: "+fmt.Sprintf("%#v", this.Results)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainFreshness) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.DomainFreshness{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "ExpireTime: "+fmt.Sprintf("%#v", this.ExpireTime)+",\n")
+	s = append(s, "TtlRemaining: "+fmt.Sprintf("%#v", this.TtlRemaining)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainFreshnessResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DomainFreshnessResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Domains != nil {
+		s = append(s, "Domains: "+fmt.Sprintf("%#v", this.Domains)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringDomain(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -248,93 +438,329 @@ func (m *UpsertDomainRequest) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Domain(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Domain(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintDomain(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpsertDomainsRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	data[offset] = uint8(v)
-	return offset + 1
+	return data[:n], nil
 }
-func (m *DomainsResponse) Size() (n int) {
+
+func (m *UpsertDomainsRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovDomain(uint64(l))
-	}
 	if len(m.Domains) > 0 {
-		for _, s := range m.Domains {
-			l = len(s)
-			n += 1 + l + sovDomain(uint64(l))
+		for _, msg := range m.Domains {
+			data[i] = 0xa
+			i++
+			i = encodeVarintDomain(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *UpsertDomainResponse) Size() (n int) {
+func (m *UpsertDomainResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UpsertDomainResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintDomain(data, i, uint64(len(m.Domain)))
+	i += copy(data[i:], m.Domain)
 	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovDomain(uint64(l))
+		data[i] = 0x12
+		i++
+		i = encodeVarintDomain(data, i, uint64(m.Error.Size()))
+		n3, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
 	}
-	return n
+	return i, nil
 }
 
-func (m *UpsertDomainRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Domain)
-	n += 1 + l + sovDomain(uint64(l))
-	n += 1 + sovDomain(uint64(m.Ttl))
-	return n
+func (m *UpsertDomainsResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func sovDomain(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *UpsertDomainsResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintDomain(data, i, uint64(m.Error.Size()))
+		n4, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n4
 	}
-	return n
-}
-func sozDomain(x uint64) (n int) {
-	return sovDomain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			data[i] = 0x12
+			i++
+			i = encodeVarintDomain(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
-func (this *DomainsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DomainFreshness) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DomainsResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`Domains:` + fmt.Sprintf("%v", this.Domains) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *UpsertDomainResponse) String() string {
+
+func (m *DomainFreshness) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintDomain(data, i, uint64(len(m.Domain)))
+	i += copy(data[i:], m.Domain)
+	data[i] = 0x10
+	i++
+	i = encodeVarintDomain(data, i, uint64(m.ExpireTime))
+	data[i] = 0x18
+	i++
+	i = encodeVarintDomain(data, i, uint64(m.TtlRemaining))
+	return i, nil
+}
+
+func (m *DomainFreshnessResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DomainFreshnessResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintDomain(data, i, uint64(m.Error.Size()))
+		n5, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Domains) > 0 {
+		for _, msg := range m.Domains {
+			data[i] = 0x12
+			i++
+			i = encodeVarintDomain(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Domain(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Domain(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintDomain(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DomainsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if len(m.Domains) > 0 {
+		for _, s := range m.Domains {
+			l = len(s)
+			n += 1 + l + sovDomain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpsertDomainResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	return n
+}
+
+func (m *UpsertDomainRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovDomain(uint64(l))
+	n += 1 + sovDomain(uint64(m.Ttl))
+	return n
+}
+
+func (m *UpsertDomainsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, e := range m.Domains {
+			l = e.Size()
+			n += 1 + l + sovDomain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpsertDomainResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovDomain(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	return n
+}
+
+func (m *UpsertDomainsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovDomain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DomainFreshness) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovDomain(uint64(l))
+	n += 1 + sovDomain(uint64(m.ExpireTime))
+	n += 1 + sovDomain(uint64(m.TtlRemaining))
+	return n
+}
+
+func (m *DomainFreshnessResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if len(m.Domains) > 0 {
+		for _, e := range m.Domains {
+			l = e.Size()
+			n += 1 + l + sovDomain(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDomain(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDomain(x uint64) (n int) {
+	return sovDomain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DomainsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Domains:` + fmt.Sprintf("%v", this.Domains) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpsertDomainResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -355,6 +781,61 @@ func (this *UpsertDomainRequest) String() string {
 	}, "")
 	return s
 }
+func (this *UpsertDomainsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpsertDomainsRequest{`,
+		`Domains:` + strings.Replace(fmt.Sprintf("%v", this.Domains), "UpsertDomainRequest", "UpsertDomainRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpsertDomainResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpsertDomainResult{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpsertDomainsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpsertDomainsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Results:` + strings.Replace(fmt.Sprintf("%v", this.Results), "UpsertDomainResult", "UpsertDomainResult", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainFreshness) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainFreshness{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`ExpireTime:` + fmt.Sprintf("%v", this.ExpireTime) + `,`,
+		`TtlRemaining:` + fmt.Sprintf("%v", this.TtlRemaining) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainFreshnessResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainFreshnessResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Domains:` + strings.Replace(fmt.Sprintf("%v", this.Domains), "DomainFreshness", "DomainFreshness", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringDomain(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -386,15 +867,308 @@ func (m *DomainsResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DomainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domains = append(m.Domains, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertDomainResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertDomainRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ttl |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertDomainsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertDomainsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpsertDomainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -418,16 +1192,64 @@ func (m *DomainsResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
-			}
-			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Domains = append(m.Domains, &UpsertDomainRequest{})
+			if err := m.Domains[len(m.Domains)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertDomainResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertDomainResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertDomainResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -452,7 +1274,40 @@ func (m *DomainsResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domains = append(m.Domains, string(data[iNdEx:postIndex]))
+			m.Domain = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -475,7 +1330,7 @@ func (m *DomainsResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *UpsertDomainResponse) Unmarshal(data []byte) error {
+func (m *UpsertDomainsResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -498,10 +1353,10 @@ func (m *UpsertDomainResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpsertDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpsertDomainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpsertDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpsertDomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -537,6 +1392,37 @@ func (m *UpsertDomainResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &UpsertDomainResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDomain(data[iNdEx:])
@@ -558,7 +1444,7 @@ func (m *UpsertDomainResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *UpsertDomainRequest) Unmarshal(data []byte) error {
+func (m *DomainFreshness) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -581,10 +1467,10 @@ func (m *UpsertDomainRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpsertDomainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainFreshness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpsertDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainFreshness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -618,9 +1504,9 @@ func (m *UpsertDomainRequest) Unmarshal(data []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
 			}
-			m.Ttl = 0
+			m.ExpireTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDomain
@@ -630,11 +1516,144 @@ func (m *UpsertDomainRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Ttl |= (uint32(b) & 0x7F) << shift
+				m.ExpireTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlRemaining", wireType)
+			}
+			m.TtlRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.TtlRemaining |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainFreshnessResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainFreshnessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainFreshnessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domains = append(m.Domains, &DomainFreshness{})
+			if err := m.Domains[len(m.Domains)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDomain(data[iNdEx:])
@@ -764,21 +1783,31 @@ var (
 func init() { proto.RegisterFile("domain.proto", fileDescriptorDomain) }
 
 var fileDescriptorDomain = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xc9, 0xcf, 0x4d,
-	0xcc, 0xcc, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xcb, 0xcd, 0x4f, 0x49, 0xcd, 0x29,
-	0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f,
-	0xcf, 0xd7, 0x07, 0x4b, 0x27, 0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0x26, 0xc5,
-	0x9d, 0x5a, 0x54, 0x94, 0x5f, 0x04, 0xe1, 0x28, 0x05, 0x70, 0xf1, 0xbb, 0x80, 0xcd, 0x2c, 0x0e,
-	0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x52, 0xe6, 0x62, 0x05, 0xab, 0x90, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x36, 0xe2, 0xd5, 0x83, 0x58, 0xa3, 0xe7, 0x0a, 0x12, 0x0c, 0x82, 0xc8, 0x09,
-	0x49, 0x70, 0xb1, 0x43, 0xdc, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x19, 0x04, 0xe3, 0x2a,
-	0x59, 0x73, 0x89, 0x84, 0x16, 0x14, 0xa7, 0x16, 0x95, 0x40, 0xcc, 0x25, 0xc9, 0x58, 0x25, 0x6f,
-	0x2e, 0x61, 0x54, 0xcd, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x32, 0x5c, 0x6c, 0x10, 0xe3, 0xc1,
-	0x9a, 0x39, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x8a, 0x09, 0x89, 0x71, 0x31, 0x97,
-	0x94, 0xe4, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x42, 0xa5, 0x40, 0x02, 0x4e, 0x3a, 0x17, 0x1e,
-	0xca, 0x31, 0xdc, 0x78, 0x28, 0xc7, 0xf0, 0xe1, 0xa1, 0x1c, 0x63, 0xc3, 0x23, 0x39, 0xc6, 0x15,
-	0x8f, 0xe4, 0x18, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x17, 0x8f, 0xe4, 0x18, 0x3e, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0x01, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0x44, 0xa3, 0xd6, 0xcb, 0x5c, 0x01, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x6a, 0xdb, 0x40,
+	0x18, 0xd4, 0x5a, 0xad, 0x8d, 0x57, 0x36, 0x05, 0xf5, 0xc7, 0xc2, 0x2d, 0x5b, 0xa1, 0x52, 0x50,
+	0xa1, 0x95, 0xa9, 0x68, 0x4f, 0xbd, 0x99, 0xb6, 0x97, 0x10, 0x08, 0x22, 0x21, 0x47, 0x13, 0xc7,
+	0x1b, 0x59, 0x20, 0x69, 0xe5, 0xdd, 0x15, 0xe4, 0x98, 0xbc, 0x41, 0x1e, 0x23, 0x8f, 0xe2, 0xa3,
+	0x8f, 0x39, 0x85, 0x58, 0xb9, 0xe4, 0xe8, 0x47, 0x08, 0xda, 0xf5, 0xc6, 0xb1, 0x30, 0x31, 0xbe,
+	0xe9, 0xfb, 0x66, 0xbe, 0xd9, 0x19, 0x46, 0xb0, 0x35, 0x22, 0xc9, 0x49, 0x94, 0x7a, 0x19, 0x25,
+	0x9c, 0x98, 0xf5, 0x84, 0x8c, 0x70, 0xcc, 0xba, 0x3f, 0xc2, 0x88, 0x8f, 0xf3, 0xa1, 0x77, 0x4a,
+	0x92, 0x5e, 0x48, 0x42, 0xd2, 0x13, 0xf0, 0x30, 0x3f, 0x13, 0x93, 0x18, 0xc4, 0x97, 0x3c, 0xeb,
+	0x1a, 0x98, 0x52, 0x42, 0xe5, 0xe0, 0x1c, 0xc0, 0x37, 0x7f, 0x85, 0x26, 0x0b, 0x30, 0xcb, 0x48,
+	0xca, 0xb0, 0xf9, 0x05, 0xbe, 0x16, 0x0c, 0x0b, 0xd8, 0xc0, 0x35, 0xfc, 0xb6, 0x27, 0x9f, 0xf1,
+	0xfe, 0x95, 0xcb, 0x40, 0x62, 0xa6, 0x05, 0x1b, 0xd2, 0x0b, 0xb3, 0x6a, 0xb6, 0xee, 0x36, 0x03,
+	0x35, 0x3a, 0x7f, 0xe0, 0xbb, 0xa3, 0x8c, 0x61, 0xca, 0xa5, 0xee, 0x4e, 0xb2, 0xce, 0x1e, 0x7c,
+	0xbb, 0x7e, 0x3c, 0xc9, 0x31, 0xe3, 0xe6, 0x27, 0x58, 0x97, 0xf2, 0xe2, 0xb8, 0xd9, 0x7f, 0x35,
+	0xbd, 0xfd, 0xac, 0x05, 0xcb, 0x9d, 0xf9, 0x01, 0xea, 0x9c, 0xc7, 0x56, 0xcd, 0x06, 0x6e, 0x7b,
+	0x09, 0x95, 0x0b, 0x67, 0x7f, 0xdd, 0x09, 0x53, 0x6a, 0xbf, 0x57, 0xde, 0x81, 0xad, 0xbb, 0x86,
+	0xff, 0x51, 0x79, 0xd9, 0xf0, 0xf6, 0x2a, 0xd8, 0x31, 0x34, 0x2b, 0xc1, 0xf2, 0x78, 0x9b, 0xb5,
+	0xa7, 0xd0, 0xb5, 0x17, 0x42, 0x53, 0xf8, 0xbe, 0xe2, 0x73, 0x97, 0x26, 0x7e, 0xc1, 0x06, 0x15,
+	0x56, 0x64, 0x13, 0x86, 0xdf, 0xdd, 0x9c, 0xa6, 0xa4, 0x04, 0x8a, 0xea, 0x5c, 0x02, 0x55, 0xfc,
+	0x7f, 0x8a, 0xd9, 0x38, 0xc5, 0x8c, 0x6d, 0x89, 0xf2, 0x15, 0x1a, 0xf8, 0x3c, 0x8b, 0x28, 0x1e,
+	0xf0, 0x28, 0xc1, 0x22, 0x90, 0xbe, 0xa4, 0x40, 0x09, 0x1c, 0x46, 0x09, 0x36, 0xbf, 0xc1, 0x36,
+	0xe7, 0xf1, 0x80, 0xe2, 0xf2, 0x28, 0x4a, 0x43, 0x4b, 0x7f, 0x56, 0x4b, 0x8b, 0xf3, 0x38, 0x50,
+	0x88, 0x33, 0x81, 0x9d, 0x8a, 0x85, 0xdd, 0x92, 0xff, 0x5c, 0xff, 0x07, 0x0d, 0xbf, 0xa3, 0x68,
+	0x55, 0x59, 0xc5, 0xeb, 0x7f, 0x9f, 0xcd, 0x91, 0x76, 0x33, 0x47, 0xda, 0x62, 0x8e, 0xc0, 0x45,
+	0x81, 0xc0, 0x75, 0x81, 0xb4, 0x69, 0x81, 0xc0, 0xac, 0x40, 0xe0, 0xae, 0x40, 0xe0, 0xa1, 0x40,
+	0xda, 0xa2, 0x40, 0xe0, 0xea, 0x1e, 0x69, 0x8f, 0x03, 0x00, 0xb9, 0xd1, 0xf8, 0x04, 0x6f, 0x03,
+	0x00, 0x00,
 }
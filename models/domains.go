@@ -1,5 +1,23 @@
 package models
 
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	// MaxUpsertDomainsBatchSize is the most domains a single
+	// UpsertDomainsRequest may upsert.
+	MaxUpsertDomainsBatchSize = 1000
+
+	// MaxDomainLength is the longest domain name the stores can hold.
+	MaxDomainLength = 255
+
+	// MaxDomainTTL is the longest TTL, in seconds, of a domain upserted in a
+	// batch. Longer TTLs are more likely a mistaken unit than intended.
+	MaxDomainTTL = 365 * 24 * 60 * 60
+)
+
 type DomainSet map[string]struct{}
 
 func (set DomainSet) Add(domain string) {
@@ -34,3 +52,46 @@ func (request *UpsertDomainRequest) Validate() error {
 
 	return nil
 }
+
+// ValidateInBatch validates an entry of an UpsertDomainsRequest. Besides what
+// Validate checks, it rejects domain names the stores cannot hold and TTLs
+// longer than MaxDomainTTL.
+func (request *UpsertDomainRequest) ValidateInBatch() error {
+	var validationError ValidationError
+
+	if request.Domain == "" || len(request.Domain) > MaxDomainLength || strings.Contains(request.Domain, "/") {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if request.Ttl > MaxDomainTTL {
+		validationError = validationError.Append(ErrInvalidField{"ttl"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// Validate only checks the size of the batch. Its entries are validated one
+// by one with ValidateInBatch, so that an invalid entry does not keep the
+// valid ones from being upserted.
+func (request *UpsertDomainsRequest) Validate() error {
+	if len(request.Domains) > MaxUpsertDomainsBatchSize {
+		return ValidationError{ErrInvalidField{"domains"}}
+	}
+
+	return nil
+}
+
+// SortDomainFreshness orders the entries by domain name.
+func SortDomainFreshness(freshness []*DomainFreshness) {
+	sort.Sort(domainFreshnessByDomain(freshness))
+}
+
+type domainFreshnessByDomain []*DomainFreshness
+
+func (d domainFreshnessByDomain) Len() int           { return len(d) }
+func (d domainFreshnessByDomain) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+func (d domainFreshnessByDomain) Less(i, j int) bool { return d[i].Domain < d[j].Domain }
@@ -1,6 +1,8 @@
 package models_test
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/bbs/models"
 
 	. "github.com/onsi/ginkgo"
@@ -34,5 +36,59 @@ var _ = Describe("Domain Requests", func() {
 				})
 			})
 		})
+
+		Describe("ValidateInBatch", func() {
+			var request models.UpsertDomainRequest
+
+			BeforeEach(func() {
+				request = models.UpsertDomainRequest{
+					Domain: "something",
+					Ttl:    60,
+				}
+			})
+
+			It("accepts a valid entry", func() {
+				Expect(request.ValidateInBatch()).To(Succeed())
+			})
+
+			It("accepts a TTL of zero, which never expires", func() {
+				request.Ttl = 0
+				Expect(request.ValidateInBatch()).To(Succeed())
+			})
+
+			It("rejects a blank domain", func() {
+				request.Domain = ""
+				Expect(request.ValidateInBatch()).To(ConsistOf(models.ErrInvalidField{"domain"}))
+			})
+
+			It("rejects a domain that is too long", func() {
+				request.Domain = strings.Repeat("a", models.MaxDomainLength+1)
+				Expect(request.ValidateInBatch()).To(ConsistOf(models.ErrInvalidField{"domain"}))
+			})
+
+			It("rejects a domain with a slash", func() {
+				request.Domain = "some/domain"
+				Expect(request.ValidateInBatch()).To(ConsistOf(models.ErrInvalidField{"domain"}))
+			})
+
+			It("rejects a TTL that is too long", func() {
+				request.Ttl = models.MaxDomainTTL + 1
+				Expect(request.ValidateInBatch()).To(ConsistOf(models.ErrInvalidField{"ttl"}))
+			})
+		})
+	})
+
+	Describe("UpsertDomainsRequest", func() {
+		Describe("Validate", func() {
+			It("accepts a batch of at most MaxUpsertDomainsBatchSize domains", func() {
+				request := models.UpsertDomainsRequest{Domains: make([]*models.UpsertDomainRequest, models.MaxUpsertDomainsBatchSize)}
+				Expect(request.Validate()).To(Succeed())
+			})
+
+			It("rejects a larger batch", func() {
+				request := models.UpsertDomainsRequest{Domains: make([]*models.UpsertDomainRequest, models.MaxUpsertDomainsBatchSize+1)}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"domains"}))
+			})
+		})
 	})
 })
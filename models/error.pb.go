@@ -56,6 +56,14 @@ const (
 	Error_Deserialize                             Error_Type = 27
 	Error_Deadlock                                Error_Type = 28
 	Error_Unrecoverable                           Error_Type = 29
+	Error_IdempotencyKeyConflict                  Error_Type = 30
+	Error_Forbidden                               Error_Type = 31
+	Error_RequestEntityTooLarge                   Error_Type = 32
+	Error_Timeout                                 Error_Type = 33
+	Error_MigrationInProgress                     Error_Type = 34
+	Error_TooManySubscribers                      Error_Type = 35
+	Error_Unavailable                             Error_Type = 36
+	Error_RateLimited                             Error_Type = 37
 )
 
 var Error_Type_name = map[int32]string{
@@ -89,6 +97,14 @@ var Error_Type_name = map[int32]string{
 	27: "Deserialize",
 	28: "Deadlock",
 	29: "Unrecoverable",
+	30: "IdempotencyKeyConflict",
+	31: "Forbidden",
+	32: "RequestEntityTooLarge",
+	33: "Timeout",
+	34: "MigrationInProgress",
+	35: "TooManySubscribers",
+	36: "Unavailable",
+	37: "RateLimited",
 }
 var Error_Type_value = map[string]int32{
 	"UnknownError":                            0,
@@ -121,6 +137,14 @@ var Error_Type_value = map[string]int32{
 	"Deserialize":                             27,
 	"Deadlock":                                28,
 	"Unrecoverable":                           29,
+	"IdempotencyKeyConflict":                  30,
+	"Forbidden":                               31,
+	"RequestEntityTooLarge":                   32,
+	"Timeout":                                 33,
+	"MigrationInProgress":                     34,
+	"TooManySubscribers":                      35,
+	"Unavailable":                             36,
+	"RateLimited":                             37,
 }
 
 func (x Error_Type) Enum() *Error_Type {
@@ -164,8 +188,50 @@ func (m *Error) GetMessage() string {
 	return ""
 }
 
+type ErrorResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ErrorResponse) Reset()                    { *m = ErrorResponse{} }
+func (*ErrorResponse) ProtoMessage()               {}
+func (*ErrorResponse) Descriptor() ([]byte, []int) { return fileDescriptorError, []int{1} }
+
+func (m *ErrorResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// RecordError identifies a record that a bulk read left out of its results
+// because it could not be read.
+type RecordError struct {
+	Guid   string `protobuf:"bytes,1,opt,name=guid" json:"guid"`
+	Reason string `protobuf:"bytes,2,opt,name=reason" json:"reason"`
+}
+
+func (m *RecordError) Reset()                    { *m = RecordError{} }
+func (*RecordError) ProtoMessage()               {}
+func (*RecordError) Descriptor() ([]byte, []int) { return fileDescriptorError, []int{2} }
+
+func (m *RecordError) GetGuid() string {
+	if m != nil {
+		return m.Guid
+	}
+	return ""
+}
+
+func (m *RecordError) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Error)(nil), "models.Error")
+	proto.RegisterType((*ErrorResponse)(nil), "models.ErrorResponse")
+	proto.RegisterType((*RecordError)(nil), "models.RecordError")
 	proto.RegisterEnum("models.Error_Type", Error_Type_name, Error_Type_value)
 }
 func (x Error_Type) String() string {
@@ -175,6 +241,39 @@ func (x Error_Type) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (this *RecordError) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RecordError)
+	if !ok {
+		that2, ok := that.(RecordError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Guid != that1.Guid {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
 func (this *Error) GoString() string {
 	if this == nil {
 		return "nil"
@@ -186,6 +285,29 @@ func (this *Error) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ErrorResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ErrorResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.RecordError{")
+	s = append(s, "Guid: "+fmt.Sprintf("%#v", this.Guid)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringError(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -237,6 +359,60 @@ func (m *Error) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ErrorResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ErrorResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintError(data, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *RecordError) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RecordError) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintError(data, i, uint64(len(m.Guid)))
+	i += copy(data[i:], m.Guid)
+	data[i] = 0x12
+	i++
+	i = encodeVarintError(data, i, uint64(len(m.Reason)))
+	i += copy(data[i:], m.Reason)
+	return i, nil
+}
+
 func encodeFixed64Error(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
 	data[offset+1] = uint8(v >> 8)
@@ -273,6 +449,26 @@ func (m *Error) Size() (n int) {
 	return n
 }
 
+func (m *ErrorResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovError(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordError) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Guid)
+	n += 1 + l + sovError(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovError(uint64(l))
+	return n
+}
+
 func sovError(x uint64) (n int) {
 	for {
 		n++
@@ -297,6 +493,27 @@ func (this *Error) String() string {
 	}, "")
 	return s
 }
+func (this *ErrorResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ErrorResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordError{`,
+		`Guid:` + fmt.Sprintf("%v", this.Guid) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringError(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -403,6 +620,197 @@ func (m *Error) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *ErrorResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowError
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowError
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthError
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipError(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthError
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordError) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowError
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowError
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthError
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowError
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthError
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipError(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthError
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipError(data []byte) (n int, err error) {
 	l := len(data)
 	iNdEx := 0
@@ -511,42 +919,54 @@ var (
 func init() { proto.RegisterFile("error.proto", fileDescriptorError) }
 
 var fileDescriptorError = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x52, 0xdb, 0x3e,
-	0x14, 0xc5, 0x63, 0xfe, 0xe1, 0x4b, 0x7c, 0x09, 0xc1, 0x1f, 0x42, 0x00, 0x97, 0x61, 0x53, 0x66,
-	0x4a, 0xc3, 0x4c, 0xdf, 0xa0, 0x24, 0x81, 0xa1, 0xd3, 0x02, 0xe3, 0x90, 0xee, 0x85, 0x75, 0x93,
-	0x68, 0xa2, 0xe8, 0xba, 0x92, 0x1c, 0x0a, 0xab, 0x3e, 0x42, 0x1f, 0xa3, 0xaf, 0xd0, 0x37, 0x60,
-	0xc9, 0xb2, 0xab, 0x4e, 0xe3, 0x6e, 0xba, 0xe4, 0x11, 0x3a, 0xb6, 0x03, 0xcd, 0x94, 0x74, 0x67,
-	0x9d, 0xdf, 0xbd, 0xc7, 0x47, 0xf7, 0x8a, 0xcc, 0x81, 0x31, 0x68, 0x2a, 0x91, 0x41, 0x87, 0x6c,
-	0xaa, 0x87, 0x02, 0x94, 0x2d, 0xbf, 0x6c, 0x4b, 0xd7, 0x89, 0x2f, 0x2b, 0x21, 0xf6, 0x0e, 0xda,
-	0xd8, 0xc6, 0x83, 0x0c, 0x5f, 0xc6, 0xad, 0xec, 0x94, 0x1d, 0xb2, 0xaf, 0xbc, 0x6d, 0xf7, 0xeb,
-	0x14, 0x99, 0xac, 0xa7, 0x36, 0x6c, 0x9f, 0x14, 0xdd, 0x75, 0x04, 0x25, 0x6f, 0xc7, 0xdb, 0x5b,
-	0x7c, 0xc5, 0x2a, 0xb9, 0x5f, 0x25, 0x83, 0x95, 0x8b, 0xeb, 0x08, 0x0e, 0x8b, 0xb7, 0xdf, 0x9f,
-	0x15, 0x82, 0xac, 0x8a, 0xf9, 0x64, 0xba, 0x07, 0xd6, 0xf2, 0x36, 0x94, 0x26, 0x76, 0xbc, 0xbd,
-	0xd9, 0x21, 0x7c, 0x10, 0x77, 0x07, 0x93, 0xa4, 0x98, 0x36, 0x31, 0x4a, 0xe6, 0x9b, 0xba, 0xab,
-	0xf1, 0x4a, 0x67, 0x4e, 0xb4, 0xc0, 0x96, 0xc9, 0xc2, 0x89, 0xee, 0x73, 0x25, 0x45, 0x0d, 0x7b,
-	0x5c, 0x6a, 0xea, 0xa5, 0x52, 0x53, 0x77, 0xf1, 0x4a, 0xbf, 0x07, 0x63, 0x25, 0x6a, 0x3a, 0x31,
-	0x52, 0x15, 0x40, 0x88, 0x46, 0xd0, 0xff, 0x18, 0x23, 0x8b, 0x8f, 0xd2, 0x87, 0x18, 0xac, 0xa3,
-	0x45, 0xb6, 0x42, 0x96, 0x1e, 0x35, 0x1b, 0xa1, 0xb6, 0x40, 0x27, 0x59, 0x99, 0xac, 0x0d, 0xc5,
-	0xf3, 0xe1, 0xe5, 0xdf, 0xe5, 0xb1, 0xe8, 0x14, 0x5b, 0x22, 0x73, 0x43, 0xf6, 0xa6, 0x71, 0x76,
-	0x4a, 0xa7, 0x59, 0x89, 0xac, 0x1e, 0x71, 0xa9, 0x40, 0x5c, 0xe0, 0x59, 0x04, 0xba, 0xae, 0xfb,
-	0xa0, 0x30, 0x02, 0x3a, 0x33, 0x62, 0xd3, 0x70, 0xdc, 0xc1, 0x85, 0xe1, 0xda, 0x4a, 0x97, 0xc6,
-	0x9b, 0xcd, 0xaf, 0xc5, 0x63, 0xd7, 0x41, 0x23, 0x6f, 0x40, 0x50, 0xc2, 0x56, 0x09, 0x0d, 0xc0,
-	0x62, 0x6c, 0x42, 0xa8, 0xa2, 0x6e, 0x29, 0x19, 0x3a, 0x3a, 0x97, 0x66, 0x7e, 0x50, 0xeb, 0x1f,
-	0xa5, 0x75, 0x96, 0xce, 0x8f, 0x56, 0x9e, 0xa2, 0x3b, 0xc2, 0x58, 0x0b, 0xba, 0x90, 0x06, 0x0b,
-	0x30, 0x76, 0x60, 0xf2, 0x39, 0x2d, 0xb2, 0x2d, 0x52, 0x7a, 0x1d, 0xba, 0x98, 0xab, 0xb7, 0xc1,
-	0x79, 0x95, 0x6b, 0x8d, 0xee, 0x10, 0xaa, 0x8a, 0xcb, 0x1e, 0x08, 0xba, 0x34, 0x96, 0x36, 0x1c,
-	0x37, 0x0e, 0x04, 0xa5, 0xe3, 0x7b, 0x0d, 0xb7, 0x1d, 0x10, 0x74, 0x99, 0x6d, 0x92, 0xf5, 0x27,
-	0x34, 0x9f, 0x01, 0x65, 0x63, 0x5b, 0x03, 0xe8, 0x61, 0x1f, 0x04, 0x5d, 0xf9, 0xc7, 0x6f, 0x31,
-	0x8a, 0x40, 0xd0, 0x55, 0xe6, 0x93, 0xf2, 0x13, 0xda, 0xd4, 0xe1, 0x30, 0xf4, 0xff, 0x63, 0x79,
-	0xbd, 0xcf, 0xc3, 0x98, 0xa7, 0xb1, 0xd7, 0xd8, 0x36, 0xd9, 0xa8, 0x81, 0x95, 0x06, 0xc4, 0xa8,
-	0x41, 0x24, 0x32, 0xbc, 0x9e, 0x2e, 0x24, 0x88, 0xb5, 0x96, 0xba, 0x7d, 0xa6, 0x6b, 0xb2, 0xd5,
-	0x02, 0x03, 0xda, 0x55, 0x41, 0x29, 0x5a, 0x62, 0x2f, 0xc8, 0xf3, 0x3f, 0xad, 0x8d, 0xb0, 0x03,
-	0x22, 0x56, 0x52, 0xb7, 0x4f, 0x74, 0x0b, 0xff, 0x36, 0xda, 0x48, 0xb7, 0x72, 0xdc, 0x3c, 0xa9,
-	0x1d, 0x83, 0x06, 0xc3, 0xb3, 0x8d, 0x96, 0xd3, 0xf9, 0xd7, 0xc0, 0x82, 0x91, 0x5c, 0xc9, 0x1b,
-	0xa0, 0x9b, 0x6c, 0x9e, 0xcc, 0xd4, 0x80, 0x0b, 0x85, 0x61, 0x97, 0x6e, 0xe5, 0x4f, 0xd4, 0x40,
-	0x88, 0x7d, 0x30, 0xfc, 0x52, 0x01, 0xdd, 0x3e, 0xdc, 0xbf, 0x1b, 0xf8, 0xde, 0xb7, 0x81, 0x5f,
-	0xb8, 0x1f, 0xf8, 0xde, 0xa7, 0xc4, 0xf7, 0xbe, 0x24, 0x7e, 0xe1, 0x36, 0xf1, 0xbd, 0xbb, 0xc4,
-	0xf7, 0x7e, 0x24, 0xbe, 0xf7, 0x2b, 0xf1, 0x0b, 0xf7, 0x89, 0xef, 0x7d, 0xfe, 0xe9, 0x17, 0x7e,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x98, 0xc4, 0x03, 0xae, 0x03, 0x00, 0x00,
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x73, 0xdb, 0x36,
+	0x10, 0x15, 0x53, 0xd9, 0x8a, 0x21, 0xcb, 0x46, 0x60, 0xc7, 0x96, 0x9d, 0x84, 0x76, 0x95, 0x7e,
+	0x64, 0xa6, 0xa9, 0x32, 0x93, 0xe9, 0xa9, 0xb7, 0xda, 0x92, 0x33, 0x6a, 0xed, 0xd8, 0x43, 0x49,
+	0xbd, 0xf5, 0x00, 0x11, 0x2b, 0x0a, 0x63, 0x12, 0xcb, 0x02, 0xa0, 0x52, 0xe5, 0xd4, 0x5b, 0xaf,
+	0xfd, 0x09, 0x3d, 0xf6, 0xa7, 0xe4, 0x98, 0x63, 0x4f, 0x69, 0xad, 0x5e, 0x32, 0x3d, 0xe5, 0x27,
+	0x74, 0x08, 0xd2, 0xae, 0xda, 0xa8, 0x17, 0x92, 0x78, 0x6f, 0xf7, 0xf1, 0x01, 0xbb, 0x0b, 0x52,
+	0x07, 0xad, 0x51, 0xb7, 0x53, 0x8d, 0x16, 0xd9, 0x6a, 0x82, 0x02, 0x62, 0xb3, 0xff, 0x79, 0x24,
+	0xed, 0x24, 0x1b, 0xb5, 0x43, 0x4c, 0x9e, 0x44, 0x18, 0xe1, 0x13, 0x47, 0x8f, 0xb2, 0xb1, 0x5b,
+	0xb9, 0x85, 0xfb, 0x2a, 0xd2, 0x5a, 0xbf, 0xd7, 0xc8, 0x4a, 0x37, 0x97, 0x61, 0x8f, 0x49, 0xd5,
+	0xce, 0x52, 0x68, 0x7a, 0x87, 0xde, 0xa3, 0x8d, 0xa7, 0xac, 0x5d, 0xe8, 0xb5, 0x1d, 0xd9, 0x1e,
+	0xcc, 0x52, 0x38, 0xaa, 0xbe, 0x7a, 0x73, 0x50, 0x09, 0x5c, 0x14, 0xf3, 0x49, 0x2d, 0x01, 0x63,
+	0x78, 0x04, 0xcd, 0x5b, 0x87, 0xde, 0xa3, 0xb5, 0x92, 0xbc, 0x06, 0x5b, 0x3f, 0xd5, 0x48, 0x35,
+	0x4f, 0x62, 0x94, 0xac, 0x0f, 0xd5, 0xa5, 0xc2, 0x17, 0xca, 0x29, 0xd1, 0x0a, 0xbb, 0x43, 0x1a,
+	0x3d, 0x35, 0xe5, 0xb1, 0x14, 0x1d, 0x4c, 0xb8, 0x54, 0xd4, 0xcb, 0xa1, 0xa1, 0xba, 0xc4, 0x17,
+	0xea, 0x5b, 0xd0, 0x46, 0xa2, 0xa2, 0xb7, 0x16, 0xa2, 0x02, 0x08, 0x51, 0x0b, 0xfa, 0x01, 0x63,
+	0x64, 0xe3, 0x06, 0xfa, 0x3e, 0x03, 0x63, 0x69, 0x95, 0x6d, 0x91, 0xcd, 0x1b, 0xcc, 0xa4, 0xa8,
+	0x0c, 0xd0, 0x15, 0xb6, 0x4f, 0x76, 0x4a, 0xf0, 0xa2, 0xdc, 0xfc, 0x59, 0x61, 0x8b, 0xae, 0xb2,
+	0x4d, 0x52, 0x2f, 0xb9, 0xaf, 0xfb, 0xe7, 0xcf, 0x69, 0x8d, 0x35, 0xc9, 0xf6, 0x09, 0x97, 0x31,
+	0x88, 0x01, 0x9e, 0xa7, 0xa0, 0xba, 0x6a, 0x0a, 0x31, 0xa6, 0x40, 0x6f, 0x2f, 0xc8, 0xf4, 0x2d,
+	0xb7, 0x30, 0xd0, 0x5c, 0x19, 0x69, 0x73, 0x7b, 0x6b, 0xc5, 0xb6, 0x78, 0x66, 0x27, 0xa8, 0xe5,
+	0x4b, 0x10, 0x94, 0xb0, 0x6d, 0x42, 0x03, 0x30, 0x98, 0xe9, 0x10, 0x8e, 0x51, 0x8d, 0x63, 0x19,
+	0x5a, 0x5a, 0xcf, 0x3d, 0x5f, 0xa3, 0xdd, 0x1f, 0xa4, 0xb1, 0x86, 0xae, 0x2f, 0x46, 0x3e, 0x47,
+	0x7b, 0x82, 0x99, 0x12, 0xb4, 0x91, 0x1b, 0x0b, 0x30, 0xb3, 0xa0, 0x8b, 0x73, 0xda, 0x60, 0xf7,
+	0x49, 0xf3, 0xab, 0xd0, 0x66, 0x3c, 0x3e, 0x0d, 0x2e, 0x8e, 0xb9, 0x52, 0x68, 0x8f, 0xe0, 0x38,
+	0xe6, 0x32, 0x01, 0x41, 0x37, 0x97, 0xb2, 0x7d, 0xcb, 0xb5, 0x05, 0x41, 0xe9, 0xf2, 0x5c, 0xcd,
+	0xcd, 0x04, 0x04, 0xbd, 0xc3, 0xee, 0x91, 0xdd, 0xf7, 0xd8, 0xe2, 0x0c, 0x28, 0x5b, 0x9a, 0x1a,
+	0x40, 0x82, 0x53, 0x10, 0x74, 0xeb, 0x7f, 0x7e, 0x8b, 0x69, 0x0a, 0x82, 0x6e, 0x33, 0x9f, 0xec,
+	0xbf, 0xc7, 0x0e, 0x55, 0x58, 0x9a, 0xbe, 0xbb, 0x94, 0xef, 0x4e, 0x79, 0x98, 0xf1, 0xdc, 0xf6,
+	0x0e, 0x7b, 0x40, 0xf6, 0x3a, 0x60, 0xa4, 0x06, 0xb1, 0x28, 0x90, 0x0a, 0x47, 0xef, 0xe6, 0x05,
+	0x09, 0x32, 0xa5, 0xa4, 0x8a, 0xce, 0x55, 0x47, 0x8e, 0xc7, 0xa0, 0x41, 0xd9, 0x63, 0x88, 0x63,
+	0xda, 0x64, 0x9f, 0x91, 0x4f, 0xff, 0x49, 0xed, 0x87, 0x13, 0x10, 0x59, 0x2c, 0x55, 0xd4, 0x53,
+	0x63, 0xfc, 0xaf, 0xd0, 0x5e, 0x5e, 0x95, 0x67, 0xc3, 0x5e, 0xe7, 0x19, 0x28, 0xd0, 0xdc, 0x55,
+	0x74, 0x3f, 0x3f, 0xff, 0x0e, 0x18, 0xd0, 0x92, 0xc7, 0xf2, 0x25, 0xd0, 0x7b, 0x6c, 0x9d, 0xdc,
+	0xee, 0x00, 0x17, 0x31, 0x86, 0x97, 0xf4, 0x7e, 0xd1, 0xa2, 0x1a, 0x42, 0x9c, 0x82, 0xe6, 0xa3,
+	0x18, 0xe8, 0x03, 0xd7, 0x1f, 0x02, 0x92, 0x14, 0x2d, 0xa8, 0x70, 0xf6, 0x0d, 0xcc, 0x6e, 0xea,
+	0xee, 0xb3, 0x06, 0x59, 0x3b, 0x41, 0x3d, 0x92, 0x42, 0x80, 0xa2, 0x07, 0x6c, 0x8f, 0xdc, 0x2d,
+	0x7b, 0xb6, 0xab, 0xac, 0xb4, 0xb3, 0x01, 0xe2, 0x29, 0xd7, 0x11, 0xd0, 0x43, 0x56, 0x27, 0xb5,
+	0x81, 0x4c, 0x00, 0x33, 0x4b, 0x3f, 0x64, 0xbb, 0x64, 0xeb, 0x4c, 0x46, 0x85, 0xa7, 0x9e, 0xba,
+	0xd0, 0x18, 0x69, 0x30, 0x86, 0xb6, 0xd8, 0x0e, 0x61, 0x03, 0xc4, 0x33, 0xae, 0x66, 0xfd, 0x6c,
+	0x64, 0x42, 0x2d, 0x47, 0xa0, 0x0d, 0x7d, 0x98, 0xbb, 0x1e, 0x2a, 0x3e, 0xe5, 0x32, 0x76, 0xa6,
+	0x3e, 0xca, 0x81, 0x80, 0x5b, 0x38, 0x95, 0x89, 0xcc, 0xf7, 0xfa, 0x71, 0xeb, 0x0b, 0xd2, 0x70,
+	0x1d, 0x75, 0x3d, 0x1f, 0xec, 0x21, 0x59, 0x71, 0x17, 0x87, 0x9b, 0xf4, 0xfa, 0xd3, 0xc6, 0xbf,
+	0x26, 0x3d, 0x28, 0xb8, 0xd6, 0x77, 0xa4, 0x5e, 0xcc, 0x9d, 0x43, 0xd9, 0x21, 0xa9, 0x46, 0x99,
+	0x14, 0x2e, 0x65, 0xed, 0x68, 0x3d, 0x9f, 0xf5, 0xbf, 0xde, 0x1c, 0x38, 0x2c, 0x70, 0x4f, 0xf6,
+	0x09, 0x59, 0xd5, 0xc0, 0x0d, 0xaa, 0xf2, 0x3e, 0xd8, 0x28, 0x63, 0x4a, 0x34, 0x28, 0xdf, 0x5f,
+	0x56, 0xdf, 0xfe, 0x72, 0xe0, 0x1d, 0x3d, 0x7e, 0x7d, 0xe5, 0x7b, 0xbf, 0x5d, 0xf9, 0x95, 0x77,
+	0x57, 0xbe, 0xf7, 0xe3, 0xdc, 0xf7, 0x7e, 0x9d, 0xfb, 0x95, 0x57, 0x73, 0xdf, 0x7b, 0x3d, 0xf7,
+	0xbd, 0x3f, 0xe6, 0xbe, 0xf7, 0x76, 0xee, 0x57, 0xde, 0xcd, 0x7d, 0xef, 0xe7, 0x3f, 0xfd, 0xca,
+	0xdf, 0x03, 0x00, 0xa0, 0xbd, 0x12, 0x93, 0xe9, 0x04, 0x00, 0x00,
 }
@@ -0,0 +1,115 @@
+package models
+
+// ErrorCategory groups the error types by what a client can do about them.
+type ErrorCategory string
+
+const (
+	// ErrorCategoryValidation errors reject a request that can only succeed
+	// once it is changed.
+	ErrorCategoryValidation ErrorCategory = "validation"
+
+	// ErrorCategoryNotFound errors report that the record the request refers
+	// to does not exist.
+	ErrorCategoryNotFound ErrorCategory = "not-found"
+
+	// ErrorCategoryConflict errors reject a request that does not fit the
+	// current state of the record, e.g. a state transition it does not allow.
+	// The client may read the record again and decide whether to retry.
+	ErrorCategoryConflict ErrorCategory = "conflict"
+
+	// ErrorCategoryUnavailable errors report that the BBS cannot serve the
+	// request right now. The same request may succeed later, or on another
+	// BBS.
+	ErrorCategoryUnavailable ErrorCategory = "unavailable"
+
+	// ErrorCategoryForbidden errors reject a request for the identity of the
+	// client.
+	ErrorCategoryForbidden ErrorCategory = "forbidden"
+
+	// ErrorCategoryInternal errors report a failure of the BBS or of its
+	// store that the client cannot do anything about.
+	ErrorCategoryInternal ErrorCategory = "internal"
+)
+
+// ErrorCatalogEntry documents an error type. The numeric code of the type,
+// int32(Type), is part of the API and never changes meaning.
+type ErrorCatalogEntry struct {
+	Type        Error_Type
+	Category    ErrorCategory
+	Retryable   bool
+	Description string
+}
+
+// ErrorCatalog lists every error type, in code order, including the reserved
+// ones that neither the BBS nor its client return any more. Clients should
+// branch on the type, or on its category, rather than on the message, which is
+// meant for people and may change.
+var ErrorCatalog = []ErrorCatalogEntry{
+	{Error_UnknownError, ErrorCategoryInternal, false, "an error the BBS did not classify"},
+	{Error_InvalidDomain, ErrorCategoryValidation, false, "not returned by this BBS; the code is reserved"},
+	{Error_UnkownVersion, ErrorCategoryInternal, false, "not returned by this BBS; the code is reserved"},
+	{Error_InvalidRecord, ErrorCategoryValidation, false, "the record fails validation"},
+	{Error_InvalidRequest, ErrorCategoryValidation, false, "the request fails validation or cannot be decoded"},
+	{Error_InvalidResponse, ErrorCategoryInternal, false, "the client received a response it cannot decode, e.g. a bare HTTP error"},
+	{Error_InvalidProtobufMessage, ErrorCategoryInternal, false, "the client received a protobuf response it cannot decode"},
+	{Error_InvalidJSON, ErrorCategoryValidation, false, "the JSON body cannot be decoded"},
+	{Error_FailedToOpenEnvelope, ErrorCategoryInternal, false, "not returned by this BBS; the code is reserved"},
+	{Error_InvalidStateTransition, ErrorCategoryConflict, false, "the task cannot move from its current state to the requested one"},
+	{Error_Unauthorized, ErrorCategoryForbidden, false, "the request lacks the client certificate the route requires"},
+	{Error_ResourceConflict, ErrorCategoryConflict, false, "the record is in a state that conflicts with the request"},
+	{Error_ResourceExists, ErrorCategoryConflict, false, "the record already exists"},
+	{Error_ResourceNotFound, ErrorCategoryNotFound, false, "the record does not exist"},
+	{Error_RouterError, ErrorCategoryUnavailable, true, "the router in front of the BBS failed to reach it"},
+	{Error_ActualLRPCannotBeClaimed, ErrorCategoryConflict, false, "the actual LRP cannot be claimed in its current state"},
+	{Error_ActualLRPCannotBeStarted, ErrorCategoryConflict, false, "the actual LRP cannot be started in its current state"},
+	{Error_ActualLRPCannotBeCrashed, ErrorCategoryConflict, false, "the actual LRP cannot be crashed in its current state"},
+	{Error_ActualLRPCannotBeFailed, ErrorCategoryConflict, false, "the actual LRP cannot be failed in its current state"},
+	{Error_ActualLRPCannotBeRemoved, ErrorCategoryConflict, false, "the actual LRP cannot be removed in its current state"},
+	{Error_ActualLRPCannotBeStopped, ErrorCategoryConflict, false, "the actual LRP cannot be stopped in its current state"},
+	{Error_ActualLRPCannotBeUnclaimed, ErrorCategoryConflict, false, "the actual LRP cannot be unclaimed in its current state"},
+	{Error_ActualLRPCannotBeEvacuated, ErrorCategoryConflict, false, "the actual LRP cannot be evacuated in its current state"},
+	{Error_DesiredLRPCannotBeUpdated, ErrorCategoryConflict, false, "the desired LRP was changed concurrently"},
+	{Error_RunningOnDifferentCell, ErrorCategoryConflict, false, "the task is running on another cell than the one in the request"},
+	{Error_DesiredLRPSchedulingInfoCannotBeUpdated, ErrorCategoryConflict, false, "not returned by this BBS; the code is reserved"},
+	{Error_GUIDGeneration, ErrorCategoryInternal, true, "the BBS failed to generate a GUID"},
+	{Error_Deserialize, ErrorCategoryInternal, false, "a stored record cannot be deserialized"},
+	{Error_Deadlock, ErrorCategoryConflict, true, "the database aborted the request to resolve a deadlock"},
+	{Error_Unrecoverable, ErrorCategoryInternal, false, "the BBS hit an error it cannot recover from and is exiting"},
+	{Error_IdempotencyKeyConflict, ErrorCategoryConflict, false, "the idempotency key was already used for a different request"},
+	{Error_Forbidden, ErrorCategoryForbidden, false, "the identity of the client is not allowed to use the route"},
+	{Error_RequestEntityTooLarge, ErrorCategoryValidation, false, "the request body exceeds the size limit"},
+	{Error_Timeout, ErrorCategoryUnavailable, true, "the database did not complete the request in time"},
+	{Error_MigrationInProgress, ErrorCategoryUnavailable, true, "the BBS holds the lock but is still migrating the database"},
+	{Error_TooManySubscribers, ErrorCategoryUnavailable, true, "the BBS has as many event stream subscribers as it allows"},
+	{Error_Unavailable, ErrorCategoryUnavailable, true, "the BBS is not ready, or does not hold the lock, and cannot serve the request"},
+	{Error_RateLimited, ErrorCategoryUnavailable, true, "the client made more requests than its rate limit allows"},
+}
+
+var errorCatalogByType = func() map[Error_Type]ErrorCatalogEntry {
+	entries := make(map[Error_Type]ErrorCatalogEntry, len(ErrorCatalog))
+	for _, entry := range ErrorCatalog {
+		entries[entry.Type] = entry
+	}
+	return entries
+}()
+
+// CatalogEntry returns the catalog entry of the error type. An unknown type,
+// e.g. one added by a newer BBS, is reported as an internal error.
+func (t Error_Type) CatalogEntry() ErrorCatalogEntry {
+	entry, ok := errorCatalogByType[t]
+	if !ok {
+		return ErrorCatalogEntry{Type: t, Category: ErrorCategoryInternal, Description: "an error type this client does not know"}
+	}
+	return entry
+}
+
+// Category returns the category of the error type.
+func (t Error_Type) Category() ErrorCategory {
+	return t.CatalogEntry().Category
+}
+
+// Retryable reports whether the same request may succeed when it is retried
+// later without being changed.
+func (t Error_Type) Retryable() bool {
+	return t.CatalogEntry().Retryable
+}
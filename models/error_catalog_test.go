@@ -0,0 +1,62 @@
+package models_test
+
+import (
+	. "code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ErrorCatalog", func() {
+	It("has one entry for every error type, in code order", func() {
+		Expect(ErrorCatalog).To(HaveLen(len(Error_Type_name)))
+		for i, entry := range ErrorCatalog {
+			Expect(int32(entry.Type)).To(BeEquivalentTo(i))
+			Expect(entry.Description).NotTo(BeEmpty(), entry.Type.String())
+		}
+	})
+
+	DescribeTable("keeps the numeric code of every error type",
+		func(errType Error_Type, code int32) {
+			Expect(int32(errType)).To(Equal(code))
+		},
+		Entry("UnknownError", Error_UnknownError, int32(0)),
+		Entry("InvalidRecord", Error_InvalidRecord, int32(3)),
+		Entry("InvalidRequest", Error_InvalidRequest, int32(4)),
+		Entry("InvalidJSON", Error_InvalidJSON, int32(7)),
+		Entry("Unauthorized", Error_Unauthorized, int32(10)),
+		Entry("ResourceConflict", Error_ResourceConflict, int32(11)),
+		Entry("ResourceExists", Error_ResourceExists, int32(12)),
+		Entry("ResourceNotFound", Error_ResourceNotFound, int32(13)),
+		Entry("ActualLRPCannotBeClaimed", Error_ActualLRPCannotBeClaimed, int32(15)),
+		Entry("Deadlock", Error_Deadlock, int32(28)),
+		Entry("Forbidden", Error_Forbidden, int32(31)),
+		Entry("Timeout", Error_Timeout, int32(33)),
+		Entry("MigrationInProgress", Error_MigrationInProgress, int32(34)),
+		Entry("TooManySubscribers", Error_TooManySubscribers, int32(35)),
+		Entry("Unavailable", Error_Unavailable, int32(36)),
+	)
+
+	DescribeTable("categorizes the error types",
+		func(errType Error_Type, category ErrorCategory, retryable bool) {
+			Expect(errType.Category()).To(Equal(category))
+			Expect(errType.Retryable()).To(Equal(retryable))
+		},
+		Entry("InvalidRequest", Error_InvalidRequest, ErrorCategoryValidation, false),
+		Entry("ResourceNotFound", Error_ResourceNotFound, ErrorCategoryNotFound, false),
+		Entry("ResourceConflict", Error_ResourceConflict, ErrorCategoryConflict, false),
+		Entry("Deadlock", Error_Deadlock, ErrorCategoryConflict, true),
+		Entry("Forbidden", Error_Forbidden, ErrorCategoryForbidden, false),
+		Entry("MigrationInProgress", Error_MigrationInProgress, ErrorCategoryUnavailable, true),
+		Entry("Unavailable", Error_Unavailable, ErrorCategoryUnavailable, true),
+		Entry("Unrecoverable", Error_Unrecoverable, ErrorCategoryInternal, false),
+		Entry("InvalidProtobufMessage, returned by the client for a response", Error_InvalidProtobufMessage, ErrorCategoryInternal, false),
+	)
+
+	It("reports an unknown error type as an internal error", func() {
+		errType := Error_Type(1000)
+		Expect(errType.Category()).To(Equal(ErrorCategoryInternal))
+		Expect(errType.Retryable()).To(BeFalse())
+	})
+})
@@ -135,6 +135,46 @@ var (
 		Type:    Error_GUIDGeneration,
 		Message: "cannot generate random guid",
 	}
+
+	ErrIdempotencyKeyConflict = &Error{
+		Type:    Error_IdempotencyKeyConflict,
+		Message: "the idempotency key was already used for a different request",
+	}
+
+	ErrRequestEntityTooLarge = &Error{
+		Type:    Error_RequestEntityTooLarge,
+		Message: "request body is too large",
+	}
+
+	ErrTimeout = &Error{
+		Type:    Error_Timeout,
+		Message: "the operation timed out",
+	}
+
+	ErrMigrationInProgress = &Error{
+		Type:    Error_MigrationInProgress,
+		Message: "the database is being migrated",
+	}
+
+	ErrTooManySubscribers = &Error{
+		Type:    Error_TooManySubscribers,
+		Message: "too many event stream subscribers",
+	}
+
+	ErrUnavailable = &Error{
+		Type:    Error_Unavailable,
+		Message: "the BBS cannot serve the request right now",
+	}
+
+	ErrRateLimited = &Error{
+		Type:    Error_RateLimited,
+		Message: "too many requests from this client",
+	}
+
+	ErrImportStoreNotEmpty = &Error{
+		Type:    Error_ResourceExists,
+		Message: "the store is not empty; import with force to overwrite existing records",
+	}
 )
 
 type ErrInvalidField struct {
@@ -145,6 +185,19 @@ func (err ErrInvalidField) Error() string {
 	return "Invalid field: " + err.Field
 }
 
+// ErrInvalidLabelSelector is returned for a label selector that does not
+// follow the grammar described on LabelSelector. Position is the byte offset
+// in Selector at which parsing failed.
+type ErrInvalidLabelSelector struct {
+	Selector string
+	Position int
+	Reason   string
+}
+
+func (err ErrInvalidLabelSelector) Error() string {
+	return fmt.Sprintf("invalid label selector '%s' at position %d: %s", err.Selector, err.Position, err.Reason)
+}
+
 type ErrInvalidModification struct {
 	InvalidField string
 }
@@ -161,6 +161,59 @@ func (m *ActualLRPCrashedEvent) GetSince() int64 {
 	return 0
 }
 
+type TaskCreatedEvent struct {
+	Task *Task `protobuf:"bytes,1,opt,name=task" json:"task,omitempty"`
+}
+
+func (m *TaskCreatedEvent) Reset()                    { *m = TaskCreatedEvent{} }
+func (*TaskCreatedEvent) ProtoMessage()               {}
+func (*TaskCreatedEvent) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{7} }
+
+func (m *TaskCreatedEvent) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type TaskChangedEvent struct {
+	Before *Task `protobuf:"bytes,1,opt,name=before" json:"before,omitempty"`
+	After  *Task `protobuf:"bytes,2,opt,name=after" json:"after,omitempty"`
+}
+
+func (m *TaskChangedEvent) Reset()                    { *m = TaskChangedEvent{} }
+func (*TaskChangedEvent) ProtoMessage()               {}
+func (*TaskChangedEvent) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{8} }
+
+func (m *TaskChangedEvent) GetBefore() *Task {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *TaskChangedEvent) GetAfter() *Task {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+type TaskRemovedEvent struct {
+	Task *Task `protobuf:"bytes,1,opt,name=task" json:"task,omitempty"`
+}
+
+func (m *TaskRemovedEvent) Reset()                    { *m = TaskRemovedEvent{} }
+func (*TaskRemovedEvent) ProtoMessage()               {}
+func (*TaskRemovedEvent) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{9} }
+
+func (m *TaskRemovedEvent) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ActualLRPCreatedEvent)(nil), "models.ActualLRPCreatedEvent")
 	proto.RegisterType((*ActualLRPChangedEvent)(nil), "models.ActualLRPChangedEvent")
@@ -169,6 +222,9 @@ func init() {
 	proto.RegisterType((*DesiredLRPChangedEvent)(nil), "models.DesiredLRPChangedEvent")
 	proto.RegisterType((*DesiredLRPRemovedEvent)(nil), "models.DesiredLRPRemovedEvent")
 	proto.RegisterType((*ActualLRPCrashedEvent)(nil), "models.ActualLRPCrashedEvent")
+	proto.RegisterType((*TaskCreatedEvent)(nil), "models.TaskCreatedEvent")
+	proto.RegisterType((*TaskChangedEvent)(nil), "models.TaskChangedEvent")
+	proto.RegisterType((*TaskRemovedEvent)(nil), "models.TaskRemovedEvent")
 }
 func (this *ActualLRPCreatedEvent) Equal(that interface{}) bool {
 	if that == nil {
@@ -398,6 +454,99 @@ func (this *ActualLRPCrashedEvent) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TaskCreatedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TaskCreatedEvent)
+	if !ok {
+		that2, ok := that.(TaskCreatedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Task.Equal(that1.Task) {
+		return false
+	}
+	return true
+}
+func (this *TaskChangedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TaskChangedEvent)
+	if !ok {
+		that2, ok := that.(TaskChangedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Before.Equal(that1.Before) {
+		return false
+	}
+	if !this.After.Equal(that1.After) {
+		return false
+	}
+	return true
+}
+func (this *TaskRemovedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TaskRemovedEvent)
+	if !ok {
+		that2, ok := that.(TaskRemovedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Task.Equal(that1.Task) {
+		return false
+	}
+	return true
+}
 func (this *ActualLRPCreatedEvent) GoString() string {
 	if this == nil {
 		return "nil"
@@ -490,6 +639,45 @@ func (this *ActualLRPCrashedEvent) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TaskCreatedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.TaskCreatedEvent{")
+	if this.Task != nil {
+		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskChangedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.TaskChangedEvent{")
+	if this.Before != nil {
+		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+	}
+	if this.After != nil {
+		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskRemovedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.TaskRemovedEvent{")
+	if this.Task != nil {
+		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringEvents(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -748,6 +936,100 @@ func (m *ActualLRPCrashedEvent) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TaskCreatedEvent) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TaskCreatedEvent) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Task != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintEvents(data, i, uint64(m.Task.Size()))
+		n11, err := m.Task.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *TaskChangedEvent) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TaskChangedEvent) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Before != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintEvents(data, i, uint64(m.Before.Size()))
+		n12, err := m.Before.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.After != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintEvents(data, i, uint64(m.After.Size()))
+		n13, err := m.After.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+
+func (m *TaskRemovedEvent) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TaskRemovedEvent) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Task != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintEvents(data, i, uint64(m.Task.Size()))
+		n14, err := m.Task.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+
 func encodeFixed64Events(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
 	data[offset+1] = uint8(v >> 8)
@@ -857,6 +1139,40 @@ func (m *ActualLRPCrashedEvent) Size() (n int) {
 	return n
 }
 
+func (m *TaskCreatedEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskChangedEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskRemovedEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	for {
 		n++
@@ -946,16 +1262,47 @@ func (this *ActualLRPCrashedEvent) String() string {
 	}, "")
 	return s
 }
-func valueToStringEvents(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *TaskCreatedEvent) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := strings.Join([]string{`&TaskCreatedEvent{`,
+		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
+		`}`,
+	}, "")
+	return s
 }
-func (m *ActualLRPCreatedEvent) Unmarshal(data []byte) error {
-	l := len(data)
+func (this *TaskChangedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskChangedEvent{`,
+		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "Task", "Task", 1) + `,`,
+		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Task", "Task", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskRemovedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskRemovedEvent{`,
+		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEvents(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ActualLRPCreatedEvent) Unmarshal(data []byte) error {
+	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -1695,6 +2042,288 @@ func (m *ActualLRPCrashedEvent) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *TaskCreatedEvent) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCreatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCreatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &Task{}
+			}
+			if err := m.Task.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskChangedEvent) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = &Task{}
+			}
+			if err := m.Before.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &Task{}
+			}
+			if err := m.After.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskRemovedEvent) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskRemovedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &Task{}
+			}
+			if err := m.Task.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(data []byte) (n int, err error) {
 	l := len(data)
 	iNdEx := 0
@@ -1803,35 +2432,38 @@ var (
 func init() { proto.RegisterFile("events.proto", fileDescriptorEvents) }
 
 var fileDescriptorEvents = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0xbb, 0xdb, 0x05, 0xa7, 0x65, 0xd1, 0xb0, 0xd6, 0x50, 0x64, 0x5a, 0x0a, 0x42,
-	0x90, 0x9a, 0x05, 0x7d, 0x01, 0xb7, 0xab, 0xa8, 0x6c, 0x05, 0xc9, 0xcd, 0x53, 0x99, 0xa6, 0x5f,
-	0xd3, 0x60, 0x93, 0x09, 0x93, 0xc9, 0x42, 0x6f, 0x3e, 0x82, 0x8f, 0xe1, 0xa3, 0x2c, 0x9e, 0x7a,
-	0xf4, 0x54, 0x6c, 0xbc, 0xc8, 0x9e, 0xf6, 0x11, 0x24, 0x33, 0x93, 0xee, 0x64, 0xbb, 0x28, 0x88,
-	0xb7, 0xcc, 0xf7, 0xfd, 0xbf, 0xdf, 0x7c, 0xf3, 0x23, 0xb8, 0x05, 0x17, 0x90, 0x88, 0xcc, 0x4b,
-	0x39, 0x13, 0xcc, 0x3e, 0x8c, 0xd9, 0x14, 0x16, 0x59, 0xe7, 0x59, 0x18, 0x89, 0x79, 0x3e, 0xf1,
-	0x02, 0x16, 0x9f, 0x84, 0x2c, 0x64, 0x27, 0xb2, 0x3d, 0xc9, 0x67, 0xf2, 0x24, 0x0f, 0xf2, 0x4b,
-	0x8d, 0x75, 0xee, 0xd3, 0x40, 0xe4, 0x74, 0x31, 0x5e, 0xf0, 0x54, 0x57, 0x1e, 0x4c, 0x21, 0x8b,
-	0x38, 0x4c, 0x6f, 0x4a, 0xfd, 0x8f, 0xf8, 0xe1, 0xa9, 0x8c, 0x8d, 0xfc, 0x0f, 0x67, 0x1c, 0xa8,
-	0x80, 0xe9, 0xeb, 0xf2, 0x6e, 0xfb, 0x25, 0x36, 0xe6, 0xc7, 0x21, 0x67, 0x79, 0xea, 0xa0, 0x1e,
-	0x72, 0x9b, 0xcf, 0xdb, 0x9e, 0xda, 0xc7, 0xdb, 0x0e, 0xbe, 0x29, 0xbb, 0xfe, 0x91, 0xca, 0x8f,
-	0x78, 0x2a, 0xcf, 0xfd, 0xdc, 0x44, 0xcf, 0x69, 0x12, 0x56, 0x68, 0x0f, 0x1f, 0x4e, 0x60, 0xc6,
-	0x38, 0xfc, 0x05, 0xa8, 0x53, 0xf6, 0x00, 0x37, 0xe8, 0x4c, 0x00, 0x77, 0xf6, 0xfe, 0x18, 0x57,
-	0xa1, 0xda, 0x8b, 0x7c, 0x88, 0xd9, 0xc5, 0xff, 0x7b, 0xd1, 0x7b, 0xdc, 0x7e, 0xa5, 0x0c, 0xde,
-	0xb6, 0xf5, 0x02, 0x37, 0x0d, 0xb7, 0x1a, 0x6b, 0x57, 0xd8, 0x9b, 0x21, 0x1f, 0xeb, 0xd8, 0x88,
-	0xa7, 0xfd, 0xa4, 0x86, 0x33, 0x0d, 0x3d, 0xbd, 0x65, 0xe8, 0x2e, 0x52, 0x65, 0xc7, 0xad, 0xdb,
-	0xb9, 0x2b, 0xaa, 0xcd, 0xd4, 0xd6, 0xaf, 0xa9, 0xf9, 0xa7, 0xf5, 0xbf, 0xed, 0xd5, 0xfe, 0x1d,
-	0x9a, 0xcd, 0x2b, 0xdc, 0x5b, 0x7c, 0x64, 0x98, 0xfe, 0x04, 0x4b, 0x4d, 0x3c, 0xde, 0xf1, 0x7c,
-	0x0e, 0xcb, 0x61, 0xeb, 0x72, 0xdd, 0xb5, 0x56, 0xeb, 0x2e, 0xba, 0x5a, 0x77, 0x2d, 0xbf, 0xb5,
-	0x75, 0x7e, 0x0e, 0x4b, 0x9b, 0xe2, 0x47, 0x06, 0x29, 0x4a, 0x32, 0x41, 0x93, 0x00, 0x24, 0x52,
-	0x3d, 0xf7, 0xf1, 0x0e, 0xf2, 0x9d, 0x0e, 0xed, 0xa2, 0x8f, 0xb7, 0x68, 0x23, 0x63, 0x3f, 0xc1,
-	0xcd, 0xa0, 0x5c, 0x7e, 0x1c, 0xb0, 0x3c, 0x11, 0xce, 0x7e, 0x0f, 0xb9, 0x8d, 0xe1, 0x41, 0x39,
-	0xe8, 0x63, 0xd9, 0x38, 0x2b, 0xeb, 0xf6, 0x29, 0x6e, 0xa9, 0x18, 0x07, 0x9a, 0xb1, 0xc4, 0x39,
-	0xe8, 0x21, 0xf7, 0xde, 0x90, 0x94, 0xb9, 0xab, 0x75, 0xb7, 0x6d, 0xf6, 0x06, 0x2c, 0x8e, 0x04,
-	0xc4, 0xa9, 0x58, 0xfa, 0x0a, 0xed, 0xcb, 0xb2, 0xdd, 0xc1, 0x8d, 0x2c, 0x4a, 0x02, 0x70, 0x1a,
-	0x3d, 0xe4, 0xee, 0xeb, 0x3b, 0x54, 0x69, 0x38, 0x58, 0x6d, 0x88, 0xf5, 0x7d, 0x43, 0xac, 0xeb,
-	0x0d, 0x41, 0x9f, 0x0b, 0x82, 0xbe, 0x16, 0x04, 0x5d, 0x16, 0x04, 0xad, 0x0a, 0x82, 0x7e, 0x14,
-	0x04, 0xfd, 0x2a, 0x88, 0x75, 0x5d, 0x10, 0xf4, 0xe5, 0x27, 0xb1, 0x7e, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0xab, 0x2f, 0x45, 0x5d, 0x20, 0x04, 0x00, 0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xd1, 0x8a, 0xd3, 0x40,
+	0x14, 0x86, 0x33, 0xbb, 0xed, 0x82, 0xd3, 0xb2, 0xac, 0x61, 0xad, 0xa5, 0xc8, 0xb4, 0x04, 0x85,
+	0x22, 0x35, 0x0b, 0xea, 0x03, 0xb8, 0x5d, 0x45, 0x65, 0x2b, 0x48, 0xf0, 0x46, 0x10, 0xca, 0x34,
+	0x9d, 0xa6, 0xa1, 0x4d, 0x26, 0x4c, 0x26, 0x0b, 0xbd, 0xf3, 0x11, 0x7c, 0x0c, 0x1f, 0x65, 0xf1,
+	0xaa, 0x97, 0x5e, 0x15, 0x1b, 0x6f, 0x64, 0xaf, 0xf6, 0x11, 0x24, 0x33, 0x93, 0x3a, 0xd3, 0x2e,
+	0x2e, 0x88, 0x77, 0x99, 0x73, 0xfe, 0xf3, 0xf5, 0x9c, 0x9f, 0xbf, 0xb0, 0x4e, 0x2e, 0x48, 0xcc,
+	0x53, 0x37, 0x61, 0x94, 0x53, 0xfb, 0x20, 0xa2, 0x63, 0x32, 0x4f, 0x5b, 0x4f, 0x82, 0x90, 0x4f,
+	0xb3, 0x91, 0xeb, 0xd3, 0xe8, 0x24, 0xa0, 0x01, 0x3d, 0x11, 0xed, 0x51, 0x36, 0x11, 0x2f, 0xf1,
+	0x10, 0x5f, 0x72, 0xac, 0x75, 0x84, 0x7d, 0x9e, 0xe1, 0xf9, 0x70, 0xce, 0x12, 0x55, 0xb9, 0x3b,
+	0x26, 0x69, 0xc8, 0xc8, 0x58, 0x2b, 0x41, 0x8e, 0xd3, 0x99, 0xfc, 0x76, 0x3e, 0xc2, 0x7b, 0xa7,
+	0x62, 0x64, 0xe0, 0xbd, 0x3f, 0x63, 0x04, 0x73, 0x32, 0x7e, 0x55, 0xec, 0x61, 0xbf, 0x80, 0x1a,
+	0x6b, 0x18, 0x30, 0x9a, 0x25, 0x4d, 0xd0, 0x01, 0xdd, 0xda, 0xd3, 0x86, 0x2b, 0x77, 0x73, 0x37,
+	0x83, 0xaf, 0x8b, 0xae, 0x77, 0x28, 0xf5, 0x03, 0x96, 0x88, 0xb7, 0x93, 0xe9, 0xe8, 0x29, 0x8e,
+	0x83, 0x12, 0xed, 0xc2, 0x83, 0x11, 0x99, 0x50, 0x46, 0x6e, 0x01, 0x2a, 0x95, 0xdd, 0x83, 0x55,
+	0x3c, 0xe1, 0x84, 0x35, 0xf7, 0xfe, 0x2a, 0x97, 0x22, 0xe3, 0x22, 0x8f, 0x44, 0xf4, 0xe2, 0xff,
+	0x5d, 0xf4, 0x0e, 0x36, 0x5e, 0x4a, 0x37, 0xb7, 0xdd, 0x7a, 0x06, 0x6b, 0x9a, 0xcf, 0x0a, 0x6b,
+	0x97, 0xd8, 0x3f, 0x43, 0x1e, 0x54, 0xb2, 0x01, 0x4b, 0x9c, 0xd8, 0xc0, 0xe9, 0x0e, 0x3d, 0xde,
+	0x72, 0xe8, 0x26, 0x52, 0xe9, 0x4e, 0xd7, 0x74, 0xe7, 0x26, 0xa9, 0x72, 0xc6, 0x58, 0xdf, 0xb0,
+	0xe6, 0x9f, 0xd6, 0xff, 0xb6, 0x67, 0x64, 0x07, 0xa7, 0xd3, 0x12, 0xf7, 0x06, 0x1e, 0x6a, 0x4e,
+	0xcf, 0xc8, 0x42, 0x11, 0x8f, 0x77, 0x7c, 0x3e, 0x27, 0x8b, 0x7e, 0xfd, 0x72, 0xd5, 0xb6, 0x96,
+	0xab, 0x36, 0xb8, 0x5a, 0xb5, 0x2d, 0xaf, 0xbe, 0xf1, 0xfc, 0x9c, 0x2c, 0x6c, 0x0c, 0xef, 0x6b,
+	0xa4, 0x30, 0x4e, 0x39, 0x8e, 0x7d, 0x22, 0x90, 0xf2, 0xdc, 0x07, 0x3b, 0xc8, 0xb7, 0x4a, 0xb4,
+	0x8b, 0x3e, 0xde, 0xa0, 0x35, 0x8d, 0xfd, 0x08, 0xd6, 0xfc, 0x62, 0xf9, 0xa1, 0x4f, 0xb3, 0x98,
+	0x37, 0xf7, 0x3b, 0xa0, 0x5b, 0xed, 0x57, 0x8a, 0x41, 0x0f, 0x8a, 0xc6, 0x59, 0x51, 0xb7, 0x4f,
+	0x61, 0x5d, 0xca, 0x18, 0xc1, 0x29, 0x8d, 0x9b, 0x95, 0x0e, 0xe8, 0xde, 0xe9, 0xa3, 0x42, 0x77,
+	0xb5, 0x6a, 0x37, 0xf4, 0x5e, 0x8f, 0x46, 0x21, 0x27, 0x51, 0xc2, 0x17, 0x9e, 0x44, 0x7b, 0xa2,
+	0x6c, 0xb7, 0x60, 0x35, 0x0d, 0x63, 0x9f, 0x34, 0xab, 0x1d, 0xd0, 0xdd, 0x57, 0xbf, 0x21, 0x4b,
+	0xce, 0x73, 0x78, 0xf4, 0x01, 0xa7, 0x33, 0x23, 0x54, 0x1d, 0x58, 0x29, 0xfe, 0xa9, 0xca, 0xbc,
+	0x7a, 0x79, 0x69, 0xa1, 0xf3, 0x44, 0xc7, 0xf9, 0xa4, 0xa6, 0xf4, 0xec, 0x3c, 0xdc, 0xca, 0x8e,
+	0x39, 0x57, 0xa6, 0xc6, 0x31, 0x53, 0x63, 0x8a, 0x54, 0x5e, 0xd4, 0x4e, 0x46, 0x52, 0x6e, 0xdd,
+	0xa9, 0xdf, 0x5b, 0xae, 0x91, 0xf5, 0x7d, 0x8d, 0xac, 0xeb, 0x35, 0x02, 0x9f, 0x73, 0x04, 0xbe,
+	0xe6, 0x08, 0x5c, 0xe6, 0x08, 0x2c, 0x73, 0x04, 0x7e, 0xe4, 0x08, 0xfc, 0xca, 0x91, 0x75, 0x9d,
+	0x23, 0xf0, 0xe5, 0x27, 0xb2, 0x7e, 0x0f, 0x00, 0x82, 0x58, 0x8c, 0x4d, 0xf6, 0x04, 0x00, 0x00,
 }
@@ -0,0 +1,29 @@
+package models
+
+// Limits on the extension fields of a single DesiredLRP or Task. The BBS does
+// not interpret extension fields; it only stores them and returns them with
+// the model, so they are bounded like an annotation.
+const (
+	maximumExtensionFields     = 64
+	maximumExtensionFieldsSize = 16 * 1024
+)
+
+func validateExtensionFields(fields map[string][]byte) error {
+	if len(fields) > maximumExtensionFields {
+		return ErrInvalidField{"extension_fields"}
+	}
+
+	size := 0
+	for name, value := range fields {
+		if name == "" {
+			return ErrInvalidField{"extension_fields"}
+		}
+		size += len(name) + len(value)
+	}
+
+	if size > maximumExtensionFieldsSize {
+		return ErrInvalidField{"extension_fields"}
+	}
+
+	return nil
+}
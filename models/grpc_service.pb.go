@@ -0,0 +1,1022 @@
+// Code generated by protoc-gen-gogo.
+// source: grpc_service.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import bytes "bytes"
+
+import strings "strings"
+import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
+import sort "sort"
+import strconv "strconv"
+import reflect "reflect"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type EventsRequest struct {
+}
+
+func (m *EventsRequest) Reset()                    { *m = EventsRequest{} }
+func (*EventsRequest) ProtoMessage()               {}
+func (*EventsRequest) Descriptor() ([]byte, []int) { return fileDescriptorGrpcService, []int{0} }
+
+type EventEnvelope struct {
+	Type    string `protobuf:"bytes,1,opt,name=type" json:"type"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload" json:"payload"`
+}
+
+func (m *EventEnvelope) Reset()                    { *m = EventEnvelope{} }
+func (*EventEnvelope) ProtoMessage()               {}
+func (*EventEnvelope) Descriptor() ([]byte, []int) { return fileDescriptorGrpcService, []int{1} }
+
+func (m *EventEnvelope) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventEnvelope) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventsRequest)(nil), "models.EventsRequest")
+	proto.RegisterType((*EventEnvelope)(nil), "models.EventEnvelope")
+}
+func (this *EventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EventsRequest)
+	if !ok {
+		that2, ok := that.(EventsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *EventEnvelope) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EventEnvelope)
+	if !ok {
+		that2, ok := that.(EventEnvelope)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+func (this *EventsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.EventsRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventEnvelope) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.EventEnvelope{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringGrpcService(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringGrpcService(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion3
+
+// Client API for BBS service
+
+type BBSClient interface {
+	ActualLRPGroups(ctx context.Context, in *ActualLRPGroupsRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupsByProcessGuid(ctx context.Context, in *ActualLRPGroupsByProcessGuidRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, in *ActualLRPGroupByProcessGuidAndIndexRequest, opts ...grpc.CallOption) (*ActualLRPGroupResponse, error)
+	DesiredLRPs(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error)
+	DesiredLRPByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPResponse, error)
+	DesiredLRPSchedulingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (BBS_DesiredLRPSchedulingInfosClient, error)
+	Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (BBS_TasksClient, error)
+	TaskByGuid(ctx context.Context, in *TaskByGuidRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	SubscribeToEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (BBS_SubscribeToEventsClient, error)
+}
+
+type bBSClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBBSClient(cc *grpc.ClientConn) BBSClient {
+	return &bBSClient{cc}
+}
+
+func (c *bBSClient) ActualLRPGroups(ctx context.Context, in *ActualLRPGroupsRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error) {
+	out := new(ActualLRPGroupsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/ActualLRPGroups", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ActualLRPGroupsByProcessGuid(ctx context.Context, in *ActualLRPGroupsByProcessGuidRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error) {
+	out := new(ActualLRPGroupsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/ActualLRPGroupsByProcessGuid", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, in *ActualLRPGroupByProcessGuidAndIndexRequest, opts ...grpc.CallOption) (*ActualLRPGroupResponse, error) {
+	out := new(ActualLRPGroupResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/ActualLRPGroupByProcessGuidAndIndex", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPs(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error) {
+	out := new(DesiredLRPsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesiredLRPs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPResponse, error) {
+	out := new(DesiredLRPResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesiredLRPByProcessGuid", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPSchedulingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (BBS_DesiredLRPSchedulingInfosClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BBS_serviceDesc.Streams[0], c.cc, "/models.BBS/DesiredLRPSchedulingInfos", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSDesiredLRPSchedulingInfosClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_DesiredLRPSchedulingInfosClient interface {
+	Recv() (*DesiredLRPSchedulingInfo, error)
+	grpc.ClientStream
+}
+
+type bBSDesiredLRPSchedulingInfosClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSDesiredLRPSchedulingInfosClient) Recv() (*DesiredLRPSchedulingInfo, error) {
+	m := new(DesiredLRPSchedulingInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bBSClient) Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (BBS_TasksClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BBS_serviceDesc.Streams[1], c.cc, "/models.BBS/Tasks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSTasksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_TasksClient interface {
+	Recv() (*Task, error)
+	grpc.ClientStream
+}
+
+type bBSTasksClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSTasksClient) Recv() (*Task, error) {
+	m := new(Task)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bBSClient) TaskByGuid(ctx context.Context, in *TaskByGuidRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	out := new(TaskResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/TaskByGuid", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) SubscribeToEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (BBS_SubscribeToEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BBS_serviceDesc.Streams[2], c.cc, "/models.BBS/SubscribeToEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSSubscribeToEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_SubscribeToEventsClient interface {
+	Recv() (*EventEnvelope, error)
+	grpc.ClientStream
+}
+
+type bBSSubscribeToEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSSubscribeToEventsClient) Recv() (*EventEnvelope, error) {
+	m := new(EventEnvelope)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for BBS service
+
+type BBSServer interface {
+	ActualLRPGroups(context.Context, *ActualLRPGroupsRequest) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupsByProcessGuid(context.Context, *ActualLRPGroupsByProcessGuidRequest) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupByProcessGuidAndIndex(context.Context, *ActualLRPGroupByProcessGuidAndIndexRequest) (*ActualLRPGroupResponse, error)
+	DesiredLRPs(context.Context, *DesiredLRPsRequest) (*DesiredLRPsResponse, error)
+	DesiredLRPByProcessGuid(context.Context, *DesiredLRPByProcessGuidRequest) (*DesiredLRPResponse, error)
+	DesiredLRPSchedulingInfos(*DesiredLRPsRequest, BBS_DesiredLRPSchedulingInfosServer) error
+	Tasks(*TasksRequest, BBS_TasksServer) error
+	TaskByGuid(context.Context, *TaskByGuidRequest) (*TaskResponse, error)
+	SubscribeToEvents(*EventsRequest, BBS_SubscribeToEventsServer) error
+}
+
+func RegisterBBSServer(s *grpc.Server, srv BBSServer) {
+	s.RegisterService(&_BBS_serviceDesc, srv)
+}
+
+func _BBS_ActualLRPGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroups(ctx, req.(*ActualLRPGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroupsByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupsByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroupsByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroupsByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroupsByProcessGuid(ctx, req.(*ActualLRPGroupsByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroupByProcessGuidAndIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupByProcessGuidAndIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroupByProcessGuidAndIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroupByProcessGuidAndIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroupByProcessGuidAndIndex(ctx, req.(*ActualLRPGroupByProcessGuidAndIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPs(ctx, req.(*DesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPByProcessGuid(ctx, req.(*DesiredLRPByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPSchedulingInfos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DesiredLRPsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).DesiredLRPSchedulingInfos(m, &bBSDesiredLRPSchedulingInfosServer{stream})
+}
+
+type BBS_DesiredLRPSchedulingInfosServer interface {
+	Send(*DesiredLRPSchedulingInfo) error
+	grpc.ServerStream
+}
+
+type bBSDesiredLRPSchedulingInfosServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSDesiredLRPSchedulingInfosServer) Send(m *DesiredLRPSchedulingInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BBS_Tasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).Tasks(m, &bBSTasksServer{stream})
+}
+
+type BBS_TasksServer interface {
+	Send(*Task) error
+	grpc.ServerStream
+}
+
+type bBSTasksServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSTasksServer) Send(m *Task) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BBS_TaskByGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskByGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).TaskByGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/TaskByGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).TaskByGuid(ctx, req.(*TaskByGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_SubscribeToEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).SubscribeToEvents(m, &bBSSubscribeToEventsServer{stream})
+}
+
+type BBS_SubscribeToEventsServer interface {
+	Send(*EventEnvelope) error
+	grpc.ServerStream
+}
+
+type bBSSubscribeToEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSSubscribeToEventsServer) Send(m *EventEnvelope) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BBS_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "models.BBS",
+	HandlerType: (*BBSServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActualLRPGroups",
+			Handler:    _BBS_ActualLRPGroups_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroupsByProcessGuid",
+			Handler:    _BBS_ActualLRPGroupsByProcessGuid_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroupByProcessGuidAndIndex",
+			Handler:    _BBS_ActualLRPGroupByProcessGuidAndIndex_Handler,
+		},
+		{
+			MethodName: "DesiredLRPs",
+			Handler:    _BBS_DesiredLRPs_Handler,
+		},
+		{
+			MethodName: "DesiredLRPByProcessGuid",
+			Handler:    _BBS_DesiredLRPByProcessGuid_Handler,
+		},
+		{
+			MethodName: "TaskByGuid",
+			Handler:    _BBS_TaskByGuid_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DesiredLRPSchedulingInfos",
+			Handler:       _BBS_DesiredLRPSchedulingInfos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Tasks",
+			Handler:       _BBS_Tasks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeToEvents",
+			Handler:       _BBS_SubscribeToEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: fileDescriptorGrpcService,
+}
+
+func (m *EventsRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EventsRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *EventEnvelope) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EventEnvelope) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGrpcService(data, i, uint64(len(m.Type)))
+	i += copy(data[i:], m.Type)
+	if m.Payload != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintGrpcService(data, i, uint64(len(m.Payload)))
+		i += copy(data[i:], m.Payload)
+	}
+	return i, nil
+}
+
+func encodeFixed64GrpcService(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32GrpcService(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintGrpcService(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *EventsRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventEnvelope) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGrpcService(uint64(l))
+	if m.Payload != nil {
+		l = len(m.Payload)
+		n += 1 + l + sovGrpcService(uint64(l))
+	}
+	return n
+}
+
+func sovGrpcService(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozGrpcService(x uint64) (n int) {
+	return sovGrpcService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventEnvelope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventEnvelope{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGrpcService(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EventsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpcService(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGrpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEnvelope) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGrpcService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], data[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpcService(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGrpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGrpcService(data []byte) (n int, err error) {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGrpcService
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpcService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if data[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpcService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthGrpcService
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowGrpcService
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipGrpcService(data[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthGrpcService = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGrpcService   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("grpc_service.proto", fileDescriptorGrpcService) }
+
+var fileDescriptorGrpcService = []byte{
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0xd0, 0x82, 0x38, 0x8a, 0xaa, 0x1e, 0x20, 0x12, 0x83, 0xae, 0x51, 0x91, 0x50,
+	0x25, 0x68, 0x1a, 0x75, 0x67, 0xa8, 0xa1, 0x54, 0x91, 0x3a, 0x44, 0x4e, 0x37, 0x84, 0x22, 0xc7,
+	0x7e, 0x75, 0xad, 0xba, 0x3e, 0x73, 0xcf, 0x8e, 0xf0, 0xc6, 0x47, 0xe0, 0x63, 0xf0, 0x51, 0x3a,
+	0x76, 0x64, 0x42, 0xc4, 0x2c, 0x8c, 0x5d, 0xd9, 0x50, 0x6c, 0x5f, 0x9a, 0x73, 0x1d, 0xd4, 0xed,
+	0xdd, 0xef, 0xff, 0x7f, 0xff, 0xe7, 0x7b, 0xd6, 0x51, 0xe6, 0xcb, 0xd8, 0x1d, 0x21, 0xc8, 0x49,
+	0xe0, 0x42, 0x37, 0x96, 0x22, 0x11, 0xec, 0xde, 0xb9, 0xf0, 0x20, 0x44, 0x73, 0xc7, 0x0f, 0x92,
+	0xd3, 0x74, 0xdc, 0x75, 0xc5, 0xf9, 0xae, 0x2f, 0x7c, 0xb1, 0x5b, 0xc8, 0xe3, 0xf4, 0xa4, 0x38,
+	0x15, 0x87, 0xa2, 0x2a, 0xdb, 0xcc, 0xb6, 0xe3, 0x26, 0xa9, 0x13, 0x8e, 0x42, 0x19, 0x8f, 0x24,
+	0x7c, 0x4e, 0x01, 0x13, 0xac, 0xa4, 0x0d, 0x0f, 0x30, 0x90, 0xe0, 0xcd, 0xb4, 0x0a, 0x99, 0x0b,
+	0xa8, 0x6e, 0xa7, 0x89, 0x83, 0x67, 0x55, 0xfd, 0x78, 0x56, 0xd7, 0x0c, 0x5b, 0xeb, 0xf4, 0xd1,
+	0xc1, 0x04, 0xa2, 0x04, 0xed, 0x92, 0x6f, 0xf5, 0x2b, 0x70, 0x10, 0x4d, 0x20, 0x14, 0x31, 0xb0,
+	0x16, 0x5d, 0x49, 0xb2, 0x18, 0x5a, 0xa4, 0x43, 0xb6, 0x1f, 0x58, 0x2b, 0x17, 0x3f, 0x37, 0x0d,
+	0xbb, 0x20, 0x8c, 0xd3, 0xfb, 0xb1, 0x93, 0x85, 0xc2, 0xf1, 0x5a, 0x77, 0x3a, 0x64, 0x7b, 0xad,
+	0x12, 0x15, 0xdc, 0xfb, 0xbb, 0x4a, 0xef, 0x5a, 0xd6, 0x90, 0xd9, 0x74, 0x7d, 0xbf, 0xb8, 0xd0,
+	0x91, 0x3d, 0x38, 0x94, 0x22, 0x8d, 0x91, 0xf1, 0x6e, 0xb9, 0x99, 0x6e, 0x4d, 0xa8, 0xbe, 0xc2,
+	0xdc, 0x5c, 0xaa, 0x63, 0x2c, 0x22, 0x04, 0x16, 0xd2, 0x17, 0x35, 0xc9, 0xca, 0x06, 0x52, 0xb8,
+	0x80, 0x78, 0x98, 0x06, 0x1e, 0x7b, 0xbd, 0x24, 0x40, 0x73, 0xdd, 0x7a, 0x5a, 0x46, 0x5f, 0xea,
+	0x92, 0x16, 0xb3, 0x1f, 0x79, 0xfd, 0xc8, 0x83, 0x2f, 0x6c, 0xaf, 0x39, 0xa7, 0xd1, 0xac, 0x66,
+	0x2f, 0xd9, 0xc4, 0x7c, 0xf4, 0x07, 0xfa, 0xf0, 0x7d, 0xf9, 0x7f, 0x8f, 0xec, 0x01, 0x32, 0x53,
+	0xd9, 0x17, 0xa0, 0x8a, 0x7a, 0xde, 0xa8, 0x55, 0x39, 0x9f, 0xe8, 0xb3, 0x6b, 0xac, 0xef, 0xea,
+	0xd5, 0xcd, 0xbe, 0xc6, 0x35, 0x35, 0xcc, 0x9e, 0xc7, 0x7f, 0xa4, 0xed, 0x6b, 0x3a, 0x74, 0x4f,
+	0xc1, 0x4b, 0xc3, 0x20, 0xf2, 0xfb, 0xd1, 0x89, 0xf8, 0xff, 0x47, 0x77, 0x6e, 0x6a, 0x7a, 0x7b,
+	0x8f, 0xb0, 0x1d, 0xba, 0x7a, 0xec, 0xe0, 0x19, 0xb2, 0x27, 0xca, 0x5c, 0x1c, 0x55, 0xc4, 0xda,
+	0x22, 0xed, 0x11, 0xf6, 0x96, 0xd2, 0x59, 0x65, 0x65, 0xc5, 0xed, 0xda, 0x8b, 0x6a, 0xc9, 0x54,
+	0xa3, 0x16, 0x37, 0xbf, 0xca, 0x3b, 0xba, 0x31, 0x4c, 0xc7, 0xe8, 0xca, 0x60, 0x0c, 0xc7, 0xa2,
+	0x7c, 0x1d, 0xec, 0xa9, 0xb2, 0x6a, 0xaf, 0xc5, 0xd4, 0xb1, 0x7a, 0x33, 0x3d, 0x62, 0xbd, 0xb9,
+	0x9c, 0x72, 0xe3, 0xc7, 0x94, 0x1b, 0x57, 0x53, 0x4e, 0xbe, 0xe6, 0x9c, 0x7c, 0xcf, 0x39, 0xb9,
+	0xc8, 0x39, 0xb9, 0xcc, 0x39, 0xf9, 0x95, 0x73, 0xf2, 0x27, 0xe7, 0xc6, 0x55, 0xce, 0xc9, 0xb7,
+	0xdf, 0xdc, 0xf8, 0x37, 0x00, 0xb0, 0x55, 0x0f, 0x7a, 0x3c, 0x04, 0x00, 0x00,
+}
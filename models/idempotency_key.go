@@ -0,0 +1,47 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"time"
+)
+
+// IdempotencyKeyTTL is how long a creation request is remembered under its
+// idempotency key. Retries within this window return the original result.
+const IdempotencyKeyTTL = 24 * time.Hour
+
+const maximumIdempotencyKeyLength = 255
+
+var idempotencyKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// IdempotencyKey pairs a client supplied key with a fingerprint of the request
+// it was sent with, so that a reused key with a different payload can be
+// rejected with ErrIdempotencyKeyConflict.
+type IdempotencyKey struct {
+	Key         string
+	Fingerprint string
+}
+
+// NewIdempotencyKey fingerprints the payload using its JSON encoding, which,
+// unlike the protobuf encoding, is stable across map iteration order.
+func NewIdempotencyKey(key string, payload interface{}) (IdempotencyKey, error) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return IdempotencyKey{}, err
+	}
+
+	sum := sha256.Sum256(payloadJSON)
+	return IdempotencyKey{
+		Key:         key,
+		Fingerprint: hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+func validIdempotencyKey(key string) bool {
+	if key == "" {
+		return true
+	}
+	return len(key) <= maximumIdempotencyKeyLength && idempotencyKeyPattern.MatchString(key)
+}
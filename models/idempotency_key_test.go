@@ -0,0 +1,60 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IdempotencyKey", func() {
+	Describe("NewIdempotencyKey", func() {
+		var desiredLRP *models.DesiredLRP
+
+		BeforeEach(func() {
+			desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+		})
+
+		It("keeps the key", func() {
+			key, err := models.NewIdempotencyKey("some-key", desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key.Key).To(Equal("some-key"))
+		})
+
+		It("fingerprints equal payloads identically", func() {
+			key, err := models.NewIdempotencyKey("some-key", desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherKey, err := models.NewIdempotencyKey("some-key", model_helpers.NewValidDesiredLRP("some-guid"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(otherKey.Fingerprint).To(Equal(key.Fingerprint))
+		})
+
+		It("fingerprints payloads independently of map ordering", func() {
+			desiredLRP.Network = &models.Network{Properties: map[string]string{}}
+			for _, k := range []string{"a", "b", "c", "d", "e", "f"} {
+				desiredLRP.Network.Properties[k] = k
+			}
+			key, err := models.NewIdempotencyKey("some-key", desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			for i := 0; i < 10; i++ {
+				otherKey, err := models.NewIdempotencyKey("some-key", desiredLRP)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(otherKey.Fingerprint).To(Equal(key.Fingerprint))
+			}
+		})
+
+		It("fingerprints different payloads differently", func() {
+			key, err := models.NewIdempotencyKey("some-key", desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP.Instances++
+			otherKey, err := models.NewIdempotencyKey("some-key", desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(otherKey.Fingerprint).NotTo(Equal(key.Fingerprint))
+		})
+	})
+})
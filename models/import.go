@@ -0,0 +1,78 @@
+package models
+
+// The kinds of record an ImportRecord may hold, as reported in its
+// ImportResult.
+const (
+	ImportKindDomain     = "domain"
+	ImportKindDesiredLRP = "desired_lrp"
+	ImportKindTask       = "task"
+	ImportKindActualLRP  = "actual_lrp"
+)
+
+// Kind returns which of the records the ImportRecord holds, or "" if it holds
+// none.
+func (record *ImportRecord) Kind() string {
+	switch {
+	case record.Domain != nil:
+		return ImportKindDomain
+	case record.DesiredLrp != nil:
+		return ImportKindDesiredLRP
+	case record.Task != nil:
+		return ImportKindTask
+	case record.ActualLrp != nil:
+		return ImportKindActualLRP
+	default:
+		return ""
+	}
+}
+
+// Guid identifies the record it holds: the domain name, process guid or task
+// guid. ActualLRPs are identified by their process guid, as there is no other
+// guid common to every state.
+func (record *ImportRecord) Guid() string {
+	switch {
+	case record.Domain != nil:
+		return record.Domain.Domain
+	case record.DesiredLrp != nil:
+		return record.DesiredLrp.ProcessGuid
+	case record.Task != nil:
+		return record.Task.TaskGuid
+	case record.ActualLrp != nil:
+		return record.ActualLrp.ProcessGuid
+	default:
+		return ""
+	}
+}
+
+func (record *ImportRecord) Validate() error {
+	var validationError ValidationError
+
+	set := 0
+	for _, isSet := range []bool{record.Domain != nil, record.DesiredLrp != nil, record.Task != nil, record.ActualLrp != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return validationError.Append(ErrInvalidField{"record"})
+	}
+
+	switch {
+	case record.Domain != nil:
+		if record.Domain.Domain == "" {
+			validationError = validationError.Append(ErrInvalidField{"domain"})
+		}
+	case record.DesiredLrp != nil:
+		validationError = validationError.Check(record.DesiredLrp)
+	case record.Task != nil:
+		validationError = validationError.Check(record.Task)
+	case record.ActualLrp != nil:
+		validationError = validationError.Check(record.ActualLrp)
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
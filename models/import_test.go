@@ -0,0 +1,68 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ImportRecord", func() {
+	Describe("Kind and Guid", func() {
+		It("describe the record held", func() {
+			record := &models.ImportRecord{Domain: &models.ImportDomain{Domain: "some-domain"}}
+			Expect(record.Kind()).To(Equal(models.ImportKindDomain))
+			Expect(record.Guid()).To(Equal("some-domain"))
+
+			record = &models.ImportRecord{DesiredLrp: model_helpers.NewValidDesiredLRP("some-process-guid")}
+			Expect(record.Kind()).To(Equal(models.ImportKindDesiredLRP))
+			Expect(record.Guid()).To(Equal("some-process-guid"))
+
+			record = &models.ImportRecord{Task: model_helpers.NewValidTask("some-task-guid")}
+			Expect(record.Kind()).To(Equal(models.ImportKindTask))
+			Expect(record.Guid()).To(Equal("some-task-guid"))
+
+			record = &models.ImportRecord{ActualLrp: model_helpers.NewValidActualLRP("some-process-guid", 1)}
+			Expect(record.Kind()).To(Equal(models.ImportKindActualLRP))
+			Expect(record.Guid()).To(Equal("some-process-guid"))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("is valid with a single valid record", func() {
+			record := &models.ImportRecord{Task: model_helpers.NewValidTask("some-task-guid")}
+			Expect(record.Validate()).To(Succeed())
+		})
+
+		It("requires exactly one record", func() {
+			record := &models.ImportRecord{}
+			Expect(record.Validate()).To(MatchError(ContainSubstring("record")))
+
+			record = &models.ImportRecord{
+				Domain: &models.ImportDomain{Domain: "some-domain"},
+				Task:   model_helpers.NewValidTask("some-task-guid"),
+			}
+			Expect(record.Validate()).To(MatchError(ContainSubstring("record")))
+		})
+
+		It("requires a domain name", func() {
+			record := &models.ImportRecord{Domain: &models.ImportDomain{}}
+			Expect(record.Validate()).To(MatchError(ContainSubstring("domain")))
+		})
+
+		It("validates the desired LRP", func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("some-process-guid")
+			desiredLRP.Instances = -1
+			record := &models.ImportRecord{DesiredLrp: desiredLRP}
+			Expect(record.Validate()).To(MatchError(ContainSubstring("instances")))
+		})
+
+		It("validates the actual LRP", func() {
+			actualLRP := model_helpers.NewValidActualLRP("some-process-guid", 1)
+			actualLRP.Index = -1
+			record := &models.ImportRecord{ActualLrp: actualLRP}
+			Expect(record.Validate()).To(MatchError(ContainSubstring("index")))
+		})
+	})
+})
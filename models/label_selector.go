@@ -0,0 +1,345 @@
+package models
+
+import (
+	"sort"
+	"strings"
+)
+
+// LabelSelector selects DesiredLRPs by their metadata labels. It is the
+// conjunction of its requirements; an empty selector selects every
+// DesiredLRP.
+//
+// Selectors are written as a comma separated list of requirements:
+//
+//	selector    = requirement *( "," requirement )
+//	requirement = key ( "=" / "==" ) value      ; has the label with the value
+//	            / key "!=" value                ; does not have the label with the value
+//	            / key "in" "(" values ")"       ; has the label with one of the values
+//	            / key "notin" "(" values ")"    ; does not have the label with any of the values
+//	            / key                           ; has the label
+//	            / "!" key                       ; does not have the label
+//	values      = value *( "," value )
+//
+// Keys follow the rules of metadata label keys. A value is any run of
+// characters other than whitespace, ",", "(", ")", "=" and "!", and may be
+// empty after "=", "==" and "!=". Whitespace between tokens is ignored, so
+// "team = payments, env in (prod, staging)" and
+// "team=payments,env in (prod,staging)" are the same selector.
+type LabelSelector []LabelRequirement
+
+type LabelOperator string
+
+const (
+	LabelOperatorIn           LabelOperator = "in"
+	LabelOperatorNotIn        LabelOperator = "notin"
+	LabelOperatorExists       LabelOperator = "exists"
+	LabelOperatorDoesNotExist LabelOperator = "!exists"
+)
+
+// LabelRequirement is a single requirement of a LabelSelector. Equality is
+// represented as LabelOperatorIn and inequality as LabelOperatorNotIn with a
+// single value.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+// NewLabelSelectorFromMap returns the selector requiring every label of
+// labels with the same value.
+func NewLabelSelectorFromMap(labels map[string]string) LabelSelector {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	selector := make(LabelSelector, 0, len(keys))
+	for _, key := range keys {
+		selector = append(selector, LabelRequirement{Key: key, Operator: LabelOperatorIn, Values: []string{labels[key]}})
+	}
+	return selector
+}
+
+// ParseLabelSelector parses a selector written in the grammar described on
+// LabelSelector, returning an ErrInvalidLabelSelector if it is malformed.
+func ParseLabelSelector(expression string) (LabelSelector, error) {
+	p := &labelSelectorParser{expression: expression}
+	if p.peek().kind == labelTokenEnd {
+		return nil, nil
+	}
+
+	var selector LabelSelector
+	for {
+		requirement, err := p.parseRequirement()
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, requirement)
+
+		token := p.next()
+		switch token.kind {
+		case labelTokenEnd:
+			return selector, nil
+		case labelTokenComma:
+		default:
+			return nil, p.errorf(token, "expected ',' or end of selector")
+		}
+	}
+}
+
+// Matches reports whether labels satisfy every requirement of the selector.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the selector in the grammar described on LabelSelector.
+func (s LabelSelector) String() string {
+	requirements := make([]string, len(s))
+	for i, requirement := range s {
+		requirements[i] = requirement.String()
+	}
+	return strings.Join(requirements, ",")
+}
+
+func (r LabelRequirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+
+	switch r.Operator {
+	case LabelOperatorIn:
+		return ok && r.hasValue(value)
+	case LabelOperatorNotIn:
+		return !ok || !r.hasValue(value)
+	case LabelOperatorExists:
+		return ok
+	case LabelOperatorDoesNotExist:
+		return !ok
+	default:
+		return false
+	}
+}
+
+func (r LabelRequirement) String() string {
+	switch r.Operator {
+	case LabelOperatorIn:
+		if len(r.Values) == 1 {
+			return r.Key + "=" + r.Values[0]
+		}
+		return r.Key + " in (" + strings.Join(r.Values, ",") + ")"
+	case LabelOperatorNotIn:
+		if len(r.Values) == 1 {
+			return r.Key + "!=" + r.Values[0]
+		}
+		return r.Key + " notin (" + strings.Join(r.Values, ",") + ")"
+	case LabelOperatorExists:
+		return r.Key
+	case LabelOperatorDoesNotExist:
+		return "!" + r.Key
+	default:
+		return ""
+	}
+}
+
+func (r LabelRequirement) hasValue(value string) bool {
+	for _, v := range r.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type labelTokenKind int
+
+const (
+	labelTokenEnd labelTokenKind = iota
+	labelTokenWord
+	labelTokenComma
+	labelTokenOpenParen
+	labelTokenCloseParen
+	labelTokenEquals
+	labelTokenNotEquals
+	labelTokenNot
+)
+
+type labelToken struct {
+	kind     labelTokenKind
+	text     string
+	position int
+}
+
+type labelSelectorParser struct {
+	expression string
+	position   int
+}
+
+func (p *labelSelectorParser) parseRequirement() (LabelRequirement, error) {
+	if p.peek().kind == labelTokenNot {
+		p.next()
+		key, err := p.parseKey()
+		if err != nil {
+			return LabelRequirement{}, err
+		}
+		return LabelRequirement{Key: key, Operator: LabelOperatorDoesNotExist}, nil
+	}
+
+	key, err := p.parseKey()
+	if err != nil {
+		return LabelRequirement{}, err
+	}
+
+	token := p.peek()
+	switch {
+	case token.kind == labelTokenEnd || token.kind == labelTokenComma:
+		return LabelRequirement{Key: key, Operator: LabelOperatorExists}, nil
+
+	case token.kind == labelTokenEquals || token.kind == labelTokenNotEquals:
+		p.next()
+		operator := LabelOperatorIn
+		if token.kind == labelTokenNotEquals {
+			operator = LabelOperatorNotIn
+		}
+
+		value := ""
+		if valueToken := p.peek(); valueToken.kind == labelTokenWord {
+			p.next()
+			if len(valueToken.text) > maximumMetadataLabelValueLength {
+				return LabelRequirement{}, p.errorf(valueToken, "value is too long")
+			}
+			value = valueToken.text
+		}
+		return LabelRequirement{Key: key, Operator: operator, Values: []string{value}}, nil
+
+	case token.kind == labelTokenWord && (token.text == "in" || token.text == "notin"):
+		p.next()
+		operator := LabelOperatorIn
+		if token.text == "notin" {
+			operator = LabelOperatorNotIn
+		}
+
+		values, err := p.parseValues()
+		if err != nil {
+			return LabelRequirement{}, err
+		}
+		return LabelRequirement{Key: key, Operator: operator, Values: values}, nil
+
+	default:
+		return LabelRequirement{}, p.errorf(token, "expected '=', '==', '!=', 'in' or 'notin' after '"+key+"'")
+	}
+}
+
+func (p *labelSelectorParser) parseKey() (string, error) {
+	token := p.next()
+	if token.kind != labelTokenWord {
+		return "", p.errorf(token, "expected a label key")
+	}
+	if len(token.text) > maximumMetadataLabelKeyLength || !metadataLabelKeyPattern.MatchString(token.text) {
+		return "", p.errorf(token, "'"+token.text+"' is not a valid label key")
+	}
+	return token.text, nil
+}
+
+func (p *labelSelectorParser) parseValues() ([]string, error) {
+	token := p.next()
+	if token.kind != labelTokenOpenParen {
+		return nil, p.errorf(token, "expected '('")
+	}
+
+	values := []string{}
+	for {
+		token = p.next()
+		if token.kind != labelTokenWord {
+			return nil, p.errorf(token, "expected a value")
+		}
+		if len(token.text) > maximumMetadataLabelValueLength {
+			return nil, p.errorf(token, "value is too long")
+		}
+		values = append(values, token.text)
+
+		token = p.next()
+		switch token.kind {
+		case labelTokenCloseParen:
+			return values, nil
+		case labelTokenComma:
+		default:
+			return nil, p.errorf(token, "expected ',' or ')'")
+		}
+	}
+}
+
+func (p *labelSelectorParser) peek() labelToken {
+	position := p.position
+	token := p.next()
+	p.position = position
+	return token
+}
+
+func (p *labelSelectorParser) next() labelToken {
+	for p.position < len(p.expression) && isLabelSelectorSpace(p.expression[p.position]) {
+		p.position++
+	}
+
+	start := p.position
+	if start == len(p.expression) {
+		return labelToken{kind: labelTokenEnd, position: start}
+	}
+
+	c := p.expression[start]
+	switch {
+	case c == ',':
+		p.position++
+		return labelToken{kind: labelTokenComma, text: ",", position: start}
+	case c == '(':
+		p.position++
+		return labelToken{kind: labelTokenOpenParen, text: "(", position: start}
+	case c == ')':
+		p.position++
+		return labelToken{kind: labelTokenCloseParen, text: ")", position: start}
+	case strings.HasPrefix(p.expression[start:], "=="):
+		p.position += 2
+		return labelToken{kind: labelTokenEquals, text: "==", position: start}
+	case strings.HasPrefix(p.expression[start:], "!="):
+		p.position += 2
+		return labelToken{kind: labelTokenNotEquals, text: "!=", position: start}
+	case c == '=':
+		p.position++
+		return labelToken{kind: labelTokenEquals, text: "=", position: start}
+	case c == '!':
+		p.position++
+		return labelToken{kind: labelTokenNot, text: "!", position: start}
+	}
+
+	for p.position < len(p.expression) && !isLabelSelectorDelimiter(p.expression[p.position]) {
+		p.position++
+	}
+	return labelToken{kind: labelTokenWord, text: p.expression[start:p.position], position: start}
+}
+
+func (p *labelSelectorParser) errorf(token labelToken, reason string) error {
+	found := "'" + token.text + "'"
+	if token.kind == labelTokenEnd {
+		found = "end of selector"
+	}
+	return ErrInvalidLabelSelector{
+		Selector: p.expression,
+		Position: token.position,
+		Reason:   reason + ", found " + found,
+	}
+}
+
+func isLabelSelectorSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isLabelSelectorDelimiter(c byte) bool {
+	return isLabelSelectorSpace(c) || strings.IndexByte(",()=!", c) >= 0
+}
@@ -0,0 +1,129 @@
+package models_test
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelSelector", func() {
+	DescribeTable("parsing well formed selectors",
+		func(expression string, expected models.LabelSelector) {
+			selector, err := models.ParseLabelSelector(expression)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(Equal(expected))
+		},
+		Entry("empty", "", nil),
+		Entry("only whitespace", "  ", nil),
+		Entry("equality", "team=payments", models.LabelSelector{
+			{Key: "team", Operator: models.LabelOperatorIn, Values: []string{"payments"}},
+		}),
+		Entry("double equality", "team==payments", models.LabelSelector{
+			{Key: "team", Operator: models.LabelOperatorIn, Values: []string{"payments"}},
+		}),
+		Entry("equality to the empty value", "team=", models.LabelSelector{
+			{Key: "team", Operator: models.LabelOperatorIn, Values: []string{""}},
+		}),
+		Entry("inequality", "team!=payments", models.LabelSelector{
+			{Key: "team", Operator: models.LabelOperatorNotIn, Values: []string{"payments"}},
+		}),
+		Entry("set membership", "env in (prod,staging)", models.LabelSelector{
+			{Key: "env", Operator: models.LabelOperatorIn, Values: []string{"prod", "staging"}},
+		}),
+		Entry("set exclusion", "env notin (dev)", models.LabelSelector{
+			{Key: "env", Operator: models.LabelOperatorNotIn, Values: []string{"dev"}},
+		}),
+		Entry("existence", "example.com/team", models.LabelSelector{
+			{Key: "example.com/team", Operator: models.LabelOperatorExists},
+		}),
+		Entry("absence", "!team", models.LabelSelector{
+			{Key: "team", Operator: models.LabelOperatorDoesNotExist},
+		}),
+		Entry("several requirements with whitespace", " team = payments , env in ( prod , staging ), !legacy ", models.LabelSelector{
+			{Key: "team", Operator: models.LabelOperatorIn, Values: []string{"payments"}},
+			{Key: "env", Operator: models.LabelOperatorIn, Values: []string{"prod", "staging"}},
+			{Key: "legacy", Operator: models.LabelOperatorDoesNotExist},
+		}),
+	)
+
+	DescribeTable("rejecting malformed selectors",
+		func(expression string, position int, reason string) {
+			_, err := models.ParseLabelSelector(expression)
+			Expect(err).To(HaveOccurred())
+
+			selectorErr, ok := err.(models.ErrInvalidLabelSelector)
+			Expect(ok).To(BeTrue())
+			Expect(selectorErr.Selector).To(Equal(expression))
+			Expect(selectorErr.Position).To(Equal(position))
+			Expect(selectorErr.Reason).To(ContainSubstring(reason))
+		},
+		Entry("a trailing comma", "team=payments,", 14, "expected a label key, found end of selector"),
+		Entry("a missing key", "=payments", 0, "expected a label key, found '='"),
+		Entry("an invalid key", "-team=payments", 0, "'-team' is not a valid label key"),
+		Entry("a too long key", strings.Repeat("a", 64), 0, "is not a valid label key"),
+		Entry("a missing operator", "team payments", 5, "expected '=', '==', '!=', 'in' or 'notin' after 'team'"),
+		Entry("a missing set", "env in", 6, "expected '('"),
+		Entry("an empty set", "env in ()", 8, "expected a value"),
+		Entry("an unterminated set", "env in (prod", 12, "expected ',' or ')'"),
+		Entry("a value after a set", "env in (prod) staging", 14, "expected ',' or end of selector"),
+		Entry("a second value", "team=payments billing", 14, "expected ',' or end of selector"),
+		Entry("a too long value", "team="+strings.Repeat("a", 256), 5, "value is too long"),
+		Entry("an absence with a value", "!team=payments", 5, "expected ',' or end of selector"),
+	)
+
+	Describe("Matches", func() {
+		labels := map[string]string{"team": "payments", "env": "prod"}
+
+		DescribeTable("matching labels",
+			func(expression string, matches bool) {
+				selector, err := models.ParseLabelSelector(expression)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(selector.Matches(labels)).To(Equal(matches))
+			},
+			Entry("the empty selector", "", true),
+			Entry("an equal value", "team=payments", true),
+			Entry("a different value", "team=billing", false),
+			Entry("a missing label compared for equality", "tier=web", false),
+			Entry("an inequality with a different value", "team!=billing", true),
+			Entry("an inequality with the same value", "team!=payments", false),
+			Entry("a missing label compared for inequality", "tier!=web", true),
+			Entry("a value in the set", "env in (staging,prod)", true),
+			Entry("a value not in the set", "env in (staging,dev)", false),
+			Entry("a value excluded by the set", "env notin (prod)", false),
+			Entry("a missing label excluded by the set", "tier notin (web)", true),
+			Entry("an existing label", "team", true),
+			Entry("a missing label", "tier", false),
+			Entry("the absence of an existing label", "!team", false),
+			Entry("the absence of a missing label", "!tier", true),
+			Entry("all requirements matching", "team=payments,env in (prod),!tier", true),
+			Entry("one requirement not matching", "team=payments,env in (dev)", false),
+		)
+	})
+
+	Describe("String", func() {
+		It("writes a selector that parses back to the same selector", func() {
+			selector, err := models.ParseLabelSelector("team == payments, env in (prod, staging), tier != web, owner notin (a,b), legacy, !beta, note=")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector.String()).To(Equal("team=payments,env in (prod,staging),tier!=web,owner notin (a,b),legacy,!beta,note="))
+
+			reparsed, err := models.ParseLabelSelector(selector.String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reparsed).To(Equal(selector))
+		})
+	})
+
+	Describe("NewLabelSelectorFromMap", func() {
+		It("requires every label with its value, in key order", func() {
+			selector := models.NewLabelSelectorFromMap(map[string]string{"team": "payments", "env": "prod"})
+			Expect(selector.String()).To(Equal("env=prod,team=payments"))
+		})
+
+		It("returns the empty selector for no labels", func() {
+			Expect(models.NewLabelSelectorFromMap(nil)).To(BeEmpty())
+		})
+	})
+})
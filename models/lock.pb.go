@@ -0,0 +1,1236 @@
+// Code generated by protoc-gen-gogo.
+// source: lock.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import strings "strings"
+import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
+import sort "sort"
+import strconv "strconv"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ReleaseLockResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ReleaseLockResponse) Reset()                    { *m = ReleaseLockResponse{} }
+func (*ReleaseLockResponse) ProtoMessage()               {}
+func (*ReleaseLockResponse) Descriptor() ([]byte, []int) { return fileDescriptorLock, []int{0} }
+
+func (m *ReleaseLockResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// BBSPresenceStatus is a BBS presence, either the BBS lock or a read
+// presence, along with what holds it in the store.
+type BBSPresenceStatus struct {
+	Id  string `protobuf:"bytes,1,opt,name=id" json:"id"`
+	Url string `protobuf:"bytes,2,opt,name=url" json:"url"`
+	// the Consul session holding the presence, or the owner of its row in the
+	// locks table
+	SessionId string `protobuf:"bytes,3,opt,name=session_id,json=sessionId" json:"session_id"`
+	// the name of the Consul session, empty for the locks table
+	SessionName string `protobuf:"bytes,4,opt,name=session_name,json=sessionName" json:"session_name"`
+	// nanoseconds
+	Ttl int64 `protobuf:"varint,5,opt,name=ttl" json:"ttl"`
+	// unix nanoseconds at which the presence expires unless it is renewed, 0 if
+	// the store does not expose it
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt" json:"expires_at"`
+}
+
+func (m *BBSPresenceStatus) Reset()                    { *m = BBSPresenceStatus{} }
+func (*BBSPresenceStatus) ProtoMessage()               {}
+func (*BBSPresenceStatus) Descriptor() ([]byte, []int) { return fileDescriptorLock, []int{1} }
+
+func (m *BBSPresenceStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BBSPresenceStatus) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *BBSPresenceStatus) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *BBSPresenceStatus) GetSessionName() string {
+	if m != nil {
+		return m.SessionName
+	}
+	return ""
+}
+
+func (m *BBSPresenceStatus) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *BBSPresenceStatus) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type CoordinationStateResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	// the ID of the BBS that served the request
+	BbsId     string `protobuf:"bytes,2,opt,name=bbs_id,json=bbsId" json:"bbs_id"`
+	HoldsLock bool   `protobuf:"varint,3,opt,name=holds_lock,json=holdsLock" json:"holds_lock"`
+	// unset when no BBS holds the lock
+	LockHolder    *BBSPresenceStatus   `protobuf:"bytes,4,opt,name=lock_holder,json=lockHolder" json:"lock_holder,omitempty"`
+	ReadPresences []*BBSPresenceStatus `protobuf:"bytes,5,rep,name=read_presences,json=readPresences" json:"read_presences,omitempty"`
+}
+
+func (m *CoordinationStateResponse) Reset()                    { *m = CoordinationStateResponse{} }
+func (*CoordinationStateResponse) ProtoMessage()               {}
+func (*CoordinationStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorLock, []int{2} }
+
+func (m *CoordinationStateResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *CoordinationStateResponse) GetBbsId() string {
+	if m != nil {
+		return m.BbsId
+	}
+	return ""
+}
+
+func (m *CoordinationStateResponse) GetHoldsLock() bool {
+	if m != nil {
+		return m.HoldsLock
+	}
+	return false
+}
+
+func (m *CoordinationStateResponse) GetLockHolder() *BBSPresenceStatus {
+	if m != nil {
+		return m.LockHolder
+	}
+	return nil
+}
+
+func (m *CoordinationStateResponse) GetReadPresences() []*BBSPresenceStatus {
+	if m != nil {
+		return m.ReadPresences
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ReleaseLockResponse)(nil), "models.ReleaseLockResponse")
+	proto.RegisterType((*BBSPresenceStatus)(nil), "models.BBSPresenceStatus")
+	proto.RegisterType((*CoordinationStateResponse)(nil), "models.CoordinationStateResponse")
+}
+func (this *ReleaseLockResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ReleaseLockResponse)
+	if !ok {
+		that2, ok := that.(ReleaseLockResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *BBSPresenceStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*BBSPresenceStatus)
+	if !ok {
+		that2, ok := that.(BBSPresenceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if this.SessionId != that1.SessionId {
+		return false
+	}
+	if this.SessionName != that1.SessionName {
+		return false
+	}
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	return true
+}
+func (this *CoordinationStateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*CoordinationStateResponse)
+	if !ok {
+		that2, ok := that.(CoordinationStateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if this.BbsId != that1.BbsId {
+		return false
+	}
+	if this.HoldsLock != that1.HoldsLock {
+		return false
+	}
+	if !this.LockHolder.Equal(that1.LockHolder) {
+		return false
+	}
+	if len(this.ReadPresences) != len(that1.ReadPresences) {
+		return false
+	}
+	for i := range this.ReadPresences {
+		if !this.ReadPresences[i].Equal(that1.ReadPresences[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ReleaseLockResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ReleaseLockResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BBSPresenceStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&models.BBSPresenceStatus{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Url: "+fmt.Sprintf("%#v", this.Url)+",\n")
+	s = append(s, "SessionId: "+fmt.Sprintf("%#v", this.SessionId)+",\n")
+	s = append(s, "SessionName: "+fmt.Sprintf("%#v", this.SessionName)+",\n")
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CoordinationStateResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.CoordinationStateResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "BbsId: "+fmt.Sprintf("%#v", this.BbsId)+",\n")
+	s = append(s, "HoldsLock: "+fmt.Sprintf("%#v", this.HoldsLock)+",\n")
+	if this.LockHolder != nil {
+		s = append(s, "LockHolder: "+fmt.Sprintf("%#v", this.LockHolder)+",\n")
+	}
+	if this.ReadPresences != nil {
+		s = append(s, "ReadPresences: "+fmt.Sprintf("%#v", this.ReadPresences)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringLock(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringLock(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+func (m *ReleaseLockResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ReleaseLockResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLock(data, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *BBSPresenceStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BBSPresenceStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintLock(data, i, uint64(len(m.Id)))
+	i += copy(data[i:], m.Id)
+	data[i] = 0x12
+	i++
+	i = encodeVarintLock(data, i, uint64(len(m.Url)))
+	i += copy(data[i:], m.Url)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintLock(data, i, uint64(len(m.SessionId)))
+	i += copy(data[i:], m.SessionId)
+	data[i] = 0x22
+	i++
+	i = encodeVarintLock(data, i, uint64(len(m.SessionName)))
+	i += copy(data[i:], m.SessionName)
+	data[i] = 0x28
+	i++
+	i = encodeVarintLock(data, i, uint64(m.Ttl))
+	data[i] = 0x30
+	i++
+	i = encodeVarintLock(data, i, uint64(m.ExpiresAt))
+	return i, nil
+}
+
+func (m *CoordinationStateResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CoordinationStateResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLock(data, i, uint64(m.Error.Size()))
+		n2, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintLock(data, i, uint64(len(m.BbsId)))
+	i += copy(data[i:], m.BbsId)
+	data[i] = 0x18
+	i++
+	if m.HoldsLock {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if m.LockHolder != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintLock(data, i, uint64(m.LockHolder.Size()))
+		n3, err := m.LockHolder.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.ReadPresences) > 0 {
+		for _, msg := range m.ReadPresences {
+			data[i] = 0x2a
+			i++
+			i = encodeVarintLock(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Lock(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Lock(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintLock(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ReleaseLockResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovLock(uint64(l))
+	}
+	return n
+}
+
+func (m *BBSPresenceStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	n += 1 + l + sovLock(uint64(l))
+	l = len(m.Url)
+	n += 1 + l + sovLock(uint64(l))
+	l = len(m.SessionId)
+	n += 1 + l + sovLock(uint64(l))
+	l = len(m.SessionName)
+	n += 1 + l + sovLock(uint64(l))
+	n += 1 + sovLock(uint64(m.Ttl))
+	n += 1 + sovLock(uint64(m.ExpiresAt))
+	return n
+}
+
+func (m *CoordinationStateResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = len(m.BbsId)
+	n += 1 + l + sovLock(uint64(l))
+	n += 2
+	if m.LockHolder != nil {
+		l = m.LockHolder.Size()
+		n += 1 + l + sovLock(uint64(l))
+	}
+	if len(m.ReadPresences) > 0 {
+		for _, e := range m.ReadPresences {
+			l = e.Size()
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLock(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozLock(x uint64) (n int) {
+	return sovLock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ReleaseLockResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleaseLockResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BBSPresenceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BBSPresenceStatus{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+		`SessionId:` + fmt.Sprintf("%v", this.SessionId) + `,`,
+		`SessionName:` + fmt.Sprintf("%v", this.SessionName) + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CoordinationStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CoordinationStateResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`BbsId:` + fmt.Sprintf("%v", this.BbsId) + `,`,
+		`HoldsLock:` + fmt.Sprintf("%v", this.HoldsLock) + `,`,
+		`LockHolder:` + strings.Replace(fmt.Sprintf("%v", this.LockHolder), "BBSPresenceStatus", "BBSPresenceStatus", 1) + `,`,
+		`ReadPresences:` + strings.Replace(fmt.Sprintf("%v", this.ReadPresences), "BBSPresenceStatus", "BBSPresenceStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLock(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ReleaseLockResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BBSPresenceStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BBSPresenceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BBSPresenceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ttl |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoordinationStateResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoordinationStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoordinationStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BbsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BbsId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldsLock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HoldsLock = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockHolder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockHolder == nil {
+				m.LockHolder = &BBSPresenceStatus{}
+			}
+			if err := m.LockHolder.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPresences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadPresences = append(m.ReadPresences, &BBSPresenceStatus{})
+			if err := m.ReadPresences[len(m.ReadPresences)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLock(data []byte) (n int, err error) {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if data[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthLock
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowLock
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipLock(data[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthLock = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLock   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("lock.proto", fileDescriptorLock) }
+
+var fileDescriptorLock = []byte{
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x8a, 0xd4, 0x30,
+	0x1c, 0xc6, 0x9b, 0x76, 0x3b, 0x38, 0xa9, 0x2b, 0x18, 0x45, 0xba, 0x2b, 0xc4, 0x61, 0xf6, 0xe0,
+	0x1e, 0xb4, 0x0b, 0x7b, 0xdc, 0x93, 0x56, 0x04, 0x17, 0x44, 0xa4, 0xfb, 0x00, 0xa5, 0x6d, 0xfe,
+	0xce, 0x84, 0x69, 0x9b, 0x92, 0xa4, 0xe0, 0x71, 0x1e, 0xc1, 0xc7, 0xf0, 0x51, 0xe6, 0xe0, 0x61,
+	0x8e, 0x9e, 0xc4, 0xa9, 0x17, 0x8f, 0xf3, 0x08, 0x92, 0xb4, 0x85, 0x82, 0x20, 0x78, 0xeb, 0xff,
+	0xf7, 0x7d, 0x7f, 0xbe, 0x7c, 0x49, 0x31, 0x2e, 0x45, 0xb1, 0x89, 0x1a, 0x29, 0xb4, 0x20, 0xb3,
+	0x4a, 0x30, 0x28, 0xd5, 0xf9, 0xcb, 0x15, 0xd7, 0xeb, 0x36, 0x8f, 0x0a, 0x51, 0x5d, 0xad, 0xc4,
+	0x4a, 0x5c, 0x59, 0x39, 0x6f, 0x3f, 0xd9, 0xc9, 0x0e, 0xf6, 0xab, 0x5f, 0x3b, 0x0f, 0x40, 0x4a,
+	0x21, 0xfb, 0x61, 0x79, 0x83, 0x1f, 0x25, 0x50, 0x42, 0xa6, 0xe0, 0xbd, 0x28, 0x36, 0x09, 0xa8,
+	0x46, 0xd4, 0x0a, 0xc8, 0x05, 0xf6, 0xad, 0x2b, 0x44, 0x0b, 0x74, 0x19, 0x5c, 0x9f, 0x46, 0x7d,
+	0x54, 0xf4, 0xd6, 0xc0, 0xa4, 0xd7, 0x96, 0xdf, 0x10, 0x7e, 0x18, 0xc7, 0x77, 0x1f, 0x25, 0x28,
+	0xa8, 0x0b, 0xb8, 0xd3, 0x99, 0x6e, 0x15, 0x79, 0x8c, 0x5d, 0xce, 0xec, 0xde, 0x3c, 0x3e, 0xd9,
+	0xfd, 0x78, 0xe6, 0x24, 0x2e, 0x67, 0xe4, 0x09, 0xf6, 0x5a, 0x59, 0x86, 0xee, 0x04, 0x1b, 0x40,
+	0x2e, 0x30, 0x56, 0xa0, 0x14, 0x17, 0x75, 0xca, 0x59, 0xe8, 0x4d, 0xe4, 0xf9, 0xc0, 0x6f, 0x19,
+	0x79, 0x8e, 0xef, 0x8f, 0xa6, 0x3a, 0xab, 0x20, 0x3c, 0x99, 0xd8, 0x82, 0x41, 0xf9, 0x90, 0x55,
+	0x60, 0x52, 0xb4, 0x2e, 0x43, 0x7f, 0x81, 0x2e, 0xbd, 0x31, 0x45, 0x6b, 0x9b, 0x02, 0x9f, 0x1b,
+	0x2e, 0x41, 0xa5, 0x99, 0x0e, 0x67, 0x13, 0x79, 0x3e, 0xf0, 0xd7, 0x7a, 0xb9, 0x75, 0xf1, 0xd9,
+	0x1b, 0x21, 0x24, 0xe3, 0x75, 0xa6, 0xb9, 0xa8, 0x4d, 0x1f, 0xf8, 0xaf, 0x1b, 0x21, 0x4f, 0xf1,
+	0x2c, 0xcf, 0x95, 0x69, 0x32, 0x2d, 0xea, 0xe7, 0xb9, 0xba, 0x65, 0xe6, 0x10, 0x6b, 0x51, 0x32,
+	0x95, 0x9a, 0x27, 0xb4, 0x55, 0xef, 0x8d, 0x87, 0xb0, 0xdc, 0x3c, 0x00, 0xb9, 0xc1, 0x81, 0x91,
+	0x53, 0x43, 0x40, 0xda, 0xa6, 0xc1, 0xf5, 0xd9, 0x18, 0xf6, 0xd7, 0x6d, 0x27, 0xf6, 0x7f, 0x78,
+	0x67, 0xcd, 0xe4, 0x15, 0x7e, 0x20, 0x21, 0x63, 0x69, 0x33, 0x58, 0x54, 0xe8, 0x2f, 0xbc, 0x7f,
+	0xaf, 0x9f, 0x9a, 0x85, 0x91, 0xa9, 0xf8, 0xc5, 0xfe, 0x40, 0x9d, 0xef, 0x07, 0xea, 0x1c, 0x0f,
+	0x14, 0x6d, 0x3b, 0x8a, 0xbe, 0x76, 0x14, 0xed, 0x3a, 0x8a, 0xf6, 0x1d, 0x45, 0x3f, 0x3b, 0x8a,
+	0x7e, 0x77, 0xd4, 0x39, 0x76, 0x14, 0x7d, 0xf9, 0x45, 0x9d, 0x3f, 0x03, 0x00, 0x52, 0x4f, 0x7c,
+	0xb9, 0x8c, 0x02, 0x00, 0x00,
+}
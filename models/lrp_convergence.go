@@ -1,11 +1,76 @@
 package models
 
+import (
+	"sort"
+	"time"
+)
+
 type ConvergenceInput struct {
 	AllProcessGuids map[string]struct{}
 	DesiredLRPs     map[string]*DesiredLRP
 	ActualLRPs      map[string]map[int32]*ActualLRP
 	Domains         DomainSet
 	Cells           CellSet
+	Filter          ConvergenceFilter
+
+	// OrphanGracePeriod is how long an actual LRP without a DesiredLRP is
+	// kept before it is retired. See ActualLRP.ShouldRetireOrphan.
+	OrphanGracePeriod time.Duration
+}
+
+// ConvergenceFilter limits a convergence pass to the LRPs in the given
+// domains. The zero value converges every domain.
+type ConvergenceFilter struct {
+	Domains []string
+
+	// PriorityDomains are the domains whose missing actual LRPs are created
+	// first, in the order given. They do not limit the pass.
+	PriorityDomains []string
+}
+
+func (filter ConvergenceFilter) IsScoped() bool {
+	return len(filter.Domains) > 0
+}
+
+func (filter ConvergenceFilter) IncludesDomain(domain string) bool {
+	if !filter.IsScoped() {
+		return true
+	}
+
+	for _, d := range filter.Domains {
+		if d == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// PriorityRank is the position of the domain in PriorityDomains. Every
+// domain not listed ranks after all of them.
+func (filter ConvergenceFilter) PriorityRank(domain string) int {
+	for i, d := range filter.PriorityDomains {
+		if d == domain {
+			return i
+		}
+	}
+	return len(filter.PriorityDomains)
+}
+
+// SortKeysByPriority orders the keys by the priority rank of their domain,
+// keeping keys of equal rank in the order given.
+func (filter ConvergenceFilter) SortKeysByPriority(keys []*ActualLRPKey) {
+	sort.Stable(keysByPriority{filter: filter, keys: keys})
+}
+
+type keysByPriority struct {
+	filter ConvergenceFilter
+	keys   []*ActualLRPKey
+}
+
+func (k keysByPriority) Len() int      { return len(k.keys) }
+func (k keysByPriority) Swap(i, j int) { k.keys[i], k.keys[j] = k.keys[j], k.keys[i] }
+func (k keysByPriority) Less(i, j int) bool {
+	return k.filter.PriorityRank(k.keys[i].Domain) < k.filter.PriorityRank(k.keys[j].Domain)
 }
 
 type ConvergenceChanges struct {
@@ -14,6 +79,12 @@ type ConvergenceChanges struct {
 	ActualLRPsWithMissingCells     []*ActualLRP
 	RestartableCrashedActualLRPs   []*ActualLRP
 	StaleUnclaimedActualLRPs       []*ActualLRP
+
+	// NewlyOrphanedActualLRPs have just been found without a DesiredLRP, and
+	// ActualLRPsNoLongerOrphaned were orphaned but have a DesiredLRP again.
+	// Both only need their OrphanedSince updated.
+	NewlyOrphanedActualLRPs    []*ActualLRP
+	ActualLRPsNoLongerOrphaned []*ActualLRP
 }
 
 type ActualLRPKeyWithSchedulingInfo struct {
@@ -0,0 +1,18 @@
+package models
+
+func (request *ConvergeLRPsRequest) Validate() error {
+	var validationError ValidationError
+
+	for _, domain := range request.Domains {
+		if domain == "" {
+			validationError = validationError.Append(ErrInvalidField{"domains"})
+			break
+		}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -22,6 +22,23 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type ConvergeLRPsRequest struct {
+	Domains []string `protobuf:"bytes,1,rep,name=domains" json:"domains,omitempty"`
+}
+
+func (m *ConvergeLRPsRequest) Reset()      { *m = ConvergeLRPsRequest{} }
+func (*ConvergeLRPsRequest) ProtoMessage() {}
+func (*ConvergeLRPsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorLrpConvergenceRequest, []int{0}
+}
+
+func (m *ConvergeLRPsRequest) GetDomains() []string {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
 type ConvergeLRPsResponse struct {
 	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
@@ -29,7 +46,7 @@ type ConvergeLRPsResponse struct {
 func (m *ConvergeLRPsResponse) Reset()      { *m = ConvergeLRPsResponse{} }
 func (*ConvergeLRPsResponse) ProtoMessage() {}
 func (*ConvergeLRPsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorLrpConvergenceRequest, []int{0}
+	return fileDescriptorLrpConvergenceRequest, []int{1}
 }
 
 func (m *ConvergeLRPsResponse) GetError() *Error {
@@ -40,8 +57,44 @@ func (m *ConvergeLRPsResponse) GetError() *Error {
 }
 
 func init() {
+	proto.RegisterType((*ConvergeLRPsRequest)(nil), "models.ConvergeLRPsRequest")
 	proto.RegisterType((*ConvergeLRPsResponse)(nil), "models.ConvergeLRPsResponse")
 }
+func (this *ConvergeLRPsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ConvergeLRPsRequest)
+	if !ok {
+		that2, ok := that.(ConvergeLRPsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Domains) != len(that1.Domains) {
+		return false
+	}
+	for i := range this.Domains {
+		if this.Domains[i] != that1.Domains[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *ConvergeLRPsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
@@ -72,6 +125,18 @@ func (this *ConvergeLRPsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ConvergeLRPsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ConvergeLRPsRequest{")
+	if this.Domains != nil {
+		s = append(s, "Domains: "+fmt.Sprintf("%#v", this.Domains)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *ConvergeLRPsResponse) GoString() string {
 	if this == nil {
 		return "nil"
@@ -110,6 +175,39 @@ func extensionToGoStringLrpConvergenceRequest(m github_com_gogo_protobuf_proto.M
 	s += strings.Join(ss, ",") + "})"
 	return s
 }
+func (m *ConvergeLRPsRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ConvergeLRPsRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, s := range m.Domains {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	return i, nil
+}
+
 func (m *ConvergeLRPsResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -165,6 +263,18 @@ func encodeVarintLrpConvergenceRequest(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
 	return offset + 1
 }
+func (m *ConvergeLRPsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, s := range m.Domains {
+			l = len(s)
+			n += 1 + l + sovLrpConvergenceRequest(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ConvergeLRPsResponse) Size() (n int) {
 	var l int
 	_ = l
@@ -188,6 +298,16 @@ func sovLrpConvergenceRequest(x uint64) (n int) {
 func sozLrpConvergenceRequest(x uint64) (n int) {
 	return sovLrpConvergenceRequest(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *ConvergeLRPsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvergeLRPsRequest{`,
+		`Domains:` + fmt.Sprintf("%v", this.Domains) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ConvergeLRPsResponse) String() string {
 	if this == nil {
 		return "nil"
@@ -206,6 +326,85 @@ func valueToStringLrpConvergenceRequest(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
+func (m *ConvergeLRPsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLrpConvergenceRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvergeLRPsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvergeLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLrpConvergenceRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLrpConvergenceRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domains = append(m.Domains, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLrpConvergenceRequest(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLrpConvergenceRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ConvergeLRPsResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -397,18 +596,19 @@ var (
 func init() { proto.RegisterFile("lrp_convergence_request.proto", fileDescriptorLrpConvergenceRequest) }
 
 var fileDescriptorLrpConvergenceRequest = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x29, 0x2a, 0x88,
+	// 218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x29, 0x2a, 0x88,
 	0x4f, 0xce, 0xcf, 0x2b, 0x4b, 0x2d, 0x4a, 0x4f, 0xcd, 0x4b, 0x4e, 0x8d, 0x2f, 0x4a, 0x2d, 0x2c,
 	0x4d, 0x2d, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xcb, 0xcd, 0x4f, 0x49, 0xcd,
 	0x29, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf,
 	0x4f, 0xcf, 0xd7, 0x07, 0x4b, 0x27, 0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0x26,
-	0xc5, 0x9d, 0x5a, 0x54, 0x94, 0x5f, 0x04, 0xe1, 0x28, 0x59, 0x73, 0x89, 0x38, 0x43, 0x2d, 0xf0,
-	0x09, 0x0a, 0x28, 0x0e, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x52, 0xe6, 0x62, 0x05,
-	0x2b, 0x93, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0xe2, 0xd5, 0x83, 0xd8, 0xa5, 0xe7, 0x0a, 0x12,
-	0x0c, 0x82, 0xc8, 0x39, 0xe9, 0x5c, 0x78, 0x28, 0xc7, 0x70, 0xe3, 0xa1, 0x1c, 0xc3, 0x87, 0x87,
-	0x72, 0x8c, 0x0d, 0x8f, 0xe4, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x5f, 0x3c, 0x92, 0x63, 0xf8, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x06, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x4b, 0xbf, 0x41, 0xce, 0x00,
-	0x00, 0x00,
+	0xc5, 0x9d, 0x5a, 0x54, 0x94, 0x5f, 0x04, 0xe1, 0x28, 0xe9, 0x73, 0x09, 0x3b, 0x43, 0x2d, 0xf0,
+	0x09, 0x0a, 0x28, 0x0e, 0x82, 0x58, 0x20, 0x24, 0xc1, 0xc5, 0x9e, 0x92, 0x9f, 0x9b, 0x98, 0x99,
+	0x57, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x19, 0x04, 0xe3, 0x2a, 0x59, 0x73, 0x89, 0xa0, 0x6a,
+	0x28, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x52, 0xe6, 0x62, 0x05, 0x9b, 0x2b, 0xc1, 0xa8, 0xc0,
+	0xa8, 0xc1, 0x6d, 0xc4, 0xab, 0x07, 0x71, 0x9c, 0x9e, 0x2b, 0x48, 0x30, 0x08, 0x22, 0xe7, 0xa4,
+	0x73, 0xe1, 0xa1, 0x1c, 0xc3, 0x8d, 0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca, 0x31, 0x36, 0x3c, 0x92,
+	0x63, 0x5c, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
+	0x92, 0x63, 0x7c, 0xf1, 0x48, 0x8e, 0xe1, 0xc3, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x00,
+	0x03, 0x00, 0x9f, 0xcf, 0x57, 0x11, 0xff, 0x00, 0x00, 0x00,
 }
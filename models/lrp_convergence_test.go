@@ -0,0 +1,68 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LRP Convergence", func() {
+	Describe("ConvergenceFilter", func() {
+		It("includes every domain when unscoped", func() {
+			filter := models.ConvergenceFilter{}
+			Expect(filter.IsScoped()).To(BeFalse())
+			Expect(filter.IncludesDomain("any-domain")).To(BeTrue())
+		})
+
+		It("includes only the given domains when scoped", func() {
+			filter := models.ConvergenceFilter{Domains: []string{"domain-1", "domain-2"}}
+			Expect(filter.IsScoped()).To(BeTrue())
+			Expect(filter.IncludesDomain("domain-2")).To(BeTrue())
+			Expect(filter.IncludesDomain("domain-3")).To(BeFalse())
+		})
+
+		It("does not scope the pass by its priority domains", func() {
+			filter := models.ConvergenceFilter{PriorityDomains: []string{"domain-1"}}
+			Expect(filter.IsScoped()).To(BeFalse())
+			Expect(filter.IncludesDomain("domain-2")).To(BeTrue())
+		})
+
+		It("sorts keys by the rank of their priority domain", func() {
+			filter := models.ConvergenceFilter{PriorityDomains: []string{"domain-2", "domain-1"}}
+			keys := []*models.ActualLRPKey{
+				{ProcessGuid: "a", Domain: "domain-3"},
+				{ProcessGuid: "b", Domain: "domain-1"},
+				{ProcessGuid: "c", Domain: "domain-3"},
+				{ProcessGuid: "d", Domain: "domain-2"},
+			}
+
+			filter.SortKeysByPriority(keys)
+
+			guids := []string{}
+			for _, key := range keys {
+				guids = append(guids, key.ProcessGuid)
+			}
+			Expect(guids).To(Equal([]string{"d", "b", "a", "c"}))
+		})
+	})
+
+	Describe("ConvergeLRPsRequest", func() {
+		Describe("Validate", func() {
+			It("is valid with no domains", func() {
+				request := models.ConvergeLRPsRequest{}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("is valid with domains", func() {
+				request := models.ConvergeLRPsRequest{Domains: []string{"domain-1"}}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("rejects an empty domain", func() {
+				request := models.ConvergeLRPsRequest{Domains: []string{"domain-1", ""}}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"domains"}))
+			})
+		})
+	})
+})
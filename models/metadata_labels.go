@@ -0,0 +1,32 @@
+package models
+
+import "regexp"
+
+// Limits on the metadata labels of a single DesiredLRP. The BBS does not
+// interpret labels, so they are kept small enough that storing and returning
+// them with every DesiredLRP stays cheap.
+const (
+	maximumMetadataLabels           = 64
+	maximumMetadataLabelKeyLength   = 63
+	maximumMetadataLabelValueLength = 255
+)
+
+var metadataLabelKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$`)
+
+func validateMetadataLabels(labels map[string]string) error {
+	if len(labels) > maximumMetadataLabels {
+		return ErrInvalidField{"metadata_labels"}
+	}
+
+	for key, value := range labels {
+		if len(key) > maximumMetadataLabelKeyLength || !metadataLabelKeyPattern.MatchString(key) {
+			return ErrInvalidField{"metadata_labels"}
+		}
+
+		if len(value) > maximumMetadataLabelValueLength {
+			return ErrInvalidField{"metadata_labels"}
+		}
+	}
+
+	return nil
+}
@@ -5,4 +5,12 @@ package models
 const (
 	maximumAnnotationLength = 10 * 1024
 	maximumRouteLength      = 4 * 1024
+
+	// Limits on the number of entries in repeated fields, so that a single
+	// task or LRP cannot take an unbounded share of the BBS's memory and
+	// storage.
+	maximumRoutes               = 100
+	maximumEnvironmentVariables = 1024
+	maximumVolumeMounts         = 128
+	maximumEgressRules          = 2048
 )
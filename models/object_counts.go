@@ -0,0 +1,56 @@
+package models
+
+// The dimensions the object counts can be grouped by. DesiredLRPs have no
+// state, so they are only ever grouped by domain.
+const (
+	CountGroupByDomain = "domain"
+	CountGroupByState  = "state"
+)
+
+func (request *ObjectCountsRequest) Validate() error {
+	var validationError ValidationError
+
+	for _, groupBy := range request.GroupBy {
+		if groupBy != CountGroupByDomain && groupBy != CountGroupByState {
+			validationError = validationError.Append(ErrInvalidField{"group_by"})
+			break
+		}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *ObjectCountsRequest) GroupsByDomain() bool {
+	return request.groupsBy(CountGroupByDomain)
+}
+
+func (request *ObjectCountsRequest) GroupsByState() bool {
+	return request.groupsBy(CountGroupByState)
+}
+
+func (request *ObjectCountsRequest) groupsBy(dimension string) bool {
+	for _, groupBy := range request.GroupBy {
+		if groupBy == dimension {
+			return true
+		}
+	}
+	return false
+}
+
+// NewObjectCount returns an empty count, with the groupings that are asked
+// for initialized so that they are reported even when there is nothing to
+// count.
+func NewObjectCount(byDomain, byState bool) *ObjectCount {
+	count := &ObjectCount{}
+	if byDomain {
+		count.ByDomain = map[string]int32{}
+	}
+	if byState {
+		count.ByState = map[string]int32{}
+	}
+	return count
+}
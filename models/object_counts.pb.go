@@ -0,0 +1,1317 @@
+// Code generated by protoc-gen-gogo.
+// source: object_counts.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import strings "strings"
+import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
+import sort "sort"
+import strconv "strconv"
+import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ObjectCountsRequest struct {
+	// The dimensions to also group the counts by, "domain" and/or "state".
+	GroupBy []string `protobuf:"bytes,1,rep,name=group_by,json=groupBy" json:"group_by,omitempty"`
+}
+
+func (m *ObjectCountsRequest) Reset()                    { *m = ObjectCountsRequest{} }
+func (*ObjectCountsRequest) ProtoMessage()               {}
+func (*ObjectCountsRequest) Descriptor() ([]byte, []int) { return fileDescriptorObjectCounts, []int{0} }
+
+func (m *ObjectCountsRequest) GetGroupBy() []string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return nil
+}
+
+type ObjectCount struct {
+	Total    int32            `protobuf:"varint,1,opt,name=total" json:"total"`
+	ByDomain map[string]int32 `protobuf:"bytes,2,rep,name=by_domain,json=byDomain" json:"by_domain,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ByState  map[string]int32 `protobuf:"bytes,3,rep,name=by_state,json=byState" json:"by_state,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+}
+
+func (m *ObjectCount) Reset()                    { *m = ObjectCount{} }
+func (*ObjectCount) ProtoMessage()               {}
+func (*ObjectCount) Descriptor() ([]byte, []int) { return fileDescriptorObjectCounts, []int{1} }
+
+func (m *ObjectCount) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ObjectCount) GetByDomain() map[string]int32 {
+	if m != nil {
+		return m.ByDomain
+	}
+	return nil
+}
+
+func (m *ObjectCount) GetByState() map[string]int32 {
+	if m != nil {
+		return m.ByState
+	}
+	return nil
+}
+
+type ObjectCountsResponse struct {
+	Error       *Error       `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	DesiredLrps *ObjectCount `protobuf:"bytes,2,opt,name=desired_lrps,json=desiredLrps" json:"desired_lrps,omitempty"`
+	ActualLrps  *ObjectCount `protobuf:"bytes,3,opt,name=actual_lrps,json=actualLrps" json:"actual_lrps,omitempty"`
+	Tasks       *ObjectCount `protobuf:"bytes,4,opt,name=tasks" json:"tasks,omitempty"`
+}
+
+func (m *ObjectCountsResponse) Reset()      { *m = ObjectCountsResponse{} }
+func (*ObjectCountsResponse) ProtoMessage() {}
+func (*ObjectCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorObjectCounts, []int{2}
+}
+
+func (m *ObjectCountsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ObjectCountsResponse) GetDesiredLrps() *ObjectCount {
+	if m != nil {
+		return m.DesiredLrps
+	}
+	return nil
+}
+
+func (m *ObjectCountsResponse) GetActualLrps() *ObjectCount {
+	if m != nil {
+		return m.ActualLrps
+	}
+	return nil
+}
+
+func (m *ObjectCountsResponse) GetTasks() *ObjectCount {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ObjectCountsRequest)(nil), "models.ObjectCountsRequest")
+	proto.RegisterType((*ObjectCount)(nil), "models.ObjectCount")
+	proto.RegisterType((*ObjectCountsResponse)(nil), "models.ObjectCountsResponse")
+}
+func (this *ObjectCountsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ObjectCountsRequest)
+	if !ok {
+		that2, ok := that.(ObjectCountsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.GroupBy) != len(that1.GroupBy) {
+		return false
+	}
+	for i := range this.GroupBy {
+		if this.GroupBy[i] != that1.GroupBy[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ObjectCount) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ObjectCount)
+	if !ok {
+		that2, ok := that.(ObjectCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	if len(this.ByDomain) != len(that1.ByDomain) {
+		return false
+	}
+	for i := range this.ByDomain {
+		if this.ByDomain[i] != that1.ByDomain[i] {
+			return false
+		}
+	}
+	if len(this.ByState) != len(that1.ByState) {
+		return false
+	}
+	for i := range this.ByState {
+		if this.ByState[i] != that1.ByState[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ObjectCountsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ObjectCountsResponse)
+	if !ok {
+		that2, ok := that.(ObjectCountsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.DesiredLrps.Equal(that1.DesiredLrps) {
+		return false
+	}
+	if !this.ActualLrps.Equal(that1.ActualLrps) {
+		return false
+	}
+	if !this.Tasks.Equal(that1.Tasks) {
+		return false
+	}
+	return true
+}
+func (this *ObjectCountsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ObjectCountsRequest{")
+	if this.GroupBy != nil {
+		s = append(s, "GroupBy: "+fmt.Sprintf("%#v", this.GroupBy)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ObjectCount) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.ObjectCount{")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	keysForByDomain := make([]string, 0, len(this.ByDomain))
+	for k, _ := range this.ByDomain {
+		keysForByDomain = append(keysForByDomain, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForByDomain)
+	mapStringForByDomain := "map[string]int32{"
+	for _, k := range keysForByDomain {
+		mapStringForByDomain += fmt.Sprintf("%#v: %#v,", k, this.ByDomain[k])
+	}
+	mapStringForByDomain += "}"
+	if this.ByDomain != nil {
+		s = append(s, "ByDomain: "+mapStringForByDomain+",\n")
+	}
+	keysForByState := make([]string, 0, len(this.ByState))
+	for k, _ := range this.ByState {
+		keysForByState = append(keysForByState, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForByState)
+	mapStringForByState := "map[string]int32{"
+	for _, k := range keysForByState {
+		mapStringForByState += fmt.Sprintf("%#v: %#v,", k, this.ByState[k])
+	}
+	mapStringForByState += "}"
+	if this.ByState != nil {
+		s = append(s, "ByState: "+mapStringForByState+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ObjectCountsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.ObjectCountsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.DesiredLrps != nil {
+		s = append(s, "DesiredLrps: "+fmt.Sprintf("%#v", this.DesiredLrps)+",\n")
+	}
+	if this.ActualLrps != nil {
+		s = append(s, "ActualLrps: "+fmt.Sprintf("%#v", this.ActualLrps)+",\n")
+	}
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringObjectCounts(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringObjectCounts(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+func (m *ObjectCountsRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ObjectCountsRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupBy) > 0 {
+		for _, s := range m.GroupBy {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ObjectCount) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ObjectCount) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintObjectCounts(data, i, uint64(m.Total))
+	if len(m.ByDomain) > 0 {
+		for k, _ := range m.ByDomain {
+			data[i] = 0x12
+			i++
+			v := m.ByDomain[k]
+			mapSize := 1 + len(k) + sovObjectCounts(uint64(len(k))) + 1 + sovObjectCounts(uint64(v))
+			i = encodeVarintObjectCounts(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintObjectCounts(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x10
+			i++
+			i = encodeVarintObjectCounts(data, i, uint64(v))
+		}
+	}
+	if len(m.ByState) > 0 {
+		for k, _ := range m.ByState {
+			data[i] = 0x1a
+			i++
+			v := m.ByState[k]
+			mapSize := 1 + len(k) + sovObjectCounts(uint64(len(k))) + 1 + sovObjectCounts(uint64(v))
+			i = encodeVarintObjectCounts(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintObjectCounts(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x10
+			i++
+			i = encodeVarintObjectCounts(data, i, uint64(v))
+		}
+	}
+	return i, nil
+}
+
+func (m *ObjectCountsResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ObjectCountsResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintObjectCounts(data, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.DesiredLrps != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintObjectCounts(data, i, uint64(m.DesiredLrps.Size()))
+		n2, err := m.DesiredLrps.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.ActualLrps != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintObjectCounts(data, i, uint64(m.ActualLrps.Size()))
+		n3, err := m.ActualLrps.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Tasks != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintObjectCounts(data, i, uint64(m.Tasks.Size()))
+		n4, err := m.Tasks.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func encodeFixed64ObjectCounts(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32ObjectCounts(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintObjectCounts(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ObjectCountsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.GroupBy) > 0 {
+		for _, s := range m.GroupBy {
+			l = len(s)
+			n += 1 + l + sovObjectCounts(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectCount) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovObjectCounts(uint64(m.Total))
+	if len(m.ByDomain) > 0 {
+		for k, v := range m.ByDomain {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovObjectCounts(uint64(len(k))) + 1 + sovObjectCounts(uint64(v))
+			n += mapEntrySize + 1 + sovObjectCounts(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ByState) > 0 {
+		for k, v := range m.ByState {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovObjectCounts(uint64(len(k))) + 1 + sovObjectCounts(uint64(v))
+			n += mapEntrySize + 1 + sovObjectCounts(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ObjectCountsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovObjectCounts(uint64(l))
+	}
+	if m.DesiredLrps != nil {
+		l = m.DesiredLrps.Size()
+		n += 1 + l + sovObjectCounts(uint64(l))
+	}
+	if m.ActualLrps != nil {
+		l = m.ActualLrps.Size()
+		n += 1 + l + sovObjectCounts(uint64(l))
+	}
+	if m.Tasks != nil {
+		l = m.Tasks.Size()
+		n += 1 + l + sovObjectCounts(uint64(l))
+	}
+	return n
+}
+
+func sovObjectCounts(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozObjectCounts(x uint64) (n int) {
+	return sovObjectCounts(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ObjectCountsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ObjectCountsRequest{`,
+		`GroupBy:` + fmt.Sprintf("%v", this.GroupBy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ObjectCount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForByDomain := make([]string, 0, len(this.ByDomain))
+	for k, _ := range this.ByDomain {
+		keysForByDomain = append(keysForByDomain, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForByDomain)
+	mapStringForByDomain := "map[string]int32{"
+	for _, k := range keysForByDomain {
+		mapStringForByDomain += fmt.Sprintf("%v: %v,", k, this.ByDomain[k])
+	}
+	mapStringForByDomain += "}"
+	keysForByState := make([]string, 0, len(this.ByState))
+	for k, _ := range this.ByState {
+		keysForByState = append(keysForByState, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForByState)
+	mapStringForByState := "map[string]int32{"
+	for _, k := range keysForByState {
+		mapStringForByState += fmt.Sprintf("%v: %v,", k, this.ByState[k])
+	}
+	mapStringForByState += "}"
+	s := strings.Join([]string{`&ObjectCount{`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`ByDomain:` + mapStringForByDomain + `,`,
+		`ByState:` + mapStringForByState + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ObjectCountsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ObjectCountsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`DesiredLrps:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrps), "ObjectCount", "ObjectCount", 1) + `,`,
+		`ActualLrps:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrps), "ObjectCount", "ObjectCount", 1) + `,`,
+		`Tasks:` + strings.Replace(fmt.Sprintf("%v", this.Tasks), "ObjectCount", "ObjectCount", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringObjectCounts(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ObjectCountsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectCounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectCountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = append(m.GroupBy, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectCounts(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectCount) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectCounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Total |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByDomain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.ByDomain == nil {
+				m.ByDomain = make(map[string]int32)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObjectCounts
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObjectCounts
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapvalue |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ByDomain[mapkey] = mapvalue
+			} else {
+				var mapvalue int32
+				m.ByDomain[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.ByState == nil {
+				m.ByState = make(map[string]int32)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObjectCounts
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObjectCounts
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapvalue |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ByState[mapkey] = mapvalue
+			} else {
+				var mapvalue int32
+				m.ByState[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectCounts(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectCountsResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectCounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectCountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredLrps == nil {
+				m.DesiredLrps = &ObjectCount{}
+			}
+			if err := m.DesiredLrps.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrps == nil {
+				m.ActualLrps = &ObjectCount{}
+			}
+			if err := m.ActualLrps.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tasks == nil {
+				m.Tasks = &ObjectCount{}
+			}
+			if err := m.Tasks.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectCounts(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObjectCounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObjectCounts(data []byte) (n int, err error) {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObjectCounts
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if data[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObjectCounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthObjectCounts
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowObjectCounts
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipObjectCounts(data[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthObjectCounts = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObjectCounts   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("object_counts.proto", fileDescriptorObjectCounts) }
+
+var fileDescriptorObjectCounts = []byte{
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x86, 0x7d, 0x71, 0x03, 0xc9, 0xe7, 0x76, 0xb9, 0xa0, 0x62, 0x79, 0xb8, 0x84, 0xb0, 0x04,
+	0xa9, 0xb8, 0x22, 0x42, 0x08, 0x31, 0x1a, 0x0a, 0x0c, 0x48, 0x48, 0xee, 0xc0, 0x68, 0xd9, 0xc9,
+	0x11, 0x42, 0xed, 0x9c, 0xb9, 0x3b, 0x23, 0xdd, 0xc6, 0x4f, 0xe0, 0x67, 0xf0, 0x53, 0x3a, 0x76,
+	0x60, 0x60, 0x8a, 0x88, 0x59, 0xaa, 0x4e, 0xfd, 0x09, 0x28, 0xdf, 0x25, 0xc8, 0x95, 0x9a, 0x85,
+	0xcd, 0xdf, 0x7b, 0xef, 0xfb, 0xdc, 0xbd, 0xfe, 0xa0, 0x27, 0xb2, 0xcf, 0x7c, 0xa2, 0x93, 0x89,
+	0xa8, 0x16, 0x5a, 0x85, 0xa5, 0x14, 0x5a, 0xd0, 0x3b, 0x85, 0x98, 0xf2, 0x5c, 0x05, 0x8f, 0x67,
+	0x73, 0xfd, 0xa9, 0xca, 0xc2, 0x89, 0x28, 0x8e, 0x67, 0x62, 0x26, 0x8e, 0xf1, 0x38, 0xab, 0x3e,
+	0xe2, 0x84, 0x03, 0x7e, 0xd9, 0x58, 0xe0, 0x71, 0x29, 0x85, 0xb4, 0xc3, 0xf0, 0x2d, 0xf4, 0xde,
+	0x23, 0xfa, 0x25, 0x92, 0x63, 0xfe, 0xa5, 0xe2, 0x4a, 0xd3, 0x27, 0xd0, 0x99, 0x49, 0x51, 0x95,
+	0x49, 0x66, 0x7c, 0x32, 0x70, 0x47, 0xdd, 0xe8, 0xf0, 0x6a, 0xd9, 0xa7, 0x5b, 0xed, 0x48, 0x14,
+	0x73, 0xcd, 0x8b, 0x52, 0x9b, 0xf8, 0x2e, 0x6a, 0x91, 0x19, 0x5e, 0xb6, 0xc0, 0x6b, 0xa0, 0x68,
+	0x00, 0x6d, 0x2d, 0x74, 0x9a, 0xfb, 0x64, 0x40, 0x46, 0xed, 0x68, 0xef, 0x7c, 0xd9, 0x77, 0x62,
+	0x2b, 0xd1, 0x0f, 0xd0, 0xcd, 0x4c, 0x32, 0x15, 0x45, 0x3a, 0x5f, 0xf8, 0xad, 0x81, 0x3b, 0xf2,
+	0xc6, 0x0f, 0x42, 0xdb, 0x26, 0x6c, 0x30, 0xc2, 0xc8, 0xbc, 0x42, 0xcf, 0xc9, 0x42, 0x4b, 0x13,
+	0xdd, 0xbf, 0x5a, 0xf6, 0x7b, 0xff, 0x72, 0x8d, 0x37, 0x74, 0xb2, 0x8d, 0x8f, 0x9e, 0x42, 0x27,
+	0x33, 0x89, 0xd2, 0xa9, 0xe6, 0xbe, 0x8b, 0xdc, 0xc1, 0xed, 0xdc, 0xd3, 0xb5, 0xc5, 0x62, 0xb1,
+	0xd9, 0x36, 0xd5, 0x6c, 0x96, 0x59, 0x57, 0xf0, 0x06, 0x0e, 0x6e, 0x3c, 0x84, 0x1e, 0x82, 0x7b,
+	0xc6, 0x0d, 0x16, 0xeb, 0x6e, 0x8a, 0xad, 0x85, 0x75, 0xe5, 0xaf, 0x69, 0x5e, 0x71, 0xbf, 0xd5,
+	0xac, 0x8c, 0xd2, 0x8b, 0xd6, 0x73, 0x12, 0xbc, 0x86, 0xfd, 0xe6, 0xcd, 0xff, 0xcb, 0x19, 0xfe,
+	0x24, 0x70, 0xef, 0xe6, 0xd6, 0x54, 0x29, 0x16, 0x8a, 0xd3, 0x87, 0xd0, 0xc6, 0xe5, 0x22, 0xd2,
+	0x1b, 0x1f, 0x6c, 0xbb, 0x9f, 0xac, 0xc5, 0xd8, 0x9e, 0xd1, 0x67, 0xb0, 0x3f, 0xe5, 0x6a, 0x2e,
+	0xf9, 0x34, 0xc9, 0x65, 0xa9, 0xf0, 0x12, 0x6f, 0xdc, 0xbb, 0xe5, 0x3f, 0xc5, 0xde, 0xc6, 0xf8,
+	0x4e, 0x96, 0x8a, 0x3e, 0x05, 0x2f, 0x9d, 0xe8, 0x2a, 0xcd, 0x6d, 0xcc, 0xdd, 0x1d, 0x03, 0xeb,
+	0xc3, 0xd4, 0x23, 0x68, 0xeb, 0x54, 0x9d, 0x29, 0x7f, 0x6f, 0xb7, 0xdf, 0x3a, 0xa2, 0xa3, 0x8b,
+	0x15, 0x73, 0x7e, 0xad, 0x98, 0x73, 0xbd, 0x62, 0xe4, 0x5b, 0xcd, 0xc8, 0x8f, 0x9a, 0x91, 0xf3,
+	0x9a, 0x91, 0x8b, 0x9a, 0x91, 0xdf, 0x35, 0x23, 0x97, 0x35, 0x73, 0xae, 0x6b, 0x46, 0xbe, 0xff,
+	0x61, 0xce, 0xdf, 0x01, 0x00, 0x20, 0xec, 0x22, 0xc4, 0x13, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,45 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObjectCountsRequest", func() {
+	Describe("Validate", func() {
+		It("is valid without groupings", func() {
+			request := models.ObjectCountsRequest{}
+			Expect(request.Validate()).To(Succeed())
+		})
+
+		It("is valid when grouping by domain and state", func() {
+			request := models.ObjectCountsRequest{GroupBy: []string{models.CountGroupByDomain, models.CountGroupByState}}
+			Expect(request.Validate()).To(Succeed())
+		})
+
+		It("is invalid when grouping by anything else", func() {
+			request := models.ObjectCountsRequest{GroupBy: []string{models.CountGroupByDomain, "cell"}}
+			err := request.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("group_by"))
+		})
+	})
+
+	Describe("groupings", func() {
+		It("reports the dimensions to group by", func() {
+			request := models.ObjectCountsRequest{GroupBy: []string{models.CountGroupByState}}
+			Expect(request.GroupsByDomain()).To(BeFalse())
+			Expect(request.GroupsByState()).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("NewObjectCount", func() {
+	It("initializes only the groupings asked for", func() {
+		Expect(models.NewObjectCount(true, false)).To(Equal(&models.ObjectCount{ByDomain: map[string]int32{}}))
+		Expect(models.NewObjectCount(false, true)).To(Equal(&models.ObjectCount{ByState: map[string]int32{}}))
+		Expect(models.NewObjectCount(false, false)).To(Equal(&models.ObjectCount{}))
+	})
+})
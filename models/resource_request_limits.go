@@ -0,0 +1,42 @@
+package models
+
+// ResourceRequestLimits caps the memory and disk that a single task or LRP instance
+// may request, and the number of instances a desired LRP may run. A zero limit
+// leaves that resource unbounded. Since a request of 0 memory or disk means an
+// unlimited allocation, it is rejected whenever a limit is set.
+type ResourceRequestLimits struct {
+	MaxMemoryMb  int32
+	MaxDiskMb    int32
+	MaxInstances int32
+}
+
+func (limits ResourceRequestLimits) Validate(memoryMb, diskMb int32) error {
+	var validationError ValidationError
+
+	if exceedsLimit(memoryMb, limits.MaxMemoryMb) {
+		validationError = validationError.Append(ErrInvalidField{"memory_mb"})
+	}
+
+	if exceedsLimit(diskMb, limits.MaxDiskMb) {
+		validationError = validationError.Append(ErrInvalidField{"disk_mb"})
+	}
+
+	return validationError.ToError()
+}
+
+// ValidateInstances rejects an instance count above MaxInstances. Unlike memory
+// and disk, a count of 0 is always within the limit.
+func (limits ResourceRequestLimits) ValidateInstances(instances int32) error {
+	if limits.MaxInstances > 0 && instances > limits.MaxInstances {
+		return ValidationError{ErrInvalidField{"instances"}}
+	}
+
+	return nil
+}
+
+func exceedsLimit(requested, limit int32) bool {
+	if limit <= 0 {
+		return false
+	}
+	return requested == 0 || requested > limit
+}
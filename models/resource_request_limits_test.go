@@ -0,0 +1,58 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceRequestLimits", func() {
+	limits := models.ResourceRequestLimits{MaxMemoryMb: 1024, MaxDiskMb: 2048}
+
+	DescribeTable("Validate",
+		func(limits models.ResourceRequestLimits, memoryMb, diskMb int32, expectedErr string) {
+			err := limits.Validate(memoryMb, diskMb)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expectedErr))
+			}
+		},
+		Entry("no limits, unlimited request", models.ResourceRequestLimits{}, int32(0), int32(0), ""),
+		Entry("no limits, large request", models.ResourceRequestLimits{}, int32(1<<30), int32(1<<30), ""),
+		Entry("within limits", limits, int32(512), int32(1024), ""),
+		Entry("at the limits", limits, int32(1024), int32(2048), ""),
+		Entry("memory one over the limit", limits, int32(1025), int32(2048), "memory_mb"),
+		Entry("disk one over the limit", limits, int32(1024), int32(2049), "disk_mb"),
+		Entry("unlimited memory with a memory limit", limits, int32(0), int32(1024), "memory_mb"),
+		Entry("unlimited disk with a disk limit", limits, int32(512), int32(0), "disk_mb"),
+		Entry("only a memory limit", models.ResourceRequestLimits{MaxMemoryMb: 1024}, int32(512), int32(0), ""),
+		Entry("only a disk limit", models.ResourceRequestLimits{MaxDiskMb: 2048}, int32(0), int32(512), ""),
+	)
+
+	It("names every offending field", func() {
+		err := limits.Validate(4096, 4096)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("memory_mb"))
+		Expect(err.Error()).To(ContainSubstring("disk_mb"))
+	})
+	DescribeTable("ValidateInstances",
+		func(limits models.ResourceRequestLimits, instances int32, valid bool) {
+			err := limits.ValidateInstances(instances)
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("instances"))
+			}
+		},
+		Entry("no limit", models.ResourceRequestLimits{}, int32(100000), true),
+		Entry("below the limit", models.ResourceRequestLimits{MaxInstances: 100}, int32(99), true),
+		Entry("at the limit", models.ResourceRequestLimits{MaxInstances: 100}, int32(100), true),
+		Entry("one over the limit", models.ResourceRequestLimits{MaxInstances: 100}, int32(101), false),
+		Entry("no instances with a limit", models.ResourceRequestLimits{MaxInstances: 100}, int32(0), true),
+	)
+})
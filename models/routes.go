@@ -3,6 +3,11 @@ package models
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
 )
 
 type Routes map[string]*json.RawMessage
@@ -65,15 +70,157 @@ func (r *Routes) Equal(other Routes) bool {
 	return true
 }
 
+// The route types whose structure the BBS checks. Routes of any other type
+// are accepted as long as they are JSON, so that new route consumers do not
+// need a BBS that knows about them, but count towards the same size limit.
+const (
+	cfRouterRouteType  = "cf-router"
+	tcpRouterRouteType = "tcp-router"
+)
+
+var hostnameLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+
+type cfRoute struct {
+	Hostnames       []string `json:"hostnames"`
+	Port            int64    `json:"port"`
+	RouteServiceUrl string   `json:"route_service_url,omitempty"`
+}
+
+type tcpRoute struct {
+	RouterGroupGuid string `json:"router_group_guid"`
+	ExternalPort    int64  `json:"external_port"`
+	ContainerPort   int64  `json:"container_port"`
+}
+
+// Validate limits the number of route types and the total size of their
+// routes, and checks the structure of the cf-router and tcp-router routes.
+// Errors name the offending route, e.g. routes.cf-router[0].hostnames[1].
 func (r Routes) Validate() error {
+	var validationError ValidationError
+
+	if len(r) > maximumRoutes {
+		validationError = validationError.Append(ErrInvalidField{"routes"})
+	}
+
+	routeTypes := make([]string, 0, len(r))
 	totalRoutesLength := 0
-	if r != nil {
-		for _, value := range r {
+	for routeType, value := range r {
+		routeTypes = append(routeTypes, routeType)
+		if value != nil {
 			totalRoutesLength += len(*value)
-			if totalRoutesLength > maximumRouteLength {
-				return ErrInvalidField{"routes"}
+		}
+	}
+
+	if totalRoutesLength > maximumRouteLength {
+		return validationError.Append(ErrInvalidField{"routes"})
+	}
+
+	sort.Strings(routeTypes)
+	for _, routeType := range routeTypes {
+		field := "routes." + routeType
+		value := r[routeType]
+		if value == nil {
+			validationError = validationError.Append(ErrInvalidField{field})
+			continue
+		}
+
+		switch routeType {
+		case cfRouterRouteType:
+			validationError = validationError.Append(validateCFRoutes(field, *value))
+		case tcpRouterRouteType:
+			validationError = validationError.Append(validateTCPRoutes(field, *value))
+		default:
+			var route interface{}
+			if err := json.Unmarshal(*value, &route); err != nil {
+				validationError = validationError.Append(ErrInvalidField{field})
 			}
 		}
 	}
-	return nil
+
+	return validationError.ToError()
+}
+
+func validateCFRoutes(field string, value json.RawMessage) ValidationError {
+	var routes []cfRoute
+	if err := json.Unmarshal(value, &routes); err != nil {
+		return ValidationError{ErrInvalidField{field}}
+	}
+
+	var validationError ValidationError
+	for i, route := range routes {
+		routeField := fmt.Sprintf("%s[%d]", field, i)
+
+		if len(route.Hostnames) == 0 {
+			validationError = validationError.Append(ErrInvalidField{routeField + ".hostnames"})
+		}
+		for j, hostname := range route.Hostnames {
+			if !validHostname(hostname) {
+				validationError = validationError.Append(ErrInvalidField{fmt.Sprintf("%s.hostnames[%d]", routeField, j)})
+			}
+		}
+
+		if !validRoutePort(route.Port) {
+			validationError = validationError.Append(ErrInvalidField{routeField + ".port"})
+		}
+
+		if route.RouteServiceUrl != "" {
+			u, err := url.Parse(route.RouteServiceUrl)
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				validationError = validationError.Append(ErrInvalidField{routeField + ".route_service_url"})
+			}
+		}
+	}
+
+	return validationError
+}
+
+func validateTCPRoutes(field string, value json.RawMessage) ValidationError {
+	var routes []tcpRoute
+	if err := json.Unmarshal(value, &routes); err != nil {
+		return ValidationError{ErrInvalidField{field}}
+	}
+
+	var validationError ValidationError
+	for i, route := range routes {
+		routeField := fmt.Sprintf("%s[%d]", field, i)
+
+		if route.RouterGroupGuid == "" {
+			validationError = validationError.Append(ErrInvalidField{routeField + ".router_group_guid"})
+		}
+		if !validRoutePort(route.ExternalPort) {
+			validationError = validationError.Append(ErrInvalidField{routeField + ".external_port"})
+		}
+		if !validRoutePort(route.ContainerPort) {
+			validationError = validationError.Append(ErrInvalidField{routeField + ".container_port"})
+		}
+	}
+
+	return validationError
+}
+
+// validHostname accepts a DNS name, optionally with a leading wildcard label
+// and followed by the path of a context path route, such as
+// *.example.com or example.com/some/path.
+func validHostname(hostname string) bool {
+	if i := strings.Index(hostname, "/"); i >= 0 {
+		hostname = hostname[:i]
+	}
+	hostname = strings.TrimPrefix(hostname, "*.")
+
+	if hostname == "" || len(hostname) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(hostname, ".") {
+		if !hostnameLabelPattern.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// validRoutePort is validPort for ports decoded from JSON, which may be out of
+// the range of a uint32.
+func validRoutePort(port int64) bool {
+	return port > 0 && port <= int64(maxPort)
 }
@@ -2,11 +2,13 @@ package models_test
 
 import (
 	"encoding/json"
+	"strings"
 
 	"code.cloudfoundry.org/bbs/models"
 	"github.com/gogo/protobuf/proto"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -49,3 +51,77 @@ var _ = Describe("Routes", func() {
 		"def": &(json.RawMessage{'"', 'g', '"'}),
 	})
 })
+
+var _ = Describe("Routes Validate", func() {
+	routes := func(routes map[string]string) models.Routes {
+		r := models.Routes{}
+		for routeType, value := range routes {
+			raw := json.RawMessage(value)
+			r[routeType] = &raw
+		}
+		return r
+	}
+
+	DescribeTable("valid routes",
+		func(r models.Routes) {
+			Expect(r.Validate()).To(Succeed())
+		},
+		Entry("no routes", models.Routes{}),
+		Entry("cf-router routes", routes(map[string]string{
+			"cf-router": `[{"hostnames":["app.example.com","*.example.com","example.com/some/path"],"port":8080,"route_service_url":"https://rs.example.com"}]`,
+		})),
+		Entry("an empty list of cf-router routes", routes(map[string]string{"cf-router": `[]`})),
+		Entry("tcp-router routes", routes(map[string]string{
+			"tcp-router": `[{"router_group_guid":"some-guid","external_port":61000,"container_port":8080}]`,
+		})),
+		Entry("routes of an unknown type", routes(map[string]string{
+			"diego-ssh": `{"container_port":2222,"private_key":"key"}`,
+			"my-router": `"anything"`,
+		})),
+	)
+
+	DescribeTable("invalid routes",
+		func(r models.Routes, expectedFields ...string) {
+			err := r.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(BeAssignableToTypeOf(models.ValidationError{}))
+
+			fields := []string{}
+			for _, fieldErr := range err.(models.ValidationError) {
+				Expect(fieldErr).To(BeAssignableToTypeOf(models.ErrInvalidField{}))
+				fields = append(fields, fieldErr.(models.ErrInvalidField).Field)
+			}
+			Expect(fields).To(Equal(expectedFields))
+		},
+		Entry("oversized routes", routes(map[string]string{
+			"my-router": `"` + strings.Repeat("a", 4*1024) + `"`,
+		}), "routes"),
+		Entry("oversized routes split across types", routes(map[string]string{
+			"my-router":    `"` + strings.Repeat("a", 2*1024) + `"`,
+			"other-router": `"` + strings.Repeat("a", 2*1024) + `"`,
+		}), "routes"),
+		Entry("a missing route", models.Routes{"my-router": nil}, "routes.my-router"),
+		Entry("a malformed route of an unknown type", routes(map[string]string{"my-router": `{"port":`}), "routes.my-router"),
+		Entry("cf-router routes that are not a list", routes(map[string]string{"cf-router": `{"hostnames":["app.example.com"],"port":8080}`}), "routes.cf-router"),
+		Entry("a cf-router route without hostnames", routes(map[string]string{"cf-router": `[{"port":8080}]`}), "routes.cf-router[0].hostnames"),
+		Entry("cf-router routes with invalid hostnames", routes(map[string]string{
+			"cf-router": `[{"hostnames":["app.example.com"],"port":8080},{"hostnames":["ok.example.com","bad..example.com","-bad.example.com",""],"port":8080}]`,
+		}), "routes.cf-router[1].hostnames[1]", "routes.cf-router[1].hostnames[2]", "routes.cf-router[1].hostnames[3]"),
+		Entry("a cf-router route with an invalid port", routes(map[string]string{
+			"cf-router": `[{"hostnames":["app.example.com"],"port":70000}]`,
+		}), "routes.cf-router[0].port"),
+		Entry("a cf-router route without a port", routes(map[string]string{
+			"cf-router": `[{"hostnames":["app.example.com"]}]`,
+		}), "routes.cf-router[0].port"),
+		Entry("a cf-router route with an invalid route service url", routes(map[string]string{
+			"cf-router": `[{"hostnames":["app.example.com"],"port":8080,"route_service_url":"not a url"}]`,
+		}), "routes.cf-router[0].route_service_url"),
+		Entry("an invalid tcp-router route", routes(map[string]string{
+			"tcp-router": `[{"external_port":0,"container_port":65536}]`,
+		}), "routes.tcp-router[0].router_group_guid", "routes.tcp-router[0].external_port", "routes.tcp-router[0].container_port"),
+		Entry("invalid routes of several types", routes(map[string]string{
+			"tcp-router": `"not a list"`,
+			"cf-router":  `[{"hostnames":["app.example.com"],"port":-1}]`,
+		}), "routes.cf-router[0].port", "routes.tcp-router"),
+	)
+})
@@ -1,7 +1,9 @@
 package models
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"net"
 	"strings"
 )
@@ -13,6 +15,13 @@ const (
 	AllProtocol  = "all"
 
 	maxPort int = 65535
+
+	// AllICMPTypes and AllICMPCodes match every ICMP type and code.
+	AllICMPTypes int32 = -1
+	AllICMPCodes int32 = -1
+
+	maxICMPType int32 = 255
+	maxICMPCode int32 = 255
 )
 
 var errInvalidIP = errors.New("Invalid IP")
@@ -43,6 +52,8 @@ func (rule SecurityGroupRule) Validate() error {
 		}
 		if rule.IcmpInfo == nil {
 			validationError = validationError.Append(ErrInvalidField{"icmp_info"})
+		} else if err := rule.IcmpInfo.validate(); err != nil {
+			validationError = validationError.Append(err)
 		}
 		if rule.GetLog() == true {
 			validationError = validationError.Append(ErrInvalidField{"log"})
@@ -72,6 +83,24 @@ func (rule SecurityGroupRule) Validate() error {
 	return nil
 }
 
+// Normalize rewrites the destinations of a valid rule in canonical form: IP
+// addresses in their shortest form, CIDRs as the network they describe and
+// ranges of a single address as that address. Duplicate destinations are
+// dropped. Destinations that do not parse are left as they are.
+func (rule *SecurityGroupRule) Normalize() {
+	seen := make(map[string]bool, len(rule.Destinations))
+	destinations := make([]string, 0, len(rule.Destinations))
+	for _, d := range rule.Destinations {
+		normalized := normalizeDestination(d)
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		destinations = append(destinations, normalized)
+	}
+	rule.Destinations = destinations
+}
+
 func (rule SecurityGroupRule) validatePorts() ValidationError {
 	var validationError ValidationError
 
@@ -84,13 +113,8 @@ func (rule SecurityGroupRule) validatePorts() ValidationError {
 	}
 
 	if rule.PortRange != nil {
-		if rule.GetPortRange().GetStart() < 1 {
-			validationError = validationError.Append(ErrInvalidField{"port_range"})
-		}
-		if rule.GetPortRange().GetEnd() < 1 {
-			validationError = validationError.Append(ErrInvalidField{"port_range"})
-		}
-		if rule.GetPortRange().GetStart() > rule.GetPortRange().GetEnd() {
+		start, end := rule.GetPortRange().GetStart(), rule.GetPortRange().GetEnd()
+		if !validPort(start) || !validPort(end) || start > end {
 			validationError = validationError.Append(ErrInvalidField{"port_range"})
 		}
 	}
@@ -100,16 +124,44 @@ func (rule SecurityGroupRule) validatePorts() ValidationError {
 			validationError = validationError.Append(ErrInvalidField{"ports"})
 		}
 
+		seen := make(map[uint32]bool, len(rule.Ports))
 		for _, p := range rule.Ports {
-			if p < 1 {
+			if !validPort(p) || seen[p] {
 				validationError = validationError.Append(ErrInvalidField{"ports"})
+				break
 			}
+			seen[p] = true
 		}
 	}
 
 	return validationError
 }
 
+func validPort(port uint32) bool {
+	return port >= 1 && port <= uint32(maxPort)
+}
+
+// validate checks the ICMP type and code. Either may be AllICMPTypes or
+// AllICMPCodes, but a code only means something for a specific type.
+func (info *ICMPInfo) validate() error {
+	var validationError ValidationError
+
+	if info.Type < AllICMPTypes || info.Type > maxICMPType {
+		validationError = validationError.Append(ErrInvalidField{"icmp_info.type"})
+	}
+
+	if info.Code < AllICMPCodes || info.Code > maxICMPCode ||
+		(info.Type == AllICMPTypes && info.Code != AllICMPCodes) {
+		validationError = validationError.Append(ErrInvalidField{"icmp_info.code"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
 func (rule SecurityGroupRule) validateDestinations() error {
 	if len(rule.Destinations) == 0 {
 		return errors.New("Must have at least 1 destination")
@@ -118,37 +170,8 @@ func (rule SecurityGroupRule) validateDestinations() error {
 	var validationError ValidationError
 
 	for _, d := range rule.Destinations {
-		n := strings.IndexAny(d, "-/")
-		if n == -1 {
-			if net.ParseIP(d) == nil {
-				validationError = validationError.Append(errInvalidIP)
-				continue
-			}
-		} else if d[n] == '/' {
-			_, _, err := net.ParseCIDR(d)
-			if err != nil {
-				validationError = validationError.Append(err)
-				continue
-			}
-		} else {
-			firstIP := net.ParseIP(d[:n])
-			secondIP := net.ParseIP(d[n+1:])
-			if firstIP == nil || secondIP == nil {
-				validationError = validationError.Append(errInvalidIP)
-				continue
-			}
-			for i, b := range firstIP {
-				if b < secondIP[i] {
-					break
-				}
-
-				if b == secondIP[i] {
-					continue
-				}
-
-				validationError = validationError.Append(errInvalidIP)
-				continue
-			}
+		if err := validateDestination(d); err != nil {
+			validationError = validationError.Append(fmt.Errorf("%q: %s", d, err.Error()))
 		}
 	}
 
@@ -158,3 +181,68 @@ func (rule SecurityGroupRule) validateDestinations() error {
 
 	return nil
 }
+
+// validateDestination accepts an IP address, a CIDR or a range of addresses of
+// the same family written as "first-last", with first no greater than last.
+func validateDestination(d string) error {
+	n := strings.IndexAny(d, "-/")
+	if n == -1 {
+		if net.ParseIP(d) == nil {
+			return errInvalidIP
+		}
+		return nil
+	}
+
+	if d[n] == '/' {
+		_, _, err := net.ParseCIDR(d)
+		return err
+	}
+
+	firstIP, secondIP := parseRangeIP(d[:n]), parseRangeIP(d[n+1:])
+	if firstIP == nil || secondIP == nil {
+		return errInvalidIP
+	}
+	if len(firstIP) != len(secondIP) {
+		return errors.New("Invalid IP range: addresses of different families")
+	}
+	if bytes.Compare(firstIP, secondIP) > 0 {
+		return errors.New("Invalid IP range: first address is greater than the last")
+	}
+
+	return nil
+}
+
+// parseRangeIP parses an end of an IP range, returning IPv4 addresses in their
+// 4-byte form so that they only compare equal to other IPv4 addresses.
+func parseRangeIP(s string) net.IP {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil && !strings.Contains(s, ":") {
+		return ip4
+	}
+	return ip.To16()
+}
+
+func normalizeDestination(d string) string {
+	if validateDestination(d) != nil {
+		return d
+	}
+
+	n := strings.IndexAny(d, "-/")
+	if n == -1 {
+		return net.ParseIP(d).String()
+	}
+
+	if d[n] == '/' {
+		_, network, _ := net.ParseCIDR(d)
+		return network.String()
+	}
+
+	firstIP, secondIP := parseRangeIP(d[:n]), parseRangeIP(d[n+1:])
+	if firstIP.Equal(secondIP) {
+		return firstIP.String()
+	}
+	return firstIP.String() + "-" + secondIP.String()
+}
@@ -3,6 +3,7 @@ package models_test
 import (
 	"code.cloudfoundry.org/bbs/models"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -363,4 +364,78 @@ var _ = Describe("SecurityGroupRule", func() {
 			})
 		})
 	})
+
+	DescribeTable("rejecting misconfigured rules",
+		func(rule models.SecurityGroupRule, invalidField string) {
+			err := rule.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(invalidField))
+		},
+		Entry("a port of 0", models.SecurityGroupRule{Protocol: "tcp", Destinations: []string{"10.0.0.1"}, Ports: []uint32{0, 80}}, "ports"),
+		Entry("a port above 65535", models.SecurityGroupRule{Protocol: "tcp", Destinations: []string{"10.0.0.1"}, Ports: []uint32{65536}}, "ports"),
+		Entry("a port listed twice", models.SecurityGroupRule{Protocol: "udp", Destinations: []string{"10.0.0.1"}, Ports: []uint32{53, 53}}, "ports"),
+		Entry("a port range starting at 0", models.SecurityGroupRule{Protocol: "tcp", Destinations: []string{"10.0.0.1"}, PortRange: &models.PortRange{Start: 0, End: 80}}, "port_range"),
+		Entry("a port range ending above 65535", models.SecurityGroupRule{Protocol: "tcp", Destinations: []string{"10.0.0.1"}, PortRange: &models.PortRange{Start: 8080, End: 70000}}, "port_range"),
+		Entry("a reversed port range", models.SecurityGroupRule{Protocol: "udp", Destinations: []string{"10.0.0.1"}, PortRange: &models.PortRange{Start: 443, End: 80}}, "port_range"),
+		Entry("icmp with ports", models.SecurityGroupRule{Protocol: "icmp", Destinations: []string{"10.0.0.1"}, Ports: []uint32{80}, IcmpInfo: &models.ICMPInfo{}}, "ports"),
+		Entry("icmp with a port range", models.SecurityGroupRule{Protocol: "icmp", Destinations: []string{"10.0.0.1"}, PortRange: &models.PortRange{Start: 1, End: 2}, IcmpInfo: &models.ICMPInfo{}}, "port_range"),
+		Entry("an icmp type above 255", models.SecurityGroupRule{Protocol: "icmp", Destinations: []string{"10.0.0.1"}, IcmpInfo: &models.ICMPInfo{Type: 256}}, "icmp_info.type"),
+		Entry("an icmp type below -1", models.SecurityGroupRule{Protocol: "icmp", Destinations: []string{"10.0.0.1"}, IcmpInfo: &models.ICMPInfo{Type: -2}}, "icmp_info.type"),
+		Entry("an icmp code above 255", models.SecurityGroupRule{Protocol: "icmp", Destinations: []string{"10.0.0.1"}, IcmpInfo: &models.ICMPInfo{Type: 3, Code: 256}}, "icmp_info.code"),
+		Entry("an icmp code for every type", models.SecurityGroupRule{Protocol: "icmp", Destinations: []string{"10.0.0.1"}, IcmpInfo: &models.ICMPInfo{Type: -1, Code: 3}}, "icmp_info.code"),
+		Entry("all with a port range", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.0.0.1"}, PortRange: &models.PortRange{Start: 1, End: 65535}}, "port_range"),
+		Entry("an uppercase protocol", models.SecurityGroupRule{Protocol: "TCP", Destinations: []string{"10.0.0.1"}, Ports: []uint32{80}}, "protocol"),
+		Entry("an empty destination", models.SecurityGroupRule{Protocol: "all", Destinations: []string{""}}, "destinations"),
+		Entry("a CIDR with a prefix too long", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.0.0.0/33"}}, "destinations"),
+		Entry("a CIDR without a prefix", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.0.0.0/"}}, "destinations"),
+		Entry("an address with an octet above 255", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.0.0.256"}}, "destinations"),
+		Entry("a reversed range", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.0.0.9-10.0.0.1"}}, "first address is greater"),
+		Entry("a range reversed in an early octet", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.1.0.0-10.0.255.255"}}, "first address is greater"),
+		Entry("a range across address families", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.0.0.1-::ffff:10.0.0.9"}}, "different families"),
+		Entry("a range with an open end", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.0.0.1-"}}, "destinations"),
+		Entry("one bad destination among good ones", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.0.0.1", "bogus", "10.0.0.0/8"}}, `"bogus"`),
+	)
+
+	DescribeTable("accepting well-formed rules",
+		func(rule models.SecurityGroupRule) {
+			Expect(rule.Validate()).To(Succeed())
+		},
+		Entry("the full port range", models.SecurityGroupRule{Protocol: "tcp", Destinations: []string{"10.0.0.1"}, PortRange: &models.PortRange{Start: 1, End: 65535}}),
+		Entry("a single port range", models.SecurityGroupRule{Protocol: "tcp", Destinations: []string{"10.0.0.1"}, PortRange: &models.PortRange{Start: 443, End: 443}}),
+		Entry("the highest port", models.SecurityGroupRule{Protocol: "udp", Destinations: []string{"10.0.0.1"}, Ports: []uint32{65535}}),
+		Entry("every icmp type and code", models.SecurityGroupRule{Protocol: "icmp", Destinations: []string{"10.0.0.1"}, IcmpInfo: &models.ICMPInfo{Type: -1, Code: -1}}),
+		Entry("every code of an icmp type", models.SecurityGroupRule{Protocol: "icmp", Destinations: []string{"10.0.0.1"}, IcmpInfo: &models.ICMPInfo{Type: 3, Code: -1}}),
+		Entry("a range of a single address", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.0.0.1-10.0.0.1"}}),
+		Entry("an IPv6 range", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"fd00::1-fd00::ff"}}),
+		Entry("a CIDR with host bits set", models.SecurityGroupRule{Protocol: "all", Destinations: []string{"10.1.2.3/8"}}),
+	)
+
+	Describe("Normalize", func() {
+		It("rewrites the destinations in canonical form and drops duplicates", func() {
+			rule := models.SecurityGroupRule{
+				Protocol: "all",
+				Destinations: []string{
+					"10.1.2.3/8",
+					"10.0.0.0/8",
+					"10.0.0.5-10.0.0.5",
+					"10.0.0.5",
+					"10.0.0.1-10.0.0.9",
+					"::ffff:10.0.0.7",
+					"FD00:0:0::1",
+					"bogus",
+				},
+			}
+
+			rule.Normalize()
+
+			Expect(rule.Destinations).To(Equal([]string{
+				"10.0.0.0/8",
+				"10.0.0.5",
+				"10.0.0.1-10.0.0.9",
+				"10.0.0.7",
+				"fd00::1",
+				"bogus",
+			}))
+		})
+	})
 })
@@ -0,0 +1,12 @@
+package models
+
+func (o StartOrder) IsValid() bool {
+	_, ok := StartOrder_name[int32(o)]
+	return ok
+}
+
+// WaitsForLeader reports whether the instance at the index may only be
+// auctioned once instance 0 of the LRP is running.
+func (s *DesiredLRPSchedulingInfo) WaitsForLeader(index int32) bool {
+	return s.StartOrder == StartOrder_LeaderFirst && index > 0
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -16,11 +19,47 @@ type TaskChange struct {
 	After  *Task
 }
 
+// TaskFilter selects tasks. An empty field matches every task; States matches
+// a task in any of the listed states.
+//
+// PartialResults has StreamTasks leave the tasks that cannot be read out of
+// the stream and return them as RecordErrors, instead of failing. Tasks
+// ignores it.
 type TaskFilter struct {
-	Domain string
+	Domain         string
+	CellID         string
+	States         []Task_State
+	PartialResults bool
+}
+
+// MatchesState reports whether the state is one of the states of the filter.
+func (filter TaskFilter) MatchesState(state Task_State) bool {
+	if len(filter.States) == 0 {
+		return true
+	}
+	for _, s := range filter.States {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// CancelledTask is a task that CancelTasks moved to the completed state, as it
+// was before and after being cancelled, along with the cell it was running on,
+// if any.
+type CancelledTask struct {
+	Before *Task
+	Task   *Task
 	CellID string
 }
 
+type CancelTasksResult struct {
+	Cancelled      []CancelledTask
+	NotCancellable []string
+	NotFound       []string
+}
+
 func (t *Task) Version() format.Version {
 	return format.V1
 }
@@ -51,6 +90,10 @@ func (task *Task) Validate() error {
 		validationError = validationError.Append(defErr)
 	}
 
+	if task.RejectionCount < 0 {
+		validationError = validationError.Append(ErrInvalidField{"rejection_count"})
+	}
+
 	if !validationError.Empty() {
 		return validationError
 	}
@@ -58,6 +101,17 @@ func (task *Task) Validate() error {
 	return nil
 }
 
+// ExpiredPendingTaskFailureReason is the failure reason of a task that
+// convergence failed because it stayed pending, without being placed on a
+// cell, for longer than the expire pending task duration.
+const ExpiredPendingTaskFailureReason = "not started within time limit"
+
+// RejectedTaskFailureReason is the failure reason of a task that convergence
+// failed because it was rejected too many times.
+func RejectedTaskFailureReason(rejectionCount int32, rejectionReason string) string {
+	return fmt.Sprintf("task was rejected %d times, last because: %s", rejectionCount, rejectionReason)
+}
+
 func (t *Task) Copy() *Task {
 	newTask := *t
 	return &newTask
@@ -78,6 +132,12 @@ func (t *Task) VersionDownTo(v format.Version) *Task {
 	}
 }
 
+// Cancellable reports whether the task may be cancelled. Only pending and
+// running tasks can be; completed and resolving tasks already have a result.
+func (t *Task) Cancellable() bool {
+	return t.State == Task_Pending || t.State == Task_Running
+}
+
 func (t *Task) ValidateTransitionTo(to Task_State) error {
 	var valid bool
 	from := t.State
@@ -135,6 +195,30 @@ func (t *TaskDefinition) Copy() *TaskDefinition {
 	return &newTaskDef
 }
 
+// NormalizeEgressRules rewrites the destinations of the egress rules in
+// canonical form; see SecurityGroupRule.Normalize.
+func (def *TaskDefinition) NormalizeEgressRules() {
+	for _, rule := range def.EgressRules {
+		rule.Normalize()
+	}
+}
+
+// ContentHash returns a hex-encoded SHA-256 hash of the task definition, so
+// that tasks submitted twice with the same work can be found. Since it only
+// covers the definition, the task guid, domain, timestamps and state do not
+// affect it. The hash is taken over the JSON encoding of the definition, which
+// does not depend on how the definition was serialized when it was received
+// or stored.
+func (def *TaskDefinition) ContentHash() (string, error) {
+	encoded, err := json.Marshal(def)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 func (def *TaskDefinition) Validate() error {
 	var validationError ValidationError
 
@@ -170,10 +254,23 @@ func (def *TaskDefinition) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"annotation"})
 	}
 
+	if len(def.EnvironmentVariables) > maximumEnvironmentVariables {
+		validationError = validationError.Append(ErrInvalidField{"env"})
+	}
+
+	if len(def.VolumeMounts) > maximumVolumeMounts {
+		validationError = validationError.Append(ErrInvalidField{"volume_mounts"})
+	}
+
+	if len(def.EgressRules) > maximumEgressRules {
+		validationError = validationError.Append(ErrInvalidField{"egress_rules"})
+	}
+
 	for _, rule := range def.EgressRules {
 		err := rule.Validate()
 		if err != nil {
 			validationError = validationError.Append(ErrInvalidField{"egress_rules"})
+			validationError = validationError.Append(err)
 		}
 	}
 
@@ -182,6 +279,10 @@ func (def *TaskDefinition) Validate() error {
 		validationError = validationError.Append(err)
 	}
 
+	if err := validateExtensionFields(def.ExtensionFields); err != nil {
+		validationError = validationError.Append(err)
+	}
+
 	if !validationError.Empty() {
 		return validationError
 	}
@@ -11,10 +11,13 @@ import _ "github.com/gogo/protobuf/gogoproto"
 
 import strconv "strconv"
 
+import bytes "bytes"
+
 import strings "strings"
 import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
 import sort "sort"
 import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -87,6 +90,7 @@ type TaskDefinition struct {
 	VolumeMounts                  []*VolumeMount         `protobuf:"bytes,18,rep,name=volume_mounts,json=volumeMounts" json:"volume_mounts,omitempty"`
 	Network                       *Network               `protobuf:"bytes,19,opt,name=network" json:"network,omitempty"`
 	PlacementTags                 []string               `protobuf:"bytes,20,rep,name=PlacementTags,json=placementTags" json:"placement_tags,omitempty"`
+	ExtensionFields               map[string][]byte      `protobuf:"bytes,21,rep,name=extension_fields,json=extensionFields" json:"extension_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *TaskDefinition) Reset()                    { *m = TaskDefinition{} }
@@ -233,6 +237,13 @@ func (m *TaskDefinition) GetPlacementTags() []string {
 	return nil
 }
 
+func (m *TaskDefinition) GetExtensionFields() map[string][]byte {
+	if m != nil {
+		return m.ExtensionFields
+	}
+	return nil
+}
+
 type Task struct {
 	*TaskDefinition  `protobuf:"bytes,1,opt,name=task_definition,json=taskDefinition,embedded=task_definition" json:""`
 	TaskGuid         string     `protobuf:"bytes,2,opt,name=task_guid,json=taskGuid" json:"task_guid"`
@@ -245,6 +256,9 @@ type Task struct {
 	Result           string     `protobuf:"bytes,9,opt,name=result" json:"result"`
 	Failed           bool       `protobuf:"varint,10,opt,name=failed" json:"failed"`
 	FailureReason    string     `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason" json:"failure_reason"`
+	RejectionCount   int32      `protobuf:"varint,12,opt,name=rejection_count,json=rejectionCount" json:"rejection_count,omitempty"`
+	RejectionReason  string     `protobuf:"bytes,13,opt,name=rejection_reason,json=rejectionReason" json:"rejection_reason,omitempty"`
+	ContentHash      string     `protobuf:"bytes,14,opt,name=content_hash,json=contentHash" json:"content_hash,omitempty"`
 }
 
 func (m *Task) Reset()                    { *m = Task{} }
@@ -321,6 +335,27 @@ func (m *Task) GetFailureReason() string {
 	return ""
 }
 
+func (m *Task) GetRejectionCount() int32 {
+	if m != nil {
+		return m.RejectionCount
+	}
+	return 0
+}
+
+func (m *Task) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+func (m *Task) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TaskDefinition)(nil), "models.TaskDefinition")
 	proto.RegisterType((*Task)(nil), "models.Task")
@@ -443,6 +478,14 @@ func (this *TaskDefinition) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.ExtensionFields) != len(that1.ExtensionFields) {
+		return false
+	}
+	for i := range this.ExtensionFields {
+		if !bytes.Equal(this.ExtensionFields[i], that1.ExtensionFields[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Task) Equal(that interface{}) bool {
@@ -503,13 +546,22 @@ func (this *Task) Equal(that interface{}) bool {
 	if this.FailureReason != that1.FailureReason {
 		return false
 	}
+	if this.RejectionCount != that1.RejectionCount {
+		return false
+	}
+	if this.RejectionReason != that1.RejectionReason {
+		return false
+	}
+	if this.ContentHash != that1.ContentHash {
+		return false
+	}
 	return true
 }
 func (this *TaskDefinition) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 24)
+	s := make([]string, 0, 25)
 	s = append(s, "&models.TaskDefinition{")
 	s = append(s, "RootFs: "+fmt.Sprintf("%#v", this.RootFs)+",\n")
 	if this.EnvironmentVariables != nil {
@@ -545,6 +597,19 @@ func (this *TaskDefinition) GoString() string {
 	if this.PlacementTags != nil {
 		s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
 	}
+	keysForExtensionFields := make([]string, 0, len(this.ExtensionFields))
+	for k, _ := range this.ExtensionFields {
+		keysForExtensionFields = append(keysForExtensionFields, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensionFields)
+	mapStringForExtensionFields := "map[string][]byte{"
+	for _, k := range keysForExtensionFields {
+		mapStringForExtensionFields += fmt.Sprintf("%#v: %#v,", k, this.ExtensionFields[k])
+	}
+	mapStringForExtensionFields += "}"
+	if this.ExtensionFields != nil {
+		s = append(s, "ExtensionFields: "+mapStringForExtensionFields+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -552,7 +617,7 @@ func (this *Task) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 18)
 	s = append(s, "&models.Task{")
 	if this.TaskDefinition != nil {
 		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
@@ -567,6 +632,9 @@ func (this *Task) GoString() string {
 	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
 	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
 	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "RejectionCount: "+fmt.Sprintf("%#v", this.RejectionCount)+",\n")
+	s = append(s, "RejectionReason: "+fmt.Sprintf("%#v", this.RejectionReason)+",\n")
+	s = append(s, "ContentHash: "+fmt.Sprintf("%#v", this.ContentHash)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -757,6 +825,31 @@ func (m *TaskDefinition) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], s)
 		}
 	}
+	if len(m.ExtensionFields) > 0 {
+		for k, _ := range m.ExtensionFields {
+			data[i] = 0xaa
+			i++
+			data[i] = 0x1
+			i++
+			v := m.ExtensionFields[k]
+			byteSize := 0
+			if v != nil {
+				byteSize = 1 + len(v) + sovTask(uint64(len(v)))
+			}
+			mapSize := 1 + len(k) + sovTask(uint64(len(k))) + byteSize
+			i = encodeVarintTask(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintTask(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			if v != nil {
+				data[i] = 0x12
+				i++
+				i = encodeVarintTask(data, i, uint64(len(v)))
+				i += copy(data[i:], v)
+			}
+		}
+	}
 	return i, nil
 }
 
@@ -825,6 +918,17 @@ func (m *Task) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintTask(data, i, uint64(len(m.FailureReason)))
 	i += copy(data[i:], m.FailureReason)
+	data[i] = 0x60
+	i++
+	i = encodeVarintTask(data, i, uint64(m.RejectionCount))
+	data[i] = 0x6a
+	i++
+	i = encodeVarintTask(data, i, uint64(len(m.RejectionReason)))
+	i += copy(data[i:], m.RejectionReason)
+	data[i] = 0x72
+	i++
+	i = encodeVarintTask(data, i, uint64(len(m.ContentHash)))
+	i += copy(data[i:], m.ContentHash)
 	return i, nil
 }
 
@@ -918,6 +1022,18 @@ func (m *TaskDefinition) Size() (n int) {
 			n += 2 + l + sovTask(uint64(l))
 		}
 	}
+	if len(m.ExtensionFields) > 0 {
+		for k, v := range m.ExtensionFields {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = 1 + len(v) + sovTask(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovTask(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovTask(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -943,6 +1059,11 @@ func (m *Task) Size() (n int) {
 	n += 2
 	l = len(m.FailureReason)
 	n += 1 + l + sovTask(uint64(l))
+	n += 1 + sovTask(uint64(m.RejectionCount))
+	l = len(m.RejectionReason)
+	n += 1 + l + sovTask(uint64(l))
+	l = len(m.ContentHash)
+	n += 1 + l + sovTask(uint64(l))
 	return n
 }
 
@@ -963,6 +1084,16 @@ func (this *TaskDefinition) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForExtensionFields := make([]string, 0, len(this.ExtensionFields))
+	for k, _ := range this.ExtensionFields {
+		keysForExtensionFields = append(keysForExtensionFields, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensionFields)
+	mapStringForExtensionFields := "map[string][]byte{"
+	for _, k := range keysForExtensionFields {
+		mapStringForExtensionFields += fmt.Sprintf("%v: %v,", k, this.ExtensionFields[k])
+	}
+	mapStringForExtensionFields += "}"
 	s := strings.Join([]string{`&TaskDefinition{`,
 		`RootFs:` + fmt.Sprintf("%v", this.RootFs) + `,`,
 		`EnvironmentVariables:` + strings.Replace(fmt.Sprintf("%v", this.EnvironmentVariables), "EnvironmentVariable", "EnvironmentVariable", 1) + `,`,
@@ -984,6 +1115,7 @@ func (this *TaskDefinition) String() string {
 		`VolumeMounts:` + strings.Replace(fmt.Sprintf("%v", this.VolumeMounts), "VolumeMount", "VolumeMount", 1) + `,`,
 		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "Network", "Network", 1) + `,`,
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
+		`ExtensionFields:` + mapStringForExtensionFields + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1004,6 +1136,9 @@ func (this *Task) String() string {
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
 		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
 		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`RejectionCount:` + fmt.Sprintf("%v", this.RejectionCount) + `,`,
+		`RejectionReason:` + fmt.Sprintf("%v", this.RejectionReason) + `,`,
+		`ContentHash:` + fmt.Sprintf("%v", this.ContentHash) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1602,6 +1737,123 @@ func (m *TaskDefinition) Unmarshal(data []byte) error {
 			}
 			m.PlacementTags = append(m.PlacementTags, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthTask
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.ExtensionFields == nil {
+				m.ExtensionFields = make(map[string][]byte)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapbyteLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapbyteLen |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intMapbyteLen := int(mapbyteLen)
+				if intMapbyteLen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postbytesIndex := iNdEx + intMapbyteLen
+				if postbytesIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := make([]byte, mapbyteLen)
+				copy(mapvalue, data[iNdEx:postbytesIndex])
+				iNdEx = postbytesIndex
+				m.ExtensionFields[mapkey] = mapvalue
+			} else {
+				var mapvalue []byte
+				m.ExtensionFields[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(data[iNdEx:])
@@ -1926,6 +2178,83 @@ func (m *Task) Unmarshal(data []byte) error {
 			}
 			m.FailureReason = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionCount", wireType)
+			}
+			m.RejectionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RejectionCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectionReason = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(data[iNdEx:])
@@ -2055,71 +2384,81 @@ var (
 func init() { proto.RegisterFile("task.proto", fileDescriptorTask) }
 
 var fileDescriptorTask = []byte{
-	// 1048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x94, 0x4d, 0x4f, 0x23, 0x37,
-	0x1c, 0xc6, 0x19, 0x5e, 0xf2, 0xe2, 0x90, 0x00, 0x06, 0x96, 0x29, 0x2c, 0x93, 0x40, 0xdb, 0xdd,
-	0xb4, 0xdd, 0x66, 0xa5, 0x9c, 0x7b, 0x28, 0x81, 0xee, 0x0a, 0xa9, 0x54, 0x28, 0x2c, 0xdb, 0xbd,
-	0x8d, 0x9c, 0x19, 0x67, 0xb0, 0xf0, 0x8c, 0x47, 0xb6, 0x27, 0x28, 0xea, 0xa5, 0xa7, 0x9e, 0x7b,
-	0xea, 0x67, 0xe8, 0x47, 0xd9, 0x23, 0xc7, 0x9e, 0xa2, 0x92, 0x5e, 0xaa, 0x9c, 0xf6, 0x23, 0x54,
-	0xf6, 0x78, 0xc0, 0x69, 0xa9, 0xf6, 0x94, 0xf8, 0x79, 0x7e, 0xfe, 0xdb, 0x63, 0xfb, 0xff, 0x00,
-	0x20, 0x91, 0xb8, 0xee, 0xa4, 0x9c, 0x49, 0x06, 0x4b, 0x31, 0x0b, 0x31, 0x15, 0xbb, 0x5f, 0x47,
-	0x44, 0x5e, 0x65, 0x83, 0x4e, 0xc0, 0xe2, 0x97, 0x11, 0x8b, 0xd8, 0x4b, 0x6d, 0x0f, 0xb2, 0xa1,
-	0x1e, 0xe9, 0x81, 0xfe, 0x97, 0x4f, 0xdb, 0xad, 0xa3, 0x40, 0x12, 0x96, 0x08, 0x33, 0xdc, 0xc3,
-	0xc9, 0x88, 0x70, 0x96, 0xc4, 0x38, 0x91, 0xfe, 0x08, 0x71, 0x82, 0x06, 0x14, 0x17, 0xe6, 0x96,
-	0xc0, 0x41, 0xc6, 0x89, 0x1c, 0xfb, 0x11, 0x67, 0x59, 0x6a, 0xd4, 0x9d, 0x00, 0x05, 0x57, 0x38,
-	0xf4, 0x43, 0x9c, 0xe2, 0x24, 0xc4, 0x49, 0x30, 0x36, 0x06, 0x1c, 0x31, 0x9a, 0xc5, 0xd8, 0x8f,
-	0x59, 0x96, 0xc8, 0x62, 0xb9, 0x04, 0xcb, 0x1b, 0xc6, 0xcd, 0xa6, 0x0f, 0x7f, 0x01, 0xa0, 0xf1,
-	0x06, 0x89, 0xeb, 0x13, 0x3c, 0x24, 0x09, 0x51, 0x1b, 0x81, 0xcf, 0x41, 0x99, 0x33, 0x26, 0xfd,
-	0xa1, 0x70, 0x9d, 0x96, 0xd3, 0xae, 0xf6, 0x1a, 0xef, 0x27, 0xcd, 0x85, 0xd9, 0xa4, 0x59, 0x52,
-	0xf2, 0x50, 0xf4, 0xf5, 0xef, 0x2b, 0x01, 0x03, 0xb0, 0xfd, 0xe8, 0x66, 0xdd, 0xc5, 0xd6, 0x52,
-	0xbb, 0xd6, 0xdd, 0xeb, 0xe4, 0x07, 0xd2, 0xf9, 0xee, 0x01, 0x7a, 0x6b, 0x98, 0xde, 0xc6, 0x6c,
-	0xd2, 0xac, 0xe3, 0x64, 0xf4, 0x82, 0xc5, 0x44, 0xe2, 0x38, 0x95, 0xe3, 0xfe, 0x16, 0xfe, 0x2f,
-	0x27, 0xe0, 0x33, 0x50, 0xca, 0x0f, 0xc8, 0x5d, 0x6a, 0x39, 0xed, 0x5a, 0xb7, 0x51, 0x54, 0x3d,
-	0xd2, 0x6a, 0xdf, 0xb8, 0x70, 0x1f, 0x94, 0x43, 0x22, 0xae, 0xfd, 0x78, 0xe0, 0x2e, 0xb7, 0x9c,
-	0xf6, 0x4a, 0x6f, 0x59, 0xed, 0xba, 0x5f, 0x52, 0xe2, 0xd9, 0x00, 0x1e, 0x80, 0x6a, 0x8c, 0x63,
-	0xc6, 0xc7, 0x0a, 0x58, 0xb1, 0x80, 0x4a, 0x2e, 0x9f, 0x0d, 0xe0, 0xa7, 0x00, 0x04, 0x69, 0xe6,
-	0xdf, 0x60, 0x12, 0x5d, 0x49, 0xb7, 0xd4, 0x72, 0xda, 0x75, 0xc3, 0x54, 0x83, 0x34, 0xfb, 0x51,
-	0xcb, 0xf0, 0x33, 0x00, 0x52, 0x4e, 0x46, 0x84, 0xe2, 0x08, 0x87, 0x6e, 0xb9, 0xe5, 0xb4, 0x2b,
-	0x06, 0xb2, 0x74, 0x55, 0x8a, 0xb2, 0xc8, 0x17, 0x2c, 0xe3, 0x01, 0x76, 0x2b, 0xfa, 0x14, 0x4d,
-	0x29, 0xca, 0xa2, 0x0b, 0x2d, 0xc3, 0x26, 0xa8, 0x28, 0x28, 0xca, 0x48, 0xe8, 0x56, 0x2d, 0xa4,
-	0x4c, 0x59, 0xf4, 0x3a, 0x23, 0x21, 0x7c, 0x0e, 0x56, 0x63, 0x2c, 0x39, 0x09, 0x44, 0x0e, 0x01,
-	0x0b, 0xaa, 0x19, 0x47, 0x83, 0x9f, 0x83, 0x1a, 0xc7, 0x22, 0xa3, 0xd2, 0x1f, 0x12, 0x8a, 0xdd,
-	0x9a, 0xc5, 0x81, 0xdc, 0x78, 0x45, 0x28, 0x86, 0x08, 0xec, 0x04, 0x2c, 0x4e, 0x29, 0x56, 0x07,
-	0xe6, 0x07, 0x88, 0xd2, 0x01, 0x0a, 0xae, 0xfd, 0x8c, 0x53, 0x77, 0x55, 0x4f, 0xf9, 0xc2, 0x5c,
-	0xf4, 0xc1, 0xff, 0x60, 0xd6, 0x65, 0x6d, 0x3f, 0x20, 0xc7, 0x86, 0xb8, 0xe4, 0x14, 0x7e, 0x03,
-	0x00, 0x4a, 0x12, 0x26, 0x91, 0xbe, 0xb1, 0xba, 0xae, 0xfa, 0xd4, 0x54, 0xdd, 0x7a, 0x70, 0xac,
-	0x42, 0x16, 0x0f, 0xdf, 0x81, 0x55, 0x1c, 0x71, 0x2c, 0x84, 0xcf, 0x33, 0xf5, 0x8e, 0x1a, 0xfa,
-	0x1d, 0x7d, 0x52, 0xdc, 0xf8, 0x85, 0x79, 0xfc, 0xaf, 0xd5, 0xdb, 0xef, 0x67, 0x14, 0xf7, 0x76,
-	0x67, 0x93, 0xe6, 0x13, 0x7b, 0x8a, 0x55, 0xb8, 0x96, 0xeb, 0x8a, 0x13, 0x90, 0x82, 0xcd, 0x7f,
-	0x37, 0x09, 0xc1, 0xc2, 0x5d, 0xd3, 0x0b, 0xb8, 0xc5, 0x02, 0xc7, 0x1a, 0x39, 0xb9, 0x6f, 0xa3,
-	0xde, 0xc1, 0x6c, 0xd2, 0xdc, 0x7f, 0x64, 0xa2, 0xb5, 0x0c, 0x0c, 0xe6, 0x27, 0x11, 0x2c, 0xe0,
-	0x3b, 0xb0, 0x45, 0x71, 0x84, 0x82, 0xb1, 0x1f, 0xb2, 0x9b, 0x84, 0x32, 0x14, 0xfa, 0x99, 0xc0,
-	0xdc, 0x5d, 0xd7, 0xe7, 0xf1, 0xcc, 0x9c, 0x87, 0xf7, 0x18, 0x63, 0x57, 0xce, 0xfd, 0x13, 0x63,
-	0x5f, 0x0a, 0xcc, 0xe1, 0x4f, 0xa0, 0x25, 0x79, 0x26, 0x24, 0x0e, 0x7d, 0x31, 0x16, 0x12, 0xc7,
-	0x7e, 0x80, 0xb9, 0x24, 0x43, 0x12, 0x20, 0x89, 0x85, 0x9f, 0x22, 0x79, 0xe5, 0x6e, 0xe8, 0x55,
-	0xba, 0x66, 0x95, 0x2f, 0x3f, 0xc6, 0x5b, 0x2b, 0xee, 0x1b, 0xf6, 0x42, 0xa3, 0xc7, 0x16, 0x79,
-	0x8e, 0xe4, 0x15, 0xbc, 0x04, 0x75, 0x3b, 0x50, 0x84, 0x0b, 0xf5, 0xf1, 0x6d, 0x16, 0xc7, 0xf7,
-	0x56, 0x9b, 0x67, 0xca, 0xeb, 0xed, 0xcd, 0x26, 0xcd, 0x9d, 0x39, 0xda, 0x5a, 0x67, 0x75, 0xf4,
-	0x40, 0x0a, 0xf8, 0x2d, 0x28, 0x9b, 0x4c, 0x72, 0x37, 0x75, 0x8b, 0xaf, 0x15, 0x05, 0x7f, 0xc8,
-	0xe5, 0xde, 0xf6, 0x6c, 0xd2, 0xdc, 0x30, 0x8c, 0x55, 0xa6, 0x98, 0x06, 0x7b, 0xa0, 0x7e, 0x4e,
-	0x51, 0x80, 0x55, 0x72, 0xbc, 0x41, 0x91, 0x70, 0xb7, 0x5a, 0x4b, 0xea, 0xe1, 0xcd, 0x26, 0x4d,
-	0x37, 0x2d, 0x0c, 0x5f, 0xa2, 0xc8, 0xde, 0x44, 0x3d, 0xb5, 0xa7, 0x1c, 0xfe, 0xb6, 0x0c, 0x96,
-	0x55, 0x10, 0xc2, 0x53, 0xb0, 0xa6, 0x42, 0xdd, 0x0f, 0xef, 0x13, 0x51, 0xc7, 0x60, 0xad, 0xfb,
-	0xa4, 0xd8, 0xd6, 0x7c, 0x5e, 0xf6, 0x2a, 0xb7, 0x93, 0xa6, 0x33, 0x53, 0xcd, 0xd6, 0x90, 0xf3,
-	0x49, 0x7a, 0x00, 0xaa, 0xba, 0x94, 0xee, 0xde, 0x45, 0xab, 0x2b, 0x2b, 0x4a, 0xd6, 0xad, 0xfb,
-	0x14, 0x94, 0x42, 0x16, 0x23, 0x92, 0xc7, 0x5b, 0xf5, 0x3e, 0xb5, 0xb4, 0xa6, 0x23, 0x89, 0x63,
-	0xa4, 0xae, 0x0f, 0x49, 0x9d, 0x6b, 0x4b, 0xf7, 0x91, 0x94, 0xeb, 0x47, 0x52, 0x41, 0x59, 0x1a,
-	0x16, 0xd0, 0x8a, 0x0d, 0x19, 0xfd, 0x48, 0xc2, 0x2e, 0x80, 0x43, 0xc2, 0x85, 0xf4, 0x4d, 0xdf,
-	0xe6, 0x70, 0xc9, 0x82, 0xd7, 0xb5, 0x7f, 0x5c, 0xd8, 0x47, 0x12, 0x76, 0xc0, 0x8a, 0x90, 0x48,
-	0x62, 0x1d, 0x73, 0x8d, 0x2e, 0xb4, 0xbf, 0xbf, 0x73, 0xa1, 0x1c, 0x33, 0x35, 0xc7, 0x54, 0x04,
-	0x07, 0x98, 0x52, 0x9f, 0x84, 0x73, 0x91, 0x57, 0x52, 0xe2, 0xa9, 0xfe, 0xd4, 0x3c, 0x8c, 0xe6,
-	0xd2, 0xce, 0x68, 0xca, 0x1d, 0x22, 0x42, 0x71, 0x1e, 0x73, 0x45, 0xa8, 0x1a, 0x0d, 0x7e, 0x05,
-	0x1a, 0xea, 0x5f, 0xc6, 0xb1, 0xcf, 0x31, 0x12, 0x2c, 0x99, 0x0b, 0xb9, 0xba, 0xf1, 0xfa, 0xda,
-	0x3a, 0xfc, 0x1e, 0xac, 0xe8, 0xdd, 0xc1, 0x1a, 0x28, 0x9f, 0x26, 0x23, 0x44, 0x49, 0xb8, 0xbe,
-	0xa0, 0x06, 0xe7, 0x38, 0x09, 0x49, 0x12, 0xad, 0x3b, 0x6a, 0xd0, 0xcf, 0x92, 0x44, 0x0d, 0x16,
-	0x61, 0x1d, 0x54, 0xef, 0x3f, 0x7b, 0x7d, 0x49, 0x0d, 0xfb, 0x58, 0x30, 0x3a, 0x52, 0xee, 0x72,
-	0xef, 0xc5, 0xed, 0x9d, 0xe7, 0xfc, 0x71, 0xe7, 0x2d, 0x7c, 0xb8, 0xf3, 0x9c, 0x9f, 0xa7, 0x9e,
-	0xf3, 0xfb, 0xd4, 0x73, 0xde, 0x4f, 0x3d, 0xe7, 0x76, 0xea, 0x39, 0x7f, 0x4e, 0x3d, 0xe7, 0xef,
-	0xa9, 0xb7, 0xf0, 0x61, 0xea, 0x39, 0xbf, 0xfe, 0xe5, 0x2d, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x2f, 0x57, 0xad, 0x7e, 0x11, 0x08, 0x00, 0x00,
+	// 1206 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x72, 0xdb, 0xb6,
+	0x1a, 0x35, 0x6d, 0x4b, 0xb6, 0x21, 0x4b, 0x56, 0x10, 0xdb, 0x61, 0x94, 0x84, 0x92, 0x7d, 0xef,
+	0x4d, 0x74, 0x9b, 0x54, 0x99, 0xf1, 0xaa, 0xd3, 0xe9, 0xa2, 0x96, 0xf3, 0xd3, 0x74, 0xea, 0x4c,
+	0x46, 0x4e, 0xd2, 0xec, 0x38, 0x10, 0xf9, 0x89, 0x42, 0x0d, 0x12, 0x1a, 0x02, 0x54, 0xaa, 0xe9,
+	0xa6, 0x8f, 0xd0, 0xc7, 0xe8, 0xa3, 0x64, 0xd5, 0xc9, 0xb2, 0x2b, 0x4d, 0xa3, 0x6e, 0x3a, 0x5a,
+	0x65, 0xd1, 0x07, 0xe8, 0x00, 0x04, 0x65, 0xc8, 0x75, 0xa7, 0x2b, 0x09, 0xe7, 0x1c, 0x9c, 0xef,
+	0xe3, 0x47, 0xe0, 0x10, 0x21, 0x49, 0xc4, 0x79, 0x67, 0x94, 0x72, 0xc9, 0x71, 0x39, 0xe6, 0x21,
+	0x30, 0xd1, 0xf8, 0x34, 0xa2, 0x72, 0x98, 0xf5, 0x3b, 0x01, 0x8f, 0x1f, 0x46, 0x3c, 0xe2, 0x0f,
+	0x35, 0xdd, 0xcf, 0x06, 0x7a, 0xa5, 0x17, 0xfa, 0x5f, 0xbe, 0xad, 0x51, 0x25, 0x81, 0xa4, 0x3c,
+	0x11, 0x66, 0x79, 0x0b, 0x92, 0x31, 0x4d, 0x79, 0x12, 0x43, 0x22, 0xfd, 0x31, 0x49, 0x29, 0xe9,
+	0x33, 0x28, 0xc8, 0x5d, 0x01, 0x41, 0x96, 0x52, 0x39, 0xf1, 0xa3, 0x94, 0x67, 0x23, 0x83, 0xde,
+	0x08, 0x48, 0x30, 0x84, 0xd0, 0x0f, 0x61, 0x04, 0x49, 0x08, 0x49, 0x30, 0x31, 0x04, 0x1e, 0x73,
+	0x96, 0xc5, 0xe0, 0xc7, 0x3c, 0x4b, 0x64, 0x51, 0x2e, 0x01, 0xf9, 0x96, 0xa7, 0xa6, 0xe9, 0xc3,
+	0x5f, 0x2a, 0xa8, 0xf6, 0x92, 0x88, 0xf3, 0x47, 0x30, 0xa0, 0x09, 0x55, 0x8d, 0xe0, 0x7b, 0x68,
+	0x23, 0xe5, 0x5c, 0xfa, 0x03, 0xe1, 0x3a, 0x2d, 0xa7, 0xbd, 0xd5, 0xad, 0xbd, 0x9b, 0x36, 0x57,
+	0xe6, 0xd3, 0x66, 0x59, 0xc1, 0x03, 0xd1, 0xd3, 0xbf, 0x4f, 0x04, 0x0e, 0xd0, 0xde, 0x95, 0xcd,
+	0xba, 0xab, 0xad, 0xb5, 0x76, 0xe5, 0xe8, 0x56, 0x27, 0x1f, 0x48, 0xe7, 0xf1, 0x85, 0xe8, 0xb5,
+	0xd1, 0x74, 0xaf, 0xcd, 0xa7, 0xcd, 0x2a, 0x24, 0xe3, 0x07, 0x3c, 0xa6, 0x12, 0xe2, 0x91, 0x9c,
+	0xf4, 0x76, 0xe1, 0xef, 0x3a, 0x81, 0xef, 0xa2, 0x72, 0x3e, 0x20, 0x77, 0xad, 0xe5, 0xb4, 0x2b,
+	0x47, 0xb5, 0xc2, 0xf5, 0x58, 0xa3, 0x3d, 0xc3, 0xe2, 0x3b, 0x68, 0x23, 0xa4, 0xe2, 0xdc, 0x8f,
+	0xfb, 0xee, 0x7a, 0xcb, 0x69, 0x97, 0xba, 0xeb, 0xaa, 0xeb, 0x5e, 0x59, 0x81, 0xa7, 0x7d, 0x7c,
+	0x80, 0xb6, 0x62, 0x88, 0x79, 0x3a, 0x51, 0x82, 0x92, 0x25, 0xd8, 0xcc, 0xe1, 0xd3, 0x3e, 0xfe,
+	0x0f, 0x42, 0xc1, 0x28, 0xf3, 0xdf, 0x02, 0x8d, 0x86, 0xd2, 0x2d, 0xb7, 0x9c, 0x76, 0xd5, 0x68,
+	0xb6, 0x82, 0x51, 0xf6, 0xad, 0x86, 0xf1, 0x7f, 0x11, 0x1a, 0xa5, 0x74, 0x4c, 0x19, 0x44, 0x10,
+	0xba, 0x1b, 0x2d, 0xa7, 0xbd, 0x69, 0x44, 0x16, 0xae, 0xac, 0x18, 0x8f, 0x7c, 0xc1, 0xb3, 0x34,
+	0x00, 0x77, 0x53, 0x4f, 0xd1, 0x58, 0x31, 0x1e, 0x9d, 0x69, 0x18, 0x37, 0xd1, 0xa6, 0x12, 0x45,
+	0x19, 0x0d, 0xdd, 0x2d, 0x4b, 0xb2, 0xc1, 0x78, 0xf4, 0x34, 0xa3, 0x21, 0xbe, 0x87, 0xb6, 0x63,
+	0x90, 0x29, 0x0d, 0x44, 0x2e, 0x42, 0x96, 0xa8, 0x62, 0x18, 0x2d, 0xfc, 0x1f, 0xaa, 0xa4, 0x20,
+	0x32, 0x26, 0xfd, 0x01, 0x65, 0xe0, 0x56, 0x2c, 0x1d, 0xca, 0x89, 0x27, 0x94, 0x01, 0x26, 0xe8,
+	0x46, 0xc0, 0xe3, 0x11, 0x03, 0x35, 0x30, 0x3f, 0x20, 0x8c, 0xf5, 0x49, 0x70, 0xee, 0x67, 0x29,
+	0x73, 0xb7, 0xf5, 0x96, 0xff, 0x9b, 0x17, 0x7d, 0xf0, 0x0f, 0x32, 0xeb, 0x65, 0xed, 0x5d, 0x48,
+	0x4e, 0x8c, 0xe2, 0x55, 0xca, 0xf0, 0x17, 0x08, 0x91, 0x24, 0xe1, 0x92, 0xe8, 0x37, 0x56, 0xd5,
+	0xae, 0xb7, 0x8d, 0xeb, 0xee, 0x05, 0x63, 0x19, 0x59, 0x7a, 0xfc, 0x06, 0x6d, 0x43, 0x94, 0x82,
+	0x10, 0x7e, 0x9a, 0xa9, 0x73, 0x54, 0xd3, 0xe7, 0xe8, 0x66, 0xf1, 0xc6, 0xcf, 0xcc, 0xe1, 0x7f,
+	0xaa, 0xce, 0x7e, 0x2f, 0x63, 0xd0, 0x6d, 0xcc, 0xa7, 0xcd, 0x7d, 0x7b, 0x8b, 0x65, 0x5c, 0xc9,
+	0x71, 0xa5, 0x13, 0x98, 0xa1, 0xeb, 0x97, 0x2f, 0x09, 0x05, 0xe1, 0xee, 0xe8, 0x02, 0x6e, 0x51,
+	0xe0, 0x44, 0x4b, 0x1e, 0x2d, 0xae, 0x51, 0xf7, 0x60, 0x3e, 0x6d, 0xde, 0xb9, 0x62, 0xa3, 0x55,
+	0x06, 0x07, 0xcb, 0x9b, 0x28, 0x08, 0xfc, 0x06, 0xed, 0x32, 0x88, 0x48, 0x30, 0xf1, 0x43, 0xfe,
+	0x36, 0x61, 0x9c, 0x84, 0x7e, 0x26, 0x20, 0x75, 0xeb, 0x7a, 0x1e, 0x77, 0xcd, 0x3c, 0xbc, 0xab,
+	0x34, 0xb6, 0x73, 0xce, 0x3f, 0x32, 0xf4, 0x2b, 0x01, 0x29, 0xfe, 0x01, 0xb5, 0x64, 0x9a, 0x09,
+	0x09, 0xa1, 0x2f, 0x26, 0x42, 0x42, 0xec, 0x07, 0x90, 0x4a, 0x3a, 0xa0, 0x01, 0x91, 0x20, 0xfc,
+	0x11, 0x91, 0x43, 0xf7, 0x9a, 0xae, 0x72, 0x64, 0xaa, 0x7c, 0xf2, 0x6f, 0x7a, 0xab, 0xe2, 0x1d,
+	0xa3, 0x3d, 0xd3, 0xd2, 0x13, 0x4b, 0xf9, 0x82, 0xc8, 0x21, 0x7e, 0x85, 0xaa, 0x76, 0xa0, 0x08,
+	0x17, 0xeb, 0xf1, 0x5d, 0x2f, 0xc6, 0xf7, 0x5a, 0x93, 0xa7, 0x8a, 0xeb, 0xde, 0x9a, 0x4f, 0x9b,
+	0x37, 0x96, 0xd4, 0x56, 0x9d, 0xed, 0xf1, 0x85, 0x52, 0xe0, 0x2f, 0xd1, 0x86, 0xc9, 0x24, 0xf7,
+	0xba, 0xbe, 0xe2, 0x3b, 0x85, 0xe1, 0xf3, 0x1c, 0xee, 0xee, 0xcd, 0xa7, 0xcd, 0x6b, 0x46, 0x63,
+	0xd9, 0x14, 0xdb, 0x70, 0x17, 0x55, 0x5f, 0x30, 0x12, 0x80, 0x4a, 0x8e, 0x97, 0x24, 0x12, 0xee,
+	0x6e, 0x6b, 0x4d, 0x1d, 0xbc, 0xf9, 0xb4, 0xe9, 0x8e, 0x0a, 0xc2, 0x97, 0x24, 0xb2, 0x9b, 0x58,
+	0xde, 0x82, 0xc7, 0xa8, 0x0e, 0xdf, 0x4b, 0x48, 0x84, 0x3a, 0xf4, 0x03, 0x0a, 0x2c, 0x14, 0xee,
+	0x9e, 0x7e, 0xbe, 0xfb, 0x45, 0x3b, 0xcb, 0x39, 0xd9, 0x79, 0x5c, 0xc8, 0x9f, 0x68, 0xf5, 0xe3,
+	0x44, 0xa6, 0x93, 0xae, 0x37, 0x9f, 0x36, 0x1b, 0x97, 0x8d, 0xac, 0xaa, 0x3b, 0xb0, 0xbc, 0xab,
+	0xf1, 0x1c, 0xed, 0x5e, 0x65, 0x84, 0xf7, 0xd1, 0xda, 0x39, 0x4c, 0x5c, 0xc7, 0xba, 0xcb, 0x0a,
+	0xc0, 0x0d, 0x54, 0x1a, 0x13, 0x96, 0x81, 0xbb, 0xda, 0x72, 0xda, 0xdb, 0x86, 0xc9, 0xa1, 0xcf,
+	0x57, 0x3f, 0x73, 0x0e, 0xff, 0x2c, 0xa1, 0x75, 0xd5, 0x28, 0x7e, 0x86, 0x76, 0xd4, 0xc7, 0xc9,
+	0x0f, 0x17, 0x1d, 0x6b, 0xb3, 0xca, 0xd1, 0xfe, 0xd5, 0xcf, 0xd3, 0xdd, 0x7c, 0x3f, 0x6d, 0x3a,
+	0x73, 0x65, 0x57, 0x93, 0x4b, 0x8c, 0x0a, 0x4f, 0x6d, 0xa5, 0x53, 0x68, 0xd5, 0xea, 0x68, 0x53,
+	0xc1, 0x3a, 0x82, 0x6e, 0xa3, 0x72, 0xc8, 0x63, 0x42, 0xf3, 0x98, 0xde, 0x5a, 0xa4, 0xaf, 0xc6,
+	0x74, 0xb4, 0xa6, 0x40, 0xd4, 0x31, 0x24, 0x52, 0xe7, 0xf3, 0xda, 0x22, 0x5a, 0x73, 0xfc, 0x58,
+	0x2a, 0x51, 0x36, 0x0a, 0x0b, 0x51, 0xc9, 0x16, 0x19, 0xfc, 0x58, 0xe2, 0x23, 0x84, 0x07, 0x34,
+	0x15, 0xd2, 0x37, 0xf9, 0x93, 0x8b, 0xcb, 0x96, 0xb8, 0xae, 0xf9, 0x93, 0x82, 0x3e, 0x96, 0xb8,
+	0x83, 0x4a, 0x42, 0x12, 0x09, 0x3a, 0xae, 0x6b, 0x47, 0xd8, 0x7e, 0xfe, 0xce, 0x99, 0x62, 0x8a,
+	0x31, 0x6a, 0x99, 0xfa, 0x94, 0x04, 0xc0, 0x98, 0x4f, 0xc3, 0xa5, 0xe8, 0x2e, 0x2b, 0xf0, 0x99,
+	0x7e, 0xd4, 0x3c, 0x54, 0x97, 0x52, 0xdb, 0x60, 0x8a, 0x1d, 0x10, 0xca, 0x20, 0x8f, 0xeb, 0xe2,
+	0xe3, 0x60, 0x30, 0x7c, 0x1f, 0xd5, 0xd4, 0xbf, 0x2c, 0x05, 0x3f, 0x05, 0x22, 0x78, 0xb2, 0x14,
+	0xd6, 0x55, 0xc3, 0xf5, 0x34, 0x85, 0xbf, 0x46, 0x3b, 0x29, 0x7c, 0x07, 0x41, 0x9e, 0xc3, 0xea,
+	0xb2, 0xe8, 0x9c, 0x2e, 0x75, 0x0f, 0xcc, 0xdd, 0xbe, 0x79, 0x89, 0xb6, 0xce, 0x59, 0x6d, 0x41,
+	0x9d, 0x28, 0x06, 0x9f, 0xa2, 0xfa, 0x85, 0xd8, 0x94, 0xce, 0xe3, 0xf9, 0xd0, 0x98, 0x35, 0x2e,
+	0xf3, 0xf6, 0xa9, 0x5d, 0x70, 0xa6, 0xb5, 0x63, 0xb4, 0x1d, 0xf0, 0x44, 0xaa, 0x6b, 0x35, 0x24,
+	0x62, 0xe8, 0xd6, 0xb4, 0x95, 0x67, 0xac, 0xf6, 0x6d, 0xce, 0x8e, 0x64, 0x83, 0x7f, 0x45, 0xc4,
+	0xf0, 0xf0, 0x1b, 0x54, 0xd2, 0xb3, 0xc7, 0x15, 0xb4, 0xf1, 0x2c, 0x19, 0x13, 0x46, 0xc3, 0xfa,
+	0x8a, 0x5a, 0xbc, 0x80, 0x24, 0xa4, 0x49, 0x54, 0x77, 0xd4, 0xa2, 0x97, 0x25, 0x89, 0x5a, 0xac,
+	0xe2, 0x2a, 0xda, 0x5a, 0xbc, 0xd4, 0xfa, 0x9a, 0x5a, 0xf6, 0x40, 0x70, 0x36, 0x56, 0xec, 0x7a,
+	0xf7, 0xc1, 0xfb, 0x0f, 0x9e, 0xf3, 0xeb, 0x07, 0x6f, 0xe5, 0xe3, 0x07, 0xcf, 0xf9, 0x71, 0xe6,
+	0x39, 0x3f, 0xcf, 0x3c, 0xe7, 0xdd, 0xcc, 0x73, 0xde, 0xcf, 0x3c, 0xe7, 0xb7, 0x99, 0xe7, 0xfc,
+	0x31, 0xf3, 0x56, 0x3e, 0xce, 0x3c, 0xe7, 0xa7, 0xdf, 0xbd, 0x95, 0xbf, 0x06, 0x00, 0x67, 0x48,
+	0x2e, 0x59, 0xb7, 0x09, 0x00, 0x00,
 }
@@ -17,6 +17,10 @@ func (req *DesireTaskRequest) Validate() error {
 		validationError = validationError.Append(defErr)
 	}
 
+	if !validIdempotencyKey(req.IdempotencyKey) {
+		validationError = validationError.Append(ErrInvalidField{"idempotency_key"})
+	}
+
 	if !validationError.Empty() {
 		return validationError
 	}
@@ -58,6 +62,23 @@ func (req *CompleteTaskRequest) Validate() error {
 	return nil
 }
 
+func (req *RejectTaskRequest) Validate() error {
+	var validationError ValidationError
+
+	if !taskGuidPattern.MatchString(req.TaskGuid) {
+		validationError = validationError.Append(ErrInvalidField{"task_guid"})
+	}
+	if req.RejectionReason == "" {
+		validationError = validationError.Append(ErrInvalidField{"rejection_reason"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
 func (req *FailTaskRequest) Validate() error {
 	var validationError ValidationError
 
@@ -75,10 +96,69 @@ func (req *FailTaskRequest) Validate() error {
 	return nil
 }
 
+func (req *CancelTasksRequest) Validate() error {
+	var validationError ValidationError
+
+	if req.Domain == "" && len(req.TaskGuids) == 0 {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+		validationError = validationError.Append(ErrInvalidField{"task_guids"})
+	}
+
+	for _, guid := range req.TaskGuids {
+		if !taskGuidPattern.MatchString(guid) {
+			validationError = validationError.Append(ErrInvalidField{"task_guids"})
+			break
+		}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (req *DuplicateTasksRequest) Validate() error {
+	var validationError ValidationError
+
+	if req.Domain == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
 func (req *TasksRequest) Validate() error {
+	var validationError ValidationError
+
+	for _, state := range req.States {
+		if value, ok := Task_State_value[state]; !ok || Task_State(value) == Task_Invalid {
+			validationError = validationError.Append(ErrInvalidField{"states"})
+			break
+		}
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
 	return nil
 }
 
+// Filter returns the TaskFilter of a validated request. The states are sent
+// by name, as in the JSON of a Task.
+func (req *TasksRequest) Filter() TaskFilter {
+	filter := TaskFilter{Domain: req.Domain, CellID: req.CellId, PartialResults: req.PartialResults}
+	for _, state := range req.States {
+		filter.States = append(filter.States, Task_State(Task_State_value[state]))
+	}
+	return filter
+}
+
 func (request *TaskByGuidRequest) Validate() error {
 	var validationError ValidationError
 
@@ -110,3 +190,17 @@ func (request *TaskGuidRequest) Validate() error {
 func (request *ConvergeTasksRequest) Validate() error {
 	return nil
 }
+
+func (request *PurgeCompletedTasksRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.MinAgeInSeconds < 0 {
+		validationError = validationError.Append(ErrInvalidField{"min_age_in_seconds"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -43,6 +43,7 @@ type DesireTaskRequest struct {
 	TaskDefinition *TaskDefinition `protobuf:"bytes,1,opt,name=task_definition,json=taskDefinition" json:"task_definition"`
 	TaskGuid       string          `protobuf:"bytes,2,opt,name=task_guid,json=taskGuid" json:"task_guid"`
 	Domain         string          `protobuf:"bytes,3,opt,name=domain" json:"domain"`
+	IdempotencyKey string          `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey" json:"idempotency_key,omitempty"`
 }
 
 func (m *DesireTaskRequest) Reset()                    { *m = DesireTaskRequest{} }
@@ -70,6 +71,13 @@ func (m *DesireTaskRequest) GetDomain() string {
 	return ""
 }
 
+func (m *DesireTaskRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 type StartTaskRequest struct {
 	TaskGuid string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid" json:"task_guid"`
 	CellId   string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
@@ -139,13 +147,36 @@ func (m *FailTaskRequest) GetFailureReason() string {
 	return ""
 }
 
+type RejectTaskRequest struct {
+	TaskGuid        string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid" json:"task_guid"`
+	RejectionReason string `protobuf:"bytes,2,opt,name=rejection_reason,json=rejectionReason" json:"rejection_reason"`
+}
+
+func (m *RejectTaskRequest) Reset()                    { *m = RejectTaskRequest{} }
+func (*RejectTaskRequest) ProtoMessage()               {}
+func (*RejectTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{5} }
+
+func (m *RejectTaskRequest) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+func (m *RejectTaskRequest) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
 type TaskGuidRequest struct {
 	TaskGuid string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid" json:"task_guid"`
 }
 
 func (m *TaskGuidRequest) Reset()                    { *m = TaskGuidRequest{} }
 func (*TaskGuidRequest) ProtoMessage()               {}
-func (*TaskGuidRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{5} }
+func (*TaskGuidRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{6} }
 
 func (m *TaskGuidRequest) GetTaskGuid() string {
 	if m != nil {
@@ -154,6 +185,133 @@ func (m *TaskGuidRequest) GetTaskGuid() string {
 	return ""
 }
 
+type CancelTasksRequest struct {
+	Domain    string   `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	TaskGuids []string `protobuf:"bytes,2,rep,name=task_guids,json=taskGuids" json:"task_guids,omitempty"`
+}
+
+func (m *CancelTasksRequest) Reset()                    { *m = CancelTasksRequest{} }
+func (*CancelTasksRequest) ProtoMessage()               {}
+func (*CancelTasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{7} }
+
+func (m *CancelTasksRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *CancelTasksRequest) GetTaskGuids() []string {
+	if m != nil {
+		return m.TaskGuids
+	}
+	return nil
+}
+
+type CancelTasksResponse struct {
+	Error                   *Error   `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	CancelledTaskGuids      []string `protobuf:"bytes,2,rep,name=cancelled_task_guids,json=cancelledTaskGuids" json:"cancelled_task_guids,omitempty"`
+	NotCancellableTaskGuids []string `protobuf:"bytes,3,rep,name=not_cancellable_task_guids,json=notCancellableTaskGuids" json:"not_cancellable_task_guids,omitempty"`
+	NotFoundTaskGuids       []string `protobuf:"bytes,4,rep,name=not_found_task_guids,json=notFoundTaskGuids" json:"not_found_task_guids,omitempty"`
+}
+
+func (m *CancelTasksResponse) Reset()                    { *m = CancelTasksResponse{} }
+func (*CancelTasksResponse) ProtoMessage()               {}
+func (*CancelTasksResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{8} }
+
+func (m *CancelTasksResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *CancelTasksResponse) GetCancelledTaskGuids() []string {
+	if m != nil {
+		return m.CancelledTaskGuids
+	}
+	return nil
+}
+
+func (m *CancelTasksResponse) GetNotCancellableTaskGuids() []string {
+	if m != nil {
+		return m.NotCancellableTaskGuids
+	}
+	return nil
+}
+
+func (m *CancelTasksResponse) GetNotFoundTaskGuids() []string {
+	if m != nil {
+		return m.NotFoundTaskGuids
+	}
+	return nil
+}
+
+type DuplicateTasksRequest struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+}
+
+func (m *DuplicateTasksRequest) Reset()      { *m = DuplicateTasksRequest{} }
+func (*DuplicateTasksRequest) ProtoMessage() {}
+func (*DuplicateTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorTaskRequests, []int{9}
+}
+
+func (m *DuplicateTasksRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type DuplicateTasks struct {
+	ContentHash string   `protobuf:"bytes,1,opt,name=content_hash,json=contentHash" json:"content_hash"`
+	TaskGuids   []string `protobuf:"bytes,2,rep,name=task_guids,json=taskGuids" json:"task_guids,omitempty"`
+}
+
+func (m *DuplicateTasks) Reset()                    { *m = DuplicateTasks{} }
+func (*DuplicateTasks) ProtoMessage()               {}
+func (*DuplicateTasks) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{10} }
+
+func (m *DuplicateTasks) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *DuplicateTasks) GetTaskGuids() []string {
+	if m != nil {
+		return m.TaskGuids
+	}
+	return nil
+}
+
+type DuplicateTasksResponse struct {
+	Error      *Error            `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Duplicates []*DuplicateTasks `protobuf:"bytes,2,rep,name=duplicates" json:"duplicates,omitempty"`
+}
+
+func (m *DuplicateTasksResponse) Reset()      { *m = DuplicateTasksResponse{} }
+func (*DuplicateTasksResponse) ProtoMessage() {}
+func (*DuplicateTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTaskRequests, []int{11}
+}
+
+func (m *DuplicateTasksResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DuplicateTasksResponse) GetDuplicates() []*DuplicateTasks {
+	if m != nil {
+		return m.Duplicates
+	}
+	return nil
+}
+
 type CompleteTaskRequest struct {
 	TaskGuid      string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid" json:"task_guid"`
 	CellId        string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
@@ -162,9 +320,11 @@ type CompleteTaskRequest struct {
 	Result        string `protobuf:"bytes,5,opt,name=result" json:"result"`
 }
 
-func (m *CompleteTaskRequest) Reset()                    { *m = CompleteTaskRequest{} }
-func (*CompleteTaskRequest) ProtoMessage()               {}
-func (*CompleteTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{6} }
+func (m *CompleteTaskRequest) Reset()      { *m = CompleteTaskRequest{} }
+func (*CompleteTaskRequest) ProtoMessage() {}
+func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorTaskRequests, []int{12}
+}
 
 func (m *CompleteTaskRequest) GetTaskGuid() string {
 	if m != nil {
@@ -212,7 +372,7 @@ type TaskCallbackResponse struct {
 
 func (m *TaskCallbackResponse) Reset()                    { *m = TaskCallbackResponse{} }
 func (*TaskCallbackResponse) ProtoMessage()               {}
-func (*TaskCallbackResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{7} }
+func (*TaskCallbackResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{13} }
 
 func (m *TaskCallbackResponse) GetTaskGuid() string {
 	if m != nil {
@@ -264,7 +424,7 @@ type ConvergeTasksRequest struct {
 
 func (m *ConvergeTasksRequest) Reset()                    { *m = ConvergeTasksRequest{} }
 func (*ConvergeTasksRequest) ProtoMessage()               {}
-func (*ConvergeTasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{8} }
+func (*ConvergeTasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{14} }
 
 func (m *ConvergeTasksRequest) GetKickTaskDuration() int64 {
 	if m != nil {
@@ -294,7 +454,7 @@ type ConvergeTasksResponse struct {
 func (m *ConvergeTasksResponse) Reset()      { *m = ConvergeTasksResponse{} }
 func (*ConvergeTasksResponse) ProtoMessage() {}
 func (*ConvergeTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorTaskRequests, []int{9}
+	return fileDescriptorTaskRequests, []int{15}
 }
 
 func (m *ConvergeTasksResponse) GetError() *Error {
@@ -304,14 +464,58 @@ func (m *ConvergeTasksResponse) GetError() *Error {
 	return nil
 }
 
+type PurgeCompletedTasksRequest struct {
+	MinAgeInSeconds int64 `protobuf:"varint,1,opt,name=min_age_in_seconds,json=minAgeInSeconds" json:"min_age_in_seconds"`
+}
+
+func (m *PurgeCompletedTasksRequest) Reset()      { *m = PurgeCompletedTasksRequest{} }
+func (*PurgeCompletedTasksRequest) ProtoMessage() {}
+func (*PurgeCompletedTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorTaskRequests, []int{16}
+}
+
+func (m *PurgeCompletedTasksRequest) GetMinAgeInSeconds() int64 {
+	if m != nil {
+		return m.MinAgeInSeconds
+	}
+	return 0
+}
+
+type PurgeCompletedTasksResponse struct {
+	Error       *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	PurgedCount int32  `protobuf:"varint,2,opt,name=purged_count,json=purgedCount" json:"purged_count"`
+}
+
+func (m *PurgeCompletedTasksResponse) Reset()      { *m = PurgeCompletedTasksResponse{} }
+func (*PurgeCompletedTasksResponse) ProtoMessage() {}
+func (*PurgeCompletedTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTaskRequests, []int{17}
+}
+
+func (m *PurgeCompletedTasksResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *PurgeCompletedTasksResponse) GetPurgedCount() int32 {
+	if m != nil {
+		return m.PurgedCount
+	}
+	return 0
+}
+
 type TasksRequest struct {
-	Domain string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
-	CellId string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
+	Domain         string   `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	CellId         string   `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
+	States         []string `protobuf:"bytes,3,rep,name=states" json:"states,omitempty"`
+	PartialResults bool     `protobuf:"varint,4,opt,name=partial_results,json=partialResults" json:"partial_results,omitempty"`
 }
 
 func (m *TasksRequest) Reset()                    { *m = TasksRequest{} }
 func (*TasksRequest) ProtoMessage()               {}
-func (*TasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{10} }
+func (*TasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{18} }
 
 func (m *TasksRequest) GetDomain() string {
 	if m != nil {
@@ -327,14 +531,29 @@ func (m *TasksRequest) GetCellId() string {
 	return ""
 }
 
+func (m *TasksRequest) GetStates() []string {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *TasksRequest) GetPartialResults() bool {
+	if m != nil {
+		return m.PartialResults
+	}
+	return false
+}
+
 type TasksResponse struct {
-	Error *Error  `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-	Tasks []*Task `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+	Error        *Error         `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Tasks        []*Task        `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+	RecordErrors []*RecordError `protobuf:"bytes,3,rep,name=record_errors,json=recordErrors" json:"record_errors,omitempty"`
 }
 
 func (m *TasksResponse) Reset()                    { *m = TasksResponse{} }
 func (*TasksResponse) ProtoMessage()               {}
-func (*TasksResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{11} }
+func (*TasksResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{19} }
 
 func (m *TasksResponse) GetError() *Error {
 	if m != nil {
@@ -350,13 +569,20 @@ func (m *TasksResponse) GetTasks() []*Task {
 	return nil
 }
 
+func (m *TasksResponse) GetRecordErrors() []*RecordError {
+	if m != nil {
+		return m.RecordErrors
+	}
+	return nil
+}
+
 type TaskByGuidRequest struct {
 	TaskGuid string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid" json:"task_guid"`
 }
 
 func (m *TaskByGuidRequest) Reset()                    { *m = TaskByGuidRequest{} }
 func (*TaskByGuidRequest) ProtoMessage()               {}
-func (*TaskByGuidRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{12} }
+func (*TaskByGuidRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{20} }
 
 func (m *TaskByGuidRequest) GetTaskGuid() string {
 	if m != nil {
@@ -372,7 +598,7 @@ type TaskResponse struct {
 
 func (m *TaskResponse) Reset()                    { *m = TaskResponse{} }
 func (*TaskResponse) ProtoMessage()               {}
-func (*TaskResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{13} }
+func (*TaskResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{21} }
 
 func (m *TaskResponse) GetError() *Error {
 	if m != nil {
@@ -394,11 +620,19 @@ func init() {
 	proto.RegisterType((*StartTaskRequest)(nil), "models.StartTaskRequest")
 	proto.RegisterType((*StartTaskResponse)(nil), "models.StartTaskResponse")
 	proto.RegisterType((*FailTaskRequest)(nil), "models.FailTaskRequest")
+	proto.RegisterType((*RejectTaskRequest)(nil), "models.RejectTaskRequest")
 	proto.RegisterType((*TaskGuidRequest)(nil), "models.TaskGuidRequest")
+	proto.RegisterType((*CancelTasksRequest)(nil), "models.CancelTasksRequest")
+	proto.RegisterType((*CancelTasksResponse)(nil), "models.CancelTasksResponse")
+	proto.RegisterType((*DuplicateTasksRequest)(nil), "models.DuplicateTasksRequest")
+	proto.RegisterType((*DuplicateTasks)(nil), "models.DuplicateTasks")
+	proto.RegisterType((*DuplicateTasksResponse)(nil), "models.DuplicateTasksResponse")
 	proto.RegisterType((*CompleteTaskRequest)(nil), "models.CompleteTaskRequest")
 	proto.RegisterType((*TaskCallbackResponse)(nil), "models.TaskCallbackResponse")
 	proto.RegisterType((*ConvergeTasksRequest)(nil), "models.ConvergeTasksRequest")
 	proto.RegisterType((*ConvergeTasksResponse)(nil), "models.ConvergeTasksResponse")
+	proto.RegisterType((*PurgeCompletedTasksRequest)(nil), "models.PurgeCompletedTasksRequest")
+	proto.RegisterType((*PurgeCompletedTasksResponse)(nil), "models.PurgeCompletedTasksResponse")
 	proto.RegisterType((*TasksRequest)(nil), "models.TasksRequest")
 	proto.RegisterType((*TasksResponse)(nil), "models.TasksResponse")
 	proto.RegisterType((*TaskByGuidRequest)(nil), "models.TaskByGuidRequest")
@@ -468,6 +702,9 @@ func (this *DesireTaskRequest) Equal(that interface{}) bool {
 	if this.Domain != that1.Domain {
 		return false
 	}
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
+	}
 	return true
 }
 func (this *StartTaskRequest) Equal(that interface{}) bool {
@@ -569,7 +806,7 @@ func (this *FailTaskRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *TaskGuidRequest) Equal(that interface{}) bool {
+func (this *RejectTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -577,9 +814,9 @@ func (this *TaskGuidRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*TaskGuidRequest)
+	that1, ok := that.(*RejectTaskRequest)
 	if !ok {
-		that2, ok := that.(TaskGuidRequest)
+		that2, ok := that.(RejectTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -597,9 +834,12 @@ func (this *TaskGuidRequest) Equal(that interface{}) bool {
 	if this.TaskGuid != that1.TaskGuid {
 		return false
 	}
+	if this.RejectionReason != that1.RejectionReason {
+		return false
+	}
 	return true
 }
-func (this *CompleteTaskRequest) Equal(that interface{}) bool {
+func (this *TaskGuidRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -607,9 +847,9 @@ func (this *CompleteTaskRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*CompleteTaskRequest)
+	that1, ok := that.(*TaskGuidRequest)
 	if !ok {
-		that2, ok := that.(CompleteTaskRequest)
+		that2, ok := that.(TaskGuidRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -627,21 +867,9 @@ func (this *CompleteTaskRequest) Equal(that interface{}) bool {
 	if this.TaskGuid != that1.TaskGuid {
 		return false
 	}
-	if this.CellId != that1.CellId {
-		return false
-	}
-	if this.Failed != that1.Failed {
-		return false
-	}
-	if this.FailureReason != that1.FailureReason {
-		return false
-	}
-	if this.Result != that1.Result {
-		return false
-	}
 	return true
 }
-func (this *TaskCallbackResponse) Equal(that interface{}) bool {
+func (this *CancelTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -649,9 +877,9 @@ func (this *TaskCallbackResponse) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*TaskCallbackResponse)
+	that1, ok := that.(*CancelTasksRequest)
 	if !ok {
-		that2, ok := that.(TaskCallbackResponse)
+		that2, ok := that.(CancelTasksRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -666,27 +894,20 @@ func (this *TaskCallbackResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.TaskGuid != that1.TaskGuid {
-		return false
-	}
-	if this.Failed != that1.Failed {
-		return false
-	}
-	if this.FailureReason != that1.FailureReason {
-		return false
-	}
-	if this.Result != that1.Result {
+	if this.Domain != that1.Domain {
 		return false
 	}
-	if this.Annotation != that1.Annotation {
+	if len(this.TaskGuids) != len(that1.TaskGuids) {
 		return false
 	}
-	if this.CreatedAt != that1.CreatedAt {
-		return false
+	for i := range this.TaskGuids {
+		if this.TaskGuids[i] != that1.TaskGuids[i] {
+			return false
+		}
 	}
 	return true
 }
-func (this *ConvergeTasksRequest) Equal(that interface{}) bool {
+func (this *CancelTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -694,9 +915,9 @@ func (this *ConvergeTasksRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*ConvergeTasksRequest)
+	that1, ok := that.(*CancelTasksResponse)
 	if !ok {
-		that2, ok := that.(ConvergeTasksRequest)
+		that2, ok := that.(CancelTasksResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -711,18 +932,36 @@ func (this *ConvergeTasksRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.KickTaskDuration != that1.KickTaskDuration {
+	if !this.Error.Equal(that1.Error) {
 		return false
 	}
-	if this.ExpirePendingTaskDuration != that1.ExpirePendingTaskDuration {
+	if len(this.CancelledTaskGuids) != len(that1.CancelledTaskGuids) {
 		return false
 	}
-	if this.ExpireCompletedTaskDuration != that1.ExpireCompletedTaskDuration {
+	for i := range this.CancelledTaskGuids {
+		if this.CancelledTaskGuids[i] != that1.CancelledTaskGuids[i] {
+			return false
+		}
+	}
+	if len(this.NotCancellableTaskGuids) != len(that1.NotCancellableTaskGuids) {
+		return false
+	}
+	for i := range this.NotCancellableTaskGuids {
+		if this.NotCancellableTaskGuids[i] != that1.NotCancellableTaskGuids[i] {
+			return false
+		}
+	}
+	if len(this.NotFoundTaskGuids) != len(that1.NotFoundTaskGuids) {
 		return false
 	}
+	for i := range this.NotFoundTaskGuids {
+		if this.NotFoundTaskGuids[i] != that1.NotFoundTaskGuids[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *ConvergeTasksResponse) Equal(that interface{}) bool {
+func (this *DuplicateTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -730,9 +969,9 @@ func (this *ConvergeTasksResponse) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*ConvergeTasksResponse)
+	that1, ok := that.(*DuplicateTasksRequest)
 	if !ok {
-		that2, ok := that.(ConvergeTasksResponse)
+		that2, ok := that.(DuplicateTasksRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -747,12 +986,12 @@ func (this *ConvergeTasksResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Error.Equal(that1.Error) {
+	if this.Domain != that1.Domain {
 		return false
 	}
 	return true
 }
-func (this *TasksRequest) Equal(that interface{}) bool {
+func (this *DuplicateTasks) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -760,9 +999,9 @@ func (this *TasksRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*TasksRequest)
+	that1, ok := that.(*DuplicateTasks)
 	if !ok {
-		that2, ok := that.(TasksRequest)
+		that2, ok := that.(DuplicateTasks)
 		if ok {
 			that1 = &that2
 		} else {
@@ -777,15 +1016,20 @@ func (this *TasksRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Domain != that1.Domain {
+	if this.ContentHash != that1.ContentHash {
 		return false
 	}
-	if this.CellId != that1.CellId {
+	if len(this.TaskGuids) != len(that1.TaskGuids) {
 		return false
 	}
+	for i := range this.TaskGuids {
+		if this.TaskGuids[i] != that1.TaskGuids[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *TasksResponse) Equal(that interface{}) bool {
+func (this *DuplicateTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -793,9 +1037,9 @@ func (this *TasksResponse) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*TasksResponse)
+	that1, ok := that.(*DuplicateTasksResponse)
 	if !ok {
-		that2, ok := that.(TasksResponse)
+		that2, ok := that.(DuplicateTasksResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -813,17 +1057,17 @@ func (this *TasksResponse) Equal(that interface{}) bool {
 	if !this.Error.Equal(that1.Error) {
 		return false
 	}
-	if len(this.Tasks) != len(that1.Tasks) {
+	if len(this.Duplicates) != len(that1.Duplicates) {
 		return false
 	}
-	for i := range this.Tasks {
-		if !this.Tasks[i].Equal(that1.Tasks[i]) {
+	for i := range this.Duplicates {
+		if !this.Duplicates[i].Equal(that1.Duplicates[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *TaskByGuidRequest) Equal(that interface{}) bool {
+func (this *CompleteTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -831,9 +1075,9 @@ func (this *TaskByGuidRequest) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*TaskByGuidRequest)
+	that1, ok := that.(*CompleteTaskRequest)
 	if !ok {
-		that2, ok := that.(TaskByGuidRequest)
+		that2, ok := that.(CompleteTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -851,9 +1095,21 @@ func (this *TaskByGuidRequest) Equal(that interface{}) bool {
 	if this.TaskGuid != that1.TaskGuid {
 		return false
 	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.Failed != that1.Failed {
+		return false
+	}
+	if this.FailureReason != that1.FailureReason {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
 	return true
 }
-func (this *TaskResponse) Equal(that interface{}) bool {
+func (this *TaskCallbackResponse) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -861,9 +1117,9 @@ func (this *TaskResponse) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*TaskResponse)
+	that1, ok := that.(*TaskCallbackResponse)
 	if !ok {
-		that2, ok := that.(TaskResponse)
+		that2, ok := that.(TaskCallbackResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -878,558 +1134,652 @@ func (this *TaskResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Error.Equal(that1.Error) {
+	if this.TaskGuid != that1.TaskGuid {
 		return false
 	}
-	if !this.Task.Equal(that1.Task) {
+	if this.Failed != that1.Failed {
 		return false
 	}
-	return true
-}
-func (this *TaskLifecycleResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.FailureReason != that1.FailureReason {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.TaskLifecycleResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if this.Result != that1.Result {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DesireTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Annotation != that1.Annotation {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&models.DesireTaskRequest{")
-	if this.TaskDefinition != nil {
-		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
+	if this.CreatedAt != that1.CreatedAt {
+		return false
 	}
-	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
-	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *StartTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ConvergeTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.StartTaskRequest{")
-	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
-	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *StartTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ConvergeTasksRequest)
+	if !ok {
+		that2, ok := that.(ConvergeTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.StartTaskResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ShouldStart: "+fmt.Sprintf("%#v", this.ShouldStart)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *FailTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.KickTaskDuration != that1.KickTaskDuration {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.FailTaskRequest{")
-	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
-	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TaskGuidRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ExpirePendingTaskDuration != that1.ExpirePendingTaskDuration {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.TaskGuidRequest{")
-	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CompleteTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ExpireCompletedTaskDuration != that1.ExpireCompletedTaskDuration {
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&models.CompleteTaskRequest{")
-	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
-	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
-	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
-	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *TaskCallbackResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ConvergeTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&models.TaskCallbackResponse{")
-	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
-	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
-	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "Annotation: "+fmt.Sprintf("%#v", this.Annotation)+",\n")
-	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ConvergeTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ConvergeTasksResponse)
+	if !ok {
+		that2, ok := that.(ConvergeTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&models.ConvergeTasksRequest{")
-	s = append(s, "KickTaskDuration: "+fmt.Sprintf("%#v", this.KickTaskDuration)+",\n")
-	s = append(s, "ExpirePendingTaskDuration: "+fmt.Sprintf("%#v", this.ExpirePendingTaskDuration)+",\n")
-	s = append(s, "ExpireCompletedTaskDuration: "+fmt.Sprintf("%#v", this.ExpireCompletedTaskDuration)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ConvergeTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.ConvergeTasksResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if !this.Error.Equal(that1.Error) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *TasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PurgeCompletedTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.TasksRequest{")
-	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
-	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PurgeCompletedTasksRequest)
+	if !ok {
+		that2, ok := that.(PurgeCompletedTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.TasksResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if this.Tasks != nil {
-		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	if this.MinAgeInSeconds != that1.MinAgeInSeconds {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *TaskByGuidRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PurgeCompletedTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.TaskByGuidRequest{")
-	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PurgeCompletedTasksResponse)
+	if !ok {
+		that2, ok := that.(PurgeCompletedTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.TaskResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if this.Task != nil {
-		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
+	if !this.Error.Equal(that1.Error) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringTaskRequests(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.PurgedCount != that1.PurgedCount {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return true
 }
-func extensionToGoStringTaskRequests(m github_com_gogo_protobuf_proto.Message) string {
-	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
-	if e == nil {
-		return "nil"
+func (this *TasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
-	keys := make([]int, 0, len(e))
-	for k := range e {
-		keys = append(keys, int(k))
+
+	that1, ok := that.(*TasksRequest)
+	if !ok {
+		that2, ok := that.(TasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	sort.Ints(keys)
-	ss := []string{}
-	for _, k := range keys {
-		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s += strings.Join(ss, ",") + "})"
-	return s
-}
-func (m *TaskLifecycleResponse) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if this.Domain != that1.Domain {
+		return false
 	}
-	return data[:n], nil
-}
-
-func (m *TaskLifecycleResponse) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
-		n1, err := m.Error.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if len(this.States) != len(that1.States) {
+		return false
+	}
+	for i := range this.States {
+		if this.States[i] != that1.States[i] {
+			return false
 		}
-		i += n1
 	}
-	return i, nil
-}
-
-func (m *DesireTaskRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if this.PartialResults != that1.PartialResults {
+		return false
 	}
-	return data[:n], nil
+	return true
 }
-
-func (m *DesireTaskRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.TaskDefinition != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintTaskRequests(data, i, uint64(m.TaskDefinition.Size()))
-		n2, err := m.TaskDefinition.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+func (this *TasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
 		}
-		i += n2
+		return false
 	}
-	data[i] = 0x12
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
-	i += copy(data[i:], m.TaskGuid)
-	data[i] = 0x1a
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.Domain)))
-	i += copy(data[i:], m.Domain)
-	return i, nil
-}
 
-func (m *StartTaskRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*TasksResponse)
+	if !ok {
+		that2, ok := that.(TasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return data[:n], nil
-}
-
-func (m *StartTaskRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
-	i += copy(data[i:], m.TaskGuid)
-	data[i] = 0x12
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.CellId)))
-	i += copy(data[i:], m.CellId)
-	return i, nil
-}
-
-func (m *StartTaskResponse) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return data[:n], nil
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Tasks) != len(that1.Tasks) {
+		return false
+	}
+	for i := range this.Tasks {
+		if !this.Tasks[i].Equal(that1.Tasks[i]) {
+			return false
+		}
+	}
+	if len(this.RecordErrors) != len(that1.RecordErrors) {
+		return false
+	}
+	for i := range this.RecordErrors {
+		if !this.RecordErrors[i].Equal(that1.RecordErrors[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *TaskByGuidRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *StartTaskResponse) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
-		n3, err := m.Error.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*TaskByGuidRequest)
+	if !ok {
+		that2, ok := that.(TaskByGuidRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n3
 	}
-	data[i] = 0x10
-	i++
-	if m.ShouldStart {
-		data[i] = 1
-	} else {
-		data[i] = 0
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	i++
-	return i, nil
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	return true
 }
+func (this *TaskResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *FailTaskRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*TaskResponse)
+	if !ok {
+		that2, ok := that.(TaskResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return data[:n], nil
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Task.Equal(that1.Task) {
+		return false
+	}
+	return true
 }
-
-func (m *FailTaskRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
-	i += copy(data[i:], m.TaskGuid)
-	data[i] = 0x12
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.FailureReason)))
-	i += copy(data[i:], m.FailureReason)
-	return i, nil
-}
-
-func (m *TaskGuidRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *TaskLifecycleResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return data[:n], nil
-}
-
-func (m *TaskGuidRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
-	i += copy(data[i:], m.TaskGuid)
-	return i, nil
-}
-
-func (m *CompleteTaskRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 5)
+	s = append(s, "&models.TaskLifecycleResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
 	}
-	return data[:n], nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CompleteTaskRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
-	i += copy(data[i:], m.TaskGuid)
-	data[i] = 0x12
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.CellId)))
-	i += copy(data[i:], m.CellId)
-	data[i] = 0x18
-	i++
-	if m.Failed {
-		data[i] = 1
-	} else {
-		data[i] = 0
+func (this *DesireTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i++
-	data[i] = 0x22
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.FailureReason)))
-	i += copy(data[i:], m.FailureReason)
-	data[i] = 0x2a
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.Result)))
-	i += copy(data[i:], m.Result)
-	return i, nil
+	s := make([]string, 0, 8)
+	s = append(s, "&models.DesireTaskRequest{")
+	if this.TaskDefinition != nil {
+		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
+	}
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TaskCallbackResponse) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *StartTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return data[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&models.StartTaskRequest{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TaskCallbackResponse) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
-	i += copy(data[i:], m.TaskGuid)
-	data[i] = 0x10
-	i++
-	if m.Failed {
-		data[i] = 1
-	} else {
-		data[i] = 0
+func (this *StartTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i++
-	data[i] = 0x1a
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.FailureReason)))
-	i += copy(data[i:], m.FailureReason)
-	data[i] = 0x22
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.Result)))
-	i += copy(data[i:], m.Result)
-	data[i] = 0x2a
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.Annotation)))
-	i += copy(data[i:], m.Annotation)
-	data[i] = 0x30
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(m.CreatedAt))
-	return i, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&models.StartTaskResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "ShouldStart: "+fmt.Sprintf("%#v", this.ShouldStart)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ConvergeTasksRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *FailTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return data[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&models.FailTaskRequest{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ConvergeTasksRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0x8
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(m.KickTaskDuration))
-	data[i] = 0x10
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(m.ExpirePendingTaskDuration))
-	data[i] = 0x18
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(m.ExpireCompletedTaskDuration))
-	return i, nil
+func (this *RejectTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.RejectTaskRequest{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "RejectionReason: "+fmt.Sprintf("%#v", this.RejectionReason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ConvergeTasksResponse) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *TaskGuidRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return data[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&models.TaskGuidRequest{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ConvergeTasksResponse) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
-		n4, err := m.Error.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+func (this *CancelTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return i, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CancelTasksRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	if this.TaskGuids != nil {
+		s = append(s, "TaskGuids: "+fmt.Sprintf("%#v", this.TaskGuids)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TasksRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *CancelTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return data[:n], nil
+	s := make([]string, 0, 8)
+	s = append(s, "&models.CancelTasksResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.CancelledTaskGuids != nil {
+		s = append(s, "CancelledTaskGuids: "+fmt.Sprintf("%#v", this.CancelledTaskGuids)+",\n")
+	}
+	if this.NotCancellableTaskGuids != nil {
+		s = append(s, "NotCancellableTaskGuids: "+fmt.Sprintf("%#v", this.NotCancellableTaskGuids)+",\n")
+	}
+	if this.NotFoundTaskGuids != nil {
+		s = append(s, "NotFoundTaskGuids: "+fmt.Sprintf("%#v", this.NotFoundTaskGuids)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TasksRequest) MarshalTo(data []byte) (int, error) {
-	var i int
+func (this *DuplicateTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DuplicateTasksRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DuplicateTasks) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DuplicateTasks{")
+	s = append(s, "ContentHash: "+fmt.Sprintf("%#v", this.ContentHash)+",\n")
+	if this.TaskGuids != nil {
+		s = append(s, "TaskGuids: "+fmt.Sprintf("%#v", this.TaskGuids)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DuplicateTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DuplicateTasksResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Duplicates != nil {
+		s = append(s, "Duplicates: "+fmt.Sprintf("%#v", this.Duplicates)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompleteTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.CompleteTaskRequest{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
+	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskCallbackResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&models.TaskCallbackResponse{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
+	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "Annotation: "+fmt.Sprintf("%#v", this.Annotation)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConvergeTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.ConvergeTasksRequest{")
+	s = append(s, "KickTaskDuration: "+fmt.Sprintf("%#v", this.KickTaskDuration)+",\n")
+	s = append(s, "ExpirePendingTaskDuration: "+fmt.Sprintf("%#v", this.ExpirePendingTaskDuration)+",\n")
+	s = append(s, "ExpireCompletedTaskDuration: "+fmt.Sprintf("%#v", this.ExpireCompletedTaskDuration)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConvergeTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ConvergeTasksResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PurgeCompletedTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.PurgeCompletedTasksRequest{")
+	s = append(s, "MinAgeInSeconds: "+fmt.Sprintf("%#v", this.MinAgeInSeconds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PurgeCompletedTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.PurgeCompletedTasksResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "PurgedCount: "+fmt.Sprintf("%#v", this.PurgedCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.TasksRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	if this.States != nil {
+		s = append(s, "States: "+fmt.Sprintf("%#v", this.States)+",\n")
+	}
+	s = append(s, "PartialResults: "+fmt.Sprintf("%#v", this.PartialResults)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.TasksResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	}
+	if this.RecordErrors != nil {
+		s = append(s, "RecordErrors: "+fmt.Sprintf("%#v", this.RecordErrors)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskByGuidRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.TaskByGuidRequest{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.TaskResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Task != nil {
+		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTaskRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringTaskRequests(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+func (m *TaskLifecycleResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TaskLifecycleResponse) MarshalTo(data []byte) (int, error) {
+	var i int
 	_ = i
 	var l int
 	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.Domain)))
-	i += copy(data[i:], m.Domain)
-	data[i] = 0x12
-	i++
-	i = encodeVarintTaskRequests(data, i, uint64(len(m.CellId)))
-	i += copy(data[i:], m.CellId)
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
 	return i, nil
 }
 
-func (m *TasksResponse) Marshal() (data []byte, err error) {
+func (m *DesireTaskRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1439,37 +1789,37 @@ func (m *TasksResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *TasksResponse) MarshalTo(data []byte) (int, error) {
+func (m *DesireTaskRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
+	if m.TaskDefinition != nil {
 		data[i] = 0xa
 		i++
-		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
-		n5, err := m.Error.MarshalTo(data[i:])
+		i = encodeVarintTaskRequests(data, i, uint64(m.TaskDefinition.Size()))
+		n2, err := m.TaskDefinition.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
-	}
-	if len(m.Tasks) > 0 {
-		for _, msg := range m.Tasks {
-			data[i] = 0x12
-			i++
-			i = encodeVarintTaskRequests(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n2
 	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
+	i += copy(data[i:], m.TaskGuid)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.Domain)))
+	i += copy(data[i:], m.Domain)
+	data[i] = 0x22
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.IdempotencyKey)))
+	i += copy(data[i:], m.IdempotencyKey)
 	return i, nil
 }
 
-func (m *TaskByGuidRequest) Marshal() (data []byte, err error) {
+func (m *StartTaskRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1479,7 +1829,7 @@ func (m *TaskByGuidRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *TaskByGuidRequest) MarshalTo(data []byte) (int, error) {
+func (m *StartTaskRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1488,10 +1838,14 @@ func (m *TaskByGuidRequest) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
 	i += copy(data[i:], m.TaskGuid)
+	data[i] = 0x12
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.CellId)))
+	i += copy(data[i:], m.CellId)
 	return i, nil
 }
 
-func (m *TaskResponse) Marshal() (data []byte, err error) {
+func (m *StartTaskResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1501,7 +1855,7 @@ func (m *TaskResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *TaskResponse) MarshalTo(data []byte) (int, error) {
+func (m *StartTaskResponse) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1510,394 +1864,1925 @@ func (m *TaskResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
-		n6, err := m.Error.MarshalTo(data[i:])
+		n3, err := m.Error.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n3
 	}
-	if m.Task != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintTaskRequests(data, i, uint64(m.Task.Size()))
-		n7, err := m.Task.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+	data[i] = 0x10
+	i++
+	if m.ShouldStart {
+		data[i] = 1
+	} else {
+		data[i] = 0
 	}
+	i++
 	return i, nil
 }
 
-func encodeFixed64TaskRequests(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32TaskRequests(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintTaskRequests(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FailTaskRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	data[offset] = uint8(v)
-	return offset + 1
+	return data[:n], nil
 }
-func (m *TaskLifecycleResponse) Size() (n int) {
+
+func (m *FailTaskRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTaskRequests(uint64(l))
-	}
-	return n
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
+	i += copy(data[i:], m.TaskGuid)
+	data[i] = 0x12
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.FailureReason)))
+	i += copy(data[i:], m.FailureReason)
+	return i, nil
 }
 
-func (m *DesireTaskRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.TaskDefinition != nil {
-		l = m.TaskDefinition.Size()
-		n += 1 + l + sovTaskRequests(uint64(l))
+func (m *RejectTaskRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TaskGuid)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	l = len(m.Domain)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	return n
+	return data[:n], nil
 }
 
-func (m *StartTaskRequest) Size() (n int) {
+func (m *RejectTaskRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskGuid)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	l = len(m.CellId)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	return n
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
+	i += copy(data[i:], m.TaskGuid)
+	data[i] = 0x12
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.RejectionReason)))
+	i += copy(data[i:], m.RejectionReason)
+	return i, nil
 }
 
-func (m *StartTaskResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTaskRequests(uint64(l))
+func (m *TaskGuidRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	n += 2
-	return n
+	return data[:n], nil
 }
 
-func (m *FailTaskRequest) Size() (n int) {
+func (m *TaskGuidRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskGuid)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	l = len(m.FailureReason)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	return n
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
+	i += copy(data[i:], m.TaskGuid)
+	return i, nil
 }
 
-func (m *TaskGuidRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.TaskGuid)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	return n
+func (m *CancelTasksRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func (m *CompleteTaskRequest) Size() (n int) {
+func (m *CancelTasksRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskGuid)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	l = len(m.CellId)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	n += 2
-	l = len(m.FailureReason)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	l = len(m.Result)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	return n
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.Domain)))
+	i += copy(data[i:], m.Domain)
+	if len(m.TaskGuids) > 0 {
+		for _, s := range m.TaskGuids {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	return i, nil
 }
 
-func (m *TaskCallbackResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.TaskGuid)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	n += 2
-	l = len(m.FailureReason)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	l = len(m.Result)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	l = len(m.Annotation)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	n += 1 + sovTaskRequests(uint64(m.CreatedAt))
-	return n
+func (m *CancelTasksResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func (m *ConvergeTasksRequest) Size() (n int) {
+func (m *CancelTasksResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovTaskRequests(uint64(m.KickTaskDuration))
-	n += 1 + sovTaskRequests(uint64(m.ExpirePendingTaskDuration))
-	n += 1 + sovTaskRequests(uint64(m.ExpireCompletedTaskDuration))
-	return n
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
+		n4, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.CancelledTaskGuids) > 0 {
+		for _, s := range m.CancelledTaskGuids {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.NotCancellableTaskGuids) > 0 {
+		for _, s := range m.NotCancellableTaskGuids {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.NotFoundTaskGuids) > 0 {
+		for _, s := range m.NotFoundTaskGuids {
+			data[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	return i, nil
 }
 
-func (m *ConvergeTasksResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTaskRequests(uint64(l))
+func (m *DuplicateTasksRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *TasksRequest) Size() (n int) {
+func (m *DuplicateTasksRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	l = len(m.CellId)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	return n
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.Domain)))
+	i += copy(data[i:], m.Domain)
+	return i, nil
 }
 
-func (m *TasksResponse) Size() (n int) {
+func (m *DuplicateTasks) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DuplicateTasks) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTaskRequests(uint64(l))
-	}
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovTaskRequests(uint64(l))
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.ContentHash)))
+	i += copy(data[i:], m.ContentHash)
+	if len(m.TaskGuids) > 0 {
+		for _, s := range m.TaskGuids {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *TaskByGuidRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.TaskGuid)
-	n += 1 + l + sovTaskRequests(uint64(l))
-	return n
+func (m *DuplicateTasksResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func (m *TaskResponse) Size() (n int) {
+func (m *DuplicateTasksResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTaskRequests(uint64(l))
+		data[i] = 0xa
+		i++
+		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
+		n5, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTaskRequests(uint64(l))
+	if len(m.Duplicates) > 0 {
+		for _, msg := range m.Duplicates {
+			data[i] = 0x12
+			i++
+			i = encodeVarintTaskRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
+	return i, nil
 }
 
-func sovTaskRequests(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *CompleteTaskRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
-}
-func sozTaskRequests(x uint64) (n int) {
-	return sovTaskRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return data[:n], nil
 }
-func (this *TaskLifecycleResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CompleteTaskRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
+	i += copy(data[i:], m.TaskGuid)
+	data[i] = 0x12
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.CellId)))
+	i += copy(data[i:], m.CellId)
+	data[i] = 0x18
+	i++
+	if m.Failed {
+		data[i] = 1
+	} else {
+		data[i] = 0
 	}
-	s := strings.Join([]string{`&TaskLifecycleResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	i++
+	data[i] = 0x22
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.FailureReason)))
+	i += copy(data[i:], m.FailureReason)
+	data[i] = 0x2a
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.Result)))
+	i += copy(data[i:], m.Result)
+	return i, nil
 }
-func (this *DesireTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TaskCallbackResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DesireTaskRequest{`,
-		`TaskDefinition:` + strings.Replace(fmt.Sprintf("%v", this.TaskDefinition), "TaskDefinition", "TaskDefinition", 1) + `,`,
-		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
-		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *StartTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TaskCallbackResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
+	i += copy(data[i:], m.TaskGuid)
+	data[i] = 0x10
+	i++
+	if m.Failed {
+		data[i] = 1
+	} else {
+		data[i] = 0
 	}
-	s := strings.Join([]string{`&StartTaskRequest{`,
-		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
-		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
-		`}`,
-	}, "")
-	return s
+	i++
+	data[i] = 0x1a
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.FailureReason)))
+	i += copy(data[i:], m.FailureReason)
+	data[i] = 0x22
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.Result)))
+	i += copy(data[i:], m.Result)
+	data[i] = 0x2a
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.Annotation)))
+	i += copy(data[i:], m.Annotation)
+	data[i] = 0x30
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(m.CreatedAt))
+	return i, nil
 }
-func (this *StartTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ConvergeTasksRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&StartTaskResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`ShouldStart:` + fmt.Sprintf("%v", this.ShouldStart) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *FailTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ConvergeTasksRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(m.KickTaskDuration))
+	data[i] = 0x10
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(m.ExpirePendingTaskDuration))
+	data[i] = 0x18
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(m.ExpireCompletedTaskDuration))
+	return i, nil
+}
+
+func (m *ConvergeTasksResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&FailTaskRequest{`,
-		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
-		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *TaskGuidRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ConvergeTasksResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
+		n6, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
 	}
-	s := strings.Join([]string{`&TaskGuidRequest{`,
-		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *CompleteTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PurgeCompletedTasksRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&CompleteTaskRequest{`,
-		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
-		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
-		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
-		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *TaskCallbackResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PurgeCompletedTasksRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(m.MinAgeInSeconds))
+	return i, nil
+}
+
+func (m *PurgeCompletedTasksResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&TaskCallbackResponse{`,
-		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
-		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
-		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`Annotation:` + fmt.Sprintf("%v", this.Annotation) + `,`,
-		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *ConvergeTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PurgeCompletedTasksResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
+		n7, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
-	s := strings.Join([]string{`&ConvergeTasksRequest{`,
-		`KickTaskDuration:` + fmt.Sprintf("%v", this.KickTaskDuration) + `,`,
-		`ExpirePendingTaskDuration:` + fmt.Sprintf("%v", this.ExpirePendingTaskDuration) + `,`,
-		`ExpireCompletedTaskDuration:` + fmt.Sprintf("%v", this.ExpireCompletedTaskDuration) + `,`,
-		`}`,
-	}, "")
-	return s
+	data[i] = 0x10
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(m.PurgedCount))
+	return i, nil
 }
-func (this *ConvergeTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TasksRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ConvergeTasksResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *TasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TasksRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.Domain)))
+	i += copy(data[i:], m.Domain)
+	data[i] = 0x12
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.CellId)))
+	i += copy(data[i:], m.CellId)
+	if len(m.States) > 0 {
+		for _, s := range m.States {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
 	}
-	s := strings.Join([]string{`&TasksRequest{`,
-		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
-		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
-		`}`,
-	}, "")
-	return s
+	data[i] = 0x20
+	i++
+	if m.PartialResults {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	return i, nil
 }
-func (this *TasksResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TasksResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&TasksResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`Tasks:` + strings.Replace(fmt.Sprintf("%v", this.Tasks), "Task", "Task", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *TaskByGuidRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TasksResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
+		n8, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
 	}
-	s := strings.Join([]string{`&TaskByGuidRequest{`,
-		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Tasks) > 0 {
+		for _, msg := range m.Tasks {
+			data[i] = 0x12
+			i++
+			i = encodeVarintTaskRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RecordErrors) > 0 {
+		for _, msg := range m.RecordErrors {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintTaskRequests(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
-func (this *TaskResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TaskByGuidRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TaskByGuidRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(data, i, uint64(len(m.TaskGuid)))
+	i += copy(data[i:], m.TaskGuid)
+	return i, nil
+}
+
+func (m *TaskResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TaskResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintTaskRequests(data, i, uint64(m.Error.Size()))
+		n9, err := m.Error.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Task != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintTaskRequests(data, i, uint64(m.Task.Size()))
+		n10, err := m.Task.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func encodeFixed64TaskRequests(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32TaskRequests(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintTaskRequests(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *TaskLifecycleResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DesireTaskRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.TaskDefinition != nil {
+		l = m.TaskDefinition.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.Domain)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.IdempotencyKey)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	return n
+}
+
+func (m *StartTaskRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.CellId)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	return n
+}
+
+func (m *StartTaskResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *FailTaskRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.FailureReason)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	return n
+}
+
+func (m *RejectTaskRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.RejectionReason)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	return n
+}
+
+func (m *TaskGuidRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	return n
+}
+
+func (m *CancelTasksRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	if len(m.TaskGuids) > 0 {
+		for _, s := range m.TaskGuids {
+			l = len(s)
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CancelTasksResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	if len(m.CancelledTaskGuids) > 0 {
+		for _, s := range m.CancelledTaskGuids {
+			l = len(s)
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	if len(m.NotCancellableTaskGuids) > 0 {
+		for _, s := range m.NotCancellableTaskGuids {
+			l = len(s)
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	if len(m.NotFoundTaskGuids) > 0 {
+		for _, s := range m.NotFoundTaskGuids {
+			l = len(s)
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DuplicateTasksRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	return n
+}
+
+func (m *DuplicateTasks) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContentHash)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	if len(m.TaskGuids) > 0 {
+		for _, s := range m.TaskGuids {
+			l = len(s)
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DuplicateTasksResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	if len(m.Duplicates) > 0 {
+		for _, e := range m.Duplicates {
+			l = e.Size()
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompleteTaskRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.CellId)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	n += 2
+	l = len(m.FailureReason)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.Result)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	return n
+}
+
+func (m *TaskCallbackResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	n += 2
+	l = len(m.FailureReason)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.Result)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.Annotation)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	n += 1 + sovTaskRequests(uint64(m.CreatedAt))
+	return n
+}
+
+func (m *ConvergeTasksRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovTaskRequests(uint64(m.KickTaskDuration))
+	n += 1 + sovTaskRequests(uint64(m.ExpirePendingTaskDuration))
+	n += 1 + sovTaskRequests(uint64(m.ExpireCompletedTaskDuration))
+	return n
+}
+
+func (m *ConvergeTasksResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *PurgeCompletedTasksRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovTaskRequests(uint64(m.MinAgeInSeconds))
+	return n
+}
+
+func (m *PurgeCompletedTasksResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	n += 1 + sovTaskRequests(uint64(m.PurgedCount))
+	return n
+}
+
+func (m *TasksRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	l = len(m.CellId)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	if len(m.States) > 0 {
+		for _, s := range m.States {
+			l = len(s)
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *TasksResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	if len(m.RecordErrors) > 0 {
+		for _, e := range m.RecordErrors {
+			l = e.Size()
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskByGuidRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	return n
+}
+
+func (m *TaskResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func sovTaskRequests(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTaskRequests(x uint64) (n int) {
+	return sovTaskRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TaskLifecycleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskLifecycleResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesireTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesireTaskRequest{`,
+		`TaskDefinition:` + strings.Replace(fmt.Sprintf("%v", this.TaskDefinition), "TaskDefinition", "TaskDefinition", 1) + `,`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartTaskRequest{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartTaskResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ShouldStart:` + fmt.Sprintf("%v", this.ShouldStart) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FailTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FailTaskRequest{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RejectTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RejectTaskRequest{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`RejectionReason:` + fmt.Sprintf("%v", this.RejectionReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskGuidRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskGuidRequest{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelTasksRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`TaskGuids:` + fmt.Sprintf("%v", this.TaskGuids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelTasksResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`CancelledTaskGuids:` + fmt.Sprintf("%v", this.CancelledTaskGuids) + `,`,
+		`NotCancellableTaskGuids:` + fmt.Sprintf("%v", this.NotCancellableTaskGuids) + `,`,
+		`NotFoundTaskGuids:` + fmt.Sprintf("%v", this.NotFoundTaskGuids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DuplicateTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DuplicateTasksRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DuplicateTasks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DuplicateTasks{`,
+		`ContentHash:` + fmt.Sprintf("%v", this.ContentHash) + `,`,
+		`TaskGuids:` + fmt.Sprintf("%v", this.TaskGuids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DuplicateTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DuplicateTasksResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Duplicates:` + strings.Replace(fmt.Sprintf("%v", this.Duplicates), "DuplicateTasks", "DuplicateTasks", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompleteTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompleteTaskRequest{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskCallbackResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskCallbackResponse{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Annotation:` + fmt.Sprintf("%v", this.Annotation) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvergeTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvergeTasksRequest{`,
+		`KickTaskDuration:` + fmt.Sprintf("%v", this.KickTaskDuration) + `,`,
+		`ExpirePendingTaskDuration:` + fmt.Sprintf("%v", this.ExpirePendingTaskDuration) + `,`,
+		`ExpireCompletedTaskDuration:` + fmt.Sprintf("%v", this.ExpireCompletedTaskDuration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvergeTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvergeTasksResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeCompletedTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeCompletedTasksRequest{`,
+		`MinAgeInSeconds:` + fmt.Sprintf("%v", this.MinAgeInSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeCompletedTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeCompletedTasksResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`PurgedCount:` + fmt.Sprintf("%v", this.PurgedCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TasksRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`States:` + fmt.Sprintf("%v", this.States) + `,`,
+		`PartialResults:` + fmt.Sprintf("%v", this.PartialResults) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TasksResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Tasks:` + strings.Replace(fmt.Sprintf("%v", this.Tasks), "Task", "Task", 1) + `,`,
+		`RecordErrors:` + strings.Replace(fmt.Sprintf("%v", this.RecordErrors), "RecordError", "RecordError", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskByGuidRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskByGuidRequest{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTaskRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TaskLifecycleResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskLifecycleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesireTaskRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesireTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesireTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskDefinition == nil {
+				m.TaskDefinition = &TaskDefinition{}
+			}
+			if err := m.TaskDefinition.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartTaskRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartTaskResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShouldStart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShouldStart = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailTaskRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RejectTaskRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejectTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejectTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectionReason = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&TaskResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringTaskRequests(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *TaskLifecycleResponse) Unmarshal(data []byte) error {
+func (m *TaskGuidRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1920,17 +3805,17 @@ func (m *TaskLifecycleResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskLifecycleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskGuidRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRequests
@@ -1940,24 +3825,20 @@ func (m *TaskLifecycleResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
-			}
-			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskGuid = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1980,7 +3861,7 @@ func (m *TaskLifecycleResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *DesireTaskRequest) Unmarshal(data []byte) error {
+func (m *CancelTasksRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,48 +3884,15 @@ func (m *DesireTaskRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesireTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesireTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRequests
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTaskRequests
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskDefinition == nil {
-				m.TaskDefinition = &TaskDefinition{}
-			}
-			if err := m.TaskDefinition.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2069,11 +3917,11 @@ func (m *DesireTaskRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskGuid = string(data[iNdEx:postIndex])
+			m.Domain = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2098,7 +3946,7 @@ func (m *DesireTaskRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(data[iNdEx:postIndex])
+			m.TaskGuids = append(m.TaskGuids, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2121,7 +3969,7 @@ func (m *DesireTaskRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *StartTaskRequest) Unmarshal(data []byte) error {
+func (m *CancelTasksResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2144,15 +3992,48 @@ func (m *StartTaskRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledTaskGuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2177,11 +4058,11 @@ func (m *StartTaskRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskGuid = string(data[iNdEx:postIndex])
+			m.CancelledTaskGuids = append(m.CancelledTaskGuids, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotCancellableTaskGuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2206,7 +4087,36 @@ func (m *StartTaskRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellId = string(data[iNdEx:postIndex])
+			m.NotCancellableTaskGuids = append(m.NotCancellableTaskGuids, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotFoundTaskGuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotFoundTaskGuids = append(m.NotFoundTaskGuids, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2229,7 +4139,7 @@ func (m *StartTaskRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *StartTaskResponse) Unmarshal(data []byte) error {
+func (m *DuplicateTasksRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2252,17 +4162,17 @@ func (m *StartTaskResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuplicateTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuplicateTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRequests
@@ -2272,45 +4182,21 @@ func (m *StartTaskResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
-			}
-			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShouldStart", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRequests
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ShouldStart = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskRequests(data[iNdEx:])
@@ -2332,7 +4218,7 @@ func (m *StartTaskResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *FailTaskRequest) Unmarshal(data []byte) error {
+func (m *DuplicateTasks) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2355,15 +4241,15 @@ func (m *FailTaskRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FailTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuplicateTasks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FailTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuplicateTasks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2388,11 +4274,11 @@ func (m *FailTaskRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskGuid = string(data[iNdEx:postIndex])
+			m.ContentHash = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2417,7 +4303,7 @@ func (m *FailTaskRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureReason = string(data[iNdEx:postIndex])
+			m.TaskGuids = append(m.TaskGuids, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2440,7 +4326,7 @@ func (m *FailTaskRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *TaskGuidRequest) Unmarshal(data []byte) error {
+func (m *DuplicateTasksResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2463,17 +4349,50 @@ func (m *TaskGuidRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskGuidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuplicateTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuplicateTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duplicates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRequests
@@ -2483,20 +4402,22 @@ func (m *TaskGuidRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTaskRequests
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskGuid = string(data[iNdEx:postIndex])
+			m.Duplicates = append(m.Duplicates, &DuplicateTasks{})
+			if err := m.Duplicates[len(m.Duplicates)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3100,6 +5021,177 @@ func (m *ConvergeTasksResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *PurgeCompletedTasksRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeCompletedTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeCompletedTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAgeInSeconds", wireType)
+			}
+			m.MinAgeInSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MinAgeInSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeCompletedTasksResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeCompletedTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeCompletedTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgedCount", wireType)
+			}
+			m.PurgedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.PurgedCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TasksRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -3187,6 +5279,55 @@ func (m *TasksRequest) Unmarshal(data []byte) error {
 			}
 			m.CellId = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialResults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialResults = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskRequests(data[iNdEx:])
@@ -3301,6 +5442,37 @@ func (m *TasksResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordErrors = append(m.RecordErrors, &RecordError{})
+			if err := m.RecordErrors[len(m.RecordErrors)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskRequests(data[iNdEx:])
@@ -3625,48 +5797,70 @@ var (
 func init() { proto.RegisterFile("task_requests.proto", fileDescriptorTaskRequests) }
 
 var fileDescriptorTaskRequests = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6f, 0x13, 0x3d,
-	0x10, 0xc6, 0xe3, 0x24, 0xcd, 0xdb, 0x4e, 0xfa, 0x77, 0xdb, 0x17, 0x85, 0x52, 0xb6, 0x61, 0x7b,
-	0x20, 0x12, 0x25, 0x95, 0x2a, 0xc4, 0xa9, 0x17, 0xd2, 0x16, 0x54, 0xc1, 0x01, 0x2d, 0x41, 0xe2,
-	0xb6, 0x72, 0x77, 0x9d, 0xd4, 0xca, 0x66, 0x1d, 0x6c, 0x2f, 0xa2, 0x37, 0x2e, 0xdc, 0xf9, 0x18,
-	0x88, 0x4f, 0xc1, 0xb1, 0xc7, 0x1e, 0x39, 0xa0, 0x8a, 0x2e, 0x17, 0xd4, 0x53, 0x3f, 0x02, 0xb2,
-	0xbd, 0x69, 0x36, 0x69, 0x11, 0x89, 0xc4, 0x6d, 0x3d, 0xcf, 0xf8, 0xe7, 0x67, 0xec, 0x9d, 0x81,
-	0x65, 0x89, 0x45, 0xc7, 0xe3, 0xe4, 0x6d, 0x4c, 0x84, 0x14, 0xf5, 0x1e, 0x67, 0x92, 0x59, 0xa5,
-	0x2e, 0x0b, 0x48, 0x28, 0x56, 0x1f, 0xb6, 0xa9, 0x3c, 0x8a, 0x0f, 0xeb, 0x3e, 0xeb, 0x6e, 0xb5,
-	0x59, 0x9b, 0x6d, 0x69, 0xf9, 0x30, 0x6e, 0xe9, 0x95, 0x5e, 0xe8, 0x2f, 0xb3, 0x6d, 0x15, 0x14,
-	0x2b, 0xfd, 0x2e, 0x13, 0xce, 0x19, 0x37, 0x0b, 0x67, 0x07, 0xfe, 0x6f, 0x62, 0xd1, 0x79, 0x41,
-	0x5b, 0xc4, 0x3f, 0xf6, 0x43, 0xe2, 0x12, 0xd1, 0x63, 0x91, 0x20, 0xd6, 0x06, 0x4c, 0xe9, 0xbc,
-	0x0a, 0xaa, 0xa2, 0x5a, 0x79, 0x7b, 0xae, 0x6e, 0x0e, 0xae, 0xef, 0xab, 0xa0, 0x6b, 0x34, 0xe7,
-	0x0b, 0x82, 0xa5, 0x3d, 0x22, 0x28, 0x27, 0x0a, 0xe2, 0x1a, 0xab, 0x56, 0x13, 0x16, 0xb4, 0xf5,
-	0x80, 0xb4, 0x68, 0x44, 0x25, 0x65, 0x51, 0x0a, 0xb9, 0xd5, 0x87, 0xa8, 0xec, 0xbd, 0x2b, 0xb5,
-	0xb1, 0x7c, 0x71, 0xb6, 0x3e, 0xba, 0xc5, 0x9d, 0x97, 0x43, 0x49, 0xd6, 0x3d, 0x98, 0xd1, 0x29,
-	0xed, 0x98, 0x06, 0x95, 0x7c, 0x15, 0xd5, 0x66, 0x1a, 0xc5, 0x93, 0xb3, 0xf5, 0x9c, 0x3b, 0xad,
-	0xc2, 0xcf, 0x62, 0x1a, 0x58, 0x6b, 0x50, 0x0a, 0x58, 0x17, 0xd3, 0xa8, 0x52, 0xc8, 0xe8, 0x69,
-	0xcc, 0x69, 0xc2, 0xe2, 0x2b, 0x89, 0xb9, 0xcc, 0x5a, 0x1d, 0x82, 0xa2, 0x1b, 0xa1, 0x77, 0xe1,
-	0x3f, 0x9f, 0x84, 0xa1, 0x37, 0x72, 0x6a, 0x49, 0x05, 0x0f, 0x02, 0x07, 0xc3, 0x52, 0x86, 0x3a,
-	0xc1, 0xe5, 0x59, 0xf7, 0x61, 0x56, 0x1c, 0xb1, 0x38, 0x0c, 0x3c, 0xa1, 0x00, 0x9a, 0x3e, 0x9d,
-	0xd2, 0xcb, 0x46, 0xd1, 0x64, 0x07, 0xc3, 0xc2, 0x53, 0x4c, 0xc3, 0x09, 0x7d, 0x3f, 0x80, 0xf9,
-	0x16, 0xa6, 0x61, 0xcc, 0x89, 0xc7, 0x09, 0x16, 0x2c, 0x1a, 0xb2, 0x3f, 0x97, 0x6a, 0xae, 0x96,
-	0x9c, 0x47, 0xb0, 0xd0, 0x4c, 0x37, 0x8e, 0x7f, 0x84, 0xf3, 0x15, 0xc1, 0xf2, 0x2e, 0xeb, 0xf6,
-	0x42, 0x22, 0xc9, 0x3f, 0xbd, 0x55, 0xf5, 0x92, 0xca, 0x20, 0x09, 0xf4, 0x4b, 0xf6, 0x6f, 0x25,
-	0x8d, 0xdd, 0x50, 0x5a, 0xf1, 0x8f, 0xa5, 0x29, 0x14, 0x27, 0x22, 0x0e, 0x65, 0x65, 0x2a, 0x7b,
-	0x90, 0x89, 0x39, 0x1f, 0xf3, 0xb0, 0xa2, 0xac, 0xef, 0xe2, 0x30, 0x3c, 0xc4, 0xfe, 0xe0, 0x09,
-	0xc7, 0xa8, 0x61, 0x60, 0x32, 0x3f, 0x96, 0xc9, 0xc2, 0x38, 0x26, 0x8b, 0xd7, 0x4d, 0x5a, 0x3b,
-	0x00, 0x38, 0x8a, 0x98, 0xc4, 0xba, 0x97, 0x4c, 0x19, 0x6b, 0x2a, 0xe3, 0xe2, 0x6c, 0x7d, 0x65,
-	0xa0, 0x6c, 0xb2, 0x2e, 0x95, 0xa4, 0xdb, 0x93, 0xc7, 0x6e, 0x26, 0xdf, 0xda, 0x00, 0xf0, 0x39,
-	0xc1, 0x92, 0x04, 0x1e, 0x96, 0x95, 0x52, 0x15, 0xd5, 0x0a, 0x29, 0x7f, 0x26, 0x8d, 0x3f, 0x91,
-	0xce, 0x77, 0x04, 0x2b, 0xbb, 0x2c, 0x7a, 0x47, 0x78, 0x5b, 0x3f, 0xa5, 0xe8, 0xbf, 0xe5, 0x36,
-	0x58, 0x1d, 0xea, 0x77, 0x3c, 0xd3, 0x9e, 0x31, 0xc7, 0x57, 0xfd, 0xdc, 0xa7, 0x2c, 0x2a, 0x5d,
-	0x77, 0x74, 0xaa, 0x5a, 0xfb, 0xb0, 0x46, 0xde, 0xf7, 0x28, 0x27, 0x5e, 0x8f, 0x44, 0x01, 0x8d,
-	0xda, 0x23, 0xbb, 0xf3, 0x99, 0xdd, 0xb7, 0x4d, 0xe6, 0x4b, 0x93, 0x38, 0x84, 0x39, 0x00, 0x3b,
-	0xc5, 0xf8, 0xe9, 0x4f, 0x16, 0x8c, 0x80, 0x0a, 0x19, 0xd0, 0x1d, 0x93, 0xdb, 0xff, 0x1f, 0x83,
-	0x2c, 0x4a, 0x8d, 0xb9, 0x91, 0xea, 0x26, 0x19, 0x73, 0xcf, 0x61, 0x76, 0xe8, 0x4e, 0x06, 0x73,
-	0x06, 0x5d, 0x9f, 0x33, 0x7f, 0x1b, 0x18, 0x6f, 0x60, 0x6e, 0x72, 0x0b, 0x96, 0x03, 0x53, 0xaa,
-	0x74, 0x51, 0xc9, 0x57, 0x0b, 0xb5, 0xf2, 0xf6, 0x6c, 0x76, 0x92, 0xba, 0x46, 0x72, 0x1e, 0xc3,
-	0x92, 0x5a, 0x36, 0x8e, 0x27, 0x6c, 0xe3, 0xd7, 0xa6, 0xbc, 0xc9, 0x0c, 0x55, 0xa1, 0xa8, 0x00,
-	0xba, 0xc4, 0x51, 0x3f, 0x5a, 0x69, 0x6c, 0x9e, 0x9e, 0xdb, 0xb9, 0x6f, 0xe7, 0x76, 0xee, 0xf2,
-	0xdc, 0x46, 0x1f, 0x12, 0x1b, 0x7d, 0x4e, 0x6c, 0x74, 0x92, 0xd8, 0xe8, 0x34, 0xb1, 0xd1, 0x8f,
-	0xc4, 0x46, 0xbf, 0x12, 0x3b, 0x77, 0x99, 0xd8, 0xe8, 0xd3, 0x4f, 0x3b, 0xf7, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x3a, 0x36, 0x69, 0x2d, 0xee, 0x06, 0x00, 0x00,
+	// 1035 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x4e, 0x48, 0x5e, 0x7e, 0x38, 0xd9, 0xb8, 0xad, 0x9b, 0xa4, 0x9b, 0x74, 0x7b,
+	0x20, 0x12, 0x21, 0x81, 0x08, 0x7a, 0xa1, 0x97, 0x3a, 0x69, 0x21, 0x80, 0x44, 0xd9, 0xa6, 0x12,
+	0xb7, 0xd5, 0x64, 0xf7, 0xc5, 0x19, 0xbc, 0x9e, 0x31, 0x33, 0xb3, 0x08, 0xdf, 0xb8, 0x70, 0xe7,
+	0xcf, 0xe0, 0xc6, 0xbf, 0xc0, 0x05, 0xa9, 0xc7, 0x1e, 0x11, 0x42, 0x11, 0x31, 0x17, 0x94, 0x53,
+	0x4f, 0x9c, 0xd1, 0xcc, 0xac, 0xed, 0x5d, 0x37, 0x80, 0x17, 0xf5, 0xb6, 0x3b, 0xdf, 0xfb, 0xbe,
+	0x79, 0xef, 0xcd, 0x9b, 0xf7, 0x06, 0xd6, 0x14, 0x91, 0xed, 0x50, 0xe0, 0x57, 0x29, 0x4a, 0x25,
+	0xf7, 0xba, 0x82, 0x2b, 0xee, 0xce, 0x76, 0x78, 0x8c, 0x89, 0x5c, 0x7f, 0xbb, 0x45, 0xd5, 0x79,
+	0x7a, 0xba, 0x17, 0xf1, 0xce, 0x7e, 0x8b, 0xb7, 0xf8, 0xbe, 0x81, 0x4f, 0xd3, 0x33, 0xf3, 0x67,
+	0x7e, 0xcc, 0x97, 0xa5, 0xad, 0x83, 0xd6, 0xca, 0xbe, 0x17, 0x50, 0x08, 0x2e, 0xec, 0x8f, 0xff,
+	0x00, 0x6e, 0x9c, 0x10, 0xd9, 0xfe, 0x94, 0x9e, 0x61, 0xd4, 0x8b, 0x12, 0x0c, 0x50, 0x76, 0x39,
+	0x93, 0xe8, 0xde, 0x83, 0x19, 0x63, 0xd7, 0x70, 0xb6, 0x9d, 0x9d, 0x85, 0x83, 0xa5, 0x3d, 0xbb,
+	0xf1, 0xde, 0x23, 0xbd, 0x18, 0x58, 0xcc, 0xff, 0xcb, 0x81, 0xd5, 0x23, 0x94, 0x54, 0xa0, 0x16,
+	0x09, 0xac, 0xab, 0xee, 0x09, 0xd4, 0x8c, 0xeb, 0x31, 0x9e, 0x51, 0x46, 0x15, 0xe5, 0x2c, 0x13,
+	0xb9, 0x39, 0x10, 0xd1, 0xd6, 0x47, 0x43, 0xb4, 0xb9, 0x76, 0x75, 0xb1, 0x35, 0x4e, 0x09, 0x96,
+	0x55, 0xc1, 0xc8, 0xbd, 0x0b, 0xf3, 0xc6, 0xa4, 0x95, 0xd2, 0xb8, 0x31, 0xbd, 0xed, 0xec, 0xcc,
+	0x37, 0xab, 0xcf, 0x2f, 0xb6, 0xa6, 0x82, 0x39, 0xbd, 0xfc, 0x61, 0x4a, 0x63, 0x77, 0x13, 0x66,
+	0x63, 0xde, 0x21, 0x94, 0x35, 0x2a, 0x39, 0x3c, 0x5b, 0x73, 0x3f, 0x86, 0x1a, 0x8d, 0xb1, 0xd3,
+	0xe5, 0x0a, 0x59, 0xd4, 0x0b, 0xdb, 0xd8, 0x6b, 0x54, 0x8d, 0xd9, 0x5d, 0x6d, 0x76, 0x75, 0xb1,
+	0x75, 0x7b, 0x0c, 0xde, 0xe5, 0x1d, 0xaa, 0xb0, 0xd3, 0x55, 0xbd, 0x60, 0x39, 0x07, 0x7d, 0x82,
+	0x3d, 0xff, 0x04, 0x56, 0x9e, 0x2a, 0x22, 0x54, 0x3e, 0xec, 0x82, 0x83, 0xce, 0xb5, 0x0e, 0xde,
+	0x81, 0x37, 0x22, 0x4c, 0x92, 0x70, 0x2c, 0x82, 0x59, 0xbd, 0x78, 0x1c, 0xfb, 0x04, 0x56, 0x73,
+	0xaa, 0x25, 0x0e, 0xc2, 0x7d, 0x13, 0x16, 0xe5, 0x39, 0x4f, 0x93, 0x38, 0x94, 0x5a, 0xc0, 0xa8,
+	0xcf, 0x65, 0xea, 0x0b, 0x16, 0x31, 0xca, 0x3e, 0x81, 0xda, 0x63, 0x42, 0x93, 0x92, 0x7e, 0xbf,
+	0x05, 0xcb, 0x67, 0x84, 0x26, 0xa9, 0xc0, 0x50, 0x20, 0x91, 0x9c, 0x15, 0xdc, 0x5f, 0xca, 0xb0,
+	0xc0, 0x40, 0x7e, 0x0b, 0x56, 0x03, 0xfc, 0x12, 0xa3, 0xb2, 0xc9, 0xd9, 0x87, 0x15, 0x61, 0x78,
+	0x94, 0xb3, 0xeb, 0xb6, 0xa9, 0x0d, 0xd1, 0x6c, 0xa3, 0xf7, 0xa0, 0x76, 0x92, 0x91, 0x27, 0xdf,
+	0xc6, 0xff, 0x1c, 0xdc, 0x43, 0xc2, 0x22, 0x34, 0x39, 0x90, 0x03, 0xe2, 0xa8, 0x74, 0x9c, 0x6b,
+	0x4a, 0xe7, 0x0e, 0xc0, 0x50, 0x56, 0x36, 0xa6, 0xb7, 0x2b, 0x3b, 0xf3, 0xc1, 0xfc, 0x40, 0x51,
+	0xfa, 0xbf, 0x3a, 0xb0, 0x56, 0xd0, 0x2c, 0x73, 0x74, 0xef, 0x40, 0x3d, 0x32, 0xdc, 0x04, 0xe3,
+	0xf0, 0x95, 0x5d, 0xdc, 0x21, 0x36, 0x08, 0x55, 0xba, 0x1f, 0xc0, 0x3a, 0xe3, 0x2a, 0xcc, 0x10,
+	0x72, 0x9a, 0x60, 0x9e, 0x57, 0x31, 0xbc, 0x5b, 0x8c, 0xab, 0xc3, 0x91, 0xc1, 0x88, 0xbc, 0x0f,
+	0x75, 0x4d, 0x3e, 0xe3, 0x29, 0x2b, 0x6c, 0x57, 0x35, 0xb4, 0x55, 0xc6, 0xd5, 0x63, 0x0d, 0x0d,
+	0x09, 0xfe, 0xfb, 0x70, 0xe3, 0x28, 0xed, 0x26, 0x34, 0x22, 0x0a, 0x27, 0x4f, 0x99, 0xff, 0x05,
+	0x2c, 0x17, 0x69, 0xba, 0x46, 0x23, 0xce, 0x14, 0x32, 0x15, 0x9e, 0x13, 0x79, 0x5e, 0x60, 0x2d,
+	0x64, 0xc8, 0x47, 0x44, 0x9e, 0xff, 0x57, 0xb6, 0x53, 0xb8, 0x39, 0xee, 0x50, 0x99, 0x7c, 0xdf,
+	0x07, 0x88, 0x07, 0x74, 0xab, 0x9e, 0x6b, 0x4c, 0x63, 0xc2, 0x39, 0x4b, 0xff, 0x27, 0x7d, 0xc8,
+	0xbc, 0xd3, 0x4d, 0x50, 0xe1, 0x6b, 0xbd, 0xf6, 0x3a, 0x91, 0xfa, 0x06, 0x61, 0xdc, 0xa8, 0xe4,
+	0xae, 0x6d, 0xb6, 0x76, 0xcd, 0xdd, 0xab, 0xfe, 0xe3, 0xdd, 0xd3, 0x52, 0x02, 0x65, 0x9a, 0xa8,
+	0xc6, 0x4c, 0x7e, 0x23, 0xbb, 0xe6, 0x7f, 0x37, 0x0d, 0x75, 0xed, 0xfa, 0x21, 0x49, 0x92, 0x53,
+	0x12, 0x8d, 0x7a, 0xcc, 0x04, 0x31, 0x8c, 0x9c, 0x9c, 0x9e, 0xc8, 0xc9, 0xca, 0x24, 0x4e, 0x56,
+	0x5f, 0x75, 0xd2, 0x7d, 0x00, 0x40, 0x18, 0xe3, 0x8a, 0x98, 0xc1, 0x61, 0xc3, 0xd8, 0xcc, 0x3a,
+	0x74, 0x7d, 0x84, 0xe4, 0x9a, 0x73, 0xce, 0xde, 0xbd, 0x07, 0x10, 0x09, 0x24, 0x0a, 0xe3, 0x90,
+	0xa8, 0xc6, 0xec, 0xb6, 0xb3, 0x53, 0xc9, 0xf4, 0xe7, 0xb3, 0xf5, 0x87, 0xca, 0xff, 0xcd, 0x81,
+	0xfa, 0x21, 0x67, 0x5f, 0xa3, 0x68, 0x15, 0x4b, 0xfa, 0x00, 0xdc, 0x36, 0x8d, 0xda, 0xf6, 0x5e,
+	0xc4, 0xa9, 0x20, 0xc3, 0xe1, 0x35, 0x50, 0x59, 0xd1, 0xb8, 0x19, 0x5f, 0x19, 0xea, 0x3e, 0x82,
+	0x4d, 0xfc, 0xa6, 0x4b, 0x05, 0x86, 0x5d, 0x64, 0x31, 0x65, 0xad, 0x31, 0xf6, 0x74, 0x8e, 0x7d,
+	0xdb, 0x5a, 0x3e, 0xb1, 0x86, 0x05, 0x99, 0x63, 0xf0, 0x32, 0x99, 0x28, 0x2b, 0xb2, 0x78, 0x4c,
+	0xa8, 0x92, 0x13, 0xda, 0xb0, 0xb6, 0x83, 0x7a, 0x8c, 0xf3, 0x52, 0x7a, 0xa6, 0x8f, 0x45, 0x57,
+	0x66, 0xa6, 0x7f, 0x06, 0xeb, 0x4f, 0x52, 0xd1, 0x2a, 0x6a, 0x0f, 0x33, 0xf4, 0x2e, 0xb8, 0x1d,
+	0xca, 0x42, 0xd2, 0xc2, 0x90, 0xb2, 0x50, 0x62, 0xc4, 0x59, 0x2c, 0x0b, 0x19, 0xaa, 0x75, 0x28,
+	0x7b, 0xd8, 0xc2, 0x63, 0xf6, 0xd4, 0x82, 0x7e, 0x1b, 0x36, 0xae, 0x15, 0x2c, 0x39, 0xdf, 0xba,
+	0x5a, 0x23, 0x0e, 0x23, 0x9e, 0x32, 0x3b, 0xdf, 0x66, 0x06, 0xbd, 0xc3, 0x22, 0x87, 0x1a, 0xf0,
+	0x7f, 0x76, 0x60, 0xb1, 0x54, 0x63, 0xff, 0xd7, 0x9b, 0xb9, 0x0b, 0xb3, 0x52, 0x99, 0x3e, 0x61,
+	0xba, 0x6a, 0xb3, 0x7e, 0x75, 0xb1, 0xb5, 0x62, 0x57, 0x72, 0xf5, 0x97, 0xd9, 0xe8, 0x07, 0x46,
+	0x97, 0x08, 0x45, 0x49, 0x12, 0xda, 0x5a, 0x96, 0xa6, 0xc0, 0xe7, 0x46, 0x0f, 0x8c, 0x31, 0x38,
+	0xff, 0xc0, 0xc8, 0xa0, 0xc0, 0x22, 0xfe, 0x8f, 0x0e, 0x2c, 0xfd, 0x8f, 0x3c, 0xf9, 0x30, 0xa3,
+	0x8b, 0x66, 0xd0, 0xd7, 0x16, 0xf3, 0x0f, 0xae, 0xc0, 0x42, 0xee, 0x33, 0x58, 0x12, 0x18, 0x71,
+	0x11, 0x87, 0x86, 0x63, 0x63, 0x5b, 0x38, 0x58, 0x1b, 0xd8, 0x06, 0x06, 0x34, 0xb2, 0xcd, 0x8d,
+	0xab, 0x8b, 0xad, 0x5b, 0x05, 0xeb, 0x9c, 0xcf, 0x8b, 0x62, 0x64, 0x29, 0xfd, 0xfb, 0xb0, 0xaa,
+	0x77, 0x69, 0xf6, 0x4a, 0xce, 0xe3, 0x67, 0xf6, 0xc0, 0xca, 0xc5, 0xb9, 0x0d, 0x55, 0x2d, 0x60,
+	0x0e, 0x6d, 0x3c, 0x4c, 0x83, 0x34, 0x77, 0x5f, 0x5c, 0x7a, 0x53, 0xbf, 0x5c, 0x7a, 0x53, 0x2f,
+	0x2f, 0x3d, 0xe7, 0xdb, 0xbe, 0xe7, 0xfc, 0xd0, 0xf7, 0x9c, 0xe7, 0x7d, 0xcf, 0x79, 0xd1, 0xf7,
+	0x9c, 0xdf, 0xfb, 0x9e, 0xf3, 0x67, 0xdf, 0x9b, 0x7a, 0xd9, 0xf7, 0x9c, 0xef, 0xff, 0xf0, 0xa6,
+	0xfe, 0x1e, 0x00, 0x2e, 0xba, 0x0c, 0xdd, 0x6c, 0x0b, 0x00, 0x00,
 }
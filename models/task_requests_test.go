@@ -1,6 +1,8 @@
 package models_test
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	. "github.com/onsi/ginkgo"
@@ -93,6 +95,36 @@ var _ = Describe("Task requests", func() {
 					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"rootfs"}))
 				})
 			})
+
+			Context("when the idempotency key is valid", func() {
+				BeforeEach(func() {
+					request.IdempotencyKey = "retry-key_1"
+				})
+
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the idempotency key contains invalid characters", func() {
+				BeforeEach(func() {
+					request.IdempotencyKey = "retry/key"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"idempotency_key"}))
+				})
+			})
+
+			Context("when the idempotency key is too long", func() {
+				BeforeEach(func() {
+					request.IdempotencyKey = strings.Repeat("k", 256)
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"idempotency_key"}))
+				})
+			})
 		})
 	})
 
@@ -135,6 +167,99 @@ var _ = Describe("Task requests", func() {
 		})
 	})
 
+	Describe("TasksRequest", func() {
+		Describe("Validate", func() {
+			It("returns nil for known states", func() {
+				request := models.TasksRequest{CellId: "cell-id", States: []string{"Running", "Completed"}}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("returns a validation error for an unknown state", func() {
+				request := models.TasksRequest{States: []string{"Running", "Exploded"}}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"states"}))
+			})
+
+			It("returns a validation error for the invalid state", func() {
+				request := models.TasksRequest{States: []string{"Invalid"}}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"states"}))
+			})
+		})
+
+		Describe("Filter", func() {
+			It("converts the names of the states", func() {
+				request := models.TasksRequest{Domain: "domain", CellId: "cell-id", States: []string{"Running", "Completed"}}
+				Expect(request.Filter()).To(Equal(models.TaskFilter{
+					Domain: "domain",
+					CellID: "cell-id",
+					States: []models.Task_State{models.Task_Running, models.Task_Completed},
+				}))
+			})
+		})
+	})
+
+	Describe("DuplicateTasksRequest", func() {
+		Describe("Validate", func() {
+			It("returns nil when the domain is set", func() {
+				request := models.DuplicateTasksRequest{Domain: "some-domain"}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("returns a validation error when the domain is missing", func() {
+				request := models.DuplicateTasksRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"domain"}))
+			})
+		})
+	})
+
+	Describe("CancelTasksRequest", func() {
+		Describe("Validate", func() {
+			var request models.CancelTasksRequest
+
+			BeforeEach(func() {
+				request = models.CancelTasksRequest{
+					Domain: "some-domain",
+				}
+			})
+
+			Context("when only the domain is set", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when only task guids are set", func() {
+				BeforeEach(func() {
+					request.Domain = ""
+					request.TaskGuids = []string{"guid-1", "guid-2"}
+				})
+
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when neither the domain nor task guids are set", func() {
+				BeforeEach(func() {
+					request.Domain = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"domain"}, models.ErrInvalidField{"task_guids"}))
+				})
+			})
+
+			Context("when a task guid is invalid", func() {
+				BeforeEach(func() {
+					request.TaskGuids = []string{"guid-1", ""}
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"task_guids"}))
+				})
+			})
+		})
+	})
+
 	Describe("FailTaskRequest", func() {
 		Describe("Validate", func() {
 			var request models.FailTaskRequest
@@ -173,4 +298,71 @@ var _ = Describe("Task requests", func() {
 			})
 		})
 	})
+
+	Describe("RejectTaskRequest", func() {
+		Describe("Validate", func() {
+			var request models.RejectTaskRequest
+
+			BeforeEach(func() {
+				request = models.RejectTaskRequest{
+					TaskGuid:        "t-guid",
+					RejectionReason: "insufficient resources",
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the TaskGuid is blank", func() {
+				BeforeEach(func() {
+					request.TaskGuid = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"task_guid"}))
+				})
+			})
+
+			Context("when the RejectionReason is blank", func() {
+				BeforeEach(func() {
+					request.RejectionReason = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"rejection_reason"}))
+				})
+			})
+		})
+	})
+
+	Describe("PurgeCompletedTasksRequest", func() {
+		Describe("Validate", func() {
+			var request models.PurgeCompletedTasksRequest
+
+			BeforeEach(func() {
+				request = models.PurgeCompletedTasksRequest{
+					MinAgeInSeconds: 60,
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the MinAgeInSeconds is negative", func() {
+				BeforeEach(func() {
+					request.MinAgeInSeconds = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"min_age_in_seconds"}))
+				})
+			})
+		})
+	})
 })
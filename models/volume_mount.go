@@ -2,6 +2,7 @@ package models
 
 import (
 	"errors"
+	"path"
 
 	"code.cloudfoundry.org/bbs/format"
 )
@@ -52,6 +53,9 @@ func (v *VolumeMount) Validate() error {
 	if v.Shared != nil && v.Shared.VolumeId == "" {
 		ve = ve.Append(errors.New("invalid volume_mount volume id"))
 	}
+	if err := validateContainerDir(v.ContainerDir); err != nil {
+		ve = ve.Append(err)
+	}
 
 	if !ve.Empty() {
 		return ve
@@ -59,3 +63,15 @@ func (v *VolumeMount) Validate() error {
 
 	return nil
 }
+
+func validateContainerDir(dir string) error {
+	switch {
+	case dir == "":
+		return errors.New("invalid volume_mount container_dir: must not be empty")
+	case !path.IsAbs(dir):
+		return errors.New("invalid volume_mount container_dir: must be an absolute path")
+	case path.Clean(dir) != dir:
+		return errors.New("invalid volume_mount container_dir: must be a clean path without '.' or '..' segments, or repeated or trailing slashes")
+	}
+	return nil
+}
@@ -82,5 +82,45 @@ var _ = Describe("VolumeMount", func() {
 				Expect(err).To(HaveOccurred())
 			})
 		})
+
+		Context("given a clean absolute container dir", func() {
+			BeforeEach(func() {
+				mount.ContainerDir = "/mnt/ok"
+			})
+
+			It("does not return an error", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("given a relative container dir", func() {
+			BeforeEach(func() {
+				mount.ContainerDir = "mnt/rel"
+			})
+
+			It("should return an error", func() {
+				Expect(err).To(MatchError(ContainSubstring("container_dir: must be an absolute path")))
+			})
+		})
+
+		Context("given a container dir with '..' segments", func() {
+			BeforeEach(func() {
+				mount.ContainerDir = "/a/../b"
+			})
+
+			It("should return an error", func() {
+				Expect(err).To(MatchError(ContainSubstring("container_dir: must be a clean path")))
+			})
+		})
+
+		Context("given an empty container dir", func() {
+			BeforeEach(func() {
+				mount.ContainerDir = ""
+			})
+
+			It("should return an error", func() {
+				Expect(err).To(MatchError(ContainSubstring("container_dir: must not be empty")))
+			})
+		})
 	})
 })
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+
+	"code.cloudfoundry.org/bbs/authorization"
+)
+
+// Limit allows each client RequestsPerSecond requests of a class on average,
+// in bursts of up to Burst requests. A Burst of 0 allows bursts of one
+// second's worth of requests.
+type Limit struct {
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	Burst             int     `json:"burst"`
+}
+
+func (l Limit) burst() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return math.Max(1, math.Ceil(l.RequestsPerSecond))
+}
+
+// Config is the limit of each operation class. Classes without a limit are
+// not rate limited.
+type Config struct {
+	Classes map[authorization.OperationClass]Limit `json:"classes"`
+}
+
+// LoadConfig reads a JSON encoded config from the file at configPath.
+func LoadConfig(configPath string) (Config, error) {
+	data, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		return Config{}, err
+	}
+
+	config := Config{}
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		return Config{}, err
+	}
+
+	err = config.Validate()
+	if err != nil {
+		return Config{}, err
+	}
+
+	return config, nil
+}
+
+func (c Config) Validate() error {
+	for class, limit := range c.Classes {
+		if err := class.Validate(); err != nil {
+			return err
+		}
+		if limit.RequestsPerSecond <= 0 {
+			return fmt.Errorf("%s limit must allow a positive number of requests per second", class)
+		}
+		if limit.Burst < 0 {
+			return fmt.Errorf("%s limit has a negative burst", class)
+		}
+	}
+	return nil
+}
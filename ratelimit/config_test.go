@@ -0,0 +1,72 @@
+package ratelimit_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/ratelimit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LoadConfig", func() {
+	var configFile *os.File
+
+	BeforeEach(func() {
+		var err error
+		configFile, err = ioutil.TempFile("", "rate-limits")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.Remove(configFile.Name())
+	})
+
+	writeConfig := func(contents string) {
+		_, err := configFile.WriteString(contents)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configFile.Close()).To(Succeed())
+	}
+
+	It("loads the limit of each class", func() {
+		writeConfig(`{"classes": {"read": {"requests_per_second": 100, "burst": 200}, "write": {"requests_per_second": 10}}}`)
+
+		config, err := ratelimit.LoadConfig(configFile.Name())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Classes).To(Equal(map[authorization.OperationClass]ratelimit.Limit{
+			authorization.Read:  {RequestsPerSecond: 100, Burst: 200},
+			authorization.Write: {RequestsPerSecond: 10},
+		}))
+	})
+
+	It("fails when the file does not exist", func() {
+		_, err := ratelimit.LoadConfig("/does/not/exist")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the file is not valid JSON", func() {
+		writeConfig(`{"classes": {`)
+		_, err := ratelimit.LoadConfig(configFile.Name())
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when a limit names an unknown class", func() {
+		writeConfig(`{"classes": {"superuser": {"requests_per_second": 1}}}`)
+		_, err := ratelimit.LoadConfig(configFile.Name())
+		Expect(err).To(MatchError(ContainSubstring(`unknown operation class "superuser"`)))
+	})
+
+	It("fails when a limit allows no requests", func() {
+		writeConfig(`{"classes": {"write": {"requests_per_second": 0}}}`)
+		_, err := ratelimit.LoadConfig(configFile.Name())
+		Expect(err).To(MatchError(ContainSubstring("positive number of requests per second")))
+	})
+
+	It("fails when a limit has a negative burst", func() {
+		writeConfig(`{"classes": {"write": {"requests_per_second": 1, "burst": -1}}}`)
+		_, err := ratelimit.LoadConfig(configFile.Name())
+		Expect(err).To(MatchError(ContainSubstring("negative burst")))
+	})
+})
@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/clock"
+)
+
+// SweepInterval is how often the limiter forgets the clients whose buckets
+// have refilled, which bounds its memory to the clients seen recently.
+const SweepInterval = time.Minute
+
+// Limiter keeps a token bucket for each client and operation class. Each
+// request takes a token from its bucket, and the bucket refills at the rate
+// of the class's limit up to its burst.
+type Limiter struct {
+	clock  clock.Clock
+	limits map[authorization.OperationClass]Limit
+
+	lock      sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+type bucketKey struct {
+	class  authorization.OperationClass
+	client string
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func NewLimiter(clock clock.Clock, config Config) *Limiter {
+	limits := make(map[authorization.OperationClass]Limit, len(config.Classes))
+	for class, limit := range config.Classes {
+		limits[class] = limit
+	}
+
+	return &Limiter{
+		clock:     clock,
+		limits:    limits,
+		buckets:   map[bucketKey]*bucket{},
+		lastSweep: clock.Now(),
+	}
+}
+
+// Limits reports whether requests of the class are rate limited at all.
+func (l *Limiter) Limits(class authorization.OperationClass) bool {
+	if l == nil {
+		return false
+	}
+	_, ok := l.limits[class]
+	return ok
+}
+
+// Allow takes a token from the client's bucket for the class. When the
+// bucket is empty it returns false and how long until the next token.
+func (l *Limiter) Allow(class authorization.OperationClass, client string) (bool, time.Duration) {
+	limit, ok := l.limits[class]
+	if !ok {
+		return true, 0
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.clock.Now()
+	l.sweep(now)
+
+	key := bucketKey{class: class, client: client}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: limit.burst(), updated: now}
+		l.buckets[key] = b
+	}
+	b.refill(limit, now)
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / limit.RequestsPerSecond * float64(time.Second))
+		return false, wait
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < SweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		limit := l.limits[key.class]
+		b.refill(limit, now)
+		if b.tokens >= limit.burst() {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func (b *bucket) refill(limit Limit, now time.Time) {
+	elapsed := now.Sub(b.updated)
+	if elapsed <= 0 {
+		return
+	}
+
+	b.tokens += elapsed.Seconds() * limit.RequestsPerSecond
+	if burst := limit.burst(); b.tokens > burst {
+		b.tokens = burst
+	}
+	b.updated = now
+}
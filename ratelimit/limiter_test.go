@@ -0,0 +1,105 @@
+package ratelimit_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/clock/fakeclock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Limiter", func() {
+	var (
+		fakeClock *fakeclock.FakeClock
+		limiter   *ratelimit.Limiter
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		limiter = ratelimit.NewLimiter(fakeClock, ratelimit.Config{
+			Classes: map[authorization.OperationClass]ratelimit.Limit{
+				authorization.Write: {RequestsPerSecond: 2, Burst: 3},
+			},
+		})
+	})
+
+	exhaust := func(client string) {
+		for i := 0; i < 3; i++ {
+			allowed, _ := limiter.Allow(authorization.Write, client)
+			Expect(allowed).To(BeTrue())
+		}
+	}
+
+	It("allows a burst of requests and then throttles the client", func() {
+		exhaust("cc")
+
+		allowed, wait := limiter.Allow(authorization.Write, "cc")
+		Expect(allowed).To(BeFalse())
+		Expect(wait).To(Equal(500 * time.Millisecond))
+	})
+
+	It("does not throttle other clients", func() {
+		exhaust("cc")
+
+		allowed, _ := limiter.Allow(authorization.Write, "rep")
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("does not throttle other classes", func() {
+		exhaust("cc")
+
+		Expect(limiter.Limits(authorization.Read)).To(BeFalse())
+		allowed, _ := limiter.Allow(authorization.Read, "cc")
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("replenishes tokens over time", func() {
+		exhaust("cc")
+
+		fakeClock.Increment(500 * time.Millisecond)
+		allowed, _ := limiter.Allow(authorization.Write, "cc")
+		Expect(allowed).To(BeTrue())
+
+		allowed, _ = limiter.Allow(authorization.Write, "cc")
+		Expect(allowed).To(BeFalse())
+	})
+
+	It("replenishes no more than the burst", func() {
+		exhaust("cc")
+
+		fakeClock.Increment(time.Hour)
+		exhaust("cc")
+
+		allowed, _ := limiter.Allow(authorization.Write, "cc")
+		Expect(allowed).To(BeFalse())
+	})
+
+	Context("when the limit has no burst", func() {
+		BeforeEach(func() {
+			limiter = ratelimit.NewLimiter(fakeClock, ratelimit.Config{
+				Classes: map[authorization.OperationClass]ratelimit.Limit{
+					authorization.Write: {RequestsPerSecond: 0.5},
+				},
+			})
+		})
+
+		It("allows one request at a time", func() {
+			allowed, _ := limiter.Allow(authorization.Write, "cc")
+			Expect(allowed).To(BeTrue())
+
+			allowed, wait := limiter.Allow(authorization.Write, "cc")
+			Expect(allowed).To(BeFalse())
+			Expect(wait).To(Equal(2 * time.Second))
+		})
+	})
+
+	Context("when the limiter is nil", func() {
+		It("limits nothing", func() {
+			var nilLimiter *ratelimit.Limiter
+			Expect(nilLimiter.Limits(authorization.Write)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Wrap serves requests with handler while the client has not exceeded the
+// limiter's limit for the class. Other requests are answered with 429 Too
+// Many Requests, a Retry-After header and a models.Error of type
+// Error_RateLimited. A nil limiter, or one without a limit for the class,
+// serves every request.
+func Wrap(logger lager.Logger, limiter *Limiter, class authorization.OperationClass, handler http.Handler) http.Handler {
+	if !limiter.Limits(class) {
+		return handler
+	}
+
+	logger = logger.Session("rate-limit")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client := Client(r)
+		allowed, wait := limiter.Allow(class, client)
+		if allowed {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		logger.Info("rate-limited", lager.Data{
+			"client":          client,
+			"operation-class": class,
+			"method":          r.Method,
+			"request":         r.URL.String(),
+		})
+
+		writeRateLimited(w, wait.Seconds())
+	})
+}
+
+// Client names the client whose bucket a request draws from: its identity,
+// or its remote IP address when it did not present a certificate.
+func Client(r *http.Request) string {
+	identity := middleware.Identity(r)
+	if identity != middleware.AnonymousIdentity {
+		return identity
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func writeRateLimited(w http.ResponseWriter, waitSeconds float64) {
+	responseBytes, err := proto.Marshal(&models.ErrorResponse{Error: models.ErrRateLimited})
+	if err != nil {
+		panic("Unable to encode Proto: " + err.Error())
+	}
+
+	retryAfter := int(math.Ceil(waitSeconds))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	w.Header().Set("Content-Length", strconv.Itoa(len(responseBytes)))
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	w.Write(responseBytes)
+}
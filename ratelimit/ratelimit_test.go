@@ -0,0 +1,127 @@
+package ratelimit_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Wrap", func() {
+	var (
+		logger    *lagertest.TestLogger
+		fakeClock *fakeclock.FakeClock
+		limiter   *ratelimit.Limiter
+		served    int
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		limiter = ratelimit.NewLimiter(fakeClock, ratelimit.Config{
+			Classes: map[authorization.OperationClass]ratelimit.Limit{
+				authorization.Write: {RequestsPerSecond: 0.25, Burst: 2},
+			},
+		})
+		served = 0
+	})
+
+	newRequest := func(commonName, remoteAddr string) *http.Request {
+		request, err := http.NewRequest("POST", "/v1/some/route", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.RemoteAddr = remoteAddr
+		if commonName != "" {
+			request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{
+				{Subject: pkix.Name{CommonName: commonName}},
+			}}
+		}
+		return request
+	}
+
+	serve := func(class authorization.OperationClass, request *http.Request) *httptest.ResponseRecorder {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { served++ })
+		recorder := httptest.NewRecorder()
+		ratelimit.Wrap(logger, limiter, class, handler).ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	expectRateLimited := func(recorder *httptest.ResponseRecorder) {
+		Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(recorder.Header().Get("Retry-After")).To(Equal("4"))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/x-protobuf"))
+
+		response := &models.DesiredLRPLifecycleResponse{}
+		Expect(response.Unmarshal(recorder.Body.Bytes())).To(Succeed())
+		Expect(response.Error).To(Equal(models.ErrRateLimited))
+	}
+
+	It("throttles a client that exceeds its rate without affecting others", func() {
+		serve(authorization.Write, newRequest("cc", "10.0.0.1:1234"))
+		serve(authorization.Write, newRequest("cc", "10.0.0.1:1234"))
+		Expect(served).To(Equal(2))
+
+		expectRateLimited(serve(authorization.Write, newRequest("cc", "10.0.0.1:1234")))
+		Expect(served).To(Equal(2))
+		Expect(logger).To(gbytes.Say("rate-limit.rate-limited"))
+		Expect(logger).To(gbytes.Say(`"client":"cc"`))
+
+		recorder := serve(authorization.Write, newRequest("rep", "10.0.0.1:1234"))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(served).To(Equal(3))
+	})
+
+	It("serves the client again once its tokens replenish", func() {
+		serve(authorization.Write, newRequest("cc", "10.0.0.1:1234"))
+		serve(authorization.Write, newRequest("cc", "10.0.0.1:1234"))
+		expectRateLimited(serve(authorization.Write, newRequest("cc", "10.0.0.1:1234")))
+
+		fakeClock.Increment(4 * time.Second)
+		recorder := serve(authorization.Write, newRequest("cc", "10.0.0.1:1234"))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(served).To(Equal(3))
+	})
+
+	It("serves operation classes without a limit", func() {
+		for i := 0; i < 5; i++ {
+			serve(authorization.Read, newRequest("cc", "10.0.0.1:1234"))
+		}
+		Expect(served).To(Equal(5))
+	})
+
+	Context("when clients do not present a certificate", func() {
+		It("tells them apart by remote IP", func() {
+			serve(authorization.Write, newRequest("", "10.0.0.1:1234"))
+			serve(authorization.Write, newRequest("", "10.0.0.1:5678"))
+			expectRateLimited(serve(authorization.Write, newRequest("", "10.0.0.1:9012")))
+
+			recorder := serve(authorization.Write, newRequest("", "10.0.0.2:1234"))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(served).To(Equal(3))
+		})
+	})
+
+	Context("when there is no limiter", func() {
+		BeforeEach(func() {
+			limiter = nil
+		})
+
+		It("serves every request", func() {
+			for i := 0; i < 5; i++ {
+				serve(authorization.Write, newRequest("cc", "10.0.0.1:1234"))
+			}
+			Expect(served).To(Equal(5))
+		})
+	})
+})
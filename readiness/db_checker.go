@@ -0,0 +1,77 @@
+package readiness
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+const DatabaseComponent = "database"
+
+//go:generate counterfeiter -o readinessfakes/fake_pinger.go . Pinger
+
+// Pinger checks that a database is reachable. *sql.DB is a Pinger.
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// DBChecker pings the database every interval and reports it as degraded
+// while the pings fail. A ping taking longer than the interval fails.
+type DBChecker struct {
+	logger   lager.Logger
+	tracker  *Tracker
+	pinger   Pinger
+	clock    clock.Clock
+	interval time.Duration
+}
+
+// NewDBChecker registers the database with tracker, which stays starting
+// until the first ping succeeds.
+func NewDBChecker(logger lager.Logger, tracker *Tracker, pinger Pinger, clock clock.Clock, interval time.Duration) *DBChecker {
+	tracker.Register(DatabaseComponent, "connecting")
+
+	return &DBChecker{
+		logger:   logger.Session("db-checker"),
+		tracker:  tracker,
+		pinger:   pinger,
+		clock:    clock,
+		interval: interval,
+	}
+}
+
+func (c *DBChecker) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	c.logger.Info("started")
+	defer c.logger.Info("finished")
+
+	c.check()
+	close(ready)
+
+	ticker := c.clock.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C():
+			c.check()
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+func (c *DBChecker) check() {
+	ctx, cancel := context.WithTimeout(context.Background(), c.interval)
+	defer cancel()
+
+	err := c.pinger.PingContext(ctx)
+	if err != nil {
+		c.logger.Error("failed-to-ping-database", err)
+		c.tracker.SetDegraded(DatabaseComponent, "database unreachable")
+		return
+	}
+
+	c.tracker.SetReady(DatabaseComponent)
+}
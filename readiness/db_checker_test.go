@@ -0,0 +1,62 @@
+package readiness_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/readiness"
+	"code.cloudfoundry.org/bbs/readiness/readinessfakes"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DBChecker", func() {
+	const interval = 10 * time.Second
+
+	var (
+		fakePinger *readinessfakes.FakePinger
+		fakeClock  *fakeclock.FakeClock
+		tracker    *readiness.Tracker
+		process    ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakePinger = new(readinessfakes.FakePinger)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		logger := lagertest.NewTestLogger("test")
+		tracker = readiness.NewTracker(logger)
+
+		checker := readiness.NewDBChecker(logger, tracker, fakePinger, fakeClock, interval)
+		Expect(tracker.Status().Reasons).To(HaveKeyWithValue(readiness.DatabaseComponent, "connecting"))
+
+		process = ifrit.Invoke(checker)
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	It("reports the database as ready once it is reachable", func() {
+		Expect(fakePinger.PingContextCallCount()).To(Equal(1))
+		Expect(tracker.Status().State).To(Equal(readiness.Ready))
+	})
+
+	It("reports the database as degraded while the pings fail", func() {
+		fakePinger.PingContextReturns(errors.New("unreachable"))
+		fakeClock.WaitForWatcherAndIncrement(interval)
+
+		Eventually(func() readiness.State { return tracker.Status().State }).Should(Equal(readiness.Degraded))
+		Expect(tracker.Status().Reasons).To(HaveKeyWithValue(readiness.DatabaseComponent, "database unreachable"))
+
+		fakePinger.PingContextReturns(nil)
+		fakeClock.WaitForWatcherAndIncrement(interval)
+
+		Eventually(func() readiness.State { return tracker.Status().State }).Should(Equal(readiness.Ready))
+	})
+})
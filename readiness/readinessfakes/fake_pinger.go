@@ -0,0 +1,77 @@
+// This file was generated by counterfeiter
+package readinessfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/readiness"
+)
+
+type FakePinger struct {
+	PingContextStub        func(ctx context.Context) error
+	pingContextMutex       sync.RWMutex
+	pingContextArgsForCall []struct {
+		ctx context.Context
+	}
+	pingContextReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePinger) PingContext(ctx context.Context) error {
+	fake.pingContextMutex.Lock()
+	fake.pingContextArgsForCall = append(fake.pingContextArgsForCall, struct {
+		ctx context.Context
+	}{ctx})
+	fake.recordInvocation("PingContext", []interface{}{ctx})
+	fake.pingContextMutex.Unlock()
+	if fake.PingContextStub != nil {
+		return fake.PingContextStub(ctx)
+	} else {
+		return fake.pingContextReturns.result1
+	}
+}
+
+func (fake *FakePinger) PingContextCallCount() int {
+	fake.pingContextMutex.RLock()
+	defer fake.pingContextMutex.RUnlock()
+	return len(fake.pingContextArgsForCall)
+}
+
+func (fake *FakePinger) PingContextArgsForCall(i int) context.Context {
+	fake.pingContextMutex.RLock()
+	defer fake.pingContextMutex.RUnlock()
+	return fake.pingContextArgsForCall[i].ctx
+}
+
+func (fake *FakePinger) PingContextReturns(result1 error) {
+	fake.PingContextStub = nil
+	fake.pingContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePinger) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pingContextMutex.RLock()
+	defer fake.pingContextMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePinger) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ readiness.Pinger = new(FakePinger)
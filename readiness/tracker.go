@@ -0,0 +1,211 @@
+package readiness
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+	"github.com/tedsuo/ifrit"
+)
+
+const readinessState = metric.Metric("ReadinessState")
+
+// State is the readiness of the BBS as a whole. It is emitted as the
+// ReadinessState metric, so the values must not change.
+type State int
+
+const (
+	// Starting means some component has not come up yet, e.g. migrations
+	// are still running or the lock has not been acquired.
+	Starting State = iota
+	// Ready means every component is up.
+	Ready
+	// Degraded means a component that was up has stopped working, e.g. the
+	// database is unreachable.
+	Degraded
+)
+
+func (s State) String() string {
+	switch s {
+	case Starting:
+		return "starting"
+	case Ready:
+		return "ready"
+	case Degraded:
+		return "degraded"
+	default:
+		return "unknown"
+	}
+}
+
+func (s State) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// Status is the state of the BBS, with the reason of every component that is
+// not ready.
+type Status struct {
+	State   State             `json:"state"`
+	Reasons map[string]string `json:"reasons,omitempty"`
+}
+
+type componentStatus struct {
+	state  State
+	reason string
+}
+
+// Tracker consolidates the readiness reported by each component of the BBS.
+// The BBS is starting until a component is registered, ready once every
+// registered component is, and degraded as soon as any of them is.
+type Tracker struct {
+	logger lager.Logger
+
+	lock       sync.Mutex
+	components map[string]componentStatus
+	state      State
+}
+
+func NewTracker(logger lager.Logger) *Tracker {
+	t := &Tracker{
+		logger:     logger.Session("readiness"),
+		components: map[string]componentStatus{},
+		state:      Starting,
+	}
+	t.sendMetric(Starting)
+	return t
+}
+
+// Register adds a component that is starting for the given reason. The BBS is
+// not ready until it is.
+func (t *Tracker) Register(component, reason string) {
+	t.set(component, Starting, reason)
+}
+
+func (t *Tracker) SetReady(component string) {
+	t.set(component, Ready, "")
+}
+
+func (t *Tracker) SetStarting(component, reason string) {
+	t.set(component, Starting, reason)
+}
+
+func (t *Tracker) SetDegraded(component, reason string) {
+	t.set(component, Degraded, reason)
+}
+
+func (t *Tracker) Status() Status {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	status := Status{State: t.state}
+	for component, componentStatus := range t.components {
+		if componentStatus.state == Ready {
+			continue
+		}
+		if status.Reasons == nil {
+			status.Reasons = map[string]string{}
+		}
+		status.Reasons[component] = componentStatus.reason
+	}
+	return status
+}
+
+// ServeHTTP responds with the Status as JSON, with a 200 when the BBS is
+// ready and a 503 otherwise.
+func (t *Tracker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status := t.Status()
+
+	code := http.StatusOK
+	if status.State != Ready {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(status)
+}
+
+// Runner reports the readiness of runner as component: starting for reason
+// until runner is ready, ready while it runs, and starting again once it has
+// exited.
+func (t *Tracker) Runner(component, reason string, runner ifrit.Runner) ifrit.Runner {
+	t.Register(component, reason)
+
+	return ifrit.RunFunc(func(signals <-chan os.Signal, ready chan<- struct{}) error {
+		innerReady := make(chan struct{})
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(signals, innerReady)
+		}()
+
+		select {
+		case <-innerReady:
+			t.SetReady(component)
+			close(ready)
+		case err := <-errCh:
+			t.SetStarting(component, "exited")
+			return err
+		}
+
+		err := <-errCh
+		t.SetStarting(component, "exited")
+		return err
+	})
+}
+
+func (t *Tracker) set(component string, state State, reason string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	previous, registered := t.components[component]
+	if registered && previous.state == state && previous.reason == reason {
+		return
+	}
+	t.components[component] = componentStatus{state: state, reason: reason}
+
+	t.logger.Info("component-changed", lager.Data{"component": component, "state": state.String(), "reason": reason})
+
+	overall := t.overallState()
+	if overall == t.state {
+		return
+	}
+	t.state = overall
+
+	t.logger.Info("state-changed", lager.Data{"state": overall.String(), "components": t.notReady()})
+	t.sendMetric(overall)
+}
+
+func (t *Tracker) overallState() State {
+	overall := Ready
+	for _, componentStatus := range t.components {
+		switch componentStatus.state {
+		case Degraded:
+			return Degraded
+		case Starting:
+			overall = Starting
+		}
+	}
+	return overall
+}
+
+func (t *Tracker) notReady() []string {
+	components := []string{}
+	for component, componentStatus := range t.components {
+		if componentStatus.state != Ready {
+			components = append(components, component)
+		}
+	}
+	sort.Strings(components)
+	return components
+}
+
+func (t *Tracker) sendMetric(state State) {
+	err := readinessState.Send(int(state))
+	if err != nil {
+		t.logger.Error("failed-to-send-readiness-state-metric", err)
+	}
+}
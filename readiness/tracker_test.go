@@ -0,0 +1,164 @@
+package readiness_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	"code.cloudfoundry.org/bbs/readiness"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracker", func() {
+	var (
+		sender  *fake.FakeMetricSender
+		tracker *readiness.Tracker
+	)
+
+	BeforeEach(func() {
+		sender = fake.NewFakeMetricSender()
+		dropsonde_metrics.Initialize(sender, nil)
+
+		tracker = readiness.NewTracker(lagertest.NewTestLogger("test"))
+		tracker.Register("migrations", "migrating")
+		tracker.Register("lock", "waiting for lock")
+	})
+
+	readinessMetric := func() float64 {
+		return sender.GetValue("ReadinessState").Value
+	}
+
+	It("is starting with the reasons of the components that are not ready", func() {
+		tracker.SetReady("lock")
+
+		Expect(tracker.Status()).To(Equal(readiness.Status{
+			State:   readiness.Starting,
+			Reasons: map[string]string{"migrations": "migrating"},
+		}))
+		Expect(readinessMetric()).To(BeEquivalentTo(readiness.Starting))
+	})
+
+	It("is ready once every component is", func() {
+		tracker.SetReady("lock")
+		tracker.SetReady("migrations")
+
+		Expect(tracker.Status()).To(Equal(readiness.Status{State: readiness.Ready}))
+		Expect(readinessMetric()).To(BeEquivalentTo(readiness.Ready))
+	})
+
+	It("is degraded as soon as any component is", func() {
+		tracker.SetDegraded("database", "database unreachable")
+
+		Expect(tracker.Status().State).To(Equal(readiness.Degraded))
+		Expect(tracker.Status().Reasons).To(HaveKeyWithValue("database", "database unreachable"))
+		Expect(readinessMetric()).To(BeEquivalentTo(readiness.Degraded))
+
+		tracker.SetReady("database")
+		Expect(tracker.Status().State).To(Equal(readiness.Starting))
+	})
+
+	Describe("ServeHTTP", func() {
+		serve := func() (*httptest.ResponseRecorder, map[string]interface{}) {
+			recorder := httptest.NewRecorder()
+			tracker.ServeHTTP(recorder, httptest.NewRequest("GET", "/ready", nil))
+
+			body := map[string]interface{}{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &body)).To(Succeed())
+			return recorder, body
+		}
+
+		It("responds with 503 and the reasons while starting", func() {
+			recorder, body := serve()
+			Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(body).To(Equal(map[string]interface{}{
+				"state": "starting",
+				"reasons": map[string]interface{}{
+					"migrations": "migrating",
+					"lock":       "waiting for lock",
+				},
+			}))
+		})
+
+		It("responds with 503 when degraded", func() {
+			tracker.SetDegraded("database", "database unreachable")
+
+			recorder, body := serve()
+			Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(body["state"]).To(Equal("degraded"))
+		})
+
+		It("responds with 200 when ready", func() {
+			tracker.SetReady("lock")
+			tracker.SetReady("migrations")
+
+			recorder, body := serve()
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(body).To(Equal(map[string]interface{}{"state": "ready"}))
+		})
+	})
+
+	Describe("Runner", func() {
+		var (
+			innerReady chan struct{}
+			innerExit  chan error
+			process    ifrit.Process
+		)
+
+		BeforeEach(func() {
+			innerReady = make(chan struct{})
+			innerExit = make(chan error, 1)
+			tracker.SetReady("lock")
+			tracker.SetReady("migrations")
+		})
+
+		JustBeforeEach(func() {
+			innerReady, innerExit := innerReady, innerExit
+			runner := tracker.Runner("server", "starting", ifrit.RunFunc(func(signals <-chan os.Signal, ready chan<- struct{}) error {
+				select {
+				case <-innerReady:
+					close(ready)
+				case <-signals:
+					return nil
+				}
+
+				select {
+				case err := <-innerExit:
+					return err
+				case <-signals:
+					return nil
+				}
+			}))
+			process = ifrit.Background(runner)
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+		})
+
+		It("reports the component as starting until the runner is ready", func() {
+			Expect(tracker.Status().Reasons).To(HaveKeyWithValue("server", "starting"))
+			Consistently(process.Ready()).ShouldNot(BeClosed())
+
+			close(innerReady)
+			Eventually(process.Ready()).Should(BeClosed())
+			Expect(tracker.Status().State).To(Equal(readiness.Ready))
+		})
+
+		It("reports the component as starting again once the runner exits", func() {
+			close(innerReady)
+			Eventually(process.Ready()).Should(BeClosed())
+
+			innerExit <- errors.New("boom")
+			Eventually(process.Wait()).Should(Receive(MatchError("boom")))
+			Expect(tracker.Status().Reasons).To(HaveKeyWithValue("server", "exited"))
+		})
+	})
+})
@@ -7,21 +7,27 @@ const (
 	PingRoute = "Ping"
 
 	// Domains
-	DomainsRoute      = "Domains"
-	UpsertDomainRoute = "UpsertDomain"
+	DomainsRoute         = "Domains"
+	UpsertDomainRoute    = "UpsertDomain"
+	UpsertDomainsRoute   = "UpsertDomains"
+	DomainFreshnessRoute = "DomainFreshness"
 
 	// Actual LRPs
 	ActualLRPGroupsRoute                     = "ActualLRPGroups"
 	ActualLRPGroupsByProcessGuidRoute        = "ActualLRPGroupsByProcessGuid"
 	ActualLRPGroupByProcessGuidAndIndexRoute = "ActualLRPGroupsByProcessGuidAndIndex"
+	CrashingActualLRPsRoute                  = "CrashingActualLRPs"
+	CellPlacementsRoute                      = "CellPlacements"
 
 	// Actual LRP Lifecycle
-	ClaimActualLRPRoute  = "ClaimActualLRP"
-	StartActualLRPRoute  = "StartActualLRP"
-	CrashActualLRPRoute  = "CrashActualLRP"
-	FailActualLRPRoute   = "FailActualLRP"
-	RemoveActualLRPRoute = "RemoveActualLRP"
-	RetireActualLRPRoute = "RetireActualLRP"
+	ClaimActualLRPRoute         = "ClaimActualLRP"
+	StartActualLRPRoute         = "StartActualLRP"
+	StartActualLRPsRoute        = "StartActualLRPs"
+	CrashActualLRPRoute         = "CrashActualLRP"
+	FailActualLRPRoute          = "FailActualLRP"
+	RemoveActualLRPRoute        = "RemoveActualLRP"
+	RetireActualLRPRoute        = "RetireActualLRP"
+	RetireActualLRPsOnCellRoute = "RetireActualLRPsOnCell"
 
 	// Evacuation
 	RemoveEvacuatingActualLRPRoute = "RemoveEvacuatingActualLRP"
@@ -34,6 +40,8 @@ const (
 	DesiredLRPsRoute               = "DesiredLRPs_r2"
 	DesiredLRPSchedulingInfosRoute = "DesiredLRPSchedulingInfos"
 	DesiredLRPByProcessGuidRoute   = "DesiredLRPByProcessGuid_r2"
+	DesiredLRPDiffRoute            = "DesiredLRPDiff"
+	ValidateDesiredLRPRoute        = "ValidateDesiredLRP"
 
 	DesiredLRPsRoute_r1             = "DesiredLRPs_r1" // Deprecated
 	DesiredLRPByProcessGuidRoute_r1 = "DesiredLRPByProcessGuid_r1"
@@ -48,16 +56,22 @@ const (
 	DesireDesiredLRPRoute_r1 = "DesireDesiredLRP_r1"
 	DesireDesiredLRPRoute_r0 = "DesireDesiredLRP"
 
+	// LRP Convergence
+	ConvergeLRPsRoute = "ConvergeLRPs"
+
 	// Tasks
-	TasksRoute         = "Tasks_r2"
-	TaskByGuidRoute    = "TaskByGuid_r2"
-	DesireTaskRoute    = "DesireTask_r2"
-	StartTaskRoute     = "StartTask"
-	CancelTaskRoute    = "CancelTask"
-	FailTaskRoute      = "FailTask"
-	CompleteTaskRoute  = "CompleteTask"
-	ResolvingTaskRoute = "ResolvingTask"
-	DeleteTaskRoute    = "DeleteTask"
+	TasksRoute          = "Tasks_r2"
+	TaskByGuidRoute     = "TaskByGuid_r2"
+	DuplicateTasksRoute = "DuplicateTasks"
+	DesireTaskRoute     = "DesireTask_r2"
+	StartTaskRoute      = "StartTask"
+	CancelTaskRoute     = "CancelTask"
+	CancelTasksRoute    = "CancelTasks"
+	FailTaskRoute       = "FailTask"
+	RejectTaskRoute     = "RejectTask"
+	CompleteTaskRoute   = "CompleteTask"
+	ResolvingTaskRoute  = "ResolvingTask"
+	DeleteTaskRoute     = "DeleteTask"
 
 	TasksRoute_r1      = "Tasks_r1"      // Deprecated
 	TaskByGuidRoute_r1 = "TaskByGuid_r1" // Deprecated
@@ -68,11 +82,27 @@ const (
 	TaskByGuidRoute_r0 = "TaskByGuid"    // Deprecated
 
 	// Event Streaming
-	EventStreamRoute_r0 = "EventStream_r0"
+	EventStreamRoute_r0  = "EventStream_r0"
+	TaskEventStreamRoute = "TaskEventStream"
+
+	// Counts
+	ObjectCountsRoute = "ObjectCounts"
 
 	// Cell Presence
-	CellsRoute    = "Cells_r2"
-	CellsRoute_r1 = "Cells_r1"
+	CellsRoute                = "Cells_r2"
+	CellsRoute_r1             = "Cells_r1"
+	CellPresenceStatusesRoute = "CellPresenceStatuses"
+
+	// Admin
+	ReleaseLockRoute                 = "ReleaseLock"
+	DesiredLRPsIncludingDeletedRoute = "DesiredLRPsIncludingDeleted"
+	PurgeCompletedTasksRoute         = "PurgeCompletedTasks"
+	ConvergenceStatusRoute           = "ConvergenceStatus"
+	PauseConvergenceRoute            = "PauseConvergence"
+	ResumeConvergenceRoute           = "ResumeConvergence"
+	ImportRoute                      = "Import"
+	ExportRoute                      = "Export"
+	CoordinationStateRoute           = "CoordinationState"
 )
 
 var Routes = rata.Routes{
@@ -82,19 +112,25 @@ var Routes = rata.Routes{
 	// Domains
 	{Path: "/v1/domains/list", Method: "POST", Name: DomainsRoute},
 	{Path: "/v1/domains/upsert", Method: "POST", Name: UpsertDomainRoute},
+	{Path: "/v1/domains/upsert_batch", Method: "POST", Name: UpsertDomainsRoute},
+	{Path: "/v1/domains/freshness", Method: "POST", Name: DomainFreshnessRoute},
 
 	// Actual LRPs
 	{Path: "/v1/actual_lrp_groups/list", Method: "POST", Name: ActualLRPGroupsRoute},
 	{Path: "/v1/actual_lrp_groups/list_by_process_guid", Method: "POST", Name: ActualLRPGroupsByProcessGuidRoute},
 	{Path: "/v1/actual_lrp_groups/get_by_process_guid_and_index", Method: "POST", Name: ActualLRPGroupByProcessGuidAndIndexRoute},
+	{Path: "/v1/actual_lrps/crashing", Method: "POST", Name: CrashingActualLRPsRoute},
+	{Path: "/v1/actual_lrps/cell_placements", Method: "POST", Name: CellPlacementsRoute},
 
 	// Actual LRP Lifecycle
 	{Path: "/v1/actual_lrps/claim", Method: "POST", Name: ClaimActualLRPRoute},
 	{Path: "/v1/actual_lrps/start", Method: "POST", Name: StartActualLRPRoute},
+	{Path: "/v1/actual_lrps/start_batch", Method: "POST", Name: StartActualLRPsRoute},
 	{Path: "/v1/actual_lrps/crash", Method: "POST", Name: CrashActualLRPRoute},
 	{Path: "/v1/actual_lrps/fail", Method: "POST", Name: FailActualLRPRoute},
 	{Path: "/v1/actual_lrps/remove", Method: "POST", Name: RemoveActualLRPRoute},
 	{Path: "/v1/actual_lrps/retire", Method: "POST", Name: RetireActualLRPRoute},
+	{Path: "/v1/actual_lrps/retire_on_cell", Method: "POST", Name: RetireActualLRPsOnCellRoute},
 
 	// Evacuation
 	{Path: "/v1/actual_lrps/remove_evacuating", Method: "POST", Name: RemoveEvacuatingActualLRPRoute},
@@ -108,6 +144,7 @@ var Routes = rata.Routes{
 
 	{Path: "/v1/desired_lrps/list.r2", Method: "POST", Name: DesiredLRPsRoute},
 	{Path: "/v1/desired_lrps/get_by_process_guid.r2", Method: "POST", Name: DesiredLRPByProcessGuidRoute},
+	{Path: "/v1/desired_lrps/diff", Method: "POST", Name: DesiredLRPDiffRoute},
 
 	{Path: "/v1/desired_lrps/list.r1", Method: "POST", Name: DesiredLRPsRoute_r1},                            // Deprecated
 	{Path: "/v1/desired_lrps/get_by_process_guid.r1", Method: "POST", Name: DesiredLRPByProcessGuidRoute_r1}, // Deprecated
@@ -119,11 +156,16 @@ var Routes = rata.Routes{
 	{Path: "/v1/desired_lrp/desire.r1", Method: "POST", Name: DesireDesiredLRPRoute_r1}, // Deprecated
 	{Path: "/v1/desired_lrp/update", Method: "POST", Name: UpdateDesiredLRPRoute},
 	{Path: "/v1/desired_lrp/remove", Method: "POST", Name: RemoveDesiredLRPRoute},
+	{Path: "/v1/desired_lrp/validate", Method: "POST", Name: ValidateDesiredLRPRoute},
 	{Path: "/v1/desired_lrp/desire", Method: "POST", Name: DesireDesiredLRPRoute_r0}, // Deprecated
 
+	// LRP Convergence
+	{Path: "/v1/lrps/converge", Method: "POST", Name: ConvergeLRPsRoute},
+
 	// Tasks
 	{Path: "/v1/tasks/list.r2", Method: "POST", Name: TasksRoute},
 	{Path: "/v1/tasks/get_by_task_guid.r2", Method: "POST", Name: TaskByGuidRoute},
+	{Path: "/v1/tasks/duplicates", Method: "POST", Name: DuplicateTasksRoute},
 
 	{Path: "/v1/tasks/list.r1", Method: "POST", Name: TasksRoute_r1},                  // Deprecated
 	{Path: "/v1/tasks/get_by_task_guid.r1", Method: "POST", Name: TaskByGuidRoute_r1}, // Deprecated
@@ -135,7 +177,9 @@ var Routes = rata.Routes{
 	{Path: "/v1/tasks/desire.r1", Method: "POST", Name: DesireTaskRoute_r1}, // Deprecated
 	{Path: "/v1/tasks/start", Method: "POST", Name: StartTaskRoute},
 	{Path: "/v1/tasks/cancel", Method: "POST", Name: CancelTaskRoute},
+	{Path: "/v1/tasks/cancel_many", Method: "POST", Name: CancelTasksRoute},
 	{Path: "/v1/tasks/fail", Method: "POST", Name: FailTaskRoute},
+	{Path: "/v1/tasks/reject", Method: "POST", Name: RejectTaskRoute},
 	{Path: "/v1/tasks/complete", Method: "POST", Name: CompleteTaskRoute},
 	{Path: "/v1/tasks/resolving", Method: "POST", Name: ResolvingTaskRoute},
 	{Path: "/v1/tasks/delete", Method: "POST", Name: DeleteTaskRoute},
@@ -144,8 +188,88 @@ var Routes = rata.Routes{
 
 	// Event Streaming
 	{Path: "/v1/events", Method: "GET", Name: EventStreamRoute_r0},
+	{Path: "/v1/events/tasks", Method: "GET", Name: TaskEventStreamRoute},
+
+	// Counts
+	{Path: "/v1/counts", Method: "POST", Name: ObjectCountsRoute},
 
 	// Cells
 	{Path: "/v1/cells/list.r1", Method: "POST", Name: CellsRoute},
 	{Path: "/v1/cells/list.r1", Method: "GET", Name: CellsRoute_r1}, // Deprecated
+	{Path: "/v1/cells/presence_statuses/list", Method: "POST", Name: CellPresenceStatusesRoute},
+
+	// Admin
+	{Path: "/v1/admin/lock/release", Method: "POST", Name: ReleaseLockRoute},
+	{Path: "/v1/admin/desired_lrps/list", Method: "POST", Name: DesiredLRPsIncludingDeletedRoute},
+	{Path: "/v1/admin/tasks/purge", Method: "POST", Name: PurgeCompletedTasksRoute},
+	{Path: "/v1/admin/convergence/status", Method: "POST", Name: ConvergenceStatusRoute},
+	{Path: "/v1/admin/convergence/pause", Method: "POST", Name: PauseConvergenceRoute},
+	{Path: "/v1/admin/convergence/resume", Method: "POST", Name: ResumeConvergenceRoute},
+	{Path: "/v1/admin/import", Method: "POST", Name: ImportRoute},
+	{Path: "/v1/admin/export", Method: "POST", Name: ExportRoute},
+	{Path: "/v1/admin/coordination_state", Method: "POST", Name: CoordinationStateRoute},
+}
+
+// ReadRoutes are the routes that a standby BBS, one that does not hold the
+// lock, may serve. Its responses can lag slightly behind the lock holder. The
+// event stream is not included because events are only emitted by the BBS
+// performing the writes.
+var ReadRoutes = map[string]bool{
+	DomainsRoute:         true,
+	DomainFreshnessRoute: true,
+
+	ActualLRPGroupsRoute:                     true,
+	ActualLRPGroupsByProcessGuidRoute:        true,
+	ActualLRPGroupByProcessGuidAndIndexRoute: true,
+	CrashingActualLRPsRoute:                  true,
+	CellPlacementsRoute:                      true,
+
+	DesiredLRPsRoute:                true,
+	DesiredLRPSchedulingInfosRoute:  true,
+	DesiredLRPByProcessGuidRoute:    true,
+	DesiredLRPDiffRoute:             true,
+	ValidateDesiredLRPRoute:         true,
+	DesiredLRPsRoute_r1:             true,
+	DesiredLRPByProcessGuidRoute_r1: true,
+	DesiredLRPsRoute_r0:             true,
+	DesiredLRPByProcessGuidRoute_r0: true,
+
+	TasksRoute:          true,
+	TaskByGuidRoute:     true,
+	DuplicateTasksRoute: true,
+	TasksRoute_r1:       true,
+	TaskByGuidRoute_r1:  true,
+	TasksRoute_r0:       true,
+	TaskByGuidRoute_r0:  true,
+
+	ObjectCountsRoute: true,
+
+	CellsRoute:                true,
+	CellsRoute_r1:             true,
+	CellPresenceStatusesRoute: true,
+}
+
+// AdminRoutes are the routes that trigger convergence or change how the BBS
+// itself runs, rather than creating or updating Tasks and LRPs, those that
+// read records kept only for operators, such as DesiredLRP tombstones and the
+// convergence status, and maintenance operations such as purging completed
+// Tasks and importing or exporting a backup.
+var AdminRoutes = map[string]bool{
+	ConvergeLRPsRoute:                true,
+	ReleaseLockRoute:                 true,
+	DesiredLRPsIncludingDeletedRoute: true,
+	PurgeCompletedTasksRoute:         true,
+	ConvergenceStatusRoute:           true,
+	PauseConvergenceRoute:            true,
+	ResumeConvergenceRoute:           true,
+	ImportRoute:                      true,
+	ExportRoute:                      true,
+	CoordinationStateRoute:           true,
+}
+
+// DiagnosticRoutes are the routes that every BBS serves, whether or not it
+// holds the lock or has finished its migrations, so that the state of each
+// instance can be inspected while triaging an incident.
+var DiagnosticRoutes = map[string]bool{
+	CoordinationStateRoute: true,
 }
@@ -3,6 +3,7 @@ package bbs
 import (
 	"os"
 	"path"
+	"sort"
 	"time"
 
 	"code.cloudfoundry.org/bbs/models"
@@ -10,12 +11,14 @@ import (
 	"code.cloudfoundry.org/consuladapter"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/locket"
+	"github.com/hashicorp/consul/api"
 	"github.com/tedsuo/ifrit"
 )
 
 const (
-	CellSchemaKey    = "cell"
-	BBSLockSchemaKey = "bbs_lock"
+	CellSchemaKey            = "cell"
+	BBSLockSchemaKey         = "bbs_lock"
+	BBSReadPresenceSchemaKey = "bbs_read"
 )
 
 func CellSchemaRoot() string {
@@ -30,16 +33,34 @@ func BBSLockSchemaPath() string {
 	return locket.LockSchemaPath(BBSLockSchemaKey)
 }
 
+func BBSReadPresenceSchemaRoot() string {
+	return locket.LockSchemaPath(BBSReadPresenceSchemaKey)
+}
+
+func BBSReadPresenceSchemaPath(bbsID string) string {
+	return locket.LockSchemaPath(BBSReadPresenceSchemaKey, bbsID)
+}
+
 //go:generate counterfeiter -o fake_bbs/fake_service_client.go . ServiceClient
 
 type ServiceClient interface {
 	CellById(logger lager.Logger, cellId string) (*models.CellPresence, error)
 	Cells(logger lager.Logger) (models.CellSet, error)
+	CellPresenceStatuses(logger lager.Logger) ([]*models.CellPresenceStatus, error)
 	CellEvents(logger lager.Logger) <-chan models.CellEvent
 	NewCellPresenceRunner(logger lager.Logger, cellPresence *models.CellPresence, retryInterval, lockTTL time.Duration) ifrit.Runner
 	NewBBSLockRunner(logger lager.Logger, bbsPresence *models.BBSPresence, retryInterval, lockTTL time.Duration) (ifrit.Runner, error)
 	CurrentBBS(logger lager.Logger) (*models.BBSPresence, error)
 	CurrentBBSURL(logger lager.Logger) (string, error)
+	NewBBSReadPresenceRunner(logger lager.Logger, bbsPresence *models.BBSPresence, retryInterval, lockTTL time.Duration) (ifrit.Runner, error)
+	BBSReadPresences(logger lager.Logger) ([]*models.BBSPresence, error)
+
+	// CurrentBBSStatus reports the BBS holding the lock along with what holds
+	// it in the store, or a ResourceNotFound error if no BBS holds it.
+	CurrentBBSStatus(logger lager.Logger) (*models.BBSPresenceStatus, error)
+	// BBSReadPresenceStatuses reports every BBS able to serve read requests
+	// along with what holds its presence in the store, sorted by ID.
+	BBSReadPresenceStatuses(logger lager.Logger) ([]*models.BBSPresenceStatus, error)
 }
 
 type serviceClient struct {
@@ -99,6 +120,51 @@ func (db *serviceClient) Cells(logger lager.Logger) (models.CellSet, error) {
 	return cellPresences, nil
 }
 
+// CellPresenceStatuses reports every registered cell with the TTL of the
+// session holding its presence. Consul does not expose when a session was
+// last renewed, so the expiry is not known and no presence is stale.
+func (db *serviceClient) CellPresenceStatuses(logger lager.Logger) ([]*models.CellPresenceStatus, error) {
+	kvPairs, _, err := db.consulClient.KV().List(CellSchemaRoot(), nil)
+	if err != nil {
+		bbsErr := models.ConvertError(convertConsulError(err))
+		if bbsErr.Type != models.Error_ResourceNotFound {
+			return nil, bbsErr
+		}
+	}
+
+	sessions, _, err := db.consulClient.Session().List(nil)
+	if err != nil {
+		return nil, models.ConvertError(convertConsulError(err))
+	}
+
+	sessionTTLs := map[string]time.Duration{}
+	for _, session := range sessions {
+		ttl, err := time.ParseDuration(session.TTL)
+		if err == nil {
+			sessionTTLs[session.ID] = ttl
+		}
+	}
+
+	now := db.clock.Now()
+	statuses := []*models.CellPresenceStatus{}
+	for _, kvPair := range kvPairs {
+		if kvPair.Session == "" {
+			continue
+		}
+
+		presence := new(models.CellPresence)
+		err := models.FromJSON(kvPair.Value, presence)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-cells-json", err)
+			continue
+		}
+		statuses = append(statuses, models.NewCellPresenceStatus(presence, sessionTTLs[kvPair.Session], 0, now))
+	}
+	sort.Sort(cellPresenceStatusesByCellID(statuses))
+
+	return statuses, nil
+}
+
 func (db *serviceClient) CellById(logger lager.Logger, cellId string) (*models.CellPresence, error) {
 	value, err := db.getAcquiredValue(CellSchemaPath(cellId))
 	if err != nil {
@@ -175,6 +241,140 @@ func (db *serviceClient) CurrentBBSURL(logger lager.Logger) (string, error) {
 	return presence.URL, nil
 }
 
+// NewBBSReadPresenceRunner registers a BBS that is willing to serve read
+// requests. Unlike the lock, any number of BBS instances may hold a read
+// presence at the same time.
+func (db *serviceClient) NewBBSReadPresenceRunner(logger lager.Logger, bbsPresence *models.BBSPresence, retryInterval, lockTTL time.Duration) (ifrit.Runner, error) {
+	bbsPresenceJSON, err := models.ToJSON(bbsPresence)
+	if err != nil {
+		return nil, err
+	}
+	return locket.NewPresence(logger, db.consulClient, BBSReadPresenceSchemaPath(bbsPresence.ID), bbsPresenceJSON, db.clock, retryInterval, lockTTL), nil
+}
+
+// BBSReadPresences returns every BBS currently able to serve read requests.
+// Reads served by an instance that does not hold the lock may lag slightly
+// behind the lock holder.
+func (db *serviceClient) BBSReadPresences(logger lager.Logger) ([]*models.BBSPresence, error) {
+	kvPairs, _, err := db.consulClient.KV().List(BBSReadPresenceSchemaRoot(), nil)
+	if err != nil {
+		bbsErr := models.ConvertError(convertConsulError(err))
+		if bbsErr.Type != models.Error_ResourceNotFound {
+			return nil, bbsErr
+		}
+	}
+
+	presences := []*models.BBSPresence{}
+	for _, kvPair := range kvPairs {
+		if kvPair.Session == "" {
+			continue
+		}
+
+		presence := new(models.BBSPresence)
+		err := models.FromJSON(kvPair.Value, presence)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-bbs-presence-json", err)
+			continue
+		}
+		presences = append(presences, presence)
+	}
+
+	return presences, nil
+}
+
+// CurrentBBSStatus reports the BBS holding the lock with the session holding
+// it. Consul does not expose when a session was last renewed, so the expiry
+// is not known.
+func (db *serviceClient) CurrentBBSStatus(logger lager.Logger) (*models.BBSPresenceStatus, error) {
+	kvPair, _, err := db.consulClient.KV().Get(BBSLockSchemaPath(), nil)
+	if err != nil {
+		return nil, models.ConvertError(convertConsulError(err))
+	}
+	if kvPair == nil || kvPair.Session == "" {
+		return nil, models.ConvertError(convertConsulError(consuladapter.NewKeyNotFoundError(BBSLockSchemaPath())))
+	}
+
+	sessions, err := db.sessionsByID()
+	if err != nil {
+		return nil, err
+	}
+
+	presence := new(models.BBSPresence)
+	err = models.FromJSON(kvPair.Value, presence)
+	if err != nil {
+		return nil, models.NewError(models.Error_InvalidJSON, err.Error())
+	}
+
+	return newBBSPresenceStatus(presence, kvPair.Session, sessions), nil
+}
+
+// BBSReadPresenceStatuses reports every BBS able to serve read requests with
+// the session holding its presence. As with CurrentBBSStatus, the expiry is
+// not known.
+func (db *serviceClient) BBSReadPresenceStatuses(logger lager.Logger) ([]*models.BBSPresenceStatus, error) {
+	kvPairs, _, err := db.consulClient.KV().List(BBSReadPresenceSchemaRoot(), nil)
+	if err != nil {
+		bbsErr := models.ConvertError(convertConsulError(err))
+		if bbsErr.Type != models.Error_ResourceNotFound {
+			return nil, bbsErr
+		}
+	}
+
+	sessions, err := db.sessionsByID()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := []*models.BBSPresenceStatus{}
+	for _, kvPair := range kvPairs {
+		if kvPair.Session == "" {
+			continue
+		}
+
+		presence := new(models.BBSPresence)
+		err := models.FromJSON(kvPair.Value, presence)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-bbs-presence-json", err)
+			continue
+		}
+		statuses = append(statuses, newBBSPresenceStatus(presence, kvPair.Session, sessions))
+	}
+	models.SortBBSPresenceStatuses(statuses)
+
+	return statuses, nil
+}
+
+func (db *serviceClient) sessionsByID() (map[string]*api.SessionEntry, error) {
+	sessions, _, err := db.consulClient.Session().List(nil)
+	if err != nil {
+		return nil, models.ConvertError(convertConsulError(err))
+	}
+
+	sessionsByID := make(map[string]*api.SessionEntry, len(sessions))
+	for _, session := range sessions {
+		sessionsByID[session.ID] = session
+	}
+	return sessionsByID, nil
+}
+
+func newBBSPresenceStatus(presence *models.BBSPresence, sessionID string, sessions map[string]*api.SessionEntry) *models.BBSPresenceStatus {
+	var sessionName string
+	var ttl time.Duration
+	if session, ok := sessions[sessionID]; ok {
+		sessionName = session.Name
+		ttl, _ = time.ParseDuration(session.TTL)
+	}
+	return models.NewBBSPresenceStatus(presence, sessionID, sessionName, ttl, 0)
+}
+
+type cellPresenceStatusesByCellID []*models.CellPresenceStatus
+
+func (s cellPresenceStatusesByCellID) Len() int      { return len(s) }
+func (s cellPresenceStatusesByCellID) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s cellPresenceStatusesByCellID) Less(i, j int) bool {
+	return s[i].Presence.CellId < s[j].Presence.CellId
+}
+
 func convertConsulError(err error) error {
 	switch err.(type) {
 	case consuladapter.KeyNotFoundError:
@@ -71,6 +71,97 @@ var _ = Describe("ServiceClient", func() {
 			})
 		})
 	})
+
+	Describe("BBSReadPresences", func() {
+		Context("when there are no read presences", func() {
+			It("returns an empty list", func() {
+				Expect(serviceClient.BBSReadPresences(logger)).To(BeEmpty())
+			})
+		})
+
+		Context("when several bbs instances serve reads", func() {
+			var (
+				presence1, presence2 models.BBSPresence
+				maintainers          ifrit.Process
+			)
+
+			BeforeEach(func() {
+				presence1 = models.NewBBSPresence("bbs-1", "https://bbs-1.example.com")
+				presence2 = models.NewBBSPresence("bbs-2", "https://bbs-2.example.com")
+
+				runner1, err := serviceClient.NewBBSReadPresenceRunner(logger, &presence1, locket.RetryInterval, locket.LockTTL)
+				Expect(err).NotTo(HaveOccurred())
+				runner2, err := serviceClient.NewBBSReadPresenceRunner(logger, &presence2, locket.RetryInterval, locket.LockTTL)
+				Expect(err).NotTo(HaveOccurred())
+
+				maintainers = ifrit.Invoke(grouper.NewParallel(os.Interrupt, grouper.Members{
+					{"bbs-1", runner1},
+					{"bbs-2", runner2},
+				}))
+			})
+
+			AfterEach(func() {
+				ginkgomon.Interrupt(maintainers)
+			})
+
+			It("returns all of them", func() {
+				Eventually(func() ([]*models.BBSPresence, error) { return serviceClient.BBSReadPresences(logger) }).Should(HaveLen(2))
+				Expect(serviceClient.BBSReadPresences(logger)).To(ConsistOf(&presence1, &presence2))
+			})
+
+			It("reports the session and TTL of each, sorted by ID", func() {
+				Eventually(func() ([]*models.BBSPresenceStatus, error) {
+					return serviceClient.BBSReadPresenceStatuses(logger)
+				}).Should(HaveLen(2))
+
+				statuses, err := serviceClient.BBSReadPresenceStatuses(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(statuses[0].Id).To(Equal("bbs-1"))
+				Expect(statuses[1].Id).To(Equal("bbs-2"))
+				for _, status := range statuses {
+					Expect(status.SessionId).NotTo(BeEmpty())
+					Expect(status.Ttl).To(Equal(int64(locket.LockTTL)))
+				}
+			})
+		})
+	})
+
+	Describe("CurrentBBSStatus", func() {
+		Context("when no BBS holds the lock", func() {
+			It("returns ErrResourceNotFound", func() {
+				_, err := serviceClient.CurrentBBSStatus(logger)
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_ResourceNotFound))
+			})
+		})
+
+		Context("when a BBS holds the lock", func() {
+			var (
+				presence models.BBSPresence
+				lock     ifrit.Process
+			)
+
+			BeforeEach(func() {
+				presence = models.NewBBSPresence("bbs-1", "https://bbs-1.example.com")
+				runner, err := serviceClient.NewBBSLockRunner(logger, &presence, locket.RetryInterval, locket.LockTTL)
+				Expect(err).NotTo(HaveOccurred())
+				lock = ifrit.Background(runner)
+				Eventually(lock.Ready()).Should(BeClosed())
+			})
+
+			AfterEach(func() {
+				ginkgomon.Interrupt(lock)
+			})
+
+			It("reports the holder with the session holding the lock", func() {
+				status, err := serviceClient.CurrentBBSStatus(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Id).To(Equal("bbs-1"))
+				Expect(status.Url).To(Equal("https://bbs-1.example.com"))
+				Expect(status.SessionId).NotTo(BeEmpty())
+				Expect(status.Ttl).To(Equal(int64(locket.LockTTL)))
+			})
+		})
+	})
 })
 
 func newCellPresence(cellID string) *models.CellPresence {
@@ -0,0 +1,637 @@
+package bbs
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/locket"
+	"github.com/nu7hatch/gouuid"
+	"github.com/tedsuo/ifrit"
+)
+
+// Locks and presences share one table. A row is held by its owner, which
+// keeps refreshing it for as long as it runs. Every write increments
+// modified_index, which fences takeovers: another owner may only take over a
+// row whose modified_index and expires_at it has seen unchanged for a whole
+// TTL, and only with a write conditional on that modified_index. ttl is the
+// TTL the owner refreshes the row for, in nanoseconds.
+const createLocksTableSQL = `CREATE TABLE IF NOT EXISTS %slocks(
+	path VARCHAR(255) PRIMARY KEY,
+	owner VARCHAR(255) NOT NULL,
+	value MEDIUMTEXT NOT NULL,
+	expires_at BIGINT NOT NULL,
+	modified_index BIGINT NOT NULL DEFAULT 0,
+	ttl BIGINT NOT NULL DEFAULT 0
+);`
+
+// Tables created before takeovers were fenced lack the modified_index column.
+const addLocksModifiedIndexSQL = `ALTER TABLE %slocks ADD COLUMN modified_index BIGINT NOT NULL DEFAULT 0;`
+
+// Tables created before cell presence statuses were reported lack the ttl
+// column. Rows written before it was added report a ttl of 0 until they are
+// next refreshed.
+const addLocksTTLSQL = `ALTER TABLE %slocks ADD COLUMN ttl BIGINT NOT NULL DEFAULT 0;`
+
+var ErrLockLost = errors.New("lost lock")
+
+type sqlServiceClient struct {
+	db                 *sql.DB
+	flavor             string
+	locksTable         string
+	clock              clock.Clock
+	clockSkewTolerance time.Duration
+	cellEventsInterval time.Duration
+}
+
+// NewSQLServiceClient returns a ServiceClient that keeps the BBS lock and the
+// cell and BBS presences in the SQL database instead of Consul, creating the
+// table it needs if it does not exist yet. Every BBS and cell sharing a
+// deployment must use the same kind of ServiceClient. The table is named
+// locks, after tablePrefix, like the tables of the SQL database.
+//
+// The expiry of a row is written by the clock of its owner and compared with
+// the clock of the reader, so rows are read as held until clockSkewTolerance
+// after they expire, and a row is only taken over once it has been seen
+// unchanged for its TTL plus clockSkewTolerance. This keeps a small skew
+// between the clocks from expiring locks and presences prematurely.
+func NewSQLServiceClient(logger lager.Logger, db *sql.DB, flavor, tablePrefix string, clock clock.Clock, clockSkewTolerance time.Duration) (ServiceClient, error) {
+	locksTable := tablePrefix + "locks"
+
+	_, err := db.Exec(sqldb.RebindForFlavor(fmt.Sprintf(createLocksTableSQL, tablePrefix), flavor))
+	if err != nil {
+		logger.Error("failed-creating-locks-table", err)
+		return nil, err
+	}
+
+	rows, err := db.Query(fmt.Sprintf("SELECT modified_index FROM %s WHERE 1 = 0", locksTable))
+	if err == nil {
+		rows.Close()
+	} else {
+		_, err = db.Exec(fmt.Sprintf(addLocksModifiedIndexSQL, tablePrefix))
+		if err != nil {
+			logger.Error("failed-adding-locks-modified-index", err)
+			return nil, err
+		}
+	}
+
+	rows, err = db.Query(fmt.Sprintf("SELECT ttl FROM %s WHERE 1 = 0", locksTable))
+	if err == nil {
+		rows.Close()
+	} else {
+		_, err = db.Exec(fmt.Sprintf(addLocksTTLSQL, tablePrefix))
+		if err != nil {
+			logger.Error("failed-adding-locks-ttl", err)
+			return nil, err
+		}
+	}
+
+	return &sqlServiceClient{
+		db:                 db,
+		flavor:             flavor,
+		locksTable:         locksTable,
+		clock:              clock,
+		clockSkewTolerance: clockSkewTolerance,
+		cellEventsInterval: locket.RetryInterval,
+	}, nil
+}
+
+func (db *sqlServiceClient) NewCellPresenceRunner(logger lager.Logger, cellPresence *models.CellPresence, retryInterval time.Duration, lockTTL time.Duration) ifrit.Runner {
+	payload, err := models.ToJSON(cellPresence)
+	if err != nil {
+		panic(err)
+	}
+
+	return db.newLock(logger, CellSchemaPath(cellPresence.CellId), payload, retryInterval, lockTTL, true)
+}
+
+func (db *sqlServiceClient) Cells(logger lager.Logger) (models.CellSet, error) {
+	values, err := db.acquiredValues(CellSchemaRoot())
+	if err != nil {
+		return nil, models.NewError(models.Error_UnknownError, err.Error())
+	}
+
+	cellPresences := models.NewCellSet()
+	for _, value := range values {
+		presence := new(models.CellPresence)
+		err := models.FromJSON(value, presence)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-cells-json", err)
+			continue
+		}
+		cellPresences.Add(presence)
+	}
+
+	return cellPresences, nil
+}
+
+// CellPresenceStatuses reports every registered cell with the TTL of its
+// presence and when the presence expires unless the cell refreshes it.
+func (db *sqlServiceClient) CellPresenceStatuses(logger lager.Logger) ([]*models.CellPresenceStatus, error) {
+	now := db.clock.Now()
+	rows, err := db.db.Query(
+		db.rebind(fmt.Sprintf("SELECT value, ttl, expires_at FROM %s WHERE path LIKE ? AND expires_at > ?", db.locksTable)),
+		CellSchemaRoot()+"/%", db.expiryCutoff(now),
+	)
+	if err != nil {
+		return nil, models.NewError(models.Error_UnknownError, err.Error())
+	}
+	defer rows.Close()
+
+	statuses := []*models.CellPresenceStatus{}
+	for rows.Next() {
+		var value string
+		var ttl, expiresAt int64
+		err := rows.Scan(&value, &ttl, &expiresAt)
+		if err != nil {
+			return nil, models.NewError(models.Error_UnknownError, err.Error())
+		}
+
+		presence := new(models.CellPresence)
+		err = models.FromJSON([]byte(value), presence)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-cells-json", err)
+			continue
+		}
+		statuses = append(statuses, models.NewCellPresenceStatus(presence, time.Duration(ttl), expiresAt, now))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, models.NewError(models.Error_UnknownError, err.Error())
+	}
+
+	sort.Sort(cellPresenceStatusesByCellID(statuses))
+	return statuses, nil
+}
+
+func (db *sqlServiceClient) CellById(logger lager.Logger, cellId string) (*models.CellPresence, error) {
+	value, err := db.acquiredValue(CellSchemaPath(cellId))
+	if err != nil {
+		return nil, err
+	}
+
+	presence := new(models.CellPresence)
+	err = models.FromJSON(value, presence)
+	if err != nil {
+		return nil, models.NewError(models.Error_InvalidJSON, err.Error())
+	}
+
+	return presence, nil
+}
+
+// CellEvents polls the cell presences, since the database cannot be watched,
+// and reports the cells that disappeared since the previous poll.
+func (db *sqlServiceClient) CellEvents(logger lager.Logger) <-chan models.CellEvent {
+	logger = logger.Session("cell-events")
+
+	events := make(chan models.CellEvent)
+	go func() {
+		previous, err := db.Cells(logger)
+		if err != nil {
+			logger.Error("failed-fetching-cells", err)
+			previous = models.NewCellSet()
+		}
+
+		ticker := db.clock.NewTicker(db.cellEventsInterval)
+		defer ticker.Stop()
+
+		for range ticker.C() {
+			current, err := db.Cells(logger)
+			if err != nil {
+				logger.Error("failed-fetching-cells", err)
+				continue
+			}
+
+			cellIDs := []string{}
+			for cellID := range previous {
+				if !current.HasCellID(cellID) {
+					cellIDs = append(cellIDs, cellID)
+				}
+			}
+			previous = current
+
+			if len(cellIDs) > 0 {
+				logger.Info("cell-disappeared", lager.Data{"cell_ids": cellIDs})
+				events <- models.NewCellDisappearedEvent(cellIDs)
+			}
+		}
+	}()
+
+	return events
+}
+
+func (db *sqlServiceClient) NewBBSLockRunner(logger lager.Logger, bbsPresence *models.BBSPresence, retryInterval, lockTTL time.Duration) (ifrit.Runner, error) {
+	bbsPresenceJSON, err := models.ToJSON(bbsPresence)
+	if err != nil {
+		return nil, err
+	}
+	return db.newLock(logger, BBSLockSchemaPath(), bbsPresenceJSON, retryInterval, lockTTL, false), nil
+}
+
+func (db *sqlServiceClient) CurrentBBS(logger lager.Logger) (*models.BBSPresence, error) {
+	value, err := db.acquiredValue(BBSLockSchemaPath())
+	if err != nil {
+		return nil, err
+	}
+
+	presence := new(models.BBSPresence)
+	err = models.FromJSON(value, presence)
+	if err != nil {
+		return nil, err
+	}
+
+	return presence, nil
+}
+
+func (db *sqlServiceClient) CurrentBBSURL(logger lager.Logger) (string, error) {
+	presence, err := db.CurrentBBS(logger)
+	if err != nil {
+		return "", err
+	}
+
+	return presence.URL, nil
+}
+
+func (db *sqlServiceClient) NewBBSReadPresenceRunner(logger lager.Logger, bbsPresence *models.BBSPresence, retryInterval, lockTTL time.Duration) (ifrit.Runner, error) {
+	bbsPresenceJSON, err := models.ToJSON(bbsPresence)
+	if err != nil {
+		return nil, err
+	}
+	return db.newLock(logger, BBSReadPresenceSchemaPath(bbsPresence.ID), bbsPresenceJSON, retryInterval, lockTTL, true), nil
+}
+
+func (db *sqlServiceClient) BBSReadPresences(logger lager.Logger) ([]*models.BBSPresence, error) {
+	values, err := db.acquiredValues(BBSReadPresenceSchemaRoot())
+	if err != nil {
+		return nil, models.NewError(models.Error_UnknownError, err.Error())
+	}
+
+	presences := []*models.BBSPresence{}
+	for _, value := range values {
+		presence := new(models.BBSPresence)
+		err := models.FromJSON(value, presence)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-bbs-presence-json", err)
+			continue
+		}
+		presences = append(presences, presence)
+	}
+
+	return presences, nil
+}
+
+// CurrentBBSStatus reports the BBS holding the lock with the owner of its row,
+// the TTL it refreshes the row for, and when the row expires.
+func (db *sqlServiceClient) CurrentBBSStatus(logger lager.Logger) (*models.BBSPresenceStatus, error) {
+	statuses, err := db.bbsPresenceStatuses(logger, "path = ?", BBSLockSchemaPath())
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return nil, models.NewError(models.Error_ResourceNotFound, "key not found: "+BBSLockSchemaPath())
+	}
+
+	return statuses[0], nil
+}
+
+// BBSReadPresenceStatuses reports every BBS able to serve read requests with
+// the owner, TTL and expiry of its row.
+func (db *sqlServiceClient) BBSReadPresenceStatuses(logger lager.Logger) ([]*models.BBSPresenceStatus, error) {
+	statuses, err := db.bbsPresenceStatuses(logger, "path LIKE ?", BBSReadPresenceSchemaRoot()+"/%")
+	if err != nil {
+		return nil, err
+	}
+
+	models.SortBBSPresenceStatuses(statuses)
+	return statuses, nil
+}
+
+func (db *sqlServiceClient) bbsPresenceStatuses(logger lager.Logger, pathCondition string, path string) ([]*models.BBSPresenceStatus, error) {
+	rows, err := db.db.Query(
+		db.rebind(fmt.Sprintf("SELECT value, owner, ttl, expires_at FROM %s WHERE %s AND expires_at > ?", db.locksTable, pathCondition)),
+		path, db.expiryCutoff(db.clock.Now()),
+	)
+	if err != nil {
+		return nil, models.NewError(models.Error_UnknownError, err.Error())
+	}
+	defer rows.Close()
+
+	statuses := []*models.BBSPresenceStatus{}
+	for rows.Next() {
+		var value, owner string
+		var ttl, expiresAt int64
+		err := rows.Scan(&value, &owner, &ttl, &expiresAt)
+		if err != nil {
+			return nil, models.NewError(models.Error_UnknownError, err.Error())
+		}
+
+		presence := new(models.BBSPresence)
+		err = models.FromJSON([]byte(value), presence)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-bbs-presence-json", err)
+			continue
+		}
+		statuses = append(statuses, models.NewBBSPresenceStatus(presence, owner, "", time.Duration(ttl), expiresAt))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, models.NewError(models.Error_UnknownError, err.Error())
+	}
+
+	return statuses, nil
+}
+
+func (db *sqlServiceClient) acquiredValue(key string) ([]byte, error) {
+	var value string
+	row := db.db.QueryRow(
+		db.rebind(fmt.Sprintf("SELECT value FROM %s WHERE path = ? AND expires_at > ?", db.locksTable)),
+		key, db.expiryCutoff(db.clock.Now()),
+	)
+	err := row.Scan(&value)
+	if err == sql.ErrNoRows {
+		return nil, models.NewError(models.Error_ResourceNotFound, "key not found: "+key)
+	} else if err != nil {
+		return nil, models.NewError(models.Error_UnknownError, err.Error())
+	}
+
+	return []byte(value), nil
+}
+
+func (db *sqlServiceClient) acquiredValues(prefix string) ([][]byte, error) {
+	rows, err := db.db.Query(
+		db.rebind(fmt.Sprintf("SELECT value FROM %s WHERE path LIKE ? AND expires_at > ?", db.locksTable)),
+		prefix+"/%", db.expiryCutoff(db.clock.Now()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := [][]byte{}
+	for rows.Next() {
+		var value string
+		err := rows.Scan(&value)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, []byte(value))
+	}
+
+	return values, rows.Err()
+}
+
+// expiryCutoff returns the time, in nanoseconds, after which rows must
+// expire to be read as held at now.
+func (db *sqlServiceClient) expiryCutoff(now time.Time) int64 {
+	return now.Add(-db.clockSkewTolerance).UnixNano()
+}
+
+func (db *sqlServiceClient) rebind(query string) string {
+	return sqldb.RebindForFlavor(query, db.flavor)
+}
+
+func (db *sqlServiceClient) newLock(logger lager.Logger, key string, value []byte, retryInterval, lockTTL time.Duration, presence bool) ifrit.Runner {
+	return &sqlLock{
+		logger:        logger,
+		serviceClient: db,
+		key:           key,
+		value:         string(value),
+		retryInterval: retryInterval,
+		lockTTL:       lockTTL,
+		presence:      presence,
+	}
+}
+
+// sqlLock holds a row in the locks table. A lock is ready once it has been
+// acquired and exits when it is lost, while a presence is ready immediately
+// and keeps trying to hold its row until it is signalled.
+//
+// A lock only considers itself held until lockTTL after it started its last
+// successful write, the earliest another owner may take the row over, and
+// exits as lost once that passes, even while a write is still in flight.
+type sqlLock struct {
+	logger        lager.Logger
+	serviceClient *sqlServiceClient
+	key           string
+	value         string
+	retryInterval time.Duration
+	lockTTL       time.Duration
+	presence      bool
+}
+
+// lockObservation is the version of a row held by another owner, and the
+// time it was first seen in that version, by the local clock.
+type lockObservation struct {
+	modifiedIndex int64
+	expiresAt     int64
+	seenAt        time.Time
+}
+
+// lockAttempt is the outcome of an attempt to acquire or refresh a row. When
+// acquired, modifiedIndex is the index of the row as written by this owner.
+type lockAttempt struct {
+	acquired      bool
+	modifiedIndex int64
+	observation   lockObservation
+	err           error
+}
+
+func (l *sqlLock) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := l.logger.Session("sql-lock", lager.Data{"key": l.key, "presence": l.presence})
+	logger.Info("starting")
+	defer logger.Info("done")
+
+	guid, err := uuid.NewV4()
+	if err != nil {
+		logger.Error("failed-generating-owner", err)
+		return err
+	}
+	owner := guid.String()
+
+	if l.presence {
+		close(ready)
+		ready = nil
+	}
+
+	db := l.serviceClient
+	ticker := db.clock.NewTicker(l.retryInterval)
+	defer ticker.Stop()
+
+	var lease clock.Timer
+	var leaseExpired <-chan time.Time
+	defer func() {
+		if lease != nil {
+			lease.Stop()
+		}
+	}()
+
+	var last lockAttempt
+	for {
+		startedAt := db.clock.Now()
+		attempts := make(chan lockAttempt, 1)
+		go func(last lockAttempt) {
+			attempts <- l.attempt(owner, last)
+		}(last)
+
+		var attempt lockAttempt
+		select {
+		case attempt = <-attempts:
+		case <-leaseExpired:
+			logger.Info("lost-lock", lager.Data{"reason": "lease-expired"})
+			return ErrLockLost
+		case <-signals:
+			if last.acquired {
+				l.release(logger, owner)
+			}
+			return nil
+		}
+
+		if attempt.err != nil {
+			logger.Error("failed-acquiring-lock", attempt.err)
+		}
+
+		switch {
+		case attempt.acquired && !last.acquired:
+			logger.Info("acquired-lock", lager.Data{"modified-index": attempt.modifiedIndex})
+			if ready != nil {
+				close(ready)
+				ready = nil
+			}
+		case !attempt.acquired && last.acquired:
+			logger.Info("lost-lock")
+			if !l.presence {
+				return ErrLockLost
+			}
+		}
+		last = attempt
+
+		if last.acquired && !l.presence {
+			if lease != nil {
+				lease.Stop()
+			}
+			lease = db.clock.NewTimer(l.lockTTL - db.clock.Since(startedAt))
+			leaseExpired = lease.C()
+		}
+
+		select {
+		case <-ticker.C():
+		case <-leaseExpired:
+			logger.Info("lost-lock", lager.Data{"reason": "lease-expired"})
+			return ErrLockLost
+		case <-signals:
+			if last.acquired {
+				l.release(logger, owner)
+			}
+			return nil
+		}
+	}
+}
+
+// attempt refreshes the row if this owner holds it, creates it if it does not
+// exist, and takes it over once it has been seen unchanged for lockTTL plus
+// the clock skew tolerance.
+func (l *sqlLock) attempt(owner string, last lockAttempt) lockAttempt {
+	if last.acquired {
+		return l.refresh(owner, last.modifiedIndex)
+	}
+
+	db := l.serviceClient
+	var current lockObservation
+	var currentOwner string
+	err := db.db.QueryRow(
+		db.rebind(fmt.Sprintf("SELECT owner, modified_index, expires_at FROM %s WHERE path = ?", db.locksTable)),
+		l.key,
+	).Scan(&currentOwner, &current.modifiedIndex, &current.expiresAt)
+	if err == sql.ErrNoRows {
+		return l.create(owner)
+	} else if err != nil {
+		return lockAttempt{observation: last.observation, err: err}
+	}
+
+	if currentOwner == owner {
+		return l.refresh(owner, current.modifiedIndex)
+	}
+
+	observation := last.observation
+	if observation.seenAt.IsZero() ||
+		current.modifiedIndex != observation.modifiedIndex ||
+		current.expiresAt != observation.expiresAt {
+		current.seenAt = db.clock.Now()
+		return lockAttempt{observation: current}
+	}
+
+	if db.clock.Since(observation.seenAt) < l.lockTTL+db.clockSkewTolerance {
+		return lockAttempt{observation: observation}
+	}
+
+	return l.takeOver(owner, observation)
+}
+
+func (l *sqlLock) refresh(owner string, modifiedIndex int64) lockAttempt {
+	db := l.serviceClient
+	result, err := db.db.Exec(
+		db.rebind(fmt.Sprintf("UPDATE %s SET value = ?, expires_at = ?, modified_index = ?, ttl = ? WHERE path = ? AND owner = ? AND modified_index = ?", db.locksTable)),
+		l.value, db.clock.Now().Add(l.lockTTL).UnixNano(), modifiedIndex+1, int64(l.lockTTL), l.key, owner, modifiedIndex,
+	)
+	return updatedLock(result, err, modifiedIndex+1)
+}
+
+func (l *sqlLock) takeOver(owner string, observation lockObservation) lockAttempt {
+	db := l.serviceClient
+	result, err := db.db.Exec(
+		db.rebind(fmt.Sprintf("UPDATE %s SET owner = ?, value = ?, expires_at = ?, modified_index = ?, ttl = ? WHERE path = ? AND modified_index = ? AND expires_at = ?", db.locksTable)),
+		owner, l.value, db.clock.Now().Add(l.lockTTL).UnixNano(), observation.modifiedIndex+1, int64(l.lockTTL),
+		l.key, observation.modifiedIndex, observation.expiresAt,
+	)
+	return updatedLock(result, err, observation.modifiedIndex+1)
+}
+
+func updatedLock(result sql.Result, err error, modifiedIndex int64) lockAttempt {
+	if err != nil {
+		return lockAttempt{err: err}
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return lockAttempt{err: err}
+	}
+	if rowsAffected == 0 {
+		return lockAttempt{}
+	}
+
+	return lockAttempt{acquired: true, modifiedIndex: modifiedIndex}
+}
+
+func (l *sqlLock) create(owner string) lockAttempt {
+	db := l.serviceClient
+	_, err := db.db.Exec(
+		db.rebind(fmt.Sprintf("INSERT INTO %s (path, owner, value, expires_at, modified_index, ttl) VALUES (?, ?, ?, ?, 1, ?)", db.locksTable)),
+		l.key, owner, l.value, db.clock.Now().Add(l.lockTTL).UnixNano(), int64(l.lockTTL),
+	)
+	if err != nil {
+		// the insert fails when another owner created the row first, which is
+		// not an error
+		var existing int
+		if db.db.QueryRow(db.rebind(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE path = ?", db.locksTable)), l.key).Scan(&existing) == nil && existing > 0 {
+			return lockAttempt{}
+		}
+		return lockAttempt{err: err}
+	}
+
+	return lockAttempt{acquired: true, modifiedIndex: 1}
+}
+
+func (l *sqlLock) release(logger lager.Logger, owner string) {
+	db := l.serviceClient
+	_, err := db.db.Exec(db.rebind(fmt.Sprintf("DELETE FROM %s WHERE path = ? AND owner = ?", db.locksTable)), l.key, owner)
+	if err != nil {
+		logger.Error("failed-releasing-lock", err)
+		return
+	}
+	logger.Info("released-lock")
+}
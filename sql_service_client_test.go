@@ -0,0 +1,460 @@
+package bbs_test
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	"code.cloudfoundry.org/bbs/test_helpers/sqlrunner"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/locket"
+	"github.com/onsi/ginkgo/config"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/ginkgomon"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SQLServiceClient", func() {
+	if !test_helpers.UseSQL() {
+		return
+	}
+
+	const (
+		retryInterval = time.Second
+		lockTTL       = 10 * time.Second
+	)
+
+	var (
+		sqlProcess    ifrit.Process
+		sqlRunner     sqlrunner.SQLRunner
+		rawSQLDB      *sql.DB
+		fakeClock     *fakeclock.FakeClock
+		serviceClient bbs.ServiceClient
+	)
+
+	rebind := func(query string) string {
+		return sqldb.RebindForFlavor(query, sqlRunner.DriverName())
+	}
+
+	BeforeEach(func() {
+		sqlRunner = test_helpers.NewSQLRunner(fmt.Sprintf("diego_service_client_%d", config.GinkgoConfig.ParallelNode))
+		sqlProcess = ginkgomon.Invoke(sqlRunner)
+
+		var err error
+		rawSQLDB, err = sql.Open(sqlRunner.DriverName(), sqlRunner.ConnectionString())
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		serviceClient, err = bbs.NewSQLServiceClient(logger, rawSQLDB, sqlRunner.DriverName(), "", fakeClock, 0)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(rawSQLDB.Close()).To(Succeed())
+		ginkgomon.Kill(sqlProcess, 5*time.Second)
+	})
+
+	It("can be created again over an existing table", func() {
+		_, err := bbs.NewSQLServiceClient(logger, rawSQLDB, sqlRunner.DriverName(), "", fakeClock, 0)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("with a table prefix", func() {
+		It("keeps the lock and the presences in the prefixed table", func() {
+			prefixedClient, err := bbs.NewSQLServiceClient(logger, rawSQLDB, sqlRunner.DriverName(), "prefixed_", fakeClock, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			presence := models.NewBBSPresence("bbs-1", "https://bbs-1.example.com")
+			runner, err := prefixedClient.NewBBSLockRunner(logger, &presence, time.Second, 10*time.Second)
+			Expect(err).NotTo(HaveOccurred())
+			lock := ifrit.Background(runner)
+			defer ginkgomon.Interrupt(lock)
+			Eventually(lock.Ready()).Should(BeClosed())
+
+			var count int
+			Expect(rawSQLDB.QueryRow(rebind("SELECT COUNT(*) FROM prefixed_locks WHERE path = ?"), bbs.BBSLockSchemaPath()).Scan(&count)).To(Succeed())
+			Expect(count).To(Equal(1))
+			Expect(rawSQLDB.QueryRow(rebind("SELECT COUNT(*) FROM locks WHERE path = ?"), bbs.BBSLockSchemaPath()).Scan(&count)).To(Succeed())
+			Expect(count).To(Equal(0))
+
+			status, err := prefixedClient.CurrentBBSStatus(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Id).To(Equal("bbs-1"))
+		})
+	})
+
+	Describe("the BBS lock", func() {
+		var (
+			presence1, presence2 models.BBSPresence
+			lock1, lock2         ifrit.Process
+		)
+
+		BeforeEach(func() {
+			presence1 = models.NewBBSPresence("bbs-1", "https://bbs-1.example.com")
+			presence2 = models.NewBBSPresence("bbs-2", "https://bbs-2.example.com")
+
+			runner, err := serviceClient.NewBBSLockRunner(logger, &presence1, retryInterval, lockTTL)
+			Expect(err).NotTo(HaveOccurred())
+			lock1 = ifrit.Background(runner)
+			Eventually(lock1.Ready()).Should(BeClosed())
+
+			runner, err = serviceClient.NewBBSLockRunner(logger, &presence2, retryInterval, lockTTL)
+			Expect(err).NotTo(HaveOccurred())
+			lock2 = ifrit.Background(runner)
+		})
+
+		AfterEach(func() {
+			ginkgomon.Interrupt(lock1)
+			ginkgomon.Interrupt(lock2)
+		})
+
+		It("is held by one BBS at a time", func() {
+			Consistently(lock2.Ready()).ShouldNot(BeClosed())
+			Expect(serviceClient.CurrentBBSURL(logger)).To(Equal("https://bbs-1.example.com"))
+		})
+
+		It("reports the holder with the owner, TTL and expiry of its row", func() {
+			status, err := serviceClient.CurrentBBSStatus(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Id).To(Equal("bbs-1"))
+			Expect(status.Url).To(Equal("https://bbs-1.example.com"))
+			Expect(status.SessionId).NotTo(BeEmpty())
+			Expect(status.Ttl).To(Equal(int64(lockTTL)))
+			Expect(status.ExpiresAt).To(Equal(fakeClock.Now().Add(lockTTL).UnixNano()))
+		})
+
+		It("is taken over once the holder releases it", func() {
+			ginkgomon.Interrupt(lock1)
+
+			fakeClock.WaitForWatcherAndIncrement(retryInterval)
+			Eventually(lock2.Ready()).Should(BeClosed())
+			Expect(serviceClient.CurrentBBSURL(logger)).To(Equal("https://bbs-2.example.com"))
+		})
+
+		Context("when the lock row is written by another owner", func() {
+			var modifiedIndex func() int64
+
+			BeforeEach(func() {
+				modifiedIndex = func() int64 {
+					var index int64
+					err := rawSQLDB.QueryRow(rebind("SELECT modified_index FROM locks WHERE path = ?"), bbs.BBSLockSchemaPath()).Scan(&index)
+					Expect(err).NotTo(HaveOccurred())
+					return index
+				}
+			})
+
+			It("does not let the holder keep refreshing it, and the holder exits", func() {
+				_, err := rawSQLDB.Exec(rebind("UPDATE locks SET owner = 'other-owner', modified_index = modified_index + 1 WHERE path = ?"), bbs.BBSLockSchemaPath())
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(func() error {
+					fakeClock.Increment(retryInterval)
+					select {
+					case err := <-lock1.Wait():
+						return err
+					default:
+						return nil
+					}
+				}).Should(Equal(bbs.ErrLockLost))
+			})
+
+			It("is not taken over while the holder keeps refreshing it, whatever its expiry", func() {
+				_, err := rawSQLDB.Exec(rebind("UPDATE locks SET expires_at = 0 WHERE path = ?"), bbs.BBSLockSchemaPath())
+				Expect(err).NotTo(HaveOccurred())
+
+				for elapsed := time.Duration(0); elapsed < 2*lockTTL; elapsed += retryInterval {
+					index := modifiedIndex()
+					fakeClock.Increment(retryInterval)
+					Eventually(modifiedIndex).Should(BeNumerically(">", index))
+				}
+
+				Expect(lock2.Ready()).NotTo(BeClosed())
+				Expect(lock1.Wait()).NotTo(Receive())
+			})
+		})
+
+		Context("when the holder cannot refresh the lock", func() {
+			var tx *sql.Tx
+
+			BeforeEach(func() {
+				var err error
+				tx, err = rawSQLDB.Begin()
+				Expect(err).NotTo(HaveOccurred())
+
+				// lock the row, so that the refreshes of the holder block
+				var owner string
+				err = tx.QueryRow(rebind("SELECT owner FROM locks WHERE path = ? FOR UPDATE"), bbs.BBSLockSchemaPath()).Scan(&owner)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				tx.Rollback()
+			})
+
+			It("exits once its lease expires, while the refresh is still blocked", func() {
+				start := fakeClock.Now()
+
+				Eventually(func() error {
+					fakeClock.Increment(retryInterval)
+					select {
+					case err := <-lock1.Wait():
+						return err
+					default:
+						return nil
+					}
+				}).Should(Equal(bbs.ErrLockLost))
+
+				Expect(fakeClock.Since(start)).To(BeNumerically(">=", lockTTL))
+			})
+		})
+	})
+
+	Describe("taking over the lock from an owner that stopped refreshing it", func() {
+		var lock ifrit.Process
+
+		BeforeEach(func() {
+			_, err := rawSQLDB.Exec(
+				rebind("INSERT INTO locks (path, owner, value, expires_at, modified_index) VALUES (?, 'crashed-owner', '{}', ?, 7)"),
+				bbs.BBSLockSchemaPath(), fakeClock.Now().Add(lockTTL).UnixNano(),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			presence := models.NewBBSPresence("bbs-2", "https://bbs-2.example.com")
+			runner, err := serviceClient.NewBBSLockRunner(logger, &presence, retryInterval, lockTTL)
+			Expect(err).NotTo(HaveOccurred())
+			lock = ifrit.Background(runner)
+		})
+
+		AfterEach(func() {
+			ginkgomon.Interrupt(lock)
+		})
+
+		It("acquires the lock only after seeing it unchanged for the lock TTL", func() {
+			Consistently(lock.Ready()).ShouldNot(BeClosed())
+			start := fakeClock.Now()
+
+			Eventually(func() bool {
+				fakeClock.Increment(retryInterval)
+				select {
+				case <-lock.Ready():
+					return true
+				default:
+					return false
+				}
+			}).Should(BeTrue())
+
+			Expect(fakeClock.Since(start)).To(BeNumerically(">=", lockTTL))
+			Expect(serviceClient.CurrentBBSURL(logger)).To(Equal("https://bbs-2.example.com"))
+
+			var index int64
+			err := rawSQLDB.QueryRow(rebind("SELECT modified_index FROM locks WHERE path = ?"), bbs.BBSLockSchemaPath()).Scan(&index)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(index).To(BeNumerically(">", 7))
+		})
+
+		It("waits again for the lock TTL whenever the row changes", func() {
+			for elapsed := time.Duration(0); elapsed < lockTTL/2; elapsed += retryInterval {
+				fakeClock.Increment(retryInterval)
+			}
+			_, err := rawSQLDB.Exec(rebind("UPDATE locks SET modified_index = modified_index + 1 WHERE path = ?"), bbs.BBSLockSchemaPath())
+			Expect(err).NotTo(HaveOccurred())
+			start := fakeClock.Now()
+
+			Eventually(func() bool {
+				fakeClock.Increment(retryInterval)
+				select {
+				case <-lock.Ready():
+					return true
+				default:
+					return false
+				}
+			}).Should(BeTrue())
+
+			Expect(fakeClock.Since(start)).To(BeNumerically(">=", lockTTL))
+		})
+	})
+
+	Describe("cell presences", func() {
+		var cells ifrit.Process
+
+		BeforeEach(func() {
+			cells = ifrit.Invoke(serviceClient.NewCellPresenceRunner(logger, newCellPresence("cell-1"), retryInterval, lockTTL))
+		})
+
+		AfterEach(func() {
+			ginkgomon.Interrupt(cells)
+		})
+
+		It("lists the registered cells", func() {
+			Eventually(func() (models.CellSet, error) { return serviceClient.Cells(logger) }).Should(HaveKey("cell-1"))
+
+			presence, err := serviceClient.CellById(logger, "cell-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(presence).To(Equal(newCellPresence("cell-1")))
+		})
+
+		It("returns ResourceNotFound for an unknown cell", func() {
+			_, err := serviceClient.CellById(logger, "cell-2")
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_ResourceNotFound))
+		})
+
+		It("stops listing a cell once its presence stops", func() {
+			Eventually(func() (models.CellSet, error) { return serviceClient.Cells(logger) }).Should(HaveKey("cell-1"))
+			ginkgomon.Interrupt(cells)
+
+			Expect(serviceClient.Cells(logger)).To(BeEmpty())
+		})
+
+		It("reports a cell disappearing", func() {
+			Eventually(func() (models.CellSet, error) { return serviceClient.Cells(logger) }).Should(HaveKey("cell-1"))
+			events := serviceClient.CellEvents(logger)
+
+			ginkgomon.Interrupt(cells)
+			fakeClock.WaitForWatcherAndIncrement(locket.RetryInterval)
+
+			Eventually(events).Should(Receive(Equal(models.NewCellDisappearedEvent([]string{"cell-1"}))))
+		})
+
+		It("reports the ttl and expiry of each presence", func() {
+			Eventually(func() ([]*models.CellPresenceStatus, error) {
+				return serviceClient.CellPresenceStatuses(logger)
+			}).Should(HaveLen(1))
+
+			statuses, err := serviceClient.CellPresenceStatuses(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses[0].Presence).To(Equal(newCellPresence("cell-1")))
+			Expect(statuses[0].Ttl).To(Equal(int64(lockTTL)))
+			Expect(statuses[0].ExpiresAt).To(Equal(fakeClock.Now().Add(lockTTL).UnixNano()))
+			Expect(statuses[0].Stale).To(BeFalse())
+		})
+
+		It("flags a presence that has missed its refreshes as stale", func() {
+			Eventually(func() ([]*models.CellPresenceStatus, error) {
+				return serviceClient.CellPresenceStatuses(logger)
+			}).Should(HaveLen(1))
+
+			_, err := rawSQLDB.Exec(rebind("UPDATE locks SET expires_at = ? WHERE path = ?"),
+				fakeClock.Now().Add(lockTTL/4).UnixNano(), bbs.CellSchemaPath("cell-1"))
+			Expect(err).NotTo(HaveOccurred())
+
+			statuses, err := serviceClient.CellPresenceStatuses(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].Stale).To(BeTrue())
+		})
+	})
+
+	Describe("BBSReadPresences", func() {
+		It("returns every BBS serving reads", func() {
+			presence := models.NewBBSPresence("bbs-1", "https://bbs-1.example.com")
+			runner, err := serviceClient.NewBBSReadPresenceRunner(logger, &presence, retryInterval, lockTTL)
+			Expect(err).NotTo(HaveOccurred())
+
+			process := ifrit.Invoke(runner)
+			defer ginkgomon.Interrupt(process)
+
+			Eventually(func() ([]*models.BBSPresence, error) { return serviceClient.BBSReadPresences(logger) }).Should(ConsistOf(&presence))
+		})
+
+		It("reports the owner, TTL and expiry of each presence", func() {
+			presence := models.NewBBSPresence("bbs-1", "https://bbs-1.example.com")
+			runner, err := serviceClient.NewBBSReadPresenceRunner(logger, &presence, retryInterval, lockTTL)
+			Expect(err).NotTo(HaveOccurred())
+
+			process := ifrit.Invoke(runner)
+			defer ginkgomon.Interrupt(process)
+
+			Eventually(func() ([]*models.BBSPresenceStatus, error) {
+				return serviceClient.BBSReadPresenceStatuses(logger)
+			}).Should(HaveLen(1))
+
+			statuses, err := serviceClient.BBSReadPresenceStatuses(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses[0].Id).To(Equal("bbs-1"))
+			Expect(statuses[0].SessionId).NotTo(BeEmpty())
+			Expect(statuses[0].Ttl).To(Equal(int64(lockTTL)))
+			Expect(statuses[0].ExpiresAt).To(Equal(fakeClock.Now().Add(lockTTL).UnixNano()))
+		})
+	})
+
+	Context("with a clock skew tolerance", func() {
+		const tolerance = 500 * time.Millisecond
+
+		var skewTolerantClient bbs.ServiceClient
+
+		BeforeEach(func() {
+			var err error
+			skewTolerantClient, err = bbs.NewSQLServiceClient(logger, rawSQLDB, sqlRunner.DriverName(), "", fakeClock, tolerance)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		writePresence := func(cellID string, expiresAt time.Time) {
+			value, err := models.ToJSON(newCellPresence(cellID))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(
+				rebind("INSERT INTO locks (path, owner, value, expires_at, modified_index, ttl) VALUES (?, 'skewed-owner', ?, ?, 1, ?)"),
+				bbs.CellSchemaPath(cellID), string(value), expiresAt.UnixNano(), int64(lockTTL),
+			)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("still lists a presence written by a clock running behind by less than the tolerance", func() {
+			writePresence("cell-1", fakeClock.Now().Add(-tolerance/2))
+
+			Expect(serviceClient.Cells(logger)).To(BeEmpty())
+			Expect(skewTolerantClient.Cells(logger)).To(HaveKey("cell-1"))
+
+			statuses, err := skewTolerantClient.CellPresenceStatuses(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].Stale).To(BeTrue())
+		})
+
+		It("stops listing a presence once it is past the tolerance", func() {
+			writePresence("cell-1", fakeClock.Now().Add(-2*tolerance))
+
+			Expect(skewTolerantClient.Cells(logger)).To(BeEmpty())
+			_, err := skewTolerantClient.CellById(logger, "cell-1")
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_ResourceNotFound))
+		})
+
+		It("takes over the lock only after seeing it unchanged for the lock TTL plus the tolerance", func() {
+			_, err := rawSQLDB.Exec(
+				rebind("INSERT INTO locks (path, owner, value, expires_at, modified_index) VALUES (?, 'crashed-owner', '{}', ?, 7)"),
+				bbs.BBSLockSchemaPath(), fakeClock.Now().Add(lockTTL).UnixNano(),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			presence := models.NewBBSPresence("bbs-2", "https://bbs-2.example.com")
+			runner, err := skewTolerantClient.NewBBSLockRunner(logger, &presence, retryInterval, lockTTL)
+			Expect(err).NotTo(HaveOccurred())
+			lock := ifrit.Background(runner)
+			defer ginkgomon.Interrupt(lock)
+
+			Consistently(lock.Ready()).ShouldNot(BeClosed())
+			start := fakeClock.Now()
+
+			Eventually(func() bool {
+				fakeClock.Increment(retryInterval)
+				select {
+				case <-lock.Ready():
+					return true
+				default:
+					return false
+				}
+			}).Should(BeTrue())
+
+			Expect(fakeClock.Since(start)).To(BeNumerically(">=", lockTTL+tolerance))
+		})
+	})
+})
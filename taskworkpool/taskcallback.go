@@ -5,42 +5,120 @@ import (
 	"encoding/json"
 	"net/http"
 	"os"
-	"regexp"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/cfhttp"
 	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
 	"code.cloudfoundry.org/workpool"
 )
 
 const MAX_CB_RETRIES = 3
 
+// RetryPolicy controls how many times a task callback is attempted and how
+// long to wait between attempts. The delay before attempt n+1 is
+// BaseDelay * BackoffMultiplier^(n-1), capped at the HTTP client's timeout.
+type RetryPolicy struct {
+	MaxAttempts       int
+	BaseDelay         time.Duration
+	BackoffMultiplier float64
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:       MAX_CB_RETRIES,
+	BaseDelay:         0,
+	BackoffMultiplier: 1,
+}
+
+func (p RetryPolicy) delay(attempt int, maxDelay time.Duration) time.Duration {
+	delay := float64(p.BaseDelay)
+	for i := 1; i < attempt; i++ {
+		delay *= p.BackoffMultiplier
+	}
+
+	if maxDelay > 0 && delay > float64(maxDelay) {
+		return maxDelay
+	}
+	return time.Duration(delay)
+}
+
+// Tasks whose callback fails are left resolving; convergence demotes them back
+// to completed and resubmits the callback until the completed task expires.
+const (
+	taskCallbacksFailedCounter = metric.Counter("TaskCallbacksFailed")
+
+	// Callbacks dropped because the queue was full are not lost for good:
+	// the task stays completed, and convergence resubmits its callback once
+	// the task has been completed for longer than the kick duration.
+	taskCallbacksDroppedCounter = metric.Counter("TaskCallbacksDropped")
+)
+
 //go:generate counterfeiter . TaskCompletionClient
 
-type CompletedTaskHandler func(logger lager.Logger, httpClient *http.Client, taskDB db.TaskDB, task *models.Task)
+type CompletedTaskHandler func(logger lager.Logger, httpClient *http.Client, taskDB db.TaskDB, task *models.Task, retryPolicy RetryPolicy, stop <-chan struct{})
 
 type TaskCompletionClient interface {
 	Submit(taskDB db.TaskDB, task *models.Task)
 }
 
+// Stats is the state of the callbacks submitted to a TaskCompletionWorkPool.
+type Stats struct {
+	// Queued is the number of callbacks waiting for a worker.
+	Queued int
+	// Active is the number of workers running a callback.
+	Active int
+}
+
 type TaskCompletionWorkPool struct {
+	// queued and active are accessed atomically, and come first to be 64-bit
+	// aligned on 32-bit platforms.
+	queued int64
+	active int64
+
 	logger           lager.Logger
 	maxWorkers       int
+	maxQueued        int64
 	callbackHandler  CompletedTaskHandler
 	callbackWorkPool *workpool.WorkPool
 	httpClient       *http.Client
+	retryPolicy      RetryPolicy
+	drainTimeout     time.Duration
+	stop             chan struct{}
+
+	// pending counts the callbacks of each task guid that are queued or
+	// running. Once draining, no callbacks are accepted and drained is
+	// closed when the last pending callback finishes.
+	pendingLock sync.Mutex
+	pending     map[string]int
+	draining    bool
+	drained     chan struct{}
 }
 
-func New(logger lager.Logger, maxWorkers int, cbHandler CompletedTaskHandler) *TaskCompletionWorkPool {
+// New creates a work pool running task callbacks on up to maxWorkers
+// workers. When maxQueued is positive, callbacks submitted while maxQueued
+// of them are already waiting for a worker are dropped; 0 queues them all.
+// On shutdown the pool waits up to drainTimeout for the queued and running
+// callbacks to finish; 0 abandons them immediately.
+func New(logger lager.Logger, maxWorkers, maxQueued int, cbHandler CompletedTaskHandler, retryPolicy RetryPolicy, drainTimeout time.Duration) *TaskCompletionWorkPool {
 	if cbHandler == nil {
 		panic("callbackHandler cannot be nil")
 	}
 	return &TaskCompletionWorkPool{
 		logger:          logger.Session("task-completion-workpool"),
 		maxWorkers:      maxWorkers,
+		maxQueued:       int64(maxQueued),
 		callbackHandler: cbHandler,
 		httpClient:      cfhttp.NewClient(),
+		retryPolicy:     retryPolicy,
+		drainTimeout:    drainTimeout,
+		stop:            make(chan struct{}),
+		pending:         map[string]int{},
+		drained:         make(chan struct{}),
 	}
 }
 
@@ -59,26 +137,120 @@ func (twp *TaskCompletionWorkPool) Run(signals <-chan os.Signal, ready chan<- st
 	defer logger.Info("finished")
 
 	<-signals
+	twp.drain()
+	close(twp.stop)
 	go twp.callbackWorkPool.Stop()
 
 	return nil
 }
 
+// drain stops accepting callbacks and waits up to the drain timeout for the
+// pending ones to finish. Callbacks still pending afterwards are abandoned;
+// their tasks stay completed or resolving, so convergence resubmits them.
+func (twp *TaskCompletionWorkPool) drain() {
+	logger := twp.logger.Session("drain", lager.Data{"timeout": twp.drainTimeout.String()})
+
+	twp.pendingLock.Lock()
+	twp.draining = true
+	if len(twp.pending) == 0 {
+		close(twp.drained)
+	}
+	twp.pendingLock.Unlock()
+
+	stats := twp.Stats()
+	logger.Info("started", lager.Data{"queued": stats.Queued, "active": stats.Active})
+
+	timer := time.NewTimer(twp.drainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-twp.drained:
+		logger.Info("finished")
+	case <-timer.C:
+		logger.Info("abandoning-task-callbacks", lager.Data{"task_guids": twp.pendingTaskGuids()})
+	}
+}
+
+func (twp *TaskCompletionWorkPool) addPending(taskGuid string) bool {
+	twp.pendingLock.Lock()
+	defer twp.pendingLock.Unlock()
+
+	if twp.draining {
+		return false
+	}
+	twp.pending[taskGuid]++
+	return true
+}
+
+func (twp *TaskCompletionWorkPool) removePending(taskGuid string) {
+	twp.pendingLock.Lock()
+	defer twp.pendingLock.Unlock()
+
+	twp.pending[taskGuid]--
+	if twp.pending[taskGuid] == 0 {
+		delete(twp.pending, taskGuid)
+	}
+	if twp.draining && len(twp.pending) == 0 {
+		close(twp.drained)
+	}
+}
+
+func (twp *TaskCompletionWorkPool) pendingTaskGuids() []string {
+	twp.pendingLock.Lock()
+	defer twp.pendingLock.Unlock()
+
+	taskGuids := make([]string, 0, len(twp.pending))
+	for taskGuid := range twp.pending {
+		taskGuids = append(taskGuids, taskGuid)
+	}
+	sort.Strings(taskGuids)
+	return taskGuids
+}
+
 func (twp *TaskCompletionWorkPool) Submit(taskDB db.TaskDB, task *models.Task) {
 	if twp.callbackWorkPool == nil {
 		panic("called submit before workpool was started")
 	}
 	logger := twp.logger
+
+	queued := atomic.AddInt64(&twp.queued, 1)
+	if twp.maxQueued > 0 && queued > twp.maxQueued {
+		atomic.AddInt64(&twp.queued, -1)
+		logger.Info("dropping-task-callback-queue-full", lager.Data{"task_guid": task.TaskGuid, "max_queued": twp.maxQueued})
+		taskCallbacksDroppedCounter.Increment()
+		return
+	}
+
+	if !twp.addPending(task.TaskGuid) {
+		atomic.AddInt64(&twp.queued, -1)
+		logger.Info("dropping-task-callback-shutting-down", lager.Data{"task_guid": task.TaskGuid})
+		taskCallbacksDroppedCounter.Increment()
+		return
+	}
+
 	twp.callbackWorkPool.Submit(func() {
-		twp.callbackHandler(logger, twp.httpClient, taskDB, task)
+		defer twp.removePending(task.TaskGuid)
+		atomic.AddInt64(&twp.queued, -1)
+		atomic.AddInt64(&twp.active, 1)
+		defer atomic.AddInt64(&twp.active, -1)
+
+		twp.callbackHandler(logger, twp.httpClient, taskDB, task, twp.retryPolicy, twp.stop)
 	})
 }
 
-func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db.TaskDB, task *models.Task) {
+// Stats returns the number of callbacks currently queued and running.
+func (twp *TaskCompletionWorkPool) Stats() Stats {
+	return Stats{
+		Queued: int(atomic.LoadInt64(&twp.queued)),
+		Active: int(atomic.LoadInt64(&twp.active)),
+	}
+}
+
+func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db.TaskDB, task *models.Task, retryPolicy RetryPolicy, stop <-chan struct{}) {
 	logger = logger.Session("handle-completed-task", lager.Data{"task_guid": task.TaskGuid})
 
 	if task.CompletionCallbackUrl != "" {
-		modelErr := taskDB.ResolvingTask(logger, task.TaskGuid)
+		_, _, modelErr := taskDB.ResolvingTask(logger, task.TaskGuid)
 		if modelErr != nil {
 			logger.Error("marking-task-as-resolving-failed", modelErr)
 			return
@@ -101,28 +273,43 @@ func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db
 
 		var statusCode int
 
-		for i := 0; i < MAX_CB_RETRIES; i++ {
+		for attempt := 1; attempt <= retryPolicy.MaxAttempts; attempt++ {
+			if attempt > 1 {
+				timer := time.NewTimer(retryPolicy.delay(attempt-1, httpClient.Timeout))
+				select {
+				case <-timer.C:
+				case <-stop:
+					timer.Stop()
+					logger.Info("aborting-callback-retries", lager.Data{"attempt": attempt})
+					return
+				}
+			}
+
 			request, err := http.NewRequest("POST", task.CompletionCallbackUrl, bytes.NewReader(json))
 			if err != nil {
 				logger.Error("building-request-failed", err)
+				taskCallbacksFailedCounter.Increment()
 				return
 			}
 
 			request.Header.Set("Content-Type", "application/json")
+			request.Cancel = stop
 			response, err := httpClient.Do(request)
 			if err != nil {
-				matched, _ := regexp.MatchString("Client.Timeout|use of closed network connection", err.Error())
-				if matched {
-					continue
+				select {
+				case <-stop:
+					logger.Info("aborting-callback-retries", lager.Data{"attempt": attempt})
+					return
+				default:
 				}
-				logger.Error("doing-request-failed", err)
-				return
+				logger.Error("doing-request-failed", err, lager.Data{"attempt": attempt})
+				continue
 			}
-			defer response.Body.Close()
+			response.Body.Close()
 
 			statusCode = response.StatusCode
 			if shouldResolve(statusCode) {
-				modelErr := taskDB.DeleteTask(logger, task.TaskGuid)
+				_, modelErr := taskDB.DeleteTask(logger, task.TaskGuid)
 				if modelErr != nil {
 					logger.Error("delete-task-failed", modelErr)
 				}
@@ -131,6 +318,7 @@ func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db
 		}
 
 		logger.Info("callback-failed", lager.Data{"status_code": statusCode})
+		taskCallbacksFailedCounter.Increment()
 	}
 	return
 }
@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
@@ -14,19 +15,23 @@ import (
 	"code.cloudfoundry.org/cfhttp"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
 	"github.com/tedsuo/ifrit"
 	"github.com/tedsuo/ifrit/ginkgomon"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 	"github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("TaskWorker", func() {
 	var (
-		fakeServer *ghttp.Server
-		logger     *lagertest.TestLogger
-		timeout    time.Duration
+		fakeServer   *ghttp.Server
+		logger       *lagertest.TestLogger
+		timeout      time.Duration
+		metricSender *fake.FakeMetricSender
 	)
 
 	BeforeEach(func() {
@@ -36,6 +41,9 @@ var _ = Describe("TaskWorker", func() {
 
 		logger = lagertest.NewTestLogger("test")
 		logger.RegisterSink(lager.NewWriterSink(GinkgoWriter, lager.INFO))
+
+		metricSender = fake.NewFakeMetricSender()
+		dropsonde_metrics.Initialize(metricSender, nil)
 	})
 
 	AfterEach(func() {
@@ -49,12 +57,16 @@ var _ = Describe("TaskWorker", func() {
 			statusCodes chan int
 			task        *models.Task
 
-			httpClient *http.Client
+			httpClient  *http.Client
+			retryPolicy taskworkpool.RetryPolicy
+			stop        chan struct{}
 		)
 
 		BeforeEach(func() {
 			httpClient = cfhttp.NewClient()
 			statusCodes = make(chan int)
+			retryPolicy = taskworkpool.DefaultRetryPolicy
+			stop = make(chan struct{})
 
 			fakeServer.RouteToHandler("POST", "/the-callback/url", func(w http.ResponseWriter, req *http.Request) {
 				w.WriteHeader(<-statusCodes)
@@ -62,15 +74,15 @@ var _ = Describe("TaskWorker", func() {
 
 			callbackURL = fakeServer.URL() + "/the-callback/url"
 			taskDB = new(dbfakes.FakeTaskDB)
-			taskDB.ResolvingTaskReturns(nil)
-			taskDB.DeleteTaskReturns(nil)
+			taskDB.ResolvingTaskReturns(nil, nil, nil)
+			taskDB.DeleteTaskReturns(nil, nil)
 		})
 
 		simulateTaskCompleting := func(signals <-chan os.Signal, ready chan<- struct{}) error {
 			close(ready)
 			task = model_helpers.NewValidTask("the-task-guid")
 			task.CompletionCallbackUrl = callbackURL
-			taskworkpool.HandleCompletedTask(logger, httpClient, taskDB, task)
+			taskworkpool.HandleCompletedTask(logger, httpClient, taskDB, task, retryPolicy, stop)
 			return nil
 		}
 
@@ -98,7 +110,7 @@ var _ = Describe("TaskWorker", func() {
 
 			Context("when marking the task as resolving fails", func() {
 				BeforeEach(func() {
-					taskDB.ResolvingTaskReturns(models.NewError(models.Error_UnknownError, "failed to resolve task"))
+					taskDB.ResolvingTaskReturns(nil, nil, models.NewError(models.Error_UnknownError, "failed to resolve task"))
 				})
 
 				It("does not make a request to the task's callback URL", func() {
@@ -136,6 +148,13 @@ var _ = Describe("TaskWorker", func() {
 						_, actualGuid := taskDB.DeleteTaskArgsForCall(0)
 						Expect(actualGuid).To(Equal("the-task-guid"))
 					})
+
+					It("does not emit a failed callback metric", func() {
+						statusCodes <- 200
+
+						Eventually(taskDB.DeleteTaskCallCount).Should(Equal(1))
+						Expect(metricSender.GetCounter("TaskCallbacksFailed")).To(BeZero())
+					})
 				})
 
 				Context("when the request fails with a 4xx response code", func() {
@@ -198,12 +217,22 @@ var _ = Describe("TaskWorker", func() {
 							Consistently(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
 							Consistently(fakeServer.ReceivedRequests, 0.25).Should(HaveLen(3))
 						})
+
+						It("emits a failed callback metric", func() {
+							statusCodes <- 503
+							statusCodes <- 504
+							statusCodes <- 503
+
+							Eventually(func() uint64 {
+								return metricSender.GetCounter("TaskCallbacksFailed")
+							}).Should(BeEquivalentTo(1))
+						})
 					})
 				})
 
 				Context("when DeleteTask fails", func() {
 					BeforeEach(func() {
-						taskDB.DeleteTaskReturns(&models.Error{})
+						taskDB.DeleteTaskReturns(nil, &models.Error{})
 					})
 
 					It("logs an error and returns", func() {
@@ -215,6 +244,110 @@ var _ = Describe("TaskWorker", func() {
 					})
 				})
 
+				Context("when the callback server is flaky", func() {
+					var (
+						failures      int
+						requestTimes  chan time.Time
+						requestsCount int
+					)
+
+					BeforeEach(func() {
+						failures = 3
+						requestsCount = 0
+						requestTimes = make(chan time.Time, 10)
+						retryPolicy = taskworkpool.RetryPolicy{
+							MaxAttempts:       5,
+							BaseDelay:         50 * time.Millisecond,
+							BackoffMultiplier: 2,
+						}
+
+						fakeServer.RouteToHandler("POST", "/the-callback/url", func(w http.ResponseWriter, req *http.Request) {
+							requestTimes <- time.Now()
+							requestsCount++
+							if requestsCount <= failures {
+								w.WriteHeader(http.StatusServiceUnavailable)
+								return
+							}
+							w.WriteHeader(http.StatusOK)
+						})
+					})
+
+					It("retries with backoff until the callback succeeds", func() {
+						Eventually(taskDB.DeleteTaskCallCount).Should(Equal(1))
+						Expect(fakeServer.ReceivedRequests()).To(HaveLen(4))
+
+						var times []time.Time
+						for i := 0; i < 4; i++ {
+							times = append(times, <-requestTimes)
+						}
+						Expect(times[1].Sub(times[0])).To(BeNumerically(">=", 50*time.Millisecond))
+						Expect(times[2].Sub(times[1])).To(BeNumerically(">=", 100*time.Millisecond))
+						Expect(times[3].Sub(times[2])).To(BeNumerically(">=", 200*time.Millisecond))
+
+						Expect(metricSender.GetCounter("TaskCallbacksFailed")).To(BeZero())
+					})
+
+					Context("when it fails more times than the configured attempts", func() {
+						BeforeEach(func() {
+							failures = 5
+						})
+
+						It("gives up after the configured number of attempts", func() {
+							Eventually(func() uint64 {
+								return metricSender.GetCounter("TaskCallbacksFailed")
+							}, 2).Should(BeEquivalentTo(1))
+							Expect(fakeServer.ReceivedRequests()).To(HaveLen(5))
+							Expect(taskDB.DeleteTaskCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the backoff would exceed the communication timeout", func() {
+						BeforeEach(func() {
+							retryPolicy.BaseDelay = time.Hour
+							failures = 1
+						})
+
+						It("caps the delay at the client timeout", func() {
+							Eventually(taskDB.DeleteTaskCallCount, 2*timeout).Should(Equal(1))
+							Expect(fakeServer.ReceivedRequests()).To(HaveLen(2))
+						})
+					})
+
+					Context("when the worker is stopped while waiting to retry", func() {
+						BeforeEach(func() {
+							retryPolicy.BaseDelay = time.Hour
+						})
+
+						It("aborts the remaining retries", func() {
+							Eventually(fakeServer.ReceivedRequests).Should(HaveLen(1))
+							close(stop)
+
+							Eventually(process.Wait()).Should(Receive())
+							Expect(fakeServer.ReceivedRequests()).To(HaveLen(1))
+							Expect(taskDB.DeleteTaskCallCount()).To(Equal(0))
+							Expect(metricSender.GetCounter("TaskCallbacksFailed")).To(BeZero())
+							Expect(logger.TestSink.LogMessages()).To(ContainElement("test.handle-completed-task.aborting-callback-retries"))
+						})
+					})
+				})
+
+				Context("when the callback server cannot be reached", func() {
+					BeforeEach(func() {
+						callbackURL = "http://127.0.0.1:0/the-callback/url"
+					})
+
+					It("does not resolve the task", func() {
+						Eventually(logger.TestSink.LogMessages).Should(ContainElement("test.handle-completed-task.doing-request-failed"))
+						Expect(taskDB.DeleteTaskCallCount()).To(Equal(0))
+					})
+
+					It("emits a failed callback metric", func() {
+						Eventually(func() uint64 {
+							return metricSender.GetCounter("TaskCallbacksFailed")
+						}).Should(BeEquivalentTo(1))
+					})
+				})
+
 				Context("when the request fails with a timeout", func() {
 					var sleepCh chan time.Duration
 
@@ -270,4 +403,137 @@ var _ = Describe("TaskWorker", func() {
 			})
 		})
 	})
+
+	Describe("TaskCompletionWorkPool", func() {
+		var (
+			maxQueued    int
+			drainTimeout time.Duration
+			release      chan struct{}
+			handled      chan string
+
+			workPool *taskworkpool.TaskCompletionWorkPool
+			process  ifrit.Process
+		)
+
+		BeforeEach(func() {
+			maxQueued = 0
+			drainTimeout = 0
+			release = make(chan struct{})
+			handled = make(chan string, 10)
+		})
+
+		JustBeforeEach(func() {
+			release, handled := release, handled
+			handler := func(_ lager.Logger, _ *http.Client, _ db.TaskDB, task *models.Task, _ taskworkpool.RetryPolicy, _ <-chan struct{}) {
+				<-release
+				handled <- task.TaskGuid
+			}
+			workPool = taskworkpool.New(logger, 1, maxQueued, handler, taskworkpool.DefaultRetryPolicy, drainTimeout)
+			process = ginkgomon.Invoke(workPool)
+		})
+
+		AfterEach(func() {
+			select {
+			case <-release:
+			default:
+				close(release)
+			}
+			ginkgomon.Kill(process)
+		})
+
+		submit := func(taskGuids ...string) {
+			for _, taskGuid := range taskGuids {
+				workPool.Submit(new(dbfakes.FakeTaskDB), model_helpers.NewValidTask(taskGuid))
+			}
+		}
+
+		It("reports the queued callbacks and the active workers", func() {
+			submit("task-1", "task-2", "task-3")
+
+			Eventually(workPool.Stats).Should(Equal(taskworkpool.Stats{Queued: 2, Active: 1}))
+
+			close(release)
+			Eventually(handled).Should(HaveLen(3))
+			Eventually(workPool.Stats).Should(Equal(taskworkpool.Stats{}))
+		})
+
+		Context("when the queue is unbounded", func() {
+			It("never drops a callback", func() {
+				submit("task-1", "task-2", "task-3", "task-4", "task-5")
+
+				close(release)
+				Eventually(handled).Should(HaveLen(5))
+				Expect(metricSender.GetCounter("TaskCallbacksDropped")).To(BeZero())
+			})
+		})
+
+		Context("when the queue is bounded", func() {
+			BeforeEach(func() {
+				maxQueued = 2
+			})
+
+			It("drops the callbacks submitted while the queue is full", func() {
+				submit("task-1")
+				Eventually(workPool.Stats).Should(Equal(taskworkpool.Stats{Queued: 0, Active: 1}))
+
+				submit("task-2", "task-3", "task-4")
+				Expect(workPool.Stats()).To(Equal(taskworkpool.Stats{Queued: 2, Active: 1}))
+				Expect(metricSender.GetCounter("TaskCallbacksDropped")).To(BeEquivalentTo(1))
+
+				close(release)
+				Eventually(handled).Should(HaveLen(3))
+				Consistently(handled).Should(HaveLen(3))
+			})
+		})
+
+		Describe("shutting down", func() {
+			BeforeEach(func() {
+				drainTimeout = time.Minute
+			})
+
+			It("attempts the queued callbacks before exiting", func() {
+				submit("task-1", "task-2", "task-3")
+				Eventually(workPool.Stats).Should(Equal(taskworkpool.Stats{Queued: 2, Active: 1}))
+
+				process.Signal(os.Interrupt)
+				Consistently(process.Wait()).ShouldNot(Receive())
+
+				close(release)
+				Eventually(handled).Should(HaveLen(3))
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+			})
+
+			It("drops callbacks submitted while draining", func() {
+				submit("task-1")
+				Eventually(workPool.Stats).Should(Equal(taskworkpool.Stats{Queued: 0, Active: 1}))
+
+				process.Signal(os.Interrupt)
+				Eventually(logger).Should(gbytes.Say("drain.started"))
+
+				submit("task-2")
+				Expect(metricSender.GetCounter("TaskCallbacksDropped")).To(BeEquivalentTo(1))
+
+				close(release)
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+				Expect(handled).To(HaveLen(1))
+			})
+
+			Context("when the callbacks do not finish within the drain timeout", func() {
+				BeforeEach(func() {
+					drainTimeout = 100 * time.Millisecond
+				})
+
+				It("exits, logging the task guids of the abandoned callbacks", func() {
+					submit("task-1", "task-2")
+					Eventually(workPool.Stats).Should(Equal(taskworkpool.Stats{Queued: 1, Active: 1}))
+
+					process.Signal(os.Interrupt)
+					Eventually(process.Wait()).Should(Receive(BeNil()))
+
+					Expect(logger).To(gbytes.Say(`abandoning-task-callbacks.*"task_guids":\["task-1","task-2"\]`))
+					Expect(handled).To(BeEmpty())
+				})
+			})
+		})
+	})
 })
@@ -71,6 +71,9 @@ func (m *MySQLRunner) Reset() {
 		"TRUNCATE TABLE tasks",
 		"TRUNCATE TABLE desired_lrps",
 		"TRUNCATE TABLE actual_lrps",
+		"TRUNCATE TABLE idempotency_keys",
+		"TRUNCATE TABLE desired_lrp_tombstones",
+		"TRUNCATE TABLE desired_lrp_labels",
 	}
 	for _, query := range truncateTablesSQL {
 		result, err := m.db.Exec(query)
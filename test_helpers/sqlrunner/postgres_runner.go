@@ -76,6 +76,9 @@ func (p *PostgresRunner) Reset() {
 		"TRUNCATE TABLE tasks",
 		"TRUNCATE TABLE desired_lrps",
 		"TRUNCATE TABLE actual_lrps",
+		"TRUNCATE TABLE idempotency_keys",
+		"TRUNCATE TABLE desired_lrp_tombstones",
+		"TRUNCATE TABLE desired_lrp_labels",
 	}
 	for _, query := range truncateTablesSQL {
 		result, err := p.db.Exec(query)